@@ -15,20 +15,32 @@ CREATE TABLE `users` (
   `avatar` varchar(255) DEFAULT 'https://example.com/default-avatar.jpg' COMMENT 'Avatar URL',
   `background_image` varchar(255) DEFAULT 'https://example.com/default-bg.jpg' COMMENT 'Background image URL',
   `signature` varchar(200) DEFAULT '' COMMENT 'User signature',
+  `email` varchar(128) NULL DEFAULT NULL COMMENT 'Verified email',
+  `phone` varchar(20) NULL DEFAULT NULL COMMENT 'Mobile phone number',
+  `timezone` varchar(64) NOT NULL DEFAULT 'UTC' COMMENT 'IANA timezone name',
+  `is_private` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'Private account, follows require approval',
   `follow_count` int DEFAULT '0' COMMENT 'Following count',
   `follower_count` int DEFAULT '0' COMMENT 'Follower count',
   `total_favorited` bigint DEFAULT '0' COMMENT 'Total likes received',
   `work_count` int DEFAULT '0' COMMENT 'Video count',
   `favorite_count` int DEFAULT '0' COMMENT 'Liked video count',
-  `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive',
+  `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive, 3-pending deletion, 4-banned, 5-deleted',
   `last_login_at` timestamp NULL COMMENT 'Last login time',
+  `deletion_requested_at` timestamp NULL DEFAULT NULL COMMENT 'Account deletion request time',
+  `deletion_scheduled_at` timestamp NULL DEFAULT NULL COMMENT 'Account purge time after the grace period',
+  `banned_at` timestamp NULL DEFAULT NULL COMMENT 'Ban time',
+  `banned_by` bigint NULL DEFAULT NULL COMMENT 'Admin who banned the user',
+  `ban_reason` varchar(500) NULL DEFAULT NULL COMMENT 'Ban reason',
   `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
   `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
   UNIQUE KEY `uk_username` (`username`),
+  UNIQUE KEY `uk_email` (`email`),
+  UNIQUE KEY `uk_phone` (`phone`),
   KEY `idx_created_at` (`created_at`),
   KEY `idx_status` (`status`),
-  KEY `idx_last_login` (`last_login_at`)
+  KEY `idx_last_login` (`last_login_at`),
+  KEY `idx_deletion_scheduled` (`deletion_scheduled_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
 -- 角色表
@@ -51,6 +63,7 @@ CREATE TABLE `permissions` (
   `resource` varchar(100) NOT NULL COMMENT 'Resource path',
   `action` varchar(20) NOT NULL COMMENT 'Action type',
   `description` varchar(200) DEFAULT NULL COMMENT 'Permission description',
+  `scope` varchar(20) NOT NULL DEFAULT '' COMMENT 'Permission scope: empty-any resource, own-owned resources only',
   `status` tinyint DEFAULT '1' COMMENT 'Permission status: 1-active, 2-inactive',
   `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
   `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
@@ -93,6 +106,7 @@ CREATE TABLE `user_sessions` (
   `id` bigint NOT NULL AUTO_INCREMENT,
   `user_id` bigint NOT NULL COMMENT 'User ID',
   `refresh_token` varchar(255) NOT NULL COMMENT 'Refresh token',
+  `family_id` varchar(64) NOT NULL DEFAULT '' COMMENT 'Refresh token rotation family',
   `expires_at` timestamp NOT NULL COMMENT 'Expiration time',
   `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
@@ -135,16 +149,33 @@ CREATE TABLE `videos` (
   `title` varchar(255) NOT NULL COMMENT 'Video title',
   `play_url` varchar(500) NOT NULL COMMENT 'Video play URL',
   `cover_url` varchar(500) DEFAULT NULL COMMENT 'Video cover URL',
+  `play_urls` json DEFAULT NULL COMMENT 'Transcoded play URLs keyed by quality',
+  `hls_url` varchar(500) NOT NULL DEFAULT '' COMMENT 'HLS master playlist URL',
+  `dynamic_cover_url` varchar(500) NOT NULL DEFAULT '' COMMENT 'Animated preview URL',
+  `category_id` bigint NOT NULL DEFAULT '0' COMMENT 'Video category, 0 for uncategorized',
+  `duration` decimal(10,3) NOT NULL DEFAULT '0' COMMENT 'Duration in seconds',
+  `width` int NOT NULL DEFAULT '0' COMMENT 'Source width in pixels',
+  `height` int NOT NULL DEFAULT '0' COMMENT 'Source height in pixels',
+  `bitrate` bigint NOT NULL DEFAULT '0' COMMENT 'Source bitrate in bps',
+  `size` bigint NOT NULL DEFAULT '0' COMMENT 'Source file size in bytes',
+  `format` varchar(16) NOT NULL DEFAULT '' COMMENT 'Container format from file extension',
+  `audio_codec` varchar(32) NOT NULL DEFAULT '' COMMENT 'Source audio codec',
+  `audio_bitrate` bigint NOT NULL DEFAULT '0' COMMENT 'Source audio bitrate in bps',
   `favorite_count` int DEFAULT '0' COMMENT 'Like count',
   `comment_count` int DEFAULT '0' COMMENT 'Comment count',
   `play_count` bigint DEFAULT '0' COMMENT 'Play count',
-  `status` tinyint DEFAULT '1' COMMENT 'Video status: 1-published, 2-private, 3-deleted',
+  `share_count` bigint NOT NULL DEFAULT '0' COMMENT 'Share count',
+  `status` tinyint DEFAULT '1' COMMENT 'Video status: 0-pending, 1-published, 2-private, 3-deleted, 4-failed, 5-auditing, 6-rejected, 7-hidden, 8-taken-down, 9-unavailable, 10-draft',
+  `visibility` tinyint NOT NULL DEFAULT '1' COMMENT 'Visibility: 1-public, 2-friends, 3-private',
+  `publish_at` timestamp NULL DEFAULT NULL COMMENT 'Scheduled publish time of a draft',
   `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
   `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
   KEY `idx_author_created` (`author_id`,`created_at` DESC),
   KEY `idx_created_at` (`created_at` DESC),
   KEY `idx_status` (`status`),
+  KEY `idx_category_status_created` (`category_id`,`status`,`created_at` DESC),
+  KEY `idx_status_publish_at` (`status`,`publish_at`),
   CONSTRAINT `fk_videos_author` FOREIGN KEY (`author_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
@@ -171,13 +202,15 @@ CREATE TABLE `comments` (
   `content` text NOT NULL COMMENT 'Comment content',
   `like_count` int DEFAULT '0' COMMENT 'Comment like count',
   `reply_count` int DEFAULT '0' COMMENT 'Reply count',
-  `status` tinyint DEFAULT '1' COMMENT 'Comment status: 1-normal, 2-deleted',
+  `status` tinyint DEFAULT '1' COMMENT 'Comment status: 1-normal, 2-deleted, 3-hidden, 4-pending review',
+  `hearted_at` timestamp NULL DEFAULT NULL COMMENT 'Time the video author hearted the comment',
   `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
   `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
   KEY `idx_video_created` (`video_id`,`created_at` DESC),
   KEY `idx_user_id` (`user_id`),
   KEY `idx_parent_id` (`parent_id`),
+  KEY `idx_status_created` (`status`,`created_at`),
   CONSTRAINT `fk_comments_video` FOREIGN KEY (`video_id`) REFERENCES `videos` (`id`) ON DELETE CASCADE,
   CONSTRAINT `fk_comments_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -198,6 +231,473 @@ CREATE TABLE `messages` (
   CONSTRAINT `fk_messages_to_user` FOREIGN KEY (`to_user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
+-- 用户屏蔽词表
+CREATE TABLE `user_muted_keywords` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Creator user ID',
+  `keyword` varchar(50) NOT NULL COMMENT 'Muted keyword, lower case',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_keyword` (`user_id`,`keyword`),
+  CONSTRAINT `fk_muted_keywords_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 视频审核记录表
+CREATE TABLE `video_audits` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL COMMENT 'Video ID',
+  `auditor_id` bigint NOT NULL COMMENT 'Moderator user ID',
+  `from_status` tinyint NOT NULL COMMENT 'Video status before review',
+  `to_status` tinyint NOT NULL COMMENT 'Video status after review',
+  `reason` varchar(255) DEFAULT NULL COMMENT 'Review reason, required on rejection',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_video_id` (`video_id`),
+  KEY `idx_auditor_created` (`auditor_id`,`created_at` DESC),
+  CONSTRAINT `fk_video_audits_video` FOREIGN KEY (`video_id`) REFERENCES `videos` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 可疑注册审核队列
+CREATE TABLE `flagged_registrations` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Registered user ID',
+  `username` varchar(32) NOT NULL COMMENT 'Username at registration',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT 'Client IP at registration',
+  `reasons` varchar(255) NOT NULL COMMENT 'Comma separated flag reasons',
+  `contact` varchar(254) NOT NULL DEFAULT '' COMMENT 'Email or phone provided at registration',
+  `status` tinyint NOT NULL DEFAULT 0 COMMENT '0 pending, 1 approved, 2 rejected',
+  `reviewer_id` bigint NOT NULL DEFAULT 0 COMMENT 'Reviewing admin user ID',
+  `reviewed_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`),
+  KEY `idx_status_created` (`status`,`created_at`),
+  CONSTRAINT `fk_flagged_registrations_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 权限拒绝审计记录
+CREATE TABLE `permission_denials` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Denied user ID',
+  `resource` varchar(100) NOT NULL COMMENT 'Requested resource',
+  `action` varchar(50) NOT NULL COMMENT 'Requested action',
+  `route` varchar(255) NOT NULL DEFAULT '' COMMENT 'Request route or gRPC operation',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`,`created_at`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 视频处理任务记录
+CREATE TABLE `processing_jobs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL COMMENT 'Video ID',
+  `author_id` bigint NOT NULL COMMENT 'Video author ID',
+  `job_type` varchar(20) NOT NULL COMMENT 'Job type: transcode, thumbnail',
+  `status` varchar(20) NOT NULL COMMENT 'Job status: success, failed',
+  `duration_ms` bigint NOT NULL DEFAULT '0' COMMENT 'Wall clock time in milliseconds',
+  `cpu_seconds` double NOT NULL DEFAULT '0' COMMENT 'CPU time of external processes',
+  `input_bytes` bigint NOT NULL DEFAULT '0' COMMENT 'Input size',
+  `output_bytes` bigint NOT NULL DEFAULT '0' COMMENT 'Output size',
+  `error_message` varchar(500) NOT NULL DEFAULT '' COMMENT 'Failure reason',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_video_id` (`video_id`),
+  KEY `idx_author_created` (`author_id`,`created_at`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 用户推荐码，记录生成时的IP和设备用于识别自我推荐
+CREATE TABLE `referral_codes` (
+  `user_id` bigint NOT NULL COMMENT 'Referrer user ID',
+  `code` varchar(16) NOT NULL COMMENT 'Referral code',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT 'IP when the code was created',
+  `device_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'Device when the code was created',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`user_id`),
+  UNIQUE KEY `uk_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 推荐注册归因
+CREATE TABLE `referrals` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `referrer_id` bigint NOT NULL COMMENT 'Referrer user ID',
+  `referee_id` bigint NOT NULL COMMENT 'Referred user ID',
+  `code` varchar(16) NOT NULL COMMENT 'Redeemed referral code',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT 'Registration IP',
+  `device_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'Registration device',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT 'Status: 0-pending, 1-activated, 2-flagged',
+  `flag_reasons` varchar(255) NOT NULL DEFAULT '' COMMENT 'Comma separated abuse flags',
+  `activated_at` timestamp NULL DEFAULT NULL COMMENT 'When the referred user published the first video',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_referee_id` (`referee_id`),
+  KEY `idx_referrer_status` (`referrer_id`,`status`),
+  KEY `idx_device_created` (`device_id`,`created_at`),
+  KEY `idx_status_activated` (`status`,`activated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 创作者内容日历草稿，设置了计划发布时间的草稿出现在日历中并按提醒时间发送提醒
+CREATE TABLE `content_drafts` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Creator user ID',
+  `title` varchar(100) NOT NULL COMMENT 'Draft title',
+  `note` varchar(500) NOT NULL DEFAULT '' COMMENT 'Planning note',
+  `scheduled_at` timestamp NULL DEFAULT NULL COMMENT 'Planned publish time, NULL when unscheduled',
+  `remind_at` timestamp NULL DEFAULT NULL COMMENT 'Reminder time, NULL when no reminder',
+  `reminded_at` timestamp NULL DEFAULT NULL COMMENT 'When the reminder was sent',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_scheduled` (`user_id`,`scheduled_at`),
+  KEY `idx_remind` (`reminded_at`,`remind_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 观看记录，同一用户在去重窗口内重复观看同一视频只记录一次，过期记录由 watch_history 保留策略清理
+CREATE TABLE `watch_history` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Viewer user ID',
+  `video_id` bigint NOT NULL COMMENT 'Watched video ID',
+  `source` tinyint NOT NULL DEFAULT '0' COMMENT 'Traffic source: 0-unknown, 1-feed, 2-profile, 3-share link',
+  `watched_at` timestamp(3) NOT NULL COMMENT 'When the view was recorded',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_watched` (`user_id`,`watched_at`),
+  KEY `idx_video_watched` (`video_id`,`watched_at`),
+  KEY `idx_watched_at` (`watched_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 事件发件箱，与业务数据同一事务写入，由 outbox_relay 任务投递到Kafka；已投递记录由 event_outbox 保留策略清理
+CREATE TABLE `event_outbox` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `event_id` varchar(64) NOT NULL COMMENT 'Idempotency key, used as the Kafka message ID',
+  `event_type` varchar(64) NOT NULL COMMENT 'Event type, e.g. video.uploaded',
+  `aggregate_id` bigint NOT NULL COMMENT 'ID of the entity the event belongs to',
+  `payload` json NOT NULL COMMENT 'Serialized domain event',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '0: pending, 1: sent, 2: dead',
+  `attempts` int NOT NULL DEFAULT '0' COMMENT 'Failed delivery attempts',
+  `last_error` varchar(500) DEFAULT NULL COMMENT 'Last delivery error',
+  `next_attempt_at` timestamp(3) NOT NULL COMMENT 'Earliest time of the next delivery attempt',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `sent_at` timestamp(3) NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_event_id` (`event_id`),
+  KEY `idx_status_next` (`status`,`next_attempt_at`),
+  KEY `idx_sent_at` (`sent_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 消费重试耗尽的死信消息，供管理后台查询和重放；已重放记录由 replayed_dead_letters 保留策略清理
+CREATE TABLE `dead_letter_messages` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `topic` varchar(128) NOT NULL COMMENT 'Original topic',
+  `partition` int NOT NULL COMMENT 'Original partition',
+  `offset` bigint NOT NULL COMMENT 'Original offset',
+  `message_key` varchar(255) NOT NULL DEFAULT '' COMMENT 'Original message key',
+  `message_id` varchar(64) NOT NULL DEFAULT '' COMMENT 'Message ID, empty when the payload could not be parsed',
+  `payload` mediumblob NOT NULL COMMENT 'Original message value',
+  `error` varchar(1000) NOT NULL COMMENT 'Last handling error',
+  `attempts` int NOT NULL DEFAULT '0' COMMENT 'Handling attempts before dead-lettering',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '0: pending, 1: replayed',
+  `replayed_by` bigint DEFAULT NULL COMMENT 'Admin who replayed the message',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `replayed_at` timestamp(3) NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_topic_partition_offset` (`topic`,`partition`,`offset`),
+  KEY `idx_status_created` (`status`,`created_at`),
+  KEY `idx_replayed_at` (`replayed_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `video_takedowns` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL,
+  `author_id` bigint NOT NULL COMMENT 'Video author, no foreign key so the record outlives account deletion',
+  `admin_id` bigint NOT NULL COMMENT 'Admin who took the video down',
+  `category` varchar(32) NOT NULL COMMENT 'copyright, illegal, violence, sexual, harassment, spam, other',
+  `reason` varchar(500) NOT NULL,
+  `from_status` tinyint NOT NULL COMMENT 'Video status before takedown, restored on reinstatement',
+  `held_objects` json DEFAULT NULL COMMENT 'Original object key to legal-hold object key',
+  `status` tinyint NOT NULL DEFAULT '1' COMMENT '1: taken down, 2: appealed, 3: reinstated, 4: upheld',
+  `legal_hold` tinyint(1) NOT NULL DEFAULT '1' COMMENT 'Content and author account must not be purged while set',
+  `appeal_reason` varchar(500) NOT NULL DEFAULT '',
+  `appealed_at` timestamp(3) NULL DEFAULT NULL,
+  `decided_by` bigint DEFAULT NULL COMMENT 'Admin who decided the appeal',
+  `decided_at` timestamp(3) NULL DEFAULT NULL,
+  `decision_note` varchar(500) NOT NULL DEFAULT '',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_video_id` (`video_id`),
+  KEY `idx_author_created` (`author_id`,`created_at`),
+  KEY `idx_status_created` (`status`,`created_at`),
+  KEY `idx_author_legal_hold` (`author_id`,`legal_hold`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `takedown_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `takedown_id` bigint NOT NULL,
+  `actor_id` bigint NOT NULL COMMENT 'Admin or author who performed the action',
+  `action` varchar(20) NOT NULL COMMENT 'takedown, appeal, reinstate, uphold',
+  `note` varchar(500) NOT NULL DEFAULT '',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_takedown_created` (`takedown_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 视频分类表
+CREATE TABLE `video_categories` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `slug` varchar(50) NOT NULL COMMENT 'Stable identifier used by clients and analytics',
+  `names` json NOT NULL COMMENT 'Localized names keyed by language tag',
+  `sort_order` int NOT NULL DEFAULT '0' COMMENT 'Ascending display order',
+  `status` tinyint NOT NULL DEFAULT '1' COMMENT '1: active, 2: disabled',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_slug` (`slug`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 运维操作审计表
+CREATE TABLE `admin_operation_logs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `admin_id` bigint NOT NULL,
+  `action` varchar(32) NOT NULL COMMENT 'flush_cache, purge_sessions, reindex, requeue_processing',
+  `target` varchar(500) NOT NULL DEFAULT '' COMMENT 'Namespace, user id, id range or video ids',
+  `affected` bigint NOT NULL DEFAULT '0' COMMENT 'Number of entries affected',
+  `status` tinyint NOT NULL DEFAULT '1' COMMENT '1: succeeded, 2: failed',
+  `error` varchar(1000) NOT NULL DEFAULT '',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_action_created` (`action`,`created_at`),
+  KEY `idx_admin_created` (`admin_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 视频字幕表
+CREATE TABLE `video_captions` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL,
+  `author_id` bigint NOT NULL COMMENT 'Denormalized video author for per-creator search',
+  `language` varchar(16) NOT NULL DEFAULT '' COMMENT 'BCP 47 language tag, empty if unknown',
+  `start_ms` int NOT NULL COMMENT 'Cue start offset in milliseconds',
+  `end_ms` int NOT NULL COMMENT 'Cue end offset in milliseconds',
+  `text` varchar(1000) NOT NULL,
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_video_language_start` (`video_id`,`language`,`start_ms`),
+  KEY `idx_author` (`author_id`),
+  FULLTEXT KEY `ft_text` (`text`) WITH PARSER ngram
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 视频文件完整性校验表
+CREATE TABLE `video_object_integrity` (
+  `video_id` bigint NOT NULL,
+  `object_name` varchar(500) NOT NULL COMMENT 'Object key of the original upload',
+  `size` bigint NOT NULL DEFAULT 0,
+  `etag` varchar(128) NOT NULL DEFAULT '' COMMENT 'ETag recorded on first verification',
+  `checksum` varchar(80) NOT NULL DEFAULT '' COMMENT 'md5:<hex> of the content, empty if never downloaded',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '1 ok, 2 derived objects missing, 3 original missing, 4 original corrupted',
+  `detail` varchar(500) NOT NULL DEFAULT '',
+  `checked_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`video_id`),
+  KEY `idx_status_checked` (`status`,`checked_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 推广计划表
+CREATE TABLE `promotion_campaigns` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `video_id` bigint NOT NULL COMMENT 'Promoted video',
+  `category_ids` json DEFAULT NULL COMMENT 'Category feeds to target, empty for the main feed only',
+  `audience` varchar(16) NOT NULL DEFAULT 'all' COMMENT 'all, users or guests',
+  `priority` int NOT NULL DEFAULT 0 COMMENT 'Higher priority fills earlier slots',
+  `frequency_cap` int NOT NULL DEFAULT 0 COMMENT 'Max impressions per user within the window, 0 for unlimited',
+  `frequency_window_sec` int NOT NULL DEFAULT 0,
+  `start_at` timestamp(3) NOT NULL,
+  `end_at` timestamp(3) NOT NULL,
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '1 active, 2 paused',
+  `created_by` bigint NOT NULL,
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_status_end` (`status`,`end_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 推广曝光点击记录表
+CREATE TABLE `promotion_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `campaign_id` bigint NOT NULL,
+  `video_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL DEFAULT 0 COMMENT '0 for guests',
+  `event_type` tinyint NOT NULL COMMENT '1 impression, 2 click',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_campaign_type_created` (`campaign_id`,`event_type`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 关注申请表，关注私密账号时先创建申请，对方通过后才建立关注关系
+CREATE TABLE `follow_requests` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `requester_id` bigint NOT NULL COMMENT 'User asking to follow',
+  `target_id` bigint NOT NULL COMMENT 'Private account being followed',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '1 pending, 2 approved, 3 rejected',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_requester_target` (`requester_id`,`target_id`),
+  KEY `idx_target_status_created` (`target_id`,`status`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 消费者已处理的事件表，Kafka重复投递时按事件ID跳过，避免重复执行副作用
+CREATE TABLE `processed_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `consumer` varchar(64) NOT NULL COMMENT 'Handler that processed the event',
+  `event_id` varchar(64) NOT NULL,
+  `processed_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_consumer_event` (`consumer`,`event_id`),
+  KEY `idx_processed_at` (`processed_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 视频计数写缓冲的日志流检查点表，崩溃后重放日志时跳过已写入的条目
+CREATE TABLE `video_stats_checkpoints` (
+  `stream` varchar(64) NOT NULL COMMENT 'Redis stream key of the stats journal',
+  `last_entry_id` varchar(32) NOT NULL COMMENT 'Last stream entry applied to videos',
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`stream`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 用户日统计表，按UTC自然日累加关注、点赞和播放事件
+CREATE TABLE `user_stats_daily` (
+  `user_id` bigint NOT NULL,
+  `stat_date` date NOT NULL COMMENT 'UTC date',
+  `new_followers` bigint NOT NULL DEFAULT 0,
+  `lost_followers` bigint NOT NULL DEFAULT 0,
+  `video_plays` bigint NOT NULL DEFAULT 0 COMMENT 'Plays of the user''s videos',
+  `likes_received` bigint NOT NULL DEFAULT 0 COMMENT 'Net likes on the user''s videos',
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`user_id`, `stat_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 内容审核词表，与配置中的词表合并
+CREATE TABLE `sensitive_words` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `word` varchar(64) NOT NULL,
+  `level` tinyint NOT NULL COMMENT '1 review, 2 block',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_word` (`word`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 令牌签名密钥，新密钥延迟生效，被替换的密钥退役前仍可验证
+CREATE TABLE `jwt_signing_keys` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `kid` varchar(64) NOT NULL,
+  `algorithm` varchar(16) NOT NULL DEFAULT 'HS256',
+  `secret` text NOT NULL,
+  `activate_at` timestamp(3) NOT NULL,
+  `retire_at` timestamp(3) NULL DEFAULT NULL,
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_kid` (`kid`),
+  KEY `idx_retire_at` (`retire_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 用户角色绑定的权限版本，随绑定变更递增
+CREATE TABLE `user_permission_versions` (
+  `user_id` bigint NOT NULL,
+  `version` bigint NOT NULL DEFAULT '0',
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 登录记录，按设备指纹和网络段识别异常登录
+CREATE TABLE `login_history` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `device_id` varchar(128) NOT NULL DEFAULT '',
+  `fingerprint` varchar(64) NOT NULL,
+  `ip` varchar(45) NOT NULL DEFAULT '',
+  `network` varchar(64) NOT NULL DEFAULT '',
+  `user_agent` varchar(255) NOT NULL DEFAULT '',
+  `status` tinyint NOT NULL,
+  `reason` varchar(64) NOT NULL DEFAULT '',
+  `challenge_code` varchar(64) NOT NULL DEFAULT '',
+  `challenge_attempts` int NOT NULL DEFAULT '0',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`,`created_at`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 第三方登录账号绑定
+CREATE TABLE `user_oauth_accounts` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `provider` varchar(32) NOT NULL,
+  `subject` varchar(128) NOT NULL,
+  `email` varchar(128) NOT NULL DEFAULT '',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_provider_subject` (`provider`,`subject`),
+  KEY `idx_user_id` (`user_id`),
+  CONSTRAINT `fk_user_oauth_accounts_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 待删除的存储对象，以 / 结尾的 object_name 表示前缀
+CREATE TABLE `storage_deletions` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `object_name` varchar(500) NOT NULL,
+  `reason` varchar(32) NOT NULL DEFAULT '',
+  `delete_after` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `attempts` int NOT NULL DEFAULT 0,
+  `last_error` varchar(500) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_delete_after` (`delete_after`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 用户创建的视频合集，video_count 随条目增删同步维护
+CREATE TABLE `playlists` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Owner user ID',
+  `name` varchar(50) NOT NULL COMMENT 'Playlist name',
+  `description` varchar(200) NOT NULL DEFAULT '' COMMENT 'Playlist description',
+  `video_count` bigint NOT NULL DEFAULT 0 COMMENT 'Number of entries',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 合集中的视频条目，按 position 升序排列
+CREATE TABLE `playlist_videos` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `playlist_id` bigint NOT NULL,
+  `video_id` bigint NOT NULL,
+  `position` int NOT NULL DEFAULT 0 COMMENT 'Sort order within the playlist',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_playlist_video` (`playlist_id`,`video_id`),
+  KEY `idx_playlist_position` (`playlist_id`,`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 分享短链，同一用户分享同一视频复用同一个 token，打开和带 token 的播放归因到分享者
+CREATE TABLE `video_share_links` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `token` varchar(16) CHARACTER SET ascii COLLATE ascii_bin NOT NULL COMMENT 'Short share token, case-sensitive',
+  `video_id` bigint NOT NULL,
+  `sharer_id` bigint NOT NULL COMMENT 'User who shared the video',
+  `share_count` bigint NOT NULL DEFAULT 0 COMMENT 'Times the sharer shared the video',
+  `open_count` bigint NOT NULL DEFAULT 0 COMMENT 'Times the link was opened by others',
+  `play_count` bigint NOT NULL DEFAULT 0 COMMENT 'Counted plays attributed to the link',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_token` (`token`),
+  UNIQUE KEY `uk_video_sharer` (`video_id`,`sharer_id`),
+  KEY `idx_sharer` (`sharer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
 -- 插入基础角色数据
 INSERT INTO `roles` (`name`, `description`) VALUES
 ('user', 'Regular user'),
@@ -220,11 +720,15 @@ INSERT INTO `permissions` (`name`, `resource`, `action`, `description`) VALUES
 ('comment:delete', '/comment', 'DELETE', 'Delete comment'),
 ('admin:all', '/*', '*', 'Administrator full access');
 
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `scope`) VALUES
+('video:delete:own', '/video', 'DELETE', 'Delete own video', 'own'),
+('comment:delete:own', '/comment', 'DELETE', 'Delete own comment or comments under own video', 'own');
+
 -- 分配权限给角色
 -- 用户角色权限
 INSERT INTO `role_permissions` (`role_id`, `permission_id`) 
 SELECT r.id, p.id FROM `roles` r, `permissions` p 
-WHERE r.name = 'user' AND p.name IN ('user:read', 'user:update', 'video:create', 'video:read', 'comment:create', 'comment:read');
+WHERE r.name = 'user' AND p.name IN ('user:read', 'user:update', 'video:create', 'video:read', 'comment:create', 'comment:read', 'video:delete:own', 'comment:delete:own');
 
 -- 管理员角色权限
 INSERT INTO `role_permissions` (`role_id`, `permission_id`) 
@@ -254,28 +254,75 @@ type ErrorCode int32
 const (
 	ErrorCode_SUCCESS ErrorCode = 0
 	// 通用错误 10xxx
-	ErrorCode_PARAM_ERROR       ErrorCode = 10001
-	ErrorCode_TOKEN_INVALID     ErrorCode = 10002
-	ErrorCode_TOKEN_EXPIRED     ErrorCode = 10003
-	ErrorCode_PERMISSION_DENIED ErrorCode = 10004
-	ErrorCode_RATE_LIMIT        ErrorCode = 10005
-	ErrorCode_SERVER_ERROR      ErrorCode = 50000
+	ErrorCode_PARAM_ERROR           ErrorCode = 10001
+	ErrorCode_TOKEN_INVALID         ErrorCode = 10002
+	ErrorCode_TOKEN_EXPIRED         ErrorCode = 10003
+	ErrorCode_PERMISSION_DENIED     ErrorCode = 10004
+	ErrorCode_RATE_LIMIT            ErrorCode = 10005
+	ErrorCode_ROLE_NOT_FOUND        ErrorCode = 10006 // 角色不存在
+	ErrorCode_PERMISSION_NOT_FOUND  ErrorCode = 10007 // 权限不存在
+	ErrorCode_ROLE_EXIST            ErrorCode = 10008 // 角色名称已存在
+	ErrorCode_PERMISSION_EXIST      ErrorCode = 10009 // 权限名称已存在
+	ErrorCode_BUILTIN_ROLE          ErrorCode = 10010 // 内置角色不能删除、改名或禁用
+	ErrorCode_DEAD_LETTER_NOT_FOUND ErrorCode = 10011 // 死信消息不存在
+	ErrorCode_DEAD_LETTER_REPLAYED  ErrorCode = 10012 // 死信消息已重放
+	ErrorCode_SIGNATURE_INVALID     ErrorCode = 10013 // 回调签名缺失、无效或时间戳过期
+	ErrorCode_REQUEST_REPLAYED      ErrorCode = 10014 // 回调请求重放
+	ErrorCode_RESOURCE_LOCKED       ErrorCode = 10015 // 资源正被其他请求处理，稍后重试
+	ErrorCode_CONTENT_VIOLATION     ErrorCode = 10016 // 内容包含违禁词或被外部审核拒绝
+	ErrorCode_SERVER_ERROR          ErrorCode = 50000
 	// 用户错误 20xxx
-	ErrorCode_USER_NOT_EXIST  ErrorCode = 20001
-	ErrorCode_USER_EXIST      ErrorCode = 20002
-	ErrorCode_PASSWORD_ERROR  ErrorCode = 20003
-	ErrorCode_REGISTER_FAILED ErrorCode = 20004
+	ErrorCode_USER_NOT_EXIST            ErrorCode = 20001
+	ErrorCode_USER_EXIST                ErrorCode = 20002
+	ErrorCode_PASSWORD_ERROR            ErrorCode = 20003
+	ErrorCode_REGISTER_FAILED           ErrorCode = 20004
+	ErrorCode_REGISTER_LIMITED          ErrorCode = 20005 // 同一IP当日注册次数超限
+	ErrorCode_CONTACT_REQUIRED          ErrorCode = 20006 // 需提供邮箱或手机号
+	ErrorCode_REGISTRATION_NOT_PENDING  ErrorCode = 20007 // 注册记录不在待审核状态
+	ErrorCode_ACCOUNT_LOCKED            ErrorCode = 20008 // 登录失败次数过多，账号暂时锁定
+	ErrorCode_RESET_TOKEN_INVALID       ErrorCode = 20009 // 密码重置Token无效或已过期
+	ErrorCode_EMAIL_TAKEN               ErrorCode = 20010 // 邮箱已被其他账号绑定
+	ErrorCode_VERIFICATION_CODE_INVALID ErrorCode = 20011 // 验证码错误或已过期
+	ErrorCode_REFERRAL_CODE_INVALID     ErrorCode = 20012 // 推荐码不存在
+	ErrorCode_IMAGE_FORMAT_ERR          ErrorCode = 20013 // 图片格式不支持或已损坏
+	ErrorCode_IMAGE_SIZE_ERR            ErrorCode = 20014 // 图片文件过大
+	ErrorCode_ACCOUNT_PENDING_DELETION  ErrorCode = 20015 // 账号处于注销冷静期，可凭密码恢复
+	ErrorCode_LOGIN_CHALLENGE_REQUIRED  ErrorCode = 20016 // 异常登录，需输入发往已绑定邮箱的验证码
+	ErrorCode_OAUTH_LOGIN_FAILED        ErrorCode = 20017 // 第三方授权码无效或兑换失败
+	ErrorCode_USER_BANNED               ErrorCode = 20018 // 账号已被封禁
 	// 视频错误 30xxx
-	ErrorCode_VIDEO_NOT_EXIST   ErrorCode = 30001
-	ErrorCode_VIDEO_UPLOAD_FAIL ErrorCode = 30002
-	ErrorCode_VIDEO_FORMAT_ERR  ErrorCode = 30003
-	ErrorCode_VIDEO_SIZE_ERR    ErrorCode = 30004
+	ErrorCode_VIDEO_NOT_EXIST           ErrorCode = 30001
+	ErrorCode_VIDEO_UPLOAD_FAIL         ErrorCode = 30002
+	ErrorCode_VIDEO_FORMAT_ERR          ErrorCode = 30003
+	ErrorCode_VIDEO_SIZE_ERR            ErrorCode = 30004
+	ErrorCode_VIDEO_NOT_PENDING         ErrorCode = 30005
+	ErrorCode_DRAFT_NOT_EXIST           ErrorCode = 30006 // 草稿不存在
+	ErrorCode_TAKEDOWN_NOT_EXIST        ErrorCode = 30007 // 下架记录不存在
+	ErrorCode_TAKEDOWN_NOT_APPEALABLE   ErrorCode = 30008 // 下架记录当前状态不能申诉或裁决
+	ErrorCode_CATEGORY_NOT_EXIST        ErrorCode = 30009 // 分类不存在或已停用
+	ErrorCode_CATEGORY_EXIST            ErrorCode = 30010 // 分类标识已存在
+	ErrorCode_CATEGORY_IN_USE           ErrorCode = 30011 // 分类下仍有视频，不能删除
+	ErrorCode_PART_CHECKSUM_MISMATCH    ErrorCode = 30012 // 分片校验值不匹配，需要重传该分片
+	ErrorCode_UPLOAD_CHECKSUM_MISMATCH  ErrorCode = 30013 // 合并后的文件校验值不匹配
+	ErrorCode_UPLOAD_QUOTA_EXCEEDED     ErrorCode = 30014 // 当日上传视频数已达上限
+	ErrorCode_STORAGE_QUOTA_EXCEEDED    ErrorCode = 30015 // 视频占用的存储已达上限
+	ErrorCode_PROMOTION_NOT_EXIST       ErrorCode = 30016 // 推广计划不存在或未在投放中
+	ErrorCode_PLAYLIST_NOT_EXIST        ErrorCode = 30017 // 合集不存在
+	ErrorCode_SHARE_LINK_NOT_EXIST      ErrorCode = 30018 // 分享链接不存在
+	ErrorCode_VIDEO_DURATION_ERR        ErrorCode = 30019 // 视频时长超过上限
+	ErrorCode_VIDEO_RESOLUTION_TOO_LOW  ErrorCode = 30020 // 视频分辨率低于下限
+	ErrorCode_VIDEO_RESOLUTION_TOO_HIGH ErrorCode = 30021 // 视频分辨率超过上限
+	ErrorCode_VIDEO_INFECTED            ErrorCode = 30022 // 上传文件未通过病毒扫描
 	// 社交错误 40xxx
-	ErrorCode_ALREADY_FOLLOW    ErrorCode = 40001
-	ErrorCode_NOT_FOLLOW        ErrorCode = 40002
-	ErrorCode_ALREADY_LIKE      ErrorCode = 40003
-	ErrorCode_NOT_LIKE          ErrorCode = 40004
-	ErrorCode_COMMENT_NOT_EXIST ErrorCode = 40005
+	ErrorCode_ALREADY_FOLLOW           ErrorCode = 40001
+	ErrorCode_NOT_FOLLOW               ErrorCode = 40002
+	ErrorCode_ALREADY_LIKE             ErrorCode = 40003
+	ErrorCode_NOT_LIKE                 ErrorCode = 40004
+	ErrorCode_COMMENT_NOT_EXIST        ErrorCode = 40005
+	ErrorCode_NOT_FRIEND               ErrorCode = 40006
+	ErrorCode_FOLLOW_REQUEST_PENDING   ErrorCode = 40007 // 已发送关注申请，等待对方处理
+	ErrorCode_FOLLOW_REQUEST_NOT_EXIST ErrorCode = 40008 // 关注申请不存在或已处理
+	ErrorCode_ACCOUNT_PRIVATE          ErrorCode = 40009 // 私密账号，仅粉丝可见
 )
 
 // Enum value maps for ErrorCode.
@@ -287,42 +334,136 @@ var (
 		10003: "TOKEN_EXPIRED",
 		10004: "PERMISSION_DENIED",
 		10005: "RATE_LIMIT",
+		10006: "ROLE_NOT_FOUND",
+		10007: "PERMISSION_NOT_FOUND",
+		10008: "ROLE_EXIST",
+		10009: "PERMISSION_EXIST",
+		10010: "BUILTIN_ROLE",
+		10011: "DEAD_LETTER_NOT_FOUND",
+		10012: "DEAD_LETTER_REPLAYED",
+		10013: "SIGNATURE_INVALID",
+		10014: "REQUEST_REPLAYED",
+		10015: "RESOURCE_LOCKED",
+		10016: "CONTENT_VIOLATION",
 		50000: "SERVER_ERROR",
 		20001: "USER_NOT_EXIST",
 		20002: "USER_EXIST",
 		20003: "PASSWORD_ERROR",
 		20004: "REGISTER_FAILED",
+		20005: "REGISTER_LIMITED",
+		20006: "CONTACT_REQUIRED",
+		20007: "REGISTRATION_NOT_PENDING",
+		20008: "ACCOUNT_LOCKED",
+		20009: "RESET_TOKEN_INVALID",
+		20010: "EMAIL_TAKEN",
+		20011: "VERIFICATION_CODE_INVALID",
+		20012: "REFERRAL_CODE_INVALID",
+		20013: "IMAGE_FORMAT_ERR",
+		20014: "IMAGE_SIZE_ERR",
+		20015: "ACCOUNT_PENDING_DELETION",
+		20016: "LOGIN_CHALLENGE_REQUIRED",
+		20017: "OAUTH_LOGIN_FAILED",
+		20018: "USER_BANNED",
 		30001: "VIDEO_NOT_EXIST",
 		30002: "VIDEO_UPLOAD_FAIL",
 		30003: "VIDEO_FORMAT_ERR",
 		30004: "VIDEO_SIZE_ERR",
+		30005: "VIDEO_NOT_PENDING",
+		30006: "DRAFT_NOT_EXIST",
+		30007: "TAKEDOWN_NOT_EXIST",
+		30008: "TAKEDOWN_NOT_APPEALABLE",
+		30009: "CATEGORY_NOT_EXIST",
+		30010: "CATEGORY_EXIST",
+		30011: "CATEGORY_IN_USE",
+		30012: "PART_CHECKSUM_MISMATCH",
+		30013: "UPLOAD_CHECKSUM_MISMATCH",
+		30014: "UPLOAD_QUOTA_EXCEEDED",
+		30015: "STORAGE_QUOTA_EXCEEDED",
+		30016: "PROMOTION_NOT_EXIST",
+		30017: "PLAYLIST_NOT_EXIST",
+		30018: "SHARE_LINK_NOT_EXIST",
+		30019: "VIDEO_DURATION_ERR",
+		30020: "VIDEO_RESOLUTION_TOO_LOW",
+		30021: "VIDEO_RESOLUTION_TOO_HIGH",
+		30022: "VIDEO_INFECTED",
 		40001: "ALREADY_FOLLOW",
 		40002: "NOT_FOLLOW",
 		40003: "ALREADY_LIKE",
 		40004: "NOT_LIKE",
 		40005: "COMMENT_NOT_EXIST",
+		40006: "NOT_FRIEND",
+		40007: "FOLLOW_REQUEST_PENDING",
+		40008: "FOLLOW_REQUEST_NOT_EXIST",
+		40009: "ACCOUNT_PRIVATE",
 	}
 	ErrorCode_value = map[string]int32{
-		"SUCCESS":           0,
-		"PARAM_ERROR":       10001,
-		"TOKEN_INVALID":     10002,
-		"TOKEN_EXPIRED":     10003,
-		"PERMISSION_DENIED": 10004,
-		"RATE_LIMIT":        10005,
-		"SERVER_ERROR":      50000,
-		"USER_NOT_EXIST":    20001,
-		"USER_EXIST":        20002,
-		"PASSWORD_ERROR":    20003,
-		"REGISTER_FAILED":   20004,
-		"VIDEO_NOT_EXIST":   30001,
-		"VIDEO_UPLOAD_FAIL": 30002,
-		"VIDEO_FORMAT_ERR":  30003,
-		"VIDEO_SIZE_ERR":    30004,
-		"ALREADY_FOLLOW":    40001,
-		"NOT_FOLLOW":        40002,
-		"ALREADY_LIKE":      40003,
-		"NOT_LIKE":          40004,
-		"COMMENT_NOT_EXIST": 40005,
+		"SUCCESS":                   0,
+		"PARAM_ERROR":               10001,
+		"TOKEN_INVALID":             10002,
+		"TOKEN_EXPIRED":             10003,
+		"PERMISSION_DENIED":         10004,
+		"RATE_LIMIT":                10005,
+		"ROLE_NOT_FOUND":            10006,
+		"PERMISSION_NOT_FOUND":      10007,
+		"ROLE_EXIST":                10008,
+		"PERMISSION_EXIST":          10009,
+		"BUILTIN_ROLE":              10010,
+		"DEAD_LETTER_NOT_FOUND":     10011,
+		"DEAD_LETTER_REPLAYED":      10012,
+		"SIGNATURE_INVALID":         10013,
+		"REQUEST_REPLAYED":          10014,
+		"RESOURCE_LOCKED":           10015,
+		"CONTENT_VIOLATION":         10016,
+		"SERVER_ERROR":              50000,
+		"USER_NOT_EXIST":            20001,
+		"USER_EXIST":                20002,
+		"PASSWORD_ERROR":            20003,
+		"REGISTER_FAILED":           20004,
+		"REGISTER_LIMITED":          20005,
+		"CONTACT_REQUIRED":          20006,
+		"REGISTRATION_NOT_PENDING":  20007,
+		"ACCOUNT_LOCKED":            20008,
+		"RESET_TOKEN_INVALID":       20009,
+		"EMAIL_TAKEN":               20010,
+		"VERIFICATION_CODE_INVALID": 20011,
+		"REFERRAL_CODE_INVALID":     20012,
+		"IMAGE_FORMAT_ERR":          20013,
+		"IMAGE_SIZE_ERR":            20014,
+		"ACCOUNT_PENDING_DELETION":  20015,
+		"LOGIN_CHALLENGE_REQUIRED":  20016,
+		"OAUTH_LOGIN_FAILED":        20017,
+		"USER_BANNED":               20018,
+		"VIDEO_NOT_EXIST":           30001,
+		"VIDEO_UPLOAD_FAIL":         30002,
+		"VIDEO_FORMAT_ERR":          30003,
+		"VIDEO_SIZE_ERR":            30004,
+		"VIDEO_NOT_PENDING":         30005,
+		"DRAFT_NOT_EXIST":           30006,
+		"TAKEDOWN_NOT_EXIST":        30007,
+		"TAKEDOWN_NOT_APPEALABLE":   30008,
+		"CATEGORY_NOT_EXIST":        30009,
+		"CATEGORY_EXIST":            30010,
+		"CATEGORY_IN_USE":           30011,
+		"PART_CHECKSUM_MISMATCH":    30012,
+		"UPLOAD_CHECKSUM_MISMATCH":  30013,
+		"UPLOAD_QUOTA_EXCEEDED":     30014,
+		"STORAGE_QUOTA_EXCEEDED":    30015,
+		"PROMOTION_NOT_EXIST":       30016,
+		"PLAYLIST_NOT_EXIST":        30017,
+		"SHARE_LINK_NOT_EXIST":      30018,
+		"VIDEO_DURATION_ERR":        30019,
+		"VIDEO_RESOLUTION_TOO_LOW":  30020,
+		"VIDEO_RESOLUTION_TOO_HIGH": 30021,
+		"VIDEO_INFECTED":            30022,
+		"ALREADY_FOLLOW":            40001,
+		"NOT_FOLLOW":                40002,
+		"ALREADY_LIKE":              40003,
+		"NOT_LIKE":                  40004,
+		"COMMENT_NOT_EXIST":         40005,
+		"NOT_FRIEND":                40006,
+		"FOLLOW_REQUEST_PENDING":    40007,
+		"FOLLOW_REQUEST_NOT_EXIST":  40008,
+		"ACCOUNT_PRIVATE":           40009,
 	}
 )
 
@@ -526,6 +667,7 @@ type User struct {
 	TotalFavorited  int64                  `protobuf:"varint,9,opt,name=total_favorited,json=totalFavorited,proto3" json:"total_favorited,omitempty"`
 	WorkCount       int64                  `protobuf:"varint,10,opt,name=work_count,json=workCount,proto3" json:"work_count,omitempty"`
 	FavoriteCount   int64                  `protobuf:"varint,11,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	IsPrivate       bool                   `protobuf:"varint,12,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"` // 私密账号，非粉丝看不到作品和计数
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -637,20 +779,37 @@ func (x *User) GetFavoriteCount() int64 {
 	return 0
 }
 
+func (x *User) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
 // 视频信息
 type Video struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Author        *User                  `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	PlayUrl       string                 `protobuf:"bytes,3,opt,name=play_url,json=playUrl,proto3" json:"play_url,omitempty"`
-	CoverUrl      string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
-	FavoriteCount int64                  `protobuf:"varint,5,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
-	CommentCount  int64                  `protobuf:"varint,6,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	IsFavorite    bool                   `protobuf:"varint,7,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
-	Title         string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Author          *User                  `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	PlayUrl         string                 `protobuf:"bytes,3,opt,name=play_url,json=playUrl,proto3" json:"play_url,omitempty"`
+	CoverUrl        string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	FavoriteCount   int64                  `protobuf:"varint,5,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	CommentCount    int64                  `protobuf:"varint,6,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	IsFavorite      bool                   `protobuf:"varint,7,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	Title           string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TitleEntities   []*TextEntity          `protobuf:"bytes,10,rep,name=title_entities,json=titleEntities,proto3" json:"title_entities,omitempty"`
+	PlayUrls        map[string]string      `protobuf:"bytes,11,rep,name=play_urls,json=playUrls,proto3" json:"play_urls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 各清晰度播放地址（480p/720p/1080p），转码完成前为空
+	HlsUrl          string                 `protobuf:"bytes,12,opt,name=hls_url,json=hlsUrl,proto3" json:"hls_url,omitempty"`                                                                                 // HLS自适应码率主播放列表（m3u8），切片完成前为空
+	CategoryId      int64                  `protobuf:"varint,13,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                                                                    // 视频分类，0表示未分类
+	Duration        float64                `protobuf:"fixed64,14,opt,name=duration,proto3" json:"duration,omitempty"`                                                                                         // 时长（秒），探测完成前为0
+	PromotionId     int64                  `protobuf:"varint,15,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`                                                                 // 推广计划ID，仅视频流中的推广视频非0，点击时上报
+	Visibility      int32                  `protobuf:"varint,16,opt,name=visibility,proto3" json:"visibility,omitempty"`                                                                                      // 可见范围：1公开 2仅互相关注的好友 3仅自己
+	PublishAt       int64                  `protobuf:"varint,17,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`                                                                       // 草稿的计划发布时间（Unix 秒），已发布的视频为0
+	ShareCount      int64                  `protobuf:"varint,18,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`                                                                    // 分享次数
+	DynamicCoverUrl string                 `protobuf:"bytes,19,opt,name=dynamic_cover_url,json=dynamicCoverUrl,proto3" json:"dynamic_cover_url,omitempty"`                                                    // 动态封面（视频开头几秒的GIF或动图WebP），生成前为空
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Video) Reset() {
@@ -746,6 +905,302 @@ func (x *Video) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *Video) GetTitleEntities() []*TextEntity {
+	if x != nil {
+		return x.TitleEntities
+	}
+	return nil
+}
+
+func (x *Video) GetPlayUrls() map[string]string {
+	if x != nil {
+		return x.PlayUrls
+	}
+	return nil
+}
+
+func (x *Video) GetHlsUrl() string {
+	if x != nil {
+		return x.HlsUrl
+	}
+	return ""
+}
+
+func (x *Video) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Video) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Video) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *Video) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *Video) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *Video) GetShareCount() int64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+func (x *Video) GetDynamicCoverUrl() string {
+	if x != nil {
+		return x.DynamicCoverUrl
+	}
+	return ""
+}
+
+// 视频分类
+type VideoCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`                                                                             // 分类标识，唯一，如 music、gaming
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                                             // 按请求语言选择的分类名称
+	Names         map[string]string      `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 各语言的分类名称，键为语言标签，如 zh、en、zh-TW
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`                                                 // 排序值，越小越靠前
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                                                                        // 1启用 2停用
+	VideoCount    int64                  `protobuf:"varint,7,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`                                              // 分类下已发布视频数，仅请求分面统计时返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoCategory) Reset() {
+	*x = VideoCategory{}
+	mi := &file_common_v1_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoCategory) ProtoMessage() {}
+
+func (x *VideoCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoCategory.ProtoReflect.Descriptor instead.
+func (*VideoCategory) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VideoCategory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VideoCategory) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *VideoCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VideoCategory) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *VideoCategory) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *VideoCategory) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VideoCategory) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+// 视频下架记录，管理后台和创作者共用
+type VideoTakedown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"` // copyright, illegal, violence, sexual, harassment, spam, other
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                        // 1已下架 2已申诉 3已恢复 4维持下架
+	LegalHold     bool                   `protobuf:"varint,7,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"` // 视频文件处于法律保全中
+	AppealReason  string                 `protobuf:"bytes,8,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	AppealedAt    int64                  `protobuf:"varint,9,opt,name=appealed_at,json=appealedAt,proto3" json:"appealed_at,omitempty"`
+	DecisionNote  string                 `protobuf:"bytes,10,opt,name=decision_note,json=decisionNote,proto3" json:"decision_note,omitempty"`
+	DecidedAt     int64                  `protobuf:"varint,11,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoTakedown) Reset() {
+	*x = VideoTakedown{}
+	mi := &file_common_v1_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoTakedown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoTakedown) ProtoMessage() {}
+
+func (x *VideoTakedown) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoTakedown.ProtoReflect.Descriptor instead.
+func (*VideoTakedown) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VideoTakedown) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VideoTakedown) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *VideoTakedown) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *VideoTakedown) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *VideoTakedown) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VideoTakedown) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VideoTakedown) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *VideoTakedown) GetAppealReason() string {
+	if x != nil {
+		return x.AppealReason
+	}
+	return ""
+}
+
+func (x *VideoTakedown) GetAppealedAt() int64 {
+	if x != nil {
+		return x.AppealedAt
+	}
+	return 0
+}
+
+func (x *VideoTakedown) GetDecisionNote() string {
+	if x != nil {
+		return x.DecisionNote
+	}
+	return ""
+}
+
+func (x *VideoTakedown) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+func (x *VideoTakedown) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
 // 评论信息
 type Comment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -755,13 +1210,19 @@ type Comment struct {
 	CreateDate    string                 `protobuf:"bytes,4,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
 	LikeCount     int64                  `protobuf:"varint,5,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
 	ReplyCount    int64                  `protobuf:"varint,6,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	Entities      []*TextEntity          `protobuf:"bytes,7,rep,name=entities,proto3" json:"entities,omitempty"`
+	ParentId      int64                  `protobuf:"varint,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 所属一级评论ID，0表示一级评论
+	VideoId       int64                  `protobuf:"varint,9,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Hearted       bool                   `protobuf:"varint,10,opt,name=hearted,proto3" json:"hearted,omitempty"`                                  // 视频作者是否点了小红心
+	Collapsed     bool                   `protobuf:"varint,11,opt,name=collapsed,proto3" json:"collapsed,omitempty"`                              // 按折叠规则默认折叠，客户端展示为"已折叠"并可展开
+	PendingReview bool                   `protobuf:"varint,12,opt,name=pending_review,json=pendingReview,proto3" json:"pending_review,omitempty"` // 内容疑似违规，等待人工复核，仅评论作者可见
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_common_v1_common_proto_msgTypes[5]
+	mi := &file_common_v1_common_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1234,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[5]
+	mi := &file_common_v1_common_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1247,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{5}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Comment) GetId() int64 {
@@ -831,6 +1292,117 @@ func (x *Comment) GetReplyCount() int64 {
 	return 0
 }
 
+func (x *Comment) GetEntities() []*TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Comment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *Comment) GetHearted() bool {
+	if x != nil {
+		return x.Hearted
+	}
+	return false
+}
+
+func (x *Comment) GetCollapsed() bool {
+	if x != nil {
+		return x.Collapsed
+	}
+	return false
+}
+
+func (x *Comment) GetPendingReview() bool {
+	if x != nil {
+		return x.PendingReview
+	}
+	return false
+}
+
+// 富文本实体，offset和length以Unicode字符计
+type TextEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // mention, hashtag, link
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"` // 用户名、话题名或规范化后的链接
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextEntity) Reset() {
+	*x = TextEntity{}
+	mi := &file_common_v1_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEntity) ProtoMessage() {}
+
+func (x *TextEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEntity.ProtoReflect.Descriptor instead.
+func (*TextEntity) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TextEntity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TextEntity) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TextEntity) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *TextEntity) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 // 消息信息
 type Message struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -845,7 +1417,7 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_common_v1_common_proto_msgTypes[6]
+	mi := &file_common_v1_common_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -857,7 +1429,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[6]
+	mi := &file_common_v1_common_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1442,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{6}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Message) GetId() int64 {
@@ -920,7 +1492,7 @@ type TokenInfo struct {
 
 func (x *TokenInfo) Reset() {
 	*x = TokenInfo{}
-	mi := &file_common_v1_common_proto_msgTypes[7]
+	mi := &file_common_v1_common_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -932,7 +1504,7 @@ func (x *TokenInfo) String() string {
 func (*TokenInfo) ProtoMessage() {}
 
 func (x *TokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[7]
+	mi := &file_common_v1_common_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,7 +1517,7 @@ func (x *TokenInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
 func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{7}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TokenInfo) GetUserId() int64 {
@@ -982,7 +1554,7 @@ type FileInfo struct {
 
 func (x *FileInfo) Reset() {
 	*x = FileInfo{}
-	mi := &file_common_v1_common_proto_msgTypes[8]
+	mi := &file_common_v1_common_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -994,7 +1566,7 @@ func (x *FileInfo) String() string {
 func (*FileInfo) ProtoMessage() {}
 
 func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[8]
+	mi := &file_common_v1_common_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +1579,7 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{8}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FileInfo) GetFilename() string {
@@ -1053,7 +1625,7 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\"?\n" +
 	"\fPageResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xe1\x02\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\x80\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
@@ -1067,7 +1639,9 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"work_count\x18\n" +
 	" \x01(\x03R\tworkCount\x12%\n" +
-	"\x0efavorite_count\x18\v \x01(\x03R\rfavoriteCount\"\x9a\x02\n" +
+	"\x0efavorite_count\x18\v \x01(\x03R\rfavoriteCount\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\f \x01(\bR\tisPrivate\"\xd7\x05\n" +
 	"\x05Video\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x06author\x18\x02 \x01(\v2\x0f.common.v1.UserR\x06author\x12\x19\n" +
@@ -1079,7 +1653,58 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"isFavorite\x12\x14\n" +
 	"\x05title\x18\b \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\t \x01(\x03R\tcreatedAt\"\xb9\x01\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12<\n" +
+	"\x0etitle_entities\x18\n" +
+	" \x03(\v2\x15.common.v1.TextEntityR\rtitleEntities\x12;\n" +
+	"\tplay_urls\x18\v \x03(\v2\x1e.common.v1.Video.PlayUrlsEntryR\bplayUrls\x12\x17\n" +
+	"\ahls_url\x18\f \x01(\tR\x06hlsUrl\x12\x1f\n" +
+	"\vcategory_id\x18\r \x01(\x03R\n" +
+	"categoryId\x12\x1a\n" +
+	"\bduration\x18\x0e \x01(\x01R\bduration\x12!\n" +
+	"\fpromotion_id\x18\x0f \x01(\x03R\vpromotionId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x10 \x01(\x05R\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x11 \x01(\x03R\tpublishAt\x12\x1f\n" +
+	"\vshare_count\x18\x12 \x01(\x03R\n" +
+	"shareCount\x12*\n" +
+	"\x11dynamic_cover_url\x18\x13 \x01(\tR\x0fdynamicCoverUrl\x1a;\n" +
+	"\rPlayUrlsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x02\n" +
+	"\rVideoCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\x05names\x18\x04 \x03(\v2#.common.v1.VideoCategory.NamesEntryR\x05names\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\tsortOrder\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vvideo_count\x18\a \x01(\x03R\n" +
+	"videoCount\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xeb\x02\n" +
+	"\rVideoTakedown\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x03R\bauthorId\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\a \x01(\bR\tlegalHold\x12#\n" +
+	"\rappeal_reason\x18\b \x01(\tR\fappealReason\x12\x1f\n" +
+	"\vappealed_at\x18\t \x01(\x03R\n" +
+	"appealedAt\x12#\n" +
+	"\rdecision_note\x18\n" +
+	" \x01(\tR\fdecisionNote\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\v \x01(\x03R\tdecidedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\"\x83\x03\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\x04user\x18\x02 \x01(\v2\x0f.common.v1.UserR\x04user\x12\x18\n" +
@@ -1089,7 +1714,20 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"like_count\x18\x05 \x01(\x03R\tlikeCount\x12\x1f\n" +
 	"\vreply_count\x18\x06 \x01(\x03R\n" +
-	"replyCount\"\x94\x01\n" +
+	"replyCount\x121\n" +
+	"\bentities\x18\a \x03(\v2\x15.common.v1.TextEntityR\bentities\x12\x1b\n" +
+	"\tparent_id\x18\b \x01(\x03R\bparentId\x12\x19\n" +
+	"\bvideo_id\x18\t \x01(\x03R\avideoId\x12\x18\n" +
+	"\ahearted\x18\n" +
+	" \x01(\bR\ahearted\x12\x1c\n" +
+	"\tcollapsed\x18\v \x01(\bR\tcollapsed\x12%\n" +
+	"\x0epending_review\x18\f \x01(\bR\rpendingReview\"f\n" +
+	"\n" +
+	"TextEntity\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"\x94\x01\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
 	"\n" +
@@ -1134,7 +1772,7 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\x0fMESSAGE_UNKNOWN\x10\x00\x12\x10\n" +
 	"\fMESSAGE_TEXT\x10\x01\x12\x11\n" +
 	"\rMESSAGE_IMAGE\x10\x02\x12\x11\n" +
-	"\rMESSAGE_VIDEO\x10\x03*\xa7\x03\n" +
+	"\rMESSAGE_VIDEO\x10\x03*\xe5\f\n" +
 	"\tErrorCode\x12\v\n" +
 	"\aSUCCESS\x10\x00\x12\x10\n" +
 	"\vPARAM_ERROR\x10\x91N\x12\x12\n" +
@@ -1142,23 +1780,72 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\rTOKEN_EXPIRED\x10\x93N\x12\x16\n" +
 	"\x11PERMISSION_DENIED\x10\x94N\x12\x0f\n" +
 	"\n" +
-	"RATE_LIMIT\x10\x95N\x12\x12\n" +
+	"RATE_LIMIT\x10\x95N\x12\x13\n" +
+	"\x0eROLE_NOT_FOUND\x10\x96N\x12\x19\n" +
+	"\x14PERMISSION_NOT_FOUND\x10\x97N\x12\x0f\n" +
+	"\n" +
+	"ROLE_EXIST\x10\x98N\x12\x15\n" +
+	"\x10PERMISSION_EXIST\x10\x99N\x12\x11\n" +
+	"\fBUILTIN_ROLE\x10\x9aN\x12\x1a\n" +
+	"\x15DEAD_LETTER_NOT_FOUND\x10\x9bN\x12\x19\n" +
+	"\x14DEAD_LETTER_REPLAYED\x10\x9cN\x12\x16\n" +
+	"\x11SIGNATURE_INVALID\x10\x9dN\x12\x15\n" +
+	"\x10REQUEST_REPLAYED\x10\x9eN\x12\x14\n" +
+	"\x0fRESOURCE_LOCKED\x10\x9fN\x12\x16\n" +
+	"\x11CONTENT_VIOLATION\x10\xa0N\x12\x12\n" +
 	"\fSERVER_ERROR\x10І\x03\x12\x14\n" +
 	"\x0eUSER_NOT_EXIST\x10\xa1\x9c\x01\x12\x10\n" +
 	"\n" +
 	"USER_EXIST\x10\xa2\x9c\x01\x12\x14\n" +
 	"\x0ePASSWORD_ERROR\x10\xa3\x9c\x01\x12\x15\n" +
-	"\x0fREGISTER_FAILED\x10\xa4\x9c\x01\x12\x15\n" +
+	"\x0fREGISTER_FAILED\x10\xa4\x9c\x01\x12\x16\n" +
+	"\x10REGISTER_LIMITED\x10\xa5\x9c\x01\x12\x16\n" +
+	"\x10CONTACT_REQUIRED\x10\xa6\x9c\x01\x12\x1e\n" +
+	"\x18REGISTRATION_NOT_PENDING\x10\xa7\x9c\x01\x12\x14\n" +
+	"\x0eACCOUNT_LOCKED\x10\xa8\x9c\x01\x12\x19\n" +
+	"\x13RESET_TOKEN_INVALID\x10\xa9\x9c\x01\x12\x11\n" +
+	"\vEMAIL_TAKEN\x10\xaa\x9c\x01\x12\x1f\n" +
+	"\x19VERIFICATION_CODE_INVALID\x10\xab\x9c\x01\x12\x1b\n" +
+	"\x15REFERRAL_CODE_INVALID\x10\xac\x9c\x01\x12\x16\n" +
+	"\x10IMAGE_FORMAT_ERR\x10\xad\x9c\x01\x12\x14\n" +
+	"\x0eIMAGE_SIZE_ERR\x10\xae\x9c\x01\x12\x1e\n" +
+	"\x18ACCOUNT_PENDING_DELETION\x10\xaf\x9c\x01\x12\x1e\n" +
+	"\x18LOGIN_CHALLENGE_REQUIRED\x10\xb0\x9c\x01\x12\x18\n" +
+	"\x12OAUTH_LOGIN_FAILED\x10\xb1\x9c\x01\x12\x11\n" +
+	"\vUSER_BANNED\x10\xb2\x9c\x01\x12\x15\n" +
 	"\x0fVIDEO_NOT_EXIST\x10\xb1\xea\x01\x12\x17\n" +
 	"\x11VIDEO_UPLOAD_FAIL\x10\xb2\xea\x01\x12\x16\n" +
 	"\x10VIDEO_FORMAT_ERR\x10\xb3\xea\x01\x12\x14\n" +
-	"\x0eVIDEO_SIZE_ERR\x10\xb4\xea\x01\x12\x14\n" +
+	"\x0eVIDEO_SIZE_ERR\x10\xb4\xea\x01\x12\x17\n" +
+	"\x11VIDEO_NOT_PENDING\x10\xb5\xea\x01\x12\x15\n" +
+	"\x0fDRAFT_NOT_EXIST\x10\xb6\xea\x01\x12\x18\n" +
+	"\x12TAKEDOWN_NOT_EXIST\x10\xb7\xea\x01\x12\x1d\n" +
+	"\x17TAKEDOWN_NOT_APPEALABLE\x10\xb8\xea\x01\x12\x18\n" +
+	"\x12CATEGORY_NOT_EXIST\x10\xb9\xea\x01\x12\x14\n" +
+	"\x0eCATEGORY_EXIST\x10\xba\xea\x01\x12\x15\n" +
+	"\x0fCATEGORY_IN_USE\x10\xbb\xea\x01\x12\x1c\n" +
+	"\x16PART_CHECKSUM_MISMATCH\x10\xbc\xea\x01\x12\x1e\n" +
+	"\x18UPLOAD_CHECKSUM_MISMATCH\x10\xbd\xea\x01\x12\x1b\n" +
+	"\x15UPLOAD_QUOTA_EXCEEDED\x10\xbe\xea\x01\x12\x1c\n" +
+	"\x16STORAGE_QUOTA_EXCEEDED\x10\xbf\xea\x01\x12\x19\n" +
+	"\x13PROMOTION_NOT_EXIST\x10\xc0\xea\x01\x12\x18\n" +
+	"\x12PLAYLIST_NOT_EXIST\x10\xc1\xea\x01\x12\x1a\n" +
+	"\x14SHARE_LINK_NOT_EXIST\x10\xc2\xea\x01\x12\x18\n" +
+	"\x12VIDEO_DURATION_ERR\x10\xc3\xea\x01\x12\x1e\n" +
+	"\x18VIDEO_RESOLUTION_TOO_LOW\x10\xc4\xea\x01\x12\x1f\n" +
+	"\x19VIDEO_RESOLUTION_TOO_HIGH\x10\xc5\xea\x01\x12\x14\n" +
+	"\x0eVIDEO_INFECTED\x10\xc6\xea\x01\x12\x14\n" +
 	"\x0eALREADY_FOLLOW\x10\xc1\xb8\x02\x12\x10\n" +
 	"\n" +
 	"NOT_FOLLOW\x10¸\x02\x12\x12\n" +
 	"\fALREADY_LIKE\x10ø\x02\x12\x0e\n" +
 	"\bNOT_LIKE\x10ĸ\x02\x12\x17\n" +
-	"\x11COMMENT_NOT_EXIST\x10Ÿ\x02B\x1dZ\x1bgo-backend/api/common/v1;v1b\x06proto3"
+	"\x11COMMENT_NOT_EXIST\x10Ÿ\x02\x12\x10\n" +
+	"\n" +
+	"NOT_FRIEND\x10Ƹ\x02\x12\x1c\n" +
+	"\x16FOLLOW_REQUEST_PENDING\x10Ǹ\x02\x12\x1e\n" +
+	"\x18FOLLOW_REQUEST_NOT_EXIST\x10ȸ\x02\x12\x15\n" +
+	"\x0fACCOUNT_PRIVATE\x10ɸ\x02B\x1dZ\x1bgo-backend/api/common/v1;v1b\x06proto3"
 
 var (
 	file_common_v1_common_proto_rawDescOnce sync.Once
@@ -1173,31 +1860,40 @@ func file_common_v1_common_proto_rawDescGZIP() []byte {
 }
 
 var file_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_common_v1_common_proto_goTypes = []any{
-	(ActionType)(0),      // 0: common.v1.ActionType
-	(Status)(0),          // 1: common.v1.Status
-	(VideoStatus)(0),     // 2: common.v1.VideoStatus
-	(MessageType)(0),     // 3: common.v1.MessageType
-	(ErrorCode)(0),       // 4: common.v1.ErrorCode
-	(*BaseResponse)(nil), // 5: common.v1.BaseResponse
-	(*PageRequest)(nil),  // 6: common.v1.PageRequest
-	(*PageResponse)(nil), // 7: common.v1.PageResponse
-	(*User)(nil),         // 8: common.v1.User
-	(*Video)(nil),        // 9: common.v1.Video
-	(*Comment)(nil),      // 10: common.v1.Comment
-	(*Message)(nil),      // 11: common.v1.Message
-	(*TokenInfo)(nil),    // 12: common.v1.TokenInfo
-	(*FileInfo)(nil),     // 13: common.v1.FileInfo
+	(ActionType)(0),       // 0: common.v1.ActionType
+	(Status)(0),           // 1: common.v1.Status
+	(VideoStatus)(0),      // 2: common.v1.VideoStatus
+	(MessageType)(0),      // 3: common.v1.MessageType
+	(ErrorCode)(0),        // 4: common.v1.ErrorCode
+	(*BaseResponse)(nil),  // 5: common.v1.BaseResponse
+	(*PageRequest)(nil),   // 6: common.v1.PageRequest
+	(*PageResponse)(nil),  // 7: common.v1.PageResponse
+	(*User)(nil),          // 8: common.v1.User
+	(*Video)(nil),         // 9: common.v1.Video
+	(*VideoCategory)(nil), // 10: common.v1.VideoCategory
+	(*VideoTakedown)(nil), // 11: common.v1.VideoTakedown
+	(*Comment)(nil),       // 12: common.v1.Comment
+	(*TextEntity)(nil),    // 13: common.v1.TextEntity
+	(*Message)(nil),       // 14: common.v1.Message
+	(*TokenInfo)(nil),     // 15: common.v1.TokenInfo
+	(*FileInfo)(nil),      // 16: common.v1.FileInfo
+	nil,                   // 17: common.v1.Video.PlayUrlsEntry
+	nil,                   // 18: common.v1.VideoCategory.NamesEntry
 }
 var file_common_v1_common_proto_depIdxs = []int32{
-	8, // 0: common.v1.Video.author:type_name -> common.v1.User
-	8, // 1: common.v1.Comment.user:type_name -> common.v1.User
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: common.v1.Video.author:type_name -> common.v1.User
+	13, // 1: common.v1.Video.title_entities:type_name -> common.v1.TextEntity
+	17, // 2: common.v1.Video.play_urls:type_name -> common.v1.Video.PlayUrlsEntry
+	18, // 3: common.v1.VideoCategory.names:type_name -> common.v1.VideoCategory.NamesEntry
+	8,  // 4: common.v1.Comment.user:type_name -> common.v1.User
+	13, // 5: common.v1.Comment.entities:type_name -> common.v1.TextEntity
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_common_proto_init() }
@@ -1211,7 +1907,7 @@ func file_common_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_common_proto_rawDesc), len(file_common_v1_common_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   9,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
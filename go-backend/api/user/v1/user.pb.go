@@ -86,8 +86,11 @@ func (UpdateStatsType) EnumDescriptor() ([]byte, []int) {
 // 用户注册请求
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 用户名
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 密码
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                             // 用户名
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                             // 密码
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                   // 邮箱，同一IP注册较多时与手机号二选一必填
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`                                   // 手机号，同一IP注册较多时与邮箱二选一必填
+	ReferralCode  string                 `protobuf:"bytes,5,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"` // 推荐码，可选
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -136,6 +139,27 @@ func (x *RegisterRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
 // 用户注册响应
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -243,11 +267,12 @@ func (x *RegisterData) GetToken() string {
 
 // 用户登录请求
 type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 用户名
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 密码
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Username         string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                                         // 用户名
+	Password         string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                                         // 密码
+	VerificationCode string                 `protobuf:"bytes,3,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"` // 异常登录时发往已绑定邮箱的验证码，返回 LOGIN_CHALLENGE_REQUIRED 后携带重试
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *LoginRequest) Reset() {
@@ -294,13 +319,21 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
 // 用户登录响应
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *LoginData             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Base                *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data                *LoginData             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	DeletionScheduledAt int64                  `protobuf:"varint,3,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"` // 账号处于注销冷静期时返回清除时间，客户端据此提示恢复账号
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -347,6 +380,13 @@ func (x *LoginResponse) GetData() *LoginData {
 	return nil
 }
 
+func (x *LoginResponse) GetDeletionScheduledAt() int64 {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return 0
+}
+
 type LoginData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
@@ -399,29 +439,31 @@ func (x *LoginData) GetToken() string {
 	return ""
 }
 
-// 获取用户信息请求
-type GetUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 第三方登录请求
+type LoginWithOAuthRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Provider         string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                         // 已配置的提供方名称，如 google、github、wechat
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                                 // 第三方返回的授权码
+	RedirectUri      string                 `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`                // 获取授权码时使用的回调地址，为空时使用服务端配置
+	VerificationCode string                 `protobuf:"bytes,4,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"` // 异常登录时发往已绑定邮箱的验证码
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+func (x *LoginWithOAuthRequest) Reset() {
+	*x = LoginWithOAuthRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *LoginWithOAuthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*LoginWithOAuthRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+func (x *LoginWithOAuthRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -433,48 +475,63 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginWithOAuthRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithOAuthRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetUserRequest) GetUserId() int64 {
+func (x *LoginWithOAuthRequest) GetProvider() string {
 	if x != nil {
-		return x.UserId
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserRequest) GetToken() string {
+func (x *LoginWithOAuthRequest) GetCode() string {
 	if x != nil {
-		return x.Token
+		return x.Code
 	}
 	return ""
 }
 
-// 获取用户信息响应
-type GetUserResponse struct {
+func (x *LoginWithOAuthRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *LoginWithOAuthRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+// 第三方登录响应
+type LoginWithOAuthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *GetUserData           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *LoginData             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Created       bool                   `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"` // 本次登录是否新建了用户
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
+func (x *LoginWithOAuthResponse) Reset() {
+	*x = LoginWithOAuthResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *LoginWithOAuthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*LoginWithOAuthResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+func (x *LoginWithOAuthResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,46 +543,55 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginWithOAuthResponse.ProtoReflect.Descriptor instead.
+func (*LoginWithOAuthResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetUserResponse) GetBase() *v1.BaseResponse {
+func (x *LoginWithOAuthResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *GetUserResponse) GetData() *GetUserData {
+func (x *LoginWithOAuthResponse) GetData() *LoginData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type GetUserData struct {
+func (x *LoginWithOAuthResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// 发送登录验证码请求
+type SendVerificationCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"` // 用户信息
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"` // email 或 sms
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`   // 邮箱或手机号
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserData) Reset() {
-	*x = GetUserData{}
+func (x *SendVerificationCodeRequest) Reset() {
+	*x = SendVerificationCodeRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserData) String() string {
+func (x *SendVerificationCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserData) ProtoMessage() {}
+func (*SendVerificationCodeRequest) ProtoMessage() {}
 
-func (x *GetUserData) ProtoReflect() protoreflect.Message {
+func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -537,42 +603,47 @@ func (x *GetUserData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserData.ProtoReflect.Descriptor instead.
-func (*GetUserData) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendVerificationCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetUserData) GetUser() *v1.User {
+func (x *SendVerificationCodeRequest) GetChannel() string {
 	if x != nil {
-		return x.User
+		return x.Channel
 	}
-	return nil
+	return ""
 }
 
-// 关注操作请求
-type RelationActionRequest struct {
+func (x *SendVerificationCodeRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// 发送登录验证码响应
+type SendVerificationCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                              // Token
-	ToUserId      int64                  `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`     // 对方用户ID
-	ActionType    int32                  `protobuf:"varint,3,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // 1关注，2取消关注
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationActionRequest) Reset() {
-	*x = RelationActionRequest{}
+func (x *SendVerificationCodeResponse) Reset() {
+	*x = SendVerificationCodeResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationActionRequest) String() string {
+func (x *SendVerificationCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationActionRequest) ProtoMessage() {}
+func (*SendVerificationCodeResponse) ProtoMessage() {}
 
-func (x *RelationActionRequest) ProtoReflect() protoreflect.Message {
+func (x *SendVerificationCodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -584,55 +655,111 @@ func (x *RelationActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationActionRequest.ProtoReflect.Descriptor instead.
-func (*RelationActionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendVerificationCodeResponse.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RelationActionRequest) GetToken() string {
+func (x *SendVerificationCodeResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
-		return x.Token
+		return x.Base
+	}
+	return nil
+}
+
+// 验证码登录请求
+type LoginWithCodeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Channel          string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`                                           // email 或 sms
+	Target           string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`                                             // 邮箱或手机号
+	Code             string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                                                 // 登录验证码
+	VerificationCode string                 `protobuf:"bytes,4,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"` // 异常登录时发往已绑定邮箱的验证码
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LoginWithCodeRequest) Reset() {
+	*x = LoginWithCodeRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithCodeRequest) ProtoMessage() {}
+
+func (x *LoginWithCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithCodeRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithCodeRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LoginWithCodeRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *RelationActionRequest) GetToUserId() int64 {
+func (x *LoginWithCodeRequest) GetTarget() string {
 	if x != nil {
-		return x.ToUserId
+		return x.Target
 	}
-	return 0
+	return ""
 }
 
-func (x *RelationActionRequest) GetActionType() int32 {
+func (x *LoginWithCodeRequest) GetCode() string {
 	if x != nil {
-		return x.ActionType
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-// 关注操作响应
-type RelationActionResponse struct {
+func (x *LoginWithCodeRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+// 验证码登录响应
+type LoginWithCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *LoginData             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationActionResponse) Reset() {
-	*x = RelationActionResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[10]
+func (x *LoginWithCodeResponse) Reset() {
+	*x = LoginWithCodeResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationActionResponse) String() string {
+func (x *LoginWithCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationActionResponse) ProtoMessage() {}
+func (*LoginWithCodeResponse) ProtoMessage() {}
 
-func (x *RelationActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[10]
+func (x *LoginWithCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,42 +770,49 @@ func (x *RelationActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationActionResponse.ProtoReflect.Descriptor instead.
-func (*RelationActionResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use LoginWithCodeResponse.ProtoReflect.Descriptor instead.
+func (*LoginWithCodeResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RelationActionResponse) GetBase() *v1.BaseResponse {
+func (x *LoginWithCodeResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-// 获取关注列表请求
-type GetFollowListRequest struct {
+func (x *LoginWithCodeResponse) GetData() *LoginData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 用户登出请求
+type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                   // Token
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // Refresh Token，可选，一并撤销
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowListRequest) Reset() {
-	*x = GetFollowListRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowListRequest) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowListRequest) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *GetFollowListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[11]
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,49 +823,48 @@ func (x *GetFollowListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowListRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowListRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetFollowListRequest) GetUserId() int64 {
+func (x *LogoutRequest) GetToken() string {
 	if x != nil {
-		return x.UserId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFollowListRequest) GetToken() string {
+func (x *LogoutRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Token
+		return x.RefreshToken
 	}
 	return ""
 }
 
-// 获取关注列表响应
-type GetFollowListResponse struct {
+// 用户登出响应
+type LogoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *GetFollowListData     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowListResponse) Reset() {
-	*x = GetFollowListResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[12]
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowListResponse) String() string {
+func (x *LogoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowListResponse) ProtoMessage() {}
+func (*LogoutResponse) ProtoMessage() {}
 
-func (x *GetFollowListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[12]
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,47 +875,42 @@ func (x *GetFollowListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowListResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowListResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetFollowListResponse) GetBase() *v1.BaseResponse {
+func (x *LogoutResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *GetFollowListResponse) GetData() *GetFollowListData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type GetFollowListData struct {
+// 注销账号请求
+type DeleteAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserList      []*v1.User             `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"` // 关注用户列表
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // Token
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 当前密码，二次确认
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowListData) Reset() {
-	*x = GetFollowListData{}
-	mi := &file_user_v1_user_proto_msgTypes[13]
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowListData) String() string {
+func (x *DeleteAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowListData) ProtoMessage() {}
+func (*DeleteAccountRequest) ProtoMessage() {}
 
-func (x *GetFollowListData) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[13]
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,42 +921,3101 @@ func (x *GetFollowListData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowListData.ProtoReflect.Descriptor instead.
-func (*GetFollowListData) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetFollowListData) GetUserList() []*v1.User {
+func (x *DeleteAccountRequest) GetToken() string {
 	if x != nil {
-		return x.UserList
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-// 获取粉丝列表请求
-type GetFollowerListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// 注销账号响应
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PurgeAt       int64                  `protobuf:"varint,2,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // 冷静期结束时间，之后账号被匿名化，个人数据被清除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteAccountResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DeleteAccountResponse) GetPurgeAt() int64 {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return 0
+}
+
+// 恢复账号请求
+type RestoreAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 用户名
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountRequest) Reset() {
+	*x = RestoreAccountRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountRequest) ProtoMessage() {}
+
+func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RestoreAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RestoreAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// 导出个人数据请求
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportMyDataRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 导出个人数据响应
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"` // ZIP 下载链接，包含个人资料、视频、关注、粉丝和评论
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // 链接过期时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportMyDataResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ExportMyDataResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// 获取用户信息请求
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowerListRequest) Reset() {
-	*x = GetFollowerListRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[14]
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取用户信息响应
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *GetUserData           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetData() *GetUserData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetUserData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"` // 用户信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserData) Reset() {
+	*x = GetUserData{}
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserData) ProtoMessage() {}
+
+func (x *GetUserData) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserData.ProtoReflect.Descriptor instead.
+func (*GetUserData) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUserData) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 更新时区请求
+type UpdateTimezoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // Token
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA时区名，如Asia/Shanghai
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimezoneRequest) Reset() {
+	*x = UpdateTimezoneRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimezoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimezoneRequest) ProtoMessage() {}
+
+func (x *UpdateTimezoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimezoneRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimezoneRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateTimezoneRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateTimezoneRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 更新时区响应
+type UpdateTimezoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // 规范化后的时区名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimezoneResponse) Reset() {
+	*x = UpdateTimezoneResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimezoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimezoneResponse) ProtoMessage() {}
+
+func (x *UpdateTimezoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimezoneResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTimezoneResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateTimezoneResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateTimezoneResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 设置私密账号请求
+type UpdatePrivacyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // Token
+	IsPrivate     bool                   `protobuf:"varint,2,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"` // true为私密账号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacyRequest) Reset() {
+	*x = UpdatePrivacyRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacyRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdatePrivacyRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdatePrivacyRequest) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+// 设置私密账号响应
+type UpdatePrivacyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	IsPrivate     bool                   `protobuf:"varint,2,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"` // 设置后的状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacyResponse) Reset() {
+	*x = UpdatePrivacyResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacyResponse) ProtoMessage() {}
+
+func (x *UpdatePrivacyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacyResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacyResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdatePrivacyResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdatePrivacyResponse) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+// 获取个人主页请求
+type GetProfilePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token，可选，用于返回关注和点赞状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfilePageRequest) Reset() {
+	*x = GetProfilePageRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfilePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfilePageRequest) ProtoMessage() {}
+
+func (x *GetProfilePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfilePageRequest.ProtoReflect.Descriptor instead.
+func (*GetProfilePageRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetProfilePageRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetProfilePageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取个人主页响应
+type GetProfilePageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *v1.User               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	PinnedVideos  []*v1.Video            `protobuf:"bytes,3,rep,name=pinned_videos,json=pinnedVideos,proto3" json:"pinned_videos,omitempty"` // 置顶作品，暂按获赞数选取
+	RecentVideos  []*v1.Video            `protobuf:"bytes,4,rep,name=recent_videos,json=recentVideos,proto3" json:"recent_videos,omitempty"` // 最近作品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfilePageResponse) Reset() {
+	*x = GetProfilePageResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfilePageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfilePageResponse) ProtoMessage() {}
+
+func (x *GetProfilePageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfilePageResponse.ProtoReflect.Descriptor instead.
+func (*GetProfilePageResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetProfilePageResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetProfilePageResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetProfilePageResponse) GetPinnedVideos() []*v1.Video {
+	if x != nil {
+		return x.PinnedVideos
+	}
+	return nil
+}
+
+func (x *GetProfilePageResponse) GetRecentVideos() []*v1.Video {
+	if x != nil {
+		return x.RecentVideos
+	}
+	return nil
+}
+
+// 更新个人资料请求，空字符串表示不修改
+type UpdateProfileRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                            // Token
+	Nickname        string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`                                      // 昵称，最多32个字符
+	Avatar          string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`                                          // 头像地址，http(s)
+	BackgroundImage string                 `protobuf:"bytes,4,opt,name=background_image,json=backgroundImage,proto3" json:"background_image,omitempty"` // 背景图地址，http(s)
+	Signature       string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`                                    // 个性签名，最多200个字符
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateProfileRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBackgroundImage() string {
+	if x != nil {
+		return x.BackgroundImage
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// 更新个人资料响应
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *v1.User               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // 更新后的用户信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateProfileResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateProfileResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 修改密码请求
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                // Token
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"` // 原密码
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // 新密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChangePasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// 修改密码响应
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChangePasswordResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 上传头像或背景图请求
+type UploadProfileImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // Token
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`         // 图片数据，支持 JPEG/PNG/GIF/WebP
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"` // 原始文件名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProfileImageRequest) Reset() {
+	*x = UploadProfileImageRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProfileImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProfileImageRequest) ProtoMessage() {}
+
+func (x *UploadProfileImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProfileImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadProfileImageRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UploadProfileImageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UploadProfileImageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadProfileImageRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// 上传头像或背景图响应
+type UploadProfileImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *v1.User               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // 更新后的用户信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProfileImageResponse) Reset() {
+	*x = UploadProfileImageResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProfileImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProfileImageResponse) ProtoMessage() {}
+
+func (x *UploadProfileImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProfileImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadProfileImageResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UploadProfileImageResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UploadProfileImageResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 申请重置密码请求
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 用户名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RequestPasswordResetRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// 申请重置密码响应
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestPasswordResetResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 重置密码请求
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                          // 用户名
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                                // 重置Token
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // 新密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResetPasswordRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// 重置密码响应
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResetPasswordResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 绑定邮箱请求
+type BindEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Token
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // 待绑定的邮箱
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindEmailRequest) Reset() {
+	*x = BindEmailRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindEmailRequest) ProtoMessage() {}
+
+func (x *BindEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindEmailRequest.ProtoReflect.Descriptor instead.
+func (*BindEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BindEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BindEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// 绑定邮箱响应
+type BindEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindEmailResponse) Reset() {
+	*x = BindEmailResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindEmailResponse) ProtoMessage() {}
+
+func (x *BindEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindEmailResponse.ProtoReflect.Descriptor instead.
+func (*BindEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BindEmailResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 用户分享卡片请求
+type GetUserShareCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserShareCardRequest) Reset() {
+	*x = GetUserShareCardRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserShareCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserShareCardRequest) ProtoMessage() {}
+
+func (x *GetUserShareCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserShareCardRequest.ProtoReflect.Descriptor instead.
+func (*GetUserShareCardRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetUserShareCardRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 用户分享卡片响应
+type GetUserShareCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CardUrl       string                 `protobuf:"bytes,2,opt,name=card_url,json=cardUrl,proto3" json:"card_url,omitempty"` // 卡片图片地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserShareCardResponse) Reset() {
+	*x = GetUserShareCardResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserShareCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserShareCardResponse) ProtoMessage() {}
+
+func (x *GetUserShareCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserShareCardResponse.ProtoReflect.Descriptor instead.
+func (*GetUserShareCardResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserShareCardResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetUserShareCardResponse) GetCardUrl() string {
+	if x != nil {
+		return x.CardUrl
+	}
+	return ""
+}
+
+// 获取配额请求
+type GetMyQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyQuotaRequest) Reset() {
+	*x = GetMyQuotaRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyQuotaRequest) ProtoMessage() {}
+
+func (x *GetMyQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetMyQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetMyQuotaRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取配额响应
+type GetMyQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *QuotaData             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyQuotaResponse) Reset() {
+	*x = GetMyQuotaResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyQuotaResponse) ProtoMessage() {}
+
+func (x *GetMyQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetMyQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetMyQuotaResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetMyQuotaResponse) GetData() *QuotaData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 限流桶当前状态
+type RateLimitBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`            // 限流范围，global为全局共享，user为按用户
+	Rate          float64                `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`          // 每秒补充的请求数
+	Burst         int32                  `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`         // 桶容量
+	Remaining     int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"` // 当前可立即发起的请求数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitBucket) Reset() {
+	*x = RateLimitBucket{}
+	mi := &file_user_v1_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitBucket) ProtoMessage() {}
+
+func (x *RateLimitBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitBucket.ProtoReflect.Descriptor instead.
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RateLimitBucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RateLimitBucket) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateLimitBucket) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimitBucket) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// 配额用量，limit为0表示不限制
+type QuotaData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RateLimits     []*RateLimitBucket     `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	UploadsUsed    int64                  `protobuf:"varint,2,opt,name=uploads_used,json=uploadsUsed,proto3" json:"uploads_used,omitempty"`            // 当日已上传视频数
+	UploadsLimit   int64                  `protobuf:"varint,3,opt,name=uploads_limit,json=uploadsLimit,proto3" json:"uploads_limit,omitempty"`         // 每日上传视频数上限
+	UploadsResetAt int64                  `protobuf:"varint,4,opt,name=uploads_reset_at,json=uploadsResetAt,proto3" json:"uploads_reset_at,omitempty"` // 上传计数重置时间（Unix秒）
+	StorageUsed    int64                  `protobuf:"varint,5,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`            // 视频占用的存储（字节）
+	StorageLimit   int64                  `protobuf:"varint,6,opt,name=storage_limit,json=storageLimit,proto3" json:"storage_limit,omitempty"`         // 存储上限（字节）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuotaData) Reset() {
+	*x = QuotaData{}
+	mi := &file_user_v1_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaData) ProtoMessage() {}
+
+func (x *QuotaData) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaData.ProtoReflect.Descriptor instead.
+func (*QuotaData) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *QuotaData) GetRateLimits() []*RateLimitBucket {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *QuotaData) GetUploadsUsed() int64 {
+	if x != nil {
+		return x.UploadsUsed
+	}
+	return 0
+}
+
+func (x *QuotaData) GetUploadsLimit() int64 {
+	if x != nil {
+		return x.UploadsLimit
+	}
+	return 0
+}
+
+func (x *QuotaData) GetUploadsResetAt() int64 {
+	if x != nil {
+		return x.UploadsResetAt
+	}
+	return 0
+}
+
+func (x *QuotaData) GetStorageUsed() int64 {
+	if x != nil {
+		return x.StorageUsed
+	}
+	return 0
+}
+
+func (x *QuotaData) GetStorageLimit() int64 {
+	if x != nil {
+		return x.StorageLimit
+	}
+	return 0
+}
+
+// 获取创作者数据请求
+type GetCreatorAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // Token
+	StartTime     int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 起始时间戳，按UTC取所在日期，默认28天前
+	EndTime       int64                  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 结束时间戳，按UTC取所在日期，默认当天；最多查询90天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorAnalyticsRequest) Reset() {
+	*x = GetCreatorAnalyticsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetCreatorAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetCreatorAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCreatorAnalyticsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCreatorAnalyticsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetCreatorAnalyticsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// 创作者某一天（UTC）的数据
+type CreatorDailyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                         // 日期，格式 2006-01-02
+	NewFollowers  int64                  `protobuf:"varint,2,opt,name=new_followers,json=newFollowers,proto3" json:"new_followers,omitempty"`    // 新增粉丝数
+	LostFollowers int64                  `protobuf:"varint,3,opt,name=lost_followers,json=lostFollowers,proto3" json:"lost_followers,omitempty"` // 取消关注数
+	VideoPlays    int64                  `protobuf:"varint,4,opt,name=video_plays,json=videoPlays,proto3" json:"video_plays,omitempty"`          // 作品播放数
+	LikesReceived int64                  `protobuf:"varint,5,opt,name=likes_received,json=likesReceived,proto3" json:"likes_received,omitempty"` // 作品获赞数，扣除当天取消的点赞
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatorDailyStats) Reset() {
+	*x = CreatorDailyStats{}
+	mi := &file_user_v1_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatorDailyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatorDailyStats) ProtoMessage() {}
+
+func (x *CreatorDailyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatorDailyStats.ProtoReflect.Descriptor instead.
+func (*CreatorDailyStats) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreatorDailyStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreatorDailyStats) GetNewFollowers() int64 {
+	if x != nil {
+		return x.NewFollowers
+	}
+	return 0
+}
+
+func (x *CreatorDailyStats) GetLostFollowers() int64 {
+	if x != nil {
+		return x.LostFollowers
+	}
+	return 0
+}
+
+func (x *CreatorDailyStats) GetVideoPlays() int64 {
+	if x != nil {
+		return x.VideoPlays
+	}
+	return 0
+}
+
+func (x *CreatorDailyStats) GetLikesReceived() int64 {
+	if x != nil {
+		return x.LikesReceived
+	}
+	return 0
+}
+
+// 获取创作者数据响应
+type GetCreatorAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Days          []*CreatorDailyStats   `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"` // 按日期升序，没有数据的日期为0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorAnalyticsResponse) Reset() {
+	*x = GetCreatorAnalyticsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetCreatorAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetCreatorAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCreatorAnalyticsResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetCreatorAnalyticsResponse) GetDays() []*CreatorDailyStats {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// 校验邮箱请求
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Token
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`   // 邮件中的验证码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerifyEmailRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 校验邮箱响应
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // 绑定成功的邮箱
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VerifyEmailResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *VerifyEmailResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// 关注操作请求
+type RelationActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                              // Token
+	ToUserId      int64                  `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`     // 对方用户ID
+	ActionType    int32                  `protobuf:"varint,3,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"` // 1关注，2取消关注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationActionRequest) Reset() {
+	*x = RelationActionRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationActionRequest) ProtoMessage() {}
+
+func (x *RelationActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationActionRequest.ProtoReflect.Descriptor instead.
+func (*RelationActionRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RelationActionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RelationActionRequest) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *RelationActionRequest) GetActionType() int32 {
+	if x != nil {
+		return x.ActionType
+	}
+	return 0
+}
+
+// 关注操作响应
+type RelationActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Requested     bool                   `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"` // 对方为私密账号，已发送关注申请等待通过
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationActionResponse) Reset() {
+	*x = RelationActionResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationActionResponse) ProtoMessage() {}
+
+func (x *RelationActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationActionResponse.ProtoReflect.Descriptor instead.
+func (*RelationActionResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RelationActionResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RelationActionResponse) GetRequested() bool {
+	if x != nil {
+		return x.Requested
+	}
+	return false
+}
+
+// 获取关注申请列表请求
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Token
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`  // 页码，从1开始
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`  // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListFollowRequestsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListFollowRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFollowRequestsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 获取关注申请列表响应
+type ListFollowRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RequestList   []*FollowRequest       `protobuf:"bytes,2,rep,name=request_list,json=requestList,proto3" json:"request_list,omitempty"` // 待处理的申请，按申请时间倒序
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                               // 总数
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`            // 是否还有下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsResponse) Reset() {
+	*x = ListFollowRequestsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListFollowRequestsResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListFollowRequestsResponse) GetRequestList() []*FollowRequest {
+	if x != nil {
+		return x.RequestList
+	}
+	return nil
+}
+
+func (x *ListFollowRequestsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFollowRequestsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 关注申请
+type FollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	User          *v1.User               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`                             // 申请人
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 申请时间，Unix秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FollowRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FollowRequest) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FollowRequest) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 处理关注申请请求
+type HandleFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                // Token
+	FromUserId    int64                  `protobuf:"varint,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"` // 申请人用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleFollowRequestRequest) Reset() {
+	*x = HandleFollowRequestRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleFollowRequestRequest) ProtoMessage() {}
+
+func (x *HandleFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*HandleFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *HandleFollowRequestRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *HandleFollowRequestRequest) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+// 处理关注申请响应
+type HandleFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleFollowRequestResponse) Reset() {
+	*x = HandleFollowRequestResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleFollowRequestResponse) ProtoMessage() {}
+
+func (x *HandleFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*HandleFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *HandleFollowRequestResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 获取关注列表请求
+type GetFollowListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                   // 页码，从1开始
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                   // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowListRequest) Reset() {
+	*x = GetFollowListRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowListRequest) ProtoMessage() {}
+
+func (x *GetFollowListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowListRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowListRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetFollowListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowListRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetFollowListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFollowListRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 获取关注列表响应
+type GetFollowListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *GetFollowListData     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowListResponse) Reset() {
+	*x = GetFollowListResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowListResponse) ProtoMessage() {}
+
+func (x *GetFollowListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowListResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowListResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetFollowListResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetFollowListResponse) GetData() *GetFollowListData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFollowListData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserList      []*v1.User             `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"` // 关注用户列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                      // 总数
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`   // 是否还有下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowListData) Reset() {
+	*x = GetFollowListData{}
+	mi := &file_user_v1_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowListData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowListData) ProtoMessage() {}
+
+func (x *GetFollowListData) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowListData.ProtoReflect.Descriptor instead.
+func (*GetFollowListData) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetFollowListData) GetUserList() []*v1.User {
+	if x != nil {
+		return x.UserList
+	}
+	return nil
+}
+
+func (x *GetFollowListData) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetFollowListData) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 获取粉丝列表请求
+type GetFollowerListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                   // 页码，从1开始
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                   // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowerListRequest) Reset() {
+	*x = GetFollowerListRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerListRequest) ProtoMessage() {}
+
+func (x *GetFollowerListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerListRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowerListRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetFollowerListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowerListRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetFollowerListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFollowerListRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 获取粉丝列表响应
+type GetFollowerListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *GetFollowerListData   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowerListResponse) Reset() {
+	*x = GetFollowerListResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerListResponse) ProtoMessage() {}
+
+func (x *GetFollowerListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerListResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowerListResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetFollowerListResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetFollowerListResponse) GetData() *GetFollowerListData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFollowerListData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserList      []*v1.User             `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"` // 粉丝用户列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                      // 总数
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`   // 是否还有下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowerListData) Reset() {
+	*x = GetFollowerListData{}
+	mi := &file_user_v1_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerListData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerListData) ProtoMessage() {}
+
+func (x *GetFollowerListData) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerListData.ProtoReflect.Descriptor instead.
+func (*GetFollowerListData) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetFollowerListData) GetUserList() []*v1.User {
+	if x != nil {
+		return x.UserList
+	}
+	return nil
+}
+
+func (x *GetFollowerListData) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetFollowerListData) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 获取好友列表请求
+type GetFriendListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendListRequest) Reset() {
+	*x = GetFriendListRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendListRequest) ProtoMessage() {}
+
+func (x *GetFriendListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendListRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendListRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetFriendListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFriendListRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取好友列表响应
+type GetFriendListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *GetFriendListData     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendListResponse) Reset() {
+	*x = GetFriendListResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendListResponse) ProtoMessage() {}
+
+func (x *GetFriendListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendListResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendListResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetFriendListResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetFriendListResponse) GetData() *GetFriendListData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFriendListData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserList      []*FriendUser          `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"` // 好友列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendListData) Reset() {
+	*x = GetFriendListData{}
+	mi := &file_user_v1_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendListData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendListData) ProtoMessage() {}
+
+func (x *GetFriendListData) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendListData.ProtoReflect.Descriptor instead.
+func (*GetFriendListData) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetFriendListData) GetUserList() []*FriendUser {
+	if x != nil {
+		return x.UserList
+	}
+	return nil
+}
+
+// 好友用户信息(包含最新消息)
+type FriendUser struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FollowCount     int64                  `protobuf:"varint,3,opt,name=follow_count,json=followCount,proto3" json:"follow_count,omitempty"`
+	FollowerCount   int64                  `protobuf:"varint,4,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	IsFollow        bool                   `protobuf:"varint,5,opt,name=is_follow,json=isFollow,proto3" json:"is_follow,omitempty"`
+	Avatar          string                 `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	BackgroundImage string                 `protobuf:"bytes,7,opt,name=background_image,json=backgroundImage,proto3" json:"background_image,omitempty"`
+	Signature       string                 `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	TotalFavorited  int64                  `protobuf:"varint,9,opt,name=total_favorited,json=totalFavorited,proto3" json:"total_favorited,omitempty"`
+	WorkCount       int64                  `protobuf:"varint,10,opt,name=work_count,json=workCount,proto3" json:"work_count,omitempty"`
+	FavoriteCount   int64                  `protobuf:"varint,11,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	Message         string                 `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`                    // 最新消息内容
+	MsgType         int64                  `protobuf:"varint,13,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`    // 消息类型
+	IsOnline        bool                   `protobuf:"varint,14,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"` // 是否在线，仅查看自己的好友列表时返回
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FriendUser) Reset() {
+	*x = FriendUser{}
+	mi := &file_user_v1_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendUser) ProtoMessage() {}
+
+func (x *FriendUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendUser.ProtoReflect.Descriptor instead.
+func (*FriendUser) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FriendUser) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FriendUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FriendUser) GetFollowCount() int64 {
+	if x != nil {
+		return x.FollowCount
+	}
+	return 0
+}
+
+func (x *FriendUser) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *FriendUser) GetIsFollow() bool {
+	if x != nil {
+		return x.IsFollow
+	}
+	return false
+}
+
+func (x *FriendUser) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *FriendUser) GetBackgroundImage() string {
+	if x != nil {
+		return x.BackgroundImage
+	}
+	return ""
+}
+
+func (x *FriendUser) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FriendUser) GetTotalFavorited() int64 {
+	if x != nil {
+		return x.TotalFavorited
+	}
+	return 0
+}
+
+func (x *FriendUser) GetWorkCount() int64 {
+	if x != nil {
+		return x.WorkCount
+	}
+	return 0
+}
+
+func (x *FriendUser) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *FriendUser) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FriendUser) GetMsgType() int64 {
+	if x != nil {
+		return x.MsgType
+	}
+	return 0
+}
+
+func (x *FriendUser) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+// 获取在线好友请求
+type GetOnlineFriendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnlineFriendsRequest) Reset() {
+	*x = GetOnlineFriendsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowerListRequest) String() string {
+func (x *GetOnlineFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowerListRequest) ProtoMessage() {}
+func (*GetOnlineFriendsRequest) ProtoMessage() {}
 
-func (x *GetFollowerListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[14]
+func (x *GetOnlineFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,49 +4026,42 @@ func (x *GetFollowerListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowerListRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowerListRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetFollowerListRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetOnlineFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetOnlineFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetFollowerListRequest) GetToken() string {
+func (x *GetOnlineFriendsRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-// 获取粉丝列表响应
-type GetFollowerListResponse struct {
+// 获取在线好友响应
+type GetOnlineFriendsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *GetFollowerListData   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	UserList      []*v1.User             `protobuf:"bytes,2,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"` // 在线好友列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowerListResponse) Reset() {
-	*x = GetFollowerListResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[15]
+func (x *GetOnlineFriendsResponse) Reset() {
+	*x = GetOnlineFriendsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowerListResponse) String() string {
+func (x *GetOnlineFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowerListResponse) ProtoMessage() {}
+func (*GetOnlineFriendsResponse) ProtoMessage() {}
 
-func (x *GetFollowerListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[15]
+func (x *GetOnlineFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,47 +4072,50 @@ func (x *GetFollowerListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowerListResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowerListResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetOnlineFriendsResponse.ProtoReflect.Descriptor instead.
+func (*GetOnlineFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetFollowerListResponse) GetBase() *v1.BaseResponse {
+func (x *GetOnlineFriendsResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *GetFollowerListResponse) GetData() *GetFollowerListData {
+func (x *GetOnlineFriendsResponse) GetUserList() []*v1.User {
 	if x != nil {
-		return x.Data
+		return x.UserList
 	}
 	return nil
 }
 
-type GetFollowerListData struct {
+// 获取登录记录请求
+type GetLoginHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserList      []*v1.User             `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"` // 粉丝用户列表
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 认证Token
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`  // 页码，从1开始
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`  // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowerListData) Reset() {
-	*x = GetFollowerListData{}
-	mi := &file_user_v1_user_proto_msgTypes[16]
+func (x *GetLoginHistoryRequest) Reset() {
+	*x = GetLoginHistoryRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowerListData) String() string {
+func (x *GetLoginHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowerListData) ProtoMessage() {}
+func (*GetLoginHistoryRequest) ProtoMessage() {}
 
-func (x *GetFollowerListData) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[16]
+func (x *GetLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,42 +4126,57 @@ func (x *GetFollowerListData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowerListData.ProtoReflect.Descriptor instead.
-func (*GetFollowerListData) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetFollowerListData) GetUserList() []*v1.User {
+func (x *GetLoginHistoryRequest) GetToken() string {
 	if x != nil {
-		return x.UserList
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-// 获取好友列表请求
-type GetFriendListRequest struct {
+func (x *GetLoginHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 获取登录记录响应
+type GetLoginHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // Token
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Records       []*LoginRecord         `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"` // 总数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFriendListRequest) Reset() {
-	*x = GetFriendListRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[17]
+func (x *GetLoginHistoryResponse) Reset() {
+	*x = GetLoginHistoryResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFriendListRequest) String() string {
+func (x *GetLoginHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendListRequest) ProtoMessage() {}
+func (*GetLoginHistoryResponse) ProtoMessage() {}
 
-func (x *GetFriendListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[17]
+func (x *GetLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,49 +4187,55 @@ func (x *GetFriendListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendListRequest.ProtoReflect.Descriptor instead.
-func (*GetFriendListRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetFriendListRequest) GetUserId() int64 {
+func (x *GetLoginHistoryResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
-		return x.UserId
+		return x.Base
 	}
-	return 0
+	return nil
 }
 
-func (x *GetFriendListRequest) GetToken() string {
+func (x *GetLoginHistoryResponse) GetRecords() []*LoginRecord {
 	if x != nil {
-		return x.Token
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-// 获取好友列表响应
-type GetFriendListResponse struct {
+func (x *GetLoginHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 在线心跳请求
+type PingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *GetFriendListData     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFriendListResponse) Reset() {
-	*x = GetFriendListResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[18]
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFriendListResponse) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendListResponse) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *GetFriendListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[18]
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,47 +4246,41 @@ func (x *GetFriendListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendListResponse.ProtoReflect.Descriptor instead.
-func (*GetFriendListResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GetFriendListResponse) GetBase() *v1.BaseResponse {
-	if x != nil {
-		return x.Base
-	}
-	return nil
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetFriendListResponse) GetData() *GetFriendListData {
+func (x *PingRequest) GetToken() string {
 	if x != nil {
-		return x.Data
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type GetFriendListData struct {
+// 在线心跳响应
+type PingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserList      []*FriendUser          `protobuf:"bytes,1,rep,name=user_list,json=userList,proto3" json:"user_list,omitempty"` // 好友列表
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFriendListData) Reset() {
-	*x = GetFriendListData{}
-	mi := &file_user_v1_user_proto_msgTypes[19]
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFriendListData) String() string {
+func (x *PingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendListData) ProtoMessage() {}
+func (*PingResponse) ProtoMessage() {}
 
-func (x *GetFriendListData) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[19]
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,53 +4291,47 @@ func (x *GetFriendListData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendListData.ProtoReflect.Descriptor instead.
-func (*GetFriendListData) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetFriendListData) GetUserList() []*FriendUser {
+func (x *PingResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
-		return x.UserList
+		return x.Base
 	}
 	return nil
 }
 
-// 好友用户信息(包含最新消息)
-type FriendUser struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	FollowCount     int64                  `protobuf:"varint,3,opt,name=follow_count,json=followCount,proto3" json:"follow_count,omitempty"`
-	FollowerCount   int64                  `protobuf:"varint,4,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
-	IsFollow        bool                   `protobuf:"varint,5,opt,name=is_follow,json=isFollow,proto3" json:"is_follow,omitempty"`
-	Avatar          string                 `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	BackgroundImage string                 `protobuf:"bytes,7,opt,name=background_image,json=backgroundImage,proto3" json:"background_image,omitempty"`
-	Signature       string                 `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
-	TotalFavorited  int64                  `protobuf:"varint,9,opt,name=total_favorited,json=totalFavorited,proto3" json:"total_favorited,omitempty"`
-	WorkCount       int64                  `protobuf:"varint,10,opt,name=work_count,json=workCount,proto3" json:"work_count,omitempty"`
-	FavoriteCount   int64                  `protobuf:"varint,11,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
-	Message         string                 `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`                 // 最新消息内容
-	MsgType         int64                  `protobuf:"varint,13,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"` // 消息类型
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 登录记录
+type LoginRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 客户端上报的设备ID
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                        // 1已放行 2异常登录等待验证码 3已通过验证码验证
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                         // 异常原因，逗号分隔：new_device、new_network，正常登录为空
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 登录时间，Unix秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FriendUser) Reset() {
-	*x = FriendUser{}
-	mi := &file_user_v1_user_proto_msgTypes[20]
+func (x *LoginRecord) Reset() {
+	*x = LoginRecord{}
+	mi := &file_user_v1_user_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FriendUser) String() string {
+func (x *LoginRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FriendUser) ProtoMessage() {}
+func (*LoginRecord) ProtoMessage() {}
 
-func (x *FriendUser) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[20]
+func (x *LoginRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,98 +4342,56 @@ func (x *FriendUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FriendUser.ProtoReflect.Descriptor instead.
-func (*FriendUser) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use LoginRecord.ProtoReflect.Descriptor instead.
+func (*LoginRecord) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *FriendUser) GetId() int64 {
+func (x *LoginRecord) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *FriendUser) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *FriendUser) GetFollowCount() int64 {
-	if x != nil {
-		return x.FollowCount
-	}
-	return 0
-}
-
-func (x *FriendUser) GetFollowerCount() int64 {
-	if x != nil {
-		return x.FollowerCount
-	}
-	return 0
-}
-
-func (x *FriendUser) GetIsFollow() bool {
-	if x != nil {
-		return x.IsFollow
-	}
-	return false
-}
-
-func (x *FriendUser) GetAvatar() string {
+func (x *LoginRecord) GetDeviceId() string {
 	if x != nil {
-		return x.Avatar
+		return x.DeviceId
 	}
 	return ""
 }
 
-func (x *FriendUser) GetBackgroundImage() string {
+func (x *LoginRecord) GetIp() string {
 	if x != nil {
-		return x.BackgroundImage
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *FriendUser) GetSignature() string {
+func (x *LoginRecord) GetUserAgent() string {
 	if x != nil {
-		return x.Signature
+		return x.UserAgent
 	}
 	return ""
 }
 
-func (x *FriendUser) GetTotalFavorited() int64 {
-	if x != nil {
-		return x.TotalFavorited
-	}
-	return 0
-}
-
-func (x *FriendUser) GetWorkCount() int64 {
-	if x != nil {
-		return x.WorkCount
-	}
-	return 0
-}
-
-func (x *FriendUser) GetFavoriteCount() int64 {
+func (x *LoginRecord) GetStatus() int32 {
 	if x != nil {
-		return x.FavoriteCount
+		return x.Status
 	}
 	return 0
 }
 
-func (x *FriendUser) GetMessage() string {
+func (x *LoginRecord) GetReason() string {
 	if x != nil {
-		return x.Message
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *FriendUser) GetMsgType() int64 {
+func (x *LoginRecord) GetCreatedAt() int64 {
 	if x != nil {
-		return x.MsgType
+		return x.CreatedAt
 	}
 	return 0
 }
@@ -1256,7 +4406,7 @@ type GetUserInfoRequest struct {
 
 func (x *GetUserInfoRequest) Reset() {
 	*x = GetUserInfoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1268,7 +4418,7 @@ func (x *GetUserInfoRequest) String() string {
 func (*GetUserInfoRequest) ProtoMessage() {}
 
 func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,7 +4431,7 @@ func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetUserInfoRequest) GetUserId() int64 {
@@ -1301,7 +4451,7 @@ type GetUserInfoResponse struct {
 
 func (x *GetUserInfoResponse) Reset() {
 	*x = GetUserInfoResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1313,7 +4463,7 @@ func (x *GetUserInfoResponse) String() string {
 func (*GetUserInfoResponse) ProtoMessage() {}
 
 func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1326,7 +4476,7 @@ func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetUserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetUserInfoResponse) GetUser() *v1.User {
@@ -1346,7 +4496,7 @@ type GetUsersInfoRequest struct {
 
 func (x *GetUsersInfoRequest) Reset() {
 	*x = GetUsersInfoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +4508,7 @@ func (x *GetUsersInfoRequest) String() string {
 func (*GetUsersInfoRequest) ProtoMessage() {}
 
 func (x *GetUsersInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +4521,7 @@ func (x *GetUsersInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetUsersInfoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetUsersInfoRequest) GetUserIds() []int64 {
@@ -1391,7 +4541,7 @@ type GetUsersInfoResponse struct {
 
 func (x *GetUsersInfoResponse) Reset() {
 	*x = GetUsersInfoResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1403,7 +4553,7 @@ func (x *GetUsersInfoResponse) String() string {
 func (*GetUsersInfoResponse) ProtoMessage() {}
 
 func (x *GetUsersInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +4566,7 @@ func (x *GetUsersInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetUsersInfoResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetUsersInfoResponse) GetUsers() []*v1.User {
@@ -1436,7 +4586,7 @@ type VerifyTokenRequest struct {
 
 func (x *VerifyTokenRequest) Reset() {
 	*x = VerifyTokenRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1448,7 +4598,7 @@ func (x *VerifyTokenRequest) String() string {
 func (*VerifyTokenRequest) ProtoMessage() {}
 
 func (x *VerifyTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,7 +4611,7 @@ func (x *VerifyTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyTokenRequest.ProtoReflect.Descriptor instead.
 func (*VerifyTokenRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *VerifyTokenRequest) GetToken() string {
@@ -1483,7 +4633,7 @@ type VerifyTokenResponse struct {
 
 func (x *VerifyTokenResponse) Reset() {
 	*x = VerifyTokenResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1495,7 +4645,7 @@ func (x *VerifyTokenResponse) String() string {
 func (*VerifyTokenResponse) ProtoMessage() {}
 
 func (x *VerifyTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,7 +4658,7 @@ func (x *VerifyTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyTokenResponse.ProtoReflect.Descriptor instead.
 func (*VerifyTokenResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *VerifyTokenResponse) GetValid() bool {
@@ -1544,7 +4694,7 @@ type UpdateUserStatsRequest struct {
 
 func (x *UpdateUserStatsRequest) Reset() {
 	*x = UpdateUserStatsRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1556,7 +4706,7 @@ func (x *UpdateUserStatsRequest) String() string {
 func (*UpdateUserStatsRequest) ProtoMessage() {}
 
 func (x *UpdateUserStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,7 +4719,7 @@ func (x *UpdateUserStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserStatsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserStatsRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *UpdateUserStatsRequest) GetUserId() int64 {
@@ -1597,25 +4747,73 @@ var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16common/v1/common.proto\"I\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16common/v1/common.proto\"\x9a\x01\n" +
 	"\x0fRegisterRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"j\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12#\n" +
+	"\rreferral_code\x18\x05 \x01(\tR\freferralCode\"j\n" +
 	"\x10RegisterResponse\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12)\n" +
 	"\x04data\x18\x02 \x01(\v2\x15.user.v1.RegisterDataR\x04data\"=\n" +
 	"\fRegisterData\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"F\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"s\n" +
 	"\fLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"d\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12+\n" +
+	"\x11verification_code\x18\x03 \x01(\tR\x10verificationCode\"\x98\x01\n" +
 	"\rLoginResponse\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12&\n" +
-	"\x04data\x18\x02 \x01(\v2\x12.user.v1.LoginDataR\x04data\":\n" +
+	"\x04data\x18\x02 \x01(\v2\x12.user.v1.LoginDataR\x04data\x122\n" +
+	"\x15deletion_scheduled_at\x18\x03 \x01(\x03R\x13deletionScheduledAt\":\n" +
 	"\tLoginData\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"?\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x97\x01\n" +
+	"\x15LoginWithOAuthRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\x12+\n" +
+	"\x11verification_code\x18\x04 \x01(\tR\x10verificationCode\"\x87\x01\n" +
+	"\x16LoginWithOAuthResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04data\x18\x02 \x01(\v2\x12.user.v1.LoginDataR\x04data\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\bR\acreated\"O\n" +
+	"\x1bSendVerificationCodeRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"K\n" +
+	"\x1cSendVerificationCodeResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"\x89\x01\n" +
+	"\x14LoginWithCodeRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12+\n" +
+	"\x11verification_code\x18\x04 \x01(\tR\x10verificationCode\"l\n" +
+	"\x15LoginWithCodeResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04data\x18\x02 \x01(\v2\x12.user.v1.LoginDataR\x04data\"J\n" +
+	"\rLogoutRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"=\n" +
+	"\x0eLogoutResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"H\n" +
+	"\x14DeleteAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"_\n" +
+	"\x15DeleteAccountResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x19\n" +
+	"\bpurge_at\x18\x02 \x01(\x03R\apurgeAt\"O\n" +
+	"\x15RestoreAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"+\n" +
+	"\x13ExportMyDataRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x85\x01\n" +
+	"\x14ExportMyDataResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12!\n" +
+	"\fdownload_url\x18\x02 \x01(\tR\vdownloadUrl\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"?\n" +
 	"\x0eGetUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"h\n" +
@@ -1623,31 +4821,163 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12(\n" +
 	"\x04data\x18\x02 \x01(\v2\x14.user.v1.GetUserDataR\x04data\"2\n" +
 	"\vGetUserData\x12#\n" +
-	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user\"l\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserR\x04user\"I\n" +
+	"\x15UpdateTimezoneRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"a\n" +
+	"\x16UpdateTimezoneResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"K\n" +
+	"\x14UpdatePrivacyRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x02 \x01(\bR\tisPrivate\"c\n" +
+	"\x15UpdatePrivacyResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x02 \x01(\bR\tisPrivate\"F\n" +
+	"\x15GetProfilePageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xd8\x01\n" +
+	"\x16GetProfilePageResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12#\n" +
+	"\x04user\x18\x02 \x01(\v2\x0f.common.v1.UserR\x04user\x125\n" +
+	"\rpinned_videos\x18\x03 \x03(\v2\x10.common.v1.VideoR\fpinnedVideos\x125\n" +
+	"\rrecent_videos\x18\x04 \x03(\v2\x10.common.v1.VideoR\frecentVideos\"\xa9\x01\n" +
+	"\x14UpdateProfileRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12)\n" +
+	"\x10background_image\x18\x04 \x01(\tR\x0fbackgroundImage\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\"i\n" +
+	"\x15UpdateProfileResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12#\n" +
+	"\x04user\x18\x02 \x01(\v2\x0f.common.v1.UserR\x04user\"s\n" +
+	"\x15ChangePasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"E\n" +
+	"\x16ChangePasswordResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"a\n" +
+	"\x19UploadProfileImageRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"n\n" +
+	"\x1aUploadProfileImageResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12#\n" +
+	"\x04user\x18\x02 \x01(\v2\x0f.common.v1.UserR\x04user\"9\n" +
+	"\x1bRequestPasswordResetRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"K\n" +
+	"\x1cRequestPasswordResetResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"k\n" +
+	"\x14ResetPasswordRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"D\n" +
+	"\x15ResetPasswordResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\">\n" +
+	"\x10BindEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"@\n" +
+	"\x11BindEmailResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"2\n" +
+	"\x17GetUserShareCardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"b\n" +
+	"\x18GetUserShareCardResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x19\n" +
+	"\bcard_url\x18\x02 \x01(\tR\acardUrl\")\n" +
+	"\x11GetMyQuotaRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"i\n" +
+	"\x12GetMyQuotaResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04data\x18\x02 \x01(\v2\x12.user.v1.QuotaDataR\x04data\"m\n" +
+	"\x0fRateLimitBucket\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x01R\x04rate\x12\x14\n" +
+	"\x05burst\x18\x03 \x01(\x05R\x05burst\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x05R\tremaining\"\x80\x02\n" +
+	"\tQuotaData\x129\n" +
+	"\vrate_limits\x18\x01 \x03(\v2\x18.user.v1.RateLimitBucketR\n" +
+	"rateLimits\x12!\n" +
+	"\fuploads_used\x18\x02 \x01(\x03R\vuploadsUsed\x12#\n" +
+	"\ruploads_limit\x18\x03 \x01(\x03R\fuploadsLimit\x12(\n" +
+	"\x10uploads_reset_at\x18\x04 \x01(\x03R\x0euploadsResetAt\x12!\n" +
+	"\fstorage_used\x18\x05 \x01(\x03R\vstorageUsed\x12#\n" +
+	"\rstorage_limit\x18\x06 \x01(\x03R\fstorageLimit\"l\n" +
+	"\x1aGetCreatorAnalyticsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\x03R\aendTime\"\xbb\x01\n" +
+	"\x11CreatorDailyStats\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12#\n" +
+	"\rnew_followers\x18\x02 \x01(\x03R\fnewFollowers\x12%\n" +
+	"\x0elost_followers\x18\x03 \x01(\x03R\rlostFollowers\x12\x1f\n" +
+	"\vvideo_plays\x18\x04 \x01(\x03R\n" +
+	"videoPlays\x12%\n" +
+	"\x0elikes_received\x18\x05 \x01(\x03R\rlikesReceived\"z\n" +
+	"\x1bGetCreatorAnalyticsResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12.\n" +
+	"\x04days\x18\x02 \x03(\v2\x1a.user.v1.CreatorDailyStatsR\x04days\">\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"X\n" +
+	"\x13VerifyEmailResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"l\n" +
 	"\x15RelationActionRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1c\n" +
 	"\n" +
 	"to_user_id\x18\x02 \x01(\x03R\btoUserId\x12\x1f\n" +
 	"\vaction_type\x18\x03 \x01(\x05R\n" +
-	"actionType\"E\n" +
+	"actionType\"c\n" +
 	"\x16RelationActionResponse\x12+\n" +
-	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"E\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\bR\trequested\"Y\n" +
+	"\x19ListFollowRequestsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\xb5\x01\n" +
+	"\x1aListFollowRequestsResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x129\n" +
+	"\frequest_list\x18\x02 \x03(\v2\x16.user.v1.FollowRequestR\vrequestList\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"c\n" +
+	"\rFollowRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\x04user\x18\x02 \x01(\v2\x0f.common.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\"T\n" +
+	"\x1aHandleFollowRequestRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\x03R\n" +
+	"fromUserId\"J\n" +
+	"\x1bHandleFollowRequestResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"m\n" +
 	"\x14GetFollowListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"t\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"t\n" +
 	"\x15GetFollowListResponse\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12.\n" +
-	"\x04data\x18\x02 \x01(\v2\x1a.user.v1.GetFollowListDataR\x04data\"A\n" +
+	"\x04data\x18\x02 \x01(\v2\x1a.user.v1.GetFollowListDataR\x04data\"r\n" +
 	"\x11GetFollowListData\x12,\n" +
-	"\tuser_list\x18\x01 \x03(\v2\x0f.common.v1.UserR\buserList\"G\n" +
+	"\tuser_list\x18\x01 \x03(\v2\x0f.common.v1.UserR\buserList\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"o\n" +
 	"\x16GetFollowerListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"x\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"x\n" +
 	"\x17GetFollowerListResponse\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x120\n" +
-	"\x04data\x18\x02 \x01(\v2\x1c.user.v1.GetFollowerListDataR\x04data\"C\n" +
+	"\x04data\x18\x02 \x01(\v2\x1c.user.v1.GetFollowerListDataR\x04data\"t\n" +
 	"\x13GetFollowerListData\x12,\n" +
-	"\tuser_list\x18\x01 \x03(\v2\x0f.common.v1.UserR\buserList\"E\n" +
+	"\tuser_list\x18\x01 \x03(\v2\x0f.common.v1.UserR\buserList\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"E\n" +
 	"\x14GetFriendListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"t\n" +
@@ -1655,7 +4985,7 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12.\n" +
 	"\x04data\x18\x02 \x01(\v2\x1a.user.v1.GetFriendListDataR\x04data\"E\n" +
 	"\x11GetFriendListData\x120\n" +
-	"\tuser_list\x18\x01 \x03(\v2\x13.user.v1.FriendUserR\buserList\"\x9c\x03\n" +
+	"\tuser_list\x18\x01 \x03(\v2\x13.user.v1.FriendUserR\buserList\"\xb9\x03\n" +
 	"\n" +
 	"FriendUser\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
@@ -1672,7 +5002,35 @@ const file_user_v1_user_proto_rawDesc = "" +
 	" \x01(\x03R\tworkCount\x12%\n" +
 	"\x0efavorite_count\x18\v \x01(\x03R\rfavoriteCount\x12\x18\n" +
 	"\amessage\x18\f \x01(\tR\amessage\x12\x19\n" +
-	"\bmsg_type\x18\r \x01(\x03R\amsgType\"-\n" +
+	"\bmsg_type\x18\r \x01(\x03R\amsgType\x12\x1b\n" +
+	"\tis_online\x18\x0e \x01(\bR\bisOnline\"/\n" +
+	"\x17GetOnlineFriendsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"u\n" +
+	"\x18GetOnlineFriendsResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12,\n" +
+	"\tuser_list\x18\x02 \x03(\v2\x0f.common.v1.UserR\buserList\"V\n" +
+	"\x16GetLoginHistoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\x8c\x01\n" +
+	"\x17GetLoginHistoryResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12.\n" +
+	"\arecords\x18\x02 \x03(\v2\x14.user.v1.LoginRecordR\arecords\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"#\n" +
+	"\vPingRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\";\n" +
+	"\fPingResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"\xb8\x01\n" +
+	"\vLoginRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"-\n" +
 	"\x12GetUserInfoRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\":\n" +
 	"\x13GetUserInfoResponse\x12#\n" +
@@ -1697,15 +5055,43 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x1bUPDATE_STATS_FOLLOWER_COUNT\x10\x02\x12\x1b\n" +
 	"\x17UPDATE_STATS_WORK_COUNT\x10\x03\x12\x1f\n" +
 	"\x1bUPDATE_STATS_FAVORITE_COUNT\x10\x04\x12 \n" +
-	"\x1cUPDATE_STATS_TOTAL_FAVORITED\x10\x052\xa9\b\n" +
+	"\x1cUPDATE_STATS_TOTAL_FAVORITED\x10\x052\xda!\n" +
 	"\vUserService\x12a\n" +
 	"\bRegister\x12\x18.user.v1.RegisterRequest\x1a\x19.user.v1.RegisterResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/douyin/user/register\x12U\n" +
-	"\x05Login\x12\x15.user.v1.LoginRequest\x1a\x16.user.v1.LoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/douyin/user/login\x12R\n" +
+	"\x05Login\x12\x15.user.v1.LoginRequest\x1a\x16.user.v1.LoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/douyin/user/login\x12v\n" +
+	"\x0eLoginWithOAuth\x12\x1e.user.v1.LoginWithOAuthRequest\x1a\x1f.user.v1.LoginWithOAuthResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/douyin/user/oauth/login\x12\x86\x01\n" +
+	"\x14SendVerificationCode\x12$.user.v1.SendVerificationCodeRequest\x1a%.user.v1.SendVerificationCodeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/douyin/user/code/send\x12r\n" +
+	"\rLoginWithCode\x12\x1d.user.v1.LoginWithCodeRequest\x1a\x1e.user.v1.LoginWithCodeResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/douyin/user/code/login\x12Y\n" +
+	"\x06Logout\x12\x16.user.v1.LogoutRequest\x1a\x17.user.v1.LogoutResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/douyin/user/logout\x12n\n" +
+	"\rDeleteAccount\x12\x1d.user.v1.DeleteAccountRequest\x1a\x1e.user.v1.DeleteAccountResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/douyin/user/delete\x12i\n" +
+	"\x0eRestoreAccount\x12\x1e.user.v1.RestoreAccountRequest\x1a\x16.user.v1.LoginResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/douyin/user/restore\x12p\n" +
+	"\fExportMyData\x12\x1c.user.v1.ExportMyDataRequest\x1a\x1d.user.v1.ExportMyDataResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/douyin/user/data/export\x12R\n" +
 	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/douyin/user\x12u\n" +
 	"\x0eRelationAction\x12\x1e.user.v1.RelationActionRequest\x1a\x1f.user.v1.RelationActionResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/douyin/relation/action\x12t\n" +
 	"\rGetFollowList\x12\x1d.user.v1.GetFollowListRequest\x1a\x1e.user.v1.GetFollowListResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/douyin/relation/follow/list\x12|\n" +
 	"\x0fGetFollowerList\x12\x1f.user.v1.GetFollowerListRequest\x1a .user.v1.GetFollowerListResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/douyin/relation/follower/list\x12t\n" +
-	"\rGetFriendList\x12\x1d.user.v1.GetFriendListRequest\x1a\x1e.user.v1.GetFriendListResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/douyin/relation/friend/list\x12H\n" +
+	"\rGetFriendList\x12\x1d.user.v1.GetFriendListRequest\x1a\x1e.user.v1.GetFriendListResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/douyin/relation/friend/list\x12\x7f\n" +
+	"\x10GetOnlineFriends\x12 .user.v1.GetOnlineFriendsRequest\x1a!.user.v1.GetOnlineFriendsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/douyin/relation/friend/online\x12o\n" +
+	"\x0eGetProfilePage\x12\x1e.user.v1.GetProfilePageRequest\x1a\x1f.user.v1.GetProfilePageResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/douyin/user/profile\x12s\n" +
+	"\x0eUpdateTimezone\x12\x1e.user.v1.UpdateTimezoneRequest\x1a\x1f.user.v1.UpdateTimezoneResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/douyin/user/timezone\x12o\n" +
+	"\rUpdatePrivacy\x12\x1d.user.v1.UpdatePrivacyRequest\x1a\x1e.user.v1.UpdatePrivacyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/douyin/user/privacy\x12\x84\x01\n" +
+	"\x12ListFollowRequests\x12\".user.v1.ListFollowRequestsRequest\x1a#.user.v1.ListFollowRequestsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/douyin/relation/request/list\x12\x8e\x01\n" +
+	"\x14ApproveFollowRequest\x12#.user.v1.HandleFollowRequestRequest\x1a$.user.v1.HandleFollowRequestResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /douyin/relation/request/approve\x12\x8c\x01\n" +
+	"\x13RejectFollowRequest\x12#.user.v1.HandleFollowRequestRequest\x1a$.user.v1.HandleFollowRequestResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/douyin/relation/request/reject\x12v\n" +
+	"\rUpdateProfile\x12\x1d.user.v1.UpdateProfileRequest\x1a\x1e.user.v1.UpdateProfileResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/douyin/user/profile/update\x12z\n" +
+	"\x0eChangePassword\x12\x1e.user.v1.ChangePasswordRequest\x1a\x1f.user.v1.ChangePasswordResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/douyin/user/password/change\x12~\n" +
+	"\fUploadAvatar\x12\".user.v1.UploadProfileImageRequest\x1a#.user.v1.UploadProfileImageResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/douyin/user/avatar/upload\x12\x8b\x01\n" +
+	"\x15UploadBackgroundImage\x12\".user.v1.UploadProfileImageRequest\x1a#.user.v1.UploadProfileImageResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/douyin/user/background/upload\x12\x93\x01\n" +
+	"\x14RequestPasswordReset\x12$.user.v1.RequestPasswordResetRequest\x1a%.user.v1.RequestPasswordResetResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/douyin/user/password/reset/request\x12v\n" +
+	"\rResetPassword\x12\x1d.user.v1.ResetPasswordRequest\x1a\x1e.user.v1.ResetPasswordResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/douyin/user/password/reset\x12f\n" +
+	"\tBindEmail\x12\x19.user.v1.BindEmailRequest\x1a\x1a.user.v1.BindEmailResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/douyin/user/email/bind\x12n\n" +
+	"\vVerifyEmail\x12\x1b.user.v1.VerifyEmailRequest\x1a\x1c.user.v1.VerifyEmailResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/douyin/user/email/verify\x12x\n" +
+	"\x10GetUserShareCard\x12 .user.v1.GetUserShareCardRequest\x1a!.user.v1.GetUserShareCardResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/douyin/user/share/card\x12a\n" +
+	"\n" +
+	"GetMyQuota\x12\x1a.user.v1.GetMyQuotaRequest\x1a\x1b.user.v1.GetMyQuotaResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/douyin/user/quota\x12\x80\x01\n" +
+	"\x13GetCreatorAnalytics\x12#.user.v1.GetCreatorAnalyticsRequest\x1a$.user.v1.GetCreatorAnalyticsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/douyin/user/analytics\x12x\n" +
+	"\x0fGetLoginHistory\x12\x1f.user.v1.GetLoginHistoryRequest\x1a .user.v1.GetLoginHistoryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/douyin/user/login/history\x12Q\n" +
+	"\x04Ping\x12\x14.user.v1.PingRequest\x1a\x15.user.v1.PingResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/douyin/user/ping\x12H\n" +
 	"\vGetUserInfo\x12\x1b.user.v1.GetUserInfoRequest\x1a\x1c.user.v1.GetUserInfoResponse\x12K\n" +
 	"\fGetUsersInfo\x12\x1c.user.v1.GetUsersInfoRequest\x1a\x1d.user.v1.GetUsersInfoResponse\x12H\n" +
 	"\vVerifyToken\x12\x1b.user.v1.VerifyTokenRequest\x1a\x1c.user.v1.VerifyTokenResponse\x12J\n" +
@@ -1724,89 +5110,236 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_user_v1_user_proto_goTypes = []any{
-	(UpdateStatsType)(0),            // 0: user.v1.UpdateStatsType
-	(*RegisterRequest)(nil),         // 1: user.v1.RegisterRequest
-	(*RegisterResponse)(nil),        // 2: user.v1.RegisterResponse
-	(*RegisterData)(nil),            // 3: user.v1.RegisterData
-	(*LoginRequest)(nil),            // 4: user.v1.LoginRequest
-	(*LoginResponse)(nil),           // 5: user.v1.LoginResponse
-	(*LoginData)(nil),               // 6: user.v1.LoginData
-	(*GetUserRequest)(nil),          // 7: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),         // 8: user.v1.GetUserResponse
-	(*GetUserData)(nil),             // 9: user.v1.GetUserData
-	(*RelationActionRequest)(nil),   // 10: user.v1.RelationActionRequest
-	(*RelationActionResponse)(nil),  // 11: user.v1.RelationActionResponse
-	(*GetFollowListRequest)(nil),    // 12: user.v1.GetFollowListRequest
-	(*GetFollowListResponse)(nil),   // 13: user.v1.GetFollowListResponse
-	(*GetFollowListData)(nil),       // 14: user.v1.GetFollowListData
-	(*GetFollowerListRequest)(nil),  // 15: user.v1.GetFollowerListRequest
-	(*GetFollowerListResponse)(nil), // 16: user.v1.GetFollowerListResponse
-	(*GetFollowerListData)(nil),     // 17: user.v1.GetFollowerListData
-	(*GetFriendListRequest)(nil),    // 18: user.v1.GetFriendListRequest
-	(*GetFriendListResponse)(nil),   // 19: user.v1.GetFriendListResponse
-	(*GetFriendListData)(nil),       // 20: user.v1.GetFriendListData
-	(*FriendUser)(nil),              // 21: user.v1.FriendUser
-	(*GetUserInfoRequest)(nil),      // 22: user.v1.GetUserInfoRequest
-	(*GetUserInfoResponse)(nil),     // 23: user.v1.GetUserInfoResponse
-	(*GetUsersInfoRequest)(nil),     // 24: user.v1.GetUsersInfoRequest
-	(*GetUsersInfoResponse)(nil),    // 25: user.v1.GetUsersInfoResponse
-	(*VerifyTokenRequest)(nil),      // 26: user.v1.VerifyTokenRequest
-	(*VerifyTokenResponse)(nil),     // 27: user.v1.VerifyTokenResponse
-	(*UpdateUserStatsRequest)(nil),  // 28: user.v1.UpdateUserStatsRequest
-	(*v1.BaseResponse)(nil),         // 29: common.v1.BaseResponse
-	(*v1.User)(nil),                 // 30: common.v1.User
-	(*emptypb.Empty)(nil),           // 31: google.protobuf.Empty
+	(UpdateStatsType)(0),                 // 0: user.v1.UpdateStatsType
+	(*RegisterRequest)(nil),              // 1: user.v1.RegisterRequest
+	(*RegisterResponse)(nil),             // 2: user.v1.RegisterResponse
+	(*RegisterData)(nil),                 // 3: user.v1.RegisterData
+	(*LoginRequest)(nil),                 // 4: user.v1.LoginRequest
+	(*LoginResponse)(nil),                // 5: user.v1.LoginResponse
+	(*LoginData)(nil),                    // 6: user.v1.LoginData
+	(*LoginWithOAuthRequest)(nil),        // 7: user.v1.LoginWithOAuthRequest
+	(*LoginWithOAuthResponse)(nil),       // 8: user.v1.LoginWithOAuthResponse
+	(*SendVerificationCodeRequest)(nil),  // 9: user.v1.SendVerificationCodeRequest
+	(*SendVerificationCodeResponse)(nil), // 10: user.v1.SendVerificationCodeResponse
+	(*LoginWithCodeRequest)(nil),         // 11: user.v1.LoginWithCodeRequest
+	(*LoginWithCodeResponse)(nil),        // 12: user.v1.LoginWithCodeResponse
+	(*LogoutRequest)(nil),                // 13: user.v1.LogoutRequest
+	(*LogoutResponse)(nil),               // 14: user.v1.LogoutResponse
+	(*DeleteAccountRequest)(nil),         // 15: user.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),        // 16: user.v1.DeleteAccountResponse
+	(*RestoreAccountRequest)(nil),        // 17: user.v1.RestoreAccountRequest
+	(*ExportMyDataRequest)(nil),          // 18: user.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),         // 19: user.v1.ExportMyDataResponse
+	(*GetUserRequest)(nil),               // 20: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),              // 21: user.v1.GetUserResponse
+	(*GetUserData)(nil),                  // 22: user.v1.GetUserData
+	(*UpdateTimezoneRequest)(nil),        // 23: user.v1.UpdateTimezoneRequest
+	(*UpdateTimezoneResponse)(nil),       // 24: user.v1.UpdateTimezoneResponse
+	(*UpdatePrivacyRequest)(nil),         // 25: user.v1.UpdatePrivacyRequest
+	(*UpdatePrivacyResponse)(nil),        // 26: user.v1.UpdatePrivacyResponse
+	(*GetProfilePageRequest)(nil),        // 27: user.v1.GetProfilePageRequest
+	(*GetProfilePageResponse)(nil),       // 28: user.v1.GetProfilePageResponse
+	(*UpdateProfileRequest)(nil),         // 29: user.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),        // 30: user.v1.UpdateProfileResponse
+	(*ChangePasswordRequest)(nil),        // 31: user.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),       // 32: user.v1.ChangePasswordResponse
+	(*UploadProfileImageRequest)(nil),    // 33: user.v1.UploadProfileImageRequest
+	(*UploadProfileImageResponse)(nil),   // 34: user.v1.UploadProfileImageResponse
+	(*RequestPasswordResetRequest)(nil),  // 35: user.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil), // 36: user.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),         // 37: user.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),        // 38: user.v1.ResetPasswordResponse
+	(*BindEmailRequest)(nil),             // 39: user.v1.BindEmailRequest
+	(*BindEmailResponse)(nil),            // 40: user.v1.BindEmailResponse
+	(*GetUserShareCardRequest)(nil),      // 41: user.v1.GetUserShareCardRequest
+	(*GetUserShareCardResponse)(nil),     // 42: user.v1.GetUserShareCardResponse
+	(*GetMyQuotaRequest)(nil),            // 43: user.v1.GetMyQuotaRequest
+	(*GetMyQuotaResponse)(nil),           // 44: user.v1.GetMyQuotaResponse
+	(*RateLimitBucket)(nil),              // 45: user.v1.RateLimitBucket
+	(*QuotaData)(nil),                    // 46: user.v1.QuotaData
+	(*GetCreatorAnalyticsRequest)(nil),   // 47: user.v1.GetCreatorAnalyticsRequest
+	(*CreatorDailyStats)(nil),            // 48: user.v1.CreatorDailyStats
+	(*GetCreatorAnalyticsResponse)(nil),  // 49: user.v1.GetCreatorAnalyticsResponse
+	(*VerifyEmailRequest)(nil),           // 50: user.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),          // 51: user.v1.VerifyEmailResponse
+	(*RelationActionRequest)(nil),        // 52: user.v1.RelationActionRequest
+	(*RelationActionResponse)(nil),       // 53: user.v1.RelationActionResponse
+	(*ListFollowRequestsRequest)(nil),    // 54: user.v1.ListFollowRequestsRequest
+	(*ListFollowRequestsResponse)(nil),   // 55: user.v1.ListFollowRequestsResponse
+	(*FollowRequest)(nil),                // 56: user.v1.FollowRequest
+	(*HandleFollowRequestRequest)(nil),   // 57: user.v1.HandleFollowRequestRequest
+	(*HandleFollowRequestResponse)(nil),  // 58: user.v1.HandleFollowRequestResponse
+	(*GetFollowListRequest)(nil),         // 59: user.v1.GetFollowListRequest
+	(*GetFollowListResponse)(nil),        // 60: user.v1.GetFollowListResponse
+	(*GetFollowListData)(nil),            // 61: user.v1.GetFollowListData
+	(*GetFollowerListRequest)(nil),       // 62: user.v1.GetFollowerListRequest
+	(*GetFollowerListResponse)(nil),      // 63: user.v1.GetFollowerListResponse
+	(*GetFollowerListData)(nil),          // 64: user.v1.GetFollowerListData
+	(*GetFriendListRequest)(nil),         // 65: user.v1.GetFriendListRequest
+	(*GetFriendListResponse)(nil),        // 66: user.v1.GetFriendListResponse
+	(*GetFriendListData)(nil),            // 67: user.v1.GetFriendListData
+	(*FriendUser)(nil),                   // 68: user.v1.FriendUser
+	(*GetOnlineFriendsRequest)(nil),      // 69: user.v1.GetOnlineFriendsRequest
+	(*GetOnlineFriendsResponse)(nil),     // 70: user.v1.GetOnlineFriendsResponse
+	(*GetLoginHistoryRequest)(nil),       // 71: user.v1.GetLoginHistoryRequest
+	(*GetLoginHistoryResponse)(nil),      // 72: user.v1.GetLoginHistoryResponse
+	(*PingRequest)(nil),                  // 73: user.v1.PingRequest
+	(*PingResponse)(nil),                 // 74: user.v1.PingResponse
+	(*LoginRecord)(nil),                  // 75: user.v1.LoginRecord
+	(*GetUserInfoRequest)(nil),           // 76: user.v1.GetUserInfoRequest
+	(*GetUserInfoResponse)(nil),          // 77: user.v1.GetUserInfoResponse
+	(*GetUsersInfoRequest)(nil),          // 78: user.v1.GetUsersInfoRequest
+	(*GetUsersInfoResponse)(nil),         // 79: user.v1.GetUsersInfoResponse
+	(*VerifyTokenRequest)(nil),           // 80: user.v1.VerifyTokenRequest
+	(*VerifyTokenResponse)(nil),          // 81: user.v1.VerifyTokenResponse
+	(*UpdateUserStatsRequest)(nil),       // 82: user.v1.UpdateUserStatsRequest
+	(*v1.BaseResponse)(nil),              // 83: common.v1.BaseResponse
+	(*v1.User)(nil),                      // 84: common.v1.User
+	(*v1.Video)(nil),                     // 85: common.v1.Video
+	(*emptypb.Empty)(nil),                // 86: google.protobuf.Empty
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	29, // 0: user.v1.RegisterResponse.base:type_name -> common.v1.BaseResponse
+	83, // 0: user.v1.RegisterResponse.base:type_name -> common.v1.BaseResponse
 	3,  // 1: user.v1.RegisterResponse.data:type_name -> user.v1.RegisterData
-	29, // 2: user.v1.LoginResponse.base:type_name -> common.v1.BaseResponse
+	83, // 2: user.v1.LoginResponse.base:type_name -> common.v1.BaseResponse
 	6,  // 3: user.v1.LoginResponse.data:type_name -> user.v1.LoginData
-	29, // 4: user.v1.GetUserResponse.base:type_name -> common.v1.BaseResponse
-	9,  // 5: user.v1.GetUserResponse.data:type_name -> user.v1.GetUserData
-	30, // 6: user.v1.GetUserData.user:type_name -> common.v1.User
-	29, // 7: user.v1.RelationActionResponse.base:type_name -> common.v1.BaseResponse
-	29, // 8: user.v1.GetFollowListResponse.base:type_name -> common.v1.BaseResponse
-	14, // 9: user.v1.GetFollowListResponse.data:type_name -> user.v1.GetFollowListData
-	30, // 10: user.v1.GetFollowListData.user_list:type_name -> common.v1.User
-	29, // 11: user.v1.GetFollowerListResponse.base:type_name -> common.v1.BaseResponse
-	17, // 12: user.v1.GetFollowerListResponse.data:type_name -> user.v1.GetFollowerListData
-	30, // 13: user.v1.GetFollowerListData.user_list:type_name -> common.v1.User
-	29, // 14: user.v1.GetFriendListResponse.base:type_name -> common.v1.BaseResponse
-	20, // 15: user.v1.GetFriendListResponse.data:type_name -> user.v1.GetFriendListData
-	21, // 16: user.v1.GetFriendListData.user_list:type_name -> user.v1.FriendUser
-	30, // 17: user.v1.GetUserInfoResponse.user:type_name -> common.v1.User
-	30, // 18: user.v1.GetUsersInfoResponse.users:type_name -> common.v1.User
-	0,  // 19: user.v1.UpdateUserStatsRequest.type:type_name -> user.v1.UpdateStatsType
-	1,  // 20: user.v1.UserService.Register:input_type -> user.v1.RegisterRequest
-	4,  // 21: user.v1.UserService.Login:input_type -> user.v1.LoginRequest
-	7,  // 22: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	10, // 23: user.v1.UserService.RelationAction:input_type -> user.v1.RelationActionRequest
-	12, // 24: user.v1.UserService.GetFollowList:input_type -> user.v1.GetFollowListRequest
-	15, // 25: user.v1.UserService.GetFollowerList:input_type -> user.v1.GetFollowerListRequest
-	18, // 26: user.v1.UserService.GetFriendList:input_type -> user.v1.GetFriendListRequest
-	22, // 27: user.v1.UserService.GetUserInfo:input_type -> user.v1.GetUserInfoRequest
-	24, // 28: user.v1.UserService.GetUsersInfo:input_type -> user.v1.GetUsersInfoRequest
-	26, // 29: user.v1.UserService.VerifyToken:input_type -> user.v1.VerifyTokenRequest
-	28, // 30: user.v1.UserService.UpdateUserStats:input_type -> user.v1.UpdateUserStatsRequest
-	2,  // 31: user.v1.UserService.Register:output_type -> user.v1.RegisterResponse
-	5,  // 32: user.v1.UserService.Login:output_type -> user.v1.LoginResponse
-	8,  // 33: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	11, // 34: user.v1.UserService.RelationAction:output_type -> user.v1.RelationActionResponse
-	13, // 35: user.v1.UserService.GetFollowList:output_type -> user.v1.GetFollowListResponse
-	16, // 36: user.v1.UserService.GetFollowerList:output_type -> user.v1.GetFollowerListResponse
-	19, // 37: user.v1.UserService.GetFriendList:output_type -> user.v1.GetFriendListResponse
-	23, // 38: user.v1.UserService.GetUserInfo:output_type -> user.v1.GetUserInfoResponse
-	25, // 39: user.v1.UserService.GetUsersInfo:output_type -> user.v1.GetUsersInfoResponse
-	27, // 40: user.v1.UserService.VerifyToken:output_type -> user.v1.VerifyTokenResponse
-	31, // 41: user.v1.UserService.UpdateUserStats:output_type -> google.protobuf.Empty
-	31, // [31:42] is the sub-list for method output_type
-	20, // [20:31] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	83, // 4: user.v1.LoginWithOAuthResponse.base:type_name -> common.v1.BaseResponse
+	6,  // 5: user.v1.LoginWithOAuthResponse.data:type_name -> user.v1.LoginData
+	83, // 6: user.v1.SendVerificationCodeResponse.base:type_name -> common.v1.BaseResponse
+	83, // 7: user.v1.LoginWithCodeResponse.base:type_name -> common.v1.BaseResponse
+	6,  // 8: user.v1.LoginWithCodeResponse.data:type_name -> user.v1.LoginData
+	83, // 9: user.v1.LogoutResponse.base:type_name -> common.v1.BaseResponse
+	83, // 10: user.v1.DeleteAccountResponse.base:type_name -> common.v1.BaseResponse
+	83, // 11: user.v1.ExportMyDataResponse.base:type_name -> common.v1.BaseResponse
+	83, // 12: user.v1.GetUserResponse.base:type_name -> common.v1.BaseResponse
+	22, // 13: user.v1.GetUserResponse.data:type_name -> user.v1.GetUserData
+	84, // 14: user.v1.GetUserData.user:type_name -> common.v1.User
+	83, // 15: user.v1.UpdateTimezoneResponse.base:type_name -> common.v1.BaseResponse
+	83, // 16: user.v1.UpdatePrivacyResponse.base:type_name -> common.v1.BaseResponse
+	83, // 17: user.v1.GetProfilePageResponse.base:type_name -> common.v1.BaseResponse
+	84, // 18: user.v1.GetProfilePageResponse.user:type_name -> common.v1.User
+	85, // 19: user.v1.GetProfilePageResponse.pinned_videos:type_name -> common.v1.Video
+	85, // 20: user.v1.GetProfilePageResponse.recent_videos:type_name -> common.v1.Video
+	83, // 21: user.v1.UpdateProfileResponse.base:type_name -> common.v1.BaseResponse
+	84, // 22: user.v1.UpdateProfileResponse.user:type_name -> common.v1.User
+	83, // 23: user.v1.ChangePasswordResponse.base:type_name -> common.v1.BaseResponse
+	83, // 24: user.v1.UploadProfileImageResponse.base:type_name -> common.v1.BaseResponse
+	84, // 25: user.v1.UploadProfileImageResponse.user:type_name -> common.v1.User
+	83, // 26: user.v1.RequestPasswordResetResponse.base:type_name -> common.v1.BaseResponse
+	83, // 27: user.v1.ResetPasswordResponse.base:type_name -> common.v1.BaseResponse
+	83, // 28: user.v1.BindEmailResponse.base:type_name -> common.v1.BaseResponse
+	83, // 29: user.v1.GetUserShareCardResponse.base:type_name -> common.v1.BaseResponse
+	83, // 30: user.v1.GetMyQuotaResponse.base:type_name -> common.v1.BaseResponse
+	46, // 31: user.v1.GetMyQuotaResponse.data:type_name -> user.v1.QuotaData
+	45, // 32: user.v1.QuotaData.rate_limits:type_name -> user.v1.RateLimitBucket
+	83, // 33: user.v1.GetCreatorAnalyticsResponse.base:type_name -> common.v1.BaseResponse
+	48, // 34: user.v1.GetCreatorAnalyticsResponse.days:type_name -> user.v1.CreatorDailyStats
+	83, // 35: user.v1.VerifyEmailResponse.base:type_name -> common.v1.BaseResponse
+	83, // 36: user.v1.RelationActionResponse.base:type_name -> common.v1.BaseResponse
+	83, // 37: user.v1.ListFollowRequestsResponse.base:type_name -> common.v1.BaseResponse
+	56, // 38: user.v1.ListFollowRequestsResponse.request_list:type_name -> user.v1.FollowRequest
+	84, // 39: user.v1.FollowRequest.user:type_name -> common.v1.User
+	83, // 40: user.v1.HandleFollowRequestResponse.base:type_name -> common.v1.BaseResponse
+	83, // 41: user.v1.GetFollowListResponse.base:type_name -> common.v1.BaseResponse
+	61, // 42: user.v1.GetFollowListResponse.data:type_name -> user.v1.GetFollowListData
+	84, // 43: user.v1.GetFollowListData.user_list:type_name -> common.v1.User
+	83, // 44: user.v1.GetFollowerListResponse.base:type_name -> common.v1.BaseResponse
+	64, // 45: user.v1.GetFollowerListResponse.data:type_name -> user.v1.GetFollowerListData
+	84, // 46: user.v1.GetFollowerListData.user_list:type_name -> common.v1.User
+	83, // 47: user.v1.GetFriendListResponse.base:type_name -> common.v1.BaseResponse
+	67, // 48: user.v1.GetFriendListResponse.data:type_name -> user.v1.GetFriendListData
+	68, // 49: user.v1.GetFriendListData.user_list:type_name -> user.v1.FriendUser
+	83, // 50: user.v1.GetOnlineFriendsResponse.base:type_name -> common.v1.BaseResponse
+	84, // 51: user.v1.GetOnlineFriendsResponse.user_list:type_name -> common.v1.User
+	83, // 52: user.v1.GetLoginHistoryResponse.base:type_name -> common.v1.BaseResponse
+	75, // 53: user.v1.GetLoginHistoryResponse.records:type_name -> user.v1.LoginRecord
+	83, // 54: user.v1.PingResponse.base:type_name -> common.v1.BaseResponse
+	84, // 55: user.v1.GetUserInfoResponse.user:type_name -> common.v1.User
+	84, // 56: user.v1.GetUsersInfoResponse.users:type_name -> common.v1.User
+	0,  // 57: user.v1.UpdateUserStatsRequest.type:type_name -> user.v1.UpdateStatsType
+	1,  // 58: user.v1.UserService.Register:input_type -> user.v1.RegisterRequest
+	4,  // 59: user.v1.UserService.Login:input_type -> user.v1.LoginRequest
+	7,  // 60: user.v1.UserService.LoginWithOAuth:input_type -> user.v1.LoginWithOAuthRequest
+	9,  // 61: user.v1.UserService.SendVerificationCode:input_type -> user.v1.SendVerificationCodeRequest
+	11, // 62: user.v1.UserService.LoginWithCode:input_type -> user.v1.LoginWithCodeRequest
+	13, // 63: user.v1.UserService.Logout:input_type -> user.v1.LogoutRequest
+	15, // 64: user.v1.UserService.DeleteAccount:input_type -> user.v1.DeleteAccountRequest
+	17, // 65: user.v1.UserService.RestoreAccount:input_type -> user.v1.RestoreAccountRequest
+	18, // 66: user.v1.UserService.ExportMyData:input_type -> user.v1.ExportMyDataRequest
+	20, // 67: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	52, // 68: user.v1.UserService.RelationAction:input_type -> user.v1.RelationActionRequest
+	59, // 69: user.v1.UserService.GetFollowList:input_type -> user.v1.GetFollowListRequest
+	62, // 70: user.v1.UserService.GetFollowerList:input_type -> user.v1.GetFollowerListRequest
+	65, // 71: user.v1.UserService.GetFriendList:input_type -> user.v1.GetFriendListRequest
+	69, // 72: user.v1.UserService.GetOnlineFriends:input_type -> user.v1.GetOnlineFriendsRequest
+	27, // 73: user.v1.UserService.GetProfilePage:input_type -> user.v1.GetProfilePageRequest
+	23, // 74: user.v1.UserService.UpdateTimezone:input_type -> user.v1.UpdateTimezoneRequest
+	25, // 75: user.v1.UserService.UpdatePrivacy:input_type -> user.v1.UpdatePrivacyRequest
+	54, // 76: user.v1.UserService.ListFollowRequests:input_type -> user.v1.ListFollowRequestsRequest
+	57, // 77: user.v1.UserService.ApproveFollowRequest:input_type -> user.v1.HandleFollowRequestRequest
+	57, // 78: user.v1.UserService.RejectFollowRequest:input_type -> user.v1.HandleFollowRequestRequest
+	29, // 79: user.v1.UserService.UpdateProfile:input_type -> user.v1.UpdateProfileRequest
+	31, // 80: user.v1.UserService.ChangePassword:input_type -> user.v1.ChangePasswordRequest
+	33, // 81: user.v1.UserService.UploadAvatar:input_type -> user.v1.UploadProfileImageRequest
+	33, // 82: user.v1.UserService.UploadBackgroundImage:input_type -> user.v1.UploadProfileImageRequest
+	35, // 83: user.v1.UserService.RequestPasswordReset:input_type -> user.v1.RequestPasswordResetRequest
+	37, // 84: user.v1.UserService.ResetPassword:input_type -> user.v1.ResetPasswordRequest
+	39, // 85: user.v1.UserService.BindEmail:input_type -> user.v1.BindEmailRequest
+	50, // 86: user.v1.UserService.VerifyEmail:input_type -> user.v1.VerifyEmailRequest
+	41, // 87: user.v1.UserService.GetUserShareCard:input_type -> user.v1.GetUserShareCardRequest
+	43, // 88: user.v1.UserService.GetMyQuota:input_type -> user.v1.GetMyQuotaRequest
+	47, // 89: user.v1.UserService.GetCreatorAnalytics:input_type -> user.v1.GetCreatorAnalyticsRequest
+	71, // 90: user.v1.UserService.GetLoginHistory:input_type -> user.v1.GetLoginHistoryRequest
+	73, // 91: user.v1.UserService.Ping:input_type -> user.v1.PingRequest
+	76, // 92: user.v1.UserService.GetUserInfo:input_type -> user.v1.GetUserInfoRequest
+	78, // 93: user.v1.UserService.GetUsersInfo:input_type -> user.v1.GetUsersInfoRequest
+	80, // 94: user.v1.UserService.VerifyToken:input_type -> user.v1.VerifyTokenRequest
+	82, // 95: user.v1.UserService.UpdateUserStats:input_type -> user.v1.UpdateUserStatsRequest
+	2,  // 96: user.v1.UserService.Register:output_type -> user.v1.RegisterResponse
+	5,  // 97: user.v1.UserService.Login:output_type -> user.v1.LoginResponse
+	8,  // 98: user.v1.UserService.LoginWithOAuth:output_type -> user.v1.LoginWithOAuthResponse
+	10, // 99: user.v1.UserService.SendVerificationCode:output_type -> user.v1.SendVerificationCodeResponse
+	12, // 100: user.v1.UserService.LoginWithCode:output_type -> user.v1.LoginWithCodeResponse
+	14, // 101: user.v1.UserService.Logout:output_type -> user.v1.LogoutResponse
+	16, // 102: user.v1.UserService.DeleteAccount:output_type -> user.v1.DeleteAccountResponse
+	5,  // 103: user.v1.UserService.RestoreAccount:output_type -> user.v1.LoginResponse
+	19, // 104: user.v1.UserService.ExportMyData:output_type -> user.v1.ExportMyDataResponse
+	21, // 105: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	53, // 106: user.v1.UserService.RelationAction:output_type -> user.v1.RelationActionResponse
+	60, // 107: user.v1.UserService.GetFollowList:output_type -> user.v1.GetFollowListResponse
+	63, // 108: user.v1.UserService.GetFollowerList:output_type -> user.v1.GetFollowerListResponse
+	66, // 109: user.v1.UserService.GetFriendList:output_type -> user.v1.GetFriendListResponse
+	70, // 110: user.v1.UserService.GetOnlineFriends:output_type -> user.v1.GetOnlineFriendsResponse
+	28, // 111: user.v1.UserService.GetProfilePage:output_type -> user.v1.GetProfilePageResponse
+	24, // 112: user.v1.UserService.UpdateTimezone:output_type -> user.v1.UpdateTimezoneResponse
+	26, // 113: user.v1.UserService.UpdatePrivacy:output_type -> user.v1.UpdatePrivacyResponse
+	55, // 114: user.v1.UserService.ListFollowRequests:output_type -> user.v1.ListFollowRequestsResponse
+	58, // 115: user.v1.UserService.ApproveFollowRequest:output_type -> user.v1.HandleFollowRequestResponse
+	58, // 116: user.v1.UserService.RejectFollowRequest:output_type -> user.v1.HandleFollowRequestResponse
+	30, // 117: user.v1.UserService.UpdateProfile:output_type -> user.v1.UpdateProfileResponse
+	32, // 118: user.v1.UserService.ChangePassword:output_type -> user.v1.ChangePasswordResponse
+	34, // 119: user.v1.UserService.UploadAvatar:output_type -> user.v1.UploadProfileImageResponse
+	34, // 120: user.v1.UserService.UploadBackgroundImage:output_type -> user.v1.UploadProfileImageResponse
+	36, // 121: user.v1.UserService.RequestPasswordReset:output_type -> user.v1.RequestPasswordResetResponse
+	38, // 122: user.v1.UserService.ResetPassword:output_type -> user.v1.ResetPasswordResponse
+	40, // 123: user.v1.UserService.BindEmail:output_type -> user.v1.BindEmailResponse
+	51, // 124: user.v1.UserService.VerifyEmail:output_type -> user.v1.VerifyEmailResponse
+	42, // 125: user.v1.UserService.GetUserShareCard:output_type -> user.v1.GetUserShareCardResponse
+	44, // 126: user.v1.UserService.GetMyQuota:output_type -> user.v1.GetMyQuotaResponse
+	49, // 127: user.v1.UserService.GetCreatorAnalytics:output_type -> user.v1.GetCreatorAnalyticsResponse
+	72, // 128: user.v1.UserService.GetLoginHistory:output_type -> user.v1.GetLoginHistoryResponse
+	74, // 129: user.v1.UserService.Ping:output_type -> user.v1.PingResponse
+	77, // 130: user.v1.UserService.GetUserInfo:output_type -> user.v1.GetUserInfoResponse
+	79, // 131: user.v1.UserService.GetUsersInfo:output_type -> user.v1.GetUsersInfoResponse
+	81, // 132: user.v1.UserService.VerifyToken:output_type -> user.v1.VerifyTokenResponse
+	86, // 133: user.v1.UserService.UpdateUserStats:output_type -> google.protobuf.Empty
+	96, // [96:134] is the sub-list for method output_type
+	58, // [58:96] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -1820,7 +5353,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   28,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,40 +19,148 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserServiceApproveFollowRequest = "/user.v1.UserService/ApproveFollowRequest"
+const OperationUserServiceBindEmail = "/user.v1.UserService/BindEmail"
+const OperationUserServiceChangePassword = "/user.v1.UserService/ChangePassword"
+const OperationUserServiceDeleteAccount = "/user.v1.UserService/DeleteAccount"
+const OperationUserServiceExportMyData = "/user.v1.UserService/ExportMyData"
+const OperationUserServiceGetCreatorAnalytics = "/user.v1.UserService/GetCreatorAnalytics"
 const OperationUserServiceGetFollowList = "/user.v1.UserService/GetFollowList"
 const OperationUserServiceGetFollowerList = "/user.v1.UserService/GetFollowerList"
 const OperationUserServiceGetFriendList = "/user.v1.UserService/GetFriendList"
+const OperationUserServiceGetLoginHistory = "/user.v1.UserService/GetLoginHistory"
+const OperationUserServiceGetMyQuota = "/user.v1.UserService/GetMyQuota"
+const OperationUserServiceGetOnlineFriends = "/user.v1.UserService/GetOnlineFriends"
+const OperationUserServiceGetProfilePage = "/user.v1.UserService/GetProfilePage"
 const OperationUserServiceGetUser = "/user.v1.UserService/GetUser"
+const OperationUserServiceGetUserShareCard = "/user.v1.UserService/GetUserShareCard"
+const OperationUserServiceListFollowRequests = "/user.v1.UserService/ListFollowRequests"
 const OperationUserServiceLogin = "/user.v1.UserService/Login"
+const OperationUserServiceLoginWithCode = "/user.v1.UserService/LoginWithCode"
+const OperationUserServiceLoginWithOAuth = "/user.v1.UserService/LoginWithOAuth"
+const OperationUserServiceLogout = "/user.v1.UserService/Logout"
+const OperationUserServicePing = "/user.v1.UserService/Ping"
 const OperationUserServiceRegister = "/user.v1.UserService/Register"
+const OperationUserServiceRejectFollowRequest = "/user.v1.UserService/RejectFollowRequest"
 const OperationUserServiceRelationAction = "/user.v1.UserService/RelationAction"
+const OperationUserServiceRequestPasswordReset = "/user.v1.UserService/RequestPasswordReset"
+const OperationUserServiceResetPassword = "/user.v1.UserService/ResetPassword"
+const OperationUserServiceRestoreAccount = "/user.v1.UserService/RestoreAccount"
+const OperationUserServiceSendVerificationCode = "/user.v1.UserService/SendVerificationCode"
+const OperationUserServiceUpdatePrivacy = "/user.v1.UserService/UpdatePrivacy"
+const OperationUserServiceUpdateProfile = "/user.v1.UserService/UpdateProfile"
+const OperationUserServiceUpdateTimezone = "/user.v1.UserService/UpdateTimezone"
+const OperationUserServiceUploadAvatar = "/user.v1.UserService/UploadAvatar"
+const OperationUserServiceUploadBackgroundImage = "/user.v1.UserService/UploadBackgroundImage"
+const OperationUserServiceVerifyEmail = "/user.v1.UserService/VerifyEmail"
 
 type UserServiceHTTPServer interface {
+	// ApproveFollowRequest 通过关注申请，申请人成为粉丝
+	ApproveFollowRequest(context.Context, *HandleFollowRequestRequest) (*HandleFollowRequestResponse, error)
+	// BindEmail 绑定邮箱，向邮箱发送验证码，验证通过后生效
+	BindEmail(context.Context, *BindEmailRequest) (*BindEmailResponse, error)
+	// ChangePassword 修改密码，成功后撤销该用户的所有会话，需要重新登录
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// DeleteAccount 注销账号，进入冷静期，期内可通过 RestoreAccount 恢复
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// ExportMyData 导出个人数据，返回限时下载链接
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataResponse, error)
+	// GetCreatorAnalytics 获取当前用户的创作者数据，按天返回粉丝、播放和获赞变化
+	GetCreatorAnalytics(context.Context, *GetCreatorAnalyticsRequest) (*GetCreatorAnalyticsResponse, error)
 	// GetFollowList 获取关注列表
 	GetFollowList(context.Context, *GetFollowListRequest) (*GetFollowListResponse, error)
 	// GetFollowerList 获取粉丝列表
 	GetFollowerList(context.Context, *GetFollowerListRequest) (*GetFollowerListResponse, error)
 	// GetFriendList 获取好友列表
 	GetFriendList(context.Context, *GetFriendListRequest) (*GetFriendListResponse, error)
+	// GetLoginHistory 获取当前用户的登录记录，包括被判定为异常的登录
+	GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error)
+	// GetMyQuota 获取当前用户的限流和上传配额用量
+	GetMyQuota(context.Context, *GetMyQuotaRequest) (*GetMyQuotaResponse, error)
+	// GetOnlineFriends 获取当前在线的好友
+	GetOnlineFriends(context.Context, *GetOnlineFriendsRequest) (*GetOnlineFriendsResponse, error)
+	// GetProfilePage 获取个人主页，包括资料、计数、置顶作品和最近作品
+	GetProfilePage(context.Context, *GetProfilePageRequest) (*GetProfilePageResponse, error)
 	// GetUser 获取用户信息
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// GetUserShareCard 获取用户主页分享卡片
+	GetUserShareCard(context.Context, *GetUserShareCardRequest) (*GetUserShareCardResponse, error)
+	// ListFollowRequests 获取收到的待处理关注申请
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error)
 	// Login 用户登录
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// LoginWithCode 验证码登录，无需密码
+	LoginWithCode(context.Context, *LoginWithCodeRequest) (*LoginWithCodeResponse, error)
+	// LoginWithOAuth 第三方登录，用授权码换取第三方账号身份，关联或创建本地用户后签发Token
+	LoginWithOAuth(context.Context, *LoginWithOAuthRequest) (*LoginWithOAuthResponse, error)
+	// Logout 用户登出
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// Ping 在线心跳，客户端在前台时定期调用以保持在线状态
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	// Register 用户注册
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// RejectFollowRequest 拒绝关注申请
+	RejectFollowRequest(context.Context, *HandleFollowRequestRequest) (*HandleFollowRequestResponse, error)
 	// RelationAction 关注操作
 	RelationAction(context.Context, *RelationActionRequest) (*RelationActionResponse, error)
+	// RequestPasswordReset 申请重置密码，无论用户是否存在均返回成功
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	// ResetPassword 使用重置Token设置新密码，成功后撤销该用户的所有会话
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	// RestoreAccount 撤销注销并登录
+	RestoreAccount(context.Context, *RestoreAccountRequest) (*LoginResponse, error)
+	// SendVerificationCode 发送登录验证码，账号需已绑定该邮箱或手机号
+	SendVerificationCode(context.Context, *SendVerificationCodeRequest) (*SendVerificationCodeResponse, error)
+	// UpdatePrivacy 设置私密账号，私密账号的关注需要本人通过
+	UpdatePrivacy(context.Context, *UpdatePrivacyRequest) (*UpdatePrivacyResponse, error)
+	// UpdateProfile 更新个人资料，未传的字段保持不变
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// UpdateTimezone 更新时区偏好
+	UpdateTimezone(context.Context, *UpdateTimezoneRequest) (*UpdateTimezoneResponse, error)
+	// UploadAvatar 上传头像，支持 multipart/form-data 的 data 文件字段，裁剪为正方形后保存
+	UploadAvatar(context.Context, *UploadProfileImageRequest) (*UploadProfileImageResponse, error)
+	// UploadBackgroundImage 上传主页背景图，等比缩放后保存
+	UploadBackgroundImage(context.Context, *UploadProfileImageRequest) (*UploadProfileImageResponse, error)
+	// VerifyEmail 校验邮箱验证码并完成绑定
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
 }
 
 func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/douyin/user/register", _UserService_Register0_HTTP_Handler(srv))
 	r.POST("/douyin/user/login", _UserService_Login0_HTTP_Handler(srv))
+	r.POST("/douyin/user/oauth/login", _UserService_LoginWithOAuth0_HTTP_Handler(srv))
+	r.POST("/douyin/user/code/send", _UserService_SendVerificationCode0_HTTP_Handler(srv))
+	r.POST("/douyin/user/code/login", _UserService_LoginWithCode0_HTTP_Handler(srv))
+	r.POST("/douyin/user/logout", _UserService_Logout0_HTTP_Handler(srv))
+	r.POST("/douyin/user/delete", _UserService_DeleteAccount0_HTTP_Handler(srv))
+	r.POST("/douyin/user/restore", _UserService_RestoreAccount0_HTTP_Handler(srv))
+	r.POST("/douyin/user/data/export", _UserService_ExportMyData0_HTTP_Handler(srv))
 	r.GET("/douyin/user", _UserService_GetUser0_HTTP_Handler(srv))
 	r.POST("/douyin/relation/action", _UserService_RelationAction0_HTTP_Handler(srv))
 	r.GET("/douyin/relation/follow/list", _UserService_GetFollowList0_HTTP_Handler(srv))
 	r.GET("/douyin/relation/follower/list", _UserService_GetFollowerList0_HTTP_Handler(srv))
 	r.GET("/douyin/relation/friend/list", _UserService_GetFriendList0_HTTP_Handler(srv))
+	r.GET("/douyin/relation/friend/online", _UserService_GetOnlineFriends0_HTTP_Handler(srv))
+	r.GET("/douyin/user/profile", _UserService_GetProfilePage0_HTTP_Handler(srv))
+	r.POST("/douyin/user/timezone", _UserService_UpdateTimezone0_HTTP_Handler(srv))
+	r.POST("/douyin/user/privacy", _UserService_UpdatePrivacy0_HTTP_Handler(srv))
+	r.GET("/douyin/relation/request/list", _UserService_ListFollowRequests0_HTTP_Handler(srv))
+	r.POST("/douyin/relation/request/approve", _UserService_ApproveFollowRequest0_HTTP_Handler(srv))
+	r.POST("/douyin/relation/request/reject", _UserService_RejectFollowRequest0_HTTP_Handler(srv))
+	r.POST("/douyin/user/profile/update", _UserService_UpdateProfile0_HTTP_Handler(srv))
+	r.POST("/douyin/user/password/change", _UserService_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/douyin/user/avatar/upload", _UserService_UploadAvatar0_HTTP_Handler(srv))
+	r.POST("/douyin/user/background/upload", _UserService_UploadBackgroundImage0_HTTP_Handler(srv))
+	r.POST("/douyin/user/password/reset/request", _UserService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/douyin/user/password/reset", _UserService_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/douyin/user/email/bind", _UserService_BindEmail0_HTTP_Handler(srv))
+	r.POST("/douyin/user/email/verify", _UserService_VerifyEmail0_HTTP_Handler(srv))
+	r.GET("/douyin/user/share/card", _UserService_GetUserShareCard0_HTTP_Handler(srv))
+	r.GET("/douyin/user/quota", _UserService_GetMyQuota0_HTTP_Handler(srv))
+	r.GET("/douyin/user/analytics", _UserService_GetCreatorAnalytics0_HTTP_Handler(srv))
+	r.GET("/douyin/user/login/history", _UserService_GetLoginHistory0_HTTP_Handler(srv))
+	r.POST("/douyin/user/ping", _UserService_Ping0_HTTP_Handler(srv))
 }
 
 func _UserService_Register0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
@@ -99,6 +207,160 @@ func _UserService_Login0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.C
 	}
 }
 
+func _UserService_LoginWithOAuth0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithOAuthRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceLoginWithOAuth)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithOAuth(ctx, req.(*LoginWithOAuthRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginWithOAuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_SendVerificationCode0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendVerificationCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceSendVerificationCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendVerificationCode(ctx, req.(*SendVerificationCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendVerificationCodeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_LoginWithCode0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceLoginWithCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithCode(ctx, req.(*LoginWithCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginWithCodeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_Logout0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_DeleteAccount0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAccountRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceDeleteAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAccount(ctx, req.(*DeleteAccountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAccountResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RestoreAccount0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreAccountRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRestoreAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreAccount(ctx, req.(*RestoreAccountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ExportMyData0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportMyDataRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceExportMyData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportMyData(ctx, req.(*ExportMyDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportMyDataResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserService_GetUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetUserRequest
@@ -161,50 +423,496 @@ func _UserService_GetFollowList0_HTTP_Handler(srv UserServiceHTTPServer) func(ct
 
 func _UserService_GetFollowerList0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetFollowerListRequest
+		var in GetFollowerListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetFollowerList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFollowerList(ctx, req.(*GetFollowerListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetFollowerListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetFriendList0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetFriendListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetFriendList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFriendList(ctx, req.(*GetFriendListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetFriendListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetOnlineFriends0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOnlineFriendsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetOnlineFriends)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOnlineFriends(ctx, req.(*GetOnlineFriendsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetOnlineFriendsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetProfilePage0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetProfilePageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetProfilePage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProfilePage(ctx, req.(*GetProfilePageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetProfilePageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UpdateTimezone0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTimezoneRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUpdateTimezone)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTimezone(ctx, req.(*UpdateTimezoneRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTimezoneResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UpdatePrivacy0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePrivacyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUpdatePrivacy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePrivacy(ctx, req.(*UpdatePrivacyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdatePrivacyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ListFollowRequests0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceListFollowRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ApproveFollowRequest0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HandleFollowRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceApproveFollowRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveFollowRequest(ctx, req.(*HandleFollowRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HandleFollowRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RejectFollowRequest0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HandleFollowRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRejectFollowRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectFollowRequest(ctx, req.(*HandleFollowRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HandleFollowRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UpdateProfile0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateProfileRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUpdateProfile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateProfile(ctx, req.(*UpdateProfileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ChangePassword0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePasswordResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UploadAvatar0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UploadProfileImageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUploadAvatar)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UploadAvatar(ctx, req.(*UploadProfileImageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UploadProfileImageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UploadBackgroundImage0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UploadProfileImageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUploadBackgroundImage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UploadBackgroundImage(ctx, req.(*UploadProfileImageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UploadProfileImageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RequestPasswordReset0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestPasswordResetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ResetPassword0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_BindEmail0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BindEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceBindEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BindEmail(ctx, req.(*BindEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BindEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_VerifyEmail0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceVerifyEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetUserShareCard0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserShareCardRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetUserShareCard)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserShareCard(ctx, req.(*GetUserShareCardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUserShareCardResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetMyQuota0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMyQuotaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetMyQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMyQuota(ctx, req.(*GetMyQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMyQuotaResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetCreatorAnalytics0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCreatorAnalyticsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetCreatorAnalytics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCreatorAnalytics(ctx, req.(*GetCreatorAnalyticsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCreatorAnalyticsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetLoginHistory0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetLoginHistoryRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserServiceGetFollowerList)
+		http.SetOperation(ctx, OperationUserServiceGetLoginHistory)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetFollowerList(ctx, req.(*GetFollowerListRequest))
+			return srv.GetLoginHistory(ctx, req.(*GetLoginHistoryRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetFollowerListResponse)
+		reply := out.(*GetLoginHistoryResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserService_GetFriendList0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Ping0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetFriendListRequest
+		var in PingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserServiceGetFriendList)
+		http.SetOperation(ctx, OperationUserServicePing)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetFriendList(ctx, req.(*GetFriendListRequest))
+			return srv.Ping(ctx, req.(*PingRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetFriendListResponse)
+		reply := out.(*PingResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
 type UserServiceHTTPClient interface {
+	ApproveFollowRequest(ctx context.Context, req *HandleFollowRequestRequest, opts ...http.CallOption) (rsp *HandleFollowRequestResponse, err error)
+	BindEmail(ctx context.Context, req *BindEmailRequest, opts ...http.CallOption) (rsp *BindEmailResponse, err error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordResponse, err error)
+	DeleteAccount(ctx context.Context, req *DeleteAccountRequest, opts ...http.CallOption) (rsp *DeleteAccountResponse, err error)
+	ExportMyData(ctx context.Context, req *ExportMyDataRequest, opts ...http.CallOption) (rsp *ExportMyDataResponse, err error)
+	GetCreatorAnalytics(ctx context.Context, req *GetCreatorAnalyticsRequest, opts ...http.CallOption) (rsp *GetCreatorAnalyticsResponse, err error)
 	GetFollowList(ctx context.Context, req *GetFollowListRequest, opts ...http.CallOption) (rsp *GetFollowListResponse, err error)
 	GetFollowerList(ctx context.Context, req *GetFollowerListRequest, opts ...http.CallOption) (rsp *GetFollowerListResponse, err error)
 	GetFriendList(ctx context.Context, req *GetFriendListRequest, opts ...http.CallOption) (rsp *GetFriendListResponse, err error)
+	GetLoginHistory(ctx context.Context, req *GetLoginHistoryRequest, opts ...http.CallOption) (rsp *GetLoginHistoryResponse, err error)
+	GetMyQuota(ctx context.Context, req *GetMyQuotaRequest, opts ...http.CallOption) (rsp *GetMyQuotaResponse, err error)
+	GetOnlineFriends(ctx context.Context, req *GetOnlineFriendsRequest, opts ...http.CallOption) (rsp *GetOnlineFriendsResponse, err error)
+	GetProfilePage(ctx context.Context, req *GetProfilePageRequest, opts ...http.CallOption) (rsp *GetProfilePageResponse, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserResponse, err error)
+	GetUserShareCard(ctx context.Context, req *GetUserShareCardRequest, opts ...http.CallOption) (rsp *GetUserShareCardResponse, err error)
+	ListFollowRequests(ctx context.Context, req *ListFollowRequestsRequest, opts ...http.CallOption) (rsp *ListFollowRequestsResponse, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	LoginWithCode(ctx context.Context, req *LoginWithCodeRequest, opts ...http.CallOption) (rsp *LoginWithCodeResponse, err error)
+	LoginWithOAuth(ctx context.Context, req *LoginWithOAuthRequest, opts ...http.CallOption) (rsp *LoginWithOAuthResponse, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutResponse, err error)
+	Ping(ctx context.Context, req *PingRequest, opts ...http.CallOption) (rsp *PingResponse, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterResponse, err error)
+	RejectFollowRequest(ctx context.Context, req *HandleFollowRequestRequest, opts ...http.CallOption) (rsp *HandleFollowRequestResponse, err error)
 	RelationAction(ctx context.Context, req *RelationActionRequest, opts ...http.CallOption) (rsp *RelationActionResponse, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *RequestPasswordResetResponse, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordResponse, err error)
+	RestoreAccount(ctx context.Context, req *RestoreAccountRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	SendVerificationCode(ctx context.Context, req *SendVerificationCodeRequest, opts ...http.CallOption) (rsp *SendVerificationCodeResponse, err error)
+	UpdatePrivacy(ctx context.Context, req *UpdatePrivacyRequest, opts ...http.CallOption) (rsp *UpdatePrivacyResponse, err error)
+	UpdateProfile(ctx context.Context, req *UpdateProfileRequest, opts ...http.CallOption) (rsp *UpdateProfileResponse, err error)
+	UpdateTimezone(ctx context.Context, req *UpdateTimezoneRequest, opts ...http.CallOption) (rsp *UpdateTimezoneResponse, err error)
+	UploadAvatar(ctx context.Context, req *UploadProfileImageRequest, opts ...http.CallOption) (rsp *UploadProfileImageResponse, err error)
+	UploadBackgroundImage(ctx context.Context, req *UploadProfileImageRequest, opts ...http.CallOption) (rsp *UploadProfileImageResponse, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest, opts ...http.CallOption) (rsp *VerifyEmailResponse, err error)
 }
 
 type UserServiceHTTPClientImpl struct {
@@ -215,6 +923,84 @@ func NewUserServiceHTTPClient(client *http.Client) UserServiceHTTPClient {
 	return &UserServiceHTTPClientImpl{client}
 }
 
+func (c *UserServiceHTTPClientImpl) ApproveFollowRequest(ctx context.Context, in *HandleFollowRequestRequest, opts ...http.CallOption) (*HandleFollowRequestResponse, error) {
+	var out HandleFollowRequestResponse
+	pattern := "/douyin/relation/request/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceApproveFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) BindEmail(ctx context.Context, in *BindEmailRequest, opts ...http.CallOption) (*BindEmailResponse, error) {
+	var out BindEmailResponse
+	pattern := "/douyin/user/email/bind"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceBindEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*ChangePasswordResponse, error) {
+	var out ChangePasswordResponse
+	pattern := "/douyin/user/password/change"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...http.CallOption) (*DeleteAccountResponse, error) {
+	var out DeleteAccountResponse
+	pattern := "/douyin/user/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceDeleteAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...http.CallOption) (*ExportMyDataResponse, error) {
+	var out ExportMyDataResponse
+	pattern := "/douyin/user/data/export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceExportMyData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) GetCreatorAnalytics(ctx context.Context, in *GetCreatorAnalyticsRequest, opts ...http.CallOption) (*GetCreatorAnalyticsResponse, error) {
+	var out GetCreatorAnalyticsResponse
+	pattern := "/douyin/user/analytics"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetCreatorAnalytics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) GetFollowList(ctx context.Context, in *GetFollowListRequest, opts ...http.CallOption) (*GetFollowListResponse, error) {
 	var out GetFollowListResponse
 	pattern := "/douyin/relation/follow/list"
@@ -254,6 +1040,58 @@ func (c *UserServiceHTTPClientImpl) GetFriendList(ctx context.Context, in *GetFr
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...http.CallOption) (*GetLoginHistoryResponse, error) {
+	var out GetLoginHistoryResponse
+	pattern := "/douyin/user/login/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetLoginHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) GetMyQuota(ctx context.Context, in *GetMyQuotaRequest, opts ...http.CallOption) (*GetMyQuotaResponse, error) {
+	var out GetMyQuotaResponse
+	pattern := "/douyin/user/quota"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetMyQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) GetOnlineFriends(ctx context.Context, in *GetOnlineFriendsRequest, opts ...http.CallOption) (*GetOnlineFriendsResponse, error) {
+	var out GetOnlineFriendsResponse
+	pattern := "/douyin/relation/friend/online"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetOnlineFriends))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) GetProfilePage(ctx context.Context, in *GetProfilePageRequest, opts ...http.CallOption) (*GetProfilePageResponse, error) {
+	var out GetProfilePageResponse
+	pattern := "/douyin/user/profile"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetProfilePage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, opts ...http.CallOption) (*GetUserResponse, error) {
 	var out GetUserResponse
 	pattern := "/douyin/user"
@@ -267,6 +1105,32 @@ func (c *UserServiceHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequ
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) GetUserShareCard(ctx context.Context, in *GetUserShareCardRequest, opts ...http.CallOption) (*GetUserShareCardResponse, error) {
+	var out GetUserShareCardResponse
+	pattern := "/douyin/user/share/card"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetUserShareCard))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...http.CallOption) (*ListFollowRequestsResponse, error) {
+	var out ListFollowRequestsResponse
+	pattern := "/douyin/relation/request/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceListFollowRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginResponse, error) {
 	var out LoginResponse
 	pattern := "/douyin/user/login"
@@ -280,6 +1144,58 @@ func (c *UserServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest,
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) LoginWithCode(ctx context.Context, in *LoginWithCodeRequest, opts ...http.CallOption) (*LoginWithCodeResponse, error) {
+	var out LoginWithCodeResponse
+	pattern := "/douyin/user/code/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceLoginWithCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) LoginWithOAuth(ctx context.Context, in *LoginWithOAuthRequest, opts ...http.CallOption) (*LoginWithOAuthResponse, error) {
+	var out LoginWithOAuthResponse
+	pattern := "/douyin/user/oauth/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceLoginWithOAuth))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutResponse, error) {
+	var out LogoutResponse
+	pattern := "/douyin/user/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) Ping(ctx context.Context, in *PingRequest, opts ...http.CallOption) (*PingResponse, error) {
+	var out PingResponse
+	pattern := "/douyin/user/ping"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServicePing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterResponse, error) {
 	var out RegisterResponse
 	pattern := "/douyin/user/register"
@@ -293,6 +1209,19 @@ func (c *UserServiceHTTPClientImpl) Register(ctx context.Context, in *RegisterRe
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) RejectFollowRequest(ctx context.Context, in *HandleFollowRequestRequest, opts ...http.CallOption) (*HandleFollowRequestResponse, error) {
+	var out HandleFollowRequestResponse
+	pattern := "/douyin/relation/request/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRejectFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) RelationAction(ctx context.Context, in *RelationActionRequest, opts ...http.CallOption) (*RelationActionResponse, error) {
 	var out RelationActionResponse
 	pattern := "/douyin/relation/action"
@@ -305,3 +1234,133 @@ func (c *UserServiceHTTPClientImpl) RelationAction(ctx context.Context, in *Rela
 	}
 	return &out, nil
 }
+
+func (c *UserServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*RequestPasswordResetResponse, error) {
+	var out RequestPasswordResetResponse
+	pattern := "/douyin/user/password/reset/request"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordResponse, error) {
+	var out ResetPasswordResponse
+	pattern := "/douyin/user/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/douyin/user/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRestoreAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) SendVerificationCode(ctx context.Context, in *SendVerificationCodeRequest, opts ...http.CallOption) (*SendVerificationCodeResponse, error) {
+	var out SendVerificationCodeResponse
+	pattern := "/douyin/user/code/send"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceSendVerificationCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) UpdatePrivacy(ctx context.Context, in *UpdatePrivacyRequest, opts ...http.CallOption) (*UpdatePrivacyResponse, error) {
+	var out UpdatePrivacyResponse
+	pattern := "/douyin/user/privacy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUpdatePrivacy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...http.CallOption) (*UpdateProfileResponse, error) {
+	var out UpdateProfileResponse
+	pattern := "/douyin/user/profile/update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUpdateProfile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) UpdateTimezone(ctx context.Context, in *UpdateTimezoneRequest, opts ...http.CallOption) (*UpdateTimezoneResponse, error) {
+	var out UpdateTimezoneResponse
+	pattern := "/douyin/user/timezone"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUpdateTimezone))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) UploadAvatar(ctx context.Context, in *UploadProfileImageRequest, opts ...http.CallOption) (*UploadProfileImageResponse, error) {
+	var out UploadProfileImageResponse
+	pattern := "/douyin/user/avatar/upload"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUploadAvatar))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) UploadBackgroundImage(ctx context.Context, in *UploadProfileImageRequest, opts ...http.CallOption) (*UploadProfileImageResponse, error) {
+	var out UploadProfileImageResponse
+	pattern := "/douyin/user/background/upload"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUploadBackgroundImage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...http.CallOption) (*VerifyEmailResponse, error) {
+	var out VerifyEmailResponse
+	pattern := "/douyin/user/email/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceVerifyEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
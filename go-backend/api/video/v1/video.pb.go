@@ -139,8 +139,13 @@ func (UpdateVideoStatsType) EnumDescriptor() ([]byte, []int) {
 // 获取视频流请求
 type GetFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LatestTime    int64                  `protobuf:"varint,1,opt,name=latest_time,json=latestTime,proto3" json:"latest_time,omitempty"` // 时间戳，可选
+	LatestTime    int64                  `protobuf:"varint,1,opt,name=latest_time,json=latestTime,proto3" json:"latest_time,omitempty"` // 时间戳，可选，已废弃：同一秒发布的多个视频可能被跳过，请改用 cursor
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                              // 可选
+	FeedType      int32                  `protobuf:"varint,3,opt,name=feed_type,json=feedType,proto3" json:"feed_type,omitempty"`       // 0按发布时间 1按互动得分排序，可选
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                           // 按得分排序时的分页偏移，可选
+	Quality       string                 `protobuf:"bytes,5,opt,name=quality,proto3" json:"quality,omitempty"`                          // 期望清晰度，可选：480p, 720p, 1080p
+	CategoryId    int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 按分类筛选，可选
+	Cursor        string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                            // 上一页返回的 next_cursor，可选，优先于 latest_time
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,6 +194,41 @@ func (x *GetFeedRequest) GetToken() string {
 	return ""
 }
 
+func (x *GetFeedRequest) GetFeedType() int32 {
+	if x != nil {
+		return x.FeedType
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *GetFeedRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 // 获取视频流响应
 type GetFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -244,8 +284,11 @@ func (x *GetFeedResponse) GetData() *GetFeedData {
 
 type GetFeedData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NextTime      int64                  `protobuf:"varint,1,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"`
+	NextTime      int64                  `protobuf:"varint,1,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"` // 本页最后一个视频的发布时间，兼容旧版客户端
 	VideoList     []*v1.Video            `protobuf:"bytes,2,rep,name=video_list,json=videoList,proto3" json:"video_list,omitempty"`
+	NextOffset    int32                  `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"` // 按得分排序时下一页的偏移
+	NextCursor    string                 `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`  // 按发布时间排序时下一页的游标，没有更多时为空
+	HasMore       bool                   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`          // 是否还有下一页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,6 +337,27 @@ func (x *GetFeedData) GetVideoList() []*v1.Video {
 	return nil
 }
 
+func (x *GetFeedData) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *GetFeedData) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetFeedData) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 // 视频上传请求 - 支持两种方式
 type PublishVideoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -303,7 +367,10 @@ type PublishVideoRequest struct {
 	//	*PublishVideoRequest_Data
 	//	*PublishVideoRequest_FileInfo
 	DataSource    isPublishVideoRequest_DataSource `protobuf_oneof:"data_source"`
-	Title         string                           `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"` // 视频标题
+	Title         string                           `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                              // 视频标题
+	CategoryId    int64                            `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 视频分类，可选
+	Visibility    int32                            `protobuf:"varint,6,opt,name=visibility,proto3" json:"visibility,omitempty"`                   // 可见范围，可选：1公开（默认） 2仅互相关注的好友 3仅自己
+	PublishAt     int64                            `protobuf:"varint,7,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`    // 计划发布时间（Unix 秒），可选，设置时保存为草稿并在该时间发布
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -377,6 +444,27 @@ func (x *PublishVideoRequest) GetTitle() string {
 	return ""
 }
 
+func (x *PublishVideoRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *PublishVideoRequest) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *PublishVideoRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
 type isPublishVideoRequest_DataSource interface {
 	isPublishVideoRequest_DataSource()
 }
@@ -465,9 +553,12 @@ func (x *FileUploadInfo) GetUploadId() string {
 // 文件上传请求 - 专门处理multipart上传
 type UploadVideoFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // 必需
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`       // 视频标题
-	Metadata      *FileMetadata          `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // 文件元数据
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                              // 必需
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                              // 视频标题
+	Metadata      *FileMetadata          `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`                        // 文件元数据
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 视频分类，可选
+	Visibility    int32                  `protobuf:"varint,5,opt,name=visibility,proto3" json:"visibility,omitempty"`                   // 可见范围，可选：1公开（默认） 2仅互相关注的好友 3仅自己
+	PublishAt     int64                  `protobuf:"varint,6,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`    // 计划发布时间（Unix 秒），可选，设置时保存为草稿并在该时间发布
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -523,6 +614,27 @@ func (x *UploadVideoFileRequest) GetMetadata() *FileMetadata {
 	return nil
 }
 
+func (x *UploadVideoFileRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UploadVideoFileRequest) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *UploadVideoFileRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
 // 文件元数据
 type FileMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -718,6 +830,7 @@ type GetPublishListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 必需
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                  // 必需
+	Quality       string                 `protobuf:"bytes,3,opt,name=quality,proto3" json:"quality,omitempty"`              // 期望清晰度，可选：480p, 720p, 1080p
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -766,6 +879,13 @@ func (x *GetPublishListRequest) GetToken() string {
 	return ""
 }
 
+func (x *GetPublishListRequest) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
 // 获取发布列表响应
 type GetPublishListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1046,29 +1166,28 @@ func (x *UploadConfig) GetExtraConfig() map[string]string {
 	return nil
 }
 
-// 获取上传进度请求
-type GetUploadProgressRequest struct {
+// 视频分享卡片请求
+type GetVideoShareCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"` // 上传ID
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                       // 认证Token
+	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // 视频ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUploadProgressRequest) Reset() {
-	*x = GetUploadProgressRequest{}
+func (x *GetVideoShareCardRequest) Reset() {
+	*x = GetVideoShareCardRequest{}
 	mi := &file_video_v1_video_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUploadProgressRequest) String() string {
+func (x *GetVideoShareCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadProgressRequest) ProtoMessage() {}
+func (*GetVideoShareCardRequest) ProtoMessage() {}
 
-func (x *GetUploadProgressRequest) ProtoReflect() protoreflect.Message {
+func (x *GetVideoShareCardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_video_v1_video_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1080,48 +1199,41 @@ func (x *GetUploadProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadProgressRequest.ProtoReflect.Descriptor instead.
-func (*GetUploadProgressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVideoShareCardRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoShareCardRequest) Descriptor() ([]byte, []int) {
 	return file_video_v1_video_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetUploadProgressRequest) GetUploadId() string {
-	if x != nil {
-		return x.UploadId
-	}
-	return ""
-}
-
-func (x *GetUploadProgressRequest) GetToken() string {
+func (x *GetVideoShareCardRequest) GetVideoId() int64 {
 	if x != nil {
-		return x.Token
+		return x.VideoId
 	}
-	return ""
+	return 0
 }
 
-// 获取上传进度响应
-type GetUploadProgressResponse struct {
+// 视频分享卡片响应
+type GetVideoShareCardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *UploadProgress        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	CardUrl       string                 `protobuf:"bytes,2,opt,name=card_url,json=cardUrl,proto3" json:"card_url,omitempty"` // 卡片图片地址
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUploadProgressResponse) Reset() {
-	*x = GetUploadProgressResponse{}
+func (x *GetVideoShareCardResponse) Reset() {
+	*x = GetVideoShareCardResponse{}
 	mi := &file_video_v1_video_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUploadProgressResponse) String() string {
+func (x *GetVideoShareCardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadProgressResponse) ProtoMessage() {}
+func (*GetVideoShareCardResponse) ProtoMessage() {}
 
-func (x *GetUploadProgressResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVideoShareCardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_video_v1_video_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1133,53 +1245,48 @@ func (x *GetUploadProgressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadProgressResponse.ProtoReflect.Descriptor instead.
-func (*GetUploadProgressResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVideoShareCardResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoShareCardResponse) Descriptor() ([]byte, []int) {
 	return file_video_v1_video_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetUploadProgressResponse) GetBase() *v1.BaseResponse {
+func (x *GetVideoShareCardResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *GetUploadProgressResponse) GetData() *UploadProgress {
+func (x *GetVideoShareCardResponse) GetCardUrl() string {
 	if x != nil {
-		return x.Data
+		return x.CardUrl
 	}
-	return nil
+	return ""
 }
 
-// 上传进度
-type UploadProgress struct {
+// 分享视频请求
+type ShareVideoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`                 // 上传ID
-	Progress      int32                  `protobuf:"varint,2,opt,name=progress,proto3" json:"progress,omitempty"`                                // 进度百分比 (0-100)
-	Status        UploadStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=video.v1.UploadStatus" json:"status,omitempty"`         // 状态
-	TotalSize     int64                  `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`             // 总大小
-	UploadedSize  int64                  `protobuf:"varint,5,opt,name=uploaded_size,json=uploadedSize,proto3" json:"uploaded_size,omitempty"`    // 已上传大小
-	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`     // 错误信息（如果有）
-	EstimatedTime int64                  `protobuf:"varint,7,opt,name=estimated_time,json=estimatedTime,proto3" json:"estimated_time,omitempty"` // 预估剩余时间（秒）
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                     // 认证Token
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // 视频ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadProgress) Reset() {
-	*x = UploadProgress{}
+func (x *ShareVideoRequest) Reset() {
+	*x = ShareVideoRequest{}
 	mi := &file_video_v1_video_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadProgress) String() string {
+func (x *ShareVideoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadProgress) ProtoMessage() {}
+func (*ShareVideoRequest) ProtoMessage() {}
 
-func (x *UploadProgress) ProtoReflect() protoreflect.Message {
+func (x *ShareVideoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_video_v1_video_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1191,82 +1298,49 @@ func (x *UploadProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadProgress.ProtoReflect.Descriptor instead.
-func (*UploadProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareVideoRequest.ProtoReflect.Descriptor instead.
+func (*ShareVideoRequest) Descriptor() ([]byte, []int) {
 	return file_video_v1_video_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UploadProgress) GetUploadId() string {
-	if x != nil {
-		return x.UploadId
-	}
-	return ""
-}
-
-func (x *UploadProgress) GetProgress() int32 {
-	if x != nil {
-		return x.Progress
-	}
-	return 0
-}
-
-func (x *UploadProgress) GetStatus() UploadStatus {
-	if x != nil {
-		return x.Status
-	}
-	return UploadStatus_UPLOAD_STATUS_UNKNOWN
-}
-
-func (x *UploadProgress) GetTotalSize() int64 {
-	if x != nil {
-		return x.TotalSize
-	}
-	return 0
-}
-
-func (x *UploadProgress) GetUploadedSize() int64 {
-	if x != nil {
-		return x.UploadedSize
-	}
-	return 0
-}
-
-func (x *UploadProgress) GetErrorMessage() string {
+func (x *ShareVideoRequest) GetToken() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UploadProgress) GetEstimatedTime() int64 {
+func (x *ShareVideoRequest) GetVideoId() int64 {
 	if x != nil {
-		return x.EstimatedTime
+		return x.VideoId
 	}
 	return 0
 }
 
-// gRPC内部调用 - 获取视频信息请求
-type GetVideoInfoRequest struct {
+// 分享视频响应
+type ShareVideoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // 分享短链token，同一用户分享同一视频时不变
+	ShareUrl      string                 `protobuf:"bytes,3,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`       // 分享落地页地址
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideoInfoRequest) Reset() {
-	*x = GetVideoInfoRequest{}
+func (x *ShareVideoResponse) Reset() {
+	*x = ShareVideoResponse{}
 	mi := &file_video_v1_video_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoInfoRequest) String() string {
+func (x *ShareVideoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoInfoRequest) ProtoMessage() {}
+func (*ShareVideoResponse) ProtoMessage() {}
 
-func (x *GetVideoInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *ShareVideoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_video_v1_video_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1278,40 +1352,55 @@ func (x *GetVideoInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetVideoInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareVideoResponse.ProtoReflect.Descriptor instead.
+func (*ShareVideoResponse) Descriptor() ([]byte, []int) {
 	return file_video_v1_video_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetVideoInfoRequest) GetVideoId() int64 {
+func (x *ShareVideoResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
-		return x.VideoId
+		return x.Base
 	}
-	return 0
+	return nil
 }
 
-// gRPC内部调用 - 获取视频信息响应
-type GetVideoInfoResponse struct {
+func (x *ShareVideoResponse) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *ShareVideoResponse) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+// 解析分享短链请求
+type ResolveShareLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Video         *v1.Video              `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // 分享短链token
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                             // 认证Token，可选
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideoInfoResponse) Reset() {
-	*x = GetVideoInfoResponse{}
+func (x *ResolveShareLinkRequest) Reset() {
+	*x = ResolveShareLinkRequest{}
 	mi := &file_video_v1_video_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoInfoResponse) String() string {
+func (x *ResolveShareLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoInfoResponse) ProtoMessage() {}
+func (*ResolveShareLinkRequest) ProtoMessage() {}
 
-func (x *GetVideoInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *ResolveShareLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_video_v1_video_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1323,40 +1412,49 @@ func (x *GetVideoInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetVideoInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*ResolveShareLinkRequest) Descriptor() ([]byte, []int) {
 	return file_video_v1_video_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetVideoInfoResponse) GetVideo() *v1.Video {
+func (x *ResolveShareLinkRequest) GetShareToken() string {
 	if x != nil {
-		return x.Video
+		return x.ShareToken
 	}
-	return nil
+	return ""
 }
 
-// gRPC内部调用 - 批量获取视频信息请求
-type GetVideosInfoRequest struct {
+func (x *ResolveShareLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 解析分享短链响应
+type ResolveShareLinkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoIds      []int64                `protobuf:"varint,1,rep,packed,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Video         *v1.Video              `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	SharerId      int64                  `protobuf:"varint,3,opt,name=sharer_id,json=sharerId,proto3" json:"sharer_id,omitempty"` // 分享者ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosInfoRequest) Reset() {
-	*x = GetVideosInfoRequest{}
+func (x *ResolveShareLinkResponse) Reset() {
+	*x = ResolveShareLinkResponse{}
 	mi := &file_video_v1_video_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosInfoRequest) String() string {
+func (x *ResolveShareLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosInfoRequest) ProtoMessage() {}
+func (*ResolveShareLinkResponse) ProtoMessage() {}
 
-func (x *GetVideosInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *ResolveShareLinkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_video_v1_video_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1368,40 +1466,56 @@ func (x *GetVideosInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetVideosInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*ResolveShareLinkResponse) Descriptor() ([]byte, []int) {
 	return file_video_v1_video_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetVideosInfoRequest) GetVideoIds() []int64 {
+func (x *ResolveShareLinkResponse) GetBase() *v1.BaseResponse {
 	if x != nil {
-		return x.VideoIds
+		return x.Base
 	}
 	return nil
 }
 
-// gRPC内部调用 - 批量获取视频信息响应
-type GetVideosInfoResponse struct {
+func (x *ResolveShareLinkResponse) GetVideo() *v1.Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *ResolveShareLinkResponse) GetSharerId() int64 {
+	if x != nil {
+		return x.SharerId
+	}
+	return 0
+}
+
+// 记录观看请求
+type RecordViewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*v1.Video            `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                     // 认证Token
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // 视频ID
+	Source        int32                  `protobuf:"varint,3,opt,name=source,proto3" json:"source,omitempty"`                  // 流量来源，可选：1推荐流 2个人主页 3分享链接
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosInfoResponse) Reset() {
-	*x = GetVideosInfoResponse{}
+func (x *RecordViewRequest) Reset() {
+	*x = RecordViewRequest{}
 	mi := &file_video_v1_video_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosInfoResponse) String() string {
+func (x *RecordViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosInfoResponse) ProtoMessage() {}
+func (*RecordViewRequest) ProtoMessage() {}
 
-func (x *GetVideosInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *RecordViewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_video_v1_video_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1413,16 +1527,2418 @@ func (x *GetVideosInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetVideosInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordViewRequest.ProtoReflect.Descriptor instead.
+func (*RecordViewRequest) Descriptor() ([]byte, []int) {
 	return file_video_v1_video_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetVideosInfoResponse) GetVideos() []*v1.Video {
+func (x *RecordViewRequest) GetToken() string {
 	if x != nil {
-		return x.Videos
+		return x.Token
 	}
-	return nil
+	return ""
+}
+
+func (x *RecordViewRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *RecordViewRequest) GetSource() int32 {
+	if x != nil {
+		return x.Source
+	}
+	return 0
+}
+
+// 记录观看响应
+type RecordViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Recorded      bool                   `protobuf:"varint,2,opt,name=recorded,proto3" json:"recorded,omitempty"` // 去重窗口内已记录过时为false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordViewResponse) Reset() {
+	*x = RecordViewResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordViewResponse) ProtoMessage() {}
+
+func (x *RecordViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordViewResponse.ProtoReflect.Descriptor instead.
+func (*RecordViewResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RecordViewResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RecordViewResponse) GetRecorded() bool {
+	if x != nil {
+		return x.Recorded
+	}
+	return false
+}
+
+// 上报播放请求
+type ReportPlayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                             // 认证Token，可选，未登录时按客户端IP去重
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`         // 视频ID
+	WatchedMs     int64                  `protobuf:"varint,3,opt,name=watched_ms,json=watchedMs,proto3" json:"watched_ms,omitempty"`   // 本次播放的观看时长（毫秒）
+	ShareToken    string                 `protobuf:"bytes,4,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // 经分享短链打开时的分享token，可选，计入的播放归因到分享者
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayRequest) Reset() {
+	*x = ReportPlayRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayRequest) ProtoMessage() {}
+
+func (x *ReportPlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayRequest.ProtoReflect.Descriptor instead.
+func (*ReportPlayRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReportPlayRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReportPlayRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *ReportPlayRequest) GetWatchedMs() int64 {
+	if x != nil {
+		return x.WatchedMs
+	}
+	return 0
+}
+
+func (x *ReportPlayRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+// 上报播放响应
+type ReportPlayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Counted       bool                   `protobuf:"varint,2,opt,name=counted,proto3" json:"counted,omitempty"` // 观看时长不足或去重窗口内已计入时为false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayResponse) Reset() {
+	*x = ReportPlayResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayResponse) ProtoMessage() {}
+
+func (x *ReportPlayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayResponse.ProtoReflect.Descriptor instead.
+func (*ReportPlayResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReportPlayResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReportPlayResponse) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+// 获取热门视频请求
+type GetTrendingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`  // 可选
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 返回数量，可选，默认20，最多50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingRequest) Reset() {
+	*x = GetTrendingRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingRequest) ProtoMessage() {}
+
+func (x *GetTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTrendingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetTrendingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 获取热门视频响应
+type GetTrendingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	VideoList     []*v1.Video            `protobuf:"bytes,2,rep,name=video_list,json=videoList,proto3" json:"video_list,omitempty"` // 按热度从高到低排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingResponse) Reset() {
+	*x = GetTrendingResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingResponse) ProtoMessage() {}
+
+func (x *GetTrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTrendingResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetTrendingResponse) GetVideoList() []*v1.Video {
+	if x != nil {
+		return x.VideoList
+	}
+	return nil
+}
+
+// 获取观看记录请求
+type GetWatchHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`     // 认证Token
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`      // 页码，从1开始
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`      // 每页数量
+	Quality       string                 `protobuf:"bytes,4,opt,name=quality,proto3" json:"quality,omitempty"` // 期望清晰度，可选：480p, 720p, 1080p
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWatchHistoryRequest) Reset() {
+	*x = GetWatchHistoryRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWatchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWatchHistoryRequest) ProtoMessage() {}
+
+func (x *GetWatchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWatchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetWatchHistoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetWatchHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetWatchHistoryRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetWatchHistoryRequest) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+// 获取观看记录响应
+type GetWatchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*WatchHistoryItem    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWatchHistoryResponse) Reset() {
+	*x = GetWatchHistoryResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWatchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWatchHistoryResponse) ProtoMessage() {}
+
+func (x *GetWatchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWatchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetWatchHistoryResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetWatchHistoryResponse) GetItems() []*WatchHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetWatchHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 观看记录
+type WatchHistoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *v1.Video              `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	WatchedAt     int64                  `protobuf:"varint,2,opt,name=watched_at,json=watchedAt,proto3" json:"watched_at,omitempty"` // 观看时间，Unix秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchHistoryItem) Reset() {
+	*x = WatchHistoryItem{}
+	mi := &file_video_v1_video_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchHistoryItem) ProtoMessage() {}
+
+func (x *WatchHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchHistoryItem.ProtoReflect.Descriptor instead.
+func (*WatchHistoryItem) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WatchHistoryItem) GetVideo() *v1.Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *WatchHistoryItem) GetWatchedAt() int64 {
+	if x != nil {
+		return x.WatchedAt
+	}
+	return 0
+}
+
+// 获取视频受众分析请求
+type GetVideoAudienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // 认证Token
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`       // 视频ID
+	StartTime     int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 起始时间戳，默认28天前
+	EndTime       int64                  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 结束时间戳，默认当前时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoAudienceRequest) Reset() {
+	*x = GetVideoAudienceRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoAudienceRequest) ProtoMessage() {}
+
+func (x *GetVideoAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoAudienceRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetVideoAudienceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetVideoAudienceRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *GetVideoAudienceRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetVideoAudienceRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// 按是否关注作者拆分的计数，以查询时的关注关系为准
+type AudienceSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follower      int64                  `protobuf:"varint,1,opt,name=follower,proto3" json:"follower,omitempty"`
+	NonFollower   int64                  `protobuf:"varint,2,opt,name=non_follower,json=nonFollower,proto3" json:"non_follower,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceSplit) Reset() {
+	*x = AudienceSplit{}
+	mi := &file_video_v1_video_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceSplit) ProtoMessage() {}
+
+func (x *AudienceSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceSplit.ProtoReflect.Descriptor instead.
+func (*AudienceSplit) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AudienceSplit) GetFollower() int64 {
+	if x != nil {
+		return x.Follower
+	}
+	return 0
+}
+
+func (x *AudienceSplit) GetNonFollower() int64 {
+	if x != nil {
+		return x.NonFollower
+	}
+	return 0
+}
+
+// 流量来源观看数
+type SourceViews struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        int32                  `protobuf:"varint,1,opt,name=source,proto3" json:"source,omitempty"` // 0未知 1推荐流 2个人主页 3分享链接
+	Views         int64                  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceViews) Reset() {
+	*x = SourceViews{}
+	mi := &file_video_v1_video_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceViews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceViews) ProtoMessage() {}
+
+func (x *SourceViews) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceViews.ProtoReflect.Descriptor instead.
+func (*SourceViews) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SourceViews) GetSource() int32 {
+	if x != nil {
+		return x.Source
+	}
+	return 0
+}
+
+func (x *SourceViews) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+// 视频受众分析，作者本人的观看和点赞不计入
+type VideoAudience struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VideoId          int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	StartTime        int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          int64                  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Views            *AudienceSplit         `protobuf:"bytes,4,opt,name=views,proto3" json:"views,omitempty"`
+	Likes            *AudienceSplit         `protobuf:"bytes,5,opt,name=likes,proto3" json:"likes,omitempty"`
+	NewViewers       int64                  `protobuf:"varint,6,opt,name=new_viewers,json=newViewers,proto3" json:"new_viewers,omitempty"`                   // 统计范围开始前没看过作者任何视频的观众数
+	ReturningViewers int64                  `protobuf:"varint,7,opt,name=returning_viewers,json=returningViewers,proto3" json:"returning_viewers,omitempty"` // 统计范围开始前看过作者视频的观众数
+	SourceViews      []*SourceViews         `protobuf:"bytes,8,rep,name=source_views,json=sourceViews,proto3" json:"source_views,omitempty"`                 // 按来源升序
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VideoAudience) Reset() {
+	*x = VideoAudience{}
+	mi := &file_video_v1_video_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoAudience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoAudience) ProtoMessage() {}
+
+func (x *VideoAudience) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoAudience.ProtoReflect.Descriptor instead.
+func (*VideoAudience) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VideoAudience) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *VideoAudience) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *VideoAudience) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *VideoAudience) GetViews() *AudienceSplit {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+func (x *VideoAudience) GetLikes() *AudienceSplit {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *VideoAudience) GetNewViewers() int64 {
+	if x != nil {
+		return x.NewViewers
+	}
+	return 0
+}
+
+func (x *VideoAudience) GetReturningViewers() int64 {
+	if x != nil {
+		return x.ReturningViewers
+	}
+	return 0
+}
+
+func (x *VideoAudience) GetSourceViews() []*SourceViews {
+	if x != nil {
+		return x.SourceViews
+	}
+	return nil
+}
+
+// 获取视频受众分析响应
+type GetVideoAudienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *VideoAudience         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoAudienceResponse) Reset() {
+	*x = GetVideoAudienceResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoAudienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoAudienceResponse) ProtoMessage() {}
+
+func (x *GetVideoAudienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoAudienceResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoAudienceResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetVideoAudienceResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetVideoAudienceResponse) GetData() *VideoAudience {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 申诉下架请求
+type AppealTakedownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TakedownId    int64                  `protobuf:"varint,2,opt,name=takedown_id,json=takedownId,proto3" json:"takedown_id,omitempty"` // 下架通知中的下架记录ID
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                            // 申诉理由，必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealTakedownRequest) Reset() {
+	*x = AppealTakedownRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTakedownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTakedownRequest) ProtoMessage() {}
+
+func (x *AppealTakedownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTakedownRequest.ProtoReflect.Descriptor instead.
+func (*AppealTakedownRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AppealTakedownRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AppealTakedownRequest) GetTakedownId() int64 {
+	if x != nil {
+		return x.TakedownId
+	}
+	return 0
+}
+
+func (x *AppealTakedownRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 申诉下架响应
+type AppealTakedownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Takedown      *v1.VideoTakedown      `protobuf:"bytes,2,opt,name=takedown,proto3" json:"takedown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealTakedownResponse) Reset() {
+	*x = AppealTakedownResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTakedownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTakedownResponse) ProtoMessage() {}
+
+func (x *AppealTakedownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTakedownResponse.ProtoReflect.Descriptor instead.
+func (*AppealTakedownResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AppealTakedownResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *AppealTakedownResponse) GetTakedown() *v1.VideoTakedown {
+	if x != nil {
+		return x.Takedown
+	}
+	return nil
+}
+
+// 查询我的下架记录请求
+type ListMyTakedownsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTakedownsRequest) Reset() {
+	*x = ListMyTakedownsRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTakedownsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTakedownsRequest) ProtoMessage() {}
+
+func (x *ListMyTakedownsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTakedownsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTakedownsRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListMyTakedownsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListMyTakedownsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyTakedownsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 查询我的下架记录响应
+type ListMyTakedownsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ListMyTakedownsData   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTakedownsResponse) Reset() {
+	*x = ListMyTakedownsResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTakedownsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTakedownsResponse) ProtoMessage() {}
+
+func (x *ListMyTakedownsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTakedownsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTakedownsResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMyTakedownsResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListMyTakedownsResponse) GetData() *ListMyTakedownsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListMyTakedownsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TakedownList  []*v1.VideoTakedown    `protobuf:"bytes,1,rep,name=takedown_list,json=takedownList,proto3" json:"takedown_list,omitempty"` // 按下架时间倒序
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTakedownsData) Reset() {
+	*x = ListMyTakedownsData{}
+	mi := &file_video_v1_video_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTakedownsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTakedownsData) ProtoMessage() {}
+
+func (x *ListMyTakedownsData) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTakedownsData.ProtoReflect.Descriptor instead.
+func (*ListMyTakedownsData) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListMyTakedownsData) GetTakedownList() []*v1.VideoTakedown {
+	if x != nil {
+		return x.TakedownList
+	}
+	return nil
+}
+
+func (x *ListMyTakedownsData) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 查询视频分类请求
+type ListVideoCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`                            // 名称语言，可选，如 en、zh-TW，默认zh
+	WithFacets    bool                   `protobuf:"varint,2,opt,name=with_facets,json=withFacets,proto3" json:"with_facets,omitempty"` // 是否统计各分类的已发布视频数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideoCategoriesRequest) Reset() {
+	*x = ListVideoCategoriesRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideoCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideoCategoriesRequest) ProtoMessage() {}
+
+func (x *ListVideoCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideoCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListVideoCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListVideoCategoriesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListVideoCategoriesRequest) GetWithFacets() bool {
+	if x != nil {
+		return x.WithFacets
+	}
+	return false
+}
+
+// 查询视频分类响应
+type ListVideoCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CategoryList  []*v1.VideoCategory    `protobuf:"bytes,2,rep,name=category_list,json=categoryList,proto3" json:"category_list,omitempty"` // 仅启用的分类，按排序值升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideoCategoriesResponse) Reset() {
+	*x = ListVideoCategoriesResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideoCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideoCategoriesResponse) ProtoMessage() {}
+
+func (x *ListVideoCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideoCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListVideoCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListVideoCategoriesResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListVideoCategoriesResponse) GetCategoryList() []*v1.VideoCategory {
+	if x != nil {
+		return x.CategoryList
+	}
+	return nil
+}
+
+// 上传视频字幕请求
+type SetVideoCaptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // 语言标签，如 en、zh-CN，可选
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`   // WebVTT 或 SRT 字幕文本
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVideoCaptionsRequest) Reset() {
+	*x = SetVideoCaptionsRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVideoCaptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoCaptionsRequest) ProtoMessage() {}
+
+func (x *SetVideoCaptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoCaptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetVideoCaptionsRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetVideoCaptionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetVideoCaptionsRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *SetVideoCaptionsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SetVideoCaptionsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// 上传视频字幕响应
+type SetVideoCaptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CueCount      int32                  `protobuf:"varint,2,opt,name=cue_count,json=cueCount,proto3" json:"cue_count,omitempty"` // 保存的字幕段数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVideoCaptionsResponse) Reset() {
+	*x = SetVideoCaptionsResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVideoCaptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoCaptionsResponse) ProtoMessage() {}
+
+func (x *SetVideoCaptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoCaptionsResponse.ProtoReflect.Descriptor instead.
+func (*SetVideoCaptionsResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetVideoCaptionsResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SetVideoCaptionsResponse) GetCueCount() int32 {
+	if x != nil {
+		return x.CueCount
+	}
+	return 0
+}
+
+// 修改视频可见范围请求
+type SetVideoVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Visibility    int32                  `protobuf:"varint,3,opt,name=visibility,proto3" json:"visibility,omitempty"` // 1公开 2仅互相关注的好友 3仅自己
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVideoVisibilityRequest) Reset() {
+	*x = SetVideoVisibilityRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVideoVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoVisibilityRequest) ProtoMessage() {}
+
+func (x *SetVideoVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*SetVideoVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetVideoVisibilityRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetVideoVisibilityRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *SetVideoVisibilityRequest) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+// 修改视频可见范围响应
+type SetVideoVisibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVideoVisibilityResponse) Reset() {
+	*x = SetVideoVisibilityResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVideoVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoVisibilityResponse) ProtoMessage() {}
+
+func (x *SetVideoVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*SetVideoVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetVideoVisibilityResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 修改草稿计划发布时间请求
+type SchedulePublishRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	VideoId       int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	PublishAt     int64                  `protobuf:"varint,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // 计划发布时间（Unix 秒），0表示立即发布
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePublishRequest) Reset() {
+	*x = SchedulePublishRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePublishRequest) ProtoMessage() {}
+
+func (x *SchedulePublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePublishRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePublishRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SchedulePublishRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SchedulePublishRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *SchedulePublishRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+// 修改草稿计划发布时间响应
+type SchedulePublishResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePublishResponse) Reset() {
+	*x = SchedulePublishResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePublishResponse) ProtoMessage() {}
+
+func (x *SchedulePublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePublishResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePublishResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SchedulePublishResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 修改视频信息请求，未设置的字段不修改
+type UpdateVideoInfoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	VideoId        int64                  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                          // 新标题，为空时不修改
+	Hashtags       []string               `protobuf:"bytes,4,rep,name=hashtags,proto3" json:"hashtags,omitempty"`                                    // 新话题，不含#，替换标题中的全部话题
+	UpdateHashtags bool                   `protobuf:"varint,5,opt,name=update_hashtags,json=updateHashtags,proto3" json:"update_hashtags,omitempty"` // 是否修改话题，为 true 且 hashtags 为空时清空话题
+	CoverData      []byte                 `protobuf:"bytes,6,opt,name=cover_data,json=coverData,proto3" json:"cover_data,omitempty"`                 // 新封面图片，支持 JPEG/PNG/GIF/WebP
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateVideoInfoRequest) Reset() {
+	*x = UpdateVideoInfoRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVideoInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVideoInfoRequest) ProtoMessage() {}
+
+func (x *UpdateVideoInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVideoInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVideoInfoRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateVideoInfoRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateVideoInfoRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *UpdateVideoInfoRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateVideoInfoRequest) GetHashtags() []string {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *UpdateVideoInfoRequest) GetUpdateHashtags() bool {
+	if x != nil {
+		return x.UpdateHashtags
+	}
+	return false
+}
+
+func (x *UpdateVideoInfoRequest) GetCoverData() []byte {
+	if x != nil {
+		return x.CoverData
+	}
+	return nil
+}
+
+// 修改视频信息响应
+type UpdateVideoInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Video         *v1.Video              `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVideoInfoResponse) Reset() {
+	*x = UpdateVideoInfoResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVideoInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVideoInfoResponse) ProtoMessage() {}
+
+func (x *UpdateVideoInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVideoInfoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVideoInfoResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateVideoInfoResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateVideoInfoResponse) GetVideo() *v1.Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+// 字幕检索请求
+type SearchWithinCreatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // 认证Token，可选
+	CreatorId     int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"` // 创作者ID
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                           // 检索词，2-100个字符
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                          // 最多返回的视频数，默认10，最大50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWithinCreatorRequest) Reset() {
+	*x = SearchWithinCreatorRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWithinCreatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWithinCreatorRequest) ProtoMessage() {}
+
+func (x *SearchWithinCreatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWithinCreatorRequest.ProtoReflect.Descriptor instead.
+func (*SearchWithinCreatorRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SearchWithinCreatorRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchWithinCreatorRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *SearchWithinCreatorRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchWithinCreatorRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 命中检索词的字幕段
+type CaptionHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartMs       int64                  `protobuf:"varint,1,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"` // 字幕段开始位置（毫秒）
+	EndMs         int64                  `protobuf:"varint,2,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`       // 字幕段结束位置（毫秒）
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	DeepLink      string                 `protobuf:"bytes,4,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"` // 从字幕段开始位置播放的链接
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptionHit) Reset() {
+	*x = CaptionHit{}
+	mi := &file_video_v1_video_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptionHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptionHit) ProtoMessage() {}
+
+func (x *CaptionHit) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptionHit.ProtoReflect.Descriptor instead.
+func (*CaptionHit) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CaptionHit) GetStartMs() int64 {
+	if x != nil {
+		return x.StartMs
+	}
+	return 0
+}
+
+func (x *CaptionHit) GetEndMs() int64 {
+	if x != nil {
+		return x.EndMs
+	}
+	return 0
+}
+
+func (x *CaptionHit) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CaptionHit) GetDeepLink() string {
+	if x != nil {
+		return x.DeepLink
+	}
+	return ""
+}
+
+// 单个视频的字幕检索结果
+type CaptionSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *v1.Video              `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	Hits          []*CaptionHit          `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"` // 按相关度排序，每个视频最多5条
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptionSearchResult) Reset() {
+	*x = CaptionSearchResult{}
+	mi := &file_video_v1_video_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptionSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptionSearchResult) ProtoMessage() {}
+
+func (x *CaptionSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptionSearchResult.ProtoReflect.Descriptor instead.
+func (*CaptionSearchResult) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CaptionSearchResult) GetVideo() *v1.Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *CaptionSearchResult) GetHits() []*CaptionHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// 字幕检索响应
+type SearchWithinCreatorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResultList    []*CaptionSearchResult `protobuf:"bytes,2,rep,name=result_list,json=resultList,proto3" json:"result_list,omitempty"` // 按视频中最相关字幕段的相关度排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWithinCreatorResponse) Reset() {
+	*x = SearchWithinCreatorResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWithinCreatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWithinCreatorResponse) ProtoMessage() {}
+
+func (x *SearchWithinCreatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWithinCreatorResponse.ProtoReflect.Descriptor instead.
+func (*SearchWithinCreatorResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SearchWithinCreatorResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SearchWithinCreatorResponse) GetResultList() []*CaptionSearchResult {
+	if x != nil {
+		return x.ResultList
+	}
+	return nil
+}
+
+// 推广点击上报请求
+type RecordPromotionClickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                 // 认证Token，可选
+	PromotionId   int64                  `protobuf:"varint,2,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"` // 视频流返回的推广计划ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordPromotionClickRequest) Reset() {
+	*x = RecordPromotionClickRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordPromotionClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPromotionClickRequest) ProtoMessage() {}
+
+func (x *RecordPromotionClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPromotionClickRequest.ProtoReflect.Descriptor instead.
+func (*RecordPromotionClickRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RecordPromotionClickRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RecordPromotionClickRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+// 推广点击上报响应
+type RecordPromotionClickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordPromotionClickResponse) Reset() {
+	*x = RecordPromotionClickResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordPromotionClickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPromotionClickResponse) ProtoMessage() {}
+
+func (x *RecordPromotionClickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPromotionClickResponse.ProtoReflect.Descriptor instead.
+func (*RecordPromotionClickResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RecordPromotionClickResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// 获取上传进度请求
+type GetUploadProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"` // 上传ID
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                       // 认证Token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadProgressRequest) Reset() {
+	*x = GetUploadProgressRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadProgressRequest) ProtoMessage() {}
+
+func (x *GetUploadProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadProgressRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetUploadProgressRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *GetUploadProgressRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取上传进度响应
+type GetUploadProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *UploadProgress        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadProgressResponse) Reset() {
+	*x = GetUploadProgressResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadProgressResponse) ProtoMessage() {}
+
+func (x *GetUploadProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadProgressResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetUploadProgressResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetUploadProgressResponse) GetData() *UploadProgress {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 上传进度
+type UploadProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`                 // 上传ID
+	Progress      int32                  `protobuf:"varint,2,opt,name=progress,proto3" json:"progress,omitempty"`                                // 进度百分比 (0-100)
+	Status        UploadStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=video.v1.UploadStatus" json:"status,omitempty"`         // 状态
+	TotalSize     int64                  `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`             // 总大小
+	UploadedSize  int64                  `protobuf:"varint,5,opt,name=uploaded_size,json=uploadedSize,proto3" json:"uploaded_size,omitempty"`    // 已上传大小
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`     // 错误信息（如果有）
+	EstimatedTime int64                  `protobuf:"varint,7,opt,name=estimated_time,json=estimatedTime,proto3" json:"estimated_time,omitempty"` // 预估剩余时间（秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProgress) Reset() {
+	*x = UploadProgress{}
+	mi := &file_video_v1_video_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProgress) ProtoMessage() {}
+
+func (x *UploadProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProgress.ProtoReflect.Descriptor instead.
+func (*UploadProgress) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UploadProgress) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadProgress) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *UploadProgress) GetStatus() UploadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UploadStatus_UPLOAD_STATUS_UNKNOWN
+}
+
+func (x *UploadProgress) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *UploadProgress) GetUploadedSize() int64 {
+	if x != nil {
+		return x.UploadedSize
+	}
+	return 0
+}
+
+func (x *UploadProgress) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *UploadProgress) GetEstimatedTime() int64 {
+	if x != nil {
+		return x.EstimatedTime
+	}
+	return 0
+}
+
+// gRPC内部调用 - 获取视频信息请求
+type GetVideoInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       int64                  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // 访问者ID，0表示匿名，按视频可见范围校验
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoInfoRequest) Reset() {
+	*x = GetVideoInfoRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoInfoRequest) ProtoMessage() {}
+
+func (x *GetVideoInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoInfoRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetVideoInfoRequest) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *GetVideoInfoRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+// gRPC内部调用 - 获取视频信息响应
+type GetVideoInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *v1.Video              `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoInfoResponse) Reset() {
+	*x = GetVideoInfoResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoInfoResponse) ProtoMessage() {}
+
+func (x *GetVideoInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoInfoResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetVideoInfoResponse) GetVideo() *v1.Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+// gRPC内部调用 - 批量获取视频信息请求
+type GetVideosInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoIds      []int64                `protobuf:"varint,1,rep,packed,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // 访问者ID，0表示匿名，访问者不可见的视频不返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideosInfoRequest) Reset() {
+	*x = GetVideosInfoRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideosInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosInfoRequest) ProtoMessage() {}
+
+func (x *GetVideosInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideosInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetVideosInfoRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetVideosInfoRequest) GetVideoIds() []int64 {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+func (x *GetVideosInfoRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+// gRPC内部调用 - 批量获取视频信息响应
+type GetVideosInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*v1.Video            `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideosInfoResponse) Reset() {
+	*x = GetVideosInfoResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideosInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosInfoResponse) ProtoMessage() {}
+
+func (x *GetVideosInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideosInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetVideosInfoResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetVideosInfoResponse) GetVideos() []*v1.Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
 }
 
 // gRPC内部调用 - 更新视频统计请求
@@ -1438,7 +3954,7 @@ type UpdateVideoStatsRequest struct {
 
 func (x *UpdateVideoStatsRequest) Reset() {
 	*x = UpdateVideoStatsRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[22]
+	mi := &file_video_v1_video_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1450,7 +3966,7 @@ func (x *UpdateVideoStatsRequest) String() string {
 func (*UpdateVideoStatsRequest) ProtoMessage() {}
 
 func (x *UpdateVideoStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[22]
+	mi := &file_video_v1_video_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +3979,7 @@ func (x *UpdateVideoStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVideoStatsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVideoStatsRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{22}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *UpdateVideoStatsRequest) GetVideoId() int64 {
@@ -1508,7 +4024,7 @@ type InitiateMultipartUploadRequest struct {
 
 func (x *InitiateMultipartUploadRequest) Reset() {
 	*x = InitiateMultipartUploadRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[23]
+	mi := &file_video_v1_video_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1520,7 +4036,7 @@ func (x *InitiateMultipartUploadRequest) String() string {
 func (*InitiateMultipartUploadRequest) ProtoMessage() {}
 
 func (x *InitiateMultipartUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[23]
+	mi := &file_video_v1_video_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,7 +4049,7 @@ func (x *InitiateMultipartUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateMultipartUploadRequest.ProtoReflect.Descriptor instead.
 func (*InitiateMultipartUploadRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{23}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *InitiateMultipartUploadRequest) GetToken() string {
@@ -1582,7 +4098,7 @@ type InitiateMultipartUploadResponse struct {
 
 func (x *InitiateMultipartUploadResponse) Reset() {
 	*x = InitiateMultipartUploadResponse{}
-	mi := &file_video_v1_video_proto_msgTypes[24]
+	mi := &file_video_v1_video_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1594,7 +4110,7 @@ func (x *InitiateMultipartUploadResponse) String() string {
 func (*InitiateMultipartUploadResponse) ProtoMessage() {}
 
 func (x *InitiateMultipartUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[24]
+	mi := &file_video_v1_video_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +4123,7 @@ func (x *InitiateMultipartUploadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateMultipartUploadResponse.ProtoReflect.Descriptor instead.
 func (*InitiateMultipartUploadResponse) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{24}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *InitiateMultipartUploadResponse) GetBase() *v1.BaseResponse {
@@ -1637,7 +4153,7 @@ type MultipartUploadInfo struct {
 
 func (x *MultipartUploadInfo) Reset() {
 	*x = MultipartUploadInfo{}
-	mi := &file_video_v1_video_proto_msgTypes[25]
+	mi := &file_video_v1_video_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1649,7 +4165,7 @@ func (x *MultipartUploadInfo) String() string {
 func (*MultipartUploadInfo) ProtoMessage() {}
 
 func (x *MultipartUploadInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[25]
+	mi := &file_video_v1_video_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,7 +4178,7 @@ func (x *MultipartUploadInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultipartUploadInfo.ProtoReflect.Descriptor instead.
 func (*MultipartUploadInfo) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{25}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *MultipartUploadInfo) GetUploadId() string {
@@ -1701,13 +4217,14 @@ type UploadPartRequest struct {
 	PartNumber    int32                  `protobuf:"varint,3,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
 	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum      string                 `protobuf:"bytes,6,opt,name=checksum,proto3" json:"checksum,omitempty"` // 分片校验值，可选，格式 md5:<hex> 或 crc32:<hex>
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UploadPartRequest) Reset() {
 	*x = UploadPartRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[26]
+	mi := &file_video_v1_video_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1719,7 +4236,7 @@ func (x *UploadPartRequest) String() string {
 func (*UploadPartRequest) ProtoMessage() {}
 
 func (x *UploadPartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[26]
+	mi := &file_video_v1_video_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,7 +4249,7 @@ func (x *UploadPartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadPartRequest.ProtoReflect.Descriptor instead.
 func (*UploadPartRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{26}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *UploadPartRequest) GetToken() string {
@@ -1767,7 +4284,14 @@ func (x *UploadPartRequest) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
-	return 0
+	return 0
+}
+
+func (x *UploadPartRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
 }
 
 // 上传分片响应
@@ -1781,7 +4305,7 @@ type UploadPartResponse struct {
 
 func (x *UploadPartResponse) Reset() {
 	*x = UploadPartResponse{}
-	mi := &file_video_v1_video_proto_msgTypes[27]
+	mi := &file_video_v1_video_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1793,7 +4317,7 @@ func (x *UploadPartResponse) String() string {
 func (*UploadPartResponse) ProtoMessage() {}
 
 func (x *UploadPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[27]
+	mi := &file_video_v1_video_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,7 +4330,7 @@ func (x *UploadPartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadPartResponse.ProtoReflect.Descriptor instead.
 func (*UploadPartResponse) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{27}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UploadPartResponse) GetBase() *v1.BaseResponse {
@@ -1835,7 +4359,7 @@ type PartInfo struct {
 
 func (x *PartInfo) Reset() {
 	*x = PartInfo{}
-	mi := &file_video_v1_video_proto_msgTypes[28]
+	mi := &file_video_v1_video_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1847,7 +4371,7 @@ func (x *PartInfo) String() string {
 func (*PartInfo) ProtoMessage() {}
 
 func (x *PartInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[28]
+	mi := &file_video_v1_video_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1860,7 +4384,7 @@ func (x *PartInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
 func (*PartInfo) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{28}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *PartInfo) GetPartNumber() int32 {
@@ -1891,13 +4415,17 @@ type CompleteMultipartUploadRequest struct {
 	UploadId      string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
 	Parts         []*PartInfo            `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
 	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 视频分类，可选
+	Checksum      string                 `protobuf:"bytes,6,opt,name=checksum,proto3" json:"checksum,omitempty"`                        // 完整文件校验值，可选，格式同分片校验值
+	Visibility    int32                  `protobuf:"varint,7,opt,name=visibility,proto3" json:"visibility,omitempty"`                   // 可见范围，可选：1公开（默认） 2仅互相关注的好友 3仅自己
+	PublishAt     int64                  `protobuf:"varint,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`    // 计划发布时间（Unix 秒），可选，设置时保存为草稿并在该时间发布
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CompleteMultipartUploadRequest) Reset() {
 	*x = CompleteMultipartUploadRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[29]
+	mi := &file_video_v1_video_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1909,7 +4437,7 @@ func (x *CompleteMultipartUploadRequest) String() string {
 func (*CompleteMultipartUploadRequest) ProtoMessage() {}
 
 func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[29]
+	mi := &file_video_v1_video_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1922,7 +4450,7 @@ func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
 func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{29}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *CompleteMultipartUploadRequest) GetToken() string {
@@ -1953,6 +4481,34 @@ func (x *CompleteMultipartUploadRequest) GetTitle() string {
 	return ""
 }
 
+func (x *CompleteMultipartUploadRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CompleteMultipartUploadRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *CompleteMultipartUploadRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
 // 取消分片上传请求
 type AbortMultipartUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1964,7 +4520,7 @@ type AbortMultipartUploadRequest struct {
 
 func (x *AbortMultipartUploadRequest) Reset() {
 	*x = AbortMultipartUploadRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[30]
+	mi := &file_video_v1_video_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1976,7 +4532,7 @@ func (x *AbortMultipartUploadRequest) String() string {
 func (*AbortMultipartUploadRequest) ProtoMessage() {}
 
 func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[30]
+	mi := &file_video_v1_video_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1989,7 +4545,7 @@ func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
 func (*AbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{30}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *AbortMultipartUploadRequest) GetToken() string {
@@ -2017,7 +4573,7 @@ type ListUploadedPartsRequest struct {
 
 func (x *ListUploadedPartsRequest) Reset() {
 	*x = ListUploadedPartsRequest{}
-	mi := &file_video_v1_video_proto_msgTypes[31]
+	mi := &file_video_v1_video_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2029,7 +4585,7 @@ func (x *ListUploadedPartsRequest) String() string {
 func (*ListUploadedPartsRequest) ProtoMessage() {}
 
 func (x *ListUploadedPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[31]
+	mi := &file_video_v1_video_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2042,7 +4598,7 @@ func (x *ListUploadedPartsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUploadedPartsRequest.ProtoReflect.Descriptor instead.
 func (*ListUploadedPartsRequest) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{31}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ListUploadedPartsRequest) GetToken() string {
@@ -2070,7 +4626,7 @@ type ListUploadedPartsResponse struct {
 
 func (x *ListUploadedPartsResponse) Reset() {
 	*x = ListUploadedPartsResponse{}
-	mi := &file_video_v1_video_proto_msgTypes[32]
+	mi := &file_video_v1_video_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2082,7 +4638,7 @@ func (x *ListUploadedPartsResponse) String() string {
 func (*ListUploadedPartsResponse) ProtoMessage() {}
 
 func (x *ListUploadedPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[32]
+	mi := &file_video_v1_video_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,7 +4651,7 @@ func (x *ListUploadedPartsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUploadedPartsResponse.ProtoReflect.Descriptor instead.
 func (*ListUploadedPartsResponse) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{32}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ListUploadedPartsResponse) GetBase() *v1.BaseResponse {
@@ -2124,7 +4680,7 @@ type ListUploadedPartsData struct {
 
 func (x *ListUploadedPartsData) Reset() {
 	*x = ListUploadedPartsData{}
-	mi := &file_video_v1_video_proto_msgTypes[33]
+	mi := &file_video_v1_video_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2136,7 +4692,7 @@ func (x *ListUploadedPartsData) String() string {
 func (*ListUploadedPartsData) ProtoMessage() {}
 
 func (x *ListUploadedPartsData) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[33]
+	mi := &file_video_v1_video_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,7 +4705,7 @@ func (x *ListUploadedPartsData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUploadedPartsData.ProtoReflect.Descriptor instead.
 func (*ListUploadedPartsData) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{33}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ListUploadedPartsData) GetParts() []*PartInfo {
@@ -2173,6 +4729,266 @@ func (x *ListUploadedPartsData) GetUploadedSize() int64 {
 	return 0
 }
 
+// 查询上传校验状态请求
+type VerifyUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UploadId      string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyUploadRequest) Reset() {
+	*x = VerifyUploadRequest{}
+	mi := &file_video_v1_video_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyUploadRequest) ProtoMessage() {}
+
+func (x *VerifyUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyUploadRequest.ProtoReflect.Descriptor instead.
+func (*VerifyUploadRequest) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *VerifyUploadRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerifyUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+// 查询上传校验状态响应
+type VerifyUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *v1.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *VerifyUploadData      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyUploadResponse) Reset() {
+	*x = VerifyUploadResponse{}
+	mi := &file_video_v1_video_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyUploadResponse) ProtoMessage() {}
+
+func (x *VerifyUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyUploadResponse.ProtoReflect.Descriptor instead.
+func (*VerifyUploadResponse) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *VerifyUploadResponse) GetBase() *v1.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *VerifyUploadResponse) GetData() *VerifyUploadData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 上传校验状态
+type VerifyUploadData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Parts           []*PartChecksum        `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`                                             // 按分片号升序
+	MismatchedParts int32                  `protobuf:"varint,2,opt,name=mismatched_parts,json=mismatchedParts,proto3" json:"mismatched_parts,omitempty"` // 校验失败的分片数
+	AllVerified     bool                   `protobuf:"varint,3,opt,name=all_verified,json=allVerified,proto3" json:"all_verified,omitempty"`             // 全部分片都提供了校验值且校验通过
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VerifyUploadData) Reset() {
+	*x = VerifyUploadData{}
+	mi := &file_video_v1_video_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyUploadData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyUploadData) ProtoMessage() {}
+
+func (x *VerifyUploadData) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyUploadData.ProtoReflect.Descriptor instead.
+func (*VerifyUploadData) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *VerifyUploadData) GetParts() []*PartChecksum {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *VerifyUploadData) GetMismatchedParts() int32 {
+	if x != nil {
+		return x.MismatchedParts
+	}
+	return 0
+}
+
+func (x *VerifyUploadData) GetAllVerified() bool {
+	if x != nil {
+		return x.AllVerified
+	}
+	return false
+}
+
+// 分片校验状态
+type PartChecksum struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                      // md5 或 crc32
+	Expected      string                 `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`                        // 客户端提供的校验值，未提供时为空
+	Actual        string                 `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`                            // 服务端计算的校验值
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                           // 1校验通过 2校验失败 3未提供校验值
+	UploadedAt    int64                  `protobuf:"varint,7,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"` // 上传时间戳
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartChecksum) Reset() {
+	*x = PartChecksum{}
+	mi := &file_video_v1_video_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartChecksum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartChecksum) ProtoMessage() {}
+
+func (x *PartChecksum) ProtoReflect() protoreflect.Message {
+	mi := &file_video_v1_video_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartChecksum.ProtoReflect.Descriptor instead.
+func (*PartChecksum) Descriptor() ([]byte, []int) {
+	return file_video_v1_video_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PartChecksum) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *PartChecksum) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PartChecksum) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *PartChecksum) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *PartChecksum) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *PartChecksum) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PartChecksum) GetUploadedAt() int64 {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return 0
+}
+
 // 扩展现有的UploadProgress消息（如果需要更详细的进度信息）
 type UploadProgressDetail struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -2191,7 +5007,7 @@ type UploadProgressDetail struct {
 
 func (x *UploadProgressDetail) Reset() {
 	*x = UploadProgressDetail{}
-	mi := &file_video_v1_video_proto_msgTypes[34]
+	mi := &file_video_v1_video_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2203,7 +5019,7 @@ func (x *UploadProgressDetail) String() string {
 func (*UploadProgressDetail) ProtoMessage() {}
 
 func (x *UploadProgressDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_video_v1_video_proto_msgTypes[34]
+	mi := &file_video_v1_video_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,7 +5032,7 @@ func (x *UploadProgressDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadProgressDetail.ProtoReflect.Descriptor instead.
 func (*UploadProgressDetail) Descriptor() ([]byte, []int) {
-	return file_video_v1_video_proto_rawDescGZIP(), []int{34}
+	return file_video_v1_video_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *UploadProgressDetail) GetUploadId() string {
@@ -2286,33 +5102,58 @@ var File_video_v1_video_proto protoreflect.FileDescriptor
 
 const file_video_v1_video_proto_rawDesc = "" +
 	"\n" +
-	"\x14video/v1/video.proto\x12\bvideo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16common/v1/common.proto\"G\n" +
+	"\x14video/v1/video.proto\x12\bvideo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16common/v1/common.proto\"\xcf\x01\n" +
 	"\x0eGetFeedRequest\x12\x1f\n" +
 	"\vlatest_time\x18\x01 \x01(\x03R\n" +
 	"latestTime\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"i\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1b\n" +
+	"\tfeed_type\x18\x03 \x01(\x05R\bfeedType\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x18\n" +
+	"\aquality\x18\x05 \x01(\tR\aquality\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x03R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\"i\n" +
 	"\x0fGetFeedResponse\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12)\n" +
-	"\x04data\x18\x02 \x01(\v2\x15.video.v1.GetFeedDataR\x04data\"[\n" +
+	"\x04data\x18\x02 \x01(\v2\x15.video.v1.GetFeedDataR\x04data\"\xb8\x01\n" +
 	"\vGetFeedData\x12\x1b\n" +
 	"\tnext_time\x18\x01 \x01(\x03R\bnextTime\x12/\n" +
 	"\n" +
-	"video_list\x18\x02 \x03(\v2\x10.common.v1.VideoR\tvideoList\"\x9f\x01\n" +
+	"video_list\x18\x02 \x03(\v2\x10.common.v1.VideoR\tvideoList\x12\x1f\n" +
+	"\vnext_offset\x18\x03 \x01(\x05R\n" +
+	"nextOffset\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\"\xff\x01\n" +
 	"\x13PublishVideoRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
 	"\x04data\x18\x02 \x01(\fH\x00R\x04data\x127\n" +
 	"\tfile_info\x18\x03 \x01(\v2\x18.video.v1.FileUploadInfoH\x00R\bfileInfo\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05titleB\r\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\x03R\n" +
+	"categoryId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\x05R\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\a \x01(\x03R\tpublishAtB\r\n" +
 	"\vdata_source\"\x89\x01\n" +
 	"\x0eFileUploadInfo\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
 	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1b\n" +
-	"\tupload_id\x18\x04 \x01(\tR\buploadId\"x\n" +
+	"\tupload_id\x18\x04 \x01(\tR\buploadId\"\xd8\x01\n" +
 	"\x16UploadVideoFileRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x122\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x16.video.v1.FileMetadataR\bmetadata\"\xf9\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x16.video.v1.FileMetadataR\bmetadata\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x05R\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x06 \x01(\x03R\tpublishAt\"\xf9\x01\n" +
 	"\fFileMetadata\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
@@ -2329,10 +5170,11 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"\x10PublishVideoData\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x1b\n" +
 	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12.\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x16.video.v1.UploadStatusR\x06status\"F\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.video.v1.UploadStatusR\x06status\"`\n" +
 	"\x15GetPublishListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"w\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x18\n" +
+	"\aquality\x18\x03 \x01(\tR\aquality\"w\n" +
 	"\x16GetPublishListResponse\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x120\n" +
 	"\x04data\x18\x02 \x01(\v2\x1c.video.v1.GetPublishListDataR\x04data\"E\n" +
@@ -2354,7 +5196,174 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"\fextra_config\x18\x06 \x03(\v2'.video.v1.UploadConfig.ExtraConfigEntryR\vextraConfig\x1a>\n" +
 	"\x10ExtraConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"M\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"5\n" +
+	"\x18GetVideoShareCardRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\"c\n" +
+	"\x19GetVideoShareCardResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x19\n" +
+	"\bcard_url\x18\x02 \x01(\tR\acardUrl\"D\n" +
+	"\x11ShareVideoRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\"\x7f\n" +
+	"\x12ShareVideoResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12\x1b\n" +
+	"\tshare_url\x18\x03 \x01(\tR\bshareUrl\"P\n" +
+	"\x17ResolveShareLinkRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x8c\x01\n" +
+	"\x18ResolveShareLinkResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12&\n" +
+	"\x05video\x18\x02 \x01(\v2\x10.common.v1.VideoR\x05video\x12\x1b\n" +
+	"\tsharer_id\x18\x03 \x01(\x03R\bsharerId\"\\\n" +
+	"\x11RecordViewRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\x05R\x06source\"]\n" +
+	"\x12RecordViewResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x1a\n" +
+	"\brecorded\x18\x02 \x01(\bR\brecorded\"\x84\x01\n" +
+	"\x11ReportPlayRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x1d\n" +
+	"\n" +
+	"watched_ms\x18\x03 \x01(\x03R\twatchedMs\x12\x1f\n" +
+	"\vshare_token\x18\x04 \x01(\tR\n" +
+	"shareToken\"[\n" +
+	"\x12ReportPlayResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x18\n" +
+	"\acounted\x18\x02 \x01(\bR\acounted\"@\n" +
+	"\x12GetTrendingRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"s\n" +
+	"\x13GetTrendingResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12/\n" +
+	"\n" +
+	"video_list\x18\x02 \x03(\v2\x10.common.v1.VideoR\tvideoList\"p\n" +
+	"\x16GetWatchHistoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x18\n" +
+	"\aquality\x18\x04 \x01(\tR\aquality\"\x8e\x01\n" +
+	"\x17GetWatchHistoryResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x120\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.video.v1.WatchHistoryItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"Y\n" +
+	"\x10WatchHistoryItem\x12&\n" +
+	"\x05video\x18\x01 \x01(\v2\x10.common.v1.VideoR\x05video\x12\x1d\n" +
+	"\n" +
+	"watched_at\x18\x02 \x01(\x03R\twatchedAt\"\x84\x01\n" +
+	"\x17GetVideoAudienceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\x03R\aendTime\"N\n" +
+	"\rAudienceSplit\x12\x1a\n" +
+	"\bfollower\x18\x01 \x01(\x03R\bfollower\x12!\n" +
+	"\fnon_follower\x18\x02 \x01(\x03R\vnonFollower\";\n" +
+	"\vSourceViews\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\x05R\x06source\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\"\xca\x02\n" +
+	"\rVideoAudience\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\x03R\aendTime\x12-\n" +
+	"\x05views\x18\x04 \x01(\v2\x17.video.v1.AudienceSplitR\x05views\x12-\n" +
+	"\x05likes\x18\x05 \x01(\v2\x17.video.v1.AudienceSplitR\x05likes\x12\x1f\n" +
+	"\vnew_viewers\x18\x06 \x01(\x03R\n" +
+	"newViewers\x12+\n" +
+	"\x11returning_viewers\x18\a \x01(\x03R\x10returningViewers\x128\n" +
+	"\fsource_views\x18\b \x03(\v2\x15.video.v1.SourceViewsR\vsourceViews\"t\n" +
+	"\x18GetVideoAudienceResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.video.v1.VideoAudienceR\x04data\"f\n" +
+	"\x15AppealTakedownRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1f\n" +
+	"\vtakedown_id\x18\x02 \x01(\x03R\n" +
+	"takedownId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"{\n" +
+	"\x16AppealTakedownResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x124\n" +
+	"\btakedown\x18\x02 \x01(\v2\x18.common.v1.VideoTakedownR\btakedown\"V\n" +
+	"\x16ListMyTakedownsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"y\n" +
+	"\x17ListMyTakedownsResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x121\n" +
+	"\x04data\x18\x02 \x01(\v2\x1d.video.v1.ListMyTakedownsDataR\x04data\"j\n" +
+	"\x13ListMyTakedownsData\x12=\n" +
+	"\rtakedown_list\x18\x01 \x03(\v2\x18.common.v1.VideoTakedownR\ftakedownList\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"U\n" +
+	"\x1aListVideoCategoriesRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1f\n" +
+	"\vwith_facets\x18\x02 \x01(\bR\n" +
+	"withFacets\"\x89\x01\n" +
+	"\x1bListVideoCategoriesResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12=\n" +
+	"\rcategory_list\x18\x02 \x03(\v2\x18.common.v1.VideoCategoryR\fcategoryList\"\x80\x01\n" +
+	"\x17SetVideoCaptionsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"d\n" +
+	"\x18SetVideoCaptionsResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12\x1b\n" +
+	"\tcue_count\x18\x02 \x01(\x05R\bcueCount\"l\n" +
+	"\x19SetVideoVisibilityRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x05R\n" +
+	"visibility\"I\n" +
+	"\x1aSetVideoVisibilityResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"h\n" +
+	"\x16SchedulePublishRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\x03R\tpublishAt\"F\n" +
+	"\x17SchedulePublishResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"\xc3\x01\n" +
+	"\x16UpdateVideoInfoRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x03R\avideoId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bhashtags\x18\x04 \x03(\tR\bhashtags\x12'\n" +
+	"\x0fupdate_hashtags\x18\x05 \x01(\bR\x0eupdateHashtags\x12\x1d\n" +
+	"\n" +
+	"cover_data\x18\x06 \x01(\fR\tcoverData\"n\n" +
+	"\x17UpdateVideoInfoResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12&\n" +
+	"\x05video\x18\x02 \x01(\v2\x10.common.v1.VideoR\x05video\"}\n" +
+	"\x1aSearchWithinCreatorRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x03R\tcreatorId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"o\n" +
+	"\n" +
+	"CaptionHit\x12\x19\n" +
+	"\bstart_ms\x18\x01 \x01(\x03R\astartMs\x12\x15\n" +
+	"\x06end_ms\x18\x02 \x01(\x03R\x05endMs\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1b\n" +
+	"\tdeep_link\x18\x04 \x01(\tR\bdeepLink\"g\n" +
+	"\x13CaptionSearchResult\x12&\n" +
+	"\x05video\x18\x01 \x01(\v2\x10.common.v1.VideoR\x05video\x12(\n" +
+	"\x04hits\x18\x02 \x03(\v2\x14.video.v1.CaptionHitR\x04hits\"\x8a\x01\n" +
+	"\x1bSearchWithinCreatorResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12>\n" +
+	"\vresult_list\x18\x02 \x03(\v2\x1d.video.v1.CaptionSearchResultR\n" +
+	"resultList\"V\n" +
+	"\x1bRecordPromotionClickRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fpromotion_id\x18\x02 \x01(\x03R\vpromotionId\"K\n" +
+	"\x1cRecordPromotionClickResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\"M\n" +
 	"\x18GetUploadProgressRequest\x12\x1b\n" +
 	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"v\n" +
@@ -2369,13 +5378,15 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"total_size\x18\x04 \x01(\x03R\ttotalSize\x12#\n" +
 	"\ruploaded_size\x18\x05 \x01(\x03R\fuploadedSize\x12#\n" +
 	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12%\n" +
-	"\x0eestimated_time\x18\a \x01(\x03R\restimatedTime\"0\n" +
+	"\x0eestimated_time\x18\a \x01(\x03R\restimatedTime\"M\n" +
 	"\x13GetVideoInfoRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\">\n" +
+	"\bvideo_id\x18\x01 \x01(\x03R\avideoId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\">\n" +
 	"\x14GetVideoInfoResponse\x12&\n" +
-	"\x05video\x18\x01 \x01(\v2\x10.common.v1.VideoR\x05video\"3\n" +
+	"\x05video\x18\x01 \x01(\v2\x10.common.v1.VideoR\x05video\"P\n" +
 	"\x14GetVideosInfoRequest\x12\x1b\n" +
-	"\tvideo_ids\x18\x01 \x03(\x03R\bvideoIds\"A\n" +
+	"\tvideo_ids\x18\x01 \x03(\x03R\bvideoIds\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"A\n" +
 	"\x15GetVideosInfoResponse\x12(\n" +
 	"\x06videos\x18\x01 \x03(\v2\x10.common.v1.VideoR\x06videos\"\x97\x01\n" +
 	"\x17UpdateVideoStatsRequest\x12\x19\n" +
@@ -2402,14 +5413,15 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"uploadUrls\x1a=\n" +
 	"\x0fUploadUrlsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xab\x01\n" +
 	"\x11UploadPartRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
 	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12\x1f\n" +
 	"\vpart_number\x18\x03 \x01(\x05R\n" +
 	"partNumber\x12\x12\n" +
 	"\x04data\x18\x04 \x01(\fR\x04data\x12\x12\n" +
-	"\x04size\x18\x05 \x01(\x03R\x04size\"i\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x06 \x01(\tR\bchecksum\"i\n" +
 	"\x12UploadPartResponse\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12&\n" +
 	"\x04data\x18\x02 \x01(\v2\x12.video.v1.PartInfoR\x04data\"S\n" +
@@ -2417,12 +5429,20 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"\vpart_number\x18\x01 \x01(\x05R\n" +
 	"partNumber\x12\x12\n" +
 	"\x04etag\x18\x02 \x01(\tR\x04etag\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x03R\x04size\"\x93\x01\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"\x8f\x02\n" +
 	"\x1eCompleteMultipartUploadRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
 	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12(\n" +
 	"\x05parts\x18\x03 \x03(\v2\x12.video.v1.PartInfoR\x05parts\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\"P\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\x03R\n" +
+	"categoryId\x12\x1a\n" +
+	"\bchecksum\x18\x06 \x01(\tR\bchecksum\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\a \x01(\x05R\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\b \x01(\x03R\tpublishAt\"P\n" +
 	"\x1bAbortMultipartUploadRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
 	"\tupload_id\x18\x02 \x01(\tR\buploadId\"M\n" +
@@ -2436,7 +5456,27 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"\x05parts\x18\x01 \x03(\v2\x12.video.v1.PartInfoR\x05parts\x12\x1f\n" +
 	"\vtotal_parts\x18\x02 \x01(\x05R\n" +
 	"totalParts\x12#\n" +
-	"\ruploaded_size\x18\x03 \x01(\x03R\fuploadedSize\"\xed\x02\n" +
+	"\ruploaded_size\x18\x03 \x01(\x03R\fuploadedSize\"H\n" +
+	"\x13VerifyUploadRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\"s\n" +
+	"\x14VerifyUploadResponse\x12+\n" +
+	"\x04base\x18\x01 \x01(\v2\x17.common.v1.BaseResponseR\x04base\x12.\n" +
+	"\x04data\x18\x02 \x01(\v2\x1a.video.v1.VerifyUploadDataR\x04data\"\x8e\x01\n" +
+	"\x10VerifyUploadData\x12,\n" +
+	"\x05parts\x18\x01 \x03(\v2\x16.video.v1.PartChecksumR\x05parts\x12)\n" +
+	"\x10mismatched_parts\x18\x02 \x01(\x05R\x0fmismatchedParts\x12!\n" +
+	"\fall_verified\x18\x03 \x01(\bR\vallVerified\"\xce\x01\n" +
+	"\fPartChecksum\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1c\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x12\x1a\n" +
+	"\bexpected\x18\x04 \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\x05 \x01(\tR\x06actual\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vuploaded_at\x18\a \x01(\x03R\n" +
+	"uploadedAt\"\xed\x02\n" +
 	"\x14UploadProgressDetail\x12\x1b\n" +
 	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1a\n" +
 	"\bprogress\x18\x02 \x01(\x05R\bprogress\x12.\n" +
@@ -2460,14 +5500,34 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"!UPDATE_VIDEO_STATS_FAVORITE_COUNT\x10\x01\x12$\n" +
 	" UPDATE_VIDEO_STATS_COMMENT_COUNT\x10\x02\x12!\n" +
 	"\x1dUPDATE_VIDEO_STATS_PLAY_COUNT\x10\x03\x12\"\n" +
-	"\x1eUPDATE_VIDEO_STATS_SHARE_COUNT\x10\x042\x8b\r\n" +
+	"\x1eUPDATE_VIDEO_STATS_SHARE_COUNT\x10\x042\xbe\x1e\n" +
 	"\fVideoService\x12T\n" +
 	"\aGetFeed\x12\x18.video.v1.GetFeedRequest\x1a\x19.video.v1.GetFeedResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/douyin/feed\x12\x8a\x01\n" +
 	"\fPublishVideo\x12\x1d.video.v1.PublishVideoRequest\x1a\x1e.video.v1.PublishVideoResponse\";\x82\xd3\xe4\x93\x025:\x01*Z\x18\"\x16/douyin/publish/action\"\x16/douyin/publish/action\x12v\n" +
 	"\x0fUploadVideoFile\x12 .video.v1.UploadVideoFileRequest\x1a\x1e.video.v1.PublishVideoResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/douyin/publish/upload\x12q\n" +
 	"\x0eGetPublishList\x12\x1f.video.v1.GetPublishListRequest\x1a .video.v1.GetPublishListResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/douyin/publish/list\x12u\n" +
 	"\x0fGetUploadConfig\x12 .video.v1.GetUploadConfigRequest\x1a!.video.v1.GetUploadConfigResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/douyin/upload/config\x12\x89\x01\n" +
-	"\x11GetUploadProgress\x12\".video.v1.GetUploadProgressRequest\x1a#.video.v1.GetUploadProgressResponse\"+\x82\xd3\xe4\x93\x02%\x12#/douyin/upload/progress/{upload_id}\x12M\n" +
+	"\x11GetUploadProgress\x12\".video.v1.GetUploadProgressRequest\x1a#.video.v1.GetUploadProgressResponse\"+\x82\xd3\xe4\x93\x02%\x12#/douyin/upload/progress/{upload_id}\x12~\n" +
+	"\x11GetVideoShareCard\x12\".video.v1.GetVideoShareCardRequest\x1a#.video.v1.GetVideoShareCardResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/douyin/video/share/card\x12g\n" +
+	"\n" +
+	"ShareVideo\x12\x1b.video.v1.ShareVideoRequest\x1a\x1c.video.v1.ShareVideoResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/douyin/video/share\x12~\n" +
+	"\x10ResolveShareLink\x12!.video.v1.ResolveShareLinkRequest\x1a\".video.v1.ResolveShareLinkResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/douyin/video/share/resolve\x12f\n" +
+	"\n" +
+	"RecordView\x12\x1b.video.v1.RecordViewRequest\x1a\x1c.video.v1.RecordViewResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/douyin/video/view\x12f\n" +
+	"\n" +
+	"ReportPlay\x12\x1b.video.v1.ReportPlayRequest\x1a\x1c.video.v1.ReportPlayResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/douyin/video/play\x12j\n" +
+	"\vGetTrending\x12\x1c.video.v1.GetTrendingRequest\x1a\x1d.video.v1.GetTrendingResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/douyin/video/trending\x12u\n" +
+	"\x0fGetWatchHistory\x12 .video.v1.GetWatchHistoryRequest\x1a!.video.v1.GetWatchHistoryResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/douyin/video/history\x12y\n" +
+	"\x10GetVideoAudience\x12!.video.v1.GetVideoAudienceRequest\x1a\".video.v1.GetVideoAudienceResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/douyin/video/audience\x12}\n" +
+	"\x0eAppealTakedown\x12\x1f.video.v1.AppealTakedownRequest\x1a .video.v1.AppealTakedownResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/douyin/video/takedown/appeal\x12{\n" +
+	"\x0fListMyTakedowns\x12 .video.v1.ListMyTakedownsRequest\x1a!.video.v1.ListMyTakedownsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/douyin/video/takedown/list\x12|\n" +
+	"\x10SetVideoCaptions\x12!.video.v1.SetVideoCaptionsRequest\x1a\".video.v1.SetVideoCaptionsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/douyin/video/captions\x12\x84\x01\n" +
+	"\x12SetVideoVisibility\x12#.video.v1.SetVideoVisibilityRequest\x1a$.video.v1.SetVideoVisibilityResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/douyin/video/visibility\x12y\n" +
+	"\x0fSchedulePublish\x12 .video.v1.SchedulePublishRequest\x1a!.video.v1.SchedulePublishResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/douyin/video/schedule\x12w\n" +
+	"\x0fUpdateVideoInfo\x12 .video.v1.UpdateVideoInfoRequest\x1a!.video.v1.UpdateVideoInfoResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/douyin/video/update\x12\x89\x01\n" +
+	"\x13SearchWithinCreator\x12$.video.v1.SearchWithinCreatorRequest\x1a%.video.v1.SearchWithinCreatorResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/douyin/video/captions/search\x12\x89\x01\n" +
+	"\x14RecordPromotionClick\x12%.video.v1.RecordPromotionClickRequest\x1a&.video.v1.RecordPromotionClickResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/douyin/promotion/click\x12\x87\x01\n" +
+	"\x13ListVideoCategories\x12$.video.v1.ListVideoCategoriesRequest\x1a%.video.v1.ListVideoCategoriesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/douyin/video/category/list\x12M\n" +
 	"\fGetVideoInfo\x12\x1d.video.v1.GetVideoInfoRequest\x1a\x1e.video.v1.GetVideoInfoResponse\x12P\n" +
 	"\rGetVideosInfo\x12\x1e.video.v1.GetVideosInfoRequest\x1a\x1f.video.v1.GetVideosInfoResponse\x12M\n" +
 	"\x10UpdateVideoStats\x12!.video.v1.UpdateVideoStatsRequest\x1a\x16.google.protobuf.Empty\x12\x9c\x01\n" +
@@ -2476,7 +5536,8 @@ const file_video_v1_video_proto_rawDesc = "" +
 	"UploadPart\x12\x1b.video.v1.UploadPartRequest\x1a\x1c.video.v1.UploadPartResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/douyin/upload/multipart/part\x12\x91\x01\n" +
 	"\x17CompleteMultipartUpload\x12(.video.v1.CompleteMultipartUploadRequest\x1a\x1e.video.v1.PublishVideoResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/douyin/upload/multipart/complete\x12\x80\x01\n" +
 	"\x14AbortMultipartUpload\x12%.video.v1.AbortMultipartUploadRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/douyin/upload/multipart/abort\x12\x90\x01\n" +
-	"\x11ListUploadedParts\x12\".video.v1.ListUploadedPartsRequest\x1a#.video.v1.ListUploadedPartsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/douyin/upload/multipart/{upload_id}/partsB\x1cZ\x1ago-backend/api/video/v1;v1b\x06proto3"
+	"\x11ListUploadedParts\x12\".video.v1.ListUploadedPartsRequest\x1a#.video.v1.ListUploadedPartsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/douyin/upload/multipart/{upload_id}/parts\x12\x82\x01\n" +
+	"\fVerifyUpload\x12\x1d.video.v1.VerifyUploadRequest\x1a\x1e.video.v1.VerifyUploadResponse\"3\x82\xd3\xe4\x93\x02-\x12+/douyin/upload/multipart/{upload_id}/verifyB\x1cZ\x1ago-backend/api/video/v1;v1b\x06proto3"
 
 var (
 	file_video_v1_video_proto_rawDescOnce sync.Once
@@ -2491,7 +5552,7 @@ func file_video_v1_video_proto_rawDescGZIP() []byte {
 }
 
 var file_video_v1_video_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_video_v1_video_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_video_v1_video_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_video_v1_video_proto_goTypes = []any{
 	(UploadStatus)(0),                       // 0: video.v1.UploadStatus
 	(UpdateVideoStatsType)(0),               // 1: video.v1.UpdateVideoStatsType
@@ -2510,99 +5571,218 @@ var file_video_v1_video_proto_goTypes = []any{
 	(*GetUploadConfigRequest)(nil),          // 14: video.v1.GetUploadConfigRequest
 	(*GetUploadConfigResponse)(nil),         // 15: video.v1.GetUploadConfigResponse
 	(*UploadConfig)(nil),                    // 16: video.v1.UploadConfig
-	(*GetUploadProgressRequest)(nil),        // 17: video.v1.GetUploadProgressRequest
-	(*GetUploadProgressResponse)(nil),       // 18: video.v1.GetUploadProgressResponse
-	(*UploadProgress)(nil),                  // 19: video.v1.UploadProgress
-	(*GetVideoInfoRequest)(nil),             // 20: video.v1.GetVideoInfoRequest
-	(*GetVideoInfoResponse)(nil),            // 21: video.v1.GetVideoInfoResponse
-	(*GetVideosInfoRequest)(nil),            // 22: video.v1.GetVideosInfoRequest
-	(*GetVideosInfoResponse)(nil),           // 23: video.v1.GetVideosInfoResponse
-	(*UpdateVideoStatsRequest)(nil),         // 24: video.v1.UpdateVideoStatsRequest
-	(*InitiateMultipartUploadRequest)(nil),  // 25: video.v1.InitiateMultipartUploadRequest
-	(*InitiateMultipartUploadResponse)(nil), // 26: video.v1.InitiateMultipartUploadResponse
-	(*MultipartUploadInfo)(nil),             // 27: video.v1.MultipartUploadInfo
-	(*UploadPartRequest)(nil),               // 28: video.v1.UploadPartRequest
-	(*UploadPartResponse)(nil),              // 29: video.v1.UploadPartResponse
-	(*PartInfo)(nil),                        // 30: video.v1.PartInfo
-	(*CompleteMultipartUploadRequest)(nil),  // 31: video.v1.CompleteMultipartUploadRequest
-	(*AbortMultipartUploadRequest)(nil),     // 32: video.v1.AbortMultipartUploadRequest
-	(*ListUploadedPartsRequest)(nil),        // 33: video.v1.ListUploadedPartsRequest
-	(*ListUploadedPartsResponse)(nil),       // 34: video.v1.ListUploadedPartsResponse
-	(*ListUploadedPartsData)(nil),           // 35: video.v1.ListUploadedPartsData
-	(*UploadProgressDetail)(nil),            // 36: video.v1.UploadProgressDetail
-	nil,                                     // 37: video.v1.FileMetadata.ExtraEntry
-	nil,                                     // 38: video.v1.UploadConfig.ExtraConfigEntry
-	nil,                                     // 39: video.v1.MultipartUploadInfo.UploadUrlsEntry
-	(*v1.BaseResponse)(nil),                 // 40: common.v1.BaseResponse
-	(*v1.Video)(nil),                        // 41: common.v1.Video
-	(*emptypb.Empty)(nil),                   // 42: google.protobuf.Empty
+	(*GetVideoShareCardRequest)(nil),        // 17: video.v1.GetVideoShareCardRequest
+	(*GetVideoShareCardResponse)(nil),       // 18: video.v1.GetVideoShareCardResponse
+	(*ShareVideoRequest)(nil),               // 19: video.v1.ShareVideoRequest
+	(*ShareVideoResponse)(nil),              // 20: video.v1.ShareVideoResponse
+	(*ResolveShareLinkRequest)(nil),         // 21: video.v1.ResolveShareLinkRequest
+	(*ResolveShareLinkResponse)(nil),        // 22: video.v1.ResolveShareLinkResponse
+	(*RecordViewRequest)(nil),               // 23: video.v1.RecordViewRequest
+	(*RecordViewResponse)(nil),              // 24: video.v1.RecordViewResponse
+	(*ReportPlayRequest)(nil),               // 25: video.v1.ReportPlayRequest
+	(*ReportPlayResponse)(nil),              // 26: video.v1.ReportPlayResponse
+	(*GetTrendingRequest)(nil),              // 27: video.v1.GetTrendingRequest
+	(*GetTrendingResponse)(nil),             // 28: video.v1.GetTrendingResponse
+	(*GetWatchHistoryRequest)(nil),          // 29: video.v1.GetWatchHistoryRequest
+	(*GetWatchHistoryResponse)(nil),         // 30: video.v1.GetWatchHistoryResponse
+	(*WatchHistoryItem)(nil),                // 31: video.v1.WatchHistoryItem
+	(*GetVideoAudienceRequest)(nil),         // 32: video.v1.GetVideoAudienceRequest
+	(*AudienceSplit)(nil),                   // 33: video.v1.AudienceSplit
+	(*SourceViews)(nil),                     // 34: video.v1.SourceViews
+	(*VideoAudience)(nil),                   // 35: video.v1.VideoAudience
+	(*GetVideoAudienceResponse)(nil),        // 36: video.v1.GetVideoAudienceResponse
+	(*AppealTakedownRequest)(nil),           // 37: video.v1.AppealTakedownRequest
+	(*AppealTakedownResponse)(nil),          // 38: video.v1.AppealTakedownResponse
+	(*ListMyTakedownsRequest)(nil),          // 39: video.v1.ListMyTakedownsRequest
+	(*ListMyTakedownsResponse)(nil),         // 40: video.v1.ListMyTakedownsResponse
+	(*ListMyTakedownsData)(nil),             // 41: video.v1.ListMyTakedownsData
+	(*ListVideoCategoriesRequest)(nil),      // 42: video.v1.ListVideoCategoriesRequest
+	(*ListVideoCategoriesResponse)(nil),     // 43: video.v1.ListVideoCategoriesResponse
+	(*SetVideoCaptionsRequest)(nil),         // 44: video.v1.SetVideoCaptionsRequest
+	(*SetVideoCaptionsResponse)(nil),        // 45: video.v1.SetVideoCaptionsResponse
+	(*SetVideoVisibilityRequest)(nil),       // 46: video.v1.SetVideoVisibilityRequest
+	(*SetVideoVisibilityResponse)(nil),      // 47: video.v1.SetVideoVisibilityResponse
+	(*SchedulePublishRequest)(nil),          // 48: video.v1.SchedulePublishRequest
+	(*SchedulePublishResponse)(nil),         // 49: video.v1.SchedulePublishResponse
+	(*UpdateVideoInfoRequest)(nil),          // 50: video.v1.UpdateVideoInfoRequest
+	(*UpdateVideoInfoResponse)(nil),         // 51: video.v1.UpdateVideoInfoResponse
+	(*SearchWithinCreatorRequest)(nil),      // 52: video.v1.SearchWithinCreatorRequest
+	(*CaptionHit)(nil),                      // 53: video.v1.CaptionHit
+	(*CaptionSearchResult)(nil),             // 54: video.v1.CaptionSearchResult
+	(*SearchWithinCreatorResponse)(nil),     // 55: video.v1.SearchWithinCreatorResponse
+	(*RecordPromotionClickRequest)(nil),     // 56: video.v1.RecordPromotionClickRequest
+	(*RecordPromotionClickResponse)(nil),    // 57: video.v1.RecordPromotionClickResponse
+	(*GetUploadProgressRequest)(nil),        // 58: video.v1.GetUploadProgressRequest
+	(*GetUploadProgressResponse)(nil),       // 59: video.v1.GetUploadProgressResponse
+	(*UploadProgress)(nil),                  // 60: video.v1.UploadProgress
+	(*GetVideoInfoRequest)(nil),             // 61: video.v1.GetVideoInfoRequest
+	(*GetVideoInfoResponse)(nil),            // 62: video.v1.GetVideoInfoResponse
+	(*GetVideosInfoRequest)(nil),            // 63: video.v1.GetVideosInfoRequest
+	(*GetVideosInfoResponse)(nil),           // 64: video.v1.GetVideosInfoResponse
+	(*UpdateVideoStatsRequest)(nil),         // 65: video.v1.UpdateVideoStatsRequest
+	(*InitiateMultipartUploadRequest)(nil),  // 66: video.v1.InitiateMultipartUploadRequest
+	(*InitiateMultipartUploadResponse)(nil), // 67: video.v1.InitiateMultipartUploadResponse
+	(*MultipartUploadInfo)(nil),             // 68: video.v1.MultipartUploadInfo
+	(*UploadPartRequest)(nil),               // 69: video.v1.UploadPartRequest
+	(*UploadPartResponse)(nil),              // 70: video.v1.UploadPartResponse
+	(*PartInfo)(nil),                        // 71: video.v1.PartInfo
+	(*CompleteMultipartUploadRequest)(nil),  // 72: video.v1.CompleteMultipartUploadRequest
+	(*AbortMultipartUploadRequest)(nil),     // 73: video.v1.AbortMultipartUploadRequest
+	(*ListUploadedPartsRequest)(nil),        // 74: video.v1.ListUploadedPartsRequest
+	(*ListUploadedPartsResponse)(nil),       // 75: video.v1.ListUploadedPartsResponse
+	(*ListUploadedPartsData)(nil),           // 76: video.v1.ListUploadedPartsData
+	(*VerifyUploadRequest)(nil),             // 77: video.v1.VerifyUploadRequest
+	(*VerifyUploadResponse)(nil),            // 78: video.v1.VerifyUploadResponse
+	(*VerifyUploadData)(nil),                // 79: video.v1.VerifyUploadData
+	(*PartChecksum)(nil),                    // 80: video.v1.PartChecksum
+	(*UploadProgressDetail)(nil),            // 81: video.v1.UploadProgressDetail
+	nil,                                     // 82: video.v1.FileMetadata.ExtraEntry
+	nil,                                     // 83: video.v1.UploadConfig.ExtraConfigEntry
+	nil,                                     // 84: video.v1.MultipartUploadInfo.UploadUrlsEntry
+	(*v1.BaseResponse)(nil),                 // 85: common.v1.BaseResponse
+	(*v1.Video)(nil),                        // 86: common.v1.Video
+	(*v1.VideoTakedown)(nil),                // 87: common.v1.VideoTakedown
+	(*v1.VideoCategory)(nil),                // 88: common.v1.VideoCategory
+	(*emptypb.Empty)(nil),                   // 89: google.protobuf.Empty
 }
 var file_video_v1_video_proto_depIdxs = []int32{
-	40, // 0: video.v1.GetFeedResponse.base:type_name -> common.v1.BaseResponse
-	4,  // 1: video.v1.GetFeedResponse.data:type_name -> video.v1.GetFeedData
-	41, // 2: video.v1.GetFeedData.video_list:type_name -> common.v1.Video
-	6,  // 3: video.v1.PublishVideoRequest.file_info:type_name -> video.v1.FileUploadInfo
-	8,  // 4: video.v1.UploadVideoFileRequest.metadata:type_name -> video.v1.FileMetadata
-	37, // 5: video.v1.FileMetadata.extra:type_name -> video.v1.FileMetadata.ExtraEntry
-	40, // 6: video.v1.PublishVideoResponse.base:type_name -> common.v1.BaseResponse
-	10, // 7: video.v1.PublishVideoResponse.data:type_name -> video.v1.PublishVideoData
-	0,  // 8: video.v1.PublishVideoData.status:type_name -> video.v1.UploadStatus
-	40, // 9: video.v1.GetPublishListResponse.base:type_name -> common.v1.BaseResponse
-	13, // 10: video.v1.GetPublishListResponse.data:type_name -> video.v1.GetPublishListData
-	41, // 11: video.v1.GetPublishListData.video_list:type_name -> common.v1.Video
-	40, // 12: video.v1.GetUploadConfigResponse.base:type_name -> common.v1.BaseResponse
-	16, // 13: video.v1.GetUploadConfigResponse.data:type_name -> video.v1.UploadConfig
-	38, // 14: video.v1.UploadConfig.extra_config:type_name -> video.v1.UploadConfig.ExtraConfigEntry
-	40, // 15: video.v1.GetUploadProgressResponse.base:type_name -> common.v1.BaseResponse
-	19, // 16: video.v1.GetUploadProgressResponse.data:type_name -> video.v1.UploadProgress
-	0,  // 17: video.v1.UploadProgress.status:type_name -> video.v1.UploadStatus
-	41, // 18: video.v1.GetVideoInfoResponse.video:type_name -> common.v1.Video
-	41, // 19: video.v1.GetVideosInfoResponse.videos:type_name -> common.v1.Video
-	1,  // 20: video.v1.UpdateVideoStatsRequest.type:type_name -> video.v1.UpdateVideoStatsType
-	40, // 21: video.v1.InitiateMultipartUploadResponse.base:type_name -> common.v1.BaseResponse
-	27, // 22: video.v1.InitiateMultipartUploadResponse.data:type_name -> video.v1.MultipartUploadInfo
-	39, // 23: video.v1.MultipartUploadInfo.upload_urls:type_name -> video.v1.MultipartUploadInfo.UploadUrlsEntry
-	40, // 24: video.v1.UploadPartResponse.base:type_name -> common.v1.BaseResponse
-	30, // 25: video.v1.UploadPartResponse.data:type_name -> video.v1.PartInfo
-	30, // 26: video.v1.CompleteMultipartUploadRequest.parts:type_name -> video.v1.PartInfo
-	40, // 27: video.v1.ListUploadedPartsResponse.base:type_name -> common.v1.BaseResponse
-	35, // 28: video.v1.ListUploadedPartsResponse.data:type_name -> video.v1.ListUploadedPartsData
-	30, // 29: video.v1.ListUploadedPartsData.parts:type_name -> video.v1.PartInfo
-	0,  // 30: video.v1.UploadProgressDetail.status:type_name -> video.v1.UploadStatus
-	30, // 31: video.v1.UploadProgressDetail.completed_parts:type_name -> video.v1.PartInfo
-	2,  // 32: video.v1.VideoService.GetFeed:input_type -> video.v1.GetFeedRequest
-	5,  // 33: video.v1.VideoService.PublishVideo:input_type -> video.v1.PublishVideoRequest
-	7,  // 34: video.v1.VideoService.UploadVideoFile:input_type -> video.v1.UploadVideoFileRequest
-	11, // 35: video.v1.VideoService.GetPublishList:input_type -> video.v1.GetPublishListRequest
-	14, // 36: video.v1.VideoService.GetUploadConfig:input_type -> video.v1.GetUploadConfigRequest
-	17, // 37: video.v1.VideoService.GetUploadProgress:input_type -> video.v1.GetUploadProgressRequest
-	20, // 38: video.v1.VideoService.GetVideoInfo:input_type -> video.v1.GetVideoInfoRequest
-	22, // 39: video.v1.VideoService.GetVideosInfo:input_type -> video.v1.GetVideosInfoRequest
-	24, // 40: video.v1.VideoService.UpdateVideoStats:input_type -> video.v1.UpdateVideoStatsRequest
-	25, // 41: video.v1.VideoService.InitiateMultipartUpload:input_type -> video.v1.InitiateMultipartUploadRequest
-	28, // 42: video.v1.VideoService.UploadPart:input_type -> video.v1.UploadPartRequest
-	31, // 43: video.v1.VideoService.CompleteMultipartUpload:input_type -> video.v1.CompleteMultipartUploadRequest
-	32, // 44: video.v1.VideoService.AbortMultipartUpload:input_type -> video.v1.AbortMultipartUploadRequest
-	33, // 45: video.v1.VideoService.ListUploadedParts:input_type -> video.v1.ListUploadedPartsRequest
-	3,  // 46: video.v1.VideoService.GetFeed:output_type -> video.v1.GetFeedResponse
-	9,  // 47: video.v1.VideoService.PublishVideo:output_type -> video.v1.PublishVideoResponse
-	9,  // 48: video.v1.VideoService.UploadVideoFile:output_type -> video.v1.PublishVideoResponse
-	12, // 49: video.v1.VideoService.GetPublishList:output_type -> video.v1.GetPublishListResponse
-	15, // 50: video.v1.VideoService.GetUploadConfig:output_type -> video.v1.GetUploadConfigResponse
-	18, // 51: video.v1.VideoService.GetUploadProgress:output_type -> video.v1.GetUploadProgressResponse
-	21, // 52: video.v1.VideoService.GetVideoInfo:output_type -> video.v1.GetVideoInfoResponse
-	23, // 53: video.v1.VideoService.GetVideosInfo:output_type -> video.v1.GetVideosInfoResponse
-	42, // 54: video.v1.VideoService.UpdateVideoStats:output_type -> google.protobuf.Empty
-	26, // 55: video.v1.VideoService.InitiateMultipartUpload:output_type -> video.v1.InitiateMultipartUploadResponse
-	29, // 56: video.v1.VideoService.UploadPart:output_type -> video.v1.UploadPartResponse
-	9,  // 57: video.v1.VideoService.CompleteMultipartUpload:output_type -> video.v1.PublishVideoResponse
-	42, // 58: video.v1.VideoService.AbortMultipartUpload:output_type -> google.protobuf.Empty
-	34, // 59: video.v1.VideoService.ListUploadedParts:output_type -> video.v1.ListUploadedPartsResponse
-	46, // [46:60] is the sub-list for method output_type
-	32, // [32:46] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	85,  // 0: video.v1.GetFeedResponse.base:type_name -> common.v1.BaseResponse
+	4,   // 1: video.v1.GetFeedResponse.data:type_name -> video.v1.GetFeedData
+	86,  // 2: video.v1.GetFeedData.video_list:type_name -> common.v1.Video
+	6,   // 3: video.v1.PublishVideoRequest.file_info:type_name -> video.v1.FileUploadInfo
+	8,   // 4: video.v1.UploadVideoFileRequest.metadata:type_name -> video.v1.FileMetadata
+	82,  // 5: video.v1.FileMetadata.extra:type_name -> video.v1.FileMetadata.ExtraEntry
+	85,  // 6: video.v1.PublishVideoResponse.base:type_name -> common.v1.BaseResponse
+	10,  // 7: video.v1.PublishVideoResponse.data:type_name -> video.v1.PublishVideoData
+	0,   // 8: video.v1.PublishVideoData.status:type_name -> video.v1.UploadStatus
+	85,  // 9: video.v1.GetPublishListResponse.base:type_name -> common.v1.BaseResponse
+	13,  // 10: video.v1.GetPublishListResponse.data:type_name -> video.v1.GetPublishListData
+	86,  // 11: video.v1.GetPublishListData.video_list:type_name -> common.v1.Video
+	85,  // 12: video.v1.GetUploadConfigResponse.base:type_name -> common.v1.BaseResponse
+	16,  // 13: video.v1.GetUploadConfigResponse.data:type_name -> video.v1.UploadConfig
+	83,  // 14: video.v1.UploadConfig.extra_config:type_name -> video.v1.UploadConfig.ExtraConfigEntry
+	85,  // 15: video.v1.GetVideoShareCardResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 16: video.v1.ShareVideoResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 17: video.v1.ResolveShareLinkResponse.base:type_name -> common.v1.BaseResponse
+	86,  // 18: video.v1.ResolveShareLinkResponse.video:type_name -> common.v1.Video
+	85,  // 19: video.v1.RecordViewResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 20: video.v1.ReportPlayResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 21: video.v1.GetTrendingResponse.base:type_name -> common.v1.BaseResponse
+	86,  // 22: video.v1.GetTrendingResponse.video_list:type_name -> common.v1.Video
+	85,  // 23: video.v1.GetWatchHistoryResponse.base:type_name -> common.v1.BaseResponse
+	31,  // 24: video.v1.GetWatchHistoryResponse.items:type_name -> video.v1.WatchHistoryItem
+	86,  // 25: video.v1.WatchHistoryItem.video:type_name -> common.v1.Video
+	33,  // 26: video.v1.VideoAudience.views:type_name -> video.v1.AudienceSplit
+	33,  // 27: video.v1.VideoAudience.likes:type_name -> video.v1.AudienceSplit
+	34,  // 28: video.v1.VideoAudience.source_views:type_name -> video.v1.SourceViews
+	85,  // 29: video.v1.GetVideoAudienceResponse.base:type_name -> common.v1.BaseResponse
+	35,  // 30: video.v1.GetVideoAudienceResponse.data:type_name -> video.v1.VideoAudience
+	85,  // 31: video.v1.AppealTakedownResponse.base:type_name -> common.v1.BaseResponse
+	87,  // 32: video.v1.AppealTakedownResponse.takedown:type_name -> common.v1.VideoTakedown
+	85,  // 33: video.v1.ListMyTakedownsResponse.base:type_name -> common.v1.BaseResponse
+	41,  // 34: video.v1.ListMyTakedownsResponse.data:type_name -> video.v1.ListMyTakedownsData
+	87,  // 35: video.v1.ListMyTakedownsData.takedown_list:type_name -> common.v1.VideoTakedown
+	85,  // 36: video.v1.ListVideoCategoriesResponse.base:type_name -> common.v1.BaseResponse
+	88,  // 37: video.v1.ListVideoCategoriesResponse.category_list:type_name -> common.v1.VideoCategory
+	85,  // 38: video.v1.SetVideoCaptionsResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 39: video.v1.SetVideoVisibilityResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 40: video.v1.SchedulePublishResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 41: video.v1.UpdateVideoInfoResponse.base:type_name -> common.v1.BaseResponse
+	86,  // 42: video.v1.UpdateVideoInfoResponse.video:type_name -> common.v1.Video
+	86,  // 43: video.v1.CaptionSearchResult.video:type_name -> common.v1.Video
+	53,  // 44: video.v1.CaptionSearchResult.hits:type_name -> video.v1.CaptionHit
+	85,  // 45: video.v1.SearchWithinCreatorResponse.base:type_name -> common.v1.BaseResponse
+	54,  // 46: video.v1.SearchWithinCreatorResponse.result_list:type_name -> video.v1.CaptionSearchResult
+	85,  // 47: video.v1.RecordPromotionClickResponse.base:type_name -> common.v1.BaseResponse
+	85,  // 48: video.v1.GetUploadProgressResponse.base:type_name -> common.v1.BaseResponse
+	60,  // 49: video.v1.GetUploadProgressResponse.data:type_name -> video.v1.UploadProgress
+	0,   // 50: video.v1.UploadProgress.status:type_name -> video.v1.UploadStatus
+	86,  // 51: video.v1.GetVideoInfoResponse.video:type_name -> common.v1.Video
+	86,  // 52: video.v1.GetVideosInfoResponse.videos:type_name -> common.v1.Video
+	1,   // 53: video.v1.UpdateVideoStatsRequest.type:type_name -> video.v1.UpdateVideoStatsType
+	85,  // 54: video.v1.InitiateMultipartUploadResponse.base:type_name -> common.v1.BaseResponse
+	68,  // 55: video.v1.InitiateMultipartUploadResponse.data:type_name -> video.v1.MultipartUploadInfo
+	84,  // 56: video.v1.MultipartUploadInfo.upload_urls:type_name -> video.v1.MultipartUploadInfo.UploadUrlsEntry
+	85,  // 57: video.v1.UploadPartResponse.base:type_name -> common.v1.BaseResponse
+	71,  // 58: video.v1.UploadPartResponse.data:type_name -> video.v1.PartInfo
+	71,  // 59: video.v1.CompleteMultipartUploadRequest.parts:type_name -> video.v1.PartInfo
+	85,  // 60: video.v1.ListUploadedPartsResponse.base:type_name -> common.v1.BaseResponse
+	76,  // 61: video.v1.ListUploadedPartsResponse.data:type_name -> video.v1.ListUploadedPartsData
+	71,  // 62: video.v1.ListUploadedPartsData.parts:type_name -> video.v1.PartInfo
+	85,  // 63: video.v1.VerifyUploadResponse.base:type_name -> common.v1.BaseResponse
+	79,  // 64: video.v1.VerifyUploadResponse.data:type_name -> video.v1.VerifyUploadData
+	80,  // 65: video.v1.VerifyUploadData.parts:type_name -> video.v1.PartChecksum
+	0,   // 66: video.v1.UploadProgressDetail.status:type_name -> video.v1.UploadStatus
+	71,  // 67: video.v1.UploadProgressDetail.completed_parts:type_name -> video.v1.PartInfo
+	2,   // 68: video.v1.VideoService.GetFeed:input_type -> video.v1.GetFeedRequest
+	5,   // 69: video.v1.VideoService.PublishVideo:input_type -> video.v1.PublishVideoRequest
+	7,   // 70: video.v1.VideoService.UploadVideoFile:input_type -> video.v1.UploadVideoFileRequest
+	11,  // 71: video.v1.VideoService.GetPublishList:input_type -> video.v1.GetPublishListRequest
+	14,  // 72: video.v1.VideoService.GetUploadConfig:input_type -> video.v1.GetUploadConfigRequest
+	58,  // 73: video.v1.VideoService.GetUploadProgress:input_type -> video.v1.GetUploadProgressRequest
+	17,  // 74: video.v1.VideoService.GetVideoShareCard:input_type -> video.v1.GetVideoShareCardRequest
+	19,  // 75: video.v1.VideoService.ShareVideo:input_type -> video.v1.ShareVideoRequest
+	21,  // 76: video.v1.VideoService.ResolveShareLink:input_type -> video.v1.ResolveShareLinkRequest
+	23,  // 77: video.v1.VideoService.RecordView:input_type -> video.v1.RecordViewRequest
+	25,  // 78: video.v1.VideoService.ReportPlay:input_type -> video.v1.ReportPlayRequest
+	27,  // 79: video.v1.VideoService.GetTrending:input_type -> video.v1.GetTrendingRequest
+	29,  // 80: video.v1.VideoService.GetWatchHistory:input_type -> video.v1.GetWatchHistoryRequest
+	32,  // 81: video.v1.VideoService.GetVideoAudience:input_type -> video.v1.GetVideoAudienceRequest
+	37,  // 82: video.v1.VideoService.AppealTakedown:input_type -> video.v1.AppealTakedownRequest
+	39,  // 83: video.v1.VideoService.ListMyTakedowns:input_type -> video.v1.ListMyTakedownsRequest
+	44,  // 84: video.v1.VideoService.SetVideoCaptions:input_type -> video.v1.SetVideoCaptionsRequest
+	46,  // 85: video.v1.VideoService.SetVideoVisibility:input_type -> video.v1.SetVideoVisibilityRequest
+	48,  // 86: video.v1.VideoService.SchedulePublish:input_type -> video.v1.SchedulePublishRequest
+	50,  // 87: video.v1.VideoService.UpdateVideoInfo:input_type -> video.v1.UpdateVideoInfoRequest
+	52,  // 88: video.v1.VideoService.SearchWithinCreator:input_type -> video.v1.SearchWithinCreatorRequest
+	56,  // 89: video.v1.VideoService.RecordPromotionClick:input_type -> video.v1.RecordPromotionClickRequest
+	42,  // 90: video.v1.VideoService.ListVideoCategories:input_type -> video.v1.ListVideoCategoriesRequest
+	61,  // 91: video.v1.VideoService.GetVideoInfo:input_type -> video.v1.GetVideoInfoRequest
+	63,  // 92: video.v1.VideoService.GetVideosInfo:input_type -> video.v1.GetVideosInfoRequest
+	65,  // 93: video.v1.VideoService.UpdateVideoStats:input_type -> video.v1.UpdateVideoStatsRequest
+	66,  // 94: video.v1.VideoService.InitiateMultipartUpload:input_type -> video.v1.InitiateMultipartUploadRequest
+	69,  // 95: video.v1.VideoService.UploadPart:input_type -> video.v1.UploadPartRequest
+	72,  // 96: video.v1.VideoService.CompleteMultipartUpload:input_type -> video.v1.CompleteMultipartUploadRequest
+	73,  // 97: video.v1.VideoService.AbortMultipartUpload:input_type -> video.v1.AbortMultipartUploadRequest
+	74,  // 98: video.v1.VideoService.ListUploadedParts:input_type -> video.v1.ListUploadedPartsRequest
+	77,  // 99: video.v1.VideoService.VerifyUpload:input_type -> video.v1.VerifyUploadRequest
+	3,   // 100: video.v1.VideoService.GetFeed:output_type -> video.v1.GetFeedResponse
+	9,   // 101: video.v1.VideoService.PublishVideo:output_type -> video.v1.PublishVideoResponse
+	9,   // 102: video.v1.VideoService.UploadVideoFile:output_type -> video.v1.PublishVideoResponse
+	12,  // 103: video.v1.VideoService.GetPublishList:output_type -> video.v1.GetPublishListResponse
+	15,  // 104: video.v1.VideoService.GetUploadConfig:output_type -> video.v1.GetUploadConfigResponse
+	59,  // 105: video.v1.VideoService.GetUploadProgress:output_type -> video.v1.GetUploadProgressResponse
+	18,  // 106: video.v1.VideoService.GetVideoShareCard:output_type -> video.v1.GetVideoShareCardResponse
+	20,  // 107: video.v1.VideoService.ShareVideo:output_type -> video.v1.ShareVideoResponse
+	22,  // 108: video.v1.VideoService.ResolveShareLink:output_type -> video.v1.ResolveShareLinkResponse
+	24,  // 109: video.v1.VideoService.RecordView:output_type -> video.v1.RecordViewResponse
+	26,  // 110: video.v1.VideoService.ReportPlay:output_type -> video.v1.ReportPlayResponse
+	28,  // 111: video.v1.VideoService.GetTrending:output_type -> video.v1.GetTrendingResponse
+	30,  // 112: video.v1.VideoService.GetWatchHistory:output_type -> video.v1.GetWatchHistoryResponse
+	36,  // 113: video.v1.VideoService.GetVideoAudience:output_type -> video.v1.GetVideoAudienceResponse
+	38,  // 114: video.v1.VideoService.AppealTakedown:output_type -> video.v1.AppealTakedownResponse
+	40,  // 115: video.v1.VideoService.ListMyTakedowns:output_type -> video.v1.ListMyTakedownsResponse
+	45,  // 116: video.v1.VideoService.SetVideoCaptions:output_type -> video.v1.SetVideoCaptionsResponse
+	47,  // 117: video.v1.VideoService.SetVideoVisibility:output_type -> video.v1.SetVideoVisibilityResponse
+	49,  // 118: video.v1.VideoService.SchedulePublish:output_type -> video.v1.SchedulePublishResponse
+	51,  // 119: video.v1.VideoService.UpdateVideoInfo:output_type -> video.v1.UpdateVideoInfoResponse
+	55,  // 120: video.v1.VideoService.SearchWithinCreator:output_type -> video.v1.SearchWithinCreatorResponse
+	57,  // 121: video.v1.VideoService.RecordPromotionClick:output_type -> video.v1.RecordPromotionClickResponse
+	43,  // 122: video.v1.VideoService.ListVideoCategories:output_type -> video.v1.ListVideoCategoriesResponse
+	62,  // 123: video.v1.VideoService.GetVideoInfo:output_type -> video.v1.GetVideoInfoResponse
+	64,  // 124: video.v1.VideoService.GetVideosInfo:output_type -> video.v1.GetVideosInfoResponse
+	89,  // 125: video.v1.VideoService.UpdateVideoStats:output_type -> google.protobuf.Empty
+	67,  // 126: video.v1.VideoService.InitiateMultipartUpload:output_type -> video.v1.InitiateMultipartUploadResponse
+	70,  // 127: video.v1.VideoService.UploadPart:output_type -> video.v1.UploadPartResponse
+	9,   // 128: video.v1.VideoService.CompleteMultipartUpload:output_type -> video.v1.PublishVideoResponse
+	89,  // 129: video.v1.VideoService.AbortMultipartUpload:output_type -> google.protobuf.Empty
+	75,  // 130: video.v1.VideoService.ListUploadedParts:output_type -> video.v1.ListUploadedPartsResponse
+	78,  // 131: video.v1.VideoService.VerifyUpload:output_type -> video.v1.VerifyUploadResponse
+	100, // [100:132] is the sub-list for method output_type
+	68,  // [68:100] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_video_v1_video_proto_init() }
@@ -2620,7 +5800,7 @@ func file_video_v1_video_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_video_v1_video_proto_rawDesc), len(file_video_v1_video_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   38,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
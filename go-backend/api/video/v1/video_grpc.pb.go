@@ -26,6 +26,23 @@ const (
 	VideoService_GetPublishList_FullMethodName          = "/video.v1.VideoService/GetPublishList"
 	VideoService_GetUploadConfig_FullMethodName         = "/video.v1.VideoService/GetUploadConfig"
 	VideoService_GetUploadProgress_FullMethodName       = "/video.v1.VideoService/GetUploadProgress"
+	VideoService_GetVideoShareCard_FullMethodName       = "/video.v1.VideoService/GetVideoShareCard"
+	VideoService_ShareVideo_FullMethodName              = "/video.v1.VideoService/ShareVideo"
+	VideoService_ResolveShareLink_FullMethodName        = "/video.v1.VideoService/ResolveShareLink"
+	VideoService_RecordView_FullMethodName              = "/video.v1.VideoService/RecordView"
+	VideoService_ReportPlay_FullMethodName              = "/video.v1.VideoService/ReportPlay"
+	VideoService_GetTrending_FullMethodName             = "/video.v1.VideoService/GetTrending"
+	VideoService_GetWatchHistory_FullMethodName         = "/video.v1.VideoService/GetWatchHistory"
+	VideoService_GetVideoAudience_FullMethodName        = "/video.v1.VideoService/GetVideoAudience"
+	VideoService_AppealTakedown_FullMethodName          = "/video.v1.VideoService/AppealTakedown"
+	VideoService_ListMyTakedowns_FullMethodName         = "/video.v1.VideoService/ListMyTakedowns"
+	VideoService_SetVideoCaptions_FullMethodName        = "/video.v1.VideoService/SetVideoCaptions"
+	VideoService_SetVideoVisibility_FullMethodName      = "/video.v1.VideoService/SetVideoVisibility"
+	VideoService_SchedulePublish_FullMethodName         = "/video.v1.VideoService/SchedulePublish"
+	VideoService_UpdateVideoInfo_FullMethodName         = "/video.v1.VideoService/UpdateVideoInfo"
+	VideoService_SearchWithinCreator_FullMethodName     = "/video.v1.VideoService/SearchWithinCreator"
+	VideoService_RecordPromotionClick_FullMethodName    = "/video.v1.VideoService/RecordPromotionClick"
+	VideoService_ListVideoCategories_FullMethodName     = "/video.v1.VideoService/ListVideoCategories"
 	VideoService_GetVideoInfo_FullMethodName            = "/video.v1.VideoService/GetVideoInfo"
 	VideoService_GetVideosInfo_FullMethodName           = "/video.v1.VideoService/GetVideosInfo"
 	VideoService_UpdateVideoStats_FullMethodName        = "/video.v1.VideoService/UpdateVideoStats"
@@ -34,6 +51,7 @@ const (
 	VideoService_CompleteMultipartUpload_FullMethodName = "/video.v1.VideoService/CompleteMultipartUpload"
 	VideoService_AbortMultipartUpload_FullMethodName    = "/video.v1.VideoService/AbortMultipartUpload"
 	VideoService_ListUploadedParts_FullMethodName       = "/video.v1.VideoService/ListUploadedParts"
+	VideoService_VerifyUpload_FullMethodName            = "/video.v1.VideoService/VerifyUpload"
 )
 
 // VideoServiceClient is the client API for VideoService service.
@@ -54,6 +72,40 @@ type VideoServiceClient interface {
 	GetUploadConfig(ctx context.Context, in *GetUploadConfigRequest, opts ...grpc.CallOption) (*GetUploadConfigResponse, error)
 	// 获取上传进度
 	GetUploadProgress(ctx context.Context, in *GetUploadProgressRequest, opts ...grpc.CallOption) (*GetUploadProgressResponse, error)
+	// 获取视频分享卡片
+	GetVideoShareCard(ctx context.Context, in *GetVideoShareCardRequest, opts ...grpc.CallOption) (*GetVideoShareCardResponse, error)
+	// 分享视频，计入视频的分享数并返回分享者的分享短链
+	ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareVideoResponse, error)
+	// 解析分享短链，返回视频和分享者
+	ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*ResolveShareLinkResponse, error)
+	// 记录观看
+	RecordView(ctx context.Context, in *RecordViewRequest, opts ...grpc.CallOption) (*RecordViewResponse, error)
+	// 上报播放
+	ReportPlay(ctx context.Context, in *ReportPlayRequest, opts ...grpc.CallOption) (*ReportPlayResponse, error)
+	// 获取热门视频
+	GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*GetTrendingResponse, error)
+	// 获取观看记录
+	GetWatchHistory(ctx context.Context, in *GetWatchHistoryRequest, opts ...grpc.CallOption) (*GetWatchHistoryResponse, error)
+	// 获取视频受众分析，仅视频作者可用
+	GetVideoAudience(ctx context.Context, in *GetVideoAudienceRequest, opts ...grpc.CallOption) (*GetVideoAudienceResponse, error)
+	// 创作者对视频下架提出申诉，每条下架记录只能申诉一次
+	AppealTakedown(ctx context.Context, in *AppealTakedownRequest, opts ...grpc.CallOption) (*AppealTakedownResponse, error)
+	// 创作者查询自己被下架的视频及申诉进度
+	ListMyTakedowns(ctx context.Context, in *ListMyTakedownsRequest, opts ...grpc.CallOption) (*ListMyTakedownsResponse, error)
+	// 创作者上传视频字幕（WebVTT 或 SRT），替换该语言已有的字幕并建立全文索引
+	SetVideoCaptions(ctx context.Context, in *SetVideoCaptionsRequest, opts ...grpc.CallOption) (*SetVideoCaptionsResponse, error)
+	// 作者修改视频的可见范围
+	SetVideoVisibility(ctx context.Context, in *SetVideoVisibilityRequest, opts ...grpc.CallOption) (*SetVideoVisibilityResponse, error)
+	// 作者修改草稿的计划发布时间或立即发布
+	SchedulePublish(ctx context.Context, in *SchedulePublishRequest, opts ...grpc.CallOption) (*SchedulePublishResponse, error)
+	// 作者或审核员修改视频的标题、话题和封面
+	UpdateVideoInfo(ctx context.Context, in *UpdateVideoInfoRequest, opts ...grpc.CallOption) (*UpdateVideoInfoResponse, error)
+	// 按口播内容在创作者已发布的视频中检索，返回命中的字幕段及跳转到对应播放位置的链接
+	SearchWithinCreator(ctx context.Context, in *SearchWithinCreatorRequest, opts ...grpc.CallOption) (*SearchWithinCreatorResponse, error)
+	// 上报用户点击视频流中的推广视频，用于推广计费
+	RecordPromotionClick(ctx context.Context, in *RecordPromotionClickRequest, opts ...grpc.CallOption) (*RecordPromotionClickResponse, error)
+	// 查询可选的视频分类，发布时选择、视频流按分类筛选，可附带各分类的视频数作为搜索分面
+	ListVideoCategories(ctx context.Context, in *ListVideoCategoriesRequest, opts ...grpc.CallOption) (*ListVideoCategoriesResponse, error)
 	// gRPC内部调用接口
 	GetVideoInfo(ctx context.Context, in *GetVideoInfoRequest, opts ...grpc.CallOption) (*GetVideoInfoResponse, error)
 	GetVideosInfo(ctx context.Context, in *GetVideosInfoRequest, opts ...grpc.CallOption) (*GetVideosInfoResponse, error)
@@ -68,6 +120,8 @@ type VideoServiceClient interface {
 	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 列出已上传的分片
 	ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...grpc.CallOption) (*ListUploadedPartsResponse, error)
+	// 查询分片上传的校验状态
+	VerifyUpload(ctx context.Context, in *VerifyUploadRequest, opts ...grpc.CallOption) (*VerifyUploadResponse, error)
 }
 
 type videoServiceClient struct {
@@ -138,6 +192,176 @@ func (c *videoServiceClient) GetUploadProgress(ctx context.Context, in *GetUploa
 	return out, nil
 }
 
+func (c *videoServiceClient) GetVideoShareCard(ctx context.Context, in *GetVideoShareCardRequest, opts ...grpc.CallOption) (*GetVideoShareCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVideoShareCardResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetVideoShareCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_ShareVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*ResolveShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveShareLinkResponse)
+	err := c.cc.Invoke(ctx, VideoService_ResolveShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RecordView(ctx context.Context, in *RecordViewRequest, opts ...grpc.CallOption) (*RecordViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordViewResponse)
+	err := c.cc.Invoke(ctx, VideoService_RecordView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReportPlay(ctx context.Context, in *ReportPlayRequest, opts ...grpc.CallOption) (*ReportPlayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportPlayResponse)
+	err := c.cc.Invoke(ctx, VideoService_ReportPlay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*GetTrendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetTrending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetWatchHistory(ctx context.Context, in *GetWatchHistoryRequest, opts ...grpc.CallOption) (*GetWatchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWatchHistoryResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetWatchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetVideoAudience(ctx context.Context, in *GetVideoAudienceRequest, opts ...grpc.CallOption) (*GetVideoAudienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVideoAudienceResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetVideoAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AppealTakedown(ctx context.Context, in *AppealTakedownRequest, opts ...grpc.CallOption) (*AppealTakedownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppealTakedownResponse)
+	err := c.cc.Invoke(ctx, VideoService_AppealTakedown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListMyTakedowns(ctx context.Context, in *ListMyTakedownsRequest, opts ...grpc.CallOption) (*ListMyTakedownsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyTakedownsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListMyTakedowns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SetVideoCaptions(ctx context.Context, in *SetVideoCaptionsRequest, opts ...grpc.CallOption) (*SetVideoCaptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVideoCaptionsResponse)
+	err := c.cc.Invoke(ctx, VideoService_SetVideoCaptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SetVideoVisibility(ctx context.Context, in *SetVideoVisibilityRequest, opts ...grpc.CallOption) (*SetVideoVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVideoVisibilityResponse)
+	err := c.cc.Invoke(ctx, VideoService_SetVideoVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SchedulePublish(ctx context.Context, in *SchedulePublishRequest, opts ...grpc.CallOption) (*SchedulePublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePublishResponse)
+	err := c.cc.Invoke(ctx, VideoService_SchedulePublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UpdateVideoInfo(ctx context.Context, in *UpdateVideoInfoRequest, opts ...grpc.CallOption) (*UpdateVideoInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVideoInfoResponse)
+	err := c.cc.Invoke(ctx, VideoService_UpdateVideoInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SearchWithinCreator(ctx context.Context, in *SearchWithinCreatorRequest, opts ...grpc.CallOption) (*SearchWithinCreatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchWithinCreatorResponse)
+	err := c.cc.Invoke(ctx, VideoService_SearchWithinCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RecordPromotionClick(ctx context.Context, in *RecordPromotionClickRequest, opts ...grpc.CallOption) (*RecordPromotionClickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordPromotionClickResponse)
+	err := c.cc.Invoke(ctx, VideoService_RecordPromotionClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListVideoCategories(ctx context.Context, in *ListVideoCategoriesRequest, opts ...grpc.CallOption) (*ListVideoCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVideoCategoriesResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListVideoCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoServiceClient) GetVideoInfo(ctx context.Context, in *GetVideoInfoRequest, opts ...grpc.CallOption) (*GetVideoInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVideoInfoResponse)
@@ -218,6 +442,16 @@ func (c *videoServiceClient) ListUploadedParts(ctx context.Context, in *ListUplo
 	return out, nil
 }
 
+func (c *videoServiceClient) VerifyUpload(ctx context.Context, in *VerifyUploadRequest, opts ...grpc.CallOption) (*VerifyUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyUploadResponse)
+	err := c.cc.Invoke(ctx, VideoService_VerifyUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoServiceServer is the server API for VideoService service.
 // All implementations must embed UnimplementedVideoServiceServer
 // for forward compatibility.
@@ -236,6 +470,40 @@ type VideoServiceServer interface {
 	GetUploadConfig(context.Context, *GetUploadConfigRequest) (*GetUploadConfigResponse, error)
 	// 获取上传进度
 	GetUploadProgress(context.Context, *GetUploadProgressRequest) (*GetUploadProgressResponse, error)
+	// 获取视频分享卡片
+	GetVideoShareCard(context.Context, *GetVideoShareCardRequest) (*GetVideoShareCardResponse, error)
+	// 分享视频，计入视频的分享数并返回分享者的分享短链
+	ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoResponse, error)
+	// 解析分享短链，返回视频和分享者
+	ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*ResolveShareLinkResponse, error)
+	// 记录观看
+	RecordView(context.Context, *RecordViewRequest) (*RecordViewResponse, error)
+	// 上报播放
+	ReportPlay(context.Context, *ReportPlayRequest) (*ReportPlayResponse, error)
+	// 获取热门视频
+	GetTrending(context.Context, *GetTrendingRequest) (*GetTrendingResponse, error)
+	// 获取观看记录
+	GetWatchHistory(context.Context, *GetWatchHistoryRequest) (*GetWatchHistoryResponse, error)
+	// 获取视频受众分析，仅视频作者可用
+	GetVideoAudience(context.Context, *GetVideoAudienceRequest) (*GetVideoAudienceResponse, error)
+	// 创作者对视频下架提出申诉，每条下架记录只能申诉一次
+	AppealTakedown(context.Context, *AppealTakedownRequest) (*AppealTakedownResponse, error)
+	// 创作者查询自己被下架的视频及申诉进度
+	ListMyTakedowns(context.Context, *ListMyTakedownsRequest) (*ListMyTakedownsResponse, error)
+	// 创作者上传视频字幕（WebVTT 或 SRT），替换该语言已有的字幕并建立全文索引
+	SetVideoCaptions(context.Context, *SetVideoCaptionsRequest) (*SetVideoCaptionsResponse, error)
+	// 作者修改视频的可见范围
+	SetVideoVisibility(context.Context, *SetVideoVisibilityRequest) (*SetVideoVisibilityResponse, error)
+	// 作者修改草稿的计划发布时间或立即发布
+	SchedulePublish(context.Context, *SchedulePublishRequest) (*SchedulePublishResponse, error)
+	// 作者或审核员修改视频的标题、话题和封面
+	UpdateVideoInfo(context.Context, *UpdateVideoInfoRequest) (*UpdateVideoInfoResponse, error)
+	// 按口播内容在创作者已发布的视频中检索，返回命中的字幕段及跳转到对应播放位置的链接
+	SearchWithinCreator(context.Context, *SearchWithinCreatorRequest) (*SearchWithinCreatorResponse, error)
+	// 上报用户点击视频流中的推广视频，用于推广计费
+	RecordPromotionClick(context.Context, *RecordPromotionClickRequest) (*RecordPromotionClickResponse, error)
+	// 查询可选的视频分类，发布时选择、视频流按分类筛选，可附带各分类的视频数作为搜索分面
+	ListVideoCategories(context.Context, *ListVideoCategoriesRequest) (*ListVideoCategoriesResponse, error)
 	// gRPC内部调用接口
 	GetVideoInfo(context.Context, *GetVideoInfoRequest) (*GetVideoInfoResponse, error)
 	GetVideosInfo(context.Context, *GetVideosInfoRequest) (*GetVideosInfoResponse, error)
@@ -250,6 +518,8 @@ type VideoServiceServer interface {
 	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*emptypb.Empty, error)
 	// 列出已上传的分片
 	ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsResponse, error)
+	// 查询分片上传的校验状态
+	VerifyUpload(context.Context, *VerifyUploadRequest) (*VerifyUploadResponse, error)
 	mustEmbedUnimplementedVideoServiceServer()
 }
 
@@ -278,6 +548,57 @@ func (UnimplementedVideoServiceServer) GetUploadConfig(context.Context, *GetUplo
 func (UnimplementedVideoServiceServer) GetUploadProgress(context.Context, *GetUploadProgressRequest) (*GetUploadProgressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUploadProgress not implemented")
 }
+func (UnimplementedVideoServiceServer) GetVideoShareCard(context.Context, *GetVideoShareCardRequest) (*GetVideoShareCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoShareCard not implemented")
+}
+func (UnimplementedVideoServiceServer) ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*ResolveShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShareLink not implemented")
+}
+func (UnimplementedVideoServiceServer) RecordView(context.Context, *RecordViewRequest) (*RecordViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordView not implemented")
+}
+func (UnimplementedVideoServiceServer) ReportPlay(context.Context, *ReportPlayRequest) (*ReportPlayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPlay not implemented")
+}
+func (UnimplementedVideoServiceServer) GetTrending(context.Context, *GetTrendingRequest) (*GetTrendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrending not implemented")
+}
+func (UnimplementedVideoServiceServer) GetWatchHistory(context.Context, *GetWatchHistoryRequest) (*GetWatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchHistory not implemented")
+}
+func (UnimplementedVideoServiceServer) GetVideoAudience(context.Context, *GetVideoAudienceRequest) (*GetVideoAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoAudience not implemented")
+}
+func (UnimplementedVideoServiceServer) AppealTakedown(context.Context, *AppealTakedownRequest) (*AppealTakedownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealTakedown not implemented")
+}
+func (UnimplementedVideoServiceServer) ListMyTakedowns(context.Context, *ListMyTakedownsRequest) (*ListMyTakedownsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyTakedowns not implemented")
+}
+func (UnimplementedVideoServiceServer) SetVideoCaptions(context.Context, *SetVideoCaptionsRequest) (*SetVideoCaptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVideoCaptions not implemented")
+}
+func (UnimplementedVideoServiceServer) SetVideoVisibility(context.Context, *SetVideoVisibilityRequest) (*SetVideoVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVideoVisibility not implemented")
+}
+func (UnimplementedVideoServiceServer) SchedulePublish(context.Context, *SchedulePublishRequest) (*SchedulePublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePublish not implemented")
+}
+func (UnimplementedVideoServiceServer) UpdateVideoInfo(context.Context, *UpdateVideoInfoRequest) (*UpdateVideoInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVideoInfo not implemented")
+}
+func (UnimplementedVideoServiceServer) SearchWithinCreator(context.Context, *SearchWithinCreatorRequest) (*SearchWithinCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchWithinCreator not implemented")
+}
+func (UnimplementedVideoServiceServer) RecordPromotionClick(context.Context, *RecordPromotionClickRequest) (*RecordPromotionClickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPromotionClick not implemented")
+}
+func (UnimplementedVideoServiceServer) ListVideoCategories(context.Context, *ListVideoCategoriesRequest) (*ListVideoCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVideoCategories not implemented")
+}
 func (UnimplementedVideoServiceServer) GetVideoInfo(context.Context, *GetVideoInfoRequest) (*GetVideoInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoInfo not implemented")
 }
@@ -302,6 +623,9 @@ func (UnimplementedVideoServiceServer) AbortMultipartUpload(context.Context, *Ab
 func (UnimplementedVideoServiceServer) ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUploadedParts not implemented")
 }
+func (UnimplementedVideoServiceServer) VerifyUpload(context.Context, *VerifyUploadRequest) (*VerifyUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyUpload not implemented")
+}
 func (UnimplementedVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {}
 func (UnimplementedVideoServiceServer) testEmbeddedByValue()                      {}
 
@@ -431,6 +755,312 @@ func _VideoService_GetUploadProgress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_GetVideoShareCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoShareCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideoShareCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetVideoShareCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideoShareCard(ctx, req.(*GetVideoShareCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ShareVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ShareVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ShareVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ShareVideo(ctx, req.(*ShareVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ResolveShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ResolveShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ResolveShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ResolveShareLink(ctx, req.(*ResolveShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RecordView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RecordView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RecordView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RecordView(ctx, req.(*RecordViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ReportPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ReportPlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ReportPlay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ReportPlay(ctx, req.(*ReportPlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetTrending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTrending(ctx, req.(*GetTrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetWatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetWatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetWatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetWatchHistory(ctx, req.(*GetWatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideoAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideoAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetVideoAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideoAudience(ctx, req.(*GetVideoAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_AppealTakedown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealTakedownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AppealTakedown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_AppealTakedown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AppealTakedown(ctx, req.(*AppealTakedownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListMyTakedowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTakedownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListMyTakedowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListMyTakedowns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListMyTakedowns(ctx, req.(*ListMyTakedownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SetVideoCaptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVideoCaptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SetVideoCaptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SetVideoCaptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SetVideoCaptions(ctx, req.(*SetVideoCaptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SetVideoVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVideoVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SetVideoVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SetVideoVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SetVideoVisibility(ctx, req.(*SetVideoVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SchedulePublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SchedulePublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SchedulePublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SchedulePublish(ctx, req.(*SchedulePublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UpdateVideoInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVideoInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UpdateVideoInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_UpdateVideoInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UpdateVideoInfo(ctx, req.(*UpdateVideoInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SearchWithinCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchWithinCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SearchWithinCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SearchWithinCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SearchWithinCreator(ctx, req.(*SearchWithinCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RecordPromotionClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPromotionClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RecordPromotionClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RecordPromotionClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RecordPromotionClick(ctx, req.(*RecordPromotionClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListVideoCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideoCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListVideoCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListVideoCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListVideoCategories(ctx, req.(*ListVideoCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoService_GetVideoInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVideoInfoRequest)
 	if err := dec(in); err != nil {
@@ -575,6 +1205,24 @@ func _VideoService_ListUploadedParts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_VerifyUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).VerifyUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_VerifyUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).VerifyUpload(ctx, req.(*VerifyUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoService_ServiceDesc is the grpc.ServiceDesc for VideoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -606,6 +1254,74 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUploadProgress",
 			Handler:    _VideoService_GetUploadProgress_Handler,
 		},
+		{
+			MethodName: "GetVideoShareCard",
+			Handler:    _VideoService_GetVideoShareCard_Handler,
+		},
+		{
+			MethodName: "ShareVideo",
+			Handler:    _VideoService_ShareVideo_Handler,
+		},
+		{
+			MethodName: "ResolveShareLink",
+			Handler:    _VideoService_ResolveShareLink_Handler,
+		},
+		{
+			MethodName: "RecordView",
+			Handler:    _VideoService_RecordView_Handler,
+		},
+		{
+			MethodName: "ReportPlay",
+			Handler:    _VideoService_ReportPlay_Handler,
+		},
+		{
+			MethodName: "GetTrending",
+			Handler:    _VideoService_GetTrending_Handler,
+		},
+		{
+			MethodName: "GetWatchHistory",
+			Handler:    _VideoService_GetWatchHistory_Handler,
+		},
+		{
+			MethodName: "GetVideoAudience",
+			Handler:    _VideoService_GetVideoAudience_Handler,
+		},
+		{
+			MethodName: "AppealTakedown",
+			Handler:    _VideoService_AppealTakedown_Handler,
+		},
+		{
+			MethodName: "ListMyTakedowns",
+			Handler:    _VideoService_ListMyTakedowns_Handler,
+		},
+		{
+			MethodName: "SetVideoCaptions",
+			Handler:    _VideoService_SetVideoCaptions_Handler,
+		},
+		{
+			MethodName: "SetVideoVisibility",
+			Handler:    _VideoService_SetVideoVisibility_Handler,
+		},
+		{
+			MethodName: "SchedulePublish",
+			Handler:    _VideoService_SchedulePublish_Handler,
+		},
+		{
+			MethodName: "UpdateVideoInfo",
+			Handler:    _VideoService_UpdateVideoInfo_Handler,
+		},
+		{
+			MethodName: "SearchWithinCreator",
+			Handler:    _VideoService_SearchWithinCreator_Handler,
+		},
+		{
+			MethodName: "RecordPromotionClick",
+			Handler:    _VideoService_RecordPromotionClick_Handler,
+		},
+		{
+			MethodName: "ListVideoCategories",
+			Handler:    _VideoService_ListVideoCategories_Handler,
+		},
 		{
 			MethodName: "GetVideoInfo",
 			Handler:    _VideoService_GetVideoInfo_Handler,
@@ -638,6 +1354,10 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUploadedParts",
 			Handler:    _VideoService_ListUploadedParts_Handler,
 		},
+		{
+			MethodName: "VerifyUpload",
+			Handler:    _VideoService_VerifyUpload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "video/v1/video.proto",
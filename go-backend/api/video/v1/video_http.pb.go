@@ -21,40 +21,94 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationVideoServiceAbortMultipartUpload = "/video.v1.VideoService/AbortMultipartUpload"
+const OperationVideoServiceAppealTakedown = "/video.v1.VideoService/AppealTakedown"
 const OperationVideoServiceCompleteMultipartUpload = "/video.v1.VideoService/CompleteMultipartUpload"
 const OperationVideoServiceGetFeed = "/video.v1.VideoService/GetFeed"
 const OperationVideoServiceGetPublishList = "/video.v1.VideoService/GetPublishList"
+const OperationVideoServiceGetTrending = "/video.v1.VideoService/GetTrending"
 const OperationVideoServiceGetUploadConfig = "/video.v1.VideoService/GetUploadConfig"
 const OperationVideoServiceGetUploadProgress = "/video.v1.VideoService/GetUploadProgress"
+const OperationVideoServiceGetVideoAudience = "/video.v1.VideoService/GetVideoAudience"
+const OperationVideoServiceGetVideoShareCard = "/video.v1.VideoService/GetVideoShareCard"
+const OperationVideoServiceGetWatchHistory = "/video.v1.VideoService/GetWatchHistory"
 const OperationVideoServiceInitiateMultipartUpload = "/video.v1.VideoService/InitiateMultipartUpload"
+const OperationVideoServiceListMyTakedowns = "/video.v1.VideoService/ListMyTakedowns"
 const OperationVideoServiceListUploadedParts = "/video.v1.VideoService/ListUploadedParts"
+const OperationVideoServiceListVideoCategories = "/video.v1.VideoService/ListVideoCategories"
 const OperationVideoServicePublishVideo = "/video.v1.VideoService/PublishVideo"
+const OperationVideoServiceRecordPromotionClick = "/video.v1.VideoService/RecordPromotionClick"
+const OperationVideoServiceRecordView = "/video.v1.VideoService/RecordView"
+const OperationVideoServiceReportPlay = "/video.v1.VideoService/ReportPlay"
+const OperationVideoServiceResolveShareLink = "/video.v1.VideoService/ResolveShareLink"
+const OperationVideoServiceSchedulePublish = "/video.v1.VideoService/SchedulePublish"
+const OperationVideoServiceSearchWithinCreator = "/video.v1.VideoService/SearchWithinCreator"
+const OperationVideoServiceSetVideoCaptions = "/video.v1.VideoService/SetVideoCaptions"
+const OperationVideoServiceSetVideoVisibility = "/video.v1.VideoService/SetVideoVisibility"
+const OperationVideoServiceShareVideo = "/video.v1.VideoService/ShareVideo"
+const OperationVideoServiceUpdateVideoInfo = "/video.v1.VideoService/UpdateVideoInfo"
 const OperationVideoServiceUploadPart = "/video.v1.VideoService/UploadPart"
 const OperationVideoServiceUploadVideoFile = "/video.v1.VideoService/UploadVideoFile"
+const OperationVideoServiceVerifyUpload = "/video.v1.VideoService/VerifyUpload"
 
 type VideoServiceHTTPServer interface {
 	// AbortMultipartUpload 取消分片上传
 	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*emptypb.Empty, error)
+	// AppealTakedown 创作者对视频下架提出申诉，每条下架记录只能申诉一次
+	AppealTakedown(context.Context, *AppealTakedownRequest) (*AppealTakedownResponse, error)
 	// CompleteMultipartUpload 完成分片上传
 	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*PublishVideoResponse, error)
 	// GetFeed 获取视频流
 	GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error)
 	// GetPublishList 获取发布列表
 	GetPublishList(context.Context, *GetPublishListRequest) (*GetPublishListResponse, error)
+	// GetTrending 获取热门视频
+	GetTrending(context.Context, *GetTrendingRequest) (*GetTrendingResponse, error)
 	// GetUploadConfig 获取上传配置
 	GetUploadConfig(context.Context, *GetUploadConfigRequest) (*GetUploadConfigResponse, error)
 	// GetUploadProgress 获取上传进度
 	GetUploadProgress(context.Context, *GetUploadProgressRequest) (*GetUploadProgressResponse, error)
+	// GetVideoAudience 获取视频受众分析，仅视频作者可用
+	GetVideoAudience(context.Context, *GetVideoAudienceRequest) (*GetVideoAudienceResponse, error)
+	// GetVideoShareCard 获取视频分享卡片
+	GetVideoShareCard(context.Context, *GetVideoShareCardRequest) (*GetVideoShareCardResponse, error)
+	// GetWatchHistory 获取观看记录
+	GetWatchHistory(context.Context, *GetWatchHistoryRequest) (*GetWatchHistoryResponse, error)
 	// InitiateMultipartUpload 初始化分片上传
 	InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*InitiateMultipartUploadResponse, error)
+	// ListMyTakedowns 创作者查询自己被下架的视频及申诉进度
+	ListMyTakedowns(context.Context, *ListMyTakedownsRequest) (*ListMyTakedownsResponse, error)
 	// ListUploadedParts 列出已上传的分片
 	ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsResponse, error)
+	// ListVideoCategories 查询可选的视频分类，发布时选择、视频流按分类筛选，可附带各分类的视频数作为搜索分面
+	ListVideoCategories(context.Context, *ListVideoCategoriesRequest) (*ListVideoCategoriesResponse, error)
 	// PublishVideo 视频上传 - 支持multipart form data
 	PublishVideo(context.Context, *PublishVideoRequest) (*PublishVideoResponse, error)
+	// RecordPromotionClick 上报用户点击视频流中的推广视频，用于推广计费
+	RecordPromotionClick(context.Context, *RecordPromotionClickRequest) (*RecordPromotionClickResponse, error)
+	// RecordView 记录观看
+	RecordView(context.Context, *RecordViewRequest) (*RecordViewResponse, error)
+	// ReportPlay 上报播放
+	ReportPlay(context.Context, *ReportPlayRequest) (*ReportPlayResponse, error)
+	// ResolveShareLink 解析分享短链，返回视频和分享者
+	ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*ResolveShareLinkResponse, error)
+	// SchedulePublish 作者修改草稿的计划发布时间或立即发布
+	SchedulePublish(context.Context, *SchedulePublishRequest) (*SchedulePublishResponse, error)
+	// SearchWithinCreator 按口播内容在创作者已发布的视频中检索，返回命中的字幕段及跳转到对应播放位置的链接
+	SearchWithinCreator(context.Context, *SearchWithinCreatorRequest) (*SearchWithinCreatorResponse, error)
+	// SetVideoCaptions 创作者上传视频字幕（WebVTT 或 SRT），替换该语言已有的字幕并建立全文索引
+	SetVideoCaptions(context.Context, *SetVideoCaptionsRequest) (*SetVideoCaptionsResponse, error)
+	// SetVideoVisibility 作者修改视频的可见范围
+	SetVideoVisibility(context.Context, *SetVideoVisibilityRequest) (*SetVideoVisibilityResponse, error)
+	// ShareVideo 分享视频，计入视频的分享数并返回分享者的分享短链
+	ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoResponse, error)
+	// UpdateVideoInfo 作者或审核员修改视频的标题、话题和封面
+	UpdateVideoInfo(context.Context, *UpdateVideoInfoRequest) (*UpdateVideoInfoResponse, error)
 	// UploadPart 上传分片
 	UploadPart(context.Context, *UploadPartRequest) (*UploadPartResponse, error)
 	// UploadVideoFile 文件上传处理 - 专门用于处理multipart文件上传
 	UploadVideoFile(context.Context, *UploadVideoFileRequest) (*PublishVideoResponse, error)
+	// VerifyUpload 查询分片上传的校验状态
+	VerifyUpload(context.Context, *VerifyUploadRequest) (*VerifyUploadResponse, error)
 }
 
 func RegisterVideoServiceHTTPServer(s *http.Server, srv VideoServiceHTTPServer) {
@@ -66,11 +120,29 @@ func RegisterVideoServiceHTTPServer(s *http.Server, srv VideoServiceHTTPServer)
 	r.GET("/douyin/publish/list", _VideoService_GetPublishList0_HTTP_Handler(srv))
 	r.GET("/douyin/upload/config", _VideoService_GetUploadConfig0_HTTP_Handler(srv))
 	r.GET("/douyin/upload/progress/{upload_id}", _VideoService_GetUploadProgress0_HTTP_Handler(srv))
+	r.GET("/douyin/video/share/card", _VideoService_GetVideoShareCard0_HTTP_Handler(srv))
+	r.POST("/douyin/video/share", _VideoService_ShareVideo0_HTTP_Handler(srv))
+	r.GET("/douyin/video/share/resolve", _VideoService_ResolveShareLink0_HTTP_Handler(srv))
+	r.POST("/douyin/video/view", _VideoService_RecordView0_HTTP_Handler(srv))
+	r.POST("/douyin/video/play", _VideoService_ReportPlay0_HTTP_Handler(srv))
+	r.GET("/douyin/video/trending", _VideoService_GetTrending0_HTTP_Handler(srv))
+	r.GET("/douyin/video/history", _VideoService_GetWatchHistory0_HTTP_Handler(srv))
+	r.GET("/douyin/video/audience", _VideoService_GetVideoAudience0_HTTP_Handler(srv))
+	r.POST("/douyin/video/takedown/appeal", _VideoService_AppealTakedown0_HTTP_Handler(srv))
+	r.GET("/douyin/video/takedown/list", _VideoService_ListMyTakedowns0_HTTP_Handler(srv))
+	r.POST("/douyin/video/captions", _VideoService_SetVideoCaptions0_HTTP_Handler(srv))
+	r.POST("/douyin/video/visibility", _VideoService_SetVideoVisibility0_HTTP_Handler(srv))
+	r.POST("/douyin/video/schedule", _VideoService_SchedulePublish0_HTTP_Handler(srv))
+	r.POST("/douyin/video/update", _VideoService_UpdateVideoInfo0_HTTP_Handler(srv))
+	r.GET("/douyin/video/captions/search", _VideoService_SearchWithinCreator0_HTTP_Handler(srv))
+	r.POST("/douyin/promotion/click", _VideoService_RecordPromotionClick0_HTTP_Handler(srv))
+	r.GET("/douyin/video/category/list", _VideoService_ListVideoCategories0_HTTP_Handler(srv))
 	r.POST("/douyin/upload/multipart/initiate", _VideoService_InitiateMultipartUpload0_HTTP_Handler(srv))
 	r.POST("/douyin/upload/multipart/part", _VideoService_UploadPart0_HTTP_Handler(srv))
 	r.POST("/douyin/upload/multipart/complete", _VideoService_CompleteMultipartUpload0_HTTP_Handler(srv))
 	r.POST("/douyin/upload/multipart/abort", _VideoService_AbortMultipartUpload0_HTTP_Handler(srv))
 	r.GET("/douyin/upload/multipart/{upload_id}/parts", _VideoService_ListUploadedParts0_HTTP_Handler(srv))
+	r.GET("/douyin/upload/multipart/{upload_id}/verify", _VideoService_VerifyUpload0_HTTP_Handler(srv))
 }
 
 func _VideoService_GetFeed0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
@@ -215,6 +287,356 @@ func _VideoService_GetUploadProgress0_HTTP_Handler(srv VideoServiceHTTPServer) f
 	}
 }
 
+func _VideoService_GetVideoShareCard0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetVideoShareCardRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceGetVideoShareCard)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetVideoShareCard(ctx, req.(*GetVideoShareCardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetVideoShareCardResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ShareVideo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ShareVideoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceShareVideo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ShareVideo(ctx, req.(*ShareVideoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ShareVideoResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ResolveShareLink0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResolveShareLinkRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceResolveShareLink)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveShareLink(ctx, req.(*ResolveShareLinkRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResolveShareLinkResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_RecordView0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RecordViewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceRecordView)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecordView(ctx, req.(*RecordViewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecordViewResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ReportPlay0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportPlayRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceReportPlay)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportPlay(ctx, req.(*ReportPlayRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportPlayResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_GetTrending0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTrendingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceGetTrending)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTrending(ctx, req.(*GetTrendingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTrendingResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_GetWatchHistory0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetWatchHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceGetWatchHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetWatchHistory(ctx, req.(*GetWatchHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetWatchHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_GetVideoAudience0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetVideoAudienceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceGetVideoAudience)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetVideoAudience(ctx, req.(*GetVideoAudienceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetVideoAudienceResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_AppealTakedown0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AppealTakedownRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceAppealTakedown)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AppealTakedown(ctx, req.(*AppealTakedownRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AppealTakedownResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListMyTakedowns0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyTakedownsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListMyTakedowns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyTakedowns(ctx, req.(*ListMyTakedownsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMyTakedownsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SetVideoCaptions0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetVideoCaptionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSetVideoCaptions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetVideoCaptions(ctx, req.(*SetVideoCaptionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetVideoCaptionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SetVideoVisibility0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetVideoVisibilityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSetVideoVisibility)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetVideoVisibility(ctx, req.(*SetVideoVisibilityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetVideoVisibilityResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SchedulePublish0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SchedulePublishRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSchedulePublish)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SchedulePublish(ctx, req.(*SchedulePublishRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SchedulePublishResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_UpdateVideoInfo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateVideoInfoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceUpdateVideoInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateVideoInfo(ctx, req.(*UpdateVideoInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateVideoInfoResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SearchWithinCreator0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchWithinCreatorRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSearchWithinCreator)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchWithinCreator(ctx, req.(*SearchWithinCreatorRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchWithinCreatorResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_RecordPromotionClick0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RecordPromotionClickRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceRecordPromotionClick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecordPromotionClick(ctx, req.(*RecordPromotionClickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecordPromotionClickResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListVideoCategories0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListVideoCategoriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListVideoCategories)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVideoCategories(ctx, req.(*ListVideoCategoriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListVideoCategoriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _VideoService_InitiateMultipartUpload0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in InitiateMultipartUploadRequest
@@ -325,18 +747,58 @@ func _VideoService_ListUploadedParts0_HTTP_Handler(srv VideoServiceHTTPServer) f
 	}
 }
 
+func _VideoService_VerifyUpload0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyUploadRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceVerifyUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyUpload(ctx, req.(*VerifyUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyUploadResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type VideoServiceHTTPClient interface {
 	AbortMultipartUpload(ctx context.Context, req *AbortMultipartUploadRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AppealTakedown(ctx context.Context, req *AppealTakedownRequest, opts ...http.CallOption) (rsp *AppealTakedownResponse, err error)
 	CompleteMultipartUpload(ctx context.Context, req *CompleteMultipartUploadRequest, opts ...http.CallOption) (rsp *PublishVideoResponse, err error)
 	GetFeed(ctx context.Context, req *GetFeedRequest, opts ...http.CallOption) (rsp *GetFeedResponse, err error)
 	GetPublishList(ctx context.Context, req *GetPublishListRequest, opts ...http.CallOption) (rsp *GetPublishListResponse, err error)
+	GetTrending(ctx context.Context, req *GetTrendingRequest, opts ...http.CallOption) (rsp *GetTrendingResponse, err error)
 	GetUploadConfig(ctx context.Context, req *GetUploadConfigRequest, opts ...http.CallOption) (rsp *GetUploadConfigResponse, err error)
 	GetUploadProgress(ctx context.Context, req *GetUploadProgressRequest, opts ...http.CallOption) (rsp *GetUploadProgressResponse, err error)
+	GetVideoAudience(ctx context.Context, req *GetVideoAudienceRequest, opts ...http.CallOption) (rsp *GetVideoAudienceResponse, err error)
+	GetVideoShareCard(ctx context.Context, req *GetVideoShareCardRequest, opts ...http.CallOption) (rsp *GetVideoShareCardResponse, err error)
+	GetWatchHistory(ctx context.Context, req *GetWatchHistoryRequest, opts ...http.CallOption) (rsp *GetWatchHistoryResponse, err error)
 	InitiateMultipartUpload(ctx context.Context, req *InitiateMultipartUploadRequest, opts ...http.CallOption) (rsp *InitiateMultipartUploadResponse, err error)
+	ListMyTakedowns(ctx context.Context, req *ListMyTakedownsRequest, opts ...http.CallOption) (rsp *ListMyTakedownsResponse, err error)
 	ListUploadedParts(ctx context.Context, req *ListUploadedPartsRequest, opts ...http.CallOption) (rsp *ListUploadedPartsResponse, err error)
+	ListVideoCategories(ctx context.Context, req *ListVideoCategoriesRequest, opts ...http.CallOption) (rsp *ListVideoCategoriesResponse, err error)
 	PublishVideo(ctx context.Context, req *PublishVideoRequest, opts ...http.CallOption) (rsp *PublishVideoResponse, err error)
+	RecordPromotionClick(ctx context.Context, req *RecordPromotionClickRequest, opts ...http.CallOption) (rsp *RecordPromotionClickResponse, err error)
+	RecordView(ctx context.Context, req *RecordViewRequest, opts ...http.CallOption) (rsp *RecordViewResponse, err error)
+	ReportPlay(ctx context.Context, req *ReportPlayRequest, opts ...http.CallOption) (rsp *ReportPlayResponse, err error)
+	ResolveShareLink(ctx context.Context, req *ResolveShareLinkRequest, opts ...http.CallOption) (rsp *ResolveShareLinkResponse, err error)
+	SchedulePublish(ctx context.Context, req *SchedulePublishRequest, opts ...http.CallOption) (rsp *SchedulePublishResponse, err error)
+	SearchWithinCreator(ctx context.Context, req *SearchWithinCreatorRequest, opts ...http.CallOption) (rsp *SearchWithinCreatorResponse, err error)
+	SetVideoCaptions(ctx context.Context, req *SetVideoCaptionsRequest, opts ...http.CallOption) (rsp *SetVideoCaptionsResponse, err error)
+	SetVideoVisibility(ctx context.Context, req *SetVideoVisibilityRequest, opts ...http.CallOption) (rsp *SetVideoVisibilityResponse, err error)
+	ShareVideo(ctx context.Context, req *ShareVideoRequest, opts ...http.CallOption) (rsp *ShareVideoResponse, err error)
+	UpdateVideoInfo(ctx context.Context, req *UpdateVideoInfoRequest, opts ...http.CallOption) (rsp *UpdateVideoInfoResponse, err error)
 	UploadPart(ctx context.Context, req *UploadPartRequest, opts ...http.CallOption) (rsp *UploadPartResponse, err error)
 	UploadVideoFile(ctx context.Context, req *UploadVideoFileRequest, opts ...http.CallOption) (rsp *PublishVideoResponse, err error)
+	VerifyUpload(ctx context.Context, req *VerifyUploadRequest, opts ...http.CallOption) (rsp *VerifyUploadResponse, err error)
 }
 
 type VideoServiceHTTPClientImpl struct {
@@ -360,6 +822,19 @@ func (c *VideoServiceHTTPClientImpl) AbortMultipartUpload(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) AppealTakedown(ctx context.Context, in *AppealTakedownRequest, opts ...http.CallOption) (*AppealTakedownResponse, error) {
+	var out AppealTakedownResponse
+	pattern := "/douyin/video/takedown/appeal"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceAppealTakedown))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...http.CallOption) (*PublishVideoResponse, error) {
 	var out PublishVideoResponse
 	pattern := "/douyin/upload/multipart/complete"
@@ -399,6 +874,19 @@ func (c *VideoServiceHTTPClientImpl) GetPublishList(ctx context.Context, in *Get
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...http.CallOption) (*GetTrendingResponse, error) {
+	var out GetTrendingResponse
+	pattern := "/douyin/video/trending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceGetTrending))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) GetUploadConfig(ctx context.Context, in *GetUploadConfigRequest, opts ...http.CallOption) (*GetUploadConfigResponse, error) {
 	var out GetUploadConfigResponse
 	pattern := "/douyin/upload/config"
@@ -425,6 +913,45 @@ func (c *VideoServiceHTTPClientImpl) GetUploadProgress(ctx context.Context, in *
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) GetVideoAudience(ctx context.Context, in *GetVideoAudienceRequest, opts ...http.CallOption) (*GetVideoAudienceResponse, error) {
+	var out GetVideoAudienceResponse
+	pattern := "/douyin/video/audience"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceGetVideoAudience))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) GetVideoShareCard(ctx context.Context, in *GetVideoShareCardRequest, opts ...http.CallOption) (*GetVideoShareCardResponse, error) {
+	var out GetVideoShareCardResponse
+	pattern := "/douyin/video/share/card"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceGetVideoShareCard))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) GetWatchHistory(ctx context.Context, in *GetWatchHistoryRequest, opts ...http.CallOption) (*GetWatchHistoryResponse, error) {
+	var out GetWatchHistoryResponse
+	pattern := "/douyin/video/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceGetWatchHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...http.CallOption) (*InitiateMultipartUploadResponse, error) {
 	var out InitiateMultipartUploadResponse
 	pattern := "/douyin/upload/multipart/initiate"
@@ -438,6 +965,19 @@ func (c *VideoServiceHTTPClientImpl) InitiateMultipartUpload(ctx context.Context
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) ListMyTakedowns(ctx context.Context, in *ListMyTakedownsRequest, opts ...http.CallOption) (*ListMyTakedownsResponse, error) {
+	var out ListMyTakedownsResponse
+	pattern := "/douyin/video/takedown/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListMyTakedowns))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...http.CallOption) (*ListUploadedPartsResponse, error) {
 	var out ListUploadedPartsResponse
 	pattern := "/douyin/upload/multipart/{upload_id}/parts"
@@ -451,6 +991,19 @@ func (c *VideoServiceHTTPClientImpl) ListUploadedParts(ctx context.Context, in *
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) ListVideoCategories(ctx context.Context, in *ListVideoCategoriesRequest, opts ...http.CallOption) (*ListVideoCategoriesResponse, error) {
+	var out ListVideoCategoriesResponse
+	pattern := "/douyin/video/category/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListVideoCategories))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) PublishVideo(ctx context.Context, in *PublishVideoRequest, opts ...http.CallOption) (*PublishVideoResponse, error) {
 	var out PublishVideoResponse
 	pattern := "/douyin/publish/action"
@@ -464,6 +1017,136 @@ func (c *VideoServiceHTTPClientImpl) PublishVideo(ctx context.Context, in *Publi
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) RecordPromotionClick(ctx context.Context, in *RecordPromotionClickRequest, opts ...http.CallOption) (*RecordPromotionClickResponse, error) {
+	var out RecordPromotionClickResponse
+	pattern := "/douyin/promotion/click"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceRecordPromotionClick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) RecordView(ctx context.Context, in *RecordViewRequest, opts ...http.CallOption) (*RecordViewResponse, error) {
+	var out RecordViewResponse
+	pattern := "/douyin/video/view"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceRecordView))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ReportPlay(ctx context.Context, in *ReportPlayRequest, opts ...http.CallOption) (*ReportPlayResponse, error) {
+	var out ReportPlayResponse
+	pattern := "/douyin/video/play"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceReportPlay))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...http.CallOption) (*ResolveShareLinkResponse, error) {
+	var out ResolveShareLinkResponse
+	pattern := "/douyin/video/share/resolve"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceResolveShareLink))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SchedulePublish(ctx context.Context, in *SchedulePublishRequest, opts ...http.CallOption) (*SchedulePublishResponse, error) {
+	var out SchedulePublishResponse
+	pattern := "/douyin/video/schedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceSchedulePublish))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SearchWithinCreator(ctx context.Context, in *SearchWithinCreatorRequest, opts ...http.CallOption) (*SearchWithinCreatorResponse, error) {
+	var out SearchWithinCreatorResponse
+	pattern := "/douyin/video/captions/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceSearchWithinCreator))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SetVideoCaptions(ctx context.Context, in *SetVideoCaptionsRequest, opts ...http.CallOption) (*SetVideoCaptionsResponse, error) {
+	var out SetVideoCaptionsResponse
+	pattern := "/douyin/video/captions"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceSetVideoCaptions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SetVideoVisibility(ctx context.Context, in *SetVideoVisibilityRequest, opts ...http.CallOption) (*SetVideoVisibilityResponse, error) {
+	var out SetVideoVisibilityResponse
+	pattern := "/douyin/video/visibility"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceSetVideoVisibility))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...http.CallOption) (*ShareVideoResponse, error) {
+	var out ShareVideoResponse
+	pattern := "/douyin/video/share"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceShareVideo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) UpdateVideoInfo(ctx context.Context, in *UpdateVideoInfoRequest, opts ...http.CallOption) (*UpdateVideoInfoResponse, error) {
+	var out UpdateVideoInfoResponse
+	pattern := "/douyin/video/update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceUpdateVideoInfo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) UploadPart(ctx context.Context, in *UploadPartRequest, opts ...http.CallOption) (*UploadPartResponse, error) {
 	var out UploadPartResponse
 	pattern := "/douyin/upload/multipart/part"
@@ -489,3 +1172,16 @@ func (c *VideoServiceHTTPClientImpl) UploadVideoFile(ctx context.Context, in *Up
 	}
 	return &out, nil
 }
+
+func (c *VideoServiceHTTPClientImpl) VerifyUpload(ctx context.Context, in *VerifyUploadRequest, opts ...http.CallOption) (*VerifyUploadResponse, error) {
+	var out VerifyUploadResponse
+	pattern := "/douyin/upload/multipart/{upload_id}/verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceVerifyUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"go-backend/internal/conf"
+	"go-backend/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -33,16 +34,20 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, scheduler *server.Scheduler, workers *server.Workers) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
+		// 各服务的停止等待时间，需覆盖后台任务的排空
+		kratos.StopTimeout(workers.DrainTimeout()),
 		kratos.Server(
 			gs,
 			hs,
+			scheduler,
+			workers,
 		),
 	)
 }
@@ -12,14 +12,12 @@ import (
 	"go-backend/internal/biz"
 	"go-backend/internal/conf"
 	"go-backend/internal/data"
+	"go-backend/internal/data/consumer"
 	"go-backend/internal/data/producer"
 	"go-backend/internal/middleware"
+	"go-backend/internal/provider"
 	"go-backend/internal/server"
 	"go-backend/internal/service"
-	"go-backend/pkg/auth"
-	"go-backend/pkg/media"
-	"go-backend/pkg/messaging"
-	"go-backend/pkg/security"
 )
 
 import (
@@ -36,89 +34,212 @@ func wireApp(confServer *conf.Server, confData *conf.Data, business *conf.Busine
 	}
 	multiLevelCache := data.NewMultiLevelCache(dataData)
 	userCache := data.NewUserCache(multiLevelCache, logger)
-	passwordManager := newPasswordManager()
-	userRepo := data.NewUserRepo(dataData, userCache, passwordManager, logger)
+	eventBus, cleanup2 := data.NewEventBus(business, logger)
+	videoCacheRepo := data.NewVideoCache(multiLevelCache, logger)
+	cacheInvalidationConsumer := data.NewCacheInvalidationConsumer(dataData, userCache, videoCacheRepo, logger)
+	profileProjection := data.NewProfileProjection(dataData, logger)
+	cacheInvalidationPublisher, err := data.NewCacheInvalidationPublisher(eventBus, cacheInvalidationConsumer, profileProjection, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordManager := provider.NewPasswordManager()
+	userRepo := data.NewUserRepo(dataData, userCache, cacheInvalidationPublisher, passwordManager, logger)
 	userUsecase := biz.NewUserUsecase(userRepo, logger)
-	relationRepo := data.NewRelationRepo(dataData, logger)
-	relationUsecase := biz.NewRelationUsecase(relationRepo, logger)
+	countsRepo := data.NewCountsRepo(dataData, cacheInvalidationPublisher, logger)
+	countsUsecase := biz.NewCountsUsecase(countsRepo, logger)
+	kafkaManager := provider.NewKafkaManager(confData, business, logger)
+	interactionEventPublisher := producer.NewInteractionEventProducer(kafkaManager, business, logger)
+	relationRepo := data.NewRelationRepo(dataData, cacheInvalidationPublisher, interactionEventPublisher, logger)
+	relationUsecase := biz.NewRelationUsecase(relationRepo, userRepo, logger)
 	authCache := data.NewAuthCache(multiLevelCache, logger)
-	sessionRepo := data.NewSessionRepo(dataData, authCache, logger)
-	jwtManager := newJWTManager(bootstrap)
-	sessionManager := newSessionManager()
-	authUsecase := biz.NewAuthUsecase(sessionRepo, userRepo, jwtManager, sessionManager, logger)
+	clock := provider.NewClock()
+	sessionRepo := data.NewSessionRepo(dataData, authCache, clock, logger)
+	rbacManager := provider.NewRBACManager()
+	keyring, err := provider.NewKeyring(bootstrap)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	signingKeyRepo := data.NewSigningKeyRepo(dataData, logger)
+	signingKeyUsecase := biz.NewSigningKeyUsecase(signingKeyRepo, keyring, business, clock, logger)
+	jwtManager, err := provider.NewJWTManager(bootstrap, keyring, signingKeyUsecase, rbacManager)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sessionManager := data.NewSessionManager(dataData, clock, logger)
+	securityEventNotifier := data.NewSecurityEventNotifier(logger)
+	locker := data.NewLocker(dataData)
+	loginHistoryRepo := data.NewLoginHistoryRepo(dataData, logger)
+	emailSender := data.NewEmailSender(logger)
+	loginAnomalyUsecase := biz.NewLoginAnomalyUsecase(loginHistoryRepo, emailSender, securityEventNotifier, business, clock, logger)
+	authUsecase := biz.NewAuthUsecase(sessionRepo, userRepo, jwtManager, sessionManager, securityEventNotifier, loginAnomalyUsecase, locker, business, clock, logger)
 	roleRepo := data.NewRoleRepo(dataData, logger)
 	permissionRepo := data.NewPermissionRepo(dataData, roleRepo, logger)
-	rbacManager := newMemoryRBACManager()
-	permissionUsecase := biz.NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, logger)
-	validator := newValidator()
-	userService := service.NewUserService(userUsecase, relationUsecase, authUsecase, permissionUsecase, jwtManager, validator, logger)
-	videoStorage, err := data.NewMinIOStorage(confData, logger)
+	ownershipRepo := data.NewOwnershipRepo(dataData, logger)
+	ownershipResolvers := biz.NewOwnershipResolvers(ownershipRepo)
+	permissionUsecase := biz.NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, ownershipResolvers, logger)
+	oAuthProviders, err := provider.NewOAuthProviders(business)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	videoCacheRepo := data.NewVideoCache(multiLevelCache, logger)
-	kafkaManager := newKafkaManager(confData, logger)
+	oAuthAccountRepo := data.NewOAuthAccountRepo(dataData, userCache, passwordManager, logger)
+	oAuthUsecase := biz.NewOAuthUsecase(oAuthProviders, oAuthAccountRepo, userRepo, authUsecase, permissionUsecase, logger)
+	loginCodeSenders := data.NewLoginCodeSenders(business, emailSender, logger)
+	codeLoginUsecase := biz.NewCodeLoginUsecase(sessionRepo, userRepo, loginCodeSenders, authUsecase, business, clock, logger)
+	messageRepo := data.NewMessageRepo(dataData, logger)
+	messageUsecase := biz.NewMessageUsecase(messageRepo, relationRepo, logger)
+	registrationRepo := data.NewRegistrationRepo(dataData, cacheInvalidationPublisher, logger)
+	registrationUsecase := biz.NewRegistrationUsecase(registrationRepo, permissionUsecase, authUsecase, business, logger)
+	passwordResetNotifier := data.NewPasswordResetNotifier(logger)
+	passwordResetUsecase := biz.NewPasswordResetUsecase(sessionRepo, userUsecase, authUsecase, passwordResetNotifier, logger)
+	emailUsecase := biz.NewEmailUsecase(sessionRepo, userRepo, emailSender, clock, logger)
+	videoStorage, err := data.NewVideoStorage(confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cdn := data.NewCDN(confData)
+	videoRepo := data.NewVideoRepo(dataData, videoStorage, cdn, videoCacheRepo, cacheInvalidationPublisher, logger)
+	shareUsecase := biz.NewShareUsecase(userRepo, videoRepo, relationUsecase, videoStorage, business, logger)
+	referralRepo := data.NewReferralRepo(dataData, logger)
+	referralUsecase := biz.NewReferralUsecase(referralRepo, userRepo, business, logger)
+	profileImageUsecase := biz.NewProfileImageUsecase(userUsecase, videoStorage, logger)
+	profileReadModelRepo := data.NewProfileReadModelRepo(profileProjection)
+	favoriteRepo := data.NewFavoriteRepo(dataData, cacheInvalidationPublisher, interactionEventPublisher, logger)
+	profileUsecase := biz.NewProfileUsecase(profileReadModelRepo, relationRepo, favoriteRepo, logger)
+	accountDeletionRepo := data.NewAccountDeletionRepo(dataData, cacheInvalidationPublisher, passwordManager, logger)
+	accountDeletionUsecase := biz.NewAccountDeletionUsecase(accountDeletionRepo, userRepo, authUsecase, business, clock, logger)
+	dataExportRepo := data.NewDataExportRepo(dataData, logger)
+	storageDeletionRepo := data.NewStorageDeletionRepo(dataData, logger)
+	orphanCleanupRepo := data.NewOrphanCleanupRepo(dataData, logger)
+	dataExportUsecase := biz.NewDataExportUsecase(dataExportRepo, storageDeletionRepo, videoStorage, business, clock, logger)
+	quotaRepo := data.NewQuotaRepo(dataData, logger)
+	rateLimitMiddleware := middleware.NewRateLimitMiddleware(logger)
+	quotaUsecase := biz.NewQuotaUsecase(quotaRepo, rateLimitMiddleware, business, clock, logger)
+	validator := provider.NewValidator()
+	userStatsRepo := data.NewUserStatsRepo(dataData, logger)
+	userStatsUsecase := biz.NewUserStatsUsecase(userStatsRepo, videoRepo, clock, logger)
+	presenceRepo := data.NewPresenceRepo(authCache, logger)
+	presenceUsecase := biz.NewPresenceUsecase(presenceRepo, relationUsecase, logger)
+	userService := service.NewUserService(userUsecase, countsUsecase, relationUsecase, authUsecase, permissionUsecase, messageUsecase, registrationUsecase, passwordResetUsecase, emailUsecase, shareUsecase, referralUsecase, profileImageUsecase, profileUsecase, accountDeletionUsecase, dataExportUsecase, quotaUsecase, userStatsUsecase, loginAnomalyUsecase, oAuthUsecase, codeLoginUsecase, presenceUsecase, jwtManager, validator, cdn, logger)
+	videoStatsBufferRepo := data.NewVideoStatsBufferRepo(dataData, cacheInvalidationPublisher, logger)
+	uploadChecksumRepo := data.NewUploadChecksumRepo(dataData, logger)
+	dependencyChecker := data.NewDependencyChecker(dataData, logger)
+	degradationUsecase := biz.NewDegradationUsecase(dependencyChecker, permissionUsecase, business, clock, logger)
+	manager := provider.NewWorkerManager(logger)
+	sensitiveWordRepo := data.NewSensitiveWordRepo(dataData, logger)
+	contentModerationUsecase := biz.NewContentModerationUsecase(sensitiveWordRepo, business, logger)
+	uploadScanUsecase := biz.NewUploadScanUsecase(business, logger)
+	videoUsecase := biz.NewVideoUseCase(videoRepo, videoCacheRepo, videoStatsBufferRepo, uploadChecksumRepo, videoStorage, kafkaManager, business, degradationUsecase, contentModerationUsecase, uploadScanUsecase, manager, locker, clock, logger)
+	favoriteUsecase := biz.NewFavoriteUsecase(favoriteRepo, videoRepo, relationUsecase, logger)
+	watchHistoryRepo := data.NewWatchHistoryRepo(dataData, logger)
+	watchHistoryUsecase := biz.NewWatchHistoryUsecase(watchHistoryRepo, videoRepo, degradationUsecase, business, logger)
+	playDedupRepo := data.NewPlayDedupRepo(dataData, logger)
+	playCountUsecase := biz.NewPlayCountUsecase(playDedupRepo, videoRepo, videoUsecase, degradationUsecase, business, logger)
+	trendingRepo := data.NewTrendingRepo(dataData, logger)
+	trendingUsecase := biz.NewTrendingUsecase(trendingRepo, videoRepo, business, clock, logger)
+	takedownRepo := data.NewTakedownRepo(dataData, cacheInvalidationPublisher, logger)
+	takedownNotifier := data.NewTakedownNotifier(logger)
+	takedownUsecase := biz.NewTakedownUsecase(takedownRepo, videoStorage, takedownNotifier, permissionUsecase, logger)
+	userBanRepo := data.NewUserBanRepo(dataData, cacheInvalidationPublisher, clock, logger)
+	userBanUsecase := biz.NewUserBanUsecase(userBanRepo, permissionUsecase, logger)
+	categoryRepo := data.NewCategoryRepo(dataData, logger)
+	categoryUsecase := biz.NewCategoryUsecase(categoryRepo, permissionUsecase, logger)
+	captionRepo := data.NewCaptionRepo(dataData, logger)
+	captionUsecase := biz.NewCaptionUsecase(captionRepo, videoRepo, business, logger)
+	promotionRepo := data.NewPromotionRepo(dataData, logger)
+	promotionUsecase := biz.NewPromotionUsecase(promotionRepo, videoRepo, permissionUsecase, degradationUsecase, business, clock, logger)
+	videoProcessor := provider.NewVideoProcessor(business)
+	videoEditUsecase := biz.NewVideoEditUsecase(videoRepo, permissionUsecase, contentModerationUsecase, storageDeletionRepo, videoStorage, business, clock, logger)
+	shareLinkRepo := data.NewShareLinkRepo(dataData, logger)
+	shareLinkUsecase := biz.NewShareLinkUsecase(shareLinkRepo, videoRepo, videoUsecase, relationUsecase, business, logger)
+	videoService := service.NewVideoService(videoUsecase, userUsecase, countsUsecase, favoriteUsecase, relationUsecase, shareUsecase, referralUsecase, watchHistoryUsecase, playCountUsecase, trendingUsecase, takedownUsecase, categoryUsecase, quotaUsecase, captionUsecase, promotionUsecase, videoEditUsecase, shareLinkUsecase, uploadScanUsecase, validator, videoProcessor, cdn, logger)
+	messageService := service.NewMessageService(messageUsecase, validator, logger)
+	favoriteService := service.NewFavoriteService(favoriteUsecase, userUsecase, relationUsecase, countsUsecase, validator, cdn, logger)
+	commentRepo := data.NewCommentRepo(dataData, cacheInvalidationPublisher, interactionEventPublisher, logger)
+	mutedKeywordRepo := data.NewMutedKeywordRepo(dataData, logger)
+	commentUsecase := biz.NewCommentUsecase(commentRepo, videoRepo, mutedKeywordRepo, permissionUsecase, relationUsecase, contentModerationUsecase, business, logger)
+	mutedKeywordUsecase := biz.NewMutedKeywordUsecase(mutedKeywordRepo, logger)
+	commentService := service.NewCommentService(commentUsecase, mutedKeywordUsecase, userUsecase, countsUsecase, validator, logger)
 	videoEventPublisher := producer.NewVideoEventProducer(kafkaManager, business, logger)
-	videoRepo := data.NewVideoRepo(dataData, videoStorage, videoCacheRepo, videoEventPublisher, logger)
-	videoUsecase := biz.NewVideoUseCase(videoRepo, videoCacheRepo, videoStorage, kafkaManager, business, logger)
-	videoProcessor := newVideoProcessor(business)
-	videoService := service.NewVideoService(videoUsecase, userUsecase, validator, videoProcessor, logger)
-	authMiddleware := middleware.NewAuthMiddleware(jwtManager, logger)
+	moderationRepo := data.NewModerationRepo(dataData, cacheInvalidationPublisher, videoEventPublisher, logger)
+	moderationUsecase := biz.NewModerationUsecase(moderationRepo, permissionUsecase, logger)
+	moderationService := service.NewModerationService(moderationUsecase, registrationUsecase, commentUsecase, userUsecase, countsUsecase, validator, logger)
+	permissionAuditRepo := data.NewPermissionAuditRepo(dataData, logger)
+	permissionAuditUsecase := biz.NewPermissionAuditUsecase(permissionAuditRepo, permissionUsecase, business, logger)
+	processingJobRepo := data.NewProcessingJobRepo(dataData, logger)
+	processingUsecase := biz.NewProcessingUsecase(processingJobRepo, permissionUsecase, logger)
+	rbacSyncUsecase := biz.NewRBACSyncUsecase(roleRepo, permissionRepo, rbacManager, business, logger)
+	rbacAdminUsecase := biz.NewRBACAdminUsecase(roleRepo, permissionRepo, permissionUsecase, rbacSyncUsecase, logger)
+	deadLetterRepo := data.NewDeadLetterRepo(dataData, logger)
+	deadLetterPublisher := data.NewDeadLetterPublisher(kafkaManager)
+	deadLetterUsecase := biz.NewDeadLetterUsecase(deadLetterRepo, deadLetterPublisher, permissionUsecase, logger)
+	opsRepo := data.NewOpsRepo(dataData, multiLevelCache, profileProjection, clock, logger)
+	opsUsecase := biz.NewOpsUsecase(opsRepo, permissionUsecase, signingKeyUsecase, locker, clock, logger)
+	adminService := service.NewAdminService(permissionAuditUsecase, processingUsecase, rbacAdminUsecase, deadLetterUsecase, takedownUsecase, userBanUsecase, categoryUsecase, opsUsecase, promotionUsecase, degradationUsecase, logger)
+	referralService := service.NewReferralService(referralUsecase, countsUsecase, logger)
+	contentDraftRepo := data.NewContentDraftRepo(dataData, logger)
+	draftReminderNotifier := data.NewDraftReminderNotifier(logger)
+	calendarUsecase := biz.NewCalendarUsecase(contentDraftRepo, draftReminderNotifier, business, clock, logger)
+	calendarService := service.NewCalendarService(calendarUsecase, logger)
+	playlistRepo := data.NewPlaylistRepo(dataData, logger)
+	playlistUsecase := biz.NewPlaylistUsecase(playlistRepo, videoRepo, relationUsecase, business, logger)
+	playlistService := service.NewPlaylistService(playlistUsecase, userUsecase, countsUsecase, favoriteUsecase, validator, cdn, logger)
+	authMiddleware := middleware.NewAuthMiddleware(jwtManager, userBanUsecase, logger)
 	videoMiddleware := middleware.NewVideoMiddleware(videoProcessor, logger)
-	grpcServer := server.NewGRPCServer(confServer, userService, videoService, authMiddleware, videoMiddleware, logger)
-	permissionChecker := newSimplePermissionChecker(rbacManager)
-	rbacMiddleware := middleware.NewRBACMiddleware(permissionChecker, logger)
-	rateLimitMiddleware := middleware.NewRateLimitMiddleware(logger)
+	metadataMiddleware := middleware.NewMetadataMiddleware(logger)
+	degradationMiddleware := middleware.NewDegradationMiddleware(degradationUsecase)
+	metricsProvider, cleanup3, err := provider.NewMetricsProvider(logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	metricsMiddleware := middleware.NewMetricsMiddleware(metricsProvider)
 	securityMiddleware := middleware.NewSecurityMiddleware(validator, logger)
-	httpServer := server.NewHTTPServer(confServer, userService, videoService, authMiddleware, rbacMiddleware, rateLimitMiddleware, securityMiddleware, videoMiddleware, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	permissionChecker, err := provider.NewPermissionChecker(rbacManager, rbacSyncUsecase)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	rbacMiddleware := middleware.NewRBACMiddleware(permissionChecker, permissionAuditUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, userService, videoService, messageService, favoriteService, commentService, moderationService, adminService, referralService, calendarService, playlistService, authMiddleware, rbacMiddleware, securityMiddleware, videoMiddleware, metadataMiddleware, degradationMiddleware, metricsMiddleware, logger)
+	nonceStore := data.NewCallbackNonceStore(dataData, logger)
+	callbackMiddleware := middleware.NewCallbackMiddleware(business, nonceStore, logger)
+	httpServer := server.NewHTTPServer(confServer, userService, videoService, messageService, favoriteService, commentService, moderationService, adminService, referralService, calendarService, playlistService, authMiddleware, rbacMiddleware, rateLimitMiddleware, securityMiddleware, videoMiddleware, metadataMiddleware, callbackMiddleware, degradationMiddleware, metricsMiddleware, logger)
+	retentionRepo := data.NewRetentionRepo(dataData, logger)
+	retentionUsecase := biz.NewRetentionUsecase(retentionRepo, business, logger)
+	counterReconcileRepo := data.NewCounterReconcileRepo(dataData, cacheInvalidationPublisher, logger)
+	counterReconcileUsecase := biz.NewCounterReconcileUsecase(counterReconcileRepo, business, clock, logger)
+	integrityRepo := data.NewIntegrityRepo(dataData, cacheInvalidationPublisher, logger)
+	integrityNotifier := data.NewIntegrityNotifier(logger)
+	integrityUsecase := biz.NewIntegrityUsecase(integrityRepo, opsRepo, videoStorage, integrityNotifier, business, clock, logger)
+	outboxRepo := data.NewOutboxRepo(dataData, logger)
+	outboxRelayUsecase := biz.NewOutboxRelayUsecase(outboxRepo, videoEventPublisher, business, logger)
+	videoStatsFlushUsecase := biz.NewVideoStatsFlushUsecase(videoStatsBufferRepo, business, locker, clock, logger)
+	accountPurgeUsecase := biz.NewAccountPurgeUsecase(accountDeletionRepo, videoStorage, business, clock, logger)
+	storageDeletionUsecase := biz.NewStorageDeletionUsecase(storageDeletionRepo, videoStorage, business, clock, logger)
+	orphanCleanupUsecase := biz.NewOrphanCleanupUsecase(orphanCleanupRepo, videoStorage, business, clock, logger)
+	scheduler := server.NewScheduler(retentionUsecase, rbacSyncUsecase, permissionAuditUsecase, calendarUsecase, countsUsecase, counterReconcileUsecase, integrityUsecase, outboxRelayUsecase, videoStatsFlushUsecase, trendingUsecase, contentModerationUsecase, signingKeyUsecase, degradationUsecase, accountPurgeUsecase, storageDeletionUsecase, orphanCleanupUsecase, videoUsecase, clock, logger)
+	processedEventRepo := data.NewProcessedEventRepo(dataData, logger)
+	idempotencyUsecase := biz.NewIdempotencyUsecase(processedEventRepo, business, logger)
+	videoProcessConsumer := consumer.NewVideoProcessConsumer(kafkaManager, videoStorage, processingUsecase, videoUsecase, deadLetterUsecase, idempotencyUsecase, business, logger)
+	statsUpdateConsumer := consumer.NewStatsUpdateConsumer(videoUsecase, userStatsUsecase, trendingUsecase, idempotencyUsecase, business, logger)
+	workers := server.NewWorkers(kafkaManager, videoProcessConsumer, statsUpdateConsumer, manager, business, logger)
+	app := newApp(logger, grpcServer, httpServer, scheduler, workers)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
-
-// wire.go:
-
-// Provider functions
-func newJWTManager(bc *conf.Bootstrap) *auth.JWTManager {
-	return auth.NewJWTManager(
-		bc.Jwt.Secret,
-		bc.Jwt.ExpireTime.AsDuration(),
-	)
-}
-
-func newPasswordManager() *auth.PasswordManager {
-	return auth.NewPasswordManager()
-}
-
-func newMemoryRBACManager() auth.RBACManager {
-	return auth.NewMemoryRBACManager()
-}
-
-func newSimplePermissionChecker(rbacManager auth.RBACManager) auth.PermissionChecker {
-	return auth.NewSimplePermissionChecker(rbacManager)
-}
-
-func newValidator() *security.Validator {
-	return security.NewValidator()
-}
-
-func newSessionManager() auth.SessionManager {
-	return auth.NewMemorySessionManager()
-}
-
-func newKafkaManager(dc *conf.Data, logger log.Logger) *messaging.KafkaManager {
-	kafkaManager, _ := messaging.NewKafkaManager(dc.Kafka, logger)
-	return kafkaManager
-}
-
-func newVideoProcessor(bc *conf.Business) *media.VideoProcessor {
-	return media.NewVideoProcessor(
-		bc.Video.MaxFileSize,
-		bc.Video.SupportedFormats,
-		int(bc.Video.CoverWidth),
-		int(bc.Video.CoverHeight),
-		int(bc.Video.CoverQuality),
-	)
-}
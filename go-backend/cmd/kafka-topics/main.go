@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/messaging"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// kafka-topics 按 business.kafka_topics 的声明检查Kafka主题。默认只报告缺失的主题和配置漂移，
+// 存在差异时以非0状态退出，可用于部署前检查
+var (
+	flagconf string
+	create   bool
+	fix      bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&create, "create", false, "create missing topics")
+	flag.BoolVar(&fix, "fix", false, "create missing topics and fix drift that can be changed online (implies -create)")
+}
+
+func main() {
+	flag.Parse()
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	manager, err := messaging.NewKafkaTopicManager(bc.Data.Kafka.Brokers, messaging.TopicSpecsFromConfig(bc.Business.KafkaTopics), log.DefaultLogger)
+	if err != nil {
+		panic(err)
+	}
+	defer manager.Close()
+
+	var report *messaging.TopicReport
+	if create || fix {
+		report, err = manager.Sync(fix)
+	} else {
+		report, err = manager.Check()
+	}
+	if report != nil {
+		printReport(report)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "kafka topics failed: %v\n", err)
+		os.Exit(1)
+	}
+	if !report.InSync() {
+		os.Exit(1)
+	}
+}
+
+func printReport(report *messaging.TopicReport) {
+	created := make(map[string]bool, len(report.Created))
+	for _, name := range report.Created {
+		created[name] = true
+		fmt.Printf("created: %s\n", name)
+	}
+	for _, name := range report.Missing {
+		if !created[name] {
+			fmt.Printf("missing: %s\n", name)
+		}
+	}
+
+	fixed := make(map[string]bool, len(report.Fixed))
+	for _, drift := range report.Fixed {
+		fixed[drift.String()] = true
+		fmt.Printf("fixed: %s\n", drift)
+	}
+	for _, drift := range report.Drifts {
+		if fixed[drift.String()] {
+			continue
+		}
+		if drift.Fixable {
+			fmt.Printf("drift: %s\n", drift)
+		} else {
+			fmt.Printf("drift (manual): %s\n", drift)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/onlineddl"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// online-migrate 通过 gh-ost 执行 migrations/online 中的大表结构变更。
+// 默认只做演练，加 -execute 才会复制数据并切换表；Ctrl-C 或 SIGTERM 会安全中止当前变更，
+// 原表保持不变，重新执行时从未完成的语句继续
+var (
+	flagconf      string
+	dir           string
+	execute       bool
+	allowOnMaster bool
+	chunkSize     int
+	maxLoad       string
+	criticalLoad  string
+	ghostBinary   string
+	workDir       string
+	verbose       bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&dir, "dir", "../../../migrations/online", "online migration directory")
+	flag.BoolVar(&execute, "execute", false, "copy rows and cut over; without it gh-ost only runs a noop check")
+	flag.BoolVar(&allowOnMaster, "allow-on-master", false, "read binlog from the master when no replica is available")
+	flag.IntVar(&chunkSize, "chunk-size", 1000, "rows copied per batch")
+	flag.StringVar(&maxLoad, "max-load", "Threads_running=25", "throttle copying above this load")
+	flag.StringVar(&criticalLoad, "critical-load", "Threads_running=100", "abort the migration above this load")
+	flag.StringVar(&ghostBinary, "gh-ost", "gh-ost", "path to the gh-ost binary")
+	flag.StringVar(&workDir, "work-dir", os.TempDir(), "directory for gh-ost flag and credential files")
+	flag.BoolVar(&verbose, "verbose", false, "print raw gh-ost output")
+}
+
+func main() {
+	flag.Parse()
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, bc.Data.Database.Source); err != nil {
+		fmt.Fprintf(os.Stderr, "online migrate failed: %v\n", err)
+		if errors.Is(err, onlineddl.ErrAborted) {
+			fmt.Fprintln(os.Stderr, "the original table is unchanged; rerun to start the statement again")
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, dsn string) error {
+	migrations, err := onlineddl.LoadMigrations(dir)
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	history, err := onlineddl.NewHistory(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	ghostConfig, err := onlineddl.GhostConfigFromDSN(dsn)
+	if err != nil {
+		return err
+	}
+	ghostConfig.Binary = ghostBinary
+	ghostConfig.ChunkSize = chunkSize
+	ghostConfig.MaxLoad = maxLoad
+	ghostConfig.CriticalLoad = criticalLoad
+	ghostConfig.AllowOnMaster = allowOnMaster
+	ghostConfig.Execute = execute
+	ghostConfig.WorkDir = workDir
+	if verbose {
+		ghostConfig.Output = os.Stdout
+	}
+
+	runner := onlineddl.NewRunner(onlineddl.NewGhost(*ghostConfig), history, !execute)
+	pending, err := runner.Pending(ctx, migrations)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		fmt.Println("no pending online migrations")
+		return nil
+	}
+
+	for _, p := range pending {
+		fmt.Printf("pending %s: ALTER TABLE %s %s\n", p.ID, p.Table, p.Alter)
+	}
+
+	err = runner.Run(ctx, pending, func(id string, p onlineddl.Progress) {
+		fmt.Printf("%s table=%s copied=%d/%d %.1f%% state=%q eta=%s\n",
+			id, p.Table, p.Copied, p.Total, p.Percent, p.State, p.ETA)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("applied=%d dry_run=%t\n", len(pending), !execute)
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go-backend/internal/prober"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// prober 对生产环境执行拨测并在 -listen 地址暴露 /metrics 供 Prometheus 抓取。
+// 金丝雀账号密码从环境变量 PROBER_PASSWORD 读取，避免出现在进程参数中
+var (
+	target           string
+	username         string
+	listen           string
+	interval         time.Duration
+	timeout          time.Duration
+	failureThreshold int
+	alertWebhook     string
+	once             bool
+)
+
+func init() {
+	flag.StringVar(&target, "target", "http://localhost:8000", "base URL of the HTTP API, eg: -target https://api.example.com")
+	flag.StringVar(&username, "username", "prober-canary", "canary account username")
+	flag.StringVar(&listen, "listen", ":9102", "address serving /metrics and /healthz")
+	flag.DurationVar(&interval, "interval", time.Minute, "interval between probe rounds")
+	flag.DurationVar(&timeout, "timeout", 30*time.Second, "timeout of a probe round")
+	flag.IntVar(&failureThreshold, "failure-threshold", 3, "consecutive failures of a step before alerting")
+	flag.StringVar(&alertWebhook, "alert-webhook", "", "URL receiving alert JSON via POST, logs only when empty")
+	flag.BoolVar(&once, "once", false, "run a single round, print results and exit non-zero on failure")
+}
+
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"service.name", "prober",
+	)
+	helper := log.NewHelper(logger)
+
+	p, err := prober.New(prober.Config{
+		Target:           target,
+		Username:         username,
+		Password:         os.Getenv("PROBER_PASSWORD"),
+		Interval:         interval,
+		Timeout:          timeout,
+		FailureThreshold: failureThreshold,
+		AlertWebhook:     alertWebhook,
+	}, logger)
+	if err != nil {
+		helper.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if once {
+		failed := false
+		for _, r := range p.RunOnce(ctx) {
+			switch {
+			case r.Skipped:
+				helper.Infof("%s: skipped", r.Name)
+			case r.Err != nil:
+				failed = true
+				helper.Errorf("%s: failed in %s: %v", r.Name, r.Duration, r.Err)
+			default:
+				helper.Infof("%s: ok in %s", r.Name, r.Duration)
+			}
+		}
+		if failed {
+			os.Exit(1)
+		}
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", p.Metrics())
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	srv := &http.Server{Addr: listen, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			helper.Errorf("metrics server failed: %v", err)
+			stop()
+		}
+	}()
+
+	helper.Infof("probing %s every %s, metrics on %s", target, interval, listen)
+	p.Run(ctx)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	srv.Shutdown(shutdownCtx)
+}
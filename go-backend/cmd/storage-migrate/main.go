@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/data"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+// storage-migrate 将单桶布局中的存量对象复制到 buckets 配置的各类别存储桶（data.minio.buckets 或 data.s3.buckets）。
+// 可以重复执行，已迁移的对象会被跳过
+var (
+	flagconf     string
+	prefix       string
+	dryRun       bool
+	deleteSource bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&prefix, "prefix", "", "only migrate objects under this prefix, eg: -prefix covers/")
+	flag.BoolVar(&dryRun, "dry-run", false, "count objects to migrate without copying")
+	flag.BoolVar(&deleteSource, "delete-source", false, "delete objects from the legacy bucket after copying")
+}
+
+func main() {
+	flag.Parse()
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	router, err := data.NewStorageRouter(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+
+	stats, err := router.Migrate(context.Background(), &storage.MigrateOptions{
+		Prefix:       prefix,
+		DryRun:       dryRun,
+		DeleteSource: deleteSource,
+	})
+	if stats != nil {
+		fmt.Printf("scanned=%d copied=%d copied_bytes=%d skipped=%d failed=%d dry_run=%t\n",
+			stats.Scanned, stats.Copied, stats.CopiedBytes, stats.Skipped, stats.Failed, dryRun)
+		for _, object := range stats.FailedObjects {
+			fmt.Fprintf(os.Stderr, "failed: %s\n", object)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate failed: %v\n", err)
+		os.Exit(1)
+	}
+	if stats.Failed > 0 {
+		os.Exit(1)
+	}
+}
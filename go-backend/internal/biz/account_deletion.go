@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultDeletionGracePeriod = 14 * 24 * time.Hour
+
+// ErrAccountPendingDeletion 账号处于注销冷静期
+var ErrAccountPendingDeletion = errors.Forbidden(v1.ErrorCode_ACCOUNT_PENDING_DELETION.String(), "account is pending deletion")
+
+// AccountDeletion 账号注销申请
+type AccountDeletion struct {
+	UserID      int64
+	Username    string
+	RequestedAt time.Time
+	PurgeAt     time.Time
+}
+
+// AccountDeletionRepo 账号注销仓储接口
+type AccountDeletionRepo interface {
+	// MarkPendingDeletion 在一个事务中将正常账号置为待注销，并隐藏其已发布视频和评论，账号不存在时返回 ErrUserNotFound
+	MarkPendingDeletion(ctx context.Context, deletion *AccountDeletion) error
+	// GetPendingDeletion 按用户名查找冷静期中的账号并校验密码，账号不存在或不在冷静期返回 ErrUserNotFound，密码错误返回 ErrPasswordError
+	GetPendingDeletion(ctx context.Context, username, password string) (*AccountDeletion, error)
+	// Restore 撤销注销，恢复账号状态以及被隐藏的视频和评论，账号不在冷静期时返回 ErrUserNotFound
+	Restore(ctx context.Context, userID int64) error
+	// ListExpiredDeletions 冷静期已满的账号，有视频处于法律保全的账号不返回
+	ListExpiredDeletions(ctx context.Context, now time.Time, limit int) ([]*User, error)
+	// ListAccountVideos 账号的全部视频，包括已删除和隐藏的视频
+	ListAccountVideos(ctx context.Context, userID int64) ([]*domain.Video, error)
+	// AnonymizeAccount 在一个事务中删除个人数据、将视频置为已删除、匿名化账号并写入待删除的存储对象，
+	// 账号已不在冷静期时返回 ErrUserNotFound
+	AnonymizeAccount(ctx context.Context, userID int64, objects []*StorageDeletion) error
+}
+
+// AccountDeletionUsecase 账号注销用例。注销后账号进入冷静期，内容对外隐藏，
+// 期内凭密码可恢复；期满后由 AccountPurgeUsecase 匿名化账号并删除个人数据和存储对象
+type AccountDeletionUsecase struct {
+	repo        AccountDeletionRepo
+	userRepo    UserRepo
+	authUc      *AuthUsecase
+	gracePeriod time.Duration
+	clock       clock.Clock
+	log         *log.Helper
+}
+
+// NewAccountDeletionUsecase 创建账号注销用例
+func NewAccountDeletionUsecase(repo AccountDeletionRepo, userRepo UserRepo, authUc *AuthUsecase, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *AccountDeletionUsecase {
+	uc := &AccountDeletionUsecase{
+		repo:        repo,
+		userRepo:    userRepo,
+		authUc:      authUc,
+		gracePeriod: defaultDeletionGracePeriod,
+		clock:       clk,
+		log:         log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetAccountDeletion(); cfg != nil && cfg.GracePeriod != nil {
+		uc.gracePeriod = cfg.GracePeriod.AsDuration()
+	}
+
+	return uc
+}
+
+// RequestDeletion 校验密码后注销账号，并撤销当前Token和会话
+func (uc *AccountDeletionUsecase) RequestDeletion(ctx context.Context, userID int64, password, accessToken string) (*AccountDeletion, error) {
+	user, err := uc.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.userRepo.VerifyPassword(ctx, user.Username, password); err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	deletion := &AccountDeletion{
+		UserID:      user.ID,
+		Username:    user.Username,
+		RequestedAt: now,
+		PurgeAt:     now.Add(uc.gracePeriod),
+	}
+	if err := uc.repo.MarkPendingDeletion(ctx, deletion); err != nil {
+		return nil, err
+	}
+
+	// 账号已进入冷静期，登出失败不影响注销结果
+	if err := uc.authUc.Logout(ctx, user.ID, accessToken, ""); err != nil {
+		uc.log.WithContext(ctx).Warnf("logout after deletion request failed: user=%d err=%v", user.ID, err)
+	}
+
+	uc.log.WithContext(ctx).Infof("account %d pending deletion, purge at %s", user.ID, deletion.PurgeAt.Format(time.RFC3339))
+	return deletion, nil
+}
+
+// PendingDeletion 返回冷静期中账号的注销信息，供登录时提示恢复账号
+func (uc *AccountDeletionUsecase) PendingDeletion(ctx context.Context, username, password string) (*AccountDeletion, error) {
+	return uc.verifyPendingDeletion(ctx, username, password)
+}
+
+// Restore 撤销注销并登录，冷静期已过但尚未被清除的账号不可恢复
+func (uc *AccountDeletionUsecase) Restore(ctx context.Context, username, password string) (*auth.TokenPair, *User, error) {
+	deletion, err := uc.verifyPendingDeletion(ctx, username, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !uc.clock.Now().Before(deletion.PurgeAt) {
+		return nil, nil, ErrUserNotFound
+	}
+
+	if err := uc.repo.Restore(ctx, deletion.UserID); err != nil {
+		return nil, nil, err
+	}
+	uc.log.WithContext(ctx).Infof("account %d restored from pending deletion", deletion.UserID)
+
+	return uc.authUc.LoginWithToken(ctx, username, password)
+}
+
+// verifyPendingDeletion 校验冷静期账号的密码，与登录共用失败次数限制
+func (uc *AccountDeletionUsecase) verifyPendingDeletion(ctx context.Context, username, password string) (*AccountDeletion, error) {
+	if _, err := uc.authUc.CheckLoginAllowed(ctx, username); err != nil {
+		return nil, err
+	}
+
+	deletion, err := uc.repo.GetPendingDeletion(ctx, username, password)
+	if err != nil {
+		if err == ErrPasswordError {
+			uc.authUc.RecordLoginFailure(ctx, username)
+		}
+		return nil, err
+	}
+	return deletion, nil
+}
@@ -0,0 +1,163 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type accountDeletionTestDeps struct {
+	repo       *MockAccountDeletionRepo
+	authRepo   *MockAuthRepo
+	userRepo   *MockUserRepo
+	jwtManager *auth.JWTManager
+	sessionMgr auth.SessionManager
+	clock      *clock.Fake
+	uc         *AccountDeletionUsecase
+}
+
+func newAccountDeletionTestDeps(t *testing.T) *accountDeletionTestDeps {
+	repo := NewMockAccountDeletionRepo(t)
+	authRepo := NewMockAuthRepo(t)
+	userRepo := NewMockUserRepo(t)
+	jwtManager := auth.NewJWTManager("test-secret", time.Hour)
+	sessionMgr := auth.NewMemorySessionManager()
+	clk := clock.NewFake(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	authUc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, nil, nil, newTestLocker(), &conf.Business{}, clock.New(), log.DefaultLogger)
+	businessConfig := &conf.Business{AccountDeletion: &conf.Business_AccountDeletion{
+		GracePeriod: durationpb.New(48 * time.Hour),
+	}}
+
+	return &accountDeletionTestDeps{
+		repo:       repo,
+		authRepo:   authRepo,
+		userRepo:   userRepo,
+		jwtManager: jwtManager,
+		sessionMgr: sessionMgr,
+		clock:      clk,
+		uc:         NewAccountDeletionUsecase(repo, userRepo, authUc, businessConfig, clk, log.DefaultLogger),
+	}
+}
+
+func TestAccountDeletionUsecase_RequestDeletion(t *testing.T) {
+	ctx := context.Background()
+	user := &User{ID: 1, Username: "alice"}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newAccountDeletionTestDeps(t)
+
+		pair, err := d.jwtManager.GenerateTokenPair(user.ID, user.Username)
+		require.NoError(t, err)
+		_, err = d.sessionMgr.CreateSession(ctx, user.ID, pair.RefreshToken, pair.FamilyID, time.Hour)
+		require.NoError(t, err)
+
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(user, nil)
+		d.userRepo.EXPECT().VerifyPassword(ctx, "alice", "secret").Return(user, nil)
+		d.repo.EXPECT().MarkPendingDeletion(ctx, mock.AnythingOfType("*biz.AccountDeletion")).Return(nil)
+		d.authRepo.EXPECT().AddTokenToBlacklist(ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
+
+		deletion, err := d.uc.RequestDeletion(ctx, 1, "secret", pair.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deletion.UserID)
+		assert.Equal(t, d.clock.Now(), deletion.RequestedAt)
+		assert.Equal(t, d.clock.Now().Add(48*time.Hour), deletion.PurgeAt)
+
+		// 注销后会话被删除，Token 被撤销
+		_, err = d.sessionMgr.ValidateSession(ctx, user.ID, pair.RefreshToken)
+		assert.Error(t, err)
+		_, err = d.jwtManager.VerifyToken(pair.AccessToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		d := newAccountDeletionTestDeps(t)
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(user, nil)
+		d.userRepo.EXPECT().VerifyPassword(ctx, "alice", "wrong").Return(nil, ErrPasswordError)
+
+		_, err := d.uc.RequestDeletion(ctx, 1, "wrong", "")
+		assert.Equal(t, ErrPasswordError, err)
+	})
+}
+
+func TestAccountDeletionUsecase_Restore(t *testing.T) {
+	ctx := context.Background()
+	user := &User{ID: 1, Username: "alice"}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newAccountDeletionTestDeps(t)
+		pending := &AccountDeletion{UserID: 1, Username: "alice", PurgeAt: d.clock.Now().Add(time.Hour)}
+
+		d.authRepo.EXPECT().GetLoginAttempts(ctx, "alice").Return(0, nil)
+		d.repo.EXPECT().GetPendingDeletion(ctx, "alice", "secret").Return(pending, nil)
+		d.repo.EXPECT().Restore(ctx, int64(1)).Return(nil)
+		d.userRepo.EXPECT().VerifyPassword(ctx, "alice", "secret").Return(user, nil)
+		d.userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(nil)
+
+		pair, restored, err := d.uc.Restore(ctx, "alice", "secret")
+		require.NoError(t, err)
+		assert.NotEmpty(t, pair.AccessToken)
+		assert.Equal(t, int64(1), restored.ID)
+	})
+
+	t.Run("GracePeriodElapsed", func(t *testing.T) {
+		d := newAccountDeletionTestDeps(t)
+		pending := &AccountDeletion{UserID: 1, Username: "alice", PurgeAt: d.clock.Now().Add(-time.Minute)}
+
+		d.authRepo.EXPECT().GetLoginAttempts(ctx, "alice").Return(0, nil)
+		d.repo.EXPECT().GetPendingDeletion(ctx, "alice", "secret").Return(pending, nil)
+
+		_, _, err := d.uc.Restore(ctx, "alice", "secret")
+		assert.Equal(t, ErrUserNotFound, err)
+	})
+
+	t.Run("GracePeriodBoundary", func(t *testing.T) {
+		d := newAccountDeletionTestDeps(t)
+		pending := &AccountDeletion{UserID: 1, Username: "alice", PurgeAt: d.clock.Now().Add(48 * time.Hour)}
+		d.authRepo.EXPECT().GetLoginAttempts(ctx, "alice").Return(0, nil)
+		d.repo.EXPECT().GetPendingDeletion(ctx, "alice", "secret").Return(pending, nil)
+
+		// 到达 PurgeAt 时冷静期已结束，不可恢复
+		d.clock.Set(pending.PurgeAt)
+		_, _, err := d.uc.Restore(ctx, "alice", "secret")
+		assert.Equal(t, ErrUserNotFound, err)
+
+		// PurgeAt 之前仍可恢复
+		d.clock.Set(pending.PurgeAt.Add(-time.Nanosecond))
+		d.repo.EXPECT().Restore(ctx, int64(1)).Return(nil)
+		d.userRepo.EXPECT().VerifyPassword(ctx, "alice", "secret").Return(user, nil)
+		d.userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(nil)
+
+		_, restored, err := d.uc.Restore(ctx, "alice", "secret")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), restored.ID)
+	})
+
+	t.Run("WrongPasswordCountsAsLoginFailure", func(t *testing.T) {
+		d := newAccountDeletionTestDeps(t)
+		d.authRepo.EXPECT().GetLoginAttempts(ctx, "alice").Return(1, nil)
+		d.authRepo.EXPECT().IncrLoginAttempts(ctx, "alice", defaultLoginLockDuration).Return(2, nil)
+		d.repo.EXPECT().GetPendingDeletion(ctx, "alice", "wrong").Return(nil, ErrPasswordError)
+
+		_, _, err := d.uc.Restore(ctx, "alice", "wrong")
+		assert.Equal(t, ErrPasswordError, err)
+	})
+
+	t.Run("Locked", func(t *testing.T) {
+		d := newAccountDeletionTestDeps(t)
+		d.authRepo.EXPECT().GetLoginAttempts(ctx, "alice").Return(defaultMaxLoginAttempts, nil)
+
+		_, _, err := d.uc.Restore(ctx, "alice", "secret")
+		assert.Equal(t, ErrAccountLocked, err)
+	})
+}
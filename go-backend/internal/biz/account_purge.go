@@ -0,0 +1,144 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultAccountPurgeInterval = time.Hour
+	// accountPurgeBatchSize 每次处理的账号数
+	accountPurgeBatchSize = 50
+)
+
+// AccountPurgeUsecase 清除冷静期已满的注销账号。账号行匿名化保留，维持私信、下架记录等数据的引用；
+// 个人数据在同一事务中删除，视频置为已删除，视频文件、封面和头像写入存储删除队列由后台任务删除。
+// 被删除的关注和点赞使其他用户的计数偏大，由计数校准任务修正
+type AccountPurgeUsecase struct {
+	repo     AccountDeletionRepo
+	storage  storage.VideoStorage
+	interval time.Duration
+	clock    clock.Clock
+	log      *log.Helper
+}
+
+// NewAccountPurgeUsecase 创建注销账号清除任务
+func NewAccountPurgeUsecase(repo AccountDeletionRepo, storage storage.VideoStorage, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *AccountPurgeUsecase {
+	uc := &AccountPurgeUsecase{
+		repo:     repo,
+		storage:  storage,
+		interval: defaultAccountPurgeInterval,
+		clock:    clk,
+		log:      log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetAccountDeletion(); cfg != nil && cfg.PurgeInterval != nil && cfg.PurgeInterval.AsDuration() > 0 {
+		uc.interval = cfg.PurgeInterval.AsDuration()
+	}
+
+	return uc
+}
+
+// Interval 执行间隔
+func (uc *AccountPurgeUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Run 清除一批冷静期已满的账号，单个账号失败不影响其余账号，供调度器调用
+func (uc *AccountPurgeUsecase) Run(ctx context.Context) error {
+	users, err := uc.repo.ListExpiredDeletions(ctx, uc.clock.Now(), accountPurgeBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, user := range users {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := uc.purge(ctx, user); err != nil {
+			if err == ErrUserNotFound {
+				// 查询之后账号被恢复
+				continue
+			}
+			failed++
+			uc.log.WithContext(ctx).Errorf("purge account failed: user=%d err=%v", user.ID, err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d account purges failed", failed, len(users))
+	}
+	return nil
+}
+
+func (uc *AccountPurgeUsecase) purge(ctx context.Context, user *User) error {
+	videos, err := uc.repo.ListAccountVideos(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	objects := uc.accountObjects(user, videos)
+	if err := uc.repo.AnonymizeAccount(ctx, user.ID, objects); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("account %d purged: videos=%d objects=%d", user.ID, len(videos), len(objects))
+	return nil
+}
+
+// accountObjects 账号的头像、背景图和视频的全部文件，HLS 文件按视频目录整体删除。
+// 不由本存储管理的地址（如默认头像）跳过
+func (uc *AccountPurgeUsecase) accountObjects(user *User, videos []*domain.Video) []*StorageDeletion {
+	resolver, ok := uc.storage.(storage.ObjectResolver)
+	if !ok {
+		uc.log.Warnf("storage cannot resolve object names, skip deleting objects of account %d", user.ID)
+		return nil
+	}
+
+	now := uc.clock.Now()
+	seen := make(map[string]bool)
+	var objects []*StorageDeletion
+	add := func(objectName string) {
+		if seen[objectName] {
+			return
+		}
+		seen[objectName] = true
+		objects = append(objects, &StorageDeletion{
+			ObjectName:  objectName,
+			Reason:      StorageDeletionReasonAccountPurge,
+			DeleteAfter: now,
+		})
+	}
+
+	urls := []string{user.Avatar, user.BackgroundImage}
+	for _, video := range videos {
+		urls = append(urls, video.PlayURL, video.CoverURL, video.DynamicCoverURL)
+		for _, url := range video.PlayURLs {
+			urls = append(urls, url)
+		}
+		if objectName, ok := resolver.ObjectName(video.HLSURL); ok {
+			if dir := path.Dir(objectName); dir != "." {
+				add(dir + "/")
+			}
+		}
+	}
+	for _, url := range urls {
+		if url == "" {
+			continue
+		}
+		if objectName, ok := resolver.ObjectName(url); ok {
+			add(objectName)
+		}
+	}
+	return objects
+}
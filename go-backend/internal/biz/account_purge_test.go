@@ -0,0 +1,64 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountPurgeUsecase_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := NewMockAccountDeletionRepo(t)
+	uc := NewAccountPurgeUsecase(repo, newMemoryStorage(), nil, clock.NewFake(now), log.DefaultLogger)
+
+	users := []*User{
+		{
+			ID:              1,
+			Avatar:          "https://cdn.example.com/avatars/1.jpg",
+			BackgroundImage: "https://example.com/default-bg.jpg",
+		},
+		{ID: 2},
+		{ID: 3},
+	}
+	repo.EXPECT().ListExpiredDeletions(ctx, now, accountPurgeBatchSize).Return(users, nil)
+
+	repo.EXPECT().ListAccountVideos(ctx, int64(1)).Return([]*domain.Video{{
+		ID:       10,
+		PlayURL:  "https://cdn.example.com/videos/10.mp4",
+		CoverURL: "https://cdn.example.com/covers/10.jpg",
+		PlayURLs: map[string]string{"720p": "https://cdn.example.com/videos/10.mp4"},
+		HLSURL:   "https://cdn.example.com/hls/10/index.m3u8",
+	}}, nil)
+	repo.EXPECT().AnonymizeAccount(ctx, int64(1), mock.Anything).
+		Run(func(_ context.Context, _ int64, objects []*StorageDeletion) {
+			var names []string
+			for _, o := range objects {
+				assert.Equal(t, StorageDeletionReasonAccountPurge, o.Reason)
+				assert.Equal(t, now, o.DeleteAfter)
+				names = append(names, o.ObjectName)
+			}
+			// 默认背景图不属于本存储，重复的地址只登记一次
+			assert.ElementsMatch(t, []string{"avatars/1.jpg", "videos/10.mp4", "covers/10.jpg", "hls/10/"}, names)
+		}).Return(nil)
+
+	// 查询之后被恢复的账号跳过
+	repo.EXPECT().ListAccountVideos(ctx, int64(2)).Return(nil, nil)
+	repo.EXPECT().AnonymizeAccount(ctx, int64(2), mock.Anything).Return(ErrUserNotFound)
+
+	repo.EXPECT().ListAccountVideos(ctx, int64(3)).Return(nil, errors.New("db error"))
+
+	err := uc.Run(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 3")
+}
@@ -2,26 +2,54 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
 	"go-backend/internal/domain"
 	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
-var ErrSessionExpired = errors.GatewayTimeout("SESSION_EXPIRED", "session expired")
+var (
+	ErrSessionExpired = errors.GatewayTimeout("SESSION_EXPIRED", "session expired")
+	ErrAccountLocked  = errors.Forbidden(v1.ErrorCode_ACCOUNT_LOCKED.String(), "account temporarily locked")
+	// ErrRefreshTokenReused 已轮换的Refresh Token被再次使用，视为令牌泄露
+	ErrRefreshTokenReused = errors.Unauthorized(v1.ErrorCode_TOKEN_INVALID.String(), "refresh token reused")
+)
+
+const (
+	defaultMaxLoginAttempts  = 5
+	defaultLoginLockDuration = 15 * time.Minute
+)
 
-// AuthRepo 认证仓储接口
+// AuthRepo 认证仓储接口，会话的存储与校验由 auth.SessionManager 负责
 type AuthRepo interface {
-	CreateSession(ctx context.Context, session *domain.UserSession) error
-	GetSession(ctx context.Context, userID int64) (*domain.UserSession, error)
-	GetSessionByToken(ctx context.Context, refreshToken string) (*domain.UserSession, error)
-	UpdateSession(ctx context.Context, userID int64, newRefreshToken string, expiry time.Duration) error
-	DeleteSession(ctx context.Context, userID int64) error
 	AddTokenToBlacklist(ctx context.Context, tokenID string, expiresAt time.Time) error
 	IsTokenBlacklisted(ctx context.Context, tokenID string) (bool, error)
+	// GetLoginAttempts 获取当前窗口内的连续登录失败次数
+	GetLoginAttempts(ctx context.Context, username string) (int, error)
+	// IncrLoginAttempts 原子地记录一次登录失败，返回累加后的失败次数，window 后自动清除
+	IncrLoginAttempts(ctx context.Context, username string, window time.Duration) (int, error)
+	ClearLoginAttempts(ctx context.Context, username string) error
+	// SetPasswordResetToken 保存密码重置Token，覆盖之前未使用的Token
+	SetPasswordResetToken(ctx context.Context, username, token string) error
+	// ConsumePasswordResetToken Token匹配时原子地删除并返回 true，Token不匹配或不存在时返回 false
+	ConsumePasswordResetToken(ctx context.Context, username, token string) (bool, error)
+}
+
+// SecurityEventNotifier 投递安全事件，实现可以是日志、告警或消息队列
+type SecurityEventNotifier interface {
+	NotifySecurityEvent(ctx context.Context, event *domain.SecurityEvent) error
+}
+
+// LoginGuard 在密码校验通过后、签发令牌前评估登录风险，返回错误时拒绝本次登录
+type LoginGuard interface {
+	CheckLogin(ctx context.Context, user *User, challengeCode string) error
 }
 
 // AuthUsecase 认证用例
@@ -30,7 +58,15 @@ type AuthUsecase struct {
 	userRepo   UserRepo
 	jwtManager *auth.JWTManager
 	sessionMgr auth.SessionManager
-	log        *log.Helper
+	notifier   SecurityEventNotifier
+	guard      LoginGuard
+	locker     Locker
+	clock      clock.Clock
+
+	maxLoginAttempts  int
+	loginLockDuration time.Duration
+
+	log *log.Helper
 }
 
 // NewAuthUsecase 创建认证用例
@@ -39,55 +75,134 @@ func NewAuthUsecase(
 	userRepo UserRepo,
 	jwtManager *auth.JWTManager,
 	sessionMgr auth.SessionManager,
+	notifier SecurityEventNotifier,
+	guard LoginGuard,
+	locker Locker,
+	businessConfig *conf.Business,
+	clk clock.Clock,
 	logger log.Logger,
 ) *AuthUsecase {
-	return &AuthUsecase{
-		repo:       repo,
-		userRepo:   userRepo,
-		jwtManager: jwtManager,
-		sessionMgr: sessionMgr,
-		log:        log.NewHelper(logger),
+	uc := &AuthUsecase{
+		repo:              repo,
+		userRepo:          userRepo,
+		jwtManager:        jwtManager,
+		sessionMgr:        sessionMgr,
+		notifier:          notifier,
+		guard:             guard,
+		locker:            locker,
+		clock:             clk,
+		maxLoginAttempts:  defaultMaxLoginAttempts,
+		loginLockDuration: defaultLoginLockDuration,
+		log:               log.NewHelper(logger),
 	}
+
+	if cfg := businessConfig.GetUser(); cfg != nil {
+		if cfg.MaxLoginAttempts > 0 {
+			uc.maxLoginAttempts = int(cfg.MaxLoginAttempts)
+		}
+		if cfg.LoginLockDuration != nil && cfg.LoginLockDuration.AsDuration() > 0 {
+			uc.loginLockDuration = cfg.LoginLockDuration.AsDuration()
+		}
+	}
+
+	return uc
 }
 
 // LoginWithToken 使用双Token机制登录
 func (uc *AuthUsecase) LoginWithToken(ctx context.Context, username, password string) (*auth.TokenPair, *User, error) {
+	return uc.LoginWithChallenge(ctx, username, password, "")
+}
+
+// LoginWithChallenge 使用双Token机制登录，challengeCode 为异常登录时发往已绑定邮箱的验证码
+func (uc *AuthUsecase) LoginWithChallenge(ctx context.Context, username, password, challengeCode string) (*auth.TokenPair, *User, error) {
 	uc.log.WithContext(ctx).Infof("Login with token: %s", username)
 
+	// 失败次数达到上限时直接拒绝，不再校验密码
+	attempts, err := uc.CheckLoginAllowed(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// 验证用户名和密码
 	user, err := uc.userRepo.VerifyPassword(ctx, username, password)
 	if err != nil {
+		if err == ErrPasswordError {
+			uc.RecordLoginFailure(ctx, username)
+		}
 		return nil, nil, err
 	}
 
-	// 生成Token对
-	tokenPair, err := uc.jwtManager.GenerateTokenPair(user.ID, user.Username)
+	if attempts > 0 {
+		if err := uc.repo.ClearLoginAttempts(ctx, username); err != nil {
+			uc.log.WithContext(ctx).Warnf("clear login attempts failed: %v", err)
+		}
+	}
+
+	tokenPair, err := uc.IssueLogin(ctx, user, challengeCode)
 	if err != nil {
 		return nil, nil, err
 	}
+	return tokenPair, user, nil
+}
 
-	// 创建会话
-	session := &domain.UserSession{
-		UserID:       user.ID,
-		RefreshToken: tokenPair.RefreshToken,
-		ExpiresAt:    tokenPair.RefreshExpiry,
+// IssueLogin 为已通过身份校验的用户完成登录：异常登录检测、签发Token对、创建会话并更新登录时间。
+// 密码登录和第三方登录共用
+func (uc *AuthUsecase) IssueLogin(ctx context.Context, user *User, challengeCode string) (*auth.TokenPair, error) {
+	// 异常登录检测，可能要求输入验证码
+	if uc.guard != nil {
+		if err := uc.guard.CheckLogin(ctx, user, challengeCode); err != nil {
+			return nil, err
+		}
 	}
 
-	// 删除旧会话并创建新会话
-	uc.repo.DeleteSession(ctx, user.ID)
-	if err := uc.repo.CreateSession(ctx, session); err != nil {
+	// 生成Token对
+	tokenPair, err := uc.jwtManager.GenerateTokenPair(user.ID, user.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	// 创建会话，替换旧会话
+	if _, err := uc.sessionMgr.CreateSession(ctx, user.ID, tokenPair.RefreshToken, tokenPair.FamilyID, uc.clock.Until(tokenPair.RefreshExpiry)); err != nil {
 		uc.log.WithContext(ctx).Errorf("create session failed: %v", err)
 	}
 
 	// 更新登录时间
-	now := time.Now()
+	now := uc.clock.Now()
 	user.LastLoginAt = &now
 	uc.userRepo.UpdateUser(ctx, user)
 
-	return tokenPair, user, nil
+	return tokenPair, nil
+}
+
+// CheckLoginAllowed 返回当前窗口内的登录失败次数，达到上限时返回 ErrAccountLocked。
+// 读取失败次数出错时不阻止登录
+func (uc *AuthUsecase) CheckLoginAllowed(ctx context.Context, username string) (int, error) {
+	attempts, err := uc.repo.GetLoginAttempts(ctx, username)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("get login attempts failed: %v", err)
+	}
+	if attempts >= uc.maxLoginAttempts {
+		return attempts, ErrAccountLocked
+	}
+	return attempts, nil
+}
+
+// RecordLoginFailure 记录一次密码错误，达到上限后账号在锁定窗口内无法登录。
+// 计数原子累加，并发的错误尝试不会互相覆盖，按累加后的返回值判断是否锁定
+func (uc *AuthUsecase) RecordLoginFailure(ctx context.Context, username string) {
+	attempts, err := uc.repo.IncrLoginAttempts(ctx, username, uc.loginLockDuration)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("incr login attempts failed: %v", err)
+		return
+	}
+	if attempts >= uc.maxLoginAttempts {
+		uc.log.WithContext(ctx).Warnf("account locked after %d failed logins: %s", attempts, username)
+	}
 }
 
-// RefreshToken 刷新Token
+// RefreshToken 刷新Token。Refresh Token每次使用后轮换，新Token沿用原轮换族；
+// 已轮换的Token再次出现说明令牌可能已泄露，撤销该族对应的会话并发出安全事件。
+// 同一轮换族的刷新跨实例互斥，并发请求中只有一个能完成轮换
 func (uc *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*auth.TokenPair, error) {
 	uc.log.WithContext(ctx).Info("Refresh token")
 
@@ -97,23 +212,49 @@ func (uc *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*
 		return nil, err
 	}
 
-	// 检查会话是否存在
-	session, err := uc.repo.GetSessionByToken(ctx, refreshToken)
+	var newTokenPair *auth.TokenPair
+	err = withLock(ctx, uc.locker, refreshLockKey(claims.FamilyID), func(ctx context.Context) error {
+		var err error
+		newTokenPair, err = uc.rotateRefreshToken(ctx, refreshToken, claims)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	return newTokenPair, nil
+}
+
+// rotateRefreshToken 检查重放并轮换Refresh Token，调用方需持有该轮换族的锁
+func (uc *AuthUsecase) rotateRefreshToken(ctx context.Context, refreshToken string, claims *auth.RefreshClaims) (*auth.TokenPair, error) {
+	// 已轮换的Token会被加入黑名单
+	rotated, err := uc.repo.IsTokenBlacklisted(ctx, claims.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	if rotated {
+		uc.handleRefreshTokenReuse(ctx, claims)
+		return nil, ErrRefreshTokenReused
+	}
+
+	// 检查会话是否存在
+	session, err := uc.sessionMgr.GetSessionByToken(ctx, refreshToken)
+	if err != nil {
+		return nil, sessionError(err)
+	}
 
-	// 生成新的Token对
-	newTokenPair, err := uc.jwtManager.GenerateTokenPair(claims.UserID, claims.Username)
+	// 在同一轮换族内生成新的Token对
+	newTokenPair, err := uc.jwtManager.GenerateTokenPairInFamily(claims.UserID, claims.Username, claims.FamilyID)
 	if err != nil {
 		return nil, err
 	}
 
-	// 将旧的Refresh Token加入黑名单
-	uc.repo.AddTokenToBlacklist(ctx, claims.TokenID, time.Unix(claims.ExpiresAt.Unix(), 0))
+	// 将旧的Refresh Token加入黑名单，写入失败会导致无法检测重放，不签发新Token
+	if err := uc.repo.AddTokenToBlacklist(ctx, claims.TokenID, time.Unix(claims.ExpiresAt.Unix(), 0)); err != nil {
+		return nil, err
+	}
 
 	// 更新会话
-	err = uc.repo.UpdateSession(ctx, session.UserID, newTokenPair.RefreshToken, 7*24*time.Hour)
+	err = uc.sessionMgr.UpdateSession(ctx, session.UserID, newTokenPair.RefreshToken, uc.clock.Until(newTokenPair.RefreshExpiry))
 	if err != nil {
 		uc.log.WithContext(ctx).Errorf("update session failed: %v", err)
 	}
@@ -121,6 +262,36 @@ func (uc *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*
 	return newTokenPair, nil
 }
 
+func refreshLockKey(familyID string) string {
+	return "auth:refresh:" + familyID
+}
+
+// handleRefreshTokenReuse 处理已轮换Token的重放。只有该族仍是当前会话时才撤销，
+// 用户重新登录后旧族的Token本就无效，不需要再次踢下线
+func (uc *AuthUsecase) handleRefreshTokenReuse(ctx context.Context, claims *auth.RefreshClaims) {
+	revoked := false
+	session, err := uc.sessionMgr.GetSession(ctx, claims.UserID)
+	if err == nil && session.FamilyID == claims.FamilyID {
+		if err := uc.RevokeAllUserTokens(ctx, claims.UserID); err != nil {
+			uc.log.WithContext(ctx).Errorf("revoke tokens after refresh token reuse failed: user=%d err=%v", claims.UserID, err)
+		} else {
+			revoked = true
+		}
+	}
+
+	uc.log.WithContext(ctx).Warnf("refresh token reuse detected: user=%d family=%s revoked=%t", claims.UserID, claims.FamilyID, revoked)
+
+	event := &domain.SecurityEvent{
+		Type:       domain.SecurityEventRefreshTokenReuse,
+		UserID:     claims.UserID,
+		Detail:     fmt.Sprintf("family=%s revoked=%t", claims.FamilyID, revoked),
+		OccurredAt: uc.clock.Now(),
+	}
+	if err := uc.notifier.NotifySecurityEvent(ctx, event); err != nil {
+		uc.log.WithContext(ctx).Warnf("notify security event failed: %v", err)
+	}
+}
+
 // Logout 登出
 func (uc *AuthUsecase) Logout(ctx context.Context, userID int64, accessToken, refreshToken string) error {
 	uc.log.WithContext(ctx).Infof("Logout user: %d", userID)
@@ -129,9 +300,10 @@ func (uc *AuthUsecase) Logout(ctx context.Context, userID int64, accessToken, re
 	if accessTokenID, err := uc.jwtManager.GetTokenID(accessToken); err == nil {
 		claims, _ := uc.jwtManager.VerifyToken(accessToken)
 		if claims != nil {
-			expiry := time.Until(time.Unix(claims.ExpiresAt.Unix(), 0))
-			uc.repo.AddTokenToBlacklist(ctx, accessTokenID, time.Now().Add(expiry))
+			uc.repo.AddTokenToBlacklist(ctx, accessTokenID, time.Unix(claims.ExpiresAt.Unix(), 0))
 		}
+		// 同时加入进程内黑名单，本实例后续请求立即拒绝该Token
+		uc.jwtManager.RevokeToken(accessToken)
 	}
 
 	// 撤销Refresh Token
@@ -140,7 +312,7 @@ func (uc *AuthUsecase) Logout(ctx context.Context, userID int64, accessToken, re
 	}
 
 	// 删除会话
-	return uc.repo.DeleteSession(ctx, userID)
+	return uc.sessionMgr.DeleteSession(ctx, userID)
 }
 
 // VerifyToken 验证Token
@@ -159,7 +331,7 @@ func (uc *AuthUsecase) RevokeAllUserTokens(ctx context.Context, userID int64) er
 	uc.log.WithContext(ctx).Infof("Revoke all tokens for user: %d", userID)
 
 	// 删除用户会话
-	return uc.repo.DeleteSession(ctx, userID)
+	return uc.sessionMgr.DeleteSession(ctx, userID)
 }
 
 // CheckTokenBlacklist 检查Token是否在黑名单
@@ -171,10 +343,10 @@ func (uc *AuthUsecase) CheckTokenBlacklist(ctx context.Context, tokenID string)
 func (uc *AuthUsecase) GetUserSession(ctx context.Context, userID int64) (*domain.UserSession, error) {
 	uc.log.WithContext(ctx).Infof("Getting user session for user: %d", userID)
 
-	session, err := uc.repo.GetSession(ctx, userID)
+	session, err := uc.sessionMgr.GetSession(ctx, userID)
 	if err != nil {
 		uc.log.WithContext(ctx).Errorf("Failed to get user session for user %d: %v", userID, err)
-		return nil, err
+		return nil, sessionError(err)
 	}
 
 	uc.log.WithContext(ctx).Infof("Successfully got user session for user: %d", userID)
@@ -183,21 +355,24 @@ func (uc *AuthUsecase) GetUserSession(ctx context.Context, userID int64) (*domai
 
 // ValidateSession 验证会话有效性
 func (uc *AuthUsecase) ValidateSession(ctx context.Context, userID int64, refreshToken string) (bool, error) {
-	session, err := uc.repo.GetSession(ctx, userID)
+	valid, err := uc.sessionMgr.ValidateSession(ctx, userID, refreshToken)
 	if err != nil {
-		return false, err
+		return false, sessionError(err)
 	}
 
-	if session.RefreshToken != refreshToken {
-		return false, nil
-	}
-
-	return !session.IsExpired(), nil
+	return valid, nil
 }
 
 // CleanupExpiredSessions 清理过期会话
 func (uc *AuthUsecase) CleanupExpiredSessions(ctx context.Context) error {
 	uc.log.WithContext(ctx).Info("Cleanup expired sessions")
-	// TODO: 这里可以实现定期清理逻辑
-	return nil
+	return uc.sessionMgr.CleanupExpiredSessions(ctx)
+}
+
+// sessionError 将会话管理器的错误转换为业务错误
+func sessionError(err error) error {
+	if err == auth.ErrSessionNotFound || err == auth.ErrSessionExpired {
+		return ErrSessionExpired
+	}
+	return err
 }
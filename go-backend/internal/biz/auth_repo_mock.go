@@ -4,11 +4,9 @@ package biz
 
 import (
 	context "context"
-	domain "go-backend/internal/domain"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
-
-	time "time"
 )
 
 // MockAuthRepo is an autogenerated mock type for the AuthRepo type
@@ -72,17 +70,17 @@ func (_c *MockAuthRepo_AddTokenToBlacklist_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// CreateSession provides a mock function with given fields: ctx, session
-func (_m *MockAuthRepo) CreateSession(ctx context.Context, session *domain.UserSession) error {
-	ret := _m.Called(ctx, session)
+// ClearLoginAttempts provides a mock function with given fields: ctx, username
+func (_m *MockAuthRepo) ClearLoginAttempts(ctx context.Context, username string) error {
+	ret := _m.Called(ctx, username)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSession")
+		panic("no return value specified for ClearLoginAttempts")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.UserSession) error); ok {
-		r0 = rf(ctx, session)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, username)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -90,105 +88,114 @@ func (_m *MockAuthRepo) CreateSession(ctx context.Context, session *domain.UserS
 	return r0
 }
 
-// MockAuthRepo_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
-type MockAuthRepo_CreateSession_Call struct {
+// MockAuthRepo_ClearLoginAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearLoginAttempts'
+type MockAuthRepo_ClearLoginAttempts_Call struct {
 	*mock.Call
 }
 
-// CreateSession is a helper method to define mock.On call
+// ClearLoginAttempts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - session *domain.UserSession
-func (_e *MockAuthRepo_Expecter) CreateSession(ctx interface{}, session interface{}) *MockAuthRepo_CreateSession_Call {
-	return &MockAuthRepo_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, session)}
+//   - username string
+func (_e *MockAuthRepo_Expecter) ClearLoginAttempts(ctx interface{}, username interface{}) *MockAuthRepo_ClearLoginAttempts_Call {
+	return &MockAuthRepo_ClearLoginAttempts_Call{Call: _e.mock.On("ClearLoginAttempts", ctx, username)}
 }
 
-func (_c *MockAuthRepo_CreateSession_Call) Run(run func(ctx context.Context, session *domain.UserSession)) *MockAuthRepo_CreateSession_Call {
+func (_c *MockAuthRepo_ClearLoginAttempts_Call) Run(run func(ctx context.Context, username string)) *MockAuthRepo_ClearLoginAttempts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.UserSession))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthRepo_CreateSession_Call) Return(_a0 error) *MockAuthRepo_CreateSession_Call {
+func (_c *MockAuthRepo_ClearLoginAttempts_Call) Return(_a0 error) *MockAuthRepo_ClearLoginAttempts_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockAuthRepo_CreateSession_Call) RunAndReturn(run func(context.Context, *domain.UserSession) error) *MockAuthRepo_CreateSession_Call {
+func (_c *MockAuthRepo_ClearLoginAttempts_Call) RunAndReturn(run func(context.Context, string) error) *MockAuthRepo_ClearLoginAttempts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSession provides a mock function with given fields: ctx, userID
-func (_m *MockAuthRepo) DeleteSession(ctx context.Context, userID int64) error {
-	ret := _m.Called(ctx, userID)
+// ConsumePasswordResetToken provides a mock function with given fields: ctx, username, token
+func (_m *MockAuthRepo) ConsumePasswordResetToken(ctx context.Context, username string, token string) (bool, error) {
+	ret := _m.Called(ctx, username, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSession")
+		panic("no return value specified for ConsumePasswordResetToken")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, userID)
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, username, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, username, token)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockAuthRepo_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
-type MockAuthRepo_DeleteSession_Call struct {
+// MockAuthRepo_ConsumePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumePasswordResetToken'
+type MockAuthRepo_ConsumePasswordResetToken_Call struct {
 	*mock.Call
 }
 
-// DeleteSession is a helper method to define mock.On call
+// ConsumePasswordResetToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-func (_e *MockAuthRepo_Expecter) DeleteSession(ctx interface{}, userID interface{}) *MockAuthRepo_DeleteSession_Call {
-	return &MockAuthRepo_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, userID)}
+//   - username string
+//   - token string
+func (_e *MockAuthRepo_Expecter) ConsumePasswordResetToken(ctx interface{}, username interface{}, token interface{}) *MockAuthRepo_ConsumePasswordResetToken_Call {
+	return &MockAuthRepo_ConsumePasswordResetToken_Call{Call: _e.mock.On("ConsumePasswordResetToken", ctx, username, token)}
 }
 
-func (_c *MockAuthRepo_DeleteSession_Call) Run(run func(ctx context.Context, userID int64)) *MockAuthRepo_DeleteSession_Call {
+func (_c *MockAuthRepo_ConsumePasswordResetToken_Call) Run(run func(ctx context.Context, username string, token string)) *MockAuthRepo_ConsumePasswordResetToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthRepo_DeleteSession_Call) Return(_a0 error) *MockAuthRepo_DeleteSession_Call {
-	_c.Call.Return(_a0)
+func (_c *MockAuthRepo_ConsumePasswordResetToken_Call) Return(_a0 bool, _a1 error) *MockAuthRepo_ConsumePasswordResetToken_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthRepo_DeleteSession_Call) RunAndReturn(run func(context.Context, int64) error) *MockAuthRepo_DeleteSession_Call {
+func (_c *MockAuthRepo_ConsumePasswordResetToken_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockAuthRepo_ConsumePasswordResetToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSession provides a mock function with given fields: ctx, userID
-func (_m *MockAuthRepo) GetSession(ctx context.Context, userID int64) (*domain.UserSession, error) {
-	ret := _m.Called(ctx, userID)
+// GetLoginAttempts provides a mock function with given fields: ctx, username
+func (_m *MockAuthRepo) GetLoginAttempts(ctx context.Context, username string) (int, error) {
+	ret := _m.Called(ctx, username)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSession")
+		panic("no return value specified for GetLoginAttempts")
 	}
 
-	var r0 *domain.UserSession
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.UserSession, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, username)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.UserSession); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, username)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserSession)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,58 +203,56 @@ func (_m *MockAuthRepo) GetSession(ctx context.Context, userID int64) (*domain.U
 	return r0, r1
 }
 
-// MockAuthRepo_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
-type MockAuthRepo_GetSession_Call struct {
+// MockAuthRepo_GetLoginAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginAttempts'
+type MockAuthRepo_GetLoginAttempts_Call struct {
 	*mock.Call
 }
 
-// GetSession is a helper method to define mock.On call
+// GetLoginAttempts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-func (_e *MockAuthRepo_Expecter) GetSession(ctx interface{}, userID interface{}) *MockAuthRepo_GetSession_Call {
-	return &MockAuthRepo_GetSession_Call{Call: _e.mock.On("GetSession", ctx, userID)}
+//   - username string
+func (_e *MockAuthRepo_Expecter) GetLoginAttempts(ctx interface{}, username interface{}) *MockAuthRepo_GetLoginAttempts_Call {
+	return &MockAuthRepo_GetLoginAttempts_Call{Call: _e.mock.On("GetLoginAttempts", ctx, username)}
 }
 
-func (_c *MockAuthRepo_GetSession_Call) Run(run func(ctx context.Context, userID int64)) *MockAuthRepo_GetSession_Call {
+func (_c *MockAuthRepo_GetLoginAttempts_Call) Run(run func(ctx context.Context, username string)) *MockAuthRepo_GetLoginAttempts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthRepo_GetSession_Call) Return(_a0 *domain.UserSession, _a1 error) *MockAuthRepo_GetSession_Call {
+func (_c *MockAuthRepo_GetLoginAttempts_Call) Return(_a0 int, _a1 error) *MockAuthRepo_GetLoginAttempts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthRepo_GetSession_Call) RunAndReturn(run func(context.Context, int64) (*domain.UserSession, error)) *MockAuthRepo_GetSession_Call {
+func (_c *MockAuthRepo_GetLoginAttempts_Call) RunAndReturn(run func(context.Context, string) (int, error)) *MockAuthRepo_GetLoginAttempts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSessionByToken provides a mock function with given fields: ctx, refreshToken
-func (_m *MockAuthRepo) GetSessionByToken(ctx context.Context, refreshToken string) (*domain.UserSession, error) {
-	ret := _m.Called(ctx, refreshToken)
+// IncrLoginAttempts provides a mock function with given fields: ctx, username, window
+func (_m *MockAuthRepo) IncrLoginAttempts(ctx context.Context, username string, window time.Duration) (int, error) {
+	ret := _m.Called(ctx, username, window)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSessionByToken")
+		panic("no return value specified for IncrLoginAttempts")
 	}
 
-	var r0 *domain.UserSession
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.UserSession, error)); ok {
-		return rf(ctx, refreshToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (int, error)); ok {
+		return rf(ctx, username, window)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.UserSession); ok {
-		r0 = rf(ctx, refreshToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) int); ok {
+		r0 = rf(ctx, username, window)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserSession)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, refreshToken)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, username, window)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -255,31 +260,32 @@ func (_m *MockAuthRepo) GetSessionByToken(ctx context.Context, refreshToken stri
 	return r0, r1
 }
 
-// MockAuthRepo_GetSessionByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionByToken'
-type MockAuthRepo_GetSessionByToken_Call struct {
+// MockAuthRepo_IncrLoginAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrLoginAttempts'
+type MockAuthRepo_IncrLoginAttempts_Call struct {
 	*mock.Call
 }
 
-// GetSessionByToken is a helper method to define mock.On call
+// IncrLoginAttempts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - refreshToken string
-func (_e *MockAuthRepo_Expecter) GetSessionByToken(ctx interface{}, refreshToken interface{}) *MockAuthRepo_GetSessionByToken_Call {
-	return &MockAuthRepo_GetSessionByToken_Call{Call: _e.mock.On("GetSessionByToken", ctx, refreshToken)}
+//   - username string
+//   - window time.Duration
+func (_e *MockAuthRepo_Expecter) IncrLoginAttempts(ctx interface{}, username interface{}, window interface{}) *MockAuthRepo_IncrLoginAttempts_Call {
+	return &MockAuthRepo_IncrLoginAttempts_Call{Call: _e.mock.On("IncrLoginAttempts", ctx, username, window)}
 }
 
-func (_c *MockAuthRepo_GetSessionByToken_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthRepo_GetSessionByToken_Call {
+func (_c *MockAuthRepo_IncrLoginAttempts_Call) Run(run func(ctx context.Context, username string, window time.Duration)) *MockAuthRepo_IncrLoginAttempts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
 	})
 	return _c
 }
 
-func (_c *MockAuthRepo_GetSessionByToken_Call) Return(_a0 *domain.UserSession, _a1 error) *MockAuthRepo_GetSessionByToken_Call {
+func (_c *MockAuthRepo_IncrLoginAttempts_Call) Return(_a0 int, _a1 error) *MockAuthRepo_IncrLoginAttempts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthRepo_GetSessionByToken_Call) RunAndReturn(run func(context.Context, string) (*domain.UserSession, error)) *MockAuthRepo_GetSessionByToken_Call {
+func (_c *MockAuthRepo_IncrLoginAttempts_Call) RunAndReturn(run func(context.Context, string, time.Duration) (int, error)) *MockAuthRepo_IncrLoginAttempts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -341,17 +347,17 @@ func (_c *MockAuthRepo_IsTokenBlacklisted_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// UpdateSession provides a mock function with given fields: ctx, userID, newRefreshToken, expiry
-func (_m *MockAuthRepo) UpdateSession(ctx context.Context, userID int64, newRefreshToken string, expiry time.Duration) error {
-	ret := _m.Called(ctx, userID, newRefreshToken, expiry)
+// SetPasswordResetToken provides a mock function with given fields: ctx, username, token
+func (_m *MockAuthRepo) SetPasswordResetToken(ctx context.Context, username string, token string) error {
+	ret := _m.Called(ctx, username, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateSession")
+		panic("no return value specified for SetPasswordResetToken")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Duration) error); ok {
-		r0 = rf(ctx, userID, newRefreshToken, expiry)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, username, token)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -359,33 +365,32 @@ func (_m *MockAuthRepo) UpdateSession(ctx context.Context, userID int64, newRefr
 	return r0
 }
 
-// MockAuthRepo_UpdateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSession'
-type MockAuthRepo_UpdateSession_Call struct {
+// MockAuthRepo_SetPasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPasswordResetToken'
+type MockAuthRepo_SetPasswordResetToken_Call struct {
 	*mock.Call
 }
 
-// UpdateSession is a helper method to define mock.On call
+// SetPasswordResetToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - newRefreshToken string
-//   - expiry time.Duration
-func (_e *MockAuthRepo_Expecter) UpdateSession(ctx interface{}, userID interface{}, newRefreshToken interface{}, expiry interface{}) *MockAuthRepo_UpdateSession_Call {
-	return &MockAuthRepo_UpdateSession_Call{Call: _e.mock.On("UpdateSession", ctx, userID, newRefreshToken, expiry)}
+//   - username string
+//   - token string
+func (_e *MockAuthRepo_Expecter) SetPasswordResetToken(ctx interface{}, username interface{}, token interface{}) *MockAuthRepo_SetPasswordResetToken_Call {
+	return &MockAuthRepo_SetPasswordResetToken_Call{Call: _e.mock.On("SetPasswordResetToken", ctx, username, token)}
 }
 
-func (_c *MockAuthRepo_UpdateSession_Call) Run(run func(ctx context.Context, userID int64, newRefreshToken string, expiry time.Duration)) *MockAuthRepo_UpdateSession_Call {
+func (_c *MockAuthRepo_SetPasswordResetToken_Call) Run(run func(ctx context.Context, username string, token string)) *MockAuthRepo_SetPasswordResetToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(time.Duration))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthRepo_UpdateSession_Call) Return(_a0 error) *MockAuthRepo_UpdateSession_Call {
+func (_c *MockAuthRepo_SetPasswordResetToken_Call) Return(_a0 error) *MockAuthRepo_SetPasswordResetToken_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockAuthRepo_UpdateSession_Call) RunAndReturn(run func(context.Context, int64, string, time.Duration) error) *MockAuthRepo_UpdateSession_Call {
+func (_c *MockAuthRepo_SetPasswordResetToken_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAuthRepo_SetPasswordResetToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
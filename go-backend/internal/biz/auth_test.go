@@ -5,14 +5,17 @@ import (
 	"testing"
 	"time"
 
+	"go-backend/internal/conf"
 	"go-backend/internal/domain"
 	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
 	"go-backend/testutils"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func setupAuthUsecase(t *testing.T) (*AuthUsecase, *MockAuthRepo, *MockUserRepo, *testutils.TestEnv, func()) {
@@ -25,7 +28,7 @@ func setupAuthUsecase(t *testing.T) (*AuthUsecase, *MockAuthRepo, *MockUserRepo,
 	sessionMgr := auth.NewMemorySessionManager()
 	logger := log.DefaultLogger
 
-	uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, logger)
+	uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, nil, nil, newTestLocker(), &conf.Business{}, clock.New(), logger)
 
 	return uc, authRepo, userRepo, env, cleanup
 }
@@ -50,10 +53,9 @@ func TestAuthUsecase_LoginWithToken(t *testing.T) {
 	}
 
 	t.Run("LoginWithToken_Success", func(t *testing.T) {
+		authRepo.EXPECT().GetLoginAttempts(ctx, "testuser1").Return(0, nil).Once()
 		userRepo.EXPECT().VerifyPassword(ctx, "testuser1", "password1").Return(user, nil)
 		userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(nil)
-		authRepo.EXPECT().DeleteSession(ctx, testUser.ID).Return(nil)
-		authRepo.EXPECT().CreateSession(ctx, mock.AnythingOfType("*domain.UserSession")).Return(nil)
 
 		tokenPair, returnedUser, err := uc.LoginWithToken(ctx, "testuser1", "password1")
 
@@ -63,9 +65,16 @@ func TestAuthUsecase_LoginWithToken(t *testing.T) {
 		assert.NotEmpty(t, tokenPair.RefreshToken)
 		assert.Equal(t, user.ID, returnedUser.ID)
 		assert.Equal(t, user.Username, returnedUser.Username)
+
+		// 会话由会话管理器创建
+		valid, err := uc.sessionMgr.ValidateSession(ctx, user.ID, tokenPair.RefreshToken)
+		require.NoError(t, err)
+		assert.True(t, valid)
 	})
 
 	t.Run("LoginWithToken_InvalidCredentials", func(t *testing.T) {
+		authRepo.EXPECT().GetLoginAttempts(ctx, "testuser1").Return(1, nil).Once()
+		authRepo.EXPECT().IncrLoginAttempts(ctx, "testuser1", defaultLoginLockDuration).Return(2, nil).Once()
 		userRepo.EXPECT().VerifyPassword(ctx, "testuser1", "wrongpassword").Return(nil, ErrPasswordError)
 
 		tokenPair, returnedUser, err := uc.LoginWithToken(ctx, "testuser1", "wrongpassword")
@@ -77,6 +86,7 @@ func TestAuthUsecase_LoginWithToken(t *testing.T) {
 	})
 
 	t.Run("LoginWithToken_UserNotFound", func(t *testing.T) {
+		authRepo.EXPECT().GetLoginAttempts(ctx, "nonexistent").Return(0, nil).Once()
 		userRepo.EXPECT().VerifyPassword(ctx, "nonexistent", "password").Return(nil, ErrUserNotFound)
 
 		tokenPair, returnedUser, err := uc.LoginWithToken(ctx, "nonexistent", "password")
@@ -88,6 +98,53 @@ func TestAuthUsecase_LoginWithToken(t *testing.T) {
 	})
 }
 
+func TestAuthUsecase_LoginLockout(t *testing.T) {
+	ctx := context.Background()
+	user := &User{ID: 1, Username: "testuser1"}
+
+	newUsecase := func(t *testing.T) (*AuthUsecase, *MockAuthRepo, *MockUserRepo) {
+		authRepo := NewMockAuthRepo(t)
+		userRepo := NewMockUserRepo(t)
+		businessConfig := &conf.Business{User: &conf.Business_User{
+			MaxLoginAttempts:  3,
+			LoginLockDuration: durationpb.New(time.Minute),
+		}}
+		uc := NewAuthUsecase(authRepo, userRepo, auth.NewJWTManager("test-secret", time.Hour),
+			auth.NewMemorySessionManager(), nil, nil, newTestLocker(), businessConfig, clock.New(), log.DefaultLogger)
+		return uc, authRepo, userRepo
+	}
+
+	t.Run("LockAfterMaxFailures", func(t *testing.T) {
+		uc, authRepo, userRepo := newUsecase(t)
+		authRepo.EXPECT().GetLoginAttempts(ctx, "testuser1").Return(2, nil).Once()
+		authRepo.EXPECT().IncrLoginAttempts(ctx, "testuser1", time.Minute).Return(3, nil).Once()
+		userRepo.EXPECT().VerifyPassword(ctx, "testuser1", "wrong").Return(nil, ErrPasswordError).Once()
+
+		_, _, err := uc.LoginWithToken(ctx, "testuser1", "wrong")
+		assert.Equal(t, ErrPasswordError, err)
+
+		// 锁定期间即使密码正确也拒绝
+		authRepo.EXPECT().GetLoginAttempts(ctx, "testuser1").Return(3, nil).Once()
+
+		tokenPair, returnedUser, err := uc.LoginWithToken(ctx, "testuser1", "password1")
+		assert.Equal(t, ErrAccountLocked, err)
+		assert.Nil(t, tokenPair)
+		assert.Nil(t, returnedUser)
+	})
+
+	t.Run("ResetOnSuccess", func(t *testing.T) {
+		uc, authRepo, userRepo := newUsecase(t)
+		authRepo.EXPECT().GetLoginAttempts(ctx, "testuser1").Return(2, nil).Once()
+		authRepo.EXPECT().ClearLoginAttempts(ctx, "testuser1").Return(nil).Once()
+		userRepo.EXPECT().VerifyPassword(ctx, "testuser1", "password1").Return(user, nil).Once()
+		userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(nil).Once()
+
+		tokenPair, _, err := uc.LoginWithToken(ctx, "testuser1", "password1")
+		require.NoError(t, err)
+		assert.NotNil(t, tokenPair)
+	})
+}
+
 func TestAuthUsecase_RefreshToken(t *testing.T) {
 	uc, authRepo, _, env, cleanup := setupAuthUsecase(t)
 	defer cleanup()
@@ -105,16 +162,11 @@ func TestAuthUsecase_RefreshToken(t *testing.T) {
 		tokenPair, err := jwtManager.GenerateTokenPair(testUser.ID, testUser.Username)
 		require.NoError(t, err)
 
-		// Mock session存在
-		session := &domain.UserSession{
-			UserID:       testUser.ID,
-			RefreshToken: tokenPair.RefreshToken,
-			ExpiresAt:    tokenPair.RefreshExpiry,
-		}
+		_, err = uc.sessionMgr.CreateSession(ctx, testUser.ID, tokenPair.RefreshToken, tokenPair.FamilyID, time.Until(tokenPair.RefreshExpiry))
+		require.NoError(t, err)
 
-		authRepo.EXPECT().GetSessionByToken(ctx, tokenPair.RefreshToken).Return(session, nil)
+		authRepo.EXPECT().IsTokenBlacklisted(ctx, mock.AnythingOfType("string")).Return(false, nil).Once()
 		authRepo.EXPECT().AddTokenToBlacklist(ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
-		authRepo.EXPECT().UpdateSession(ctx, testUser.ID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Duration")).Return(nil)
 
 		newTokenPair, err := uc.RefreshToken(ctx, tokenPair.RefreshToken)
 
@@ -124,6 +176,14 @@ func TestAuthUsecase_RefreshToken(t *testing.T) {
 		assert.NotEmpty(t, newTokenPair.RefreshToken)
 		assert.NotEqual(t, tokenPair.AccessToken, newTokenPair.AccessToken)
 		assert.NotEqual(t, tokenPair.RefreshToken, newTokenPair.RefreshToken)
+
+		// 会话已轮换到新的Refresh Token
+		session, err := uc.sessionMgr.GetSession(ctx, testUser.ID)
+		require.NoError(t, err)
+		assert.Equal(t, newTokenPair.RefreshToken, session.RefreshToken)
+		// 轮换后仍属于同一族
+		assert.Equal(t, tokenPair.FamilyID, newTokenPair.FamilyID)
+		assert.Equal(t, tokenPair.FamilyID, session.FamilyID)
 	})
 
 	t.Run("RefreshToken_InvalidToken", func(t *testing.T) {
@@ -140,7 +200,8 @@ func TestAuthUsecase_RefreshToken(t *testing.T) {
 		tokenPair, err := jwtManager.GenerateTokenPair(testUser.ID, testUser.Username)
 		require.NoError(t, err)
 
-		authRepo.EXPECT().GetSessionByToken(ctx, tokenPair.RefreshToken).Return(nil, ErrSessionExpired)
+		require.NoError(t, uc.sessionMgr.DeleteSession(ctx, testUser.ID))
+		authRepo.EXPECT().IsTokenBlacklisted(ctx, mock.AnythingOfType("string")).Return(false, nil).Once()
 
 		newTokenPair, err := uc.RefreshToken(ctx, tokenPair.RefreshToken)
 
@@ -150,6 +211,76 @@ func TestAuthUsecase_RefreshToken(t *testing.T) {
 	})
 }
 
+func TestAuthUsecase_RefreshTokenReuse(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*AuthUsecase, *MockAuthRepo, *MockSecurityEventNotifier, *auth.TokenPair) {
+		authRepo := NewMockAuthRepo(t)
+		notifier := NewMockSecurityEventNotifier(t)
+		jwtManager := auth.NewJWTManager("test-secret", time.Hour)
+		uc := NewAuthUsecase(authRepo, NewMockUserRepo(t), jwtManager, auth.NewMemorySessionManager(), notifier, nil, newTestLocker(), &conf.Business{}, clock.New(), log.DefaultLogger)
+
+		tokenPair, err := jwtManager.GenerateTokenPair(1, "alice")
+		require.NoError(t, err)
+		_, err = uc.sessionMgr.CreateSession(ctx, 1, tokenPair.RefreshToken, tokenPair.FamilyID, time.Hour)
+		require.NoError(t, err)
+
+		return uc, authRepo, notifier, tokenPair
+	}
+
+	t.Run("RevokeFamily", func(t *testing.T) {
+		uc, authRepo, notifier, tokenPair := setup(t)
+
+		// 第一次刷新正常轮换，旧Token进入黑名单
+		var rotatedID string
+		authRepo.EXPECT().IsTokenBlacklisted(ctx, mock.AnythingOfType("string")).
+			RunAndReturn(func(_ context.Context, tokenID string) (bool, error) {
+				return tokenID == rotatedID, nil
+			})
+		authRepo.EXPECT().AddTokenToBlacklist(ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+			RunAndReturn(func(_ context.Context, tokenID string, _ time.Time) error {
+				rotatedID = tokenID
+				return nil
+			}).Once()
+
+		rotated, err := uc.RefreshToken(ctx, tokenPair.RefreshToken)
+		require.NoError(t, err)
+
+		// 旧Token被重放，撤销整个族并发出安全事件
+		notifier.EXPECT().NotifySecurityEvent(ctx, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+			return event.Type == domain.SecurityEventRefreshTokenReuse && event.UserID == 1
+		})).Return(nil).Once()
+
+		_, err = uc.RefreshToken(ctx, tokenPair.RefreshToken)
+		assert.Equal(t, ErrRefreshTokenReused, err)
+
+		_, err = uc.sessionMgr.GetSession(ctx, 1)
+		assert.Equal(t, auth.ErrSessionNotFound, err)
+
+		// 轮换出的新Token也随族一起失效
+		_, err = uc.RefreshToken(ctx, rotated.RefreshToken)
+		assert.Equal(t, ErrSessionExpired, err)
+	})
+
+	t.Run("StaleFamily", func(t *testing.T) {
+		uc, authRepo, notifier, tokenPair := setup(t)
+
+		// 用户重新登录后旧族的Token被重放，不影响新会话
+		_, err := uc.sessionMgr.CreateSession(ctx, 1, "new-refresh-token", "new-family", time.Hour)
+		require.NoError(t, err)
+
+		authRepo.EXPECT().IsTokenBlacklisted(ctx, mock.AnythingOfType("string")).Return(true, nil)
+		notifier.EXPECT().NotifySecurityEvent(ctx, mock.Anything).Return(nil).Once()
+
+		_, err = uc.RefreshToken(ctx, tokenPair.RefreshToken)
+		assert.Equal(t, ErrRefreshTokenReused, err)
+
+		session, err := uc.sessionMgr.GetSession(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "new-family", session.FamilyID)
+	})
+}
+
 func TestAuthUsecase_Logout(t *testing.T) {
 	uc, authRepo, _, env, cleanup := setupAuthUsecase(t)
 	defer cleanup()
@@ -170,7 +301,6 @@ func TestAuthUsecase_Logout(t *testing.T) {
 		require.NoError(t, err)
 
 		authRepo.EXPECT().AddTokenToBlacklist(ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
-		authRepo.EXPECT().DeleteSession(ctx, testUser.ID).Return(nil)
 
 		err = uc.Logout(ctx, testUser.ID, accessToken, tokenPair.RefreshToken)
 
@@ -183,7 +313,6 @@ func TestAuthUsecase_Logout(t *testing.T) {
 		require.NoError(t, err)
 
 		authRepo.EXPECT().AddTokenToBlacklist(ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
-		authRepo.EXPECT().DeleteSession(ctx, testUser.ID).Return(nil)
 
 		err = uc.Logout(ctx, testUser.ID, accessToken, "")
 
@@ -252,7 +381,7 @@ func TestAuthUsecase_RevokeToken(t *testing.T) {
 }
 
 func TestAuthUsecase_GetUserSession(t *testing.T) {
-	uc, authRepo, _, env, cleanup := setupAuthUsecase(t)
+	uc, _, _, env, cleanup := setupAuthUsecase(t)
 	defer cleanup()
 
 	ctx := context.Background()
@@ -263,13 +392,8 @@ func TestAuthUsecase_GetUserSession(t *testing.T) {
 	testUser := users[0]
 
 	t.Run("GetUserSession_Success", func(t *testing.T) {
-		expectedSession := &domain.UserSession{
-			UserID:       testUser.ID,
-			RefreshToken: "test-refresh-token",
-			ExpiresAt:    time.Now().Add(time.Hour),
-		}
-
-		authRepo.EXPECT().GetSession(ctx, testUser.ID).Return(expectedSession, nil).Once()
+		expectedSession, err := uc.sessionMgr.CreateSession(ctx, testUser.ID, "test-refresh-token", "family", time.Hour)
+		require.NoError(t, err)
 
 		session, err := uc.GetUserSession(ctx, testUser.ID)
 
@@ -279,8 +403,7 @@ func TestAuthUsecase_GetUserSession(t *testing.T) {
 	})
 
 	t.Run("GetUserSession_NotFound", func(t *testing.T) {
-		// 清理之前的 mock 期望或使用新的 mock 实例
-		authRepo.EXPECT().GetSession(ctx, testUser.ID).Return(nil, ErrSessionExpired).Once()
+		require.NoError(t, uc.sessionMgr.DeleteSession(ctx, testUser.ID))
 
 		session, err := uc.GetUserSession(ctx, testUser.ID)
 
@@ -308,16 +431,11 @@ func TestAuthUsecase_ValidateSession(t *testing.T) {
 		userRepo := NewMockUserRepo(t)
 		jwtManager := auth.NewJWTManager("test-secret", time.Hour)
 		sessionMgr := auth.NewMemorySessionManager()
-		uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, log.DefaultLogger)
+		uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, nil, nil, newTestLocker(), &conf.Business{}, clock.New(), log.DefaultLogger)
 
 		refreshToken := "valid-refresh-token"
-		session := &domain.UserSession{
-			UserID:       testUser.ID,
-			RefreshToken: refreshToken,
-			ExpiresAt:    time.Now().Add(time.Hour),
-		}
-
-		authRepo.EXPECT().GetSession(ctx, testUser.ID).Return(session, nil)
+		_, err := sessionMgr.CreateSession(ctx, testUser.ID, refreshToken, "family", time.Hour)
+		require.NoError(t, err)
 
 		isValid, err := uc.ValidateSession(ctx, testUser.ID, refreshToken)
 
@@ -331,17 +449,12 @@ func TestAuthUsecase_ValidateSession(t *testing.T) {
 		userRepo := NewMockUserRepo(t)
 		jwtManager := auth.NewJWTManager("test-secret", time.Hour)
 		sessionMgr := auth.NewMemorySessionManager()
-		uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, log.DefaultLogger)
+		uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, nil, nil, newTestLocker(), &conf.Business{}, clock.New(), log.DefaultLogger)
 
 		refreshToken := "valid-refresh-token"
 		wrongToken := "wrong-refresh-token"
-		session := &domain.UserSession{
-			UserID:       testUser.ID,
-			RefreshToken: refreshToken,
-			ExpiresAt:    time.Now().Add(time.Hour),
-		}
-
-		authRepo.EXPECT().GetSession(ctx, testUser.ID).Return(session, nil)
+		_, err := sessionMgr.CreateSession(ctx, testUser.ID, refreshToken, "family", time.Hour)
+		require.NoError(t, err)
 
 		isValid, err := uc.ValidateSession(ctx, testUser.ID, wrongToken)
 
@@ -355,9 +468,7 @@ func TestAuthUsecase_ValidateSession(t *testing.T) {
 		userRepo := NewMockUserRepo(t)
 		jwtManager := auth.NewJWTManager("test-secret", time.Hour)
 		sessionMgr := auth.NewMemorySessionManager()
-		uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, log.DefaultLogger)
-
-		authRepo.EXPECT().GetSession(ctx, testUser.ID).Return(nil, ErrSessionExpired)
+		uc := NewAuthUsecase(authRepo, userRepo, jwtManager, sessionMgr, nil, nil, newTestLocker(), &conf.Business{}, clock.New(), log.DefaultLogger)
 
 		isValid, err := uc.ValidateSession(ctx, testUser.ID, "any-token")
 
@@ -397,7 +508,7 @@ func TestAuthUsecase_CheckTokenBlacklist(t *testing.T) {
 }
 
 func TestAuthUsecase_RevokeAllUserTokens(t *testing.T) {
-	uc, authRepo, _, env, cleanup := setupAuthUsecase(t)
+	uc, _, _, env, cleanup := setupAuthUsecase(t)
 	defer cleanup()
 
 	ctx := context.Background()
@@ -408,10 +519,14 @@ func TestAuthUsecase_RevokeAllUserTokens(t *testing.T) {
 	testUser := users[0]
 
 	t.Run("RevokeAllUserTokens_Success", func(t *testing.T) {
-		authRepo.EXPECT().DeleteSession(ctx, testUser.ID).Return(nil)
+		_, err := uc.sessionMgr.CreateSession(ctx, testUser.ID, "refresh-token", "family", time.Hour)
+		require.NoError(t, err)
 
-		err := uc.RevokeAllUserTokens(ctx, testUser.ID)
+		err = uc.RevokeAllUserTokens(ctx, testUser.ID)
 
 		assert.NoError(t, err)
+
+		_, err = uc.sessionMgr.GetSession(ctx, testUser.ID)
+		assert.Equal(t, auth.ErrSessionNotFound, err)
 	})
 }
@@ -1,6 +1,10 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"go-backend/pkg/auth"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(
@@ -8,5 +12,62 @@ var ProviderSet = wire.NewSet(
 	NewRelationUsecase,
 	NewAuthUsecase,
 	NewPermissionUsecase,
+	NewOwnershipResolvers,
 	NewVideoUseCase,
+	NewMessageUsecase,
+	NewFavoriteUsecase,
+	NewCommentUsecase,
+	NewMutedKeywordUsecase,
+	NewModerationUsecase,
+	NewRetentionUsecase,
+	NewRBACSyncUsecase,
+	NewRegistrationUsecase,
+	NewPermissionAuditUsecase,
+	NewPasswordResetUsecase,
+	NewEmailUsecase,
+	NewProcessingUsecase,
+	NewShareUsecase,
+	NewReferralUsecase,
+	NewRBACAdminUsecase,
+	NewCalendarUsecase,
+	NewProfileImageUsecase,
+	NewProfileUsecase,
+	NewCountsUsecase,
+	NewWatchHistoryUsecase,
+	NewPlayCountUsecase,
+	NewUserStatsUsecase,
+	NewTrendingUsecase,
+	NewContentModerationUsecase,
+	NewUploadScanUsecase,
+	NewSigningKeyUsecase,
+	NewOutboxRelayUsecase,
+	NewIdempotencyUsecase,
+	NewAccountDeletionUsecase,
+	NewAccountPurgeUsecase,
+	NewDataExportUsecase,
+	NewStorageDeletionUsecase,
+	NewOrphanCleanupUsecase,
+	NewVideoEditUsecase,
+	NewPlaylistUsecase,
+	NewShareLinkUsecase,
+	NewPresenceUsecase,
+	NewDeadLetterUsecase,
+	NewOpsUsecase,
+	NewTakedownUsecase,
+	NewUserBanUsecase,
+	NewCategoryUsecase,
+	NewQuotaUsecase,
+	NewCounterReconcileUsecase,
+	NewCaptionUsecase,
+	NewIntegrityUsecase,
+	NewPromotionUsecase,
+	NewDegradationUsecase,
+	NewVideoStatsFlushUsecase,
+	NewLoginAnomalyUsecase,
+	NewOAuthUsecase,
+	NewCodeLoginUsecase,
+	wire.Bind(new(auth.DenialRecorder), new(*PermissionAuditUsecase)),
+	wire.Bind(new(LoginGuard), new(*LoginAnomalyUsecase)),
+	wire.Bind(new(LoginIssuer), new(*AuthUsecase)),
+	wire.Bind(new(DefaultRoleInitializer), new(*PermissionUsecase)),
 )
@@ -0,0 +1,346 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrDraftNotFound       = errors.NotFound(v1.ErrorCode_DRAFT_NOT_EXIST.String(), "draft not found")
+	ErrInvalidDraftTitle   = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "draft title must be 1-100 characters")
+	ErrDraftNoteTooLong    = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "draft note must be at most 500 characters")
+	ErrDraftScheduleInPast = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "scheduled time must be in the future")
+	ErrInvalidRemindBefore = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "reminder must be 0-7 days before the scheduled time")
+	ErrTooManyDrafts       = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "too many drafts")
+)
+
+const (
+	maxDraftTitleLength = 100
+	maxDraftNoteLength  = 500
+	maxRemindBefore     = 7 * 24 * time.Hour
+
+	defaultMaxDraftsPerUser  = 200
+	defaultScheduleMinGap    = 2 * time.Hour
+	defaultReminderInterval  = time.Minute
+	defaultReminderBatchSize = 100
+	defaultCalendarRange     = 30 * 24 * time.Hour
+	maxCalendarRange         = 366 * 24 * time.Hour
+	reminderNotifyTimeout    = 5 * time.Second
+	reminderOverdueTolerance = 24 * time.Hour
+)
+
+// ContentDraft 创作者的内容草稿，设置了计划发布时间的草稿出现在日历中
+type ContentDraft struct {
+	ID          int64
+	UserID      int64
+	Title       string
+	Note        string
+	ScheduledAt *time.Time // 计划发布时间，为空表示未排期
+	RemindAt    *time.Time // 提醒时间，为空表示不提醒
+	RemindedAt  *time.Time // 已发送提醒的时间
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RemindBefore 提醒时间距计划发布时间的提前量，不提醒时为0
+func (d *ContentDraft) RemindBefore() time.Duration {
+	if d.ScheduledAt == nil || d.RemindAt == nil {
+		return 0
+	}
+	return d.ScheduledAt.Sub(*d.RemindAt)
+}
+
+// DraftInput 创建或修改草稿的参数
+type DraftInput struct {
+	Title        string
+	Note         string
+	ScheduledAt  *time.Time
+	RemindBefore time.Duration // 0表示不提醒
+}
+
+// ContentDraftRepo is a ContentDraft repo.
+type ContentDraftRepo interface {
+	CreateDraft(context.Context, *ContentDraft) error
+	// UpdateDraft 更新草稿内容和排期，排期变化时由调用方清空RemindedAt
+	UpdateDraft(context.Context, *ContentDraft) error
+	// GetDraft 草稿不存在或不属于该用户时返回ErrDraftNotFound
+	GetDraft(ctx context.Context, userID, draftID int64) (*ContentDraft, error)
+	DeleteDraft(ctx context.Context, userID, draftID int64) error
+	CountDrafts(ctx context.Context, userID int64) (int64, error)
+	// ListScheduledDrafts 按计划发布时间升序列出[start, end)内的草稿
+	ListScheduledDrafts(ctx context.Context, userID int64, start, end time.Time) ([]*ContentDraft, error)
+	// ListUnscheduledDrafts 按更新时间倒序列出未排期的草稿
+	ListUnscheduledDrafts(ctx context.Context, userID int64, limit int) ([]*ContentDraft, error)
+	// ListDueReminders 列出提醒时间不晚于now且尚未提醒的草稿
+	ListDueReminders(ctx context.Context, now time.Time, limit int) ([]*ContentDraft, error)
+	// ClaimReminder 将提醒标记为已发送，已被其他实例标记时返回false
+	ClaimReminder(ctx context.Context, draftID int64, remindAt, now time.Time) (bool, error)
+	// ReleaseReminder 发送失败时撤销标记，以便下次重试
+	ReleaseReminder(ctx context.Context, draftID int64, remindAt time.Time) error
+}
+
+// DraftReminderNotifier 发送草稿提醒
+type DraftReminderNotifier interface {
+	NotifyDraftReminder(ctx context.Context, draft *ContentDraft) error
+}
+
+// CalendarUsecase 创作者内容日历：管理排期草稿、按提醒时间发送提醒，
+// 并在两条草稿的计划发布时间过近时给出冲突提示。冲突只提示，不阻止排期
+type CalendarUsecase struct {
+	repo     ContentDraftRepo
+	notifier DraftReminderNotifier
+
+	maxDrafts        int64
+	minGap           time.Duration
+	reminderInterval time.Duration
+	batchSize        int
+	clock            clock.Clock
+
+	log *log.Helper
+}
+
+// NewCalendarUsecase new a Calendar usecase.
+func NewCalendarUsecase(repo ContentDraftRepo, notifier DraftReminderNotifier, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *CalendarUsecase {
+	uc := &CalendarUsecase{
+		repo:             repo,
+		notifier:         notifier,
+		maxDrafts:        defaultMaxDraftsPerUser,
+		minGap:           defaultScheduleMinGap,
+		reminderInterval: defaultReminderInterval,
+		batchSize:        defaultReminderBatchSize,
+		clock:            clk,
+		log:              log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetCalendar(); cfg != nil {
+		if cfg.MaxDrafts > 0 {
+			uc.maxDrafts = int64(cfg.MaxDrafts)
+		}
+		if cfg.MinGap != nil {
+			uc.minGap = cfg.MinGap.AsDuration()
+		}
+		if cfg.ReminderInterval != nil {
+			uc.reminderInterval = cfg.ReminderInterval.AsDuration()
+		}
+		if cfg.ReminderBatchSize > 0 {
+			uc.batchSize = int(cfg.ReminderBatchSize)
+		}
+	}
+
+	return uc
+}
+
+// ReminderInterval 提醒扫描间隔
+func (uc *CalendarUsecase) ReminderInterval() time.Duration {
+	return uc.reminderInterval
+}
+
+// CreateDraft 创建草稿，返回与其计划发布时间过近的其他草稿
+func (uc *CalendarUsecase) CreateDraft(ctx context.Context, userID int64, input *DraftInput) (*ContentDraft, []*ContentDraft, error) {
+	now := uc.clock.Now()
+	if err := validateDraftInput(input, now); err != nil {
+		return nil, nil, err
+	}
+
+	count, err := uc.repo.CountDrafts(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if count >= uc.maxDrafts {
+		return nil, nil, ErrTooManyDrafts
+	}
+
+	draft := &ContentDraft{UserID: userID}
+	applyDraftInput(draft, input)
+	if err := uc.repo.CreateDraft(ctx, draft); err != nil {
+		return nil, nil, err
+	}
+
+	conflicts, err := uc.findConflicts(ctx, draft)
+	if err != nil {
+		return nil, nil, err
+	}
+	return draft, conflicts, nil
+}
+
+// UpdateDraft 修改草稿。排期或提醒提前量变化后重新计算提醒时间，已发送的提醒会再次发送
+func (uc *CalendarUsecase) UpdateDraft(ctx context.Context, userID, draftID int64, input *DraftInput) (*ContentDraft, []*ContentDraft, error) {
+	draft, err := uc.repo.GetDraft(ctx, userID, draftID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 排期不变时允许保存已经过去的计划时间，便于修改过期草稿的标题和备注
+	now := uc.clock.Now()
+	if input.ScheduledAt != nil && draft.ScheduledAt != nil && input.ScheduledAt.Equal(*draft.ScheduledAt) {
+		now = time.Time{}
+	}
+	if err := validateDraftInput(input, now); err != nil {
+		return nil, nil, err
+	}
+
+	oldRemindAt := draft.RemindAt
+	applyDraftInput(draft, input)
+	if !sameTime(oldRemindAt, draft.RemindAt) {
+		draft.RemindedAt = nil
+	}
+
+	if err := uc.repo.UpdateDraft(ctx, draft); err != nil {
+		return nil, nil, err
+	}
+
+	conflicts, err := uc.findConflicts(ctx, draft)
+	if err != nil {
+		return nil, nil, err
+	}
+	return draft, conflicts, nil
+}
+
+// DeleteDraft 删除草稿
+func (uc *CalendarUsecase) DeleteDraft(ctx context.Context, userID, draftID int64) error {
+	return uc.repo.DeleteDraft(ctx, userID, draftID)
+}
+
+// ListCalendar 列出时间范围内已排期的草稿，默认从当前时间起30天。
+// includeUnscheduled为true时同时返回未排期的草稿
+func (uc *CalendarUsecase) ListCalendar(ctx context.Context, userID int64, start, end time.Time, includeUnscheduled bool) ([]*ContentDraft, []*ContentDraft, error) {
+	if start.IsZero() {
+		start = uc.clock.Now()
+	}
+	if end.IsZero() || !end.After(start) {
+		end = start.Add(defaultCalendarRange)
+	}
+	if end.Sub(start) > maxCalendarRange {
+		end = start.Add(maxCalendarRange)
+	}
+
+	scheduled, err := uc.repo.ListScheduledDrafts(ctx, userID, start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var unscheduled []*ContentDraft
+	if includeUnscheduled {
+		unscheduled, err = uc.repo.ListUnscheduledDrafts(ctx, userID, int(uc.maxDrafts))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return scheduled, unscheduled, nil
+}
+
+// SendReminders 发送到期的草稿提醒，供调度器调用。先标记再发送，多实例下同一提醒只发送一次；
+// 发送失败时撤销标记等待下次重试，超过计划发布时间太久的提醒直接跳过
+func (uc *CalendarUsecase) SendReminders(ctx context.Context) error {
+	now := uc.clock.Now()
+	drafts, err := uc.repo.ListDueReminders(ctx, now, uc.batchSize)
+	if err != nil {
+		return err
+	}
+
+	sent := 0
+	for _, draft := range drafts {
+		claimed, err := uc.repo.ClaimReminder(ctx, draft.ID, *draft.RemindAt, now)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("claim draft reminder failed: draft=%d err=%v", draft.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if draft.ScheduledAt != nil && now.Sub(*draft.ScheduledAt) > reminderOverdueTolerance {
+			uc.log.WithContext(ctx).Infof("skip overdue draft reminder: draft=%d scheduled_at=%s", draft.ID, draft.ScheduledAt)
+			continue
+		}
+
+		notifyCtx, cancel := context.WithTimeout(ctx, reminderNotifyTimeout)
+		err = uc.notifier.NotifyDraftReminder(notifyCtx, draft)
+		cancel()
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("send draft reminder failed: draft=%d user=%d err=%v", draft.ID, draft.UserID, err)
+			if err := uc.repo.ReleaseReminder(ctx, draft.ID, *draft.RemindAt); err != nil {
+				uc.log.WithContext(ctx).Warnf("release draft reminder failed: draft=%d err=%v", draft.ID, err)
+			}
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		uc.log.WithContext(ctx).Infof("sent %d draft reminders", sent)
+	}
+	return nil
+}
+
+// findConflicts 查找与草稿计划发布时间相距不足最小间隔的其他草稿
+func (uc *CalendarUsecase) findConflicts(ctx context.Context, draft *ContentDraft) ([]*ContentDraft, error) {
+	if draft.ScheduledAt == nil || uc.minGap <= 0 {
+		return nil, nil
+	}
+
+	// 区间右端开放，加1纳秒使恰好相距minGap的草稿不算冲突
+	start := draft.ScheduledAt.Add(-uc.minGap).Add(time.Nanosecond)
+	end := draft.ScheduledAt.Add(uc.minGap)
+	nearby, err := uc.repo.ListScheduledDrafts(ctx, draft.UserID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []*ContentDraft
+	for _, other := range nearby {
+		if other.ID != draft.ID {
+			conflicts = append(conflicts, other)
+		}
+	}
+	return conflicts, nil
+}
+
+// validateDraftInput 校验草稿参数，now为零值时不检查计划时间是否已过
+func validateDraftInput(input *DraftInput, now time.Time) error {
+	input.Title = strings.TrimSpace(input.Title)
+	input.Note = strings.TrimSpace(input.Note)
+
+	if input.Title == "" || utf8.RuneCountInString(input.Title) > maxDraftTitleLength {
+		return ErrInvalidDraftTitle
+	}
+	if utf8.RuneCountInString(input.Note) > maxDraftNoteLength {
+		return ErrDraftNoteTooLong
+	}
+	if input.RemindBefore < 0 || input.RemindBefore > maxRemindBefore {
+		return ErrInvalidRemindBefore
+	}
+	if input.ScheduledAt != nil && !now.IsZero() && !input.ScheduledAt.After(now) {
+		return ErrDraftScheduleInPast
+	}
+	return nil
+}
+
+// applyDraftInput 写入草稿参数并计算提醒时间，未排期的草稿不提醒
+func applyDraftInput(draft *ContentDraft, input *DraftInput) {
+	draft.Title = input.Title
+	draft.Note = input.Note
+	draft.ScheduledAt = input.ScheduledAt
+	draft.RemindAt = nil
+	if input.ScheduledAt != nil && input.RemindBefore > 0 {
+		remindAt := input.ScheduledAt.Add(-input.RemindBefore)
+		draft.RemindAt = &remindAt
+	}
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newCalendarTestUsecase(t *testing.T) (*CalendarUsecase, *MockContentDraftRepo, *MockDraftReminderNotifier) {
+	repo := NewMockContentDraftRepo(t)
+	notifier := NewMockDraftReminderNotifier(t)
+	config := &conf.Business{Calendar: &conf.Business_Calendar{MaxDrafts: 2, MinGap: durationpb.New(time.Hour)}}
+	return NewCalendarUsecase(repo, notifier, config, clock.New(), log.DefaultLogger), repo, notifier
+}
+
+func TestCalendarUsecase_CreateDraft(t *testing.T) {
+	ctx := context.Background()
+	scheduledAt := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+
+	t.Run("ReminderAndConflicts", func(t *testing.T) {
+		uc, repo, _ := newCalendarTestUsecase(t)
+		repo.EXPECT().CountDrafts(ctx, int64(1)).Return(1, nil)
+		repo.EXPECT().CreateDraft(ctx, mock.MatchedBy(func(d *ContentDraft) bool {
+			return d.Title == "vlog" && d.RemindAt != nil && d.RemindAt.Equal(scheduledAt.Add(-30*time.Minute))
+		})).RunAndReturn(func(_ context.Context, d *ContentDraft) error {
+			d.ID = 10
+			return nil
+		})
+		repo.EXPECT().ListScheduledDrafts(ctx, int64(1), mock.Anything, scheduledAt.Add(time.Hour)).
+			Return([]*ContentDraft{{ID: 10}, {ID: 7, Title: "other"}}, nil)
+
+		draft, conflicts, err := uc.CreateDraft(ctx, 1, &DraftInput{Title: " vlog ", ScheduledAt: &scheduledAt, RemindBefore: 30 * time.Minute})
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Minute, draft.RemindBefore())
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, int64(7), conflicts[0].ID)
+	})
+
+	t.Run("Unscheduled", func(t *testing.T) {
+		uc, repo, _ := newCalendarTestUsecase(t)
+		repo.EXPECT().CountDrafts(ctx, int64(1)).Return(0, nil)
+		repo.EXPECT().CreateDraft(ctx, mock.MatchedBy(func(d *ContentDraft) bool {
+			return d.ScheduledAt == nil && d.RemindAt == nil
+		})).Return(nil)
+
+		_, conflicts, err := uc.CreateDraft(ctx, 1, &DraftInput{Title: "idea", RemindBefore: time.Hour})
+		require.NoError(t, err)
+		assert.Empty(t, conflicts)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		uc, _, _ := newCalendarTestUsecase(t)
+		past := time.Now().Add(-time.Minute)
+
+		_, _, err := uc.CreateDraft(ctx, 1, &DraftInput{Title: "  "})
+		assert.ErrorIs(t, err, ErrInvalidDraftTitle)
+		_, _, err = uc.CreateDraft(ctx, 1, &DraftInput{Title: "late", ScheduledAt: &past})
+		assert.ErrorIs(t, err, ErrDraftScheduleInPast)
+		_, _, err = uc.CreateDraft(ctx, 1, &DraftInput{Title: "early", ScheduledAt: &scheduledAt, RemindBefore: 8 * 24 * time.Hour})
+		assert.ErrorIs(t, err, ErrInvalidRemindBefore)
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		uc, repo, _ := newCalendarTestUsecase(t)
+		repo.EXPECT().CountDrafts(ctx, int64(1)).Return(2, nil)
+
+		_, _, err := uc.CreateDraft(ctx, 1, &DraftInput{Title: "third"})
+		assert.ErrorIs(t, err, ErrTooManyDrafts)
+	})
+}
+
+func TestCalendarUsecase_UpdateDraft(t *testing.T) {
+	ctx := context.Background()
+	scheduledAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	remindAt := scheduledAt.Add(-time.Hour)
+	remindedAt := remindAt
+
+	t.Run("KeepPastScheduleAndReminder", func(t *testing.T) {
+		uc, repo, _ := newCalendarTestUsecase(t)
+		repo.EXPECT().GetDraft(ctx, int64(1), int64(10)).Return(&ContentDraft{
+			ID: 10, UserID: 1, Title: "old", ScheduledAt: &scheduledAt, RemindAt: &remindAt, RemindedAt: &remindedAt,
+		}, nil)
+		repo.EXPECT().UpdateDraft(ctx, mock.MatchedBy(func(d *ContentDraft) bool {
+			return d.Title == "new" && d.RemindedAt != nil
+		})).Return(nil)
+		repo.EXPECT().ListScheduledDrafts(ctx, int64(1), mock.Anything, mock.Anything).Return(nil, nil)
+
+		same := scheduledAt
+		_, _, err := uc.UpdateDraft(ctx, 1, 10, &DraftInput{Title: "new", ScheduledAt: &same, RemindBefore: time.Hour})
+		require.NoError(t, err)
+	})
+
+	t.Run("RescheduleResetsReminder", func(t *testing.T) {
+		uc, repo, _ := newCalendarTestUsecase(t)
+		repo.EXPECT().GetDraft(ctx, int64(1), int64(10)).Return(&ContentDraft{
+			ID: 10, UserID: 1, Title: "old", ScheduledAt: &scheduledAt, RemindAt: &remindAt, RemindedAt: &remindedAt,
+		}, nil)
+		repo.EXPECT().UpdateDraft(ctx, mock.MatchedBy(func(d *ContentDraft) bool {
+			return d.RemindedAt == nil && d.RemindAt != nil
+		})).Return(nil)
+		repo.EXPECT().ListScheduledDrafts(ctx, int64(1), mock.Anything, mock.Anything).Return(nil, nil)
+
+		later := time.Now().Add(24 * time.Hour)
+		_, _, err := uc.UpdateDraft(ctx, 1, 10, &DraftInput{Title: "old", ScheduledAt: &later, RemindBefore: time.Hour})
+		require.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		uc, repo, _ := newCalendarTestUsecase(t)
+		repo.EXPECT().GetDraft(ctx, int64(1), int64(11)).Return(nil, ErrDraftNotFound)
+
+		_, _, err := uc.UpdateDraft(ctx, 1, 11, &DraftInput{Title: "x"})
+		assert.ErrorIs(t, err, ErrDraftNotFound)
+	})
+}
+
+func TestCalendarUsecase_ListCalendar(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, _ := newCalendarTestUsecase(t)
+	start := time.Now()
+
+	repo.EXPECT().ListScheduledDrafts(ctx, int64(1), start, start.Add(maxCalendarRange)).Return([]*ContentDraft{{ID: 1}}, nil)
+	repo.EXPECT().ListUnscheduledDrafts(ctx, int64(1), 2).Return([]*ContentDraft{{ID: 2}}, nil)
+
+	scheduled, unscheduled, err := uc.ListCalendar(ctx, 1, start, start.Add(5*365*24*time.Hour), true)
+	require.NoError(t, err)
+	assert.Len(t, scheduled, 1)
+	assert.Len(t, unscheduled, 1)
+}
+
+func TestCalendarUsecase_SendReminders(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, notifier := newCalendarTestUsecase(t)
+	clk := clock.NewFake(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	uc.clock = clk
+	now := clk.Now()
+
+	soon := now.Add(30 * time.Minute)
+	stale := now.Add(-48 * time.Hour)
+	remindAt := now.Add(-time.Minute)
+	due := []*ContentDraft{
+		{ID: 1, UserID: 1, ScheduledAt: &soon, RemindAt: &remindAt},
+		{ID: 2, UserID: 1, ScheduledAt: &soon, RemindAt: &remindAt},
+		{ID: 3, UserID: 2, ScheduledAt: &soon, RemindAt: &remindAt},
+		{ID: 4, UserID: 2, ScheduledAt: &stale, RemindAt: &remindAt},
+	}
+	repo.EXPECT().ListDueReminders(ctx, now, defaultReminderBatchSize).Return(due, nil)
+	repo.EXPECT().ClaimReminder(ctx, int64(1), remindAt, now).Return(true, nil)
+	// 已被其他实例发送
+	repo.EXPECT().ClaimReminder(ctx, int64(2), remindAt, now).Return(false, nil)
+	repo.EXPECT().ClaimReminder(ctx, int64(3), remindAt, now).Return(true, nil)
+	// 计划时间已过去太久，标记后不发送
+	repo.EXPECT().ClaimReminder(ctx, int64(4), remindAt, now).Return(true, nil)
+
+	notifier.EXPECT().NotifyDraftReminder(mock.Anything, due[0]).Return(nil)
+	notifier.EXPECT().NotifyDraftReminder(mock.Anything, due[2]).Return(errors.New("push failed"))
+	repo.EXPECT().ReleaseReminder(ctx, int64(3), remindAt).Return(nil)
+
+	require.NoError(t, uc.SendReminders(ctx))
+}
@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrInvalidCaptionLanguage = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "caption language must be a language tag such as en or zh-CN")
+	ErrTooManyCaptionCues     = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "too many caption cues")
+	ErrInvalidCaptionQuery    = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "search query must be 2-100 characters")
+)
+
+const (
+	// maxCaptionCues 单个视频单种语言最多保存的字幕段数
+	maxCaptionCues = 5000
+	// maxCaptionCueLength 单个字幕段保存的最大字符数，超出部分截断
+	maxCaptionCueLength = 1000
+	// 检索词长度，ngram 全文索引按两个字符切词，单字无法命中
+	minCaptionQueryLength = 2
+	maxCaptionQueryLength = 100
+
+	defaultCaptionSearchLimit = 10
+	maxCaptionSearchLimit     = 50
+	// captionHitsPerVideo 每个视频最多返回的命中字幕段数
+	captionHitsPerVideo = 5
+)
+
+var captionLanguageRegex = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// CaptionHit 命中检索词的字幕段
+type CaptionHit struct {
+	VideoID  int64
+	Start    time.Duration
+	End      time.Duration
+	Text     string
+	DeepLink string // 跳转到字幕段开始位置播放的链接
+}
+
+// CaptionSearchResult 单个视频的检索结果
+type CaptionSearchResult struct {
+	Video *domain.Video
+	Hits  []*CaptionHit
+}
+
+// CaptionRepo 视频字幕仓储接口
+type CaptionRepo interface {
+	// ReplaceCaptions 替换视频某种语言的全部字幕段
+	ReplaceCaptions(ctx context.Context, videoID, authorID int64, language string, cues []media.CaptionCue) error
+	// SearchCaptions 在作者已发布视频的字幕中全文检索，按相关度返回命中的字幕段
+	SearchCaptions(ctx context.Context, authorID int64, query string, limit int) ([]*CaptionHit, error)
+}
+
+// CaptionUsecase 视频字幕用例：创作者上传字幕后建立全文索引，
+// 观众可以在创作者的视频中按口播内容检索并直接跳到对应位置播放
+type CaptionUsecase struct {
+	repo      CaptionRepo
+	videoRepo VideoRepo
+	baseURL   string
+	log       *log.Helper
+}
+
+// NewCaptionUsecase 创建视频字幕用例
+func NewCaptionUsecase(repo CaptionRepo, videoRepo VideoRepo, businessConfig *conf.Business, logger log.Logger) *CaptionUsecase {
+	return &CaptionUsecase{
+		repo:      repo,
+		videoRepo: videoRepo,
+		baseURL:   strings.TrimRight(businessConfig.GetShare().GetBaseUrl(), "/"),
+		log:       log.NewHelper(logger),
+	}
+}
+
+// SetCaptions 作者上传视频字幕（WebVTT 或 SRT），替换该语言已有的字幕，返回保存的字幕段数
+func (uc *CaptionUsecase) SetCaptions(ctx context.Context, userID, videoID int64, language, content string) (int, error) {
+	if language != "" && !captionLanguageRegex.MatchString(language) {
+		return 0, ErrInvalidCaptionLanguage
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return 0, err
+	}
+	if video.AuthorID != userID {
+		return 0, ErrPermissionDenied
+	}
+
+	cues, err := media.ParseCaptions(content)
+	if err != nil {
+		return 0, errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), err.Error())
+	}
+	if len(cues) > maxCaptionCues {
+		return 0, ErrTooManyCaptionCues
+	}
+	for i := range cues {
+		cues[i].Text = truncateRunes(cues[i].Text, maxCaptionCueLength)
+	}
+
+	if err := uc.repo.ReplaceCaptions(ctx, videoID, video.AuthorID, language, cues); err != nil {
+		return 0, err
+	}
+	return len(cues), nil
+}
+
+// SearchWithinCreator 在创作者已发布的视频中按字幕内容检索，结果按视频中最相关字幕段的相关度排序
+func (uc *CaptionUsecase) SearchWithinCreator(ctx context.Context, creatorID int64, query string, limit int32) ([]*CaptionSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if n := utf8.RuneCountInString(query); n < minCaptionQueryLength || n > maxCaptionQueryLength {
+		return nil, ErrInvalidCaptionQuery
+	}
+	if limit <= 0 {
+		limit = defaultCaptionSearchLimit
+	}
+	if limit > maxCaptionSearchLimit {
+		limit = maxCaptionSearchLimit
+	}
+
+	hits, err := uc.repo.SearchCaptions(ctx, creatorID, query, int(limit)*captionHitsPerVideo)
+	if err != nil {
+		return nil, err
+	}
+
+	var videoIDs []int64
+	grouped := make(map[int64][]*CaptionHit)
+	for _, hit := range hits {
+		if _, ok := grouped[hit.VideoID]; !ok {
+			if len(videoIDs) == int(limit) {
+				continue
+			}
+			videoIDs = append(videoIDs, hit.VideoID)
+		}
+		if len(grouped[hit.VideoID]) < captionHitsPerVideo {
+			hit.DeepLink = uc.deepLink(hit.VideoID, hit.Start)
+			grouped[hit.VideoID] = append(grouped[hit.VideoID], hit)
+		}
+	}
+	if len(videoIDs) == 0 {
+		return []*CaptionSearchResult{}, nil
+	}
+
+	videos, err := uc.videoRepo.GetVideos(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	videoMap := make(map[int64]*domain.Video, len(videos))
+	for _, video := range videos {
+		videoMap[video.ID] = video
+	}
+
+	results := make([]*CaptionSearchResult, 0, len(videoIDs))
+	for _, videoID := range videoIDs {
+		video, ok := videoMap[videoID]
+		if !ok || video.Status != domain.VideoStatusPublished || !video.IsPublic() {
+			continue
+		}
+		results = append(results, &CaptionSearchResult{Video: video, Hits: grouped[videoID]})
+	}
+	return results, nil
+}
+
+// deepLink 生成从指定位置开始播放视频的落地页链接
+func (uc *CaptionUsecase) deepLink(videoID int64, offset time.Duration) string {
+	return fmt.Sprintf("%s/video/%d?t=%s", uc.baseURL, videoID, strconv.FormatFloat(offset.Seconds(), 'f', -1, 64))
+}
+
+// truncateRunes 按字符截断字符串
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCaptionUsecase(t *testing.T) (*MockCaptionRepo, *MockVideoRepo, *CaptionUsecase) {
+	repo := NewMockCaptionRepo(t)
+	videoRepo := NewMockVideoRepo(t)
+	config := &conf.Business{Share: &conf.Business_Share{BaseUrl: "https://example.com/share/"}}
+	return repo, videoRepo, NewCaptionUsecase(repo, videoRepo, config, log.DefaultLogger)
+}
+
+func TestCaptionUsecase_SetCaptions(t *testing.T) {
+	ctx := context.Background()
+	content := "WEBVTT\n\n00:01.000 --> 00:03.000\n今天聊聊咖啡\n"
+
+	t.Run("Replace", func(t *testing.T) {
+		repo, videoRepo, uc := setupCaptionUsecase(t)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1}, nil)
+		repo.EXPECT().ReplaceCaptions(ctx, int64(10), int64(1), "zh-CN", []media.CaptionCue{
+			{Start: time.Second, End: 3 * time.Second, Text: "今天聊聊咖啡"},
+		}).Return(nil)
+
+		count, err := uc.SetCaptions(ctx, 1, 10, "zh-CN", content)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		_, videoRepo, uc := setupCaptionUsecase(t)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+
+		_, err := uc.SetCaptions(ctx, 1, 10, "", content)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("InvalidLanguage", func(t *testing.T) {
+		_, _, uc := setupCaptionUsecase(t)
+
+		_, err := uc.SetCaptions(ctx, 1, 10, "zh_CN!", content)
+		assert.ErrorIs(t, err, ErrInvalidCaptionLanguage)
+	})
+
+	t.Run("InvalidContent", func(t *testing.T) {
+		_, videoRepo, uc := setupCaptionUsecase(t)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1}, nil)
+
+		_, err := uc.SetCaptions(ctx, 1, 10, "", "not a caption file")
+		assert.Error(t, err)
+	})
+}
+
+func TestCaptionUsecase_SearchWithinCreator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GroupByVideo", func(t *testing.T) {
+		repo, videoRepo, uc := setupCaptionUsecase(t)
+		repo.EXPECT().SearchCaptions(ctx, int64(1), "咖啡", 2*captionHitsPerVideo).Return([]*CaptionHit{
+			{VideoID: 20, Start: 1500 * time.Millisecond, End: 3 * time.Second, Text: "手冲咖啡"},
+			{VideoID: 10, Start: time.Second, End: 2 * time.Second, Text: "咖啡豆"},
+			{VideoID: 20, Start: time.Minute, End: time.Minute + time.Second, Text: "咖啡杯"},
+			{VideoID: 30, Start: 0, End: time.Second, Text: "咖啡机"},
+		}, nil)
+		videoRepo.EXPECT().GetVideos(ctx, []int64{20, 10}).Return([]*domain.Video{
+			{ID: 10, AuthorID: 1, Status: domain.VideoStatusPublished},
+			{ID: 20, AuthorID: 1, Status: domain.VideoStatusPublished},
+		}, nil)
+
+		results, err := uc.SearchWithinCreator(ctx, 1, " 咖啡 ", 2)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, int64(20), results[0].Video.ID)
+		require.Len(t, results[0].Hits, 2)
+		assert.Equal(t, "https://example.com/share/video/20?t=1.5", results[0].Hits[0].DeepLink)
+		assert.Equal(t, "https://example.com/share/video/20?t=60", results[0].Hits[1].DeepLink)
+		assert.Equal(t, int64(10), results[1].Video.ID)
+	})
+
+	t.Run("SkipUnpublished", func(t *testing.T) {
+		repo, videoRepo, uc := setupCaptionUsecase(t)
+		repo.EXPECT().SearchCaptions(ctx, int64(1), "咖啡", defaultCaptionSearchLimit*captionHitsPerVideo).Return([]*CaptionHit{
+			{VideoID: 10, Text: "咖啡"},
+		}, nil)
+		videoRepo.EXPECT().GetVideos(ctx, []int64{10}).Return([]*domain.Video{
+			{ID: 10, AuthorID: 1, Status: domain.VideoStatusPrivate},
+		}, nil)
+
+		results, err := uc.SearchWithinCreator(ctx, 1, "咖啡", 0)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		_, _, uc := setupCaptionUsecase(t)
+
+		_, err := uc.SearchWithinCreator(ctx, 1, "咖", 10)
+		assert.ErrorIs(t, err, ErrInvalidCaptionQuery)
+	})
+
+	t.Run("NoHits", func(t *testing.T) {
+		repo, _, uc := setupCaptionUsecase(t)
+		repo.EXPECT().SearchCaptions(ctx, int64(1), "咖啡", mock.Anything).Return(nil, nil)
+
+		results, err := uc.SearchWithinCreator(ctx, 1, "咖啡", 10)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
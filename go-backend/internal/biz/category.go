@@ -0,0 +1,274 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrCategoryNotFound      = errors.NotFound(v1.ErrorCode_CATEGORY_NOT_EXIST.String(), "category not found")
+	ErrCategoryExist         = errors.Conflict(v1.ErrorCode_CATEGORY_EXIST.String(), "category slug already exists")
+	ErrCategoryInUse         = errors.Conflict(v1.ErrorCode_CATEGORY_IN_USE.String(), "category still has videos")
+	ErrInvalidCategorySlug   = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "category slug must be 1-50 lowercase letters, digits or hyphens")
+	ErrInvalidCategoryNames  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "category names must include zh and each name must be 1-30 characters")
+	ErrInvalidCategoryLocale = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "invalid category name locale")
+	ErrInvalidCategoryStatus = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "category status must be 1 or 2")
+)
+
+// 分类状态
+const (
+	CategoryStatusActive   int32 = 1 // 启用，创作者可选择
+	CategoryStatusDisabled int32 = 2 // 停用，已有视频保留分类
+)
+
+// DefaultCategoryLocale 分类名称的默认语言，每个分类都必须提供
+const DefaultCategoryLocale = "zh"
+
+const (
+	maxCategorySlugLength = 50
+	maxCategoryNameLength = 30
+)
+
+var (
+	categorySlugPattern   = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	categoryLocalePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+)
+
+// Category 视频分类
+type Category struct {
+	ID   int64
+	Slug string
+	// Names 各语言的分类名称，键为小写语言标签，如 zh、en、zh-tw
+	Names     map[string]string
+	SortOrder int32
+	Status    int32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Name 按语言选择分类名称：先精确匹配，再匹配主语言（zh-TW 回退到 zh），
+// 再回退到默认语言，都没有时返回分类标识
+func (c *Category) Name(locale string) string {
+	locale = normalizeLocale(locale)
+	if name, ok := c.Names[locale]; ok {
+		return name
+	}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		if name, ok := c.Names[base]; ok {
+			return name
+		}
+	}
+	if name, ok := c.Names[DefaultCategoryLocale]; ok {
+		return name
+	}
+	return c.Slug
+}
+
+// CategoryFacet 分类及其已发布视频数
+type CategoryFacet struct {
+	*Category
+	VideoCount int64
+}
+
+// CategoryRepo 视频分类仓储接口
+type CategoryRepo interface {
+	// ListCategories 查询所有分类，按排序值、ID升序
+	ListCategories(ctx context.Context) ([]*Category, error)
+	// GetCategory 获取分类，不存在时返回ErrCategoryNotFound
+	GetCategory(ctx context.Context, id int64) (*Category, error)
+	// CreateCategory 创建分类，标识重复时返回ErrCategoryExist
+	CreateCategory(ctx context.Context, category *Category) error
+	// UpdateCategory 修改名称、排序和状态，不存在时返回ErrCategoryNotFound
+	UpdateCategory(ctx context.Context, category *Category) error
+	// DeleteCategory 删除分类，仍有未删除的视频时返回ErrCategoryInUse
+	DeleteCategory(ctx context.Context, id int64) error
+	// CountPublishedVideos 统计各分类的已发布视频数，键为分类ID
+	CountPublishedVideos(ctx context.Context) (map[int64]int64, error)
+}
+
+// CategoryUsecase 视频分类用例：管理员维护分类及多语言名称，创作者发布时选择启用的分类，
+// 视频流按分类筛选，分类视频数作为搜索分面
+type CategoryUsecase struct {
+	repo         CategoryRepo
+	permissionUc *PermissionUsecase
+	log          *log.Helper
+}
+
+// NewCategoryUsecase 创建视频分类用例
+func NewCategoryUsecase(repo CategoryRepo, permissionUc *PermissionUsecase, logger log.Logger) *CategoryUsecase {
+	return &CategoryUsecase{
+		repo:         repo,
+		permissionUc: permissionUc,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// ListCategories 管理员查询所有分类，包括已停用的分类
+func (uc *CategoryUsecase) ListCategories(ctx context.Context, adminID int64) ([]*Category, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListCategories(ctx)
+}
+
+// CreateCategory 创建分类，新分类默认启用
+func (uc *CategoryUsecase) CreateCategory(ctx context.Context, adminID int64, slug string, names map[string]string, sortOrder int32) (*Category, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	slug = strings.TrimSpace(slug)
+	if len(slug) > maxCategorySlugLength || !categorySlugPattern.MatchString(slug) {
+		return nil, ErrInvalidCategorySlug
+	}
+	normalized, err := normalizeCategoryNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &Category{
+		Slug:      slug,
+		Names:     normalized,
+		SortOrder: sortOrder,
+		Status:    CategoryStatusActive,
+	}
+	if err := uc.repo.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d created category %d (%s)", adminID, category.ID, category.Slug)
+	return category, nil
+}
+
+// UpdateCategory 修改分类名称、排序和状态，分类标识创建后不可修改
+func (uc *CategoryUsecase) UpdateCategory(ctx context.Context, adminID int64, category *Category) (*Category, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	if category.Status != CategoryStatusActive && category.Status != CategoryStatusDisabled {
+		return nil, ErrInvalidCategoryStatus
+	}
+	normalized, err := normalizeCategoryNames(category.Names)
+	if err != nil {
+		return nil, err
+	}
+	category.Names = normalized
+
+	if err := uc.repo.UpdateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d updated category %d (status=%d)", adminID, category.ID, category.Status)
+	return category, nil
+}
+
+// DeleteCategory 删除分类，分类下仍有视频时应改为停用
+func (uc *CategoryUsecase) DeleteCategory(ctx context.Context, adminID, categoryID int64) error {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	if err := uc.repo.DeleteCategory(ctx, categoryID); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d deleted category %d", adminID, categoryID)
+	return nil
+}
+
+// ListActiveCategories 查询创作者可选择的分类
+func (uc *CategoryUsecase) ListActiveCategories(ctx context.Context) ([]*Category, error) {
+	categories, err := uc.repo.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]*Category, 0, len(categories))
+	for _, category := range categories {
+		if category.Status == CategoryStatusActive {
+			active = append(active, category)
+		}
+	}
+	return active, nil
+}
+
+// ListCategoryFacets 查询启用的分类及各分类的已发布视频数
+func (uc *CategoryUsecase) ListCategoryFacets(ctx context.Context) ([]*CategoryFacet, error) {
+	categories, err := uc.ListActiveCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.repo.CountPublishedVideos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	facets := make([]*CategoryFacet, 0, len(categories))
+	for _, category := range categories {
+		facets = append(facets, &CategoryFacet{Category: category, VideoCount: counts[category.ID]})
+	}
+	return facets, nil
+}
+
+// ValidateSelectable 校验创作者发布时选择的分类，0表示不选择分类
+func (uc *CategoryUsecase) ValidateSelectable(ctx context.Context, categoryID int64) error {
+	if categoryID == 0 {
+		return nil
+	}
+
+	category, err := uc.repo.GetCategory(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	if category.Status != CategoryStatusActive {
+		return ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (uc *CategoryUsecase) requireAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// normalizeCategoryNames 统一语言标签的大小写并校验名称，必须包含默认语言
+func normalizeCategoryNames(names map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(names))
+	for locale, name := range names {
+		locale = normalizeLocale(locale)
+		if !categoryLocalePattern.MatchString(locale) {
+			return nil, ErrInvalidCategoryLocale
+		}
+		name = strings.TrimSpace(name)
+		if name == "" || utf8.RuneCountInString(name) > maxCategoryNameLength {
+			return nil, ErrInvalidCategoryNames
+		}
+		normalized[locale] = name
+	}
+
+	if _, ok := normalized[DefaultCategoryLocale]; !ok {
+		return nil, ErrInvalidCategoryNames
+	}
+	return normalized, nil
+}
+
+// normalizeLocale 语言标签转为小写并以连字符分隔，如 zh_TW 转为 zh-tw
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
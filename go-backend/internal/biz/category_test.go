@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newCategoryTestUsecase(t *testing.T) (*CategoryUsecase, *MockCategoryRepo, *MockRoleRepo) {
+	repo := NewMockCategoryRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+	return NewCategoryUsecase(repo, permissionUc, log.DefaultLogger), repo, roleRepo
+}
+
+func expectCategoryAdmin(ctx context.Context, roleRepo *MockRoleRepo, userID int64, isAdmin bool) {
+	roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(isAdmin, nil)
+}
+
+func TestCategory_Name(t *testing.T) {
+	category := &Category{
+		Slug:  "music",
+		Names: map[string]string{"zh": "音乐", "en": "Music", "zh-tw": "音樂"},
+	}
+
+	assert.Equal(t, "音樂", category.Name("zh_TW"))
+	assert.Equal(t, "Music", category.Name("en-GB"))
+	assert.Equal(t, "音乐", category.Name("ja"))
+	assert.Equal(t, "音乐", category.Name(""))
+	assert.Equal(t, "music", (&Category{Slug: "music"}).Name("en"))
+}
+
+func TestCategoryUsecase_CreateCategory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NormalizesNames", func(t *testing.T) {
+		uc, repo, roleRepo := newCategoryTestUsecase(t)
+		expectCategoryAdmin(ctx, roleRepo, 1, true)
+		repo.EXPECT().CreateCategory(ctx, mock.MatchedBy(func(c *Category) bool {
+			return c.Slug == "gaming" && c.Status == CategoryStatusActive &&
+				c.Names["zh"] == "游戏" && c.Names["en-us"] == "Gaming"
+		})).Return(nil)
+
+		category, err := uc.CreateCategory(ctx, 1, " gaming ", map[string]string{"zh": " 游戏 ", "en_US": "Gaming"}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), category.SortOrder)
+	})
+
+	t.Run("InvalidSlug", func(t *testing.T) {
+		uc, _, roleRepo := newCategoryTestUsecase(t)
+		expectCategoryAdmin(ctx, roleRepo, 1, true)
+
+		_, err := uc.CreateCategory(ctx, 1, "Music Videos", map[string]string{"zh": "音乐"}, 0)
+		assert.Equal(t, ErrInvalidCategorySlug, err)
+	})
+
+	t.Run("DefaultLocaleRequired", func(t *testing.T) {
+		uc, _, roleRepo := newCategoryTestUsecase(t)
+		expectCategoryAdmin(ctx, roleRepo, 1, true)
+
+		_, err := uc.CreateCategory(ctx, 1, "music", map[string]string{"en": "Music"}, 0)
+		assert.Equal(t, ErrInvalidCategoryNames, err)
+	})
+
+	t.Run("InvalidLocale", func(t *testing.T) {
+		uc, _, roleRepo := newCategoryTestUsecase(t)
+		expectCategoryAdmin(ctx, roleRepo, 1, true)
+
+		_, err := uc.CreateCategory(ctx, 1, "music", map[string]string{"zh": "音乐", "english!": "Music"}, 0)
+		assert.Equal(t, ErrInvalidCategoryLocale, err)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		uc, _, roleRepo := newCategoryTestUsecase(t)
+		expectCategoryAdmin(ctx, roleRepo, 2, false)
+
+		_, err := uc.CreateCategory(ctx, 2, "music", map[string]string{"zh": "音乐"}, 0)
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
+
+func TestCategoryUsecase_UpdateCategory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		uc, _, roleRepo := newCategoryTestUsecase(t)
+		expectCategoryAdmin(ctx, roleRepo, 1, true)
+
+		_, err := uc.UpdateCategory(ctx, 1, &Category{ID: 3, Names: map[string]string{"zh": "音乐"}, Status: 0})
+		assert.Equal(t, ErrInvalidCategoryStatus, err)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		uc, repo, roleRepo := newCategoryTestUsecase(t)
+		expectCategoryAdmin(ctx, roleRepo, 1, true)
+		repo.EXPECT().UpdateCategory(ctx, mock.MatchedBy(func(c *Category) bool {
+			return c.ID == 3 && c.Status == CategoryStatusDisabled
+		})).Return(nil)
+
+		_, err := uc.UpdateCategory(ctx, 1, &Category{ID: 3, Names: map[string]string{"zh": "音乐"}, Status: CategoryStatusDisabled})
+		require.NoError(t, err)
+	})
+}
+
+func TestCategoryUsecase_ListCategoryFacets(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, _ := newCategoryTestUsecase(t)
+
+	repo.EXPECT().ListCategories(ctx).Return([]*Category{
+		{ID: 1, Slug: "music", Status: CategoryStatusActive},
+		{ID: 2, Slug: "retired", Status: CategoryStatusDisabled},
+		{ID: 3, Slug: "gaming", Status: CategoryStatusActive},
+	}, nil)
+	repo.EXPECT().CountPublishedVideos(ctx).Return(map[int64]int64{1: 5, 2: 9}, nil)
+
+	facets, err := uc.ListCategoryFacets(ctx)
+	require.NoError(t, err)
+	require.Len(t, facets, 2)
+	assert.Equal(t, "music", facets[0].Slug)
+	assert.Equal(t, int64(5), facets[0].VideoCount)
+	assert.Equal(t, "gaming", facets[1].Slug)
+	assert.Zero(t, facets[1].VideoCount)
+}
+
+func TestCategoryUsecase_ValidateSelectable(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, _ := newCategoryTestUsecase(t)
+
+	assert.NoError(t, uc.ValidateSelectable(ctx, 0))
+
+	repo.EXPECT().GetCategory(ctx, int64(1)).Return(&Category{ID: 1, Status: CategoryStatusActive}, nil)
+	assert.NoError(t, uc.ValidateSelectable(ctx, 1))
+
+	repo.EXPECT().GetCategory(ctx, int64(2)).Return(&Category{ID: 2, Status: CategoryStatusDisabled}, nil)
+	assert.Equal(t, ErrCategoryNotFound, uc.ValidateSelectable(ctx, 2))
+
+	repo.EXPECT().GetCategory(ctx, int64(3)).Return(nil, ErrCategoryNotFound)
+	assert.Equal(t, ErrCategoryNotFound, uc.ValidateSelectable(ctx, 3))
+}
@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"go-backend/internal/domain"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
+)
+
+// 合并并发读取的操作名，用于指标标签
+const (
+	coalesceGetVideo       = "get_video"
+	coalesceGetUser        = "get_user"
+	coalesceGetPublishList = "get_publish_list"
+	coalesceGetFeed        = "get_feed"
+)
+
+var errCoalescedReadAborted = errors.New("coalesced read aborted")
+
+type coalesceResult struct {
+	value  interface{}
+	err    error
+	shared bool
+}
+
+// readCoalescer 合并参数相同的并发读取：缓存未命中时N个相同请求只查询一次存储，结果由所有调用方共享。
+// 查询不随发起者的请求取消而中断，其余调用方仍能拿到结果；每个调用方各自等待到自己的请求取消为止
+type readCoalescer struct {
+	group     singleflight.Group
+	operation metric.MeasurementOption
+	calls     metric.Int64Counter
+	coalesced metric.Int64Counter
+}
+
+func newReadCoalescer(operation string) *readCoalescer {
+	c := &readCoalescer{
+		operation: metric.WithAttributes(attribute.String("operation", operation)),
+	}
+
+	meter := otel.Meter("go-backend/coalesce")
+	c.calls, _ = meter.Int64Counter("read_coalesce_calls_total",
+		metric.WithDescription("Read calls passed through the request coalescer"))
+	c.coalesced, _ = meter.Int64Counter("read_coalesce_coalesced_total",
+		metric.WithDescription("Read calls served by another in-flight call instead of querying storage"))
+
+	return c
+}
+
+// coalesce 以key合并并发的fetch调用。结果被多个调用方共享时，每个调用方拿到clone后的副本，
+// 避免调用方修改返回值时相互影响
+func coalesce[T any](ctx context.Context, c *readCoalescer, key string, fetch func(context.Context) (T, error), clone func(T) T) (T, error) {
+	c.calls.Add(ctx, 1, c.operation)
+
+	executed := false
+	ch := make(chan coalesceResult, 1)
+	go func() {
+		// fetch 调用 runtime.Goexit 时 Do 不会返回，defer 保证调用方仍能拿到错误而不是一直等待
+		res := coalesceResult{err: errCoalescedReadAborted}
+		defer func() { ch <- res }()
+		res.value, res.err, res.shared = c.group.Do(key, func() (value interface{}, err error) {
+			executed = true
+			// 查询在独立的goroutine中执行，panic 无法被请求的恢复中间件捕获，转为错误返回
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("coalesced read panicked: %v", r)
+				}
+			}()
+			return fetch(context.WithoutCancel(ctx))
+		})
+	}()
+
+	select {
+	case res := <-ch:
+		if !executed {
+			c.coalesced.Add(ctx, 1, c.operation)
+		}
+		value, _ := res.value.(T)
+		if res.err != nil {
+			return value, res.err
+		}
+		if res.shared {
+			value = clone(value)
+		}
+		return value, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+func cloneUser(user *User) *User {
+	if user == nil {
+		return nil
+	}
+	c := *user
+	return &c
+}
+
+func cloneVideo(video *domain.Video) *domain.Video {
+	if video == nil {
+		return nil
+	}
+	c := *video
+	c.PlayURLs = maps.Clone(video.PlayURLs)
+	return &c
+}
+
+func cloneVideos(videos []*domain.Video) []*domain.Video {
+	if videos == nil {
+		return nil
+	}
+	cloned := make([]*domain.Video, len(videos))
+	for i, video := range videos {
+		cloned[i] = cloneVideo(video)
+	}
+	return cloned
+}
+
+func cloneFeedCacheEntry(entry *FeedCacheEntry) *FeedCacheEntry {
+	if entry == nil {
+		return nil
+	}
+	return &FeedCacheEntry{Videos: cloneVideos(entry.Videos), RefreshAt: entry.RefreshAt}
+}
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalesce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ConcurrentCallsShareOneFetch", func(t *testing.T) {
+		c := newReadCoalescer(coalesceGetUser)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var fetches atomic.Int32
+		fetch := func(context.Context) (*User, error) {
+			if fetches.Add(1) == 1 {
+				close(started)
+			}
+			<-release
+			return &User{ID: 1, FollowCount: 3}, nil
+		}
+
+		const callers = 5
+		results := make([]*User, callers)
+		var wg sync.WaitGroup
+		for i := 0; i < callers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				user, err := coalesce(ctx, c, "1", fetch, cloneUser)
+				assert.NoError(t, err)
+				results[i] = user
+			}(i)
+			if i == 0 {
+				<-started
+			}
+		}
+		// 等待其余调用方加入进行中的查询
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), fetches.Load())
+		for i, user := range results {
+			require.NotNil(t, user)
+			assert.Equal(t, 3, user.FollowCount)
+			for _, other := range results[i+1:] {
+				assert.NotSame(t, user, other)
+			}
+		}
+	})
+
+	t.Run("CallerCancelDoesNotAbortFetch", func(t *testing.T) {
+		c := newReadCoalescer(coalesceGetVideo)
+		release := make(chan struct{})
+		fetched := make(chan error, 1)
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := coalesce(cancelCtx, c, "1", func(ctx context.Context) (*User, error) {
+			<-release
+			fetched <- ctx.Err()
+			return &User{ID: 1}, nil
+		}, cloneUser)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		close(release)
+		assert.NoError(t, <-fetched)
+	})
+
+	t.Run("PanicBecomesError", func(t *testing.T) {
+		c := newReadCoalescer(coalesceGetVideo)
+		user, err := coalesce(ctx, c, "1", func(context.Context) (*User, error) {
+			panic("boom")
+		}, cloneUser)
+		assert.Nil(t, user)
+		assert.ErrorContains(t, err, "boom")
+	})
+}
+
+func TestUserUsecase_GetUser_Coalesced(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockUserRepo(t)
+	uc := NewUserUsecase(repo, log.DefaultLogger)
+
+	release := make(chan time.Time)
+	repo.EXPECT().GetUser(mock.Anything, int64(7)).Return(&User{ID: 7, Nickname: "alice"}, nil).WaitUntil(release).Once()
+
+	var wg sync.WaitGroup
+	users := make([]*User, 3)
+	for i := range users {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			user, err := uc.GetUser(ctx, 7)
+			assert.NoError(t, err)
+			users[i] = user
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, user := range users {
+		require.NotNil(t, user)
+		assert.Equal(t, "alice", user.Nickname)
+	}
+}
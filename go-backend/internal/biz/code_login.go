@@ -0,0 +1,239 @@
+package biz
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrLoginCodeChannelUnsupported 验证码登录渠道未开启或未配置发送通道
+var ErrLoginCodeChannelUnsupported = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "verification code login channel not supported")
+
+// 验证码登录渠道
+const (
+	LoginCodeChannelEmail = "email"
+	LoginCodeChannelSMS   = "sms"
+)
+
+const (
+	defaultLoginCodeTTL            = 5 * time.Minute
+	defaultLoginCodeResendInterval = time.Minute
+	defaultLoginCodeMaxAttempts    = 5
+)
+
+// LoginCode 待使用的登录验证码
+type LoginCode struct {
+	Code      string    `json:"code"` // 验证码的SHA-256
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LoginCodeRepo 登录验证码存储，同一渠道的同一账号同时只保留一条
+type LoginCodeRepo interface {
+	// SetLoginCode 保存新的验证码并清零校验次数
+	SetLoginCode(ctx context.Context, channel, target string, code *LoginCode, ttl time.Duration) error
+	// GetLoginCode 不存在或已过期时返回nil
+	GetLoginCode(ctx context.Context, channel, target string) (*LoginCode, error)
+	// IncrLoginCodeAttempts 原子地累加验证码的校验次数并返回累加后的值，ttl 后自动清除
+	IncrLoginCodeAttempts(ctx context.Context, channel, target string, ttl time.Duration) (int, error)
+	DeleteLoginCode(ctx context.Context, channel, target string) error
+}
+
+// LoginCodeSender 将登录验证码发往邮箱或手机号
+type LoginCodeSender interface {
+	SendLoginCode(ctx context.Context, target, code string) error
+}
+
+// LoginCodeSenders 按渠道名索引的发送通道
+type LoginCodeSenders map[string]LoginCodeSender
+
+// CodeLoginUsecase 验证码登录。验证码只发往已绑定该邮箱或手机号的账号，
+// 账号不存在时同样返回成功，避免通过接口探测已注册的邮箱和手机号
+type CodeLoginUsecase struct {
+	repo     LoginCodeRepo
+	userRepo UserRepo
+	senders  LoginCodeSenders
+	issuer   LoginIssuer
+	clock    clock.Clock
+
+	enabled        bool
+	channels       map[string]bool
+	ttl            time.Duration
+	resendInterval time.Duration
+	maxAttempts    int
+
+	log *log.Helper
+}
+
+// NewCodeLoginUsecase 创建验证码登录用例
+func NewCodeLoginUsecase(
+	repo LoginCodeRepo,
+	userRepo UserRepo,
+	senders LoginCodeSenders,
+	issuer LoginIssuer,
+	businessConfig *conf.Business,
+	clk clock.Clock,
+	logger log.Logger,
+) *CodeLoginUsecase {
+	uc := &CodeLoginUsecase{
+		repo:           repo,
+		userRepo:       userRepo,
+		senders:        senders,
+		issuer:         issuer,
+		clock:          clk,
+		channels:       map[string]bool{LoginCodeChannelEmail: true},
+		ttl:            defaultLoginCodeTTL,
+		resendInterval: defaultLoginCodeResendInterval,
+		maxAttempts:    defaultLoginCodeMaxAttempts,
+		log:            log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetCodeLogin(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		if len(cfg.Channels) > 0 {
+			uc.channels = make(map[string]bool, len(cfg.Channels))
+			for _, channel := range cfg.Channels {
+				uc.channels[channel] = true
+			}
+		}
+		if cfg.CodeTtl != nil && cfg.CodeTtl.AsDuration() > 0 {
+			uc.ttl = cfg.CodeTtl.AsDuration()
+		}
+		if cfg.ResendInterval != nil && cfg.ResendInterval.AsDuration() > 0 {
+			uc.resendInterval = cfg.ResendInterval.AsDuration()
+		}
+		if cfg.MaxAttempts > 0 {
+			uc.maxAttempts = int(cfg.MaxAttempts)
+		}
+	}
+
+	return uc
+}
+
+// SendLoginCode 生成验证码发往邮箱或手机号，重发间隔内重复请求返回 ErrVerificationTooFrequent
+func (uc *CodeLoginUsecase) SendLoginCode(ctx context.Context, channel, target string) error {
+	sender, err := uc.sender(channel)
+	if err != nil {
+		return err
+	}
+	target = NormalizeLoginTarget(channel, target)
+
+	pending, err := uc.repo.GetLoginCode(ctx, channel, target)
+	if err != nil {
+		return err
+	}
+	now := uc.clock.Now()
+	if pending != nil && now.Sub(pending.CreatedAt) < uc.resendInterval {
+		return ErrVerificationTooFrequent
+	}
+
+	if _, err := uc.lookupUser(ctx, channel, target); err != nil {
+		if err == ErrUserNotFound {
+			uc.log.WithContext(ctx).Infof("login code requested for unknown %s account", channel)
+			return nil
+		}
+		return err
+	}
+
+	plain, err := generateVerificationCode(emailCodeLength)
+	if err != nil {
+		return err
+	}
+	code := &LoginCode{Code: hashLoginChallengeCode(plain), CreatedAt: now}
+	if err := uc.repo.SetLoginCode(ctx, channel, target, code, uc.ttl); err != nil {
+		return err
+	}
+
+	return sender.SendLoginCode(ctx, target, plain)
+}
+
+// LoginWithCode 校验验证码并签发Token对。验证码在登录成功后作废，
+// 异常登录检测要求额外验证时保留，携带 challengeCode 重试即可。
+// 每次校验前先原子地累加校验次数，并发的猜测请求同样受次数上限约束
+func (uc *CodeLoginUsecase) LoginWithCode(ctx context.Context, channel, target, code, challengeCode string) (*auth.TokenPair, *User, error) {
+	if _, err := uc.sender(channel); err != nil {
+		return nil, nil, err
+	}
+	target = NormalizeLoginTarget(channel, target)
+
+	pending, err := uc.repo.GetLoginCode(ctx, channel, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pending == nil {
+		return nil, nil, ErrVerificationCodeInvalid
+	}
+
+	attempts, err := uc.repo.IncrLoginCodeAttempts(ctx, channel, target, uc.clock.Until(pending.CreatedAt.Add(uc.ttl)))
+	if err != nil {
+		return nil, nil, err
+	}
+	if attempts > uc.maxAttempts {
+		return nil, nil, ErrVerificationCodeInvalid
+	}
+
+	if subtle.ConstantTimeCompare([]byte(pending.Code), []byte(hashLoginChallengeCode(code))) != 1 {
+		// 最后一次机会也输错时作废验证码
+		if attempts == uc.maxAttempts {
+			if err := uc.repo.DeleteLoginCode(ctx, channel, target); err != nil {
+				uc.log.WithContext(ctx).Warnf("delete login code failed: channel=%s err=%v", channel, err)
+			}
+		}
+		return nil, nil, ErrVerificationCodeInvalid
+	}
+
+	// 验证码发出后账号被注销或更换了绑定
+	user, err := uc.lookupUser(ctx, channel, target)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, nil, ErrVerificationCodeInvalid
+		}
+		return nil, nil, err
+	}
+
+	tokenPair, err := uc.issuer.IssueLogin(ctx, user, challengeCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := uc.repo.DeleteLoginCode(ctx, channel, target); err != nil {
+		uc.log.WithContext(ctx).Warnf("delete login code failed: user=%d err=%v", user.ID, err)
+	}
+	return tokenPair, user, nil
+}
+
+// sender 返回已开启渠道的发送通道
+func (uc *CodeLoginUsecase) sender(channel string) (LoginCodeSender, error) {
+	if !uc.enabled || !uc.channels[channel] {
+		return nil, ErrLoginCodeChannelUnsupported
+	}
+	sender, ok := uc.senders[channel]
+	if !ok {
+		return nil, ErrLoginCodeChannelUnsupported
+	}
+	return sender, nil
+}
+
+func (uc *CodeLoginUsecase) lookupUser(ctx context.Context, channel, target string) (*User, error) {
+	if channel == LoginCodeChannelSMS {
+		return uc.userRepo.GetUserByPhone(ctx, target)
+	}
+	return uc.userRepo.GetUserByEmail(ctx, target)
+}
+
+// NormalizeLoginTarget 统一邮箱大小写，去掉手机号中的空格和连字符
+func NormalizeLoginTarget(channel, target string) string {
+	target = strings.TrimSpace(target)
+	if channel == LoginCodeChannelSMS {
+		return strings.NewReplacer(" ", "", "-", "").Replace(target)
+	}
+	return strings.ToLower(target)
+}
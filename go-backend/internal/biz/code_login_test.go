@@ -0,0 +1,211 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type codeLoginTestDeps struct {
+	uc       *CodeLoginUsecase
+	repo     *MockLoginCodeRepo
+	userRepo *MockUserRepo
+	email    *MockLoginCodeSender
+	sms      *MockLoginCodeSender
+	issuer   *MockLoginIssuer
+	clock    *clock.Fake
+}
+
+func newCodeLoginTestDeps(t *testing.T, channels ...string) *codeLoginTestDeps {
+	d := &codeLoginTestDeps{
+		repo:     NewMockLoginCodeRepo(t),
+		userRepo: NewMockUserRepo(t),
+		email:    NewMockLoginCodeSender(t),
+		sms:      NewMockLoginCodeSender(t),
+		issuer:   NewMockLoginIssuer(t),
+		clock:    clock.NewFake(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)),
+	}
+	senders := LoginCodeSenders{LoginCodeChannelEmail: d.email, LoginCodeChannelSMS: d.sms}
+	config := &conf.Business{CodeLogin: &conf.Business_CodeLogin{Enabled: true, Channels: channels}}
+	d.uc = NewCodeLoginUsecase(d.repo, d.userRepo, senders, d.issuer, config, d.clock, log.DefaultLogger)
+	return d
+}
+
+func TestCodeLoginUsecase_SendLoginCode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Email", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").Return(nil, nil)
+		d.userRepo.EXPECT().GetUserByEmail(ctx, "alice@example.com").Return(&User{ID: 1}, nil)
+		var stored *LoginCode
+		d.repo.EXPECT().SetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com", mock.Anything, defaultLoginCodeTTL).
+			Run(func(ctx context.Context, channel, target string, code *LoginCode, ttl time.Duration) { stored = code }).
+			Return(nil)
+		var sent string
+		d.email.EXPECT().SendLoginCode(ctx, "alice@example.com", mock.Anything).
+			Run(func(ctx context.Context, target, code string) { sent = code }).
+			Return(nil)
+
+		require.NoError(t, d.uc.SendLoginCode(ctx, LoginCodeChannelEmail, " Alice@Example.com "))
+		require.NotNil(t, stored)
+		assert.Len(t, sent, emailCodeLength)
+		// 只保存验证码摘要
+		assert.Equal(t, hashLoginChallengeCode(sent), stored.Code)
+	})
+
+	t.Run("UnknownAccountSilentlySucceeds", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "nobody@example.com").Return(nil, nil)
+		d.userRepo.EXPECT().GetUserByEmail(ctx, "nobody@example.com").Return(nil, ErrUserNotFound)
+
+		assert.NoError(t, d.uc.SendLoginCode(ctx, LoginCodeChannelEmail, "nobody@example.com"))
+	})
+
+	t.Run("TooFrequent", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").
+			Return(&LoginCode{CreatedAt: d.clock.Now().Add(-30 * time.Second)}, nil)
+
+		assert.Equal(t, ErrVerificationTooFrequent, d.uc.SendLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com"))
+	})
+
+	t.Run("SMSDisabledByDefault", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		assert.Equal(t, ErrLoginCodeChannelUnsupported, d.uc.SendLoginCode(ctx, LoginCodeChannelSMS, "13800138000"))
+	})
+
+	t.Run("SMS", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t, LoginCodeChannelEmail, LoginCodeChannelSMS)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelSMS, "13800138000").Return(nil, nil)
+		d.userRepo.EXPECT().GetUserByPhone(ctx, "13800138000").Return(&User{ID: 1}, nil)
+		d.repo.EXPECT().SetLoginCode(ctx, LoginCodeChannelSMS, "13800138000", mock.Anything, mock.Anything).Return(nil)
+		d.sms.EXPECT().SendLoginCode(ctx, "13800138000", mock.Anything).Return(nil)
+
+		assert.NoError(t, d.uc.SendLoginCode(ctx, LoginCodeChannelSMS, "138-0013-8000"))
+	})
+}
+
+func TestCodeLoginUsecase_LoginWithCode(t *testing.T) {
+	ctx := context.Background()
+	tokens := &auth.TokenPair{AccessToken: "access"}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		user := &User{ID: 1}
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").
+			Return(&LoginCode{Code: hashLoginChallengeCode("123456"), CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrLoginCodeAttempts(ctx, LoginCodeChannelEmail, "alice@example.com", defaultLoginCodeTTL).Return(1, nil)
+		d.userRepo.EXPECT().GetUserByEmail(ctx, "alice@example.com").Return(user, nil)
+		d.issuer.EXPECT().IssueLogin(ctx, user, "").Return(tokens, nil)
+		d.repo.EXPECT().DeleteLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").Return(nil)
+
+		pair, got, err := d.uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "123456", "")
+		require.NoError(t, err)
+		assert.Equal(t, tokens, pair)
+		assert.Equal(t, user, got)
+	})
+
+	t.Run("ChallengeKeepsCode", func(t *testing.T) {
+		// 异常登录需额外验证时验证码保留，便于携带邮箱验证码重试
+		d := newCodeLoginTestDeps(t)
+		user := &User{ID: 1}
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").
+			Return(&LoginCode{Code: hashLoginChallengeCode("123456"), CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrLoginCodeAttempts(ctx, LoginCodeChannelEmail, "alice@example.com", defaultLoginCodeTTL).Return(1, nil)
+		d.userRepo.EXPECT().GetUserByEmail(ctx, "alice@example.com").Return(user, nil)
+		d.issuer.EXPECT().IssueLogin(ctx, user, "").Return(nil, ErrLoginChallengeRequired)
+
+		_, _, err := d.uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "123456", "")
+		assert.Equal(t, ErrLoginChallengeRequired, err)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		created := d.clock.Now()
+		d.clock.Advance(time.Minute)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").
+			Return(&LoginCode{Code: hashLoginChallengeCode("123456"), CreatedAt: created}, nil)
+		d.repo.EXPECT().IncrLoginCodeAttempts(ctx, LoginCodeChannelEmail, "alice@example.com", defaultLoginCodeTTL-time.Minute).Return(1, nil)
+
+		_, _, err := d.uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "000000", "")
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("LastAttemptDeletesCode", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").
+			Return(&LoginCode{Code: hashLoginChallengeCode("123456"), CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrLoginCodeAttempts(ctx, LoginCodeChannelEmail, "alice@example.com", defaultLoginCodeTTL).Return(defaultLoginCodeMaxAttempts, nil)
+		d.repo.EXPECT().DeleteLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").Return(nil)
+
+		_, _, err := d.uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "000000", "")
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("AttemptsExhausted", func(t *testing.T) {
+		// 次数用尽后正确的验证码也不再通过
+		d := newCodeLoginTestDeps(t)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").
+			Return(&LoginCode{Code: hashLoginChallengeCode("123456"), CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrLoginCodeAttempts(ctx, LoginCodeChannelEmail, "alice@example.com", defaultLoginCodeTTL).Return(defaultLoginCodeMaxAttempts+1, nil)
+
+		_, _, err := d.uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "123456", "")
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("ConcurrentWrongGuesses", func(t *testing.T) {
+		// 并发猜测共享同一个计数，只有前 maxAttempts 次请求会比较验证码
+		d := newCodeLoginTestDeps(t)
+		pending := &LoginCode{Code: hashLoginChallengeCode("123456"), CreatedAt: d.clock.Now()}
+		var attempts, compared int32
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").Return(pending, nil)
+		d.repo.EXPECT().IncrLoginCodeAttempts(ctx, LoginCodeChannelEmail, "alice@example.com", defaultLoginCodeTTL).
+			RunAndReturn(func(context.Context, string, string, time.Duration) (int, error) {
+				n := int(atomic.AddInt32(&attempts, 1))
+				if n <= defaultLoginCodeMaxAttempts {
+					atomic.AddInt32(&compared, 1)
+				}
+				return n, nil
+			})
+		d.repo.EXPECT().DeleteLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").Return(nil).Once()
+
+		var wg sync.WaitGroup
+		for i := 0; i < defaultLoginCodeMaxAttempts*4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, err := d.uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "000000", "")
+				assert.Equal(t, ErrVerificationCodeInvalid, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(defaultLoginCodeMaxAttempts), compared)
+	})
+
+	t.Run("NoPendingCode", func(t *testing.T) {
+		d := newCodeLoginTestDeps(t)
+		d.repo.EXPECT().GetLoginCode(ctx, LoginCodeChannelEmail, "alice@example.com").Return(nil, nil)
+
+		_, _, err := d.uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "123456", "")
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		uc := NewCodeLoginUsecase(nil, nil, nil, nil, &conf.Business{}, clock.NewFake(time.Now()), log.DefaultLogger)
+		_, _, err := uc.LoginWithCode(ctx, LoginCodeChannelEmail, "alice@example.com", "123456", "")
+		assert.Equal(t, ErrLoginCodeChannelUnsupported, err)
+	})
+}
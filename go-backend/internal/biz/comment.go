@@ -0,0 +1,322 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/richtext"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrCommentNotFound      = errors.NotFound(v1.ErrorCode_COMMENT_NOT_EXIST.String(), "comment not found")
+	ErrCommentVideoMismatch = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "comment does not belong to the video")
+)
+
+// 评论状态
+const (
+	CommentStatusNormal  int32 = 1
+	CommentStatusDeleted int32 = 2
+	CommentStatusHidden  int32 = 3 // 作者账号注销冷静期内或被封禁时隐藏
+	CommentStatusReview  int32 = 4 // 内容疑似违规，等待人工复核，仅评论作者可见
+)
+
+// Comment is a Comment model.
+type Comment struct {
+	ID      int64
+	VideoID int64
+	UserID  int64
+	// ParentID 所属一级评论ID，0表示一级评论。回复的回复统一挂到一级评论下
+	ParentID   int64
+	Content    string
+	LikeCount  int64
+	ReplyCount int64
+	Status     int32
+	// Hearted 视频作者点了小红心
+	Hearted   bool
+	CreatedAt time.Time
+	// Collapsed 按折叠规则默认折叠，只在列表查询时计算
+	Collapsed bool
+}
+
+// CommentFilter 评论列表过滤条件
+type CommentFilter struct {
+	// ViewerID 当前查看者，被屏蔽的评论仍对其作者本人可见
+	ViewerID int64
+	// MutedKeywords 视频作者设置的屏蔽词，包含任一屏蔽词的评论会被隐藏
+	MutedKeywords []string
+	// VideoAuthorID 视频作者，其评论不参与折叠
+	VideoAuthorID int64
+}
+
+// CommentRepo is a Comment repo.
+type CommentRepo interface {
+	// CreateComment 创建评论并在事务内更新视频评论数和父评论回复数，最后一个参数为视频作者ID
+	CreateComment(context.Context, *Comment, int64) (*Comment, error)
+	// GetComment 获取未删除的评论
+	GetComment(context.Context, int64) (*Comment, error)
+	// DeleteComment 软删除评论，删除一级评论时一并删除其回复
+	DeleteComment(context.Context, *Comment) error
+	// ListComments 分页获取视频的一级评论，按时间倒序
+	ListComments(context.Context, int64, *CommentFilter, int32, int32) ([]*Comment, int64, error)
+	// ListReplies 分页获取一级评论下的回复，按时间正序
+	ListReplies(context.Context, int64, *CommentFilter, int32, int32) ([]*Comment, int64, error)
+	// SetCommentHearted 设置或取消视频作者的小红心，评论不存在时返回 ErrCommentNotFound
+	SetCommentHearted(context.Context, int64, bool) error
+	// ListPendingComments 分页获取待复核的评论，按提交时间正序
+	ListPendingComments(context.Context, int32, int32) ([]*Comment, int64, error)
+	// ReviewComment 通过或拒绝待复核的评论，通过时在事务内计入评论数，评论不处于待复核状态时返回 ErrCommentNotFound
+	ReviewComment(context.Context, int64, bool) (*Comment, error)
+}
+
+// CommentUsecase is a Comment usecase.
+type CommentUsecase struct {
+	repo         CommentRepo
+	videoRepo    VideoRepo
+	mutedRepo    MutedKeywordRepo
+	permissionUc *PermissionUsecase
+	relationUc   *RelationUsecase
+	moderation   *ContentModerationUsecase
+	folding      *CommentFoldingPolicy
+	log          *log.Helper
+}
+
+// NewCommentUsecase new a Comment usecase.
+func NewCommentUsecase(repo CommentRepo, videoRepo VideoRepo, mutedRepo MutedKeywordRepo, permissionUc *PermissionUsecase, relationUc *RelationUsecase, moderation *ContentModerationUsecase, businessConfig *conf.Business, logger log.Logger) *CommentUsecase {
+	return &CommentUsecase{
+		repo:         repo,
+		videoRepo:    videoRepo,
+		mutedRepo:    mutedRepo,
+		permissionUc: permissionUc,
+		relationUc:   relationUc,
+		moderation:   moderation,
+		folding:      NewCommentFoldingPolicy(businessConfig),
+		log:          log.NewHelper(logger),
+	}
+}
+
+// CreateComment posts a comment or a reply. 无权查看的视频按不存在处理
+func (uc *CommentUsecase) CreateComment(ctx context.Context, userID, videoID, parentID int64, content string) (*Comment, error) {
+	uc.log.WithContext(ctx).Infof("User %d comments on video %d", userID, videoID)
+
+	// 清理并校验评论内容
+	text, err := richtext.Parse(content, richtext.DefaultLimits)
+	if err != nil {
+		return nil, errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), err.Error())
+	}
+	if err := security.ValidateComment(text.Plain); err != nil {
+		return nil, errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), err.Error())
+	}
+
+	// 违规内容直接拒绝，疑似违规的评论转人工复核
+	flagged, err := uc.moderation.Check(ctx, text.Plain)
+	if err != nil {
+		return nil, err
+	}
+	status := CommentStatusNormal
+	if flagged {
+		status = CommentStatusReview
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.relationUc.CheckVideoVisible(ctx, userID, video); err != nil {
+		return nil, err
+	}
+
+	if parentID > 0 {
+		parent, err := uc.repo.GetComment(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.VideoID != videoID {
+			return nil, ErrCommentVideoMismatch
+		}
+		// 只保留两级结构
+		if parent.ParentID > 0 {
+			parentID = parent.ParentID
+		}
+	}
+
+	return uc.repo.CreateComment(ctx, &Comment{
+		VideoID:  videoID,
+		UserID:   userID,
+		ParentID: parentID,
+		Content:  text.Plain,
+		Status:   status,
+	}, video.AuthorID)
+}
+
+// DeleteComment deletes a comment. The comment owner, the video author and users
+// with comment delete permission are allowed.
+func (uc *CommentUsecase) DeleteComment(ctx context.Context, userID, videoID, commentID int64) error {
+	uc.log.WithContext(ctx).Infof("User %d deletes comment %d", userID, commentID)
+
+	comment, err := uc.repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if videoID > 0 && comment.VideoID != videoID {
+		return ErrCommentVideoMismatch
+	}
+
+	if err := uc.checkDeletePermission(ctx, userID, comment); err != nil {
+		return err
+	}
+
+	return uc.repo.DeleteComment(ctx, comment)
+}
+
+// GetCommentList gets root comments of a video visible to the viewer.
+func (uc *CommentUsecase) GetCommentList(ctx context.Context, viewerID, videoID int64, page, size int32) ([]*Comment, int64, error) {
+	filter, err := uc.buildFilter(ctx, viewerID, videoID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, size = NormalizePage(page, size)
+	comments, total, err := uc.repo.ListComments(ctx, videoID, filter, page, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	uc.folding.Apply(comments, filter.VideoAuthorID, viewerID)
+	return comments, total, nil
+}
+
+// GetCommentReplies gets replies of a root comment visible to the viewer.
+func (uc *CommentUsecase) GetCommentReplies(ctx context.Context, viewerID, commentID int64, page, size int32) ([]*Comment, int64, error) {
+	comment, err := uc.repo.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rootID := comment.ID
+	if comment.ParentID > 0 {
+		rootID = comment.ParentID
+	}
+
+	filter, err := uc.buildFilter(ctx, viewerID, comment.VideoID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, size = NormalizePage(page, size)
+	return uc.repo.ListReplies(ctx, rootID, filter, page, size)
+}
+
+// HeartComment 视频作者为评论点或取消小红心
+func (uc *CommentUsecase) HeartComment(ctx context.Context, userID, commentID int64, hearted bool) error {
+	uc.log.WithContext(ctx).Infof("User %d sets heart=%t on comment %d", userID, hearted, commentID)
+
+	comment, err := uc.repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, comment.VideoID)
+	if err != nil {
+		return err
+	}
+	if video.AuthorID != userID {
+		return ErrPermissionDenied
+	}
+
+	if comment.Hearted == hearted {
+		return nil
+	}
+	return uc.repo.SetCommentHearted(ctx, commentID, hearted)
+}
+
+// ListPendingComments lists comments flagged by content moderation and waiting for review.
+func (uc *CommentUsecase) ListPendingComments(ctx context.Context, moderatorID int64, page, size int32) ([]*Comment, int64, error) {
+	if err := uc.checkModerator(ctx, moderatorID); err != nil {
+		return nil, 0, err
+	}
+
+	page, size = NormalizePage(page, size)
+	return uc.repo.ListPendingComments(ctx, page, size)
+}
+
+// ReviewComment approves or rejects a flagged comment. Rejected comments are deleted.
+func (uc *CommentUsecase) ReviewComment(ctx context.Context, moderatorID, commentID int64, action int32) error {
+	if err := uc.checkModerator(ctx, moderatorID); err != nil {
+		return err
+	}
+
+	var approve bool
+	switch action {
+	case AuditActionApprove:
+		approve = true
+	case AuditActionReject:
+		approve = false
+	default:
+		return ErrInvalidAuditAction
+	}
+
+	uc.log.WithContext(ctx).Infof("Moderator %d reviews comment %d: approve=%t", moderatorID, commentID, approve)
+
+	_, err := uc.repo.ReviewComment(ctx, commentID, approve)
+	return err
+}
+
+func (uc *CommentUsecase) checkModerator(ctx context.Context, userID int64) error {
+	allowed, err := uc.permissionUc.CanModerateContent(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// buildFilter 加载视频作者的屏蔽词，访问者无权查看视频时按视频不存在返回
+func (uc *CommentUsecase) buildFilter(ctx context.Context, viewerID, videoID int64) (*CommentFilter, error) {
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.relationUc.CheckVideoVisible(ctx, viewerID, video); err != nil {
+		return nil, err
+	}
+
+	keywords, err := uc.mutedRepo.GetMutedKeywords(ctx, video.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommentFilter{ViewerID: viewerID, MutedKeywords: keywords, VideoAuthorID: video.AuthorID}, nil
+}
+
+func (uc *CommentUsecase) checkDeletePermission(ctx context.Context, userID int64, comment *Comment) error {
+	// 评论者和视频作者通过 comment:delete:own 授权，审核员和管理员拥有无条件删除权限
+	allowed, err := uc.permissionUc.CheckResourcePermission(ctx, userID, ResourceComment, domain.ActionDelete, comment.ID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// NormalizePage 规范化分页参数，页码默认1，每页数量超出范围时默认20
+func NormalizePage(page, size int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 20
+	}
+	return page, size
+}
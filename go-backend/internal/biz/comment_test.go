@@ -0,0 +1,391 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type commentTestDeps struct {
+	repo           *MockCommentRepo
+	videoRepo      *MockVideoRepo
+	mutedRepo      *MockMutedKeywordRepo
+	permissionRepo *MockPermissionRepo
+	ownershipRepo  *MockOwnershipRepo
+	roleRepo       *MockRoleRepo
+	uc             *CommentUsecase
+}
+
+func newCommentTestDeps(t *testing.T) *commentTestDeps {
+	repo := NewMockCommentRepo(t)
+	videoRepo := NewMockVideoRepo(t)
+	mutedRepo := NewMockMutedKeywordRepo(t)
+	permissionRepo := NewMockPermissionRepo(t)
+	ownershipRepo := NewMockOwnershipRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, permissionRepo, auth.NewMemoryRBACManager(), NewOwnershipResolvers(ownershipRepo), log.DefaultLogger)
+	moderation := NewContentModerationUsecase(nil, &conf.Business{
+		Moderation: &conf.Business_Moderation{BlockWords: []string{"赌博"}, ReviewWords: []string{"加微信"}},
+	}, log.DefaultLogger)
+
+	return &commentTestDeps{
+		repo:           repo,
+		videoRepo:      videoRepo,
+		mutedRepo:      mutedRepo,
+		permissionRepo: permissionRepo,
+		ownershipRepo:  ownershipRepo,
+		roleRepo:       roleRepo,
+		uc:             NewCommentUsecase(repo, videoRepo, mutedRepo, permissionUc, newTestRelationUsecase(t), moderation, &conf.Business{}, log.DefaultLogger),
+	}
+}
+
+func TestCommentUsecase_CreateComment(t *testing.T) {
+	ctx := context.Background()
+	video := &domain.Video{ID: 10, AuthorID: 2}
+
+	t.Run("RootComment", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil)
+		d.repo.EXPECT().CreateComment(ctx, mock.MatchedBy(func(c *Comment) bool {
+			return c.VideoID == 10 && c.UserID == 1 && c.ParentID == 0 && c.Content == "nice #go"
+		}), int64(2)).Return(&Comment{ID: 100, VideoID: 10, UserID: 1, Content: "nice #go"}, nil)
+
+		comment, err := d.uc.CreateComment(ctx, 1, 10, 0, "  <b>nice</b> #go ")
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), comment.ID)
+	})
+
+	t.Run("ReplyToReplyAttachesToRoot", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil)
+		d.repo.EXPECT().GetComment(ctx, int64(101)).Return(&Comment{ID: 101, VideoID: 10, ParentID: 100}, nil)
+		d.repo.EXPECT().CreateComment(ctx, mock.MatchedBy(func(c *Comment) bool {
+			return c.ParentID == 100
+		}), int64(2)).Return(&Comment{ID: 102, ParentID: 100}, nil)
+
+		comment, err := d.uc.CreateComment(ctx, 1, 10, 101, "reply")
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), comment.ParentID)
+	})
+
+	t.Run("ParentOnOtherVideo", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil)
+		d.repo.EXPECT().GetComment(ctx, int64(200)).Return(&Comment{ID: 200, VideoID: 11}, nil)
+
+		_, err := d.uc.CreateComment(ctx, 1, 10, 200, "reply")
+
+		assert.Equal(t, ErrCommentVideoMismatch, err)
+	})
+
+	t.Run("PrivateVideo", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).
+			Return(&domain.Video{ID: 10, AuthorID: 2, Visibility: domain.VideoVisibilityPrivate}, nil)
+
+		_, err := d.uc.CreateComment(ctx, 1, 10, 0, "nice")
+
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+
+	t.Run("FlaggedForReview", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil)
+		d.repo.EXPECT().CreateComment(ctx, mock.MatchedBy(func(c *Comment) bool {
+			return c.Status == CommentStatusReview
+		}), int64(2)).Return(&Comment{ID: 103, Status: CommentStatusReview}, nil)
+
+		comment, err := d.uc.CreateComment(ctx, 1, 10, 0, "资源请加微信")
+
+		require.NoError(t, err)
+		assert.Equal(t, CommentStatusReview, comment.Status)
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		_, err := d.uc.CreateComment(ctx, 1, 10, 0, "一起来赌博")
+
+		assert.ErrorIs(t, err, ErrContentViolation)
+	})
+
+	t.Run("EmptyAfterSanitize", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		_, err := d.uc.CreateComment(ctx, 1, 10, 0, "<p></p>")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("TooLong", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		_, err := d.uc.CreateComment(ctx, 1, 10, 0, strings.Repeat("a", 501))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCommentUsecase_DeleteComment(t *testing.T) {
+	ctx := context.Background()
+	comment := &Comment{ID: 100, VideoID: 10, UserID: 1}
+	ownPerms := []*domain.Permission{
+		{ID: 10, Name: "comment:delete:own", Resource: "/comment", Action: "DELETE", Scope: domain.PermissionScopeOwn, Status: 1},
+	}
+
+	t.Run("Owner", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+		d.permissionRepo.EXPECT().HasPermission(ctx, int64(1), "/comment", "DELETE").Return(false, nil)
+		d.permissionRepo.EXPECT().GetUserPermissions(ctx, int64(1)).Return(ownPerms, nil)
+		d.ownershipRepo.EXPECT().GetCommentOwnerIDs(ctx, int64(100)).Return(1, 2, nil)
+		d.repo.EXPECT().DeleteComment(ctx, comment).Return(nil)
+
+		err := d.uc.DeleteComment(ctx, 1, 10, 100)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("VideoAuthor", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+		d.permissionRepo.EXPECT().HasPermission(ctx, int64(2), "/comment", "DELETE").Return(false, nil)
+		d.permissionRepo.EXPECT().GetUserPermissions(ctx, int64(2)).Return(ownPerms, nil)
+		d.ownershipRepo.EXPECT().GetCommentOwnerIDs(ctx, int64(100)).Return(1, 2, nil)
+		d.repo.EXPECT().DeleteComment(ctx, comment).Return(nil)
+
+		err := d.uc.DeleteComment(ctx, 2, 10, 100)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Moderator", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+		d.permissionRepo.EXPECT().HasPermission(ctx, int64(3), "/comment", "DELETE").Return(true, nil)
+		d.repo.EXPECT().DeleteComment(ctx, comment).Return(nil)
+
+		err := d.uc.DeleteComment(ctx, 3, 10, 100)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+		d.permissionRepo.EXPECT().HasPermission(ctx, int64(3), "/comment", "DELETE").Return(false, nil)
+		d.permissionRepo.EXPECT().GetUserPermissions(ctx, int64(3)).Return(ownPerms, nil)
+		d.ownershipRepo.EXPECT().GetCommentOwnerIDs(ctx, int64(100)).Return(1, 2, nil)
+
+		err := d.uc.DeleteComment(ctx, 3, 10, 100)
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("NoScopedPermission", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+		d.permissionRepo.EXPECT().HasPermission(ctx, int64(1), "/comment", "DELETE").Return(false, nil)
+		d.permissionRepo.EXPECT().GetUserPermissions(ctx, int64(1)).Return(nil, nil)
+
+		err := d.uc.DeleteComment(ctx, 1, 10, 100)
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("WrongVideo", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+
+		err := d.uc.DeleteComment(ctx, 1, 11, 100)
+
+		assert.Equal(t, ErrCommentVideoMismatch, err)
+	})
+}
+
+func TestCommentUsecase_GetCommentReplies(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ResolveRoot", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(101)).Return(&Comment{ID: 101, VideoID: 10, ParentID: 100}, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+		d.mutedRepo.EXPECT().GetMutedKeywords(ctx, int64(2)).Return([]string{}, nil)
+		d.repo.EXPECT().ListReplies(ctx, int64(100), &CommentFilter{ViewerID: 1, MutedKeywords: []string{}, VideoAuthorID: 2}, int32(1), int32(20)).
+			Return([]*Comment{{ID: 101}}, 1, nil)
+
+		replies, total, err := d.uc.GetCommentReplies(ctx, 1, 101, 0, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, replies, 1)
+	})
+}
+
+func TestCommentUsecase_GetCommentList(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ApplyAuthorMutedKeywords", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+		d.mutedRepo.EXPECT().GetMutedKeywords(ctx, int64(2)).Return([]string{"spam"}, nil)
+		d.repo.EXPECT().ListComments(ctx, int64(10), &CommentFilter{ViewerID: 1, MutedKeywords: []string{"spam"}, VideoAuthorID: 2}, int32(2), int32(10)).
+			Return([]*Comment{{ID: 100}}, 11, nil)
+
+		comments, total, err := d.uc.GetCommentList(ctx, 1, 10, 2, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), total)
+		assert.Len(t, comments, 1)
+	})
+
+	t.Run("CollapseLowQualityThreads", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+		d.uc.folding = NewCommentFoldingPolicy(&conf.Business{CommentFolding: &conf.Business_CommentFolding{Enabled: true}})
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+		d.mutedRepo.EXPECT().GetMutedKeywords(ctx, int64(2)).Return([]string{}, nil)
+		d.repo.EXPECT().ListComments(ctx, int64(10), mock.Anything, int32(1), int32(20)).Return([]*Comment{
+			{ID: 1, UserID: 3, Content: "great editing on this one"},
+			{ID: 2, UserID: 3, Content: "ok"},
+			{ID: 3, UserID: 3, Content: "ok", LikeCount: 2},
+			{ID: 4, UserID: 3, Content: "ok", Hearted: true},
+			{ID: 5, UserID: 2, Content: "ok"},
+			{ID: 6, UserID: 1, Content: "ok"},
+			{ID: 7, UserID: 3, Content: "free coins at https://spam.example.com"},
+		}, 7, nil)
+
+		comments, _, err := d.uc.GetCommentList(ctx, 1, 10, 0, 0)
+
+		require.NoError(t, err)
+		collapsed := make(map[int64]bool)
+		for _, c := range comments {
+			collapsed[c.ID] = c.Collapsed
+		}
+		// 短评论和带链接的评论被折叠；点赞、红心、视频作者和查看者本人的评论保留
+		assert.Equal(t, map[int64]bool{1: false, 2: true, 3: false, 4: false, 5: false, 6: false, 7: true}, collapsed)
+	})
+
+	t.Run("VideoNotFound", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(nil, utils.ErrVideoNotFound)
+
+		_, _, err := d.uc.GetCommentList(ctx, 1, 10, 1, 10)
+
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+
+	t.Run("PrivateVideo", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).
+			Return(&domain.Video{ID: 10, AuthorID: 2, Visibility: domain.VideoVisibilityPrivate}, nil)
+
+		_, _, err := d.uc.GetCommentList(ctx, 1, 10, 1, 10)
+
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+}
+
+func TestCommentUsecase_HeartComment(t *testing.T) {
+	ctx := context.Background()
+	comment := &Comment{ID: 100, VideoID: 10, UserID: 1}
+
+	t.Run("VideoAuthor", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+		d.repo.EXPECT().SetCommentHearted(ctx, int64(100), true).Return(nil)
+
+		require.NoError(t, d.uc.HeartComment(ctx, 2, 100, true))
+	})
+
+	t.Run("AlreadyInState", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(&Comment{ID: 100, VideoID: 10, Hearted: true}, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+
+		require.NoError(t, d.uc.HeartComment(ctx, 2, 100, true))
+	})
+
+	t.Run("NotVideoAuthor", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		d.repo.EXPECT().GetComment(ctx, int64(100)).Return(comment, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+
+		assert.Equal(t, ErrPermissionDenied, d.uc.HeartComment(ctx, 1, 100, true))
+	})
+}
+
+func TestCommentUsecase_ReviewComment(t *testing.T) {
+	ctx := context.Background()
+	expectModerator := func(d *commentTestDeps, userID int64, isModerator bool) {
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(false, nil)
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "moderator").Return(&domain.Role{ID: 3, Name: "moderator"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, userID, int64(3)).Return(isModerator, nil)
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		expectModerator(d, 5, true)
+		d.repo.EXPECT().ReviewComment(ctx, int64(100), true).Return(&Comment{ID: 100, Status: CommentStatusNormal}, nil)
+
+		require.NoError(t, d.uc.ReviewComment(ctx, 5, 100, AuditActionApprove))
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		expectModerator(d, 5, true)
+		d.repo.EXPECT().ReviewComment(ctx, int64(100), false).Return(&Comment{ID: 100, Status: CommentStatusDeleted}, nil)
+
+		require.NoError(t, d.uc.ReviewComment(ctx, 5, 100, AuditActionReject))
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		expectModerator(d, 5, true)
+
+		assert.Equal(t, ErrInvalidAuditAction, d.uc.ReviewComment(ctx, 5, 100, 3))
+	})
+
+	t.Run("NotModerator", func(t *testing.T) {
+		d := newCommentTestDeps(t)
+
+		expectModerator(d, 6, false)
+
+		assert.Equal(t, ErrPermissionDenied, d.uc.ReviewComment(ctx, 6, 100, AuditActionApprove))
+	})
+}
@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrContentViolation = errors.BadRequest(v1.ErrorCode_CONTENT_VIOLATION.String(), "content violates community guidelines")
+
+const (
+	defaultModerationReloadInterval  = 5 * time.Minute
+	defaultModerationExternalTimeout = 2 * time.Second
+)
+
+// SensitiveWord 数据库中维护的敏感词
+type SensitiveWord struct {
+	Word    string
+	Verdict security.ModerationVerdict
+}
+
+// SensitiveWordRepo 敏感词仓储
+type SensitiveWordRepo interface {
+	// ListSensitiveWords 获取全部敏感词
+	ListSensitiveWords(ctx context.Context) ([]*SensitiveWord, error)
+}
+
+// ContentModerationUsecase 发布内容前的自动审核。本地词表由配置和数据库合并而成并定时重新加载，
+// 配置了外部审核服务时再调用外部服务，外部服务失败只记录日志，以本地词表的结论为准
+type ContentModerationUsecase struct {
+	repo     SensitiveWordRepo
+	filter   *security.SensitiveWordFilter
+	external security.ContentModerator
+
+	configWords     map[string]security.ModerationVerdict
+	reloadInterval  time.Duration
+	externalTimeout time.Duration
+
+	log *log.Helper
+}
+
+// NewContentModerationUsecase 创建内容审核用例，数据库词表在首次 Reload 后生效
+func NewContentModerationUsecase(repo SensitiveWordRepo, businessConfig *conf.Business, logger log.Logger) *ContentModerationUsecase {
+	uc := &ContentModerationUsecase{
+		repo:            repo,
+		configWords:     make(map[string]security.ModerationVerdict),
+		reloadInterval:  defaultModerationReloadInterval,
+		externalTimeout: defaultModerationExternalTimeout,
+		log:             log.NewHelper(logger),
+	}
+
+	cfg := businessConfig.GetModeration()
+	for _, word := range cfg.GetReviewWords() {
+		uc.configWords[word] = security.ModerationReview
+	}
+	for _, word := range cfg.GetBlockWords() {
+		uc.configWords[word] = security.ModerationBlock
+	}
+	if cfg.GetReloadInterval() != nil && cfg.GetReloadInterval().AsDuration() > 0 {
+		uc.reloadInterval = cfg.GetReloadInterval().AsDuration()
+	}
+	if cfg.GetExternalTimeout() != nil && cfg.GetExternalTimeout().AsDuration() > 0 {
+		uc.externalTimeout = cfg.GetExternalTimeout().AsDuration()
+	}
+	if cfg.GetExternalUrl() != "" {
+		uc.external = security.NewRemoteModerator(cfg.GetExternalUrl(), &http.Client{Timeout: uc.externalTimeout})
+	}
+
+	uc.filter = security.NewSensitiveWordFilter(uc.configWords)
+	return uc
+}
+
+// ReloadInterval 数据库词表的重新加载间隔
+func (uc *ContentModerationUsecase) ReloadInterval() time.Duration {
+	return uc.reloadInterval
+}
+
+// Reload 从数据库重新加载词表，与配置中的词表合并，同一个词取更严格的结论
+func (uc *ContentModerationUsecase) Reload(ctx context.Context) error {
+	words, err := uc.repo.ListSensitiveWords(ctx)
+	if err != nil {
+		return err
+	}
+
+	merged := make(map[string]security.ModerationVerdict, len(uc.configWords)+len(words))
+	for word, verdict := range uc.configWords {
+		merged[word] = verdict
+	}
+	for _, word := range words {
+		if word.Verdict > merged[word.Word] {
+			merged[word.Word] = word.Verdict
+		}
+	}
+
+	uc.filter.Load(merged)
+	return nil
+}
+
+// Check 审核待发布的文本，违规时返回 ErrContentViolation，疑似违规时返回 true，由调用方转人工复核
+func (uc *ContentModerationUsecase) Check(ctx context.Context, text string) (bool, error) {
+	if strings.TrimSpace(text) == "" {
+		return false, nil
+	}
+
+	result, err := uc.filter.Moderate(ctx, text)
+	if err != nil {
+		return false, err
+	}
+
+	if result.Verdict < security.ModerationBlock && uc.external != nil {
+		externalCtx, cancel := context.WithTimeout(ctx, uc.externalTimeout)
+		external, err := uc.external.Moderate(externalCtx, text)
+		cancel()
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("external moderation failed, using local result: %v", err)
+		} else if external.Verdict > result.Verdict {
+			result = external
+		}
+	}
+
+	switch result.Verdict {
+	case security.ModerationBlock:
+		uc.log.WithContext(ctx).Infof("content blocked by moderation: hits=%v", result.Hits)
+		return false, ErrContentViolation
+	case security.ModerationReview:
+		uc.log.WithContext(ctx).Infof("content flagged for review: hits=%v", result.Hits)
+		return true, nil
+	default:
+		return false, nil
+	}
+}
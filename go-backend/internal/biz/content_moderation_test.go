@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestContentModeration 返回不含任何敏感词的审核用例
+func newTestContentModeration() *ContentModerationUsecase {
+	return NewContentModerationUsecase(nil, &conf.Business{}, log.DefaultLogger)
+}
+
+type fakeModerator struct {
+	result *security.ModerationResult
+	err    error
+}
+
+func (m *fakeModerator) Moderate(ctx context.Context, text string) (*security.ModerationResult, error) {
+	return m.result, m.err
+}
+
+func newModerationTestUsecase(t *testing.T) (*ContentModerationUsecase, *MockSensitiveWordRepo) {
+	repo := NewMockSensitiveWordRepo(t)
+	config := &conf.Business{
+		Moderation: &conf.Business_Moderation{
+			BlockWords:  []string{"赌博"},
+			ReviewWords: []string{"加微信", "兼职"},
+		},
+	}
+	return NewContentModerationUsecase(repo, config, log.DefaultLogger), repo
+}
+
+func TestContentModerationUsecase_Check(t *testing.T) {
+	ctx := context.Background()
+	uc, _ := newModerationTestUsecase(t)
+
+	flagged, err := uc.Check(ctx, "今天天气不错")
+	require.NoError(t, err)
+	assert.False(t, flagged)
+
+	flagged, err = uc.Check(ctx, "加 微 信了解一下")
+	require.NoError(t, err)
+	assert.True(t, flagged)
+
+	_, err = uc.Check(ctx, "线上赌博")
+	assert.ErrorIs(t, err, ErrContentViolation)
+}
+
+func TestContentModerationUsecase_Reload(t *testing.T) {
+	ctx := context.Background()
+	uc, repo := newModerationTestUsecase(t)
+	repo.EXPECT().ListSensitiveWords(ctx).Return([]*SensitiveWord{
+		{Word: "刷单", Verdict: security.ModerationBlock},
+		// 数据库把配置中的疑似违规词升级为违禁词
+		{Word: "兼职", Verdict: security.ModerationBlock},
+		// 更宽松的结论不覆盖配置
+		{Word: "赌博", Verdict: security.ModerationReview},
+	}, nil)
+
+	require.NoError(t, uc.Reload(ctx))
+
+	for _, text := range []string{"刷单返利", "日结兼职", "赌博"} {
+		_, err := uc.Check(ctx, text)
+		assert.ErrorIs(t, err, ErrContentViolation, text)
+	}
+
+	flagged, err := uc.Check(ctx, "加微信")
+	require.NoError(t, err)
+	assert.True(t, flagged)
+}
+
+func TestContentModerationUsecase_External(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("StricterExternalVerdict", func(t *testing.T) {
+		uc, _ := newModerationTestUsecase(t)
+		uc.external = &fakeModerator{result: &security.ModerationResult{Verdict: security.ModerationBlock, Hits: []string{"porn"}}}
+
+		_, err := uc.Check(ctx, "看起来很正常")
+		assert.ErrorIs(t, err, ErrContentViolation)
+	})
+
+	t.Run("ExternalFailureFallsBackToLocal", func(t *testing.T) {
+		uc, _ := newModerationTestUsecase(t)
+		uc.external = &fakeModerator{err: errors.New("timeout")}
+
+		flagged, err := uc.Check(ctx, "看起来很正常")
+		require.NoError(t, err)
+		assert.False(t, flagged)
+
+		flagged, err = uc.Check(ctx, "加微信")
+		require.NoError(t, err)
+		assert.True(t, flagged)
+	})
+}
@@ -0,0 +1,240 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultCounterReconcileBatchSize  = 500
+	defaultCounterReconcileMaxBatches = 20
+)
+
+// 参与校正的冗余计数
+const (
+	CounterTargetUsers  = "users"  // 用户的关注数、粉丝数和喜欢数
+	CounterTargetVideos = "videos" // 视频的点赞数
+)
+
+// CounterCorrection 一处计数偏差
+type CounterCorrection struct {
+	ID     int64
+	Column string
+	Stored int64 // 表中的冗余计数
+	Actual int64 // 从来源表重算的计数
+}
+
+// CounterReconcileBatch 单批校正结果
+type CounterReconcileBatch struct {
+	LastID      int64 // 本批最后一行的ID，下一批从其后继续
+	Scanned     int
+	Corrections []CounterCorrection
+}
+
+// CounterReconcileRepo 冗余计数校正仓储，按ID顺序分批从来源表重算计数，
+// dryRun 为 false 时修复偏差并清理相关缓存
+type CounterReconcileRepo interface {
+	// ReconcileUserCounters 校正 afterID 之后的一批用户的关注数、粉丝数和喜欢数
+	ReconcileUserCounters(ctx context.Context, afterID int64, limit int, dryRun bool) (*CounterReconcileBatch, error)
+	// ReconcileVideoCounters 校正 afterID 之后的一批视频的点赞数
+	ReconcileVideoCounters(ctx context.Context, afterID int64, limit int, dryRun bool) (*CounterReconcileBatch, error)
+}
+
+// CounterReconcileReport 单类计数一次执行的报告
+type CounterReconcileReport struct {
+	Target    string
+	FromID    int64
+	ToID      int64
+	Scanned   int
+	Corrected int
+	Completed bool // 是否已扫完全表，下次从头开始
+	DryRun    bool
+	Duration  time.Duration
+	Err       error
+}
+
+// counterReconcileTarget 一类待校正的计数及其扫描断点
+type counterReconcileTarget struct {
+	name      string
+	reconcile func(ctx context.Context, afterID int64, limit int, dryRun bool) (*CounterReconcileBatch, error)
+	cursor    int64
+}
+
+// CounterReconcileUsecase 冗余计数校正任务。关注、点赞时事务部分失败或人工修数据都会让
+// 用户表、视频表中的计数偏离关系表，任务定期全表扫描并按来源表修正。
+// 每次执行每类计数最多处理 maxBatches 批，未扫完的下次从断点继续
+type CounterReconcileUsecase struct {
+	targets    []*counterReconcileTarget
+	batchSize  int
+	maxBatches int
+	dryRun     bool
+	interval   time.Duration
+	enabled    bool
+	clock      clock.Clock
+
+	scannedCounter    metric.Int64Counter
+	correctionCounter metric.Int64Counter
+	runDuration       metric.Float64Histogram
+
+	log *log.Helper
+}
+
+// NewCounterReconcileUsecase 创建冗余计数校正任务
+func NewCounterReconcileUsecase(repo CounterReconcileRepo, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *CounterReconcileUsecase {
+	uc := &CounterReconcileUsecase{
+		targets: []*counterReconcileTarget{
+			{name: CounterTargetUsers, reconcile: repo.ReconcileUserCounters},
+			{name: CounterTargetVideos, reconcile: repo.ReconcileVideoCounters},
+		},
+		batchSize:  defaultCounterReconcileBatchSize,
+		maxBatches: defaultCounterReconcileMaxBatches,
+		clock:      clk,
+		log:        log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetCounterReconcile(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		uc.dryRun = cfg.DryRun
+		if cfg.BatchSize > 0 {
+			uc.batchSize = int(cfg.BatchSize)
+		}
+		if cfg.MaxBatches > 0 {
+			uc.maxBatches = int(cfg.MaxBatches)
+		}
+		if cfg.Interval != nil {
+			uc.interval = cfg.Interval.AsDuration()
+		}
+	}
+
+	meter := otel.Meter("go-backend/counter_reconcile")
+	uc.scannedCounter, _ = meter.Int64Counter("counter_reconcile_scanned_rows_total",
+		metric.WithDescription("Rows whose counters were recomputed"))
+	uc.correctionCounter, _ = meter.Int64Counter("counter_reconcile_corrections_total",
+		metric.WithDescription("Counter drifts found by reconciliation"))
+	uc.runDuration, _ = meter.Float64Histogram("counter_reconcile_run_duration_seconds",
+		metric.WithDescription("Counter reconciliation execution duration"), metric.WithUnit("s"))
+
+	return uc
+}
+
+// Enabled 是否启用计数校正任务
+func (uc *CounterReconcileUsecase) Enabled() bool {
+	return uc.enabled
+}
+
+// Interval 执行间隔
+func (uc *CounterReconcileUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Run 按配置执行一次校正，供调度器调用
+func (uc *CounterReconcileUsecase) Run(ctx context.Context) error {
+	reports := uc.Apply(ctx, uc.dryRun)
+
+	var failed int
+	for _, report := range reports {
+		if report.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d counter reconciliations failed", failed, len(reports))
+	}
+	return nil
+}
+
+// Apply 从各类计数的断点继续校正并返回报告
+func (uc *CounterReconcileUsecase) Apply(ctx context.Context, dryRun bool) []*CounterReconcileReport {
+	reports := make([]*CounterReconcileReport, 0, len(uc.targets))
+	for _, target := range uc.targets {
+		if ctx.Err() != nil {
+			break
+		}
+		report := uc.applyTarget(ctx, target, dryRun)
+		uc.logReport(ctx, report)
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// applyTarget 分批校正一类计数，扫完全表后断点归零
+func (uc *CounterReconcileUsecase) applyTarget(ctx context.Context, target *counterReconcileTarget, dryRun bool) *CounterReconcileReport {
+	start := uc.clock.Now()
+	report := &CounterReconcileReport{
+		Target: target.name,
+		FromID: target.cursor,
+		ToID:   target.cursor,
+		DryRun: dryRun,
+	}
+	defer func() {
+		report.Duration = uc.clock.Since(start)
+		uc.runDuration.Record(ctx, report.Duration.Seconds(), metric.WithAttributes(
+			attribute.String("target", target.name),
+			attribute.Bool("dry_run", dryRun),
+		))
+	}()
+
+	for i := 0; i < uc.maxBatches; i++ {
+		if ctx.Err() != nil {
+			report.Err = ctx.Err()
+			return report
+		}
+
+		batch, err := target.reconcile(ctx, target.cursor, uc.batchSize, dryRun)
+		if err != nil {
+			report.Err = err
+			return report
+		}
+		uc.record(ctx, target.name, batch, dryRun)
+		report.Scanned += batch.Scanned
+		report.Corrected += len(batch.Corrections)
+
+		if batch.Scanned < uc.batchSize {
+			target.cursor = 0
+			report.Completed = true
+			return report
+		}
+		target.cursor = batch.LastID
+		report.ToID = batch.LastID
+	}
+
+	return report
+}
+
+// record 记录扫描行数和各列的偏差数
+func (uc *CounterReconcileUsecase) record(ctx context.Context, target string, batch *CounterReconcileBatch, dryRun bool) {
+	uc.scannedCounter.Add(ctx, int64(batch.Scanned), metric.WithAttributes(
+		attribute.String("target", target),
+		attribute.Bool("dry_run", dryRun),
+	))
+	for _, c := range batch.Corrections {
+		uc.correctionCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("target", target),
+			attribute.String("column", c.Column),
+			attribute.Bool("dry_run", dryRun),
+		))
+		uc.log.WithContext(ctx).Warnf("counter drift: %s id=%d %s stored=%d actual=%d dry_run=%t",
+			target, c.ID, c.Column, c.Stored, c.Actual, dryRun)
+	}
+}
+
+// logReport 输出执行报告
+func (uc *CounterReconcileUsecase) logReport(ctx context.Context, report *CounterReconcileReport) {
+	if report.Err != nil {
+		uc.log.WithContext(ctx).Errorf("counter reconcile %s failed after scanning %d rows from id %d: %v",
+			report.Target, report.Scanned, report.FromID, report.Err)
+		return
+	}
+
+	uc.log.WithContext(ctx).Infof("counter reconcile %s: scanned=%d corrected=%d completed=%t dry_run=%t in %s",
+		report.Target, report.Scanned, report.Corrected, report.Completed, report.DryRun, report.Duration)
+}
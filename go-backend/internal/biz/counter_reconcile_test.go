@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newCounterReconcileTestUsecase(t *testing.T, dryRun bool) (*CounterReconcileUsecase, *MockCounterReconcileRepo) {
+	repo := NewMockCounterReconcileRepo(t)
+	config := &conf.Business{
+		CounterReconcile: &conf.Business_CounterReconcile{
+			Enabled:    true,
+			Interval:   durationpb.New(10 * time.Minute),
+			BatchSize:  2,
+			MaxBatches: 2,
+			DryRun:     dryRun,
+		},
+	}
+	return NewCounterReconcileUsecase(repo, config, clock.NewFake(time.Now()), log.DefaultLogger), repo
+}
+
+func TestCounterReconcileUsecase_Config(t *testing.T) {
+	uc, _ := newCounterReconcileTestUsecase(t, false)
+	assert.True(t, uc.Enabled())
+	assert.Equal(t, 10*time.Minute, uc.Interval())
+
+	disabled := NewCounterReconcileUsecase(NewMockCounterReconcileRepo(t), &conf.Business{}, clock.New(), log.DefaultLogger)
+	assert.False(t, disabled.Enabled())
+}
+
+func TestCounterReconcileUsecase_Apply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ResumeFromCursor", func(t *testing.T) {
+		uc, repo := newCounterReconcileTestUsecase(t, false)
+
+		// 第一次执行处理满两批后停在断点
+		repo.EXPECT().ReconcileUserCounters(ctx, int64(0), 2, false).
+			Return(&CounterReconcileBatch{LastID: 2, Scanned: 2, Corrections: []CounterCorrection{
+				{ID: 1, Column: "follow_count", Stored: 3, Actual: 1},
+			}}, nil).Once()
+		repo.EXPECT().ReconcileUserCounters(ctx, int64(2), 2, false).
+			Return(&CounterReconcileBatch{LastID: 5, Scanned: 2}, nil).Once()
+		repo.EXPECT().ReconcileVideoCounters(ctx, int64(0), 2, false).
+			Return(&CounterReconcileBatch{LastID: 1, Scanned: 1}, nil).Once()
+
+		reports := uc.Apply(ctx, false)
+		require.Len(t, reports, 2)
+		assert.Equal(t, CounterTargetUsers, reports[0].Target)
+		assert.Equal(t, 4, reports[0].Scanned)
+		assert.Equal(t, 1, reports[0].Corrected)
+		assert.Equal(t, int64(5), reports[0].ToID)
+		assert.False(t, reports[0].Completed)
+		assert.True(t, reports[1].Completed)
+
+		// 第二次从断点继续，扫完后归零
+		repo.EXPECT().ReconcileUserCounters(ctx, int64(5), 2, false).
+			Return(&CounterReconcileBatch{LastID: 6, Scanned: 1}, nil).Once()
+		repo.EXPECT().ReconcileVideoCounters(ctx, int64(0), 2, false).
+			Return(&CounterReconcileBatch{}, nil).Once()
+
+		reports = uc.Apply(ctx, false)
+		require.Len(t, reports, 2)
+		assert.Equal(t, int64(5), reports[0].FromID)
+		assert.True(t, reports[0].Completed)
+		assert.Equal(t, int64(0), uc.targets[0].cursor)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		uc, repo := newCounterReconcileTestUsecase(t, true)
+
+		repo.EXPECT().ReconcileUserCounters(ctx, int64(0), 2, true).
+			Return(&CounterReconcileBatch{LastID: 1, Scanned: 1, Corrections: []CounterCorrection{
+				{ID: 1, Column: "favorite_count", Stored: 2, Actual: 0},
+			}}, nil)
+		repo.EXPECT().ReconcileVideoCounters(ctx, int64(0), 2, true).
+			Return(&CounterReconcileBatch{}, nil)
+
+		require.NoError(t, uc.Run(ctx))
+	})
+
+	t.Run("ErrorKeepsCursor", func(t *testing.T) {
+		uc, repo := newCounterReconcileTestUsecase(t, false)
+
+		repo.EXPECT().ReconcileUserCounters(ctx, int64(0), 2, false).
+			Return(&CounterReconcileBatch{LastID: 2, Scanned: 2}, nil).Once()
+		repo.EXPECT().ReconcileUserCounters(ctx, int64(2), 2, false).
+			Return(nil, errors.New("db down")).Once()
+		repo.EXPECT().ReconcileVideoCounters(ctx, mock.Anything, 2, false).
+			Return(&CounterReconcileBatch{}, nil)
+
+		err := uc.Run(ctx)
+		require.Error(t, err)
+		assert.Equal(t, int64(2), uc.targets[0].cursor)
+	})
+}
@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// countsReconcileInterval 计数校正任务的执行间隔
+	countsReconcileInterval = time.Minute
+	// countsReconcileBatch 每次校正的用户数上限
+	countsReconcileBatch = 500
+)
+
+// UserCounts 用户关注计数快照
+type UserCounts struct {
+	FollowCount   int
+	FollowerCount int
+}
+
+// CountsRepo 关注计数仓储。计数以关注关系表为准，Redis 计数器是唯一读路径：
+// 关注和取关时原子增减并标记待校正，计数缺失时从关注关系表回源
+type CountsRepo interface {
+	// GetUserCounts 批量读取关注计数
+	GetUserCounts(ctx context.Context, userIDs []int64) (map[int64]UserCounts, error)
+	// PopDirtyUsers 取出计数发生过变化、等待校正的用户
+	PopDirtyUsers(ctx context.Context, limit int) ([]int64, error)
+	// ReconcileUserCounts 按关注关系表重算计数并写回用户表和 Redis，返回计数有偏差的用户
+	ReconcileUserCounts(ctx context.Context, userIDs []int64) ([]int64, error)
+}
+
+// CountsUsecase 关注计数用例，所有响应中的关注数和粉丝数都经由这里读取，
+// 避免用户表、关系表和各级缓存给出不一致的数字
+type CountsUsecase struct {
+	repo CountsRepo
+	log  *log.Helper
+}
+
+// NewCountsUsecase 创建关注计数用例
+func NewCountsUsecase(repo CountsRepo, logger log.Logger) *CountsUsecase {
+	return &CountsUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Apply 用计数快照覆盖用户上的关注数和粉丝数。读取失败时保留用户上已有的计数，
+// 计数展示降级不影响响应
+func (uc *CountsUsecase) Apply(ctx context.Context, users ...*User) {
+	userIDs := make([]int64, 0, len(users))
+	for _, user := range users {
+		if user != nil && user.ID > 0 {
+			userIDs = append(userIDs, user.ID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	counts, err := uc.repo.GetUserCounts(ctx, userIDs)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("get user counts failed: users=%v err=%v", userIDs, err)
+		return
+	}
+
+	for _, user := range users {
+		if user == nil {
+			continue
+		}
+		if c, ok := counts[user.ID]; ok {
+			user.FollowCount = c.FollowCount
+			user.FollowerCount = c.FollowerCount
+		}
+	}
+}
+
+// ReconcileInterval 计数校正任务的执行间隔
+func (uc *CountsUsecase) ReconcileInterval() time.Duration {
+	return countsReconcileInterval
+}
+
+// Reconcile 校正最近计数发生变化的用户
+func (uc *CountsUsecase) Reconcile(ctx context.Context) error {
+	userIDs, err := uc.repo.PopDirtyUsers(ctx, countsReconcileBatch)
+	if err != nil {
+		return err
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	drifted, err := uc.repo.ReconcileUserCounts(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if len(drifted) > 0 {
+		uc.log.WithContext(ctx).Warnf("reconciled follow counts: checked=%d drifted=%v", len(userIDs), drifted)
+	}
+	return nil
+}
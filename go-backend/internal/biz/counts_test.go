@@ -0,0 +1,85 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountsUsecase_Apply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OverlayCounts", func(t *testing.T) {
+		repo := NewMockCountsRepo(t)
+		uc := NewCountsUsecase(repo, log.DefaultLogger)
+
+		alice := &User{ID: 1, FollowCount: 7, FollowerCount: 7}
+		bob := &User{ID: 2, FollowCount: 3}
+		repo.EXPECT().GetUserCounts(ctx, []int64{1, 2}).Return(map[int64]UserCounts{
+			1: {FollowCount: 2, FollowerCount: 5},
+		}, nil)
+
+		uc.Apply(ctx, alice, nil, bob)
+		assert.Equal(t, 2, alice.FollowCount)
+		assert.Equal(t, 5, alice.FollowerCount)
+		// 没有返回计数的用户保持原样
+		assert.Equal(t, 3, bob.FollowCount)
+	})
+
+	t.Run("KeepCountsOnError", func(t *testing.T) {
+		repo := NewMockCountsRepo(t)
+		uc := NewCountsUsecase(repo, log.DefaultLogger)
+
+		user := &User{ID: 1, FollowCount: 4, FollowerCount: 6}
+		repo.EXPECT().GetUserCounts(ctx, []int64{1}).Return(nil, errors.New("redis down"))
+
+		uc.Apply(ctx, user)
+		assert.Equal(t, 4, user.FollowCount)
+		assert.Equal(t, 6, user.FollowerCount)
+	})
+
+	t.Run("NoUsers", func(t *testing.T) {
+		repo := NewMockCountsRepo(t)
+		uc := NewCountsUsecase(repo, log.DefaultLogger)
+
+		uc.Apply(ctx)
+		uc.Apply(ctx, nil, &User{})
+	})
+}
+
+func TestCountsUsecase_Reconcile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ReconcileDirtyUsers", func(t *testing.T) {
+		repo := NewMockCountsRepo(t)
+		uc := NewCountsUsecase(repo, log.DefaultLogger)
+
+		repo.EXPECT().PopDirtyUsers(ctx, countsReconcileBatch).Return([]int64{1, 2}, nil)
+		repo.EXPECT().ReconcileUserCounts(ctx, []int64{1, 2}).Return([]int64{2}, nil)
+
+		require.NoError(t, uc.Reconcile(ctx))
+	})
+
+	t.Run("NothingDirty", func(t *testing.T) {
+		repo := NewMockCountsRepo(t)
+		uc := NewCountsUsecase(repo, log.DefaultLogger)
+
+		repo.EXPECT().PopDirtyUsers(ctx, countsReconcileBatch).Return(nil, nil)
+
+		require.NoError(t, uc.Reconcile(ctx))
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		repo := NewMockCountsRepo(t)
+		uc := NewCountsUsecase(repo, log.DefaultLogger)
+
+		repo.EXPECT().PopDirtyUsers(ctx, countsReconcileBatch).Return([]int64{1}, nil)
+		repo.EXPECT().ReconcileUserCounts(ctx, []int64{1}).Return(nil, errors.New("db down"))
+
+		assert.Error(t, uc.Reconcile(ctx))
+	})
+}
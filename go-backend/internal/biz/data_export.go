@@ -0,0 +1,241 @@
+package biz
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrDataExportTooFrequent 导出间隔内重复导出
+var ErrDataExportTooFrequent = errors.New(429, v1.ErrorCode_RATE_LIMIT.String(), "data export requested too frequently, retry later")
+
+const (
+	defaultExportLinkTTL  = 24 * time.Hour
+	defaultExportInterval = 24 * time.Hour
+)
+
+// ExportProfile 导出的个人资料
+type ExportProfile struct {
+	ID              int64      `json:"id"`
+	Username        string     `json:"username"`
+	Nickname        string     `json:"nickname"`
+	Avatar          string     `json:"avatar"`
+	BackgroundImage string     `json:"background_image"`
+	Signature       string     `json:"signature"`
+	Email           string     `json:"email,omitempty"`
+	Phone           string     `json:"phone,omitempty"`
+	Timezone        string     `json:"timezone"`
+	IsPrivate       bool       `json:"is_private"`
+	LastLoginAt     *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// ExportVideo 导出的视频元数据，不包含视频文件
+type ExportVideo struct {
+	ID            int64     `json:"id"`
+	Title         string    `json:"title"`
+	Duration      float64   `json:"duration"`
+	PlayURL       string    `json:"play_url"`
+	CoverURL      string    `json:"cover_url"`
+	Status        int32     `json:"status"`
+	FavoriteCount int64     `json:"favorite_count"`
+	CommentCount  int64     `json:"comment_count"`
+	PlayCount     int64     `json:"play_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ExportRelation 导出的关注或粉丝
+type ExportRelation struct {
+	UserID    int64     `json:"user_id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportComment 导出的评论
+type ExportComment struct {
+	ID        int64     `json:"id"`
+	VideoID   int64     `json:"video_id"`
+	ParentID  int64     `json:"parent_id,omitempty"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserDataExport 用户个人数据
+type UserDataExport struct {
+	Profile   *ExportProfile
+	Videos    []*ExportVideo
+	Following []*ExportRelation
+	Followers []*ExportRelation
+	Comments  []*ExportComment
+}
+
+// DataExport 已生成的导出文件
+type DataExport struct {
+	DownloadURL string
+	ExpiresAt   time.Time
+}
+
+// DataExportRepo 个人数据导出仓储接口
+type DataExportRepo interface {
+	// GetUserDataExport 读取用户的个人资料、视频、关注、粉丝和评论，用户不存在时返回ErrUserNotFound
+	GetUserDataExport(ctx context.Context, userID int64) (*UserDataExport, error)
+	// AcquireDataExport 占用导出间隔，间隔内已导出过时返回false
+	AcquireDataExport(ctx context.Context, userID int64, interval time.Duration) (bool, error)
+	// ReleaseDataExport 导出失败时释放占用，允许立即重试
+	ReleaseDataExport(ctx context.Context, userID int64) error
+}
+
+// DataExportUsecase 个人数据导出。数据打包为 ZIP，每类数据一个 JSON 文件，
+// 上传后返回限时下载链接，链接过期后导出文件由存储删除任务清除
+type DataExportUsecase struct {
+	repo      DataExportRepo
+	deletions StorageDeletionRepo
+	storage   storage.VideoStorage
+	linkTTL   time.Duration
+	interval  time.Duration
+	clock     clock.Clock
+	log       *log.Helper
+}
+
+// NewDataExportUsecase 创建个人数据导出用例
+func NewDataExportUsecase(repo DataExportRepo, deletions StorageDeletionRepo, storage storage.VideoStorage, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *DataExportUsecase {
+	uc := &DataExportUsecase{
+		repo:      repo,
+		deletions: deletions,
+		storage:   storage,
+		linkTTL:   defaultExportLinkTTL,
+		interval:  defaultExportInterval,
+		clock:     clk,
+		log:       log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetAccountDeletion(); cfg != nil {
+		if cfg.ExportLinkTtl != nil && cfg.ExportLinkTtl.AsDuration() > 0 {
+			uc.linkTTL = cfg.ExportLinkTtl.AsDuration()
+		}
+		if cfg.ExportInterval != nil && cfg.ExportInterval.AsDuration() > 0 {
+			uc.interval = cfg.ExportInterval.AsDuration()
+		}
+	}
+
+	return uc
+}
+
+// ExportMyData 导出用户的个人数据，返回预签名下载链接
+func (uc *DataExportUsecase) ExportMyData(ctx context.Context, userID int64) (*DataExport, error) {
+	ok, err := uc.repo.AcquireDataExport(ctx, userID, uc.interval)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrDataExportTooFrequent
+	}
+
+	export, err := uc.export(ctx, userID)
+	if err != nil {
+		if rerr := uc.repo.ReleaseDataExport(ctx, userID); rerr != nil {
+			uc.log.WithContext(ctx).Warnf("release data export failed: user=%d err=%v", userID, rerr)
+		}
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("user %d exported personal data, link expires at %s", userID, export.ExpiresAt.Format(time.RFC3339))
+	return export, nil
+}
+
+func (uc *DataExportUsecase) export(ctx context.Context, userID int64) (*DataExport, error) {
+	data, err := uc.repo.GetUserDataExport(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := buildDataExportArchive(data)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, err
+	}
+	now := uc.clock.Now()
+	objectName := fmt.Sprintf("exports/%d/%s-%s.zip", userID, now.UTC().Format("20060102T150405Z"), hex.EncodeToString(suffix))
+
+	if _, err := uc.storage.Upload(ctx, objectName, bytes.NewReader(archive), int64(len(archive)),
+		&storage.UploadOptions{ContentType: "application/zip"}); err != nil {
+		return nil, fmt.Errorf("upload data export failed: %w", err)
+	}
+
+	// 先登记删除再发放链接，避免导出文件无人清理
+	expiresAt := now.Add(uc.linkTTL)
+	if err := uc.deletions.ScheduleStorageDeletions(ctx, []*StorageDeletion{{
+		ObjectName:  objectName,
+		Reason:      StorageDeletionReasonDataExport,
+		DeleteAfter: expiresAt,
+	}}); err != nil {
+		if derr := uc.storage.Delete(ctx, objectName); derr != nil {
+			uc.log.WithContext(ctx).Warnf("delete unscheduled data export failed: object=%s err=%v", objectName, derr)
+		}
+		return nil, err
+	}
+
+	url, err := uc.storage.GetPresignedURL(ctx, objectName, uc.linkTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &DataExport{DownloadURL: url, ExpiresAt: expiresAt}, nil
+}
+
+// buildDataExportArchive 按数据类别写入 JSON 文件，空列表写为 []
+func buildDataExportArchive(data *UserDataExport) ([]byte, error) {
+	files := []struct {
+		name string
+		v    interface{}
+	}{
+		{"profile.json", data.Profile},
+		{"videos.json", nonNilSlice(data.Videos)},
+		{"following.json", nonNilSlice(data.Following)},
+		{"followers.json", nonNilSlice(data.Followers)},
+		{"comments.json", nonNilSlice(data.Comments)},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range files {
+		content, err := json.MarshalIndent(file.v, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s failed: %w", file.name, err)
+		}
+		w, err := zw.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func nonNilSlice[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// presignStorage 在内存存储上补充预签名链接
+type presignStorage struct {
+	*memoryStorage
+}
+
+func (s *presignStorage) GetPresignedURL(_ context.Context, objectName string, expiry time.Duration) (string, error) {
+	return "https://cdn.example.com/" + objectName + "?expires=" + expiry.String(), nil
+}
+
+type dataExportTestDeps struct {
+	repo      *MockDataExportRepo
+	deletions *MockStorageDeletionRepo
+	storage   *presignStorage
+	clock     *clock.Fake
+	uc        *DataExportUsecase
+}
+
+func newDataExportTestDeps(t *testing.T) *dataExportTestDeps {
+	d := &dataExportTestDeps{
+		repo:      NewMockDataExportRepo(t),
+		deletions: NewMockStorageDeletionRepo(t),
+		storage:   &presignStorage{newMemoryStorage()},
+		clock:     clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	d.uc = NewDataExportUsecase(d.repo, d.deletions, d.storage, nil, d.clock, log.DefaultLogger)
+	return d
+}
+
+func readExportArchive(t *testing.T, data []byte) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func TestDataExportUsecase_ExportMyData(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newDataExportTestDeps(t)
+		d.repo.EXPECT().AcquireDataExport(ctx, int64(1), defaultExportInterval).Return(true, nil)
+		d.repo.EXPECT().GetUserDataExport(ctx, int64(1)).Return(&UserDataExport{
+			Profile:   &ExportProfile{ID: 1, Username: "alice"},
+			Videos:    []*ExportVideo{{ID: 10, Title: "first"}},
+			Following: []*ExportRelation{{UserID: 2, Username: "bob"}},
+			Comments:  []*ExportComment{{ID: 100, VideoID: 10, Content: "hi"}},
+		}, nil)
+
+		var objectName string
+		d.deletions.EXPECT().ScheduleStorageDeletions(ctx, mock.Anything).
+			Run(func(_ context.Context, deletions []*StorageDeletion) {
+				require.Len(t, deletions, 1)
+				objectName = deletions[0].ObjectName
+				assert.Equal(t, StorageDeletionReasonDataExport, deletions[0].Reason)
+				assert.Equal(t, d.clock.Now().Add(defaultExportLinkTTL), deletions[0].DeleteAfter)
+			}).Return(nil)
+
+		export, err := d.uc.ExportMyData(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(objectName, "exports/1/"))
+		assert.Equal(t, d.clock.Now().Add(defaultExportLinkTTL), export.ExpiresAt)
+		assert.Contains(t, export.DownloadURL, objectName)
+
+		files := readExportArchive(t, d.storage.objects[objectName])
+		assert.Len(t, files, 5)
+		assert.Equal(t, "[]", files["followers.json"])
+
+		var profile ExportProfile
+		require.NoError(t, json.Unmarshal([]byte(files["profile.json"]), &profile))
+		assert.Equal(t, "alice", profile.Username)
+		var videos []*ExportVideo
+		require.NoError(t, json.Unmarshal([]byte(files["videos.json"]), &videos))
+		assert.Equal(t, int64(10), videos[0].ID)
+	})
+
+	t.Run("TooFrequent", func(t *testing.T) {
+		d := newDataExportTestDeps(t)
+		d.repo.EXPECT().AcquireDataExport(ctx, int64(1), defaultExportInterval).Return(false, nil)
+
+		_, err := d.uc.ExportMyData(ctx, 1)
+		assert.Equal(t, ErrDataExportTooFrequent, err)
+	})
+
+	t.Run("ReleaseOnFailure", func(t *testing.T) {
+		d := newDataExportTestDeps(t)
+		d.repo.EXPECT().AcquireDataExport(ctx, int64(1), defaultExportInterval).Return(true, nil)
+		d.repo.EXPECT().GetUserDataExport(ctx, int64(1)).Return(&UserDataExport{Profile: &ExportProfile{ID: 1}}, nil)
+		d.deletions.EXPECT().ScheduleStorageDeletions(ctx, mock.Anything).Return(errors.New("db error"))
+		d.repo.EXPECT().ReleaseDataExport(ctx, int64(1)).Return(nil)
+
+		_, err := d.uc.ExportMyData(ctx, 1)
+		require.Error(t, err)
+		assert.Empty(t, d.storage.objects, "unscheduled export should be deleted")
+	})
+}
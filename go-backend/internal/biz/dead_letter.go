@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/listquery"
+	"go-backend/pkg/messaging"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrDeadLetterNotFound = errors.NotFound(v1.ErrorCode_DEAD_LETTER_NOT_FOUND.String(), "dead letter not found")
+	ErrDeadLetterReplayed = errors.BadRequest(v1.ErrorCode_DEAD_LETTER_REPLAYED.String(), "dead letter already replayed")
+)
+
+// 死信状态
+const (
+	DeadLetterStatusPending  int32 = 0
+	DeadLetterStatusReplayed int32 = 1
+)
+
+// DeadLetter 重试耗尽的消费消息
+type DeadLetter struct {
+	ID         int64
+	Topic      string
+	Partition  int32
+	Offset     int64
+	Key        string
+	MessageID  string
+	Payload    []byte
+	Error      string
+	Attempts   int32
+	Status     int32
+	ReplayedBy int64
+	CreatedAt  time.Time
+	ReplayedAt *time.Time
+}
+
+// DeadLetterFilter 死信查询条件
+type DeadLetterFilter struct {
+	Topic string
+	// Status 为 nil 时不过滤状态
+	Status *int32
+}
+
+// DeadLetterListSchema 死信列表可过滤和排序的字段，默认按记录时间倒序
+var DeadLetterListSchema = listquery.NewSchema("id", []listquery.Sort{{Field: "created_at", Desc: true}},
+	listquery.Field{Name: "topic", Type: listquery.String},
+	listquery.Field{Name: "partition", Type: listquery.Int},
+	listquery.Field{Name: "status", Type: listquery.Int},
+	listquery.Field{Name: "attempts", Type: listquery.Int, Sortable: true},
+	listquery.Field{Name: "message_id", Type: listquery.String, Ops: []listquery.Op{listquery.OpEq}},
+	listquery.Field{Name: "created_at", Type: listquery.Time, Sortable: true},
+	listquery.Field{Name: "replayed_at", Type: listquery.Time, Sortable: true},
+)
+
+// DeadLetterRepo 死信仓储接口
+type DeadLetterRepo interface {
+	// CreateDeadLetter 记录死信，同一主题分区偏移量重复记录时忽略
+	CreateDeadLetter(context.Context, *DeadLetter) error
+	// GetDeadLetter 获取死信，不存在时返回 ErrDeadLetterNotFound
+	GetDeadLetter(context.Context, int64) (*DeadLetter, error)
+	// ListDeadLetters 按条件和已校验的列表表达式分页查询
+	ListDeadLetters(context.Context, *DeadLetterFilter, *listquery.Compiled) ([]*DeadLetter, int64, error)
+	// MarkReplayed 标记为已重放，已重放过时返回 ErrDeadLetterReplayed
+	MarkReplayed(ctx context.Context, id, adminID int64) error
+}
+
+// DeadLetterPublisher 将死信中的原始消息重新发送到原主题
+type DeadLetterPublisher interface {
+	Republish(ctx context.Context, topic, key string, payload []byte) error
+}
+
+// DeadLetterUsecase 死信用例：消费者重试耗尽后记录死信，管理员排查原因后重放
+type DeadLetterUsecase struct {
+	repo         DeadLetterRepo
+	publisher    DeadLetterPublisher
+	permissionUc *PermissionUsecase
+	log          *log.Helper
+}
+
+// NewDeadLetterUsecase 创建死信用例
+func NewDeadLetterUsecase(repo DeadLetterRepo, publisher DeadLetterPublisher, permissionUc *PermissionUsecase, logger log.Logger) *DeadLetterUsecase {
+	return &DeadLetterUsecase{
+		repo:         repo,
+		publisher:    publisher,
+		permissionUc: permissionUc,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Record 记录死信，作为消费者的死信出口之一
+func (uc *DeadLetterUsecase) Record(ctx context.Context, event *messaging.DeadLetterEvent) error {
+	return uc.repo.CreateDeadLetter(ctx, &DeadLetter{
+		Topic:     event.Topic,
+		Partition: event.Partition,
+		Offset:    event.Offset,
+		Key:       event.Key,
+		MessageID: event.MessageID,
+		Payload:   event.Payload,
+		Error:     event.Error,
+		Attempts:  int32(event.Attempts),
+		Status:    DeadLetterStatusPending,
+	})
+}
+
+// ListDeadLetters 管理员分页查询死信
+func (uc *DeadLetterUsecase) ListDeadLetters(ctx context.Context, adminID int64, filter *DeadLetterFilter, q *listquery.Query) ([]*DeadLetter, int64, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, 0, err
+	}
+
+	compiled, err := compileListQuery(DeadLetterListSchema, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.ListDeadLetters(ctx, filter, compiled)
+}
+
+// Replay 管理员将死信重新发送到原主题。先发送再标记，标记失败时可能重复发送，
+// 消息ID保持不变，由消费者去重
+func (uc *DeadLetterUsecase) Replay(ctx context.Context, adminID, id int64) error {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	letter, err := uc.repo.GetDeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+	if letter.Status == DeadLetterStatusReplayed {
+		return ErrDeadLetterReplayed
+	}
+
+	if err := uc.publisher.Republish(ctx, letter.Topic, letter.Key, letter.Payload); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("admin %d replayed dead letter %d to %s", adminID, id, letter.Topic)
+
+	return uc.repo.MarkReplayed(ctx, id, adminID)
+}
+
+func (uc *DeadLetterUsecase) requireAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/listquery"
+	"go-backend/pkg/messaging"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type deadLetterTestDeps struct {
+	repo      *MockDeadLetterRepo
+	publisher *MockDeadLetterPublisher
+	roleRepo  *MockRoleRepo
+	uc        *DeadLetterUsecase
+}
+
+func newDeadLetterTestDeps(t *testing.T) *deadLetterTestDeps {
+	repo := NewMockDeadLetterRepo(t)
+	publisher := NewMockDeadLetterPublisher(t)
+	roleRepo := NewMockRoleRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+
+	return &deadLetterTestDeps{
+		repo:      repo,
+		publisher: publisher,
+		roleRepo:  roleRepo,
+		uc:        NewDeadLetterUsecase(repo, publisher, permissionUc, log.DefaultLogger),
+	}
+}
+
+func (d *deadLetterTestDeps) expectAdmin(ctx context.Context, userID int64, isAdmin bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(isAdmin, nil)
+}
+
+func TestDeadLetterUsecase_Record(t *testing.T) {
+	ctx := context.Background()
+	d := newDeadLetterTestDeps(t)
+
+	event := &messaging.DeadLetterEvent{
+		Topic:     "video-upload-topic",
+		Partition: 2,
+		Offset:    42,
+		Key:       "video_1",
+		Payload:   []byte(`{"id":"m1"}`),
+		MessageID: "m1",
+		Error:     "transcode failed",
+		Attempts:  3,
+	}
+	d.repo.EXPECT().CreateDeadLetter(ctx, &DeadLetter{
+		Topic:     "video-upload-topic",
+		Partition: 2,
+		Offset:    42,
+		Key:       "video_1",
+		MessageID: "m1",
+		Payload:   []byte(`{"id":"m1"}`),
+		Error:     "transcode failed",
+		Attempts:  3,
+		Status:    DeadLetterStatusPending,
+	}).Return(nil)
+
+	require.NoError(t, d.uc.Record(ctx, event))
+}
+
+func TestDeadLetterUsecase_ListDeadLetters(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Admin", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		filter := &DeadLetterFilter{Topic: "video-upload-topic"}
+		d.repo.EXPECT().ListDeadLetters(ctx, filter, &listquery.Compiled{
+			Orders: []listquery.Order{{Column: "created_at", Desc: true}, {Column: "id", Desc: true}},
+			Page:   1,
+			Size:   20,
+		}).Return([]*DeadLetter{{ID: 1}}, int64(1), nil)
+
+		letters, total, err := d.uc.ListDeadLetters(ctx, 1, filter, nil)
+
+		require.NoError(t, err)
+		assert.Len(t, letters, 1)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 7, false)
+
+		_, _, err := d.uc.ListDeadLetters(ctx, 7, &DeadLetterFilter{}, nil)
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("Expression", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().ListDeadLetters(ctx, mock.Anything, mock.MatchedBy(func(q *listquery.Compiled) bool {
+			return len(q.Conditions) == 1 && q.Conditions[0].Column == "attempts" &&
+				q.Conditions[0].Values[0] == int64(3) && q.Orders[0] == listquery.Order{Column: "attempts", Desc: true}
+		})).Return(nil, int64(0), nil)
+
+		q, err := ParseListQuery("attempts:gte:3", "-attempts", 1, 20)
+		require.NoError(t, err)
+		_, _, err = d.uc.ListDeadLetters(ctx, 1, &DeadLetterFilter{}, q)
+		require.NoError(t, err)
+	})
+
+	t.Run("FieldNotAllowed", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+
+		q, err := ParseListQuery("payload:contains:secret", "", 1, 20)
+		require.NoError(t, err)
+		_, _, err = d.uc.ListDeadLetters(ctx, 1, &DeadLetterFilter{}, q)
+		assert.ErrorContains(t, err, `unknown filter field "payload"`)
+	})
+}
+
+func TestDeadLetterUsecase_Replay(t *testing.T) {
+	ctx := context.Background()
+	letter := &DeadLetter{ID: 5, Topic: "video-upload-topic", Key: "video_1", Payload: []byte(`{"id":"m1"}`)}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().GetDeadLetter(ctx, int64(5)).Return(letter, nil)
+		d.publisher.EXPECT().Republish(ctx, "video-upload-topic", "video_1", letter.Payload).Return(nil)
+		d.repo.EXPECT().MarkReplayed(ctx, int64(5), int64(1)).Return(nil)
+
+		require.NoError(t, d.uc.Replay(ctx, 1, 5))
+	})
+
+	t.Run("AlreadyReplayed", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().GetDeadLetter(ctx, int64(5)).
+			Return(&DeadLetter{ID: 5, Status: DeadLetterStatusReplayed}, nil)
+
+		assert.Equal(t, ErrDeadLetterReplayed, d.uc.Replay(ctx, 1, 5))
+	})
+
+	t.Run("PublishFailedNotMarked", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().GetDeadLetter(ctx, int64(5)).Return(letter, nil)
+		d.publisher.EXPECT().Republish(ctx, "video-upload-topic", "video_1", letter.Payload).
+			Return(errors.New("kafka unavailable"))
+
+		assert.Error(t, d.uc.Replay(ctx, 1, 5))
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newDeadLetterTestDeps(t)
+		d.expectAdmin(ctx, 7, false)
+
+		assert.Equal(t, ErrPermissionDenied, d.uc.Replay(ctx, 7, 5))
+	})
+}
@@ -0,0 +1,305 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// 可降级的功能，按配置的顺序在异常时依次关闭
+const (
+	FeatureFeedRanking   = "feed_ranking"  // 按互动得分排序的视频流，关闭后返回按发布时间的视频流
+	FeaturePromotion     = "promotion"     // 视频流推广位，关闭后只返回自然内容
+	FeatureViewCounting  = "view_counting" // 播放计数和观看记录，关闭期间的观看不计入
+	FeatureNotifications = "notifications" // 草稿提醒等非关键通知，关闭期间的提醒在恢复后补发
+)
+
+var degradableFeatures = map[string]bool{
+	FeatureFeedRanking:   true,
+	FeaturePromotion:     true,
+	FeatureViewCounting:  true,
+	FeatureNotifications: true,
+}
+
+const (
+	defaultDegradationInterval     = 10 * time.Second
+	defaultDegradationMinRequests  = 20
+	defaultDegradationProbeTimeout = time.Second
+	defaultDegradationRecoverAfter = 3
+)
+
+// DependencyHealth 依赖探测结果
+type DependencyHealth struct {
+	Name    string
+	Latency time.Duration
+	Err     error
+}
+
+// DependencyChecker 探测存储依赖的可用性
+type DependencyChecker interface {
+	// CheckDependencies 依次探测各依赖，ctx 超时的依赖视为故障
+	CheckDependencies(ctx context.Context) []*DependencyHealth
+}
+
+// RequestLoad 一个评估间隔内的请求负载
+type RequestLoad struct {
+	Inflight   int64 // 评估时进行中的请求数
+	Requests   int64 // 间隔内完成的请求数
+	Errors     int64 // 其中的服务端错误数
+	AvgLatency time.Duration
+}
+
+// DegradationStatus 降级状态
+type DegradationStatus struct {
+	Enabled      bool
+	Order        []string // 功能降级顺序
+	Disabled     []string // 已关闭的功能，按关闭顺序
+	Reasons      []string // 最近一次评估发现的异常
+	Dependencies []*DependencyHealth
+	Load         RequestLoad
+	ChangedAt    time.Time
+	EvaluatedAt  time.Time
+}
+
+// DegradationUsecase 功能降级策略。定期根据请求负载和依赖探测结果评估健康状态，
+// 异常时每次评估按配置顺序多关闭一项功能，连续健康若干次后按相反顺序逐项恢复，
+// 避免负载在阈值附近时功能反复开关。状态保存在本实例内存中，各实例独立评估
+type DegradationUsecase struct {
+	checker      DependencyChecker
+	permissionUc *PermissionUsecase
+	enabled      bool
+	interval     time.Duration
+	order        []string
+	position     map[string]int
+	maxInflight  int64
+	maxErrorRate float64
+	minRequests  int64
+	maxLatency   time.Duration
+	probeTimeout time.Duration
+	recoverAfter int
+	clock        clock.Clock
+
+	// level 已关闭的功能数，请求路径上无锁读取
+	level atomic.Int32
+
+	inflight     atomic.Int64
+	requests     atomic.Int64
+	failures     atomic.Int64
+	latencyTotal atomic.Int64
+
+	mu            sync.Mutex
+	healthyStreak int
+	status        DegradationStatus
+
+	transitionCounter metric.Int64Counter
+
+	log *log.Helper
+}
+
+// NewDegradationUsecase 创建功能降级策略，配置中未知的功能名被忽略
+func NewDegradationUsecase(checker DependencyChecker, permissionUc *PermissionUsecase, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *DegradationUsecase {
+	uc := &DegradationUsecase{
+		checker:      checker,
+		permissionUc: permissionUc,
+		interval:     defaultDegradationInterval,
+		position:     make(map[string]int),
+		minRequests:  defaultDegradationMinRequests,
+		probeTimeout: defaultDegradationProbeTimeout,
+		recoverAfter: defaultDegradationRecoverAfter,
+		clock:        clk,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetDegradation(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		for _, feature := range cfg.Order {
+			if !degradableFeatures[feature] {
+				uc.log.Warnf("unknown degradable feature %q ignored", feature)
+				continue
+			}
+			if _, ok := uc.position[feature]; ok {
+				continue
+			}
+			uc.position[feature] = len(uc.order)
+			uc.order = append(uc.order, feature)
+		}
+		uc.maxInflight = cfg.MaxInflight
+		uc.maxErrorRate = cfg.MaxErrorRate
+		if cfg.MinRequests > 0 {
+			uc.minRequests = cfg.MinRequests
+		}
+		if cfg.MaxLatency != nil {
+			uc.maxLatency = cfg.MaxLatency.AsDuration()
+		}
+		if cfg.Interval != nil && cfg.Interval.AsDuration() > 0 {
+			uc.interval = cfg.Interval.AsDuration()
+		}
+		if cfg.ProbeTimeout != nil && cfg.ProbeTimeout.AsDuration() > 0 {
+			uc.probeTimeout = cfg.ProbeTimeout.AsDuration()
+		}
+		if cfg.RecoverAfter > 0 {
+			uc.recoverAfter = int(cfg.RecoverAfter)
+		}
+	}
+	uc.status.Enabled = uc.enabled
+	uc.status.Order = uc.order
+
+	meter := otel.Meter("go-backend/degradation")
+	uc.transitionCounter, _ = meter.Int64Counter("degradation_transitions_total",
+		metric.WithDescription("Features disabled or re-enabled by the degradation policy"))
+
+	return uc
+}
+
+// Enabled 是否启用降级评估
+func (uc *DegradationUsecase) Enabled() bool {
+	return uc.enabled
+}
+
+// Interval 评估间隔
+func (uc *DegradationUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Allow 功能当前是否可用，未参与降级的功能始终可用
+func (uc *DegradationUsecase) Allow(feature string) bool {
+	pos, ok := uc.position[feature]
+	if !ok {
+		return true
+	}
+	return pos >= int(uc.level.Load())
+}
+
+// Guard 包装后台任务，功能关闭期间跳过执行
+func (uc *DegradationUsecase) Guard(feature string, run func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if !uc.Allow(feature) {
+			uc.log.WithContext(ctx).Debugf("feature %s degraded, skip run", feature)
+			return nil
+		}
+		return run(ctx)
+	}
+}
+
+// TrackRequest 记录一个请求开始，返回的函数在请求结束时调用
+func (uc *DegradationUsecase) TrackRequest() func(err error) {
+	start := uc.clock.Now()
+	uc.inflight.Add(1)
+	return func(err error) {
+		uc.inflight.Add(-1)
+		uc.requests.Add(1)
+		uc.latencyTotal.Add(int64(uc.clock.Since(start)))
+		if err != nil && errors.FromError(err).Code >= 500 {
+			uc.failures.Add(1)
+		}
+	}
+}
+
+// Evaluate 评估一次健康状态并调整降级级别，供调度器调用
+func (uc *DegradationUsecase) Evaluate(ctx context.Context) error {
+	probeCtx, cancel := context.WithTimeout(ctx, uc.probeTimeout)
+	dependencies := uc.checker.CheckDependencies(probeCtx)
+	cancel()
+
+	load := uc.drainLoad()
+	reasons := uc.detect(dependencies, load)
+	now := uc.clock.Now()
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	level := int(uc.level.Load())
+	if len(reasons) > 0 {
+		uc.healthyStreak = 0
+		if level < len(uc.order) {
+			feature := uc.order[level]
+			uc.level.Store(int32(level + 1))
+			uc.status.ChangedAt = now
+			uc.transitionCounter.Add(ctx, 1, metric.WithAttributes(
+				attribute.String("feature", feature), attribute.String("action", "disable")))
+			uc.log.WithContext(ctx).Warnf("feature degraded: feature=%s reasons=%v", feature, reasons)
+		}
+	} else {
+		uc.healthyStreak++
+		if level > 0 && uc.healthyStreak >= uc.recoverAfter {
+			feature := uc.order[level-1]
+			uc.level.Store(int32(level - 1))
+			uc.healthyStreak = 0
+			uc.status.ChangedAt = now
+			uc.transitionCounter.Add(ctx, 1, metric.WithAttributes(
+				attribute.String("feature", feature), attribute.String("action", "enable")))
+			uc.log.WithContext(ctx).Infof("feature recovered: feature=%s", feature)
+		}
+	}
+
+	uc.status.Reasons = reasons
+	uc.status.Dependencies = dependencies
+	uc.status.Load = load
+	uc.status.EvaluatedAt = now
+	return nil
+}
+
+// GetStatus 查询本实例的降级状态，仅管理员可用
+func (uc *DegradationUsecase) GetStatus(ctx context.Context, adminID int64) (*DegradationStatus, error) {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, ErrPermissionDenied
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	status := uc.status
+	status.Disabled = append([]string(nil), uc.order[:uc.level.Load()]...)
+	return &status, nil
+}
+
+// drainLoad 读取并清零评估间隔内的请求统计
+func (uc *DegradationUsecase) drainLoad() RequestLoad {
+	load := RequestLoad{
+		Inflight: uc.inflight.Load(),
+		Requests: uc.requests.Swap(0),
+		Errors:   uc.failures.Swap(0),
+	}
+	latencyTotal := uc.latencyTotal.Swap(0)
+	if load.Requests > 0 {
+		load.AvgLatency = time.Duration(latencyTotal / load.Requests)
+	}
+	return load
+}
+
+// detect 返回超出阈值的信号，为空表示健康
+func (uc *DegradationUsecase) detect(dependencies []*DependencyHealth, load RequestLoad) []string {
+	var reasons []string
+	for _, dep := range dependencies {
+		if dep.Err != nil {
+			reasons = append(reasons, fmt.Sprintf("%s unavailable: %v", dep.Name, dep.Err))
+		}
+	}
+	if uc.maxInflight > 0 && load.Inflight > uc.maxInflight {
+		reasons = append(reasons, fmt.Sprintf("inflight requests %d exceed %d", load.Inflight, uc.maxInflight))
+	}
+	if uc.maxErrorRate > 0 && load.Requests >= uc.minRequests {
+		if rate := float64(load.Errors) / float64(load.Requests); rate > uc.maxErrorRate {
+			reasons = append(reasons, fmt.Sprintf("error rate %.3f exceeds %.3f", rate, uc.maxErrorRate))
+		}
+	}
+	if uc.maxLatency > 0 && load.AvgLatency > uc.maxLatency {
+		reasons = append(reasons, fmt.Sprintf("average latency %s exceeds %s", load.AvgLatency, uc.maxLatency))
+	}
+	return reasons
+}
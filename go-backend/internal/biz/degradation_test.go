@@ -0,0 +1,191 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// newTestDegradation 未启用降级评估的策略，所有功能始终可用
+func newTestDegradation() *DegradationUsecase {
+	return NewDegradationUsecase(nil, nil, &conf.Business{}, clock.New(), log.DefaultLogger)
+}
+
+type degradationTestDeps struct {
+	checker  *MockDependencyChecker
+	roleRepo *MockRoleRepo
+	clock    *clock.Fake
+	uc       *DegradationUsecase
+}
+
+func newDegradationTestDeps(t *testing.T) *degradationTestDeps {
+	d := &degradationTestDeps{
+		checker:  NewMockDependencyChecker(t),
+		roleRepo: NewMockRoleRepo(t),
+		clock:    clock.NewFake(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)),
+	}
+	permissionUc := NewPermissionUsecase(d.roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+	config := &conf.Business{Degradation: &conf.Business_Degradation{
+		Enabled:      true,
+		Order:        []string{FeatureFeedRanking, "unknown", FeaturePromotion, FeatureFeedRanking, FeatureViewCounting},
+		MaxInflight:  2,
+		MaxErrorRate: 0.5,
+		MinRequests:  2,
+		MaxLatency:   durationpb.New(time.Second),
+		RecoverAfter: 2,
+	}}
+	d.uc = NewDegradationUsecase(d.checker, permissionUc, config, d.clock, log.DefaultLogger)
+	return d
+}
+
+func healthyDependencies() []*DependencyHealth {
+	return []*DependencyHealth{{Name: "mysql"}, {Name: "redis"}}
+}
+
+func (d *degradationTestDeps) allowed() []string {
+	var features []string
+	for _, feature := range []string{FeatureFeedRanking, FeaturePromotion, FeatureViewCounting, FeatureNotifications} {
+		if d.uc.Allow(feature) {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+func TestDegradationUsecase_Evaluate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DegradeInOrderAndRecover", func(t *testing.T) {
+		d := newDegradationTestDeps(t)
+		failing := []*DependencyHealth{{Name: "mysql", Err: errors.New("connection refused")}, {Name: "redis"}}
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return(failing).Times(4)
+
+		// 每次异常评估多关闭一项功能，全部关闭后保持
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.Equal(t, []string{FeaturePromotion, FeatureViewCounting, FeatureNotifications}, d.allowed())
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.Equal(t, []string{FeatureViewCounting, FeatureNotifications}, d.allowed())
+		require.NoError(t, d.uc.Evaluate(ctx))
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.Equal(t, []string{FeatureNotifications}, d.allowed())
+
+		// 连续健康两次恢复一项，按关闭的相反顺序
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return(healthyDependencies())
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.Equal(t, []string{FeatureNotifications}, d.allowed())
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.Equal(t, []string{FeatureViewCounting, FeatureNotifications}, d.allowed())
+		require.NoError(t, d.uc.Evaluate(ctx))
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.Equal(t, []string{FeaturePromotion, FeatureViewCounting, FeatureNotifications}, d.allowed())
+	})
+
+	t.Run("UnhealthyResetsRecovery", func(t *testing.T) {
+		d := newDegradationTestDeps(t)
+		failing := []*DependencyHealth{{Name: "redis", Err: context.DeadlineExceeded}}
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return(failing).Once()
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return(healthyDependencies()).Once()
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return(failing).Once()
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return(healthyDependencies()).Once()
+
+		for i := 0; i < 4; i++ {
+			require.NoError(t, d.uc.Evaluate(ctx))
+		}
+		assert.False(t, d.uc.Allow(FeatureFeedRanking))
+		assert.False(t, d.uc.Allow(FeaturePromotion))
+	})
+
+	t.Run("RequestLoad", func(t *testing.T) {
+		d := newDegradationTestDeps(t)
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return(healthyDependencies())
+
+		// 单个慢请求未达到检查错误率的请求数，只有平均耗时超限
+		done := d.uc.TrackRequest()
+		d.clock.Advance(3 * time.Second)
+		done(kerrors.InternalServer("SERVER_ERROR", "boom"))
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.False(t, d.uc.Allow(FeatureFeedRanking))
+
+		// 客户端错误不计入错误率，统计在评估后清零
+		for i := 0; i < 2; i++ {
+			d.uc.TrackRequest()(kerrors.BadRequest("PARAM_ERROR", "bad"))
+		}
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.True(t, d.uc.Allow(FeaturePromotion))
+
+		for i := 0; i < 2; i++ {
+			d.uc.TrackRequest()(kerrors.ServiceUnavailable("SERVER_ERROR", "unavailable"))
+		}
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.False(t, d.uc.Allow(FeaturePromotion))
+
+		// 进行中的请求数超限
+		for i := 0; i < 3; i++ {
+			defer d.uc.TrackRequest()(nil)
+		}
+		require.NoError(t, d.uc.Evaluate(ctx))
+		assert.False(t, d.uc.Allow(FeatureViewCounting))
+	})
+}
+
+func TestDegradationUsecase_Guard(t *testing.T) {
+	ctx := context.Background()
+	d := newDegradationTestDeps(t)
+	d.checker.EXPECT().CheckDependencies(mock.Anything).Return([]*DependencyHealth{{Name: "mysql", Err: assert.AnError}})
+
+	runs := 0
+	notify := d.uc.Guard(FeatureNotifications, func(context.Context) error {
+		runs++
+		return nil
+	})
+	ranking := d.uc.Guard(FeatureFeedRanking, func(context.Context) error {
+		runs++
+		return nil
+	})
+
+	require.NoError(t, d.uc.Evaluate(ctx))
+	require.NoError(t, notify(ctx))
+	require.NoError(t, ranking(ctx))
+	assert.Equal(t, 1, runs, "notifications are not in the configured order and stay enabled")
+}
+
+func TestDegradationUsecase_GetStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Admin", func(t *testing.T) {
+		d := newDegradationTestDeps(t)
+		d.checker.EXPECT().CheckDependencies(mock.Anything).Return([]*DependencyHealth{{Name: "mysql", Err: assert.AnError}})
+		require.NoError(t, d.uc.Evaluate(ctx))
+
+		expectCategoryAdmin(ctx, d.roleRepo, 1, true)
+		status, err := d.uc.GetStatus(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, status.Enabled)
+		assert.Equal(t, []string{FeatureFeedRanking, FeaturePromotion, FeatureViewCounting}, status.Order)
+		assert.Equal(t, []string{FeatureFeedRanking}, status.Disabled)
+		require.Len(t, status.Reasons, 1)
+		assert.Contains(t, status.Reasons[0], "mysql unavailable")
+		assert.Equal(t, d.clock.Now(), status.ChangedAt)
+		assert.Equal(t, d.clock.Now(), status.EvaluatedAt)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newDegradationTestDeps(t)
+		expectCategoryAdmin(ctx, d.roleRepo, 2, false)
+
+		_, err := d.uc.GetStatus(ctx, 2)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
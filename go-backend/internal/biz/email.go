@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrEmailTaken              = errors.Conflict(v1.ErrorCode_EMAIL_TAKEN.String(), "email already bound to another account")
+	ErrVerificationCodeInvalid = errors.BadRequest(v1.ErrorCode_VERIFICATION_CODE_INVALID.String(), "invalid or expired verification code")
+	ErrVerificationTooFrequent = errors.New(429, v1.ErrorCode_RATE_LIMIT.String(), "verification code requested too frequently")
+)
+
+const (
+	emailCodeLength       = 6
+	emailCodeTTL          = 15 * time.Minute
+	emailCodeResendWindow = time.Minute
+	// emailCodeMaxAttempts 验证码的校验次数上限，输错达到上限后作废需重新申请
+	emailCodeMaxAttempts = 5
+)
+
+// EmailVerification 待验证的邮箱绑定
+type EmailVerification struct {
+	Email     string    `json:"email"`
+	Code      string    `json:"code"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EmailVerificationRepo 待验证邮箱的存储，每个用户同时只保留一条
+type EmailVerificationRepo interface {
+	// SetEmailVerification 保存新的待验证邮箱并清零校验次数
+	SetEmailVerification(ctx context.Context, userID int64, verification *EmailVerification, ttl time.Duration) error
+	// GetEmailVerification 不存在或已过期时返回nil
+	GetEmailVerification(ctx context.Context, userID int64) (*EmailVerification, error)
+	// IncrEmailVerificationAttempts 原子地累加验证码的校验次数并返回累加后的值，ttl 后自动清除
+	IncrEmailVerificationAttempts(ctx context.Context, userID int64, ttl time.Duration) (int, error)
+	DeleteEmailVerification(ctx context.Context, userID int64) error
+}
+
+// EmailSender 发送邮箱验证码
+type EmailSender interface {
+	SendVerificationCode(ctx context.Context, email, code string) error
+}
+
+// EmailUsecase 邮箱绑定与验证
+type EmailUsecase struct {
+	repo     EmailVerificationRepo
+	userRepo UserRepo
+	sender   EmailSender
+	clock    clock.Clock
+	log      *log.Helper
+}
+
+// NewEmailUsecase 创建邮箱用例
+func NewEmailUsecase(repo EmailVerificationRepo, userRepo UserRepo, sender EmailSender, clk clock.Clock, logger log.Logger) *EmailUsecase {
+	return &EmailUsecase{
+		repo:     repo,
+		userRepo: userRepo,
+		sender:   sender,
+		clock:    clk,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// BindEmail 生成验证码发往待绑定邮箱，验证通过前不修改账号邮箱
+func (uc *EmailUsecase) BindEmail(ctx context.Context, userID int64, email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	pending, err := uc.repo.GetEmailVerification(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if pending != nil && uc.clock.Since(pending.CreatedAt) < emailCodeResendWindow {
+		return ErrVerificationTooFrequent
+	}
+
+	owner, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil && err != ErrUserNotFound {
+		return err
+	}
+	if owner != nil && owner.ID != userID {
+		return ErrEmailTaken
+	}
+
+	code, err := generateVerificationCode(emailCodeLength)
+	if err != nil {
+		return err
+	}
+
+	verification := &EmailVerification{Email: email, Code: code, CreatedAt: uc.clock.Now()}
+	if err := uc.repo.SetEmailVerification(ctx, userID, verification, emailCodeTTL); err != nil {
+		return err
+	}
+
+	return uc.sender.SendVerificationCode(ctx, email, code)
+}
+
+// VerifyEmail 校验验证码，通过后将邮箱写入账号并返回该邮箱。
+// 每次校验前先原子地累加校验次数，并发的猜测请求同样受次数上限约束
+func (uc *EmailUsecase) VerifyEmail(ctx context.Context, userID int64, code string) (string, error) {
+	pending, err := uc.repo.GetEmailVerification(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if pending == nil {
+		return "", ErrVerificationCodeInvalid
+	}
+
+	attempts, err := uc.repo.IncrEmailVerificationAttempts(ctx, userID, uc.clock.Until(pending.CreatedAt.Add(emailCodeTTL)))
+	if err != nil {
+		return "", err
+	}
+	if attempts > emailCodeMaxAttempts {
+		return "", ErrVerificationCodeInvalid
+	}
+
+	if subtle.ConstantTimeCompare([]byte(pending.Code), []byte(code)) != 1 {
+		if attempts == emailCodeMaxAttempts {
+			if err := uc.repo.DeleteEmailVerification(ctx, userID); err != nil {
+				uc.log.WithContext(ctx).Warnf("delete email verification failed: user=%d err=%v", userID, err)
+			}
+		}
+		return "", ErrVerificationCodeInvalid
+	}
+
+	if err := uc.userRepo.UpdateEmail(ctx, userID, pending.Email); err != nil {
+		return "", err
+	}
+
+	if err := uc.repo.DeleteEmailVerification(ctx, userID); err != nil {
+		uc.log.WithContext(ctx).Warnf("delete email verification failed: user=%d err=%v", userID, err)
+	}
+
+	return pending.Email, nil
+}
+
+// generateVerificationCode 生成指定位数的数字验证码
+func generateVerificationCode(length int) (string, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", length, n), nil
+}
@@ -0,0 +1,187 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type emailTestDeps struct {
+	repo     *MockEmailVerificationRepo
+	userRepo *MockUserRepo
+	sender   *MockEmailSender
+	clock    *clock.Fake
+	uc       *EmailUsecase
+}
+
+func newEmailTestDeps(t *testing.T) *emailTestDeps {
+	repo := NewMockEmailVerificationRepo(t)
+	userRepo := NewMockUserRepo(t)
+	sender := NewMockEmailSender(t)
+	clk := clock.NewFake(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	return &emailTestDeps{
+		repo:     repo,
+		userRepo: userRepo,
+		sender:   sender,
+		clock:    clk,
+		uc:       NewEmailUsecase(repo, userRepo, sender, clk, log.DefaultLogger),
+	}
+}
+
+func TestEmailUsecase_BindEmail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+
+		var stored *EmailVerification
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).Return(nil, nil)
+		d.userRepo.EXPECT().GetUserByEmail(ctx, "alice@example.com").Return(nil, ErrUserNotFound)
+		d.repo.EXPECT().SetEmailVerification(ctx, int64(1), mock.Anything, emailCodeTTL).
+			RunAndReturn(func(_ context.Context, _ int64, v *EmailVerification, _ time.Duration) error {
+				stored = v
+				return nil
+			})
+		d.sender.EXPECT().SendVerificationCode(ctx, "alice@example.com", mock.AnythingOfType("string")).
+			RunAndReturn(func(_ context.Context, _ string, code string) error {
+				assert.Equal(t, stored.Code, code)
+				return nil
+			})
+
+		require.NoError(t, d.uc.BindEmail(ctx, 1, " Alice@Example.com "))
+		assert.Equal(t, "alice@example.com", stored.Email)
+		assert.Len(t, stored.Code, emailCodeLength)
+		assert.Equal(t, d.clock.Now(), stored.CreatedAt)
+	})
+
+	t.Run("Taken", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).Return(nil, nil)
+		d.userRepo.EXPECT().GetUserByEmail(ctx, "bob@example.com").Return(&User{ID: 2}, nil)
+
+		assert.Equal(t, ErrEmailTaken, d.uc.BindEmail(ctx, 1, "bob@example.com"))
+	})
+
+	t.Run("TooFrequent", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).
+			Return(&EmailVerification{Email: "alice@example.com", Code: "123456", CreatedAt: d.clock.Now().Add(-emailCodeResendWindow + time.Second)}, nil)
+
+		assert.Equal(t, ErrVerificationTooFrequent, d.uc.BindEmail(ctx, 1, "alice@example.com"))
+	})
+}
+
+func TestEmailUsecase_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).
+			Return(&EmailVerification{Email: "alice@example.com", Code: "123456", CreatedAt: d.clock.Now().Add(-time.Minute)}, nil)
+		d.repo.EXPECT().IncrEmailVerificationAttempts(ctx, int64(1), emailCodeTTL-time.Minute).Return(1, nil)
+		d.userRepo.EXPECT().UpdateEmail(ctx, int64(1), "alice@example.com").Return(nil)
+		d.repo.EXPECT().DeleteEmailVerification(ctx, int64(1)).Return(nil)
+
+		email, err := d.uc.VerifyEmail(ctx, 1, "123456")
+
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", email)
+	})
+
+	t.Run("NoPending", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).Return(nil, nil)
+
+		_, err := d.uc.VerifyEmail(ctx, 1, "123456")
+
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).
+			Return(&EmailVerification{Email: "alice@example.com", Code: "123456", CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrEmailVerificationAttempts(ctx, int64(1), emailCodeTTL).Return(1, nil)
+
+		_, err := d.uc.VerifyEmail(ctx, 1, "654321")
+
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("TooManyAttempts", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).
+			Return(&EmailVerification{Email: "alice@example.com", Code: "123456", CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrEmailVerificationAttempts(ctx, int64(1), emailCodeTTL).Return(emailCodeMaxAttempts, nil)
+		d.repo.EXPECT().DeleteEmailVerification(ctx, int64(1)).Return(nil)
+
+		_, err := d.uc.VerifyEmail(ctx, 1, "654321")
+
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("AttemptsExhausted", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).
+			Return(&EmailVerification{Email: "alice@example.com", Code: "123456", CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrEmailVerificationAttempts(ctx, int64(1), emailCodeTTL).Return(emailCodeMaxAttempts+1, nil)
+
+		// 次数用尽后正确的验证码也不再通过
+		_, err := d.uc.VerifyEmail(ctx, 1, "123456")
+
+		assert.Equal(t, ErrVerificationCodeInvalid, err)
+	})
+
+	t.Run("ConcurrentWrongGuesses", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		pending := &EmailVerification{Email: "alice@example.com", Code: "123456", CreatedAt: d.clock.Now()}
+		var attempts, compared int32
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).Return(pending, nil)
+		d.repo.EXPECT().IncrEmailVerificationAttempts(ctx, int64(1), emailCodeTTL).
+			RunAndReturn(func(context.Context, int64, time.Duration) (int, error) {
+				n := int(atomic.AddInt32(&attempts, 1))
+				if n <= emailCodeMaxAttempts {
+					atomic.AddInt32(&compared, 1)
+				}
+				return n, nil
+			})
+		d.repo.EXPECT().DeleteEmailVerification(ctx, int64(1)).Return(nil).Once()
+
+		var wg sync.WaitGroup
+		for i := 0; i < emailCodeMaxAttempts*4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := d.uc.VerifyEmail(ctx, 1, "654321")
+				assert.Equal(t, ErrVerificationCodeInvalid, err)
+			}()
+		}
+		wg.Wait()
+
+		// 只有前 emailCodeMaxAttempts 次请求比较了验证码
+		assert.Equal(t, int32(emailCodeMaxAttempts), compared)
+	})
+
+	t.Run("EmailTaken", func(t *testing.T) {
+		d := newEmailTestDeps(t)
+		d.repo.EXPECT().GetEmailVerification(ctx, int64(1)).
+			Return(&EmailVerification{Email: "alice@example.com", Code: "123456", CreatedAt: d.clock.Now()}, nil)
+		d.repo.EXPECT().IncrEmailVerificationAttempts(ctx, int64(1), emailCodeTTL).Return(1, nil)
+		d.userRepo.EXPECT().UpdateEmail(ctx, int64(1), "alice@example.com").Return(ErrEmailTaken)
+
+		_, err := d.uc.VerifyEmail(ctx, 1, "123456")
+
+		assert.True(t, errors.Is(err, ErrEmailTaken))
+	})
+}
@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrAlreadyLike = errors.BadRequest(v1.ErrorCode_ALREADY_LIKE.String(), "already liked")
+	ErrNotLike     = errors.BadRequest(v1.ErrorCode_NOT_LIKE.String(), "not liked")
+)
+
+// FavoriteRepo is a Favorite repo.
+type FavoriteRepo interface {
+	// Like 点赞，参数依次为用户ID、视频ID、视频作者ID
+	Like(context.Context, int64, int64, int64) error
+	// Unlike 取消点赞，参数依次为用户ID、视频ID、视频作者ID
+	Unlike(context.Context, int64, int64, int64) error
+	IsFavorite(context.Context, int64, int64) (bool, error)
+	BatchIsFavorite(context.Context, int64, []int64) (map[int64]bool, error)
+	GetFavoriteVideoIDs(context.Context, int64, int32, int32) ([]int64, int64, error)
+	GetFavoriteCount(context.Context, int64) (int64, error)
+}
+
+// FavoriteUsecase is a Favorite usecase.
+type FavoriteUsecase struct {
+	repo       FavoriteRepo
+	videoRepo  VideoRepo
+	relationUc *RelationUsecase
+	log        *log.Helper
+}
+
+// NewFavoriteUsecase new a Favorite usecase.
+func NewFavoriteUsecase(repo FavoriteRepo, videoRepo VideoRepo, relationUc *RelationUsecase, logger log.Logger) *FavoriteUsecase {
+	return &FavoriteUsecase{repo: repo, videoRepo: videoRepo, relationUc: relationUc, log: log.NewHelper(logger)}
+}
+
+// Like likes a video. 无权查看的视频按不存在处理
+func (uc *FavoriteUsecase) Like(ctx context.Context, userID, videoID int64) error {
+	uc.log.WithContext(ctx).Infof("User %d likes video %d", userID, videoID)
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if err := uc.relationUc.CheckVideoVisible(ctx, userID, video); err != nil {
+		return err
+	}
+
+	return uc.repo.Like(ctx, userID, video.ID, video.AuthorID)
+}
+
+// Unlike unlikes a video.
+func (uc *FavoriteUsecase) Unlike(ctx context.Context, userID, videoID int64) error {
+	uc.log.WithContext(ctx).Infof("User %d unlikes video %d", userID, videoID)
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.Unlike(ctx, userID, video.ID, video.AuthorID)
+}
+
+// IsFavorite checks if user has liked the video.
+func (uc *FavoriteUsecase) IsFavorite(ctx context.Context, userID, videoID int64) (bool, error) {
+	if userID <= 0 {
+		return false, nil
+	}
+
+	return uc.repo.IsFavorite(ctx, userID, videoID)
+}
+
+// BatchIsFavorite checks like status of multiple videos for a user.
+func (uc *FavoriteUsecase) BatchIsFavorite(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	if userID <= 0 || len(videoIDs) == 0 {
+		return map[int64]bool{}, nil
+	}
+
+	return uc.repo.BatchIsFavorite(ctx, userID, videoIDs)
+}
+
+// GetFavoriteList gets videos liked by the user, newest like first.
+func (uc *FavoriteUsecase) GetFavoriteList(ctx context.Context, userID int64, page, size int32) ([]*domain.Video, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 20
+	}
+
+	videoIDs, total, err := uc.repo.GetFavoriteVideoIDs(ctx, userID, page, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(videoIDs) == 0 {
+		return []*domain.Video{}, total, nil
+	}
+
+	videos, err := uc.videoRepo.GetVideos(ctx, videoIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 按点赞时间顺序返回，已删除的视频直接跳过
+	videoMap := make(map[int64]*domain.Video, len(videos))
+	for _, video := range videos {
+		videoMap[video.ID] = video
+	}
+	result := make([]*domain.Video, 0, len(videos))
+	for _, id := range videoIDs {
+		if video, ok := videoMap[id]; ok {
+			result = append(result, video)
+		}
+	}
+
+	return result, total, nil
+}
+
+// GetFavoriteCount gets the like count of a video.
+func (uc *FavoriteUsecase) GetFavoriteCount(ctx context.Context, videoID int64) (int64, error) {
+	return uc.repo.GetFavoriteCount(ctx, videoID)
+}
@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFavoriteUsecase_Like(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		uc := NewFavoriteUsecase(repo, videoRepo, newTestRelationUsecase(t), log.DefaultLogger)
+
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+		repo.EXPECT().Like(ctx, int64(1), int64(10), int64(2)).Return(nil)
+
+		err := uc.Like(ctx, 1, 10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyLiked", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		uc := NewFavoriteUsecase(repo, videoRepo, newTestRelationUsecase(t), log.DefaultLogger)
+
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+		repo.EXPECT().Like(ctx, int64(1), int64(10), int64(2)).Return(ErrAlreadyLike)
+
+		err := uc.Like(ctx, 1, 10)
+
+		assert.Equal(t, ErrAlreadyLike, err)
+	})
+
+	t.Run("VideoNotFound", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		uc := NewFavoriteUsecase(repo, videoRepo, newTestRelationUsecase(t), log.DefaultLogger)
+
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(nil, utils.ErrVideoNotFound)
+
+		err := uc.Like(ctx, 1, 10)
+
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+
+	t.Run("PrivateVideo", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		uc := NewFavoriteUsecase(repo, videoRepo, newTestRelationUsecase(t), log.DefaultLogger)
+
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).
+			Return(&domain.Video{ID: 10, AuthorID: 2, Visibility: domain.VideoVisibilityPrivate}, nil)
+
+		err := uc.Like(ctx, 1, 10)
+
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+}
+
+// newTestRelationUsecase 只用于按可见范围检查视频，公开和私密视频不会查询关注关系
+func newTestRelationUsecase(t *testing.T) *RelationUsecase {
+	return NewRelationUsecase(NewMockRelationRepo(t), NewMockUserRepo(t), log.DefaultLogger)
+}
+
+func TestFavoriteUsecase_Unlike(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NotLiked", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		uc := NewFavoriteUsecase(repo, videoRepo, newTestRelationUsecase(t), log.DefaultLogger)
+
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+		repo.EXPECT().Unlike(ctx, int64(1), int64(10), int64(2)).Return(ErrNotLike)
+
+		err := uc.Unlike(ctx, 1, 10)
+
+		assert.Equal(t, ErrNotLike, err)
+	})
+}
+
+func TestFavoriteUsecase_IsFavorite(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Anonymous", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		uc := NewFavoriteUsecase(repo, NewMockVideoRepo(t), newTestRelationUsecase(t), log.DefaultLogger)
+
+		isFavorite, err := uc.IsFavorite(ctx, 0, 10)
+
+		assert.NoError(t, err)
+		assert.False(t, isFavorite)
+	})
+
+	t.Run("BatchEmpty", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		uc := NewFavoriteUsecase(repo, NewMockVideoRepo(t), newTestRelationUsecase(t), log.DefaultLogger)
+
+		result, err := uc.BatchIsFavorite(ctx, 1, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestFavoriteUsecase_GetFavoriteList(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("KeepLikeOrder", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		uc := NewFavoriteUsecase(repo, videoRepo, newTestRelationUsecase(t), log.DefaultLogger)
+
+		repo.EXPECT().GetFavoriteVideoIDs(ctx, int64(1), int32(1), int32(20)).Return([]int64{3, 1, 2}, 3, nil)
+		videoRepo.EXPECT().GetVideos(ctx, []int64{3, 1, 2}).Return([]*domain.Video{
+			{ID: 1}, {ID: 2},
+		}, nil)
+
+		videos, total, err := uc.GetFavoriteList(ctx, 1, 0, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, videos, 2)
+		assert.Equal(t, int64(1), videos[0].ID)
+		assert.Equal(t, int64(2), videos[1].ID)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		repo := NewMockFavoriteRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		uc := NewFavoriteUsecase(repo, videoRepo, newTestRelationUsecase(t), log.DefaultLogger)
+
+		repo.EXPECT().GetFavoriteVideoIDs(ctx, int64(1), mock.Anything, mock.Anything).Return(nil, 0, nil)
+
+		videos, total, err := uc.GetFavoriteList(ctx, 1, 1, 10)
+
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, videos)
+	})
+}
@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+)
+
+const (
+	defaultFeedCacheBucket  = time.Minute
+	defaultFeedCacheSoftTTL = 30 * time.Second
+	defaultFeedCacheHardTTL = 5 * time.Minute
+	defaultFeedCacheWindow  = 100
+
+	// feedCacheLatestKey 从最新开始的视频流的缓存键
+	feedCacheLatestKey = "latest"
+)
+
+// FeedCacheEntry 视频流缓存条目，保存某个时间点之前最新的一批视频。
+// 超过 RefreshAt 后仍可返回，同时在后台刷新
+type FeedCacheEntry struct {
+	Videos    []*domain.Video `json:"videos"`
+	RefreshAt time.Time       `json:"refresh_at"`
+}
+
+// feedCache 不分类视频流的缓存策略。游标按 bucket 向上取整后作为缓存键，
+// 同一区间内的游标共用一个条目，每个条目保存取整时间之前的 window 条视频
+type feedCache struct {
+	bucket  time.Duration
+	softTTL time.Duration
+	hardTTL time.Duration
+	window  int
+
+	loads *readCoalescer
+	// refreshing 正在后台刷新的缓存键，同一条目同时只刷新一次
+	refreshing sync.Map
+}
+
+func newFeedCache(businessConfig *conf.Business) *feedCache {
+	c := &feedCache{
+		bucket:  defaultFeedCacheBucket,
+		softTTL: defaultFeedCacheSoftTTL,
+		hardTTL: defaultFeedCacheHardTTL,
+		window:  defaultFeedCacheWindow,
+		loads:   newReadCoalescer(coalesceGetFeed),
+	}
+
+	cfg := businessConfig.GetFeedCache()
+	if d := cfg.GetBucket().AsDuration(); d > 0 {
+		c.bucket = d
+	}
+	if d := cfg.GetSoftTtl().AsDuration(); d > 0 {
+		c.softTTL = d
+	}
+	if d := cfg.GetHardTtl().AsDuration(); d > 0 {
+		c.hardTTL = d
+	}
+	if w := int(cfg.GetWindow()); w > 0 {
+		c.window = w
+	}
+	// 条目至少要能凑满一页并判断是否有下一页
+	if minWindow := int(businessConfig.GetVideo().GetDefaultFeedLimit()) + 1; c.window < minWindow {
+		c.window = minWindow
+	}
+	return c
+}
+
+// key 返回游标对应的缓存键和回源时使用的游标
+func (c *feedCache) key(cursor *domain.FeedCursor) (string, *domain.FeedCursor) {
+	if cursor == nil {
+		return feedCacheLatestKey, nil
+	}
+	end := cursor.CreatedAt.Truncate(c.bucket).Add(c.bucket)
+	return "t:" + strconv.FormatInt(end.Unix(), 10), &domain.FeedCursor{CreatedAt: end}
+}
+
+// after 筛选出条目中位于游标之后的视频，规则与仓储的游标查询一致
+func (c *feedCache) after(videos []*domain.Video, cursor *domain.FeedCursor) []*domain.Video {
+	if cursor == nil {
+		return videos
+	}
+	for i, v := range videos {
+		if v.CreatedAt.Before(cursor.CreatedAt) ||
+			(cursor.VideoID > 0 && v.CreatedAt.Equal(cursor.CreatedAt) && v.ID < cursor.VideoID) {
+			return videos[i:]
+		}
+	}
+	return nil
+}
+
+// getCachedFeed 从缓存读取游标之后的一页视频，缓存不足以确定这一页时返回 false
+func (uc *VideoUsecase) getCachedFeed(ctx context.Context, cursor *domain.FeedCursor, limit int) ([]*domain.Video, bool, error) {
+	key, loadCursor := uc.feedCache.key(cursor)
+
+	if entry, ok := uc.cache.GetFeedVideos(ctx, key); ok {
+		if uc.clock.Now().After(entry.RefreshAt) {
+			uc.refreshFeed(ctx, key, loadCursor)
+		}
+		if videos := uc.feedCache.after(entry.Videos, cursor); len(videos) > limit {
+			return videos, true, nil
+		}
+	}
+
+	// 未命中或条目不足一页时回源，并发的相同请求只查询一次
+	entry, err := coalesce(ctx, uc.feedCache.loads, key, func(ctx context.Context) (*FeedCacheEntry, error) {
+		return uc.loadFeed(ctx, key, loadCursor)
+	}, cloneFeedCacheEntry)
+	if err != nil {
+		return nil, false, err
+	}
+
+	videos := uc.feedCache.after(entry.Videos, cursor)
+	// 条目未装满说明之后已经没有视频，可以直接结束翻页
+	if len(videos) > limit || len(entry.Videos) < uc.feedCache.window {
+		return videos, true, nil
+	}
+	return nil, false, nil
+}
+
+// loadFeed 从数据库加载一个缓存条目并写入缓存
+func (uc *VideoUsecase) loadFeed(ctx context.Context, key string, cursor *domain.FeedCursor) (*FeedCacheEntry, error) {
+	videos, err := uc.repo.GetFeedVideos(ctx, cursor, 0, uc.feedCache.window)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &FeedCacheEntry{
+		Videos:    videos,
+		RefreshAt: uc.clock.Now().Add(uc.feedCache.softTTL),
+	}
+	uc.cache.SetFeedVideos(ctx, key, entry, uc.feedCache.hardTTL)
+	return entry, nil
+}
+
+// refreshFeed 在后台重新加载软过期的条目，当前请求继续使用旧数据
+func (uc *VideoUsecase) refreshFeed(ctx context.Context, key string, cursor *domain.FeedCursor) {
+	if _, loaded := uc.feedCache.refreshing.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	err := uc.workers.Go(ctx, "feed_refresh", func(ctx context.Context) {
+		defer uc.feedCache.refreshing.Delete(key)
+		if _, err := coalesce(ctx, uc.feedCache.loads, key, func(ctx context.Context) (*FeedCacheEntry, error) {
+			return uc.loadFeed(ctx, key, cursor)
+		}, cloneFeedCacheEntry); err != nil {
+			uc.log.WithContext(ctx).Warnf("refresh feed cache %s failed: %v", key, err)
+		}
+	})
+	if err != nil {
+		uc.feedCache.refreshing.Delete(key)
+	}
+}
@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestVideoUsecase_GetFeed_Cache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 30, 0, time.UTC)
+	config := &conf.Business{
+		Video: &conf.Business_Video{DefaultFeedLimit: 2},
+		FeedCache: &conf.Business_FeedCache{
+			Bucket:  durationpb.New(time.Minute),
+			SoftTtl: durationpb.New(30 * time.Second),
+			HardTtl: durationpb.New(5 * time.Minute),
+			Window:  4,
+		},
+	}
+	videos := []*domain.Video{
+		{ID: 5, CreatedAt: now.Add(-10 * time.Second)},
+		{ID: 4, CreatedAt: now.Add(-20 * time.Second)},
+		{ID: 3, CreatedAt: now.Add(-40 * time.Second)},
+		{ID: 2, CreatedAt: now.Add(-50 * time.Second)},
+	}
+
+	newUsecase := func(t *testing.T) (*VideoUsecase, *MockVideoRepo, *MockVideoCacheRepo, *worker.Manager) {
+		repo := NewMockVideoRepo(t)
+		cache := NewMockVideoCacheRepo(t)
+		workers := worker.NewManager(log.DefaultLogger)
+		uc := NewVideoUseCase(repo, cache, nil, nil, nil, nil, config, newTestDegradation(), newTestContentModeration(), nil, workers, nil, clock.NewFake(now), log.DefaultLogger)
+		return uc, repo, cache, workers
+	}
+
+	t.Run("MissLoadsWindow", func(t *testing.T) {
+		uc, repo, cache, _ := newUsecase(t)
+		cache.EXPECT().GetFeedVideos(ctx, feedCacheLatestKey).Return(nil, false)
+		repo.EXPECT().GetFeedVideos(mock.Anything, (*domain.FeedCursor)(nil), int64(0), 4).Return(videos, nil)
+		cache.EXPECT().SetFeedVideos(mock.Anything, feedCacheLatestKey, &FeedCacheEntry{
+			Videos:    videos,
+			RefreshAt: now.Add(30 * time.Second),
+		}, 5*time.Minute).Return()
+
+		page, next, err := uc.GetFeed(ctx, nil, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{5, 4}, videoIDs(page))
+		assert.Equal(t, &domain.FeedCursor{CreatedAt: videos[1].CreatedAt, VideoID: 4}, next)
+	})
+
+	t.Run("CursorSharesBucket", func(t *testing.T) {
+		// 游标取整到12:01，同一分钟内的游标都读同一个条目，再按游标筛选
+		uc, _, cache, _ := newUsecase(t)
+		key := "t:" + strconv.FormatInt(now.Truncate(time.Minute).Add(time.Minute).Unix(), 10)
+		cache.EXPECT().GetFeedVideos(ctx, key).Return(&FeedCacheEntry{
+			Videos:    append(videos, &domain.Video{ID: 1, CreatedAt: now.Add(-55 * time.Second)}),
+			RefreshAt: now.Add(time.Second),
+		}, true)
+
+		page, next, err := uc.GetFeed(ctx, &domain.FeedCursor{CreatedAt: videos[1].CreatedAt, VideoID: 4}, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 2}, videoIDs(page))
+		assert.Equal(t, int64(2), next.VideoID)
+	})
+
+	t.Run("SoftExpiredRefreshesInBackground", func(t *testing.T) {
+		uc, repo, cache, workers := newUsecase(t)
+		cache.EXPECT().GetFeedVideos(ctx, feedCacheLatestKey).Return(&FeedCacheEntry{
+			Videos:    videos,
+			RefreshAt: now.Add(-time.Second),
+		}, true)
+		fresh := append([]*domain.Video{{ID: 6, CreatedAt: now}}, videos[:3]...)
+		repo.EXPECT().GetFeedVideos(mock.Anything, (*domain.FeedCursor)(nil), int64(0), 4).Return(fresh, nil).Once()
+		cache.EXPECT().SetFeedVideos(mock.Anything, feedCacheLatestKey, mock.Anything, 5*time.Minute).Return()
+
+		// 本次请求仍返回旧数据
+		page, _, err := uc.GetFeed(ctx, nil, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{5, 4}, videoIDs(page))
+		require.NoError(t, workers.Shutdown(ctx))
+	})
+
+	t.Run("EntryTooShortQueriesDirectly", func(t *testing.T) {
+		// 条目已装满但游标之后不足一页加一条，说明区间内视频过多，按原游标直接查库
+		uc, repo, cache, _ := newUsecase(t)
+		cursor := &domain.FeedCursor{CreatedAt: videos[1].CreatedAt, VideoID: 4}
+		key, _ := uc.feedCache.key(cursor)
+		cache.EXPECT().GetFeedVideos(ctx, key).Return(nil, false)
+		repo.EXPECT().GetFeedVideos(mock.Anything, &domain.FeedCursor{CreatedAt: now.Truncate(time.Minute).Add(time.Minute)}, int64(0), 4).Return(videos, nil)
+		cache.EXPECT().SetFeedVideos(mock.Anything, key, mock.Anything, 5*time.Minute).Return()
+		repo.EXPECT().GetFeedVideos(ctx, cursor, int64(0), 3).Return(videos[2:], nil)
+
+		page, next, err := uc.GetFeed(ctx, cursor, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 2}, videoIDs(page))
+		assert.Nil(t, next)
+	})
+}
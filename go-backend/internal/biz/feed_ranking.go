@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+)
+
+const (
+	defaultFeedFavoriteWeight    = 2.0
+	defaultFeedCommentWeight     = 3.0
+	defaultFeedPlayWeight        = 0.5
+	defaultFeedHalfLife          = 24 * time.Hour
+	defaultFeedCandidatePoolSize = 300
+)
+
+// FeedRanker 按互动得分对候选视频排序。
+// 得分 = (1 + Σ 权重·ln(1+计数)) · 0.5^(发布时长/半衰期)，
+// 取对数避免个别爆款视频的计数压倒时效衰减，常数1保证无互动的新视频仍按发布时间排序。
+// 配置了分类连续上限时，排序后再打散同一分类的连续视频
+type FeedRanker struct {
+	enabled         bool
+	favoriteWeight  float64
+	commentWeight   float64
+	playWeight      float64
+	halfLife        time.Duration
+	poolSize        int
+	candidateWindow time.Duration
+	maxCategoryRun  int
+}
+
+// NewFeedRanker 按业务配置创建排序器，未配置的项使用默认值
+func NewFeedRanker(businessConfig *conf.Business) *FeedRanker {
+	r := &FeedRanker{
+		favoriteWeight: defaultFeedFavoriteWeight,
+		commentWeight:  defaultFeedCommentWeight,
+		playWeight:     defaultFeedPlayWeight,
+		halfLife:       defaultFeedHalfLife,
+		poolSize:       defaultFeedCandidatePoolSize,
+	}
+
+	cfg := businessConfig.GetFeedRanking()
+	if cfg == nil {
+		return r
+	}
+
+	r.enabled = cfg.Enabled
+	if cfg.FavoriteWeight > 0 || cfg.CommentWeight > 0 || cfg.PlayWeight > 0 {
+		r.favoriteWeight = cfg.FavoriteWeight
+		r.commentWeight = cfg.CommentWeight
+		r.playWeight = cfg.PlayWeight
+	}
+	if cfg.HalfLife != nil && cfg.HalfLife.AsDuration() > 0 {
+		r.halfLife = cfg.HalfLife.AsDuration()
+	}
+	if cfg.CandidatePoolSize > 0 {
+		r.poolSize = int(cfg.CandidatePoolSize)
+	}
+	if cfg.CandidateWindow != nil {
+		r.candidateWindow = cfg.CandidateWindow.AsDuration()
+	}
+	if cfg.MaxCategoryRun > 0 {
+		r.maxCategoryRun = int(cfg.MaxCategoryRun)
+	}
+
+	return r
+}
+
+// Enabled 是否开启得分排序
+func (r *FeedRanker) Enabled() bool {
+	return r.enabled
+}
+
+// PoolSize 候选视频数
+func (r *FeedRanker) PoolSize() int {
+	return r.poolSize
+}
+
+// Score 计算视频在now时刻的得分
+func (r *FeedRanker) Score(video *domain.Video, now time.Time) float64 {
+	engagement := r.favoriteWeight*math.Log1p(float64(max(video.FavoriteCount, 0))) +
+		r.commentWeight*math.Log1p(float64(max(video.CommentCount, 0))) +
+		r.playWeight*math.Log1p(float64(max(video.PlayCount, 0)))
+
+	age := max(now.Sub(video.CreatedAt), 0)
+	decay := math.Pow(0.5, age.Hours()/r.halfLife.Hours())
+
+	return (1 + engagement) * decay
+}
+
+// Rank 过滤超出候选时间窗口的视频，按得分降序排序，得分相同时较新的视频在前
+func (r *FeedRanker) Rank(videos []*domain.Video, now time.Time) []*domain.Video {
+	type scored struct {
+		video *domain.Video
+		score float64
+	}
+
+	candidates := make([]scored, 0, len(videos))
+	for _, video := range videos {
+		if r.candidateWindow > 0 && now.Sub(video.CreatedAt) > r.candidateWindow {
+			continue
+		}
+		candidates = append(candidates, scored{video: video, score: r.Score(video, now)})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		if !candidates[i].video.CreatedAt.Equal(candidates[j].video.CreatedAt) {
+			return candidates[i].video.CreatedAt.After(candidates[j].video.CreatedAt)
+		}
+		return candidates[i].video.ID > candidates[j].video.ID
+	})
+
+	ranked := make([]*domain.Video, len(candidates))
+	for i, c := range candidates {
+		ranked[i] = c.video
+	}
+	return r.diversify(ranked)
+}
+
+// diversify 同一分类连续出现达到上限时，把后面第一个其他分类的视频提前，
+// 其余视频保持得分顺序；未分类的视频不计入连续数，找不到其他分类时按原顺序排列
+func (r *FeedRanker) diversify(videos []*domain.Video) []*domain.Video {
+	if r.maxCategoryRun <= 0 || len(videos) == 0 {
+		return videos
+	}
+
+	pending := append([]*domain.Video(nil), videos...)
+	result := make([]*domain.Video, 0, len(videos))
+	var lastCategory int64
+	run := 0
+	for len(pending) > 0 {
+		pick := 0
+		if lastCategory != 0 && run >= r.maxCategoryRun {
+			for i, video := range pending {
+				if video.CategoryID != lastCategory {
+					pick = i
+					break
+				}
+			}
+		}
+
+		video := pending[pick]
+		pending = append(pending[:pick], pending[pick+1:]...)
+		result = append(result, video)
+
+		switch {
+		case video.CategoryID == 0:
+			lastCategory, run = 0, 0
+		case video.CategoryID == lastCategory:
+			run++
+		default:
+			lastCategory, run = video.CategoryID, 1
+		}
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newRankingBusinessConfig(window time.Duration) *conf.Business {
+	return &conf.Business{
+		Video: &conf.Business_Video{DefaultFeedLimit: 2},
+		FeedRanking: &conf.Business_FeedRanking{
+			Enabled:           true,
+			FavoriteWeight:    1,
+			CommentWeight:     1,
+			PlayWeight:        0,
+			HalfLife:          durationpb.New(time.Hour),
+			CandidatePoolSize: 50,
+			CandidateWindow:   durationpb.New(window),
+		},
+	}
+}
+
+func videoIDs(videos []*domain.Video) []int64 {
+	ids := make([]int64, len(videos))
+	for i, v := range videos {
+		ids[i] = v.ID
+	}
+	return ids
+}
+
+func TestFeedRanker_Score(t *testing.T) {
+	now := time.Now()
+	r := NewFeedRanker(newRankingBusinessConfig(0))
+
+	fresh := &domain.Video{CreatedAt: now}
+	assert.InDelta(t, 1.0, r.Score(fresh, now), 1e-9)
+
+	// 一个半衰期后得分减半
+	aged := &domain.Video{CreatedAt: now.Add(-time.Hour)}
+	assert.InDelta(t, 0.5, r.Score(aged, now), 1e-9)
+
+	popular := &domain.Video{FavoriteCount: 100, CommentCount: 10, CreatedAt: now}
+	assert.Greater(t, r.Score(popular, now), r.Score(fresh, now))
+}
+
+func TestFeedRanker_Defaults(t *testing.T) {
+	r := NewFeedRanker(&conf.Business{})
+
+	assert.False(t, r.Enabled())
+	assert.Equal(t, defaultFeedCandidatePoolSize, r.PoolSize())
+}
+
+func TestFeedRanker_Rank(t *testing.T) {
+	now := time.Now()
+	r := NewFeedRanker(newRankingBusinessConfig(24 * time.Hour))
+
+	videos := []*domain.Video{
+		{ID: 1, CreatedAt: now},
+		{ID: 2, FavoriteCount: 50, CommentCount: 20, CreatedAt: now.Add(-time.Hour)},
+		{ID: 3, CreatedAt: now},
+		{ID: 4, FavoriteCount: 1000, CreatedAt: now.Add(-48 * time.Hour)}, // 超出候选窗口
+	}
+
+	ranked := r.Rank(videos, now)
+
+	// 得分相同的视频按ID降序
+	assert.Equal(t, []int64{2, 3, 1}, videoIDs(ranked))
+}
+
+func TestFeedRanker_RankDiversifiesCategories(t *testing.T) {
+	now := time.Now()
+	cfg := newRankingBusinessConfig(0)
+	cfg.FeedRanking.MaxCategoryRun = 2
+	r := NewFeedRanker(cfg)
+
+	// 按得分依次为 1..6，分类1的视频得分都更高
+	videos := []*domain.Video{
+		{ID: 1, CategoryID: 1, FavoriteCount: 500, CreatedAt: now},
+		{ID: 2, CategoryID: 1, FavoriteCount: 400, CreatedAt: now},
+		{ID: 3, CategoryID: 1, FavoriteCount: 300, CreatedAt: now},
+		{ID: 4, CategoryID: 1, FavoriteCount: 200, CreatedAt: now},
+		{ID: 5, CategoryID: 2, FavoriteCount: 100, CreatedAt: now},
+		{ID: 6, CategoryID: 0, FavoriteCount: 50, CreatedAt: now},
+	}
+
+	ranked := r.Rank(videos, now)
+
+	// 分类1连续两条后插入其他分类的视频，其他分类用完后按原顺序排列
+	assert.Equal(t, []int64{1, 2, 5, 3, 4, 6}, videoIDs(ranked))
+}
+
+func TestVideoUsecase_GetRankedFeed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	clk := clock.NewFake(now)
+	candidates := []*domain.Video{
+		{ID: 1, CreatedAt: now},
+		{ID: 2, FavoriteCount: 30, CreatedAt: now.Add(-time.Minute)},
+		{ID: 3, CommentCount: 5, CreatedAt: now.Add(-2 * time.Minute)},
+	}
+
+	t.Run("Paginate", func(t *testing.T) {
+		repo := NewMockVideoRepo(t)
+		uc := NewVideoUseCase(repo, nil, nil, nil, nil, nil, newRankingBusinessConfig(0), newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clk, log.DefaultLogger)
+
+		repo.EXPECT().GetFeedVideos(ctx, &domain.FeedCursor{CreatedAt: now}, int64(0), 50).Return(candidates, nil).Twice()
+
+		page, nextOffset, err := uc.GetRankedFeed(ctx, 0, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, videoIDs(page))
+		assert.Equal(t, 2, nextOffset)
+
+		page, nextOffset, err = uc.GetRankedFeed(ctx, 0, nextOffset, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, videoIDs(page))
+		assert.Equal(t, 0, nextOffset)
+	})
+
+	t.Run("Category", func(t *testing.T) {
+		repo := NewMockVideoRepo(t)
+		uc := NewVideoUseCase(repo, nil, nil, nil, nil, nil, newRankingBusinessConfig(0), newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clk, log.DefaultLogger)
+
+		repo.EXPECT().GetFeedVideos(ctx, mock.Anything, int64(7), 50).Return(candidates[1:], nil)
+
+		page, _, err := uc.GetRankedFeed(ctx, 7, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, videoIDs(page))
+	})
+
+	t.Run("OffsetOutOfRange", func(t *testing.T) {
+		repo := NewMockVideoRepo(t)
+		uc := NewVideoUseCase(repo, nil, nil, nil, nil, nil, newRankingBusinessConfig(0), newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clk, log.DefaultLogger)
+
+		repo.EXPECT().GetFeedVideos(ctx, mock.Anything, int64(0), 50).Return(candidates, nil)
+
+		page, nextOffset, err := uc.GetRankedFeed(ctx, 0, 10, 2)
+		require.NoError(t, err)
+		assert.Empty(t, page)
+		assert.Equal(t, 0, nextOffset)
+	})
+}
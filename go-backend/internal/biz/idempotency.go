@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go-backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultEventLockTTL  = 10 * time.Minute
+	defaultEventCacheTTL = 24 * time.Hour
+)
+
+// ErrEventInProgress 同一事件正在被其他消费者实例处理，消息稍后重试
+var ErrEventInProgress = errors.New("event is being processed by another consumer")
+
+// ProcessedEventRepo 消费者已处理事件的记录。Redis 标记处理中的事件并缓存处理结果，
+// 数据库中的记录是去重的最终依据
+type ProcessedEventRepo interface {
+	// IsProcessed 事件是否已被该消费者处理过
+	IsProcessed(ctx context.Context, consumer, eventID string, cacheTTL time.Duration) (bool, error)
+	// TryLock 通过 SETNX 占用事件，返回 false 表示其他实例正在处理
+	TryLock(ctx context.Context, consumer, eventID string, ttl time.Duration) (bool, error)
+	// Unlock 释放占用，处理失败后允许重试
+	Unlock(ctx context.Context, consumer, eventID string) error
+	// MarkProcessed 记录事件已处理，重复记录不报错
+	MarkProcessed(ctx context.Context, consumer, eventID string, cacheTTL time.Duration) error
+}
+
+// IdempotencyUsecase 消费者幂等处理：同一事件ID在同一消费者上的副作用只执行一次
+type IdempotencyUsecase struct {
+	repo     ProcessedEventRepo
+	lockTTL  time.Duration
+	cacheTTL time.Duration
+	log      *log.Helper
+}
+
+// NewIdempotencyUsecase 创建消费者幂等处理用例
+func NewIdempotencyUsecase(repo ProcessedEventRepo, businessConfig *conf.Business, logger log.Logger) *IdempotencyUsecase {
+	uc := &IdempotencyUsecase{
+		repo:     repo,
+		lockTTL:  defaultEventLockTTL,
+		cacheTTL: defaultEventCacheTTL,
+		log:      log.NewHelper(logger),
+	}
+
+	cfg := businessConfig.GetEventIdempotency()
+	if d := cfg.GetLockTtl().AsDuration(); d > 0 {
+		uc.lockTTL = d
+	}
+	if d := cfg.GetCacheTtl().AsDuration(); d > 0 {
+		uc.cacheTTL = d
+	}
+	return uc
+}
+
+// Run 执行事件处理，已处理过的事件直接跳过。处理成功后才记录，失败时释放占用以便重试；
+// 其他实例正在处理时返回 ErrEventInProgress。eventID 为空的事件无法去重，直接执行
+func (uc *IdempotencyUsecase) Run(ctx context.Context, consumer, eventID string, fn func(ctx context.Context) error) error {
+	if eventID == "" {
+		return fn(ctx)
+	}
+
+	processed, err := uc.repo.IsProcessed(ctx, consumer, eventID, uc.cacheTTL)
+	if err != nil {
+		return err
+	}
+	if processed {
+		uc.log.WithContext(ctx).Infof("skip duplicate event %s for %s", eventID, consumer)
+		return nil
+	}
+
+	locked, err := uc.repo.TryLock(ctx, consumer, eventID, uc.lockTTL)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return ErrEventInProgress
+	}
+
+	if err := fn(ctx); err != nil {
+		if unlockErr := uc.repo.Unlock(ctx, consumer, eventID); unlockErr != nil {
+			uc.log.WithContext(ctx).Warnf("unlock event %s for %s failed: %v", eventID, consumer, unlockErr)
+		}
+		return err
+	}
+
+	// 成功后不释放占用：其他实例可能在记录前已通过检查，占用到期前它们拿不到锁。
+	// 副作用已执行，记录失败也不返回错误，否则消息重试会再次执行
+	if err := uc.repo.MarkProcessed(ctx, consumer, eventID, uc.cacheTTL); err != nil {
+		uc.log.WithContext(ctx).Errorf("mark event %s processed for %s failed: %v", eventID, consumer, err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestIdempotencyUsecase_Run(t *testing.T) {
+	ctx := context.Background()
+	config := &conf.Business{EventIdempotency: &conf.Business_EventIdempotency{
+		LockTtl:  durationpb.New(time.Minute),
+		CacheTtl: durationpb.New(time.Hour),
+	}}
+
+	newUsecase := func(t *testing.T) (*IdempotencyUsecase, *MockProcessedEventRepo) {
+		repo := NewMockProcessedEventRepo(t)
+		return NewIdempotencyUsecase(repo, config, log.DefaultLogger), repo
+	}
+	counting := func(calls *int, err error) func(context.Context) error {
+		return func(context.Context) error {
+			*calls++
+			return err
+		}
+	}
+
+	t.Run("FirstDelivery", func(t *testing.T) {
+		uc, repo := newUsecase(t)
+		repo.EXPECT().IsProcessed(ctx, "video_upload", "evt-1", time.Hour).Return(false, nil)
+		repo.EXPECT().TryLock(ctx, "video_upload", "evt-1", time.Minute).Return(true, nil)
+		repo.EXPECT().MarkProcessed(ctx, "video_upload", "evt-1", time.Hour).Return(nil)
+
+		calls := 0
+		assert.NoError(t, uc.Run(ctx, "video_upload", "evt-1", counting(&calls, nil)))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Redelivered", func(t *testing.T) {
+		uc, repo := newUsecase(t)
+		repo.EXPECT().IsProcessed(ctx, "video_upload", "evt-1", time.Hour).Return(true, nil)
+
+		calls := 0
+		assert.NoError(t, uc.Run(ctx, "video_upload", "evt-1", counting(&calls, nil)))
+		assert.Zero(t, calls)
+	})
+
+	t.Run("InProgressElsewhere", func(t *testing.T) {
+		uc, repo := newUsecase(t)
+		repo.EXPECT().IsProcessed(ctx, "video_upload", "evt-1", time.Hour).Return(false, nil)
+		repo.EXPECT().TryLock(ctx, "video_upload", "evt-1", time.Minute).Return(false, nil)
+
+		calls := 0
+		assert.ErrorIs(t, uc.Run(ctx, "video_upload", "evt-1", counting(&calls, nil)), ErrEventInProgress)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("HandlerFailsReleasesLock", func(t *testing.T) {
+		uc, repo := newUsecase(t)
+		failure := errors.New("transcode failed")
+		repo.EXPECT().IsProcessed(ctx, "video_upload", "evt-1", time.Hour).Return(false, nil)
+		repo.EXPECT().TryLock(ctx, "video_upload", "evt-1", time.Minute).Return(true, nil)
+		repo.EXPECT().Unlock(ctx, "video_upload", "evt-1").Return(nil)
+
+		calls := 0
+		assert.ErrorIs(t, uc.Run(ctx, "video_upload", "evt-1", counting(&calls, failure)), failure)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("MarkFailsAfterSideEffects", func(t *testing.T) {
+		// 副作用已执行，不能让消息重试
+		uc, repo := newUsecase(t)
+		repo.EXPECT().IsProcessed(ctx, "video_upload", "evt-1", time.Hour).Return(false, nil)
+		repo.EXPECT().TryLock(ctx, "video_upload", "evt-1", time.Minute).Return(true, nil)
+		repo.EXPECT().MarkProcessed(ctx, "video_upload", "evt-1", time.Hour).Return(errors.New("db down"))
+
+		calls := 0
+		assert.NoError(t, uc.Run(ctx, "video_upload", "evt-1", counting(&calls, nil)))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("NoEventID", func(t *testing.T) {
+		uc, _ := newUsecase(t)
+
+		calls := 0
+		assert.NoError(t, uc.Run(ctx, "video_stats", "", counting(&calls, nil)))
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,347 @@
+package biz
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultIntegritySampleSize = 20
+	// integrityRequeueCooldown 转码产物缺失并重新入队后，冷却期内再次抽到不重复入队
+	integrityRequeueCooldown = time.Hour
+	checksumPrefixMD5        = "md5:"
+)
+
+// 视频文件完整性校验结果
+const (
+	IntegrityStatusOK                = 1 // 原始文件和转码产物完好
+	IntegrityStatusDerivedMissing    = 2 // 转码产物缺失，已从原始文件重新转码
+	IntegrityStatusOriginalMissing   = 3 // 原始文件丢失，视频已标记为不可用
+	IntegrityStatusOriginalCorrupted = 4 // 原始文件与基线不一致，视频已标记为不可用
+)
+
+// 参与校验的对象类型，用于指标标签
+const (
+	IntegrityObjectOriginal  = "original"
+	IntegrityObjectCover     = "cover"
+	IntegrityObjectHLS       = "hls"
+	IntegrityObjectRendition = "rendition"
+)
+
+// VideoIntegrity 视频原始文件的完整性基线与最近一次校验结果。
+// 数据库上传时未保存原始文件的校验值，基线在首次校验时从存储记录（首次信任），
+// 之后的校验与基线比较；视频表中的文件大小在上传时写入，始终参与比较
+type VideoIntegrity struct {
+	VideoID    int64
+	ObjectName string
+	Size       int64
+	ETag       string
+	Checksum   string // md5:<hex>，未开启内容校验时为空
+	Status     int32
+	Detail     string
+	CheckedAt  time.Time
+}
+
+// IntegrityRepo 视频文件完整性校验仓储接口
+type IntegrityRepo interface {
+	// SampleVideos 随机抽取一批已发布或私密的视频
+	SampleVideos(ctx context.Context, limit int) ([]*domain.Video, error)
+	// GetIntegrity 获取视频的校验记录，从未校验过时返回nil
+	GetIntegrity(ctx context.Context, videoID int64) (*VideoIntegrity, error)
+	// SaveIntegrity 写入或更新视频的校验记录
+	SaveIntegrity(ctx context.Context, record *VideoIntegrity) error
+	// MarkVideoUnavailable 把视频置为不可用并清理缓存，视频已删除或状态已变化时返回false
+	MarkVideoUnavailable(ctx context.Context, videoID int64, fromStatus int32) (bool, error)
+}
+
+// IntegrityNotifier 视频文件损坏通知发送接口
+type IntegrityNotifier interface {
+	// NotifyVideoUnavailable 通知作者视频原始文件丢失或损坏，需要重新上传
+	NotifyVideoUnavailable(ctx context.Context, video *domain.Video, record *VideoIntegrity) error
+}
+
+// IntegrityUsecase 存储完整性校验任务。定期随机抽查视频，核对原始文件的大小、ETag和内容校验值：
+// 原始文件丢失或损坏时视频无法恢复，标记为不可用并通知作者；
+// 转码产物、HLS播放列表或封面缺失时，原始文件完好即可重新转码恢复
+type IntegrityUsecase struct {
+	repo          IntegrityRepo
+	opsRepo       OpsRepo
+	storage       storage.VideoStorage
+	notifier      IntegrityNotifier
+	sampleSize    int
+	verifyContent bool
+	interval      time.Duration
+	enabled       bool
+	clock         clock.Clock
+
+	checkedCounter metric.Int64Counter
+	problemCounter metric.Int64Counter
+
+	log *log.Helper
+}
+
+// NewIntegrityUsecase 创建存储完整性校验任务
+func NewIntegrityUsecase(repo IntegrityRepo, opsRepo OpsRepo, storage storage.VideoStorage, notifier IntegrityNotifier, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *IntegrityUsecase {
+	uc := &IntegrityUsecase{
+		repo:       repo,
+		opsRepo:    opsRepo,
+		storage:    storage,
+		notifier:   notifier,
+		sampleSize: defaultIntegritySampleSize,
+		clock:      clk,
+		log:        log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetIntegrityCheck(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		uc.verifyContent = cfg.VerifyContent
+		if cfg.SampleSize > 0 {
+			uc.sampleSize = int(cfg.SampleSize)
+		}
+		if cfg.Interval != nil {
+			uc.interval = cfg.Interval.AsDuration()
+		}
+	}
+
+	meter := otel.Meter("go-backend/integrity")
+	uc.checkedCounter, _ = meter.Int64Counter("storage_integrity_checked_objects_total",
+		metric.WithDescription("Stored objects verified by the integrity check"))
+	uc.problemCounter, _ = meter.Int64Counter("storage_integrity_problems_total",
+		metric.WithDescription("Missing or corrupted objects found by the integrity check"))
+
+	return uc
+}
+
+// Enabled 是否启用完整性校验任务
+func (uc *IntegrityUsecase) Enabled() bool {
+	return uc.enabled
+}
+
+// Interval 执行间隔
+func (uc *IntegrityUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Run 抽查一批视频，单个视频校验失败不影响其余视频，供调度器调用
+func (uc *IntegrityUsecase) Run(ctx context.Context) error {
+	resolver, ok := uc.storage.(storage.ObjectResolver)
+	if !ok {
+		uc.log.WithContext(ctx).Warn("storage cannot resolve object names, skip integrity check")
+		return nil
+	}
+
+	videos, err := uc.repo.SampleVideos(ctx, uc.sampleSize)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	counts := make(map[int32]int)
+	for _, video := range videos {
+		if ctx.Err() != nil {
+			break
+		}
+		record, err := uc.checkVideo(ctx, resolver, video)
+		if err != nil {
+			failed++
+			uc.log.WithContext(ctx).Errorf("integrity check failed: video=%d err=%v", video.ID, err)
+			continue
+		}
+		if record != nil {
+			counts[record.Status]++
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("integrity check finished: sampled=%d ok=%d requeued=%d unavailable=%d failed=%d",
+		len(videos), counts[IntegrityStatusOK], counts[IntegrityStatusDerivedMissing],
+		counts[IntegrityStatusOriginalMissing]+counts[IntegrityStatusOriginalCorrupted], failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d integrity checks failed", failed, len(videos))
+	}
+	return nil
+}
+
+// checkVideo 校验单个视频，原始文件不由本存储管理时跳过并返回nil
+func (uc *IntegrityUsecase) checkVideo(ctx context.Context, resolver storage.ObjectResolver, video *domain.Video) (*VideoIntegrity, error) {
+	objectName, ok := resolver.ObjectName(video.PlayURL)
+	if !ok {
+		return nil, nil
+	}
+
+	previous, err := uc.repo.GetIntegrity(ctx, video.ID)
+	if err != nil {
+		return nil, err
+	}
+	record := &VideoIntegrity{VideoID: video.ID, ObjectName: objectName, Status: IntegrityStatusOK}
+	if previous != nil && previous.ObjectName == objectName {
+		record.Size, record.ETag, record.Checksum = previous.Size, previous.ETag, previous.Checksum
+	}
+
+	status, detail, err := uc.verifyOriginal(ctx, video, record)
+	if err != nil {
+		return nil, err
+	}
+	record.Status, record.Detail = status, detail
+
+	if status == IntegrityStatusOK {
+		missing, err := uc.missingDerived(ctx, resolver, video)
+		if err != nil {
+			return nil, err
+		}
+		if len(missing) > 0 {
+			record.Status = IntegrityStatusDerivedMissing
+			record.Detail = truncateRunes("missing "+strings.Join(missing, ", "), 500)
+			if err := uc.requeue(ctx, video, previous); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		if err := uc.markUnavailable(ctx, video, record); err != nil {
+			return nil, err
+		}
+	}
+
+	record.CheckedAt = uc.clock.Now()
+	if err := uc.repo.SaveIntegrity(ctx, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// verifyOriginal 核对原始文件，首次校验时把存储返回的ETag和校验值写入基线
+func (uc *IntegrityUsecase) verifyOriginal(ctx context.Context, video *domain.Video, record *VideoIntegrity) (int32, string, error) {
+	uc.checkedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", IntegrityObjectOriginal)))
+
+	exists, err := uc.storage.Exists(ctx, record.ObjectName)
+	if err != nil {
+		return 0, "", err
+	}
+	if !exists {
+		uc.recordProblem(ctx, IntegrityObjectOriginal, "missing")
+		return IntegrityStatusOriginalMissing, "original object not found", nil
+	}
+
+	info, err := uc.storage.GetFileInfo(ctx, record.ObjectName)
+	if err != nil {
+		return 0, "", err
+	}
+	if video.Size > 0 && info.Size != video.Size {
+		uc.recordProblem(ctx, IntegrityObjectOriginal, "corrupted")
+		return IntegrityStatusOriginalCorrupted, fmt.Sprintf("size %d, expected %d", info.Size, video.Size), nil
+	}
+	if record.ETag != "" && info.ETag != record.ETag {
+		uc.recordProblem(ctx, IntegrityObjectOriginal, "corrupted")
+		return IntegrityStatusOriginalCorrupted, fmt.Sprintf("etag %s, expected %s", info.ETag, record.ETag), nil
+	}
+	record.Size, record.ETag = info.Size, info.ETag
+
+	if uc.verifyContent {
+		checksum, err := uc.checksum(ctx, record.ObjectName)
+		if err != nil {
+			return 0, "", err
+		}
+		if record.Checksum != "" && checksum != record.Checksum {
+			uc.recordProblem(ctx, IntegrityObjectOriginal, "corrupted")
+			return IntegrityStatusOriginalCorrupted, fmt.Sprintf("checksum %s, expected %s", checksum, record.Checksum), nil
+		}
+		record.Checksum = checksum
+	}
+	return IntegrityStatusOK, "", nil
+}
+
+// missingDerived 返回缺失的转码产物、HLS主播放列表和封面（含动态封面）的对象键，不由本存储管理的地址跳过
+func (uc *IntegrityUsecase) missingDerived(ctx context.Context, resolver storage.ObjectResolver, video *domain.Video) ([]string, error) {
+	kinds := map[string]string{video.CoverURL: IntegrityObjectCover, video.DynamicCoverURL: IntegrityObjectCover, video.HLSURL: IntegrityObjectHLS}
+	for _, url := range video.PlayURLs {
+		kinds[url] = IntegrityObjectRendition
+	}
+
+	var missing []string
+	for url, kind := range kinds {
+		objectName, ok := resolver.ObjectName(url)
+		if !ok {
+			continue
+		}
+		uc.checkedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", kind)))
+
+		exists, err := uc.storage.Exists(ctx, objectName)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			uc.recordProblem(ctx, kind, "missing")
+			missing = append(missing, objectName)
+		}
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// requeue 从原始文件重新转码，转码产物的对象键固定，重新生成后覆盖原位置。
+// 上次校验刚入队且仍在冷却期内时不重复入队
+func (uc *IntegrityUsecase) requeue(ctx context.Context, video *domain.Video, previous *VideoIntegrity) error {
+	if previous != nil && previous.Status == IntegrityStatusDerivedMissing &&
+		uc.clock.Since(previous.CheckedAt) < integrityRequeueCooldown {
+		return nil
+	}
+	if _, err := uc.opsRepo.RequeueProcessing(ctx, []int64{video.ID}); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Warnf("video %d derived objects missing, requeued processing", video.ID)
+	return nil
+}
+
+// markUnavailable 把视频标记为不可用并通知作者，通知失败只记录日志
+func (uc *IntegrityUsecase) markUnavailable(ctx context.Context, video *domain.Video, record *VideoIntegrity) error {
+	updated, err := uc.repo.MarkVideoUnavailable(ctx, video.ID, video.Status)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return nil
+	}
+	uc.log.WithContext(ctx).Errorf("video %d marked unavailable: object=%s %s", video.ID, record.ObjectName, record.Detail)
+
+	if err := uc.notifier.NotifyVideoUnavailable(ctx, video, record); err != nil {
+		uc.log.WithContext(ctx).Warnf("notify video unavailable failed: video=%d err=%v", video.ID, err)
+	}
+	return nil
+}
+
+// checksum 下载对象并计算MD5
+func (uc *IntegrityUsecase) checksum(ctx context.Context, objectName string) (string, error) {
+	reader, err := uc.storage.Download(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", err
+	}
+	return checksumPrefixMD5 + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (uc *IntegrityUsecase) recordProblem(ctx context.Context, kind, problem string) {
+	uc.problemCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("kind", kind),
+		attribute.String("problem", problem),
+	))
+}
@@ -0,0 +1,175 @@
+package biz
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// integrityStorage 在内存存储上补充文件信息，ETag 取内容的MD5
+type integrityStorage struct {
+	*memoryStorage
+}
+
+func (s *integrityStorage) GetFileInfo(_ context.Context, objectName string) (*storage.FileInfo, error) {
+	data := s.objects[objectName]
+	sum := md5.Sum(data)
+	return &storage.FileInfo{Name: objectName, Size: int64(len(data)), ETag: hex.EncodeToString(sum[:])}, nil
+}
+
+type integrityTestDeps struct {
+	repo     *MockIntegrityRepo
+	opsRepo  *MockOpsRepo
+	notifier *MockIntegrityNotifier
+	storage  *integrityStorage
+	clock    *clock.Fake
+	uc       *IntegrityUsecase
+}
+
+func newIntegrityTestDeps(t *testing.T, verifyContent bool) *integrityTestDeps {
+	d := &integrityTestDeps{
+		repo:     NewMockIntegrityRepo(t),
+		opsRepo:  NewMockOpsRepo(t),
+		notifier: NewMockIntegrityNotifier(t),
+		storage:  &integrityStorage{newMemoryStorage()},
+		clock:    clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	config := &conf.Business{IntegrityCheck: &conf.Business_IntegrityCheck{Enabled: true, SampleSize: 5, VerifyContent: verifyContent}}
+	d.uc = NewIntegrityUsecase(d.repo, d.opsRepo, d.storage, d.notifier, config, d.clock, log.DefaultLogger)
+
+	d.storage.objects["videos/1.mp4"] = []byte("original")
+	d.storage.objects["covers/1.jpg"] = []byte("cover")
+	d.storage.objects["renditions/transcoded_1_720p.mp4"] = []byte("720p")
+	return d
+}
+
+func integrityTestVideo() *domain.Video {
+	return &domain.Video{
+		ID:       1,
+		AuthorID: 7,
+		PlayURL:  "https://cdn.example.com/videos/1.mp4",
+		CoverURL: "https://cdn.example.com/covers/1.jpg",
+		PlayURLs: map[string]string{"720p": "https://cdn.example.com/renditions/transcoded_1_720p.mp4"},
+		Size:     int64(len("original")),
+		Status:   domain.VideoStatusPublished,
+	}
+}
+
+func TestIntegrityUsecase_Run(t *testing.T) {
+	ctx := context.Background()
+	sum := md5.Sum([]byte("original"))
+	originalETag := hex.EncodeToString(sum[:])
+
+	t.Run("RecordBaseline", func(t *testing.T) {
+		d := newIntegrityTestDeps(t, true)
+		d.repo.EXPECT().SampleVideos(ctx, 5).Return([]*domain.Video{integrityTestVideo()}, nil)
+		d.repo.EXPECT().GetIntegrity(ctx, int64(1)).Return(nil, nil)
+		d.repo.EXPECT().SaveIntegrity(ctx, &VideoIntegrity{
+			VideoID:    1,
+			ObjectName: "videos/1.mp4",
+			Size:       8,
+			ETag:       originalETag,
+			Checksum:   "md5:" + originalETag,
+			Status:     IntegrityStatusOK,
+			CheckedAt:  d.clock.Now(),
+		}).Return(nil)
+
+		require.NoError(t, d.uc.Run(ctx))
+	})
+
+	t.Run("OriginalMissing", func(t *testing.T) {
+		d := newIntegrityTestDeps(t, false)
+		delete(d.storage.objects, "videos/1.mp4")
+		video := integrityTestVideo()
+		d.repo.EXPECT().SampleVideos(ctx, 5).Return([]*domain.Video{video}, nil)
+		d.repo.EXPECT().GetIntegrity(ctx, int64(1)).Return(nil, nil)
+		d.repo.EXPECT().MarkVideoUnavailable(ctx, int64(1), int32(domain.VideoStatusPublished)).Return(true, nil)
+		d.notifier.EXPECT().NotifyVideoUnavailable(ctx, video, mock.MatchedBy(func(r *VideoIntegrity) bool {
+			return r.Status == IntegrityStatusOriginalMissing
+		})).Return(nil)
+		d.repo.EXPECT().SaveIntegrity(ctx, mock.MatchedBy(func(r *VideoIntegrity) bool {
+			return r.Status == IntegrityStatusOriginalMissing
+		})).Return(nil)
+
+		require.NoError(t, d.uc.Run(ctx))
+	})
+
+	t.Run("ETagChanged", func(t *testing.T) {
+		d := newIntegrityTestDeps(t, false)
+		d.storage.objects["videos/1.mp4"] = []byte("0riginal")
+		video := integrityTestVideo()
+		d.repo.EXPECT().SampleVideos(ctx, 5).Return([]*domain.Video{video}, nil)
+		d.repo.EXPECT().GetIntegrity(ctx, int64(1)).Return(&VideoIntegrity{
+			VideoID: 1, ObjectName: "videos/1.mp4", Size: 8, ETag: originalETag, Status: IntegrityStatusOK,
+		}, nil)
+		d.repo.EXPECT().MarkVideoUnavailable(ctx, int64(1), int32(domain.VideoStatusPublished)).Return(true, nil)
+		d.notifier.EXPECT().NotifyVideoUnavailable(ctx, video, mock.Anything).Return(nil)
+		d.repo.EXPECT().SaveIntegrity(ctx, mock.MatchedBy(func(r *VideoIntegrity) bool {
+			// 损坏时保留原基线，修复文件后仍以原基线核对
+			return r.Status == IntegrityStatusOriginalCorrupted && r.ETag == originalETag
+		})).Return(nil)
+
+		require.NoError(t, d.uc.Run(ctx))
+	})
+
+	t.Run("SizeMismatch", func(t *testing.T) {
+		d := newIntegrityTestDeps(t, false)
+		d.storage.objects["videos/1.mp4"] = []byte("orig")
+		d.repo.EXPECT().SampleVideos(ctx, 5).Return([]*domain.Video{integrityTestVideo()}, nil)
+		d.repo.EXPECT().GetIntegrity(ctx, int64(1)).Return(nil, nil)
+		d.repo.EXPECT().MarkVideoUnavailable(ctx, int64(1), int32(domain.VideoStatusPublished)).Return(false, nil)
+		d.repo.EXPECT().SaveIntegrity(ctx, mock.MatchedBy(func(r *VideoIntegrity) bool {
+			return r.Status == IntegrityStatusOriginalCorrupted && r.Detail == "size 4, expected 8"
+		})).Return(nil)
+
+		require.NoError(t, d.uc.Run(ctx))
+	})
+
+	t.Run("RenditionMissing", func(t *testing.T) {
+		d := newIntegrityTestDeps(t, false)
+		delete(d.storage.objects, "renditions/transcoded_1_720p.mp4")
+		d.repo.EXPECT().SampleVideos(ctx, 5).Return([]*domain.Video{integrityTestVideo()}, nil)
+		d.repo.EXPECT().GetIntegrity(ctx, int64(1)).Return(nil, nil)
+		d.opsRepo.EXPECT().RequeueProcessing(ctx, []int64{1}).Return(1, nil)
+		d.repo.EXPECT().SaveIntegrity(ctx, mock.MatchedBy(func(r *VideoIntegrity) bool {
+			return r.Status == IntegrityStatusDerivedMissing && r.Detail == "missing renditions/transcoded_1_720p.mp4"
+		})).Return(nil)
+
+		require.NoError(t, d.uc.Run(ctx))
+	})
+
+	t.Run("RequeueCooldown", func(t *testing.T) {
+		d := newIntegrityTestDeps(t, false)
+		delete(d.storage.objects, "covers/1.jpg")
+		d.repo.EXPECT().SampleVideos(ctx, 5).Return([]*domain.Video{integrityTestVideo()}, nil)
+		d.repo.EXPECT().GetIntegrity(ctx, int64(1)).Return(&VideoIntegrity{
+			VideoID: 1, ObjectName: "videos/1.mp4", Status: IntegrityStatusDerivedMissing,
+			CheckedAt: d.clock.Now().Add(-10 * time.Minute),
+		}, nil)
+		d.repo.EXPECT().SaveIntegrity(ctx, mock.MatchedBy(func(r *VideoIntegrity) bool {
+			return r.Status == IntegrityStatusDerivedMissing
+		})).Return(nil)
+
+		require.NoError(t, d.uc.Run(ctx))
+	})
+
+	t.Run("SkipForeignObjects", func(t *testing.T) {
+		d := newIntegrityTestDeps(t, false)
+		video := integrityTestVideo()
+		video.PlayURL = "https://other.example.com/videos/1.mp4"
+		d.repo.EXPECT().SampleVideos(ctx, 5).Return([]*domain.Video{video}, nil)
+
+		require.NoError(t, d.uc.Run(ctx))
+	})
+}
@@ -0,0 +1,26 @@
+package biz
+
+import (
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/listquery"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ParseListQuery 解析管理后台列表的过滤和排序表达式，语法错误时返回参数错误
+func ParseListQuery(filter, sort string, page, size int32) (*listquery.Query, error) {
+	q, err := listquery.Parse(filter, sort, page, size)
+	if err != nil {
+		return nil, errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), err.Error())
+	}
+	return q, nil
+}
+
+// compileListQuery 按列表的字段白名单校验表达式，q 为 nil 时只使用默认排序和分页
+func compileListQuery(schema *listquery.Schema, q *listquery.Query) (*listquery.Compiled, error) {
+	compiled, err := schema.Compile(q)
+	if err != nil {
+		return nil, errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), err.Error())
+	}
+	return compiled, nil
+}
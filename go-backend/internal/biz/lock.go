@@ -0,0 +1,31 @@
+package biz
+
+import (
+	"context"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/cache"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrResourceLocked 同一资源正被其他请求处理，等待超时
+var ErrResourceLocked = errors.Conflict(v1.ErrorCode_RESOURCE_LOCKED.String(), "resource is being processed by another request")
+
+// Locker 跨实例的互斥锁
+type Locker interface {
+	// WithLock 持有 key 对应的锁执行 fn，token 为随获取顺序递增的栅栏令牌。
+	// 等待超时返回 cache.ErrLockNotAcquired，持有期间锁丢失时取消 fn 的上下文并返回 cache.ErrLockLost
+	WithLock(ctx context.Context, key string, fn func(ctx context.Context, token int64) error) error
+}
+
+// withLock 持锁执行 fn，拿不到锁时返回 ErrResourceLocked
+func withLock(ctx context.Context, locker Locker, key string, fn func(ctx context.Context) error) error {
+	err := locker.WithLock(ctx, key, func(ctx context.Context, _ int64) error {
+		return fn(ctx)
+	})
+	if errors.Is(err, cache.ErrLockNotAcquired) {
+		return ErrResourceLocked
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"go-backend/pkg/cache"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// localLocker 进程内的 Locker 实现，锁被占用时不等待直接返回 cache.ErrLockNotAcquired
+type localLocker struct {
+	mu    sync.Mutex
+	held  map[string]bool
+	token int64
+}
+
+func newTestLocker() *localLocker {
+	return &localLocker{held: make(map[string]bool)}
+}
+
+func (l *localLocker) WithLock(ctx context.Context, key string, fn func(ctx context.Context, token int64) error) error {
+	l.mu.Lock()
+	if l.held[key] {
+		l.mu.Unlock()
+		return cache.ErrLockNotAcquired
+	}
+	l.held[key] = true
+	l.token++
+	token := l.token
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		delete(l.held, key)
+		l.mu.Unlock()
+	}()
+	return fn(ctx, token)
+}
+
+func TestWithLock(t *testing.T) {
+	ctx := context.Background()
+	locker := newTestLocker()
+
+	err := withLock(ctx, locker, "k", func(ctx context.Context) error {
+		// 持有期间同一键的请求被拒绝，其他键不受影响
+		assert.Equal(t, ErrResourceLocked, withLock(ctx, locker, "k", func(context.Context) error { return nil }))
+		assert.NoError(t, withLock(ctx, locker, "other", func(context.Context) error { return nil }))
+		return assert.AnError
+	})
+	assert.Equal(t, assert.AnError, err)
+
+	// 释放后可以再次获取
+	assert.NoError(t, withLock(ctx, locker, "k", func(context.Context) error { return nil }))
+}
@@ -0,0 +1,278 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/reqctx"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrLoginChallengeRequired 异常登录已被拒绝，验证码已发往已绑定邮箱
+var ErrLoginChallengeRequired = errors.Forbidden(v1.ErrorCode_LOGIN_CHALLENGE_REQUIRED.String(), "login from unrecognized device, verification code sent to bound email")
+
+// 登录记录状态
+const (
+	LoginStatusAllowed    int8 = 1 // 已放行
+	LoginStatusChallenged int8 = 2 // 异常登录被拒绝，等待输入验证码
+	LoginStatusVerified   int8 = 3 // 已通过验证码验证
+)
+
+// 发现异常登录时的处理方式
+const (
+	LoginAnomalyActionNotify    = "notify"
+	LoginAnomalyActionChallenge = "challenge"
+)
+
+// 异常原因
+const (
+	LoginReasonNewDevice  = "new_device"
+	LoginReasonNewNetwork = "new_network"
+)
+
+const (
+	defaultLoginHistoryWindow = 90 * 24 * time.Hour
+	defaultLoginChallengeTTL  = 15 * time.Minute
+	// loginChallengeResendWindow 窗口内重复登录不重新发送验证码
+	loginChallengeResendWindow = time.Minute
+	// loginChallengeMaxAttempts 验证码输错次数上限，达到后需重新登录获取新验证码
+	loginChallengeMaxAttempts = 5
+)
+
+// LoginRecord 一次通过密码校验的登录
+type LoginRecord struct {
+	ID                int64
+	UserID            int64
+	DeviceID          string
+	Fingerprint       string // 设备ID和User-Agent的SHA-256
+	IP                string
+	Network           string // IPv4 取 /24，IPv6 取 /48
+	UserAgent         string
+	Status            int8
+	Reason            string // 逗号分隔的异常原因，正常登录为空
+	ChallengeCode     string // 验证码的SHA-256，仅等待验证的记录有值
+	ChallengeAttempts int
+	CreatedAt         time.Time
+}
+
+// LoginBaseline 本次登录与历史成功登录的比较
+type LoginBaseline struct {
+	Logins      int64 // 窗口内成功登录次数，包括放行和通过验证的登录
+	DeviceSeen  bool  // 窗口内是否从同一设备指纹成功登录过
+	NetworkSeen bool  // 窗口内是否从同一网络段成功登录过
+}
+
+// LoginHistoryRepo 登录记录仓储
+type LoginHistoryRepo interface {
+	CreateLoginRecord(ctx context.Context, record *LoginRecord) error
+	// GetLoginBaseline 统计 since 之后的成功登录，并判断设备指纹和网络段是否出现过，network 为空时视为未出现
+	GetLoginBaseline(ctx context.Context, userID int64, fingerprint, network string, since time.Time) (*LoginBaseline, error)
+	// GetPendingChallenge 返回 since 之后该设备最近一次等待验证的登录，不存在时返回nil
+	GetPendingChallenge(ctx context.Context, userID int64, fingerprint string, since time.Time) (*LoginRecord, error)
+	// UpdateChallenge 更新等待验证的登录的状态和验证码输错次数
+	UpdateChallenge(ctx context.Context, id int64, status int8, attempts int) error
+	// ListLoginRecords 按登录时间倒序分页查询
+	ListLoginRecords(ctx context.Context, userID int64, page, size int32) ([]*LoginRecord, int64, error)
+}
+
+// LoginAnomalyUsecase 异常登录检测。每次通过密码校验的登录都记录设备指纹和网络段，
+// 有历史成功登录的账号从从未出现过的设备和网络段同时登录时视为异常。
+// 过期记录由数据保留任务按 login_history 策略清理
+type LoginAnomalyUsecase struct {
+	repo     LoginHistoryRepo
+	sender   EmailSender
+	notifier SecurityEventNotifier
+	clock    clock.Clock
+
+	enabled      bool
+	action       string
+	window       time.Duration
+	challengeTTL time.Duration
+
+	log *log.Helper
+}
+
+// NewLoginAnomalyUsecase 创建异常登录检测用例
+func NewLoginAnomalyUsecase(
+	repo LoginHistoryRepo,
+	sender EmailSender,
+	notifier SecurityEventNotifier,
+	businessConfig *conf.Business,
+	clk clock.Clock,
+	logger log.Logger,
+) *LoginAnomalyUsecase {
+	uc := &LoginAnomalyUsecase{
+		repo:         repo,
+		sender:       sender,
+		notifier:     notifier,
+		clock:        clk,
+		action:       LoginAnomalyActionNotify,
+		window:       defaultLoginHistoryWindow,
+		challengeTTL: defaultLoginChallengeTTL,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetLoginAnomaly(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		if cfg.Action == LoginAnomalyActionChallenge {
+			uc.action = cfg.Action
+		}
+		if cfg.HistoryWindow != nil && cfg.HistoryWindow.AsDuration() > 0 {
+			uc.window = cfg.HistoryWindow.AsDuration()
+		}
+		if cfg.ChallengeTtl != nil && cfg.ChallengeTtl.AsDuration() > 0 {
+			uc.challengeTTL = cfg.ChallengeTtl.AsDuration()
+		}
+	}
+
+	return uc
+}
+
+// CheckLogin 记录本次登录并检测异常。challenge 模式下异常登录需携带发往已绑定邮箱的验证码，
+// 未绑定邮箱的账号无法验证，按 notify 处理。查询历史失败时不阻断登录
+func (uc *LoginAnomalyUsecase) CheckLogin(ctx context.Context, user *User, challengeCode string) error {
+	record := newLoginRecord(ctx, user.ID, uc.clock.Now())
+
+	if uc.enabled {
+		baseline, err := uc.repo.GetLoginBaseline(ctx, user.ID, record.Fingerprint, record.Network, record.CreatedAt.Add(-uc.window))
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("get login baseline failed: user=%d err=%v", user.ID, err)
+		} else {
+			record.Reason = baseline.anomaly()
+		}
+	}
+
+	if record.Reason != "" && uc.action == LoginAnomalyActionChallenge && user.Email != "" {
+		return uc.challenge(ctx, user, record, challengeCode)
+	}
+
+	record.Status = LoginStatusAllowed
+	if err := uc.repo.CreateLoginRecord(ctx, record); err != nil {
+		uc.log.WithContext(ctx).Warnf("create login record failed: user=%d err=%v", user.ID, err)
+	}
+	if record.Reason != "" {
+		uc.notify(ctx, record)
+	}
+	return nil
+}
+
+// challenge 校验验证码，未携带验证码时生成新验证码发往邮箱并拒绝本次登录。
+// 通过验证的登录计入成功登录，之后从该设备登录不再要求验证
+func (uc *LoginAnomalyUsecase) challenge(ctx context.Context, user *User, record *LoginRecord, code string) error {
+	pending, err := uc.repo.GetPendingChallenge(ctx, user.ID, record.Fingerprint, record.CreatedAt.Add(-uc.challengeTTL))
+	if err != nil {
+		return err
+	}
+
+	if code != "" {
+		if pending == nil || pending.ChallengeAttempts >= loginChallengeMaxAttempts {
+			return ErrVerificationCodeInvalid
+		}
+		if subtle.ConstantTimeCompare([]byte(pending.ChallengeCode), []byte(hashLoginChallengeCode(code))) != 1 {
+			if err := uc.repo.UpdateChallenge(ctx, pending.ID, LoginStatusChallenged, pending.ChallengeAttempts+1); err != nil {
+				uc.log.WithContext(ctx).Warnf("update login challenge attempts failed: user=%d err=%v", user.ID, err)
+			}
+			return ErrVerificationCodeInvalid
+		}
+		return uc.repo.UpdateChallenge(ctx, pending.ID, LoginStatusVerified, pending.ChallengeAttempts)
+	}
+
+	if pending != nil && record.CreatedAt.Sub(pending.CreatedAt) < loginChallengeResendWindow {
+		return ErrLoginChallengeRequired
+	}
+
+	plain, err := generateVerificationCode(emailCodeLength)
+	if err != nil {
+		return err
+	}
+	record.Status = LoginStatusChallenged
+	record.ChallengeCode = hashLoginChallengeCode(plain)
+	if err := uc.repo.CreateLoginRecord(ctx, record); err != nil {
+		return err
+	}
+	uc.notify(ctx, record)
+
+	if err := uc.sender.SendVerificationCode(ctx, user.Email, plain); err != nil {
+		return err
+	}
+	return ErrLoginChallengeRequired
+}
+
+// notify 发出异常登录安全事件，投递失败只记录日志
+func (uc *LoginAnomalyUsecase) notify(ctx context.Context, record *LoginRecord) {
+	event := &domain.SecurityEvent{
+		Type:       domain.SecurityEventLoginAnomaly,
+		UserID:     record.UserID,
+		Detail:     fmt.Sprintf("reason=%s ip=%s device=%q status=%d", record.Reason, record.IP, record.DeviceID, record.Status),
+		OccurredAt: record.CreatedAt,
+	}
+	if err := uc.notifier.NotifySecurityEvent(ctx, event); err != nil {
+		uc.log.WithContext(ctx).Warnf("notify security event failed: %v", err)
+	}
+}
+
+// ListLoginHistory 获取用户的登录记录
+func (uc *LoginAnomalyUsecase) ListLoginHistory(ctx context.Context, userID int64, page, size int32) ([]*LoginRecord, int64, error) {
+	page, size = NormalizePage(page, size)
+	return uc.repo.ListLoginRecords(ctx, userID, page, size)
+}
+
+// anomaly 有历史成功登录且设备和网络段都未出现过时返回异常原因，首次登录作为基线不视为异常
+func (b *LoginBaseline) anomaly() string {
+	if b.Logins == 0 || b.DeviceSeen || b.NetworkSeen {
+		return ""
+	}
+	return strings.Join([]string{LoginReasonNewDevice, LoginReasonNewNetwork}, ",")
+}
+
+// newLoginRecord 从请求上下文提取设备和网络信息
+func newLoginRecord(ctx context.Context, userID int64, now time.Time) *LoginRecord {
+	deviceID, _ := reqctx.DeviceID(ctx)
+	ip, _ := reqctx.ClientIP(ctx)
+	userAgent, _ := reqctx.UserAgent(ctx)
+
+	return &LoginRecord{
+		UserID:      userID,
+		DeviceID:    truncateRunes(deviceID, 128),
+		Fingerprint: LoginFingerprint(deviceID, userAgent),
+		IP:          ip,
+		Network:     LoginNetwork(ip),
+		UserAgent:   truncateRunes(userAgent, 255),
+		CreatedAt:   now,
+	}
+}
+
+// LoginFingerprint 设备指纹，由设备ID和User-Agent计算
+func LoginFingerprint(deviceID, userAgent string) string {
+	sum := sha256.Sum256([]byte(deviceID + "\n" + userAgent))
+	return hex.EncodeToString(sum[:])
+}
+
+// LoginNetwork 客户端IP所在的网络段，IPv4 取 /24，IPv6 取 /48，无法解析时返回空
+func LoginNetwork(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return (&net.IPNet{IP: v4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+	}
+	return (&net.IPNet{IP: parsed.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}).String()
+}
+
+func hashLoginChallengeCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/reqctx"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type loginAnomalyTestDeps struct {
+	uc       *LoginAnomalyUsecase
+	repo     *MockLoginHistoryRepo
+	sender   *MockEmailSender
+	notifier *MockSecurityEventNotifier
+	clock    *clock.Fake
+}
+
+func newLoginAnomalyTestDeps(t *testing.T, action string) *loginAnomalyTestDeps {
+	d := &loginAnomalyTestDeps{
+		repo:     NewMockLoginHistoryRepo(t),
+		sender:   NewMockEmailSender(t),
+		notifier: NewMockSecurityEventNotifier(t),
+		clock:    clock.NewFake(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)),
+	}
+	config := &conf.Business{LoginAnomaly: &conf.Business_LoginAnomaly{Enabled: true, Action: action}}
+	d.uc = NewLoginAnomalyUsecase(d.repo, d.sender, d.notifier, config, d.clock, log.DefaultLogger)
+	return d
+}
+
+func loginContext() context.Context {
+	ctx := reqctx.WithDeviceID(context.Background(), "device-1")
+	ctx = reqctx.WithClientIP(ctx, "203.0.113.7")
+	return reqctx.WithUserAgent(ctx, "douyin-ios/1.0")
+}
+
+func TestLoginNetwork(t *testing.T) {
+	assert.Equal(t, "203.0.113.0/24", LoginNetwork("203.0.113.7"))
+	assert.Equal(t, "2001:db8:1::/48", LoginNetwork("2001:db8:1:2::5"))
+	assert.Equal(t, "", LoginNetwork("unknown"))
+}
+
+func TestLoginAnomalyUsecase_CheckLogin(t *testing.T) {
+	ctx := loginContext()
+	user := &User{ID: 1, Email: "alice@example.com"}
+	fingerprint := LoginFingerprint("device-1", "douyin-ios/1.0")
+
+	t.Run("KnownDevice", func(t *testing.T) {
+		d := newLoginAnomalyTestDeps(t, LoginAnomalyActionChallenge)
+		d.repo.EXPECT().GetLoginBaseline(ctx, int64(1), fingerprint, "203.0.113.0/24", d.clock.Now().Add(-defaultLoginHistoryWindow)).
+			Return(&LoginBaseline{Logins: 3, DeviceSeen: true}, nil)
+		d.repo.EXPECT().CreateLoginRecord(ctx, mock.MatchedBy(func(r *LoginRecord) bool {
+			return r.Status == LoginStatusAllowed && r.Reason == "" && r.DeviceID == "device-1" && r.IP == "203.0.113.7"
+		})).Return(nil)
+
+		assert.NoError(t, d.uc.CheckLogin(ctx, user, ""))
+	})
+
+	t.Run("FirstLoginIsBaseline", func(t *testing.T) {
+		d := newLoginAnomalyTestDeps(t, LoginAnomalyActionChallenge)
+		d.repo.EXPECT().GetLoginBaseline(ctx, int64(1), fingerprint, mock.Anything, mock.Anything).Return(&LoginBaseline{}, nil)
+		d.repo.EXPECT().CreateLoginRecord(ctx, mock.MatchedBy(func(r *LoginRecord) bool {
+			return r.Status == LoginStatusAllowed && r.Reason == ""
+		})).Return(nil)
+
+		assert.NoError(t, d.uc.CheckLogin(ctx, user, ""))
+	})
+
+	t.Run("NotifyMode", func(t *testing.T) {
+		d := newLoginAnomalyTestDeps(t, LoginAnomalyActionNotify)
+		d.repo.EXPECT().GetLoginBaseline(ctx, int64(1), fingerprint, mock.Anything, mock.Anything).Return(&LoginBaseline{Logins: 3}, nil)
+		d.repo.EXPECT().CreateLoginRecord(ctx, mock.MatchedBy(func(r *LoginRecord) bool {
+			return r.Status == LoginStatusAllowed && r.Reason == "new_device,new_network"
+		})).Return(nil)
+		d.notifier.EXPECT().NotifySecurityEvent(ctx, mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+			return e.Type == domain.SecurityEventLoginAnomaly && e.UserID == 1
+		})).Return(nil)
+
+		assert.NoError(t, d.uc.CheckLogin(ctx, user, ""))
+	})
+
+	t.Run("ChallengeWithoutEmail", func(t *testing.T) {
+		// 未绑定邮箱的账号无法验证，放行并告警
+		d := newLoginAnomalyTestDeps(t, LoginAnomalyActionChallenge)
+		d.repo.EXPECT().GetLoginBaseline(ctx, int64(2), fingerprint, mock.Anything, mock.Anything).Return(&LoginBaseline{Logins: 3}, nil)
+		d.repo.EXPECT().CreateLoginRecord(ctx, mock.Anything).Return(nil)
+		d.notifier.EXPECT().NotifySecurityEvent(ctx, mock.Anything).Return(nil)
+
+		assert.NoError(t, d.uc.CheckLogin(ctx, &User{ID: 2}, ""))
+	})
+
+	t.Run("BaselineErrorDoesNotBlock", func(t *testing.T) {
+		d := newLoginAnomalyTestDeps(t, LoginAnomalyActionChallenge)
+		d.repo.EXPECT().GetLoginBaseline(ctx, int64(1), fingerprint, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+		d.repo.EXPECT().CreateLoginRecord(ctx, mock.Anything).Return(nil)
+
+		assert.NoError(t, d.uc.CheckLogin(ctx, user, ""))
+	})
+}
+
+func TestLoginAnomalyUsecase_Challenge(t *testing.T) {
+	ctx := loginContext()
+	user := &User{ID: 1, Email: "alice@example.com"}
+	d := newLoginAnomalyTestDeps(t, LoginAnomalyActionChallenge)
+	d.repo.EXPECT().GetLoginBaseline(ctx, int64(1), mock.Anything, mock.Anything, mock.Anything).Return(&LoginBaseline{Logins: 3}, nil)
+
+	// 首次登录：生成验证码发往邮箱并拒绝
+	var pending *LoginRecord
+	var code string
+	d.repo.EXPECT().GetPendingChallenge(ctx, int64(1), mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, userID int64, fingerprint string, since time.Time) (*LoginRecord, error) {
+			return pending, nil
+		})
+	d.repo.EXPECT().CreateLoginRecord(ctx, mock.Anything).Run(func(ctx context.Context, record *LoginRecord) {
+		record.ID = 10
+		pending = record
+	}).Return(nil).Once()
+	d.notifier.EXPECT().NotifySecurityEvent(ctx, mock.Anything).Return(nil).Once()
+	d.sender.EXPECT().SendVerificationCode(ctx, "alice@example.com", mock.Anything).Run(func(ctx context.Context, email, c string) {
+		code = c
+	}).Return(nil).Once()
+
+	assert.Equal(t, ErrLoginChallengeRequired, d.uc.CheckLogin(ctx, user, ""))
+	require.NotNil(t, pending)
+	assert.Equal(t, LoginStatusChallenged, pending.Status)
+	assert.NotEqual(t, code, pending.ChallengeCode)
+
+	// 重发窗口内再次登录不重新发送
+	d.clock.Advance(30 * time.Second)
+	assert.Equal(t, ErrLoginChallengeRequired, d.uc.CheckLogin(ctx, user, ""))
+
+	// 验证码错误时累计输错次数
+	d.repo.EXPECT().UpdateChallenge(ctx, int64(10), LoginStatusChallenged, 1).Return(nil).Once()
+	assert.Equal(t, ErrVerificationCodeInvalid, d.uc.CheckLogin(ctx, user, "000000x"))
+
+	// 验证通过后放行
+	d.repo.EXPECT().UpdateChallenge(ctx, int64(10), LoginStatusVerified, 0).Return(nil).Once()
+	assert.NoError(t, d.uc.CheckLogin(ctx, user, code))
+
+	// 输错次数达到上限后不再接受验证码
+	pending.ChallengeAttempts = loginChallengeMaxAttempts
+	assert.Equal(t, ErrVerificationCodeInvalid, d.uc.CheckLogin(ctx, user, code))
+}
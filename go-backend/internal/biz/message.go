@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrNotFriend     = errors.Forbidden(v1.ErrorCode_NOT_FRIEND.String(), "only friends can send messages")
+	ErrMessageToSelf = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "cannot send message to yourself")
+)
+
+// 消息类型
+const (
+	MessageTypeText int32 = 1
+)
+
+// 好友列表中最新消息的方向
+const (
+	MsgTypeReceived int64 = 0 // 当前用户接收的消息
+	MsgTypeSent     int64 = 1 // 当前用户发送的消息
+)
+
+// 聊天记录单次拉取上限
+const maxMessageHistory = 100
+
+// Message is a Message model.
+type Message struct {
+	ID          int64
+	FromUserID  int64
+	ToUserID    int64
+	Content     string
+	MessageType int32
+	CreatedAt   time.Time
+}
+
+// MessageRepo is a Message repo.
+type MessageRepo interface {
+	CreateMessage(context.Context, *Message) (*Message, error)
+	// GetMessages 获取两个用户之间晚于指定时间的消息，按时间升序
+	GetMessages(context.Context, int64, int64, time.Time, int) ([]*Message, error)
+	// GetLatestMessages 获取用户与每个好友之间的最新一条消息，key为好友ID
+	GetLatestMessages(context.Context, int64, []int64) (map[int64]*Message, error)
+}
+
+// MessageUsecase is a Message usecase.
+type MessageUsecase struct {
+	repo         MessageRepo
+	relationRepo RelationRepo
+	log          *log.Helper
+}
+
+// NewMessageUsecase new a Message usecase.
+func NewMessageUsecase(repo MessageRepo, relationRepo RelationRepo, logger log.Logger) *MessageUsecase {
+	return &MessageUsecase{repo: repo, relationRepo: relationRepo, log: log.NewHelper(logger)}
+}
+
+// SendMessage sends a text message to a friend.
+func (uc *MessageUsecase) SendMessage(ctx context.Context, fromUserID, toUserID int64, content string) (*Message, error) {
+	uc.log.WithContext(ctx).Infof("User %d sends message to user %d", fromUserID, toUserID)
+
+	if fromUserID == toUserID {
+		return nil, ErrMessageToSelf
+	}
+
+	// 只有互相关注的好友才能发送私信
+	isFriend, err := uc.IsFriend(ctx, fromUserID, toUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isFriend {
+		return nil, ErrNotFriend
+	}
+
+	return uc.repo.CreateMessage(ctx, &Message{
+		FromUserID:  fromUserID,
+		ToUserID:    toUserID,
+		Content:     content,
+		MessageType: MessageTypeText,
+	})
+}
+
+// GetMessageHistory gets messages between two users after preMsgTime (in milliseconds).
+func (uc *MessageUsecase) GetMessageHistory(ctx context.Context, userID, toUserID, preMsgTime int64) ([]*Message, error) {
+	var since time.Time
+	if preMsgTime > 0 {
+		since = time.UnixMilli(preMsgTime)
+	}
+
+	return uc.repo.GetMessages(ctx, userID, toUserID, since, maxMessageHistory)
+}
+
+// GetLatestMessages gets the latest message between user and each friend.
+func (uc *MessageUsecase) GetLatestMessages(ctx context.Context, userID int64, friendIDs []int64) (map[int64]*Message, error) {
+	if len(friendIDs) == 0 {
+		return map[int64]*Message{}, nil
+	}
+
+	return uc.repo.GetLatestMessages(ctx, userID, friendIDs)
+}
+
+// IsFriend checks if two users follow each other.
+func (uc *MessageUsecase) IsFriend(ctx context.Context, userID, otherUserID int64) (bool, error) {
+	following, err := uc.relationRepo.IsFollowing(ctx, userID, otherUserID)
+	if err != nil || !following {
+		return false, err
+	}
+
+	return uc.relationRepo.IsFollowing(ctx, otherUserID, userID)
+}
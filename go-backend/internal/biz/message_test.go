@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageUsecase_SendMessage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SendMessage_Success", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(true, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(2), int64(1)).Return(true, nil)
+		messageRepo.EXPECT().CreateMessage(ctx, mock.MatchedBy(func(m *Message) bool {
+			return m.FromUserID == 1 && m.ToUserID == 2 && m.Content == "hi" && m.MessageType == MessageTypeText
+		})).Return(&Message{ID: 10, FromUserID: 1, ToUserID: 2, Content: "hi"}, nil)
+
+		msg, err := uc.SendMessage(ctx, 1, 2, "hi")
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), msg.ID)
+	})
+
+	t.Run("SendMessage_ToSelf", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		_, err := uc.SendMessage(ctx, 1, 1, "hi")
+
+		assert.Equal(t, ErrMessageToSelf, err)
+	})
+
+	t.Run("SendMessage_NotFollowing", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(false, nil)
+
+		_, err := uc.SendMessage(ctx, 1, 2, "hi")
+
+		assert.Equal(t, ErrNotFriend, err)
+	})
+
+	t.Run("SendMessage_NotFollowedBack", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(true, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(2), int64(1)).Return(false, nil)
+
+		_, err := uc.SendMessage(ctx, 1, 2, "hi")
+
+		assert.Equal(t, ErrNotFriend, err)
+	})
+
+	t.Run("SendMessage_RelationError", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(false, assert.AnError)
+
+		_, err := uc.SendMessage(ctx, 1, 2, "hi")
+
+		assert.Equal(t, assert.AnError, err)
+	})
+}
+
+func TestMessageUsecase_GetMessageHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GetMessageHistory_FromBeginning", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		expected := []*Message{{ID: 1}, {ID: 2}}
+		messageRepo.EXPECT().GetMessages(ctx, int64(1), int64(2), time.Time{}, maxMessageHistory).Return(expected, nil)
+
+		messages, err := uc.GetMessageHistory(ctx, 1, 2, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, messages)
+	})
+
+	t.Run("GetMessageHistory_SincePreMsgTime", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		preMsgTime := int64(1700000000000)
+		messageRepo.EXPECT().GetMessages(ctx, int64(1), int64(2), time.UnixMilli(preMsgTime), maxMessageHistory).Return([]*Message{}, nil)
+
+		messages, err := uc.GetMessageHistory(ctx, 1, 2, preMsgTime)
+
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+}
+
+func TestMessageUsecase_GetLatestMessages(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GetLatestMessages_NoFriends", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		messages, err := uc.GetLatestMessages(ctx, 1, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("GetLatestMessages_Success", func(t *testing.T) {
+		messageRepo := NewMockMessageRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewMessageUsecase(messageRepo, relationRepo, log.DefaultLogger)
+
+		expected := map[int64]*Message{2: {ID: 5, FromUserID: 2, ToUserID: 1}}
+		messageRepo.EXPECT().GetLatestMessages(ctx, int64(1), []int64{2, 3}).Return(expected, nil)
+
+		messages, err := uc.GetLatestMessages(ctx, 1, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, messages)
+	})
+}
@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/domain"
+	"go-backend/pkg/richtext"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrVideoNotPending      = errors.Conflict(v1.ErrorCode_VIDEO_NOT_PENDING.String(), "video is not pending review")
+	ErrRejectReasonRequired = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "reject reason is required")
+	ErrAuditReasonTooLong   = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "audit reason is too long")
+	ErrInvalidAuditAction   = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "invalid audit action")
+)
+
+// 审核操作
+const (
+	AuditActionApprove int32 = 1
+	AuditActionReject  int32 = 2
+)
+
+// 审核意见最大长度
+const maxAuditReasonLength = 200
+
+// PendingVideoStatuses 等待人工审核的视频状态
+var PendingVideoStatuses = []int32{domain.VideoStatusPending, domain.VideoStatusAuditing}
+
+// VideoAudit 视频审核记录
+type VideoAudit struct {
+	ID         int64
+	VideoID    int64
+	AuditorID  int64
+	FromStatus int32
+	ToStatus   int32
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// ModerationRepo is a Moderation repo.
+type ModerationRepo interface {
+	// ListPendingVideos 分页获取待审核视频，按提交时间正序
+	ListPendingVideos(context.Context, int32, int32) ([]*domain.Video, int64, error)
+	// AuditVideo 在事务内更新待审核视频的状态并写入审核记录，视频不处于待审核状态时返回ErrVideoNotPending
+	AuditVideo(context.Context, *VideoAudit) (*domain.Video, error)
+}
+
+// ModerationUsecase is a Moderation usecase.
+type ModerationUsecase struct {
+	repo         ModerationRepo
+	permissionUc *PermissionUsecase
+	log          *log.Helper
+}
+
+// NewModerationUsecase new a Moderation usecase.
+func NewModerationUsecase(repo ModerationRepo, permissionUc *PermissionUsecase, logger log.Logger) *ModerationUsecase {
+	return &ModerationUsecase{
+		repo:         repo,
+		permissionUc: permissionUc,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// ListPendingVideos lists videos waiting for review.
+func (uc *ModerationUsecase) ListPendingVideos(ctx context.Context, moderatorID int64, page, size int32) ([]*domain.Video, int64, error) {
+	if err := uc.checkModerator(ctx, moderatorID); err != nil {
+		return nil, 0, err
+	}
+
+	page, size = NormalizePage(page, size)
+	return uc.repo.ListPendingVideos(ctx, page, size)
+}
+
+// ReviewVideo approves or rejects a pending video. A reason is required on rejection.
+func (uc *ModerationUsecase) ReviewVideo(ctx context.Context, moderatorID, videoID int64, action int32, reason string) (*domain.Video, error) {
+	if err := uc.checkModerator(ctx, moderatorID); err != nil {
+		return nil, err
+	}
+
+	reason = richtext.Sanitize(reason)
+	if utf8.RuneCountInString(reason) > maxAuditReasonLength {
+		return nil, ErrAuditReasonTooLong
+	}
+
+	var toStatus int32
+	switch action {
+	case AuditActionApprove:
+		toStatus = domain.VideoStatusPublished
+	case AuditActionReject:
+		if reason == "" {
+			return nil, ErrRejectReasonRequired
+		}
+		toStatus = domain.VideoStatusRejected
+	default:
+		return nil, ErrInvalidAuditAction
+	}
+
+	uc.log.WithContext(ctx).Infof("Moderator %d reviews video %d: status=%d", moderatorID, videoID, toStatus)
+
+	return uc.repo.AuditVideo(ctx, &VideoAudit{
+		VideoID:   videoID,
+		AuditorID: moderatorID,
+		ToStatus:  toStatus,
+		Reason:    reason,
+	})
+}
+
+func (uc *ModerationUsecase) checkModerator(ctx context.Context, userID int64) error {
+	allowed, err := uc.permissionUc.CanModerateContent(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrPermissionDenied
+	}
+	return nil
+}
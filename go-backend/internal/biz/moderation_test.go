@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type moderationTestDeps struct {
+	repo     *MockModerationRepo
+	roleRepo *MockRoleRepo
+	uc       *ModerationUsecase
+}
+
+func newModerationTestDeps(t *testing.T) *moderationTestDeps {
+	repo := NewMockModerationRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+
+	return &moderationTestDeps{
+		repo:     repo,
+		roleRepo: roleRepo,
+		uc:       NewModerationUsecase(repo, permissionUc, log.DefaultLogger),
+	}
+}
+
+// expectModerator 模拟用户为审核员而非管理员
+func (d *moderationTestDeps) expectModerator(ctx context.Context, userID int64, isModerator bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(false, nil)
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "moderator").Return(&domain.Role{ID: 3, Name: "moderator"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(3)).Return(isModerator, nil)
+}
+
+func TestModerationUsecase_ListPendingVideos(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Moderator", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 5, true)
+		d.repo.EXPECT().ListPendingVideos(ctx, int32(1), int32(20)).
+			Return([]*domain.Video{{ID: 10, Status: domain.VideoStatusPending}}, 1, nil)
+
+		videos, total, err := d.uc.ListPendingVideos(ctx, 5, 0, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, videos, 1)
+	})
+
+	t.Run("Admin", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, int64(6), int64(1)).Return(true, nil)
+		d.repo.EXPECT().ListPendingVideos(ctx, int32(2), int32(10)).Return([]*domain.Video{}, 0, nil)
+
+		_, _, err := d.uc.ListPendingVideos(ctx, 6, 2, 10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 7, false)
+
+		_, _, err := d.uc.ListPendingVideos(ctx, 7, 1, 10)
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
+
+func TestModerationUsecase_ReviewVideo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Approve", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 5, true)
+		d.repo.EXPECT().AuditVideo(ctx, mock.MatchedBy(func(a *VideoAudit) bool {
+			return a.VideoID == 10 && a.AuditorID == 5 && a.ToStatus == domain.VideoStatusPublished
+		})).Return(&domain.Video{ID: 10, Status: domain.VideoStatusPublished}, nil)
+
+		video, err := d.uc.ReviewVideo(ctx, 5, 10, AuditActionApprove, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.VideoStatusPublished), video.Status)
+	})
+
+	t.Run("RejectWithReason", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 5, true)
+		d.repo.EXPECT().AuditVideo(ctx, mock.MatchedBy(func(a *VideoAudit) bool {
+			return a.ToStatus == domain.VideoStatusRejected && a.Reason == "spam content"
+		})).Return(&domain.Video{ID: 10, Status: domain.VideoStatusRejected}, nil)
+
+		_, err := d.uc.ReviewVideo(ctx, 5, 10, AuditActionReject, " <b>spam</b> content ")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("RejectWithoutReason", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 5, true)
+
+		_, err := d.uc.ReviewVideo(ctx, 5, 10, AuditActionReject, "  ")
+
+		assert.Equal(t, ErrRejectReasonRequired, err)
+	})
+
+	t.Run("ReasonTooLong", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 5, true)
+
+		_, err := d.uc.ReviewVideo(ctx, 5, 10, AuditActionReject, strings.Repeat("a", maxAuditReasonLength+1))
+
+		assert.Equal(t, ErrAuditReasonTooLong, err)
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 5, true)
+
+		_, err := d.uc.ReviewVideo(ctx, 5, 10, 3, "")
+
+		assert.Equal(t, ErrInvalidAuditAction, err)
+	})
+
+	t.Run("NotModerator", func(t *testing.T) {
+		d := newModerationTestDeps(t)
+
+		d.expectModerator(ctx, 7, false)
+
+		_, err := d.uc.ReviewVideo(ctx, 7, 10, AuditActionApprove, "")
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/richtext"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrInvalidMutedKeyword  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "muted keyword must be 1-20 characters")
+	ErrTooManyMutedKeywords = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "too many muted keywords")
+)
+
+// 屏蔽词限制
+const (
+	maxMutedKeywords      = 100
+	maxMutedKeywordLength = 20
+)
+
+// MutedKeywordRepo is a MutedKeyword repo.
+type MutedKeywordRepo interface {
+	// AddMutedKeyword 添加屏蔽词，已存在时直接返回成功
+	AddMutedKeyword(context.Context, int64, string) error
+	RemoveMutedKeyword(context.Context, int64, string) error
+	GetMutedKeywords(context.Context, int64) ([]string, error)
+}
+
+// MutedKeywordUsecase is a MutedKeyword usecase.
+type MutedKeywordUsecase struct {
+	repo MutedKeywordRepo
+	log  *log.Helper
+}
+
+// NewMutedKeywordUsecase new a MutedKeyword usecase.
+func NewMutedKeywordUsecase(repo MutedKeywordRepo, logger log.Logger) *MutedKeywordUsecase {
+	return &MutedKeywordUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// AddMutedKeyword adds a keyword muted in comments on the user's videos.
+func (uc *MutedKeywordUsecase) AddMutedKeyword(ctx context.Context, userID int64, keyword string) error {
+	keyword, err := normalizeMutedKeyword(keyword)
+	if err != nil {
+		return err
+	}
+
+	keywords, err := uc.repo.GetMutedKeywords(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, k := range keywords {
+		if k == keyword {
+			return nil
+		}
+	}
+	if len(keywords) >= maxMutedKeywords {
+		return ErrTooManyMutedKeywords
+	}
+
+	uc.log.WithContext(ctx).Infof("User %d mutes keyword %q", userID, keyword)
+	return uc.repo.AddMutedKeyword(ctx, userID, keyword)
+}
+
+// RemoveMutedKeyword removes a muted keyword.
+func (uc *MutedKeywordUsecase) RemoveMutedKeyword(ctx context.Context, userID int64, keyword string) error {
+	keyword, err := normalizeMutedKeyword(keyword)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.RemoveMutedKeyword(ctx, userID, keyword)
+}
+
+// ListMutedKeywords lists muted keywords of the user.
+func (uc *MutedKeywordUsecase) ListMutedKeywords(ctx context.Context, userID int64) ([]string, error) {
+	return uc.repo.GetMutedKeywords(ctx, userID)
+}
+
+// normalizeMutedKeyword 屏蔽词统一清理并转为小写，匹配时不区分大小写
+func normalizeMutedKeyword(keyword string) (string, error) {
+	keyword = strings.ToLower(richtext.Sanitize(keyword))
+	if n := utf8.RuneCountInString(keyword); n == 0 || n > maxMutedKeywordLength {
+		return "", ErrInvalidMutedKeyword
+	}
+	return keyword, nil
+}
@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutedKeywordUsecase_AddMutedKeyword(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Normalize", func(t *testing.T) {
+		repo := NewMockMutedKeywordRepo(t)
+		uc := NewMutedKeywordUsecase(repo, log.DefaultLogger)
+
+		repo.EXPECT().GetMutedKeywords(ctx, int64(1)).Return([]string{}, nil)
+		repo.EXPECT().AddMutedKeyword(ctx, int64(1), "spam").Return(nil)
+
+		err := uc.AddMutedKeyword(ctx, 1, "  <b>SPAM</b> ")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyMuted", func(t *testing.T) {
+		repo := NewMockMutedKeywordRepo(t)
+		uc := NewMutedKeywordUsecase(repo, log.DefaultLogger)
+
+		repo.EXPECT().GetMutedKeywords(ctx, int64(1)).Return([]string{"spam"}, nil)
+
+		err := uc.AddMutedKeyword(ctx, 1, "Spam")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		repo := NewMockMutedKeywordRepo(t)
+		uc := NewMutedKeywordUsecase(repo, log.DefaultLogger)
+
+		keywords := make([]string, maxMutedKeywords)
+		for i := range keywords {
+			keywords[i] = strings.Repeat("k", i%maxMutedKeywordLength+1)
+		}
+		repo.EXPECT().GetMutedKeywords(ctx, int64(1)).Return(keywords, nil)
+
+		err := uc.AddMutedKeyword(ctx, 1, "new")
+
+		assert.Equal(t, ErrTooManyMutedKeywords, err)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		repo := NewMockMutedKeywordRepo(t)
+		uc := NewMutedKeywordUsecase(repo, log.DefaultLogger)
+
+		assert.Equal(t, ErrInvalidMutedKeyword, uc.AddMutedKeyword(ctx, 1, "   "))
+		assert.Equal(t, ErrInvalidMutedKeyword, uc.AddMutedKeyword(ctx, 1, strings.Repeat("a", maxMutedKeywordLength+1)))
+	})
+}
@@ -0,0 +1,214 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrOAuthProviderNotFound 未配置的第三方登录提供方
+	ErrOAuthProviderNotFound = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "oauth provider not supported")
+	ErrOAuthCodeRequired     = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "oauth code is required")
+	// ErrOAuthFailed 授权码兑换失败
+	ErrOAuthFailed = errors.Unauthorized(v1.ErrorCode_OAUTH_LOGIN_FAILED.String(), "third-party login failed")
+)
+
+const (
+	// oauthCreateUserRetries 生成的用户名冲突时的重试次数
+	oauthCreateUserRetries = 3
+	oauthUsernameSuffixLen = 6
+	oauthUsernameMaxLen    = 32
+)
+
+// OAuthAccount 本地用户与第三方账号的绑定
+type OAuthAccount struct {
+	ID        int64
+	UserID    int64
+	Provider  string
+	Subject   string
+	Email     string // 绑定时第三方返回的邮箱，仅供参考
+	CreatedAt time.Time
+}
+
+// OAuthAccountRepo 第三方账号绑定仓储
+type OAuthAccountRepo interface {
+	// GetOAuthAccount 按提供方和第三方账号ID查询绑定，不存在时返回nil
+	GetOAuthAccount(ctx context.Context, provider, subject string) (*OAuthAccount, error)
+	// LinkOAuthAccount 将第三方账号绑定到已有用户
+	LinkOAuthAccount(ctx context.Context, account *OAuthAccount) error
+	// CreateOAuthUser 在同一事务中创建用户和绑定，用户名或绑定已存在时返回 ErrUserExist
+	CreateOAuthUser(ctx context.Context, user *User, account *OAuthAccount) (*User, error)
+}
+
+// LoginIssuer 为已确认身份的用户签发登录凭证
+type LoginIssuer interface {
+	IssueLogin(ctx context.Context, user *User, challengeCode string) (*auth.TokenPair, error)
+}
+
+// DefaultRoleInitializer 为新用户分配默认角色
+type DefaultRoleInitializer interface {
+	InitUserDefaultRole(ctx context.Context, userID int64) error
+}
+
+// OAuthUsecase 第三方登录。已绑定的第三方账号直接登录对应用户；
+// 未绑定时，提供方确认过的邮箱与已有用户一致则绑定到该用户，否则创建新用户
+type OAuthUsecase struct {
+	providers *auth.OAuthProviders
+	repo      OAuthAccountRepo
+	userRepo  UserRepo
+	issuer    LoginIssuer
+	roles     DefaultRoleInitializer
+	log       *log.Helper
+}
+
+// NewOAuthUsecase 创建第三方登录用例
+func NewOAuthUsecase(providers *auth.OAuthProviders, repo OAuthAccountRepo, userRepo UserRepo, issuer LoginIssuer, roles DefaultRoleInitializer, logger log.Logger) *OAuthUsecase {
+	return &OAuthUsecase{
+		providers: providers,
+		repo:      repo,
+		userRepo:  userRepo,
+		issuer:    issuer,
+		roles:     roles,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// LoginWithOAuth 用第三方授权码登录，返回Token对、用户以及是否新建了用户
+func (uc *OAuthUsecase) LoginWithOAuth(ctx context.Context, providerName, code, redirectURI, challengeCode string) (*auth.TokenPair, *User, bool, error) {
+	provider, ok := uc.providers.Get(providerName)
+	if !ok {
+		return nil, nil, false, ErrOAuthProviderNotFound
+	}
+	if code == "" {
+		return nil, nil, false, ErrOAuthCodeRequired
+	}
+
+	identity, err := provider.Exchange(ctx, code, redirectURI)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("oauth exchange failed: provider=%s err=%v", providerName, err)
+		if errors.Is(err, auth.ErrOAuthExchange) {
+			return nil, nil, false, ErrOAuthFailed
+		}
+		return nil, nil, false, err
+	}
+
+	user, created, err := uc.resolveUser(ctx, providerName, identity)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	// 角色写入Access Token，需在签发前分配
+	if created {
+		if err := uc.roles.InitUserDefaultRole(ctx, user.ID); err != nil {
+			uc.log.WithContext(ctx).Errorf("init user default role failed: %v", err)
+		}
+	}
+
+	tokenPair, err := uc.issuer.IssueLogin(ctx, user, challengeCode)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return tokenPair, user, created, nil
+}
+
+// resolveUser 查找或创建第三方账号对应的本地用户
+func (uc *OAuthUsecase) resolveUser(ctx context.Context, providerName string, identity *auth.OAuthIdentity) (*User, bool, error) {
+	account := &OAuthAccount{
+		Provider: providerName,
+		Subject:  identity.Subject,
+		Email:    truncateRunes(identity.Email, 128),
+	}
+
+	for i := 0; i < oauthCreateUserRetries; i++ {
+		linked, err := uc.repo.GetOAuthAccount(ctx, providerName, identity.Subject)
+		if err != nil {
+			return nil, false, err
+		}
+		if linked != nil {
+			user, err := uc.userRepo.GetUser(ctx, linked.UserID)
+			return user, false, err
+		}
+
+		// 只信任提供方确认过的邮箱，否则可能被用他人邮箱注册的第三方账号接管
+		if i == 0 && identity.EmailVerified && identity.Email != "" {
+			user, err := uc.userRepo.GetUserByEmail(ctx, strings.ToLower(identity.Email))
+			if err != nil && err != ErrUserNotFound {
+				return nil, false, err
+			}
+			if user != nil {
+				account.UserID = user.ID
+				if err := uc.repo.LinkOAuthAccount(ctx, account); err != nil {
+					return nil, false, err
+				}
+				uc.log.WithContext(ctx).Infof("oauth account linked by email: provider=%s user=%d", providerName, user.ID)
+				return user, false, nil
+			}
+		}
+
+		user, err := newOAuthUser(providerName, identity)
+		if err != nil {
+			return nil, false, err
+		}
+		user, err = uc.repo.CreateOAuthUser(ctx, user, account)
+		if err == nil {
+			uc.log.WithContext(ctx).Infof("user created by oauth: provider=%s user=%d", providerName, user.ID)
+			return user, true, nil
+		}
+		// 用户名冲突或同一第三方账号并发登录，重新检查绑定后重试
+		if err != ErrUserExist {
+			return nil, false, err
+		}
+	}
+	return nil, false, ErrUserExist
+}
+
+// newOAuthUser 生成第三方登录的新用户。用户名为 提供方_昵称_随机数字，
+// 密码为随机值，用户可通过绑定邮箱后重置密码
+func newOAuthUser(providerName string, identity *auth.OAuthIdentity) (*User, error) {
+	suffix, err := generateVerificationCode(oauthUsernameSuffixLen)
+	if err != nil {
+		return nil, err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	base := sanitizeUsername(providerName)
+	if name := strings.Trim(sanitizeUsername(identity.Name), "_"); name != "" {
+		base += "_" + name
+	}
+	if maxLen := oauthUsernameMaxLen - oauthUsernameSuffixLen - 1; len(base) > maxLen {
+		base = base[:maxLen]
+	}
+
+	avatar := identity.AvatarURL
+	if len(avatar) > 255 {
+		avatar = ""
+	}
+	return &User{
+		Username:     base + "_" + suffix,
+		PasswordHash: hex.EncodeToString(secret),
+		Nickname:     truncateRunes(identity.Name, 50),
+		Avatar:       avatar,
+	}, nil
+}
+
+// sanitizeUsername 只保留用户名允许的字母、数字和下划线
+func sanitizeUsername(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r < 128 && (r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOAuthProvider 固定返回指定身份，授权码为 bad 时兑换失败
+type stubOAuthProvider struct {
+	identity *auth.OAuthIdentity
+}
+
+func (p *stubOAuthProvider) Name() string {
+	return p.identity.Provider
+}
+
+func (p *stubOAuthProvider) Exchange(ctx context.Context, code, redirectURI string) (*auth.OAuthIdentity, error) {
+	if code == "bad" {
+		return nil, auth.ErrOAuthExchange
+	}
+	return p.identity, nil
+}
+
+type oauthTestDeps struct {
+	uc       *OAuthUsecase
+	repo     *MockOAuthAccountRepo
+	userRepo *MockUserRepo
+	issuer   *MockLoginIssuer
+	roles    *MockDefaultRoleInitializer
+}
+
+func newOAuthTestDeps(t *testing.T, identity *auth.OAuthIdentity) *oauthTestDeps {
+	d := &oauthTestDeps{
+		repo:     NewMockOAuthAccountRepo(t),
+		userRepo: NewMockUserRepo(t),
+		issuer:   NewMockLoginIssuer(t),
+		roles:    NewMockDefaultRoleInitializer(t),
+	}
+	providers := auth.NewOAuthProviders(&stubOAuthProvider{identity: identity})
+	d.uc = NewOAuthUsecase(providers, d.repo, d.userRepo, d.issuer, d.roles, log.DefaultLogger)
+	return d
+}
+
+func TestOAuthUsecase_LoginWithOAuth(t *testing.T) {
+	ctx := context.Background()
+	tokens := &auth.TokenPair{AccessToken: "access"}
+
+	t.Run("LinkedAccount", func(t *testing.T) {
+		d := newOAuthTestDeps(t, &auth.OAuthIdentity{Provider: "github", Subject: "42"})
+		user := &User{ID: 7, Username: "alice"}
+		d.repo.EXPECT().GetOAuthAccount(ctx, "github", "42").Return(&OAuthAccount{UserID: 7}, nil)
+		d.userRepo.EXPECT().GetUser(ctx, int64(7)).Return(user, nil)
+		d.issuer.EXPECT().IssueLogin(ctx, user, "123456").Return(tokens, nil)
+
+		pair, got, created, err := d.uc.LoginWithOAuth(ctx, "github", "code", "", "123456")
+		require.NoError(t, err)
+		assert.Equal(t, tokens, pair)
+		assert.Equal(t, user, got)
+		assert.False(t, created)
+	})
+
+	t.Run("LinkByVerifiedEmail", func(t *testing.T) {
+		d := newOAuthTestDeps(t, &auth.OAuthIdentity{Provider: "google", Subject: "g-1", Email: "Alice@Example.com", EmailVerified: true})
+		user := &User{ID: 7, Email: "alice@example.com"}
+		d.repo.EXPECT().GetOAuthAccount(ctx, "google", "g-1").Return(nil, nil)
+		d.userRepo.EXPECT().GetUserByEmail(ctx, "alice@example.com").Return(user, nil)
+		d.repo.EXPECT().LinkOAuthAccount(ctx, &OAuthAccount{UserID: 7, Provider: "google", Subject: "g-1", Email: "Alice@Example.com"}).Return(nil)
+		d.issuer.EXPECT().IssueLogin(ctx, user, "").Return(tokens, nil)
+
+		_, got, created, err := d.uc.LoginWithOAuth(ctx, "google", "code", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), got.ID)
+		assert.False(t, created)
+	})
+
+	t.Run("UnverifiedEmailCreatesUser", func(t *testing.T) {
+		// 未验证的邮箱不能用来关联已有账号
+		d := newOAuthTestDeps(t, &auth.OAuthIdentity{Provider: "github", Subject: "42", Email: "alice@example.com", Name: "Octo Cat!"})
+		d.repo.EXPECT().GetOAuthAccount(ctx, "github", "42").Return(nil, nil)
+		d.repo.EXPECT().CreateOAuthUser(ctx, mock.MatchedBy(func(u *User) bool {
+			return strings.HasPrefix(u.Username, "github_OctoCat_") && len(u.Username) == len("github_OctoCat_")+oauthUsernameSuffixLen &&
+				u.Nickname == "Octo Cat!" && u.PasswordHash != ""
+		}), mock.Anything).RunAndReturn(func(ctx context.Context, u *User, a *OAuthAccount) (*User, error) {
+			return &User{ID: 8, Username: u.Username}, nil
+		})
+		d.roles.EXPECT().InitUserDefaultRole(ctx, int64(8)).Return(nil)
+		d.issuer.EXPECT().IssueLogin(ctx, mock.Anything, "").Return(tokens, nil)
+
+		_, got, created, err := d.uc.LoginWithOAuth(ctx, "github", "code", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), got.ID)
+		assert.True(t, created)
+	})
+
+	t.Run("ConcurrentFirstLogin", func(t *testing.T) {
+		// 并发的首次登录已创建绑定，重试时直接使用
+		d := newOAuthTestDeps(t, &auth.OAuthIdentity{Provider: "wechat", Subject: "union-1"})
+		user := &User{ID: 9}
+		d.repo.EXPECT().GetOAuthAccount(ctx, "wechat", "union-1").Return(nil, nil).Once()
+		d.repo.EXPECT().CreateOAuthUser(ctx, mock.Anything, mock.Anything).Return(nil, ErrUserExist).Once()
+		d.repo.EXPECT().GetOAuthAccount(ctx, "wechat", "union-1").Return(&OAuthAccount{UserID: 9}, nil).Once()
+		d.userRepo.EXPECT().GetUser(ctx, int64(9)).Return(user, nil)
+		d.issuer.EXPECT().IssueLogin(ctx, user, "").Return(tokens, nil)
+
+		_, _, created, err := d.uc.LoginWithOAuth(ctx, "wechat", "code", "", "")
+		require.NoError(t, err)
+		assert.False(t, created)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		d := newOAuthTestDeps(t, &auth.OAuthIdentity{Provider: "github", Subject: "42"})
+
+		_, _, _, err := d.uc.LoginWithOAuth(ctx, "gitlab", "code", "", "")
+		assert.Equal(t, ErrOAuthProviderNotFound, err)
+
+		_, _, _, err = d.uc.LoginWithOAuth(ctx, "github", "", "", "")
+		assert.Equal(t, ErrOAuthCodeRequired, err)
+
+		_, _, _, err = d.uc.LoginWithOAuth(ctx, "github", "bad", "", "")
+		assert.Equal(t, ErrOAuthFailed, err)
+	})
+}
+
+func TestNewOAuthUser(t *testing.T) {
+	user, err := newOAuthUser("wechat", &auth.OAuthIdentity{Name: "微信用户_abcdefghijklmnopqrstuvwxyz"})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(user.Username), oauthUsernameMaxLen)
+	assert.True(t, strings.HasPrefix(user.Username, "wechat_abcdefghijklmnopqr"))
+	assert.Equal(t, "微信用户_abcdefghijklmnopqrstuvwxyz", user.Nickname)
+}
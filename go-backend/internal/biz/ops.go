@@ -0,0 +1,278 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrInvalidCacheNamespace = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "unknown cache namespace")
+	ErrInvalidPurgeUser      = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "user id must be positive, or 0 for all users")
+	ErrInvalidReindexRange   = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "reindex range must satisfy 0 < from <= to and span at most 1000 users")
+	ErrInvalidRequeueVideos  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "video ids must contain 1-100 positive ids")
+	ErrOpsRateLimited        = errors.New(429, v1.ErrorCode_RATE_LIMIT.String(), "operation executed too frequently, retry later")
+)
+
+// 运维操作类型
+const (
+	OpsActionFlushCache        = "flush_cache"
+	OpsActionPurgeSessions     = "purge_sessions"
+	OpsActionReindex           = "reindex"
+	OpsActionRequeueProcessing = "requeue_processing"
+	OpsActionRotateSigningKey  = "rotate_signing_key"
+)
+
+// 运维操作结果
+const (
+	OpsStatusSucceeded int32 = 1
+	OpsStatusFailed    int32 = 2
+)
+
+// 可清除的缓存命名空间
+const (
+	CacheNamespaceUser       = "user"
+	CacheNamespaceVideo      = "video"
+	CacheNamespaceFeed       = "feed"
+	CacheNamespaceRelation   = "relation"
+	CacheNamespacePermission = "permission"
+	CacheNamespaceProfile    = "profile"
+)
+
+var cacheNamespaces = map[string]bool{
+	CacheNamespaceUser:       true,
+	CacheNamespaceVideo:      true,
+	CacheNamespaceFeed:       true,
+	CacheNamespaceRelation:   true,
+	CacheNamespacePermission: true,
+	CacheNamespaceProfile:    true,
+}
+
+const (
+	// opsRateWindow 内同一类操作最多执行 opsRateLimit 次，所有管理员共享额度
+	opsRateWindow = time.Minute
+	opsRateLimit  = 5
+	// maxReindexSpan 单次重建的用户ID跨度
+	maxReindexSpan = 1000
+	// maxRequeueVideos 单次重新处理的视频数
+	maxRequeueVideos = 100
+	// opsTimeout 运维操作的执行时间上限，请求断开后仍然执行完并写入审计记录
+	opsTimeout = 5 * time.Minute
+)
+
+// OpsLog 运维操作审计记录
+type OpsLog struct {
+	ID        int64
+	AdminID   int64
+	Action    string
+	Target    string
+	Affected  int64
+	Status    int32
+	Error     string
+	CreatedAt time.Time
+}
+
+// OpsRepo 运维操作的执行和审计接口
+type OpsRepo interface {
+	// FlushCacheNamespace 删除命名空间下的 Redis 缓存，并清空本实例的本地缓存
+	FlushCacheNamespace(ctx context.Context, namespace string) error
+	// PurgeSessions 删除用户会话，userID 为 0 时删除全部用户的会话，返回删除的会话数
+	PurgeSessions(ctx context.Context, userID int64) (int64, error)
+	// RebuildProfiles 重建ID区间内有效用户的个人主页读模型，返回重建的用户数
+	RebuildProfiles(ctx context.Context, fromUserID, toUserID int64) (int64, error)
+	// RequeueProcessing 为存在的视频重新写入上传事件，触发转码和审核，返回入队的视频数
+	RequeueProcessing(ctx context.Context, videoIDs []int64) (int64, error)
+	CreateOpsLog(ctx context.Context, log *OpsLog) error
+	// CountOpsLogs 统计 since 之后执行的同类操作次数
+	CountOpsLogs(ctx context.Context, action string, since time.Time) (int64, error)
+}
+
+// OpsUsecase 管理员执行的运维操作。每次执行写入审计记录，同类操作按时间窗口限频，
+// 限频计数来自审计记录，多实例部署时共享额度。同类操作的计数和执行持有同一把分布式锁，
+// 并发请求不会同时通过限频检查
+type OpsUsecase struct {
+	repo         OpsRepo
+	permissionUc *PermissionUsecase
+	signingKeyUc *SigningKeyUsecase
+	locker       Locker
+	clock        clock.Clock
+	log          *log.Helper
+}
+
+// NewOpsUsecase 创建运维操作用例
+func NewOpsUsecase(repo OpsRepo, permissionUc *PermissionUsecase, signingKeyUc *SigningKeyUsecase, locker Locker, clk clock.Clock, logger log.Logger) *OpsUsecase {
+	return &OpsUsecase{
+		repo:         repo,
+		permissionUc: permissionUc,
+		signingKeyUc: signingKeyUc,
+		locker:       locker,
+		clock:        clk,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// FlushCache 清除缓存命名空间，其他实例的本地缓存在过期后收敛
+func (uc *OpsUsecase) FlushCache(ctx context.Context, adminID int64, namespace string) error {
+	namespace = strings.TrimSpace(namespace)
+	if !cacheNamespaces[namespace] {
+		return ErrInvalidCacheNamespace
+	}
+
+	_, err := uc.execute(ctx, adminID, OpsActionFlushCache, namespace, func(ctx context.Context) (int64, error) {
+		return 0, uc.repo.FlushCacheNamespace(ctx, namespace)
+	})
+	return err
+}
+
+// PurgeSessions 删除用户会话，userID 为 0 时删除全部用户的会话。
+// 用户需要重新登录才能刷新令牌，已签发的访问令牌在过期前仍然有效
+func (uc *OpsUsecase) PurgeSessions(ctx context.Context, adminID, userID int64) (int64, error) {
+	if userID < 0 {
+		return 0, ErrInvalidPurgeUser
+	}
+
+	target := "all"
+	if userID > 0 {
+		target = "user:" + strconv.FormatInt(userID, 10)
+	}
+	return uc.execute(ctx, adminID, OpsActionPurgeSessions, target, func(ctx context.Context) (int64, error) {
+		return uc.repo.PurgeSessions(ctx, userID)
+	})
+}
+
+// Reindex 重建用户ID区间 [fromUserID, toUserID] 内的个人主页读模型
+func (uc *OpsUsecase) Reindex(ctx context.Context, adminID, fromUserID, toUserID int64) (int64, error) {
+	if fromUserID <= 0 || toUserID < fromUserID || toUserID-fromUserID >= maxReindexSpan {
+		return 0, ErrInvalidReindexRange
+	}
+
+	target := fmt.Sprintf("user:%d-%d", fromUserID, toUserID)
+	return uc.execute(ctx, adminID, OpsActionReindex, target, func(ctx context.Context) (int64, error) {
+		return uc.repo.RebuildProfiles(ctx, fromUserID, toUserID)
+	})
+}
+
+// RequeueProcessing 重新处理视频，重复的ID只处理一次，不存在的视频跳过
+func (uc *OpsUsecase) RequeueProcessing(ctx context.Context, adminID int64, videoIDs []int64) (int64, error) {
+	ids := make([]int64, 0, len(videoIDs))
+	seen := make(map[int64]bool, len(videoIDs))
+	for _, id := range videoIDs {
+		if id <= 0 {
+			return 0, ErrInvalidRequeueVideos
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 || len(ids) > maxRequeueVideos {
+		return 0, ErrInvalidRequeueVideos
+	}
+
+	target := make([]string, len(ids))
+	for i, id := range ids {
+		target[i] = strconv.FormatInt(id, 10)
+	}
+	return uc.execute(ctx, adminID, OpsActionRequeueProcessing, "video:"+strings.Join(target, ","), func(ctx context.Context) (int64, error) {
+		return uc.repo.RequeueProcessing(ctx, ids)
+	})
+}
+
+// RotateSigningKey 轮换令牌签名密钥，已签发的令牌在旧密钥退役前仍然有效
+func (uc *OpsUsecase) RotateSigningKey(ctx context.Context, adminID int64) (*SigningKey, error) {
+	var key *SigningKey
+	_, err := uc.execute(ctx, adminID, OpsActionRotateSigningKey, "jwt", func(ctx context.Context) (int64, error) {
+		var err error
+		key, err = uc.signingKeyUc.Rotate(ctx)
+		return 0, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ListSigningKeys 查看令牌签名密钥，不返回密钥内容
+func (uc *OpsUsecase) ListSigningKeys(ctx context.Context, adminID int64) ([]*SigningKey, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	return uc.signingKeyUc.ListSigningKeys(ctx)
+}
+
+// execute 校验权限和频率后执行操作，无论成功与否都写入审计记录。
+// 限频计数、执行和写入审计记录都在同类操作的锁内完成，同类操作正在执行时返回 ErrResourceLocked
+func (uc *OpsUsecase) execute(ctx context.Context, adminID int64, action, target string, op func(context.Context) (int64, error)) (int64, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return 0, err
+	}
+
+	var affected int64
+	var opErr error
+	err := withLock(ctx, uc.locker, opsLockKey(action), func(ctx context.Context) error {
+		count, err := uc.repo.CountOpsLogs(ctx, action, uc.clock.Now().Add(-opsRateWindow))
+		if err != nil {
+			return err
+		}
+		if count >= opsRateLimit {
+			uc.log.WithContext(ctx).Warnf("ops action rate limited: admin=%d action=%s target=%s", adminID, action, target)
+			return ErrOpsRateLimited
+		}
+
+		affected, opErr = uc.run(ctx, adminID, action, target, op)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return affected, opErr
+}
+
+// run 执行操作并写入审计记录
+func (uc *OpsUsecase) run(ctx context.Context, adminID int64, action, target string, op func(context.Context) (int64, error)) (int64, error) {
+	// 操作开始后不随请求取消，保证审计记录与实际执行一致
+	opCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), opsTimeout)
+	defer cancel()
+
+	affected, opErr := op(opCtx)
+	entry := &OpsLog{
+		AdminID:  adminID,
+		Action:   action,
+		Target:   target,
+		Affected: affected,
+		Status:   OpsStatusSucceeded,
+	}
+	if opErr != nil {
+		entry.Status = OpsStatusFailed
+		entry.Error = opErr.Error()
+	}
+	if err := uc.repo.CreateOpsLog(opCtx, entry); err != nil {
+		uc.log.WithContext(ctx).Errorf("record ops log failed: admin=%d action=%s target=%s err=%v", adminID, action, target, err)
+	}
+
+	uc.log.WithContext(ctx).Infof("ops action executed: admin=%d action=%s target=%s affected=%d err=%v", adminID, action, target, affected, opErr)
+	return affected, opErr
+}
+
+func opsLockKey(action string) string {
+	return "ops:" + action
+}
+
+func (uc *OpsUsecase) requireAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
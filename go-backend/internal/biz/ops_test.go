@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type opsTestDeps struct {
+	repo     *MockOpsRepo
+	roleRepo *MockRoleRepo
+	keyRepo  *MockSigningKeyRepo
+	clock    *clock.Fake
+	uc       *OpsUsecase
+}
+
+func newOpsTestDeps(t *testing.T) *opsTestDeps {
+	repo := NewMockOpsRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	keyRepo := NewMockSigningKeyRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+	keyring, err := auth.NewKeyring(auth.RefreshTokenExpiry, &auth.SigningKey{ID: auth.LegacyKeyID, Secret: "test-secret"})
+	require.NoError(t, err)
+	signingKeyUc := NewSigningKeyUsecase(keyRepo, keyring, &conf.Business{}, clock.New(), log.DefaultLogger)
+	clk := clock.NewFake(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	return &opsTestDeps{
+		repo:     repo,
+		roleRepo: roleRepo,
+		keyRepo:  keyRepo,
+		clock:    clk,
+		uc:       NewOpsUsecase(repo, permissionUc, signingKeyUc, newTestLocker(), clk, log.DefaultLogger),
+	}
+}
+
+func (d *opsTestDeps) expectAdmin(ctx context.Context, userID int64, isAdmin bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(isAdmin, nil)
+}
+
+func (d *opsTestDeps) expectLog(action, target string, affected int64, status int32, errMsg string) {
+	d.repo.EXPECT().CreateOpsLog(mock.Anything, &OpsLog{
+		AdminID:  1,
+		Action:   action,
+		Target:   target,
+		Affected: affected,
+		Status:   status,
+		Error:    errMsg,
+	}).Return(nil)
+}
+
+func TestOpsUsecase_FlushCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CountOpsLogs(ctx, OpsActionFlushCache, mock.Anything).Return(0, nil)
+		d.repo.EXPECT().FlushCacheNamespace(mock.Anything, CacheNamespaceFeed).Return(nil)
+		d.expectLog(OpsActionFlushCache, CacheNamespaceFeed, 0, OpsStatusSucceeded, "")
+
+		require.NoError(t, d.uc.FlushCache(ctx, 1, " feed "))
+	})
+
+	t.Run("UnknownNamespace", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		assert.ErrorIs(t, d.uc.FlushCache(ctx, 1, "session"), ErrInvalidCacheNamespace)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 2, false)
+		assert.ErrorIs(t, d.uc.FlushCache(ctx, 2, CacheNamespaceUser), ErrPermissionDenied)
+	})
+
+	t.Run("RateLimited", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CountOpsLogs(ctx, OpsActionFlushCache, d.clock.Now().Add(-opsRateWindow)).Return(opsRateLimit, nil)
+
+		assert.ErrorIs(t, d.uc.FlushCache(ctx, 1, CacheNamespaceUser), ErrOpsRateLimited)
+	})
+
+	t.Run("ConcurrentActionRejected", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+
+		// 同类操作执行期间，其他请求不会在该操作写入审计记录前通过限频检查
+		err := d.uc.locker.WithLock(ctx, opsLockKey(OpsActionFlushCache), func(ctx context.Context, _ int64) error {
+			return d.uc.FlushCache(ctx, 1, CacheNamespaceUser)
+		})
+		assert.ErrorIs(t, err, ErrResourceLocked)
+	})
+}
+
+func TestOpsUsecase_PurgeSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("AllUsers", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CountOpsLogs(ctx, OpsActionPurgeSessions, mock.Anything).Return(0, nil)
+		d.repo.EXPECT().PurgeSessions(mock.Anything, int64(0)).Return(12, nil)
+		d.expectLog(OpsActionPurgeSessions, "all", 12, OpsStatusSucceeded, "")
+
+		purged, err := d.uc.PurgeSessions(ctx, 1, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), purged)
+	})
+
+	t.Run("FailureIsAudited", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CountOpsLogs(ctx, OpsActionPurgeSessions, mock.Anything).Return(0, nil)
+		d.repo.EXPECT().PurgeSessions(mock.Anything, int64(7)).Return(0, errors.New("redis down"))
+		d.expectLog(OpsActionPurgeSessions, "user:7", 0, OpsStatusFailed, "redis down")
+
+		_, err := d.uc.PurgeSessions(ctx, 1, 7)
+		assert.EqualError(t, err, "redis down")
+	})
+
+	t.Run("InvalidUser", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		_, err := d.uc.PurgeSessions(ctx, 1, -1)
+		assert.ErrorIs(t, err, ErrInvalidPurgeUser)
+	})
+}
+
+func TestOpsUsecase_Reindex(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CountOpsLogs(ctx, OpsActionReindex, mock.Anything).Return(0, nil)
+		d.repo.EXPECT().RebuildProfiles(mock.Anything, int64(100), int64(199)).Return(80, nil)
+		d.expectLog(OpsActionReindex, "user:100-199", 80, OpsStatusSucceeded, "")
+
+		rebuilt, err := d.uc.Reindex(ctx, 1, 100, 199)
+		require.NoError(t, err)
+		assert.Equal(t, int64(80), rebuilt)
+	})
+
+	t.Run("InvalidRange", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		for _, r := range [][2]int64{{0, 10}, {10, 9}, {1, maxReindexSpan + 1}} {
+			_, err := d.uc.Reindex(ctx, 1, r[0], r[1])
+			assert.ErrorIs(t, err, ErrInvalidReindexRange, r)
+		}
+	})
+}
+
+func TestOpsUsecase_RequeueProcessing(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DeduplicatesIDs", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CountOpsLogs(ctx, OpsActionRequeueProcessing, mock.Anything).Return(0, nil)
+		d.repo.EXPECT().RequeueProcessing(mock.Anything, []int64{3, 1}).Return(2, nil)
+		d.expectLog(OpsActionRequeueProcessing, "video:3,1", 2, OpsStatusSucceeded, "")
+
+		requeued, err := d.uc.RequeueProcessing(ctx, 1, []int64{3, 1, 3})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), requeued)
+	})
+
+	t.Run("InvalidIDs", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		tooMany := make([]int64, maxRequeueVideos+1)
+		for i := range tooMany {
+			tooMany[i] = int64(i + 1)
+		}
+		for _, ids := range [][]int64{nil, {1, 0}, tooMany} {
+			_, err := d.uc.RequeueProcessing(ctx, 1, ids)
+			assert.ErrorIs(t, err, ErrInvalidRequeueVideos)
+		}
+	})
+}
+
+func TestOpsUsecase_RotateSigningKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CountOpsLogs(ctx, OpsActionRotateSigningKey, mock.Anything).Return(0, nil)
+		d.keyRepo.EXPECT().RotateSigningKey(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		d.keyRepo.EXPECT().ListSigningKeys(mock.Anything).Return([]*SigningKey{{KeyID: auth.LegacyKeyID, Secret: "test-secret"}}, nil)
+		d.expectLog(OpsActionRotateSigningKey, "jwt", 0, OpsStatusSucceeded, "")
+
+		key, err := d.uc.RotateSigningKey(ctx, 1)
+		require.NoError(t, err)
+		assert.NotEmpty(t, key.KeyID)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newOpsTestDeps(t)
+		d.expectAdmin(ctx, 2, false)
+
+		_, err := d.uc.RotateSigningKey(ctx, 2)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultOrphanGracePeriod  = 24 * time.Hour
+	defaultOrphanMaxDeletions = 1000
+	orphanRefPageSize         = 1000
+	// orphanReportSamples dry-run 报告中列出的孤儿对象数
+	orphanReportSamples = 20
+	orphanHLSPrefix     = "hls/"
+)
+
+// orphanCleanupPrefixes 参与孤儿清理的对象前缀：原始视频、转码产物、HLS切片和视频封面。
+// 头像、分享卡片、数据导出、隔离区和法律保全区的对象由各自的流程管理，不参与清理
+var orphanCleanupPrefixes = []string{"videos/", "renditions/", orphanHLSPrefix, "covers/"}
+
+// OrphanCleanupRepo 孤儿对象清理仓储接口
+type OrphanCleanupRepo interface {
+	// ListVideoObjectRefs 按ID升序分页返回未删除视频的媒体地址，只填充ID和各地址字段
+	ListVideoObjectRefs(ctx context.Context, afterID int64, limit int) ([]*domain.Video, error)
+}
+
+// OrphanReport 一次孤儿对象清理的结果
+type OrphanReport struct {
+	DryRun       bool
+	Scanned      int      // 遍历的对象数
+	Orphans      int      // 超过宽限期且没有被视频引用的对象数
+	OrphanBytes  int64    // 孤儿对象的总大小
+	Deleted      int      // 本次删除的对象数，dry-run 时为0
+	Failed       int      // 删除失败的对象数，下次执行时重试
+	StaleUploads int      // 取消的过期分片上传数，dry-run 时为待取消的数量
+	Samples      []string // 部分孤儿对象键，用于 dry-run 时人工核对
+}
+
+// OrphanCleanupUsecase 孤儿对象清理任务。发布失败、分片上传中断和删除视频都可能在存储中
+// 留下没有被引用的对象，本任务遍历存储桶，与未删除视频引用的对象对比，删除超过宽限期的孤儿对象。
+// 引用集合在遍历前生成，宽限期内新上传的对象可能尚未写入数据库，不做处理
+type OrphanCleanupUsecase struct {
+	repo         OrphanCleanupRepo
+	storage      storage.VideoStorage
+	enabled      bool
+	dryRun       bool
+	interval     time.Duration
+	gracePeriod  time.Duration
+	maxDeletions int
+	clock        clock.Clock
+	log          *log.Helper
+}
+
+// NewOrphanCleanupUsecase 创建孤儿对象清理任务
+func NewOrphanCleanupUsecase(repo OrphanCleanupRepo, storage storage.VideoStorage, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *OrphanCleanupUsecase {
+	uc := &OrphanCleanupUsecase{
+		repo:         repo,
+		storage:      storage,
+		gracePeriod:  defaultOrphanGracePeriod,
+		maxDeletions: defaultOrphanMaxDeletions,
+		clock:        clk,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetOrphanCleanup(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		uc.dryRun = cfg.DryRun
+		if cfg.Interval != nil {
+			uc.interval = cfg.Interval.AsDuration()
+		}
+		if cfg.GracePeriod != nil && cfg.GracePeriod.AsDuration() > 0 {
+			uc.gracePeriod = cfg.GracePeriod.AsDuration()
+		}
+		if cfg.MaxDeletions > 0 {
+			uc.maxDeletions = int(cfg.MaxDeletions)
+		}
+	}
+
+	return uc
+}
+
+// Enabled 是否启用孤儿对象清理任务
+func (uc *OrphanCleanupUsecase) Enabled() bool {
+	return uc.enabled
+}
+
+// Interval 执行间隔
+func (uc *OrphanCleanupUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Run 按配置执行一次清理，供调度器调用
+func (uc *OrphanCleanupUsecase) Run(ctx context.Context) error {
+	report, err := uc.Reconcile(ctx, uc.dryRun)
+	if report == nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("orphan cleanup finished: dry_run=%t scanned=%d orphans=%d orphan_bytes=%d deleted=%d failed=%d stale_uploads=%d",
+		report.DryRun, report.Scanned, report.Orphans, report.OrphanBytes, report.Deleted, report.Failed, report.StaleUploads)
+	if report.DryRun && len(report.Samples) > 0 {
+		uc.log.WithContext(ctx).Infof("orphan cleanup dry run samples: %s", strings.Join(report.Samples, ", "))
+	}
+	if err != nil {
+		return err
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d orphan deletions failed", report.Failed, report.Deleted+report.Failed)
+	}
+	return nil
+}
+
+// Reconcile 核对存储桶与数据库引用，dryRun 时只生成报告不删除。每次最多删除 maxDeletions 个对象，
+// 其余孤儿对象留到下次执行。存储不支持遍历或解析访问地址时跳过并返回nil
+func (uc *OrphanCleanupUsecase) Reconcile(ctx context.Context, dryRun bool) (*OrphanReport, error) {
+	lister, ok := uc.storage.(storage.ObjectLister)
+	if !ok {
+		uc.log.WithContext(ctx).Warn("storage cannot list objects, skip orphan cleanup")
+		return nil, nil
+	}
+	resolver, ok := uc.storage.(storage.ObjectResolver)
+	if !ok {
+		uc.log.WithContext(ctx).Warn("storage cannot resolve object names, skip orphan cleanup")
+		return nil, nil
+	}
+
+	cutoff := uc.clock.Now().Add(-uc.gracePeriod)
+	refs, videoIDs, err := uc.loadRefs(ctx, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &OrphanReport{DryRun: dryRun}
+	var orphans []string
+	for _, prefix := range orphanCleanupPrefixes {
+		err := lister.ListObjects(ctx, prefix, func(object *storage.FileInfo) error {
+			report.Scanned++
+			if object.UploadedAt.After(cutoff) || refs[object.Name] || isLiveHLSObject(object.Name, videoIDs) {
+				return nil
+			}
+
+			report.Orphans++
+			report.OrphanBytes += object.Size
+			if len(report.Samples) < orphanReportSamples {
+				report.Samples = append(report.Samples, object.Name)
+			}
+			if len(orphans) < uc.maxDeletions {
+				orphans = append(orphans, object.Name)
+			}
+			return nil
+		})
+		if err != nil {
+			return report, err
+		}
+	}
+
+	if aborter, ok := uc.storage.(storage.StaleUploadAborter); ok {
+		report.StaleUploads, err = aborter.AbortStaleUploads(ctx, cutoff, dryRun)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	if dryRun {
+		return report, nil
+	}
+	for _, objectName := range orphans {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := uc.storage.Delete(ctx, objectName); err != nil {
+			report.Failed++
+			uc.log.WithContext(ctx).Warnf("delete orphan object failed: object=%s err=%v", objectName, err)
+			continue
+		}
+		report.Deleted++
+	}
+	return report, nil
+}
+
+// loadRefs 分页读取未删除视频引用的对象键。HLS切片的键不写入数据库，按视频ID目录判断
+func (uc *OrphanCleanupUsecase) loadRefs(ctx context.Context, resolver storage.ObjectResolver) (map[string]bool, map[int64]bool, error) {
+	refs := make(map[string]bool)
+	videoIDs := make(map[int64]bool)
+
+	var afterID int64
+	for {
+		videos, err := uc.repo.ListVideoObjectRefs(ctx, afterID, orphanRefPageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, video := range videos {
+			videoIDs[video.ID] = true
+			urls := []string{video.PlayURL, video.CoverURL, video.DynamicCoverURL, video.HLSURL}
+			for _, url := range video.PlayURLs {
+				urls = append(urls, url)
+			}
+			for _, url := range urls {
+				if objectName, ok := orphanObjectRef(resolver, url); ok {
+					refs[objectName] = true
+				}
+			}
+			afterID = video.ID
+		}
+		if len(videos) < orphanRefPageSize {
+			return refs, videoIDs, nil
+		}
+	}
+}
+
+// orphanObjectRef 解析视频引用的对象键。直传上传保存完整地址，普通上传和转码保存的是对象键本身
+func orphanObjectRef(resolver storage.ObjectResolver, url string) (string, bool) {
+	if objectName, ok := resolver.ObjectName(url); ok {
+		return objectName, true
+	}
+	if url == "" || strings.Contains(url, "://") {
+		return "", false
+	}
+	return strings.TrimPrefix(url, "/"), true
+}
+
+// isLiveHLSObject HLS对象键为 hls/<视频ID>/<文件名>，视频未删除时整个目录都视为被引用
+func isLiveHLSObject(objectName string, videoIDs map[int64]bool) bool {
+	rest, ok := strings.CutPrefix(objectName, orphanHLSPrefix)
+	if !ok {
+		return false
+	}
+	id, _, ok := strings.Cut(rest, "/")
+	if !ok {
+		return false
+	}
+	videoID, err := strconv.ParseInt(id, 10, 64)
+	return err == nil && videoIDs[videoID]
+}
@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// datedStorage 在可遍历的内存存储上记录对象的上传时间，未记录的对象视为很早以前上传
+type datedStorage struct {
+	*listingStorage
+	uploadedAt map[string]time.Time
+}
+
+func (s *datedStorage) ListObjects(ctx context.Context, prefix string, fn func(*storage.FileInfo) error) error {
+	return s.listingStorage.ListObjects(ctx, prefix, func(info *storage.FileInfo) error {
+		info.Size = int64(len(s.objects[info.Name]))
+		info.UploadedAt = s.uploadedAt[info.Name]
+		return fn(info)
+	})
+}
+
+func TestOrphanCleanupUsecase_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	newStore := func() *datedStorage {
+		store := &datedStorage{
+			listingStorage: &listingStorage{memoryStorage: newMemoryStorage()},
+			uploadedAt:     map[string]time.Time{"videos/3.mp4": now.Add(-time.Hour)},
+		}
+		for _, name := range []string{
+			"videos/1.mp4", "covers/1.jpg", "covers/1.gif", "renditions/1-720p.mp4", "hls/1/index.m3u8", "hls/1/seg0.ts",
+			"videos/2.mp4", "hls/2/index.m3u8", // 视频已删除或发布失败后留下的对象
+			"videos/3.mp4",          // 宽限期内刚上传，尚未写入数据库
+			"profiles/1/avatar.jpg", // 不参与清理的前缀
+		} {
+			store.objects[name] = []byte(name)
+		}
+		return store
+	}
+	refs := []*domain.Video{{
+		ID:              1,
+		PlayURL:         "https://cdn.example.com/videos/1.mp4",
+		CoverURL:        "covers/1.jpg", // 普通上传保存的是对象键
+		DynamicCoverURL: "https://cdn.example.com/covers/1.gif",
+		HLSURL:          "https://cdn.example.com/hls/1/index.m3u8",
+		PlayURLs:        map[string]string{"720p": "https://cdn.example.com/renditions/1-720p.mp4"},
+	}}
+
+	t.Run("DeleteOrphans", func(t *testing.T) {
+		repo := NewMockOrphanCleanupRepo(t)
+		store := newStore()
+		uc := NewOrphanCleanupUsecase(repo, store, nil, clock.NewFake(now), log.DefaultLogger)
+
+		repo.EXPECT().ListVideoObjectRefs(ctx, int64(0), orphanRefPageSize).Return(refs, nil)
+
+		report, err := uc.Reconcile(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, 9, report.Scanned)
+		assert.Equal(t, 2, report.Orphans)
+		assert.Equal(t, 2, report.Deleted)
+		assert.Equal(t, int64(len("videos/2.mp4")+len("hls/2/index.m3u8")), report.OrphanBytes)
+		assert.NotContains(t, store.objects, "videos/2.mp4")
+		assert.NotContains(t, store.objects, "hls/2/index.m3u8")
+		assert.Contains(t, store.objects, "videos/3.mp4")
+		assert.Contains(t, store.objects, "hls/1/seg0.ts")
+		assert.Contains(t, store.objects, "covers/1.gif")
+		assert.Contains(t, store.objects, "profiles/1/avatar.jpg")
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		repo := NewMockOrphanCleanupRepo(t)
+		store := newStore()
+		uc := NewOrphanCleanupUsecase(repo, store, nil, clock.NewFake(now), log.DefaultLogger)
+
+		repo.EXPECT().ListVideoObjectRefs(ctx, int64(0), orphanRefPageSize).Return(refs, nil)
+
+		report, err := uc.Reconcile(ctx, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 2, report.Orphans)
+		assert.Zero(t, report.Deleted)
+		assert.ElementsMatch(t, []string{"videos/2.mp4", "hls/2/index.m3u8"}, report.Samples)
+		assert.Contains(t, store.objects, "videos/2.mp4")
+	})
+
+	t.Run("MaxDeletions", func(t *testing.T) {
+		repo := NewMockOrphanCleanupRepo(t)
+		store := newStore()
+		uc := NewOrphanCleanupUsecase(repo, store, &conf.Business{
+			OrphanCleanup: &conf.Business_OrphanCleanup{MaxDeletions: 1},
+		}, clock.NewFake(now), log.DefaultLogger)
+
+		repo.EXPECT().ListVideoObjectRefs(ctx, int64(0), orphanRefPageSize).Return(refs, nil)
+
+		report, err := uc.Reconcile(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Orphans)
+		assert.Equal(t, 1, report.Deleted)
+	})
+
+	t.Run("DeleteFailed", func(t *testing.T) {
+		repo := NewMockOrphanCleanupRepo(t)
+		store := newStore()
+		store.deleteErr = errors.New("storage unavailable")
+		uc := NewOrphanCleanupUsecase(repo, store, nil, clock.NewFake(now), log.DefaultLogger)
+
+		repo.EXPECT().ListVideoObjectRefs(ctx, int64(0), orphanRefPageSize).Return(refs, nil)
+
+		assert.Error(t, uc.Run(ctx))
+	})
+
+	t.Run("RefsError", func(t *testing.T) {
+		repo := NewMockOrphanCleanupRepo(t)
+		uc := NewOrphanCleanupUsecase(repo, newStore(), nil, clock.NewFake(now), log.DefaultLogger)
+
+		repo.EXPECT().ListVideoObjectRefs(ctx, int64(0), orphanRefPageSize).Return(nil, errors.New("db down"))
+
+		_, err := uc.Reconcile(ctx, false)
+		assert.Error(t, err)
+	})
+}
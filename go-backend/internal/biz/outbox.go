@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultOutboxPollInterval = time.Second
+	defaultOutboxBatchSize    = 100
+	defaultOutboxMaxAttempts  = 10
+	defaultOutboxRetryBackoff = time.Second
+	defaultOutboxMaxBackoff   = 5 * time.Minute
+	defaultOutboxClaimLease   = 30 * time.Second
+	// outboxErrorMaxLength last_error 列长度
+	outboxErrorMaxLength = 500
+)
+
+// 发件箱事件状态
+const (
+	OutboxStatusPending int32 = 0
+	OutboxStatusSent    int32 = 1
+	OutboxStatusDead    int32 = 2
+)
+
+// 发件箱事件类型，决定中继用哪个发布方法投递
+const (
+	OutboxEventVideoUploaded     = "video.uploaded"
+	OutboxEventVideoStatsUpdated = "video.stats_updated"
+)
+
+// OutboxEvent 发件箱事件，与业务数据在同一事务中写入，由中继异步投递到Kafka
+type OutboxEvent struct {
+	ID            int64
+	EventID       string // 幂等键，投递时作为消息ID，重复投递时消费者据此去重
+	EventType     string
+	AggregateID   int64
+	Payload       []byte
+	Status        int32
+	Attempts      int32
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// OutboxRepo 发件箱仓储
+type OutboxRepo interface {
+	// ClaimDue 领取到期的待投递事件并顺延 lease，多个中继实例不会同时领取同一事件
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*OutboxEvent, error)
+	// MarkSent 标记事件投递成功
+	MarkSent(ctx context.Context, id int64) error
+	// MarkFailed 记录投递失败，按 event 的 Status、Attempts、NextAttemptAt、LastError 更新
+	MarkFailed(ctx context.Context, event *OutboxEvent) error
+}
+
+// OutboxRelayUsecase 发件箱中继，轮询到期事件投递到Kafka，失败按指数退避重试
+type OutboxRelayUsecase struct {
+	repo      OutboxRepo
+	publisher domain.VideoEventPublisher
+
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int32
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	claimLease   time.Duration
+
+	log *log.Helper
+}
+
+// NewOutboxRelayUsecase 创建发件箱中继
+func NewOutboxRelayUsecase(repo OutboxRepo, publisher domain.VideoEventPublisher, businessConfig *conf.Business, logger log.Logger) *OutboxRelayUsecase {
+	uc := &OutboxRelayUsecase{
+		repo:         repo,
+		publisher:    publisher,
+		pollInterval: defaultOutboxPollInterval,
+		batchSize:    defaultOutboxBatchSize,
+		maxAttempts:  defaultOutboxMaxAttempts,
+		retryBackoff: defaultOutboxRetryBackoff,
+		maxBackoff:   defaultOutboxMaxBackoff,
+		claimLease:   defaultOutboxClaimLease,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetOutbox(); cfg != nil {
+		if cfg.PollInterval != nil {
+			uc.pollInterval = cfg.PollInterval.AsDuration()
+		}
+		if cfg.BatchSize > 0 {
+			uc.batchSize = int(cfg.BatchSize)
+		}
+		if cfg.MaxAttempts > 0 {
+			uc.maxAttempts = cfg.MaxAttempts
+		}
+		if cfg.RetryBackoff != nil {
+			uc.retryBackoff = cfg.RetryBackoff.AsDuration()
+		}
+		if cfg.MaxBackoff != nil {
+			uc.maxBackoff = cfg.MaxBackoff.AsDuration()
+		}
+		if cfg.ClaimLease != nil {
+			uc.claimLease = cfg.ClaimLease.AsDuration()
+		}
+	}
+
+	return uc
+}
+
+// PollInterval 中继轮询间隔
+func (uc *OutboxRelayUsecase) PollInterval() time.Duration {
+	return uc.pollInterval
+}
+
+// Relay 投递一批到期事件。单个事件失败不影响其他事件，只有领取失败时返回错误
+func (uc *OutboxRelayUsecase) Relay(ctx context.Context) error {
+	events, err := uc.repo.ClaimDue(ctx, uc.batchSize, uc.claimLease)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err := uc.dispatch(ctx, event); err != nil {
+			uc.fail(ctx, event, err)
+			continue
+		}
+		if err := uc.repo.MarkSent(ctx, event.ID); err != nil {
+			// 租约到期后会被重新投递，消费者按 EventID 去重
+			uc.log.WithContext(ctx).Warnf("mark outbox event %s sent failed: %v", event.EventID, err)
+		}
+	}
+
+	return nil
+}
+
+// dispatch 按事件类型反序列化并发布
+func (uc *OutboxRelayUsecase) dispatch(ctx context.Context, event *OutboxEvent) error {
+	switch event.EventType {
+	case OutboxEventVideoUploaded:
+		var e domain.VideoUploadedEvent
+		if err := json.Unmarshal(event.Payload, &e); err != nil {
+			return err
+		}
+		e.EventID = event.EventID
+		return uc.publisher.PublishVideoUploadedEvent(ctx, &e)
+	case OutboxEventVideoStatsUpdated:
+		var e domain.VideoStatsUpdatedEvent
+		if err := json.Unmarshal(event.Payload, &e); err != nil {
+			return err
+		}
+		e.EventID = event.EventID
+		return uc.publisher.PublishVideoStatsUpdatedEvent(ctx, &e)
+	default:
+		return fmt.Errorf("unknown outbox event type %q", event.EventType)
+	}
+}
+
+// fail 记录失败并安排重试，达到上限后转为死信等待人工处理
+func (uc *OutboxRelayUsecase) fail(ctx context.Context, event *OutboxEvent, cause error) {
+	event.Attempts++
+	event.LastError = cause.Error()
+	if len(event.LastError) > outboxErrorMaxLength {
+		event.LastError = event.LastError[:outboxErrorMaxLength]
+	}
+
+	if event.Attempts >= uc.maxAttempts {
+		event.Status = OutboxStatusDead
+		uc.log.WithContext(ctx).Errorf("outbox event %s (%s) dead after %d attempts: %v",
+			event.EventID, event.EventType, event.Attempts, cause)
+	} else {
+		event.Status = OutboxStatusPending
+		event.NextAttemptAt = time.Now().Add(uc.backoff(event.Attempts))
+		uc.log.WithContext(ctx).Warnf("outbox event %s (%s) attempt %d failed, retry at %s: %v",
+			event.EventID, event.EventType, event.Attempts, event.NextAttemptAt.Format(time.RFC3339), cause)
+	}
+
+	if err := uc.repo.MarkFailed(ctx, event); err != nil {
+		uc.log.WithContext(ctx).Errorf("mark outbox event %s failed: %v", event.EventID, err)
+	}
+}
+
+// backoff 第 n 次失败后的重试间隔
+func (uc *OutboxRelayUsecase) backoff(attempts int32) time.Duration {
+	d := uc.retryBackoff
+	for i := int32(1); i < attempts; i++ {
+		d *= 2
+		if d >= uc.maxBackoff {
+			return uc.maxBackoff
+		}
+	}
+	return d
+}
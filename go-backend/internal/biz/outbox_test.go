@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// recordingPublisher 记录发布的事件，err 非空时发布失败
+type recordingPublisher struct {
+	uploaded []*domain.VideoUploadedEvent
+	stats    []*domain.VideoStatsUpdatedEvent
+	err      error
+}
+
+func (p *recordingPublisher) PublishVideoUploadedEvent(_ context.Context, event *domain.VideoUploadedEvent) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.uploaded = append(p.uploaded, event)
+	return nil
+}
+
+func (p *recordingPublisher) PublishVideoProcessedEvent(context.Context, *domain.VideoProcessedEvent) error {
+	return p.err
+}
+
+func (p *recordingPublisher) PublishVideoStatsUpdatedEvent(_ context.Context, event *domain.VideoStatsUpdatedEvent) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.stats = append(p.stats, event)
+	return nil
+}
+
+func (p *recordingPublisher) PublishVideoDeletedEvent(context.Context, *domain.VideoDeletedEvent) error {
+	return p.err
+}
+
+func (p *recordingPublisher) PublishVideoAuditedEvent(context.Context, *domain.VideoAuditEvent) error {
+	return p.err
+}
+
+func TestOutboxRelayUsecase_Relay(t *testing.T) {
+	ctx := context.Background()
+
+	payload := func(t *testing.T, v interface{}) []byte {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return data
+	}
+
+	setup := func(t *testing.T, businessConfig *conf.Business) (*MockOutboxRepo, *recordingPublisher, *OutboxRelayUsecase) {
+		repo := NewMockOutboxRepo(t)
+		publisher := &recordingPublisher{}
+		return repo, publisher, NewOutboxRelayUsecase(repo, publisher, businessConfig, log.DefaultLogger)
+	}
+
+	t.Run("DispatchByType", func(t *testing.T) {
+		repo, publisher, uc := setup(t, &conf.Business{})
+		repo.EXPECT().ClaimDue(ctx, defaultOutboxBatchSize, defaultOutboxClaimLease).Return([]*OutboxEvent{
+			{ID: 1, EventID: "evt_1", EventType: OutboxEventVideoUploaded, Payload: payload(t, &domain.VideoUploadedEvent{VideoID: 10, Title: "t"})},
+			{ID: 2, EventID: "evt_2", EventType: OutboxEventVideoStatsUpdated, Payload: payload(t, &domain.VideoStatsUpdatedEvent{VideoID: 10, StatsType: "play_count", Delta: 1})},
+		}, nil)
+		repo.EXPECT().MarkSent(ctx, int64(1)).Return(nil)
+		repo.EXPECT().MarkSent(ctx, int64(2)).Return(nil)
+
+		require.NoError(t, uc.Relay(ctx))
+		require.Len(t, publisher.uploaded, 1)
+		assert.Equal(t, "evt_1", publisher.uploaded[0].EventID)
+		assert.Equal(t, int64(10), publisher.uploaded[0].VideoID)
+		require.Len(t, publisher.stats, 1)
+		assert.Equal(t, "evt_2", publisher.stats[0].EventID)
+		assert.Equal(t, int64(1), publisher.stats[0].Delta)
+	})
+
+	t.Run("RetryWithBackoff", func(t *testing.T) {
+		repo, publisher, uc := setup(t, &conf.Business{Outbox: &conf.Business_Outbox{
+			RetryBackoff: durationpb.New(time.Second),
+			MaxBackoff:   durationpb.New(3 * time.Second),
+		}})
+		publisher.err = errors.New("kafka unavailable")
+		repo.EXPECT().ClaimDue(ctx, defaultOutboxBatchSize, defaultOutboxClaimLease).Return([]*OutboxEvent{
+			{ID: 1, EventID: "evt_1", EventType: OutboxEventVideoUploaded, Payload: payload(t, &domain.VideoUploadedEvent{}), Attempts: 2},
+		}, nil)
+		before := time.Now()
+		repo.EXPECT().MarkFailed(ctx, mock.MatchedBy(func(event *OutboxEvent) bool {
+			// 第3次失败本应等待4秒，被上限截断为3秒
+			return event.Status == OutboxStatusPending && event.Attempts == 3 &&
+				event.LastError == "kafka unavailable" &&
+				!event.NextAttemptAt.Before(before.Add(3*time.Second)) &&
+				event.NextAttemptAt.Before(before.Add(4*time.Second))
+		})).Return(nil)
+
+		require.NoError(t, uc.Relay(ctx))
+	})
+
+	t.Run("DeadAfterMaxAttempts", func(t *testing.T) {
+		repo, publisher, uc := setup(t, &conf.Business{Outbox: &conf.Business_Outbox{MaxAttempts: 3}})
+		publisher.err = errors.New("kafka unavailable")
+		repo.EXPECT().ClaimDue(ctx, defaultOutboxBatchSize, defaultOutboxClaimLease).Return([]*OutboxEvent{
+			{ID: 1, EventID: "evt_1", EventType: OutboxEventVideoUploaded, Payload: payload(t, &domain.VideoUploadedEvent{}), Attempts: 2},
+		}, nil)
+		repo.EXPECT().MarkFailed(ctx, mock.MatchedBy(func(event *OutboxEvent) bool {
+			return event.Status == OutboxStatusDead && event.Attempts == 3
+		})).Return(nil)
+
+		require.NoError(t, uc.Relay(ctx))
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		repo, publisher, uc := setup(t, &conf.Business{})
+		repo.EXPECT().ClaimDue(ctx, defaultOutboxBatchSize, defaultOutboxClaimLease).Return([]*OutboxEvent{
+			{ID: 1, EventID: "evt_1", EventType: "video.unknown", Payload: []byte(`{}`)},
+			{ID: 2, EventID: "evt_2", EventType: OutboxEventVideoUploaded, Payload: payload(t, &domain.VideoUploadedEvent{VideoID: 10})},
+		}, nil)
+		repo.EXPECT().MarkFailed(ctx, mock.MatchedBy(func(event *OutboxEvent) bool {
+			return event.ID == 1 && event.Attempts == 1
+		})).Return(nil)
+		repo.EXPECT().MarkSent(ctx, int64(2)).Return(nil)
+
+		require.NoError(t, uc.Relay(ctx))
+		assert.Len(t, publisher.uploaded, 1)
+	})
+
+	t.Run("ClaimError", func(t *testing.T) {
+		repo, _, uc := setup(t, &conf.Business{})
+		repo.EXPECT().ClaimDue(ctx, defaultOutboxBatchSize, defaultOutboxClaimLease).Return(nil, errors.New("db down"))
+
+		assert.Error(t, uc.Relay(ctx))
+	})
+}
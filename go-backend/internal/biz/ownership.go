@@ -0,0 +1,61 @@
+package biz
+
+import (
+	"context"
+)
+
+// 带归属条件的资源
+const (
+	ResourceVideo   = "/video"
+	ResourceComment = "/comment"
+)
+
+// OwnershipResolver 解析用户是否拥有某个资源，用于评估 own 范围的权限
+type OwnershipResolver interface {
+	IsOwner(ctx context.Context, userID, resourceID int64) (bool, error)
+}
+
+// OwnershipResolvers 按资源路径索引的归属解析器
+type OwnershipResolvers map[string]OwnershipResolver
+
+// OwnershipRepo 查询资源归属，只读取归属字段，不经过资源缓存
+type OwnershipRepo interface {
+	// GetVideoAuthorID 获取未删除视频的作者ID
+	GetVideoAuthorID(ctx context.Context, videoID int64) (int64, error)
+	// GetCommentOwnerIDs 获取未删除评论的评论者ID和所属视频的作者ID
+	GetCommentOwnerIDs(ctx context.Context, commentID int64) (int64, int64, error)
+}
+
+// NewOwnershipResolvers 注册各资源的归属解析器
+func NewOwnershipResolvers(repo OwnershipRepo) OwnershipResolvers {
+	return OwnershipResolvers{
+		ResourceVideo:   &videoOwnershipResolver{repo: repo},
+		ResourceComment: &commentOwnershipResolver{repo: repo},
+	}
+}
+
+// videoOwnershipResolver 视频作者拥有视频
+type videoOwnershipResolver struct {
+	repo OwnershipRepo
+}
+
+func (r *videoOwnershipResolver) IsOwner(ctx context.Context, userID, videoID int64) (bool, error) {
+	authorID, err := r.repo.GetVideoAuthorID(ctx, videoID)
+	if err != nil {
+		return false, err
+	}
+	return authorID == userID, nil
+}
+
+// commentOwnershipResolver 评论者拥有评论，视频作者也可以管理自己视频下的评论
+type commentOwnershipResolver struct {
+	repo OwnershipRepo
+}
+
+func (r *commentOwnershipResolver) IsOwner(ctx context.Context, userID, commentID int64) (bool, error) {
+	commenterID, videoAuthorID, err := r.repo.GetCommentOwnerIDs(ctx, commentID)
+	if err != nil {
+		return false, err
+	}
+	return commenterID == userID || videoAuthorID == userID, nil
+}
@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"context"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrResetTokenInvalid = errors.BadRequest(v1.ErrorCode_RESET_TOKEN_INVALID.String(), "invalid or expired reset token")
+
+// PasswordResetNotifier 将密码重置Token投递给用户，实现可以是邮件、短信或站内信
+type PasswordResetNotifier interface {
+	SendPasswordResetToken(ctx context.Context, user *User, token string) error
+}
+
+// PasswordResetUsecase 密码重置：生成一次性Token并投递，校验后修改密码并撤销所有会话
+type PasswordResetUsecase struct {
+	repo     AuthRepo
+	userUc   *UserUsecase
+	authUc   *AuthUsecase
+	notifier PasswordResetNotifier
+	log      *log.Helper
+}
+
+// NewPasswordResetUsecase 创建密码重置用例
+func NewPasswordResetUsecase(repo AuthRepo, userUc *UserUsecase, authUc *AuthUsecase, notifier PasswordResetNotifier, logger log.Logger) *PasswordResetUsecase {
+	return &PasswordResetUsecase{
+		repo:     repo,
+		userUc:   userUc,
+		authUc:   authUc,
+		notifier: notifier,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// RequestPasswordReset 为用户生成重置Token并投递。用户不存在或投递失败时同样返回成功，
+// 避免通过该接口探测用户名是否存在
+func (uc *PasswordResetUsecase) RequestPasswordReset(ctx context.Context, username string) error {
+	user, err := uc.userUc.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == ErrUserNotFound {
+			uc.log.WithContext(ctx).Infof("password reset requested for unknown user: %s", username)
+			return nil
+		}
+		return err
+	}
+
+	token, err := security.GenerateTokenID()
+	if err != nil {
+		return err
+	}
+
+	if err := uc.repo.SetPasswordResetToken(ctx, user.Username, token); err != nil {
+		return err
+	}
+
+	if err := uc.notifier.SendPasswordResetToken(ctx, user, token); err != nil {
+		uc.log.WithContext(ctx).Errorf("deliver password reset token failed: user=%d err=%v", user.ID, err)
+	}
+
+	return nil
+}
+
+// ResetPassword 校验重置Token并设置新密码，Token在校验时即被消费，并发请求只有一个能通过。
+// 成功后撤销该用户的所有会话，并清除登录失败计数
+func (uc *PasswordResetUsecase) ResetPassword(ctx context.Context, username, token, newPassword string) error {
+	consumed, err := uc.repo.ConsumePasswordResetToken(ctx, username, token)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return ErrResetTokenInvalid
+	}
+
+	user, err := uc.userUc.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return ErrResetTokenInvalid
+		}
+		return err
+	}
+
+	if err := uc.userUc.ResetPassword(ctx, user.ID, newPassword); err != nil {
+		return err
+	}
+
+	if err := uc.authUc.RevokeAllUserTokens(ctx, user.ID); err != nil {
+		uc.log.WithContext(ctx).Warnf("revoke sessions after password reset failed: user=%d err=%v", user.ID, err)
+	}
+	if err := uc.repo.ClearLoginAttempts(ctx, username); err != nil {
+		uc.log.WithContext(ctx).Warnf("clear login attempts after password reset failed: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type passwordResetTestDeps struct {
+	authRepo   *MockAuthRepo
+	userRepo   *MockUserRepo
+	notifier   *MockPasswordResetNotifier
+	sessionMgr auth.SessionManager
+	uc         *PasswordResetUsecase
+}
+
+func newPasswordResetTestDeps(t *testing.T) *passwordResetTestDeps {
+	authRepo := NewMockAuthRepo(t)
+	userRepo := NewMockUserRepo(t)
+	notifier := NewMockPasswordResetNotifier(t)
+	sessionMgr := auth.NewMemorySessionManager()
+
+	userUc := NewUserUsecase(userRepo, log.DefaultLogger)
+	authUc := NewAuthUsecase(authRepo, userRepo, auth.NewJWTManager("test-secret", time.Hour), sessionMgr, nil, nil, newTestLocker(), &conf.Business{}, clock.New(), log.DefaultLogger)
+
+	return &passwordResetTestDeps{
+		authRepo:   authRepo,
+		userRepo:   userRepo,
+		notifier:   notifier,
+		sessionMgr: sessionMgr,
+		uc:         NewPasswordResetUsecase(authRepo, userUc, authUc, notifier, log.DefaultLogger),
+	}
+}
+
+func TestPasswordResetUsecase_RequestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	user := &User{ID: 1, Username: "alice"}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newPasswordResetTestDeps(t)
+
+		var stored string
+		d.userRepo.EXPECT().GetUserByUsername(ctx, "alice").Return(user, nil)
+		d.authRepo.EXPECT().SetPasswordResetToken(ctx, "alice", mock.AnythingOfType("string")).
+			RunAndReturn(func(_ context.Context, _ string, token string) error {
+				stored = token
+				return nil
+			})
+		d.notifier.EXPECT().SendPasswordResetToken(ctx, user, mock.AnythingOfType("string")).
+			RunAndReturn(func(_ context.Context, _ *User, token string) error {
+				assert.Equal(t, stored, token)
+				return nil
+			})
+
+		require.NoError(t, d.uc.RequestPasswordReset(ctx, "alice"))
+		assert.NotEmpty(t, stored)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		d := newPasswordResetTestDeps(t)
+		d.userRepo.EXPECT().GetUserByUsername(ctx, "nobody").Return(nil, ErrUserNotFound)
+
+		assert.NoError(t, d.uc.RequestPasswordReset(ctx, "nobody"))
+	})
+
+	t.Run("DeliveryFailureHidden", func(t *testing.T) {
+		d := newPasswordResetTestDeps(t)
+		d.userRepo.EXPECT().GetUserByUsername(ctx, "alice").Return(user, nil)
+		d.authRepo.EXPECT().SetPasswordResetToken(ctx, "alice", mock.AnythingOfType("string")).Return(nil)
+		d.notifier.EXPECT().SendPasswordResetToken(ctx, user, mock.AnythingOfType("string")).Return(errors.New("smtp down"))
+
+		assert.NoError(t, d.uc.RequestPasswordReset(ctx, "alice"))
+	})
+}
+
+func TestPasswordResetUsecase_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	user := &User{ID: 1, Username: "alice"}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newPasswordResetTestDeps(t)
+		_, err := d.sessionMgr.CreateSession(ctx, user.ID, "refresh-token", "family", time.Hour)
+		require.NoError(t, err)
+
+		d.authRepo.EXPECT().ConsumePasswordResetToken(ctx, "alice", "reset-token").Return(true, nil)
+		d.userRepo.EXPECT().GetUserByUsername(ctx, "alice").Return(user, nil)
+		d.userRepo.EXPECT().UpdatePassword(ctx, int64(1), "NewPassword123").Return(nil)
+		d.authRepo.EXPECT().ClearLoginAttempts(ctx, "alice").Return(nil)
+
+		require.NoError(t, d.uc.ResetPassword(ctx, "alice", "reset-token", "NewPassword123"))
+
+		// 所有会话已撤销
+		valid, _ := d.sessionMgr.ValidateSession(ctx, user.ID, "refresh-token")
+		assert.False(t, valid)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		d := newPasswordResetTestDeps(t)
+		d.authRepo.EXPECT().ConsumePasswordResetToken(ctx, "alice", "wrong").Return(false, nil)
+
+		err := d.uc.ResetPassword(ctx, "alice", "wrong", "NewPassword123")
+
+		assert.Equal(t, ErrResetTokenInvalid, err)
+	})
+
+	t.Run("UserGone", func(t *testing.T) {
+		d := newPasswordResetTestDeps(t)
+		d.authRepo.EXPECT().ConsumePasswordResetToken(ctx, "alice", "reset-token").Return(true, nil)
+		d.userRepo.EXPECT().GetUserByUsername(ctx, "alice").Return(nil, ErrUserNotFound)
+
+		err := d.uc.ResetPassword(ctx, "alice", "reset-token", "NewPassword123")
+
+		assert.Equal(t, ErrResetTokenInvalid, err)
+	})
+}
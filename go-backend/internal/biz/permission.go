@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 
+	v1 "go-backend/api/common/v1"
 	"go-backend/internal/domain"
 	"go-backend/pkg/auth"
 
@@ -13,8 +14,13 @@ import (
 // 权限相关错误
 var (
 	ErrPermissionDenied = errors.Forbidden("PERMISSION_DENIED", "permission denied")
-	ErrRoleNotFound     = errors.NotFound("ROLE_NOT_FOUND", "role not found")
+	ErrRoleNotFound     = errors.NotFound(v1.ErrorCode_ROLE_NOT_FOUND.String(), "role not found")
 	ErrInvalidRole      = errors.BadRequest("INVALID_ROLE", "invalid role")
+
+	ErrPermissionNotFound = errors.NotFound(v1.ErrorCode_PERMISSION_NOT_FOUND.String(), "permission not found")
+	ErrRoleExist          = errors.Conflict(v1.ErrorCode_ROLE_EXIST.String(), "role name already exists")
+	ErrPermissionExist    = errors.Conflict(v1.ErrorCode_PERMISSION_EXIST.String(), "permission name already exists")
+	ErrBuiltinRole        = errors.BadRequest(v1.ErrorCode_BUILTIN_ROLE.String(), "built-in role cannot be renamed, disabled or deleted")
 )
 
 // RoleRepo 角色仓储接口
@@ -25,6 +31,19 @@ type RoleRepo interface {
 	AssignRole(ctx context.Context, userID, roleID int64) error
 	RemoveRole(ctx context.Context, userID, roleID int64) error
 	HasRole(ctx context.Context, userID, roleID int64) (bool, error)
+	ListRoles(ctx context.Context) ([]*domain.Role, error)
+	ListUserRoles(ctx context.Context) ([]*domain.UserRole, error)
+	// FindRole 按ID查找角色，不过滤状态，不存在时返回ErrRoleNotFound
+	FindRole(ctx context.Context, roleID int64) (*domain.Role, error)
+	// CreateRole 名称重复时返回ErrRoleExist
+	CreateRole(ctx context.Context, role *domain.Role) error
+	UpdateRole(ctx context.Context, role *domain.Role) error
+	// DeleteRole 删除角色及其用户、权限绑定
+	DeleteRole(ctx context.Context, roleID int64) error
+	// GetUserRoleBindings 在同一事务中读取用户绑定的角色ID（不过滤角色状态）和权限版本
+	GetUserRoleBindings(ctx context.Context, userID int64) ([]int64, int64, error)
+	// ListPermissionVersions 所有绑定变更过的用户的权限版本
+	ListPermissionVersions(ctx context.Context) (map[int64]int64, error)
 }
 
 // PermissionRepo 权限仓储接口
@@ -33,6 +52,18 @@ type PermissionRepo interface {
 	GetRolePermissions(ctx context.Context, roleID int64) ([]*domain.Permission, error)
 	GetUserPermissions(ctx context.Context, userID int64) ([]*domain.Permission, error)
 	HasPermission(ctx context.Context, userID int64, resource, action string) (bool, error)
+	ListPermissions(ctx context.Context) ([]*domain.Permission, error)
+	ListRolePermissions(ctx context.Context) ([]*domain.RolePermission, error)
+	// FindPermission 按ID查找权限，不过滤状态，不存在时返回ErrPermissionNotFound
+	FindPermission(ctx context.Context, permissionID int64) (*domain.Permission, error)
+	// CreatePermission 名称重复时返回ErrPermissionExist
+	CreatePermission(ctx context.Context, perm *domain.Permission) error
+	UpdatePermission(ctx context.Context, perm *domain.Permission) error
+	// DeletePermission 删除权限及其角色绑定
+	DeletePermission(ctx context.Context, permissionID int64) error
+	// AssignPermission 为角色绑定权限，已绑定时不做任何事
+	AssignPermission(ctx context.Context, roleID, permissionID int64) error
+	RevokePermission(ctx context.Context, roleID, permissionID int64) error
 }
 
 // PermissionUsecase 权限用例
@@ -40,6 +71,7 @@ type PermissionUsecase struct {
 	roleRepo       RoleRepo
 	permissionRepo PermissionRepo
 	rbacManager    auth.RBACManager
+	resolvers      OwnershipResolvers
 	log            *log.Helper
 }
 
@@ -48,12 +80,14 @@ func NewPermissionUsecase(
 	roleRepo RoleRepo,
 	permissionRepo PermissionRepo,
 	rbacManager auth.RBACManager,
+	resolvers OwnershipResolvers,
 	logger log.Logger,
 ) *PermissionUsecase {
 	return &PermissionUsecase{
 		roleRepo:       roleRepo,
 		permissionRepo: permissionRepo,
 		rbacManager:    rbacManager,
+		resolvers:      resolvers,
 		log:            log.NewHelper(logger),
 	}
 }
@@ -69,6 +103,59 @@ func (uc *PermissionUsecase) CheckPermission(ctx context.Context, userID int64,
 	return uc.permissionRepo.HasPermission(ctx, userID, resource, action)
 }
 
+// CheckResourcePermission 检查用户对具体资源的权限：先检查无条件权限，
+// 再评估带范围条件的权限，own 范围通过资源的归属解析器判断
+func (uc *PermissionUsecase) CheckResourcePermission(ctx context.Context, userID int64, resource, action string, resourceID int64) (bool, error) {
+	allowed, err := uc.CheckPermission(ctx, userID, resource, action)
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	scoped, err := uc.scopedPermissions(ctx, userID, resource, action)
+	if err != nil {
+		return false, err
+	}
+
+	for _, perm := range scoped {
+		if perm.Scope != domain.PermissionScopeOwn {
+			continue
+		}
+
+		resolver, ok := uc.resolvers[resource]
+		if !ok {
+			uc.log.WithContext(ctx).Warnf("no ownership resolver for resource %s", resource)
+			return false, nil
+		}
+		return resolver.IsOwner(ctx, userID, resourceID)
+	}
+
+	return false, nil
+}
+
+// scopedPermissions 获取匹配资源和操作的带范围条件的权限，内存中没有时回退到数据库
+func (uc *PermissionUsecase) scopedPermissions(ctx context.Context, userID int64, resource, action string) ([]*domain.Permission, error) {
+	permissions, _ := uc.rbacManager.GetUserPermissions(userID)
+	if scoped := filterScopedPermissions(permissions, resource, action); len(scoped) > 0 {
+		return scoped, nil
+	}
+
+	permissions, err := uc.permissionRepo.GetUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return filterScopedPermissions(permissions, resource, action), nil
+}
+
+func filterScopedPermissions(permissions []*domain.Permission, resource, action string) []*domain.Permission {
+	var scoped []*domain.Permission
+	for _, perm := range permissions {
+		if perm.MatchScoped(resource, action) {
+			scoped = append(scoped, perm)
+		}
+	}
+	return scoped
+}
+
 // GetUserRoles 获取用户角色
 func (uc *PermissionUsecase) GetUserRoles(ctx context.Context, userID int64) ([]*domain.Role, error) {
 	// 优先从数据库获取
@@ -100,7 +187,7 @@ func (uc *PermissionUsecase) AssignRole(ctx context.Context, userID, roleID int6
 	}
 
 	// 同步到内存管理器
-	uc.rbacManager.AssignRole(userID, roleID)
+	uc.syncUserRoles(ctx, userID, func() { uc.rbacManager.AssignRole(userID, roleID) })
 
 	return nil
 }
@@ -115,11 +202,23 @@ func (uc *PermissionUsecase) RemoveRole(ctx context.Context, userID, roleID int6
 	}
 
 	// 同步到内存管理器
-	uc.rbacManager.RemoveRole(userID, roleID)
+	uc.syncUserRoles(ctx, userID, func() { uc.rbacManager.RemoveRole(userID, roleID) })
 
 	return nil
 }
 
+// syncUserRoles 从数据库重新读取用户的角色绑定和权限版本写入内存，保证内存中同一版本对应的角色与数据库一致。
+// 读取失败时退回到增量修改，内存中该用户的版本失效，令牌中的角色不再被信任，直到下一次全量刷新
+func (uc *PermissionUsecase) syncUserRoles(ctx context.Context, userID int64, fallback func()) {
+	roleIDs, version, err := uc.roleRepo.GetUserRoleBindings(ctx, userID)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("reload role bindings of user %d failed: %v", userID, err)
+		fallback()
+		return
+	}
+	uc.rbacManager.SetUserRoles(userID, roleIDs, version)
+}
+
 // HasRole 检查用户是否有指定角色
 func (uc *PermissionUsecase) HasRole(ctx context.Context, userID, roleID int64) (bool, error) {
 	return uc.roleRepo.HasRole(ctx, userID, roleID)
@@ -161,6 +260,19 @@ func (uc *PermissionUsecase) IsModerator(ctx context.Context, userID int64) (boo
 	return uc.HasRole(ctx, userID, modRole.ID)
 }
 
+// CanModerateContent 检查用户是否可以审核内容，管理员和审核员均可
+func (uc *PermissionUsecase) CanModerateContent(ctx context.Context, userID int64) (bool, error) {
+	isAdmin, err := uc.IsAdmin(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if isAdmin {
+		return true, nil
+	}
+
+	return uc.IsModerator(ctx, userID)
+}
+
 // ClearUserPermissionCache 清除用户权限缓存
 func (uc *PermissionUsecase) ClearUserPermissionCache(ctx context.Context, userID int64) {
 	uc.log.WithContext(ctx).Infof("Clear permission cache for user: %d", userID)
@@ -169,14 +281,12 @@ func (uc *PermissionUsecase) ClearUserPermissionCache(ctx context.Context, userI
 
 // CheckVideoPermission 检查视频相关权限
 func (uc *PermissionUsecase) CheckVideoPermission(ctx context.Context, userID int64, action string) (bool, error) {
-	resource := "/video"
-	return uc.CheckPermission(ctx, userID, resource, action)
+	return uc.CheckPermission(ctx, userID, ResourceVideo, action)
 }
 
 // CheckCommentPermission 检查评论相关权限
 func (uc *PermissionUsecase) CheckCommentPermission(ctx context.Context, userID int64, action string) (bool, error) {
-	resource := "/comment"
-	return uc.CheckPermission(ctx, userID, resource, action)
+	return uc.CheckPermission(ctx, userID, ResourceComment, action)
 }
 
 // CheckUserPermission 检查用户信息相关权限
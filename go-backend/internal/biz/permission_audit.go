@@ -0,0 +1,155 @@
+package biz
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/listquery"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultDenialMaxRows      = 100000
+	defaultDenialTrimInterval = 10 * time.Minute
+	denialTrimBatchSize       = 1000
+	// denialWriteTimeout 写入拒绝记录的超时，请求已结束或取消时仍然写入
+	denialWriteTimeout = 2 * time.Second
+)
+
+// DenialFilter 权限拒绝记录查询条件，零值字段不过滤
+type DenialFilter struct {
+	UserID    int64
+	Resource  string
+	Action    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// DenialListSchema 权限拒绝记录列表可过滤和排序的字段，默认按ID倒序
+var DenialListSchema = listquery.NewSchema("id", nil,
+	listquery.Field{Name: "id", Type: listquery.Int, Sortable: true},
+	listquery.Field{Name: "user_id", Type: listquery.Int},
+	listquery.Field{Name: "resource", Type: listquery.String},
+	listquery.Field{Name: "action", Type: listquery.String},
+	listquery.Field{Name: "route", Type: listquery.String},
+	listquery.Field{Name: "created_at", Type: listquery.Time, Sortable: true},
+)
+
+// PermissionAuditRepo is a PermissionAudit repo.
+type PermissionAuditRepo interface {
+	CreateDenial(context.Context, *domain.PermissionDenial) error
+	// ListDenials 按条件和已校验的列表表达式分页查询拒绝记录
+	ListDenials(context.Context, *DenialFilter, *listquery.Compiled) ([]*domain.PermissionDenial, int64, error)
+	// TrimDenials 只保留最新的keep条记录，按批删除，返回删除行数
+	TrimDenials(context.Context, int64, int) (int64, error)
+}
+
+// PermissionAuditUsecase 记录被拒绝的权限检查，按采样率写入容量受限的审计表，
+// 供管理员排查"为什么用户X不能做Y"以及发现越权试探。拒绝次数全量计入指标，不受采样影响
+type PermissionAuditUsecase struct {
+	repo         PermissionAuditRepo
+	permissionUc *PermissionUsecase
+
+	enabled      bool
+	sampleRate   float64
+	maxRows      int64
+	trimInterval time.Duration
+
+	denialCounter metric.Int64Counter
+
+	log *log.Helper
+}
+
+// NewPermissionAuditUsecase new a PermissionAudit usecase.
+func NewPermissionAuditUsecase(repo PermissionAuditRepo, permissionUc *PermissionUsecase, businessConfig *conf.Business, logger log.Logger) *PermissionAuditUsecase {
+	uc := &PermissionAuditUsecase{
+		repo:         repo,
+		permissionUc: permissionUc,
+		sampleRate:   1,
+		maxRows:      defaultDenialMaxRows,
+		trimInterval: defaultDenialTrimInterval,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetPermissionAudit(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		if cfg.SampleRate > 0 && cfg.SampleRate < 1 {
+			uc.sampleRate = cfg.SampleRate
+		}
+		if cfg.MaxRows > 0 {
+			uc.maxRows = cfg.MaxRows
+		}
+		if cfg.TrimInterval != nil {
+			uc.trimInterval = cfg.TrimInterval.AsDuration()
+		}
+	}
+
+	meter := otel.Meter("go-backend/rbac")
+	uc.denialCounter, _ = meter.Int64Counter("permission_denial_total",
+		metric.WithDescription("Permission checks denied by the RBAC middleware"))
+
+	return uc
+}
+
+// Enabled 是否记录拒绝审计
+func (uc *PermissionAuditUsecase) Enabled() bool {
+	return uc.enabled
+}
+
+// TrimInterval 裁剪间隔
+func (uc *PermissionAuditUsecase) TrimInterval() time.Duration {
+	return uc.trimInterval
+}
+
+// RecordDenial 记录一次权限拒绝，写入失败只打日志
+func (uc *PermissionAuditUsecase) RecordDenial(ctx context.Context, denial *domain.PermissionDenial) {
+	uc.denialCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("resource", denial.Resource)))
+
+	if !uc.enabled || (uc.sampleRate < 1 && rand.Float64() >= uc.sampleRate) {
+		return
+	}
+
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), denialWriteTimeout)
+	defer cancel()
+
+	if err := uc.repo.CreateDenial(writeCtx, denial); err != nil {
+		uc.log.WithContext(ctx).Warnf("record permission denial failed: user=%d err=%v", denial.UserID, err)
+	}
+}
+
+// ListDenials lists recorded permission denials for admins.
+func (uc *PermissionAuditUsecase) ListDenials(ctx context.Context, adminID int64, filter *DenialFilter, q *listquery.Query) ([]*domain.PermissionDenial, int64, error) {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, adminID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !isAdmin {
+		return nil, 0, ErrPermissionDenied
+	}
+
+	compiled, err := compileListQuery(DenialListSchema, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.ListDenials(ctx, filter, compiled)
+}
+
+// Trim 删除超出容量上限的旧记录，供调度器调用
+func (uc *PermissionAuditUsecase) Trim(ctx context.Context) error {
+	deleted, err := uc.repo.TrimDenials(ctx, uc.maxRows, denialTrimBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		uc.log.WithContext(ctx).Infof("trimmed %d permission denials beyond %d rows", deleted, uc.maxRows)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/listquery"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type permissionAuditTestDeps struct {
+	repo     *MockPermissionAuditRepo
+	roleRepo *MockRoleRepo
+	uc       *PermissionAuditUsecase
+}
+
+func newPermissionAuditTestDeps(t *testing.T, cfg *conf.Business_PermissionAudit) *permissionAuditTestDeps {
+	repo := NewMockPermissionAuditRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+
+	return &permissionAuditTestDeps{
+		repo:     repo,
+		roleRepo: roleRepo,
+		uc:       NewPermissionAuditUsecase(repo, permissionUc, &conf.Business{PermissionAudit: cfg}, log.DefaultLogger),
+	}
+}
+
+func TestPermissionAuditUsecase_RecordDenial(t *testing.T) {
+	ctx := context.Background()
+	denial := &domain.PermissionDenial{UserID: 7, Resource: "/video", Action: "DELETE", Route: "POST /douyin/video/delete"}
+
+	t.Run("Enabled", func(t *testing.T) {
+		d := newPermissionAuditTestDeps(t, &conf.Business_PermissionAudit{Enabled: true, SampleRate: 1})
+		d.repo.EXPECT().CreateDenial(mock.Anything, denial).Return(nil).Once()
+
+		d.uc.RecordDenial(ctx, denial)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		d := newPermissionAuditTestDeps(t, nil)
+
+		d.uc.RecordDenial(ctx, denial)
+	})
+
+	t.Run("WriteFailureIgnored", func(t *testing.T) {
+		d := newPermissionAuditTestDeps(t, &conf.Business_PermissionAudit{Enabled: true})
+		d.repo.EXPECT().CreateDenial(mock.Anything, denial).Return(errors.New("db down"))
+
+		d.uc.RecordDenial(ctx, denial)
+	})
+
+	t.Run("Sampled", func(t *testing.T) {
+		d := newPermissionAuditTestDeps(t, &conf.Business_PermissionAudit{Enabled: true, SampleRate: 0.5})
+
+		var written int
+		d.repo.EXPECT().CreateDenial(mock.Anything, denial).RunAndReturn(func(context.Context, *domain.PermissionDenial) error {
+			written++
+			return nil
+		}).Maybe()
+
+		for i := 0; i < 1000; i++ {
+			d.uc.RecordDenial(ctx, denial)
+		}
+
+		assert.Greater(t, written, 300)
+		assert.Less(t, written, 700)
+	})
+}
+
+func TestPermissionAuditUsecase_ListDenials(t *testing.T) {
+	ctx := context.Background()
+	filter := &DenialFilter{UserID: 7}
+
+	t.Run("Admin", func(t *testing.T) {
+		d := newPermissionAuditTestDeps(t, nil)
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, int64(1), int64(1)).Return(true, nil)
+		d.repo.EXPECT().ListDenials(ctx, filter, &listquery.Compiled{
+			Orders: []listquery.Order{{Column: "id", Desc: true}},
+			Page:   1,
+			Size:   20,
+		}).Return([]*domain.PermissionDenial{{ID: 3, UserID: 7}}, 1, nil)
+
+		denials, total, err := d.uc.ListDenials(ctx, 1, filter, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, denials, 1)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newPermissionAuditTestDeps(t, nil)
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, int64(7), int64(1)).Return(false, nil)
+
+		_, _, err := d.uc.ListDenials(ctx, 7, filter, nil)
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
+
+func TestPermissionAuditUsecase_Trim(t *testing.T) {
+	ctx := context.Background()
+	d := newPermissionAuditTestDeps(t, &conf.Business_PermissionAudit{Enabled: true, MaxRows: 500})
+	d.repo.EXPECT().TrimDenials(ctx, int64(500), denialTrimBatchSize).Return(20, nil)
+
+	require.NoError(t, d.uc.Trim(ctx))
+}
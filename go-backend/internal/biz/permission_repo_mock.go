@@ -22,6 +22,207 @@ func (_m *MockPermissionRepo) EXPECT() *MockPermissionRepo_Expecter {
 	return &MockPermissionRepo_Expecter{mock: &_m.Mock}
 }
 
+// AssignPermission provides a mock function with given fields: ctx, roleID, permissionID
+func (_m *MockPermissionRepo) AssignPermission(ctx context.Context, roleID int64, permissionID int64) error {
+	ret := _m.Called(ctx, roleID, permissionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignPermission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, roleID, permissionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPermissionRepo_AssignPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignPermission'
+type MockPermissionRepo_AssignPermission_Call struct {
+	*mock.Call
+}
+
+// AssignPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int64
+//   - permissionID int64
+func (_e *MockPermissionRepo_Expecter) AssignPermission(ctx interface{}, roleID interface{}, permissionID interface{}) *MockPermissionRepo_AssignPermission_Call {
+	return &MockPermissionRepo_AssignPermission_Call{Call: _e.mock.On("AssignPermission", ctx, roleID, permissionID)}
+}
+
+func (_c *MockPermissionRepo_AssignPermission_Call) Run(run func(ctx context.Context, roleID int64, permissionID int64)) *MockPermissionRepo_AssignPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_AssignPermission_Call) Return(_a0 error) *MockPermissionRepo_AssignPermission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPermissionRepo_AssignPermission_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockPermissionRepo_AssignPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePermission provides a mock function with given fields: ctx, perm
+func (_m *MockPermissionRepo) CreatePermission(ctx context.Context, perm *domain.Permission) error {
+	ret := _m.Called(ctx, perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePermission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Permission) error); ok {
+		r0 = rf(ctx, perm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPermissionRepo_CreatePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePermission'
+type MockPermissionRepo_CreatePermission_Call struct {
+	*mock.Call
+}
+
+// CreatePermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - perm *domain.Permission
+func (_e *MockPermissionRepo_Expecter) CreatePermission(ctx interface{}, perm interface{}) *MockPermissionRepo_CreatePermission_Call {
+	return &MockPermissionRepo_CreatePermission_Call{Call: _e.mock.On("CreatePermission", ctx, perm)}
+}
+
+func (_c *MockPermissionRepo_CreatePermission_Call) Run(run func(ctx context.Context, perm *domain.Permission)) *MockPermissionRepo_CreatePermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Permission))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_CreatePermission_Call) Return(_a0 error) *MockPermissionRepo_CreatePermission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPermissionRepo_CreatePermission_Call) RunAndReturn(run func(context.Context, *domain.Permission) error) *MockPermissionRepo_CreatePermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePermission provides a mock function with given fields: ctx, permissionID
+func (_m *MockPermissionRepo) DeletePermission(ctx context.Context, permissionID int64) error {
+	ret := _m.Called(ctx, permissionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePermission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, permissionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPermissionRepo_DeletePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePermission'
+type MockPermissionRepo_DeletePermission_Call struct {
+	*mock.Call
+}
+
+// DeletePermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permissionID int64
+func (_e *MockPermissionRepo_Expecter) DeletePermission(ctx interface{}, permissionID interface{}) *MockPermissionRepo_DeletePermission_Call {
+	return &MockPermissionRepo_DeletePermission_Call{Call: _e.mock.On("DeletePermission", ctx, permissionID)}
+}
+
+func (_c *MockPermissionRepo_DeletePermission_Call) Run(run func(ctx context.Context, permissionID int64)) *MockPermissionRepo_DeletePermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_DeletePermission_Call) Return(_a0 error) *MockPermissionRepo_DeletePermission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPermissionRepo_DeletePermission_Call) RunAndReturn(run func(context.Context, int64) error) *MockPermissionRepo_DeletePermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPermission provides a mock function with given fields: ctx, permissionID
+func (_m *MockPermissionRepo) FindPermission(ctx context.Context, permissionID int64) (*domain.Permission, error) {
+	ret := _m.Called(ctx, permissionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPermission")
+	}
+
+	var r0 *domain.Permission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Permission, error)); ok {
+		return rf(ctx, permissionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Permission); ok {
+		r0 = rf(ctx, permissionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Permission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, permissionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPermissionRepo_FindPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPermission'
+type MockPermissionRepo_FindPermission_Call struct {
+	*mock.Call
+}
+
+// FindPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permissionID int64
+func (_e *MockPermissionRepo_Expecter) FindPermission(ctx interface{}, permissionID interface{}) *MockPermissionRepo_FindPermission_Call {
+	return &MockPermissionRepo_FindPermission_Call{Call: _e.mock.On("FindPermission", ctx, permissionID)}
+}
+
+func (_c *MockPermissionRepo_FindPermission_Call) Run(run func(ctx context.Context, permissionID int64)) *MockPermissionRepo_FindPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_FindPermission_Call) Return(_a0 *domain.Permission, _a1 error) *MockPermissionRepo_FindPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPermissionRepo_FindPermission_Call) RunAndReturn(run func(context.Context, int64) (*domain.Permission, error)) *MockPermissionRepo_FindPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPermission provides a mock function with given fields: ctx, permissionID
 func (_m *MockPermissionRepo) GetPermission(ctx context.Context, permissionID int64) (*domain.Permission, error) {
 	ret := _m.Called(ctx, permissionID)
@@ -258,6 +459,217 @@ func (_c *MockPermissionRepo_HasPermission_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListPermissions provides a mock function with given fields: ctx
+func (_m *MockPermissionRepo) ListPermissions(ctx context.Context) ([]*domain.Permission, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPermissions")
+	}
+
+	var r0 []*domain.Permission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Permission, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Permission); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Permission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPermissionRepo_ListPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPermissions'
+type MockPermissionRepo_ListPermissions_Call struct {
+	*mock.Call
+}
+
+// ListPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPermissionRepo_Expecter) ListPermissions(ctx interface{}) *MockPermissionRepo_ListPermissions_Call {
+	return &MockPermissionRepo_ListPermissions_Call{Call: _e.mock.On("ListPermissions", ctx)}
+}
+
+func (_c *MockPermissionRepo_ListPermissions_Call) Run(run func(ctx context.Context)) *MockPermissionRepo_ListPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_ListPermissions_Call) Return(_a0 []*domain.Permission, _a1 error) *MockPermissionRepo_ListPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPermissionRepo_ListPermissions_Call) RunAndReturn(run func(context.Context) ([]*domain.Permission, error)) *MockPermissionRepo_ListPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRolePermissions provides a mock function with given fields: ctx
+func (_m *MockPermissionRepo) ListRolePermissions(ctx context.Context) ([]*domain.RolePermission, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRolePermissions")
+	}
+
+	var r0 []*domain.RolePermission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.RolePermission, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.RolePermission); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.RolePermission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPermissionRepo_ListRolePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRolePermissions'
+type MockPermissionRepo_ListRolePermissions_Call struct {
+	*mock.Call
+}
+
+// ListRolePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPermissionRepo_Expecter) ListRolePermissions(ctx interface{}) *MockPermissionRepo_ListRolePermissions_Call {
+	return &MockPermissionRepo_ListRolePermissions_Call{Call: _e.mock.On("ListRolePermissions", ctx)}
+}
+
+func (_c *MockPermissionRepo_ListRolePermissions_Call) Run(run func(ctx context.Context)) *MockPermissionRepo_ListRolePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_ListRolePermissions_Call) Return(_a0 []*domain.RolePermission, _a1 error) *MockPermissionRepo_ListRolePermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPermissionRepo_ListRolePermissions_Call) RunAndReturn(run func(context.Context) ([]*domain.RolePermission, error)) *MockPermissionRepo_ListRolePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokePermission provides a mock function with given fields: ctx, roleID, permissionID
+func (_m *MockPermissionRepo) RevokePermission(ctx context.Context, roleID int64, permissionID int64) error {
+	ret := _m.Called(ctx, roleID, permissionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokePermission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, roleID, permissionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPermissionRepo_RevokePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePermission'
+type MockPermissionRepo_RevokePermission_Call struct {
+	*mock.Call
+}
+
+// RevokePermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int64
+//   - permissionID int64
+func (_e *MockPermissionRepo_Expecter) RevokePermission(ctx interface{}, roleID interface{}, permissionID interface{}) *MockPermissionRepo_RevokePermission_Call {
+	return &MockPermissionRepo_RevokePermission_Call{Call: _e.mock.On("RevokePermission", ctx, roleID, permissionID)}
+}
+
+func (_c *MockPermissionRepo_RevokePermission_Call) Run(run func(ctx context.Context, roleID int64, permissionID int64)) *MockPermissionRepo_RevokePermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_RevokePermission_Call) Return(_a0 error) *MockPermissionRepo_RevokePermission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPermissionRepo_RevokePermission_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockPermissionRepo_RevokePermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePermission provides a mock function with given fields: ctx, perm
+func (_m *MockPermissionRepo) UpdatePermission(ctx context.Context, perm *domain.Permission) error {
+	ret := _m.Called(ctx, perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePermission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Permission) error); ok {
+		r0 = rf(ctx, perm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPermissionRepo_UpdatePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePermission'
+type MockPermissionRepo_UpdatePermission_Call struct {
+	*mock.Call
+}
+
+// UpdatePermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - perm *domain.Permission
+func (_e *MockPermissionRepo_Expecter) UpdatePermission(ctx interface{}, perm interface{}) *MockPermissionRepo_UpdatePermission_Call {
+	return &MockPermissionRepo_UpdatePermission_Call{Call: _e.mock.On("UpdatePermission", ctx, perm)}
+}
+
+func (_c *MockPermissionRepo_UpdatePermission_Call) Run(run func(ctx context.Context, perm *domain.Permission)) *MockPermissionRepo_UpdatePermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Permission))
+	})
+	return _c
+}
+
+func (_c *MockPermissionRepo_UpdatePermission_Call) Return(_a0 error) *MockPermissionRepo_UpdatePermission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPermissionRepo_UpdatePermission_Call) RunAndReturn(run func(context.Context, *domain.Permission) error) *MockPermissionRepo_UpdatePermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockPermissionRepo creates a new instance of MockPermissionRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockPermissionRepo(t interface {
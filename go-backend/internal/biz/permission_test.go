@@ -30,7 +30,7 @@ func TestPermissionUsecase_CheckPermission(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 通过内存RBAC管理器分配角色
 		err := rbacManager.AssignRole(testUser.ID, 1) // 分配用户角色
@@ -47,7 +47,7 @@ func TestPermissionUsecase_CheckPermission(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 检查不存在的权限
 		permissionRepo.EXPECT().HasPermission(ctx, testUser.ID, "/admin", "DELETE").Return(false, nil)
@@ -63,7 +63,7 @@ func TestPermissionUsecase_CheckPermission(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 内存RBAC没有权限，回退到数据库查询
 		permissionRepo.EXPECT().HasPermission(ctx, testUser.ID, "/video", "POST").Return(true, nil)
@@ -92,7 +92,7 @@ func TestPermissionUsecase_GetUserRoles(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		expectedRoles := []*domain.Role{
 			{ID: 1, Name: "user", Description: "Regular user", Status: 1},
@@ -114,7 +114,7 @@ func TestPermissionUsecase_GetUserRoles(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		roleRepo.EXPECT().GetUserRoles(ctx, testUser.ID).Return([]*domain.Role{}, nil)
 
@@ -142,7 +142,7 @@ func TestPermissionUsecase_GetUserPermissions(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		expectedPermissions := []*domain.Permission{
 			{ID: 1, Name: "user:read", Resource: "/user", Action: "GET", Status: 1},
@@ -177,21 +177,43 @@ func TestPermissionUsecase_AssignRole(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		roleID := int64(1)
 
 		roleRepo.EXPECT().AssignRole(ctx, testUser.ID, roleID).Return(nil)
+		roleRepo.EXPECT().GetUserRoleBindings(ctx, testUser.ID).Return([]int64{roleID}, 4, nil)
 
 		err := uc.AssignRole(ctx, testUser.ID, roleID)
 
 		require.NoError(t, err)
 
-		// 验证内存管理器中已分配角色
+		// 验证内存管理器中已分配角色，并记录数据库中的权限版本
 		roles, err := rbacManager.GetUserRoles(testUser.ID)
 		require.NoError(t, err)
 		assert.Len(t, roles, 1)
 		assert.Equal(t, roleID, roles[0].ID)
+		version, ok := rbacManager.PermissionVersion(testUser.ID)
+		assert.True(t, ok)
+		assert.Equal(t, int64(4), version)
+	})
+
+	t.Run("AssignRole_ReloadFailed", func(t *testing.T) {
+		roleRepo := NewMockRoleRepo(t)
+		permissionRepo := NewMockPermissionRepo(t)
+		rbacManager := auth.NewMemoryRBACManager()
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
+		rbacManager.SetUserRoles(testUser.ID, nil, 3)
+
+		roleRepo.EXPECT().AssignRole(ctx, testUser.ID, int64(1)).Return(nil)
+		roleRepo.EXPECT().GetUserRoleBindings(ctx, testUser.ID).Return(nil, 0, assert.AnError)
+
+		require.NoError(t, uc.AssignRole(ctx, testUser.ID, 1))
+
+		// 增量更新内存，版本失效，令牌中的角色不再被信任
+		assert.True(t, rbacManager.HasPermission(ctx, testUser.ID, "/user", "GET"))
+		_, ok := rbacManager.PermissionVersion(testUser.ID)
+		assert.False(t, ok)
 	})
 
 	t.Run("AssignRole_DatabaseError", func(t *testing.T) {
@@ -199,7 +221,7 @@ func TestPermissionUsecase_AssignRole(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		roleID := int64(2)
 
@@ -229,7 +251,7 @@ func TestPermissionUsecase_RemoveRole(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		roleID := int64(1)
 
@@ -237,6 +259,7 @@ func TestPermissionUsecase_RemoveRole(t *testing.T) {
 		rbacManager.AssignRole(testUser.ID, roleID)
 
 		roleRepo.EXPECT().RemoveRole(ctx, testUser.ID, roleID).Return(nil)
+		roleRepo.EXPECT().GetUserRoleBindings(ctx, testUser.ID).Return(nil, 2, nil)
 
 		err := uc.RemoveRole(ctx, testUser.ID, roleID)
 
@@ -266,7 +289,7 @@ func TestPermissionUsecase_HasRole(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		roleID := int64(1)
 
@@ -283,7 +306,7 @@ func TestPermissionUsecase_HasRole(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		roleID := int64(2)
 
@@ -313,7 +336,7 @@ func TestPermissionUsecase_InitUserDefaultRole(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		defaultRole := &domain.Role{
 			ID:   1,
@@ -322,6 +345,7 @@ func TestPermissionUsecase_InitUserDefaultRole(t *testing.T) {
 
 		roleRepo.EXPECT().GetRoleByName(ctx, "user").Return(defaultRole, nil)
 		roleRepo.EXPECT().AssignRole(ctx, testUser.ID, defaultRole.ID).Return(nil)
+		roleRepo.EXPECT().GetUserRoleBindings(ctx, testUser.ID).Return([]int64{defaultRole.ID}, 1, nil)
 
 		err := uc.InitUserDefaultRole(ctx, testUser.ID)
 
@@ -333,7 +357,7 @@ func TestPermissionUsecase_InitUserDefaultRole(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		roleRepo.EXPECT().GetRoleByName(ctx, "user").Return(nil, ErrRoleNotFound)
 
@@ -361,7 +385,7 @@ func TestPermissionUsecase_IsAdmin(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		adminRole := &domain.Role{
 			ID:   2,
@@ -382,7 +406,7 @@ func TestPermissionUsecase_IsAdmin(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		adminRole := &domain.Role{
 			ID:   2,
@@ -416,7 +440,7 @@ func TestPermissionUsecase_IsModerator(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		modRole := &domain.Role{
 			ID:   3,
@@ -437,7 +461,7 @@ func TestPermissionUsecase_IsModerator(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		modRole := &domain.Role{
 			ID:   3,
@@ -471,7 +495,7 @@ func TestPermissionUsecase_ClearUserPermissionCache(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 先分配角色建立缓存
 		rbacManager.AssignRole(testUser.ID, 1)
@@ -501,7 +525,7 @@ func TestPermissionUsecase_ValidateResourceAccess(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 分配用户角色
 		rbacManager.AssignRole(testUser.ID, 1)
@@ -516,7 +540,7 @@ func TestPermissionUsecase_ValidateResourceAccess(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		permissionRepo.EXPECT().HasPermission(ctx, testUser.ID, "/admin", "DELETE").Return(false, nil)
 
@@ -544,7 +568,7 @@ func TestPermissionUsecase_CheckSpecificPermissions(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 分配用户角色，应该有video相关权限
 		rbacManager.AssignRole(testUser.ID, 1)
@@ -560,7 +584,7 @@ func TestPermissionUsecase_CheckSpecificPermissions(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 分配用户角色，应该有comment相关权限
 		rbacManager.AssignRole(testUser.ID, 1)
@@ -576,7 +600,7 @@ func TestPermissionUsecase_CheckSpecificPermissions(t *testing.T) {
 		roleRepo := NewMockRoleRepo(t)
 		permissionRepo := NewMockPermissionRepo(t)
 		rbacManager := auth.NewMemoryRBACManager()
-		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
+		uc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
 
 		// 分配用户角色，应该有user相关权限
 		rbacManager.AssignRole(testUser.ID, 1)
@@ -587,3 +611,60 @@ func TestPermissionUsecase_CheckSpecificPermissions(t *testing.T) {
 		assert.True(t, hasPermission)
 	})
 }
+
+func TestPermissionUsecase_CheckResourcePermission(t *testing.T) {
+	ctx := context.Background()
+
+	newUsecase := func(t *testing.T) (*PermissionUsecase, *MockPermissionRepo, *MockOwnershipRepo) {
+		permissionRepo := NewMockPermissionRepo(t)
+		ownershipRepo := NewMockOwnershipRepo(t)
+		rbacManager := auth.NewMemoryRBACManager()
+		// 内置user角色带有 video:delete:own
+		require.NoError(t, rbacManager.AssignRole(1, 1))
+		resolvers := NewOwnershipResolvers(ownershipRepo)
+		uc := NewPermissionUsecase(NewMockRoleRepo(t), permissionRepo, rbacManager, resolvers, log.DefaultLogger)
+		return uc, permissionRepo, ownershipRepo
+	}
+
+	t.Run("Owner", func(t *testing.T) {
+		uc, permissionRepo, ownershipRepo := newUsecase(t)
+		permissionRepo.EXPECT().HasPermission(ctx, int64(1), "/video", "DELETE").Return(false, nil)
+		ownershipRepo.EXPECT().GetVideoAuthorID(ctx, int64(10)).Return(1, nil)
+
+		allowed, err := uc.CheckResourcePermission(ctx, 1, ResourceVideo, domain.ActionDelete, 10)
+
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		uc, permissionRepo, ownershipRepo := newUsecase(t)
+		permissionRepo.EXPECT().HasPermission(ctx, int64(1), "/video", "DELETE").Return(false, nil)
+		ownershipRepo.EXPECT().GetVideoAuthorID(ctx, int64(10)).Return(2, nil)
+
+		allowed, err := uc.CheckResourcePermission(ctx, 1, ResourceVideo, domain.ActionDelete, 10)
+
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("UnconditionalSkipsResolver", func(t *testing.T) {
+		uc, permissionRepo, _ := newUsecase(t)
+		permissionRepo.EXPECT().HasPermission(ctx, int64(3), "/video", "DELETE").Return(true, nil)
+
+		allowed, err := uc.CheckResourcePermission(ctx, 3, ResourceVideo, domain.ActionDelete, 10)
+
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("ScopedPermissionNotUnconditional", func(t *testing.T) {
+		uc, permissionRepo, _ := newUsecase(t)
+		permissionRepo.EXPECT().HasPermission(ctx, int64(1), "/video", "DELETE").Return(false, nil)
+
+		allowed, err := uc.CheckPermission(ctx, 1, ResourceVideo, domain.ActionDelete)
+
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultPlayDedupWindow 同一观众在窗口内重复播放同一视频只计一次
+	defaultPlayDedupWindow = 30 * time.Minute
+	// defaultPlayMinWatch 观看时长达到该值才计入播放
+	defaultPlayMinWatch = 3 * time.Second
+)
+
+// PlayViewer 播放去重的观众标识，登录用户按用户ID去重，匿名观众按客户端IP去重
+type PlayViewer struct {
+	UserID   int64
+	ClientIP string
+}
+
+// PlayDedupRepo 播放去重仓储
+type PlayDedupRepo interface {
+	// MarkPlayed 标记观众在当前去重窗口内播放过视频，窗口内首次播放返回 true
+	MarkPlayed(ctx context.Context, videoID int64, viewer PlayViewer, window time.Duration) (bool, error)
+}
+
+// PlayCountUsecase 播放计数用例。播放数只由客户端上报的有效播放累加，
+// 获取视频信息不再计入播放，刷新页面不会刷高播放数
+type PlayCountUsecase struct {
+	repo        PlayDedupRepo
+	videoRepo   VideoRepo
+	videoUc     *VideoUsecase
+	degradation *DegradationUsecase
+
+	dedupWindow time.Duration
+	minWatch    time.Duration
+
+	log *log.Helper
+}
+
+// NewPlayCountUsecase 创建播放计数用例
+func NewPlayCountUsecase(repo PlayDedupRepo, videoRepo VideoRepo, videoUc *VideoUsecase, degradation *DegradationUsecase, businessConfig *conf.Business, logger log.Logger) *PlayCountUsecase {
+	uc := &PlayCountUsecase{
+		repo:        repo,
+		videoRepo:   videoRepo,
+		videoUc:     videoUc,
+		degradation: degradation,
+		dedupWindow: defaultPlayDedupWindow,
+		minWatch:    defaultPlayMinWatch,
+		log:         log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetPlayCount(); cfg != nil {
+		if cfg.DedupWindow != nil && cfg.DedupWindow.AsDuration() > 0 {
+			uc.dedupWindow = cfg.DedupWindow.AsDuration()
+		}
+		if cfg.MinWatch != nil && cfg.MinWatch.AsDuration() >= 0 {
+			uc.minWatch = cfg.MinWatch.AsDuration()
+		}
+	}
+
+	return uc
+}
+
+// ReportPlay 上报一次播放，返回是否计入播放数。观看时长不足、去重窗口内已计入、
+// 无法识别观众或播放计数被降级时不计入
+func (uc *PlayCountUsecase) ReportPlay(ctx context.Context, viewer PlayViewer, videoID int64, watched time.Duration) (bool, error) {
+	if !uc.degradation.Allow(FeatureViewCounting) {
+		return false, nil
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return false, err
+	}
+	if video.Status != domain.VideoStatusPublished {
+		return false, utils.ErrVideoNotFound
+	}
+
+	if watched < uc.minWatchFor(video) {
+		return false, nil
+	}
+	if viewer.UserID <= 0 && viewer.ClientIP == "" {
+		return false, nil
+	}
+
+	first, err := uc.repo.MarkPlayed(ctx, videoID, viewer, uc.dedupWindow)
+	if err != nil {
+		return false, err
+	}
+	if !first {
+		return false, nil
+	}
+
+	if err := uc.videoUc.IncrementPlayCount(ctx, videoID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// minWatchFor 计入播放所需的观看时长，比最短观看时长还短的视频看完即计入
+func (uc *PlayCountUsecase) minWatchFor(video *domain.Video) time.Duration {
+	threshold := uc.minWatch
+	if video.Duration > 0 {
+		if length := time.Duration(video.Duration * float64(time.Second)); length < threshold {
+			threshold = length
+		}
+	}
+	return threshold
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/utils"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type playCountTestDeps struct {
+	repo      *MockPlayDedupRepo
+	videoRepo *MockVideoRepo
+	buffer    *MockVideoStatsBufferRepo
+	cache     *MockVideoCacheRepo
+	uc        *PlayCountUsecase
+}
+
+func newPlayCountTestUsecase(t *testing.T) *playCountTestDeps {
+	d := &playCountTestDeps{
+		repo:      NewMockPlayDedupRepo(t),
+		videoRepo: NewMockVideoRepo(t),
+		buffer:    NewMockVideoStatsBufferRepo(t),
+		cache:     NewMockVideoCacheRepo(t),
+	}
+	config := &conf.Business{
+		Video:      &conf.Business_Video{},
+		VideoStats: &conf.Business_VideoStats{WriteBehind: true},
+		PlayCount: &conf.Business_PlayCount{
+			DedupWindow: durationpb.New(10 * time.Minute),
+			MinWatch:    durationpb.New(5 * time.Second),
+		},
+	}
+	videoUc := NewVideoUseCase(d.videoRepo, d.cache, d.buffer, nil, nil, nil, config, newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clock.New(), log.DefaultLogger)
+	d.uc = NewPlayCountUsecase(d.repo, d.videoRepo, videoUc, newTestDegradation(), config, log.DefaultLogger)
+	return d
+}
+
+func TestPlayCountUsecase_Config(t *testing.T) {
+	d := newPlayCountTestUsecase(t)
+	assert.Equal(t, 10*time.Minute, d.uc.dedupWindow)
+	assert.Equal(t, 5*time.Second, d.uc.minWatch)
+
+	defaults := NewPlayCountUsecase(NewMockPlayDedupRepo(t), NewMockVideoRepo(t), nil, newTestDegradation(), &conf.Business{}, log.DefaultLogger)
+	assert.Equal(t, defaultPlayDedupWindow, defaults.dedupWindow)
+	assert.Equal(t, defaultPlayMinWatch, defaults.minWatch)
+}
+
+func TestPlayCountUsecase_ReportPlay(t *testing.T) {
+	ctx := context.Background()
+	published := &domain.Video{ID: 10, Status: domain.VideoStatusPublished, Duration: 30}
+
+	t.Run("CountFirstPlay", func(t *testing.T) {
+		d := newPlayCountTestUsecase(t)
+		viewer := PlayViewer{UserID: 1}
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+		d.repo.EXPECT().MarkPlayed(ctx, int64(10), viewer, 10*time.Minute).Return(true, nil).Once()
+		d.buffer.EXPECT().Incr(ctx, int64(10), "play_count", int64(1)).Return(nil).Once()
+		d.cache.EXPECT().IncrVideoStats(ctx, int64(10), "play_count", int64(1)).Return().Once()
+
+		counted, err := d.uc.ReportPlay(ctx, viewer, 10, 6*time.Second)
+		require.NoError(t, err)
+		assert.True(t, counted)
+	})
+
+	t.Run("DuplicateInWindow", func(t *testing.T) {
+		d := newPlayCountTestUsecase(t)
+		viewer := PlayViewer{ClientIP: "10.0.0.1"}
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+		d.repo.EXPECT().MarkPlayed(ctx, int64(10), viewer, 10*time.Minute).Return(false, nil).Once()
+
+		counted, err := d.uc.ReportPlay(ctx, viewer, 10, 6*time.Second)
+		require.NoError(t, err)
+		assert.False(t, counted)
+	})
+
+	t.Run("WatchTooShort", func(t *testing.T) {
+		d := newPlayCountTestUsecase(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+
+		counted, err := d.uc.ReportPlay(ctx, PlayViewer{UserID: 1}, 10, 2*time.Second)
+		require.NoError(t, err)
+		assert.False(t, counted)
+	})
+
+	t.Run("ShortVideoWatchedToEnd", func(t *testing.T) {
+		d := newPlayCountTestUsecase(t)
+		viewer := PlayViewer{UserID: 1}
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(11)).
+			Return(&domain.Video{ID: 11, Status: domain.VideoStatusPublished, Duration: 2.5}, nil)
+		d.repo.EXPECT().MarkPlayed(ctx, int64(11), viewer, 10*time.Minute).Return(true, nil).Once()
+		d.buffer.EXPECT().Incr(ctx, int64(11), "play_count", int64(1)).Return(nil).Once()
+		d.cache.EXPECT().IncrVideoStats(ctx, int64(11), "play_count", int64(1)).Return().Once()
+
+		counted, err := d.uc.ReportPlay(ctx, viewer, 11, 2500*time.Millisecond)
+		require.NoError(t, err)
+		assert.True(t, counted)
+	})
+
+	t.Run("UnknownViewer", func(t *testing.T) {
+		d := newPlayCountTestUsecase(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+
+		counted, err := d.uc.ReportPlay(ctx, PlayViewer{}, 10, 6*time.Second)
+		require.NoError(t, err)
+		assert.False(t, counted)
+	})
+
+	t.Run("UnpublishedVideo", func(t *testing.T) {
+		d := newPlayCountTestUsecase(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, Status: domain.VideoStatusPending}, nil)
+
+		_, err := d.uc.ReportPlay(ctx, PlayViewer{UserID: 1}, 10, 6*time.Second)
+		assert.ErrorIs(t, err, utils.ErrVideoNotFound)
+	})
+}
@@ -0,0 +1,304 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrPlaylistNotFound           = errors.NotFound(v1.ErrorCode_PLAYLIST_NOT_EXIST.String(), "playlist not found")
+	ErrInvalidPlaylistName        = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "playlist name must be 1-50 characters")
+	ErrPlaylistDescriptionTooLong = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "playlist description must be at most 200 characters")
+	ErrTooManyPlaylists           = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "too many playlists")
+	ErrPlaylistFull               = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "playlist is full")
+	ErrVideoAlreadyInPlaylist     = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "video is already in the playlist")
+	ErrVideoNotInPlaylist         = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "video is not in the playlist")
+	ErrInvalidPlaylistOrder       = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "new order must contain every video in the playlist exactly once")
+)
+
+const (
+	maxPlaylistNameLength        = 50
+	maxPlaylistDescriptionLength = 200
+
+	defaultMaxPlaylistsPerUser = 100
+	defaultMaxPlaylistVideos   = 500
+)
+
+// Playlist 用户创建的视频合集，视频按 position 排序
+type Playlist struct {
+	ID          int64
+	UserID      int64
+	Name        string
+	Description string
+	VideoCount  int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// PlaylistRepo is a Playlist repo.
+type PlaylistRepo interface {
+	CreatePlaylist(context.Context, *Playlist) error
+	UpdatePlaylist(context.Context, *Playlist) error
+	// GetPlaylist 合集不存在时返回ErrPlaylistNotFound
+	GetPlaylist(ctx context.Context, playlistID int64) (*Playlist, error)
+	// DeletePlaylist 删除合集及其中的视频条目
+	DeletePlaylist(ctx context.Context, userID, playlistID int64) error
+	CountPlaylists(ctx context.Context, userID int64) (int64, error)
+	// ListUserPlaylists 按创建时间倒序分页列出用户的合集
+	ListUserPlaylists(ctx context.Context, userID int64, page, size int32) ([]*Playlist, int64, error)
+	// AddPlaylistVideo 将视频追加到合集末尾，已收录时返回ErrVideoAlreadyInPlaylist，
+	// 合集已有maxVideos个视频时返回ErrPlaylistFull
+	AddPlaylistVideo(ctx context.Context, playlistID, videoID int64, maxVideos int64) error
+	// RemovePlaylistVideo 未收录时返回ErrVideoNotInPlaylist
+	RemovePlaylistVideo(ctx context.Context, playlistID, videoID int64) error
+	// GetPlaylistVideoIDs 按顺序分页列出合集中的视频ID
+	GetPlaylistVideoIDs(ctx context.Context, playlistID int64, page, size int32) ([]int64, int64, error)
+	// ListAllPlaylistVideoIDs 按顺序列出合集中的全部视频ID
+	ListAllPlaylistVideoIDs(ctx context.Context, playlistID int64) ([]int64, error)
+	// ReorderPlaylistVideos 按videoIDs的顺序重排合集
+	ReorderPlaylistVideos(ctx context.Context, playlistID int64, videoIDs []int64) error
+}
+
+// PlaylistUsecase 视频合集：用户创建具名合集，收录自己的视频或他人的公开视频并调整顺序。
+// 合集对所有人可见，其中的视频按访问者的查看权限过滤
+type PlaylistUsecase struct {
+	repo       PlaylistRepo
+	videoRepo  VideoRepo
+	relationUc *RelationUsecase
+
+	maxPlaylists int64
+	maxVideos    int64
+
+	log *log.Helper
+}
+
+// NewPlaylistUsecase new a Playlist usecase.
+func NewPlaylistUsecase(repo PlaylistRepo, videoRepo VideoRepo, relationUc *RelationUsecase, businessConfig *conf.Business, logger log.Logger) *PlaylistUsecase {
+	uc := &PlaylistUsecase{
+		repo:         repo,
+		videoRepo:    videoRepo,
+		relationUc:   relationUc,
+		maxPlaylists: defaultMaxPlaylistsPerUser,
+		maxVideos:    defaultMaxPlaylistVideos,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetPlaylist(); cfg != nil {
+		if cfg.MaxPlaylists > 0 {
+			uc.maxPlaylists = int64(cfg.MaxPlaylists)
+		}
+		if cfg.MaxVideos > 0 {
+			uc.maxVideos = int64(cfg.MaxVideos)
+		}
+	}
+
+	return uc
+}
+
+// CreatePlaylist 创建合集
+func (uc *PlaylistUsecase) CreatePlaylist(ctx context.Context, userID int64, name, description string) (*Playlist, error) {
+	name, description, err := validatePlaylistInfo(name, description)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := uc.repo.CountPlaylists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= uc.maxPlaylists {
+		return nil, ErrTooManyPlaylists
+	}
+
+	playlist := &Playlist{
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+	}
+	if err := uc.repo.CreatePlaylist(ctx, playlist); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("playlist %d created by user %d", playlist.ID, userID)
+	return playlist, nil
+}
+
+// UpdatePlaylist 修改合集名称和简介
+func (uc *PlaylistUsecase) UpdatePlaylist(ctx context.Context, userID, playlistID int64, name, description string) (*Playlist, error) {
+	name, description, err := validatePlaylistInfo(name, description)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := uc.getOwnPlaylist(ctx, userID, playlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist.Name = name
+	playlist.Description = description
+	if err := uc.repo.UpdatePlaylist(ctx, playlist); err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// DeletePlaylist 删除合集，合集中的视频本身不受影响
+func (uc *PlaylistUsecase) DeletePlaylist(ctx context.Context, userID, playlistID int64) error {
+	if _, err := uc.getOwnPlaylist(ctx, userID, playlistID); err != nil {
+		return err
+	}
+	if err := uc.repo.DeletePlaylist(ctx, userID, playlistID); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("playlist %d deleted by user %d", playlistID, userID)
+	return nil
+}
+
+// AddVideo 向合集追加视频。可以收录自己的任意视频，他人的视频须为已发布的公开视频，
+// 否则按视频不存在处理，不暴露非公开视频
+func (uc *PlaylistUsecase) AddVideo(ctx context.Context, userID, playlistID, videoID int64) error {
+	if _, err := uc.getOwnPlaylist(ctx, userID, playlistID); err != nil {
+		return err
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if video.AuthorID != userID && (video.Status != domain.VideoStatusPublished || !video.IsPublic()) {
+		return utils.ErrVideoNotFound
+	}
+
+	return uc.repo.AddPlaylistVideo(ctx, playlistID, videoID, uc.maxVideos)
+}
+
+// RemoveVideo 从合集移除视频，视频已删除时也可以移除
+func (uc *PlaylistUsecase) RemoveVideo(ctx context.Context, userID, playlistID, videoID int64) error {
+	if _, err := uc.getOwnPlaylist(ctx, userID, playlistID); err != nil {
+		return err
+	}
+	return uc.repo.RemovePlaylistVideo(ctx, playlistID, videoID)
+}
+
+// ReorderVideos 按给定顺序重排合集，videoIDs 须恰好包含合集中的全部视频
+func (uc *PlaylistUsecase) ReorderVideos(ctx context.Context, userID, playlistID int64, videoIDs []int64) error {
+	if _, err := uc.getOwnPlaylist(ctx, userID, playlistID); err != nil {
+		return err
+	}
+
+	current, err := uc.repo.ListAllPlaylistVideoIDs(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+	if len(current) != len(videoIDs) {
+		return ErrInvalidPlaylistOrder
+	}
+	remaining := make(map[int64]struct{}, len(current))
+	for _, id := range current {
+		remaining[id] = struct{}{}
+	}
+	for _, id := range videoIDs {
+		if _, ok := remaining[id]; !ok {
+			return ErrInvalidPlaylistOrder
+		}
+		delete(remaining, id)
+	}
+
+	return uc.repo.ReorderPlaylistVideos(ctx, playlistID, videoIDs)
+}
+
+// GetPlaylist 获取合集及一页视频。访问者无权查看的视频和已删除的视频被跳过，
+// 因此一页中的视频数可能少于 size，total 为合集收录的视频总数
+func (uc *PlaylistUsecase) GetPlaylist(ctx context.Context, viewerID, playlistID int64, page, size int32) (*Playlist, []*domain.Video, int64, error) {
+	page, size = normalizePlaylistPage(page, size)
+
+	playlist, err := uc.repo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	videoIDs, total, err := uc.repo.GetPlaylistVideoIDs(ctx, playlistID, page, size)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if len(videoIDs) == 0 {
+		return playlist, []*domain.Video{}, total, nil
+	}
+
+	videos, err := uc.videoRepo.GetVideos(ctx, videoIDs)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// 按合集顺序返回，他人未发布的视频不返回
+	videoMap := make(map[int64]*domain.Video, len(videos))
+	for _, video := range videos {
+		videoMap[video.ID] = video
+	}
+	ordered := make([]*domain.Video, 0, len(videos))
+	for _, id := range videoIDs {
+		video, ok := videoMap[id]
+		if !ok || (video.AuthorID != viewerID && video.Status != domain.VideoStatusPublished) {
+			continue
+		}
+		ordered = append(ordered, video)
+	}
+
+	visible, err := uc.relationUc.FilterVideosByVisibility(ctx, viewerID, ordered)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return playlist, visible, total, nil
+}
+
+// ListUserPlaylists 分页列出用户创建的合集
+func (uc *PlaylistUsecase) ListUserPlaylists(ctx context.Context, userID int64, page, size int32) ([]*Playlist, int64, error) {
+	page, size = normalizePlaylistPage(page, size)
+	return uc.repo.ListUserPlaylists(ctx, userID, page, size)
+}
+
+// getOwnPlaylist 获取用户自己的合集，他人的合集按不存在处理
+func (uc *PlaylistUsecase) getOwnPlaylist(ctx context.Context, userID, playlistID int64) (*Playlist, error) {
+	playlist, err := uc.repo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		return nil, err
+	}
+	if playlist.UserID != userID {
+		return nil, ErrPlaylistNotFound
+	}
+	return playlist, nil
+}
+
+func validatePlaylistInfo(name, description string) (string, string, error) {
+	name = strings.TrimSpace(name)
+	description = strings.TrimSpace(description)
+	if name == "" || utf8.RuneCountInString(name) > maxPlaylistNameLength {
+		return "", "", ErrInvalidPlaylistName
+	}
+	if utf8.RuneCountInString(description) > maxPlaylistDescriptionLength {
+		return "", "", ErrPlaylistDescriptionTooLong
+	}
+	return name, description, nil
+}
+
+func normalizePlaylistPage(page, size int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 20
+	}
+	return page, size
+}
@@ -0,0 +1,163 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type playlistTestDeps struct {
+	repo         *MockPlaylistRepo
+	videoRepo    *MockVideoRepo
+	relationRepo *MockRelationRepo
+	uc           *PlaylistUsecase
+}
+
+func newPlaylistTestDeps(t *testing.T) *playlistTestDeps {
+	d := &playlistTestDeps{
+		repo:         NewMockPlaylistRepo(t),
+		videoRepo:    NewMockVideoRepo(t),
+		relationRepo: NewMockRelationRepo(t),
+	}
+	relationUc := NewRelationUsecase(d.relationRepo, NewMockUserRepo(t), log.DefaultLogger)
+	config := &conf.Business{Playlist: &conf.Business_Playlist{MaxPlaylists: 2, MaxVideos: 3}}
+	d.uc = NewPlaylistUsecase(d.repo, d.videoRepo, relationUc, config, log.DefaultLogger)
+	return d
+}
+
+func TestPlaylistUsecase_CreatePlaylist(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		d.repo.EXPECT().CountPlaylists(ctx, int64(1)).Return(1, nil)
+		d.repo.EXPECT().CreatePlaylist(ctx, &Playlist{UserID: 1, Name: "旅行", Description: "路上拍的"}).Return(nil)
+
+		playlist, err := d.uc.CreatePlaylist(ctx, 1, "  旅行 ", "路上拍的")
+		require.NoError(t, err)
+		assert.Equal(t, "旅行", playlist.Name)
+	})
+
+	t.Run("InvalidInfo", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		_, err := d.uc.CreatePlaylist(ctx, 1, " ", "")
+		assert.Equal(t, ErrInvalidPlaylistName, err)
+
+		_, err = d.uc.CreatePlaylist(ctx, 1, strings.Repeat("合", 51), "")
+		assert.Equal(t, ErrInvalidPlaylistName, err)
+
+		_, err = d.uc.CreatePlaylist(ctx, 1, "旅行", strings.Repeat("简", 201))
+		assert.Equal(t, ErrPlaylistDescriptionTooLong, err)
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		d.repo.EXPECT().CountPlaylists(ctx, int64(1)).Return(2, nil)
+
+		_, err := d.uc.CreatePlaylist(ctx, 1, "旅行", "")
+		assert.Equal(t, ErrTooManyPlaylists, err)
+	})
+}
+
+func TestPlaylistUsecase_AddVideo(t *testing.T) {
+	ctx := context.Background()
+	playlist := &Playlist{ID: 7, UserID: 1, Name: "旅行"}
+
+	t.Run("OwnPrivateVideo", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		d.repo.EXPECT().GetPlaylist(ctx, int64(7)).Return(playlist, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPrivate}, nil)
+		d.repo.EXPECT().AddPlaylistVideo(ctx, int64(7), int64(10), int64(3)).Return(nil)
+
+		require.NoError(t, d.uc.AddVideo(ctx, 1, 7, 10))
+	})
+
+	t.Run("OthersPublicVideo", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		d.repo.EXPECT().GetPlaylist(ctx, int64(7)).Return(playlist, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(11)).Return(&domain.Video{ID: 11, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPublic}, nil)
+		d.repo.EXPECT().AddPlaylistVideo(ctx, int64(7), int64(11), int64(3)).Return(nil)
+
+		require.NoError(t, d.uc.AddVideo(ctx, 1, 7, 11))
+	})
+
+	t.Run("OthersNonPublicVideo", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		for _, video := range []*domain.Video{
+			{ID: 12, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityFriends},
+			{ID: 12, AuthorID: 2, Status: domain.VideoStatusAuditing, Visibility: domain.VideoVisibilityPublic},
+			{ID: 12, AuthorID: 2, Status: domain.VideoStatusDraft, Visibility: domain.VideoVisibilityPublic},
+		} {
+			d.repo.EXPECT().GetPlaylist(ctx, int64(7)).Return(playlist, nil).Once()
+			d.videoRepo.EXPECT().GetVideo(ctx, int64(12)).Return(video, nil).Once()
+
+			assert.Equal(t, utils.ErrVideoNotFound, d.uc.AddVideo(ctx, 1, 7, 12))
+		}
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		d.repo.EXPECT().GetPlaylist(ctx, int64(7)).Return(playlist, nil)
+
+		assert.Equal(t, ErrPlaylistNotFound, d.uc.AddVideo(ctx, 2, 7, 10))
+	})
+}
+
+func TestPlaylistUsecase_ReorderVideos(t *testing.T) {
+	ctx := context.Background()
+	playlist := &Playlist{ID: 7, UserID: 1}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		d.repo.EXPECT().GetPlaylist(ctx, int64(7)).Return(playlist, nil)
+		d.repo.EXPECT().ListAllPlaylistVideoIDs(ctx, int64(7)).Return([]int64{10, 11, 12}, nil)
+		d.repo.EXPECT().ReorderPlaylistVideos(ctx, int64(7), []int64{12, 10, 11}).Return(nil)
+
+		require.NoError(t, d.uc.ReorderVideos(ctx, 1, 7, []int64{12, 10, 11}))
+	})
+
+	t.Run("InvalidOrder", func(t *testing.T) {
+		d := newPlaylistTestDeps(t)
+		for _, order := range [][]int64{
+			{10, 11},
+			{10, 11, 13},
+			{10, 10, 11},
+		} {
+			d.repo.EXPECT().GetPlaylist(ctx, int64(7)).Return(playlist, nil).Once()
+			d.repo.EXPECT().ListAllPlaylistVideoIDs(ctx, int64(7)).Return([]int64{10, 11, 12}, nil).Once()
+
+			assert.Equal(t, ErrInvalidPlaylistOrder, d.uc.ReorderVideos(ctx, 1, 7, order))
+		}
+	})
+}
+
+func TestPlaylistUsecase_GetPlaylist(t *testing.T) {
+	ctx := context.Background()
+	playlist := &Playlist{ID: 7, UserID: 1, VideoCount: 5}
+
+	d := newPlaylistTestDeps(t)
+	d.repo.EXPECT().GetPlaylist(ctx, int64(7)).Return(playlist, nil)
+	d.repo.EXPECT().GetPlaylistVideoIDs(ctx, int64(7), int32(1), int32(20)).Return([]int64{14, 13, 12, 11, 10}, 5, nil)
+	// 13 已删除，仓储不返回
+	d.videoRepo.EXPECT().GetVideos(ctx, []int64{14, 13, 12, 11, 10}).Return([]*domain.Video{
+		{ID: 10, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPublic},
+		{ID: 11, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPrivate},
+		{ID: 12, AuthorID: 2, Status: domain.VideoStatusAuditing, Visibility: domain.VideoVisibilityPublic},
+		{ID: 14, AuthorID: 5, Status: domain.VideoStatusAuditing, Visibility: domain.VideoVisibilityPrivate},
+	}, nil)
+
+	got, videos, total, err := d.uc.GetPlaylist(ctx, 5, 7, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, playlist, got)
+	assert.Equal(t, int64(5), total)
+	// 按合集顺序返回，访问者自己的视频不受状态和可见范围限制
+	assert.Equal(t, []int64{14, 10}, videoIDs(videos))
+}
@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PresenceRepo 在线状态仓储，在线标记一段时间内没有心跳刷新时自动过期
+type PresenceRepo interface {
+	MarkOnline(ctx context.Context, userID int64) error
+	MarkOffline(ctx context.Context, userID int64) error
+	// OnlineUsers 返回userIDs中在线的用户
+	OnlineUsers(ctx context.Context, userIDs []int64) (map[int64]bool, error)
+}
+
+// PresenceUsecase 在线状态：客户端在前台时定期发送心跳刷新在线标记，登出时立即下线。
+// 在线状态只向互相关注的好友展示
+type PresenceUsecase struct {
+	repo       PresenceRepo
+	relationUc *RelationUsecase
+	log        *log.Helper
+}
+
+// NewPresenceUsecase new a Presence usecase.
+func NewPresenceUsecase(repo PresenceRepo, relationUc *RelationUsecase, logger log.Logger) *PresenceUsecase {
+	return &PresenceUsecase{
+		repo:       repo,
+		relationUc: relationUc,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// Heartbeat 刷新用户的在线标记
+func (uc *PresenceUsecase) Heartbeat(ctx context.Context, userID int64) error {
+	return uc.repo.MarkOnline(ctx, userID)
+}
+
+// Offline 用户登出时清除在线标记
+func (uc *PresenceUsecase) Offline(ctx context.Context, userID int64) error {
+	return uc.repo.MarkOffline(ctx, userID)
+}
+
+// OnlineStatus 批量查询用户是否在线，不在结果中的用户按离线处理
+func (uc *PresenceUsecase) OnlineStatus(ctx context.Context, userIDs []int64) (map[int64]bool, error) {
+	if len(userIDs) == 0 {
+		return map[int64]bool{}, nil
+	}
+	return uc.repo.OnlineUsers(ctx, userIDs)
+}
+
+// GetOnlineFriends 获取当前在线的好友
+func (uc *PresenceUsecase) GetOnlineFriends(ctx context.Context, userID int64) ([]*User, error) {
+	friends, err := uc.relationUc.GetFriendList(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	friendIDs := make([]int64, 0, len(friends))
+	for _, friend := range friends {
+		friendIDs = append(friendIDs, friend.ID)
+	}
+	online, err := uc.OnlineStatus(ctx, friendIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*User, 0, len(online))
+	for _, friend := range friends {
+		if online[friend.ID] {
+			result = append(result, friend)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresenceUsecase_GetOnlineFriends(t *testing.T) {
+	ctx := context.Background()
+	friends := []*User{{ID: 2}, {ID: 3}, {ID: 4}}
+
+	t.Run("Success", func(t *testing.T) {
+		repo := NewMockPresenceRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewPresenceUsecase(repo, NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger), log.DefaultLogger)
+
+		relationRepo.EXPECT().GetFriendList(ctx, int64(1)).Return(friends, nil)
+		repo.EXPECT().OnlineUsers(ctx, []int64{2, 3, 4}).Return(map[int64]bool{2: true, 4: true}, nil)
+
+		online, err := uc.GetOnlineFriends(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []*User{{ID: 2}, {ID: 4}}, online)
+	})
+
+	t.Run("NoFriends", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewPresenceUsecase(NewMockPresenceRepo(t), NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger), log.DefaultLogger)
+
+		relationRepo.EXPECT().GetFriendList(ctx, int64(1)).Return([]*User{}, nil)
+
+		online, err := uc.GetOnlineFriends(ctx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, online)
+	})
+
+	t.Run("PresenceError", func(t *testing.T) {
+		repo := NewMockPresenceRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewPresenceUsecase(repo, NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger), log.DefaultLogger)
+
+		relationRepo.EXPECT().GetFriendList(ctx, int64(1)).Return(friends, nil)
+		repo.EXPECT().OnlineUsers(ctx, []int64{2, 3, 4}).Return(nil, errors.New("redis down"))
+
+		_, err := uc.GetOnlineFriends(ctx, 1)
+		assert.Error(t, err)
+	})
+}
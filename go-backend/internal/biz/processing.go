@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultProcessingReportRange = 7 * 24 * time.Hour
+	maxProcessingReportRange     = 92 * 24 * time.Hour
+	// processingWriteTimeout 写入处理记录的超时，处理流程的上下文可能已经结束
+	processingWriteTimeout = 2 * time.Second
+)
+
+// ProcessingReportFilter 处理报表查询条件，零值字段不过滤
+type ProcessingReportFilter struct {
+	AuthorID  int64
+	JobType   string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// ProcessingReport 处理报表，明细按天倒序、同一天内按CPU时间倒序
+type ProcessingReport struct {
+	Stats []*domain.ProcessingStat
+	Total *domain.ProcessingStat
+}
+
+// ProcessingJobRepo is a ProcessingJob repo.
+type ProcessingJobRepo interface {
+	CreateJob(context.Context, *domain.ProcessingJob) error
+	// AggregateJobs 按天和创作者聚合处理记录
+	AggregateJobs(context.Context, *ProcessingReportFilter) ([]*domain.ProcessingStat, error)
+}
+
+// ProcessingUsecase 记录视频处理流水线每个任务的耗时、CPU时间和输出大小，
+// 按天和创作者汇总后提供给运维做容量规划和分级限额
+type ProcessingUsecase struct {
+	repo         ProcessingJobRepo
+	permissionUc *PermissionUsecase
+	log          *log.Helper
+}
+
+// NewProcessingUsecase new a Processing usecase.
+func NewProcessingUsecase(repo ProcessingJobRepo, permissionUc *PermissionUsecase, logger log.Logger) *ProcessingUsecase {
+	return &ProcessingUsecase{
+		repo:         repo,
+		permissionUc: permissionUc,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// RecordJob 记录一次处理任务，写入失败只打日志，不影响处理流程
+func (uc *ProcessingUsecase) RecordJob(ctx context.Context, job *domain.ProcessingJob) {
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), processingWriteTimeout)
+	defer cancel()
+
+	if err := uc.repo.CreateJob(writeCtx, job); err != nil {
+		uc.log.WithContext(ctx).Warnf("record processing job failed: video=%d type=%s err=%v", job.VideoID, job.JobType, err)
+	}
+}
+
+// GetReport 查询处理报表，仅管理员可用。默认统计最近7天，时间跨度最多92天
+func (uc *ProcessingUsecase) GetReport(ctx context.Context, adminID int64, filter *ProcessingReportFilter) (*ProcessingReport, error) {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, ErrPermissionDenied
+	}
+
+	if filter.EndTime.IsZero() {
+		filter.EndTime = time.Now()
+	}
+	if filter.StartTime.IsZero() {
+		filter.StartTime = filter.EndTime.Add(-defaultProcessingReportRange)
+	}
+	if filter.EndTime.Sub(filter.StartTime) > maxProcessingReportRange {
+		filter.StartTime = filter.EndTime.Add(-maxProcessingReportRange)
+	}
+
+	stats, err := uc.repo.AggregateJobs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &domain.ProcessingStat{AuthorID: filter.AuthorID}
+	for _, stat := range stats {
+		total.Jobs += stat.Jobs
+		total.FailedJobs += stat.FailedJobs
+		total.DurationMs += stat.DurationMs
+		total.CPUSeconds += stat.CPUSeconds
+		total.InputBytes += stat.InputBytes
+		total.OutputBytes += stat.OutputBytes
+	}
+
+	return &ProcessingReport{Stats: stats, Total: total}, nil
+}
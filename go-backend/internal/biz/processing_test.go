@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type processingTestDeps struct {
+	repo     *MockProcessingJobRepo
+	roleRepo *MockRoleRepo
+	uc       *ProcessingUsecase
+}
+
+func newProcessingTestDeps(t *testing.T) *processingTestDeps {
+	repo := NewMockProcessingJobRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+
+	return &processingTestDeps{
+		repo:     repo,
+		roleRepo: roleRepo,
+		uc:       NewProcessingUsecase(repo, permissionUc, log.DefaultLogger),
+	}
+}
+
+func TestProcessingUsecase_RecordJob(t *testing.T) {
+	job := &domain.ProcessingJob{VideoID: 1, AuthorID: 2, JobType: domain.ProcessTypeTranscode, Status: domain.ProcessStatusSuccess}
+
+	t.Run("Success", func(t *testing.T) {
+		d := newProcessingTestDeps(t)
+		d.repo.EXPECT().CreateJob(mock.Anything, job).Return(nil).Once()
+
+		d.uc.RecordJob(context.Background(), job)
+	})
+
+	t.Run("CanceledContext", func(t *testing.T) {
+		d := newProcessingTestDeps(t)
+		d.repo.EXPECT().CreateJob(mock.Anything, job).RunAndReturn(func(ctx context.Context, _ *domain.ProcessingJob) error {
+			return ctx.Err()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		d.uc.RecordJob(ctx, job)
+	})
+
+	t.Run("WriteFailureIgnored", func(t *testing.T) {
+		d := newProcessingTestDeps(t)
+		d.repo.EXPECT().CreateJob(mock.Anything, job).Return(errors.New("db down"))
+
+		d.uc.RecordJob(context.Background(), job)
+	})
+}
+
+func TestProcessingUsecase_GetReport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Admin", func(t *testing.T) {
+		d := newProcessingTestDeps(t)
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, int64(1), int64(1)).Return(true, nil)
+
+		var got *ProcessingReportFilter
+		d.repo.EXPECT().AggregateJobs(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, filter *ProcessingReportFilter) ([]*domain.ProcessingStat, error) {
+				got = filter
+				return []*domain.ProcessingStat{
+					{Day: "2026-10-02", AuthorID: 7, Jobs: 3, FailedJobs: 1, DurationMs: 3000, CPUSeconds: 4.5, InputBytes: 300, OutputBytes: 200},
+					{Day: "2026-10-01", AuthorID: 7, Jobs: 2, DurationMs: 1000, CPUSeconds: 1.5, InputBytes: 100, OutputBytes: 50},
+				}, nil
+			})
+
+		report, err := d.uc.GetReport(ctx, 1, &ProcessingReportFilter{AuthorID: 7})
+
+		require.NoError(t, err)
+		assert.Len(t, report.Stats, 2)
+		assert.Equal(t, int64(5), report.Total.Jobs)
+		assert.Equal(t, int64(1), report.Total.FailedJobs)
+		assert.Equal(t, int64(4000), report.Total.DurationMs)
+		assert.InDelta(t, 6.0, report.Total.CPUSeconds, 1e-9)
+		assert.Equal(t, int64(250), report.Total.OutputBytes)
+		// 默认统计最近7天
+		assert.Equal(t, defaultProcessingReportRange, got.EndTime.Sub(got.StartTime))
+	})
+
+	t.Run("RangeClamped", func(t *testing.T) {
+		d := newProcessingTestDeps(t)
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, int64(1), int64(1)).Return(true, nil)
+		d.repo.EXPECT().AggregateJobs(ctx, mock.Anything).Return(nil, nil)
+
+		end := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		filter := &ProcessingReportFilter{StartTime: end.AddDate(-1, 0, 0), EndTime: end}
+
+		_, err := d.uc.GetReport(ctx, 1, filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, end.Add(-maxProcessingReportRange), filter.StartTime)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newProcessingTestDeps(t)
+		d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+		d.roleRepo.EXPECT().HasRole(ctx, int64(7), int64(1)).Return(false, nil)
+
+		_, err := d.uc.GetReport(ctx, 7, &ProcessingReportFilter{})
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ProfilePage 个人主页读模型，汇总用户资料、计数、置顶作品和最近作品。
+// 项目暂不支持手动置顶，置顶位展示获赞最多的作品
+type ProfilePage struct {
+	User         *User
+	PinnedVideos []*domain.Video
+	RecentVideos []*domain.Video
+	ProjectedAt  time.Time
+}
+
+// ProfileView 按访问者视角补充关注和点赞状态的主页
+type ProfileView struct {
+	*ProfilePage
+	IsFollow  bool
+	Favorited map[int64]bool
+	// Restricted 私密账号对非粉丝隐藏作品和计数
+	Restricted bool
+}
+
+// ProfileReadModelRepo 个人主页读模型仓储，读模型由用户、视频和关注关系事件投影维护
+type ProfileReadModelRepo interface {
+	// GetProfilePage 读取主页读模型，尚未投影的用户从数据源重建
+	GetProfilePage(ctx context.Context, userID int64) (*ProfilePage, error)
+}
+
+// ProfileUsecase 个人主页用例
+type ProfileUsecase struct {
+	repo         ProfileReadModelRepo
+	relationRepo RelationRepo
+	favoriteRepo FavoriteRepo
+	log          *log.Helper
+}
+
+// NewProfileUsecase 创建个人主页用例
+func NewProfileUsecase(repo ProfileReadModelRepo, relationRepo RelationRepo, favoriteRepo FavoriteRepo, logger log.Logger) *ProfileUsecase {
+	return &ProfileUsecase{
+		repo:         repo,
+		relationRepo: relationRepo,
+		favoriteRepo: favoriteRepo,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// GetProfilePage 获取个人主页，viewerID 为0表示未登录访问
+func (uc *ProfileUsecase) GetProfilePage(ctx context.Context, viewerID, userID int64) (*ProfileView, error) {
+	page, err := uc.repo.GetProfilePage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &ProfileView{ProfilePage: page, Favorited: map[int64]bool{}}
+
+	// 访问者相关的状态不进入读模型，查询失败时按未关注、未点赞展示
+	if viewerID != 0 && viewerID != userID {
+		if view.IsFollow, err = uc.relationRepo.IsFollowing(ctx, viewerID, userID); err != nil {
+			uc.log.WithContext(ctx).Warnf("check follow for profile failed: viewer=%d user=%d err=%v", viewerID, userID, err)
+		}
+	}
+
+	// 读模型按用户共享，隐藏内容时复制一份而不是修改读模型
+	if !CanViewContent(page.User, viewerID, view.IsFollow) {
+		view.Restricted = true
+		view.ProfilePage = &ProfilePage{User: RestrictedUser(page.User), ProjectedAt: page.ProjectedAt}
+		return view, nil
+	}
+	if viewerID == 0 {
+		return view, nil
+	}
+
+	videoIDs := make([]int64, 0, len(page.PinnedVideos)+len(page.RecentVideos))
+	for _, videos := range [][]*domain.Video{page.PinnedVideos, page.RecentVideos} {
+		for _, v := range videos {
+			videoIDs = append(videoIDs, v.ID)
+		}
+	}
+	if len(videoIDs) > 0 {
+		favorited, err := uc.favoriteRepo.BatchIsFavorite(ctx, viewerID, videoIDs)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("check favorite for profile failed: viewer=%d user=%d err=%v", viewerID, userID, err)
+		} else {
+			view.Favorited = favorited
+		}
+	}
+
+	return view, nil
+}
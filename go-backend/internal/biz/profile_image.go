@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/pkg/media"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrImageFormat = errors.BadRequest(v1.ErrorCode_IMAGE_FORMAT_ERR.String(), "unsupported image format")
+	ErrImageSize   = errors.BadRequest(v1.ErrorCode_IMAGE_SIZE_ERR.String(), "image too large")
+)
+
+const (
+	// avatarSize 头像裁剪后的边长
+	avatarSize = 512
+	// backgroundMaxWidth、backgroundMaxHeight 背景图缩放后的尺寸上限
+	backgroundMaxWidth  = 1920
+	backgroundMaxHeight = 1080
+)
+
+// ProfileImageKind 个人资料图片类型
+type ProfileImageKind string
+
+const (
+	ProfileImageAvatar     ProfileImageKind = "avatar"
+	ProfileImageBackground ProfileImageKind = "background"
+)
+
+// options 图片类型对应的处理参数
+func (k ProfileImageKind) options() media.ImageOptions {
+	if k == ProfileImageAvatar {
+		return media.ImageOptions{Width: avatarSize, Height: avatarSize, Crop: true}
+	}
+	return media.ImageOptions{Width: backgroundMaxWidth, Height: backgroundMaxHeight}
+}
+
+// ProfileImageUsecase 处理用户上传的头像和背景图：校验并重新编码后存入对象存储，
+// 再更新用户资料。替换后的旧图片如果在本站存储中则一并删除
+type ProfileImageUsecase struct {
+	userUc    *UserUsecase
+	storage   storage.VideoStorage
+	processor *media.ImageProcessor
+	log       *log.Helper
+}
+
+// NewProfileImageUsecase 创建个人资料图片用例
+func NewProfileImageUsecase(userUc *UserUsecase, storage storage.VideoStorage, logger log.Logger) *ProfileImageUsecase {
+	return &ProfileImageUsecase{
+		userUc:    userUc,
+		storage:   storage,
+		processor: media.NewImageProcessor(0, 0),
+		log:       log.NewHelper(logger),
+	}
+}
+
+// UploadAvatar 上传头像
+func (uc *ProfileImageUsecase) UploadAvatar(ctx context.Context, userID int64, reader io.Reader) (*User, error) {
+	return uc.upload(ctx, userID, ProfileImageAvatar, reader)
+}
+
+// UploadBackgroundImage 上传主页背景图
+func (uc *ProfileImageUsecase) UploadBackgroundImage(ctx context.Context, userID int64, reader io.Reader) (*User, error) {
+	return uc.upload(ctx, userID, ProfileImageBackground, reader)
+}
+
+func (uc *ProfileImageUsecase) upload(ctx context.Context, userID int64, kind ProfileImageKind, reader io.Reader) (*User, error) {
+	img, err := uc.processor.Process(reader, kind.options())
+	if err != nil {
+		switch {
+		case errors.Is(err, media.ErrImageTooLarge):
+			return nil, ErrImageSize
+		case errors.Is(err, media.ErrUnsupportedImage):
+			return nil, ErrImageFormat
+		default:
+			return nil, err
+		}
+	}
+
+	// 先读取旧地址，更新成功后再清理
+	previous, err := uc.userUc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	oldURL := previous.Avatar
+	if kind == ProfileImageBackground {
+		oldURL = previous.BackgroundImage
+	}
+
+	// 按内容寻址，重复上传同一张图片得到相同的对象键
+	sum := sha256.Sum256(img.Data)
+	objectName := fmt.Sprintf("profiles/%d/%s-%s%s", userID, kind, hex.EncodeToString(sum[:8]), img.Ext)
+	if _, err := uc.storage.Upload(ctx, objectName, bytes.NewReader(img.Data), img.Size(), &storage.UploadOptions{
+		ContentType: img.ContentType,
+	}); err != nil {
+		return nil, fmt.Errorf("upload %s image failed: %w", kind, err)
+	}
+
+	url, err := uc.storage.GenerateCoverURL(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *User
+	if kind == ProfileImageAvatar {
+		user, err = uc.userUc.UpdateProfile(ctx, userID, "", url, "", "")
+	} else {
+		user, err = uc.userUc.UpdateProfile(ctx, userID, "", "", url, "")
+	}
+	// 重新上传了当前图片，对象仍被引用，无论成败都不能删除
+	if oldURL == url {
+		return user, err
+	}
+	if err != nil {
+		uc.deleteObject(ctx, objectName)
+		return nil, err
+	}
+
+	uc.deleteOwnedImage(ctx, userID, oldURL)
+	return user, nil
+}
+
+// deleteOwnedImage 删除用户此前上传到本站存储的图片，外部地址和其他用户的对象不处理
+func (uc *ProfileImageUsecase) deleteOwnedImage(ctx context.Context, userID int64, url string) {
+	resolver, ok := uc.storage.(storage.ObjectResolver)
+	if !ok || url == "" {
+		return
+	}
+	objectName, ok := resolver.ObjectName(url)
+	if !ok || !strings.HasPrefix(objectName, fmt.Sprintf("profiles/%d/", userID)) {
+		return
+	}
+	uc.deleteObject(ctx, objectName)
+}
+
+func (uc *ProfileImageUsecase) deleteObject(ctx context.Context, objectName string) {
+	if err := uc.storage.Delete(ctx, objectName); err != nil {
+		uc.log.WithContext(ctx).Warnf("delete profile image failed: object=%s err=%v", objectName, err)
+	}
+}
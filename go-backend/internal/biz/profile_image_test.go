@@ -0,0 +1,92 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/disintegration/imaging"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeProfileTestImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, imaging.New(width, height, color.NRGBA{R: 200, A: 255})))
+	return buf.Bytes()
+}
+
+func TestProfileImageUsecase_Upload(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*MockUserRepo, *memoryStorage, *ProfileImageUsecase) {
+		userRepo := NewMockUserRepo(t)
+		store := newMemoryStorage()
+		return userRepo, store, NewProfileImageUsecase(NewUserUsecase(userRepo, log.DefaultLogger), store, log.DefaultLogger)
+	}
+
+	t.Run("AvatarReplacesOwnedImage", func(t *testing.T) {
+		userRepo, store, uc := setup(t)
+		oldObject := "profiles/1/avatar-1.jpg"
+		store.objects[oldObject] = []byte("old")
+		oldURL := "https://cdn.example.com/" + oldObject
+
+		userRepo.EXPECT().GetUser(mock.Anything, int64(1)).Return(&User{ID: 1, Avatar: oldURL}, nil).Once()
+		userRepo.EXPECT().GetUser(mock.Anything, int64(1)).Return(&User{ID: 1, Avatar: oldURL}, nil).Once()
+		userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(nil)
+
+		user, err := uc.UploadAvatar(ctx, 1, bytes.NewReader(encodeProfileTestImage(t, 800, 600)))
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(user.Avatar, "https://cdn.example.com/profiles/1/avatar-"))
+
+		assert.NotContains(t, store.objects, oldObject)
+		data := store.objects[strings.TrimPrefix(user.Avatar, "https://cdn.example.com/")]
+		img, format, err := image.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, image.Rect(0, 0, avatarSize, avatarSize), img.Bounds())
+	})
+
+	t.Run("BackgroundKeepsExternalImage", func(t *testing.T) {
+		userRepo, store, uc := setup(t)
+		external := "https://example.org/bg.jpg"
+		userRepo.EXPECT().GetUser(mock.Anything, int64(2)).Return(&User{ID: 2, BackgroundImage: external}, nil).Times(2)
+		userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(nil)
+
+		user, err := uc.UploadBackgroundImage(ctx, 2, bytes.NewReader(encodeProfileTestImage(t, 3840, 1080)))
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(user.BackgroundImage, "https://cdn.example.com/profiles/2/background-"))
+		assert.Len(t, store.objects, 1)
+
+		img, _, err := image.Decode(bytes.NewReader(store.objects[strings.TrimPrefix(user.BackgroundImage, "https://cdn.example.com/")]))
+		require.NoError(t, err)
+		assert.Equal(t, backgroundMaxWidth, img.Bounds().Dx())
+		assert.Equal(t, 540, img.Bounds().Dy())
+	})
+
+	t.Run("InvalidImage", func(t *testing.T) {
+		_, store, uc := setup(t)
+
+		_, err := uc.UploadAvatar(ctx, 1, strings.NewReader("not an image"))
+		assert.ErrorIs(t, err, ErrImageFormat)
+		assert.Empty(t, store.objects)
+	})
+
+	t.Run("UpdateFailedRemovesUpload", func(t *testing.T) {
+		userRepo, store, uc := setup(t)
+		userRepo.EXPECT().GetUser(mock.Anything, int64(1)).Return(&User{ID: 1}, nil).Times(2)
+		userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(errors.New("db down"))
+
+		_, err := uc.UploadAvatar(ctx, 1, bytes.NewReader(encodeProfileTestImage(t, 64, 64)))
+		assert.Error(t, err)
+		assert.Empty(t, store.objects)
+	})
+}
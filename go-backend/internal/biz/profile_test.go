@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileUsecase_GetProfilePage(t *testing.T) {
+	ctx := context.Background()
+	page := &ProfilePage{
+		User:         &User{ID: 1, Username: "alice"},
+		PinnedVideos: []*domain.Video{{ID: 11, AuthorID: 1}},
+		RecentVideos: []*domain.Video{{ID: 12, AuthorID: 1}, {ID: 11, AuthorID: 1}},
+	}
+
+	setup := func(t *testing.T) (*MockProfileReadModelRepo, *MockRelationRepo, *MockFavoriteRepo, *ProfileUsecase) {
+		repo := NewMockProfileReadModelRepo(t)
+		relationRepo := NewMockRelationRepo(t)
+		favoriteRepo := NewMockFavoriteRepo(t)
+		return repo, relationRepo, favoriteRepo, NewProfileUsecase(repo, relationRepo, favoriteRepo, log.DefaultLogger)
+	}
+
+	t.Run("Anonymous", func(t *testing.T) {
+		repo, _, _, uc := setup(t)
+		repo.EXPECT().GetProfilePage(ctx, int64(1)).Return(page, nil)
+
+		view, err := uc.GetProfilePage(ctx, 0, 1)
+		require.NoError(t, err)
+		assert.Same(t, page, view.ProfilePage)
+		assert.False(t, view.IsFollow)
+		assert.Empty(t, view.Favorited)
+	})
+
+	t.Run("Viewer", func(t *testing.T) {
+		repo, relationRepo, favoriteRepo, uc := setup(t)
+		repo.EXPECT().GetProfilePage(ctx, int64(1)).Return(page, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(2), int64(1)).Return(true, nil)
+		favoriteRepo.EXPECT().BatchIsFavorite(ctx, int64(2), []int64{11, 12, 11}).Return(map[int64]bool{12: true}, nil)
+
+		view, err := uc.GetProfilePage(ctx, 2, 1)
+		require.NoError(t, err)
+		assert.True(t, view.IsFollow)
+		assert.True(t, view.Favorited[12])
+		assert.False(t, view.Favorited[11])
+	})
+
+	t.Run("OwnProfile", func(t *testing.T) {
+		repo, _, favoriteRepo, uc := setup(t)
+		repo.EXPECT().GetProfilePage(ctx, int64(1)).Return(page, nil)
+		favoriteRepo.EXPECT().BatchIsFavorite(ctx, int64(1), []int64{11, 12, 11}).Return(map[int64]bool{}, nil)
+
+		view, err := uc.GetProfilePage(ctx, 1, 1)
+		require.NoError(t, err)
+		assert.False(t, view.IsFollow)
+	})
+
+	t.Run("ViewerStateFailureDegrades", func(t *testing.T) {
+		repo, relationRepo, favoriteRepo, uc := setup(t)
+		repo.EXPECT().GetProfilePage(ctx, int64(1)).Return(page, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(2), int64(1)).Return(false, errors.New("redis down"))
+		favoriteRepo.EXPECT().BatchIsFavorite(ctx, int64(2), []int64{11, 12, 11}).Return(nil, errors.New("redis down"))
+
+		view, err := uc.GetProfilePage(ctx, 2, 1)
+		require.NoError(t, err)
+		assert.False(t, view.IsFollow)
+		assert.NotNil(t, view.Favorited)
+	})
+
+	t.Run("PrivateAccount", func(t *testing.T) {
+		private := &ProfilePage{
+			User:         &User{ID: 1, IsPrivate: true, FollowerCount: 5, WorkCount: 2},
+			RecentVideos: page.RecentVideos,
+		}
+
+		repo, relationRepo, _, uc := setup(t)
+		repo.EXPECT().GetProfilePage(ctx, int64(1)).Return(private, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(2), int64(1)).Return(false, nil)
+
+		view, err := uc.GetProfilePage(ctx, 2, 1)
+		require.NoError(t, err)
+		assert.True(t, view.Restricted)
+		assert.Empty(t, view.RecentVideos)
+		assert.Zero(t, view.User.FollowerCount)
+		assert.Zero(t, view.User.WorkCount)
+		assert.Equal(t, 5, private.User.FollowerCount, "read model must not be modified")
+
+		// 粉丝正常查看
+		repo, relationRepo, favoriteRepo, uc := setup(t)
+		repo.EXPECT().GetProfilePage(ctx, int64(1)).Return(private, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(3), int64(1)).Return(true, nil)
+		favoriteRepo.EXPECT().BatchIsFavorite(ctx, int64(3), []int64{12, 11}).Return(map[int64]bool{}, nil)
+
+		view, err = uc.GetProfilePage(ctx, 3, 1)
+		require.NoError(t, err)
+		assert.False(t, view.Restricted)
+		assert.Len(t, view.RecentVideos, 2)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo, _, _, uc := setup(t)
+		repo.EXPECT().GetProfilePage(ctx, int64(9)).Return(nil, ErrUserNotFound)
+
+		_, err := uc.GetProfilePage(ctx, 2, 9)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
@@ -0,0 +1,533 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrPromotionNotFound         = errors.NotFound(v1.ErrorCode_PROMOTION_NOT_EXIST.String(), "promotion not found")
+	ErrInvalidPromotionName      = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "promotion name must be 1-100 characters")
+	ErrInvalidPromotionSchedule  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "promotion must end after it starts")
+	ErrInvalidPromotionAudience  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "promotion audience must be all, users or guests")
+	ErrInvalidPromotionStatus    = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "promotion status must be 1 or 2")
+	ErrInvalidPromotionFrequency = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "frequency cap and window must be both positive or both zero")
+	ErrPromotedVideoNotPublished = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "promoted video must be published")
+)
+
+// 推广计划状态
+const (
+	PromotionStatusActive int32 = 1 // 投放中，仍受投放时段限制
+	PromotionStatusPaused int32 = 2 // 已暂停
+)
+
+// 推广定向人群
+const (
+	PromotionAudienceAll    = "all"
+	PromotionAudienceUsers  = "users"  // 登录用户
+	PromotionAudienceGuests = "guests" // 未登录用户
+)
+
+// 推广事件类型
+const (
+	PromotionEventImpression int32 = 1
+	PromotionEventClick      int32 = 2
+)
+
+const (
+	maxPromotionNameLength = 100
+	// promotionCacheTTL 投放中推广计划的本地缓存时长，修改推广后其他实例最多延迟该时长生效
+	promotionCacheTTL = 30 * time.Second
+)
+
+// defaultPromotionSlots 推广视频在每页视频流中的默认位置
+var defaultPromotionSlots = []int{4, 12}
+
+// PromotionCampaign 推广计划
+type PromotionCampaign struct {
+	ID      int64
+	Name    string
+	VideoID int64
+	// CategoryIDs 投放的分类视频流，为空时只投放到不筛选分类的视频流
+	CategoryIDs []int64
+	Audience    string
+	Priority    int32
+	// FrequencyCap 每个登录用户在 FrequencyWindow 内最多曝光的次数，0不限制；未登录用户无法识别，不做频控
+	FrequencyCap    int32
+	FrequencyWindow time.Duration
+	StartAt         time.Time
+	EndAt           time.Time
+	Status          int32
+	CreatedBy       int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Running 推广是否处于投放中
+func (c *PromotionCampaign) Running(now time.Time) bool {
+	return c.Status == PromotionStatusActive && !now.Before(c.StartAt) && now.Before(c.EndAt)
+}
+
+// Targets 推广是否定向到该用户请求的视频流，userID为0表示未登录
+func (c *PromotionCampaign) Targets(userID, categoryID int64) bool {
+	switch c.Audience {
+	case PromotionAudienceUsers:
+		if userID == 0 {
+			return false
+		}
+	case PromotionAudienceGuests:
+		if userID != 0 {
+			return false
+		}
+	}
+
+	if categoryID == 0 {
+		return len(c.CategoryIDs) == 0
+	}
+	for _, id := range c.CategoryIDs {
+		if id == categoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// PromotionEvent 推广曝光或点击记录
+type PromotionEvent struct {
+	CampaignID int64
+	VideoID    int64
+	UserID     int64 // 未登录时为0
+	Type       int32
+	CreatedAt  time.Time
+}
+
+// PromotionReport 推广计费报表
+type PromotionReport struct {
+	Impressions int64
+	Clicks      int64
+	UniqueUsers int64 // 曝光的去重登录用户数
+}
+
+// PromotionRepo 推广仓储接口
+type PromotionRepo interface {
+	// ListCampaigns 查询所有推广计划，按ID倒序
+	ListCampaigns(ctx context.Context) ([]*PromotionCampaign, error)
+	// ListRunningCampaigns 查询 now 时处于投放中的推广计划
+	ListRunningCampaigns(ctx context.Context, now time.Time) ([]*PromotionCampaign, error)
+	// GetCampaign 获取推广计划，不存在时返回ErrPromotionNotFound
+	GetCampaign(ctx context.Context, id int64) (*PromotionCampaign, error)
+	CreateCampaign(ctx context.Context, campaign *PromotionCampaign) error
+	// UpdateCampaign 修改推广计划，不存在时返回ErrPromotionNotFound
+	UpdateCampaign(ctx context.Context, campaign *PromotionCampaign) error
+	// GetFrequencies 查询登录用户在各推广当前频控窗口内的曝光次数，键为推广ID
+	GetFrequencies(ctx context.Context, userID int64, campaignIDs []int64) (map[int64]int64, error)
+	// IncrFrequencies 累加登录用户的曝光次数，窗口从首次曝光开始计算
+	IncrFrequencies(ctx context.Context, userID int64, campaigns []*PromotionCampaign) error
+	// CreateEvents 写入曝光或点击记录
+	CreateEvents(ctx context.Context, events []*PromotionEvent) error
+	// GetReport 统计推广在 [since, until) 内的曝光和点击
+	GetReport(ctx context.Context, campaignID int64, since, until time.Time) (*PromotionReport, error)
+}
+
+// PromotionUsecase 视频流推广用例：管理员配置推广计划，获取视频流时按优先级、定向和频控
+// 把推广视频插入配置的推广位，并记录曝光和点击用于计费
+type PromotionUsecase struct {
+	repo         PromotionRepo
+	videoRepo    VideoRepo
+	permissionUc *PermissionUsecase
+	degradation  *DegradationUsecase
+	slots        []int
+	enabled      bool
+	clock        clock.Clock
+
+	mu        sync.Mutex
+	running   []*PromotionCampaign
+	expiresAt time.Time
+
+	log *log.Helper
+}
+
+// NewPromotionUsecase 创建视频流推广用例
+func NewPromotionUsecase(repo PromotionRepo, videoRepo VideoRepo, permissionUc *PermissionUsecase, degradation *DegradationUsecase, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *PromotionUsecase {
+	uc := &PromotionUsecase{
+		repo:         repo,
+		videoRepo:    videoRepo,
+		permissionUc: permissionUc,
+		degradation:  degradation,
+		slots:        defaultPromotionSlots,
+		clock:        clk,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetPromotion(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		if len(cfg.Slots) > 0 {
+			uc.slots = normalizePromotionSlots(cfg.Slots)
+		}
+	}
+	return uc
+}
+
+// ListCampaigns 管理员查询所有推广计划
+func (uc *PromotionUsecase) ListCampaigns(ctx context.Context, adminID int64) ([]*PromotionCampaign, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListCampaigns(ctx)
+}
+
+// CreateCampaign 创建推广计划，新计划默认投放中
+func (uc *PromotionUsecase) CreateCampaign(ctx context.Context, adminID int64, campaign *PromotionCampaign) (*PromotionCampaign, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	campaign.Status = PromotionStatusActive
+	if err := validatePromotionCampaign(campaign); err != nil {
+		return nil, err
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, campaign.VideoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.Status != domain.VideoStatusPublished || !video.IsPublic() {
+		return nil, ErrPromotedVideoNotPublished
+	}
+
+	campaign.CreatedBy = adminID
+	if err := uc.repo.CreateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+	uc.invalidate()
+
+	uc.log.WithContext(ctx).Infof("admin %d created promotion %d for video %d", adminID, campaign.ID, campaign.VideoID)
+	return campaign, nil
+}
+
+// UpdateCampaign 修改推广计划，推广视频和创建人保持不变
+func (uc *PromotionUsecase) UpdateCampaign(ctx context.Context, adminID int64, campaign *PromotionCampaign) (*PromotionCampaign, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	if err := validatePromotionCampaign(campaign); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.repo.GetCampaign(ctx, campaign.ID)
+	if err != nil {
+		return nil, err
+	}
+	campaign.VideoID = existing.VideoID
+	campaign.CreatedBy = existing.CreatedBy
+	campaign.CreatedAt = existing.CreatedAt
+
+	if err := uc.repo.UpdateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+	uc.invalidate()
+
+	uc.log.WithContext(ctx).Infof("admin %d updated promotion %d (status=%d)", adminID, campaign.ID, campaign.Status)
+	return campaign, nil
+}
+
+// GetReport 统计推广的曝光和点击，since 为零值时从投放开始统计，until 为零值时统计到当前时间
+func (uc *PromotionUsecase) GetReport(ctx context.Context, adminID, campaignID int64, since, until time.Time) (*PromotionReport, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	campaign, err := uc.repo.GetCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if since.IsZero() {
+		since = campaign.StartAt
+	}
+	if until.IsZero() {
+		until = uc.clock.Now()
+	}
+	return uc.repo.GetReport(ctx, campaignID, since, until)
+}
+
+// Inject 把推广视频插入一页视频流，返回插入后的视频和推广视频对应的推广ID。
+// 推广视频不再以普通视频重复出现；查询推广失败或推广被降级时返回原视频流，推广不影响视频流可用性
+func (uc *PromotionUsecase) Inject(ctx context.Context, userID, categoryID int64, videos []*domain.Video) ([]*domain.Video, map[int64]int64) {
+	if !uc.enabled || len(uc.slots) == 0 || len(videos) == 0 || !uc.degradation.Allow(FeaturePromotion) {
+		return videos, nil
+	}
+
+	campaigns, err := uc.selectCampaigns(ctx, userID, categoryID)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("select promotions failed, serve organic feed: %v", err)
+		return videos, nil
+	}
+	if len(campaigns) == 0 {
+		return videos, nil
+	}
+
+	videoMap, err := uc.promotedVideos(ctx, campaigns)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("load promoted videos failed, serve organic feed: %v", err)
+		return videos, nil
+	}
+	available := make([]*PromotionCampaign, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		if _, ok := videoMap[campaign.VideoID]; ok {
+			available = append(available, campaign)
+		}
+	}
+	if len(available) == 0 {
+		return videos, nil
+	}
+
+	result := make([]*domain.Video, 0, len(videos)+len(available))
+	for _, video := range videos {
+		if _, ok := videoMap[video.ID]; !ok {
+			result = append(result, video)
+		}
+	}
+
+	promoted := make(map[int64]int64, len(available))
+	served := make([]*PromotionCampaign, 0, len(available))
+	for i, slot := range uc.slots {
+		if i == len(available) || slot-1 > len(result) {
+			break
+		}
+		campaign := available[i]
+
+		index := slot - 1
+		result = append(result, nil)
+		copy(result[index+1:], result[index:])
+		result[index] = videoMap[campaign.VideoID]
+		promoted[campaign.VideoID] = campaign.ID
+		served = append(served, campaign)
+	}
+	if len(served) == 0 {
+		return videos, nil
+	}
+
+	uc.recordImpressions(ctx, userID, served)
+	return result, promoted
+}
+
+// RecordClick 记录推广点击，推广须处于投放中
+func (uc *PromotionUsecase) RecordClick(ctx context.Context, userID, campaignID int64) error {
+	campaigns, err := uc.runningCampaigns(ctx)
+	if err != nil {
+		return err
+	}
+	for _, campaign := range campaigns {
+		if campaign.ID == campaignID {
+			return uc.repo.CreateEvents(ctx, []*PromotionEvent{{
+				CampaignID: campaign.ID,
+				VideoID:    campaign.VideoID,
+				UserID:     userID,
+				Type:       PromotionEventClick,
+				CreatedAt:  uc.clock.Now(),
+			}})
+		}
+	}
+	return ErrPromotionNotFound
+}
+
+// selectCampaigns 按定向和频控筛选投放中的推广，按优先级降序、ID升序排列，同一视频只保留一个推广
+func (uc *PromotionUsecase) selectCampaigns(ctx context.Context, userID, categoryID int64) ([]*PromotionCampaign, error) {
+	running, err := uc.runningCampaigns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*PromotionCampaign
+	var capped []int64
+	for _, campaign := range running {
+		if !campaign.Targets(userID, categoryID) {
+			continue
+		}
+		candidates = append(candidates, campaign)
+		if userID != 0 && campaign.FrequencyCap > 0 {
+			capped = append(capped, campaign.ID)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	var frequencies map[int64]int64
+	if len(capped) > 0 {
+		if frequencies, err = uc.repo.GetFrequencies(ctx, userID, capped); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority > candidates[j].Priority
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	selected := make([]*PromotionCampaign, 0, len(uc.slots))
+	seen := make(map[int64]bool)
+	for _, campaign := range candidates {
+		if len(selected) == len(uc.slots) {
+			break
+		}
+		if seen[campaign.VideoID] {
+			continue
+		}
+		if userID != 0 && campaign.FrequencyCap > 0 && frequencies[campaign.ID] >= int64(campaign.FrequencyCap) {
+			continue
+		}
+		seen[campaign.VideoID] = true
+		selected = append(selected, campaign)
+	}
+	return selected, nil
+}
+
+// promotedVideos 批量加载推广视频，推广期间视频被删除或改为私密时跳过
+func (uc *PromotionUsecase) promotedVideos(ctx context.Context, campaigns []*PromotionCampaign) (map[int64]*domain.Video, error) {
+	ids := make([]int64, len(campaigns))
+	for i, campaign := range campaigns {
+		ids[i] = campaign.VideoID
+	}
+
+	videos, err := uc.videoRepo.GetVideos(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	videoMap := make(map[int64]*domain.Video, len(videos))
+	for _, video := range videos {
+		if video.Status == domain.VideoStatusPublished && video.IsPublic() {
+			videoMap[video.ID] = video
+		}
+	}
+	return videoMap, nil
+}
+
+// recordImpressions 记录曝光并累加频控计数，失败只记录日志
+func (uc *PromotionUsecase) recordImpressions(ctx context.Context, userID int64, campaigns []*PromotionCampaign) {
+	now := uc.clock.Now()
+	events := make([]*PromotionEvent, len(campaigns))
+	var capped []*PromotionCampaign
+	for i, campaign := range campaigns {
+		events[i] = &PromotionEvent{
+			CampaignID: campaign.ID,
+			VideoID:    campaign.VideoID,
+			UserID:     userID,
+			Type:       PromotionEventImpression,
+			CreatedAt:  now,
+		}
+		if userID != 0 && campaign.FrequencyCap > 0 {
+			capped = append(capped, campaign)
+		}
+	}
+
+	if err := uc.repo.CreateEvents(ctx, events); err != nil {
+		uc.log.WithContext(ctx).Errorf("record promotion impressions failed: user=%d err=%v", userID, err)
+	}
+	if len(capped) > 0 {
+		if err := uc.repo.IncrFrequencies(ctx, userID, capped); err != nil {
+			uc.log.WithContext(ctx).Warnf("incr promotion frequencies failed: user=%d err=%v", userID, err)
+		}
+	}
+}
+
+// runningCampaigns 读取投放中的推广计划，结果在本地缓存 promotionCacheTTL
+func (uc *PromotionUsecase) runningCampaigns(ctx context.Context) ([]*PromotionCampaign, error) {
+	now := uc.clock.Now()
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if now.Before(uc.expiresAt) {
+		return filterRunningCampaigns(uc.running, now), nil
+	}
+
+	campaigns, err := uc.repo.ListRunningCampaigns(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	uc.running = campaigns
+	uc.expiresAt = now.Add(promotionCacheTTL)
+	return campaigns, nil
+}
+
+// invalidate 清除本实例的推广缓存
+func (uc *PromotionUsecase) invalidate() {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.expiresAt = time.Time{}
+}
+
+func (uc *PromotionUsecase) requireAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// filterRunningCampaigns 缓存期间可能有推广到期，返回时再按当前时间过滤
+func filterRunningCampaigns(campaigns []*PromotionCampaign, now time.Time) []*PromotionCampaign {
+	running := make([]*PromotionCampaign, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		if campaign.Running(now) {
+			running = append(running, campaign)
+		}
+	}
+	return running
+}
+
+// validatePromotionCampaign 校验并规范化推广计划的可编辑字段
+func validatePromotionCampaign(campaign *PromotionCampaign) error {
+	campaign.Name = strings.TrimSpace(campaign.Name)
+	if campaign.Name == "" || utf8.RuneCountInString(campaign.Name) > maxPromotionNameLength {
+		return ErrInvalidPromotionName
+	}
+	if campaign.Audience == "" {
+		campaign.Audience = PromotionAudienceAll
+	}
+	switch campaign.Audience {
+	case PromotionAudienceAll, PromotionAudienceUsers, PromotionAudienceGuests:
+	default:
+		return ErrInvalidPromotionAudience
+	}
+	if campaign.Status != PromotionStatusActive && campaign.Status != PromotionStatusPaused {
+		return ErrInvalidPromotionStatus
+	}
+	if (campaign.FrequencyCap > 0) != (campaign.FrequencyWindow > 0) || campaign.FrequencyCap < 0 || campaign.FrequencyWindow < 0 {
+		return ErrInvalidPromotionFrequency
+	}
+	if campaign.StartAt.IsZero() || !campaign.EndAt.After(campaign.StartAt) {
+		return ErrInvalidPromotionSchedule
+	}
+	return nil
+}
+
+// normalizePromotionSlots 去掉非法和重复的推广位并升序排列
+func normalizePromotionSlots(slots []int32) []int {
+	seen := make(map[int]bool, len(slots))
+	normalized := make([]int, 0, len(slots))
+	for _, slot := range slots {
+		if slot > 0 && !seen[int(slot)] {
+			seen[int(slot)] = true
+			normalized = append(normalized, int(slot))
+		}
+	}
+	sort.Ints(normalized)
+	return normalized
+}
@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type promotionTestDeps struct {
+	repo      *MockPromotionRepo
+	videoRepo *MockVideoRepo
+	roleRepo  *MockRoleRepo
+	clock     *clock.Fake
+	uc        *PromotionUsecase
+}
+
+func newPromotionTestDeps(t *testing.T) *promotionTestDeps {
+	d := &promotionTestDeps{
+		repo:      NewMockPromotionRepo(t),
+		videoRepo: NewMockVideoRepo(t),
+		roleRepo:  NewMockRoleRepo(t),
+		clock:     clock.NewFake(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)),
+	}
+	permissionUc := NewPermissionUsecase(d.roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+	config := &conf.Business{Promotion: &conf.Business_Promotion{Enabled: true, Slots: []int32{3, 2, 3, 0}}}
+	d.uc = NewPromotionUsecase(d.repo, d.videoRepo, permissionUc, newTestDegradation(), config, d.clock, log.DefaultLogger)
+	return d
+}
+
+func (d *promotionTestDeps) campaign(id, videoID int64, priority int32) *PromotionCampaign {
+	return &PromotionCampaign{
+		ID:       id,
+		VideoID:  videoID,
+		Audience: PromotionAudienceAll,
+		Priority: priority,
+		StartAt:  d.clock.Now().Add(-time.Hour),
+		EndAt:    d.clock.Now().Add(time.Hour),
+		Status:   PromotionStatusActive,
+	}
+}
+
+func organicVideos(ids ...int64) []*domain.Video {
+	videos := make([]*domain.Video, len(ids))
+	for i, id := range ids {
+		videos[i] = &domain.Video{ID: id, Status: domain.VideoStatusPublished}
+	}
+	return videos
+}
+
+func TestPromotionCampaign_Targets(t *testing.T) {
+	campaign := &PromotionCampaign{Audience: PromotionAudienceAll}
+	assert.True(t, campaign.Targets(0, 0))
+	assert.False(t, campaign.Targets(0, 5))
+
+	campaign = &PromotionCampaign{Audience: PromotionAudienceUsers, CategoryIDs: []int64{5}}
+	assert.True(t, campaign.Targets(1, 5))
+	assert.False(t, campaign.Targets(0, 5))
+	assert.False(t, campaign.Targets(1, 0))
+
+	campaign = &PromotionCampaign{Audience: PromotionAudienceGuests}
+	assert.True(t, campaign.Targets(0, 0))
+	assert.False(t, campaign.Targets(1, 0))
+}
+
+func TestPromotionUsecase_Inject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("FillSlotsByPriority", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		d.repo.EXPECT().ListRunningCampaigns(ctx, d.clock.Now()).Return([]*PromotionCampaign{
+			d.campaign(1, 100, 1),
+			d.campaign(2, 200, 5),
+			d.campaign(3, 300, 0),
+		}, nil)
+		d.videoRepo.EXPECT().GetVideos(ctx, []int64{200, 100}).Return(organicVideos(100, 200), nil)
+		d.repo.EXPECT().CreateEvents(ctx, mock.MatchedBy(func(events []*PromotionEvent) bool {
+			return len(events) == 2 && events[0].CampaignID == 2 && events[1].CampaignID == 1 &&
+				events[0].Type == PromotionEventImpression && events[0].UserID == 0
+		})).Return(nil)
+
+		videos, promoted := d.uc.Inject(ctx, 0, 0, organicVideos(1, 100, 2, 3))
+		assert.Equal(t, []int64{1, 200, 100, 2, 3}, videoIDs(videos))
+		assert.Equal(t, map[int64]int64{200: 2, 100: 1}, promoted)
+	})
+
+	t.Run("FrequencyCap", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		capped := d.campaign(1, 100, 5)
+		capped.FrequencyCap, capped.FrequencyWindow = 2, 24*time.Hour
+		fresh := d.campaign(2, 200, 1)
+		fresh.FrequencyCap, fresh.FrequencyWindow = 2, 24*time.Hour
+		d.repo.EXPECT().ListRunningCampaigns(ctx, d.clock.Now()).Return([]*PromotionCampaign{capped, fresh}, nil)
+		d.repo.EXPECT().GetFrequencies(ctx, int64(9), []int64{1, 2}).Return(map[int64]int64{1: 2, 2: 1}, nil)
+		d.videoRepo.EXPECT().GetVideos(ctx, []int64{200}).Return(organicVideos(200), nil)
+		d.repo.EXPECT().CreateEvents(ctx, mock.Anything).Return(nil)
+		d.repo.EXPECT().IncrFrequencies(ctx, int64(9), []*PromotionCampaign{fresh}).Return(nil)
+
+		videos, promoted := d.uc.Inject(ctx, 9, 0, organicVideos(1, 2, 3))
+		assert.Equal(t, []int64{1, 200, 2, 3}, videoIDs(videos))
+		assert.Equal(t, map[int64]int64{200: 2}, promoted)
+	})
+
+	t.Run("SkipUnpublishedVideo", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		d.repo.EXPECT().ListRunningCampaigns(ctx, d.clock.Now()).Return([]*PromotionCampaign{
+			d.campaign(1, 100, 5),
+			d.campaign(2, 200, 1),
+		}, nil)
+		d.videoRepo.EXPECT().GetVideos(ctx, []int64{100, 200}).Return([]*domain.Video{
+			{ID: 100, Status: domain.VideoStatusPrivate},
+			{ID: 200, Status: domain.VideoStatusPublished},
+		}, nil)
+		d.repo.EXPECT().CreateEvents(ctx, mock.Anything).Return(nil)
+
+		videos, promoted := d.uc.Inject(ctx, 0, 0, organicVideos(1, 2))
+		assert.Equal(t, []int64{1, 200, 2}, videoIDs(videos))
+		assert.Equal(t, map[int64]int64{200: 2}, promoted)
+	})
+
+	t.Run("CachedCampaigns", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		expiring := d.campaign(1, 100, 0)
+		expiring.EndAt = d.clock.Now().Add(10 * time.Second)
+		d.repo.EXPECT().ListRunningCampaigns(ctx, d.clock.Now()).Return([]*PromotionCampaign{expiring}, nil).Once()
+		d.videoRepo.EXPECT().GetVideos(ctx, []int64{100}).Return(organicVideos(100), nil).Once()
+		d.repo.EXPECT().CreateEvents(ctx, mock.Anything).Return(nil).Once()
+
+		_, promoted := d.uc.Inject(ctx, 0, 0, organicVideos(1, 2))
+		assert.Len(t, promoted, 1)
+
+		// 缓存期间推广到期，不再投放
+		d.clock.Advance(20 * time.Second)
+		videos, promoted := d.uc.Inject(ctx, 0, 0, organicVideos(1, 2))
+		assert.Equal(t, []int64{1, 2}, videoIDs(videos))
+		assert.Empty(t, promoted)
+	})
+
+	t.Run("RepoErrorServesOrganic", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		d.repo.EXPECT().ListRunningCampaigns(ctx, d.clock.Now()).Return(nil, assert.AnError)
+
+		videos, promoted := d.uc.Inject(ctx, 0, 0, organicVideos(1, 2))
+		assert.Equal(t, []int64{1, 2}, videoIDs(videos))
+		assert.Nil(t, promoted)
+	})
+}
+
+func TestPromotionUsecase_CreateCampaign(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		expectCategoryAdmin(ctx, d.roleRepo, 1, true)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(100)).Return(&domain.Video{ID: 100, Status: domain.VideoStatusPublished}, nil)
+		d.repo.EXPECT().CreateCampaign(ctx, mock.MatchedBy(func(c *PromotionCampaign) bool {
+			return c.Name == "夏季推广" && c.Audience == PromotionAudienceAll &&
+				c.Status == PromotionStatusActive && c.CreatedBy == 1
+		})).Return(nil)
+
+		campaign := d.campaign(0, 100, 0)
+		campaign.Name, campaign.Audience = " 夏季推广 ", ""
+		_, err := d.uc.CreateCampaign(ctx, 1, campaign)
+		require.NoError(t, err)
+	})
+
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		expectCategoryAdmin(ctx, d.roleRepo, 1, true)
+
+		campaign := d.campaign(0, 100, 0)
+		campaign.Name, campaign.EndAt = "推广", campaign.StartAt
+		_, err := d.uc.CreateCampaign(ctx, 1, campaign)
+		assert.ErrorIs(t, err, ErrInvalidPromotionSchedule)
+	})
+
+	t.Run("InvalidFrequency", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		expectCategoryAdmin(ctx, d.roleRepo, 1, true)
+
+		campaign := d.campaign(0, 100, 0)
+		campaign.Name, campaign.FrequencyCap = "推广", 3
+		_, err := d.uc.CreateCampaign(ctx, 1, campaign)
+		assert.ErrorIs(t, err, ErrInvalidPromotionFrequency)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newPromotionTestDeps(t)
+		expectCategoryAdmin(ctx, d.roleRepo, 2, false)
+
+		_, err := d.uc.CreateCampaign(ctx, 2, d.campaign(0, 100, 0))
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
+
+func TestPromotionUsecase_RecordClick(t *testing.T) {
+	ctx := context.Background()
+	d := newPromotionTestDeps(t)
+	d.repo.EXPECT().ListRunningCampaigns(ctx, d.clock.Now()).Return([]*PromotionCampaign{d.campaign(1, 100, 0)}, nil)
+	d.repo.EXPECT().CreateEvents(ctx, []*PromotionEvent{{
+		CampaignID: 1, VideoID: 100, UserID: 9, Type: PromotionEventClick, CreatedAt: d.clock.Now(),
+	}}).Return(nil)
+
+	require.NoError(t, d.uc.RecordClick(ctx, 9, 1))
+	assert.ErrorIs(t, d.uc.RecordClick(ctx, 9, 2), ErrPromotionNotFound)
+}
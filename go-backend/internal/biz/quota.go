@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrUploadQuotaExceeded  = errors.New(429, v1.ErrorCode_UPLOAD_QUOTA_EXCEEDED.String(), "daily upload quota exceeded")
+	ErrStorageQuotaExceeded = errors.New(429, v1.ErrorCode_STORAGE_QUOTA_EXCEEDED.String(), "storage quota exceeded")
+)
+
+// uploadQuotaPeriod 上传计数周期，按UTC自然日重置
+const uploadQuotaPeriod = 24 * time.Hour
+
+// RateLimitBucket 限流桶当前状态
+type RateLimitBucket struct {
+	Name      string
+	Rate      float64
+	Burst     int
+	Remaining int
+}
+
+// RateLimitInspector 查询用户当前的限流桶，由限流中间件实现
+type RateLimitInspector interface {
+	UserBuckets(userID int64) []RateLimitBucket
+}
+
+// Quota 用户的限流和上传配额用量，Limit 为0表示不限制
+type Quota struct {
+	RateLimits     []RateLimitBucket
+	UploadsUsed    int64
+	UploadsLimit   int64
+	UploadsResetAt time.Time
+	StorageUsed    int64
+	StorageLimit   int64
+}
+
+// QuotaRepo 上传配额用量查询接口
+type QuotaRepo interface {
+	// CountUploadsSince 统计用户 since 之后上传的视频数，已删除的视频也计入
+	CountUploadsSince(ctx context.Context, userID int64, since time.Time) (int64, error)
+	// SumStorageBytes 统计用户未删除视频占用的存储
+	SumStorageBytes(ctx context.Context, userID int64) (int64, error)
+}
+
+// QuotaUsecase 用户配额用例。上传前检查配额，客户端可查询用量提前提示
+type QuotaUsecase struct {
+	repo         QuotaRepo
+	limiter      RateLimitInspector
+	clock        clock.Clock
+	uploadLimit  int64
+	storageLimit int64
+	log          *log.Helper
+}
+
+// NewQuotaUsecase 创建配额用例
+func NewQuotaUsecase(repo QuotaRepo, limiter RateLimitInspector, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *QuotaUsecase {
+	uc := &QuotaUsecase{
+		repo:    repo,
+		limiter: limiter,
+		clock:   clk,
+		log:     log.NewHelper(logger),
+	}
+	if cfg := businessConfig.GetQuota(); cfg != nil {
+		if cfg.DailyUploadLimit > 0 {
+			uc.uploadLimit = int64(cfg.DailyUploadLimit)
+		}
+		if cfg.StorageLimit > 0 {
+			uc.storageLimit = cfg.StorageLimit
+		}
+	}
+	return uc
+}
+
+// GetQuota 获取用户当前的限流和配额用量
+func (uc *QuotaUsecase) GetQuota(ctx context.Context, userID int64) (*Quota, error) {
+	periodStart := uc.periodStart()
+	uploads, err := uc.repo.CountUploadsSince(ctx, userID, periodStart)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := uc.repo.SumStorageBytes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quota{
+		RateLimits:     uc.limiter.UserBuckets(userID),
+		UploadsUsed:    uploads,
+		UploadsLimit:   uc.uploadLimit,
+		UploadsResetAt: periodStart.Add(uploadQuotaPeriod),
+		StorageUsed:    storage,
+		StorageLimit:   uc.storageLimit,
+	}, nil
+}
+
+// CheckUpload 在上传开始前检查配额，size 为待上传文件大小，未知时传0
+func (uc *QuotaUsecase) CheckUpload(ctx context.Context, userID, size int64) error {
+	if uc.uploadLimit > 0 {
+		uploads, err := uc.repo.CountUploadsSince(ctx, userID, uc.periodStart())
+		if err != nil {
+			return err
+		}
+		if uploads >= uc.uploadLimit {
+			return ErrUploadQuotaExceeded
+		}
+	}
+
+	if uc.storageLimit > 0 {
+		storage, err := uc.repo.SumStorageBytes(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if storage+size > uc.storageLimit {
+			return ErrStorageQuotaExceeded
+		}
+	}
+
+	return nil
+}
+
+// periodStart 当前上传计数周期的开始时间
+func (uc *QuotaUsecase) periodStart() time.Time {
+	return uc.clock.Now().UTC().Truncate(uploadQuotaPeriod)
+}
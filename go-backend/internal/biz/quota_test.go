@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newQuotaTestUsecase(t *testing.T, uploadLimit int32, storageLimit int64) (*QuotaUsecase, *MockQuotaRepo, *MockRateLimitInspector, *clock.Fake) {
+	repo := NewMockQuotaRepo(t)
+	limiter := NewMockRateLimitInspector(t)
+	clk := clock.NewFake(time.Date(2024, 6, 1, 15, 30, 0, 0, time.UTC))
+	config := &conf.Business{Quota: &conf.Business_Quota{DailyUploadLimit: uploadLimit, StorageLimit: storageLimit}}
+	return NewQuotaUsecase(repo, limiter, config, clk, log.DefaultLogger), repo, limiter, clk
+}
+
+func TestQuotaUsecase_GetQuota(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, limiter, _ := newQuotaTestUsecase(t, 10, 1000)
+	dayStart := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	buckets := []RateLimitBucket{{Name: "global", Rate: 100, Burst: 10, Remaining: 7}}
+
+	repo.EXPECT().CountUploadsSince(ctx, int64(1), dayStart).Return(3, nil)
+	repo.EXPECT().SumStorageBytes(ctx, int64(1)).Return(400, nil)
+	limiter.EXPECT().UserBuckets(int64(1)).Return(buckets)
+
+	quota, err := uc.GetQuota(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &Quota{
+		RateLimits:     buckets,
+		UploadsUsed:    3,
+		UploadsLimit:   10,
+		UploadsResetAt: dayStart.Add(24 * time.Hour),
+		StorageUsed:    400,
+		StorageLimit:   1000,
+	}, quota)
+}
+
+func TestQuotaUsecase_CheckUpload(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("WithinQuota", func(t *testing.T) {
+		uc, repo, _, _ := newQuotaTestUsecase(t, 10, 1000)
+		repo.EXPECT().CountUploadsSince(ctx, int64(1), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)).Return(9, nil)
+		repo.EXPECT().SumStorageBytes(ctx, int64(1)).Return(400, nil)
+
+		assert.NoError(t, uc.CheckUpload(ctx, 1, 600))
+	})
+
+	t.Run("UploadsExceeded", func(t *testing.T) {
+		uc, repo, _, _ := newQuotaTestUsecase(t, 10, 1000)
+		repo.EXPECT().CountUploadsSince(ctx, int64(1), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)).Return(10, nil)
+
+		assert.ErrorIs(t, uc.CheckUpload(ctx, 1, 0), ErrUploadQuotaExceeded)
+	})
+
+	t.Run("ResetsNextDay", func(t *testing.T) {
+		uc, repo, _, clk := newQuotaTestUsecase(t, 10, 0)
+		clk.Advance(9 * time.Hour)
+		repo.EXPECT().CountUploadsSince(ctx, int64(1), time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)).Return(0, nil)
+
+		assert.NoError(t, uc.CheckUpload(ctx, 1, 0))
+	})
+
+	t.Run("StorageExceeded", func(t *testing.T) {
+		uc, repo, _, _ := newQuotaTestUsecase(t, 0, 1000)
+		repo.EXPECT().SumStorageBytes(ctx, int64(1)).Return(400, nil)
+
+		assert.ErrorIs(t, uc.CheckUpload(ctx, 1, 601), ErrStorageQuotaExceeded)
+	})
+
+	t.Run("Unlimited", func(t *testing.T) {
+		uc, _, _, _ := newQuotaTestUsecase(t, 0, 0)
+		assert.NoError(t, uc.CheckUpload(ctx, 1, 1<<40))
+	})
+}
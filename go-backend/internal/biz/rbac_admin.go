@@ -0,0 +1,338 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrInvalidRoleName      = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "role name must be 1-50 characters")
+	ErrInvalidPermission    = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "permission name must be 1-50 characters and resource 1-100 characters")
+	ErrInvalidAction        = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "action must be GET, POST, PUT, DELETE or *")
+	ErrInvalidScope         = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "scope must be empty or own")
+	ErrInvalidRBACStatus    = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "status must be 1 or 2")
+	ErrInvalidBindingAction = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "invalid role permission action")
+)
+
+// 角色权限绑定操作
+const (
+	RolePermissionAttach int32 = 1
+	RolePermissionDetach int32 = 2
+)
+
+const (
+	maxRoleNameLength       = 50
+	maxPermissionNameLength = 50
+	maxResourceLength       = 100
+	maxRBACDescLength       = 200
+)
+
+// builtinRoles 代码中按名称引用的角色，不能改名、禁用或删除
+var builtinRoles = map[string]bool{
+	auth.RoleNameUser:      true,
+	auth.RoleNameAdmin:     true,
+	auth.RoleNameModerator: true,
+}
+
+// RoleWithPermissions 角色及其绑定的权限ID
+type RoleWithPermissions struct {
+	*domain.Role
+	PermissionIDs []int64
+}
+
+// RBACAdminUsecase 管理员维护角色、权限及其绑定关系。每次变更写入数据库后
+// 重新加载本实例的内存RBAC管理器，其他实例在下一次定时刷新时收敛
+type RBACAdminUsecase struct {
+	roleRepo       RoleRepo
+	permissionRepo PermissionRepo
+	permissionUc   *PermissionUsecase
+	syncUc         *RBACSyncUsecase
+	log            *log.Helper
+}
+
+// NewRBACAdminUsecase 创建RBAC管理用例
+func NewRBACAdminUsecase(
+	roleRepo RoleRepo,
+	permissionRepo PermissionRepo,
+	permissionUc *PermissionUsecase,
+	syncUc *RBACSyncUsecase,
+	logger log.Logger,
+) *RBACAdminUsecase {
+	return &RBACAdminUsecase{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		permissionUc:   permissionUc,
+		syncUc:         syncUc,
+		log:            log.NewHelper(logger),
+	}
+}
+
+// ListRoles 查询所有角色及其绑定的权限
+func (uc *RBACAdminUsecase) ListRoles(ctx context.Context, adminID int64) ([]*RoleWithPermissions, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	roles, err := uc.roleRepo.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePermissions, err := uc.permissionRepo.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	permissionIDs := make(map[int64][]int64, len(roles))
+	for _, rp := range rolePermissions {
+		permissionIDs[rp.RoleID] = append(permissionIDs[rp.RoleID], rp.PermissionID)
+	}
+
+	result := make([]*RoleWithPermissions, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, &RoleWithPermissions{Role: role, PermissionIDs: permissionIDs[role.ID]})
+	}
+	return result, nil
+}
+
+// CreateRole 创建角色，新角色默认启用
+func (uc *RBACAdminUsecase) CreateRole(ctx context.Context, adminID int64, name, description string) (*domain.Role, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	role := &domain.Role{
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		Status:      int8(domain.RoleStatusActive),
+	}
+	if err := validateRole(role); err != nil {
+		return nil, err
+	}
+
+	if err := uc.roleRepo.CreateRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d created role %d (%s)", adminID, role.ID, role.Name)
+	uc.reload(ctx)
+	return role, nil
+}
+
+// UpdateRole 修改角色，内置角色只能修改描述
+func (uc *RBACAdminUsecase) UpdateRole(ctx context.Context, adminID int64, role *domain.Role) (*domain.Role, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	role.Name = strings.TrimSpace(role.Name)
+	role.Description = strings.TrimSpace(role.Description)
+	if err := validateRole(role); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.roleRepo.FindRole(ctx, role.ID)
+	if err != nil {
+		return nil, err
+	}
+	if builtinRoles[existing.Name] && (role.Name != existing.Name || role.Status != int8(domain.RoleStatusActive)) {
+		return nil, ErrBuiltinRole
+	}
+
+	if err := uc.roleRepo.UpdateRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d updated role %d (%s)", adminID, role.ID, role.Name)
+	uc.reload(ctx)
+	return role, nil
+}
+
+// DeleteRole 删除角色及其绑定关系
+func (uc *RBACAdminUsecase) DeleteRole(ctx context.Context, adminID, roleID int64) error {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	existing, err := uc.roleRepo.FindRole(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	if builtinRoles[existing.Name] {
+		return ErrBuiltinRole
+	}
+
+	if err := uc.roleRepo.DeleteRole(ctx, roleID); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d deleted role %d (%s)", adminID, roleID, existing.Name)
+	uc.reload(ctx)
+	return nil
+}
+
+// ListPermissions 查询所有权限
+func (uc *RBACAdminUsecase) ListPermissions(ctx context.Context, adminID int64) ([]*domain.Permission, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	return uc.permissionRepo.ListPermissions(ctx)
+}
+
+// CreatePermission 创建权限，新权限默认启用
+func (uc *RBACAdminUsecase) CreatePermission(ctx context.Context, adminID int64, perm *domain.Permission) (*domain.Permission, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	perm.Status = int8(domain.PermissionStatusActive)
+	normalizePermission(perm)
+	if err := validatePermission(perm); err != nil {
+		return nil, err
+	}
+
+	if err := uc.permissionRepo.CreatePermission(ctx, perm); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d created permission %d (%s)", adminID, perm.ID, perm.Name)
+	uc.reload(ctx)
+	return perm, nil
+}
+
+// UpdatePermission 修改权限
+func (uc *RBACAdminUsecase) UpdatePermission(ctx context.Context, adminID int64, perm *domain.Permission) (*domain.Permission, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	normalizePermission(perm)
+	if err := validatePermission(perm); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.permissionRepo.FindPermission(ctx, perm.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.permissionRepo.UpdatePermission(ctx, perm); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d updated permission %d (%s)", adminID, perm.ID, perm.Name)
+	uc.reload(ctx)
+	return perm, nil
+}
+
+// DeletePermission 删除权限及其角色绑定
+func (uc *RBACAdminUsecase) DeletePermission(ctx context.Context, adminID, permissionID int64) error {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	if err := uc.permissionRepo.DeletePermission(ctx, permissionID); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d deleted permission %d", adminID, permissionID)
+	uc.reload(ctx)
+	return nil
+}
+
+// RolePermissionAction 为角色绑定或解绑权限
+func (uc *RBACAdminUsecase) RolePermissionAction(ctx context.Context, adminID, roleID, permissionID int64, actionType int32) error {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	if actionType != RolePermissionAttach && actionType != RolePermissionDetach {
+		return ErrInvalidBindingAction
+	}
+
+	if _, err := uc.roleRepo.FindRole(ctx, roleID); err != nil {
+		return err
+	}
+	if _, err := uc.permissionRepo.FindPermission(ctx, permissionID); err != nil {
+		return err
+	}
+
+	var err error
+	if actionType == RolePermissionAttach {
+		err = uc.permissionRepo.AssignPermission(ctx, roleID, permissionID)
+	} else {
+		err = uc.permissionRepo.RevokePermission(ctx, roleID, permissionID)
+	}
+	if err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("admin %d changed role %d permission %d, action=%d", adminID, roleID, permissionID, actionType)
+	uc.reload(ctx)
+	return nil
+}
+
+func (uc *RBACAdminUsecase) requireAdmin(ctx context.Context, adminID int64) error {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// reload 变更已写入数据库，重新加载失败只打日志，由定时刷新收敛
+func (uc *RBACAdminUsecase) reload(ctx context.Context) {
+	if err := uc.syncUc.Hydrate(ctx); err != nil {
+		uc.log.WithContext(ctx).Warnf("reload rbac after admin change failed: %v", err)
+	}
+}
+
+func validateRole(role *domain.Role) error {
+	if role.Name == "" || len(role.Name) > maxRoleNameLength || len(role.Description) > maxRBACDescLength {
+		return ErrInvalidRoleName
+	}
+	if role.Status != int8(domain.RoleStatusActive) && role.Status != int8(domain.RoleStatusInactive) {
+		return ErrInvalidRBACStatus
+	}
+	return nil
+}
+
+func normalizePermission(perm *domain.Permission) {
+	perm.Name = strings.TrimSpace(perm.Name)
+	perm.Resource = strings.TrimSpace(perm.Resource)
+	perm.Action = strings.ToUpper(strings.TrimSpace(perm.Action))
+	perm.Scope = strings.TrimSpace(perm.Scope)
+	perm.Description = strings.TrimSpace(perm.Description)
+}
+
+func validatePermission(perm *domain.Permission) error {
+	if perm.Name == "" || len(perm.Name) > maxPermissionNameLength ||
+		perm.Resource == "" || len(perm.Resource) > maxResourceLength ||
+		len(perm.Description) > maxRBACDescLength {
+		return ErrInvalidPermission
+	}
+
+	switch perm.Action {
+	case domain.ActionGet, domain.ActionPost, domain.ActionPut, domain.ActionDelete, domain.ActionAll:
+	default:
+		return ErrInvalidAction
+	}
+
+	if perm.Scope != domain.PermissionScopeAll && perm.Scope != domain.PermissionScopeOwn {
+		return ErrInvalidScope
+	}
+
+	if perm.Status != int8(domain.PermissionStatusActive) && perm.Status != int8(domain.PermissionStatusInactive) {
+		return ErrInvalidRBACStatus
+	}
+	return nil
+}
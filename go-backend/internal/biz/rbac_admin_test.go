@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rbacTestAdminID  = int64(1)
+	rbacTestAdminRID = int64(2)
+)
+
+type rbacAdminTestDeps struct {
+	roleRepo       *MockRoleRepo
+	permissionRepo *MockPermissionRepo
+	rbacManager    *auth.MemoryRBACManager
+	uc             *RBACAdminUsecase
+}
+
+func newRBACAdminTestDeps(t *testing.T) *rbacAdminTestDeps {
+	roleRepo := NewMockRoleRepo(t)
+	permissionRepo := NewMockPermissionRepo(t)
+	rbacManager := auth.NewMemoryRBACManager()
+	permissionUc := NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, nil, log.DefaultLogger)
+	syncUc := NewRBACSyncUsecase(roleRepo, permissionRepo, rbacManager, &conf.Business{}, log.DefaultLogger)
+
+	return &rbacAdminTestDeps{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		rbacManager:    rbacManager,
+		uc:             NewRBACAdminUsecase(roleRepo, permissionRepo, permissionUc, syncUc, log.DefaultLogger),
+	}
+}
+
+func (d *rbacAdminTestDeps) expectAdmin(ctx context.Context, userID int64, isAdmin bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, auth.RoleNameAdmin).Return(&domain.Role{ID: rbacTestAdminRID, Name: auth.RoleNameAdmin, Status: 1}, nil).Once()
+	d.roleRepo.EXPECT().HasRole(ctx, userID, rbacTestAdminRID).Return(isAdmin, nil).Once()
+}
+
+// expectReload 变更后从数据库重新加载的状态
+func (d *rbacAdminTestDeps) expectReload(ctx context.Context, roles []*domain.Role, permissions []*domain.Permission, rolePermissions []*domain.RolePermission) {
+	d.roleRepo.EXPECT().ListRoles(ctx).Return(roles, nil).Once()
+	d.permissionRepo.EXPECT().ListPermissions(ctx).Return(permissions, nil).Once()
+	d.permissionRepo.EXPECT().ListRolePermissions(ctx).Return(rolePermissions, nil).Once()
+	d.roleRepo.EXPECT().ListPermissionVersions(ctx).Return(map[int64]int64{}, nil).Once()
+	d.roleRepo.EXPECT().ListUserRoles(ctx).Return([]*domain.UserRole{{UserID: 7, RoleID: 5}}, nil).Once()
+}
+
+func TestRBACAdminUsecase_NotAdmin(t *testing.T) {
+	ctx := context.Background()
+	d := newRBACAdminTestDeps(t)
+	d.expectAdmin(ctx, 7, false)
+
+	_, err := d.uc.CreateRole(ctx, 7, "editor", "")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+}
+
+func TestRBACAdminUsecase_CreateRole(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().CreateRole(ctx, mock.MatchedBy(func(role *domain.Role) bool {
+			return role.Name == "editor" && role.Status == int8(domain.RoleStatusActive)
+		})).RunAndReturn(func(_ context.Context, role *domain.Role) error {
+			role.ID = 5
+			return nil
+		})
+		d.expectReload(ctx, []*domain.Role{{ID: 5, Name: "editor", Status: 1}}, nil, nil)
+
+		role, err := d.uc.CreateRole(ctx, rbacTestAdminID, "  editor ", "edits things")
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), role.ID)
+
+		// 内存状态已按数据库重新加载
+		roles, err := d.rbacManager.GetUserRoles(7)
+		require.NoError(t, err)
+		require.Len(t, roles, 1)
+		assert.Equal(t, "editor", roles[0].Name)
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+
+		_, err := d.uc.CreateRole(ctx, rbacTestAdminID, " ", "")
+		assert.ErrorIs(t, err, ErrInvalidRoleName)
+	})
+}
+
+func TestRBACAdminUsecase_BuiltinRole(t *testing.T) {
+	ctx := context.Background()
+	builtin := &domain.Role{ID: 3, Name: auth.RoleNameModerator, Status: 1}
+
+	t.Run("Rename", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().FindRole(ctx, int64(3)).Return(builtin, nil)
+
+		_, err := d.uc.UpdateRole(ctx, rbacTestAdminID, &domain.Role{ID: 3, Name: "reviewer", Status: 1})
+		assert.ErrorIs(t, err, ErrBuiltinRole)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().FindRole(ctx, int64(3)).Return(builtin, nil)
+
+		_, err := d.uc.UpdateRole(ctx, rbacTestAdminID, &domain.Role{ID: 3, Name: auth.RoleNameModerator, Status: 2})
+		assert.ErrorIs(t, err, ErrBuiltinRole)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().FindRole(ctx, int64(3)).Return(builtin, nil)
+
+		err := d.uc.DeleteRole(ctx, rbacTestAdminID, 3)
+		assert.ErrorIs(t, err, ErrBuiltinRole)
+	})
+
+	t.Run("UpdateDescription", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().FindRole(ctx, int64(3)).Return(builtin, nil)
+		d.roleRepo.EXPECT().UpdateRole(ctx, mock.Anything).Return(nil)
+		d.expectReload(ctx, []*domain.Role{builtin}, nil, nil)
+
+		role, err := d.uc.UpdateRole(ctx, rbacTestAdminID, &domain.Role{ID: 3, Name: auth.RoleNameModerator, Description: "reviews content", Status: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "reviews content", role.Description)
+	})
+}
+
+func TestRBACAdminUsecase_CreatePermission(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Normalize", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.permissionRepo.EXPECT().CreatePermission(ctx, mock.MatchedBy(func(perm *domain.Permission) bool {
+			return perm.Action == domain.ActionDelete && perm.Scope == domain.PermissionScopeOwn && perm.Status == 1
+		})).Return(nil)
+		d.expectReload(ctx, nil, nil, nil)
+
+		_, err := d.uc.CreatePermission(ctx, rbacTestAdminID, &domain.Permission{Name: "video:delete:own", Resource: "/video", Action: "delete", Scope: "own"})
+		require.NoError(t, err)
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+
+		_, err := d.uc.CreatePermission(ctx, rbacTestAdminID, &domain.Permission{Name: "video:patch", Resource: "/video", Action: "PATCH"})
+		assert.ErrorIs(t, err, ErrInvalidAction)
+	})
+
+	t.Run("InvalidScope", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+
+		_, err := d.uc.CreatePermission(ctx, rbacTestAdminID, &domain.Permission{Name: "video:read", Resource: "/video", Action: "GET", Scope: "team"})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
+}
+
+func TestRBACAdminUsecase_RolePermissionAction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("AttachReloadsManager", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().FindRole(ctx, int64(5)).Return(&domain.Role{ID: 5, Name: "editor", Status: 1}, nil)
+		d.permissionRepo.EXPECT().FindPermission(ctx, int64(30)).Return(&domain.Permission{ID: 30, Status: 1}, nil)
+		d.permissionRepo.EXPECT().AssignPermission(ctx, int64(5), int64(30)).Return(nil)
+		d.expectReload(ctx,
+			[]*domain.Role{{ID: 5, Name: "editor", Status: 1}},
+			[]*domain.Permission{{ID: 30, Name: "video:update", Resource: "/video", Action: "PUT", Status: 1}},
+			[]*domain.RolePermission{{RoleID: 5, PermissionID: 30}},
+		)
+
+		assert.False(t, d.rbacManager.HasPermission(ctx, 7, "/video", "PUT"))
+		err := d.uc.RolePermissionAction(ctx, rbacTestAdminID, 5, 30, RolePermissionAttach)
+		require.NoError(t, err)
+		assert.True(t, d.rbacManager.HasPermission(ctx, 7, "/video", "PUT"))
+	})
+
+	t.Run("Detach", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().FindRole(ctx, int64(5)).Return(&domain.Role{ID: 5, Name: "editor", Status: 1}, nil)
+		d.permissionRepo.EXPECT().FindPermission(ctx, int64(30)).Return(&domain.Permission{ID: 30, Status: 1}, nil)
+		d.permissionRepo.EXPECT().RevokePermission(ctx, int64(5), int64(30)).Return(nil)
+		d.expectReload(ctx, nil, nil, nil)
+
+		err := d.uc.RolePermissionAction(ctx, rbacTestAdminID, 5, 30, RolePermissionDetach)
+		require.NoError(t, err)
+	})
+
+	t.Run("PermissionNotFound", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+		d.roleRepo.EXPECT().FindRole(ctx, int64(5)).Return(&domain.Role{ID: 5, Name: "editor", Status: 1}, nil)
+		d.permissionRepo.EXPECT().FindPermission(ctx, int64(31)).Return(nil, ErrPermissionNotFound)
+
+		err := d.uc.RolePermissionAction(ctx, rbacTestAdminID, 5, 31, RolePermissionAttach)
+		assert.ErrorIs(t, err, ErrPermissionNotFound)
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		d := newRBACAdminTestDeps(t)
+		d.expectAdmin(ctx, rbacTestAdminID, true)
+
+		err := d.uc.RolePermissionAction(ctx, rbacTestAdminID, 5, 30, 3)
+		assert.ErrorIs(t, err, ErrInvalidBindingAction)
+	})
+}
+
+func TestRBACAdminUsecase_ListRoles(t *testing.T) {
+	ctx := context.Background()
+	d := newRBACAdminTestDeps(t)
+	d.expectAdmin(ctx, rbacTestAdminID, true)
+	d.roleRepo.EXPECT().ListRoles(ctx).Return([]*domain.Role{{ID: 1, Name: "user"}, {ID: 5, Name: "editor"}}, nil)
+	d.permissionRepo.EXPECT().ListRolePermissions(ctx).Return([]*domain.RolePermission{
+		{RoleID: 1, PermissionID: 21},
+		{RoleID: 1, PermissionID: 22},
+	}, nil)
+
+	roles, err := d.uc.ListRoles(ctx, rbacTestAdminID)
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, []int64{21, 22}, roles[0].PermissionIDs)
+	assert.Empty(t, roles[1].PermissionIDs)
+}
@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultRBACRefreshInterval          = 5 * time.Minute
+	defaultRBACConsistencyCheckInterval = 15 * time.Minute
+)
+
+// RBACSyncUsecase 负责将数据库中的角色权限同步到内存RBAC管理器：
+// 启动时全量加载、定期刷新，以及检查两者是否出现偏差
+type RBACSyncUsecase struct {
+	roleRepo       RoleRepo
+	permissionRepo PermissionRepo
+	rbacManager    auth.RBACManager
+
+	refreshInterval time.Duration
+	checkInterval   time.Duration
+
+	divergenceCounter metric.Int64Counter
+
+	log *log.Helper
+}
+
+// NewRBACSyncUsecase 创建RBAC同步用例
+func NewRBACSyncUsecase(
+	roleRepo RoleRepo,
+	permissionRepo PermissionRepo,
+	rbacManager auth.RBACManager,
+	businessConfig *conf.Business,
+	logger log.Logger,
+) *RBACSyncUsecase {
+	uc := &RBACSyncUsecase{
+		roleRepo:        roleRepo,
+		permissionRepo:  permissionRepo,
+		rbacManager:     rbacManager,
+		refreshInterval: defaultRBACRefreshInterval,
+		checkInterval:   defaultRBACConsistencyCheckInterval,
+		log:             log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetRbac(); cfg != nil {
+		if cfg.RefreshInterval != nil {
+			uc.refreshInterval = cfg.RefreshInterval.AsDuration()
+		}
+		if cfg.ConsistencyCheckInterval != nil {
+			uc.checkInterval = cfg.ConsistencyCheckInterval.AsDuration()
+		}
+	}
+
+	meter := otel.Meter("go-backend/rbac")
+	uc.divergenceCounter, _ = meter.Int64Counter("rbac_divergence_total",
+		metric.WithDescription("Objects whose in-memory RBAC state diverged from the database"))
+
+	return uc
+}
+
+// RefreshInterval 刷新间隔
+func (uc *RBACSyncUsecase) RefreshInterval() time.Duration {
+	return uc.refreshInterval
+}
+
+// ConsistencyCheckInterval 一致性检查间隔
+func (uc *RBACSyncUsecase) ConsistencyCheckInterval() time.Duration {
+	return uc.checkInterval
+}
+
+// Hydrate 从数据库全量加载角色权限并替换内存状态
+func (uc *RBACSyncUsecase) Hydrate(ctx context.Context) error {
+	snapshot, err := uc.loadSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.rbacManager.Load(snapshot)
+	uc.log.WithContext(ctx).Infof("rbac hydrated: %d roles, %d permissions, %d users",
+		len(snapshot.Roles), len(snapshot.Permissions), len(snapshot.UserRoles))
+
+	return nil
+}
+
+// CheckConsistency 比较内存与数据库状态并报告差异，不修正差异，由下一次刷新收敛。
+// 检查期间发生的角色变更可能导致偶发的误报
+func (uc *RBACSyncUsecase) CheckConsistency(ctx context.Context) (*auth.RBACDivergence, error) {
+	expected, err := uc.loadSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	divergence := auth.DiffRBACSnapshots(expected, uc.rbacManager.Snapshot())
+	if !divergence.IsEmpty() {
+		uc.divergenceCounter.Add(ctx, int64(divergence.Total()))
+		uc.log.WithContext(ctx).Warnf("rbac memory state diverged from database: roles=%v permissions=%v role_permissions=%v user_roles=%v",
+			divergence.Roles, divergence.Permissions, divergence.RolePermissions, divergence.UserRoles)
+	}
+
+	return divergence, nil
+}
+
+// RunConsistencyCheck 供调度器调用的一致性检查任务
+func (uc *RBACSyncUsecase) RunConsistencyCheck(ctx context.Context) error {
+	_, err := uc.CheckConsistency(ctx)
+	return err
+}
+
+// loadSnapshot 从数据库构建快照
+func (uc *RBACSyncUsecase) loadSnapshot(ctx context.Context) (*auth.RBACSnapshot, error) {
+	roles, err := uc.roleRepo.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := uc.permissionRepo.ListPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePermissions, err := uc.permissionRepo.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先读版本再读绑定：期间发生的变更只会让内存中的版本偏旧，携带新版本的令牌回退到完整检查，
+	// 而不会让旧的角色集合对应上新的版本
+	versions, err := uc.roleRepo.ListPermissionVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userRoles, err := uc.roleRepo.ListUserRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := auth.NewRBACSnapshot()
+	snapshot.Roles = roles
+	snapshot.Permissions = permissions
+	for _, rp := range rolePermissions {
+		snapshot.RolePermissions[rp.RoleID] = append(snapshot.RolePermissions[rp.RoleID], rp.PermissionID)
+	}
+	for _, ur := range userRoles {
+		snapshot.UserRoles[ur.UserID] = append(snapshot.UserRoles[ur.UserID], ur.RoleID)
+	}
+	snapshot.UserVersions = versions
+
+	return snapshot, nil
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type rbacSyncTestDeps struct {
+	roleRepo       *MockRoleRepo
+	permissionRepo *MockPermissionRepo
+	rbacManager    *auth.MemoryRBACManager
+	uc             *RBACSyncUsecase
+}
+
+func newRBACSyncTestDeps(t *testing.T) *rbacSyncTestDeps {
+	roleRepo := NewMockRoleRepo(t)
+	permissionRepo := NewMockPermissionRepo(t)
+	rbacManager := auth.NewMemoryRBACManager()
+
+	return &rbacSyncTestDeps{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		rbacManager:    rbacManager,
+		uc:             NewRBACSyncUsecase(roleRepo, permissionRepo, rbacManager, &conf.Business{}, log.DefaultLogger),
+	}
+}
+
+func (d *rbacSyncTestDeps) expectDatabaseState(ctx context.Context, userRoles []*domain.UserRole) {
+	d.roleRepo.EXPECT().ListRoles(ctx).Return([]*domain.Role{
+		{ID: 11, Name: auth.RoleNameUser, Status: 1},
+		{ID: 12, Name: auth.RoleNameModerator, Status: 1},
+	}, nil).Once()
+	d.permissionRepo.EXPECT().ListPermissions(ctx).Return([]*domain.Permission{
+		{ID: 21, Name: "video:read", Resource: "/video", Action: "GET", Status: 1},
+		{ID: 22, Name: "comment:delete", Resource: "/comment", Action: "DELETE", Status: 1},
+	}, nil).Once()
+	d.permissionRepo.EXPECT().ListRolePermissions(ctx).Return([]*domain.RolePermission{
+		{RoleID: 11, PermissionID: 21},
+		{RoleID: 12, PermissionID: 21},
+		{RoleID: 12, PermissionID: 22},
+	}, nil).Once()
+	d.roleRepo.EXPECT().ListPermissionVersions(ctx).Return(map[int64]int64{2: 3}, nil).Once()
+	d.roleRepo.EXPECT().ListUserRoles(ctx).Return(userRoles, nil).Once()
+}
+
+func TestRBACSyncUsecase_Intervals(t *testing.T) {
+	uc := NewRBACSyncUsecase(nil, nil, auth.NewMemoryRBACManager(), &conf.Business{
+		Rbac: &conf.Business_Rbac{RefreshInterval: durationpb.New(time.Minute)},
+	}, log.DefaultLogger)
+
+	assert.Equal(t, time.Minute, uc.RefreshInterval())
+	assert.Equal(t, defaultRBACConsistencyCheckInterval, uc.ConsistencyCheckInterval())
+}
+
+func TestRBACSyncUsecase_Hydrate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("LoadFromDatabase", func(t *testing.T) {
+		d := newRBACSyncTestDeps(t)
+		d.expectDatabaseState(ctx, []*domain.UserRole{
+			{UserID: 1, RoleID: 11},
+			{UserID: 2, RoleID: 12},
+		})
+
+		require.NoError(t, d.uc.Hydrate(ctx))
+
+		assert.True(t, d.rbacManager.HasPermission(ctx, 1, "/video", "GET"))
+		assert.False(t, d.rbacManager.HasPermission(ctx, 1, "/comment", "DELETE"))
+		assert.True(t, d.rbacManager.HasPermission(ctx, 2, "/comment", "DELETE"))
+		assert.True(t, d.rbacManager.IsModerator(2))
+	})
+
+	t.Run("KeepStateOnError", func(t *testing.T) {
+		d := newRBACSyncTestDeps(t)
+		require.NoError(t, d.rbacManager.AssignRole(1, 1))
+
+		d.roleRepo.EXPECT().ListRoles(ctx).Return(nil, errors.New("db down"))
+
+		assert.Error(t, d.uc.Hydrate(ctx))
+		assert.True(t, d.rbacManager.HasPermission(ctx, 1, "/user", "GET"))
+	})
+}
+
+func TestRBACSyncUsecase_CheckConsistency(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Consistent", func(t *testing.T) {
+		d := newRBACSyncTestDeps(t)
+		userRoles := []*domain.UserRole{{UserID: 1, RoleID: 11}}
+		d.expectDatabaseState(ctx, userRoles)
+		require.NoError(t, d.uc.Hydrate(ctx))
+
+		d.expectDatabaseState(ctx, userRoles)
+		divergence, err := d.uc.CheckConsistency(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, divergence.IsEmpty())
+	})
+
+	t.Run("RoleGrantedElsewhere", func(t *testing.T) {
+		d := newRBACSyncTestDeps(t)
+		d.expectDatabaseState(ctx, []*domain.UserRole{{UserID: 1, RoleID: 11}})
+		require.NoError(t, d.uc.Hydrate(ctx))
+
+		// 其他实例为用户2授予了审核员角色
+		d.expectDatabaseState(ctx, []*domain.UserRole{{UserID: 1, RoleID: 11}, {UserID: 2, RoleID: 12}})
+		divergence, err := d.uc.CheckConsistency(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, divergence.UserRoles)
+		assert.Empty(t, divergence.Roles)
+		assert.False(t, d.rbacManager.IsModerator(2))
+	})
+}
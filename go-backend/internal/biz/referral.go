@@ -0,0 +1,335 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrReferralCodeInvalid = errors.BadRequest(v1.ErrorCode_REFERRAL_CODE_INVALID.String(), "invalid referral code")
+	// ErrReferralCodeConflict 生成的推荐码与已有推荐码重复，由用例重试
+	ErrReferralCodeConflict = errors.Conflict(v1.ErrorCode_SERVER_ERROR.String(), "referral code conflict")
+)
+
+// 推荐记录状态
+const (
+	ReferralStatusPending   int32 = 0 // 已注册，尚未激活
+	ReferralStatusActivated int32 = 1 // 被推荐人已发布首个视频
+	ReferralStatusFlagged   int32 = 2 // 命中防作弊规则，不计入统计和排行
+)
+
+// 推荐作弊标记原因
+const (
+	ReferralFlagSelfReferral  = "self_referral"
+	ReferralFlagDeviceCluster = "device_cluster"
+	ReferralFlagIPCluster     = "ip_cluster"
+)
+
+const (
+	// 推荐码去掉了容易混淆的 0/O、1/I/L
+	referralCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+	referralCodeLength   = 8
+	referralCodeAttempts = 3
+
+	defaultReferralClusterWindow = 30 * 24 * time.Hour
+	defaultLeaderboardDays       = 30
+	maxLeaderboardDays           = 365
+	defaultLeaderboardLimit      = 10
+	maxLeaderboardLimit          = 50
+)
+
+// ReferralCode 用户的推荐码，记录生成时的IP和设备用于识别自我推荐
+type ReferralCode struct {
+	UserID    int64
+	Code      string
+	IP        string
+	DeviceID  string
+	CreatedAt time.Time
+}
+
+// Referral 一次推荐注册
+type Referral struct {
+	ID          int64
+	ReferrerID  int64
+	RefereeID   int64
+	Code        string
+	IP          string
+	DeviceID    string
+	Status      int32
+	FlagReasons []string
+	ActivatedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// ReferralStats 推荐人的推荐统计，不含被标记的推荐
+type ReferralStats struct {
+	Invited   int64
+	Activated int64
+}
+
+// ReferralRank 推荐排行榜条目
+type ReferralRank struct {
+	UserID    int64
+	Activated int64
+	User      *User
+}
+
+// ReferralClusterStats 窗口内与本次注册相同设备或IP的推荐注册数
+type ReferralClusterStats struct {
+	SameDevice     int64 // 所有推荐人下使用同一设备的注册
+	SameReferrerIP int64 // 同一推荐人下来自同一IP的注册
+}
+
+// ReferralRepo is a Referral repo.
+type ReferralRepo interface {
+	// GetReferralCodeByUser 用户还没有推荐码时返回nil
+	GetReferralCodeByUser(context.Context, int64) (*ReferralCode, error)
+	// GetReferralCode 按推荐码查找，不存在时返回nil
+	GetReferralCode(context.Context, string) (*ReferralCode, error)
+	// CreateReferralCode 用户已有推荐码时回填已有的推荐码，推荐码重复时返回ErrReferralCodeConflict
+	CreateReferralCode(context.Context, *ReferralCode) error
+	CreateReferral(context.Context, *Referral) error
+	CountRecentReferrals(ctx context.Context, referrerID int64, deviceID, ip string, since time.Time) (*ReferralClusterStats, error)
+	// ActivateReferral 将被推荐人待激活的推荐标记为已激活，没有待激活记录时返回false
+	ActivateReferral(context.Context, int64) (bool, error)
+	GetReferralStats(context.Context, int64) (*ReferralStats, error)
+	// ListTopReferrers 按since之后激活的推荐数倒序
+	ListTopReferrers(ctx context.Context, since time.Time, limit int) ([]*ReferralRank, error)
+}
+
+// ReferralUsecase 邀请推荐：每个用户一个推荐码，注册时兑换并记录归因，
+// 被推荐人发布首个视频后激活。自我推荐和同设备、同IP批量注册会被标记，不计入统计
+type ReferralUsecase struct {
+	repo     ReferralRepo
+	userRepo UserRepo
+
+	deviceClusterLimit int64
+	ipClusterLimit     int64
+	clusterWindow      time.Duration
+
+	log *log.Helper
+}
+
+// NewReferralUsecase new a Referral usecase.
+func NewReferralUsecase(repo ReferralRepo, userRepo UserRepo, businessConfig *conf.Business, logger log.Logger) *ReferralUsecase {
+	uc := &ReferralUsecase{
+		repo:          repo,
+		userRepo:      userRepo,
+		clusterWindow: defaultReferralClusterWindow,
+		log:           log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetReferral(); cfg != nil {
+		uc.deviceClusterLimit = int64(cfg.DeviceClusterLimit)
+		uc.ipClusterLimit = int64(cfg.IpClusterLimit)
+		if cfg.ClusterWindow != nil && cfg.ClusterWindow.AsDuration() > 0 {
+			uc.clusterWindow = cfg.ClusterWindow.AsDuration()
+		}
+	}
+
+	return uc
+}
+
+// GetMyReferral 获取用户的推荐码和推荐统计，首次获取时生成推荐码
+func (uc *ReferralUsecase) GetMyReferral(ctx context.Context, userID int64, ip, deviceID string) (*ReferralCode, *ReferralStats, error) {
+	code, err := uc.repo.GetReferralCodeByUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if code == nil {
+		code, err = uc.createCode(ctx, userID, ip, deviceID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	stats, err := uc.repo.GetReferralStats(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return code, stats, nil
+}
+
+// ResolveCode 注册前校验推荐码，未填写时返回nil
+func (uc *ReferralUsecase) ResolveCode(ctx context.Context, code string) (*ReferralCode, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, nil
+	}
+	if len(code) != referralCodeLength {
+		return nil, ErrReferralCodeInvalid
+	}
+
+	referralCode, err := uc.repo.GetReferralCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if referralCode == nil {
+		return nil, ErrReferralCodeInvalid
+	}
+
+	return referralCode, nil
+}
+
+// Redeem 账号创建成功后记录推荐归因，命中防作弊规则的推荐标记后仍然记录。
+// 记录失败只打日志，不影响已完成的注册
+func (uc *ReferralUsecase) Redeem(ctx context.Context, code *ReferralCode, refereeID int64, ip, deviceID string) {
+	referral := &Referral{
+		ReferrerID: code.UserID,
+		RefereeID:  refereeID,
+		Code:       code.Code,
+		IP:         ip,
+		DeviceID:   deviceID,
+		Status:     ReferralStatusPending,
+	}
+
+	reasons, err := uc.checkAbuse(ctx, code, referral)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("check referral abuse failed: referrer=%d referee=%d err=%v", code.UserID, refereeID, err)
+	}
+	if len(reasons) > 0 {
+		referral.Status = ReferralStatusFlagged
+		referral.FlagReasons = reasons
+		uc.log.WithContext(ctx).Infof("referral flagged: referrer=%d referee=%d reasons=%v", code.UserID, refereeID, reasons)
+	}
+
+	if err := uc.repo.CreateReferral(ctx, referral); err != nil {
+		uc.log.WithContext(ctx).Warnf("create referral failed: referrer=%d referee=%d err=%v", code.UserID, refereeID, err)
+	}
+}
+
+// Activate 被推荐人发布视频后激活推荐，没有待激活的推荐时不做任何事
+func (uc *ReferralUsecase) Activate(ctx context.Context, refereeID int64) {
+	activated, err := uc.repo.ActivateReferral(ctx, refereeID)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("activate referral failed: referee=%d err=%v", refereeID, err)
+		return
+	}
+	if activated {
+		uc.log.WithContext(ctx).Infof("referral activated: referee=%d", refereeID)
+	}
+}
+
+// GetLeaderboard 获取最近若干天激活推荐数最多的用户
+func (uc *ReferralUsecase) GetLeaderboard(ctx context.Context, days, limit int32) ([]*ReferralRank, error) {
+	if days <= 0 {
+		days = defaultLeaderboardDays
+	}
+	if days > maxLeaderboardDays {
+		days = maxLeaderboardDays
+	}
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+
+	since := time.Now().AddDate(0, 0, -int(days))
+	ranks, err := uc.repo.ListTopReferrers(ctx, since, int(limit))
+	if err != nil {
+		return nil, err
+	}
+	if len(ranks) == 0 {
+		return ranks, nil
+	}
+
+	userIDs := make([]int64, 0, len(ranks))
+	for _, rank := range ranks {
+		userIDs = append(userIDs, rank.UserID)
+	}
+	users, err := uc.userRepo.GetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	userMap := make(map[int64]*User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	// 已注销的用户不上榜
+	result := make([]*ReferralRank, 0, len(ranks))
+	for _, rank := range ranks {
+		if user, ok := userMap[rank.UserID]; ok {
+			rank.User = user
+			result = append(result, rank)
+		}
+	}
+
+	return result, nil
+}
+
+// checkAbuse 检查自我推荐和批量注册。推荐人领取推荐码时的设备与注册设备相同视为自我推荐；
+// 同一设备或同一推荐人下同一IP的注册数达到上限后，后续注册被标记
+func (uc *ReferralUsecase) checkAbuse(ctx context.Context, code *ReferralCode, referral *Referral) ([]string, error) {
+	var reasons []string
+	if code.UserID == referral.RefereeID || (code.DeviceID != "" && code.DeviceID == referral.DeviceID) {
+		reasons = append(reasons, ReferralFlagSelfReferral)
+	}
+
+	if uc.deviceClusterLimit <= 0 && uc.ipClusterLimit <= 0 {
+		return reasons, nil
+	}
+
+	stats, err := uc.repo.CountRecentReferrals(ctx, code.UserID, referral.DeviceID, referral.IP, time.Now().Add(-uc.clusterWindow))
+	if err != nil {
+		return reasons, err
+	}
+
+	if uc.deviceClusterLimit > 0 && referral.DeviceID != "" && stats.SameDevice >= uc.deviceClusterLimit {
+		reasons = append(reasons, ReferralFlagDeviceCluster)
+	}
+	if uc.ipClusterLimit > 0 && referral.IP != "" && stats.SameReferrerIP >= uc.ipClusterLimit {
+		reasons = append(reasons, ReferralFlagIPCluster)
+	}
+
+	return reasons, nil
+}
+
+// createCode 生成推荐码，与已有推荐码重复时重新生成
+func (uc *ReferralUsecase) createCode(ctx context.Context, userID int64, ip, deviceID string) (*ReferralCode, error) {
+	for attempt := 0; ; attempt++ {
+		value, err := generateReferralCode()
+		if err != nil {
+			return nil, err
+		}
+
+		code := &ReferralCode{
+			UserID:   userID,
+			Code:     value,
+			IP:       ip,
+			DeviceID: deviceID,
+		}
+		err = uc.repo.CreateReferralCode(ctx, code)
+		if err == nil {
+			return code, nil
+		}
+		if !errors.Is(err, ErrReferralCodeConflict) || attempt+1 >= referralCodeAttempts {
+			return nil, err
+		}
+	}
+}
+
+func generateReferralCode() (string, error) {
+	limit := big.NewInt(int64(len(referralCodeAlphabet)))
+	var sb strings.Builder
+	for i := 0; i < referralCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(referralCodeAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
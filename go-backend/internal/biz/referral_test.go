@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newReferralTestUsecase(t *testing.T) (*ReferralUsecase, *MockReferralRepo, *MockUserRepo) {
+	repo := NewMockReferralRepo(t)
+	userRepo := NewMockUserRepo(t)
+	config := &conf.Business{Referral: &conf.Business_Referral{DeviceClusterLimit: 1, IpClusterLimit: 3}}
+	return NewReferralUsecase(repo, userRepo, config, log.DefaultLogger), repo, userRepo
+}
+
+func TestReferralUsecase_GetMyReferral(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Existing", func(t *testing.T) {
+		uc, repo, _ := newReferralTestUsecase(t)
+		repo.EXPECT().GetReferralCodeByUser(ctx, int64(1)).Return(&ReferralCode{UserID: 1, Code: "ABCD2345"}, nil)
+		repo.EXPECT().GetReferralStats(ctx, int64(1)).Return(&ReferralStats{Invited: 3, Activated: 1}, nil)
+
+		code, stats, err := uc.GetMyReferral(ctx, 1, "1.1.1.1", "dev")
+		require.NoError(t, err)
+		assert.Equal(t, "ABCD2345", code.Code)
+		assert.Equal(t, int64(3), stats.Invited)
+	})
+
+	t.Run("RetryOnConflict", func(t *testing.T) {
+		uc, repo, _ := newReferralTestUsecase(t)
+		repo.EXPECT().GetReferralCodeByUser(ctx, int64(1)).Return(nil, nil)
+		repo.EXPECT().CreateReferralCode(ctx, mock.Anything).Return(ErrReferralCodeConflict).Once()
+		repo.EXPECT().CreateReferralCode(ctx, mock.MatchedBy(func(code *ReferralCode) bool {
+			return code.UserID == 1 && code.IP == "1.1.1.1" && code.DeviceID == "dev"
+		})).Return(nil).Once()
+		repo.EXPECT().GetReferralStats(ctx, int64(1)).Return(&ReferralStats{}, nil)
+
+		code, _, err := uc.GetMyReferral(ctx, 1, "1.1.1.1", "dev")
+		require.NoError(t, err)
+		assert.Len(t, code.Code, referralCodeLength)
+		assert.NotContains(t, code.Code, "0")
+	})
+
+	t.Run("GiveUpAfterAttempts", func(t *testing.T) {
+		uc, repo, _ := newReferralTestUsecase(t)
+		repo.EXPECT().GetReferralCodeByUser(ctx, int64(1)).Return(nil, nil)
+		repo.EXPECT().CreateReferralCode(ctx, mock.Anything).Return(ErrReferralCodeConflict).Times(referralCodeAttempts)
+
+		_, _, err := uc.GetMyReferral(ctx, 1, "", "")
+		assert.ErrorIs(t, err, ErrReferralCodeConflict)
+	})
+}
+
+func TestReferralUsecase_ResolveCode(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, _ := newReferralTestUsecase(t)
+
+	code, err := uc.ResolveCode(ctx, "  ")
+	require.NoError(t, err)
+	assert.Nil(t, code)
+
+	_, err = uc.ResolveCode(ctx, "SHORT")
+	assert.ErrorIs(t, err, ErrReferralCodeInvalid)
+
+	repo.EXPECT().GetReferralCode(ctx, "ABCD2345").Return(&ReferralCode{UserID: 1, Code: "ABCD2345"}, nil).Once()
+	code, err = uc.ResolveCode(ctx, "abcd2345")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), code.UserID)
+
+	repo.EXPECT().GetReferralCode(ctx, "ZZZZ2345").Return(nil, nil).Once()
+	_, err = uc.ResolveCode(ctx, "ZZZZ2345")
+	assert.ErrorIs(t, err, ErrReferralCodeInvalid)
+}
+
+func TestReferralUsecase_Redeem(t *testing.T) {
+	ctx := context.Background()
+	code := &ReferralCode{UserID: 1, Code: "ABCD2345", DeviceID: "referrer-dev"}
+
+	tests := []struct {
+		name     string
+		deviceID string
+		stats    *ReferralClusterStats
+		statsErr error
+		status   int32
+		reasons  []string
+	}{
+		{name: "Clean", deviceID: "dev-a", stats: &ReferralClusterStats{}, status: ReferralStatusPending},
+		{name: "SameDeviceAsReferrer", deviceID: "referrer-dev", stats: &ReferralClusterStats{}, status: ReferralStatusFlagged, reasons: []string{ReferralFlagSelfReferral}},
+		{name: "DeviceCluster", deviceID: "dev-a", stats: &ReferralClusterStats{SameDevice: 1}, status: ReferralStatusFlagged, reasons: []string{ReferralFlagDeviceCluster}},
+		{name: "IPCluster", deviceID: "dev-a", stats: &ReferralClusterStats{SameReferrerIP: 3}, status: ReferralStatusFlagged, reasons: []string{ReferralFlagIPCluster}},
+		{name: "CountFailed", deviceID: "dev-a", statsErr: errors.New("db down"), status: ReferralStatusPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _ := newReferralTestUsecase(t)
+			repo.EXPECT().CountRecentReferrals(ctx, int64(1), tt.deviceID, "2.2.2.2", mock.Anything).Return(tt.stats, tt.statsErr)
+			repo.EXPECT().CreateReferral(ctx, mock.MatchedBy(func(r *Referral) bool {
+				return r.ReferrerID == 1 && r.RefereeID == 2 && r.Status == tt.status && assert.ObjectsAreEqual(tt.reasons, r.FlagReasons)
+			})).Return(nil)
+
+			uc.Redeem(ctx, code, 2, "2.2.2.2", tt.deviceID)
+		})
+	}
+}
+
+func TestReferralUsecase_GetLeaderboard(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, userRepo := newReferralTestUsecase(t)
+
+	repo.EXPECT().ListTopReferrers(ctx, mock.MatchedBy(func(since time.Time) bool {
+		return time.Since(since) > 364*24*time.Hour && time.Since(since) < 366*24*time.Hour
+	}), maxLeaderboardLimit).Return([]*ReferralRank{
+		{UserID: 1, Activated: 5},
+		{UserID: 2, Activated: 3},
+	}, nil)
+	userRepo.EXPECT().GetUsers(ctx, []int64{1, 2}).Return([]*User{{ID: 1, Username: "alice"}}, nil)
+
+	ranks, err := uc.GetLeaderboard(ctx, 1000, 1000)
+	require.NoError(t, err)
+	require.Len(t, ranks, 1)
+	assert.Equal(t, "alice", ranks[0].User.Username)
+	assert.Equal(t, int64(5), ranks[0].Activated)
+}
@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrRegisterLimited        = errors.New(429, v1.ErrorCode_REGISTER_LIMITED.String(), "too many registrations from this IP today")
+	ErrContactRequired        = errors.BadRequest(v1.ErrorCode_CONTACT_REQUIRED.String(), "email or phone is required")
+	ErrRegistrationNotPending = errors.Conflict(v1.ErrorCode_REGISTRATION_NOT_PENDING.String(), "registration is not pending review")
+)
+
+// 可疑注册标记原因
+const (
+	FlagReasonSequentialUsername = "sequential_username"
+	FlagReasonRandomUsername     = "random_username"
+	FlagReasonHighVolumeIP       = "high_volume_ip"
+)
+
+// 可疑注册审核状态
+const (
+	RegistrationStatusPending  int32 = 0
+	RegistrationStatusApproved int32 = 1
+	RegistrationStatusRejected int32 = 2
+)
+
+// FlaggedRegistration 被标记为可疑、等待管理员审核的注册
+type FlaggedRegistration struct {
+	ID         int64
+	UserID     int64
+	Username   string
+	IP         string
+	Reasons    []string
+	Contact    string
+	Status     int32
+	ReviewerID int64
+	ReviewedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// RegistrationStats 单个IP当日的注册统计
+type RegistrationStats struct {
+	Count        int64
+	LastUsername string
+}
+
+// RegistrationCheck 注册前检查的结果，注册成功后交给Record记录
+type RegistrationCheck struct {
+	IP      string
+	Contact string
+	Reasons []string
+}
+
+// RegistrationRepo is a Registration repo.
+type RegistrationRepo interface {
+	// GetRegistrationStats 获取IP当日的注册统计，没有记录时返回零值
+	GetRegistrationStats(context.Context, string) (*RegistrationStats, error)
+	// RecordRegistration 累加IP当日注册数并记录最近注册的用户名
+	RecordRegistration(context.Context, string, string) error
+	CreateFlaggedRegistration(context.Context, *FlaggedRegistration) error
+	// ListFlaggedRegistrations 分页获取待审核的可疑注册，按注册时间正序
+	ListFlaggedRegistrations(context.Context, int32, int32) ([]*FlaggedRegistration, int64, error)
+	// ReviewFlaggedRegistration 更新待审核记录的状态并回填用户ID，拒绝时同时禁用对应账号；
+	// 记录不处于待审核状态时返回ErrRegistrationNotPending
+	ReviewFlaggedRegistration(context.Context, *FlaggedRegistration) error
+}
+
+// RegistrationUsecase 注册防滥用：单IP每日注册上限、超过阈值后要求联系方式、
+// 识别连号和随机用户名，并将可疑注册放入管理员审核队列
+type RegistrationUsecase struct {
+	repo         RegistrationRepo
+	permissionUc *PermissionUsecase
+	authUc       *AuthUsecase
+
+	dailyIPLimit         int64
+	contactRequiredAfter int64
+
+	log *log.Helper
+}
+
+// NewRegistrationUsecase new a Registration usecase.
+func NewRegistrationUsecase(
+	repo RegistrationRepo,
+	permissionUc *PermissionUsecase,
+	authUc *AuthUsecase,
+	businessConfig *conf.Business,
+	logger log.Logger,
+) *RegistrationUsecase {
+	uc := &RegistrationUsecase{
+		repo:         repo,
+		permissionUc: permissionUc,
+		authUc:       authUc,
+		log:          log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetRegistration(); cfg != nil {
+		uc.dailyIPLimit = int64(cfg.DailyIpLimit)
+		uc.contactRequiredAfter = int64(cfg.ContactRequiredAfter)
+	}
+
+	return uc
+}
+
+// Check 在创建账号前检查注册请求。IP当日注册数达到上限时拒绝，超过阈值时要求邮箱或手机号，
+// 并根据用户名模式给出标记原因。检查与记录不是原子的，并发注册可能略微超出上限，
+// 由注册接口的IP限流兜底
+func (uc *RegistrationUsecase) Check(ctx context.Context, ip, username, email, phone string) (*RegistrationCheck, error) {
+	check := &RegistrationCheck{IP: ip}
+
+	if email != "" {
+		if err := security.ValidateEmail(email); err != nil {
+			return nil, errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), err.Error())
+		}
+		check.Contact = email
+	} else if phone != "" {
+		if err := security.ValidatePhone(phone); err != nil {
+			return nil, errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), err.Error())
+		}
+		check.Contact = phone
+	}
+
+	if security.LooksRandomUsername(username) {
+		check.Reasons = append(check.Reasons, FlagReasonRandomUsername)
+	}
+
+	// 无法识别来源IP时（如内部gRPC调用）只做用户名检查
+	if ip == "" {
+		return check, nil
+	}
+
+	stats, err := uc.repo.GetRegistrationStats(ctx, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.dailyIPLimit > 0 && stats.Count >= uc.dailyIPLimit {
+		uc.log.WithContext(ctx).Warnf("registration limited: ip=%s count=%d", ip, stats.Count)
+		return nil, ErrRegisterLimited
+	}
+
+	if uc.contactRequiredAfter > 0 && stats.Count >= uc.contactRequiredAfter {
+		if check.Contact == "" {
+			return nil, ErrContactRequired
+		}
+		check.Reasons = append(check.Reasons, FlagReasonHighVolumeIP)
+	}
+
+	if security.IsSequentialUsername(stats.LastUsername, username) {
+		check.Reasons = append(check.Reasons, FlagReasonSequentialUsername)
+	}
+
+	return check, nil
+}
+
+// Record 账号创建成功后记录注册，带标记原因的注册进入审核队列。
+// 记录失败只打日志，不影响已完成的注册
+func (uc *RegistrationUsecase) Record(ctx context.Context, check *RegistrationCheck, user *User) {
+	if check.IP != "" {
+		if err := uc.repo.RecordRegistration(ctx, check.IP, user.Username); err != nil {
+			uc.log.WithContext(ctx).Warnf("record registration failed: ip=%s err=%v", check.IP, err)
+		}
+	}
+
+	if len(check.Reasons) == 0 {
+		return
+	}
+
+	uc.log.WithContext(ctx).Infof("registration flagged: user=%d ip=%s reasons=%v", user.ID, check.IP, check.Reasons)
+	if err := uc.repo.CreateFlaggedRegistration(ctx, &FlaggedRegistration{
+		UserID:   user.ID,
+		Username: user.Username,
+		IP:       check.IP,
+		Reasons:  check.Reasons,
+		Contact:  check.Contact,
+		Status:   RegistrationStatusPending,
+	}); err != nil {
+		uc.log.WithContext(ctx).Warnf("create flagged registration failed: user=%d err=%v", user.ID, err)
+	}
+}
+
+// ListFlaggedRegistrations lists registrations waiting for admin review.
+func (uc *RegistrationUsecase) ListFlaggedRegistrations(ctx context.Context, adminID int64, page, size int32) ([]*FlaggedRegistration, int64, error) {
+	if err := uc.checkAdmin(ctx, adminID); err != nil {
+		return nil, 0, err
+	}
+
+	page, size = NormalizePage(page, size)
+	return uc.repo.ListFlaggedRegistrations(ctx, page, size)
+}
+
+// ReviewRegistration approves or rejects a flagged registration. Rejection disables the account and revokes its session.
+func (uc *RegistrationUsecase) ReviewRegistration(ctx context.Context, adminID, registrationID int64, action int32) error {
+	if err := uc.checkAdmin(ctx, adminID); err != nil {
+		return err
+	}
+
+	var status int32
+	switch action {
+	case AuditActionApprove:
+		status = RegistrationStatusApproved
+	case AuditActionReject:
+		status = RegistrationStatusRejected
+	default:
+		return ErrInvalidAuditAction
+	}
+
+	uc.log.WithContext(ctx).Infof("Admin %d reviews registration %d: status=%d", adminID, registrationID, status)
+
+	registration := &FlaggedRegistration{
+		ID:         registrationID,
+		Status:     status,
+		ReviewerID: adminID,
+	}
+	if err := uc.repo.ReviewFlaggedRegistration(ctx, registration); err != nil {
+		return err
+	}
+
+	if status == RegistrationStatusRejected {
+		if err := uc.authUc.RevokeAllUserTokens(ctx, registration.UserID); err != nil {
+			uc.log.WithContext(ctx).Warnf("revoke tokens of rejected user %d failed: %v", registration.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+func (uc *RegistrationUsecase) checkAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type registrationTestDeps struct {
+	repo       *MockRegistrationRepo
+	roleRepo   *MockRoleRepo
+	sessionMgr auth.SessionManager
+	uc         *RegistrationUsecase
+}
+
+func newRegistrationTestDeps(t *testing.T) *registrationTestDeps {
+	repo := NewMockRegistrationRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	sessionMgr := auth.NewMemorySessionManager()
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+	authUc := NewAuthUsecase(nil, nil, nil, sessionMgr, nil, nil, nil, &conf.Business{}, clock.New(), log.DefaultLogger)
+
+	businessConfig := &conf.Business{
+		Registration: &conf.Business_Registration{
+			DailyIpLimit:         5,
+			ContactRequiredAfter: 2,
+		},
+	}
+
+	return &registrationTestDeps{
+		repo:       repo,
+		roleRepo:   roleRepo,
+		sessionMgr: sessionMgr,
+		uc:         NewRegistrationUsecase(repo, permissionUc, authUc, businessConfig, log.DefaultLogger),
+	}
+}
+
+// expectAdmin 模拟用户是否为管理员
+func (d *registrationTestDeps) expectAdmin(ctx context.Context, userID int64, isAdmin bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(isAdmin, nil)
+}
+
+func TestRegistrationUsecase_Check(t *testing.T) {
+	ctx := context.Background()
+	const ip = "203.0.113.7"
+
+	t.Run("FirstRegistration", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.repo.EXPECT().GetRegistrationStats(ctx, ip).Return(&RegistrationStats{}, nil)
+
+		check, err := d.uc.Check(ctx, ip, "alice", "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, ip, check.IP)
+		assert.Empty(t, check.Reasons)
+	})
+
+	t.Run("DailyLimitReached", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.repo.EXPECT().GetRegistrationStats(ctx, ip).Return(&RegistrationStats{Count: 5}, nil)
+
+		_, err := d.uc.Check(ctx, ip, "alice", "alice@example.com", "")
+
+		assert.Equal(t, ErrRegisterLimited, err)
+	})
+
+	t.Run("ContactRequired", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.repo.EXPECT().GetRegistrationStats(ctx, ip).Return(&RegistrationStats{Count: 2}, nil)
+
+		_, err := d.uc.Check(ctx, ip, "alice", "", "")
+
+		assert.Equal(t, ErrContactRequired, err)
+	})
+
+	t.Run("ContactProvidedAfterThreshold", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.repo.EXPECT().GetRegistrationStats(ctx, ip).Return(&RegistrationStats{Count: 2}, nil)
+
+		check, err := d.uc.Check(ctx, ip, "alice", "", "13812345678")
+
+		require.NoError(t, err)
+		assert.Equal(t, "13812345678", check.Contact)
+		assert.Equal(t, []string{FlagReasonHighVolumeIP}, check.Reasons)
+	})
+
+	t.Run("InvalidEmail", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+
+		_, err := d.uc.Check(ctx, ip, "alice", "not-an-email", "")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("SequentialUsername", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.repo.EXPECT().GetRegistrationStats(ctx, ip).Return(&RegistrationStats{Count: 1, LastUsername: "bot_001"}, nil)
+
+		check, err := d.uc.Check(ctx, ip, "bot_002", "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{FlagReasonSequentialUsername}, check.Reasons)
+	})
+
+	t.Run("RandomUsernameWithoutIP", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+
+		check, err := d.uc.Check(ctx, "", "a8f3k2m9x", "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{FlagReasonRandomUsername}, check.Reasons)
+	})
+}
+
+func TestRegistrationUsecase_Record(t *testing.T) {
+	ctx := context.Background()
+	user := &User{ID: 10, Username: "bot_002"}
+
+	t.Run("NotFlagged", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.repo.EXPECT().RecordRegistration(ctx, "203.0.113.7", "bot_002").Return(nil)
+
+		d.uc.Record(ctx, &RegistrationCheck{IP: "203.0.113.7"}, user)
+	})
+
+	t.Run("Flagged", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.repo.EXPECT().RecordRegistration(ctx, "203.0.113.7", "bot_002").Return(nil)
+		d.repo.EXPECT().CreateFlaggedRegistration(ctx, mock.MatchedBy(func(r *FlaggedRegistration) bool {
+			return r.UserID == 10 && r.Status == RegistrationStatusPending &&
+				assert.ObjectsAreEqual([]string{FlagReasonSequentialUsername}, r.Reasons)
+		})).Return(nil)
+
+		d.uc.Record(ctx, &RegistrationCheck{IP: "203.0.113.7", Reasons: []string{FlagReasonSequentialUsername}}, user)
+	})
+}
+
+func TestRegistrationUsecase_ReviewRegistration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.expectAdmin(ctx, 5, false)
+
+		err := d.uc.ReviewRegistration(ctx, 5, 1, AuditActionReject)
+
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.expectAdmin(ctx, 5, true)
+
+		err := d.uc.ReviewRegistration(ctx, 5, 1, 3)
+
+		assert.Equal(t, ErrInvalidAuditAction, err)
+	})
+
+	t.Run("RejectRevokesSession", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.expectAdmin(ctx, 5, true)
+		_, err := d.sessionMgr.CreateSession(ctx, 10, "refresh-token", "family", time.Hour)
+		require.NoError(t, err)
+
+		d.repo.EXPECT().ReviewFlaggedRegistration(ctx, mock.MatchedBy(func(r *FlaggedRegistration) bool {
+			return r.ID == 1 && r.Status == RegistrationStatusRejected && r.ReviewerID == 5
+		})).RunAndReturn(func(_ context.Context, r *FlaggedRegistration) error {
+			r.UserID = 10
+			return nil
+		})
+
+		require.NoError(t, d.uc.ReviewRegistration(ctx, 5, 1, AuditActionReject))
+
+		_, err = d.sessionMgr.GetSession(ctx, 10)
+		assert.ErrorIs(t, err, auth.ErrSessionNotFound)
+	})
+
+	t.Run("NotPending", func(t *testing.T) {
+		d := newRegistrationTestDeps(t)
+		d.expectAdmin(ctx, 5, true)
+		d.repo.EXPECT().ReviewFlaggedRegistration(ctx, mock.Anything).Return(ErrRegistrationNotPending)
+
+		err := d.uc.ReviewRegistration(ctx, 5, 1, AuditActionApprove)
+
+		assert.Equal(t, ErrRegistrationNotPending, err)
+	})
+}
@@ -2,8 +2,10 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	v1 "go-backend/api/common/v1"
+	"go-backend/internal/domain"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -12,38 +14,183 @@ import (
 var (
 	ErrAlreadyFollow = errors.BadRequest(v1.ErrorCode_ALREADY_FOLLOW.String(), "already followed")
 	ErrNotFollow     = errors.BadRequest(v1.ErrorCode_NOT_FOLLOW.String(), "not followed")
+
+	ErrFollowRequestPending  = errors.BadRequest(v1.ErrorCode_FOLLOW_REQUEST_PENDING.String(), "follow request already pending")
+	ErrFollowRequestNotExist = errors.NotFound(v1.ErrorCode_FOLLOW_REQUEST_NOT_EXIST.String(), "follow request not found")
+	ErrAccountPrivate        = errors.Forbidden(v1.ErrorCode_ACCOUNT_PRIVATE.String(), "account is private")
 )
 
+// FollowRequest 关注私密账号时创建的待处理申请
+type FollowRequest struct {
+	ID          int64
+	RequesterID int64
+	TargetID    int64
+	Requester   *User // 申请人资料，列表查询时填充
+	CreatedAt   time.Time
+}
+
 // RelationRepo is a Relation repo.
 type RelationRepo interface {
 	Follow(context.Context, int64, int64) error
 	Unfollow(context.Context, int64, int64) error
 	IsFollowing(context.Context, int64, int64) (bool, error)
+	BatchIsFollowing(context.Context, int64, []int64) (map[int64]bool, error)
 	GetFollowList(context.Context, int64, int32, int32) ([]*User, int64, error)
 	GetFollowerList(context.Context, int64, int32, int32) ([]*User, int64, error)
 	GetFriendList(context.Context, int64) ([]*User, error)
+	// CreateFollowRequest 创建待处理的关注申请，已有待处理申请时返回 ErrFollowRequestPending，
+	// 之前已处理过的申请重新置为待处理
+	CreateFollowRequest(ctx context.Context, requesterID, targetID int64) error
+	// ListFollowRequests 按申请时间倒序分页列出用户收到的待处理申请
+	ListFollowRequests(ctx context.Context, targetID int64, page, size int32) ([]*FollowRequest, int64, error)
+	// ApproveFollowRequest 通过申请并建立关注关系，没有待处理申请时返回 ErrFollowRequestNotExist
+	ApproveFollowRequest(ctx context.Context, targetID, requesterID int64) error
+	// RejectFollowRequest 拒绝申请，没有待处理申请时返回 ErrFollowRequestNotExist
+	RejectFollowRequest(ctx context.Context, targetID, requesterID int64) error
 }
 
 // RelationUsecase is a Relation usecase.
 type RelationUsecase struct {
-	repo RelationRepo
-	log  *log.Helper
+	repo     RelationRepo
+	userRepo UserRepo
+	log      *log.Helper
 }
 
 // NewRelationUsecase new a Relation usecase.
-func NewRelationUsecase(repo RelationRepo, logger log.Logger) *RelationUsecase {
-	return &RelationUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewRelationUsecase(repo RelationRepo, userRepo UserRepo, logger log.Logger) *RelationUsecase {
+	return &RelationUsecase{repo: repo, userRepo: userRepo, log: log.NewHelper(logger)}
 }
 
-// Follow follows a user.
-func (uc *RelationUsecase) Follow(ctx context.Context, userID, followUserID int64) error {
+// Follow follows a user. 对方为私密账号时创建关注申请而不是关注关系，requested 为 true
+func (uc *RelationUsecase) Follow(ctx context.Context, userID, followUserID int64) (requested bool, err error) {
 	uc.log.WithContext(ctx).Infof("User %d follows user %d", userID, followUserID)
 
 	if userID == followUserID {
-		return errors.BadRequest("INVALID_FOLLOW", "cannot follow yourself")
+		return false, errors.BadRequest("INVALID_FOLLOW", "cannot follow yourself")
+	}
+
+	target, err := uc.userRepo.GetUser(ctx, followUserID)
+	if err != nil {
+		return false, err
+	}
+	if !target.IsPrivate {
+		return false, uc.repo.Follow(ctx, userID, followUserID)
+	}
+
+	following, err := uc.repo.IsFollowing(ctx, userID, followUserID)
+	if err != nil {
+		return false, err
+	}
+	if following {
+		return false, ErrAlreadyFollow
+	}
+	if err := uc.repo.CreateFollowRequest(ctx, userID, followUserID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListFollowRequests 获取用户收到的待处理关注申请
+func (uc *RelationUsecase) ListFollowRequests(ctx context.Context, userID int64, page, size int32) ([]*FollowRequest, int64, error) {
+	page, size = NormalizePage(page, size)
+	return uc.repo.ListFollowRequests(ctx, userID, page, size)
+}
+
+// ApproveFollowRequest 通过 requesterID 发给 userID 的关注申请
+func (uc *RelationUsecase) ApproveFollowRequest(ctx context.Context, userID, requesterID int64) error {
+	uc.log.WithContext(ctx).Infof("User %d approves follow request from user %d", userID, requesterID)
+	return uc.repo.ApproveFollowRequest(ctx, userID, requesterID)
+}
+
+// RejectFollowRequest 拒绝 requesterID 发给 userID 的关注申请
+func (uc *RelationUsecase) RejectFollowRequest(ctx context.Context, userID, requesterID int64) error {
+	uc.log.WithContext(ctx).Infof("User %d rejects follow request from user %d", userID, requesterID)
+	return uc.repo.RejectFollowRequest(ctx, userID, requesterID)
+}
+
+// CanViewContent 访问者能否查看用户的作品和计数：公开账号、本人或私密账号的粉丝
+func CanViewContent(owner *User, viewerID int64, isFollow bool) bool {
+	return !owner.IsPrivate || owner.ID == viewerID || isFollow
+}
+
+// RestrictedUser 私密账号对非粉丝展示的资料，保留昵称头像等基本信息，计数清零
+func RestrictedUser(user *User) *User {
+	c := *user
+	c.FollowCount = 0
+	c.FollowerCount = 0
+	c.TotalFavorited = 0
+	c.WorkCount = 0
+	c.FavoriteCount = 0
+	return &c
+}
+
+// CheckVisible 检查访问者能否查看用户的作品，不可见时返回 ErrAccountPrivate。viewerID 为0表示未登录访问
+func (uc *RelationUsecase) CheckVisible(ctx context.Context, viewerID int64, owner *User) error {
+	if CanViewContent(owner, viewerID, false) {
+		return nil
+	}
+	if viewerID == 0 {
+		return ErrAccountPrivate
+	}
+	following, err := uc.repo.IsFollowing(ctx, viewerID, owner.ID)
+	if err != nil {
+		return err
 	}
+	if !following {
+		return ErrAccountPrivate
+	}
+	return nil
+}
 
-	return uc.repo.Follow(ctx, userID, followUserID)
+// FilterVisibleVideos 过滤访问者无权查看的私密账号作品和按可见范围不可见的视频，用于视频流等混合多个作者的列表
+func (uc *RelationUsecase) FilterVisibleVideos(ctx context.Context, viewerID int64, videos []*domain.Video) ([]*domain.Video, error) {
+	if len(videos) == 0 {
+		return videos, nil
+	}
+	videos, err := uc.FilterVideosByVisibility(ctx, viewerID, videos)
+	if err != nil || len(videos) == 0 {
+		return videos, err
+	}
+
+	seen := make(map[int64]bool, len(videos))
+	authorIDs := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		if !seen[v.AuthorID] {
+			seen[v.AuthorID] = true
+			authorIDs = append(authorIDs, v.AuthorID)
+		}
+	}
+	authors, err := uc.userRepo.GetUsers(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var private []int64
+	for _, author := range authors {
+		if !CanViewContent(author, viewerID, false) {
+			private = append(private, author.ID)
+		}
+	}
+	if len(private) == 0 {
+		return videos, nil
+	}
+
+	hidden := make(map[int64]bool, len(private))
+	following, err := uc.BatchIsFollowing(ctx, viewerID, private)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range private {
+		hidden[id] = !following[id]
+	}
+
+	visible := make([]*domain.Video, 0, len(videos))
+	for _, v := range videos {
+		if !hidden[v.AuthorID] {
+			visible = append(visible, v)
+		}
+	}
+	return visible, nil
 }
 
 // Unfollow unfollows a user.
@@ -58,27 +205,24 @@ func (uc *RelationUsecase) IsFollowing(ctx context.Context, userID, followUserID
 	return uc.repo.IsFollowing(ctx, userID, followUserID)
 }
 
-// GetFollowList gets user's follow list.
-func (uc *RelationUsecase) GetFollowList(ctx context.Context, userID int64, page, size int32) ([]*User, int64, error) {
-	if page <= 0 {
-		page = 1
-	}
-	if size <= 0 || size > 50 {
-		size = 20
+// BatchIsFollowing checks follow status of multiple users for a user.
+func (uc *RelationUsecase) BatchIsFollowing(ctx context.Context, userID int64, followUserIDs []int64) (map[int64]bool, error) {
+	if userID <= 0 || len(followUserIDs) == 0 {
+		return map[int64]bool{}, nil
 	}
 
+	return uc.repo.BatchIsFollowing(ctx, userID, followUserIDs)
+}
+
+// GetFollowList gets user's follow list.
+func (uc *RelationUsecase) GetFollowList(ctx context.Context, userID int64, page, size int32) ([]*User, int64, error) {
+	page, size = NormalizePage(page, size)
 	return uc.repo.GetFollowList(ctx, userID, page, size)
 }
 
 // GetFollowerList gets user's follower list.
 func (uc *RelationUsecase) GetFollowerList(ctx context.Context, userID int64, page, size int32) ([]*User, int64, error) {
-	if page <= 0 {
-		page = 1
-	}
-	if size <= 0 || size > 50 {
-		size = 20
-	}
-
+	page, size = NormalizePage(page, size)
 	return uc.repo.GetFollowerList(ctx, userID, page, size)
 }
 
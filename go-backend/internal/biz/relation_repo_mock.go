@@ -21,6 +21,162 @@ func (_m *MockRelationRepo) EXPECT() *MockRelationRepo_Expecter {
 	return &MockRelationRepo_Expecter{mock: &_m.Mock}
 }
 
+// ApproveFollowRequest provides a mock function with given fields: ctx, targetID, requesterID
+func (_m *MockRelationRepo) ApproveFollowRequest(ctx context.Context, targetID int64, requesterID int64) error {
+	ret := _m.Called(ctx, targetID, requesterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveFollowRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, targetID, requesterID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRelationRepo_ApproveFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveFollowRequest'
+type MockRelationRepo_ApproveFollowRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetID int64
+//   - requesterID int64
+func (_e *MockRelationRepo_Expecter) ApproveFollowRequest(ctx interface{}, targetID interface{}, requesterID interface{}) *MockRelationRepo_ApproveFollowRequest_Call {
+	return &MockRelationRepo_ApproveFollowRequest_Call{Call: _e.mock.On("ApproveFollowRequest", ctx, targetID, requesterID)}
+}
+
+func (_c *MockRelationRepo_ApproveFollowRequest_Call) Run(run func(ctx context.Context, targetID int64, requesterID int64)) *MockRelationRepo_ApproveFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepo_ApproveFollowRequest_Call) Return(_a0 error) *MockRelationRepo_ApproveFollowRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRelationRepo_ApproveFollowRequest_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockRelationRepo_ApproveFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchIsFollowing provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockRelationRepo) BatchIsFollowing(_a0 context.Context, _a1 int64, _a2 []int64) (map[int64]bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchIsFollowing")
+	}
+
+	var r0 map[int64]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) (map[int64]bool, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) map[int64]bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRelationRepo_BatchIsFollowing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchIsFollowing'
+type MockRelationRepo_BatchIsFollowing_Call struct {
+	*mock.Call
+}
+
+// BatchIsFollowing is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 []int64
+func (_e *MockRelationRepo_Expecter) BatchIsFollowing(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockRelationRepo_BatchIsFollowing_Call {
+	return &MockRelationRepo_BatchIsFollowing_Call{Call: _e.mock.On("BatchIsFollowing", _a0, _a1, _a2)}
+}
+
+func (_c *MockRelationRepo_BatchIsFollowing_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 []int64)) *MockRelationRepo_BatchIsFollowing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepo_BatchIsFollowing_Call) Return(_a0 map[int64]bool, _a1 error) *MockRelationRepo_BatchIsFollowing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRelationRepo_BatchIsFollowing_Call) RunAndReturn(run func(context.Context, int64, []int64) (map[int64]bool, error)) *MockRelationRepo_BatchIsFollowing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFollowRequest provides a mock function with given fields: ctx, requesterID, targetID
+func (_m *MockRelationRepo) CreateFollowRequest(ctx context.Context, requesterID int64, targetID int64) error {
+	ret := _m.Called(ctx, requesterID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFollowRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, requesterID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRelationRepo_CreateFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFollowRequest'
+type MockRelationRepo_CreateFollowRequest_Call struct {
+	*mock.Call
+}
+
+// CreateFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterID int64
+//   - targetID int64
+func (_e *MockRelationRepo_Expecter) CreateFollowRequest(ctx interface{}, requesterID interface{}, targetID interface{}) *MockRelationRepo_CreateFollowRequest_Call {
+	return &MockRelationRepo_CreateFollowRequest_Call{Call: _e.mock.On("CreateFollowRequest", ctx, requesterID, targetID)}
+}
+
+func (_c *MockRelationRepo_CreateFollowRequest_Call) Run(run func(ctx context.Context, requesterID int64, targetID int64)) *MockRelationRepo_CreateFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepo_CreateFollowRequest_Call) Return(_a0 error) *MockRelationRepo_CreateFollowRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRelationRepo_CreateFollowRequest_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockRelationRepo_CreateFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Follow provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockRelationRepo) Follow(_a0 context.Context, _a1 int64, _a2 int64) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -322,6 +478,122 @@ func (_c *MockRelationRepo_IsFollowing_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListFollowRequests provides a mock function with given fields: ctx, targetID, page, size
+func (_m *MockRelationRepo) ListFollowRequests(ctx context.Context, targetID int64, page int32, size int32) ([]*FollowRequest, int64, error) {
+	ret := _m.Called(ctx, targetID, page, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowRequests")
+	}
+
+	var r0 []*FollowRequest
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]*FollowRequest, int64, error)); ok {
+		return rf(ctx, targetID, page, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []*FollowRequest); ok {
+		r0 = rf(ctx, targetID, page, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*FollowRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, targetID, page, size)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
+		r2 = rf(ctx, targetID, page, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRelationRepo_ListFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowRequests'
+type MockRelationRepo_ListFollowRequests_Call struct {
+	*mock.Call
+}
+
+// ListFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetID int64
+//   - page int32
+//   - size int32
+func (_e *MockRelationRepo_Expecter) ListFollowRequests(ctx interface{}, targetID interface{}, page interface{}, size interface{}) *MockRelationRepo_ListFollowRequests_Call {
+	return &MockRelationRepo_ListFollowRequests_Call{Call: _e.mock.On("ListFollowRequests", ctx, targetID, page, size)}
+}
+
+func (_c *MockRelationRepo_ListFollowRequests_Call) Run(run func(ctx context.Context, targetID int64, page int32, size int32)) *MockRelationRepo_ListFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepo_ListFollowRequests_Call) Return(_a0 []*FollowRequest, _a1 int64, _a2 error) *MockRelationRepo_ListFollowRequests_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRelationRepo_ListFollowRequests_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]*FollowRequest, int64, error)) *MockRelationRepo_ListFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectFollowRequest provides a mock function with given fields: ctx, targetID, requesterID
+func (_m *MockRelationRepo) RejectFollowRequest(ctx context.Context, targetID int64, requesterID int64) error {
+	ret := _m.Called(ctx, targetID, requesterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectFollowRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, targetID, requesterID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRelationRepo_RejectFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectFollowRequest'
+type MockRelationRepo_RejectFollowRequest_Call struct {
+	*mock.Call
+}
+
+// RejectFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetID int64
+//   - requesterID int64
+func (_e *MockRelationRepo_Expecter) RejectFollowRequest(ctx interface{}, targetID interface{}, requesterID interface{}) *MockRelationRepo_RejectFollowRequest_Call {
+	return &MockRelationRepo_RejectFollowRequest_Call{Call: _e.mock.On("RejectFollowRequest", ctx, targetID, requesterID)}
+}
+
+func (_c *MockRelationRepo_RejectFollowRequest_Call) Run(run func(ctx context.Context, targetID int64, requesterID int64)) *MockRelationRepo_RejectFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRelationRepo_RejectFollowRequest_Call) Return(_a0 error) *MockRelationRepo_RejectFollowRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRelationRepo_RejectFollowRequest_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockRelationRepo_RejectFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unfollow provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockRelationRepo) Unfollow(_a0 context.Context, _a1 int64, _a2 int64) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"go-backend/internal/domain"
 	"go-backend/testutils"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -21,26 +22,29 @@ func TestRelationUsecase_Follow(t *testing.T) {
 	t.Run("Follow_Success", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(relationRepo, userRepo, log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
 
+		userRepo.EXPECT().GetUser(ctx, followUserID).Return(&User{ID: followUserID}, nil)
 		relationRepo.EXPECT().Follow(ctx, userID, followUserID).Return(nil)
 
-		err := uc.Follow(ctx, userID, followUserID)
+		requested, err := uc.Follow(ctx, userID, followUserID)
 
 		assert.NoError(t, err)
+		assert.False(t, requested)
 	})
 
 	t.Run("Follow_SelfFollow", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 
-		err := uc.Follow(ctx, userID, userID)
+		_, err := uc.Follow(ctx, userID, userID)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot follow yourself")
@@ -49,14 +53,16 @@ func TestRelationUsecase_Follow(t *testing.T) {
 	t.Run("Follow_AlreadyFollowing", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(relationRepo, userRepo, log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
 
+		userRepo.EXPECT().GetUser(ctx, followUserID).Return(&User{ID: followUserID}, nil)
 		relationRepo.EXPECT().Follow(ctx, userID, followUserID).Return(ErrAlreadyFollow)
 
-		err := uc.Follow(ctx, userID, followUserID)
+		_, err := uc.Follow(ctx, userID, followUserID)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrAlreadyFollow, err)
@@ -65,14 +71,16 @@ func TestRelationUsecase_Follow(t *testing.T) {
 	t.Run("Follow_DatabaseError", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(relationRepo, userRepo, log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
 
+		userRepo.EXPECT().GetUser(ctx, followUserID).Return(&User{ID: followUserID}, nil)
 		relationRepo.EXPECT().Follow(ctx, userID, followUserID).Return(assert.AnError)
 
-		err := uc.Follow(ctx, userID, followUserID)
+		_, err := uc.Follow(ctx, userID, followUserID)
 
 		assert.Error(t, err)
 		assert.Equal(t, assert.AnError, err)
@@ -89,7 +97,7 @@ func TestRelationUsecase_Unfollow(t *testing.T) {
 	t.Run("Unfollow_Success", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
@@ -104,7 +112,7 @@ func TestRelationUsecase_Unfollow(t *testing.T) {
 	t.Run("Unfollow_NotFollowing", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
@@ -120,7 +128,7 @@ func TestRelationUsecase_Unfollow(t *testing.T) {
 	t.Run("Unfollow_DatabaseError", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
@@ -144,7 +152,7 @@ func TestRelationUsecase_IsFollowing(t *testing.T) {
 	t.Run("IsFollowing_True", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
@@ -160,7 +168,7 @@ func TestRelationUsecase_IsFollowing(t *testing.T) {
 	t.Run("IsFollowing_False", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
@@ -176,7 +184,7 @@ func TestRelationUsecase_IsFollowing(t *testing.T) {
 	t.Run("IsFollowing_DatabaseError", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		followUserID := int64(2)
@@ -190,6 +198,34 @@ func TestRelationUsecase_IsFollowing(t *testing.T) {
 	})
 }
 
+func TestRelationUsecase_BatchIsFollowing(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("BatchIsFollowing_Success", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
+
+		relationRepo.EXPECT().BatchIsFollowing(ctx, int64(1), []int64{2, 3}).
+			Return(map[int64]bool{2: true}, nil)
+
+		result, err := uc.BatchIsFollowing(ctx, 1, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.True(t, result[2])
+		assert.False(t, result[3])
+	})
+
+	t.Run("BatchIsFollowing_Anonymous", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
+
+		result, err := uc.BatchIsFollowing(ctx, 0, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
 func TestRelationUsecase_GetFollowList(t *testing.T) {
 	_, cleanup, err := testutils.SetupTestWithCleanup()
 	require.NoError(t, err)
@@ -200,7 +236,7 @@ func TestRelationUsecase_GetFollowList(t *testing.T) {
 	t.Run("GetFollowList_Success", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		page := int32(1)
@@ -226,7 +262,7 @@ func TestRelationUsecase_GetFollowList(t *testing.T) {
 	t.Run("GetFollowList_DefaultPagination", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		page := int32(0) // 应该被修正为1
@@ -247,7 +283,7 @@ func TestRelationUsecase_GetFollowList(t *testing.T) {
 	t.Run("GetFollowList_LargePageSize", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		page := int32(1)
@@ -268,7 +304,7 @@ func TestRelationUsecase_GetFollowList(t *testing.T) {
 	t.Run("GetFollowList_DatabaseError", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		page := int32(1)
@@ -294,7 +330,7 @@ func TestRelationUsecase_GetFollowerList(t *testing.T) {
 	t.Run("GetFollowerList_Success", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		page := int32(1)
@@ -321,7 +357,7 @@ func TestRelationUsecase_GetFollowerList(t *testing.T) {
 	t.Run("GetFollowerList_DefaultPagination", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		page := int32(-1) // 应该被修正为1
@@ -342,7 +378,7 @@ func TestRelationUsecase_GetFollowerList(t *testing.T) {
 	t.Run("GetFollowerList_DatabaseError", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 		page := int32(1)
@@ -368,7 +404,7 @@ func TestRelationUsecase_GetFriendList(t *testing.T) {
 	t.Run("GetFriendList_Success", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 
@@ -391,7 +427,7 @@ func TestRelationUsecase_GetFriendList(t *testing.T) {
 	t.Run("GetFriendList_Empty", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 
@@ -406,7 +442,7 @@ func TestRelationUsecase_GetFriendList(t *testing.T) {
 	t.Run("GetFriendList_DatabaseError", func(t *testing.T) {
 		// 创建独立的mock和usecase
 		relationRepo := NewMockRelationRepo(t)
-		uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 		userID := int64(1)
 
@@ -462,7 +498,7 @@ func TestRelationUsecase_PaginationValidation(t *testing.T) {
 		t.Run(tc.name+"_FollowList", func(t *testing.T) {
 			// 为每个测试用例创建独立的mock
 			relationRepo := NewMockRelationRepo(t)
-			uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+			uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 			relationRepo.EXPECT().GetFollowList(ctx, userID, tc.expectedPage, tc.expectedSize).Return([]*User{}, int64(0), nil)
 
@@ -473,7 +509,7 @@ func TestRelationUsecase_PaginationValidation(t *testing.T) {
 		t.Run(tc.name+"_FollowerList", func(t *testing.T) {
 			// 为每个测试用例创建独立的mock
 			relationRepo := NewMockRelationRepo(t)
-			uc := NewRelationUsecase(relationRepo, log.DefaultLogger)
+			uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
 
 			relationRepo.EXPECT().GetFollowerList(ctx, userID, tc.expectedPage, tc.expectedSize).Return([]*User{}, int64(0), nil)
 
@@ -482,3 +518,112 @@ func TestRelationUsecase_PaginationValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestRelationUsecase_FollowPrivate(t *testing.T) {
+	ctx := context.Background()
+	private := &User{ID: 2, IsPrivate: true}
+
+	t.Run("CreatesRequest", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(relationRepo, userRepo, log.DefaultLogger)
+
+		userRepo.EXPECT().GetUser(ctx, int64(2)).Return(private, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(false, nil)
+		relationRepo.EXPECT().CreateFollowRequest(ctx, int64(1), int64(2)).Return(nil)
+
+		requested, err := uc.Follow(ctx, 1, 2)
+		require.NoError(t, err)
+		assert.True(t, requested)
+	})
+
+	t.Run("AlreadyFollowing", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(relationRepo, userRepo, log.DefaultLogger)
+
+		userRepo.EXPECT().GetUser(ctx, int64(2)).Return(private, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(true, nil)
+
+		_, err := uc.Follow(ctx, 1, 2)
+		assert.Equal(t, ErrAlreadyFollow, err)
+	})
+
+	t.Run("RequestPending", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(relationRepo, userRepo, log.DefaultLogger)
+
+		userRepo.EXPECT().GetUser(ctx, int64(2)).Return(private, nil)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(false, nil)
+		relationRepo.EXPECT().CreateFollowRequest(ctx, int64(1), int64(2)).Return(ErrFollowRequestPending)
+
+		requested, err := uc.Follow(ctx, 1, 2)
+		assert.Equal(t, ErrFollowRequestPending, err)
+		assert.False(t, requested)
+	})
+}
+
+func TestRelationUsecase_CheckVisible(t *testing.T) {
+	ctx := context.Background()
+	relationRepo := NewMockRelationRepo(t)
+	uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
+	private := &User{ID: 2, IsPrivate: true}
+
+	assert.NoError(t, uc.CheckVisible(ctx, 0, &User{ID: 3}))
+	assert.NoError(t, uc.CheckVisible(ctx, 2, private))
+	assert.Equal(t, ErrAccountPrivate, uc.CheckVisible(ctx, 0, private))
+
+	relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(true, nil).Once()
+	assert.NoError(t, uc.CheckVisible(ctx, 1, private))
+	relationRepo.EXPECT().IsFollowing(ctx, int64(4), int64(2)).Return(false, nil).Once()
+	assert.Equal(t, ErrAccountPrivate, uc.CheckVisible(ctx, 4, private))
+}
+
+func TestRelationUsecase_FilterVisibleVideos(t *testing.T) {
+	ctx := context.Background()
+	videos := []*domain.Video{
+		{ID: 10, AuthorID: 1},
+		{ID: 11, AuthorID: 2},
+		{ID: 12, AuthorID: 3},
+		{ID: 13, AuthorID: 2},
+	}
+
+	t.Run("HidesPrivateAuthorsNotFollowed", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(relationRepo, userRepo, log.DefaultLogger)
+
+		userRepo.EXPECT().GetUsers(ctx, []int64{1, 2, 3}).
+			Return([]*User{{ID: 1}, {ID: 2, IsPrivate: true}, {ID: 3, IsPrivate: true}}, nil)
+		relationRepo.EXPECT().BatchIsFollowing(ctx, int64(5), []int64{2, 3}).Return(map[int64]bool{3: true}, nil)
+
+		visible, err := uc.FilterVisibleVideos(ctx, 5, videos)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10, 12}, videoIDs(visible))
+	})
+
+	t.Run("Guest", func(t *testing.T) {
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(NewMockRelationRepo(t), userRepo, log.DefaultLogger)
+
+		userRepo.EXPECT().GetUsers(ctx, []int64{1, 2, 3}).
+			Return([]*User{{ID: 1}, {ID: 2, IsPrivate: true}, {ID: 3}}, nil)
+
+		visible, err := uc.FilterVisibleVideos(ctx, 0, videos)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10, 12}, videoIDs(visible))
+	})
+
+	t.Run("OwnVideos", func(t *testing.T) {
+		userRepo := NewMockUserRepo(t)
+		uc := NewRelationUsecase(NewMockRelationRepo(t), userRepo, log.DefaultLogger)
+
+		userRepo.EXPECT().GetUsers(ctx, []int64{1, 2, 3}).
+			Return([]*User{{ID: 1}, {ID: 2, IsPrivate: true}, {ID: 3}}, nil)
+
+		visible, err := uc.FilterVisibleVideos(ctx, 2, videos)
+		require.NoError(t, err)
+		assert.Len(t, visible, 4)
+	})
+}
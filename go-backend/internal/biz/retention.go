@@ -0,0 +1,253 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go-backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultRetentionBatchSize = 1000
+	// 单次执行每个策略最多删除的批次数，防止长时间占用数据库
+	maxRetentionBatchesPerRun = 100
+)
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// RetentionPolicy 数据保留策略
+type RetentionPolicy struct {
+	Name       string
+	Table      string
+	TimeColumn string
+	MaxAge     time.Duration
+	Condition  string
+	DryRun     bool
+}
+
+// RetentionReport 单个策略的执行报告
+type RetentionReport struct {
+	Policy   string
+	Table    string
+	Cutoff   time.Time
+	Matched  int64 // 执行前满足过期条件的行数
+	Deleted  int64 // 实际删除的行数，dry-run时为0
+	DryRun   bool
+	Duration time.Duration
+	Err      error
+}
+
+// RetentionRepo is a data retention repo.
+type RetentionRepo interface {
+	CountExpired(context.Context, *RetentionPolicy, time.Time) (int64, error)
+	DeleteExpiredBatch(context.Context, *RetentionPolicy, time.Time, int) (int64, error)
+}
+
+// RetentionUsecase 数据保留策略执行器
+type RetentionUsecase struct {
+	repo      RetentionRepo
+	policies  []*RetentionPolicy
+	batchSize int
+	dryRun    bool
+	interval  time.Duration
+	enabled   bool
+
+	deletedCounter metric.Int64Counter
+	matchedCounter metric.Int64Counter
+	runDuration    metric.Float64Histogram
+
+	log *log.Helper
+}
+
+// NewRetentionUsecase 创建数据保留策略执行器
+func NewRetentionUsecase(repo RetentionRepo, businessConfig *conf.Business, logger log.Logger) *RetentionUsecase {
+	uc := &RetentionUsecase{
+		repo:      repo,
+		batchSize: defaultRetentionBatchSize,
+		log:       log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetRetention(); cfg != nil {
+		uc.enabled = cfg.Enabled
+		uc.dryRun = cfg.DryRun
+		if cfg.BatchSize > 0 {
+			uc.batchSize = int(cfg.BatchSize)
+		}
+		if cfg.Interval != nil {
+			uc.interval = cfg.Interval.AsDuration()
+		}
+		for _, p := range cfg.Policies {
+			policy := &RetentionPolicy{
+				Name:       p.Name,
+				Table:      p.Table,
+				TimeColumn: p.TimeColumn,
+				MaxAge:     p.MaxAge.AsDuration(),
+				Condition:  p.Condition,
+				DryRun:     p.DryRun,
+			}
+			if err := policy.Validate(); err != nil {
+				uc.log.Errorf("skip invalid retention policy %s: %v", p.Name, err)
+				continue
+			}
+			uc.policies = append(uc.policies, policy)
+		}
+	}
+
+	meter := otel.Meter("go-backend/retention")
+	uc.deletedCounter, _ = meter.Int64Counter("retention_deleted_rows_total",
+		metric.WithDescription("Rows deleted by retention policies"))
+	uc.matchedCounter, _ = meter.Int64Counter("retention_matched_rows_total",
+		metric.WithDescription("Expired rows matched by retention policies"))
+	uc.runDuration, _ = meter.Float64Histogram("retention_run_duration_seconds",
+		metric.WithDescription("Retention policy execution duration"), metric.WithUnit("s"))
+
+	return uc
+}
+
+// Validate 校验策略，表名和字段名只允许普通标识符
+func (p *RetentionPolicy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("policy name is required")
+	}
+	if !identifierRegex.MatchString(p.Table) {
+		return fmt.Errorf("invalid table name: %q", p.Table)
+	}
+	if !identifierRegex.MatchString(p.TimeColumn) {
+		return fmt.Errorf("invalid time column: %q", p.TimeColumn)
+	}
+	if p.MaxAge < 0 {
+		return fmt.Errorf("max age must not be negative")
+	}
+	return nil
+}
+
+// Enabled 是否启用数据保留任务
+func (uc *RetentionUsecase) Enabled() bool {
+	return uc.enabled && len(uc.policies) > 0
+}
+
+// Interval 执行间隔
+func (uc *RetentionUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Policies 获取已加载的策略
+func (uc *RetentionUsecase) Policies() []*RetentionPolicy {
+	return uc.policies
+}
+
+// Run 执行所有保留策略，供调度器调用
+func (uc *RetentionUsecase) Run(ctx context.Context) error {
+	reports := uc.Apply(ctx, uc.dryRun)
+
+	var failed int
+	for _, report := range reports {
+		if report.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d retention policies failed", failed, len(reports))
+	}
+	return nil
+}
+
+// Preview 以dry-run方式执行所有策略，只统计待删除行数
+func (uc *RetentionUsecase) Preview(ctx context.Context) []*RetentionReport {
+	return uc.Apply(ctx, true)
+}
+
+// Apply 执行所有保留策略并返回报告
+func (uc *RetentionUsecase) Apply(ctx context.Context, dryRun bool) []*RetentionReport {
+	now := time.Now()
+	reports := make([]*RetentionReport, 0, len(uc.policies))
+
+	for _, policy := range uc.policies {
+		if ctx.Err() != nil {
+			break
+		}
+		report := uc.applyPolicy(ctx, policy, now.Add(-policy.MaxAge), dryRun || policy.DryRun)
+		uc.logReport(ctx, report)
+		reports = append(reports, report)
+	}
+
+	return reports
+}
+
+// applyPolicy 执行单个策略，按批删除直到没有过期数据
+func (uc *RetentionUsecase) applyPolicy(ctx context.Context, policy *RetentionPolicy, cutoff time.Time, dryRun bool) *RetentionReport {
+	start := time.Now()
+	report := &RetentionReport{
+		Policy: policy.Name,
+		Table:  policy.Table,
+		Cutoff: cutoff,
+		DryRun: dryRun,
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("policy", policy.Name),
+		attribute.String("table", policy.Table),
+		attribute.Bool("dry_run", dryRun),
+	)
+	defer func() {
+		report.Duration = time.Since(start)
+		uc.runDuration.Record(ctx, report.Duration.Seconds(), attrs)
+	}()
+
+	matched, err := uc.repo.CountExpired(ctx, policy, cutoff)
+	if err != nil {
+		report.Err = err
+		return report
+	}
+	report.Matched = matched
+	uc.matchedCounter.Add(ctx, matched, attrs)
+
+	if dryRun || matched == 0 {
+		return report
+	}
+
+	for i := 0; i < maxRetentionBatchesPerRun; i++ {
+		if ctx.Err() != nil {
+			report.Err = ctx.Err()
+			break
+		}
+
+		deleted, err := uc.repo.DeleteExpiredBatch(ctx, policy, cutoff, uc.batchSize)
+		if err != nil {
+			report.Err = err
+			break
+		}
+		report.Deleted += deleted
+		uc.deletedCounter.Add(ctx, deleted, attrs)
+
+		if deleted < int64(uc.batchSize) {
+			break
+		}
+	}
+
+	return report
+}
+
+// logReport 输出执行报告
+func (uc *RetentionUsecase) logReport(ctx context.Context, report *RetentionReport) {
+	if report.Err != nil {
+		uc.log.WithContext(ctx).Errorf("retention policy %s on %s failed after deleting %d rows: %v",
+			report.Policy, report.Table, report.Deleted, report.Err)
+		return
+	}
+
+	if report.DryRun {
+		uc.log.WithContext(ctx).Infof("retention policy %s (dry-run): %d rows in %s older than %s would be deleted",
+			report.Policy, report.Matched, report.Table, report.Cutoff.Format(time.RFC3339))
+		return
+	}
+
+	uc.log.WithContext(ctx).Infof("retention policy %s: deleted %d/%d rows from %s older than %s in %s",
+		report.Policy, report.Deleted, report.Matched, report.Table, report.Cutoff.Format(time.RFC3339), report.Duration)
+}
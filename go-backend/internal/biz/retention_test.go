@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newRetentionConfig(dryRun bool, policies ...*conf.Business_Retention_Policy) *conf.Business {
+	return &conf.Business{
+		Retention: &conf.Business_Retention{
+			Enabled:   true,
+			Interval:  durationpb.New(time.Hour),
+			BatchSize: 2,
+			DryRun:    dryRun,
+			Policies:  policies,
+		},
+	}
+}
+
+func TestRetentionUsecase_LoadPolicies(t *testing.T) {
+	t.Run("SkipInvalidPolicies", func(t *testing.T) {
+		repo := NewMockRetentionRepo(t)
+		uc := NewRetentionUsecase(repo, newRetentionConfig(false,
+			&conf.Business_Retention_Policy{Name: "ok", Table: "messages", TimeColumn: "created_at", MaxAge: durationpb.New(time.Hour)},
+			&conf.Business_Retention_Policy{Name: "bad_table", Table: "messages; DROP TABLE users", TimeColumn: "created_at"},
+			&conf.Business_Retention_Policy{Name: "bad_column", Table: "messages", TimeColumn: "created at"},
+		), log.DefaultLogger)
+
+		require.Len(t, uc.Policies(), 1)
+		assert.Equal(t, "ok", uc.Policies()[0].Name)
+		assert.True(t, uc.Enabled())
+		assert.Equal(t, time.Hour, uc.Interval())
+	})
+
+	t.Run("DisabledWithoutConfig", func(t *testing.T) {
+		repo := NewMockRetentionRepo(t)
+		uc := NewRetentionUsecase(repo, &conf.Business{}, log.DefaultLogger)
+
+		assert.False(t, uc.Enabled())
+		assert.Empty(t, uc.Policies())
+	})
+}
+
+func TestRetentionUsecase_Apply(t *testing.T) {
+	ctx := context.Background()
+	policy := &conf.Business_Retention_Policy{
+		Name:       "old_messages",
+		Table:      "messages",
+		TimeColumn: "created_at",
+		MaxAge:     durationpb.New(24 * time.Hour),
+	}
+
+	t.Run("DeleteInBatches", func(t *testing.T) {
+		repo := NewMockRetentionRepo(t)
+		uc := NewRetentionUsecase(repo, newRetentionConfig(false, policy), log.DefaultLogger)
+
+		repo.EXPECT().CountExpired(ctx, mock.Anything, mock.Anything).Return(5, nil)
+		repo.EXPECT().DeleteExpiredBatch(ctx, mock.Anything, mock.Anything, 2).Return(2, nil).Twice()
+		repo.EXPECT().DeleteExpiredBatch(ctx, mock.Anything, mock.Anything, 2).Return(1, nil).Once()
+
+		reports := uc.Apply(ctx, false)
+
+		require.Len(t, reports, 1)
+		assert.NoError(t, reports[0].Err)
+		assert.Equal(t, int64(5), reports[0].Matched)
+		assert.Equal(t, int64(5), reports[0].Deleted)
+		assert.False(t, reports[0].DryRun)
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), reports[0].Cutoff, time.Minute)
+	})
+
+	t.Run("DryRunOnlyCounts", func(t *testing.T) {
+		repo := NewMockRetentionRepo(t)
+		uc := NewRetentionUsecase(repo, newRetentionConfig(true, policy), log.DefaultLogger)
+
+		repo.EXPECT().CountExpired(ctx, mock.Anything, mock.Anything).Return(7, nil)
+
+		require.NoError(t, uc.Run(ctx))
+	})
+
+	t.Run("PreviewDoesNotDelete", func(t *testing.T) {
+		repo := NewMockRetentionRepo(t)
+		uc := NewRetentionUsecase(repo, newRetentionConfig(false, policy), log.DefaultLogger)
+
+		repo.EXPECT().CountExpired(ctx, mock.Anything, mock.Anything).Return(3, nil)
+
+		reports := uc.Preview(ctx)
+
+		require.Len(t, reports, 1)
+		assert.True(t, reports[0].DryRun)
+		assert.Equal(t, int64(3), reports[0].Matched)
+		assert.Zero(t, reports[0].Deleted)
+	})
+
+	t.Run("DeleteError", func(t *testing.T) {
+		repo := NewMockRetentionRepo(t)
+		uc := NewRetentionUsecase(repo, newRetentionConfig(false, policy), log.DefaultLogger)
+
+		repo.EXPECT().CountExpired(ctx, mock.Anything, mock.Anything).Return(5, nil)
+		repo.EXPECT().DeleteExpiredBatch(ctx, mock.Anything, mock.Anything, 2).Return(0, assert.AnError)
+
+		err := uc.Run(ctx)
+
+		assert.Error(t, err)
+	})
+}
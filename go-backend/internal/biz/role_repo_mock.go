@@ -70,6 +70,159 @@ func (_c *MockRoleRepo_AssignRole_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// CreateRole provides a mock function with given fields: ctx, role
+func (_m *MockRoleRepo) CreateRole(ctx context.Context, role *domain.Role) error {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Role) error); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRoleRepo_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type MockRoleRepo_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *domain.Role
+func (_e *MockRoleRepo_Expecter) CreateRole(ctx interface{}, role interface{}) *MockRoleRepo_CreateRole_Call {
+	return &MockRoleRepo_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, role)}
+}
+
+func (_c *MockRoleRepo_CreateRole_Call) Run(run func(ctx context.Context, role *domain.Role)) *MockRoleRepo_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_CreateRole_Call) Return(_a0 error) *MockRoleRepo_CreateRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRoleRepo_CreateRole_Call) RunAndReturn(run func(context.Context, *domain.Role) error) *MockRoleRepo_CreateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function with given fields: ctx, roleID
+func (_m *MockRoleRepo) DeleteRole(ctx context.Context, roleID int64) error {
+	ret := _m.Called(ctx, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRoleRepo_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type MockRoleRepo_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int64
+func (_e *MockRoleRepo_Expecter) DeleteRole(ctx interface{}, roleID interface{}) *MockRoleRepo_DeleteRole_Call {
+	return &MockRoleRepo_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, roleID)}
+}
+
+func (_c *MockRoleRepo_DeleteRole_Call) Run(run func(ctx context.Context, roleID int64)) *MockRoleRepo_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_DeleteRole_Call) Return(_a0 error) *MockRoleRepo_DeleteRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRoleRepo_DeleteRole_Call) RunAndReturn(run func(context.Context, int64) error) *MockRoleRepo_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRole provides a mock function with given fields: ctx, roleID
+func (_m *MockRoleRepo) FindRole(ctx context.Context, roleID int64) (*domain.Role, error) {
+	ret := _m.Called(ctx, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRole")
+	}
+
+	var r0 *domain.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Role, error)); ok {
+		return rf(ctx, roleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Role); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoleRepo_FindRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRole'
+type MockRoleRepo_FindRole_Call struct {
+	*mock.Call
+}
+
+// FindRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int64
+func (_e *MockRoleRepo_Expecter) FindRole(ctx interface{}, roleID interface{}) *MockRoleRepo_FindRole_Call {
+	return &MockRoleRepo_FindRole_Call{Call: _e.mock.On("FindRole", ctx, roleID)}
+}
+
+func (_c *MockRoleRepo_FindRole_Call) Run(run func(ctx context.Context, roleID int64)) *MockRoleRepo_FindRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_FindRole_Call) Return(_a0 *domain.Role, _a1 error) *MockRoleRepo_FindRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoleRepo_FindRole_Call) RunAndReturn(run func(context.Context, int64) (*domain.Role, error)) *MockRoleRepo_FindRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRole provides a mock function with given fields: ctx, roleID
 func (_m *MockRoleRepo) GetRole(ctx context.Context, roleID int64) (*domain.Role, error) {
 	ret := _m.Called(ctx, roleID)
@@ -188,6 +341,72 @@ func (_c *MockRoleRepo_GetRoleByName_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetUserRoleBindings provides a mock function with given fields: ctx, userID
+func (_m *MockRoleRepo) GetUserRoleBindings(ctx context.Context, userID int64) ([]int64, int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRoleBindings")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) int64); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+		r2 = rf(ctx, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRoleRepo_GetUserRoleBindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRoleBindings'
+type MockRoleRepo_GetUserRoleBindings_Call struct {
+	*mock.Call
+}
+
+// GetUserRoleBindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockRoleRepo_Expecter) GetUserRoleBindings(ctx interface{}, userID interface{}) *MockRoleRepo_GetUserRoleBindings_Call {
+	return &MockRoleRepo_GetUserRoleBindings_Call{Call: _e.mock.On("GetUserRoleBindings", ctx, userID)}
+}
+
+func (_c *MockRoleRepo_GetUserRoleBindings_Call) Run(run func(ctx context.Context, userID int64)) *MockRoleRepo_GetUserRoleBindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_GetUserRoleBindings_Call) Return(_a0 []int64, _a1 int64, _a2 error) *MockRoleRepo_GetUserRoleBindings_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRoleRepo_GetUserRoleBindings_Call) RunAndReturn(run func(context.Context, int64) ([]int64, int64, error)) *MockRoleRepo_GetUserRoleBindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserRoles provides a mock function with given fields: ctx, userID
 func (_m *MockRoleRepo) GetUserRoles(ctx context.Context, userID int64) ([]*domain.Role, error) {
 	ret := _m.Called(ctx, userID)
@@ -305,6 +524,180 @@ func (_c *MockRoleRepo_HasRole_Call) RunAndReturn(run func(context.Context, int6
 	return _c
 }
 
+// ListPermissionVersions provides a mock function with given fields: ctx
+func (_m *MockRoleRepo) ListPermissionVersions(ctx context.Context) (map[int64]int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPermissionVersions")
+	}
+
+	var r0 map[int64]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[int64]int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[int64]int64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoleRepo_ListPermissionVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPermissionVersions'
+type MockRoleRepo_ListPermissionVersions_Call struct {
+	*mock.Call
+}
+
+// ListPermissionVersions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRoleRepo_Expecter) ListPermissionVersions(ctx interface{}) *MockRoleRepo_ListPermissionVersions_Call {
+	return &MockRoleRepo_ListPermissionVersions_Call{Call: _e.mock.On("ListPermissionVersions", ctx)}
+}
+
+func (_c *MockRoleRepo_ListPermissionVersions_Call) Run(run func(ctx context.Context)) *MockRoleRepo_ListPermissionVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_ListPermissionVersions_Call) Return(_a0 map[int64]int64, _a1 error) *MockRoleRepo_ListPermissionVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoleRepo_ListPermissionVersions_Call) RunAndReturn(run func(context.Context) (map[int64]int64, error)) *MockRoleRepo_ListPermissionVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoles provides a mock function with given fields: ctx
+func (_m *MockRoleRepo) ListRoles(ctx context.Context) ([]*domain.Role, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 []*domain.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Role, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Role); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoleRepo_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type MockRoleRepo_ListRoles_Call struct {
+	*mock.Call
+}
+
+// ListRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRoleRepo_Expecter) ListRoles(ctx interface{}) *MockRoleRepo_ListRoles_Call {
+	return &MockRoleRepo_ListRoles_Call{Call: _e.mock.On("ListRoles", ctx)}
+}
+
+func (_c *MockRoleRepo_ListRoles_Call) Run(run func(ctx context.Context)) *MockRoleRepo_ListRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_ListRoles_Call) Return(_a0 []*domain.Role, _a1 error) *MockRoleRepo_ListRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoleRepo_ListRoles_Call) RunAndReturn(run func(context.Context) ([]*domain.Role, error)) *MockRoleRepo_ListRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserRoles provides a mock function with given fields: ctx
+func (_m *MockRoleRepo) ListUserRoles(ctx context.Context) ([]*domain.UserRole, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserRoles")
+	}
+
+	var r0 []*domain.UserRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.UserRole, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.UserRole); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.UserRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoleRepo_ListUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRoles'
+type MockRoleRepo_ListUserRoles_Call struct {
+	*mock.Call
+}
+
+// ListUserRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRoleRepo_Expecter) ListUserRoles(ctx interface{}) *MockRoleRepo_ListUserRoles_Call {
+	return &MockRoleRepo_ListUserRoles_Call{Call: _e.mock.On("ListUserRoles", ctx)}
+}
+
+func (_c *MockRoleRepo_ListUserRoles_Call) Run(run func(ctx context.Context)) *MockRoleRepo_ListUserRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_ListUserRoles_Call) Return(_a0 []*domain.UserRole, _a1 error) *MockRoleRepo_ListUserRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoleRepo_ListUserRoles_Call) RunAndReturn(run func(context.Context) ([]*domain.UserRole, error)) *MockRoleRepo_ListUserRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveRole provides a mock function with given fields: ctx, userID, roleID
 func (_m *MockRoleRepo) RemoveRole(ctx context.Context, userID int64, roleID int64) error {
 	ret := _m.Called(ctx, userID, roleID)
@@ -353,6 +746,53 @@ func (_c *MockRoleRepo_RemoveRole_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// UpdateRole provides a mock function with given fields: ctx, role
+func (_m *MockRoleRepo) UpdateRole(ctx context.Context, role *domain.Role) error {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Role) error); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRoleRepo_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockRoleRepo_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *domain.Role
+func (_e *MockRoleRepo_Expecter) UpdateRole(ctx interface{}, role interface{}) *MockRoleRepo_UpdateRole_Call {
+	return &MockRoleRepo_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, role)}
+}
+
+func (_c *MockRoleRepo_UpdateRole_Call) Run(run func(ctx context.Context, role *domain.Role)) *MockRoleRepo_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Role))
+	})
+	return _c
+}
+
+func (_c *MockRoleRepo_UpdateRole_Call) Return(_a0 error) *MockRoleRepo_UpdateRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRoleRepo_UpdateRole_Call) RunAndReturn(run func(context.Context, *domain.Role) error) *MockRoleRepo_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRoleRepo creates a new instance of MockRoleRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRoleRepo(t interface {
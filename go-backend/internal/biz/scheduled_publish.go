@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	ErrVideoNotDraft      = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "video is not a scheduled draft")
+	ErrInvalidPublishTime = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "publish time must be in the future")
+	ErrPublishTimeTooFar  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "publish time is too far in the future")
+)
+
+const (
+	defaultScheduledPublishInterval = time.Minute
+	defaultMaxScheduleAhead         = 30 * 24 * time.Hour
+	// scheduledPublishBatchSize 每次发布的草稿数
+	scheduledPublishBatchSize = 100
+)
+
+// draftPublishAt 上传时指定的计划发布时间（Unix 秒），0 表示立即发布
+func (uc *VideoUsecase) draftPublishAt(publishAt int64) (*time.Time, error) {
+	if publishAt == 0 {
+		return nil, nil
+	}
+	t, err := uc.validatePublishAt(publishAt)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (uc *VideoUsecase) validatePublishAt(publishAt int64) (time.Time, error) {
+	t := time.Unix(publishAt, 0)
+	now := uc.clock.Now()
+	if !t.After(now) {
+		return time.Time{}, ErrInvalidPublishTime
+	}
+	if t.Sub(now) > uc.maxScheduleAhead() {
+		return time.Time{}, ErrPublishTimeTooFar
+	}
+	return t, nil
+}
+
+func (uc *VideoUsecase) maxScheduleAhead() time.Duration {
+	if d := uc.businessConfig.Video.GetMaxScheduleAhead(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultMaxScheduleAhead
+}
+
+// ScheduledPublishInterval 定时发布任务的执行间隔
+func (uc *VideoUsecase) ScheduledPublishInterval() time.Duration {
+	if d := uc.businessConfig.Video.GetScheduledPublishInterval(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultScheduledPublishInterval
+}
+
+// SchedulePublish 作者修改草稿的计划发布时间（Unix 秒），publishAt 为0时立即发布
+func (uc *VideoUsecase) SchedulePublish(ctx context.Context, userID, videoID, publishAt int64) (*domain.Video, error) {
+	video, err := uc.repo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.AuthorID != userID {
+		return nil, ErrPermissionDenied
+	}
+	if video.Status != domain.VideoStatusDraft {
+		return nil, ErrVideoNotDraft
+	}
+
+	if publishAt == 0 {
+		if err := uc.publishDraft(ctx, video); err != nil {
+			return nil, err
+		}
+		return video, nil
+	}
+
+	t, err := uc.validatePublishAt(publishAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.RescheduleDraftVideo(ctx, video, t); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("video %d scheduled to publish at %s by user %d", videoID, t.Format(time.RFC3339), userID)
+	video.PublishAt = &t
+	return video, nil
+}
+
+// PublishDueDrafts 发布一批到达计划发布时间的草稿，单个草稿失败不影响其余草稿，供调度器调用。
+// 多个实例同时执行时由仓储按状态条件更新保证每个草稿只发布一次
+func (uc *VideoUsecase) PublishDueDrafts(ctx context.Context) error {
+	videos, err := uc.repo.ListDueDraftVideos(ctx, uc.clock.Now(), scheduledPublishBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, video := range videos {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := uc.publishDraft(ctx, video); err != nil {
+			if err == ErrVideoNotDraft {
+				// 其他实例已发布或作者已提前发布
+				continue
+			}
+			failed++
+			uc.log.WithContext(ctx).Errorf("publish scheduled video failed: video=%d err=%v", video.ID, err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d scheduled publishes failed", failed, len(videos))
+	}
+	return nil
+}
+
+// publishDraft 发布草稿。标题在发布时重新审核，审核规则在排期期间可能已更新，
+// 此时违规的标题转人工复核，避免定时任务反复失败；开启审核时进入待审核状态，与普通上传一致
+func (uc *VideoUsecase) publishDraft(ctx context.Context, video *domain.Video) error {
+	flagged, err := uc.moderation.Check(ctx, video.Title)
+	if err != nil && err != ErrContentViolation {
+		return err
+	}
+	flagged = flagged || err == ErrContentViolation
+	status := int32(domain.VideoStatusPublished)
+	if uc.businessConfig.Video.RequireReview {
+		status = domain.VideoStatusPending
+	}
+	if flagged {
+		status = domain.VideoStatusAuditing
+	}
+
+	now := uc.clock.Now()
+	ok, err := uc.repo.PublishDraftVideo(ctx, video, status, now)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVideoNotDraft
+	}
+
+	video.Status = status
+	video.PublishAt = nil
+	video.CreatedAt = now
+
+	uc.publishVideoUploadedEvent(ctx, video)
+	uc.processVideoAsync(ctx, video)
+
+	uc.log.WithContext(ctx).Infof("scheduled video %d published with status %d", video.ID, status)
+	return nil
+}
@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduledPublishTestUsecase(t *testing.T, video *conf.Business_Video) (*VideoUsecase, *MockVideoRepo, *clock.Fake) {
+	repo := NewMockVideoRepo(t)
+	clk := clock.NewFake(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	moderation := NewContentModerationUsecase(nil, &conf.Business{
+		Moderation: &conf.Business_Moderation{
+			BlockWords:  []string{"赌博"},
+			ReviewWords: []string{"加微信"},
+		},
+	}, log.DefaultLogger)
+	uc := NewVideoUseCase(repo, nil, nil, nil, nil, nil, &conf.Business{Video: video}, newTestDegradation(), moderation, nil, worker.NewManager(log.DefaultLogger), nil, clk, log.DefaultLogger)
+	return uc, repo, clk
+}
+
+func TestVideoUsecase_SchedulePublish(t *testing.T) {
+	ctx := context.Background()
+	draft := func() *domain.Video {
+		return &domain.Video{ID: 10, AuthorID: 1, Title: "draft", Status: domain.VideoStatusDraft}
+	}
+
+	t.Run("Reschedule", func(t *testing.T) {
+		uc, repo, clk := newScheduledPublishTestUsecase(t, &conf.Business_Video{})
+		video := draft()
+		publishAt := clk.Now().Add(2 * time.Hour)
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil)
+		repo.EXPECT().RescheduleDraftVideo(ctx, video, mock.MatchedBy(publishAt.Equal)).Return(nil)
+
+		got, err := uc.SchedulePublish(ctx, 1, 10, publishAt.Unix())
+		require.NoError(t, err)
+		require.NotNil(t, got.PublishAt)
+		assert.True(t, publishAt.Equal(*got.PublishAt))
+	})
+
+	t.Run("PublishNow", func(t *testing.T) {
+		uc, repo, clk := newScheduledPublishTestUsecase(t, &conf.Business_Video{})
+		video := draft()
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil)
+		repo.EXPECT().PublishDraftVideo(ctx, video, int32(domain.VideoStatusPublished), clk.Now()).Return(true, nil)
+
+		got, err := uc.SchedulePublish(ctx, 1, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.VideoStatusPublished), got.Status)
+		assert.Nil(t, got.PublishAt)
+		assert.Equal(t, clk.Now(), got.CreatedAt)
+	})
+
+	t.Run("InvalidTime", func(t *testing.T) {
+		uc, repo, clk := newScheduledPublishTestUsecase(t, &conf.Business_Video{})
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(draft(), nil)
+		_, err := uc.SchedulePublish(ctx, 1, 10, clk.Now().Add(-time.Minute).Unix())
+		assert.Equal(t, ErrInvalidPublishTime, err)
+
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(draft(), nil)
+		_, err = uc.SchedulePublish(ctx, 1, 10, clk.Now().Add(defaultMaxScheduleAhead+time.Hour).Unix())
+		assert.Equal(t, ErrPublishTimeTooFar, err)
+	})
+
+	t.Run("NotDraft", func(t *testing.T) {
+		uc, repo, clk := newScheduledPublishTestUsecase(t, &conf.Business_Video{})
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1, Status: domain.VideoStatusPublished}, nil)
+
+		_, err := uc.SchedulePublish(ctx, 1, 10, clk.Now().Add(time.Hour).Unix())
+		assert.Equal(t, ErrVideoNotDraft, err)
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		uc, repo, _ := newScheduledPublishTestUsecase(t, &conf.Business_Video{})
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(draft(), nil)
+
+		_, err := uc.SchedulePublish(ctx, 2, 10, 0)
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
+
+func TestVideoUsecase_PublishDueDrafts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("PublishesDueDrafts", func(t *testing.T) {
+		uc, repo, clk := newScheduledPublishTestUsecase(t, &conf.Business_Video{})
+		repo.EXPECT().ListDueDraftVideos(ctx, clk.Now(), scheduledPublishBatchSize).Return([]*domain.Video{
+			{ID: 10, AuthorID: 1, Title: "ok", Status: domain.VideoStatusDraft},
+			{ID: 11, AuthorID: 1, Title: "加微信", Status: domain.VideoStatusDraft},
+			{ID: 12, AuthorID: 1, Title: "赌博", Status: domain.VideoStatusDraft},
+			{ID: 13, AuthorID: 1, Title: "raced", Status: domain.VideoStatusDraft},
+		}, nil)
+
+		statuses := map[int64]int32{}
+		repo.EXPECT().PublishDraftVideo(ctx, mock.Anything, mock.Anything, clk.Now()).
+			RunAndReturn(func(_ context.Context, video *domain.Video, status int32, _ time.Time) (bool, error) {
+				if video.ID == 13 {
+					// 其他实例已发布
+					return false, nil
+				}
+				statuses[video.ID] = status
+				return true, nil
+			})
+
+		require.NoError(t, uc.PublishDueDrafts(ctx))
+		// 发布时违规的标题转人工复核
+		assert.Equal(t, map[int64]int32{
+			10: domain.VideoStatusPublished,
+			11: domain.VideoStatusAuditing,
+			12: domain.VideoStatusAuditing,
+		}, statuses)
+	})
+
+	t.Run("RequireReview", func(t *testing.T) {
+		uc, repo, clk := newScheduledPublishTestUsecase(t, &conf.Business_Video{RequireReview: true})
+		video := &domain.Video{ID: 10, AuthorID: 1, Title: "ok", Status: domain.VideoStatusDraft}
+		repo.EXPECT().ListDueDraftVideos(ctx, clk.Now(), scheduledPublishBatchSize).Return([]*domain.Video{video}, nil)
+		repo.EXPECT().PublishDraftVideo(ctx, video, int32(domain.VideoStatusPending), clk.Now()).Return(true, nil)
+
+		require.NoError(t, uc.PublishDueDrafts(ctx))
+	})
+}
@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"strings"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/media"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// shareCardVersion 卡片版式版本，修改版式后递增，使已缓存的卡片失效
+const shareCardVersion = 1
+
+// ShareUsecase 渲染个人主页和视频的分享卡片。卡片按展示内容寻址存入对象存储，
+// 内容不变时直接复用已上传的卡片，统计数据按展示精度变化后才重新渲染
+type ShareUsecase struct {
+	userRepo   UserRepo
+	videoRepo  VideoRepo
+	relationUc *RelationUsecase
+	storage    storage.VideoStorage
+	renderer   *media.CardRenderer
+	baseURL    string
+	log        *log.Helper
+}
+
+// NewShareUsecase 创建分享用例
+func NewShareUsecase(userRepo UserRepo, videoRepo VideoRepo, relationUc *RelationUsecase, storage storage.VideoStorage, businessConfig *conf.Business, logger log.Logger) *ShareUsecase {
+	return &ShareUsecase{
+		userRepo:   userRepo,
+		videoRepo:  videoRepo,
+		relationUc: relationUc,
+		storage:    storage,
+		renderer:   media.NewCardRenderer(0),
+		baseURL:    strings.TrimRight(businessConfig.GetShare().GetBaseUrl(), "/"),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// GetUserCard 获取用户主页分享卡片的访问URL。卡片对所有人可见，私密账号只展示受限的资料
+func (uc *ShareUsecase) GetUserCard(ctx context.Context, userID int64) (string, error) {
+	user, err := uc.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user.IsPrivate {
+		user = RestrictedUser(user)
+	}
+
+	card := &media.Card{
+		Title:    displayName(user),
+		Subtitle: "@" + user.Username,
+		Stats: []media.CardStat{
+			{Label: "Following", Value: formatCount(int64(user.FollowCount))},
+			{Label: "Followers", Value: formatCount(int64(user.FollowerCount))},
+			{Label: "Likes", Value: formatCount(user.TotalFavorited)},
+			{Label: "Videos", Value: formatCount(int64(user.WorkCount))},
+		},
+		QRContent: uc.shareURL("user", userID),
+		Footer:    "Scan to follow",
+	}
+
+	return uc.renderCard(ctx, fmt.Sprintf("user/%d", userID), card, user.Avatar)
+}
+
+// GetVideoCard 获取视频分享卡片的访问URL。卡片对所有人可见，只有公开账号已发布的公开视频可以分享，
+// 其余视频按不存在处理
+func (uc *ShareUsecase) GetVideoCard(ctx context.Context, videoID int64) (string, error) {
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return "", err
+	}
+	if video.Status != domain.VideoStatusPublished {
+		return "", utils.ErrVideoNotFound
+	}
+	if err := uc.relationUc.CheckVideoVisible(ctx, 0, video); err != nil {
+		return "", err
+	}
+
+	author, err := uc.userRepo.GetUser(ctx, video.AuthorID)
+	if err != nil {
+		return "", err
+	}
+	if author.IsPrivate {
+		return "", utils.ErrVideoNotFound
+	}
+
+	title := video.Title
+	if title == "" || !media.CanRenderText(title) {
+		title = displayName(author)
+	}
+
+	card := &media.Card{
+		Title:    title,
+		Subtitle: "@" + author.Username,
+		Stats: []media.CardStat{
+			{Label: "Likes", Value: formatCount(video.FavoriteCount)},
+			{Label: "Comments", Value: formatCount(video.CommentCount)},
+			{Label: "Plays", Value: formatCount(video.PlayCount)},
+		},
+		QRContent: uc.shareURL("video", videoID),
+		Footer:    "Scan to watch",
+	}
+
+	return uc.renderCard(ctx, fmt.Sprintf("video/%d", videoID), card, video.CoverURL)
+}
+
+// renderCard 按卡片内容生成对象键，已存在时直接返回，否则渲染后上传
+func (uc *ShareUsecase) renderCard(ctx context.Context, subject string, card *media.Card, imageURL string) (string, error) {
+	objectName := fmt.Sprintf("cards/%s/%s.jpg", subject, cardFingerprint(card, imageURL))
+
+	exists, err := uc.storage.Exists(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		card.Image = uc.loadImage(ctx, imageURL)
+
+		var buf bytes.Buffer
+		if err := uc.renderer.Render(card, &buf); err != nil {
+			return "", err
+		}
+		size := int64(buf.Len())
+		if _, err := uc.storage.Upload(ctx, objectName, &buf, size, &storage.UploadOptions{ContentType: "image/jpeg"}); err != nil {
+			return "", fmt.Errorf("upload share card failed: %w", err)
+		}
+	}
+
+	return uc.storage.GenerateCoverURL(ctx, objectName)
+}
+
+// loadImage 读取头像或封面，只读取本站存储中的图片，失败时使用占位图
+func (uc *ShareUsecase) loadImage(ctx context.Context, imageURL string) image.Image {
+	resolver, ok := uc.storage.(storage.ObjectResolver)
+	if !ok || imageURL == "" {
+		return nil
+	}
+	objectName, ok := resolver.ObjectName(imageURL)
+	if !ok {
+		return nil
+	}
+
+	reader, err := uc.storage.Download(ctx, objectName)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("download share card image failed: object=%s err=%v", objectName, err)
+		return nil
+	}
+	defer reader.Close()
+
+	img, err := media.DecodeCardImage(reader)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("decode share card image failed: object=%s err=%v", objectName, err)
+		return nil
+	}
+	return img
+}
+
+func (uc *ShareUsecase) shareURL(kind string, id int64) string {
+	return fmt.Sprintf("%s/%s/%d", uc.baseURL, kind, id)
+}
+
+// cardFingerprint 卡片展示内容的摘要，作为对象键的一部分
+func cardFingerprint(card *media.Card, imageURL string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\x00%s\x00%s\x00%s\x00%s\x00%s", shareCardVersion, card.Title, card.Subtitle, card.QRContent, card.Footer, imageURL)
+	for _, stat := range card.Stats {
+		fmt.Fprintf(h, "\x00%s=%s", stat.Label, stat.Value)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// displayName 卡片上展示的名称，昵称包含字体无法显示的字符时使用用户名
+func displayName(user *User) string {
+	if user.Nickname != "" && media.CanRenderText(user.Nickname) {
+		return user.Nickname
+	}
+	return user.Username
+}
+
+// formatCount 按展示精度格式化计数，如 1234 -> 1.2K
+func formatCount(n int64) string {
+	switch {
+	case n >= 1_000_000_000:
+		return trimCount(float64(n)/1_000_000_000) + "B"
+	case n >= 1_000_000:
+		return trimCount(float64(n)/1_000_000) + "M"
+	case n >= 1_000:
+		return trimCount(float64(n)/1_000) + "K"
+	default:
+		return fmt.Sprintf("%d", n)
+	}
+}
+
+func trimCount(v float64) string {
+	s := fmt.Sprintf("%.1f", float64(int64(v*10))/10)
+	return strings.TrimSuffix(s, ".0")
+}
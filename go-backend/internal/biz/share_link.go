@@ -0,0 +1,218 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrShareLinkNotFound = errors.NotFound(v1.ErrorCode_SHARE_LINK_NOT_EXIST.String(), "share link not found")
+	// ErrShareTokenConflict 生成的分享 token 与已有 token 重复，由用例重试
+	ErrShareTokenConflict = errors.Conflict(v1.ErrorCode_SERVER_ERROR.String(), "share token conflict")
+)
+
+const (
+	shareTokenAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	shareTokenLength   = 10
+	shareTokenAttempts = 3
+)
+
+// ShareLink 用户分享视频生成的短链，同一用户分享同一视频复用同一个 token
+type ShareLink struct {
+	ID         int64
+	Token      string
+	VideoID    int64
+	SharerID   int64
+	ShareCount int64 // 分享者分享该视频的次数
+	OpenCount  int64 // 他人打开链接的次数
+	PlayCount  int64 // 经链接计入的有效播放数
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ShareLinkRepo is a ShareLink repo.
+type ShareLinkRepo interface {
+	// GetShareLink 按视频和分享者查找，不存在时返回nil
+	GetShareLink(ctx context.Context, videoID, sharerID int64) (*ShareLink, error)
+	// GetShareLinkByToken 不存在时返回ErrShareLinkNotFound
+	GetShareLinkByToken(ctx context.Context, token string) (*ShareLink, error)
+	// CreateShareLink 分享者已有该视频的链接时回填已有链接，token 重复时返回ErrShareTokenConflict
+	CreateShareLink(context.Context, *ShareLink) error
+	RecordShare(ctx context.Context, linkID int64) error
+	RecordOpen(ctx context.Context, linkID int64) error
+	RecordPlay(ctx context.Context, linkID int64) error
+}
+
+// ShareLinkUsecase 视频分享：每次分享计入视频的分享数并发出统计事件，
+// 分享者得到可解析为视频的短链，他人打开链接和经链接的有效播放归因到分享者
+type ShareLinkUsecase struct {
+	repo       ShareLinkRepo
+	videoRepo  VideoRepo
+	videoUc    *VideoUsecase
+	relationUc *RelationUsecase
+	baseURL    string
+	log        *log.Helper
+}
+
+// NewShareLinkUsecase new a ShareLink usecase.
+func NewShareLinkUsecase(repo ShareLinkRepo, videoRepo VideoRepo, videoUc *VideoUsecase, relationUc *RelationUsecase, businessConfig *conf.Business, logger log.Logger) *ShareLinkUsecase {
+	return &ShareLinkUsecase{
+		repo:       repo,
+		videoRepo:  videoRepo,
+		videoUc:    videoUc,
+		relationUc: relationUc,
+		baseURL:    strings.TrimRight(businessConfig.GetShare().GetBaseUrl(), "/"),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// ShareVideo 分享视频，返回分享者在该视频上的短链。只有已发布且分享者可见的视频可以分享
+func (uc *ShareLinkUsecase) ShareVideo(ctx context.Context, userID, videoID int64) (*ShareLink, error) {
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.Status != domain.VideoStatusPublished {
+		return nil, utils.ErrVideoNotFound
+	}
+	if err := uc.relationUc.CheckVideoVisible(ctx, userID, video); err != nil {
+		return nil, err
+	}
+
+	link, err := uc.repo.GetShareLink(ctx, videoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil {
+		if link, err = uc.createLink(ctx, videoID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.repo.RecordShare(ctx, link.ID); err != nil {
+		return nil, err
+	}
+	link.ShareCount++
+
+	if err := uc.videoUc.UpdateVideoStats(ctx, videoID, "share", 1); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// ResolveShareLink 解析分享短链，返回链接和视频。访问者无权查看视频时按视频不存在处理，
+// 分享者以外的访问者打开时计入链接的打开数
+func (uc *ShareLinkUsecase) ResolveShareLink(ctx context.Context, viewerID int64, token string) (*ShareLink, *domain.Video, error) {
+	link, err := uc.getLink(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, link.VideoID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if video.Status != domain.VideoStatusPublished {
+		return nil, nil, utils.ErrVideoNotFound
+	}
+	if err := uc.relationUc.CheckVideoVisible(ctx, viewerID, video); err != nil {
+		return nil, nil, err
+	}
+
+	if viewerID != link.SharerID {
+		if err := uc.repo.RecordOpen(ctx, link.ID); err != nil {
+			uc.log.WithContext(ctx).Warnf("record share link open failed: link=%d err=%v", link.ID, err)
+		} else {
+			link.OpenCount++
+		}
+	}
+	return link, video, nil
+}
+
+// AttributePlay 把经分享链接计入的一次播放归因到分享者，分享者自己的播放不计入。
+// token 与播放的视频不对应时返回ErrShareLinkNotFound
+func (uc *ShareLinkUsecase) AttributePlay(ctx context.Context, viewerID int64, token string, videoID int64) error {
+	link, err := uc.getLink(ctx, token)
+	if err != nil {
+		return err
+	}
+	if link.VideoID != videoID {
+		return ErrShareLinkNotFound
+	}
+	if viewerID == link.SharerID {
+		return nil
+	}
+	return uc.repo.RecordPlay(ctx, link.ID)
+}
+
+// ShareURL 分享短链的落地页地址
+func (uc *ShareLinkUsecase) ShareURL(token string) string {
+	return uc.baseURL + "/s/" + token
+}
+
+// getLink 按 token 查找链接，格式不合法的 token 不查库
+func (uc *ShareLinkUsecase) getLink(ctx context.Context, token string) (*ShareLink, error) {
+	if !validShareToken(token) {
+		return nil, ErrShareLinkNotFound
+	}
+	return uc.repo.GetShareLinkByToken(ctx, token)
+}
+
+// createLink 生成短链，与已有 token 重复时重新生成
+func (uc *ShareLinkUsecase) createLink(ctx context.Context, videoID, sharerID int64) (*ShareLink, error) {
+	for attempt := 0; ; attempt++ {
+		token, err := generateShareToken()
+		if err != nil {
+			return nil, err
+		}
+
+		link := &ShareLink{
+			Token:    token,
+			VideoID:  videoID,
+			SharerID: sharerID,
+		}
+		err = uc.repo.CreateShareLink(ctx, link)
+		if err == nil {
+			return link, nil
+		}
+		if !errors.Is(err, ErrShareTokenConflict) || attempt+1 >= shareTokenAttempts {
+			return nil, err
+		}
+	}
+}
+
+func generateShareToken() (string, error) {
+	limit := big.NewInt(int64(len(shareTokenAlphabet)))
+	var sb strings.Builder
+	for i := 0; i < shareTokenLength; i++ {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(shareTokenAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+func validShareToken(token string) bool {
+	if len(token) != shareTokenLength {
+		return false
+	}
+	for i := 0; i < len(token); i++ {
+		if strings.IndexByte(shareTokenAlphabet, token[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
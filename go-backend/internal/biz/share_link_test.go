@@ -0,0 +1,163 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/utils"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type shareLinkTestDeps struct {
+	repo      *MockShareLinkRepo
+	videoRepo *MockVideoRepo
+	cache     *MockVideoCacheRepo
+	uc        *ShareLinkUsecase
+}
+
+func newShareLinkTestDeps(t *testing.T) *shareLinkTestDeps {
+	d := &shareLinkTestDeps{
+		repo:      NewMockShareLinkRepo(t),
+		videoRepo: NewMockVideoRepo(t),
+		cache:     NewMockVideoCacheRepo(t),
+	}
+	config := &conf.Business{
+		Video: &conf.Business_Video{},
+		Share: &conf.Business_Share{BaseUrl: "https://example.com/share/"},
+	}
+	videoUc := NewVideoUseCase(d.videoRepo, d.cache, NewMockVideoStatsBufferRepo(t), nil, nil, nil, config, newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clock.New(), log.DefaultLogger)
+	relationUc := NewRelationUsecase(NewMockRelationRepo(t), NewMockUserRepo(t), log.DefaultLogger)
+	d.uc = NewShareLinkUsecase(d.repo, d.videoRepo, videoUc, relationUc, config, log.DefaultLogger)
+	return d
+}
+
+func TestShareLinkUsecase_ShareVideo(t *testing.T) {
+	ctx := context.Background()
+	published := &domain.Video{ID: 10, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPublic}
+
+	t.Run("FirstShare", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+		d.repo.EXPECT().GetShareLink(ctx, int64(10), int64(1)).Return(nil, nil)
+		d.repo.EXPECT().CreateShareLink(ctx, mock.MatchedBy(func(link *ShareLink) bool {
+			return link.VideoID == 10 && link.SharerID == 1 && validShareToken(link.Token)
+		})).RunAndReturn(func(_ context.Context, link *ShareLink) error {
+			link.ID = 5
+			return nil
+		})
+		d.repo.EXPECT().RecordShare(ctx, int64(5)).Return(nil)
+		d.videoRepo.EXPECT().UpdateVideoStats(ctx, int64(10), "share_count", int64(1)).Return(nil)
+		d.cache.EXPECT().IncrVideoStats(ctx, int64(10), "share_count", int64(1)).Return()
+
+		link, err := d.uc.ShareVideo(ctx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), link.ShareCount)
+		assert.Equal(t, "https://example.com/share/s/"+link.Token, d.uc.ShareURL(link.Token))
+	})
+
+	t.Run("ReuseLink", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		existing := &ShareLink{ID: 5, Token: "aB3dE5gH7j", VideoID: 10, SharerID: 1, ShareCount: 2}
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+		d.repo.EXPECT().GetShareLink(ctx, int64(10), int64(1)).Return(existing, nil)
+		d.repo.EXPECT().RecordShare(ctx, int64(5)).Return(nil)
+		d.videoRepo.EXPECT().UpdateVideoStats(ctx, int64(10), "share_count", int64(1)).Return(nil)
+		d.cache.EXPECT().IncrVideoStats(ctx, int64(10), "share_count", int64(1)).Return()
+
+		link, err := d.uc.ShareVideo(ctx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, "aB3dE5gH7j", link.Token)
+		assert.Equal(t, int64(3), link.ShareCount)
+	})
+
+	t.Run("RetryTokenConflict", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+		d.repo.EXPECT().GetShareLink(ctx, int64(10), int64(1)).Return(nil, nil)
+		d.repo.EXPECT().CreateShareLink(ctx, mock.Anything).Return(ErrShareTokenConflict).Times(shareTokenAttempts)
+
+		_, err := d.uc.ShareVideo(ctx, 1, 10)
+		assert.ErrorIs(t, err, ErrShareTokenConflict)
+	})
+
+	t.Run("NotShareable", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		for _, video := range []*domain.Video{
+			{ID: 10, AuthorID: 2, Status: domain.VideoStatusAuditing, Visibility: domain.VideoVisibilityPublic},
+			{ID: 10, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPrivate},
+		} {
+			d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil).Once()
+
+			_, err := d.uc.ShareVideo(ctx, 1, 10)
+			assert.Equal(t, utils.ErrVideoNotFound, err)
+		}
+	})
+}
+
+func TestShareLinkUsecase_ResolveShareLink(t *testing.T) {
+	ctx := context.Background()
+	link := &ShareLink{ID: 5, Token: "aB3dE5gH7j", VideoID: 10, SharerID: 1}
+	published := &domain.Video{ID: 10, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPublic}
+
+	t.Run("RecordOpen", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		d.repo.EXPECT().GetShareLinkByToken(ctx, "aB3dE5gH7j").Return(link, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+		d.repo.EXPECT().RecordOpen(ctx, int64(5)).Return(nil)
+
+		got, video, err := d.uc.ResolveShareLink(ctx, 0, "aB3dE5gH7j")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), got.SharerID)
+		assert.Equal(t, published, video)
+	})
+
+	t.Run("SharerOpen", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		d.repo.EXPECT().GetShareLinkByToken(ctx, "aB3dE5gH7j").Return(&ShareLink{ID: 5, VideoID: 10, SharerID: 1}, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(published, nil)
+
+		_, _, err := d.uc.ResolveShareLink(ctx, 1, "aB3dE5gH7j")
+		require.NoError(t, err)
+	})
+
+	t.Run("MalformedToken", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		for _, token := range []string{"", "short", "aB3dE5gH7j!", "aB3dE5-H7j"} {
+			_, _, err := d.uc.ResolveShareLink(ctx, 0, token)
+			assert.Equal(t, ErrShareLinkNotFound, err)
+		}
+	})
+
+	t.Run("VideoNoLongerVisible", func(t *testing.T) {
+		d := newShareLinkTestDeps(t)
+		d.repo.EXPECT().GetShareLinkByToken(ctx, "aB3dE5gH7j").Return(link, nil)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).
+			Return(&domain.Video{ID: 10, AuthorID: 2, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityPrivate}, nil)
+
+		_, _, err := d.uc.ResolveShareLink(ctx, 3, "aB3dE5gH7j")
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+}
+
+func TestShareLinkUsecase_AttributePlay(t *testing.T) {
+	ctx := context.Background()
+	link := &ShareLink{ID: 5, Token: "aB3dE5gH7j", VideoID: 10, SharerID: 1}
+
+	d := newShareLinkTestDeps(t)
+	d.repo.EXPECT().GetShareLinkByToken(ctx, "aB3dE5gH7j").Return(link, nil).Times(3)
+	d.repo.EXPECT().RecordPlay(ctx, int64(5)).Return(nil).Once()
+
+	require.NoError(t, d.uc.AttributePlay(ctx, 3, "aB3dE5gH7j", 10))
+	// 分享者自己的播放不计入
+	require.NoError(t, d.uc.AttributePlay(ctx, 1, "aB3dE5gH7j", 10))
+	// token 与播放的视频不对应
+	assert.Equal(t, ErrShareLinkNotFound, d.uc.AttributePlay(ctx, 3, "aB3dE5gH7j", 11))
+}
@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStorage 内存对象存储，只实现分享卡片和资料图片用到的方法
+type memoryStorage struct {
+	storage.VideoStorage
+	objects map[string][]byte
+	uploads int
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{objects: make(map[string][]byte)}
+}
+
+func (s *memoryStorage) Upload(_ context.Context, objectName string, reader io.Reader, size int64, _ *storage.UploadOptions) (*storage.FileInfo, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	s.objects[objectName] = data
+	s.uploads++
+	return &storage.FileInfo{Name: objectName, Size: size}, nil
+}
+
+func (s *memoryStorage) Download(_ context.Context, objectName string) (io.ReadCloser, error) {
+	data, ok := s.objects[objectName]
+	if !ok {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memoryStorage) Delete(_ context.Context, objectName string) error {
+	delete(s.objects, objectName)
+	return nil
+}
+
+func (s *memoryStorage) Exists(_ context.Context, objectName string) (bool, error) {
+	_, ok := s.objects[objectName]
+	return ok, nil
+}
+
+func (s *memoryStorage) GenerateCoverURL(_ context.Context, objectName string) (string, error) {
+	return "https://cdn.example.com/" + objectName, nil
+}
+
+func (s *memoryStorage) ObjectName(url string) (string, bool) {
+	name := strings.TrimPrefix(url, "https://cdn.example.com/")
+	return name, name != url
+}
+
+type shareTestDeps struct {
+	userRepo  *MockUserRepo
+	videoRepo *MockVideoRepo
+	storage   *memoryStorage
+	uc        *ShareUsecase
+}
+
+func newShareTestDeps(t *testing.T) *shareTestDeps {
+	userRepo := NewMockUserRepo(t)
+	videoRepo := NewMockVideoRepo(t)
+	store := newMemoryStorage()
+	config := &conf.Business{Share: &conf.Business_Share{BaseUrl: "https://tiktok.example.com/share/"}}
+
+	return &shareTestDeps{
+		userRepo:  userRepo,
+		videoRepo: videoRepo,
+		storage:   store,
+		uc:        NewShareUsecase(userRepo, videoRepo, newTestRelationUsecase(t), store, config, log.DefaultLogger),
+	}
+}
+
+func TestShareUsecase_GetUserCard(t *testing.T) {
+	ctx := context.Background()
+	user := &User{ID: 1, Username: "alice", Nickname: "Alice", FollowerCount: 1234, TotalFavorited: 56}
+
+	t.Run("RenderOnce", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(user, nil).Times(2)
+
+		url, err := d.uc.GetUserCard(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(url, "https://cdn.example.com/cards/user/1/"))
+
+		again, err := d.uc.GetUserCard(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, url, again)
+		assert.Equal(t, 1, d.storage.uploads)
+
+		img, _, err := image.Decode(bytes.NewReader(d.storage.objects[strings.TrimPrefix(url, "https://cdn.example.com/")]))
+		require.NoError(t, err)
+		assert.Equal(t, 600, img.Bounds().Dx())
+	})
+
+	t.Run("StatsChangeRerenders", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		updated := *user
+		updated.FollowerCount = 2500
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(user, nil).Once()
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(&updated, nil).Once()
+
+		first, err := d.uc.GetUserCard(ctx, 1)
+		require.NoError(t, err)
+		second, err := d.uc.GetUserCard(ctx, 1)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("PrivateAccountHidesStats", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		private := *user
+		private.IsPrivate = true
+		restricted := RestrictedUser(&private)
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(&private, nil).Once()
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(restricted, nil).Once()
+
+		// 与计数清零后的卡片相同，说明卡片上没有私密账号的真实计数
+		first, err := d.uc.GetUserCard(ctx, 1)
+		require.NoError(t, err)
+		second, err := d.uc.GetUserCard(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		assert.Equal(t, 1, d.storage.uploads)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		d.userRepo.EXPECT().GetUser(ctx, int64(2)).Return(nil, ErrUserNotFound)
+
+		_, err := d.uc.GetUserCard(ctx, 2)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestShareUsecase_GetVideoCard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{
+			ID: 10, AuthorID: 1, Title: "my video", Status: domain.VideoStatusPublished,
+			CoverURL: "https://other.example.com/cover.jpg", CreatedAt: time.Now(),
+		}, nil)
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(&User{ID: 1, Username: "alice"}, nil)
+
+		url, err := d.uc.GetVideoCard(ctx, 10)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(url, "https://cdn.example.com/cards/video/10/"))
+	})
+
+	t.Run("NotPublished", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(11)).Return(&domain.Video{ID: 11, AuthorID: 1, Status: domain.VideoStatusPrivate}, nil)
+
+		_, err := d.uc.GetVideoCard(ctx, 11)
+		assert.ErrorIs(t, err, utils.ErrVideoNotFound)
+		assert.Zero(t, d.storage.uploads)
+	})
+
+	t.Run("NotPublic", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(12)).Return(&domain.Video{
+			ID: 12, AuthorID: 1, Status: domain.VideoStatusPublished, Visibility: domain.VideoVisibilityFriends,
+		}, nil)
+
+		_, err := d.uc.GetVideoCard(ctx, 12)
+		assert.ErrorIs(t, err, utils.ErrVideoNotFound)
+		assert.Zero(t, d.storage.uploads)
+	})
+
+	t.Run("PrivateAuthor", func(t *testing.T) {
+		d := newShareTestDeps(t)
+		d.videoRepo.EXPECT().GetVideo(ctx, int64(13)).Return(&domain.Video{ID: 13, AuthorID: 1, Status: domain.VideoStatusPublished}, nil)
+		d.userRepo.EXPECT().GetUser(ctx, int64(1)).Return(&User{ID: 1, Username: "alice", IsPrivate: true}, nil)
+
+		_, err := d.uc.GetVideoCard(ctx, 13)
+		assert.ErrorIs(t, err, utils.ErrVideoNotFound)
+		assert.Zero(t, d.storage.uploads)
+	})
+}
+
+func TestFormatCount(t *testing.T) {
+	assert.Equal(t, "999", formatCount(999))
+	assert.Equal(t, "1K", formatCount(1000))
+	assert.Equal(t, "1.2K", formatCount(1299))
+	assert.Equal(t, "3.4M", formatCount(3_450_000))
+	assert.Equal(t, "2B", formatCount(2_000_000_000))
+}
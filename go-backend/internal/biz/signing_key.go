@@ -0,0 +1,231 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSigningKeyRefreshInterval = time.Minute
+	defaultSigningKeyActivationDelay = 2 * time.Minute
+	signingKeyIDSuffixLength         = 6
+)
+
+// SigningKey 数据库中的令牌签名密钥
+type SigningKey struct {
+	KeyID string
+	// Algorithm 签名算法，非对称算法的 Secret 为 PEM 私钥
+	Algorithm  string
+	Secret     string
+	ActivateAt time.Time
+	// RetireAt 为空表示未安排退役
+	RetireAt  *time.Time
+	CreatedAt time.Time
+}
+
+// SigningKeyRepo 令牌签名密钥仓储
+type SigningKeyRepo interface {
+	// ListSigningKeys 获取全部密钥，包括已退役的
+	ListSigningKeys(ctx context.Context) ([]*SigningKey, error)
+	// CreateSigningKey 写入密钥，kid 已存在时返回错误
+	CreateSigningKey(ctx context.Context, key *SigningKey) error
+	// RotateSigningKey 在同一事务中将尚未安排退役的密钥的退役时间设为 retireAt，并写入新密钥
+	RotateSigningKey(ctx context.Context, key *SigningKey, retireAt time.Time) error
+}
+
+// SigningKeyUsecase 令牌签名密钥的轮换与同步。数据库是密钥的唯一来源，各实例定时加载到密钥环；
+// 新密钥延迟生效，保证所有实例在它开始签发前已能验证，被替换的密钥在令牌最长有效期后退役。
+// 配置中的密钥在首次加载时写入数据库，修改 current_key_id 等同于一次轮换
+type SigningKeyUsecase struct {
+	repo    SigningKeyRepo
+	keyring *auth.Keyring
+
+	// seeds 配置中的密钥，seedCurrent 为配置指定的签发密钥
+	seeds       []*auth.SigningKey
+	seedCurrent string
+	seeded      bool
+	mu          sync.Mutex
+
+	refreshInterval time.Duration
+	activationDelay time.Duration
+	// algorithm 轮换时生成的新密钥的算法
+	algorithm string
+
+	clock clock.Clock
+	log   *log.Helper
+}
+
+// NewSigningKeyUsecase 创建签名密钥用例，keyring 中此时的密钥视为配置中的密钥
+func NewSigningKeyUsecase(repo SigningKeyRepo, keyring *auth.Keyring, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *SigningKeyUsecase {
+	uc := &SigningKeyUsecase{
+		repo:            repo,
+		keyring:         keyring,
+		seeds:           keyring.Keys(),
+		refreshInterval: defaultSigningKeyRefreshInterval,
+		activationDelay: defaultSigningKeyActivationDelay,
+		algorithm:       auth.AlgorithmHS256,
+		clock:           clk,
+		log:             log.NewHelper(logger),
+	}
+	if current, err := keyring.Current(clk.Now()); err == nil {
+		uc.seedCurrent = current.ID
+	}
+
+	cfg := businessConfig.GetSigningKeys()
+	if cfg.GetRefreshInterval() != nil && cfg.GetRefreshInterval().AsDuration() > 0 {
+		uc.refreshInterval = cfg.GetRefreshInterval().AsDuration()
+	}
+	if cfg.GetActivationDelay() != nil && cfg.GetActivationDelay().AsDuration() >= 0 {
+		uc.activationDelay = cfg.GetActivationDelay().AsDuration()
+	}
+	if cfg.GetAlgorithm() != "" {
+		uc.algorithm = cfg.GetAlgorithm()
+	}
+	return uc
+}
+
+// RefreshInterval 重新加载密钥的间隔
+func (uc *SigningKeyUsecase) RefreshInterval() time.Duration {
+	return uc.refreshInterval
+}
+
+// Refresh 从数据库加载密钥替换密钥环，首次加载时先写入配置中新增的密钥
+func (uc *SigningKeyUsecase) Refresh(ctx context.Context) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	keys, err := uc.repo.ListSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !uc.seeded {
+		if uc.seed(ctx, keys) {
+			if keys, err = uc.repo.ListSigningKeys(ctx); err != nil {
+				return err
+			}
+		}
+		uc.seeded = true
+	}
+
+	// 数据库为空时保留配置中的密钥，不让密钥环变空
+	if len(keys) == 0 {
+		return nil
+	}
+
+	ringKeys := make([]*auth.SigningKey, len(keys))
+	for i, key := range keys {
+		ringKeys[i] = &auth.SigningKey{
+			ID:         key.KeyID,
+			Algorithm:  key.Algorithm,
+			Secret:     key.Secret,
+			ActivateAt: key.ActivateAt,
+		}
+		if key.RetireAt != nil {
+			ringKeys[i].RetireAt = *key.RetireAt
+		}
+	}
+	// 无法解析的密钥被跳过，不影响其他密钥
+	if err := uc.keyring.Load(ringKeys); err != nil {
+		uc.log.WithContext(ctx).Warnf("reload signing keys: %v", err)
+	}
+	return nil
+}
+
+// seed 写入数据库中不存在的配置密钥，返回是否有写入。配置的签发密钥以轮换方式写入，
+// 其他实例已有密钥时延迟生效；写入失败通常是其他实例已抢先写入，只记录日志
+func (uc *SigningKeyUsecase) seed(ctx context.Context, existing []*SigningKey) bool {
+	known := make(map[string]bool, len(existing))
+	for _, key := range existing {
+		known[key.KeyID] = true
+	}
+
+	now := uc.clock.Now()
+	written := false
+	var current *auth.SigningKey
+	for _, seed := range uc.seeds {
+		if known[seed.ID] {
+			continue
+		}
+		if seed.ID == uc.seedCurrent {
+			current = seed
+			continue
+		}
+
+		key := &SigningKey{KeyID: seed.ID, Algorithm: seed.Algorithm, Secret: seed.Secret, ActivateAt: now}
+		if !seed.RetireAt.IsZero() {
+			retireAt := seed.RetireAt
+			key.RetireAt = &retireAt
+		}
+		if err := uc.repo.CreateSigningKey(ctx, key); err != nil {
+			uc.log.WithContext(ctx).Warnf("seed signing key %s failed: %v", seed.ID, err)
+			continue
+		}
+		written = true
+	}
+
+	if current != nil {
+		activateAt := now
+		if len(existing) > 0 {
+			activateAt = now.Add(uc.activationDelay)
+		}
+		key := &SigningKey{KeyID: current.ID, Algorithm: current.Algorithm, Secret: current.Secret, ActivateAt: activateAt}
+		if err := uc.repo.RotateSigningKey(ctx, key, activateAt.Add(uc.keyring.RetireAfter())); err != nil {
+			uc.log.WithContext(ctx).Warnf("seed current signing key %s failed: %v", current.ID, err)
+		} else {
+			uc.log.WithContext(ctx).Infof("signing key rotated by config: kid=%s activate_at=%s", current.ID, activateAt.Format(time.RFC3339))
+			written = true
+		}
+	}
+	return written
+}
+
+// Rotate 按配置的算法生成新密钥，新密钥在 activation_delay 后开始签发，现有密钥在新密钥生效后再过令牌最长有效期退役
+func (uc *SigningKeyUsecase) Rotate(ctx context.Context) (*SigningKey, error) {
+	suffix, err := security.GenerateRandomString(signingKeyIDSuffixLength)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	generated, err := auth.GenerateSigningKey(fmt.Sprintf("%s-%s", now.UTC().Format("20060102T150405Z"), suffix), uc.algorithm)
+	if err != nil {
+		return nil, err
+	}
+	key := &SigningKey{
+		KeyID:      generated.ID,
+		Algorithm:  uc.algorithm,
+		Secret:     generated.Secret,
+		ActivateAt: now.Add(uc.activationDelay),
+	}
+	if err := uc.repo.RotateSigningKey(ctx, key, key.ActivateAt.Add(uc.keyring.RetireAfter())); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("signing key rotated: kid=%s alg=%s activate_at=%s", key.KeyID, key.Algorithm, key.ActivateAt.Format(time.RFC3339))
+
+	if err := uc.Refresh(ctx); err != nil {
+		uc.log.WithContext(ctx).Warnf("reload signing keys after rotation failed: %v", err)
+	}
+	return key, nil
+}
+
+// ListSigningKeys 获取全部密钥，返回值不含密钥内容
+func (uc *SigningKeyUsecase) ListSigningKeys(ctx context.Context) ([]*SigningKey, error) {
+	keys, err := uc.repo.ListSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		key.Secret = ""
+	}
+	return keys, nil
+}
@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newSigningKeyTestUsecase(t *testing.T, algorithm string, keys ...*auth.SigningKey) (*SigningKeyUsecase, *MockSigningKeyRepo, *auth.Keyring, *clock.Fake) {
+	repo := NewMockSigningKeyRepo(t)
+	clk := clock.NewFake(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC))
+	keyring, err := auth.NewKeyring(auth.RefreshTokenExpiry, keys...)
+	require.NoError(t, err)
+	config := &conf.Business{
+		SigningKeys: &conf.Business_SigningKeys{ActivationDelay: durationpb.New(2 * time.Minute), Algorithm: algorithm},
+	}
+	return NewSigningKeyUsecase(repo, keyring, config, clk, log.DefaultLogger), repo, keyring, clk
+}
+
+func TestSigningKeyUsecase_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SeedEmptyDatabase", func(t *testing.T) {
+		uc, repo, keyring, clk := newSigningKeyTestUsecase(t, "", &auth.SigningKey{ID: auth.LegacyKeyID, Secret: "s"})
+		seeded := &SigningKey{KeyID: auth.LegacyKeyID, Secret: "s", ActivateAt: clk.Now()}
+
+		repo.EXPECT().ListSigningKeys(ctx).Return(nil, nil).Once()
+		// 数据库为空时配置密钥立即生效
+		repo.EXPECT().RotateSigningKey(ctx, seeded, clk.Now().Add(auth.RefreshTokenExpiry)).Return(nil)
+		repo.EXPECT().ListSigningKeys(ctx).Return([]*SigningKey{seeded}, nil).Once()
+
+		require.NoError(t, uc.Refresh(ctx))
+		current, err := keyring.Current(clk.Now())
+		require.NoError(t, err)
+		assert.Equal(t, auth.LegacyKeyID, current.ID)
+
+		// 只在首次加载时写入
+		repo.EXPECT().ListSigningKeys(ctx).Return([]*SigningKey{seeded}, nil).Once()
+		require.NoError(t, uc.Refresh(ctx))
+	})
+
+	t.Run("ConfigRotation", func(t *testing.T) {
+		uc, repo, keyring, clk := newSigningKeyTestUsecase(t, "",
+			&auth.SigningKey{ID: "k1", Secret: "s1", RetireAt: time.Date(2024, 7, 8, 12, 0, 0, 0, time.UTC)},
+			&auth.SigningKey{ID: "k2", Secret: "s2"},
+		)
+		existing := &SigningKey{KeyID: "k1", Secret: "s1", ActivateAt: clk.Now().Add(-24 * time.Hour)}
+		activateAt := clk.Now().Add(2 * time.Minute)
+		retireAt := activateAt.Add(auth.RefreshTokenExpiry)
+
+		repo.EXPECT().ListSigningKeys(ctx).Return([]*SigningKey{existing}, nil).Once()
+		repo.EXPECT().RotateSigningKey(ctx, &SigningKey{KeyID: "k2", Secret: "s2", ActivateAt: activateAt}, retireAt).Return(nil)
+		repo.EXPECT().ListSigningKeys(ctx).Return([]*SigningKey{
+			{KeyID: "k1", Secret: "s1", ActivateAt: existing.ActivateAt, RetireAt: &retireAt},
+			{KeyID: "k2", Secret: "s2", ActivateAt: activateAt},
+		}, nil).Once()
+
+		require.NoError(t, uc.Refresh(ctx))
+
+		// 新密钥生效前仍由旧密钥签发，但已可验证
+		current, err := keyring.Current(clk.Now())
+		require.NoError(t, err)
+		assert.Equal(t, "k1", current.ID)
+		_, ok := keyring.Lookup("k2", clk.Now())
+		assert.True(t, ok)
+
+		clk.Advance(3 * time.Minute)
+		current, err = keyring.Current(clk.Now())
+		require.NoError(t, err)
+		assert.Equal(t, "k2", current.ID)
+	})
+}
+
+func TestSigningKeyUsecase_Rotate(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, keyring, clk := newSigningKeyTestUsecase(t, "", &auth.SigningKey{ID: auth.LegacyKeyID, Secret: "s"})
+	legacy := &SigningKey{KeyID: auth.LegacyKeyID, Secret: "s", ActivateAt: clk.Now().Add(-time.Hour)}
+
+	var rotated *SigningKey
+	repo.EXPECT().RotateSigningKey(ctx, mock.Anything, clk.Now().Add(2*time.Minute+auth.RefreshTokenExpiry)).
+		Run(func(ctx context.Context, key *SigningKey, retireAt time.Time) {
+			rotated = key
+			legacy.RetireAt = &retireAt
+		}).Return(nil)
+	repo.EXPECT().ListSigningKeys(ctx).RunAndReturn(func(ctx context.Context) ([]*SigningKey, error) {
+		return []*SigningKey{legacy, rotated}, nil
+	})
+
+	key, err := uc.Rotate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, clk.Now().Add(2*time.Minute), key.ActivateAt)
+	assert.Equal(t, auth.AlgorithmHS256, key.Algorithm)
+	assert.NotEmpty(t, key.Secret)
+	assert.NotEqual(t, auth.LegacyKeyID, key.KeyID)
+
+	clk.Advance(2 * time.Minute)
+	current, err := keyring.Current(clk.Now())
+	require.NoError(t, err)
+	assert.Equal(t, key.KeyID, current.ID)
+
+	// 旧密钥在新密钥生效后再过令牌最长有效期退役
+	_, ok := keyring.Lookup(auth.LegacyKeyID, clk.Now().Add(auth.RefreshTokenExpiry-time.Second))
+	assert.True(t, ok)
+	_, ok = keyring.Lookup(auth.LegacyKeyID, clk.Now().Add(auth.RefreshTokenExpiry))
+	assert.False(t, ok)
+}
+
+func TestSigningKeyUsecase_RotateAsymmetric(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, keyring, clk := newSigningKeyTestUsecase(t, auth.AlgorithmEdDSA, &auth.SigningKey{ID: auth.LegacyKeyID, Secret: "s"})
+	legacy := &SigningKey{KeyID: auth.LegacyKeyID, Secret: "s", ActivateAt: clk.Now().Add(-time.Hour)}
+
+	var rotated *SigningKey
+	repo.EXPECT().RotateSigningKey(ctx, mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, key *SigningKey, retireAt time.Time) {
+			rotated = key
+		}).Return(nil)
+	repo.EXPECT().ListSigningKeys(ctx).RunAndReturn(func(ctx context.Context) ([]*SigningKey, error) {
+		return []*SigningKey{legacy, rotated}, nil
+	})
+
+	key, err := uc.Rotate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, auth.AlgorithmEdDSA, key.Algorithm)
+
+	// 新密钥生效前公钥已发布
+	set := keyring.JWKS(clk.Now())
+	require.Len(t, set.Keys, 1)
+	assert.Equal(t, key.KeyID, set.Keys[0].Kid)
+}
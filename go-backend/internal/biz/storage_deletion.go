@@ -0,0 +1,154 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultStorageCleanupInterval  = 5 * time.Minute
+	defaultStorageCleanupBatchSize = 100
+	// storageDeletionRetryDelay 删除失败后推迟重试的基础间隔，按失败次数线性增长，最长一天
+	storageDeletionRetryDelay    = 10 * time.Minute
+	maxStorageDeletionRetryDelay = 24 * time.Hour
+)
+
+// 存储对象删除原因
+const (
+	StorageDeletionReasonAccountPurge = "account_purge"
+	StorageDeletionReasonDataExport   = "data_export"
+	// StorageDeletionReasonCoverReplaced 替换封面后删除旧封面
+	StorageDeletionReasonCoverReplaced = "cover_replaced"
+)
+
+// StorageDeletion 待删除的存储对象，ObjectName 以 / 结尾时删除该前缀下的全部对象
+type StorageDeletion struct {
+	ID          int64
+	ObjectName  string
+	Reason      string
+	DeleteAfter time.Time
+	Attempts    int
+	LastError   string
+}
+
+// StorageDeletionRepo 待删除存储对象队列
+type StorageDeletionRepo interface {
+	ScheduleStorageDeletions(ctx context.Context, deletions []*StorageDeletion) error
+	// ListDueStorageDeletions 按 delete_after 升序返回已到期的记录
+	ListDueStorageDeletions(ctx context.Context, now time.Time, limit int) ([]*StorageDeletion, error)
+	DeleteStorageDeletion(ctx context.Context, id int64) error
+	// RetryStorageDeletion 记录失败原因并推迟到 retryAt
+	RetryStorageDeletion(ctx context.Context, id int64, retryAt time.Time, lastError string) error
+}
+
+// StorageDeletionUsecase 后台删除存储对象。删除与业务事务解耦：业务在事务内写入队列，
+// 由本任务异步删除，失败时推迟重试，对象已不存在视为成功
+type StorageDeletionUsecase struct {
+	repo      StorageDeletionRepo
+	storage   storage.VideoStorage
+	interval  time.Duration
+	batchSize int
+	clock     clock.Clock
+	log       *log.Helper
+}
+
+// NewStorageDeletionUsecase 创建存储对象删除任务
+func NewStorageDeletionUsecase(repo StorageDeletionRepo, storage storage.VideoStorage, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *StorageDeletionUsecase {
+	uc := &StorageDeletionUsecase{
+		repo:      repo,
+		storage:   storage,
+		interval:  defaultStorageCleanupInterval,
+		batchSize: defaultStorageCleanupBatchSize,
+		clock:     clk,
+		log:       log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetStorageCleanup(); cfg != nil {
+		if cfg.Interval != nil && cfg.Interval.AsDuration() > 0 {
+			uc.interval = cfg.Interval.AsDuration()
+		}
+		if cfg.BatchSize > 0 {
+			uc.batchSize = int(cfg.BatchSize)
+		}
+	}
+
+	return uc
+}
+
+// Interval 执行间隔
+func (uc *StorageDeletionUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Run 删除一批到期的对象，单个对象失败不影响其余对象，供调度器调用
+func (uc *StorageDeletionUsecase) Run(ctx context.Context) error {
+	now := uc.clock.Now()
+	deletions, err := uc.repo.ListDueStorageDeletions(ctx, now, uc.batchSize)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, deletion := range deletions {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := uc.delete(ctx, deletion.ObjectName); err != nil {
+			failed++
+			delay := time.Duration(deletion.Attempts+1) * storageDeletionRetryDelay
+			if delay > maxStorageDeletionRetryDelay {
+				delay = maxStorageDeletionRetryDelay
+			}
+			uc.log.WithContext(ctx).Warnf("delete storage object failed: object=%s attempts=%d err=%v", deletion.ObjectName, deletion.Attempts+1, err)
+			if err := uc.repo.RetryStorageDeletion(ctx, deletion.ID, now.Add(delay), truncateRunes(err.Error(), 500)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := uc.repo.DeleteStorageDeletion(ctx, deletion.ID); err != nil {
+			return err
+		}
+	}
+
+	if len(deletions) > 0 {
+		uc.log.WithContext(ctx).Infof("storage deletion finished: total=%d failed=%d", len(deletions), failed)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d storage deletions failed", failed, len(deletions))
+	}
+	return nil
+}
+
+// delete 删除对象或前缀下的全部对象，存储不支持遍历时无法删除前缀
+func (uc *StorageDeletionUsecase) delete(ctx context.Context, objectName string) error {
+	if !strings.HasSuffix(objectName, "/") {
+		return uc.deleteObject(ctx, objectName)
+	}
+
+	lister, ok := uc.storage.(storage.ObjectLister)
+	if !ok {
+		return fmt.Errorf("storage cannot list objects under %s", objectName)
+	}
+	return lister.ListObjects(ctx, objectName, func(object *storage.FileInfo) error {
+		return uc.deleteObject(ctx, object.Name)
+	})
+}
+
+func (uc *StorageDeletionUsecase) deleteObject(ctx context.Context, objectName string) error {
+	exists, err := uc.storage.Exists(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	return uc.storage.Delete(ctx, objectName)
+}
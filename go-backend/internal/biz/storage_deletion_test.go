@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listingStorage 在内存存储上补充按前缀遍历
+type listingStorage struct {
+	*memoryStorage
+	deleteErr error
+}
+
+func (s *listingStorage) ListObjects(_ context.Context, prefix string, fn func(*storage.FileInfo) error) error {
+	var names []string
+	for name := range s.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := fn(&storage.FileInfo{Name: name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *listingStorage) Delete(ctx context.Context, objectName string) error {
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
+	return s.memoryStorage.Delete(ctx, objectName)
+}
+
+func TestStorageDeletionUsecase_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("DeleteObjectsAndPrefixes", func(t *testing.T) {
+		repo := NewMockStorageDeletionRepo(t)
+		store := &listingStorage{memoryStorage: newMemoryStorage()}
+		store.objects["videos/1.mp4"] = []byte("video")
+		store.objects["hls/1/index.m3u8"] = []byte("playlist")
+		store.objects["hls/1/seg0.ts"] = []byte("segment")
+		store.objects["hls/10/index.m3u8"] = []byte("other")
+		uc := NewStorageDeletionUsecase(repo, store, nil, clock.NewFake(now), log.DefaultLogger)
+
+		repo.EXPECT().ListDueStorageDeletions(ctx, now, defaultStorageCleanupBatchSize).Return([]*StorageDeletion{
+			{ID: 1, ObjectName: "videos/1.mp4"},
+			{ID: 2, ObjectName: "hls/1/"},
+			{ID: 3, ObjectName: "covers/missing.jpg"},
+		}, nil)
+		repo.EXPECT().DeleteStorageDeletion(ctx, int64(1)).Return(nil)
+		repo.EXPECT().DeleteStorageDeletion(ctx, int64(2)).Return(nil)
+		repo.EXPECT().DeleteStorageDeletion(ctx, int64(3)).Return(nil)
+
+		require.NoError(t, uc.Run(ctx))
+		assert.Equal(t, map[string][]byte{"hls/10/index.m3u8": []byte("other")}, store.objects)
+	})
+
+	t.Run("RetryWithBackoff", func(t *testing.T) {
+		repo := NewMockStorageDeletionRepo(t)
+		store := &listingStorage{memoryStorage: newMemoryStorage(), deleteErr: errors.New("storage unavailable")}
+		store.objects["videos/1.mp4"] = []byte("video")
+		uc := NewStorageDeletionUsecase(repo, store, nil, clock.NewFake(now), log.DefaultLogger)
+
+		repo.EXPECT().ListDueStorageDeletions(ctx, now, defaultStorageCleanupBatchSize).Return([]*StorageDeletion{
+			{ID: 1, ObjectName: "videos/1.mp4", Attempts: 2},
+			{ID: 2, ObjectName: "videos/1.mp4", Attempts: 1000},
+		}, nil)
+		repo.EXPECT().RetryStorageDeletion(ctx, int64(1), now.Add(3*storageDeletionRetryDelay), "storage unavailable").Return(nil)
+		repo.EXPECT().RetryStorageDeletion(ctx, int64(2), now.Add(maxStorageDeletionRetryDelay), "storage unavailable").Return(nil)
+
+		assert.Error(t, uc.Run(ctx))
+	})
+}
@@ -0,0 +1,350 @@
+package biz
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/domain"
+	"go-backend/pkg/listquery"
+	"go-backend/pkg/richtext"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrTakedownNotFound        = errors.NotFound(v1.ErrorCode_TAKEDOWN_NOT_EXIST.String(), "takedown not found")
+	ErrTakedownNotAppealable   = errors.Conflict(v1.ErrorCode_TAKEDOWN_NOT_APPEALABLE.String(), "takedown is not in an appealable state")
+	ErrInvalidTakedownCategory = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "invalid takedown category")
+	ErrTakedownReasonRequired  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "takedown reason is required")
+	ErrTakedownReasonTooLong   = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "takedown reason is too long")
+	ErrAppealReasonRequired    = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "appeal reason is required")
+	ErrInvalidTakedownDecision = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "invalid takedown decision")
+)
+
+// 下架状态
+const (
+	TakedownStatusTakenDown  int32 = 1 // 已下架，作者可申诉
+	TakedownStatusAppealed   int32 = 2 // 作者已申诉，等待管理员裁决
+	TakedownStatusReinstated int32 = 3 // 申诉成功，视频已恢复
+	TakedownStatusUpheld     int32 = 4 // 申诉驳回，维持下架
+)
+
+// 申诉裁决
+const (
+	TakedownDecisionReinstate int32 = 1
+	TakedownDecisionUphold    int32 = 2
+)
+
+// 下架审计动作
+const (
+	TakedownActionTakedown  = "takedown"
+	TakedownActionAppeal    = "appeal"
+	TakedownActionReinstate = "reinstate"
+	TakedownActionUphold    = "uphold"
+)
+
+// TakedownCategories 下架原因分类
+var TakedownCategories = []string{"copyright", "illegal", "violence", "sexual", "harassment", "spam", "other"}
+
+// 下架原因、申诉理由和裁决意见的最大长度
+const maxTakedownTextLength = 500
+
+// VideoTakedown 视频下架记录
+type VideoTakedown struct {
+	ID         int64
+	VideoID    int64
+	AuthorID   int64
+	AdminID    int64
+	Category   string
+	Reason     string
+	FromStatus int32
+	// HeldObjects 已移入法律保全区的对象，键为原对象键，值为保全后的对象键
+	HeldObjects  map[string]string
+	Status       int32
+	LegalHold    bool
+	AppealReason string
+	AppealedAt   *time.Time
+	DecidedBy    int64
+	DecidedAt    *time.Time
+	DecisionNote string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// TakedownEvent 下架审计记录，下架、申诉和裁决各记录一条
+type TakedownEvent struct {
+	ID         int64
+	TakedownID int64
+	ActorID    int64
+	Action     string
+	Note       string
+	CreatedAt  time.Time
+}
+
+// TakedownFilter 下架记录查询条件
+type TakedownFilter struct {
+	// AuthorID 为 0 时不过滤作者
+	AuthorID int64
+	// Status 为 nil 时不过滤状态
+	Status *int32
+}
+
+// TakedownListSchema 下架记录列表可过滤和排序的字段，默认按下架时间倒序
+var TakedownListSchema = listquery.NewSchema("id", []listquery.Sort{{Field: "created_at", Desc: true}},
+	listquery.Field{Name: "video_id", Type: listquery.Int},
+	listquery.Field{Name: "author_id", Type: listquery.Int},
+	listquery.Field{Name: "admin_id", Type: listquery.Int},
+	listquery.Field{Name: "category", Type: listquery.String, Ops: []listquery.Op{listquery.OpEq, listquery.OpNe, listquery.OpIn}},
+	listquery.Field{Name: "status", Type: listquery.Int},
+	listquery.Field{Name: "legal_hold", Type: listquery.Bool},
+	listquery.Field{Name: "created_at", Type: listquery.Time, Sortable: true},
+	listquery.Field{Name: "appealed_at", Type: listquery.Time, Sortable: true},
+)
+
+// TakedownRepo 下架记录仓储接口
+type TakedownRepo interface {
+	// CreateTakedown 在事务内将视频置为已下架并写入下架记录和审计记录，视频不存在或已下架时返回ErrVideoNotFound
+	CreateTakedown(context.Context, *VideoTakedown) (*domain.Video, error)
+	// SetHeldObjects 记录已移入保全区的对象
+	SetHeldObjects(ctx context.Context, id int64, objects map[string]string) error
+	// GetTakedown 获取下架记录，不存在时返回ErrTakedownNotFound
+	GetTakedown(context.Context, int64) (*VideoTakedown, error)
+	// ListTakedowns 按条件和已校验的列表表达式分页查询
+	ListTakedowns(context.Context, *TakedownFilter, *listquery.Compiled) ([]*VideoTakedown, int64, error)
+	// AppealTakedown 作者提交申诉，记录不属于该作者时返回ErrTakedownNotFound，不是已下架状态时返回ErrTakedownNotAppealable
+	AppealTakedown(ctx context.Context, id, authorID int64, reason string) (*VideoTakedown, error)
+	// DecideAppeal 裁决申诉，恢复时同时还原视频状态并解除保全，不是已申诉状态时返回ErrTakedownNotAppealable
+	DecideAppeal(ctx context.Context, id, adminID int64, reinstate bool, note string) (*VideoTakedown, error)
+	// ListTakedownEvents 获取下架记录的审计记录，按时间正序
+	ListTakedownEvents(context.Context, int64) ([]*TakedownEvent, error)
+}
+
+// TakedownNotifier 通知创作者下架和申诉结果
+type TakedownNotifier interface {
+	// NotifyTakedown 通知视频被下架，通知中需包含申诉入口
+	NotifyTakedown(ctx context.Context, takedown *VideoTakedown) error
+	// NotifyAppealDecision 通知申诉裁决结果
+	NotifyAppealDecision(ctx context.Context, takedown *VideoTakedown) error
+}
+
+// TakedownUsecase 内容下架用例：管理员按原因分类下架视频，视频文件移入法律保全区，
+// 创作者可申诉一次，申诉由管理员裁决，全程写入审计记录
+type TakedownUsecase struct {
+	repo         TakedownRepo
+	storage      storage.VideoStorage
+	notifier     TakedownNotifier
+	permissionUc *PermissionUsecase
+	log          *log.Helper
+}
+
+// NewTakedownUsecase 创建内容下架用例
+func NewTakedownUsecase(repo TakedownRepo, storage storage.VideoStorage, notifier TakedownNotifier, permissionUc *PermissionUsecase, logger log.Logger) *TakedownUsecase {
+	return &TakedownUsecase{
+		repo:         repo,
+		storage:      storage,
+		notifier:     notifier,
+		permissionUc: permissionUc,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// TakedownVideo 管理员下架视频。先更新数据库让视频立即不可见，再把视频文件移入保全区，
+// 移动失败的文件留在原位置，不影响保全
+func (uc *TakedownUsecase) TakedownVideo(ctx context.Context, adminID, videoID int64, category, reason string) (*VideoTakedown, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	if !isTakedownCategory(category) {
+		return nil, ErrInvalidTakedownCategory
+	}
+	reason = richtext.Sanitize(reason)
+	if reason == "" {
+		return nil, ErrTakedownReasonRequired
+	}
+	if utf8.RuneCountInString(reason) > maxTakedownTextLength {
+		return nil, ErrTakedownReasonTooLong
+	}
+
+	takedown := &VideoTakedown{
+		VideoID:   videoID,
+		AdminID:   adminID,
+		Category:  category,
+		Reason:    reason,
+		Status:    TakedownStatusTakenDown,
+		LegalHold: true,
+	}
+	video, err := uc.repo.CreateTakedown(ctx, takedown)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin %d took down video %d: category=%s takedown=%d", adminID, videoID, category, takedown.ID)
+
+	if held := uc.holdObjects(ctx, video); len(held) > 0 {
+		if err := uc.repo.SetHeldObjects(ctx, takedown.ID, held); err != nil {
+			uc.log.WithContext(ctx).Errorf("save held objects failed: takedown=%d objects=%v err=%v", takedown.ID, held, err)
+		}
+		takedown.HeldObjects = held
+	}
+
+	if err := uc.notifier.NotifyTakedown(ctx, takedown); err != nil {
+		uc.log.WithContext(ctx).Warnf("notify takedown failed: takedown=%d err=%v", takedown.ID, err)
+	}
+
+	return takedown, nil
+}
+
+// AppealTakedown 创作者对下架提出申诉，每条下架记录只能申诉一次
+func (uc *TakedownUsecase) AppealTakedown(ctx context.Context, userID, takedownID int64, reason string) (*VideoTakedown, error) {
+	reason = richtext.Sanitize(reason)
+	if reason == "" {
+		return nil, ErrAppealReasonRequired
+	}
+	if utf8.RuneCountInString(reason) > maxTakedownTextLength {
+		return nil, ErrTakedownReasonTooLong
+	}
+
+	return uc.repo.AppealTakedown(ctx, takedownID, userID, reason)
+}
+
+// DecideAppeal 管理员裁决申诉。恢复时把保全区的文件移回原位置；维持下架时保全继续有效
+func (uc *TakedownUsecase) DecideAppeal(ctx context.Context, adminID, takedownID int64, decision int32, note string) (*VideoTakedown, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	if decision != TakedownDecisionReinstate && decision != TakedownDecisionUphold {
+		return nil, ErrInvalidTakedownDecision
+	}
+	note = richtext.Sanitize(note)
+	if utf8.RuneCountInString(note) > maxTakedownTextLength {
+		return nil, ErrTakedownReasonTooLong
+	}
+
+	takedown, err := uc.repo.DecideAppeal(ctx, takedownID, adminID, decision == TakedownDecisionReinstate, note)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin %d decided takedown %d appeal: status=%d", adminID, takedownID, takedown.Status)
+
+	if takedown.Status == TakedownStatusReinstated {
+		uc.releaseObjects(ctx, takedown)
+	}
+
+	if err := uc.notifier.NotifyAppealDecision(ctx, takedown); err != nil {
+		uc.log.WithContext(ctx).Warnf("notify appeal decision failed: takedown=%d err=%v", takedown.ID, err)
+	}
+
+	return takedown, nil
+}
+
+// ListTakedowns 管理员分页查询下架记录
+func (uc *TakedownUsecase) ListTakedowns(ctx context.Context, adminID int64, status *int32, q *listquery.Query) ([]*VideoTakedown, int64, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, 0, err
+	}
+
+	compiled, err := compileListQuery(TakedownListSchema, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.ListTakedowns(ctx, &TakedownFilter{Status: status}, compiled)
+}
+
+// ListMyTakedowns 创作者查询自己被下架的视频
+func (uc *TakedownUsecase) ListMyTakedowns(ctx context.Context, userID int64, page, size int32) ([]*VideoTakedown, int64, error) {
+	compiled, err := compileListQuery(TakedownListSchema, &listquery.Query{Page: page, Size: size})
+	if err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.ListTakedowns(ctx, &TakedownFilter{AuthorID: userID}, compiled)
+}
+
+// GetTakedownEvents 管理员查看下架记录的完整审计记录
+func (uc *TakedownUsecase) GetTakedownEvents(ctx context.Context, adminID, takedownID int64) (*VideoTakedown, []*TakedownEvent, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, nil, err
+	}
+
+	takedown, err := uc.repo.GetTakedown(ctx, takedownID)
+	if err != nil {
+		return nil, nil, err
+	}
+	events, err := uc.repo.ListTakedownEvents(ctx, takedownID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return takedown, events, nil
+}
+
+// holdObjects 把视频原文件、各清晰度文件、HLS主播放列表和封面移入保全区，存储不支持保全时跳过。
+// HLS分片不单独保全，移走主播放列表后客户端无法再拉流
+func (uc *TakedownUsecase) holdObjects(ctx context.Context, video *domain.Video) map[string]string {
+	resolver, ok := uc.storage.(storage.ObjectResolver)
+	if !ok {
+		return nil
+	}
+	holder, ok := uc.storage.(storage.LegalHoldStorage)
+	if !ok {
+		uc.log.WithContext(ctx).Warnf("storage does not support legal hold, video %d objects stay in place", video.ID)
+		return nil
+	}
+
+	urls := []string{video.PlayURL, video.CoverURL, video.DynamicCoverURL, video.HLSURL}
+	for _, url := range video.PlayURLs {
+		urls = append(urls, url)
+	}
+
+	held := make(map[string]string, len(urls))
+	for _, url := range urls {
+		objectName, ok := resolver.ObjectName(url)
+		if !ok {
+			continue
+		}
+		if _, done := held[objectName]; done {
+			continue
+		}
+		heldName, err := holder.Hold(ctx, objectName)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("hold object failed: video=%d object=%s err=%v", video.ID, objectName, err)
+			continue
+		}
+		held[objectName] = heldName
+	}
+	return held
+}
+
+// releaseObjects 把保全区的文件移回原位置，失败时记录日志由运维处理
+func (uc *TakedownUsecase) releaseObjects(ctx context.Context, takedown *VideoTakedown) {
+	holder, ok := uc.storage.(storage.LegalHoldStorage)
+	if !ok {
+		return
+	}
+	for objectName, heldName := range takedown.HeldObjects {
+		if _, err := holder.Release(ctx, heldName); err != nil {
+			uc.log.WithContext(ctx).Errorf("release held object failed: takedown=%d object=%s held=%s err=%v", takedown.ID, objectName, heldName, err)
+		}
+	}
+}
+
+func (uc *TakedownUsecase) requireAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := uc.permissionUc.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func isTakedownCategory(category string) bool {
+	for _, c := range TakedownCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
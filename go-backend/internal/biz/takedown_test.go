@@ -0,0 +1,234 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// holdingStorage 支持法律保全的内存存储
+type holdingStorage struct {
+	*memoryStorage
+}
+
+func (s *holdingStorage) Hold(_ context.Context, objectName string) (string, error) {
+	held := "legal-hold/" + objectName
+	s.objects[held] = s.objects[objectName]
+	delete(s.objects, objectName)
+	return held, nil
+}
+
+func (s *holdingStorage) Release(_ context.Context, heldName string) (string, error) {
+	objectName := strings.TrimPrefix(heldName, "legal-hold/")
+	s.objects[objectName] = s.objects[heldName]
+	delete(s.objects, heldName)
+	return objectName, nil
+}
+
+type takedownTestDeps struct {
+	repo     *MockTakedownRepo
+	notifier *MockTakedownNotifier
+	roleRepo *MockRoleRepo
+	storage  *holdingStorage
+	uc       *TakedownUsecase
+}
+
+func newTakedownTestDeps(t *testing.T) *takedownTestDeps {
+	repo := NewMockTakedownRepo(t)
+	notifier := NewMockTakedownNotifier(t)
+	roleRepo := NewMockRoleRepo(t)
+	store := &holdingStorage{newMemoryStorage()}
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+
+	return &takedownTestDeps{
+		repo:     repo,
+		notifier: notifier,
+		roleRepo: roleRepo,
+		storage:  store,
+		uc:       NewTakedownUsecase(repo, store, notifier, permissionUc, log.DefaultLogger),
+	}
+}
+
+func (d *takedownTestDeps) expectAdmin(ctx context.Context, userID int64, isAdmin bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(isAdmin, nil)
+}
+
+func TestTakedownUsecase_TakedownVideo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("HoldsObjectsAndNotifies", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.storage.objects["videos/a.mp4"] = []byte("video")
+		d.storage.objects["covers/a.jpg"] = []byte("cover")
+		d.storage.objects["renditions/a_720p.mp4"] = []byte("720p")
+
+		video := &domain.Video{
+			ID:       10,
+			AuthorID: 2,
+			PlayURL:  "https://cdn.example.com/videos/a.mp4",
+			CoverURL: "https://cdn.example.com/covers/a.jpg",
+			PlayURLs: map[string]string{"720p": "https://cdn.example.com/renditions/a_720p.mp4"},
+		}
+		d.repo.EXPECT().CreateTakedown(ctx, mock.MatchedBy(func(td *VideoTakedown) bool {
+			return td.VideoID == 10 && td.AdminID == 1 && td.Category == "copyright" && td.Reason == "reported" && td.LegalHold
+		})).RunAndReturn(func(_ context.Context, td *VideoTakedown) (*domain.Video, error) {
+			td.ID = 100
+			td.AuthorID = 2
+			return video, nil
+		})
+		held := map[string]string{
+			"videos/a.mp4":          "legal-hold/videos/a.mp4",
+			"covers/a.jpg":          "legal-hold/covers/a.jpg",
+			"renditions/a_720p.mp4": "legal-hold/renditions/a_720p.mp4",
+		}
+		d.repo.EXPECT().SetHeldObjects(ctx, int64(100), held).Return(nil)
+		d.notifier.EXPECT().NotifyTakedown(ctx, mock.MatchedBy(func(td *VideoTakedown) bool {
+			return td.ID == 100 && td.AuthorID == 2
+		})).Return(nil)
+
+		takedown, err := d.uc.TakedownVideo(ctx, 1, 10, "copyright", " reported ")
+		require.NoError(t, err)
+		assert.Equal(t, held, takedown.HeldObjects)
+		assert.NotContains(t, d.storage.objects, "videos/a.mp4")
+		assert.Equal(t, []byte("video"), d.storage.objects["legal-hold/videos/a.mp4"])
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, false)
+
+		_, err := d.uc.TakedownVideo(ctx, 1, 10, "copyright", "reported")
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("InvalidCategory", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+
+		_, err := d.uc.TakedownVideo(ctx, 1, 10, "boring", "reported")
+		assert.Equal(t, ErrInvalidTakedownCategory, err)
+	})
+
+	t.Run("ReasonRequired", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+
+		_, err := d.uc.TakedownVideo(ctx, 1, 10, "spam", "  ")
+		assert.Equal(t, ErrTakedownReasonRequired, err)
+	})
+
+	t.Run("VideoNotFound", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().CreateTakedown(ctx, mock.Anything).Return(nil, utils.ErrVideoNotFound)
+
+		_, err := d.uc.TakedownVideo(ctx, 1, 10, "spam", "spam")
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+}
+
+func TestTakedownUsecase_AppealTakedown(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Appeal", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.repo.EXPECT().AppealTakedown(ctx, int64(100), int64(2), "fair use").
+			Return(&VideoTakedown{ID: 100, Status: TakedownStatusAppealed}, nil)
+
+		takedown, err := d.uc.AppealTakedown(ctx, 2, 100, "fair use")
+		require.NoError(t, err)
+		assert.Equal(t, TakedownStatusAppealed, takedown.Status)
+	})
+
+	t.Run("ReasonRequired", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+
+		_, err := d.uc.AppealTakedown(ctx, 2, 100, "")
+		assert.Equal(t, ErrAppealReasonRequired, err)
+	})
+}
+
+func TestTakedownUsecase_DecideAppeal(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ReinstateReleasesObjects", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.storage.objects["legal-hold/videos/a.mp4"] = []byte("video")
+
+		decided := &VideoTakedown{
+			ID:          100,
+			Status:      TakedownStatusReinstated,
+			HeldObjects: map[string]string{"videos/a.mp4": "legal-hold/videos/a.mp4"},
+		}
+		d.repo.EXPECT().DecideAppeal(ctx, int64(100), int64(1), true, "fair use").Return(decided, nil)
+		d.notifier.EXPECT().NotifyAppealDecision(ctx, decided).Return(nil)
+
+		_, err := d.uc.DecideAppeal(ctx, 1, 100, TakedownDecisionReinstate, "fair use")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("video"), d.storage.objects["videos/a.mp4"])
+		assert.NotContains(t, d.storage.objects, "legal-hold/videos/a.mp4")
+	})
+
+	t.Run("UpholdKeepsHold", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.storage.objects["legal-hold/videos/a.mp4"] = []byte("video")
+
+		decided := &VideoTakedown{
+			ID:          100,
+			Status:      TakedownStatusUpheld,
+			LegalHold:   true,
+			HeldObjects: map[string]string{"videos/a.mp4": "legal-hold/videos/a.mp4"},
+		}
+		d.repo.EXPECT().DecideAppeal(ctx, int64(100), int64(1), false, "").Return(decided, nil)
+		d.notifier.EXPECT().NotifyAppealDecision(ctx, decided).Return(nil)
+
+		_, err := d.uc.DecideAppeal(ctx, 1, 100, TakedownDecisionUphold, "")
+		require.NoError(t, err)
+		assert.Contains(t, d.storage.objects, "legal-hold/videos/a.mp4")
+	})
+
+	t.Run("InvalidDecision", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+
+		_, err := d.uc.DecideAppeal(ctx, 1, 100, 3, "")
+		assert.Equal(t, ErrInvalidTakedownDecision, err)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newTakedownTestDeps(t)
+		d.expectAdmin(ctx, 2, false)
+
+		_, err := d.uc.DecideAppeal(ctx, 2, 100, TakedownDecisionReinstate, "")
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
+
+func TestTakedownUsecase_GetTakedownEvents(t *testing.T) {
+	ctx := context.Background()
+	d := newTakedownTestDeps(t)
+	d.expectAdmin(ctx, 1, true)
+
+	takedown := &VideoTakedown{ID: 100}
+	events := []*TakedownEvent{{ID: 1, TakedownID: 100, Action: TakedownActionTakedown}}
+	d.repo.EXPECT().GetTakedown(ctx, int64(100)).Return(takedown, nil)
+	d.repo.EXPECT().ListTakedownEvents(ctx, int64(100)).Return(events, nil)
+
+	gotTakedown, gotEvents, err := d.uc.GetTakedownEvents(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, takedown, gotTakedown)
+	assert.Equal(t, events, gotEvents)
+}
@@ -0,0 +1,163 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultTrendingBucket          = time.Hour
+	defaultTrendingWindow          = 24
+	defaultTrendingDecay           = 0.8
+	defaultTrendingRefreshInterval = time.Minute
+	defaultTrendingMaxSize         = 500
+
+	// defaultTrendingLimit GetTrending 未指定数量时返回的视频数
+	defaultTrendingLimit = 20
+	// maxTrendingLimit GetTrending 单次最多返回的视频数
+	maxTrendingLimit = 50
+)
+
+// trendingWeights 各项计数每增加1带来的热度，评论比点赞更能说明视频的热度
+var trendingWeights = map[string]float64{
+	"play_count":     1,
+	"favorite_count": 5,
+	"comment_count":  10,
+}
+
+// TrendingRepo 热门视频仓储。热度先按时间桶累加，再按桶的新旧加权合并为排行榜
+type TrendingRepo interface {
+	// Incr 把视频的热度累加到指定时间桶，桶在 ttl 后过期
+	Incr(ctx context.Context, bucket int64, videoID int64, score float64, ttl time.Duration) error
+	// Rebuild 把各时间桶按权重合并为排行榜，只保留热度最高的 size 个视频
+	Rebuild(ctx context.Context, buckets []int64, weights []float64, size int) error
+	// Top 按热度从高到低返回排行榜中前 limit 个视频ID
+	Top(ctx context.Context, limit int) ([]int64, error)
+}
+
+// TrendingUsecase 热门视频用例。统计消费者按视频计数更新事件累加热度，
+// 定时任务把最近 window 个时间桶按 decay 衰减后合并为排行榜，越早的热度占比越低
+type TrendingUsecase struct {
+	repo      TrendingRepo
+	videoRepo VideoRepo
+
+	bucket          time.Duration
+	window          int
+	decay           float64
+	refreshInterval time.Duration
+	maxSize         int
+
+	clock clock.Clock
+	log   *log.Helper
+}
+
+// NewTrendingUsecase 创建热门视频用例
+func NewTrendingUsecase(repo TrendingRepo, videoRepo VideoRepo, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *TrendingUsecase {
+	uc := &TrendingUsecase{
+		repo:            repo,
+		videoRepo:       videoRepo,
+		bucket:          defaultTrendingBucket,
+		window:          defaultTrendingWindow,
+		decay:           defaultTrendingDecay,
+		refreshInterval: defaultTrendingRefreshInterval,
+		maxSize:         defaultTrendingMaxSize,
+		clock:           clk,
+		log:             log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetTrending(); cfg != nil {
+		if cfg.Bucket != nil && cfg.Bucket.AsDuration() > 0 {
+			uc.bucket = cfg.Bucket.AsDuration()
+		}
+		if cfg.Window > 0 {
+			uc.window = int(cfg.Window)
+		}
+		if cfg.Decay > 0 && cfg.Decay <= 1 {
+			uc.decay = cfg.Decay
+		}
+		if cfg.RefreshInterval != nil && cfg.RefreshInterval.AsDuration() > 0 {
+			uc.refreshInterval = cfg.RefreshInterval.AsDuration()
+		}
+		if cfg.MaxSize > 0 {
+			uc.maxSize = int(cfg.MaxSize)
+		}
+	}
+
+	return uc
+}
+
+// RefreshInterval 排行榜合并间隔
+func (uc *TrendingUsecase) RefreshInterval() time.Duration {
+	return uc.refreshInterval
+}
+
+// RecordStats 按视频计数的增量累加热度，取消点赞、删除评论等减少的计数不扣减热度
+func (uc *TrendingUsecase) RecordStats(ctx context.Context, videoID int64, statsType string, delta int64, at time.Time) error {
+	weight, ok := trendingWeights[statsType]
+	if !ok || delta <= 0 {
+		return nil
+	}
+
+	// 超出窗口的桶不再参与合并，多保留一个桶避免合并时恰好过期
+	ttl := uc.bucket * time.Duration(uc.window+1)
+	return uc.repo.Incr(ctx, uc.bucketOf(at), videoID, weight*float64(delta), ttl)
+}
+
+// Refresh 合并最近的时间桶，当前桶权重为1，每早一个桶乘以一次 decay
+func (uc *TrendingUsecase) Refresh(ctx context.Context) error {
+	current := uc.bucketOf(uc.clock.Now())
+	buckets := make([]int64, uc.window)
+	weights := make([]float64, uc.window)
+	for i := 0; i < uc.window; i++ {
+		buckets[i] = current - int64(i)
+		weights[i] = math.Pow(uc.decay, float64(i))
+	}
+	return uc.repo.Rebuild(ctx, buckets, weights, uc.maxSize)
+}
+
+// GetTrending 获取热门视频，按热度从高到低排列。已删除、下架、未发布或非公开的视频不返回，
+// 因此返回的数量可能少于 limit
+func (uc *TrendingUsecase) GetTrending(ctx context.Context, limit int) ([]*domain.Video, error) {
+	if limit <= 0 {
+		limit = defaultTrendingLimit
+	}
+	if limit > maxTrendingLimit {
+		limit = maxTrendingLimit
+	}
+
+	ids, err := uc.repo.Top(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*domain.Video{}, nil
+	}
+
+	videos, err := uc.videoRepo.GetVideos(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*domain.Video, len(videos))
+	for _, video := range videos {
+		byID[video.ID] = video
+	}
+
+	result := make([]*domain.Video, 0, len(ids))
+	for _, id := range ids {
+		if video, ok := byID[id]; ok && video.Status == domain.VideoStatusPublished && video.IsPublic() {
+			result = append(result, video)
+		}
+	}
+	return result, nil
+}
+
+func (uc *TrendingUsecase) bucketOf(t time.Time) int64 {
+	return t.UnixNano() / int64(uc.bucket)
+}
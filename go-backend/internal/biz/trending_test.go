@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newTrendingTestUsecase(t *testing.T, now time.Time) (*TrendingUsecase, *MockTrendingRepo, *MockVideoRepo) {
+	repo := NewMockTrendingRepo(t)
+	videoRepo := NewMockVideoRepo(t)
+	config := &conf.Business{
+		Trending: &conf.Business_Trending{
+			Bucket:  durationpb.New(time.Hour),
+			Window:  3,
+			Decay:   0.5,
+			MaxSize: 100,
+		},
+	}
+	return NewTrendingUsecase(repo, videoRepo, config, clock.NewFake(now), log.DefaultLogger), repo, videoRepo
+}
+
+func TestTrendingUsecase_RecordStats(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	bucket := at.Unix() / 3600
+
+	uc, repo, _ := newTrendingTestUsecase(t, at)
+	repo.EXPECT().Incr(ctx, bucket, int64(10), float64(3), 4*time.Hour).Return(nil).Once()
+	repo.EXPECT().Incr(ctx, bucket, int64(10), float64(5), 4*time.Hour).Return(nil).Once()
+
+	require.NoError(t, uc.RecordStats(ctx, 10, "play_count", 3, at))
+	require.NoError(t, uc.RecordStats(ctx, 10, "favorite_count", 1, at))
+	// 取消点赞和未知类型不影响热度
+	require.NoError(t, uc.RecordStats(ctx, 10, "favorite_count", -1, at))
+	require.NoError(t, uc.RecordStats(ctx, 10, "share", 1, at))
+}
+
+func TestTrendingUsecase_Refresh(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+	current := now.Unix() / 3600
+
+	uc, repo, _ := newTrendingTestUsecase(t, now)
+	repo.EXPECT().Rebuild(ctx, []int64{current, current - 1, current - 2}, []float64{1, 0.5, 0.25}, 100).Return(nil).Once()
+
+	require.NoError(t, uc.Refresh(ctx))
+}
+
+func TestTrendingUsecase_GetTrending(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("KeepRankAndSkipUnpublished", func(t *testing.T) {
+		uc, repo, videoRepo := newTrendingTestUsecase(t, time.Now())
+		repo.EXPECT().Top(ctx, defaultTrendingLimit).Return([]int64{3, 1, 2}, nil)
+		videoRepo.EXPECT().GetVideos(ctx, []int64{3, 1, 2}).Return([]*domain.Video{
+			{ID: 1, Status: domain.VideoStatusPublished},
+			{ID: 2, Status: domain.VideoStatusPending},
+			{ID: 3, Status: domain.VideoStatusPublished},
+		}, nil)
+
+		videos, err := uc.GetTrending(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, videos, 2)
+		assert.Equal(t, int64(3), videos[0].ID)
+		assert.Equal(t, int64(1), videos[1].ID)
+	})
+
+	t.Run("ClampLimit", func(t *testing.T) {
+		uc, repo, _ := newTrendingTestUsecase(t, time.Now())
+		repo.EXPECT().Top(ctx, maxTrendingLimit).Return(nil, nil)
+
+		videos, err := uc.GetTrending(ctx, 1000)
+		require.NoError(t, err)
+		assert.Empty(t, videos)
+	})
+}
@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	ErrInvalidChecksum        = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "checksum must be md5:<32 hex> or crc32:<8 hex>")
+	ErrPartChecksumMismatch   = errors.BadRequest(v1.ErrorCode_PART_CHECKSUM_MISMATCH.String(), "part checksum mismatch")
+	ErrUploadChecksumMismatch = errors.BadRequest(v1.ErrorCode_UPLOAD_CHECKSUM_MISMATCH.String(), "upload checksum mismatch")
+)
+
+// 校验算法
+const (
+	ChecksumMD5   = "md5"
+	ChecksumCRC32 = "crc32"
+)
+
+// 分片校验状态
+const (
+	PartChecksumStatusVerified  int32 = 1 // 校验通过
+	PartChecksumStatusMismatch  int32 = 2 // 校验失败，分片未写入存储
+	PartChecksumStatusUnchecked int32 = 3 // 客户端未提供校验值
+)
+
+// Checksum 客户端提供的校验值，Value 为小写十六进制
+type Checksum struct {
+	Algorithm string
+	Value     string
+}
+
+// ParseChecksum 解析 算法:十六进制值 格式的校验值，空字符串返回 nil
+func ParseChecksum(s string) (*Checksum, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	algorithm, value, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return nil, ErrInvalidChecksum
+	}
+	checksum := &Checksum{Algorithm: strings.ToLower(algorithm), Value: strings.ToLower(value)}
+
+	var size int
+	switch checksum.Algorithm {
+	case ChecksumMD5:
+		size = md5.Size
+	case ChecksumCRC32:
+		size = crc32.Size
+	default:
+		return nil, ErrInvalidChecksum
+	}
+	if decoded, err := hex.DecodeString(checksum.Value); err != nil || len(decoded) != size {
+		return nil, ErrInvalidChecksum
+	}
+	return checksum, nil
+}
+
+// String 返回 算法:十六进制值 格式
+func (c *Checksum) String() string {
+	return c.Algorithm + ":" + c.Value
+}
+
+// PartChecksum 分片的校验记录，Actual 为服务端按 Algorithm 计算的值
+type PartChecksum struct {
+	PartNumber int
+	Size       int64
+	Algorithm  string
+	Expected   string
+	Actual     string
+	Status     int32
+	UploadedAt time.Time
+}
+
+// UploadChecksumRepo 分片校验记录仓储接口，记录随上传会话过期
+type UploadChecksumRepo interface {
+	// SavePartChecksum 记录分片校验结果，同一分片重传时覆盖
+	SavePartChecksum(ctx context.Context, uploadID string, part *PartChecksum) error
+	// ListPartChecksums 按分片号升序返回上传的全部分片记录
+	ListPartChecksums(ctx context.Context, uploadID string) ([]*PartChecksum, error)
+	// DeleteUploadChecksums 删除上传的全部分片记录
+	DeleteUploadChecksums(ctx context.Context, uploadID string) error
+}
+
+// checkPart 计算分片校验值并与客户端提供的值比较，未提供时按MD5记录供事后核对
+func checkPart(partNumber int, data []byte, expected *Checksum) *PartChecksum {
+	algorithm := ChecksumMD5
+	if expected != nil {
+		algorithm = expected.Algorithm
+	}
+
+	h := newChecksumHash(algorithm)
+	h.Write(data)
+
+	part := &PartChecksum{
+		PartNumber: partNumber,
+		Size:       int64(len(data)),
+		Algorithm:  algorithm,
+		Actual:     hex.EncodeToString(h.Sum(nil)),
+		Status:     PartChecksumStatusUnchecked,
+		UploadedAt: time.Now(),
+	}
+	if expected != nil {
+		part.Expected = expected.Value
+		part.Status = PartChecksumStatusVerified
+		if part.Actual != expected.Value {
+			part.Status = PartChecksumStatusMismatch
+		}
+	}
+	return part
+}
+
+// verifyChecksum 流式计算 reader 的校验值并与期望值比较
+func verifyChecksum(reader io.Reader, expected *Checksum) (bool, error) {
+	h := newChecksumHash(expected.Algorithm)
+	if _, err := io.Copy(h, reader); err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(h.Sum(nil)) == expected.Value, nil
+}
+
+func newChecksumHash(algorithm string) hash.Hash {
+	if algorithm == ChecksumCRC32 {
+		return crc32.NewIEEE()
+	}
+	return md5.New()
+}
@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+	"testing"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// multipartMemoryStorage 内存分片存储，合并时按分片号拼接
+type multipartMemoryStorage struct {
+	*memoryStorage
+	parts map[int][]byte
+}
+
+func (s *multipartMemoryStorage) InitiateMultipartUpload(_ context.Context, key string, _ *storage.MultipartUploadOptions) (*storage.MultipartUploadInfo, error) {
+	return &storage.MultipartUploadInfo{UploadID: key, Key: key}, nil
+}
+
+func (s *multipartMemoryStorage) UploadPart(_ context.Context, _ string, partNumber int, reader io.Reader, size int64) (*storage.PartInfo, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	s.parts[partNumber] = data
+	return &storage.PartInfo{PartNumber: partNumber, ETag: fmt.Sprintf("part-%d", partNumber), Size: size}, nil
+}
+
+func (s *multipartMemoryStorage) CompleteMultipartUpload(_ context.Context, uploadID string, parts []storage.PartInfo) (*storage.FileInfo, error) {
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	var buf bytes.Buffer
+	for _, part := range parts {
+		buf.Write(s.parts[part.PartNumber])
+	}
+	s.objects[uploadID] = buf.Bytes()
+	return &storage.FileInfo{Name: uploadID, Size: int64(buf.Len()), URL: "https://cdn.example.com/" + uploadID}, nil
+}
+
+func (s *multipartMemoryStorage) AbortMultipartUpload(context.Context, string) error {
+	return nil
+}
+
+func (s *multipartMemoryStorage) ListParts(context.Context, string) ([]storage.PartInfo, error) {
+	return nil, nil
+}
+
+type uploadChecksumTestDeps struct {
+	repo      *MockVideoRepo
+	checksums *MockUploadChecksumRepo
+	storage   *multipartMemoryStorage
+	uc        *VideoUsecase
+}
+
+func newUploadChecksumTestDeps(t *testing.T) *uploadChecksumTestDeps {
+	repo := NewMockVideoRepo(t)
+	checksums := NewMockUploadChecksumRepo(t)
+	store := &multipartMemoryStorage{memoryStorage: newMemoryStorage(), parts: make(map[int][]byte)}
+	return &uploadChecksumTestDeps{
+		repo:      repo,
+		checksums: checksums,
+		storage:   store,
+		uc:        NewVideoUseCase(repo, nil, nil, checksums, store, nil, newRankingBusinessConfig(0), newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), newTestLocker(), clock.New(), log.DefaultLogger),
+	}
+}
+
+func md5Checksum(data []byte) string {
+	sum := md5.Sum(data)
+	return "md5:" + hex.EncodeToString(sum[:])
+}
+
+func TestParseChecksum(t *testing.T) {
+	checksum, err := ParseChecksum("MD5:D41D8CD98F00B204E9800998ECF8427E")
+	require.NoError(t, err)
+	assert.Equal(t, &Checksum{Algorithm: ChecksumMD5, Value: "d41d8cd98f00b204e9800998ecf8427e"}, checksum)
+
+	checksum, err = ParseChecksum("crc32:352441c2")
+	require.NoError(t, err)
+	assert.Equal(t, "crc32:352441c2", checksum.String())
+
+	checksum, err = ParseChecksum("")
+	require.NoError(t, err)
+	assert.Nil(t, checksum)
+
+	for _, invalid := range []string{"d41d8cd98f00b204e9800998ecf8427e", "sha1:abcd", "md5:xyz", "crc32:352441c2ff"} {
+		_, err := ParseChecksum(invalid)
+		assert.Equal(t, ErrInvalidChecksum, err, invalid)
+	}
+}
+
+func TestVideoUsecase_UploadPart(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("part data")
+
+	t.Run("Verified", func(t *testing.T) {
+		d := newUploadChecksumTestDeps(t)
+		crc := fmt.Sprintf("crc32:%08x", crc32.ChecksumIEEE(data))
+		d.checksums.EXPECT().SavePartChecksum(ctx, "u1", mock.MatchedBy(func(p *PartChecksum) bool {
+			return p.PartNumber == 1 && p.Algorithm == ChecksumCRC32 && p.Status == PartChecksumStatusVerified && p.Size == int64(len(data))
+		})).Return(nil)
+
+		part, err := d.uc.UploadPart(ctx, "u1", 1, bytes.NewReader(data), int64(len(data)), crc)
+		require.NoError(t, err)
+		assert.Equal(t, 1, part.PartNumber)
+		assert.Equal(t, data, d.storage.parts[1])
+	})
+
+	t.Run("MismatchNotStored", func(t *testing.T) {
+		d := newUploadChecksumTestDeps(t)
+		d.checksums.EXPECT().SavePartChecksum(ctx, "u1", mock.MatchedBy(func(p *PartChecksum) bool {
+			return p.Status == PartChecksumStatusMismatch && p.Expected == "00000000"
+		})).Return(nil)
+
+		_, err := d.uc.UploadPart(ctx, "u1", 2, bytes.NewReader(data), int64(len(data)), "crc32:00000000")
+		assert.Equal(t, ErrPartChecksumMismatch, err)
+		assert.NotContains(t, d.storage.parts, 2)
+	})
+
+	t.Run("UncheckedRecordsMD5", func(t *testing.T) {
+		d := newUploadChecksumTestDeps(t)
+		d.checksums.EXPECT().SavePartChecksum(ctx, "u1", mock.MatchedBy(func(p *PartChecksum) bool {
+			return p.Status == PartChecksumStatusUnchecked && "md5:"+p.Actual == md5Checksum(data)
+		})).Return(assert.AnError)
+
+		_, err := d.uc.UploadPart(ctx, "u1", 3, bytes.NewReader(data), int64(len(data)), "")
+		require.NoError(t, err)
+	})
+}
+
+func TestVideoUsecase_CompleteMultipartUploadChecksum(t *testing.T) {
+	require.NoError(t, utils.InitSnowflake(1, 1))
+	ctx := context.Background()
+	parts := []storage.PartInfo{{PartNumber: 1, Size: 5}, {PartNumber: 2, Size: 5}}
+
+	setup := func(t *testing.T) *uploadChecksumTestDeps {
+		d := newUploadChecksumTestDeps(t)
+		d.storage.parts[1] = []byte("hello")
+		d.storage.parts[2] = []byte("world")
+		return d
+	}
+
+	t.Run("FileVerified", func(t *testing.T) {
+		d := setup(t)
+		d.checksums.EXPECT().ListPartChecksums(ctx, "u1").Return(nil, nil)
+		d.repo.EXPECT().CreateVideo(ctx, mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Size == 10
+		})).Return(nil)
+
+		video, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, md5Checksum([]byte("helloworld")))
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), video.AuthorID)
+	})
+
+	t.Run("FileMismatchDeleted", func(t *testing.T) {
+		d := setup(t)
+		d.checksums.EXPECT().ListPartChecksums(ctx, "u1").Return(nil, nil)
+
+		_, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, md5Checksum([]byte("hello")))
+		assert.Equal(t, ErrUploadChecksumMismatch, err)
+		assert.NotContains(t, d.storage.objects, "u1")
+	})
+
+	t.Run("MismatchedPartRejected", func(t *testing.T) {
+		d := setup(t)
+		d.checksums.EXPECT().ListPartChecksums(ctx, "u1").Return([]*PartChecksum{
+			{PartNumber: 1, Status: PartChecksumStatusVerified},
+			{PartNumber: 2, Status: PartChecksumStatusMismatch},
+		}, nil)
+
+		_, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, "")
+		assert.Equal(t, ErrPartChecksumMismatch, err)
+		assert.NotContains(t, d.storage.objects, "u1")
+	})
+	t.Run("ConcurrentCompleteRejected", func(t *testing.T) {
+		d := setup(t)
+		err := d.uc.locker.WithLock(ctx, completeUploadLockKey("u1"), func(ctx context.Context, _ int64) error {
+			_, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, "")
+			return err
+		})
+		assert.Equal(t, ErrResourceLocked, err)
+		assert.NotContains(t, d.storage.objects, "u1")
+	})
+}
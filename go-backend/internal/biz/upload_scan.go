@@ -0,0 +1,93 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrVideoInfected = errors.BadRequest(v1.ErrorCode_VIDEO_INFECTED.String(), "uploaded file failed virus scan")
+
+const defaultUploadScanTimeout = 30 * time.Second
+
+// ScanEnforcement 扫描发现病毒时的处理方式
+type ScanEnforcement string
+
+const (
+	ScanEnforcementBlock ScanEnforcement = "block" // 拒绝上传
+	ScanEnforcementFlag  ScanEnforcement = "flag"  // 保存后隔离，留给人工复核
+)
+
+// UploadScanUsecase 上传文件病毒扫描。视频写入存储前调用扫描钩子，发现病毒时按配置拒绝或隔离；
+// 扫描超时或失败时无法确认文件是否安全，无论哪种处理方式都保存后隔离，不直接拒绝用户的上传
+type UploadScanUsecase struct {
+	hook        security.ScanHook
+	enforcement ScanEnforcement
+	timeout     time.Duration
+	log         *log.Helper
+}
+
+// NewUploadScanUsecase 创建上传扫描用例，未配置扫描服务时不扫描
+func NewUploadScanUsecase(businessConfig *conf.Business, logger log.Logger) *UploadScanUsecase {
+	uc := &UploadScanUsecase{
+		enforcement: ScanEnforcementBlock,
+		timeout:     defaultUploadScanTimeout,
+		log:         log.NewHelper(logger),
+	}
+
+	cfg := businessConfig.GetUploadScan()
+	if strings.EqualFold(cfg.GetEnforcement(), string(ScanEnforcementFlag)) {
+		uc.enforcement = ScanEnforcementFlag
+	}
+	if cfg.GetTimeout() != nil && cfg.GetTimeout().AsDuration() > 0 {
+		uc.timeout = cfg.GetTimeout().AsDuration()
+	}
+	if cfg.GetClamavAddress() != "" {
+		uc.hook = security.NewClamAVScanner(cfg.GetClamavAddress())
+	}
+	return uc
+}
+
+// Enabled 是否配置了扫描服务
+func (uc *UploadScanUsecase) Enabled() bool {
+	return uc.hook != nil
+}
+
+// Scan 扫描上传的文件，block 模式发现病毒时返回 ErrVideoInfected；
+// 需要隔离时返回 true，由调用方以 VideoStatusQuarantined 保存
+func (uc *UploadScanUsecase) Scan(ctx context.Context, userID int64, filename string, data []byte) (bool, error) {
+	return uc.ScanReader(ctx, userID, filename, bytes.NewReader(data))
+}
+
+// ScanReader 同 Scan，从 reader 流式读取文件，用于扫描已写入存储的分片上传合并结果
+func (uc *UploadScanUsecase) ScanReader(ctx context.Context, userID int64, filename string, reader io.Reader) (bool, error) {
+	if uc.hook == nil {
+		return false, nil
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, uc.timeout)
+	result, err := uc.hook.Scan(scanCtx, reader)
+	cancel()
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("upload scan failed, quarantine upload: user=%d file=%s err=%v", userID, filename, err)
+		return true, nil
+	}
+	if !result.Infected {
+		return false, nil
+	}
+
+	uc.log.WithContext(ctx).Warnf("upload infected: user=%d file=%s signature=%s enforcement=%s", userID, filename, result.Signature, uc.enforcement)
+	if uc.enforcement == ScanEnforcementBlock {
+		return false, ErrVideoInfected
+	}
+	return true, nil
+}
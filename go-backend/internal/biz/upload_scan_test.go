@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/security"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type fakeScanHook struct {
+	result *security.ScanResult
+	err    error
+	data   []byte
+}
+
+func (h *fakeScanHook) Scan(ctx context.Context, reader io.Reader) (*security.ScanResult, error) {
+	h.data, _ = io.ReadAll(reader)
+	return h.result, h.err
+}
+
+func newUploadScanTestUsecase(enforcement string, hook security.ScanHook) *UploadScanUsecase {
+	uc := NewUploadScanUsecase(&conf.Business{
+		UploadScan: &conf.Business_UploadScan{Enforcement: enforcement},
+	}, log.DefaultLogger)
+	uc.hook = hook
+	return uc
+}
+
+func TestNewUploadScanUsecase(t *testing.T) {
+	uc := NewUploadScanUsecase(&conf.Business{}, log.DefaultLogger)
+	assert.Nil(t, uc.hook)
+	assert.Equal(t, ScanEnforcementBlock, uc.enforcement)
+	assert.Equal(t, defaultUploadScanTimeout, uc.timeout)
+
+	uc = NewUploadScanUsecase(&conf.Business{UploadScan: &conf.Business_UploadScan{
+		ClamavAddress: "127.0.0.1:3310",
+		Enforcement:   "FLAG",
+		Timeout:       durationpb.New(5 * time.Second),
+	}}, log.DefaultLogger)
+	assert.IsType(t, &security.ClamAVScanner{}, uc.hook)
+	assert.Equal(t, ScanEnforcementFlag, uc.enforcement)
+	assert.Equal(t, 5*time.Second, uc.timeout)
+}
+
+func TestUploadScanUsecase_Scan(t *testing.T) {
+	ctx := context.Background()
+	infected := &security.ScanResult{Infected: true, Signature: "Eicar-Test-Signature"}
+
+	t.Run("Disabled", func(t *testing.T) {
+		quarantined, err := NewUploadScanUsecase(&conf.Business{}, log.DefaultLogger).Scan(ctx, 1, "a.mp4", []byte("data"))
+		require.NoError(t, err)
+		assert.False(t, quarantined)
+	})
+
+	t.Run("Clean", func(t *testing.T) {
+		hook := &fakeScanHook{result: &security.ScanResult{}}
+		quarantined, err := newUploadScanTestUsecase("block", hook).Scan(ctx, 1, "a.mp4", []byte("data"))
+		require.NoError(t, err)
+		assert.False(t, quarantined)
+		assert.Equal(t, []byte("data"), hook.data)
+	})
+
+	t.Run("InfectedBlock", func(t *testing.T) {
+		_, err := newUploadScanTestUsecase("block", &fakeScanHook{result: infected}).Scan(ctx, 1, "a.mp4", []byte("data"))
+		assert.ErrorIs(t, err, ErrVideoInfected)
+	})
+
+	t.Run("InfectedFlag", func(t *testing.T) {
+		quarantined, err := newUploadScanTestUsecase("flag", &fakeScanHook{result: infected}).Scan(ctx, 1, "a.mp4", []byte("data"))
+		require.NoError(t, err)
+		assert.True(t, quarantined)
+	})
+
+	t.Run("ScanFailed", func(t *testing.T) {
+		for _, enforcement := range []string{"block", "flag"} {
+			quarantined, err := newUploadScanTestUsecase(enforcement, &fakeScanHook{err: errors.New("clamd unavailable")}).Scan(ctx, 1, "a.mp4", []byte("data"))
+			require.NoError(t, err)
+			assert.True(t, quarantined)
+		}
+	})
+}
+
+// Quarantine 将对象移到 quarantine/ 前缀下
+func (s *multipartMemoryStorage) Quarantine(_ context.Context, objectName string) (string, error) {
+	target := "quarantine/" + objectName
+	s.objects[target] = s.objects[objectName]
+	delete(s.objects, objectName)
+	return target, nil
+}
+
+func TestVideoUsecase_CompleteMultipartUploadScan(t *testing.T) {
+	require.NoError(t, utils.InitSnowflake(1, 1))
+	ctx := context.Background()
+	parts := []storage.PartInfo{{PartNumber: 1, Size: 5}, {PartNumber: 2, Size: 5}}
+	infected := &security.ScanResult{Infected: true, Signature: "Eicar-Test-Signature"}
+
+	setup := func(t *testing.T, enforcement string, hook security.ScanHook) *uploadChecksumTestDeps {
+		d := newUploadChecksumTestDeps(t)
+		d.uc.scanner = newUploadScanTestUsecase(enforcement, hook)
+		d.storage.parts[1] = []byte("hello")
+		d.storage.parts[2] = []byte("world")
+		d.checksums.EXPECT().ListPartChecksums(ctx, "u1").Return(nil, nil)
+		return d
+	}
+
+	t.Run("Clean", func(t *testing.T) {
+		hook := &fakeScanHook{result: &security.ScanResult{}}
+		d := setup(t, "block", hook)
+		d.repo.EXPECT().CreateVideo(ctx, mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Status == domain.VideoStatusPending && v.PlayURL == "https://cdn.example.com/u1"
+		})).Return(nil)
+
+		_, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, "")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("helloworld"), hook.data)
+	})
+
+	t.Run("InfectedBlockDeleted", func(t *testing.T) {
+		d := setup(t, "block", &fakeScanHook{result: infected})
+
+		_, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, "")
+		assert.ErrorIs(t, err, ErrVideoInfected)
+		assert.NotContains(t, d.storage.objects, "u1")
+	})
+
+	t.Run("InfectedFlagQuarantined", func(t *testing.T) {
+		d := setup(t, "flag", &fakeScanHook{result: infected})
+		d.repo.EXPECT().CreateVideo(ctx, mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Status == domain.VideoStatusQuarantined && v.PlayURL == "quarantine/u1"
+		})).Return(nil)
+
+		video, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, "")
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.VideoStatusQuarantined), video.Status)
+		assert.NotContains(t, d.storage.objects, "u1")
+		assert.Contains(t, d.storage.objects, "quarantine/u1")
+	})
+
+	t.Run("ScanFailedQuarantined", func(t *testing.T) {
+		d := setup(t, "block", &fakeScanHook{err: errors.New("clamd unavailable")})
+		d.repo.EXPECT().CreateVideo(ctx, mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Status == domain.VideoStatusQuarantined
+		})).Return(nil)
+
+		_, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, "")
+		require.NoError(t, err)
+	})
+}
@@ -2,9 +2,12 @@ package biz
 
 import (
     "context"
+    "strconv"
     "time"
 
     v1 "go-backend/api/common/v1"
+    "go-backend/internal/domain"
+    "go-backend/pkg/timeutil"
 
     "github.com/go-kratos/kratos/v2/errors"
     "github.com/go-kratos/kratos/v2/log"
@@ -12,9 +15,10 @@ import (
 
 var (
     // ErrUserNotFound is user not found.
-    ErrUserNotFound  = errors.NotFound(v1.ErrorCode_USER_NOT_EXIST.String(), "user not found")
-    ErrUserExist     = errors.BadRequest(v1.ErrorCode_USER_EXIST.String(), "user already exists")
-    ErrPasswordError = errors.BadRequest(v1.ErrorCode_PASSWORD_ERROR.String(), "password error")
+    ErrUserNotFound    = errors.NotFound(v1.ErrorCode_USER_NOT_EXIST.String(), "user not found")
+    ErrUserExist       = errors.BadRequest(v1.ErrorCode_USER_EXIST.String(), "user already exists")
+    ErrPasswordError   = errors.BadRequest(v1.ErrorCode_PASSWORD_ERROR.String(), "password error")
+    ErrInvalidTimezone = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "invalid timezone")
 )
 
 // User is a User model.
@@ -27,12 +31,17 @@ type User struct {
     Avatar          string
     BackgroundImage string
     Signature       string
+    Email           string // 已验证的邮箱，未绑定时为空
+    Phone           string // 手机号，未绑定时为空
+    Timezone        string // IANA时区名，如Asia/Shanghai，默认UTC
+    IsPrivate       bool   // 私密账号，关注需要本人通过，非粉丝看不到作品和计数
     FollowCount     int
     FollowerCount   int
     TotalFavorited  int64
     WorkCount       int
     FavoriteCount   int
     IsFollow        bool
+    Status          int8 // 账号状态，取值见 domain.UserStatus
     LastLoginAt     *time.Time
     CreatedAt       time.Time
     UpdatedAt       time.Time
@@ -52,21 +61,30 @@ type UserRepo interface {
     CreateUser(context.Context, *User) (*User, error)
     GetUser(context.Context, int64) (*User, error)
     GetUserByUsername(context.Context, string) (*User, error)
+    // GetUserByEmail 按已绑定邮箱查找用户
+    GetUserByEmail(context.Context, string) (*User, error)
+    // GetUserByPhone 按手机号查找用户
+    GetUserByPhone(context.Context, string) (*User, error)
     GetUsers(context.Context, []int64) ([]*User, error)
     UpdateUser(context.Context, *User) error
     UpdateUserStats(context.Context, int64, *UserStats) error
     VerifyPassword(context.Context, string, string) (*User, error)
+    // UpdatePassword 设置新密码，由repo层加密
+    UpdatePassword(context.Context, int64, string) error
+    // UpdateEmail 绑定邮箱，邮箱已被其他用户绑定时返回 ErrEmailTaken
+    UpdateEmail(context.Context, int64, string) error
 }
 
 // UserUsecase is a User usecase.
 type UserUsecase struct {
-    repo UserRepo
-    log  *log.Helper
+    repo  UserRepo
+    reads *readCoalescer
+    log   *log.Helper
 }
 
 // NewUserUsecase new a User usecase.
 func NewUserUsecase(repo UserRepo, logger log.Logger) *UserUsecase {
-    return &UserUsecase{repo: repo, log: log.NewHelper(logger)}
+    return &UserUsecase{repo: repo, reads: newReadCoalescer(coalesceGetUser), log: log.NewHelper(logger)}
 }
 
 // Register creates a User, and returns the new User.
@@ -109,9 +127,11 @@ func (uc *UserUsecase) Login(ctx context.Context, username, password string) (*U
     return user, nil
 }
 
-// GetUser gets a user by ID.
+// GetUser gets a user by ID. Concurrent reads of the same user share one repo fetch.
 func (uc *UserUsecase) GetUser(ctx context.Context, userID int64) (*User, error) {
-    return uc.repo.GetUser(ctx, userID)
+    return coalesce(ctx, uc.reads, strconv.FormatInt(userID, 10), func(ctx context.Context) (*User, error) {
+        return uc.repo.GetUser(ctx, userID)
+    }, cloneUser)
 }
 
 // GetUsers gets users by IDs.
@@ -152,23 +172,72 @@ func (uc *UserUsecase) ChangePassword(ctx context.Context, userID int64, oldPass
         return ErrPasswordError
     }
 
-    // 更新密码
-    user.PasswordHash = newPassword // 在repo层进行密码加密
+    return uc.repo.UpdatePassword(ctx, userID, newPassword)
+}
+
+// ResetPassword 不校验旧密码直接设置新密码，调用方负责验证重置凭证
+func (uc *UserUsecase) ResetPassword(ctx context.Context, userID int64, newPassword string) error {
+    uc.log.WithContext(ctx).Infof("Reset password for user: %d", userID)
+    return uc.repo.UpdatePassword(ctx, userID, newPassword)
+}
+
+// UpdateTimezone 更新用户时区偏好，返回规范化后的时区名
+func (uc *UserUsecase) UpdateTimezone(ctx context.Context, userID int64, timezone string) (string, error) {
+    loc, err := timeutil.LoadLocation(timezone)
+    if err != nil {
+        return "", ErrInvalidTimezone
+    }
+
+    user, err := uc.repo.GetUser(ctx, userID)
+    if err != nil {
+        return "", err
+    }
+
+    uc.log.WithContext(ctx).Infof("Update timezone for user %d: %s", userID, loc.String())
+
+    user.Timezone = loc.String()
+    if err := uc.repo.UpdateUser(ctx, user); err != nil {
+        return "", err
+    }
+
+    return user.Timezone, nil
+}
+
+// UpdatePrivacy 设置私密账号。切换回公开账号时已有的关注申请保留，仍可由本人处理
+func (uc *UserUsecase) UpdatePrivacy(ctx context.Context, userID int64, isPrivate bool) error {
+    user, err := uc.repo.GetUser(ctx, userID)
+    if err != nil {
+        return err
+    }
+
+    uc.log.WithContext(ctx).Infof("Update privacy for user %d: private=%v", userID, isPrivate)
+
+    user.IsPrivate = isPrivate
     return uc.repo.UpdateUser(ctx, user)
 }
 
-// IsActive 检查用户是否激活
+// GetLocation 获取用户所在时区，用于解析定时发布时间、计算推送窗口和统计分桶。
+// 用户不存在或时区无效时回退到UTC
+func (uc *UserUsecase) GetLocation(ctx context.Context, userID int64) *time.Location {
+    user, err := uc.repo.GetUser(ctx, userID)
+    if err != nil {
+        return time.UTC
+    }
+    return timeutil.LocationOrUTC(user.Timezone)
+}
+
+// IsActive 检查用户是否为正常状态，封禁、禁用和注销冷静期中的账号返回false
 func (u *User) IsActive() bool {
-    return true // 简化处理，默认用户都是激活状态
+    return u.Status == int8(domain.UserStatusActive)
 }
 
-// UpdateProfile 更新用户资料
-func (uc *UserUsecase) UpdateProfile(ctx context.Context, userID int64, nickname, avatar, backgroundImage, signature string) error {
+// UpdateProfile 更新用户资料，空字段保持不变，返回更新后的用户
+func (uc *UserUsecase) UpdateProfile(ctx context.Context, userID int64, nickname, avatar, backgroundImage, signature string) (*User, error) {
     uc.log.WithContext(ctx).Infof("Update profile for user: %d", userID)
 
     user, err := uc.repo.GetUser(ctx, userID)
     if err != nil {
-        return err
+        return nil, err
     }
 
     // 更新用户信息
@@ -185,5 +254,9 @@ func (uc *UserUsecase) UpdateProfile(ctx context.Context, userID int64, nickname
         user.Signature = signature
     }
 
-    return uc.repo.UpdateUser(ctx, user)
+    if err := uc.repo.UpdateUser(ctx, user); err != nil {
+        return nil, err
+    }
+
+    return user, nil
 }
\ No newline at end of file
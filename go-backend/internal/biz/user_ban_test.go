@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type userBanTestDeps struct {
+	repo     *MockUserBanRepo
+	roleRepo *MockRoleRepo
+	uc       *UserBanUsecase
+}
+
+func newUserBanTestDeps(t *testing.T) *userBanTestDeps {
+	repo := NewMockUserBanRepo(t)
+	roleRepo := NewMockRoleRepo(t)
+	permissionUc := NewPermissionUsecase(roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+
+	return &userBanTestDeps{
+		repo:     repo,
+		roleRepo: roleRepo,
+		uc:       NewUserBanUsecase(repo, permissionUc, log.DefaultLogger),
+	}
+}
+
+func (d *userBanTestDeps) expectAdmin(ctx context.Context, userID int64, isAdmin bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(isAdmin, nil)
+}
+
+func TestUserBanUsecase_BanUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		d := newUserBanTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().BanUser(ctx, mock.MatchedBy(func(ban *UserBan) bool {
+			return ban.UserID == 2 && ban.AdminID == 1 && ban.Reason == "spam" && !ban.BannedAt.IsZero()
+		})).Return(nil)
+
+		ban, err := d.uc.BanUser(ctx, 1, 2, " spam ")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), ban.UserID)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		d := newUserBanTestDeps(t)
+		d.expectAdmin(ctx, 1, false)
+
+		_, err := d.uc.BanUser(ctx, 1, 2, "spam")
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("CannotBanSelf", func(t *testing.T) {
+		d := newUserBanTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+
+		_, err := d.uc.BanUser(ctx, 1, 1, "spam")
+		assert.Equal(t, ErrInvalidBanTarget, err)
+	})
+
+	t.Run("InvalidReason", func(t *testing.T) {
+		d := newUserBanTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		_, err := d.uc.BanUser(ctx, 1, 2, "  ")
+		assert.Equal(t, ErrBanReasonRequired, err)
+
+		d.expectAdmin(ctx, 1, true)
+		_, err = d.uc.BanUser(ctx, 1, 2, strings.Repeat("a", maxBanReasonLength+1))
+		assert.Equal(t, ErrBanReasonTooLong, err)
+	})
+
+	t.Run("AlreadyBanned", func(t *testing.T) {
+		d := newUserBanTestDeps(t)
+		d.expectAdmin(ctx, 1, true)
+		d.repo.EXPECT().BanUser(ctx, mock.Anything).Return(ErrUserAlreadyBanned)
+
+		_, err := d.uc.BanUser(ctx, 1, 2, "spam")
+		assert.Equal(t, ErrUserAlreadyBanned, err)
+	})
+}
+
+func TestUserBanUsecase_UnbanUser(t *testing.T) {
+	ctx := context.Background()
+
+	d := newUserBanTestDeps(t)
+	d.expectAdmin(ctx, 1, true)
+	d.repo.EXPECT().UnbanUser(ctx, int64(2)).Return(nil)
+	require.NoError(t, d.uc.UnbanUser(ctx, 1, 2))
+
+	d.expectAdmin(ctx, 1, true)
+	d.repo.EXPECT().UnbanUser(ctx, int64(3)).Return(ErrUserNotBanned)
+	assert.Equal(t, ErrUserNotBanned, d.uc.UnbanUser(ctx, 1, 3))
+}
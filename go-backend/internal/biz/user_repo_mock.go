@@ -139,6 +139,124 @@ func (_c *MockUserRepo_GetUser_Call) RunAndReturn(run func(context.Context, int6
 	return _c
 }
 
+// GetUserByEmail provides a mock function with given fields: _a0, _a1
+func (_m *MockUserRepo) GetUserByEmail(_a0 context.Context, _a1 string) (*User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 *User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*User, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepo_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockUserRepo_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+func (_e *MockUserRepo_Expecter) GetUserByEmail(_a0 interface{}, _a1 interface{}) *MockUserRepo_GetUserByEmail_Call {
+	return &MockUserRepo_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", _a0, _a1)}
+}
+
+func (_c *MockUserRepo_GetUserByEmail_Call) Run(run func(_a0 context.Context, _a1 string)) *MockUserRepo_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetUserByEmail_Call) Return(_a0 *User, _a1 error) *MockUserRepo_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepo_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (*User, error)) *MockUserRepo_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByPhone provides a mock function with given fields: _a0, _a1
+func (_m *MockUserRepo) GetUserByPhone(_a0 context.Context, _a1 string) (*User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByPhone")
+	}
+
+	var r0 *User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*User, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepo_GetUserByPhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByPhone'
+type MockUserRepo_GetUserByPhone_Call struct {
+	*mock.Call
+}
+
+// GetUserByPhone is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+func (_e *MockUserRepo_Expecter) GetUserByPhone(_a0 interface{}, _a1 interface{}) *MockUserRepo_GetUserByPhone_Call {
+	return &MockUserRepo_GetUserByPhone_Call{Call: _e.mock.On("GetUserByPhone", _a0, _a1)}
+}
+
+func (_c *MockUserRepo_GetUserByPhone_Call) Run(run func(_a0 context.Context, _a1 string)) *MockUserRepo_GetUserByPhone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetUserByPhone_Call) Return(_a0 *User, _a1 error) *MockUserRepo_GetUserByPhone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepo_GetUserByPhone_Call) RunAndReturn(run func(context.Context, string) (*User, error)) *MockUserRepo_GetUserByPhone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByUsername provides a mock function with given fields: _a0, _a1
 func (_m *MockUserRepo) GetUserByUsername(_a0 context.Context, _a1 string) (*User, error) {
 	ret := _m.Called(_a0, _a1)
@@ -257,6 +375,102 @@ func (_c *MockUserRepo_GetUsers_Call) RunAndReturn(run func(context.Context, []i
 	return _c
 }
 
+// UpdateEmail provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUserRepo) UpdateEmail(_a0 context.Context, _a1 int64, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepo_UpdateEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmail'
+type MockUserRepo_UpdateEmail_Call struct {
+	*mock.Call
+}
+
+// UpdateEmail is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 string
+func (_e *MockUserRepo_Expecter) UpdateEmail(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUserRepo_UpdateEmail_Call {
+	return &MockUserRepo_UpdateEmail_Call{Call: _e.mock.On("UpdateEmail", _a0, _a1, _a2)}
+}
+
+func (_c *MockUserRepo_UpdateEmail_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 string)) *MockUserRepo_UpdateEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateEmail_Call) Return(_a0 error) *MockUserRepo_UpdateEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateEmail_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockUserRepo_UpdateEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUserRepo) UpdatePassword(_a0 context.Context, _a1 int64, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepo_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUserRepo_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 string
+func (_e *MockUserRepo_Expecter) UpdatePassword(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUserRepo_UpdatePassword_Call {
+	return &MockUserRepo_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", _a0, _a1, _a2)}
+}
+
+func (_c *MockUserRepo_UpdatePassword_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 string)) *MockUserRepo_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UpdatePassword_Call) Return(_a0 error) *MockUserRepo_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepo_UpdatePassword_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockUserRepo_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: _a0, _a1
 func (_m *MockUserRepo) UpdateUser(_a0 context.Context, _a1 *User) error {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go-backend/pkg/clock"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultCreatorAnalyticsDays 创作者数据默认返回最近28天
+	defaultCreatorAnalyticsDays = 28
+	// maxCreatorAnalyticsDays 创作者数据单次最多查询的天数
+	maxCreatorAnalyticsDays = 90
+)
+
+// 用户日统计指标，与 user_stats_daily 表的列名一致
+const (
+	UserStatNewFollowers  = "new_followers"
+	UserStatLostFollowers = "lost_followers"
+	UserStatVideoPlays    = "video_plays"
+	UserStatLikesReceived = "likes_received"
+)
+
+// UserDailyStats 用户某一天（UTC）的统计
+type UserDailyStats struct {
+	UserID        int64
+	Date          time.Time
+	NewFollowers  int64
+	LostFollowers int64
+	VideoPlays    int64 // 作品被播放的次数
+	LikesReceived int64 // 作品收到的点赞数，当天取消的点赞会抵扣
+}
+
+// UserStatsRepo 用户日统计仓储
+type UserStatsRepo interface {
+	// IncrDaily 累加用户某天的一项指标，当天没有记录时新建
+	IncrDaily(ctx context.Context, userID int64, date time.Time, metric string, delta int64) error
+	// ListDaily 查询 [start, end] 内有记录的日统计，按日期升序
+	ListDaily(ctx context.Context, userID int64, start, end time.Time) ([]*UserDailyStats, error)
+}
+
+// UserStatsUsecase 用户统计用例，由统计消费者根据关注、点赞和播放事件按天累加
+type UserStatsUsecase struct {
+	repo      UserStatsRepo
+	videoRepo VideoRepo
+	clock     clock.Clock
+	log       *log.Helper
+}
+
+// NewUserStatsUsecase 创建用户统计用例
+func NewUserStatsUsecase(repo UserStatsRepo, videoRepo VideoRepo, clk clock.Clock, logger log.Logger) *UserStatsUsecase {
+	return &UserStatsUsecase{
+		repo:      repo,
+		videoRepo: videoRepo,
+		clock:     clk,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// RecordFollow 记录被关注者的粉丝变化，delta 为正表示新增关注，为负表示取消关注
+func (uc *UserStatsUsecase) RecordFollow(ctx context.Context, followUserID int64, at time.Time, delta int64) error {
+	if delta > 0 {
+		return uc.repo.IncrDaily(ctx, followUserID, statsDate(at), UserStatNewFollowers, delta)
+	}
+	if delta < 0 {
+		return uc.repo.IncrDaily(ctx, followUserID, statsDate(at), UserStatLostFollowers, -delta)
+	}
+	return nil
+}
+
+// RecordPlays 把视频的播放数计入作者
+func (uc *UserStatsUsecase) RecordPlays(ctx context.Context, videoID int64, at time.Time, plays int64) error {
+	if plays == 0 {
+		return nil
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	return uc.repo.IncrDaily(ctx, video.AuthorID, statsDate(at), UserStatVideoPlays, plays)
+}
+
+// RecordLike 把点赞或取消点赞计入作者，作者给自己点赞不计入
+func (uc *UserStatsUsecase) RecordLike(ctx context.Context, userID, videoID int64, at time.Time, delta int64) error {
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if video.AuthorID == userID {
+		return nil
+	}
+	return uc.repo.IncrDaily(ctx, video.AuthorID, statsDate(at), UserStatLikesReceived, delta)
+}
+
+// GetCreatorAnalytics 获取用户自己的每日统计，start、end 为零值时默认最近28天，
+// 最多90天。没有记录的日期补零，返回的序列连续
+func (uc *UserStatsUsecase) GetCreatorAnalytics(ctx context.Context, userID int64, start, end time.Time) ([]*UserDailyStats, error) {
+	if end.IsZero() {
+		end = uc.clock.Now()
+	}
+	end = statsDate(end)
+	if start.IsZero() {
+		start = end.AddDate(0, 0, -(defaultCreatorAnalyticsDays - 1))
+	}
+	start = statsDate(start)
+	if start.After(end) {
+		return nil, utils.ErrInvalidParam
+	}
+	if earliest := end.AddDate(0, 0, -(maxCreatorAnalyticsDays - 1)); start.Before(earliest) {
+		start = earliest
+	}
+
+	rows, err := uc.repo.ListDaily(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	byDate := make(map[time.Time]*UserDailyStats, len(rows))
+	for _, row := range rows {
+		byDate[statsDate(row.Date)] = row
+	}
+
+	series := make([]*UserDailyStats, 0, int(end.Sub(start)/(24*time.Hour))+1)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if row, ok := byDate[day]; ok {
+			row.Date = day
+			series = append(series, row)
+			continue
+		}
+		series = append(series, &UserDailyStats{UserID: userID, Date: day})
+	}
+	return series, nil
+}
+
+// statsDate 统计按UTC自然日归档
+func statsDate(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newUserStatsTestUsecase(t *testing.T, now time.Time) (*UserStatsUsecase, *MockUserStatsRepo, *MockVideoRepo) {
+	repo := NewMockUserStatsRepo(t)
+	videoRepo := NewMockVideoRepo(t)
+	return NewUserStatsUsecase(repo, videoRepo, clock.NewFake(now), log.DefaultLogger), repo, videoRepo
+}
+
+func TestUserStatsUsecase_Record(t *testing.T) {
+	ctx := context.Background()
+	// 北京时间凌晨仍归入UTC的前一天
+	at := time.Date(2026, 3, 2, 1, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Follow", func(t *testing.T) {
+		uc, repo, _ := newUserStatsTestUsecase(t, at)
+		repo.EXPECT().IncrDaily(ctx, int64(2), day, UserStatNewFollowers, int64(1)).Return(nil).Once()
+		repo.EXPECT().IncrDaily(ctx, int64(2), day, UserStatLostFollowers, int64(1)).Return(nil).Once()
+
+		require.NoError(t, uc.RecordFollow(ctx, 2, at, 1))
+		require.NoError(t, uc.RecordFollow(ctx, 2, at, -1))
+	})
+
+	t.Run("PlaysToAuthor", func(t *testing.T) {
+		uc, repo, videoRepo := newUserStatsTestUsecase(t, at)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 3}, nil)
+		repo.EXPECT().IncrDaily(ctx, int64(3), day, UserStatVideoPlays, int64(5)).Return(nil).Once()
+
+		require.NoError(t, uc.RecordPlays(ctx, 10, at, 5))
+	})
+
+	t.Run("SkipSelfLike", func(t *testing.T) {
+		uc, repo, videoRepo := newUserStatsTestUsecase(t, at)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 3}, nil)
+		repo.EXPECT().IncrDaily(ctx, int64(3), day, UserStatLikesReceived, int64(-1)).Return(nil).Once()
+
+		require.NoError(t, uc.RecordLike(ctx, 3, 10, at, 1))
+		require.NoError(t, uc.RecordLike(ctx, 4, 10, at, -1))
+	})
+}
+
+func TestUserStatsUsecase_GetCreatorAnalytics(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("FillMissingDays", func(t *testing.T) {
+		uc, repo, _ := newUserStatsTestUsecase(t, now)
+		repo.EXPECT().ListDaily(ctx, int64(1), day(7), day(10)).Return([]*UserDailyStats{
+			{UserID: 1, Date: day(8), NewFollowers: 2, VideoPlays: 30},
+		}, nil)
+
+		series, err := uc.GetCreatorAnalytics(ctx, 1, day(7), time.Time{})
+		require.NoError(t, err)
+		require.Len(t, series, 4)
+		assert.Equal(t, day(7), series[0].Date)
+		assert.Zero(t, series[0].VideoPlays)
+		assert.Equal(t, int64(2), series[1].NewFollowers)
+		assert.Equal(t, int64(30), series[1].VideoPlays)
+		assert.Equal(t, day(10), series[3].Date)
+	})
+
+	t.Run("DefaultRange", func(t *testing.T) {
+		uc, repo, _ := newUserStatsTestUsecase(t, now)
+		repo.EXPECT().ListDaily(ctx, int64(1), now.AddDate(0, 0, -27).Truncate(24*time.Hour), day(10)).Return(nil, nil)
+
+		series, err := uc.GetCreatorAnalytics(ctx, 1, time.Time{}, time.Time{})
+		require.NoError(t, err)
+		assert.Len(t, series, defaultCreatorAnalyticsDays)
+	})
+
+	t.Run("ClampToMaxRange", func(t *testing.T) {
+		uc, repo, _ := newUserStatsTestUsecase(t, now)
+		repo.EXPECT().ListDaily(ctx, int64(1), mock.Anything, day(10)).Return(nil, nil)
+
+		series, err := uc.GetCreatorAnalytics(ctx, 1, now.AddDate(-1, 0, 0), now)
+		require.NoError(t, err)
+		assert.Len(t, series, maxCreatorAnalyticsDays)
+	})
+
+	t.Run("StartAfterEnd", func(t *testing.T) {
+		uc, _, _ := newUserStatsTestUsecase(t, now)
+
+		_, err := uc.GetCreatorAnalytics(ctx, 1, day(10), day(9))
+		assert.ErrorIs(t, err, utils.ErrInvalidParam)
+	})
+}
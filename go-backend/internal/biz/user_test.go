@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"go-backend/internal/domain"
 	"go-backend/testutils"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -406,7 +407,7 @@ func TestUserUsecase_ChangePassword(t *testing.T) {
 
 		userRepo.EXPECT().GetUser(ctx, userID).Return(user, nil)
 		userRepo.EXPECT().VerifyPassword(ctx, user.Username, oldPassword).Return(user, nil)
-		userRepo.EXPECT().UpdateUser(ctx, mock.AnythingOfType("*biz.User")).Return(nil)
+		userRepo.EXPECT().UpdatePassword(ctx, userID, newPassword).Return(nil)
 
 		err := uc.ChangePassword(ctx, userID, oldPassword, newPassword)
 
@@ -487,9 +488,10 @@ func TestUserUsecase_UpdateProfile(t *testing.T) {
 				u.Signature == signature
 		})).Return(nil)
 
-		err := uc.UpdateProfile(ctx, userID, nickname, avatar, backgroundImage, signature)
+		updated, err := uc.UpdateProfile(ctx, userID, nickname, avatar, backgroundImage, signature)
 
 		assert.NoError(t, err)
+		assert.Equal(t, nickname, updated.Nickname)
 	})
 
 	t.Run("UpdateProfile_UserNotFound", func(t *testing.T) {
@@ -501,7 +503,7 @@ func TestUserUsecase_UpdateProfile(t *testing.T) {
 
 		userRepo.EXPECT().GetUser(ctx, userID).Return(nil, ErrUserNotFound)
 
-		err := uc.UpdateProfile(ctx, userID, "nickname", "", "", "")
+		_, err := uc.UpdateProfile(ctx, userID, "nickname", "", "", "")
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrUserNotFound, err)
@@ -533,7 +535,7 @@ func TestUserUsecase_UpdateProfile(t *testing.T) {
 				u.Signature == "old signature"
 		})).Return(nil)
 
-		err := uc.UpdateProfile(ctx, userID, nickname, "", "", "")
+		_, err := uc.UpdateProfile(ctx, userID, nickname, "", "", "")
 
 		assert.NoError(t, err)
 	})
@@ -543,8 +545,50 @@ func TestUser_IsActive(t *testing.T) {
 	user := &User{
 		ID:       1,
 		Username: "testuser",
+		Status:   int8(domain.UserStatusActive),
 	}
-
-	// 当前实现总是返回true
 	assert.True(t, user.IsActive())
+
+	user.Status = int8(domain.UserStatusBanned)
+	assert.False(t, user.IsActive())
+}
+
+func TestUserUsecase_UpdateTimezone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		userRepo := NewMockUserRepo(t)
+		uc := NewUserUsecase(userRepo, log.DefaultLogger)
+
+		userRepo.EXPECT().GetUser(ctx, int64(1)).Return(&User{ID: 1, Timezone: "UTC"}, nil)
+		userRepo.EXPECT().UpdateUser(ctx, mock.MatchedBy(func(u *User) bool {
+			return u.ID == 1 && u.Timezone == "Asia/Shanghai"
+		})).Return(nil)
+
+		timezone, err := uc.UpdateTimezone(ctx, 1, " Asia/Shanghai ")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Shanghai", timezone)
+	})
+
+	t.Run("InvalidTimezone", func(t *testing.T) {
+		userRepo := NewMockUserRepo(t)
+		uc := NewUserUsecase(userRepo, log.DefaultLogger)
+
+		_, err := uc.UpdateTimezone(ctx, 1, "Mars/Olympus")
+
+		assert.Equal(t, ErrInvalidTimezone, err)
+	})
+}
+
+func TestUserUsecase_GetLocation(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepo(t)
+	uc := NewUserUsecase(userRepo, log.DefaultLogger)
+
+	userRepo.EXPECT().GetUser(ctx, int64(1)).Return(&User{ID: 1, Timezone: "America/New_York"}, nil)
+	userRepo.EXPECT().GetUser(ctx, int64(2)).Return(nil, ErrUserNotFound)
+
+	assert.Equal(t, "America/New_York", uc.GetLocation(ctx, 1).String())
+	assert.Equal(t, "UTC", uc.GetLocation(ctx, 2).String())
 }
@@ -1,19 +1,26 @@
 package biz
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
 	"go-backend/internal/conf"
 	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
 	"go-backend/pkg/media"
 	"go-backend/pkg/messaging"
+	"go-backend/pkg/richtext"
 	"go-backend/pkg/security"
 	"go-backend/pkg/storage"
 	"go-backend/pkg/utils"
+	"go-backend/pkg/worker"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -24,11 +31,27 @@ type VideoRepo interface {
 	GetVideo(ctx context.Context, videoID int64) (*domain.Video, error)
 	GetVideos(ctx context.Context, videoIDs []int64) ([]*domain.Video, error)
 	GetUserVideos(ctx context.Context, userID int64, limit int) ([]*domain.Video, error)
-	GetFeedVideos(ctx context.Context, latestTime time.Time, limit int) ([]*domain.Video, error)
+	GetFeedVideos(ctx context.Context, cursor *domain.FeedCursor, categoryID int64, limit int) ([]*domain.Video, error)
 	UpdateVideoStats(ctx context.Context, videoID int64, field string, delta int64) error
 	UpdateVideo(ctx context.Context, video *domain.Video) error
 	UpdateVideoCover(ctx context.Context, videoID int64, coverURL string) error
 	UpdateVideoPlayURL(ctx context.Context, videoID int64, playURL string) error
+	UpdateVideoPlayURLs(ctx context.Context, videoID int64, playURLs map[string]string) error
+	UpdateVideoHLSURL(ctx context.Context, videoID int64, hlsURL string) error
+	UpdateVideoDynamicCover(ctx context.Context, videoID int64, dynamicCoverURL string) error
+	// UpdateVideoInfo 更新视频标题、封面和状态，失效作者作品列表和视频流
+	UpdateVideoInfo(ctx context.Context, video *domain.Video) error
+	// UpdateVideoVisibility 更新视频可见范围并失效作者作品列表和视频流
+	UpdateVideoVisibility(ctx context.Context, video *domain.Video, visibility int32) error
+	// UpdateVideoMetadata 更新视频元信息，只写入非零字段
+	UpdateVideoMetadata(ctx context.Context, videoID int64, metadata *domain.VideoMetadata) error
+	// ListDueDraftVideos 按计划发布时间升序返回已到期的草稿
+	ListDueDraftVideos(ctx context.Context, now time.Time, limit int) ([]*domain.Video, error)
+	// RescheduleDraftVideo 修改草稿的计划发布时间，视频已不是草稿时返回 ErrVideoNotDraft
+	RescheduleDraftVideo(ctx context.Context, video *domain.Video, publishAt time.Time) error
+	// PublishDraftVideo 将草稿改为 status，发布时间记为 publishedAt，并在同一事务中写入视频上传事件，
+	// 失效作者作品列表和视频流。视频已不是草稿时返回 false
+	PublishDraftVideo(ctx context.Context, video *domain.Video, status int32, publishedAt time.Time) (bool, error)
 }
 
 // VideoCacheRepo 视频缓存接口
@@ -39,23 +62,40 @@ type VideoCacheRepo interface {
 	GetUserVideos(ctx context.Context, userID int64) ([]*domain.Video, bool)
 	SetUserVideos(ctx context.Context, userID int64, videos []*domain.Video)
 	DeleteUserVideos(ctx context.Context, userID int64)
-	GetFeedVideos(ctx context.Context, lastTime int64) ([]*domain.Video, bool)
-	SetFeedVideos(ctx context.Context, lastTime int64, videos []*domain.Video)
+	// GetFeedVideos 获取不分类视频流的缓存条目，key 由游标按时间区间取整得到
+	GetFeedVideos(ctx context.Context, key string) (*FeedCacheEntry, bool)
+	SetFeedVideos(ctx context.Context, key string, entry *FeedCacheEntry, ttl time.Duration)
 	DeleteFeedCache(ctx context.Context)
 	GetVideoStats(ctx context.Context, videoID int64) (map[string]int64, bool)
 	SetVideoStats(ctx context.Context, videoID int64, stats map[string]int64)
 	IncrVideoStats(ctx context.Context, videoID int64, field string, delta int64)
 }
 
+// coverFrameSeconds 封面截取的画面位置（秒），跳过片头常见的黑场
+const coverFrameSeconds = 1
+
 // VideoUsecase 视频用例
 type VideoUsecase struct {
 	repo           VideoRepo
 	cache          VideoCacheRepo
+	statsBuffer    VideoStatsBufferRepo
+	checksums      UploadChecksumRepo
 	storage        storage.VideoStorage
 	processor      *media.VideoProcessor
+	thumbnail      *media.ThumbnailGenerator
 	kafkaManager   *messaging.KafkaManager
 	validator      *security.Validator
 	businessConfig *conf.Business
+	ranker         *FeedRanker
+	feedCache      *feedCache
+	degradation    *DegradationUsecase
+	moderation     *ContentModerationUsecase
+	scanner        *UploadScanUsecase
+	videoReads     *readCoalescer
+	publishReads   *readCoalescer
+	workers        *worker.Manager
+	locker         Locker
+	clock          clock.Clock
 	log            *log.Helper
 }
 
@@ -63,9 +103,17 @@ type VideoUsecase struct {
 func NewVideoUseCase(
 	repo VideoRepo,
 	cache VideoCacheRepo,
+	statsBuffer VideoStatsBufferRepo,
+	checksums UploadChecksumRepo,
 	storage storage.VideoStorage,
 	kafkaManager *messaging.KafkaManager,
 	businessConfig *conf.Business,
+	degradation *DegradationUsecase,
+	moderation *ContentModerationUsecase,
+	scanner *UploadScanUsecase,
+	workers *worker.Manager,
+	locker Locker,
+	clk clock.Clock,
 	logger log.Logger,
 ) *VideoUsecase {
 	processor := media.NewVideoProcessor(
@@ -75,23 +123,58 @@ func NewVideoUseCase(
 		int(businessConfig.Video.CoverHeight),
 		int(businessConfig.Video.CoverQuality),
 	)
+	processor.SetLimits(NewVideoLimits(businessConfig.Video))
+	thumbnail := media.NewThumbnailGenerator(
+		int(businessConfig.Video.CoverWidth),
+		int(businessConfig.Video.CoverHeight),
+		int(businessConfig.Video.CoverQuality),
+		media.NewFFmpegProcessor(os.TempDir()),
+	)
 
 	return &VideoUsecase{
 		repo:           repo,
 		cache:          cache,
+		statsBuffer:    statsBuffer,
+		checksums:      checksums,
 		storage:        storage,
 		processor:      processor,
+		thumbnail:      thumbnail,
 		kafkaManager:   kafkaManager,
 		validator:      security.NewValidator(),
 		businessConfig: businessConfig,
+		ranker:         NewFeedRanker(businessConfig),
+		feedCache:      newFeedCache(businessConfig),
+		degradation:    degradation,
+		moderation:     moderation,
+		scanner:        scanner,
+		videoReads:     newReadCoalescer(coalesceGetVideo),
+		publishReads:   newReadCoalescer(coalesceGetPublishList),
+		workers:        workers,
+		locker:         locker,
+		clock:          clk,
 		log:            log.NewHelper(logger),
 	}
 }
 
-// PublishVideo 发布视频
-func (uc *VideoUsecase) PublishVideo(ctx context.Context, authorID int64, title string, videoData []byte, filename string) (*domain.Video, error) {
-	// 验证标题
-	if err := uc.validator.ValidateVideoTitle(title); err != nil {
+// PublishVideo 发布视频，categoryID 需事先由分类用例校验，0表示未分类；visibility 为0时公开；
+// publishAt 不为0时保存为草稿，到达该时间（Unix 秒）后由定时发布任务发布。
+// quarantined 为 true 时视频未通过上传扫描，文件移入隔离区并以隔离状态保存，不生成封面也不处理
+func (uc *VideoUsecase) PublishVideo(ctx context.Context, authorID int64, title string, categoryID int64, visibility int32, publishAt int64, videoData []byte, filename string, quarantined bool) (*domain.Video, error) {
+	// 清理并验证标题
+	title, err := uc.normalizeTitle(title)
+	if err != nil {
+		return nil, err
+	}
+	visibility, err = normalizeVideoVisibility(visibility)
+	if err != nil {
+		return nil, err
+	}
+	scheduledAt, err := uc.draftPublishAt(publishAt)
+	if err != nil {
+		return nil, err
+	}
+	flagged, err := uc.moderation.Check(ctx, title)
+	if err != nil {
 		return nil, err
 	}
 
@@ -103,6 +186,16 @@ func (uc *VideoUsecase) PublishVideo(ctx context.Context, authorID int64, title
 	// 生成视频ID
 	videoID := utils.MustGenerateID()
 
+	// 探测视频元信息并校验时长和分辨率，配置了限制时无法探测的文件直接拒绝
+	metadata, err := uc.processor.GetMetadata(ctx, bytes.NewReader(videoData))
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("probe video metadata failed: video=%d err=%v", videoID, err)
+		metadata = &media.VideoMetadata{}
+	}
+	if err := uc.processor.ValidateMetadata(metadata); err != nil {
+		return nil, videoLimitError(err)
+	}
+
 	// 上传视频到存储
 	playURL, err := uc.uploadVideoToStorage(ctx, videoData, filename)
 	if err != nil {
@@ -110,11 +203,32 @@ func (uc *VideoUsecase) PublishVideo(ctx context.Context, authorID int64, title
 		return nil, fmt.Errorf("video upload failed")
 	}
 
-	// 生成封面
-	coverURL, err := uc.generateAndUploadCover(ctx, videoData, videoID)
-	if err != nil {
-		uc.log.WithContext(ctx).Warnf("generate cover failed: %v", err)
-		coverURL = ""
+	// 生成封面，隔离的视频不生成
+	var coverURL string
+	if quarantined {
+		playURL = uc.quarantineObject(ctx, playURL)
+	} else {
+		coverURL, err = uc.generateAndUploadCover(ctx, videoData, videoID)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("generate cover failed: %v", err)
+			coverURL = ""
+		}
+	}
+
+	// 开启审核时视频需审核通过后才会发布，标题疑似违规的视频转人工复核
+	status := int32(domain.VideoStatusPublished)
+	if uc.businessConfig.Video.RequireReview {
+		status = domain.VideoStatusPending
+	}
+	if flagged {
+		status = domain.VideoStatusAuditing
+	}
+	// 草稿在发布时重新审核
+	if scheduledAt != nil {
+		status = domain.VideoStatusDraft
+	}
+	if quarantined {
+		status = domain.VideoStatusQuarantined
 	}
 
 	// 创建视频记录
@@ -124,11 +238,17 @@ func (uc *VideoUsecase) PublishVideo(ctx context.Context, authorID int64, title
 		Title:         title,
 		PlayURL:       playURL,
 		CoverURL:      coverURL,
+		CategoryID:    categoryID,
+		Size:          int64(len(videoData)),
+		Format:        videoFormat(filename),
 		FavoriteCount: 0,
 		CommentCount:  0,
 		PlayCount:     0,
-		Status:        domain.VideoStatusPublished,
+		Status:        status,
+		Visibility:    visibility,
+		PublishAt:     scheduledAt,
 	}
+	applyVideoMetadata(video, toDomainMetadata(metadata))
 
 	// 保存到数据库
 	if err := uc.repo.CreateVideo(ctx, video); err != nil {
@@ -136,11 +256,24 @@ func (uc *VideoUsecase) PublishVideo(ctx context.Context, authorID int64, title
 		return nil, err
 	}
 
+	if quarantined {
+		uc.log.WithContext(ctx).Warnf("video %d quarantined after upload scan", videoID)
+		return video, nil
+	}
+
+	// 草稿的上传事件和处理在发布时发送
+	if scheduledAt != nil {
+		uc.log.WithContext(ctx).Infof("video %d saved as draft, scheduled at %s", videoID, scheduledAt.Format(time.RFC3339))
+		return video, nil
+	}
+
 	// 发送视频上传事件到Kafka
 	uc.publishVideoUploadedEvent(ctx, video)
 
 	// 异步处理视频
-	go uc.processVideoAsync(context.Background(), video)
+	uc.workers.Go(ctx, "video_process_event", func(ctx context.Context) {
+		uc.processVideoAsync(ctx, video)
+	})
 
 	uc.log.WithContext(ctx).Infof("video published successfully: %d", videoID)
 	return video, nil
@@ -153,8 +286,9 @@ func (uc *VideoUsecase) InitiateMultipartUpload(ctx context.Context, filename st
 		return nil, err
 	}
 
-	// 验证标题
-	if err := uc.validator.ValidateVideoTitle(title); err != nil {
+	// 清理并验证标题
+	title, err := uc.normalizeTitle(title)
+	if err != nil {
 		return nil, err
 	}
 
@@ -174,54 +308,162 @@ func (uc *VideoUsecase) InitiateMultipartUpload(ctx context.Context, filename st
 	return nil, fmt.Errorf("storage does not support multipart upload")
 }
 
-// UploadPart 上传分片
-func (uc *VideoUsecase) UploadPart(ctx context.Context, uploadID string, partNumber int, reader io.Reader, size int64) (*storage.PartInfo, error) {
-	if multipartStorage, ok := uc.storage.(storage.MultipartStorage); ok {
-		return multipartStorage.UploadPart(ctx, uploadID, partNumber, reader, size)
+// UploadPart 上传分片，checksum 为空时不校验。分片先读入内存完成校验，
+// 校验失败的分片不写入存储，客户端重传该分片即可
+func (uc *VideoUsecase) UploadPart(ctx context.Context, uploadID string, partNumber int, reader io.Reader, size int64, checksum string) (*storage.PartInfo, error) {
+	multipartStorage, ok := uc.storage.(storage.MultipartStorage)
+	if !ok {
+		return nil, fmt.Errorf("storage does not support multipart upload")
 	}
-	return nil, fmt.Errorf("storage does not support multipart upload")
+
+	expected, err := ParseChecksum(checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	part := checkPart(partNumber, data, expected)
+	if err := uc.checksums.SavePartChecksum(ctx, uploadID, part); err != nil {
+		uc.log.WithContext(ctx).Warnf("save part checksum failed: upload=%s part=%d err=%v", uploadID, partNumber, err)
+	}
+	if part.Status == PartChecksumStatusMismatch {
+		return nil, ErrPartChecksumMismatch
+	}
+
+	return multipartStorage.UploadPart(ctx, uploadID, partNumber, bytes.NewReader(data), size)
 }
 
-// CompleteMultipartUpload 完成分片上传
-func (uc *VideoUsecase) CompleteMultipartUpload(ctx context.Context, uploadID string, parts []storage.PartInfo, title string, userID int64) (*domain.Video, error) {
+// CompleteMultipartUpload 完成分片上传，checksum 不为空时校验合并后的完整文件，
+// 不匹配时删除合并结果；合并后的文件经病毒扫描并校验时长和分辨率，处理方式同 PublishVideo。同一上传的完成请求跨实例互斥，重复提交不会创建多个视频。
+// publishAt 不为0时保存为草稿，见 PublishVideo
+func (uc *VideoUsecase) CompleteMultipartUpload(ctx context.Context, uploadID string, parts []storage.PartInfo, title string, categoryID, userID int64, visibility int32, publishAt int64, checksum string) (*domain.Video, error) {
 	multipartStorage, ok := uc.storage.(storage.MultipartStorage)
 	if !ok {
 		return nil, fmt.Errorf("storage does not support multipart upload")
 	}
 
-	// 完成分片上传
-	fileInfo, err := multipartStorage.CompleteMultipartUpload(ctx, uploadID, parts)
+	// 清理并验证标题
+	title, err := uc.normalizeTitle(title)
+	if err != nil {
+		return nil, err
+	}
+	visibility, err = normalizeVideoVisibility(visibility)
+	if err != nil {
+		return nil, err
+	}
+	flagged, err := uc.moderation.Check(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+	status := int32(domain.VideoStatusPending)
+	if flagged {
+		status = domain.VideoStatusAuditing
+	}
+	scheduledAt, err := uc.draftPublishAt(publishAt)
 	if err != nil {
 		return nil, err
 	}
+	if scheduledAt != nil {
+		status = domain.VideoStatusDraft
+	}
 
-	// 创建视频记录
-	video := &domain.Video{
-		ID:            utils.MustGenerateID(),
-		AuthorID:      userID,
-		Title:         title,
-		PlayURL:       fileInfo.URL,
-		FavoriteCount: 0,
-		CommentCount:  0,
-		PlayCount:     0,
-		Status:        domain.VideoStatusPending,
+	expected, err := ParseChecksum(checksum)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := uc.repo.CreateVideo(ctx, video); err != nil {
+	var video *domain.Video
+	err = withLock(ctx, uc.locker, completeUploadLockKey(uploadID), func(ctx context.Context) error {
+		if err := uc.checkUploadedParts(ctx, uploadID, parts); err != nil {
+			return err
+		}
+
+		// 完成分片上传
+		fileInfo, err := multipartStorage.CompleteMultipartUpload(ctx, uploadID, parts)
+		if err != nil {
+			return err
+		}
+
+		if expected != nil {
+			if err := uc.verifyCompletedUpload(ctx, fileInfo.Name, expected); err != nil {
+				return err
+			}
+		}
+
+		// 分片在合并前无法完整扫描，从存储读取合并后的文件扫描
+		quarantined, err := uc.scanCompletedUpload(ctx, userID, fileInfo.Name)
+		if err != nil {
+			return err
+		}
+		playURL := fileInfo.URL
+		metadata := &media.VideoMetadata{}
+		if quarantined {
+			playURL = uc.quarantineObject(ctx, fileInfo.Name)
+			status = domain.VideoStatusQuarantined
+		} else {
+			metadata, err = uc.validateCompletedUpload(ctx, fileInfo.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 创建视频记录
+		video = &domain.Video{
+			ID:            utils.MustGenerateID(),
+			AuthorID:      userID,
+			Title:         title,
+			PlayURL:       playURL,
+			CategoryID:    categoryID,
+			Size:          fileInfo.Size,
+			Format:        videoFormat(fileInfo.Name),
+			FavoriteCount: 0,
+			CommentCount:  0,
+			PlayCount:     0,
+			Status:        status,
+			Visibility:    visibility,
+			PublishAt:     scheduledAt,
+		}
+		applyVideoMetadata(video, toDomainMetadata(metadata))
+		return uc.repo.CreateVideo(ctx, video)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// 发送处理事件
-	uc.publishVideoUploadedEvent(ctx, video)
+	if video.Status == domain.VideoStatusQuarantined {
+		uc.log.WithContext(ctx).Warnf("video %d quarantined after upload scan", video.ID)
+		return video, nil
+	}
+
+	// 发送处理事件，草稿在发布时发送
+	if scheduledAt == nil {
+		uc.publishVideoUploadedEvent(ctx, video)
+	}
 	return video, nil
 }
 
+func completeUploadLockKey(uploadID string) string {
+	return "upload:complete:" + uploadID
+}
+
 // AbortMultipartUpload 取消分片上传
 func (uc *VideoUsecase) AbortMultipartUpload(ctx context.Context, uploadID string) error {
-	if multipartStorage, ok := uc.storage.(storage.MultipartStorage); ok {
-		return multipartStorage.AbortMultipartUpload(ctx, uploadID)
+	multipartStorage, ok := uc.storage.(storage.MultipartStorage)
+	if !ok {
+		return fmt.Errorf("storage does not support multipart upload")
+	}
+
+	if err := multipartStorage.AbortMultipartUpload(ctx, uploadID); err != nil {
+		return err
 	}
-	return fmt.Errorf("storage does not support multipart upload")
+	if err := uc.checksums.DeleteUploadChecksums(ctx, uploadID); err != nil {
+		uc.log.WithContext(ctx).Warnf("delete upload checksums failed: upload=%s err=%v", uploadID, err)
+	}
+	return nil
 }
 
 // ListUploadedParts 列出已上传的分片
@@ -232,70 +474,190 @@ func (uc *VideoUsecase) ListUploadedParts(ctx context.Context, uploadID string)
 	return nil, fmt.Errorf("storage does not support multipart upload")
 }
 
-// GetFeed 获取视频流
-func (uc *VideoUsecase) GetFeed(ctx context.Context, latestTime int64, limit int) ([]*domain.Video, int64, error) {
+// VerifyUpload 查询上传的分片校验记录，按分片号升序
+func (uc *VideoUsecase) VerifyUpload(ctx context.Context, uploadID string) ([]*PartChecksum, error) {
+	return uc.checksums.ListPartChecksums(ctx, uploadID)
+}
+
+// checkUploadedParts 拒绝合并最近一次上传校验失败的分片
+func (uc *VideoUsecase) checkUploadedParts(ctx context.Context, uploadID string, parts []storage.PartInfo) error {
+	records, err := uc.checksums.ListPartChecksums(ctx, uploadID)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("list part checksums failed: upload=%s err=%v", uploadID, err)
+		return nil
+	}
+
+	mismatched := make(map[int]bool)
+	for _, record := range records {
+		if record.Status == PartChecksumStatusMismatch {
+			mismatched[record.PartNumber] = true
+		}
+	}
+	for _, part := range parts {
+		if mismatched[part.PartNumber] {
+			return ErrPartChecksumMismatch
+		}
+	}
+	return nil
+}
+
+// scanCompletedUpload 扫描合并后的文件，block 模式发现病毒时删除该文件并返回 ErrVideoInfected。
+// 读取文件失败时与扫描失败一样无法确认文件安全，按隔离处理
+func (uc *VideoUsecase) scanCompletedUpload(ctx context.Context, userID int64, objectName string) (bool, error) {
+	if uc.scanner == nil || !uc.scanner.Enabled() {
+		return false, nil
+	}
+
+	reader, err := uc.storage.Download(ctx, objectName)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("read completed upload for scan failed, quarantine upload: object=%s err=%v", objectName, err)
+		return true, nil
+	}
+	quarantined, err := uc.scanner.ScanReader(ctx, userID, objectName, reader)
+	reader.Close()
+	if err != nil {
+		if err := uc.storage.Delete(ctx, objectName); err != nil {
+			uc.log.WithContext(ctx).Warnf("delete infected upload failed: object=%s err=%v", objectName, err)
+		}
+		return false, err
+	}
+	return quarantined, nil
+}
+
+// validateCompletedUpload 探测合并后文件的元信息并校验时长和分辨率，不符合限制或配置了限制但无法探测时
+// 删除该文件并返回错误
+func (uc *VideoUsecase) validateCompletedUpload(ctx context.Context, objectName string) (*media.VideoMetadata, error) {
+	metadata := &media.VideoMetadata{}
+	reader, err := uc.storage.Download(ctx, objectName)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("read completed upload for probe failed: object=%s err=%v", objectName, err)
+	} else {
+		probed, err := uc.processor.GetMetadata(ctx, reader)
+		reader.Close()
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("probe completed upload failed: object=%s err=%v", objectName, err)
+		} else {
+			metadata = probed
+		}
+	}
+
+	if err := uc.processor.ValidateMetadata(metadata); err != nil {
+		if err := uc.storage.Delete(ctx, objectName); err != nil {
+			uc.log.WithContext(ctx).Warnf("delete rejected upload failed: object=%s err=%v", objectName, err)
+		}
+		return nil, videoLimitError(err)
+	}
+	return metadata, nil
+}
+
+// verifyCompletedUpload 读取合并后的文件计算校验值，不匹配时删除该文件
+func (uc *VideoUsecase) verifyCompletedUpload(ctx context.Context, objectName string, expected *Checksum) error {
+	reader, err := uc.storage.Download(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	matched, err := verifyChecksum(reader, expected)
+	reader.Close()
+	if err != nil {
+		return err
+	}
+	if matched {
+		return nil
+	}
+
+	if err := uc.storage.Delete(ctx, objectName); err != nil {
+		uc.log.WithContext(ctx).Warnf("delete corrupted upload failed: object=%s err=%v", objectName, err)
+	}
+	return ErrUploadChecksumMismatch
+}
+
+// GetFeed 获取游标之后的视频流，返回下一页游标，没有更多时为 nil。
+// cursor 为 nil 时从最新开始，categoryID 为 0 时不按分类筛选
+func (uc *VideoUsecase) GetFeed(ctx context.Context, cursor *domain.FeedCursor, categoryID int64, limit int) ([]*domain.Video, *domain.FeedCursor, error) {
 	if limit <= 0 || limit > int(uc.businessConfig.Video.DefaultFeedLimit) {
 		limit = int(uc.businessConfig.Video.DefaultFeedLimit)
 	}
 
-	var feedTime time.Time
-	if latestTime > 0 {
-		feedTime = time.Unix(latestTime, 0)
-	} else {
-		feedTime = time.Now()
+	// 缓存只保存不分类的视频流，按分类筛选时直接查库
+	if categoryID == 0 {
+		videos, ok, err := uc.getCachedFeed(ctx, cursor, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			return feedPage(videos, limit)
+		}
+	}
+
+	// 多取一条用于判断是否还有下一页
+	videos, err := uc.repo.GetFeedVideos(ctx, cursor, categoryID, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return feedPage(videos, limit)
+}
+
+// GetRankedFeed 获取按互动得分排序的视频流，返回下一页偏移，没有更多时为0。
+// 每次请求都对最新的候选池重新排序，翻页期间得分变化可能导致少量重复或遗漏；
+// 未开启得分排序或排序被降级时退化为按发布时间的视频流。categoryID 不为 0 时只对该分类的视频排序
+func (uc *VideoUsecase) GetRankedFeed(ctx context.Context, categoryID int64, offset, limit int) ([]*domain.Video, int, error) {
+	if !uc.ranker.Enabled() || !uc.degradation.Allow(FeatureFeedRanking) {
+		videos, _, err := uc.GetFeed(ctx, nil, categoryID, limit)
+		return videos, 0, err
 	}
 
-	// 先尝试从缓存获取
-	if videos, ok := uc.cache.GetFeedVideos(ctx, latestTime); ok && len(videos) >= limit {
-		nextTime := uc.getNextTime(videos, limit)
-		return videos[:limit], nextTime, nil
+	if limit <= 0 || limit > int(uc.businessConfig.Video.DefaultFeedLimit) {
+		limit = int(uc.businessConfig.Video.DefaultFeedLimit)
+	}
+	if offset < 0 {
+		offset = 0
 	}
 
-	// 从数据库获取
-	videos, err := uc.repo.GetFeedVideos(ctx, feedTime, limit)
+	now := uc.clock.Now()
+	candidates, err := uc.repo.GetFeedVideos(ctx, &domain.FeedCursor{CreatedAt: now}, categoryID, uc.ranker.PoolSize())
 	if err != nil {
 		return nil, 0, err
 	}
 
-	// 缓存结果
-	if len(videos) > 0 {
-		uc.cache.SetFeedVideos(ctx, latestTime, videos)
+	ranked := uc.ranker.Rank(candidates, now)
+	if offset >= len(ranked) {
+		return []*domain.Video{}, 0, nil
+	}
+
+	end := min(offset+limit, len(ranked))
+	nextOffset := 0
+	if end < len(ranked) {
+		nextOffset = end
 	}
 
-	nextTime := uc.getNextTime(videos, limit)
-	return videos, nextTime, nil
+	return ranked[offset:end], nextOffset, nil
 }
 
-// GetPublishList 获取用户发布列表
+// GetPublishList 获取用户发布列表，包含全部可见范围，由调用方按访问者过滤。同一用户的并发请求合并为一次查询
 func (uc *VideoUsecase) GetPublishList(ctx context.Context, userID int64) ([]*domain.Video, error) {
 	if err := uc.validator.ValidateUserID(userID); err != nil {
 		return nil, err
 	}
 
-	videos, err := uc.repo.GetUserVideos(ctx, userID, 100)
-	if err != nil {
-		return nil, err
-	}
-
-	return videos, nil
+	return coalesce(ctx, uc.publishReads, strconv.FormatInt(userID, 10), func(ctx context.Context) ([]*domain.Video, error) {
+		return uc.repo.GetUserVideos(ctx, userID, 100)
+	}, cloneVideos)
 }
 
-// GetVideo 获取视频信息
+// GetVideo 获取视频信息，同一视频的并发请求合并为一次查询。播放数由 PlayCountUsecase 按上报的有效播放计入
 func (uc *VideoUsecase) GetVideo(ctx context.Context, videoID int64) (*domain.Video, error) {
 	if err := uc.validator.ValidateVideoID(videoID); err != nil {
 		return nil, err
 	}
 
-	video, err := uc.repo.GetVideo(ctx, videoID)
+	video, err := coalesce(ctx, uc.videoReads, strconv.FormatInt(videoID, 10), func(ctx context.Context) (*domain.Video, error) {
+		return uc.repo.GetVideo(ctx, videoID)
+	}, cloneVideo)
 	if err != nil {
 		return nil, err
 	}
 
-	// 异步增加播放计数
-	go func() {
-		uc.IncrementPlayCount(context.Background(), videoID)
-	}()
-
 	return video, nil
 }
 
@@ -322,12 +684,18 @@ func (uc *VideoUsecase) UpdateVideoStats(ctx context.Context, videoID int64, sta
 		field = "comment_count"
 	case "play":
 		field = "play_count"
+	case "share":
+		field = "share_count"
 	default:
 		return fmt.Errorf("invalid stats type: %s", statsType)
 	}
 
-	// 更新数据库
-	if err := uc.repo.UpdateVideoStats(ctx, videoID, field, delta); err != nil {
+	// 开启写缓冲时只累加到Redis，由刷写任务批量写库
+	if uc.businessConfig.GetVideoStats().GetWriteBehind() {
+		if err := uc.statsBuffer.Incr(ctx, videoID, field, delta); err != nil {
+			return err
+		}
+	} else if err := uc.repo.UpdateVideoStats(ctx, videoID, field, delta); err != nil {
 		return err
 	}
 
@@ -424,17 +792,124 @@ func (uc *VideoUsecase) UpdateVideoPlayURL(ctx context.Context, videoID int64, p
 	return nil
 }
 
+// UpdateVideoPlayURLs 更新视频各清晰度的播放地址
+func (uc *VideoUsecase) UpdateVideoPlayURLs(ctx context.Context, videoID int64, playURLs map[string]string) error {
+	if err := uc.repo.UpdateVideoPlayURLs(ctx, videoID, playURLs); err != nil {
+		return err
+	}
+
+	// 清除缓存
+	uc.cache.DeleteVideo(ctx, videoID)
+	return nil
+}
+
+// UpdateVideoDynamicCover 更新视频的动态封面地址
+func (uc *VideoUsecase) UpdateVideoDynamicCover(ctx context.Context, videoID int64, dynamicCoverURL string) error {
+	if err := uc.repo.UpdateVideoDynamicCover(ctx, videoID, dynamicCoverURL); err != nil {
+		return err
+	}
+
+	// 清除缓存
+	uc.cache.DeleteVideo(ctx, videoID)
+	return nil
+}
+
+// UpdateVideoHLSURL 更新视频的HLS主播放列表地址
+func (uc *VideoUsecase) UpdateVideoHLSURL(ctx context.Context, videoID int64, hlsURL string) error {
+	if err := uc.repo.UpdateVideoHLSURL(ctx, videoID, hlsURL); err != nil {
+		return err
+	}
+
+	// 清除缓存
+	uc.cache.DeleteVideo(ctx, videoID)
+	return nil
+}
+
+// UpdateVideoMetadata 保存转码前探测到的视频元信息，格式以上传文件扩展名为准不覆盖
+func (uc *VideoUsecase) UpdateVideoMetadata(ctx context.Context, videoID int64, metadata *media.VideoMetadata) error {
+	if err := uc.repo.UpdateVideoMetadata(ctx, videoID, toDomainMetadata(metadata)); err != nil {
+		return err
+	}
+
+	// 清除缓存
+	uc.cache.DeleteVideo(ctx, videoID)
+	return nil
+}
+
 // 内部辅助方法
 
+// videoFormat 从文件名取容器格式，如 mp4、mov
+func videoFormat(filename string) string {
+	return strings.TrimPrefix(strings.ToLower(path.Ext(filename)), ".")
+}
+
+// toDomainMetadata 转换探测结果，不包含格式：ffprobe 的格式名是一组别名，如 mov,mp4,m4a
+func toDomainMetadata(metadata *media.VideoMetadata) *domain.VideoMetadata {
+	bitrate, _ := strconv.ParseInt(metadata.Bitrate, 10, 64)
+	audioBitrate, _ := strconv.ParseInt(metadata.AudioBitrate, 10, 64)
+	return &domain.VideoMetadata{
+		Duration:     metadata.Duration,
+		Width:        int32(metadata.Width),
+		Height:       int32(metadata.Height),
+		Bitrate:      bitrate,
+		Size:         metadata.Size,
+		Framerate:    metadata.Framerate,
+		AudioCodec:   metadata.AudioCodec,
+		AudioBitrate: audioBitrate,
+	}
+}
+
+// applyVideoMetadata 将元信息的非零字段写入视频
+func applyVideoMetadata(video *domain.Video, metadata *domain.VideoMetadata) {
+	if metadata.Duration > 0 {
+		video.Duration = metadata.Duration
+	}
+	if metadata.Width > 0 && metadata.Height > 0 {
+		video.Width, video.Height = metadata.Width, metadata.Height
+	}
+	if metadata.Bitrate > 0 {
+		video.Bitrate = metadata.Bitrate
+	}
+	if metadata.Size > 0 {
+		video.Size = metadata.Size
+	}
+	if metadata.Format != "" {
+		video.Format = metadata.Format
+	}
+	if metadata.AudioCodec != "" {
+		video.AudioCodec = metadata.AudioCodec
+	}
+	if metadata.AudioBitrate > 0 {
+		video.AudioBitrate = metadata.AudioBitrate
+	}
+}
+
+// normalizeTitle 清理标题中的HTML和控制字符，并校验长度和富文本实体数量
+func (uc *VideoUsecase) normalizeTitle(title string) (string, error) {
+	text, err := richtext.Parse(title, richtext.DefaultLimits)
+	if err != nil {
+		return "", err
+	}
+	if err := uc.validator.ValidateVideoTitle(text.Plain); err != nil {
+		return "", err
+	}
+	return text.Plain, nil
+}
+
 func (uc *VideoUsecase) uploadVideoToStorage(ctx context.Context, videoData []byte, filename string) (string, error) {
 	objectName := utils.GenerateVideoFilename(filename)
 	return uc.storage.UploadVideo(ctx, objectName, strings.NewReader(string(videoData)), int64(len(videoData)))
 }
 
+// generateAndUploadCover 截取视频画面作为封面，ffmpeg失败时使用占位图
 func (uc *VideoUsecase) generateAndUploadCover(ctx context.Context, videoData []byte, videoID int64) (string, error) {
-	coverReader, err := uc.processor.GenerateDefaultThumbnail(ctx)
+	coverReader, err := uc.thumbnail.ExtractFrame(ctx, bytes.NewReader(videoData), coverFrameSeconds)
 	if err != nil {
-		return "", err
+		uc.log.WithContext(ctx).Warnf("extract cover frame failed, using placeholder: video=%d err=%v", videoID, err)
+		coverReader, err = uc.processor.GenerateDefaultThumbnail(ctx)
+		if err != nil {
+			return "", err
+		}
 	}
 
 	coverData, err := io.ReadAll(coverReader)
@@ -446,6 +921,22 @@ func (uc *VideoUsecase) generateAndUploadCover(ctx context.Context, videoData []
 	return uc.storage.UploadCover(ctx, coverFilename, strings.NewReader(string(coverData)), int64(len(coverData)))
 }
 
+// quarantineObject 将上传的视频移入隔离区，返回隔离后的对象键。存储不支持隔离或移动失败时
+// 文件留在原位置，视频状态仍会阻止其被处理和访问
+func (uc *VideoUsecase) quarantineObject(ctx context.Context, objectName string) string {
+	quarantiner, ok := uc.storage.(storage.QuarantineStorage)
+	if !ok {
+		uc.log.WithContext(ctx).Warnf("storage does not support quarantine, object %s stays in place", objectName)
+		return objectName
+	}
+	quarantined, err := quarantiner.Quarantine(ctx, objectName)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("quarantine object failed: object=%s err=%v", objectName, err)
+		return objectName
+	}
+	return quarantined
+}
+
 func (uc *VideoUsecase) publishVideoUploadedEvent(ctx context.Context, video *domain.Video) {
 	if uc.kafkaManager == nil {
 		return
@@ -521,17 +1012,14 @@ func (uc *VideoUsecase) extractObjectName(url string) string {
 	return url
 }
 
-func (uc *VideoUsecase) getNextTime(videos []*domain.Video, limit int) int64 {
-	if len(videos) == 0 {
-		return 0
-	}
-
-	lastIndex := len(videos) - 1
-	if len(videos) > limit {
-		lastIndex = limit - 1
+// feedPage 截取一页视频，多出的视频说明还有下一页，以本页最后一条作为下一页游标
+func feedPage(videos []*domain.Video, limit int) ([]*domain.Video, *domain.FeedCursor, error) {
+	if len(videos) <= limit {
+		return videos, nil, nil
 	}
 
-	return videos[lastIndex].CreatedAt.Unix()
+	last := videos[limit-1]
+	return videos[:limit], &domain.FeedCursor{CreatedAt: last.CreatedAt, VideoID: last.ID}, nil
 }
 
 // UploadConfig 上传配置
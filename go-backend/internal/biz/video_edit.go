@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/media"
+	"go-backend/pkg/richtext"
+	"go-backend/pkg/security"
+	"go-backend/pkg/storage"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrNothingToUpdate = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "nothing to update")
+	ErrInvalidHashtag  = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "hashtags may only contain letters, digits and underscores")
+)
+
+// VideoInfoInput 视频信息修改内容，为空的字段不修改
+type VideoInfoInput struct {
+	Title *string
+	// Hashtags 替换标题中的话题，不含 # 前缀；为 nil 时不修改，为空列表时清空话题
+	Hashtags []string
+	Cover    io.Reader
+}
+
+// VideoEditUsecase 修改已发布视频的标题、话题和封面，仅作者和审核员可修改。
+// 话题以 #话题 的形式保存在标题中，修改话题即改写标题
+type VideoEditUsecase struct {
+	repo         VideoRepo
+	permissionUc *PermissionUsecase
+	moderation   *ContentModerationUsecase
+	deletions    StorageDeletionRepo
+	storage      storage.VideoStorage
+	images       *media.ImageProcessor
+	coverOptions media.ImageOptions
+	validator    *security.Validator
+	clock        clock.Clock
+	log          *log.Helper
+}
+
+// NewVideoEditUsecase 创建视频信息修改用例
+func NewVideoEditUsecase(repo VideoRepo, permissionUc *PermissionUsecase, moderation *ContentModerationUsecase, deletions StorageDeletionRepo, storage storage.VideoStorage, businessConfig *conf.Business, clk clock.Clock, logger log.Logger) *VideoEditUsecase {
+	return &VideoEditUsecase{
+		repo:         repo,
+		permissionUc: permissionUc,
+		moderation:   moderation,
+		deletions:    deletions,
+		storage:      storage,
+		images:       media.NewImageProcessor(0, int(businessConfig.Video.CoverQuality)),
+		coverOptions: media.ImageOptions{
+			Width:  int(businessConfig.Video.CoverWidth),
+			Height: int(businessConfig.Video.CoverHeight),
+			Crop:   true,
+			Format: media.ParseImageFormat(businessConfig.Video.CoverFormat),
+		},
+		validator: security.NewValidator(),
+		clock:     clk,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// UpdateVideoInfo 修改视频信息。作者修改的标题需要重新审核，疑似违规时已发布的视频转人工复核；
+// 审核员的修改不再审核。新封面上传成功并更新后，旧封面写入存储删除队列
+func (uc *VideoEditUsecase) UpdateVideoInfo(ctx context.Context, operatorID, videoID int64, input *VideoInfoInput) (*domain.Video, error) {
+	if input.Title == nil && input.Hashtags == nil && input.Cover == nil {
+		return nil, ErrNothingToUpdate
+	}
+
+	video, err := uc.repo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	isModerator, err := uc.checkEditor(ctx, operatorID, video)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *video
+	if input.Title != nil || input.Hashtags != nil {
+		title, err := uc.buildTitle(video.Title, input)
+		if err != nil {
+			return nil, err
+		}
+		if title != video.Title && !isModerator {
+			flagged, err := uc.moderation.Check(ctx, title)
+			if err != nil {
+				return nil, err
+			}
+			if flagged && video.Status == domain.VideoStatusPublished {
+				updated.Status = domain.VideoStatusAuditing
+			}
+		}
+		updated.Title = title
+	}
+
+	if input.Cover != nil {
+		coverURL, err := uc.uploadCover(ctx, videoID, input.Cover)
+		if err != nil {
+			return nil, err
+		}
+		updated.CoverURL = coverURL
+	}
+
+	if err := uc.repo.UpdateVideoInfo(ctx, &updated); err != nil {
+		if updated.CoverURL != video.CoverURL {
+			uc.deleteCover(ctx, updated.CoverURL)
+		}
+		return nil, err
+	}
+	if updated.CoverURL != video.CoverURL {
+		uc.scheduleCoverDeletion(ctx, video.CoverURL)
+	}
+
+	uc.log.WithContext(ctx).Infof("video %d info updated by user %d: title_changed=%t cover_changed=%t status=%d",
+		videoID, operatorID, updated.Title != video.Title, updated.CoverURL != video.CoverURL, updated.Status)
+	return &updated, nil
+}
+
+// checkEditor 作者或审核员可以修改，返回是否以审核员身份修改
+func (uc *VideoEditUsecase) checkEditor(ctx context.Context, operatorID int64, video *domain.Video) (bool, error) {
+	if video.AuthorID == operatorID {
+		return false, nil
+	}
+	allowed, err := uc.permissionUc.CanModerateContent(ctx, operatorID)
+	if err != nil {
+		return false, err
+	}
+	if !allowed {
+		return false, ErrPermissionDenied
+	}
+	return true, nil
+}
+
+// buildTitle 按输入生成新标题：先替换标题正文，再替换话题；只修改话题时保留原标题正文
+func (uc *VideoEditUsecase) buildTitle(current string, input *VideoInfoInput) (string, error) {
+	title := current
+	if input.Title != nil {
+		title = *input.Title
+	}
+	if input.Hashtags != nil {
+		var err error
+		if title, err = richtext.ReplaceHashtags(richtext.Sanitize(title), input.Hashtags); err != nil {
+			return "", ErrInvalidHashtag
+		}
+	}
+
+	text, err := richtext.Parse(title, richtext.DefaultLimits)
+	if err != nil {
+		return "", err
+	}
+	if err := uc.validator.ValidateVideoTitle(text.Plain); err != nil {
+		return "", err
+	}
+	return text.Plain, nil
+}
+
+// uploadCover 校验并裁剪封面图片后上传，返回封面地址
+func (uc *VideoEditUsecase) uploadCover(ctx context.Context, videoID int64, reader io.Reader) (string, error) {
+	img, err := uc.images.Process(reader, uc.coverOptions)
+	if err != nil {
+		switch {
+		case errors.Is(err, media.ErrImageTooLarge):
+			return "", ErrImageSize
+		case errors.Is(err, media.ErrUnsupportedImage):
+			return "", ErrImageFormat
+		default:
+			return "", err
+		}
+	}
+
+	url, err := uc.storage.UploadCover(ctx, fmt.Sprintf("cover_%d%s", videoID, img.Ext), bytes.NewReader(img.Data), img.Size())
+	if err != nil {
+		return "", fmt.Errorf("upload video cover failed: %w", err)
+	}
+	return url, nil
+}
+
+// scheduleCoverDeletion 旧封面由存储删除任务删除
+func (uc *VideoEditUsecase) scheduleCoverDeletion(ctx context.Context, coverURL string) {
+	objectName, ok := uc.coverObjectName(coverURL)
+	if !ok {
+		return
+	}
+	if err := uc.deletions.ScheduleStorageDeletions(ctx, []*StorageDeletion{{
+		ObjectName:  objectName,
+		Reason:      StorageDeletionReasonCoverReplaced,
+		DeleteAfter: uc.clock.Now(),
+	}}); err != nil {
+		uc.log.WithContext(ctx).Warnf("schedule cover deletion failed: object=%s err=%v", objectName, err)
+	}
+}
+
+func (uc *VideoEditUsecase) deleteCover(ctx context.Context, coverURL string) {
+	objectName, ok := uc.coverObjectName(coverURL)
+	if !ok {
+		return
+	}
+	if err := uc.storage.Delete(ctx, objectName); err != nil {
+		uc.log.WithContext(ctx).Warnf("delete video cover failed: object=%s err=%v", objectName, err)
+	}
+}
+
+// coverObjectName 封面地址可能是上传时返回的对象键或访问URL，只处理本站存储中的封面
+func (uc *VideoEditUsecase) coverObjectName(coverURL string) (string, bool) {
+	objectName := coverURL
+	if resolver, ok := uc.storage.(storage.ObjectResolver); ok {
+		if name, ok := resolver.ObjectName(coverURL); ok {
+			objectName = name
+		}
+	}
+	if !strings.HasPrefix(objectName, "covers/") {
+		return "", false
+	}
+	return objectName, true
+}
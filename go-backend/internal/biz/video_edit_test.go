@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// coverStorage 与 MinIO 一致，上传封面返回对象键
+type coverStorage struct {
+	*memoryStorage
+}
+
+func (s *coverStorage) UploadCover(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	objectName := fmt.Sprintf("covers/%d.jpg", s.uploads+1)
+	if _, err := s.Upload(ctx, objectName, reader, size, nil); err != nil {
+		return "", err
+	}
+	return objectName, nil
+}
+
+type videoEditTestDeps struct {
+	repo      *MockVideoRepo
+	roleRepo  *MockRoleRepo
+	deletions *MockStorageDeletionRepo
+	storage   *coverStorage
+	clock     *clock.Fake
+	uc        *VideoEditUsecase
+}
+
+func newVideoEditTestDeps(t *testing.T) *videoEditTestDeps {
+	d := &videoEditTestDeps{
+		repo:      NewMockVideoRepo(t),
+		roleRepo:  NewMockRoleRepo(t),
+		deletions: NewMockStorageDeletionRepo(t),
+		storage:   &coverStorage{memoryStorage: newMemoryStorage()},
+		clock:     clock.NewFake(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)),
+	}
+	permissionUc := NewPermissionUsecase(d.roleRepo, NewMockPermissionRepo(t), auth.NewMemoryRBACManager(), nil, log.DefaultLogger)
+	moderation := NewContentModerationUsecase(nil, &conf.Business{
+		Moderation: &conf.Business_Moderation{
+			BlockWords:  []string{"赌博"},
+			ReviewWords: []string{"加微信"},
+		},
+	}, log.DefaultLogger)
+	config := &conf.Business{Video: &conf.Business_Video{CoverWidth: 32, CoverHeight: 18}}
+	d.uc = NewVideoEditUsecase(d.repo, permissionUc, moderation, d.deletions, d.storage, config, d.clock, log.DefaultLogger)
+	return d
+}
+
+func (d *videoEditTestDeps) expectModerator(ctx context.Context, userID int64, isModerator bool) {
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "admin").Return(&domain.Role{ID: 1, Name: "admin"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(1)).Return(false, nil)
+	d.roleRepo.EXPECT().GetRoleByName(ctx, "moderator").Return(&domain.Role{ID: 2, Name: "moderator"}, nil)
+	d.roleRepo.EXPECT().HasRole(ctx, userID, int64(2)).Return(isModerator, nil)
+}
+
+func TestVideoEditUsecase_UpdateVideoInfo(t *testing.T) {
+	ctx := context.Background()
+	published := func() *domain.Video {
+		return &domain.Video{ID: 10, AuthorID: 1, Title: "旅行日记 #旅行 #风景", CoverURL: "covers/old.jpg", Status: domain.VideoStatusPublished}
+	}
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("AuthorEditsTitle", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.repo.EXPECT().UpdateVideoInfo(ctx, mock.Anything).Return(nil)
+
+		video, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Title: strPtr("新的标题 #旅行")})
+		require.NoError(t, err)
+		assert.Equal(t, "新的标题 #旅行", video.Title)
+		assert.Equal(t, int32(domain.VideoStatusPublished), video.Status)
+		assert.Equal(t, "covers/old.jpg", video.CoverURL)
+	})
+
+	t.Run("ReplaceHashtagsKeepsTitle", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.repo.EXPECT().UpdateVideoInfo(ctx, mock.Anything).Return(nil)
+
+		video, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Hashtags: []string{"美食", "美食", "vlog"}})
+		require.NoError(t, err)
+		assert.Equal(t, "旅行日记 #美食 #vlog", video.Title)
+	})
+
+	t.Run("ClearHashtags", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.repo.EXPECT().UpdateVideoInfo(ctx, mock.Anything).Return(nil)
+
+		video, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Hashtags: []string{}})
+		require.NoError(t, err)
+		assert.Equal(t, "旅行日记", video.Title)
+	})
+
+	t.Run("InvalidHashtag", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+
+		_, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Hashtags: []string{"a b"}})
+		assert.Equal(t, ErrInvalidHashtag, err)
+	})
+
+	t.Run("FlaggedTitleGoesToReview", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.repo.EXPECT().UpdateVideoInfo(ctx, mock.Anything).Return(nil)
+
+		video, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Title: strPtr("加微信领福利")})
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.VideoStatusAuditing), video.Status)
+	})
+
+	t.Run("BlockedTitle", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+
+		_, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Title: strPtr("赌博")})
+		assert.Equal(t, ErrContentViolation, err)
+	})
+
+	t.Run("ModeratorSkipsModeration", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.expectModerator(ctx, 5, true)
+		d.repo.EXPECT().UpdateVideoInfo(ctx, mock.Anything).Return(nil)
+
+		video, err := d.uc.UpdateVideoInfo(ctx, 5, 10, &VideoInfoInput{Title: strPtr("加微信")})
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.VideoStatusPublished), video.Status)
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.expectModerator(ctx, 2, false)
+
+		_, err := d.uc.UpdateVideoInfo(ctx, 2, 10, &VideoInfoInput{Title: strPtr("新的标题")})
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("NothingToUpdate", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		_, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{})
+		assert.Equal(t, ErrNothingToUpdate, err)
+	})
+
+	t.Run("ReplaceCover", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.repo.EXPECT().UpdateVideoInfo(ctx, mock.Anything).Return(nil)
+		d.deletions.EXPECT().ScheduleStorageDeletions(ctx, []*StorageDeletion{{
+			ObjectName:  "covers/old.jpg",
+			Reason:      StorageDeletionReasonCoverReplaced,
+			DeleteAfter: d.clock.Now(),
+		}}).Return(nil)
+
+		cover := encodeProfileTestImage(t, 640, 480)
+		video, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Cover: strings.NewReader(string(cover))})
+		require.NoError(t, err)
+		assert.Equal(t, "covers/1.jpg", video.CoverURL)
+		assert.Contains(t, d.storage.objects, "covers/1.jpg")
+	})
+
+	t.Run("UpdateFailureDeletesNewCover", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+		d.repo.EXPECT().UpdateVideoInfo(ctx, mock.Anything).Return(errors.New("db error"))
+
+		cover := encodeProfileTestImage(t, 640, 480)
+		_, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Cover: strings.NewReader(string(cover))})
+		require.Error(t, err)
+		assert.Empty(t, d.storage.objects)
+	})
+
+	t.Run("InvalidCover", func(t *testing.T) {
+		d := newVideoEditTestDeps(t)
+		d.repo.EXPECT().GetVideo(ctx, int64(10)).Return(published(), nil)
+
+		_, err := d.uc.UpdateVideoInfo(ctx, 1, 10, &VideoInfoInput{Cover: strings.NewReader("not an image")})
+		assert.Equal(t, ErrImageFormat, err)
+	})
+}
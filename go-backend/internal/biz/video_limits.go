@@ -0,0 +1,44 @@
+package biz
+
+import (
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	ErrVideoTooLong           = errors.BadRequest(v1.ErrorCode_VIDEO_DURATION_ERR.String(), "video duration exceeds limit")
+	ErrVideoResolutionTooLow  = errors.BadRequest(v1.ErrorCode_VIDEO_RESOLUTION_TOO_LOW.String(), "video resolution below limit")
+	ErrVideoResolutionTooHigh = errors.BadRequest(v1.ErrorCode_VIDEO_RESOLUTION_TOO_HIGH.String(), "video resolution exceeds limit")
+	// ErrVideoMetadataUnavailable 配置了时长或分辨率限制时，无法探测元信息的文件无法确认是否符合限制
+	ErrVideoMetadataUnavailable = errors.BadRequest(v1.ErrorCode_VIDEO_FORMAT_ERR.String(), "video metadata could not be read")
+)
+
+// NewVideoLimits 从配置创建视频时长和分辨率限制，未配置的字段不限制
+func NewVideoLimits(cfg *conf.Business_Video) media.VideoLimits {
+	return media.VideoLimits{
+		MaxDuration:   cfg.GetMaxDuration().AsDuration(),
+		MinResolution: int(cfg.GetMinResolution()),
+		MaxResolution: int(cfg.GetMaxResolution()),
+	}
+}
+
+// videoLimitError 将处理器的校验错误转换为对应错误码，消息保留实际值和限制值方便客户端提示
+func videoLimitError(err error) error {
+	var target *errors.Error
+	switch {
+	case errors.Is(err, media.ErrVideoTooLong):
+		target = ErrVideoTooLong
+	case errors.Is(err, media.ErrResolutionTooLow):
+		target = ErrVideoResolutionTooLow
+	case errors.Is(err, media.ErrResolutionTooHigh):
+		target = ErrVideoResolutionTooHigh
+	case errors.Is(err, media.ErrMetadataUnavailable):
+		target = ErrVideoMetadataUnavailable
+	default:
+		return err
+	}
+	return errors.BadRequest(target.Reason, err.Error())
+}
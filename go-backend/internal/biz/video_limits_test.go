@@ -0,0 +1,70 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/conf"
+	"go-backend/pkg/media"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestNewVideoLimits(t *testing.T) {
+	assert.Equal(t, media.VideoLimits{}, NewVideoLimits(nil))
+
+	limits := NewVideoLimits(&conf.Business_Video{
+		MaxDuration:   durationpb.New(10 * time.Minute),
+		MinResolution: 360,
+		MaxResolution: 2160,
+	})
+	assert.Equal(t, media.VideoLimits{MaxDuration: 10 * time.Minute, MinResolution: 360, MaxResolution: 2160}, limits)
+}
+
+func TestVideoLimitError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code v1.ErrorCode
+	}{
+		{fmt.Errorf("%w: duration 75.0s, max 60s", media.ErrVideoTooLong), v1.ErrorCode_VIDEO_DURATION_ERR},
+		{fmt.Errorf("%w: 640x320, min 360p", media.ErrResolutionTooLow), v1.ErrorCode_VIDEO_RESOLUTION_TOO_LOW},
+		{fmt.Errorf("%w: 3840x2160, max 1080p", media.ErrResolutionTooHigh), v1.ErrorCode_VIDEO_RESOLUTION_TOO_HIGH},
+		{fmt.Errorf("%w: duration unknown", media.ErrMetadataUnavailable), v1.ErrorCode_VIDEO_FORMAT_ERR},
+	}
+	for _, tt := range tests {
+		err := kerrors.FromError(videoLimitError(tt.err))
+		assert.Equal(t, int32(400), err.Code)
+		assert.Equal(t, tt.code.String(), err.Reason)
+		assert.Equal(t, tt.err.Error(), err.Message)
+	}
+
+	other := errors.New("probe failed")
+	assert.Same(t, other, videoLimitError(other))
+	assert.True(t, kerrors.Is(videoLimitError(tests[0].err), ErrVideoTooLong))
+}
+
+func TestVideoUsecase_CompleteMultipartUploadLimits(t *testing.T) {
+	require.NoError(t, utils.InitSnowflake(1, 1))
+	ctx := context.Background()
+	parts := []storage.PartInfo{{PartNumber: 1, Size: 5}, {PartNumber: 2, Size: 5}}
+
+	// 合并结果不是有效视频，无法探测时长和分辨率，配置了限制时拒绝并删除合并结果
+	d := newUploadChecksumTestDeps(t)
+	d.uc.processor.SetLimits(media.VideoLimits{MaxDuration: time.Minute, MinResolution: 360})
+	d.storage.parts[1] = []byte("hello")
+	d.storage.parts[2] = []byte("world")
+	d.checksums.EXPECT().ListPartChecksums(ctx, "u1").Return(nil, nil)
+
+	_, err := d.uc.CompleteMultipartUpload(ctx, "u1", parts, "title", 0, 7, 0, 0, "")
+	assert.True(t, kerrors.Is(err, ErrVideoMetadataUnavailable))
+	assert.NotContains(t, d.storage.objects, "u1")
+}
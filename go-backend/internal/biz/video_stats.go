@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultVideoStatsFlushInterval  = 5 * time.Second
+	defaultVideoStatsFlushBatchSize = 500
+
+	// videoStatsFlushLockKey 同一时刻只有一个实例刷写，避免并发事务争用同一批视频行
+	videoStatsFlushLockKey = "video:stats:flush"
+	// videoStatsFlushMaxBatches 单次刷写最多处理的批次数，剩余的留给下一次
+	videoStatsFlushMaxBatches = 20
+)
+
+// VideoStatsFlushBatch 一批日志条目的写入结果
+type VideoStatsFlushBatch struct {
+	Entries int // 从日志流读取的条目数
+	Applied int // 写入数据库的条目数，重放时已应用过的条目不计入
+}
+
+// VideoStatsBufferRepo 视频计数写缓冲。增量先累加在 Redis 哈希中，刷写时原子地转入
+// 只追加的 Redis 日志流，再分批写入 MySQL。数据库在同一事务中记录已应用的最后一条日志，
+// 写库后、删除日志前崩溃时，重放会跳过已应用的条目
+type VideoStatsBufferRepo interface {
+	// Incr 把一次计数增量累加到缓冲
+	Incr(ctx context.Context, videoID int64, field string, delta int64) error
+	// Seal 把缓冲中累计的增量追加到日志流并清空缓冲，返回追加的条目数
+	Seal(ctx context.Context) (int, error)
+	// Apply 把日志流中最早的至多 limit 条增量在一个事务内写入视频表并推进检查点，提交后删除这些日志条目
+	Apply(ctx context.Context, limit int) (*VideoStatsFlushBatch, error)
+}
+
+// VideoStatsFlushUsecase 视频计数刷写任务，开启写缓冲时定期把 Redis 中的增量批量写入数据库
+type VideoStatsFlushUsecase struct {
+	repo      VideoStatsBufferRepo
+	locker    Locker
+	enabled   bool
+	interval  time.Duration
+	batchSize int
+	clock     clock.Clock
+	log       *log.Helper
+}
+
+// NewVideoStatsFlushUsecase 创建视频计数刷写任务
+func NewVideoStatsFlushUsecase(repo VideoStatsBufferRepo, businessConfig *conf.Business, locker Locker, clk clock.Clock, logger log.Logger) *VideoStatsFlushUsecase {
+	uc := &VideoStatsFlushUsecase{
+		repo:      repo,
+		locker:    locker,
+		interval:  defaultVideoStatsFlushInterval,
+		batchSize: defaultVideoStatsFlushBatchSize,
+		clock:     clk,
+		log:       log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetVideoStats(); cfg != nil {
+		uc.enabled = cfg.WriteBehind
+		if cfg.FlushInterval != nil && cfg.FlushInterval.AsDuration() > 0 {
+			uc.interval = cfg.FlushInterval.AsDuration()
+		}
+		if cfg.FlushBatchSize > 0 {
+			uc.batchSize = int(cfg.FlushBatchSize)
+		}
+	}
+
+	return uc
+}
+
+// Enabled 是否开启写缓冲
+func (uc *VideoStatsFlushUsecase) Enabled() bool {
+	return uc.enabled
+}
+
+// Interval 刷写间隔
+func (uc *VideoStatsFlushUsecase) Interval() time.Duration {
+	return uc.interval
+}
+
+// Flush 持锁执行一次刷写，供调度器调用，其他实例正在刷写时跳过本次
+func (uc *VideoStatsFlushUsecase) Flush(ctx context.Context) error {
+	err := withLock(ctx, uc.locker, videoStatsFlushLockKey, uc.flush)
+	if errors.Is(err, ErrResourceLocked) {
+		return nil
+	}
+	return err
+}
+
+// flush 先把缓冲转入日志流，再分批写库。上次未写完的日志条目排在前面，会先被处理
+func (uc *VideoStatsFlushUsecase) flush(ctx context.Context) error {
+	start := uc.clock.Now()
+
+	sealed, err := uc.repo.Seal(ctx)
+	if err != nil {
+		return fmt.Errorf("seal video stats buffer: %w", err)
+	}
+
+	var entries, applied int
+	for i := 0; i < videoStatsFlushMaxBatches; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		batch, err := uc.repo.Apply(ctx, uc.batchSize)
+		if err != nil {
+			return fmt.Errorf("apply video stats journal: %w", err)
+		}
+		entries += batch.Entries
+		applied += batch.Applied
+
+		if batch.Entries < uc.batchSize {
+			break
+		}
+	}
+
+	if entries > 0 {
+		uc.log.WithContext(ctx).Debugf("video stats flushed: sealed=%d entries=%d applied=%d in %s",
+			sealed, entries, applied, uc.clock.Since(start))
+	}
+	return nil
+}
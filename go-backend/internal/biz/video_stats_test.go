@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newVideoStatsTestUsecase(t *testing.T, locker Locker) (*VideoStatsFlushUsecase, *MockVideoStatsBufferRepo) {
+	repo := NewMockVideoStatsBufferRepo(t)
+	config := &conf.Business{
+		VideoStats: &conf.Business_VideoStats{
+			WriteBehind:    true,
+			FlushInterval:  durationpb.New(2 * time.Second),
+			FlushBatchSize: 2,
+		},
+	}
+	return NewVideoStatsFlushUsecase(repo, config, locker, clock.NewFake(time.Now()), log.DefaultLogger), repo
+}
+
+func TestVideoStatsFlushUsecase_Config(t *testing.T) {
+	uc, _ := newVideoStatsTestUsecase(t, newTestLocker())
+	assert.True(t, uc.Enabled())
+	assert.Equal(t, 2*time.Second, uc.Interval())
+
+	defaults := NewVideoStatsFlushUsecase(NewMockVideoStatsBufferRepo(t), &conf.Business{}, newTestLocker(), clock.New(), log.DefaultLogger)
+	assert.False(t, defaults.Enabled())
+	assert.Equal(t, defaultVideoStatsFlushInterval, defaults.Interval())
+}
+
+func TestVideoStatsFlushUsecase_Flush(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ApplyUntilJournalDrained", func(t *testing.T) {
+		uc, repo := newVideoStatsTestUsecase(t, newTestLocker())
+
+		repo.EXPECT().Seal(mock.Anything).Return(3, nil).Once()
+		repo.EXPECT().Apply(mock.Anything, 2).Return(&VideoStatsFlushBatch{Entries: 2, Applied: 2}, nil).Once()
+		repo.EXPECT().Apply(mock.Anything, 2).Return(&VideoStatsFlushBatch{Entries: 1, Applied: 1}, nil).Once()
+
+		require.NoError(t, uc.Flush(ctx))
+	})
+
+	t.Run("SkipWhenLocked", func(t *testing.T) {
+		locker := newTestLocker()
+		uc, _ := newVideoStatsTestUsecase(t, locker)
+
+		// 其他实例持锁刷写时本次直接跳过
+		err := locker.WithLock(ctx, videoStatsFlushLockKey, func(ctx context.Context, _ int64) error {
+			return uc.Flush(ctx)
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("SealFailed", func(t *testing.T) {
+		uc, repo := newVideoStatsTestUsecase(t, newTestLocker())
+
+		repo.EXPECT().Seal(mock.Anything).Return(0, assert.AnError).Once()
+
+		assert.ErrorIs(t, uc.Flush(ctx), assert.AnError)
+	})
+}
+
+func TestVideoUsecase_UpdateVideoStatsWriteBehind(t *testing.T) {
+	ctx := context.Background()
+	config := &conf.Business{
+		Video:      &conf.Business_Video{},
+		VideoStats: &conf.Business_VideoStats{WriteBehind: true},
+	}
+
+	repo := NewMockVideoRepo(t)
+	cache := NewMockVideoCacheRepo(t)
+	buffer := NewMockVideoStatsBufferRepo(t)
+	uc := NewVideoUseCase(repo, cache, buffer, nil, nil, nil, config, newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clock.New(), log.DefaultLogger)
+
+	// 只累加到缓冲，不直接写库
+	buffer.EXPECT().Incr(ctx, int64(7), "play_count", int64(1)).Return(nil).Once()
+	cache.EXPECT().IncrVideoStats(ctx, int64(7), "play_count", int64(1)).Return().Once()
+
+	require.NoError(t, uc.UpdateVideoStats(ctx, 7, "play", 1))
+}
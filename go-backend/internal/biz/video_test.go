@@ -0,0 +1,88 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/media"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVideoFormat(t *testing.T) {
+	assert.Equal(t, "mp4", videoFormat("clip.MP4"))
+	assert.Equal(t, "mov", videoFormat("videos/2024/a.b.mov"))
+	assert.Equal(t, "", videoFormat("noext"))
+}
+
+func TestApplyVideoMetadata(t *testing.T) {
+	video := &domain.Video{Size: 2048, Format: "mp4"}
+
+	applyVideoMetadata(video, toDomainMetadata(&media.VideoMetadata{
+		Duration:     9.5,
+		Width:        1920,
+		Height:       1080,
+		Bitrate:      "2500000",
+		Format:       "mov,mp4,m4a,3gp,3g2,mj2",
+		AudioCodec:   "aac",
+		AudioBitrate: "128000",
+	}))
+
+	assert.InDelta(t, 9.5, video.Duration, 1e-9)
+	assert.Equal(t, int32(1920), video.Width)
+	assert.Equal(t, int32(1080), video.Height)
+	assert.Equal(t, int64(2500000), video.Bitrate)
+	assert.Equal(t, "aac", video.AudioCodec)
+	assert.Equal(t, int64(128000), video.AudioBitrate)
+	// 探测不到大小时保留上传大小，格式以扩展名为准
+	assert.Equal(t, int64(2048), video.Size)
+	assert.Equal(t, "mp4", video.Format)
+}
+
+func TestVideoUsecase_GetFeed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	config := &conf.Business{Video: &conf.Business_Video{DefaultFeedLimit: 2}}
+
+	// 按分类筛选时不读写缓存
+	t.Run("HasMore", func(t *testing.T) {
+		repo := NewMockVideoRepo(t)
+		uc := NewVideoUseCase(repo, nil, nil, nil, nil, nil, config, newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clock.New(), log.DefaultLogger)
+
+		cursor := &domain.FeedCursor{CreatedAt: now, VideoID: 10}
+		repo.EXPECT().GetFeedVideos(ctx, cursor, int64(3), 3).Return([]*domain.Video{
+			{ID: 9, CreatedAt: now},
+			{ID: 8, CreatedAt: now},
+			{ID: 7, CreatedAt: now},
+		}, nil)
+
+		videos, next, err := uc.GetFeed(ctx, cursor, 3, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{9, 8}, videoIDs(videos))
+		require.NotNil(t, next)
+		assert.Equal(t, int64(8), next.VideoID)
+		assert.Equal(t, now.Unix(), next.CreatedAt.Unix())
+	})
+
+	t.Run("LastPage", func(t *testing.T) {
+		repo := NewMockVideoRepo(t)
+		uc := NewVideoUseCase(repo, nil, nil, nil, nil, nil, config, newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clock.New(), log.DefaultLogger)
+
+		repo.EXPECT().GetFeedVideos(ctx, (*domain.FeedCursor)(nil), int64(3), 3).Return([]*domain.Video{
+			{ID: 2, CreatedAt: now},
+			{ID: 1, CreatedAt: now},
+		}, nil)
+
+		videos, next, err := uc.GetFeed(ctx, nil, 3, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 1}, videoIDs(videos))
+		assert.Nil(t, next)
+	})
+}
@@ -0,0 +1,118 @@
+package biz
+
+import (
+	"context"
+
+	v1 "go-backend/api/common/v1"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrInvalidVideoVisibility 可见范围取值无效
+var ErrInvalidVideoVisibility = errors.BadRequest(v1.ErrorCode_PARAM_ERROR.String(), "invalid video visibility")
+
+// normalizeVideoVisibility 校验可见范围，未指定时为公开
+func normalizeVideoVisibility(visibility int32) (int32, error) {
+	if visibility == 0 {
+		return domain.VideoVisibilityPublic, nil
+	}
+	if !domain.ValidVideoVisibility(visibility) {
+		return 0, ErrInvalidVideoVisibility
+	}
+	return visibility, nil
+}
+
+// SetVideoVisibility 作者修改视频的可见范围
+func (uc *VideoUsecase) SetVideoVisibility(ctx context.Context, userID, videoID int64, visibility int32) (*domain.Video, error) {
+	if !domain.ValidVideoVisibility(visibility) {
+		return nil, ErrInvalidVideoVisibility
+	}
+
+	video, err := uc.repo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.AuthorID != userID {
+		return nil, ErrPermissionDenied
+	}
+	if video.Visibility == visibility {
+		return video, nil
+	}
+
+	if err := uc.repo.UpdateVideoVisibility(ctx, video, visibility); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("video %d visibility changed to %d by user %d", videoID, visibility, userID)
+	video.Visibility = visibility
+	return video, nil
+}
+
+// CanViewVideo 访问者能否按视频的可见范围查看：公开视频所有人可见，好友可见的视频仅作者和
+// 互相关注的用户可见，私密视频、草稿和隔离的视频仅作者可见。不包含私密账号的限制，见 CanViewContent
+func CanViewVideo(video *domain.Video, viewerID int64, isFriend bool) bool {
+	if video.AuthorID == viewerID {
+		return true
+	}
+	if video.Status == domain.VideoStatusDraft || video.Status == domain.VideoStatusQuarantined {
+		return false
+	}
+	if video.IsPublic() {
+		return true
+	}
+	return video.Visibility == domain.VideoVisibilityFriends && isFriend
+}
+
+// isFriend 两个用户是否互相关注
+func (uc *RelationUsecase) isFriend(ctx context.Context, userID, otherID int64) (bool, error) {
+	if userID == 0 || otherID == 0 {
+		return false, nil
+	}
+	following, err := uc.repo.IsFollowing(ctx, userID, otherID)
+	if err != nil || !following {
+		return false, err
+	}
+	return uc.repo.IsFollowing(ctx, otherID, userID)
+}
+
+// FilterVideosByVisibility 按视频的可见范围过滤访问者无权查看的视频，每个好友可见视频的作者只查询一次关系
+func (uc *RelationUsecase) FilterVideosByVisibility(ctx context.Context, viewerID int64, videos []*domain.Video) ([]*domain.Video, error) {
+	friends := make(map[int64]bool)
+	visible := make([]*domain.Video, 0, len(videos))
+	for _, v := range videos {
+		if CanViewVideo(v, viewerID, false) {
+			visible = append(visible, v)
+			continue
+		}
+		if !CanViewVideo(v, viewerID, true) {
+			continue
+		}
+
+		isFriend, ok := friends[v.AuthorID]
+		if !ok {
+			var err error
+			if isFriend, err = uc.isFriend(ctx, viewerID, v.AuthorID); err != nil {
+				return nil, err
+			}
+			friends[v.AuthorID] = isFriend
+		}
+		if isFriend {
+			visible = append(visible, v)
+		}
+	}
+	return visible, nil
+}
+
+// CheckVideoVisible 检查访问者能否按可见范围查看视频，不可见时按视频不存在返回，不暴露视频的存在
+func (uc *RelationUsecase) CheckVideoVisible(ctx context.Context, viewerID int64, video *domain.Video) error {
+	visible, err := uc.FilterVideosByVisibility(ctx, viewerID, []*domain.Video{video})
+	if err != nil {
+		return err
+	}
+	if len(visible) == 0 {
+		return utils.ErrVideoNotFound
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/utils"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVideoUsecase_SetVideoVisibility(t *testing.T) {
+	ctx := context.Background()
+	newUsecase := func(t *testing.T) (*VideoUsecase, *MockVideoRepo) {
+		repo := NewMockVideoRepo(t)
+		uc := NewVideoUseCase(repo, nil, nil, nil, nil, nil, &conf.Business{Video: &conf.Business_Video{}}, newTestDegradation(), newTestContentModeration(), nil, worker.NewManager(log.DefaultLogger), nil, clock.New(), log.DefaultLogger)
+		return uc, repo
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		uc, repo := newUsecase(t)
+		video := &domain.Video{ID: 10, AuthorID: 1, Visibility: domain.VideoVisibilityPublic}
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(video, nil)
+		repo.EXPECT().UpdateVideoVisibility(ctx, video, int32(domain.VideoVisibilityFriends)).Return(nil)
+
+		updated, err := uc.SetVideoVisibility(ctx, 1, 10, domain.VideoVisibilityFriends)
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.VideoVisibilityFriends), updated.Visibility)
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		uc, repo := newUsecase(t)
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1, Visibility: domain.VideoVisibilityPrivate}, nil)
+
+		_, err := uc.SetVideoVisibility(ctx, 1, 10, domain.VideoVisibilityPrivate)
+		require.NoError(t, err)
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		uc, repo := newUsecase(t)
+		repo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 2}, nil)
+
+		_, err := uc.SetVideoVisibility(ctx, 1, 10, domain.VideoVisibilityPrivate)
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+
+	t.Run("InvalidVisibility", func(t *testing.T) {
+		uc, _ := newUsecase(t)
+		for _, visibility := range []int32{0, 4, -1} {
+			_, err := uc.SetVideoVisibility(ctx, 1, 10, visibility)
+			assert.Equal(t, ErrInvalidVideoVisibility, err)
+		}
+	})
+}
+
+func TestCanViewVideo(t *testing.T) {
+	public := &domain.Video{AuthorID: 1, Visibility: domain.VideoVisibilityPublic}
+	legacy := &domain.Video{AuthorID: 1}
+	friends := &domain.Video{AuthorID: 1, Visibility: domain.VideoVisibilityFriends}
+	private := &domain.Video{AuthorID: 1, Visibility: domain.VideoVisibilityPrivate}
+
+	assert.True(t, CanViewVideo(public, 0, false))
+	assert.True(t, CanViewVideo(legacy, 0, false))
+	assert.False(t, CanViewVideo(friends, 2, false))
+	assert.True(t, CanViewVideo(friends, 2, true))
+	assert.True(t, CanViewVideo(friends, 1, false))
+	assert.False(t, CanViewVideo(private, 2, true))
+	assert.True(t, CanViewVideo(private, 1, false))
+
+	draft := &domain.Video{AuthorID: 1, Status: domain.VideoStatusDraft}
+	assert.False(t, CanViewVideo(draft, 2, true))
+	assert.True(t, CanViewVideo(draft, 1, false))
+
+	quarantined := &domain.Video{AuthorID: 1, Status: domain.VideoStatusQuarantined, Visibility: domain.VideoVisibilityPublic}
+	assert.False(t, CanViewVideo(quarantined, 2, true))
+	assert.True(t, CanViewVideo(quarantined, 1, false))
+}
+
+func TestRelationUsecase_FilterVideosByVisibility(t *testing.T) {
+	ctx := context.Background()
+	videos := []*domain.Video{
+		{ID: 10, AuthorID: 1, Visibility: domain.VideoVisibilityPublic},
+		{ID: 11, AuthorID: 1, Visibility: domain.VideoVisibilityFriends},
+		{ID: 12, AuthorID: 1, Visibility: domain.VideoVisibilityPrivate},
+		{ID: 13, AuthorID: 2, Visibility: domain.VideoVisibilityFriends},
+		{ID: 14, AuthorID: 1, Visibility: domain.VideoVisibilityFriends},
+	}
+
+	t.Run("MutualFollow", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
+
+		// 同一作者只查询一次关系
+		relationRepo.EXPECT().IsFollowing(ctx, int64(5), int64(1)).Return(true, nil).Once()
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(5)).Return(true, nil).Once()
+		relationRepo.EXPECT().IsFollowing(ctx, int64(5), int64(2)).Return(true, nil).Once()
+		relationRepo.EXPECT().IsFollowing(ctx, int64(2), int64(5)).Return(false, nil).Once()
+
+		visible, err := uc.FilterVideosByVisibility(ctx, 5, videos)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10, 11, 14}, videoIDs(visible))
+	})
+
+	t.Run("Guest", func(t *testing.T) {
+		uc := NewRelationUsecase(NewMockRelationRepo(t), NewMockUserRepo(t), log.DefaultLogger)
+
+		visible, err := uc.FilterVideosByVisibility(ctx, 0, videos)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10}, videoIDs(visible))
+	})
+
+	t.Run("Author", func(t *testing.T) {
+		relationRepo := NewMockRelationRepo(t)
+		uc := NewRelationUsecase(relationRepo, NewMockUserRepo(t), log.DefaultLogger)
+		relationRepo.EXPECT().IsFollowing(ctx, int64(1), int64(2)).Return(false, nil).Once()
+
+		visible, err := uc.FilterVideosByVisibility(ctx, 1, videos)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10, 11, 12, 14}, videoIDs(visible))
+	})
+}
+
+func TestRelationUsecase_CheckVideoVisible(t *testing.T) {
+	ctx := context.Background()
+	uc := NewRelationUsecase(NewMockRelationRepo(t), NewMockUserRepo(t), log.DefaultLogger)
+
+	assert.NoError(t, uc.CheckVideoVisible(ctx, 0, &domain.Video{AuthorID: 1}))
+	assert.NoError(t, uc.CheckVideoVisible(ctx, 1, &domain.Video{AuthorID: 1, Visibility: domain.VideoVisibilityPrivate}))
+	assert.Equal(t, utils.ErrVideoNotFound, uc.CheckVideoVisible(ctx, 2, &domain.Video{AuthorID: 1, Visibility: domain.VideoVisibilityPrivate}))
+}
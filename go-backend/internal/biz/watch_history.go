@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultWatchDedupWindow 同一用户在窗口内重复观看同一视频只记录一次
+	defaultWatchDedupWindow = 30 * time.Minute
+	// defaultAudienceRange 受众分析默认统计最近28天
+	defaultAudienceRange = 28 * 24 * time.Hour
+	// maxAudienceRange 受众分析最大时间跨度，与观看记录的保留期一致
+	maxAudienceRange = 90 * 24 * time.Hour
+)
+
+// 观看的流量来源
+const (
+	WatchSourceUnknown int32 = 0
+	WatchSourceFeed    int32 = 1 // 推荐流
+	WatchSourceProfile int32 = 2 // 作者个人主页
+	WatchSourceShare   int32 = 3 // 分享链接
+)
+
+// WatchHistoryEntry 观看记录
+type WatchHistoryEntry struct {
+	ID        int64
+	UserID    int64
+	VideoID   int64
+	Source    int32
+	WatchedAt time.Time
+}
+
+// AudienceSplit 按是否关注作者拆分的计数，以统计时的关注关系为准
+type AudienceSplit struct {
+	Follower    int64
+	NonFollower int64
+}
+
+// VideoAudience 视频受众分析，作者本人的观看和点赞不计入
+type VideoAudience struct {
+	VideoID   int64
+	StartTime time.Time
+	EndTime   time.Time
+	Views     AudienceSplit
+	Likes     AudienceSplit
+	// NewViewers 统计范围开始前没看过该作者任何视频的观众数，ReturningViewers 为看过的观众数
+	NewViewers       int64
+	ReturningViewers int64
+	// SourceViews 按流量来源的观看数
+	SourceViews map[int32]int64
+}
+
+// WatchHistoryRepo 观看记录仓储
+type WatchHistoryRepo interface {
+	// RecordView 记录一次观看，窗口内已有同一视频的记录时不重复写入，返回是否写入
+	RecordView(ctx context.Context, entry *WatchHistoryEntry, dedupWindow time.Duration) (bool, error)
+	// ListWatchHistory 按观看时间倒序分页查询
+	ListWatchHistory(ctx context.Context, userID int64, page, size int32) ([]*WatchHistoryEntry, int64, error)
+	// AggregateVideoAudience 统计 [start, end) 内视频的观看和点赞受众
+	AggregateVideoAudience(ctx context.Context, videoID, authorID int64, start, end time.Time) (*VideoAudience, error)
+}
+
+// WatchHistoryUsecase 观看记录用例。过期记录由数据保留任务按 watch_history 策略清理
+type WatchHistoryUsecase struct {
+	repo        WatchHistoryRepo
+	videoRepo   VideoRepo
+	degradation *DegradationUsecase
+
+	dedupWindow time.Duration
+
+	log *log.Helper
+}
+
+// NewWatchHistoryUsecase 创建观看记录用例
+func NewWatchHistoryUsecase(repo WatchHistoryRepo, videoRepo VideoRepo, degradation *DegradationUsecase, businessConfig *conf.Business, logger log.Logger) *WatchHistoryUsecase {
+	uc := &WatchHistoryUsecase{
+		repo:        repo,
+		videoRepo:   videoRepo,
+		degradation: degradation,
+		dedupWindow: defaultWatchDedupWindow,
+		log:         log.NewHelper(logger),
+	}
+
+	if cfg := businessConfig.GetWatchHistory(); cfg != nil && cfg.DedupWindow != nil {
+		uc.dedupWindow = cfg.DedupWindow.AsDuration()
+	}
+
+	return uc
+}
+
+// RecordView 记录用户观看视频，只记录已发布的视频，无法识别的来源记为未知。
+// 观看记录被降级时直接返回未写入
+func (uc *WatchHistoryUsecase) RecordView(ctx context.Context, userID, videoID int64, source int32) (bool, error) {
+	if !uc.degradation.Allow(FeatureViewCounting) {
+		return false, nil
+	}
+
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return false, err
+	}
+	if video.Status != domain.VideoStatusPublished {
+		return false, utils.ErrVideoNotFound
+	}
+
+	if source < WatchSourceUnknown || source > WatchSourceShare {
+		source = WatchSourceUnknown
+	}
+
+	return uc.repo.RecordView(ctx, &WatchHistoryEntry{
+		UserID:    userID,
+		VideoID:   videoID,
+		Source:    source,
+		WatchedAt: time.Now(),
+	}, uc.dedupWindow)
+}
+
+// GetWatchHistory 获取观看记录
+func (uc *WatchHistoryUsecase) GetWatchHistory(ctx context.Context, userID int64, page, size int32) ([]*WatchHistoryEntry, int64, error) {
+	page, size = NormalizePage(page, size)
+	return uc.repo.ListWatchHistory(ctx, userID, page, size)
+}
+
+// GetVideoAudience 获取视频受众分析，仅视频作者可查看。默认统计最近28天，
+// 时间跨度最多90天，更早的观看记录已被保留策略清理
+func (uc *WatchHistoryUsecase) GetVideoAudience(ctx context.Context, userID, videoID int64, start, end time.Time) (*VideoAudience, error) {
+	video, err := uc.videoRepo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.AuthorID != userID {
+		return nil, ErrPermissionDenied
+	}
+
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if start.IsZero() {
+		start = end.Add(-defaultAudienceRange)
+	}
+	if end.Sub(start) > maxAudienceRange {
+		start = end.Add(-maxAudienceRange)
+	}
+
+	return uc.repo.AggregateVideoAudience(ctx, videoID, video.AuthorID, start, end)
+}
@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestWatchHistoryUsecase_RecordView(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, businessConfig *conf.Business) (*MockWatchHistoryRepo, *MockVideoRepo, *WatchHistoryUsecase) {
+		repo := NewMockWatchHistoryRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		return repo, videoRepo, NewWatchHistoryUsecase(repo, videoRepo, newTestDegradation(), businessConfig, log.DefaultLogger)
+	}
+
+	t.Run("Record", func(t *testing.T) {
+		repo, videoRepo, uc := setup(t, &conf.Business{})
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, Status: domain.VideoStatusPublished}, nil)
+		repo.EXPECT().RecordView(ctx, mock.MatchedBy(func(entry *WatchHistoryEntry) bool {
+			return entry.UserID == 1 && entry.VideoID == 10 && entry.Source == WatchSourceFeed && !entry.WatchedAt.IsZero()
+		}), defaultWatchDedupWindow).Return(true, nil)
+
+		recorded, err := uc.RecordView(ctx, 1, 10, WatchSourceFeed)
+		require.NoError(t, err)
+		assert.True(t, recorded)
+	})
+
+	t.Run("ConfiguredWindow", func(t *testing.T) {
+		repo, videoRepo, uc := setup(t, &conf.Business{WatchHistory: &conf.Business_WatchHistory{
+			DedupWindow: durationpb.New(5 * time.Minute),
+		}})
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, Status: domain.VideoStatusPublished}, nil)
+		repo.EXPECT().RecordView(ctx, mock.Anything, 5*time.Minute).Return(false, nil)
+
+		recorded, err := uc.RecordView(ctx, 1, 10, WatchSourceUnknown)
+		require.NoError(t, err)
+		assert.False(t, recorded)
+	})
+
+	t.Run("UnpublishedVideo", func(t *testing.T) {
+		_, videoRepo, uc := setup(t, &conf.Business{})
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, Status: domain.VideoStatusPending}, nil)
+
+		_, err := uc.RecordView(ctx, 1, 10, WatchSourceFeed)
+		assert.ErrorIs(t, err, utils.ErrVideoNotFound)
+	})
+
+	t.Run("UnknownSource", func(t *testing.T) {
+		repo, videoRepo, uc := setup(t, &conf.Business{})
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, Status: domain.VideoStatusPublished}, nil)
+		repo.EXPECT().RecordView(ctx, mock.MatchedBy(func(entry *WatchHistoryEntry) bool {
+			return entry.Source == WatchSourceUnknown
+		}), defaultWatchDedupWindow).Return(true, nil)
+
+		_, err := uc.RecordView(ctx, 1, 10, 42)
+		require.NoError(t, err)
+	})
+}
+
+func TestWatchHistoryUsecase_GetWatchHistory(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockWatchHistoryRepo(t)
+	uc := NewWatchHistoryUsecase(repo, NewMockVideoRepo(t), newTestDegradation(), &conf.Business{}, log.DefaultLogger)
+
+	entries := []*WatchHistoryEntry{{ID: 1, UserID: 1, VideoID: 10}}
+	repo.EXPECT().ListWatchHistory(ctx, int64(1), int32(1), int32(20)).Return(entries, 1, nil)
+
+	result, total, err := uc.GetWatchHistory(ctx, 1, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, entries, result)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestWatchHistoryUsecase_GetVideoAudience(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*MockWatchHistoryRepo, *MockVideoRepo, *WatchHistoryUsecase) {
+		repo := NewMockWatchHistoryRepo(t)
+		videoRepo := NewMockVideoRepo(t)
+		return repo, videoRepo, NewWatchHistoryUsecase(repo, videoRepo, newTestDegradation(), &conf.Business{}, log.DefaultLogger)
+	}
+
+	t.Run("DefaultRange", func(t *testing.T) {
+		repo, videoRepo, uc := setup(t)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1}, nil)
+		audience := &VideoAudience{VideoID: 10}
+		repo.EXPECT().AggregateVideoAudience(ctx, int64(10), int64(1), mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _, _ int64, start, end time.Time) (*VideoAudience, error) {
+				assert.Equal(t, defaultAudienceRange, end.Sub(start))
+				return audience, nil
+			})
+
+		got, err := uc.GetVideoAudience(ctx, 1, 10, time.Time{}, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, audience, got)
+	})
+
+	t.Run("RangeClamped", func(t *testing.T) {
+		repo, videoRepo, uc := setup(t)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1}, nil)
+		end := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		repo.EXPECT().AggregateVideoAudience(ctx, int64(10), int64(1), end.Add(-maxAudienceRange), end).
+			Return(&VideoAudience{}, nil)
+
+		_, err := uc.GetVideoAudience(ctx, 1, 10, end.AddDate(-1, 0, 0), end)
+		require.NoError(t, err)
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		_, videoRepo, uc := setup(t)
+		videoRepo.EXPECT().GetVideo(ctx, int64(10)).Return(&domain.Video{ID: 10, AuthorID: 1}, nil)
+
+		_, err := uc.GetVideoAudience(ctx, 2, 10, time.Time{}, time.Time{})
+		assert.Equal(t, ErrPermissionDenied, err)
+	})
+}
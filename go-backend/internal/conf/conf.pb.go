@@ -143,12 +143,18 @@ func (x *Server) GetGrpc() *Server_GRPC {
 }
 
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	Minio         *Data_MinIO            `protobuf:"bytes,3,opt,name=minio,proto3" json:"minio,omitempty"`
-	Qiniu         *Data_Qiniu            `protobuf:"bytes,4,opt,name=qiniu,proto3" json:"qiniu,omitempty"`
-	Kafka         *Data_Kafka            `protobuf:"bytes,5,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Database *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis    *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Minio    *Data_MinIO            `protobuf:"bytes,3,opt,name=minio,proto3" json:"minio,omitempty"`
+	Qiniu    *Data_Qiniu            `protobuf:"bytes,4,opt,name=qiniu,proto3" json:"qiniu,omitempty"`
+	Kafka    *Data_Kafka            `protobuf:"bytes,5,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	// 存储驱动：minio（默认）、s3 或 local
+	StorageDriver string      `protobuf:"bytes,6,opt,name=storage_driver,json=storageDriver,proto3" json:"storage_driver,omitempty"`
+	S3            *Data_S3    `protobuf:"bytes,7,opt,name=s3,proto3" json:"s3,omitempty"`
+	Local         *Data_Local `protobuf:"bytes,8,opt,name=local,proto3" json:"local,omitempty"`
+	// 播放地址和封面地址的 CDN 分发配置
+	Cdn           *Data_CDN `protobuf:"bytes,9,opt,name=cdn,proto3" json:"cdn,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -218,10 +224,40 @@ func (x *Data) GetKafka() *Data_Kafka {
 	return nil
 }
 
+func (x *Data) GetStorageDriver() string {
+	if x != nil {
+		return x.StorageDriver
+	}
+	return ""
+}
+
+func (x *Data) GetS3() *Data_S3 {
+	if x != nil {
+		return x.S3
+	}
+	return nil
+}
+
+func (x *Data) GetLocal() *Data_Local {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *Data) GetCdn() *Data_CDN {
+	if x != nil {
+		return x.Cdn
+	}
+	return nil
+}
+
 type JWT struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"` // 未配置 keys 时使用的单个密钥，kid 为 default
 	ExpireTime    *durationpb.Duration   `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	Keys          []*JWT_Key             `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`                                       // 签名密钥，数据库中不存在的密钥在启动时写入
+	CurrentKeyId  string                 `protobuf:"bytes,4,opt,name=current_key_id,json=currentKeyId,proto3" json:"current_key_id,omitempty"` // 用于签发的密钥，变更后视为一次轮换
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -270,14 +306,63 @@ func (x *JWT) GetExpireTime() *durationpb.Duration {
 	return nil
 }
 
+func (x *JWT) GetKeys() []*JWT_Key {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *JWT) GetCurrentKeyId() string {
+	if x != nil {
+		return x.CurrentKeyId
+	}
+	return ""
+}
+
 type Business struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *Business_User         `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Video         *Business_Video        `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
-	Storage       *Business_Storage      `protobuf:"bytes,3,opt,name=storage,proto3" json:"storage,omitempty"`
-	KafkaTopics   *Business_KafkaTopics  `protobuf:"bytes,4,opt,name=kafka_topics,json=kafkaTopics,proto3" json:"kafka_topics,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	User             *Business_User             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Video            *Business_Video            `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	Storage          *Business_Storage          `protobuf:"bytes,3,opt,name=storage,proto3" json:"storage,omitempty"`
+	KafkaTopics      *Business_KafkaTopics      `protobuf:"bytes,4,opt,name=kafka_topics,json=kafkaTopics,proto3" json:"kafka_topics,omitempty"`
+	Retention        *Business_Retention        `protobuf:"bytes,5,opt,name=retention,proto3" json:"retention,omitempty"`
+	Rbac             *Business_Rbac             `protobuf:"bytes,6,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	FeedRanking      *Business_FeedRanking      `protobuf:"bytes,7,opt,name=feed_ranking,json=feedRanking,proto3" json:"feed_ranking,omitempty"`
+	Registration     *Business_Registration     `protobuf:"bytes,8,opt,name=registration,proto3" json:"registration,omitempty"`
+	PermissionAudit  *Business_PermissionAudit  `protobuf:"bytes,9,opt,name=permission_audit,json=permissionAudit,proto3" json:"permission_audit,omitempty"`
+	Share            *Business_Share            `protobuf:"bytes,10,opt,name=share,proto3" json:"share,omitempty"`
+	Referral         *Business_Referral         `protobuf:"bytes,11,opt,name=referral,proto3" json:"referral,omitempty"`
+	Calendar         *Business_Calendar         `protobuf:"bytes,12,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	WatchHistory     *Business_WatchHistory     `protobuf:"bytes,13,opt,name=watch_history,json=watchHistory,proto3" json:"watch_history,omitempty"`
+	Outbox           *Business_Outbox           `protobuf:"bytes,14,opt,name=outbox,proto3" json:"outbox,omitempty"`
+	EventBus         *Business_EventBus         `protobuf:"bytes,15,opt,name=event_bus,json=eventBus,proto3" json:"event_bus,omitempty"`
+	AccountDeletion  *Business_AccountDeletion  `protobuf:"bytes,16,opt,name=account_deletion,json=accountDeletion,proto3" json:"account_deletion,omitempty"`
+	CommentFolding   *Business_CommentFolding   `protobuf:"bytes,17,opt,name=comment_folding,json=commentFolding,proto3" json:"comment_folding,omitempty"`
+	ConsumerRetry    *Business_ConsumerRetry    `protobuf:"bytes,18,opt,name=consumer_retry,json=consumerRetry,proto3" json:"consumer_retry,omitempty"`
+	Callback         *Business_Callback         `protobuf:"bytes,19,opt,name=callback,proto3" json:"callback,omitempty"`
+	Quota            *Business_Quota            `protobuf:"bytes,20,opt,name=quota,proto3" json:"quota,omitempty"`
+	CounterReconcile *Business_CounterReconcile `protobuf:"bytes,21,opt,name=counter_reconcile,json=counterReconcile,proto3" json:"counter_reconcile,omitempty"`
+	IntegrityCheck   *Business_IntegrityCheck   `protobuf:"bytes,22,opt,name=integrity_check,json=integrityCheck,proto3" json:"integrity_check,omitempty"`
+	Promotion        *Business_Promotion        `protobuf:"bytes,23,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	Degradation      *Business_Degradation      `protobuf:"bytes,24,opt,name=degradation,proto3" json:"degradation,omitempty"`
+	Shutdown         *Business_Shutdown         `protobuf:"bytes,25,opt,name=shutdown,proto3" json:"shutdown,omitempty"`
+	EventIdempotency *Business_EventIdempotency `protobuf:"bytes,26,opt,name=event_idempotency,json=eventIdempotency,proto3" json:"event_idempotency,omitempty"`
+	FeedCache        *Business_FeedCache        `protobuf:"bytes,27,opt,name=feed_cache,json=feedCache,proto3" json:"feed_cache,omitempty"`
+	VideoStats       *Business_VideoStats       `protobuf:"bytes,28,opt,name=video_stats,json=videoStats,proto3" json:"video_stats,omitempty"`
+	PlayCount        *Business_PlayCount        `protobuf:"bytes,29,opt,name=play_count,json=playCount,proto3" json:"play_count,omitempty"`
+	Trending         *Business_Trending         `protobuf:"bytes,30,opt,name=trending,proto3" json:"trending,omitempty"`
+	Moderation       *Business_Moderation       `protobuf:"bytes,31,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	SigningKeys      *Business_SigningKeys      `protobuf:"bytes,32,opt,name=signing_keys,json=signingKeys,proto3" json:"signing_keys,omitempty"`
+	LoginAnomaly     *Business_LoginAnomaly     `protobuf:"bytes,33,opt,name=login_anomaly,json=loginAnomaly,proto3" json:"login_anomaly,omitempty"`
+	Oauth            *Business_OAuth            `protobuf:"bytes,34,opt,name=oauth,proto3" json:"oauth,omitempty"`
+	CodeLogin        *Business_CodeLogin        `protobuf:"bytes,35,opt,name=code_login,json=codeLogin,proto3" json:"code_login,omitempty"`
+	StorageCleanup   *Business_StorageCleanup   `protobuf:"bytes,36,opt,name=storage_cleanup,json=storageCleanup,proto3" json:"storage_cleanup,omitempty"`
+	Playlist         *Business_Playlist         `protobuf:"bytes,37,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	OrphanCleanup    *Business_OrphanCleanup    `protobuf:"bytes,38,opt,name=orphan_cleanup,json=orphanCleanup,proto3" json:"orphan_cleanup,omitempty"`
+	UploadScan       *Business_UploadScan       `protobuf:"bytes,39,opt,name=upload_scan,json=uploadScan,proto3" json:"upload_scan,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Business) Reset() {
@@ -338,6 +423,251 @@ func (x *Business) GetKafkaTopics() *Business_KafkaTopics {
 	return nil
 }
 
+func (x *Business) GetRetention() *Business_Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Business) GetRbac() *Business_Rbac {
+	if x != nil {
+		return x.Rbac
+	}
+	return nil
+}
+
+func (x *Business) GetFeedRanking() *Business_FeedRanking {
+	if x != nil {
+		return x.FeedRanking
+	}
+	return nil
+}
+
+func (x *Business) GetRegistration() *Business_Registration {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *Business) GetPermissionAudit() *Business_PermissionAudit {
+	if x != nil {
+		return x.PermissionAudit
+	}
+	return nil
+}
+
+func (x *Business) GetShare() *Business_Share {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+func (x *Business) GetReferral() *Business_Referral {
+	if x != nil {
+		return x.Referral
+	}
+	return nil
+}
+
+func (x *Business) GetCalendar() *Business_Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+func (x *Business) GetWatchHistory() *Business_WatchHistory {
+	if x != nil {
+		return x.WatchHistory
+	}
+	return nil
+}
+
+func (x *Business) GetOutbox() *Business_Outbox {
+	if x != nil {
+		return x.Outbox
+	}
+	return nil
+}
+
+func (x *Business) GetEventBus() *Business_EventBus {
+	if x != nil {
+		return x.EventBus
+	}
+	return nil
+}
+
+func (x *Business) GetAccountDeletion() *Business_AccountDeletion {
+	if x != nil {
+		return x.AccountDeletion
+	}
+	return nil
+}
+
+func (x *Business) GetCommentFolding() *Business_CommentFolding {
+	if x != nil {
+		return x.CommentFolding
+	}
+	return nil
+}
+
+func (x *Business) GetConsumerRetry() *Business_ConsumerRetry {
+	if x != nil {
+		return x.ConsumerRetry
+	}
+	return nil
+}
+
+func (x *Business) GetCallback() *Business_Callback {
+	if x != nil {
+		return x.Callback
+	}
+	return nil
+}
+
+func (x *Business) GetQuota() *Business_Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *Business) GetCounterReconcile() *Business_CounterReconcile {
+	if x != nil {
+		return x.CounterReconcile
+	}
+	return nil
+}
+
+func (x *Business) GetIntegrityCheck() *Business_IntegrityCheck {
+	if x != nil {
+		return x.IntegrityCheck
+	}
+	return nil
+}
+
+func (x *Business) GetPromotion() *Business_Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+func (x *Business) GetDegradation() *Business_Degradation {
+	if x != nil {
+		return x.Degradation
+	}
+	return nil
+}
+
+func (x *Business) GetShutdown() *Business_Shutdown {
+	if x != nil {
+		return x.Shutdown
+	}
+	return nil
+}
+
+func (x *Business) GetEventIdempotency() *Business_EventIdempotency {
+	if x != nil {
+		return x.EventIdempotency
+	}
+	return nil
+}
+
+func (x *Business) GetFeedCache() *Business_FeedCache {
+	if x != nil {
+		return x.FeedCache
+	}
+	return nil
+}
+
+func (x *Business) GetVideoStats() *Business_VideoStats {
+	if x != nil {
+		return x.VideoStats
+	}
+	return nil
+}
+
+func (x *Business) GetPlayCount() *Business_PlayCount {
+	if x != nil {
+		return x.PlayCount
+	}
+	return nil
+}
+
+func (x *Business) GetTrending() *Business_Trending {
+	if x != nil {
+		return x.Trending
+	}
+	return nil
+}
+
+func (x *Business) GetModeration() *Business_Moderation {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+func (x *Business) GetSigningKeys() *Business_SigningKeys {
+	if x != nil {
+		return x.SigningKeys
+	}
+	return nil
+}
+
+func (x *Business) GetLoginAnomaly() *Business_LoginAnomaly {
+	if x != nil {
+		return x.LoginAnomaly
+	}
+	return nil
+}
+
+func (x *Business) GetOauth() *Business_OAuth {
+	if x != nil {
+		return x.Oauth
+	}
+	return nil
+}
+
+func (x *Business) GetCodeLogin() *Business_CodeLogin {
+	if x != nil {
+		return x.CodeLogin
+	}
+	return nil
+}
+
+func (x *Business) GetStorageCleanup() *Business_StorageCleanup {
+	if x != nil {
+		return x.StorageCleanup
+	}
+	return nil
+}
+
+func (x *Business) GetPlaylist() *Business_Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+func (x *Business) GetOrphanCleanup() *Business_OrphanCleanup {
+	if x != nil {
+		return x.OrphanCleanup
+	}
+	return nil
+}
+
+func (x *Business) GetUploadScan() *Business_UploadScan {
+	if x != nil {
+		return x.UploadScan
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -627,14 +957,17 @@ func (x *Data_Redis) GetPoolSize() int32 {
 }
 
 type Data_MinIO struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	AccessKey     string                 `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
-	SecretKey     string                 `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
-	BucketName    string                 `protobuf:"bytes,4,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
-	UseSsl        bool                   `protobuf:"varint,6,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
-	BaseUrl       string                 `protobuf:"bytes,7,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint   string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	AccessKey  string                 `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey  string                 `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	BucketName string                 `protobuf:"bytes,4,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	Region     string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	UseSsl     bool                   `protobuf:"varint,6,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	BaseUrl    string                 `protobuf:"bytes,7,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	// 按对象类别路由的存储桶，键为 original, transcoded, cover, quarantine, legal-hold，
+	// 未配置的类别使用 bucket_name
+	Buckets       map[string]*Data_MinIO_Bucket `protobuf:"bytes,8,rep,name=buckets,proto3" json:"buckets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -718,6 +1051,13 @@ func (x *Data_MinIO) GetBaseUrl() string {
 	return ""
 }
 
+func (x *Data_MinIO) GetBuckets() map[string]*Data_MinIO_Bucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
 type Data_Qiniu struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessKey     string                 `protobuf:"bytes,1,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
@@ -810,29 +1150,34 @@ func (x *Data_Qiniu) GetRecordDir() string {
 	return ""
 }
 
-type Data_Kafka struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Brokers       []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
-	Producer      *Data_Kafka_Producer   `protobuf:"bytes,2,opt,name=producer,proto3" json:"producer,omitempty"`
-	Consumer      *Data_Kafka_Consumer   `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+type Data_S3 struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Region     string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	BucketName string                 `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	AccessKey  string                 `protobuf:"bytes,3,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"` // 为空时依次使用环境变量、~/.aws/credentials 和实例角色凭证
+	SecretKey  string                 `protobuf:"bytes,4,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	Endpoint   string                 `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`              // 为空时使用 s3.<region>.amazonaws.com
+	BaseUrl    string                 `protobuf:"bytes,6,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"` // 为空时使用存储桶的虚拟主机域名
+	// 按对象类别路由的存储桶，同 minio.buckets，存储桶需预先创建
+	Buckets       map[string]*Data_MinIO_Bucket `protobuf:"bytes,7,rep,name=buckets,proto3" json:"buckets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Kafka) Reset() {
-	*x = Data_Kafka{}
+func (x *Data_S3) Reset() {
+	*x = Data_S3{}
 	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Kafka) String() string {
+func (x *Data_S3) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Kafka) ProtoMessage() {}
+func (*Data_S3) ProtoMessage() {}
 
-func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
+func (x *Data_S3) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,45 +1189,313 @@ func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
-func (*Data_Kafka) Descriptor() ([]byte, []int) {
+// Deprecated: Use Data_S3.ProtoReflect.Descriptor instead.
+func (*Data_S3) Descriptor() ([]byte, []int) {
 	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
 }
 
-func (x *Data_Kafka) GetBrokers() []string {
+func (x *Data_S3) GetRegion() string {
 	if x != nil {
-		return x.Brokers
+		return x.Region
 	}
-	return nil
+	return ""
 }
 
-func (x *Data_Kafka) GetProducer() *Data_Kafka_Producer {
+func (x *Data_S3) GetBucketName() string {
 	if x != nil {
-		return x.Producer
+		return x.BucketName
 	}
-	return nil
+	return ""
 }
 
-func (x *Data_Kafka) GetConsumer() *Data_Kafka_Consumer {
+func (x *Data_S3) GetAccessKey() string {
 	if x != nil {
-		return x.Consumer
+		return x.AccessKey
 	}
-	return nil
-}
-
-type Data_Kafka_Producer struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RetryMax        int32                  `protobuf:"varint,1,opt,name=retry_max,json=retryMax,proto3" json:"retry_max,omitempty"`
-	BatchSize       int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	LingerMs        int32                  `protobuf:"varint,3,opt,name=linger_ms,json=lingerMs,proto3" json:"linger_ms,omitempty"`
-	CompressionType string                 `protobuf:"bytes,4,opt,name=compression_type,json=compressionType,proto3" json:"compression_type,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	return ""
+}
+
+func (x *Data_S3) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Data_S3) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_S3) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Data_S3) GetBuckets() map[string]*Data_MinIO_Bucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type Data_Local struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootDir       string                 `protobuf:"bytes,1,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	BaseUrl       string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"` // 对外提供 root_dir 下文件的静态文件服务地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Local) Reset() {
+	*x = Data_Local{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Local) ProtoMessage() {}
+
+func (x *Data_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Local.ProtoReflect.Descriptor instead.
+func (*Data_Local) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Local) GetRootDir() string {
+	if x != nil {
+		return x.RootDir
+	}
+	return ""
+}
+
+func (x *Data_Local) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+type Data_CDN struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`                  // CDN 访问地址，如 https://cdn.example.com，为空时不改写
+	Origins       []string               `protobuf:"bytes,2,rep,name=origins,proto3" json:"origins,omitempty"`                // 改写为 CDN 地址的源站URL前缀，如 http://localhost:9000
+	SignKey       string                 `protobuf:"bytes,3,opt,name=sign_key,json=signKey,proto3" json:"sign_key,omitempty"` // URL 签名密钥，与 CDN 边缘鉴权配置一致，为空时不签名
+	SignTtl       *durationpb.Duration   `protobuf:"bytes,4,opt,name=sign_ttl,json=signTtl,proto3" json:"sign_ttl,omitempty"` // 签名有效期，默认1小时
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_CDN) Reset() {
+	*x = Data_CDN{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_CDN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_CDN) ProtoMessage() {}
+
+func (x *Data_CDN) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_CDN.ProtoReflect.Descriptor instead.
+func (*Data_CDN) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Data_CDN) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Data_CDN) GetOrigins() []string {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *Data_CDN) GetSignKey() string {
+	if x != nil {
+		return x.SignKey
+	}
+	return ""
+}
+
+func (x *Data_CDN) GetSignTtl() *durationpb.Duration {
+	if x != nil {
+		return x.SignTtl
+	}
+	return nil
+}
+
+type Data_Kafka struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brokers       []string               `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	Producer      *Data_Kafka_Producer   `protobuf:"bytes,2,opt,name=producer,proto3" json:"producer,omitempty"`
+	Consumer      *Data_Kafka_Consumer   `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Kafka) Reset() {
+	*x = Data_Kafka{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Kafka) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Kafka) ProtoMessage() {}
+
+func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
+func (*Data_Kafka) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Data_Kafka) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *Data_Kafka) GetProducer() *Data_Kafka_Producer {
+	if x != nil {
+		return x.Producer
+	}
+	return nil
+}
+
+func (x *Data_Kafka) GetConsumer() *Data_Kafka_Consumer {
+	if x != nil {
+		return x.Consumer
+	}
+	return nil
+}
+
+type Data_MinIO_Bucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BaseUrl       string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	StorageClass  string                 `protobuf:"bytes,3,opt,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"` // 写入对象的存储类型，用于区分冷热数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_MinIO_Bucket) Reset() {
+	*x = Data_MinIO_Bucket{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_MinIO_Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_MinIO_Bucket) ProtoMessage() {}
+
+func (x *Data_MinIO_Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_MinIO_Bucket.ProtoReflect.Descriptor instead.
+func (*Data_MinIO_Bucket) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2, 1}
+}
+
+func (x *Data_MinIO_Bucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Data_MinIO_Bucket) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Data_MinIO_Bucket) GetStorageClass() string {
+	if x != nil {
+		return x.StorageClass
+	}
+	return ""
+}
+
+type Data_Kafka_Producer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RetryMax        int32                  `protobuf:"varint,1,opt,name=retry_max,json=retryMax,proto3" json:"retry_max,omitempty"`
+	BatchSize       int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	LingerMs        int32                  `protobuf:"varint,3,opt,name=linger_ms,json=lingerMs,proto3" json:"linger_ms,omitempty"`
+	CompressionType string                 `protobuf:"bytes,4,opt,name=compression_type,json=compressionType,proto3" json:"compression_type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Data_Kafka_Producer) Reset() {
 	*x = Data_Kafka_Producer{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -894,7 +1507,7 @@ func (x *Data_Kafka_Producer) String() string {
 func (*Data_Kafka_Producer) ProtoMessage() {}
 
 func (x *Data_Kafka_Producer) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1520,7 @@ func (x *Data_Kafka_Producer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Kafka_Producer.ProtoReflect.Descriptor instead.
 func (*Data_Kafka_Producer) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4, 0}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 7, 0}
 }
 
 func (x *Data_Kafka_Producer) GetRetryMax() int32 {
@@ -951,7 +1564,7 @@ type Data_Kafka_Consumer struct {
 
 func (x *Data_Kafka_Consumer) Reset() {
 	*x = Data_Kafka_Consumer{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,8 +1575,2948 @@ func (x *Data_Kafka_Consumer) String() string {
 
 func (*Data_Kafka_Consumer) ProtoMessage() {}
 
-func (x *Data_Kafka_Consumer) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+func (x *Data_Kafka_Consumer) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Kafka_Consumer.ProtoReflect.Descriptor instead.
+func (*Data_Kafka_Consumer) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 7, 1}
+}
+
+func (x *Data_Kafka_Consumer) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Data_Kafka_Consumer) GetAutoCommit() bool {
+	if x != nil {
+		return x.AutoCommit
+	}
+	return false
+}
+
+func (x *Data_Kafka_Consumer) GetSessionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.SessionTimeout
+	}
+	return nil
+}
+
+func (x *Data_Kafka_Consumer) GetFetchMinBytes() int32 {
+	if x != nil {
+		return x.FetchMinBytes
+	}
+	return 0
+}
+
+func (x *Data_Kafka_Consumer) GetFetchMaxWait() *durationpb.Duration {
+	if x != nil {
+		return x.FetchMaxWait
+	}
+	return nil
+}
+
+type JWT_Key struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // 密钥ID，写入令牌头的 kid
+	Secret         string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`                                         // HS256 为共享密钥，RS256/EdDSA 为 PKCS#8 PEM 私钥
+	Algorithm      string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                                   // HS256（默认）、RS256 或 EdDSA
+	PrivateKeyFile string                 `protobuf:"bytes,4,opt,name=private_key_file,json=privateKeyFile,proto3" json:"private_key_file,omitempty"` // 从文件读取 PEM 私钥，设置后忽略 secret
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JWT_Key) Reset() {
+	*x = JWT_Key{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWT_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWT_Key) ProtoMessage() {}
+
+func (x *JWT_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWT_Key.ProtoReflect.Descriptor instead.
+func (*JWT_Key) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *JWT_Key) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JWT_Key) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *JWT_Key) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *JWT_Key) GetPrivateKeyFile() string {
+	if x != nil {
+		return x.PrivateKeyFile
+	}
+	return ""
+}
+
+type Business_User struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PasswordSaltLength int32                  `protobuf:"varint,1,opt,name=password_salt_length,json=passwordSaltLength,proto3" json:"password_salt_length,omitempty"`
+	UsernameMinLength  int32                  `protobuf:"varint,2,opt,name=username_min_length,json=usernameMinLength,proto3" json:"username_min_length,omitempty"`
+	UsernameMaxLength  int32                  `protobuf:"varint,3,opt,name=username_max_length,json=usernameMaxLength,proto3" json:"username_max_length,omitempty"`
+	PasswordMinLength  int32                  `protobuf:"varint,4,opt,name=password_min_length,json=passwordMinLength,proto3" json:"password_min_length,omitempty"`
+	PasswordMaxLength  int32                  `protobuf:"varint,5,opt,name=password_max_length,json=passwordMaxLength,proto3" json:"password_max_length,omitempty"`
+	MaxLoginAttempts   int32                  `protobuf:"varint,6,opt,name=max_login_attempts,json=maxLoginAttempts,proto3" json:"max_login_attempts,omitempty"`   // 连续密码错误次数上限，达到后锁定
+	LoginLockDuration  *durationpb.Duration   `protobuf:"bytes,7,opt,name=login_lock_duration,json=loginLockDuration,proto3" json:"login_lock_duration,omitempty"` // 锁定时长，失败计数在此窗口内累计
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Business_User) Reset() {
+	*x = Business_User{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_User) ProtoMessage() {}
+
+func (x *Business_User) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_User.ProtoReflect.Descriptor instead.
+func (*Business_User) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Business_User) GetPasswordSaltLength() int32 {
+	if x != nil {
+		return x.PasswordSaltLength
+	}
+	return 0
+}
+
+func (x *Business_User) GetUsernameMinLength() int32 {
+	if x != nil {
+		return x.UsernameMinLength
+	}
+	return 0
+}
+
+func (x *Business_User) GetUsernameMaxLength() int32 {
+	if x != nil {
+		return x.UsernameMaxLength
+	}
+	return 0
+}
+
+func (x *Business_User) GetPasswordMinLength() int32 {
+	if x != nil {
+		return x.PasswordMinLength
+	}
+	return 0
+}
+
+func (x *Business_User) GetPasswordMaxLength() int32 {
+	if x != nil {
+		return x.PasswordMaxLength
+	}
+	return 0
+}
+
+func (x *Business_User) GetMaxLoginAttempts() int32 {
+	if x != nil {
+		return x.MaxLoginAttempts
+	}
+	return 0
+}
+
+func (x *Business_User) GetLoginLockDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LoginLockDuration
+	}
+	return nil
+}
+
+type Business_Video struct {
+	state                    protoimpl.MessageState             `protogen:"open.v1"`
+	MaxFileSize              int64                              `protobuf:"varint,1,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	MaxTitleLength           int32                              `protobuf:"varint,2,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
+	DefaultFeedLimit         int32                              `protobuf:"varint,3,opt,name=default_feed_limit,json=defaultFeedLimit,proto3" json:"default_feed_limit,omitempty"`
+	SupportedFormats         []string                           `protobuf:"bytes,4,rep,name=supported_formats,json=supportedFormats,proto3" json:"supported_formats,omitempty"`
+	CoverQuality             int32                              `protobuf:"varint,5,opt,name=cover_quality,json=coverQuality,proto3" json:"cover_quality,omitempty"`
+	CoverWidth               int32                              `protobuf:"varint,6,opt,name=cover_width,json=coverWidth,proto3" json:"cover_width,omitempty"`
+	CoverHeight              int32                              `protobuf:"varint,7,opt,name=cover_height,json=coverHeight,proto3" json:"cover_height,omitempty"`
+	TempDir                  string                             `protobuf:"bytes,8,opt,name=temp_dir,json=tempDir,proto3" json:"temp_dir,omitempty"`                                                       // 视频处理临时目录
+	RequireReview            bool                               `protobuf:"varint,9,opt,name=require_review,json=requireReview,proto3" json:"require_review,omitempty"`                                    // 普通上传是否需要审核通过后才发布
+	ScheduledPublishInterval *durationpb.Duration               `protobuf:"bytes,10,opt,name=scheduled_publish_interval,json=scheduledPublishInterval,proto3" json:"scheduled_publish_interval,omitempty"` // 定时发布任务的执行间隔，默认1分钟
+	MaxScheduleAhead         *durationpb.Duration               `protobuf:"bytes,11,opt,name=max_schedule_ahead,json=maxScheduleAhead,proto3" json:"max_schedule_ahead,omitempty"`                         // 计划发布时间距现在的最大间隔，默认30天
+	CoverFormat              string                             `protobuf:"bytes,12,opt,name=cover_format,json=coverFormat,proto3" json:"cover_format,omitempty"`                                          // 用户上传封面的编码格式：jpeg 或 webp，默认 jpeg。webp 需要 ffmpeg 支持 libwebp，不支持时退回 jpeg
+	DynamicCover             *Business_Video_DynamicCover       `protobuf:"bytes,13,opt,name=dynamic_cover,json=dynamicCover,proto3" json:"dynamic_cover,omitempty"`
+	AudioNormalization       *Business_Video_AudioNormalization `protobuf:"bytes,14,opt,name=audio_normalization,json=audioNormalization,proto3" json:"audio_normalization,omitempty"`
+	// 上传时按 ffprobe 探测结果校验，0表示不限制。分辨率按短边计算（如1080表示1080p），横屏和竖屏使用同一限制
+	MaxDuration   *durationpb.Duration `protobuf:"bytes,15,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`        // 最长时长
+	MinResolution int32                `protobuf:"varint,16,opt,name=min_resolution,json=minResolution,proto3" json:"min_resolution,omitempty"` // 最低分辨率，如360
+	MaxResolution int32                `protobuf:"varint,17,opt,name=max_resolution,json=maxResolution,proto3" json:"max_resolution,omitempty"` // 最高分辨率，如2160
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Video) Reset() {
+	*x = Business_Video{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Video) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Video) ProtoMessage() {}
+
+func (x *Business_Video) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Video.ProtoReflect.Descriptor instead.
+func (*Business_Video) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *Business_Video) GetMaxFileSize() int64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *Business_Video) GetMaxTitleLength() int32 {
+	if x != nil {
+		return x.MaxTitleLength
+	}
+	return 0
+}
+
+func (x *Business_Video) GetDefaultFeedLimit() int32 {
+	if x != nil {
+		return x.DefaultFeedLimit
+	}
+	return 0
+}
+
+func (x *Business_Video) GetSupportedFormats() []string {
+	if x != nil {
+		return x.SupportedFormats
+	}
+	return nil
+}
+
+func (x *Business_Video) GetCoverQuality() int32 {
+	if x != nil {
+		return x.CoverQuality
+	}
+	return 0
+}
+
+func (x *Business_Video) GetCoverWidth() int32 {
+	if x != nil {
+		return x.CoverWidth
+	}
+	return 0
+}
+
+func (x *Business_Video) GetCoverHeight() int32 {
+	if x != nil {
+		return x.CoverHeight
+	}
+	return 0
+}
+
+func (x *Business_Video) GetTempDir() string {
+	if x != nil {
+		return x.TempDir
+	}
+	return ""
+}
+
+func (x *Business_Video) GetRequireReview() bool {
+	if x != nil {
+		return x.RequireReview
+	}
+	return false
+}
+
+func (x *Business_Video) GetScheduledPublishInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ScheduledPublishInterval
+	}
+	return nil
+}
+
+func (x *Business_Video) GetMaxScheduleAhead() *durationpb.Duration {
+	if x != nil {
+		return x.MaxScheduleAhead
+	}
+	return nil
+}
+
+func (x *Business_Video) GetCoverFormat() string {
+	if x != nil {
+		return x.CoverFormat
+	}
+	return ""
+}
+
+func (x *Business_Video) GetDynamicCover() *Business_Video_DynamicCover {
+	if x != nil {
+		return x.DynamicCover
+	}
+	return nil
+}
+
+func (x *Business_Video) GetAudioNormalization() *Business_Video_AudioNormalization {
+	if x != nil {
+		return x.AudioNormalization
+	}
+	return nil
+}
+
+func (x *Business_Video) GetMaxDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return nil
+}
+
+func (x *Business_Video) GetMinResolution() int32 {
+	if x != nil {
+		return x.MinResolution
+	}
+	return 0
+}
+
+func (x *Business_Video) GetMaxResolution() int32 {
+	if x != nil {
+		return x.MaxResolution
+	}
+	return 0
+}
+
+type Business_Storage struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UploadTimeout        *durationpb.Duration   `protobuf:"bytes,1,opt,name=upload_timeout,json=uploadTimeout,proto3" json:"upload_timeout,omitempty"`
+	DownloadTimeout      *durationpb.Duration   `protobuf:"bytes,2,opt,name=download_timeout,json=downloadTimeout,proto3" json:"download_timeout,omitempty"`
+	PresignedUrlExpire   *durationpb.Duration   `protobuf:"bytes,3,opt,name=presigned_url_expire,json=presignedUrlExpire,proto3" json:"presigned_url_expire,omitempty"`
+	DefaultProvider      string                 `protobuf:"bytes,4,opt,name=default_provider,json=defaultProvider,proto3" json:"default_provider,omitempty"`
+	MultipartChunkSize   int64                  `protobuf:"varint,5,opt,name=multipart_chunk_size,json=multipartChunkSize,proto3" json:"multipart_chunk_size,omitempty"`       // 分片大小
+	MaxConcurrentUploads int32                  `protobuf:"varint,6,opt,name=max_concurrent_uploads,json=maxConcurrentUploads,proto3" json:"max_concurrent_uploads,omitempty"` // 最大并发上传数
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Business_Storage) Reset() {
+	*x = Business_Storage{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Storage) ProtoMessage() {}
+
+func (x *Business_Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Storage.ProtoReflect.Descriptor instead.
+func (*Business_Storage) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *Business_Storage) GetUploadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.UploadTimeout
+	}
+	return nil
+}
+
+func (x *Business_Storage) GetDownloadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DownloadTimeout
+	}
+	return nil
+}
+
+func (x *Business_Storage) GetPresignedUrlExpire() *durationpb.Duration {
+	if x != nil {
+		return x.PresignedUrlExpire
+	}
+	return nil
+}
+
+func (x *Business_Storage) GetDefaultProvider() string {
+	if x != nil {
+		return x.DefaultProvider
+	}
+	return ""
+}
+
+func (x *Business_Storage) GetMultipartChunkSize() int64 {
+	if x != nil {
+		return x.MultipartChunkSize
+	}
+	return 0
+}
+
+func (x *Business_Storage) GetMaxConcurrentUploads() int32 {
+	if x != nil {
+		return x.MaxConcurrentUploads
+	}
+	return 0
+}
+
+type Business_KafkaTopics struct {
+	state            protoimpl.MessageState                `protogen:"open.v1"`
+	VideoUpload      string                                `protobuf:"bytes,1,opt,name=video_upload,json=videoUpload,proto3" json:"video_upload,omitempty"`
+	VideoProcess     string                                `protobuf:"bytes,2,opt,name=video_process,json=videoProcess,proto3" json:"video_process,omitempty"`
+	VideoStats       string                                `protobuf:"bytes,3,opt,name=video_stats,json=videoStats,proto3" json:"video_stats,omitempty"`
+	UserAction       string                                `protobuf:"bytes,4,opt,name=user_action,json=userAction,proto3" json:"user_action,omitempty"`
+	DeadLetter       string                                `protobuf:"bytes,5,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"` // 重试耗尽的消息连同失败信息转入该主题
+	Defaults         *Business_KafkaTopics_Spec            `protobuf:"bytes,6,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	Overrides        map[string]*Business_KafkaTopics_Spec `protobuf:"bytes,7,rep,name=overrides,proto3" json:"overrides,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 按主题名覆盖，未设置的字段取 defaults
+	ProvisionOnStart bool                                  `protobuf:"varint,8,opt,name=provision_on_start,json=provisionOnStart,proto3" json:"provision_on_start,omitempty"`                                  // 服务启动时创建缺失主题并记录配置漂移
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Business_KafkaTopics) Reset() {
+	*x = Business_KafkaTopics{}
+	mi := &file_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_KafkaTopics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_KafkaTopics) ProtoMessage() {}
+
+func (x *Business_KafkaTopics) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_KafkaTopics.ProtoReflect.Descriptor instead.
+func (*Business_KafkaTopics) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *Business_KafkaTopics) GetVideoUpload() string {
+	if x != nil {
+		return x.VideoUpload
+	}
+	return ""
+}
+
+func (x *Business_KafkaTopics) GetVideoProcess() string {
+	if x != nil {
+		return x.VideoProcess
+	}
+	return ""
+}
+
+func (x *Business_KafkaTopics) GetVideoStats() string {
+	if x != nil {
+		return x.VideoStats
+	}
+	return ""
+}
+
+func (x *Business_KafkaTopics) GetUserAction() string {
+	if x != nil {
+		return x.UserAction
+	}
+	return ""
+}
+
+func (x *Business_KafkaTopics) GetDeadLetter() string {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return ""
+}
+
+func (x *Business_KafkaTopics) GetDefaults() *Business_KafkaTopics_Spec {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *Business_KafkaTopics) GetOverrides() map[string]*Business_KafkaTopics_Spec {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *Business_KafkaTopics) GetProvisionOnStart() bool {
+	if x != nil {
+		return x.ProvisionOnStart
+	}
+	return false
+}
+
+type Business_Retention struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Enabled       bool                         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval      *durationpb.Duration         `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                     // 执行间隔
+	BatchSize     int32                        `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 单批删除行数
+	DryRun        bool                         `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`          // 全局仅统计不删除
+	Policies      []*Business_Retention_Policy `protobuf:"bytes,5,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Retention) Reset() {
+	*x = Business_Retention{}
+	mi := &file_conf_conf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Retention) ProtoMessage() {}
+
+func (x *Business_Retention) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Retention.ProtoReflect.Descriptor instead.
+func (*Business_Retention) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *Business_Retention) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_Retention) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Business_Retention) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Business_Retention) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *Business_Retention) GetPolicies() []*Business_Retention_Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type Business_Rbac struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	RefreshInterval          *durationpb.Duration   `protobuf:"bytes,1,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`                              // 从数据库刷新内存RBAC的间隔
+	ConsistencyCheckInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=consistency_check_interval,json=consistencyCheckInterval,proto3" json:"consistency_check_interval,omitempty"` // 内存与数据库一致性检查间隔
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Business_Rbac) Reset() {
+	*x = Business_Rbac{}
+	mi := &file_conf_conf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Rbac) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Rbac) ProtoMessage() {}
+
+func (x *Business_Rbac) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Rbac.ProtoReflect.Descriptor instead.
+func (*Business_Rbac) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *Business_Rbac) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *Business_Rbac) GetConsistencyCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ConsistencyCheckInterval
+	}
+	return nil
+}
+
+type Business_FeedRanking struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Enabled           bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	FavoriteWeight    float64                `protobuf:"fixed64,2,opt,name=favorite_weight,json=favoriteWeight,proto3" json:"favorite_weight,omitempty"`           // 点赞数权重
+	CommentWeight     float64                `protobuf:"fixed64,3,opt,name=comment_weight,json=commentWeight,proto3" json:"comment_weight,omitempty"`              // 评论数权重
+	PlayWeight        float64                `protobuf:"fixed64,4,opt,name=play_weight,json=playWeight,proto3" json:"play_weight,omitempty"`                       // 播放数权重
+	HalfLife          *durationpb.Duration   `protobuf:"bytes,5,opt,name=half_life,json=halfLife,proto3" json:"half_life,omitempty"`                               // 时效衰减半衰期
+	CandidatePoolSize int32                  `protobuf:"varint,6,opt,name=candidate_pool_size,json=candidatePoolSize,proto3" json:"candidate_pool_size,omitempty"` // 参与排序的候选视频数
+	CandidateWindow   *durationpb.Duration   `protobuf:"bytes,7,opt,name=candidate_window,json=candidateWindow,proto3" json:"candidate_window,omitempty"`          // 只对该时长内发布的视频排序
+	MaxCategoryRun    int32                  `protobuf:"varint,8,opt,name=max_category_run,json=maxCategoryRun,proto3" json:"max_category_run,omitempty"`          // 同一分类最多连续出现的视频数，0不限制
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Business_FeedRanking) Reset() {
+	*x = Business_FeedRanking{}
+	mi := &file_conf_conf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_FeedRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_FeedRanking) ProtoMessage() {}
+
+func (x *Business_FeedRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_FeedRanking.ProtoReflect.Descriptor instead.
+func (*Business_FeedRanking) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *Business_FeedRanking) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_FeedRanking) GetFavoriteWeight() float64 {
+	if x != nil {
+		return x.FavoriteWeight
+	}
+	return 0
+}
+
+func (x *Business_FeedRanking) GetCommentWeight() float64 {
+	if x != nil {
+		return x.CommentWeight
+	}
+	return 0
+}
+
+func (x *Business_FeedRanking) GetPlayWeight() float64 {
+	if x != nil {
+		return x.PlayWeight
+	}
+	return 0
+}
+
+func (x *Business_FeedRanking) GetHalfLife() *durationpb.Duration {
+	if x != nil {
+		return x.HalfLife
+	}
+	return nil
+}
+
+func (x *Business_FeedRanking) GetCandidatePoolSize() int32 {
+	if x != nil {
+		return x.CandidatePoolSize
+	}
+	return 0
+}
+
+func (x *Business_FeedRanking) GetCandidateWindow() *durationpb.Duration {
+	if x != nil {
+		return x.CandidateWindow
+	}
+	return nil
+}
+
+func (x *Business_FeedRanking) GetMaxCategoryRun() int32 {
+	if x != nil {
+		return x.MaxCategoryRun
+	}
+	return 0
+}
+
+type Business_Registration struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DailyIpLimit         int32                  `protobuf:"varint,1,opt,name=daily_ip_limit,json=dailyIpLimit,proto3" json:"daily_ip_limit,omitempty"`                         // 单IP每日注册上限，0不限制
+	ContactRequiredAfter int32                  `protobuf:"varint,2,opt,name=contact_required_after,json=contactRequiredAfter,proto3" json:"contact_required_after,omitempty"` // 单IP当日注册数达到该值后需提供邮箱或手机号，0不要求
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Business_Registration) Reset() {
+	*x = Business_Registration{}
+	mi := &file_conf_conf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Registration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Registration) ProtoMessage() {}
+
+func (x *Business_Registration) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Registration.ProtoReflect.Descriptor instead.
+func (*Business_Registration) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *Business_Registration) GetDailyIpLimit() int32 {
+	if x != nil {
+		return x.DailyIpLimit
+	}
+	return 0
+}
+
+func (x *Business_Registration) GetContactRequiredAfter() int32 {
+	if x != nil {
+		return x.ContactRequiredAfter
+	}
+	return 0
+}
+
+type Business_PermissionAudit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	SampleRate    float64                `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`     // 拒绝记录采样率，0-1
+	MaxRows       int64                  `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`               // 表中最多保留的记录数
+	TrimInterval  *durationpb.Duration   `protobuf:"bytes,4,opt,name=trim_interval,json=trimInterval,proto3" json:"trim_interval,omitempty"` // 裁剪超出上限记录的间隔
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_PermissionAudit) Reset() {
+	*x = Business_PermissionAudit{}
+	mi := &file_conf_conf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_PermissionAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_PermissionAudit) ProtoMessage() {}
+
+func (x *Business_PermissionAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_PermissionAudit.ProtoReflect.Descriptor instead.
+func (*Business_PermissionAudit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 8}
+}
+
+func (x *Business_PermissionAudit) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_PermissionAudit) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Business_PermissionAudit) GetMaxRows() int64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *Business_PermissionAudit) GetTrimInterval() *durationpb.Duration {
+	if x != nil {
+		return x.TrimInterval
+	}
+	return nil
+}
+
+type Business_Referral struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DeviceClusterLimit int32                  `protobuf:"varint,1,opt,name=device_cluster_limit,json=deviceClusterLimit,proto3" json:"device_cluster_limit,omitempty"` // 窗口内同一设备最多计入的推荐注册数，超出的标记，0不检查
+	IpClusterLimit     int32                  `protobuf:"varint,2,opt,name=ip_cluster_limit,json=ipClusterLimit,proto3" json:"ip_cluster_limit,omitempty"`             // 窗口内同一推荐人下同一IP最多计入的推荐注册数，0不检查
+	ClusterWindow      *durationpb.Duration   `protobuf:"bytes,3,opt,name=cluster_window,json=clusterWindow,proto3" json:"cluster_window,omitempty"`                   // 批量注册检查窗口，默认30天
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Business_Referral) Reset() {
+	*x = Business_Referral{}
+	mi := &file_conf_conf_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Referral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Referral) ProtoMessage() {}
+
+func (x *Business_Referral) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Referral.ProtoReflect.Descriptor instead.
+func (*Business_Referral) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 9}
+}
+
+func (x *Business_Referral) GetDeviceClusterLimit() int32 {
+	if x != nil {
+		return x.DeviceClusterLimit
+	}
+	return 0
+}
+
+func (x *Business_Referral) GetIpClusterLimit() int32 {
+	if x != nil {
+		return x.IpClusterLimit
+	}
+	return 0
+}
+
+func (x *Business_Referral) GetClusterWindow() *durationpb.Duration {
+	if x != nil {
+		return x.ClusterWindow
+	}
+	return nil
+}
+
+type Business_Calendar struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxDrafts         int32                  `protobuf:"varint,1,opt,name=max_drafts,json=maxDrafts,proto3" json:"max_drafts,omitempty"`                           // 每个用户最多保存的草稿数，默认200
+	MinGap            *durationpb.Duration   `protobuf:"bytes,2,opt,name=min_gap,json=minGap,proto3" json:"min_gap,omitempty"`                                     // 两条草稿计划发布时间的最小间隔，不足时提示冲突，0不检查
+	ReminderInterval  *durationpb.Duration   `protobuf:"bytes,3,opt,name=reminder_interval,json=reminderInterval,proto3" json:"reminder_interval,omitempty"`       // 扫描到期提醒的间隔
+	ReminderBatchSize int32                  `protobuf:"varint,4,opt,name=reminder_batch_size,json=reminderBatchSize,proto3" json:"reminder_batch_size,omitempty"` // 单次最多发送的提醒数
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Business_Calendar) Reset() {
+	*x = Business_Calendar{}
+	mi := &file_conf_conf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Calendar) ProtoMessage() {}
+
+func (x *Business_Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Calendar.ProtoReflect.Descriptor instead.
+func (*Business_Calendar) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 10}
+}
+
+func (x *Business_Calendar) GetMaxDrafts() int32 {
+	if x != nil {
+		return x.MaxDrafts
+	}
+	return 0
+}
+
+func (x *Business_Calendar) GetMinGap() *durationpb.Duration {
+	if x != nil {
+		return x.MinGap
+	}
+	return nil
+}
+
+func (x *Business_Calendar) GetReminderInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReminderInterval
+	}
+	return nil
+}
+
+func (x *Business_Calendar) GetReminderBatchSize() int32 {
+	if x != nil {
+		return x.ReminderBatchSize
+	}
+	return 0
+}
+
+type Business_WatchHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DedupWindow   *durationpb.Duration   `protobuf:"bytes,1,opt,name=dedup_window,json=dedupWindow,proto3" json:"dedup_window,omitempty"` // 同一视频在窗口内重复观看只记录一次，默认30分钟；保留时长由 retention 的 watch_history 策略控制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_WatchHistory) Reset() {
+	*x = Business_WatchHistory{}
+	mi := &file_conf_conf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_WatchHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_WatchHistory) ProtoMessage() {}
+
+func (x *Business_WatchHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_WatchHistory.ProtoReflect.Descriptor instead.
+func (*Business_WatchHistory) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 11}
+}
+
+func (x *Business_WatchHistory) GetDedupWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DedupWindow
+	}
+	return nil
+}
+
+type Business_Outbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollInterval  *durationpb.Duration   `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"` // 中继轮询间隔，默认1秒
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`         // 单次最多投递的事件数，默认100
+	MaxAttempts   int32                  `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`   // 投递失败次数上限，超过后标记为死信，默认10
+	RetryBackoff  *durationpb.Duration   `protobuf:"bytes,4,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"` // 首次重试间隔，之后按指数退避，默认1秒
+	MaxBackoff    *durationpb.Duration   `protobuf:"bytes,5,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`       // 重试间隔上限，默认5分钟
+	ClaimLease    *durationpb.Duration   `protobuf:"bytes,6,opt,name=claim_lease,json=claimLease,proto3" json:"claim_lease,omitempty"`       // 领取事件后的租约，中继在租约内未确认时事件可被重新领取，默认30秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Outbox) Reset() {
+	*x = Business_Outbox{}
+	mi := &file_conf_conf_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Outbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Outbox) ProtoMessage() {}
+
+func (x *Business_Outbox) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Outbox.ProtoReflect.Descriptor instead.
+func (*Business_Outbox) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 12}
+}
+
+func (x *Business_Outbox) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Business_Outbox) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Business_Outbox) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Business_Outbox) GetRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryBackoff
+	}
+	return nil
+}
+
+func (x *Business_Outbox) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *Business_Outbox) GetClaimLease() *durationpb.Duration {
+	if x != nil {
+		return x.ClaimLease
+	}
+	return nil
+}
+
+type Business_EventBus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workers       int32                  `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`                      // 异步投递的工作协程数，默认4
+	QueueSize     int32                  `protobuf:"varint,2,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"` // 异步队列长度，队列满时丢弃事件并返回错误，默认1024
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_EventBus) Reset() {
+	*x = Business_EventBus{}
+	mi := &file_conf_conf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_EventBus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_EventBus) ProtoMessage() {}
+
+func (x *Business_EventBus) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_EventBus.ProtoReflect.Descriptor instead.
+func (*Business_EventBus) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 13}
+}
+
+func (x *Business_EventBus) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Business_EventBus) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+type Business_AccountDeletion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GracePeriod    *durationpb.Duration   `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`          // 注销冷静期，期内可凭密码恢复账号，期满后匿名化，默认14天
+	PurgeInterval  *durationpb.Duration   `protobuf:"bytes,2,opt,name=purge_interval,json=purgeInterval,proto3" json:"purge_interval,omitempty"`    // 匿名化冷静期满账号的任务间隔，默认1小时
+	ExportLinkTtl  *durationpb.Duration   `protobuf:"bytes,3,opt,name=export_link_ttl,json=exportLinkTtl,proto3" json:"export_link_ttl,omitempty"`  // 个人数据导出下载链接的有效期，到期后删除导出文件，默认24小时
+	ExportInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=export_interval,json=exportInterval,proto3" json:"export_interval,omitempty"` // 同一用户两次导出的最小间隔，默认24小时
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Business_AccountDeletion) Reset() {
+	*x = Business_AccountDeletion{}
+	mi := &file_conf_conf_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_AccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_AccountDeletion) ProtoMessage() {}
+
+func (x *Business_AccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_AccountDeletion.ProtoReflect.Descriptor instead.
+func (*Business_AccountDeletion) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 14}
+}
+
+func (x *Business_AccountDeletion) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *Business_AccountDeletion) GetPurgeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PurgeInterval
+	}
+	return nil
+}
+
+func (x *Business_AccountDeletion) GetExportLinkTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ExportLinkTtl
+	}
+	return nil
+}
+
+func (x *Business_AccountDeletion) GetExportInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ExportInterval
+	}
+	return nil
+}
+
+type Business_StorageCleanup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                     // 删除待删除存储对象的任务间隔，默认5分钟
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每次处理的对象数，默认100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_StorageCleanup) Reset() {
+	*x = Business_StorageCleanup{}
+	mi := &file_conf_conf_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_StorageCleanup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_StorageCleanup) ProtoMessage() {}
+
+func (x *Business_StorageCleanup) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_StorageCleanup.ProtoReflect.Descriptor instead.
+func (*Business_StorageCleanup) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 15}
+}
+
+func (x *Business_StorageCleanup) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Business_StorageCleanup) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Business_OrphanCleanup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                              // 执行间隔
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`     // 上传后超过该时间仍未被引用的对象才会清理，默认24小时
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                   // 只在日志中报告孤儿对象，不删除
+	MaxDeletions  int32                  `protobuf:"varint,5,opt,name=max_deletions,json=maxDeletions,proto3" json:"max_deletions,omitempty"` // 每次最多删除的对象数，默认1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_OrphanCleanup) Reset() {
+	*x = Business_OrphanCleanup{}
+	mi := &file_conf_conf_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_OrphanCleanup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_OrphanCleanup) ProtoMessage() {}
+
+func (x *Business_OrphanCleanup) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_OrphanCleanup.ProtoReflect.Descriptor instead.
+func (*Business_OrphanCleanup) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 16}
+}
+
+func (x *Business_OrphanCleanup) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_OrphanCleanup) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Business_OrphanCleanup) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *Business_OrphanCleanup) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *Business_OrphanCleanup) GetMaxDeletions() int32 {
+	if x != nil {
+		return x.MaxDeletions
+	}
+	return 0
+}
+
+// 上传文件病毒扫描：视频写入存储前调用扫描钩子，未配置扫描服务时不扫描
+type Business_UploadScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClamavAddress string                 `protobuf:"bytes,1,opt,name=clamav_address,json=clamavAddress,proto3" json:"clamav_address,omitempty"` // clamd 的 TCP 地址，如 127.0.0.1:3310，为空时不扫描
+	Enforcement   string                 `protobuf:"bytes,2,opt,name=enforcement,proto3" json:"enforcement,omitempty"`                          // 发现病毒时的处理：block 拒绝上传（默认），flag 保存后隔离
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                                  // 单次扫描超时，默认30s。超时或扫描失败的视频保存后隔离
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_UploadScan) Reset() {
+	*x = Business_UploadScan{}
+	mi := &file_conf_conf_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_UploadScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_UploadScan) ProtoMessage() {}
+
+func (x *Business_UploadScan) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_UploadScan.ProtoReflect.Descriptor instead.
+func (*Business_UploadScan) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 17}
+}
+
+func (x *Business_UploadScan) GetClamavAddress() string {
+	if x != nil {
+		return x.ClamavAddress
+	}
+	return ""
+}
+
+func (x *Business_UploadScan) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+func (x *Business_UploadScan) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Business_Playlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxPlaylists  int32                  `protobuf:"varint,1,opt,name=max_playlists,json=maxPlaylists,proto3" json:"max_playlists,omitempty"` // 每个用户最多创建的合集数，默认100
+	MaxVideos     int32                  `protobuf:"varint,2,opt,name=max_videos,json=maxVideos,proto3" json:"max_videos,omitempty"`          // 每个合集最多收录的视频数，默认500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Playlist) Reset() {
+	*x = Business_Playlist{}
+	mi := &file_conf_conf_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Playlist) ProtoMessage() {}
+
+func (x *Business_Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Playlist.ProtoReflect.Descriptor instead.
+func (*Business_Playlist) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 18}
+}
+
+func (x *Business_Playlist) GetMaxPlaylists() int32 {
+	if x != nil {
+		return x.MaxPlaylists
+	}
+	return 0
+}
+
+func (x *Business_Playlist) GetMaxVideos() int32 {
+	if x != nil {
+		return x.MaxVideos
+	}
+	return 0
+}
+
+type Business_CommentFolding struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Enabled             bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CollapseThreshold   float64                `protobuf:"fixed64,2,opt,name=collapse_threshold,json=collapseThreshold,proto3" json:"collapse_threshold,omitempty"`         // 得分低于该值的一级评论默认折叠，默认0
+	LikeWeight          float64                `protobuf:"fixed64,3,opt,name=like_weight,json=likeWeight,proto3" json:"like_weight,omitempty"`                              // 每个点赞的加分，默认1
+	ReplyWeight         float64                `protobuf:"fixed64,4,opt,name=reply_weight,json=replyWeight,proto3" json:"reply_weight,omitempty"`                           // 每条回复的加分，默认0.5
+	ShortContentLength  int32                  `protobuf:"varint,5,opt,name=short_content_length,json=shortContentLength,proto3" json:"short_content_length,omitempty"`     // 短于该字符数的评论视为低质量，默认4
+	ShortContentPenalty float64                `protobuf:"fixed64,6,opt,name=short_content_penalty,json=shortContentPenalty,proto3" json:"short_content_penalty,omitempty"` // 短评论扣分，默认1.5
+	LinkPenalty         float64                `protobuf:"fixed64,7,opt,name=link_penalty,json=linkPenalty,proto3" json:"link_penalty,omitempty"`                           // 包含链接的扣分，默认2
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Business_CommentFolding) Reset() {
+	*x = Business_CommentFolding{}
+	mi := &file_conf_conf_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_CommentFolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_CommentFolding) ProtoMessage() {}
+
+func (x *Business_CommentFolding) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_CommentFolding.ProtoReflect.Descriptor instead.
+func (*Business_CommentFolding) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 19}
+}
+
+func (x *Business_CommentFolding) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_CommentFolding) GetCollapseThreshold() float64 {
+	if x != nil {
+		return x.CollapseThreshold
+	}
+	return 0
+}
+
+func (x *Business_CommentFolding) GetLikeWeight() float64 {
+	if x != nil {
+		return x.LikeWeight
+	}
+	return 0
+}
+
+func (x *Business_CommentFolding) GetReplyWeight() float64 {
+	if x != nil {
+		return x.ReplyWeight
+	}
+	return 0
+}
+
+func (x *Business_CommentFolding) GetShortContentLength() int32 {
+	if x != nil {
+		return x.ShortContentLength
+	}
+	return 0
+}
+
+func (x *Business_CommentFolding) GetShortContentPenalty() float64 {
+	if x != nil {
+		return x.ShortContentPenalty
+	}
+	return 0
+}
+
+func (x *Business_CommentFolding) GetLinkPenalty() float64 {
+	if x != nil {
+		return x.LinkPenalty
+	}
+	return 0
+}
+
+type Business_ConsumerRetry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts    int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`         // 单条消息最多处理次数（含首次），默认3
+	InitialBackoff *durationpb.Duration   `protobuf:"bytes,2,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"` // 首次重试间隔，之后按指数退避，默认500ms
+	MaxBackoff     *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`             // 重试间隔上限，默认30秒
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Business_ConsumerRetry) Reset() {
+	*x = Business_ConsumerRetry{}
+	mi := &file_conf_conf_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_ConsumerRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_ConsumerRetry) ProtoMessage() {}
+
+func (x *Business_ConsumerRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_ConsumerRetry.ProtoReflect.Descriptor instead.
+func (*Business_ConsumerRetry) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 20}
+}
+
+func (x *Business_ConsumerRetry) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Business_ConsumerRetry) GetInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.InitialBackoff
+	}
+	return nil
+}
+
+func (x *Business_ConsumerRetry) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+type Business_Callback struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	ClockSkew     *durationpb.Duration        `protobuf:"bytes,1,opt,name=clock_skew,json=clockSkew,proto3" json:"clock_skew,omitempty"` // 允许的时钟偏差，超出的请求视为过期，默认5分钟
+	Sources       []*Business_Callback_Source `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Callback) Reset() {
+	*x = Business_Callback{}
+	mi := &file_conf_conf_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Callback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Callback) ProtoMessage() {}
+
+func (x *Business_Callback) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Callback.ProtoReflect.Descriptor instead.
+func (*Business_Callback) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 21}
+}
+
+func (x *Business_Callback) GetClockSkew() *durationpb.Duration {
+	if x != nil {
+		return x.ClockSkew
+	}
+	return nil
+}
+
+func (x *Business_Callback) GetSources() []*Business_Callback_Source {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type Business_Quota struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DailyUploadLimit int32                  `protobuf:"varint,1,opt,name=daily_upload_limit,json=dailyUploadLimit,proto3" json:"daily_upload_limit,omitempty"` // 每个用户每日（UTC）最多上传的视频数，0不限制
+	StorageLimit     int64                  `protobuf:"varint,2,opt,name=storage_limit,json=storageLimit,proto3" json:"storage_limit,omitempty"`               // 每个用户视频占用的存储上限（字节），已删除的视频不计入，0不限制
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Business_Quota) Reset() {
+	*x = Business_Quota{}
+	mi := &file_conf_conf_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Quota) ProtoMessage() {}
+
+func (x *Business_Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Quota.ProtoReflect.Descriptor instead.
+func (*Business_Quota) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 22}
+}
+
+func (x *Business_Quota) GetDailyUploadLimit() int32 {
+	if x != nil {
+		return x.DailyUploadLimit
+	}
+	return 0
+}
+
+func (x *Business_Quota) GetStorageLimit() int64 {
+	if x != nil {
+		return x.StorageLimit
+	}
+	return 0
+}
+
+type Business_CounterReconcile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                        // 执行间隔
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`    // 单批重算的行数，默认500
+	MaxBatches    int32                  `protobuf:"varint,4,opt,name=max_batches,json=maxBatches,proto3" json:"max_batches,omitempty"` // 单次执行每类计数最多处理的批次数，未扫完的下次从断点继续，默认20
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`             // 仅统计偏差不修复
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_CounterReconcile) Reset() {
+	*x = Business_CounterReconcile{}
+	mi := &file_conf_conf_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_CounterReconcile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_CounterReconcile) ProtoMessage() {}
+
+func (x *Business_CounterReconcile) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_CounterReconcile.ProtoReflect.Descriptor instead.
+func (*Business_CounterReconcile) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 23}
+}
+
+func (x *Business_CounterReconcile) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_CounterReconcile) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Business_CounterReconcile) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Business_CounterReconcile) GetMaxBatches() int32 {
+	if x != nil {
+		return x.MaxBatches
+	}
+	return 0
+}
+
+func (x *Business_CounterReconcile) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type Business_IntegrityCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                                 // 执行间隔
+	SampleSize    int32                  `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`          // 每次随机抽查的视频数，默认20
+	VerifyContent bool                   `protobuf:"varint,4,opt,name=verify_content,json=verifyContent,proto3" json:"verify_content,omitempty"` // 下载原始文件计算MD5，关闭时只比较大小和ETag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_IntegrityCheck) Reset() {
+	*x = Business_IntegrityCheck{}
+	mi := &file_conf_conf_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_IntegrityCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_IntegrityCheck) ProtoMessage() {}
+
+func (x *Business_IntegrityCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_IntegrityCheck.ProtoReflect.Descriptor instead.
+func (*Business_IntegrityCheck) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 24}
+}
+
+func (x *Business_IntegrityCheck) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_IntegrityCheck) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Business_IntegrityCheck) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *Business_IntegrityCheck) GetVerifyContent() bool {
+	if x != nil {
+		return x.VerifyContent
+	}
+	return false
+}
+
+type Business_Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Slots         []int32                `protobuf:"varint,2,rep,packed,name=slots,proto3" json:"slots,omitempty"` // 推广视频在每页视频流中的位置，从1开始，默认[4, 12]
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Promotion) Reset() {
+	*x = Business_Promotion{}
+	mi := &file_conf_conf_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Promotion) ProtoMessage() {}
+
+func (x *Business_Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Promotion.ProtoReflect.Descriptor instead.
+func (*Business_Promotion) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 25}
+}
+
+func (x *Business_Promotion) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_Promotion) GetSlots() []int32 {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type Business_Degradation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                                 // 评估间隔，默认10s
+	Order         []string               `protobuf:"bytes,3,rep,name=order,proto3" json:"order,omitempty"`                                       // 功能降级顺序，先关闭的在前：feed_ranking, promotion, view_counting, notifications
+	MaxInflight   int64                  `protobuf:"varint,4,opt,name=max_inflight,json=maxInflight,proto3" json:"max_inflight,omitempty"`       // 进行中的请求数上限，0表示不检查
+	MaxErrorRate  float64                `protobuf:"fixed64,5,opt,name=max_error_rate,json=maxErrorRate,proto3" json:"max_error_rate,omitempty"` // 评估间隔内服务端错误占比上限，0表示不检查
+	MinRequests   int64                  `protobuf:"varint,6,opt,name=min_requests,json=minRequests,proto3" json:"min_requests,omitempty"`       // 请求数达到后才检查错误率，默认20
+	MaxLatency    *durationpb.Duration   `protobuf:"bytes,7,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`           // 评估间隔内平均请求耗时上限，0表示不检查
+	ProbeTimeout  *durationpb.Duration   `protobuf:"bytes,8,opt,name=probe_timeout,json=probeTimeout,proto3" json:"probe_timeout,omitempty"`     // 依赖探测超时，超时视为依赖故障，默认1s
+	RecoverAfter  int32                  `protobuf:"varint,9,opt,name=recover_after,json=recoverAfter,proto3" json:"recover_after,omitempty"`    // 连续健康的评估次数，达到后恢复最近关闭的一项功能，默认3
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Degradation) Reset() {
+	*x = Business_Degradation{}
+	mi := &file_conf_conf_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Degradation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Degradation) ProtoMessage() {}
+
+func (x *Business_Degradation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Degradation.ProtoReflect.Descriptor instead.
+func (*Business_Degradation) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 26}
+}
+
+func (x *Business_Degradation) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_Degradation) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Business_Degradation) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *Business_Degradation) GetMaxInflight() int64 {
+	if x != nil {
+		return x.MaxInflight
+	}
+	return 0
+}
+
+func (x *Business_Degradation) GetMaxErrorRate() float64 {
+	if x != nil {
+		return x.MaxErrorRate
+	}
+	return 0
+}
+
+func (x *Business_Degradation) GetMinRequests() int64 {
+	if x != nil {
+		return x.MinRequests
+	}
+	return 0
+}
+
+func (x *Business_Degradation) GetMaxLatency() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLatency
+	}
+	return nil
+}
+
+func (x *Business_Degradation) GetProbeTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProbeTimeout
+	}
+	return nil
+}
+
+func (x *Business_Degradation) GetRecoverAfter() int32 {
+	if x != nil {
+		return x.RecoverAfter
+	}
+	return 0
+}
+
+type Business_Shutdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrainTimeout  *durationpb.Duration   `protobuf:"bytes,1,opt,name=drain_timeout,json=drainTimeout,proto3" json:"drain_timeout,omitempty"` // 停止时等待消费者和后台任务结束的时间，默认10s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Shutdown) Reset() {
+	*x = Business_Shutdown{}
+	mi := &file_conf_conf_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Shutdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Shutdown) ProtoMessage() {}
+
+func (x *Business_Shutdown) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Shutdown.ProtoReflect.Descriptor instead.
+func (*Business_Shutdown) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 27}
+}
+
+func (x *Business_Shutdown) GetDrainTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DrainTimeout
+	}
+	return nil
+}
+
+type Business_EventIdempotency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LockTtl       *durationpb.Duration   `protobuf:"bytes,1,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`    // 事件处理中的占用时间，超时后其他实例可以重新处理，需大于最长的处理耗时，默认10m
+	CacheTtl      *durationpb.Duration   `protobuf:"bytes,2,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"` // 已处理标记在Redis中的缓存时间，过期后查数据库，默认24h
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_EventIdempotency) Reset() {
+	*x = Business_EventIdempotency{}
+	mi := &file_conf_conf_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_EventIdempotency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_EventIdempotency) ProtoMessage() {}
+
+func (x *Business_EventIdempotency) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_EventIdempotency.ProtoReflect.Descriptor instead.
+func (*Business_EventIdempotency) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 28}
+}
+
+func (x *Business_EventIdempotency) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+func (x *Business_EventIdempotency) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+type Business_FeedCache struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        *durationpb.Duration   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`                  // 翻页游标按该粒度取整后作为缓存键，同一区间的游标共用缓存，默认60s
+	SoftTtl       *durationpb.Duration   `protobuf:"bytes,2,opt,name=soft_ttl,json=softTtl,proto3" json:"soft_ttl,omitempty"` // 软过期时间，过期后仍返回缓存并在后台刷新，默认30s
+	HardTtl       *durationpb.Duration   `protobuf:"bytes,3,opt,name=hard_ttl,json=hardTtl,proto3" json:"hard_ttl,omitempty"` // 缓存实际保留时间，过期后请求回源，默认5m
+	Window        int32                  `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`                 // 每个缓存条目保存的视频数，不少于单页数量加一，默认100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_FeedCache) Reset() {
+	*x = Business_FeedCache{}
+	mi := &file_conf_conf_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_FeedCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_FeedCache) ProtoMessage() {}
+
+func (x *Business_FeedCache) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_FeedCache.ProtoReflect.Descriptor instead.
+func (*Business_FeedCache) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 29}
+}
+
+func (x *Business_FeedCache) GetBucket() *durationpb.Duration {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *Business_FeedCache) GetSoftTtl() *durationpb.Duration {
+	if x != nil {
+		return x.SoftTtl
+	}
+	return nil
+}
+
+func (x *Business_FeedCache) GetHardTtl() *durationpb.Duration {
+	if x != nil {
+		return x.HardTtl
+	}
+	return nil
+}
+
+func (x *Business_FeedCache) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+type Business_VideoStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WriteBehind    bool                   `protobuf:"varint,1,opt,name=write_behind,json=writeBehind,proto3" json:"write_behind,omitempty"`            // 计数增量先累加在Redis，由后台任务批量写入MySQL
+	FlushInterval  *durationpb.Duration   `protobuf:"bytes,2,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`       // 批量写入间隔，默认5s
+	FlushBatchSize int32                  `protobuf:"varint,3,opt,name=flush_batch_size,json=flushBatchSize,proto3" json:"flush_batch_size,omitempty"` // 单次写入的日志条目数上限，默认500
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Business_VideoStats) Reset() {
+	*x = Business_VideoStats{}
+	mi := &file_conf_conf_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_VideoStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_VideoStats) ProtoMessage() {}
+
+func (x *Business_VideoStats) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_VideoStats.ProtoReflect.Descriptor instead.
+func (*Business_VideoStats) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 30}
+}
+
+func (x *Business_VideoStats) GetWriteBehind() bool {
+	if x != nil {
+		return x.WriteBehind
+	}
+	return false
+}
+
+func (x *Business_VideoStats) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+func (x *Business_VideoStats) GetFlushBatchSize() int32 {
+	if x != nil {
+		return x.FlushBatchSize
+	}
+	return 0
+}
+
+type Business_PlayCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DedupWindow   *durationpb.Duration   `protobuf:"bytes,1,opt,name=dedup_window,json=dedupWindow,proto3" json:"dedup_window,omitempty"` // 同一观众在窗口内重复播放同一视频只计一次，默认30分钟
+	MinWatch      *durationpb.Duration   `protobuf:"bytes,2,opt,name=min_watch,json=minWatch,proto3" json:"min_watch,omitempty"`          // 上报的观看时长达到该值才计入播放，短于该值的视频以视频时长为准，默认3s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_PlayCount) Reset() {
+	*x = Business_PlayCount{}
+	mi := &file_conf_conf_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_PlayCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_PlayCount) ProtoMessage() {}
+
+func (x *Business_PlayCount) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_PlayCount.ProtoReflect.Descriptor instead.
+func (*Business_PlayCount) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 31}
+}
+
+func (x *Business_PlayCount) GetDedupWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DedupWindow
+	}
+	return nil
+}
+
+func (x *Business_PlayCount) GetMinWatch() *durationpb.Duration {
+	if x != nil {
+		return x.MinWatch
+	}
+	return nil
+}
+
+type Business_Trending struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Bucket          *durationpb.Duration   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`                                          // 热度按该粒度分桶累加，默认1小时
+	Window          int32                  `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`                                         // 参与排名的最近桶数，默认24
+	Decay           float64                `protobuf:"fixed64,3,opt,name=decay,proto3" json:"decay,omitempty"`                                          // 每早一个桶热度乘以该系数，取值(0,1]，默认0.8
+	RefreshInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"` // 重新合并排行榜的间隔，默认1分钟
+	MaxSize         int32                  `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`                        // 排行榜保留的视频数，默认500
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Business_Trending) Reset() {
+	*x = Business_Trending{}
+	mi := &file_conf_conf_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Trending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Trending) ProtoMessage() {}
+
+func (x *Business_Trending) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Trending.ProtoReflect.Descriptor instead.
+func (*Business_Trending) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 32}
+}
+
+func (x *Business_Trending) GetBucket() *durationpb.Duration {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *Business_Trending) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *Business_Trending) GetDecay() float64 {
+	if x != nil {
+		return x.Decay
+	}
+	return 0
+}
+
+func (x *Business_Trending) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *Business_Trending) GetMaxSize() int32 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+type Business_Moderation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BlockWords      []string               `protobuf:"bytes,1,rep,name=block_words,json=blockWords,proto3" json:"block_words,omitempty"`                // 违禁词，命中时直接拒绝，与数据库中的词表合并
+	ReviewWords     []string               `protobuf:"bytes,2,rep,name=review_words,json=reviewWords,proto3" json:"review_words,omitempty"`             // 疑似违规词，命中时转人工复核
+	ReloadInterval  *durationpb.Duration   `protobuf:"bytes,3,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"`    // 重新加载数据库词表的间隔，默认5分钟
+	ExternalUrl     string                 `protobuf:"bytes,4,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`             // 外部审核服务地址，为空时只使用本地词表
+	ExternalTimeout *durationpb.Duration   `protobuf:"bytes,5,opt,name=external_timeout,json=externalTimeout,proto3" json:"external_timeout,omitempty"` // 外部审核超时，超时或失败时以本地词表结果为准，默认2s
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Business_Moderation) Reset() {
+	*x = Business_Moderation{}
+	mi := &file_conf_conf_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Moderation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Moderation) ProtoMessage() {}
+
+func (x *Business_Moderation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Moderation.ProtoReflect.Descriptor instead.
+func (*Business_Moderation) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 33}
+}
+
+func (x *Business_Moderation) GetBlockWords() []string {
+	if x != nil {
+		return x.BlockWords
+	}
+	return nil
+}
+
+func (x *Business_Moderation) GetReviewWords() []string {
+	if x != nil {
+		return x.ReviewWords
+	}
+	return nil
+}
+
+func (x *Business_Moderation) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+func (x *Business_Moderation) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *Business_Moderation) GetExternalTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ExternalTimeout
+	}
+	return nil
+}
+
+type Business_SigningKeys struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RefreshInterval *durationpb.Duration   `protobuf:"bytes,1,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"` // 各实例从数据库重新加载签名密钥的间隔，默认1分钟
+	ActivationDelay *durationpb.Duration   `protobuf:"bytes,2,opt,name=activation_delay,json=activationDelay,proto3" json:"activation_delay,omitempty"` // 新密钥写入后延迟生效，保证所有实例先加载到它，默认2分钟
+	Algorithm       string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                                    // 轮换时生成的新密钥的算法：HS256（默认）、RS256 或 EdDSA，非对称密钥的公钥通过 JWKS 发布
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Business_SigningKeys) Reset() {
+	*x = Business_SigningKeys{}
+	mi := &file_conf_conf_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_SigningKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_SigningKeys) ProtoMessage() {}
+
+func (x *Business_SigningKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_SigningKeys.ProtoReflect.Descriptor instead.
+func (*Business_SigningKeys) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 34}
+}
+
+func (x *Business_SigningKeys) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *Business_SigningKeys) GetActivationDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ActivationDelay
+	}
+	return nil
+}
+
+func (x *Business_SigningKeys) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type Business_Share struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseUrl       string                 `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"` // 分享落地页地址，卡片二维码指向 {base_url}/user/{id} 或 {base_url}/video/{id}，分享短链为 {base_url}/s/{token}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Share) Reset() {
+	*x = Business_Share{}
+	mi := &file_conf_conf_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Share) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Share) ProtoMessage() {}
+
+func (x *Business_Share) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Share.ProtoReflect.Descriptor instead.
+func (*Business_Share) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 35}
+}
+
+func (x *Business_Share) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+type Business_LoginAnomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                    // 发现异常登录时的处理：notify（默认）放行并发出安全事件，challenge 拒绝登录并向已绑定邮箱发送验证码，未绑定邮箱的账号按 notify 处理
+	HistoryWindow *durationpb.Duration   `protobuf:"bytes,3,opt,name=history_window,json=historyWindow,proto3" json:"history_window,omitempty"` // 与该时长内的成功登录比较设备和网络，默认90天；记录的保留时长由 retention 的 login_history 策略控制
+	ChallengeTtl  *durationpb.Duration   `protobuf:"bytes,4,opt,name=challenge_ttl,json=challengeTtl,proto3" json:"challenge_ttl,omitempty"`    // 登录验证码有效期，默认15分钟
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_LoginAnomaly) Reset() {
+	*x = Business_LoginAnomaly{}
+	mi := &file_conf_conf_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_LoginAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_LoginAnomaly) ProtoMessage() {}
+
+func (x *Business_LoginAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_LoginAnomaly.ProtoReflect.Descriptor instead.
+func (*Business_LoginAnomaly) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 36}
+}
+
+func (x *Business_LoginAnomaly) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_LoginAnomaly) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Business_LoginAnomaly) GetHistoryWindow() *durationpb.Duration {
+	if x != nil {
+		return x.HistoryWindow
+	}
+	return nil
+}
+
+func (x *Business_LoginAnomaly) GetChallengeTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ChallengeTtl
+	}
+	return nil
+}
+
+type Business_OAuth struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Providers     []*Business_OAuth_Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_OAuth) Reset() {
+	*x = Business_OAuth{}
+	mi := &file_conf_conf_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_OAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_OAuth) ProtoMessage() {}
+
+func (x *Business_OAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_OAuth.ProtoReflect.Descriptor instead.
+func (*Business_OAuth) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 37}
+}
+
+func (x *Business_OAuth) GetProviders() []*Business_OAuth_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type Business_CodeLogin struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Enabled        bool                    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Channels       []string                `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`                                   // 允许的渠道：email、sms，为空时只允许 email
+	CodeTtl        *durationpb.Duration    `protobuf:"bytes,3,opt,name=code_ttl,json=codeTtl,proto3" json:"code_ttl,omitempty"`                      // 验证码有效期，默认5分钟
+	ResendInterval *durationpb.Duration    `protobuf:"bytes,4,opt,name=resend_interval,json=resendInterval,proto3" json:"resend_interval,omitempty"` // 同一账号两次发送的最小间隔，默认1分钟
+	MaxAttempts    int32                   `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`         // 验证码输错次数上限，默认5次
+	Sms            *Business_CodeLogin_SMS `protobuf:"bytes,6,opt,name=sms,proto3" json:"sms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Business_CodeLogin) Reset() {
+	*x = Business_CodeLogin{}
+	mi := &file_conf_conf_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_CodeLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_CodeLogin) ProtoMessage() {}
+
+func (x *Business_CodeLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_CodeLogin.ProtoReflect.Descriptor instead.
+func (*Business_CodeLogin) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 38}
+}
+
+func (x *Business_CodeLogin) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_CodeLogin) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Business_CodeLogin) GetCodeTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CodeTtl
+	}
+	return nil
+}
+
+func (x *Business_CodeLogin) GetResendInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ResendInterval
+	}
+	return nil
+}
+
+func (x *Business_CodeLogin) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Business_CodeLogin) GetSms() *Business_CodeLogin_SMS {
+	if x != nil {
+		return x.Sms
+	}
+	return nil
+}
+
+// 动态封面：视频处理时截取开头几秒生成循环播放的 GIF 或动图 WebP，用于列表悬停和预览
+type Business_Video_DynamicCover struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                              // gif 或 webp，默认 gif
+	Duration      *durationpb.Duration   `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`                          // 截取时长，默认3秒，最长5秒
+	StartOffset   *durationpb.Duration   `protobuf:"bytes,4,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"` // 起始时间点，默认从头开始
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`                               // 输出宽度，默认320，高度按比例缩放
+	Fps           int32                  `protobuf:"varint,6,opt,name=fps,proto3" json:"fps,omitempty"`                                   // 帧率，默认10
+	Quality       int32                  `protobuf:"varint,7,opt,name=quality,proto3" json:"quality,omitempty"`                           // WebP 编码质量，默认60
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Video_DynamicCover) Reset() {
+	*x = Business_Video_DynamicCover{}
+	mi := &file_conf_conf_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Video_DynamicCover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Video_DynamicCover) ProtoMessage() {}
+
+func (x *Business_Video_DynamicCover) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business_Video_DynamicCover.ProtoReflect.Descriptor instead.
+func (*Business_Video_DynamicCover) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1, 0}
+}
+
+func (x *Business_Video_DynamicCover) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Business_Video_DynamicCover) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Business_Video_DynamicCover) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Business_Video_DynamicCover) GetStartOffset() *durationpb.Duration {
+	if x != nil {
+		return x.StartOffset
+	}
+	return nil
+}
+
+func (x *Business_Video_DynamicCover) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Business_Video_DynamicCover) GetFps() int32 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+func (x *Business_Video_DynamicCover) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+// 音轨响度归一化（EBU R128）：转码前提取音轨两遍测量并线性归一化后重新封装，各清晰度使用归一化后的音轨
+type Business_Video_AudioNormalization struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Enabled        bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	TargetLoudness float64                `protobuf:"fixed64,2,opt,name=target_loudness,json=targetLoudness,proto3" json:"target_loudness,omitempty"` // 目标综合响度（LUFS），默认-23，短视频平台常用-14 ~ -16
+	TruePeak       float64                `protobuf:"fixed64,3,opt,name=true_peak,json=truePeak,proto3" json:"true_peak,omitempty"`                   // 真峰值上限（dBTP），默认-1
+	LoudnessRange  float64                `protobuf:"fixed64,4,opt,name=loudness_range,json=loudnessRange,proto3" json:"loudness_range,omitempty"`    // 目标响度范围（LU），默认11
+	AudioBitrate   string                 `protobuf:"bytes,5,opt,name=audio_bitrate,json=audioBitrate,proto3" json:"audio_bitrate,omitempty"`         // 归一化后 AAC 码率，默认128k
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Business_Video_AudioNormalization) Reset() {
+	*x = Business_Video_AudioNormalization{}
+	mi := &file_conf_conf_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Video_AudioNormalization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Video_AudioNormalization) ProtoMessage() {}
+
+func (x *Business_Video_AudioNormalization) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,72 +4527,72 @@ func (x *Data_Kafka_Consumer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Kafka_Consumer.ProtoReflect.Descriptor instead.
-func (*Data_Kafka_Consumer) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4, 1}
+// Deprecated: Use Business_Video_AudioNormalization.ProtoReflect.Descriptor instead.
+func (*Business_Video_AudioNormalization) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1, 1}
 }
 
-func (x *Data_Kafka_Consumer) GetGroupId() string {
+func (x *Business_Video_AudioNormalization) GetEnabled() bool {
 	if x != nil {
-		return x.GroupId
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Data_Kafka_Consumer) GetAutoCommit() bool {
+func (x *Business_Video_AudioNormalization) GetTargetLoudness() float64 {
 	if x != nil {
-		return x.AutoCommit
+		return x.TargetLoudness
 	}
-	return false
+	return 0
 }
 
-func (x *Data_Kafka_Consumer) GetSessionTimeout() *durationpb.Duration {
+func (x *Business_Video_AudioNormalization) GetTruePeak() float64 {
 	if x != nil {
-		return x.SessionTimeout
+		return x.TruePeak
 	}
-	return nil
+	return 0
 }
 
-func (x *Data_Kafka_Consumer) GetFetchMinBytes() int32 {
+func (x *Business_Video_AudioNormalization) GetLoudnessRange() float64 {
 	if x != nil {
-		return x.FetchMinBytes
+		return x.LoudnessRange
 	}
 	return 0
 }
 
-func (x *Data_Kafka_Consumer) GetFetchMaxWait() *durationpb.Duration {
+func (x *Business_Video_AudioNormalization) GetAudioBitrate() string {
 	if x != nil {
-		return x.FetchMaxWait
+		return x.AudioBitrate
 	}
-	return nil
+	return ""
 }
 
-type Business_User struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	PasswordSaltLength int32                  `protobuf:"varint,1,opt,name=password_salt_length,json=passwordSaltLength,proto3" json:"password_salt_length,omitempty"`
-	UsernameMinLength  int32                  `protobuf:"varint,2,opt,name=username_min_length,json=usernameMinLength,proto3" json:"username_min_length,omitempty"`
-	UsernameMaxLength  int32                  `protobuf:"varint,3,opt,name=username_max_length,json=usernameMaxLength,proto3" json:"username_max_length,omitempty"`
-	PasswordMinLength  int32                  `protobuf:"varint,4,opt,name=password_min_length,json=passwordMinLength,proto3" json:"password_min_length,omitempty"`
-	PasswordMaxLength  int32                  `protobuf:"varint,5,opt,name=password_max_length,json=passwordMaxLength,proto3" json:"password_max_length,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// 主题的声明配置，启动时或由 cmd/kafka-topics 创建缺失主题并检查配置漂移
+type Business_KafkaTopics_Spec struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Partitions        int32                  `protobuf:"varint,1,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	ReplicationFactor int32                  `protobuf:"varint,2,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	Retention         *durationpb.Duration   `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"` // 消息保留时长，不设置时沿用broker默认值
+	Compact           bool                   `protobuf:"varint,4,opt,name=compact,proto3" json:"compact,omitempty"`    // cleanup.policy=compact，按key只保留最新消息
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Business_User) Reset() {
-	*x = Business_User{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+func (x *Business_KafkaTopics_Spec) Reset() {
+	*x = Business_KafkaTopics_Spec{}
+	mi := &file_conf_conf_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Business_User) String() string {
+func (x *Business_KafkaTopics_Spec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Business_User) ProtoMessage() {}
+func (*Business_KafkaTopics_Spec) ProtoMessage() {}
 
-func (x *Business_User) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+func (x *Business_KafkaTopics_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,75 +4603,66 @@ func (x *Business_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Business_User.ProtoReflect.Descriptor instead.
-func (*Business_User) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4, 0}
-}
-
-func (x *Business_User) GetPasswordSaltLength() int32 {
-	if x != nil {
-		return x.PasswordSaltLength
-	}
-	return 0
+// Deprecated: Use Business_KafkaTopics_Spec.ProtoReflect.Descriptor instead.
+func (*Business_KafkaTopics_Spec) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 3, 0}
 }
 
-func (x *Business_User) GetUsernameMinLength() int32 {
+func (x *Business_KafkaTopics_Spec) GetPartitions() int32 {
 	if x != nil {
-		return x.UsernameMinLength
+		return x.Partitions
 	}
 	return 0
 }
 
-func (x *Business_User) GetUsernameMaxLength() int32 {
+func (x *Business_KafkaTopics_Spec) GetReplicationFactor() int32 {
 	if x != nil {
-		return x.UsernameMaxLength
+		return x.ReplicationFactor
 	}
 	return 0
 }
 
-func (x *Business_User) GetPasswordMinLength() int32 {
+func (x *Business_KafkaTopics_Spec) GetRetention() *durationpb.Duration {
 	if x != nil {
-		return x.PasswordMinLength
+		return x.Retention
 	}
-	return 0
+	return nil
 }
 
-func (x *Business_User) GetPasswordMaxLength() int32 {
+func (x *Business_KafkaTopics_Spec) GetCompact() bool {
 	if x != nil {
-		return x.PasswordMaxLength
+		return x.Compact
 	}
-	return 0
+	return false
 }
 
-type Business_Video struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	MaxFileSize      int64                  `protobuf:"varint,1,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
-	MaxTitleLength   int32                  `protobuf:"varint,2,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
-	DefaultFeedLimit int32                  `protobuf:"varint,3,opt,name=default_feed_limit,json=defaultFeedLimit,proto3" json:"default_feed_limit,omitempty"`
-	SupportedFormats []string               `protobuf:"bytes,4,rep,name=supported_formats,json=supportedFormats,proto3" json:"supported_formats,omitempty"`
-	CoverQuality     int32                  `protobuf:"varint,5,opt,name=cover_quality,json=coverQuality,proto3" json:"cover_quality,omitempty"`
-	CoverWidth       int32                  `protobuf:"varint,6,opt,name=cover_width,json=coverWidth,proto3" json:"cover_width,omitempty"`
-	CoverHeight      int32                  `protobuf:"varint,7,opt,name=cover_height,json=coverHeight,proto3" json:"cover_height,omitempty"`
-	TempDir          string                 `protobuf:"bytes,8,opt,name=temp_dir,json=tempDir,proto3" json:"temp_dir,omitempty"` // 视频处理临时目录
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type Business_Retention_Policy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // 策略名称
+	Table         string                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`                             // 目标表
+	TimeColumn    string                 `protobuf:"bytes,3,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"` // 判断过期的时间字段
+	MaxAge        *durationpb.Duration   `protobuf:"bytes,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`             // 保留时长
+	Condition     string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`                     // 额外过滤条件，如按事件类型区分
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`            // 仅统计不删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Business_Video) Reset() {
-	*x = Business_Video{}
-	mi := &file_conf_conf_proto_msgTypes[15]
+func (x *Business_Retention_Policy) Reset() {
+	*x = Business_Retention_Policy{}
+	mi := &file_conf_conf_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Business_Video) String() string {
+func (x *Business_Retention_Policy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Business_Video) ProtoMessage() {}
+func (*Business_Retention_Policy) ProtoMessage() {}
 
-func (x *Business_Video) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[15]
+func (x *Business_Retention_Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,94 +4673,146 @@ func (x *Business_Video) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Business_Video.ProtoReflect.Descriptor instead.
-func (*Business_Video) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use Business_Retention_Policy.ProtoReflect.Descriptor instead.
+func (*Business_Retention_Policy) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 4, 0}
 }
 
-func (x *Business_Video) GetMaxFileSize() int64 {
+func (x *Business_Retention_Policy) GetName() string {
 	if x != nil {
-		return x.MaxFileSize
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Business_Video) GetMaxTitleLength() int32 {
+func (x *Business_Retention_Policy) GetTable() string {
 	if x != nil {
-		return x.MaxTitleLength
+		return x.Table
 	}
-	return 0
+	return ""
 }
 
-func (x *Business_Video) GetDefaultFeedLimit() int32 {
+func (x *Business_Retention_Policy) GetTimeColumn() string {
 	if x != nil {
-		return x.DefaultFeedLimit
+		return x.TimeColumn
 	}
-	return 0
+	return ""
 }
 
-func (x *Business_Video) GetSupportedFormats() []string {
+func (x *Business_Retention_Policy) GetMaxAge() *durationpb.Duration {
 	if x != nil {
-		return x.SupportedFormats
+		return x.MaxAge
 	}
 	return nil
 }
 
-func (x *Business_Video) GetCoverQuality() int32 {
+func (x *Business_Retention_Policy) GetCondition() string {
 	if x != nil {
-		return x.CoverQuality
+		return x.Condition
 	}
-	return 0
+	return ""
 }
 
-func (x *Business_Video) GetCoverWidth() int32 {
+func (x *Business_Retention_Policy) GetDryRun() bool {
 	if x != nil {
-		return x.CoverWidth
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *Business_Video) GetCoverHeight() int32 {
+type Business_Callback_Source struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // 回调来源，如 media、payment、cdn，用于区分 nonce
+	PathPrefix    string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"` // 接收回调的路由前缀，匹配的请求必须携带签名
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`                           // 与回调方约定的签名密钥
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Business_Callback_Source) Reset() {
+	*x = Business_Callback_Source{}
+	mi := &file_conf_conf_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Business_Callback_Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business_Callback_Source) ProtoMessage() {}
+
+func (x *Business_Callback_Source) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[65]
 	if x != nil {
-		return x.CoverHeight
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Business_Video) GetTempDir() string {
+// Deprecated: Use Business_Callback_Source.ProtoReflect.Descriptor instead.
+func (*Business_Callback_Source) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 21, 0}
+}
+
+func (x *Business_Callback_Source) GetName() string {
 	if x != nil {
-		return x.TempDir
+		return x.Name
 	}
 	return ""
 }
 
-type Business_Storage struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	UploadTimeout        *durationpb.Duration   `protobuf:"bytes,1,opt,name=upload_timeout,json=uploadTimeout,proto3" json:"upload_timeout,omitempty"`
-	DownloadTimeout      *durationpb.Duration   `protobuf:"bytes,2,opt,name=download_timeout,json=downloadTimeout,proto3" json:"download_timeout,omitempty"`
-	PresignedUrlExpire   *durationpb.Duration   `protobuf:"bytes,3,opt,name=presigned_url_expire,json=presignedUrlExpire,proto3" json:"presigned_url_expire,omitempty"`
-	DefaultProvider      string                 `protobuf:"bytes,4,opt,name=default_provider,json=defaultProvider,proto3" json:"default_provider,omitempty"`
-	MultipartChunkSize   int64                  `protobuf:"varint,5,opt,name=multipart_chunk_size,json=multipartChunkSize,proto3" json:"multipart_chunk_size,omitempty"`       // 分片大小
-	MaxConcurrentUploads int32                  `protobuf:"varint,6,opt,name=max_concurrent_uploads,json=maxConcurrentUploads,proto3" json:"max_concurrent_uploads,omitempty"` // 最大并发上传数
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *Business_Callback_Source) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
 }
 
-func (x *Business_Storage) Reset() {
-	*x = Business_Storage{}
-	mi := &file_conf_conf_proto_msgTypes[16]
+func (x *Business_Callback_Source) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type Business_OAuth_Provider struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // 登录请求中的 provider，也是账号绑定记录中的提供方名
+	Type               string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                     // google、github、wechat 或 oauth2（默认），前两者自带地址和字段映射
+	ClientId           string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`             // 微信为 appid
+	ClientSecret       string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // 微信为 appsecret
+	RedirectUri        string                 `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`    // 获取授权码时使用的回调地址，客户端传入的地址必须与之一致
+	TokenUrl           string                 `protobuf:"bytes,6,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`             // 覆盖内置地址，oauth2 类型必填
+	UserinfoUrl        string                 `protobuf:"bytes,7,opt,name=userinfo_url,json=userinfoUrl,proto3" json:"userinfo_url,omitempty"`    // 覆盖内置地址，oauth2 类型必填
+	SubjectField       string                 `protobuf:"bytes,8,opt,name=subject_field,json=subjectField,proto3" json:"subject_field,omitempty"` // 用户信息中账号ID的字段名，oauth2 类型默认 sub
+	EmailField         string                 `protobuf:"bytes,9,opt,name=email_field,json=emailField,proto3" json:"email_field,omitempty"`
+	EmailVerifiedField string                 `protobuf:"bytes,10,opt,name=email_verified_field,json=emailVerifiedField,proto3" json:"email_verified_field,omitempty"` // 为空时不信任邮箱，不按邮箱关联已有账号
+	NameField          string                 `protobuf:"bytes,11,opt,name=name_field,json=nameField,proto3" json:"name_field,omitempty"`
+	AvatarField        string                 `protobuf:"bytes,12,opt,name=avatar_field,json=avatarField,proto3" json:"avatar_field,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Business_OAuth_Provider) Reset() {
+	*x = Business_OAuth_Provider{}
+	mi := &file_conf_conf_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Business_Storage) String() string {
+func (x *Business_OAuth_Provider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Business_Storage) ProtoMessage() {}
+func (*Business_OAuth_Provider) ProtoMessage() {}
 
-func (x *Business_Storage) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[16]
+func (x *Business_OAuth_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,78 +4823,119 @@ func (x *Business_Storage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Business_Storage.ProtoReflect.Descriptor instead.
-func (*Business_Storage) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4, 2}
+// Deprecated: Use Business_OAuth_Provider.ProtoReflect.Descriptor instead.
+func (*Business_OAuth_Provider) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 37, 0}
 }
 
-func (x *Business_Storage) GetUploadTimeout() *durationpb.Duration {
+func (x *Business_OAuth_Provider) GetName() string {
 	if x != nil {
-		return x.UploadTimeout
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Business_Storage) GetDownloadTimeout() *durationpb.Duration {
+func (x *Business_OAuth_Provider) GetType() string {
 	if x != nil {
-		return x.DownloadTimeout
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *Business_Storage) GetPresignedUrlExpire() *durationpb.Duration {
+func (x *Business_OAuth_Provider) GetClientId() string {
 	if x != nil {
-		return x.PresignedUrlExpire
+		return x.ClientId
 	}
-	return nil
+	return ""
 }
 
-func (x *Business_Storage) GetDefaultProvider() string {
+func (x *Business_OAuth_Provider) GetClientSecret() string {
 	if x != nil {
-		return x.DefaultProvider
+		return x.ClientSecret
 	}
 	return ""
 }
 
-func (x *Business_Storage) GetMultipartChunkSize() int64 {
+func (x *Business_OAuth_Provider) GetRedirectUri() string {
 	if x != nil {
-		return x.MultipartChunkSize
+		return x.RedirectUri
 	}
-	return 0
+	return ""
 }
 
-func (x *Business_Storage) GetMaxConcurrentUploads() int32 {
+func (x *Business_OAuth_Provider) GetTokenUrl() string {
 	if x != nil {
-		return x.MaxConcurrentUploads
+		return x.TokenUrl
 	}
-	return 0
+	return ""
 }
 
-type Business_KafkaTopics struct {
+func (x *Business_OAuth_Provider) GetUserinfoUrl() string {
+	if x != nil {
+		return x.UserinfoUrl
+	}
+	return ""
+}
+
+func (x *Business_OAuth_Provider) GetSubjectField() string {
+	if x != nil {
+		return x.SubjectField
+	}
+	return ""
+}
+
+func (x *Business_OAuth_Provider) GetEmailField() string {
+	if x != nil {
+		return x.EmailField
+	}
+	return ""
+}
+
+func (x *Business_OAuth_Provider) GetEmailVerifiedField() string {
+	if x != nil {
+		return x.EmailVerifiedField
+	}
+	return ""
+}
+
+func (x *Business_OAuth_Provider) GetNameField() string {
+	if x != nil {
+		return x.NameField
+	}
+	return ""
+}
+
+func (x *Business_OAuth_Provider) GetAvatarField() string {
+	if x != nil {
+		return x.AvatarField
+	}
+	return ""
+}
+
+type Business_CodeLogin_SMS struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoUpload   string                 `protobuf:"bytes,1,opt,name=video_upload,json=videoUpload,proto3" json:"video_upload,omitempty"`
-	VideoProcess  string                 `protobuf:"bytes,2,opt,name=video_process,json=videoProcess,proto3" json:"video_process,omitempty"`
-	VideoStats    string                 `protobuf:"bytes,3,opt,name=video_stats,json=videoStats,proto3" json:"video_stats,omitempty"`
-	UserAction    string                 `protobuf:"bytes,4,opt,name=user_action,json=userAction,proto3" json:"user_action,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`           // 短信网关地址，验证码以 JSON {"phone","code"} POST 发送；为空时只写日志
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // 以 Bearer 方式携带
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`             // 默认5秒
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Business_KafkaTopics) Reset() {
-	*x = Business_KafkaTopics{}
-	mi := &file_conf_conf_proto_msgTypes[17]
+func (x *Business_CodeLogin_SMS) Reset() {
+	*x = Business_CodeLogin_SMS{}
+	mi := &file_conf_conf_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Business_KafkaTopics) String() string {
+func (x *Business_CodeLogin_SMS) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Business_KafkaTopics) ProtoMessage() {}
+func (*Business_CodeLogin_SMS) ProtoMessage() {}
 
-func (x *Business_KafkaTopics) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[17]
+func (x *Business_CodeLogin_SMS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,37 +4946,30 @@ func (x *Business_KafkaTopics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Business_KafkaTopics.ProtoReflect.Descriptor instead.
-func (*Business_KafkaTopics) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4, 3}
-}
-
-func (x *Business_KafkaTopics) GetVideoUpload() string {
-	if x != nil {
-		return x.VideoUpload
-	}
-	return ""
+// Deprecated: Use Business_CodeLogin_SMS.ProtoReflect.Descriptor instead.
+func (*Business_CodeLogin_SMS) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 38, 0}
 }
 
-func (x *Business_KafkaTopics) GetVideoProcess() string {
+func (x *Business_CodeLogin_SMS) GetEndpoint() string {
 	if x != nil {
-		return x.VideoProcess
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *Business_KafkaTopics) GetVideoStats() string {
+func (x *Business_CodeLogin_SMS) GetApiKey() string {
 	if x != nil {
-		return x.VideoStats
+		return x.ApiKey
 	}
 	return ""
 }
 
-func (x *Business_KafkaTopics) GetUserAction() string {
+func (x *Business_CodeLogin_SMS) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.UserAction
+		return x.Timeout
 	}
-	return ""
+	return nil
 }
 
 var File_conf_conf_proto protoreflect.FileDescriptor
@@ -1363,13 +4993,17 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb3\r\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xe3\x14\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12,\n" +
 	"\x05minio\x18\x03 \x01(\v2\x16.kratos.api.Data.MinIOR\x05minio\x12,\n" +
 	"\x05qiniu\x18\x04 \x01(\v2\x16.kratos.api.Data.QiniuR\x05qiniu\x12,\n" +
-	"\x05kafka\x18\x05 \x01(\v2\x16.kratos.api.Data.KafkaR\x05kafka\x1a\xcd\x01\n" +
+	"\x05kafka\x18\x05 \x01(\v2\x16.kratos.api.Data.KafkaR\x05kafka\x12%\n" +
+	"\x0estorage_driver\x18\x06 \x01(\tR\rstorageDriver\x12#\n" +
+	"\x02s3\x18\a \x01(\v2\x13.kratos.api.Data.S3R\x02s3\x12,\n" +
+	"\x05local\x18\b \x01(\v2\x16.kratos.api.Data.LocalR\x05local\x12&\n" +
+	"\x03cdn\x18\t \x01(\v2\x14.kratos.api.Data.CDNR\x03cdn\x1a\xcd\x01\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
@@ -1383,7 +5017,7 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\fdial_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12<\n" +
 	"\fread_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1b\n" +
-	"\tpool_size\x18\a \x01(\x05R\bpoolSize\x1a\xce\x01\n" +
+	"\tpool_size\x18\a \x01(\x05R\bpoolSize\x1a\xc6\x03\n" +
 	"\x05MinIO\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1d\n" +
 	"\n" +
@@ -1394,7 +5028,15 @@ const file_conf_conf_proto_rawDesc = "" +
 	"bucketName\x12\x16\n" +
 	"\x06region\x18\x05 \x01(\tR\x06region\x12\x17\n" +
 	"\ause_ssl\x18\x06 \x01(\bR\x06useSsl\x12\x19\n" +
-	"\bbase_url\x18\a \x01(\tR\abaseUrl\x1a\xd2\x01\n" +
+	"\bbase_url\x18\a \x01(\tR\abaseUrl\x12=\n" +
+	"\abuckets\x18\b \x03(\v2#.kratos.api.Data.MinIO.BucketsEntryR\abuckets\x1aY\n" +
+	"\fBucketsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.kratos.api.Data.MinIO.BucketR\x05value:\x028\x01\x1a\\\n" +
+	"\x06Bucket\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12#\n" +
+	"\rstorage_class\x18\x03 \x01(\tR\fstorageClass\x1a\xd2\x01\n" +
 	"\x05Qiniu\x12\x1d\n" +
 	"\n" +
 	"access_key\x18\x01 \x01(\tR\taccessKey\x12\x1d\n" +
@@ -1406,7 +5048,29 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1b\n" +
 	"\tuse_https\x18\x06 \x01(\bR\buseHttps\x12\x1d\n" +
 	"\n" +
-	"record_dir\x18\a \x01(\tR\trecordDir\x1a\xa2\x04\n" +
+	"record_dir\x18\a \x01(\tR\trecordDir\x1a\xc9\x02\n" +
+	"\x02S3\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1f\n" +
+	"\vbucket_name\x18\x02 \x01(\tR\n" +
+	"bucketName\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x03 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x04 \x01(\tR\tsecretKey\x12\x1a\n" +
+	"\bendpoint\x18\x05 \x01(\tR\bendpoint\x12\x19\n" +
+	"\bbase_url\x18\x06 \x01(\tR\abaseUrl\x12:\n" +
+	"\abuckets\x18\a \x03(\v2 .kratos.api.Data.S3.BucketsEntryR\abuckets\x1aY\n" +
+	"\fBucketsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.kratos.api.Data.MinIO.BucketR\x05value:\x028\x01\x1a=\n" +
+	"\x05Local\x12\x19\n" +
+	"\broot_dir\x18\x01 \x01(\tR\arootDir\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x1a\x88\x01\n" +
+	"\x03CDN\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x18\n" +
+	"\aorigins\x18\x02 \x03(\tR\aorigins\x12\x19\n" +
+	"\bsign_key\x18\x03 \x01(\tR\asignKey\x124\n" +
+	"\bsign_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\asignTtl\x1a\xa2\x04\n" +
 	"\x05Kafka\x12\x18\n" +
 	"\abrokers\x18\x01 \x03(\tR\abrokers\x12;\n" +
 	"\bproducer\x18\x02 \x01(\v2\x1f.kratos.api.Data.Kafka.ProducerR\bproducer\x12;\n" +
@@ -1423,23 +5087,75 @@ const file_conf_conf_proto_rawDesc = "" +
 	"autoCommit\x12B\n" +
 	"\x0fsession_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0esessionTimeout\x12&\n" +
 	"\x0ffetch_min_bytes\x18\x04 \x01(\x05R\rfetchMinBytes\x12?\n" +
-	"\x0efetch_max_wait\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\ffetchMaxWait\"Y\n" +
+	"\x0efetch_max_wait\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\ffetchMaxWait\"\x9f\x02\n" +
 	"\x03JWT\x12\x16\n" +
 	"\x06secret\x18\x01 \x01(\tR\x06secret\x12:\n" +
 	"\vexpire_time\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
-	"expireTime\"\xa8\n" +
-	"\n" +
+	"expireTime\x12'\n" +
+	"\x04keys\x18\x03 \x03(\v2\x13.kratos.api.JWT.KeyR\x04keys\x12$\n" +
+	"\x0ecurrent_key_id\x18\x04 \x01(\tR\fcurrentKeyId\x1au\n" +
+	"\x03Key\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1c\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x12(\n" +
+	"\x10private_key_file\x18\x04 \x01(\tR\x0eprivateKeyFile\"\xb5_\n" +
 	"\bBusiness\x12-\n" +
 	"\x04user\x18\x01 \x01(\v2\x19.kratos.api.Business.UserR\x04user\x120\n" +
 	"\x05video\x18\x02 \x01(\v2\x1a.kratos.api.Business.VideoR\x05video\x126\n" +
 	"\astorage\x18\x03 \x01(\v2\x1c.kratos.api.Business.StorageR\astorage\x12C\n" +
-	"\fkafka_topics\x18\x04 \x01(\v2 .kratos.api.Business.KafkaTopicsR\vkafkaTopics\x1a\xf8\x01\n" +
+	"\fkafka_topics\x18\x04 \x01(\v2 .kratos.api.Business.KafkaTopicsR\vkafkaTopics\x12<\n" +
+	"\tretention\x18\x05 \x01(\v2\x1e.kratos.api.Business.RetentionR\tretention\x12-\n" +
+	"\x04rbac\x18\x06 \x01(\v2\x19.kratos.api.Business.RbacR\x04rbac\x12C\n" +
+	"\ffeed_ranking\x18\a \x01(\v2 .kratos.api.Business.FeedRankingR\vfeedRanking\x12E\n" +
+	"\fregistration\x18\b \x01(\v2!.kratos.api.Business.RegistrationR\fregistration\x12O\n" +
+	"\x10permission_audit\x18\t \x01(\v2$.kratos.api.Business.PermissionAuditR\x0fpermissionAudit\x120\n" +
+	"\x05share\x18\n" +
+	" \x01(\v2\x1a.kratos.api.Business.ShareR\x05share\x129\n" +
+	"\breferral\x18\v \x01(\v2\x1d.kratos.api.Business.ReferralR\breferral\x129\n" +
+	"\bcalendar\x18\f \x01(\v2\x1d.kratos.api.Business.CalendarR\bcalendar\x12F\n" +
+	"\rwatch_history\x18\r \x01(\v2!.kratos.api.Business.WatchHistoryR\fwatchHistory\x123\n" +
+	"\x06outbox\x18\x0e \x01(\v2\x1b.kratos.api.Business.OutboxR\x06outbox\x12:\n" +
+	"\tevent_bus\x18\x0f \x01(\v2\x1d.kratos.api.Business.EventBusR\beventBus\x12O\n" +
+	"\x10account_deletion\x18\x10 \x01(\v2$.kratos.api.Business.AccountDeletionR\x0faccountDeletion\x12L\n" +
+	"\x0fcomment_folding\x18\x11 \x01(\v2#.kratos.api.Business.CommentFoldingR\x0ecommentFolding\x12I\n" +
+	"\x0econsumer_retry\x18\x12 \x01(\v2\".kratos.api.Business.ConsumerRetryR\rconsumerRetry\x129\n" +
+	"\bcallback\x18\x13 \x01(\v2\x1d.kratos.api.Business.CallbackR\bcallback\x120\n" +
+	"\x05quota\x18\x14 \x01(\v2\x1a.kratos.api.Business.QuotaR\x05quota\x12R\n" +
+	"\x11counter_reconcile\x18\x15 \x01(\v2%.kratos.api.Business.CounterReconcileR\x10counterReconcile\x12L\n" +
+	"\x0fintegrity_check\x18\x16 \x01(\v2#.kratos.api.Business.IntegrityCheckR\x0eintegrityCheck\x12<\n" +
+	"\tpromotion\x18\x17 \x01(\v2\x1e.kratos.api.Business.PromotionR\tpromotion\x12B\n" +
+	"\vdegradation\x18\x18 \x01(\v2 .kratos.api.Business.DegradationR\vdegradation\x129\n" +
+	"\bshutdown\x18\x19 \x01(\v2\x1d.kratos.api.Business.ShutdownR\bshutdown\x12R\n" +
+	"\x11event_idempotency\x18\x1a \x01(\v2%.kratos.api.Business.EventIdempotencyR\x10eventIdempotency\x12=\n" +
+	"\n" +
+	"feed_cache\x18\x1b \x01(\v2\x1e.kratos.api.Business.FeedCacheR\tfeedCache\x12@\n" +
+	"\vvideo_stats\x18\x1c \x01(\v2\x1f.kratos.api.Business.VideoStatsR\n" +
+	"videoStats\x12=\n" +
+	"\n" +
+	"play_count\x18\x1d \x01(\v2\x1e.kratos.api.Business.PlayCountR\tplayCount\x129\n" +
+	"\btrending\x18\x1e \x01(\v2\x1d.kratos.api.Business.TrendingR\btrending\x12?\n" +
+	"\n" +
+	"moderation\x18\x1f \x01(\v2\x1f.kratos.api.Business.ModerationR\n" +
+	"moderation\x12C\n" +
+	"\fsigning_keys\x18  \x01(\v2 .kratos.api.Business.SigningKeysR\vsigningKeys\x12F\n" +
+	"\rlogin_anomaly\x18! \x01(\v2!.kratos.api.Business.LoginAnomalyR\floginAnomaly\x120\n" +
+	"\x05oauth\x18\" \x01(\v2\x1a.kratos.api.Business.OAuthR\x05oauth\x12=\n" +
+	"\n" +
+	"code_login\x18# \x01(\v2\x1e.kratos.api.Business.CodeLoginR\tcodeLogin\x12L\n" +
+	"\x0fstorage_cleanup\x18$ \x01(\v2#.kratos.api.Business.StorageCleanupR\x0estorageCleanup\x129\n" +
+	"\bplaylist\x18% \x01(\v2\x1d.kratos.api.Business.PlaylistR\bplaylist\x12I\n" +
+	"\x0eorphan_cleanup\x18& \x01(\v2\".kratos.api.Business.OrphanCleanupR\rorphanCleanup\x12@\n" +
+	"\vupload_scan\x18' \x01(\v2\x1f.kratos.api.Business.UploadScanR\n" +
+	"uploadScan\x1a\xf1\x02\n" +
 	"\x04User\x120\n" +
 	"\x14password_salt_length\x18\x01 \x01(\x05R\x12passwordSaltLength\x12.\n" +
 	"\x13username_min_length\x18\x02 \x01(\x05R\x11usernameMinLength\x12.\n" +
 	"\x13username_max_length\x18\x03 \x01(\x05R\x11usernameMaxLength\x12.\n" +
 	"\x13password_min_length\x18\x04 \x01(\x05R\x11passwordMinLength\x12.\n" +
-	"\x13password_max_length\x18\x05 \x01(\x05R\x11passwordMaxLength\x1a\xb4\x02\n" +
+	"\x13password_max_length\x18\x05 \x01(\x05R\x11passwordMaxLength\x12,\n" +
+	"\x12max_login_attempts\x18\x06 \x01(\x05R\x10maxLoginAttempts\x12I\n" +
+	"\x13login_lock_duration\x18\a \x01(\v2\x19.google.protobuf.DurationR\x11loginLockDuration\x1a\x97\n" +
+	"\n" +
 	"\x05Video\x12\"\n" +
 	"\rmax_file_size\x18\x01 \x01(\x03R\vmaxFileSize\x12(\n" +
 	"\x10max_title_length\x18\x02 \x01(\x05R\x0emaxTitleLength\x12,\n" +
@@ -1449,21 +5165,273 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\vcover_width\x18\x06 \x01(\x05R\n" +
 	"coverWidth\x12!\n" +
 	"\fcover_height\x18\a \x01(\x05R\vcoverHeight\x12\x19\n" +
-	"\btemp_dir\x18\b \x01(\tR\atempDir\x1a\xf1\x02\n" +
+	"\btemp_dir\x18\b \x01(\tR\atempDir\x12%\n" +
+	"\x0erequire_review\x18\t \x01(\bR\rrequireReview\x12W\n" +
+	"\x1ascheduled_publish_interval\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x18scheduledPublishInterval\x12G\n" +
+	"\x12max_schedule_ahead\x18\v \x01(\v2\x19.google.protobuf.DurationR\x10maxScheduleAhead\x12!\n" +
+	"\fcover_format\x18\f \x01(\tR\vcoverFormat\x12L\n" +
+	"\rdynamic_cover\x18\r \x01(\v2'.kratos.api.Business.Video.DynamicCoverR\fdynamicCover\x12^\n" +
+	"\x13audio_normalization\x18\x0e \x01(\v2-.kratos.api.Business.Video.AudioNormalizationR\x12audioNormalization\x12<\n" +
+	"\fmax_duration\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\vmaxDuration\x12%\n" +
+	"\x0emin_resolution\x18\x10 \x01(\x05R\rminResolution\x12%\n" +
+	"\x0emax_resolution\x18\x11 \x01(\x05R\rmaxResolution\x1a\xf7\x01\n" +
+	"\fDynamicCover\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12<\n" +
+	"\fstart_offset\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vstartOffset\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x10\n" +
+	"\x03fps\x18\x06 \x01(\x05R\x03fps\x12\x18\n" +
+	"\aquality\x18\a \x01(\x05R\aquality\x1a\xc0\x01\n" +
+	"\x12AudioNormalization\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12'\n" +
+	"\x0ftarget_loudness\x18\x02 \x01(\x01R\x0etargetLoudness\x12\x1b\n" +
+	"\ttrue_peak\x18\x03 \x01(\x01R\btruePeak\x12%\n" +
+	"\x0eloudness_range\x18\x04 \x01(\x01R\rloudnessRange\x12#\n" +
+	"\raudio_bitrate\x18\x05 \x01(\tR\faudioBitrate\x1a\xf1\x02\n" +
 	"\aStorage\x12@\n" +
 	"\x0eupload_timeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\ruploadTimeout\x12D\n" +
 	"\x10download_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fdownloadTimeout\x12K\n" +
 	"\x14presigned_url_expire\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x12presignedUrlExpire\x12)\n" +
 	"\x10default_provider\x18\x04 \x01(\tR\x0fdefaultProvider\x120\n" +
 	"\x14multipart_chunk_size\x18\x05 \x01(\x03R\x12multipartChunkSize\x124\n" +
-	"\x16max_concurrent_uploads\x18\x06 \x01(\x05R\x14maxConcurrentUploads\x1a\x97\x01\n" +
+	"\x16max_concurrent_uploads\x18\x06 \x01(\x05R\x14maxConcurrentUploads\x1a\x88\x05\n" +
 	"\vKafkaTopics\x12!\n" +
 	"\fvideo_upload\x18\x01 \x01(\tR\vvideoUpload\x12#\n" +
 	"\rvideo_process\x18\x02 \x01(\tR\fvideoProcess\x12\x1f\n" +
 	"\vvideo_stats\x18\x03 \x01(\tR\n" +
 	"videoStats\x12\x1f\n" +
 	"\vuser_action\x18\x04 \x01(\tR\n" +
-	"userActionB\x1fZ\x1dgo-backend/internal/conf;confb\x06proto3"
+	"userAction\x12\x1f\n" +
+	"\vdead_letter\x18\x05 \x01(\tR\n" +
+	"deadLetter\x12A\n" +
+	"\bdefaults\x18\x06 \x01(\v2%.kratos.api.Business.KafkaTopics.SpecR\bdefaults\x12M\n" +
+	"\toverrides\x18\a \x03(\v2/.kratos.api.Business.KafkaTopics.OverridesEntryR\toverrides\x12,\n" +
+	"\x12provision_on_start\x18\b \x01(\bR\x10provisionOnStart\x1a\xa8\x01\n" +
+	"\x04Spec\x12\x1e\n" +
+	"\n" +
+	"partitions\x18\x01 \x01(\x05R\n" +
+	"partitions\x12-\n" +
+	"\x12replication_factor\x18\x02 \x01(\x05R\x11replicationFactor\x127\n" +
+	"\tretention\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12\x18\n" +
+	"\acompact\x18\x04 \x01(\bR\acompact\x1ac\n" +
+	"\x0eOverridesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12;\n" +
+	"\x05value\x18\x02 \x01(\v2%.kratos.api.Business.KafkaTopics.SpecR\x05value:\x028\x01\x1a\x98\x03\n" +
+	"\tRetention\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12A\n" +
+	"\bpolicies\x18\x05 \x03(\v2%.kratos.api.Business.Retention.PolicyR\bpolicies\x1a\xbe\x01\n" +
+	"\x06Policy\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x1f\n" +
+	"\vtime_column\x18\x03 \x01(\tR\n" +
+	"timeColumn\x122\n" +
+	"\amax_age\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x12\x1c\n" +
+	"\tcondition\x18\x05 \x01(\tR\tcondition\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x1a\xa5\x01\n" +
+	"\x04Rbac\x12D\n" +
+	"\x10refresh_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x12W\n" +
+	"\x1aconsistency_check_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x18consistencyCheckInterval\x1a\xf0\x02\n" +
+	"\vFeedRanking\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12'\n" +
+	"\x0ffavorite_weight\x18\x02 \x01(\x01R\x0efavoriteWeight\x12%\n" +
+	"\x0ecomment_weight\x18\x03 \x01(\x01R\rcommentWeight\x12\x1f\n" +
+	"\vplay_weight\x18\x04 \x01(\x01R\n" +
+	"playWeight\x126\n" +
+	"\thalf_life\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bhalfLife\x12.\n" +
+	"\x13candidate_pool_size\x18\x06 \x01(\x05R\x11candidatePoolSize\x12D\n" +
+	"\x10candidate_window\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0fcandidateWindow\x12(\n" +
+	"\x10max_category_run\x18\b \x01(\x05R\x0emaxCategoryRun\x1aj\n" +
+	"\fRegistration\x12$\n" +
+	"\x0edaily_ip_limit\x18\x01 \x01(\x05R\fdailyIpLimit\x124\n" +
+	"\x16contact_required_after\x18\x02 \x01(\x05R\x14contactRequiredAfter\x1a\xa7\x01\n" +
+	"\x0fPermissionAudit\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vsample_rate\x18\x02 \x01(\x01R\n" +
+	"sampleRate\x12\x19\n" +
+	"\bmax_rows\x18\x03 \x01(\x03R\amaxRows\x12>\n" +
+	"\rtrim_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\ftrimInterval\x1a\xa8\x01\n" +
+	"\bReferral\x120\n" +
+	"\x14device_cluster_limit\x18\x01 \x01(\x05R\x12deviceClusterLimit\x12(\n" +
+	"\x10ip_cluster_limit\x18\x02 \x01(\x05R\x0eipClusterLimit\x12@\n" +
+	"\x0ecluster_window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rclusterWindow\x1a\xd5\x01\n" +
+	"\bCalendar\x12\x1d\n" +
+	"\n" +
+	"max_drafts\x18\x01 \x01(\x05R\tmaxDrafts\x122\n" +
+	"\amin_gap\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06minGap\x12F\n" +
+	"\x11reminder_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x10reminderInterval\x12.\n" +
+	"\x13reminder_batch_size\x18\x04 \x01(\x05R\x11reminderBatchSize\x1aL\n" +
+	"\fWatchHistory\x12<\n" +
+	"\fdedup_window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vdedupWindow\x1a\xc2\x02\n" +
+	"\x06Outbox\x12>\n" +
+	"\rpoll_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x12>\n" +
+	"\rretry_backoff\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fretryBackoff\x12:\n" +
+	"\vmax_backoff\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x12:\n" +
+	"\vclaim_lease\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"claimLease\x1aC\n" +
+	"\bEventBus\x12\x18\n" +
+	"\aworkers\x18\x01 \x01(\x05R\aworkers\x12\x1d\n" +
+	"\n" +
+	"queue_size\x18\x02 \x01(\x05R\tqueueSize\x1a\x98\x02\n" +
+	"\x0fAccountDeletion\x12<\n" +
+	"\fgrace_period\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12@\n" +
+	"\x0epurge_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rpurgeInterval\x12A\n" +
+	"\x0fexport_link_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rexportLinkTtl\x12B\n" +
+	"\x0fexport_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0eexportInterval\x1af\n" +
+	"\x0eStorageCleanup\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x1a\xdc\x01\n" +
+	"\rOrphanCleanup\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12<\n" +
+	"\fgrace_period\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12#\n" +
+	"\rmax_deletions\x18\x05 \x01(\x05R\fmaxDeletions\x1a\x8a\x01\n" +
+	"\n" +
+	"UploadScan\x12%\n" +
+	"\x0eclamav_address\x18\x01 \x01(\tR\rclamavAddress\x12 \n" +
+	"\venforcement\x18\x02 \x01(\tR\venforcement\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1aN\n" +
+	"\bPlaylist\x12#\n" +
+	"\rmax_playlists\x18\x01 \x01(\x05R\fmaxPlaylists\x12\x1d\n" +
+	"\n" +
+	"max_videos\x18\x02 \x01(\x05R\tmaxVideos\x1a\xa6\x02\n" +
+	"\x0eCommentFolding\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12-\n" +
+	"\x12collapse_threshold\x18\x02 \x01(\x01R\x11collapseThreshold\x12\x1f\n" +
+	"\vlike_weight\x18\x03 \x01(\x01R\n" +
+	"likeWeight\x12!\n" +
+	"\freply_weight\x18\x04 \x01(\x01R\vreplyWeight\x120\n" +
+	"\x14short_content_length\x18\x05 \x01(\x05R\x12shortContentLength\x122\n" +
+	"\x15short_content_penalty\x18\x06 \x01(\x01R\x13shortContentPenalty\x12!\n" +
+	"\flink_penalty\x18\a \x01(\x01R\vlinkPenalty\x1a\xb2\x01\n" +
+	"\rConsumerRetry\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12B\n" +
+	"\x0finitial_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0einitialBackoff\x12:\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x1a\xdb\x01\n" +
+	"\bCallback\x128\n" +
+	"\n" +
+	"clock_skew\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tclockSkew\x12>\n" +
+	"\asources\x18\x02 \x03(\v2$.kratos.api.Business.Callback.SourceR\asources\x1aU\n" +
+	"\x06Source\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vpath_prefix\x18\x02 \x01(\tR\n" +
+	"pathPrefix\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x1aZ\n" +
+	"\x05Quota\x12,\n" +
+	"\x12daily_upload_limit\x18\x01 \x01(\x05R\x10dailyUploadLimit\x12#\n" +
+	"\rstorage_limit\x18\x02 \x01(\x03R\fstorageLimit\x1a\xbc\x01\n" +
+	"\x10CounterReconcile\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12\x1f\n" +
+	"\vmax_batches\x18\x04 \x01(\x05R\n" +
+	"maxBatches\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x1a\xa9\x01\n" +
+	"\x0eIntegrityCheck\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1f\n" +
+	"\vsample_size\x18\x03 \x01(\x05R\n" +
+	"sampleSize\x12%\n" +
+	"\x0everify_content\x18\x04 \x01(\bR\rverifyContent\x1a;\n" +
+	"\tPromotion\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05slots\x18\x02 \x03(\x05R\x05slots\x1a\x81\x03\n" +
+	"\vDegradation\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x14\n" +
+	"\x05order\x18\x03 \x03(\tR\x05order\x12!\n" +
+	"\fmax_inflight\x18\x04 \x01(\x03R\vmaxInflight\x12$\n" +
+	"\x0emax_error_rate\x18\x05 \x01(\x01R\fmaxErrorRate\x12!\n" +
+	"\fmin_requests\x18\x06 \x01(\x03R\vminRequests\x12:\n" +
+	"\vmax_latency\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxLatency\x12>\n" +
+	"\rprobe_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\fprobeTimeout\x12#\n" +
+	"\rrecover_after\x18\t \x01(\x05R\frecoverAfter\x1aJ\n" +
+	"\bShutdown\x12>\n" +
+	"\rdrain_timeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fdrainTimeout\x1a\x80\x01\n" +
+	"\x10EventIdempotency\x124\n" +
+	"\block_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x126\n" +
+	"\tcache_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bcacheTtl\x1a\xc2\x01\n" +
+	"\tFeedCache\x121\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06bucket\x124\n" +
+	"\bsoft_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\asoftTtl\x124\n" +
+	"\bhard_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\ahardTtl\x12\x16\n" +
+	"\x06window\x18\x04 \x01(\x05R\x06window\x1a\x9b\x01\n" +
+	"\n" +
+	"VideoStats\x12!\n" +
+	"\fwrite_behind\x18\x01 \x01(\bR\vwriteBehind\x12@\n" +
+	"\x0eflush_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\x12(\n" +
+	"\x10flush_batch_size\x18\x03 \x01(\x05R\x0eflushBatchSize\x1a\x81\x01\n" +
+	"\tPlayCount\x12<\n" +
+	"\fdedup_window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vdedupWindow\x126\n" +
+	"\tmin_watch\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bminWatch\x1a\xcc\x01\n" +
+	"\bTrending\x121\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06bucket\x12\x16\n" +
+	"\x06window\x18\x02 \x01(\x05R\x06window\x12\x14\n" +
+	"\x05decay\x18\x03 \x01(\x01R\x05decay\x12D\n" +
+	"\x10refresh_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x12\x19\n" +
+	"\bmax_size\x18\x05 \x01(\x05R\amaxSize\x1a\xfd\x01\n" +
+	"\n" +
+	"Moderation\x12\x1f\n" +
+	"\vblock_words\x18\x01 \x03(\tR\n" +
+	"blockWords\x12!\n" +
+	"\freview_words\x18\x02 \x03(\tR\vreviewWords\x12B\n" +
+	"\x0freload_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\x12!\n" +
+	"\fexternal_url\x18\x04 \x01(\tR\vexternalUrl\x12D\n" +
+	"\x10external_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fexternalTimeout\x1a\xb7\x01\n" +
+	"\vSigningKeys\x12D\n" +
+	"\x10refresh_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x12D\n" +
+	"\x10activation_delay\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0factivationDelay\x12\x1c\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x1a\"\n" +
+	"\x05Share\x12\x19\n" +
+	"\bbase_url\x18\x01 \x01(\tR\abaseUrl\x1a\xc2\x01\n" +
+	"\fLoginAnomaly\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12@\n" +
+	"\x0ehistory_window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rhistoryWindow\x12>\n" +
+	"\rchallenge_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fchallengeTtl\x1a\xde\x03\n" +
+	"\x05OAuth\x12A\n" +
+	"\tproviders\x18\x01 \x03(\v2#.kratos.api.Business.OAuth.ProviderR\tproviders\x1a\x91\x03\n" +
+	"\bProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\x12!\n" +
+	"\fredirect_uri\x18\x05 \x01(\tR\vredirectUri\x12\x1b\n" +
+	"\ttoken_url\x18\x06 \x01(\tR\btokenUrl\x12!\n" +
+	"\fuserinfo_url\x18\a \x01(\tR\vuserinfoUrl\x12#\n" +
+	"\rsubject_field\x18\b \x01(\tR\fsubjectField\x12\x1f\n" +
+	"\vemail_field\x18\t \x01(\tR\n" +
+	"emailField\x120\n" +
+	"\x14email_verified_field\x18\n" +
+	" \x01(\tR\x12emailVerifiedField\x12\x1d\n" +
+	"\n" +
+	"name_field\x18\v \x01(\tR\tnameField\x12!\n" +
+	"\favatar_field\x18\f \x01(\tR\vavatarField\x1a\x85\x03\n" +
+	"\tCodeLogin\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\x124\n" +
+	"\bcode_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\acodeTtl\x12B\n" +
+	"\x0fresend_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0eresendInterval\x12!\n" +
+	"\fmax_attempts\x18\x05 \x01(\x05R\vmaxAttempts\x124\n" +
+	"\x03sms\x18\x06 \x01(\v2\".kratos.api.Business.CodeLogin.SMSR\x03sms\x1ao\n" +
+	"\x03SMS\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeoutB\x1fZ\x1dgo-backend/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -1477,63 +5445,224 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),            // 0: kratos.api.Bootstrap
-	(*Server)(nil),               // 1: kratos.api.Server
-	(*Data)(nil),                 // 2: kratos.api.Data
-	(*JWT)(nil),                  // 3: kratos.api.JWT
-	(*Business)(nil),             // 4: kratos.api.Business
-	(*Server_HTTP)(nil),          // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),          // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil),        // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),           // 8: kratos.api.Data.Redis
-	(*Data_MinIO)(nil),           // 9: kratos.api.Data.MinIO
-	(*Data_Qiniu)(nil),           // 10: kratos.api.Data.Qiniu
-	(*Data_Kafka)(nil),           // 11: kratos.api.Data.Kafka
-	(*Data_Kafka_Producer)(nil),  // 12: kratos.api.Data.Kafka.Producer
-	(*Data_Kafka_Consumer)(nil),  // 13: kratos.api.Data.Kafka.Consumer
-	(*Business_User)(nil),        // 14: kratos.api.Business.User
-	(*Business_Video)(nil),       // 15: kratos.api.Business.Video
-	(*Business_Storage)(nil),     // 16: kratos.api.Business.Storage
-	(*Business_KafkaTopics)(nil), // 17: kratos.api.Business.KafkaTopics
-	(*durationpb.Duration)(nil),  // 18: google.protobuf.Duration
+	(*Bootstrap)(nil),                         // 0: kratos.api.Bootstrap
+	(*Server)(nil),                            // 1: kratos.api.Server
+	(*Data)(nil),                              // 2: kratos.api.Data
+	(*JWT)(nil),                               // 3: kratos.api.JWT
+	(*Business)(nil),                          // 4: kratos.api.Business
+	(*Server_HTTP)(nil),                       // 5: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),                       // 6: kratos.api.Server.GRPC
+	(*Data_Database)(nil),                     // 7: kratos.api.Data.Database
+	(*Data_Redis)(nil),                        // 8: kratos.api.Data.Redis
+	(*Data_MinIO)(nil),                        // 9: kratos.api.Data.MinIO
+	(*Data_Qiniu)(nil),                        // 10: kratos.api.Data.Qiniu
+	(*Data_S3)(nil),                           // 11: kratos.api.Data.S3
+	(*Data_Local)(nil),                        // 12: kratos.api.Data.Local
+	(*Data_CDN)(nil),                          // 13: kratos.api.Data.CDN
+	(*Data_Kafka)(nil),                        // 14: kratos.api.Data.Kafka
+	nil,                                       // 15: kratos.api.Data.MinIO.BucketsEntry
+	(*Data_MinIO_Bucket)(nil),                 // 16: kratos.api.Data.MinIO.Bucket
+	nil,                                       // 17: kratos.api.Data.S3.BucketsEntry
+	(*Data_Kafka_Producer)(nil),               // 18: kratos.api.Data.Kafka.Producer
+	(*Data_Kafka_Consumer)(nil),               // 19: kratos.api.Data.Kafka.Consumer
+	(*JWT_Key)(nil),                           // 20: kratos.api.JWT.Key
+	(*Business_User)(nil),                     // 21: kratos.api.Business.User
+	(*Business_Video)(nil),                    // 22: kratos.api.Business.Video
+	(*Business_Storage)(nil),                  // 23: kratos.api.Business.Storage
+	(*Business_KafkaTopics)(nil),              // 24: kratos.api.Business.KafkaTopics
+	(*Business_Retention)(nil),                // 25: kratos.api.Business.Retention
+	(*Business_Rbac)(nil),                     // 26: kratos.api.Business.Rbac
+	(*Business_FeedRanking)(nil),              // 27: kratos.api.Business.FeedRanking
+	(*Business_Registration)(nil),             // 28: kratos.api.Business.Registration
+	(*Business_PermissionAudit)(nil),          // 29: kratos.api.Business.PermissionAudit
+	(*Business_Referral)(nil),                 // 30: kratos.api.Business.Referral
+	(*Business_Calendar)(nil),                 // 31: kratos.api.Business.Calendar
+	(*Business_WatchHistory)(nil),             // 32: kratos.api.Business.WatchHistory
+	(*Business_Outbox)(nil),                   // 33: kratos.api.Business.Outbox
+	(*Business_EventBus)(nil),                 // 34: kratos.api.Business.EventBus
+	(*Business_AccountDeletion)(nil),          // 35: kratos.api.Business.AccountDeletion
+	(*Business_StorageCleanup)(nil),           // 36: kratos.api.Business.StorageCleanup
+	(*Business_OrphanCleanup)(nil),            // 37: kratos.api.Business.OrphanCleanup
+	(*Business_UploadScan)(nil),               // 38: kratos.api.Business.UploadScan
+	(*Business_Playlist)(nil),                 // 39: kratos.api.Business.Playlist
+	(*Business_CommentFolding)(nil),           // 40: kratos.api.Business.CommentFolding
+	(*Business_ConsumerRetry)(nil),            // 41: kratos.api.Business.ConsumerRetry
+	(*Business_Callback)(nil),                 // 42: kratos.api.Business.Callback
+	(*Business_Quota)(nil),                    // 43: kratos.api.Business.Quota
+	(*Business_CounterReconcile)(nil),         // 44: kratos.api.Business.CounterReconcile
+	(*Business_IntegrityCheck)(nil),           // 45: kratos.api.Business.IntegrityCheck
+	(*Business_Promotion)(nil),                // 46: kratos.api.Business.Promotion
+	(*Business_Degradation)(nil),              // 47: kratos.api.Business.Degradation
+	(*Business_Shutdown)(nil),                 // 48: kratos.api.Business.Shutdown
+	(*Business_EventIdempotency)(nil),         // 49: kratos.api.Business.EventIdempotency
+	(*Business_FeedCache)(nil),                // 50: kratos.api.Business.FeedCache
+	(*Business_VideoStats)(nil),               // 51: kratos.api.Business.VideoStats
+	(*Business_PlayCount)(nil),                // 52: kratos.api.Business.PlayCount
+	(*Business_Trending)(nil),                 // 53: kratos.api.Business.Trending
+	(*Business_Moderation)(nil),               // 54: kratos.api.Business.Moderation
+	(*Business_SigningKeys)(nil),              // 55: kratos.api.Business.SigningKeys
+	(*Business_Share)(nil),                    // 56: kratos.api.Business.Share
+	(*Business_LoginAnomaly)(nil),             // 57: kratos.api.Business.LoginAnomaly
+	(*Business_OAuth)(nil),                    // 58: kratos.api.Business.OAuth
+	(*Business_CodeLogin)(nil),                // 59: kratos.api.Business.CodeLogin
+	(*Business_Video_DynamicCover)(nil),       // 60: kratos.api.Business.Video.DynamicCover
+	(*Business_Video_AudioNormalization)(nil), // 61: kratos.api.Business.Video.AudioNormalization
+	(*Business_KafkaTopics_Spec)(nil),         // 62: kratos.api.Business.KafkaTopics.Spec
+	nil,                                       // 63: kratos.api.Business.KafkaTopics.OverridesEntry
+	(*Business_Retention_Policy)(nil),         // 64: kratos.api.Business.Retention.Policy
+	(*Business_Callback_Source)(nil),          // 65: kratos.api.Business.Callback.Source
+	(*Business_OAuth_Provider)(nil),           // 66: kratos.api.Business.OAuth.Provider
+	(*Business_CodeLogin_SMS)(nil),            // 67: kratos.api.Business.CodeLogin.SMS
+	(*durationpb.Duration)(nil),               // 68: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	3,  // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.JWT
-	4,  // 3: kratos.api.Bootstrap.business:type_name -> kratos.api.Business
-	5,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	9,  // 8: kratos.api.Data.minio:type_name -> kratos.api.Data.MinIO
-	10, // 9: kratos.api.Data.qiniu:type_name -> kratos.api.Data.Qiniu
-	11, // 10: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
-	18, // 11: kratos.api.JWT.expire_time:type_name -> google.protobuf.Duration
-	14, // 12: kratos.api.Business.user:type_name -> kratos.api.Business.User
-	15, // 13: kratos.api.Business.video:type_name -> kratos.api.Business.Video
-	16, // 14: kratos.api.Business.storage:type_name -> kratos.api.Business.Storage
-	17, // 15: kratos.api.Business.kafka_topics:type_name -> kratos.api.Business.KafkaTopics
-	18, // 16: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	18, // 17: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	18, // 18: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	18, // 19: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	18, // 20: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	18, // 21: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	12, // 22: kratos.api.Data.Kafka.producer:type_name -> kratos.api.Data.Kafka.Producer
-	13, // 23: kratos.api.Data.Kafka.consumer:type_name -> kratos.api.Data.Kafka.Consumer
-	18, // 24: kratos.api.Data.Kafka.Consumer.session_timeout:type_name -> google.protobuf.Duration
-	18, // 25: kratos.api.Data.Kafka.Consumer.fetch_max_wait:type_name -> google.protobuf.Duration
-	18, // 26: kratos.api.Business.Storage.upload_timeout:type_name -> google.protobuf.Duration
-	18, // 27: kratos.api.Business.Storage.download_timeout:type_name -> google.protobuf.Duration
-	18, // 28: kratos.api.Business.Storage.presigned_url_expire:type_name -> google.protobuf.Duration
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	1,   // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	2,   // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	3,   // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.JWT
+	4,   // 3: kratos.api.Bootstrap.business:type_name -> kratos.api.Business
+	5,   // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	6,   // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	7,   // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	8,   // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	9,   // 8: kratos.api.Data.minio:type_name -> kratos.api.Data.MinIO
+	10,  // 9: kratos.api.Data.qiniu:type_name -> kratos.api.Data.Qiniu
+	14,  // 10: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
+	11,  // 11: kratos.api.Data.s3:type_name -> kratos.api.Data.S3
+	12,  // 12: kratos.api.Data.local:type_name -> kratos.api.Data.Local
+	13,  // 13: kratos.api.Data.cdn:type_name -> kratos.api.Data.CDN
+	68,  // 14: kratos.api.JWT.expire_time:type_name -> google.protobuf.Duration
+	20,  // 15: kratos.api.JWT.keys:type_name -> kratos.api.JWT.Key
+	21,  // 16: kratos.api.Business.user:type_name -> kratos.api.Business.User
+	22,  // 17: kratos.api.Business.video:type_name -> kratos.api.Business.Video
+	23,  // 18: kratos.api.Business.storage:type_name -> kratos.api.Business.Storage
+	24,  // 19: kratos.api.Business.kafka_topics:type_name -> kratos.api.Business.KafkaTopics
+	25,  // 20: kratos.api.Business.retention:type_name -> kratos.api.Business.Retention
+	26,  // 21: kratos.api.Business.rbac:type_name -> kratos.api.Business.Rbac
+	27,  // 22: kratos.api.Business.feed_ranking:type_name -> kratos.api.Business.FeedRanking
+	28,  // 23: kratos.api.Business.registration:type_name -> kratos.api.Business.Registration
+	29,  // 24: kratos.api.Business.permission_audit:type_name -> kratos.api.Business.PermissionAudit
+	56,  // 25: kratos.api.Business.share:type_name -> kratos.api.Business.Share
+	30,  // 26: kratos.api.Business.referral:type_name -> kratos.api.Business.Referral
+	31,  // 27: kratos.api.Business.calendar:type_name -> kratos.api.Business.Calendar
+	32,  // 28: kratos.api.Business.watch_history:type_name -> kratos.api.Business.WatchHistory
+	33,  // 29: kratos.api.Business.outbox:type_name -> kratos.api.Business.Outbox
+	34,  // 30: kratos.api.Business.event_bus:type_name -> kratos.api.Business.EventBus
+	35,  // 31: kratos.api.Business.account_deletion:type_name -> kratos.api.Business.AccountDeletion
+	40,  // 32: kratos.api.Business.comment_folding:type_name -> kratos.api.Business.CommentFolding
+	41,  // 33: kratos.api.Business.consumer_retry:type_name -> kratos.api.Business.ConsumerRetry
+	42,  // 34: kratos.api.Business.callback:type_name -> kratos.api.Business.Callback
+	43,  // 35: kratos.api.Business.quota:type_name -> kratos.api.Business.Quota
+	44,  // 36: kratos.api.Business.counter_reconcile:type_name -> kratos.api.Business.CounterReconcile
+	45,  // 37: kratos.api.Business.integrity_check:type_name -> kratos.api.Business.IntegrityCheck
+	46,  // 38: kratos.api.Business.promotion:type_name -> kratos.api.Business.Promotion
+	47,  // 39: kratos.api.Business.degradation:type_name -> kratos.api.Business.Degradation
+	48,  // 40: kratos.api.Business.shutdown:type_name -> kratos.api.Business.Shutdown
+	49,  // 41: kratos.api.Business.event_idempotency:type_name -> kratos.api.Business.EventIdempotency
+	50,  // 42: kratos.api.Business.feed_cache:type_name -> kratos.api.Business.FeedCache
+	51,  // 43: kratos.api.Business.video_stats:type_name -> kratos.api.Business.VideoStats
+	52,  // 44: kratos.api.Business.play_count:type_name -> kratos.api.Business.PlayCount
+	53,  // 45: kratos.api.Business.trending:type_name -> kratos.api.Business.Trending
+	54,  // 46: kratos.api.Business.moderation:type_name -> kratos.api.Business.Moderation
+	55,  // 47: kratos.api.Business.signing_keys:type_name -> kratos.api.Business.SigningKeys
+	57,  // 48: kratos.api.Business.login_anomaly:type_name -> kratos.api.Business.LoginAnomaly
+	58,  // 49: kratos.api.Business.oauth:type_name -> kratos.api.Business.OAuth
+	59,  // 50: kratos.api.Business.code_login:type_name -> kratos.api.Business.CodeLogin
+	36,  // 51: kratos.api.Business.storage_cleanup:type_name -> kratos.api.Business.StorageCleanup
+	39,  // 52: kratos.api.Business.playlist:type_name -> kratos.api.Business.Playlist
+	37,  // 53: kratos.api.Business.orphan_cleanup:type_name -> kratos.api.Business.OrphanCleanup
+	38,  // 54: kratos.api.Business.upload_scan:type_name -> kratos.api.Business.UploadScan
+	68,  // 55: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	68,  // 56: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	68,  // 57: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	68,  // 58: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	68,  // 59: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	68,  // 60: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	15,  // 61: kratos.api.Data.MinIO.buckets:type_name -> kratos.api.Data.MinIO.BucketsEntry
+	17,  // 62: kratos.api.Data.S3.buckets:type_name -> kratos.api.Data.S3.BucketsEntry
+	68,  // 63: kratos.api.Data.CDN.sign_ttl:type_name -> google.protobuf.Duration
+	18,  // 64: kratos.api.Data.Kafka.producer:type_name -> kratos.api.Data.Kafka.Producer
+	19,  // 65: kratos.api.Data.Kafka.consumer:type_name -> kratos.api.Data.Kafka.Consumer
+	16,  // 66: kratos.api.Data.MinIO.BucketsEntry.value:type_name -> kratos.api.Data.MinIO.Bucket
+	16,  // 67: kratos.api.Data.S3.BucketsEntry.value:type_name -> kratos.api.Data.MinIO.Bucket
+	68,  // 68: kratos.api.Data.Kafka.Consumer.session_timeout:type_name -> google.protobuf.Duration
+	68,  // 69: kratos.api.Data.Kafka.Consumer.fetch_max_wait:type_name -> google.protobuf.Duration
+	68,  // 70: kratos.api.Business.User.login_lock_duration:type_name -> google.protobuf.Duration
+	68,  // 71: kratos.api.Business.Video.scheduled_publish_interval:type_name -> google.protobuf.Duration
+	68,  // 72: kratos.api.Business.Video.max_schedule_ahead:type_name -> google.protobuf.Duration
+	60,  // 73: kratos.api.Business.Video.dynamic_cover:type_name -> kratos.api.Business.Video.DynamicCover
+	61,  // 74: kratos.api.Business.Video.audio_normalization:type_name -> kratos.api.Business.Video.AudioNormalization
+	68,  // 75: kratos.api.Business.Video.max_duration:type_name -> google.protobuf.Duration
+	68,  // 76: kratos.api.Business.Storage.upload_timeout:type_name -> google.protobuf.Duration
+	68,  // 77: kratos.api.Business.Storage.download_timeout:type_name -> google.protobuf.Duration
+	68,  // 78: kratos.api.Business.Storage.presigned_url_expire:type_name -> google.protobuf.Duration
+	62,  // 79: kratos.api.Business.KafkaTopics.defaults:type_name -> kratos.api.Business.KafkaTopics.Spec
+	63,  // 80: kratos.api.Business.KafkaTopics.overrides:type_name -> kratos.api.Business.KafkaTopics.OverridesEntry
+	68,  // 81: kratos.api.Business.Retention.interval:type_name -> google.protobuf.Duration
+	64,  // 82: kratos.api.Business.Retention.policies:type_name -> kratos.api.Business.Retention.Policy
+	68,  // 83: kratos.api.Business.Rbac.refresh_interval:type_name -> google.protobuf.Duration
+	68,  // 84: kratos.api.Business.Rbac.consistency_check_interval:type_name -> google.protobuf.Duration
+	68,  // 85: kratos.api.Business.FeedRanking.half_life:type_name -> google.protobuf.Duration
+	68,  // 86: kratos.api.Business.FeedRanking.candidate_window:type_name -> google.protobuf.Duration
+	68,  // 87: kratos.api.Business.PermissionAudit.trim_interval:type_name -> google.protobuf.Duration
+	68,  // 88: kratos.api.Business.Referral.cluster_window:type_name -> google.protobuf.Duration
+	68,  // 89: kratos.api.Business.Calendar.min_gap:type_name -> google.protobuf.Duration
+	68,  // 90: kratos.api.Business.Calendar.reminder_interval:type_name -> google.protobuf.Duration
+	68,  // 91: kratos.api.Business.WatchHistory.dedup_window:type_name -> google.protobuf.Duration
+	68,  // 92: kratos.api.Business.Outbox.poll_interval:type_name -> google.protobuf.Duration
+	68,  // 93: kratos.api.Business.Outbox.retry_backoff:type_name -> google.protobuf.Duration
+	68,  // 94: kratos.api.Business.Outbox.max_backoff:type_name -> google.protobuf.Duration
+	68,  // 95: kratos.api.Business.Outbox.claim_lease:type_name -> google.protobuf.Duration
+	68,  // 96: kratos.api.Business.AccountDeletion.grace_period:type_name -> google.protobuf.Duration
+	68,  // 97: kratos.api.Business.AccountDeletion.purge_interval:type_name -> google.protobuf.Duration
+	68,  // 98: kratos.api.Business.AccountDeletion.export_link_ttl:type_name -> google.protobuf.Duration
+	68,  // 99: kratos.api.Business.AccountDeletion.export_interval:type_name -> google.protobuf.Duration
+	68,  // 100: kratos.api.Business.StorageCleanup.interval:type_name -> google.protobuf.Duration
+	68,  // 101: kratos.api.Business.OrphanCleanup.interval:type_name -> google.protobuf.Duration
+	68,  // 102: kratos.api.Business.OrphanCleanup.grace_period:type_name -> google.protobuf.Duration
+	68,  // 103: kratos.api.Business.UploadScan.timeout:type_name -> google.protobuf.Duration
+	68,  // 104: kratos.api.Business.ConsumerRetry.initial_backoff:type_name -> google.protobuf.Duration
+	68,  // 105: kratos.api.Business.ConsumerRetry.max_backoff:type_name -> google.protobuf.Duration
+	68,  // 106: kratos.api.Business.Callback.clock_skew:type_name -> google.protobuf.Duration
+	65,  // 107: kratos.api.Business.Callback.sources:type_name -> kratos.api.Business.Callback.Source
+	68,  // 108: kratos.api.Business.CounterReconcile.interval:type_name -> google.protobuf.Duration
+	68,  // 109: kratos.api.Business.IntegrityCheck.interval:type_name -> google.protobuf.Duration
+	68,  // 110: kratos.api.Business.Degradation.interval:type_name -> google.protobuf.Duration
+	68,  // 111: kratos.api.Business.Degradation.max_latency:type_name -> google.protobuf.Duration
+	68,  // 112: kratos.api.Business.Degradation.probe_timeout:type_name -> google.protobuf.Duration
+	68,  // 113: kratos.api.Business.Shutdown.drain_timeout:type_name -> google.protobuf.Duration
+	68,  // 114: kratos.api.Business.EventIdempotency.lock_ttl:type_name -> google.protobuf.Duration
+	68,  // 115: kratos.api.Business.EventIdempotency.cache_ttl:type_name -> google.protobuf.Duration
+	68,  // 116: kratos.api.Business.FeedCache.bucket:type_name -> google.protobuf.Duration
+	68,  // 117: kratos.api.Business.FeedCache.soft_ttl:type_name -> google.protobuf.Duration
+	68,  // 118: kratos.api.Business.FeedCache.hard_ttl:type_name -> google.protobuf.Duration
+	68,  // 119: kratos.api.Business.VideoStats.flush_interval:type_name -> google.protobuf.Duration
+	68,  // 120: kratos.api.Business.PlayCount.dedup_window:type_name -> google.protobuf.Duration
+	68,  // 121: kratos.api.Business.PlayCount.min_watch:type_name -> google.protobuf.Duration
+	68,  // 122: kratos.api.Business.Trending.bucket:type_name -> google.protobuf.Duration
+	68,  // 123: kratos.api.Business.Trending.refresh_interval:type_name -> google.protobuf.Duration
+	68,  // 124: kratos.api.Business.Moderation.reload_interval:type_name -> google.protobuf.Duration
+	68,  // 125: kratos.api.Business.Moderation.external_timeout:type_name -> google.protobuf.Duration
+	68,  // 126: kratos.api.Business.SigningKeys.refresh_interval:type_name -> google.protobuf.Duration
+	68,  // 127: kratos.api.Business.SigningKeys.activation_delay:type_name -> google.protobuf.Duration
+	68,  // 128: kratos.api.Business.LoginAnomaly.history_window:type_name -> google.protobuf.Duration
+	68,  // 129: kratos.api.Business.LoginAnomaly.challenge_ttl:type_name -> google.protobuf.Duration
+	66,  // 130: kratos.api.Business.OAuth.providers:type_name -> kratos.api.Business.OAuth.Provider
+	68,  // 131: kratos.api.Business.CodeLogin.code_ttl:type_name -> google.protobuf.Duration
+	68,  // 132: kratos.api.Business.CodeLogin.resend_interval:type_name -> google.protobuf.Duration
+	67,  // 133: kratos.api.Business.CodeLogin.sms:type_name -> kratos.api.Business.CodeLogin.SMS
+	68,  // 134: kratos.api.Business.Video.DynamicCover.duration:type_name -> google.protobuf.Duration
+	68,  // 135: kratos.api.Business.Video.DynamicCover.start_offset:type_name -> google.protobuf.Duration
+	68,  // 136: kratos.api.Business.KafkaTopics.Spec.retention:type_name -> google.protobuf.Duration
+	62,  // 137: kratos.api.Business.KafkaTopics.OverridesEntry.value:type_name -> kratos.api.Business.KafkaTopics.Spec
+	68,  // 138: kratos.api.Business.Retention.Policy.max_age:type_name -> google.protobuf.Duration
+	68,  // 139: kratos.api.Business.CodeLogin.SMS.timeout:type_name -> google.protobuf.Duration
+	140, // [140:140] is the sub-list for method output_type
+	140, // [140:140] is the sub-list for method input_type
+	140, // [140:140] is the sub-list for extension type_name
+	140, // [140:140] is the sub-list for extension extendee
+	0,   // [0:140] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1547,7 +5676,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
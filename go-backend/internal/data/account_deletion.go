@@ -0,0 +1,283 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type accountDeletionRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	passwordMgr *auth.PasswordManager
+	log         *log.Helper
+}
+
+// NewAccountDeletionRepo .
+func NewAccountDeletionRepo(data *Data, invalidator domain.CacheInvalidationPublisher, passwordMgr *auth.PasswordManager, logger log.Logger) biz.AccountDeletionRepo {
+	return &accountDeletionRepo{
+		data:        data,
+		invalidator: invalidator,
+		passwordMgr: passwordMgr,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// MarkPendingDeletion 账号置为待注销，已发布视频和正常评论转为隐藏状态
+func (r *accountDeletionRepo) MarkPendingDeletion(ctx context.Context, deletion *biz.AccountDeletion) error {
+	var videoIDs []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&User{}).
+			Where("id = ? AND status = ?", deletion.UserID, domain.UserStatusActive).
+			Updates(map[string]interface{}{
+				"status":                domain.UserStatusPendingDeletion,
+				"deletion_requested_at": deletion.RequestedAt,
+				"deletion_scheduled_at": deletion.PurgeAt,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrUserNotFound
+		}
+
+		return swapUserContentStatus(tx, deletion.UserID,
+			domain.VideoStatusPublished, domain.VideoStatusHidden,
+			biz.CommentStatusNormal, biz.CommentStatusHidden, &videoIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	invalidateUserContent(ctx, r.invalidator, r.log, deletion.UserID, videoIDs)
+	return nil
+}
+
+// GetPendingDeletion 查找冷静期中的账号并校验密码
+func (r *accountDeletionRepo) GetPendingDeletion(ctx context.Context, username, password string) (*biz.AccountDeletion, error) {
+	var u User
+	if err := r.data.db.WithContext(ctx).
+		Where("username = ? AND status = ?", username, domain.UserStatusPendingDeletion).
+		First(&u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	isValid, err := r.passwordMgr.VerifyPassword(password, u.PasswordHash, u.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if !isValid {
+		return nil, biz.ErrPasswordError
+	}
+
+	deletion := &biz.AccountDeletion{
+		UserID:   u.ID,
+		Username: u.Username,
+	}
+	if u.DeletionRequestedAt != nil {
+		deletion.RequestedAt = *u.DeletionRequestedAt
+	}
+	if u.DeletionScheduledAt != nil {
+		deletion.PurgeAt = *u.DeletionScheduledAt
+	}
+	return deletion, nil
+}
+
+// Restore 撤销注销，恢复账号和被隐藏的内容
+func (r *accountDeletionRepo) Restore(ctx context.Context, userID int64) error {
+	var videoIDs []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&User{}).
+			Where("id = ? AND status = ?", userID, domain.UserStatusPendingDeletion).
+			Updates(map[string]interface{}{
+				"status":                domain.UserStatusActive,
+				"deletion_requested_at": nil,
+				"deletion_scheduled_at": nil,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrUserNotFound
+		}
+
+		return swapUserContentStatus(tx, userID,
+			domain.VideoStatusHidden, domain.VideoStatusPublished,
+			biz.CommentStatusHidden, biz.CommentStatusNormal, &videoIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	invalidateUserContent(ctx, r.invalidator, r.log, userID, videoIDs)
+	return nil
+}
+
+// swapUserContentStatus 切换用户视频和评论的状态，videoIDs 返回受影响的视频用于失效缓存。
+// 注销冷静期和封禁只能从正常状态进入，期间作者的视频只会是隐藏状态，用状态互换即可精确恢复，不需要记录原状态
+func swapUserContentStatus(tx *gorm.DB, userID int64, fromVideo, toVideo int32, fromComment, toComment int32, videoIDs *[]int64) error {
+	if err := tx.Model(&VideoModel{}).
+		Where("author_id = ? AND status = ?", userID, fromVideo).
+		Pluck("id", videoIDs).Error; err != nil {
+		return err
+	}
+	if len(*videoIDs) > 0 {
+		if err := tx.Model(&VideoModel{}).
+			Where("id IN ?", *videoIDs).
+			Update("status", toVideo).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(&Comment{}).
+		Where("user_id = ? AND status = ?", userID, fromComment).
+		Update("status", toComment).Error
+}
+
+// invalidateUserContent 失效用户、作品列表、Feed 和受影响视频的缓存
+func invalidateUserContent(ctx context.Context, invalidator domain.CacheInvalidationPublisher, logger *log.Helper, userID int64, videoIDs []int64) {
+	events := []*domain.CacheInvalidationEvent{
+		cacheInvalidation(domain.CacheTypeUser, userID),
+		cacheInvalidation(domain.CacheTypeUserVideos, userID),
+		cacheInvalidation(domain.CacheTypeFeed),
+	}
+	if len(videoIDs) > 0 {
+		events = append(events, cacheInvalidation(domain.CacheTypeVideo, videoIDs...))
+	}
+	invalidateCache(ctx, invalidator, logger, events...)
+}
+
+// ListExpiredDeletions 冷静期已满的待注销账号，作者有视频处于司法保全时跳过
+func (r *accountDeletionRepo) ListExpiredDeletions(ctx context.Context, now time.Time, limit int) ([]*biz.User, error) {
+	var users []*User
+	if err := r.data.db.WithContext(ctx).
+		Where("status = ? AND deletion_scheduled_at <= ?", domain.UserStatusPendingDeletion, now).
+		Where("NOT EXISTS (SELECT 1 FROM video_takedowns t WHERE t.author_id = users.id AND t.legal_hold = ?)", true).
+		Order("deletion_scheduled_at ASC").
+		Limit(limit).
+		Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.User, 0, len(users))
+	for _, u := range users {
+		result = append(result, userModelToBiz(u))
+	}
+	return result, nil
+}
+
+// ListAccountVideos 用户的全部视频，包括已删除的视频，用于清理存储文件
+func (r *accountDeletionRepo) ListAccountVideos(ctx context.Context, userID int64) ([]*domain.Video, error) {
+	var models []*VideoModel
+	if err := r.data.db.WithContext(ctx).
+		Where("author_id = ?", userID).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	videos := make([]*domain.Video, 0, len(models))
+	for _, m := range models {
+		videos = append(videos, videoModelToDomain(m))
+	}
+	return videos, nil
+}
+
+// AnonymizeAccount 在一个事务中删除个人数据、下线视频、匿名化账号行并登记待删除的存储对象。
+// 私信和下架记录保留，发送方显示为匿名账号；评论保留楼层结构，内容清空并置为已删除
+func (r *accountDeletionRepo) AnonymizeAccount(ctx context.Context, userID int64, objects []*biz.StorageDeletion) error {
+	var videoIDs []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&User{}).
+			Where("id = ? AND status = ?", userID, domain.UserStatusPendingDeletion).
+			Updates(map[string]interface{}{
+				"username":              fmt.Sprintf("deleted_%d", userID),
+				"password_hash":         "",
+				"salt":                  "",
+				"nickname":              "",
+				"avatar":                "",
+				"background_image":      "",
+				"signature":             "",
+				"email":                 nil,
+				"phone":                 nil,
+				"is_private":            false,
+				"follow_count":          0,
+				"follower_count":        0,
+				"total_favorited":       0,
+				"work_count":            0,
+				"favorite_count":        0,
+				"status":                domain.UserStatusDeleted,
+				"last_login_at":         nil,
+				"deletion_scheduled_at": nil,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrUserNotFound
+		}
+
+		id := sql.Named("id", userID)
+		personal := []struct {
+			model interface{}
+			where string
+		}{
+			{&UserSession{}, "user_id = @id"},
+			{&UserFollow{}, "user_id = @id OR follow_user_id = @id"},
+			{&FollowRequest{}, "requester_id = @id OR target_id = @id"},
+			{&UserFavorite{}, "user_id = @id"},
+			{&UserMutedKeyword{}, "user_id = @id"},
+			{&UserRole{}, "user_id = @id"},
+			{&OAuthAccountModel{}, "user_id = @id"},
+			{&LoginHistoryModel{}, "user_id = @id"},
+			{&WatchHistoryModel{}, "user_id = @id"},
+			{&ContentDraftModel{}, "user_id = @id"},
+			{&FlaggedRegistrationModel{}, "user_id = @id"},
+		}
+		for _, p := range personal {
+			if err := tx.Where(p.where, id).Delete(p.model).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&Comment{}).
+			Where("user_id = ?", userID).
+			Updates(map[string]interface{}{"content": "", "status": biz.CommentStatusDeleted}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&VideoModel{}).
+			Where("author_id = ? AND status <> ?", userID, domain.VideoStatusDeleted).
+			Pluck("id", &videoIDs).Error; err != nil {
+			return err
+		}
+		if len(videoIDs) > 0 {
+			if err := tx.Model(&VideoModel{}).
+				Where("id IN ?", videoIDs).
+				Update("status", domain.VideoStatusDeleted).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(objects) == 0 {
+			return nil
+		}
+		return tx.Create(storageDeletionModelsFromBiz(objects)).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	invalidateUserContent(ctx, r.invalidator, r.log, userID, videoIDs)
+	return nil
+}
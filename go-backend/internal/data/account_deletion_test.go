@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountDeletionRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	data := &Data{db: env.DB.DB, rdb: env.Redis.Client}
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+	repo := &accountDeletionRepo{
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		passwordMgr: auth.NewPasswordManager(),
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	fixture, err := env.DataManager.CreateUser(
+		testutils.WithVideos(2, domain.VideoStatusPublished),
+		testutils.WithVideos(1, domain.VideoStatusPrivate),
+	)
+	require.NoError(t, err)
+	user := fixture.User
+	comment := &Comment{VideoID: fixture.Videos[0].ID, UserID: user.ID, Content: "hello", Status: biz.CommentStatusNormal}
+	require.NoError(t, env.DB.DB.Create(comment).Error)
+
+	videoStatuses := func() map[int32]int {
+		var statuses []int32
+		require.NoError(t, env.DB.DB.Model(&VideoModel{}).Where("author_id = ?", user.ID).Pluck("status", &statuses).Error)
+		counts := make(map[int32]int)
+		for _, s := range statuses {
+			counts[s]++
+		}
+		return counts
+	}
+
+	now := time.Now().Truncate(time.Second)
+	deletion := &biz.AccountDeletion{UserID: user.ID, RequestedAt: now, PurgeAt: now.Add(14 * 24 * time.Hour)}
+
+	t.Run("MarkPendingDeletion", func(t *testing.T) {
+		require.NoError(t, repo.MarkPendingDeletion(ctx, deletion))
+
+		var u User
+		require.NoError(t, env.DB.DB.First(&u, user.ID).Error)
+		assert.Equal(t, int8(domain.UserStatusPendingDeletion), u.Status)
+		require.NotNil(t, u.DeletionScheduledAt)
+		assert.WithinDuration(t, deletion.PurgeAt, *u.DeletionScheduledAt, time.Second)
+
+		// 已发布视频隐藏，私密视频保持不变
+		assert.Equal(t, map[int32]int{domain.VideoStatusHidden: 2, domain.VideoStatusPrivate: 1}, videoStatuses())
+		var c Comment
+		require.NoError(t, env.DB.DB.First(&c, comment.ID).Error)
+		assert.Equal(t, biz.CommentStatusHidden, c.Status)
+
+		// 不能重复注销
+		assert.ErrorIs(t, repo.MarkPendingDeletion(ctx, deletion), biz.ErrUserNotFound)
+	})
+
+	t.Run("GetPendingDeletion", func(t *testing.T) {
+		got, err := repo.GetPendingDeletion(ctx, user.Username, testutils.FixturePassword)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, got.UserID)
+		assert.WithinDuration(t, deletion.PurgeAt, got.PurgeAt, time.Second)
+
+		_, err = repo.GetPendingDeletion(ctx, user.Username, "wrong")
+		assert.ErrorIs(t, err, biz.ErrPasswordError)
+		_, err = repo.GetPendingDeletion(ctx, "nobody", testutils.FixturePassword)
+		assert.ErrorIs(t, err, biz.ErrUserNotFound)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		require.NoError(t, repo.Restore(ctx, user.ID))
+
+		var u User
+		require.NoError(t, env.DB.DB.First(&u, user.ID).Error)
+		assert.Equal(t, int8(domain.UserStatusActive), u.Status)
+		assert.Nil(t, u.DeletionRequestedAt)
+		assert.Nil(t, u.DeletionScheduledAt)
+
+		assert.Equal(t, map[int32]int{domain.VideoStatusPublished: 2, domain.VideoStatusPrivate: 1}, videoStatuses())
+		var c Comment
+		require.NoError(t, env.DB.DB.First(&c, comment.ID).Error)
+		assert.Equal(t, biz.CommentStatusNormal, c.Status)
+
+		assert.ErrorIs(t, repo.Restore(ctx, user.ID), biz.ErrUserNotFound)
+	})
+
+	t.Run("AnonymizeAccount", func(t *testing.T) {
+		expired := now.Add(-time.Minute)
+		require.NoError(t, repo.MarkPendingDeletion(ctx, &biz.AccountDeletion{UserID: user.ID, RequestedAt: now, PurgeAt: expired}))
+		require.NoError(t, env.DB.DB.Create(&UserSession{
+			UserID: user.ID, RefreshToken: "refresh", FamilyID: "family", ExpiresAt: now.Add(time.Hour),
+		}).Error)
+
+		users, err := repo.ListExpiredDeletions(ctx, now, 10)
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, user.ID, users[0].ID)
+
+		videos, err := repo.ListAccountVideos(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Len(t, videos, 3)
+
+		objects := []*biz.StorageDeletion{{ObjectName: "videos/1.mp4", Reason: biz.StorageDeletionReasonAccountPurge, DeleteAfter: now}}
+		require.NoError(t, repo.AnonymizeAccount(ctx, user.ID, objects))
+
+		var u User
+		require.NoError(t, env.DB.DB.First(&u, user.ID).Error)
+		assert.Equal(t, int8(domain.UserStatusDeleted), u.Status)
+		assert.NotEqual(t, user.Username, u.Username)
+		assert.Empty(t, u.PasswordHash)
+		assert.Nil(t, u.Email)
+		assert.Nil(t, u.DeletionScheduledAt)
+
+		assert.Equal(t, map[int32]int{domain.VideoStatusDeleted: 3}, videoStatuses())
+		var c Comment
+		require.NoError(t, env.DB.DB.First(&c, comment.ID).Error)
+		assert.Equal(t, biz.CommentStatusDeleted, c.Status)
+		assert.Empty(t, c.Content)
+
+		var sessions, queued int64
+		require.NoError(t, env.DB.DB.Model(&UserSession{}).Where("user_id = ?", user.ID).Count(&sessions).Error)
+		assert.Zero(t, sessions)
+		require.NoError(t, env.DB.DB.Model(&StorageDeletionModel{}).Where("object_name = ?", "videos/1.mp4").Count(&queued).Error)
+		assert.Equal(t, int64(1), queued)
+
+		// 已匿名化的账号不会被再次处理
+		users, err = repo.ListExpiredDeletions(ctx, now, 10)
+		require.NoError(t, err)
+		assert.Empty(t, users)
+		assert.ErrorIs(t, repo.AnonymizeAccount(ctx, user.ID, nil), biz.ErrUserNotFound)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"go-backend/internal/biz"
 	"go-backend/internal/domain"
 	"go-backend/pkg/cache"
 
@@ -121,77 +122,132 @@ func (c *AuthCache) DeleteUserSession(ctx context.Context, userID int64) error {
 	return c.cache.Delete(ctx, key)
 }
 
-// SetLoginAttempts 设置登录尝试次数
-func (c *AuthCache) SetLoginAttempts(ctx context.Context, username string, attempts int) error {
+// IncrLoginAttempts 原子地累加登录失败次数并返回累加后的值，expiration 后自动清除
+func (c *AuthCache) IncrLoginAttempts(ctx context.Context, username string, expiration time.Duration) (int, error) {
 	key := fmt.Sprintf("login_attempts:%s", username)
-	return c.cache.SetString(ctx, key, fmt.Sprintf("%d", attempts), 15*time.Minute)
+	attempts, err := c.cache.Incr(ctx, key, expiration)
+	return int(attempts), err
 }
 
 // GetLoginAttempts 获取登录尝试次数
 func (c *AuthCache) GetLoginAttempts(ctx context.Context, username string) (int, error) {
 	key := fmt.Sprintf("login_attempts:%s", username)
+	attempts, err := c.cache.GetCounter(ctx, key)
+	return int(attempts), err
+}
+
+// ClearLoginAttempts 清除登录尝试次数
+func (c *AuthCache) ClearLoginAttempts(ctx context.Context, username string) error {
+	key := fmt.Sprintf("login_attempts:%s", username)
+	return c.cache.Delete(ctx, key)
+}
+
+// SetPasswordResetToken 设置密码重置Token，account 为用户名等账号标识。
+// 只保存Token本身，使用时在Redis中比较并删除
+func (c *AuthCache) SetPasswordResetToken(ctx context.Context, account, token string) error {
+	key := fmt.Sprintf("password_reset:%s", account)
+	return c.cache.SetString(ctx, key, token, 30*time.Minute)
+}
+
+// ConsumePasswordResetToken Token匹配时删除并返回 true，同一Token只有一次调用能返回 true
+func (c *AuthCache) ConsumePasswordResetToken(ctx context.Context, account, token string) (bool, error) {
+	key := fmt.Sprintf("password_reset:%s", account)
+	return c.cache.CompareAndDelete(ctx, key, token)
+}
+
+// SetEmailVerification 保存待验证的邮箱绑定，新验证码的校验次数从0开始
+func (c *AuthCache) SetEmailVerification(ctx context.Context, userID int64, verification *biz.EmailVerification, expiration time.Duration) error {
+	key := fmt.Sprintf("email_verification:%d", userID)
+
+	data, err := json.Marshal(verification)
+	if err != nil {
+		return fmt.Errorf("marshal email verification failed: %w", err)
+	}
+
+	if err := c.cache.Delete(ctx, fmt.Sprintf("email_verification_attempts:%d", userID)); err != nil {
+		return err
+	}
+	return c.cache.SetString(ctx, key, string(data), expiration)
+}
+
+// GetEmailVerification 获取待验证的邮箱绑定，不存在时返回nil
+func (c *AuthCache) GetEmailVerification(ctx context.Context, userID int64) (*biz.EmailVerification, error) {
+	key := fmt.Sprintf("email_verification:%d", userID)
 
 	data, err := c.cache.GetString(ctx, key)
 	if err != nil {
-		return 0, nil // 没有记录则返回0
+		return nil, nil
 	}
 
-	var attempts int
-	if _, err := fmt.Sscanf(data, "%d", &attempts); err != nil {
-		return 0, err
+	var verification biz.EmailVerification
+	if err := json.Unmarshal([]byte(data), &verification); err != nil {
+		return nil, err
 	}
 
-	return attempts, nil
+	return &verification, nil
 }
 
-// ClearLoginAttempts 清除登录尝试次数
-func (c *AuthCache) ClearLoginAttempts(ctx context.Context, username string) error {
-	key := fmt.Sprintf("login_attempts:%s", username)
-	return c.cache.Delete(ctx, key)
+// IncrEmailVerificationAttempts 原子地累加验证码的校验次数，计数与验证码分开保存，expiration 后自动清除
+func (c *AuthCache) IncrEmailVerificationAttempts(ctx context.Context, userID int64, expiration time.Duration) (int, error) {
+	key := fmt.Sprintf("email_verification_attempts:%d", userID)
+	attempts, err := c.cache.Incr(ctx, key, expiration)
+	return int(attempts), err
 }
 
-// SetPasswordResetToken 设置密码重置Token
-func (c *AuthCache) SetPasswordResetToken(ctx context.Context, email, token string) error {
-	key := fmt.Sprintf("password_reset:%s", email)
-
-	tokenData := map[string]interface{}{
-		"token":      token,
-		"created_at": time.Now().Unix(),
+// DeleteEmailVerification 删除待验证的邮箱绑定及其校验次数
+func (c *AuthCache) DeleteEmailVerification(ctx context.Context, userID int64) error {
+	if err := c.cache.Delete(ctx, fmt.Sprintf("email_verification_attempts:%d", userID)); err != nil {
+		return err
 	}
+	key := fmt.Sprintf("email_verification:%d", userID)
+	return c.cache.Delete(ctx, key)
+}
 
-	data, err := json.Marshal(tokenData)
+// SetLoginCode 保存登录验证码，新验证码的校验次数从0开始
+func (c *AuthCache) SetLoginCode(ctx context.Context, channel, target string, code *biz.LoginCode, expiration time.Duration) error {
+	key := fmt.Sprintf("login_code:%s:%s", channel, target)
+
+	data, err := json.Marshal(code)
 	if err != nil {
-		return fmt.Errorf("marshal password reset token failed: %w", err)
+		return fmt.Errorf("marshal login code failed: %w", err)
 	}
 
-	return c.cache.SetString(ctx, key, string(data), 30*time.Minute)
+	if err := c.cache.Delete(ctx, fmt.Sprintf("login_code_attempts:%s:%s", channel, target)); err != nil {
+		return err
+	}
+	return c.cache.SetString(ctx, key, string(data), expiration)
 }
 
-// VerifyPasswordResetToken 验证密码重置Token
-func (c *AuthCache) VerifyPasswordResetToken(ctx context.Context, email, token string) (bool, error) {
-	key := fmt.Sprintf("password_reset:%s", email)
+// GetLoginCode 获取登录验证码，不存在时返回nil
+func (c *AuthCache) GetLoginCode(ctx context.Context, channel, target string) (*biz.LoginCode, error) {
+	key := fmt.Sprintf("login_code:%s:%s", channel, target)
 
 	data, err := c.cache.GetString(ctx, key)
 	if err != nil {
-		return false, nil
+		return nil, nil
 	}
 
-	var tokenData map[string]interface{}
-	if err := json.Unmarshal([]byte(data), &tokenData); err != nil {
-		return false, err
+	var code biz.LoginCode
+	if err := json.Unmarshal([]byte(data), &code); err != nil {
+		return nil, err
 	}
 
-	cachedToken, ok := tokenData["token"].(string)
-	if !ok {
-		return false, fmt.Errorf("invalid password reset token data")
-	}
+	return &code, nil
+}
 
-	return cachedToken == token, nil
+// IncrLoginCodeAttempts 累加登录验证码的校验次数，计数键独立于验证码，并发校验不会互相覆盖
+func (c *AuthCache) IncrLoginCodeAttempts(ctx context.Context, channel, target string, expiration time.Duration) (int, error) {
+	key := fmt.Sprintf("login_code_attempts:%s:%s", channel, target)
+	attempts, err := c.cache.Incr(ctx, key, expiration)
+	return int(attempts), err
 }
 
-// DeletePasswordResetToken 删除密码重置Token
-func (c *AuthCache) DeletePasswordResetToken(ctx context.Context, email string) error {
-	key := fmt.Sprintf("password_reset:%s", email)
+// DeleteLoginCode 删除登录验证码及其校验次数
+func (c *AuthCache) DeleteLoginCode(ctx context.Context, channel, target string) error {
+	if err := c.cache.Delete(ctx, fmt.Sprintf("login_code_attempts:%s:%s", channel, target)); err != nil {
+		return err
+	}
+	key := fmt.Sprintf("login_code:%s:%s", channel, target)
 	return c.cache.Delete(ctx, key)
 }
 
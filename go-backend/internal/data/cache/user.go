@@ -226,18 +226,14 @@ func (c *UserCache) BatchSetUsers(ctx context.Context, users []*biz.User) error
 	return nil
 }
 
-// InvalidateUserCache 失效用户相关缓存
+// InvalidateUserCache 失效用户相关缓存。关注状态按关注者存放在 follow:{user_id} 哈希中，
+// 整体删除即可；其他用户对该用户的关注状态分散在各自的哈希里，随过期时间失效
 func (c *UserCache) InvalidateUserCache(ctx context.Context, userID int64) error {
-	patterns := []string{
-		fmt.Sprintf("user:%d*", userID),
-		fmt.Sprintf("follow:%d:*", userID),
-		fmt.Sprintf("follow:*:%d", userID),
+	if err := c.cache.Invalidate(ctx, fmt.Sprintf("user:%d*", userID)); err != nil {
+		c.log.WithContext(ctx).Errorf("invalidate user cache failed: %v", err)
 	}
-
-	for _, pattern := range patterns {
-		if err := c.cache.Invalidate(ctx, pattern); err != nil {
-			c.log.WithContext(ctx).Errorf("invalidate user cache failed: %v", err)
-		}
+	if err := c.cache.Delete(ctx, fmt.Sprintf("follow:%d", userID)); err != nil {
+		c.log.WithContext(ctx).Errorf("invalidate follow cache failed: %v", err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	pkgcache "go-backend/pkg/cache"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 )
 
 // VideoCache 视频缓存实现
@@ -108,30 +110,37 @@ func (c *VideoCache) DeleteUserVideos(ctx context.Context, userID int64) {
 	}
 }
 
-// GetFeedVideos 获取Feed视频缓存
-func (c *VideoCache) GetFeedVideos(ctx context.Context, lastTime int64) ([]*domain.Video, bool) {
-	key := c.feedKey(lastTime)
+// GetFeedVideos 获取Feed视频缓存。条目以JSON保存，从Redis读取时也能还原类型
+func (c *VideoCache) GetFeedVideos(ctx context.Context, key string) (*biz.FeedCacheEntry, bool) {
+	key = c.feedKey(key)
 
-	data, exists := c.cache.Get(ctx, key)
-	if !exists {
+	data, err := c.cache.GetString(ctx, key)
+	if err != nil {
+		if err != redis.Nil {
+			c.log.WithContext(ctx).Warnf("get feed cache failed: %v", err)
+		}
 		return nil, false
 	}
 
-	videos, ok := data.([]*domain.Video)
-	if !ok {
-		c.log.WithContext(ctx).Warnf("invalid feed cache data type for key: %s", key)
+	var entry biz.FeedCacheEntry
+	if err := json.Unmarshal([]byte(data), &entry); err != nil {
+		c.log.WithContext(ctx).Warnf("invalid feed cache data for key: %s", key)
 		c.cache.Delete(ctx, key)
 		return nil, false
 	}
 
-	return videos, true
+	return &entry, true
 }
 
 // SetFeedVideos 设置Feed视频缓存
-func (c *VideoCache) SetFeedVideos(ctx context.Context, lastTime int64, videos []*domain.Video) {
-	key := c.feedKey(lastTime)
-	// Feed流缓存时间较短，保证时效性
-	if err := c.cache.Set(ctx, key, videos, 5*time.Minute); err != nil {
+func (c *VideoCache) SetFeedVideos(ctx context.Context, key string, entry *biz.FeedCacheEntry, ttl time.Duration) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("marshal feed cache failed: %v", err)
+		return
+	}
+
+	if err := c.cache.SetString(ctx, c.feedKey(key), string(data), ttl); err != nil {
 		c.log.WithContext(ctx).Errorf("set feed cache failed: %v", err)
 	}
 }
@@ -234,6 +243,7 @@ func (c *VideoCache) WarmupCache(ctx context.Context, videos []*domain.Video) {
 			"play_count":     video.PlayCount,
 			"favorite_count": video.FavoriteCount,
 			"comment_count":  video.CommentCount,
+			"share_count":    video.ShareCount,
 		}
 		c.SetVideoStats(ctx, video.ID, stats)
 	}
@@ -248,8 +258,8 @@ func (c *VideoCache) userVideosKey(userID int64) string {
 	return fmt.Sprintf("user:videos:%d", userID)
 }
 
-func (c *VideoCache) feedKey(lastTime int64) string {
-	return fmt.Sprintf("feed:%d", lastTime)
+func (c *VideoCache) feedKey(key string) string {
+	return "feed:" + key
 }
 
 func (c *VideoCache) videoStatsKey(videoID int64) string {
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/cache"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CacheInvalidationConsumer 缓存失效事件消费者，按缓存类型精确删除受影响的缓存键
+type CacheInvalidationConsumer struct {
+	data       *Data
+	userCache  *cache.UserCache
+	videoCache biz.VideoCacheRepo
+	log        *log.Helper
+}
+
+// NewCacheInvalidationConsumer 创建缓存失效事件消费者
+func NewCacheInvalidationConsumer(data *Data, userCache *cache.UserCache, videoCache biz.VideoCacheRepo, logger log.Logger) *CacheInvalidationConsumer {
+	return &CacheInvalidationConsumer{
+		data:       data,
+		userCache:  userCache,
+		videoCache: videoCache,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// GetEventType 获取处理的事件类型
+func (c *CacheInvalidationConsumer) GetEventType() string {
+	return domain.EventTypeCacheInvalidation
+}
+
+// Handle 处理缓存失效事件
+func (c *CacheInvalidationConsumer) Handle(ctx context.Context, event domain.DomainEvent) error {
+	invalidation, ok := event.(*domain.CacheInvalidationEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type: %s", event.GetEventType())
+	}
+
+	switch invalidation.CacheType {
+	case domain.CacheTypeUser:
+		for _, userID := range invalidation.EntityIDs {
+			if err := c.userCache.DeleteUser(ctx, userID); err != nil {
+				return err
+			}
+		}
+	case domain.CacheTypeVideo:
+		for _, videoID := range invalidation.EntityIDs {
+			c.videoCache.DeleteVideo(ctx, videoID)
+		}
+	case domain.CacheTypeUserVideos:
+		for _, authorID := range invalidation.EntityIDs {
+			c.videoCache.DeleteUserVideos(ctx, authorID)
+		}
+	case domain.CacheTypeFeed:
+		c.videoCache.DeleteFeedCache(ctx)
+	case domain.CacheTypeRelation:
+		if len(invalidation.EntityIDs) != 2 {
+			return fmt.Errorf("relation invalidation requires 2 ids, got %d", len(invalidation.EntityIDs))
+		}
+		userID, followUserID := invalidation.EntityIDs[0], invalidation.EntityIDs[1]
+		if err := c.data.rdb.HDel(ctx, followCacheKey(userID), followCacheField(followUserID)).Err(); err != nil {
+			return err
+		}
+		// 关注数和粉丝数随关系变化，双方的用户缓存一并失效
+		for _, id := range invalidation.EntityIDs {
+			if err := c.userCache.DeleteUser(ctx, id); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unknown cache type: %s", invalidation.CacheType)
+	}
+
+	return nil
+}
+
+type cacheInvalidationPublisher struct {
+	bus domain.EventBus
+	log *log.Helper
+}
+
+// NewCacheInvalidationPublisher 创建缓存失效事件发布器，事件经事件总线在进程内同步投递给
+// 缓存消费者和个人主页读模型投影，保证写操作返回后读到的不是旧数据
+func NewCacheInvalidationPublisher(bus domain.EventBus, consumer *CacheInvalidationConsumer, projection *ProfileProjection, logger log.Logger) (domain.CacheInvalidationPublisher, error) {
+	// 先失效缓存，读模型重建直接查询数据库，不依赖缓存
+	for _, handler := range []domain.EventHandler{consumer, projection} {
+		if err := bus.Subscribe(handler.GetEventType(), handler); err != nil {
+			return nil, err
+		}
+	}
+	return &cacheInvalidationPublisher{
+		bus: bus,
+		log: log.NewHelper(logger),
+	}, nil
+}
+
+func (p *cacheInvalidationPublisher) PublishCacheInvalidation(ctx context.Context, events ...*domain.CacheInvalidationEvent) error {
+	var errs []error
+	for _, event := range events {
+		// 单个事件或处理器失败不影响其余处理
+		if err := p.bus.Publish(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("handle %s event %v: %w", event.CacheType, event.EntityIDs, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// invalidateCache 发布缓存失效事件，失败只记录日志，不影响已提交的写操作
+func invalidateCache(ctx context.Context, publisher domain.CacheInvalidationPublisher, logger *log.Helper, events ...*domain.CacheInvalidationEvent) {
+	if err := publisher.PublishCacheInvalidation(ctx, events...); err != nil {
+		logger.WithContext(ctx).Warnf("publish cache invalidation failed: %v", err)
+	}
+}
+
+func cacheInvalidation(cacheType string, entityIDs ...int64) *domain.CacheInvalidationEvent {
+	return domain.NewEventFactory().CreateCacheInvalidationEvent(cacheType, entityIDs...)
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/cache"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/pkg/eventbus"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCacheInvalidationPublisher(data *Data, multiCache *pkgcache.MultiLevelCache) domain.CacheInvalidationPublisher {
+	consumer := NewCacheInvalidationConsumer(
+		data,
+		cache.NewUserCache(multiCache, log.DefaultLogger),
+		cache.NewVideoCache(multiCache, log.DefaultLogger),
+		log.DefaultLogger,
+	)
+	publisher, err := NewCacheInvalidationPublisher(eventbus.New(nil, log.DefaultLogger), consumer, NewProfileProjection(data, log.DefaultLogger), log.DefaultLogger)
+	if err != nil {
+		panic(err)
+	}
+	return publisher
+}
+
+func TestCacheInvalidationConsumer_Handle(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	data := &Data{db: env.DB.DB, rdb: env.Redis.Client}
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+	userCache := cache.NewUserCache(multiCache, log.DefaultLogger)
+	videoCache := cache.NewVideoCache(multiCache, log.DefaultLogger)
+	publisher, err := NewCacheInvalidationPublisher(
+		eventbus.New(nil, log.DefaultLogger),
+		NewCacheInvalidationConsumer(data, userCache, videoCache, log.DefaultLogger),
+		NewProfileProjection(data, log.DefaultLogger),
+		log.DefaultLogger,
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("User", func(t *testing.T) {
+		require.NoError(t, userCache.SetUser(ctx, &biz.User{ID: 1, Username: "alice"}))
+		require.NoError(t, userCache.SetUser(ctx, &biz.User{ID: 2, Username: "bob"}))
+
+		require.NoError(t, publisher.PublishCacheInvalidation(ctx, cacheInvalidation(domain.CacheTypeUser, 1)))
+
+		cached, err := userCache.GetUser(ctx, 1)
+		require.NoError(t, err)
+		assert.Nil(t, cached)
+
+		// 只失效事件指定的用户
+		cached, err = userCache.GetUser(ctx, 2)
+		require.NoError(t, err)
+		assert.NotNil(t, cached)
+	})
+
+	t.Run("Video", func(t *testing.T) {
+		videoCache.SetVideo(ctx, &domain.Video{ID: 10, AuthorID: 1})
+		videoCache.SetUserVideos(ctx, 1, []*domain.Video{{ID: 10, AuthorID: 1}})
+
+		require.NoError(t, publisher.PublishCacheInvalidation(ctx, cacheInvalidation(domain.CacheTypeVideo, 10)))
+
+		_, ok := videoCache.GetVideo(ctx, 10)
+		assert.False(t, ok)
+		_, ok = videoCache.GetUserVideos(ctx, 1)
+		assert.True(t, ok)
+
+		require.NoError(t, publisher.PublishCacheInvalidation(ctx, cacheInvalidation(domain.CacheTypeUserVideos, 1)))
+		_, ok = videoCache.GetUserVideos(ctx, 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("Relation", func(t *testing.T) {
+		require.NoError(t, env.Redis.Client.HSet(ctx, followCacheKey(1), followCacheField(2), "1", followCacheField(3), "0").Err())
+		require.NoError(t, env.Redis.Client.HSet(ctx, followCacheKey(2), followCacheField(1), "1").Err())
+		require.NoError(t, userCache.SetUser(ctx, &biz.User{ID: 1, Username: "alice"}))
+
+		require.NoError(t, publisher.PublishCacheInvalidation(ctx, cacheInvalidation(domain.CacheTypeRelation, 1, 2)))
+
+		assert.False(t, env.Redis.Client.HExists(ctx, followCacheKey(1), followCacheField(2)).Val())
+		assert.True(t, env.Redis.Client.HExists(ctx, followCacheKey(1), followCacheField(3)).Val())
+		assert.True(t, env.Redis.Client.HExists(ctx, followCacheKey(2), followCacheField(1)).Val())
+		cached, err := userCache.GetUser(ctx, 1)
+		require.NoError(t, err)
+		assert.Nil(t, cached)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.NoError(t, userCache.SetUser(ctx, &biz.User{ID: 3, Username: "carol"}))
+
+		// 无法识别的事件返回错误，但不影响同批次其他事件
+		err := publisher.PublishCacheInvalidation(ctx,
+			cacheInvalidation("unknown", 1),
+			cacheInvalidation(domain.CacheTypeRelation, 1),
+			cacheInvalidation(domain.CacheTypeUser, 3),
+		)
+		assert.Error(t, err)
+
+		cached, err := userCache.GetUser(ctx, 3)
+		require.NoError(t, err)
+		assert.Nil(t, cached)
+	})
+}
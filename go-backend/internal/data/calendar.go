@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// ContentDraftModel 内容草稿模型
+type ContentDraftModel struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID      int64      `gorm:"not null;index:idx_user_scheduled,priority:1" json:"user_id"`
+	Title       string     `gorm:"size:100;not null" json:"title"`
+	Note        string     `gorm:"size:500;not null;default:''" json:"note"`
+	ScheduledAt *time.Time `gorm:"index:idx_user_scheduled,priority:2" json:"scheduled_at"`
+	RemindAt    *time.Time `gorm:"index:idx_remind,priority:2" json:"remind_at"`
+	RemindedAt  *time.Time `gorm:"index:idx_remind,priority:1" json:"reminded_at"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (ContentDraftModel) TableName() string {
+	return "content_drafts"
+}
+
+type contentDraftRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewContentDraftRepo .
+func NewContentDraftRepo(data *Data, logger log.Logger) biz.ContentDraftRepo {
+	return &contentDraftRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *contentDraftRepo) CreateDraft(ctx context.Context, draft *biz.ContentDraft) error {
+	model := &ContentDraftModel{
+		UserID:      draft.UserID,
+		Title:       draft.Title,
+		Note:        draft.Note,
+		ScheduledAt: draft.ScheduledAt,
+		RemindAt:    draft.RemindAt,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*draft = *contentDraftModelToBiz(model)
+	return nil
+}
+
+func (r *contentDraftRepo) UpdateDraft(ctx context.Context, draft *biz.ContentDraft) error {
+	result := r.data.db.WithContext(ctx).Model(&ContentDraftModel{}).
+		Where("id = ? AND user_id = ?", draft.ID, draft.UserID).
+		Updates(map[string]interface{}{
+			"title":        draft.Title,
+			"note":         draft.Note,
+			"scheduled_at": draft.ScheduledAt,
+			"remind_at":    draft.RemindAt,
+			"reminded_at":  draft.RemindedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	updated, err := r.GetDraft(ctx, draft.UserID, draft.ID)
+	if err != nil {
+		return err
+	}
+	*draft = *updated
+	return nil
+}
+
+func (r *contentDraftRepo) GetDraft(ctx context.Context, userID, draftID int64) (*biz.ContentDraft, error) {
+	var model ContentDraftModel
+	if err := r.data.db.WithContext(ctx).Where("id = ? AND user_id = ?", draftID, userID).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrDraftNotFound
+		}
+		return nil, err
+	}
+	return contentDraftModelToBiz(&model), nil
+}
+
+func (r *contentDraftRepo) DeleteDraft(ctx context.Context, userID, draftID int64) error {
+	result := r.data.db.WithContext(ctx).Where("id = ? AND user_id = ?", draftID, userID).Delete(&ContentDraftModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrDraftNotFound
+	}
+	return nil
+}
+
+func (r *contentDraftRepo) CountDrafts(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Model(&ContentDraftModel{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *contentDraftRepo) ListScheduledDrafts(ctx context.Context, userID int64, start, end time.Time) ([]*biz.ContentDraft, error) {
+	var models []ContentDraftModel
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ? AND scheduled_at >= ? AND scheduled_at < ?", userID, start, end).
+		Order("scheduled_at ASC, id ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return contentDraftModelsToBiz(models), nil
+}
+
+func (r *contentDraftRepo) ListUnscheduledDrafts(ctx context.Context, userID int64, limit int) ([]*biz.ContentDraft, error) {
+	var models []ContentDraftModel
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ? AND scheduled_at IS NULL", userID).
+		Order("updated_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return contentDraftModelsToBiz(models), nil
+}
+
+func (r *contentDraftRepo) ListDueReminders(ctx context.Context, now time.Time, limit int) ([]*biz.ContentDraft, error) {
+	var models []ContentDraftModel
+	if err := r.data.db.WithContext(ctx).
+		Where("reminded_at IS NULL AND remind_at <= ?", now).
+		Order("remind_at ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return contentDraftModelsToBiz(models), nil
+}
+
+func (r *contentDraftRepo) ClaimReminder(ctx context.Context, draftID int64, remindAt, now time.Time) (bool, error) {
+	// 同时匹配提醒时间，避免标记到用户刚修改过排期的草稿
+	result := r.data.db.WithContext(ctx).Model(&ContentDraftModel{}).
+		Where("id = ? AND remind_at = ? AND reminded_at IS NULL", draftID, remindAt).
+		Update("reminded_at", now)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *contentDraftRepo) ReleaseReminder(ctx context.Context, draftID int64, remindAt time.Time) error {
+	return r.data.db.WithContext(ctx).Model(&ContentDraftModel{}).
+		Where("id = ? AND remind_at = ?", draftID, remindAt).
+		Update("reminded_at", nil).Error
+}
+
+func contentDraftModelsToBiz(models []ContentDraftModel) []*biz.ContentDraft {
+	drafts := make([]*biz.ContentDraft, 0, len(models))
+	for i := range models {
+		drafts = append(drafts, contentDraftModelToBiz(&models[i]))
+	}
+	return drafts
+}
+
+func contentDraftModelToBiz(model *ContentDraftModel) *biz.ContentDraft {
+	return &biz.ContentDraft{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Title:       model.Title,
+		Note:        model.Note,
+		ScheduledAt: model.ScheduledAt,
+		RemindAt:    model.RemindAt,
+		RemindedAt:  model.RemindedAt,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+}
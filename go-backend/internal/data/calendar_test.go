@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentDraftRepo_CRUD(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewContentDraftRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+	scheduledAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	draft := &biz.ContentDraft{UserID: 1, Title: "vlog", Note: "outdoor", ScheduledAt: &scheduledAt}
+	require.NoError(t, repo.CreateDraft(ctx, draft))
+	assert.NotZero(t, draft.ID)
+
+	found, err := repo.GetDraft(ctx, 1, draft.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "vlog", found.Title)
+	require.NotNil(t, found.ScheduledAt)
+	assert.True(t, found.ScheduledAt.Equal(scheduledAt))
+
+	// 其他用户无法读取
+	_, err = repo.GetDraft(ctx, 2, draft.ID)
+	assert.ErrorIs(t, err, biz.ErrDraftNotFound)
+
+	found.Title = "vlog v2"
+	found.ScheduledAt = nil
+	require.NoError(t, repo.UpdateDraft(ctx, found))
+	assert.Equal(t, "vlog v2", found.Title)
+	assert.Nil(t, found.ScheduledAt)
+
+	count, err := repo.CountDrafts(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	assert.ErrorIs(t, repo.DeleteDraft(ctx, 2, draft.ID), biz.ErrDraftNotFound)
+	require.NoError(t, repo.DeleteDraft(ctx, 1, draft.ID))
+	assert.ErrorIs(t, repo.DeleteDraft(ctx, 1, draft.ID), biz.ErrDraftNotFound)
+}
+
+func TestContentDraftRepo_List(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewContentDraftRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	first := now.Add(time.Hour)
+	second := now.Add(2 * time.Hour)
+	outside := now.Add(48 * time.Hour)
+
+	for _, draft := range []*biz.ContentDraft{
+		{UserID: 1, Title: "second", ScheduledAt: &second},
+		{UserID: 1, Title: "first", ScheduledAt: &first},
+		{UserID: 1, Title: "outside", ScheduledAt: &outside},
+		{UserID: 1, Title: "idea"},
+		{UserID: 2, Title: "other", ScheduledAt: &first},
+	} {
+		require.NoError(t, repo.CreateDraft(ctx, draft))
+	}
+
+	scheduled, err := repo.ListScheduledDrafts(ctx, 1, now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, scheduled, 2)
+	assert.Equal(t, "first", scheduled[0].Title)
+	assert.Equal(t, "second", scheduled[1].Title)
+
+	unscheduled, err := repo.ListUnscheduledDrafts(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, unscheduled, 1)
+	assert.Equal(t, "idea", unscheduled[0].Title)
+}
+
+func TestContentDraftRepo_Reminders(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewContentDraftRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	scheduledAt := now.Add(30 * time.Minute)
+	dueAt := now.Add(-time.Minute)
+	laterAt := now.Add(10 * time.Minute)
+
+	due := &biz.ContentDraft{UserID: 1, Title: "due", ScheduledAt: &scheduledAt, RemindAt: &dueAt}
+	later := &biz.ContentDraft{UserID: 1, Title: "later", ScheduledAt: &scheduledAt, RemindAt: &laterAt}
+	require.NoError(t, repo.CreateDraft(ctx, due))
+	require.NoError(t, repo.CreateDraft(ctx, later))
+
+	drafts, err := repo.ListDueReminders(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, drafts, 1)
+	assert.Equal(t, due.ID, drafts[0].ID)
+
+	// 提醒时间不匹配时不标记
+	claimed, err := repo.ClaimReminder(ctx, due.ID, laterAt, now)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	claimed, err = repo.ClaimReminder(ctx, due.ID, dueAt, now)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// 重复标记失败
+	claimed, err = repo.ClaimReminder(ctx, due.ID, dueAt, now)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	drafts, err = repo.ListDueReminders(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, drafts)
+
+	require.NoError(t, repo.ReleaseReminder(ctx, due.ID, dueAt))
+	drafts, err = repo.ListDueReminders(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Len(t, drafts, 1)
+}
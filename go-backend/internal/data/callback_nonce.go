@@ -0,0 +1,30 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const callbackNonceKeyPrefix = "callback:nonce:"
+
+type callbackNonceStore struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCallbackNonceStore .
+func NewCallbackNonceStore(data *Data, logger log.Logger) security.NonceStore {
+	return &callbackNonceStore{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Use 通过 SETNX 占用 nonce，多实例部署时同一 nonce 只有一个实例能占用成功
+func (s *callbackNonceStore) Use(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return s.data.rdb.SetNX(ctx, callbackNonceKeyPrefix+key, 1, ttl).Result()
+}
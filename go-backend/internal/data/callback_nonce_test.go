@@ -0,0 +1,41 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallbackNonceStore_Use(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	store := &callbackNonceStore{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	fresh, err := store.Use(ctx, "media:nonce-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, fresh)
+
+	fresh, err = store.Use(ctx, "media:nonce-1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, fresh)
+
+	fresh, err = store.Use(ctx, "cdn:nonce-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, fresh)
+
+	ttl, err := env.Redis.Client.TTL(ctx, callbackNonceKeyPrefix+"media:nonce-1").Result()
+	require.NoError(t, err)
+	assert.Greater(t, ttl.Seconds(), float64(0))
+}
@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// captionInsertBatch 批量写入字幕段的单批行数
+const captionInsertBatch = 500
+
+// VideoCaption 视频字幕段数据模型
+type VideoCaption struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	VideoID   int64     `gorm:"not null;index:idx_video_language_start,priority:1" json:"video_id"`
+	AuthorID  int64     `gorm:"not null;index:idx_author" json:"author_id"`
+	Language  string    `gorm:"size:16;not null;default:'';index:idx_video_language_start,priority:2" json:"language"`
+	StartMs   int64     `gorm:"not null;index:idx_video_language_start,priority:3" json:"start_ms"`
+	EndMs     int64     `gorm:"not null" json:"end_ms"`
+	Text      string    `gorm:"size:1000;not null" json:"text"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (VideoCaption) TableName() string {
+	return "video_captions"
+}
+
+type captionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCaptionRepo .
+func NewCaptionRepo(data *Data, logger log.Logger) biz.CaptionRepo {
+	return &captionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *captionRepo) ReplaceCaptions(ctx context.Context, videoID, authorID int64, language string, cues []media.CaptionCue) error {
+	rows := make([]*VideoCaption, len(cues))
+	for i, cue := range cues {
+		rows[i] = &VideoCaption{
+			VideoID:  videoID,
+			AuthorID: authorID,
+			Language: language,
+			StartMs:  cue.Start.Milliseconds(),
+			EndMs:    cue.End.Milliseconds(),
+			Text:     cue.Text,
+		}
+	}
+
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ? AND language = ?", videoID, language).Delete(&VideoCaption{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(rows, captionInsertBatch).Error
+	})
+}
+
+func (r *captionRepo) SearchCaptions(ctx context.Context, authorID int64, query string, limit int) ([]*biz.CaptionHit, error) {
+	var rows []VideoCaption
+	err := r.data.db.WithContext(ctx).
+		Table("video_captions AS c").
+		Select("c.video_id, c.start_ms, c.end_ms, c.text, MATCH(c.text) AGAINST(? IN NATURAL LANGUAGE MODE) AS score", query).
+		Joins("JOIN videos AS v ON v.id = c.video_id").
+		Where("c.author_id = ? AND v.status = ? AND v.visibility = ?", authorID, domain.VideoStatusPublished, domain.VideoVisibilityPublic).
+		Where("MATCH(c.text) AGAINST(? IN NATURAL LANGUAGE MODE)", query).
+		Order("score DESC, c.video_id DESC, c.start_ms").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*biz.CaptionHit, len(rows))
+	for i, row := range rows {
+		hits[i] = &biz.CaptionHit{
+			VideoID: row.VideoID,
+			Start:   time.Duration(row.StartMs) * time.Millisecond,
+			End:     time.Duration(row.EndMs) * time.Millisecond,
+			Text:    row.Text,
+		}
+	}
+	return hits, nil
+}
@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptionRepo(t *testing.T) {
+	favorite, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := &captionRepo{
+		data: favorite.data,
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	author, other := users[0].ID, users[1].ID
+	published := createFavoriteTestVideo(t, repo.data, author)
+	private := createFavoriteTestVideo(t, repo.data, author)
+	require.NoError(t, repo.data.db.Model(&VideoModel{}).Where("id = ?", private.ID).
+		Update("status", domain.VideoStatusPrivate).Error)
+	otherVideo := createFavoriteTestVideo(t, repo.data, other)
+
+	cues := []media.CaptionCue{
+		{Start: time.Second, End: 3 * time.Second, Text: "今天聊聊手冲咖啡"},
+		{Start: 5 * time.Second, End: 8 * time.Second, Text: "先准备好器具"},
+	}
+	require.NoError(t, repo.ReplaceCaptions(ctx, published.ID, author, "zh", cues))
+	require.NoError(t, repo.ReplaceCaptions(ctx, private.ID, author, "zh", cues))
+	require.NoError(t, repo.ReplaceCaptions(ctx, otherVideo.ID, other, "zh", cues))
+
+	t.Run("SearchPublishedOnly", func(t *testing.T) {
+		hits, err := repo.SearchCaptions(ctx, author, "咖啡", 10)
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, published.ID, hits[0].VideoID)
+		assert.Equal(t, time.Second, hits[0].Start)
+		assert.Equal(t, 3*time.Second, hits[0].End)
+	})
+
+	t.Run("ReplaceLanguage", func(t *testing.T) {
+		require.NoError(t, repo.ReplaceCaptions(ctx, published.ID, author, "zh", []media.CaptionCue{
+			{Start: 0, End: time.Second, Text: "换成红茶"},
+		}))
+		require.NoError(t, repo.ReplaceCaptions(ctx, published.ID, author, "en", []media.CaptionCue{
+			{Start: 0, End: time.Second, Text: "black tea"},
+		}))
+
+		var count int64
+		require.NoError(t, repo.data.db.Model(&VideoCaption{}).Where("video_id = ?", published.ID).Count(&count).Error)
+		assert.Equal(t, int64(2), count)
+
+		hits, err := repo.SearchCaptions(ctx, author, "咖啡", 10)
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+
+		hits, err = repo.SearchCaptions(ctx, author, "红茶", 10)
+		require.NoError(t, err)
+		assert.Len(t, hits, 1)
+	})
+}
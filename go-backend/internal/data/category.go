@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// VideoCategoryModel 视频分类模型
+type VideoCategoryModel struct {
+	ID        int64             `gorm:"primaryKey;autoIncrement" json:"id"`
+	Slug      string            `gorm:"size:50;not null;uniqueIndex:uk_slug" json:"slug"`
+	Names     map[string]string `gorm:"serializer:json;type:json;not null" json:"names"`
+	SortOrder int32             `gorm:"not null;default:0" json:"sort_order"`
+	Status    int32             `gorm:"not null;default:1" json:"status"`
+	CreatedAt time.Time         `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time         `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (VideoCategoryModel) TableName() string {
+	return "video_categories"
+}
+
+type categoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCategoryRepo .
+func NewCategoryRepo(data *Data, logger log.Logger) biz.CategoryRepo {
+	return &categoryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *categoryRepo) ListCategories(ctx context.Context) ([]*biz.Category, error) {
+	var models []VideoCategoryModel
+	if err := r.data.db.WithContext(ctx).Order("sort_order ASC, id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	categories := make([]*biz.Category, 0, len(models))
+	for i := range models {
+		categories = append(categories, r.toBiz(&models[i]))
+	}
+	return categories, nil
+}
+
+func (r *categoryRepo) GetCategory(ctx context.Context, id int64) (*biz.Category, error) {
+	var model VideoCategoryModel
+	if err := r.data.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return r.toBiz(&model), nil
+}
+
+func (r *categoryRepo) CreateCategory(ctx context.Context, category *biz.Category) error {
+	model := &VideoCategoryModel{
+		Slug:      category.Slug,
+		Names:     category.Names,
+		SortOrder: category.SortOrder,
+		Status:    category.Status,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return biz.ErrCategoryExist
+		}
+		return err
+	}
+
+	*category = *r.toBiz(model)
+	return nil
+}
+
+func (r *categoryRepo) UpdateCategory(ctx context.Context, category *biz.Category) error {
+	result := r.data.db.WithContext(ctx).Model(&VideoCategoryModel{ID: category.ID}).
+		Updates(map[string]interface{}{
+			"names":      category.Names,
+			"sort_order": category.SortOrder,
+			"status":     category.Status,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	updated, err := r.GetCategory(ctx, category.ID)
+	if err != nil {
+		return err
+	}
+	*category = *updated
+	return nil
+}
+
+func (r *categoryRepo) DeleteCategory(ctx context.Context, id int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var inUse int64
+		if err := tx.Model(&VideoModel{}).
+			Where("category_id = ? AND status <> ?", id, domain.VideoStatusDeleted).
+			Count(&inUse).Error; err != nil {
+			return err
+		}
+		if inUse > 0 {
+			return biz.ErrCategoryInUse
+		}
+
+		// 已删除的视频不再展示，直接解除分类
+		if err := tx.Model(&VideoModel{}).Where("category_id = ?", id).
+			Update("category_id", 0).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&VideoCategoryModel{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrCategoryNotFound
+		}
+		return nil
+	})
+}
+
+func (r *categoryRepo) CountPublishedVideos(ctx context.Context) (map[int64]int64, error) {
+	var rows []struct {
+		CategoryID int64
+		Count      int64
+	}
+	if err := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Select("category_id, COUNT(*) AS count").
+		Where("category_id <> 0 AND status = ? AND visibility = ?", domain.VideoStatusPublished, domain.VideoVisibilityPublic).
+		Group("category_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.CategoryID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *categoryRepo) toBiz(model *VideoCategoryModel) *biz.Category {
+	return &biz.Category{
+		ID:        model.ID,
+		Slug:      model.Slug,
+		Names:     model.Names,
+		SortOrder: model.SortOrder,
+		Status:    model.Status,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCategoryRepo(t *testing.T) (*categoryRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	repo := &categoryRepo{
+		data: &Data{
+			db:  env.DB.DB,
+			rdb: env.Redis.Client,
+		},
+		log: log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+func TestCategoryRepo(t *testing.T) {
+	repo, env, cleanup := setupCategoryRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	author := users[0]
+
+	music := &biz.Category{Slug: "music", Names: map[string]string{"zh": "音乐", "en": "Music"}, SortOrder: 20, Status: biz.CategoryStatusActive}
+	gaming := &biz.Category{Slug: "gaming", Names: map[string]string{"zh": "游戏"}, SortOrder: 10, Status: biz.CategoryStatusActive}
+
+	t.Run("CreateAndList", func(t *testing.T) {
+		require.NoError(t, repo.CreateCategory(ctx, music))
+		require.NoError(t, repo.CreateCategory(ctx, gaming))
+		assert.NotZero(t, music.ID)
+
+		err := repo.CreateCategory(ctx, &biz.Category{Slug: "music", Names: map[string]string{"zh": "音乐"}, Status: biz.CategoryStatusActive})
+		assert.Equal(t, biz.ErrCategoryExist, err)
+
+		categories, err := repo.ListCategories(ctx)
+		require.NoError(t, err)
+		require.Len(t, categories, 2)
+		assert.Equal(t, "gaming", categories[0].Slug)
+		assert.Equal(t, "Music", categories[1].Names["en"])
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		update := &biz.Category{ID: music.ID, Names: map[string]string{"zh": "音乐", "ja": "音楽"}, SortOrder: 5, Status: biz.CategoryStatusDisabled}
+		require.NoError(t, repo.UpdateCategory(ctx, update))
+		assert.Equal(t, "music", update.Slug)
+		assert.Equal(t, "音楽", update.Names["ja"])
+		assert.NotContains(t, update.Names, "en")
+
+		err := repo.UpdateCategory(ctx, &biz.Category{ID: 999999, Names: map[string]string{"zh": "x"}, Status: biz.CategoryStatusActive})
+		assert.Equal(t, biz.ErrCategoryNotFound, err)
+	})
+
+	t.Run("FeedAndFacets", func(t *testing.T) {
+		videoRepo := &videoRepo{data: repo.data, log: log.NewHelper(log.DefaultLogger)}
+		now := time.Now()
+		for i, categoryID := range []int64{gaming.ID, gaming.ID, music.ID, 0} {
+			video := &VideoModel{
+				AuthorID:   author.ID,
+				Title:      "category test video",
+				PlayURL:    "http://example.com/video.mp4",
+				CategoryID: categoryID,
+				Status:     domain.VideoStatusPublished,
+				CreatedAt:  now.Add(-time.Duration(i) * time.Minute),
+			}
+			require.NoError(t, repo.data.db.Create(video).Error)
+		}
+		hidden := &VideoModel{AuthorID: author.ID, Title: "hidden", PlayURL: "http://example.com/video.mp4", CategoryID: gaming.ID, Status: domain.VideoStatusHidden}
+		require.NoError(t, repo.data.db.Create(hidden).Error)
+
+		videos, err := videoRepo.GetFeedVideos(ctx, &domain.FeedCursor{CreatedAt: now.Add(time.Minute)}, gaming.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, videos, 2)
+		for _, video := range videos {
+			assert.Equal(t, gaming.ID, video.CategoryID)
+		}
+
+		counts, err := repo.CountPublishedVideos(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int64{gaming.ID: 2, music.ID: 1}, counts)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.Equal(t, biz.ErrCategoryInUse, repo.DeleteCategory(ctx, gaming.ID))
+
+		// 分类下只剩已删除的视频时可以删除，视频解除分类
+		deleted := &VideoModel{AuthorID: author.ID, Title: "deleted", PlayURL: "http://example.com/video.mp4", CategoryID: music.ID, Status: domain.VideoStatusDeleted}
+		require.NoError(t, repo.data.db.Create(deleted).Error)
+		require.NoError(t, repo.data.db.Model(&VideoModel{}).
+			Where("category_id = ? AND id <> ?", music.ID, deleted.ID).
+			Update("status", domain.VideoStatusDeleted).Error)
+
+		require.NoError(t, repo.DeleteCategory(ctx, music.ID))
+		_, err := repo.GetCategory(ctx, music.ID)
+		assert.Equal(t, biz.ErrCategoryNotFound, err)
+
+		var model VideoModel
+		require.NoError(t, repo.data.db.First(&model, deleted.ID).Error)
+		assert.Zero(t, model.CategoryID)
+
+		assert.Equal(t, biz.ErrCategoryNotFound, repo.DeleteCategory(ctx, music.ID))
+	})
+}
@@ -0,0 +1,320 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Comment 评论模型
+type Comment struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	VideoID    int64      `gorm:"not null;index:idx_video_created,priority:1" json:"video_id"`
+	UserID     int64      `gorm:"not null;index:idx_user_id" json:"user_id"`
+	ParentID   int64      `gorm:"default:0;index:idx_parent_id" json:"parent_id"`
+	Content    string     `gorm:"type:text;not null" json:"content"`
+	LikeCount  int64      `gorm:"default:0" json:"like_count"`
+	ReplyCount int64      `gorm:"default:0" json:"reply_count"`
+	Status     int32      `gorm:"default:1" json:"status"`
+	HeartedAt  *time.Time `gorm:"column:hearted_at" json:"hearted_at"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;index:idx_video_created,priority:2,sort:desc" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (Comment) TableName() string {
+	return "comments"
+}
+
+type commentRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	producer    domain.InteractionEventPublisher
+	log         *log.Helper
+}
+
+// NewCommentRepo .
+func NewCommentRepo(data *Data, invalidator domain.CacheInvalidationPublisher, producer domain.InteractionEventPublisher, logger log.Logger) biz.CommentRepo {
+	return &commentRepo{
+		data:        data,
+		invalidator: invalidator,
+		producer:    producer,
+		log:         log.NewHelper(logger),
+	}
+}
+
+func (r *commentRepo) CreateComment(ctx context.Context, c *biz.Comment, authorID int64) (*biz.Comment, error) {
+	model := &Comment{
+		VideoID:  c.VideoID,
+		UserID:   c.UserID,
+		ParentID: c.ParentID,
+		Content:  c.Content,
+		Status:   c.Status,
+	}
+	if model.Status == 0 {
+		model.Status = biz.CommentStatusNormal
+	}
+
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		// 待复核的评论通过审核后才计入评论数
+		if model.Status != biz.CommentStatusNormal {
+			return nil
+		}
+		return r.countCreated(tx, model)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if model.Status == biz.CommentStatusNormal {
+		r.afterCreated(ctx, model, authorID)
+	}
+
+	return r.toBiz(model), nil
+}
+
+// countCreated 在事务内更新父评论回复数和视频评论数，并写入视频统计更新事件
+func (r *commentRepo) countCreated(tx *gorm.DB, model *Comment) error {
+	if model.ParentID > 0 {
+		if err := tx.Model(&Comment{}).Where("id = ?", model.ParentID).
+			Update("reply_count", gorm.Expr("reply_count + 1")).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Model(&VideoModel{}).Where("id = ?", model.VideoID).
+		Update("comment_count", gorm.Expr("comment_count + 1")).Error; err != nil {
+		return err
+	}
+
+	return enqueueVideoStatsUpdated(tx, model.VideoID, "comment_count", 1)
+}
+
+// afterCreated 评论对外可见后失效视频缓存并发布评论创建事件
+func (r *commentRepo) afterCreated(ctx context.Context, model *Comment, authorID int64) {
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, model.VideoID))
+
+	event := domain.NewEventFactory().CreateCommentCreatedEvent(model.ID, model.VideoID, model.UserID, authorID, model.Content, model.ParentID)
+	if err := r.producer.PublishCommentCreatedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish comment created event failed: %v", err)
+	}
+}
+
+func (r *commentRepo) GetComment(ctx context.Context, id int64) (*biz.Comment, error) {
+	var model Comment
+	if err := r.data.db.WithContext(ctx).
+		Where("id = ? AND status = ?", id, biz.CommentStatusNormal).
+		First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return r.toBiz(&model), nil
+}
+
+func (r *commentRepo) DeleteComment(ctx context.Context, c *biz.Comment) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Comment{}).
+			Where("id = ? AND status = ?", c.ID, biz.CommentStatusNormal).
+			Update("status", biz.CommentStatusDeleted)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrCommentNotFound
+		}
+
+		removed := int64(1)
+		if c.ParentID == 0 {
+			// 一级评论删除时一并删除回复
+			replies := tx.Model(&Comment{}).
+				Where("parent_id = ? AND status = ?", c.ID, biz.CommentStatusNormal).
+				Update("status", biz.CommentStatusDeleted)
+			if replies.Error != nil {
+				return replies.Error
+			}
+			removed += replies.RowsAffected
+		} else {
+			if err := tx.Model(&Comment{}).Where("id = ?", c.ParentID).
+				Update("reply_count", gorm.Expr("GREATEST(reply_count - 1, 0)")).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&VideoModel{}).Where("id = ?", c.VideoID).
+			Update("comment_count", gorm.Expr("GREATEST(comment_count - ?, 0)", removed)).Error; err != nil {
+			return err
+		}
+
+		return enqueueVideoStatsUpdated(tx, c.VideoID, "comment_count", -removed)
+	})
+	if err != nil {
+		return err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, c.VideoID))
+
+	event := domain.NewEventFactory().CreateCommentDeletedEvent(c.ID, c.VideoID, c.UserID)
+	if err := r.producer.PublishCommentDeletedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish comment deleted event failed: %v", err)
+	}
+
+	return nil
+}
+
+func (r *commentRepo) SetCommentHearted(ctx context.Context, id int64, hearted bool) error {
+	var heartedAt interface{}
+	if hearted {
+		heartedAt = time.Now()
+	}
+
+	result := r.data.db.WithContext(ctx).Model(&Comment{}).
+		Where("id = ? AND status = ?", id, biz.CommentStatusNormal).
+		Update("hearted_at", heartedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrCommentNotFound
+	}
+	return nil
+}
+
+func (r *commentRepo) ListComments(ctx context.Context, videoID int64, filter *biz.CommentFilter, page, size int32) ([]*biz.Comment, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&Comment{}).
+		Where("video_id = ? AND parent_id = 0", videoID)
+	return r.list(r.applyFilter(r.visible(query, filter), filter), "created_at DESC, id DESC", page, size)
+}
+
+func (r *commentRepo) ListReplies(ctx context.Context, parentID int64, filter *biz.CommentFilter, page, size int32) ([]*biz.Comment, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&Comment{}).
+		Where("parent_id = ?", parentID)
+	return r.list(r.applyFilter(r.visible(query, filter), filter), "created_at ASC, id ASC", page, size)
+}
+
+func (r *commentRepo) ListPendingComments(ctx context.Context, page, size int32) ([]*biz.Comment, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&Comment{}).
+		Where("status = ?", biz.CommentStatusReview)
+	return r.list(query, "created_at ASC, id ASC", page, size)
+}
+
+func (r *commentRepo) ReviewComment(ctx context.Context, id int64, approve bool) (*biz.Comment, error) {
+	var (
+		model    Comment
+		authorID int64
+	)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定评论行，避免多个审核员同时审核同一评论
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status = ?", id, biz.CommentStatusReview).
+			First(&model).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrCommentNotFound
+			}
+			return err
+		}
+
+		if !approve {
+			model.Status = biz.CommentStatusDeleted
+			return tx.Model(&model).Update("status", model.Status).Error
+		}
+
+		model.Status = biz.CommentStatusNormal
+		if err := tx.Model(&model).Update("status", model.Status).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&VideoModel{}).Where("id = ?", model.VideoID).Pluck("author_id", &authorID).Error; err != nil {
+			return err
+		}
+		return r.countCreated(tx, &model)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if approve {
+		r.afterCreated(ctx, &model, authorID)
+	}
+
+	return r.toBiz(&model), nil
+}
+
+// visible 只返回正常的评论，待复核的评论仅对其作者本人可见
+func (r *commentRepo) visible(query *gorm.DB, filter *biz.CommentFilter) *gorm.DB {
+	if filter == nil || filter.ViewerID <= 0 {
+		return query.Where("status = ?", biz.CommentStatusNormal)
+	}
+	return query.Where("(status = ? OR (status = ? AND user_id = ?))",
+		biz.CommentStatusNormal, biz.CommentStatusReview, filter.ViewerID)
+}
+
+// applyFilter 隐藏包含屏蔽词的评论，评论作者本人仍可见。comments表使用不区分大小写的排序规则
+func (r *commentRepo) applyFilter(query *gorm.DB, filter *biz.CommentFilter) *gorm.DB {
+	if filter == nil || len(filter.MutedKeywords) == 0 {
+		return query
+	}
+
+	conditions := make([]string, 0, len(filter.MutedKeywords))
+	args := make([]interface{}, 0, len(filter.MutedKeywords)+1)
+	args = append(args, filter.ViewerID)
+	for _, keyword := range filter.MutedKeywords {
+		conditions = append(conditions, "content NOT LIKE ?")
+		args = append(args, "%"+escapeLike(keyword)+"%")
+	}
+
+	return query.Where("(user_id = ? OR ("+strings.Join(conditions, " AND ")+"))", args...)
+}
+
+func (r *commentRepo) list(query *gorm.DB, order string, page, size int32) ([]*biz.Comment, int64, error) {
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []Comment
+	if err := query.Session(&gorm.Session{}).
+		Order(order).
+		Offset(int((page - 1) * size)).Limit(int(size)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	comments := make([]*biz.Comment, 0, len(models))
+	for i := range models {
+		comments = append(comments, r.toBiz(&models[i]))
+	}
+
+	return comments, total, nil
+}
+
+func (r *commentRepo) toBiz(m *Comment) *biz.Comment {
+	return &biz.Comment{
+		ID:         m.ID,
+		VideoID:    m.VideoID,
+		UserID:     m.UserID,
+		ParentID:   m.ParentID,
+		Content:    m.Content,
+		LikeCount:  m.LikeCount,
+		ReplyCount: m.ReplyCount,
+		Status:     m.Status,
+		Hearted:    m.HeartedAt != nil,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// escapeLike 转义LIKE模式中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
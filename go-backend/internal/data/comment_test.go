@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/producer"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCommentRepo(t *testing.T) (*commentRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+
+	repo := &commentRepo{
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		producer:    &producer.NoOpInteractionEventProducer{},
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+func TestCommentRepo_CreateAndDelete(t *testing.T) {
+	repo, env, cleanup := setupCommentRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user, author := users[0], users[1]
+	video := createFavoriteTestVideo(t, repo.data, author.ID)
+
+	root, err := repo.CreateComment(ctx, &biz.Comment{VideoID: video.ID, UserID: user.ID, Content: "root"}, author.ID)
+	require.NoError(t, err)
+	assert.NotZero(t, root.ID)
+
+	_, err = repo.CreateComment(ctx, &biz.Comment{VideoID: video.ID, UserID: author.ID, ParentID: root.ID, Content: "reply"}, author.ID)
+	require.NoError(t, err)
+
+	// 评论数和回复数在事务内更新
+	var videoModel VideoModel
+	require.NoError(t, repo.data.db.First(&videoModel, video.ID).Error)
+	assert.Equal(t, int64(2), videoModel.CommentCount)
+
+	root, err = repo.GetComment(ctx, root.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), root.ReplyCount)
+
+	comments, total, err := repo.ListComments(ctx, video.ID, nil, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, comments, 1)
+
+	replies, total, err := repo.ListReplies(ctx, root.ID, nil, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, replies, 1)
+	assert.Equal(t, "reply", replies[0].Content)
+
+	// 删除一级评论时回复一并删除
+	require.NoError(t, repo.DeleteComment(ctx, root))
+
+	require.NoError(t, repo.data.db.First(&videoModel, video.ID).Error)
+	assert.Zero(t, videoModel.CommentCount)
+
+	_, err = repo.GetComment(ctx, root.ID)
+	assert.Equal(t, biz.ErrCommentNotFound, err)
+
+	_, total, err = repo.ListReplies(ctx, root.ID, nil, 1, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestCommentRepo_ListWithMutedKeywords(t *testing.T) {
+	repo, env, cleanup := setupCommentRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(3)
+	require.NoError(t, err)
+	spammer, other, author := users[0], users[1], users[2]
+	video := createFavoriteTestVideo(t, repo.data, author.ID)
+
+	_, err = repo.CreateComment(ctx, &biz.Comment{VideoID: video.ID, UserID: spammer.ID, Content: "Buy CHEAP followers"}, author.ID)
+	require.NoError(t, err)
+	_, err = repo.CreateComment(ctx, &biz.Comment{VideoID: video.ID, UserID: other.ID, Content: "100% real"}, author.ID)
+	require.NoError(t, err)
+	_, err = repo.CreateComment(ctx, &biz.Comment{VideoID: video.ID, UserID: other.ID, Content: "nice video"}, author.ID)
+	require.NoError(t, err)
+
+	// 匹配不区分大小写，通配符按字面匹配
+	filter := &biz.CommentFilter{ViewerID: other.ID, MutedKeywords: []string{"cheap", "%"}}
+	comments, total, err := repo.ListComments(ctx, video.ID, filter, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "nice video", comments[0].Content)
+
+	// 被屏蔽的评论对其作者本人仍可见
+	filter.ViewerID = spammer.ID
+	_, total, err = repo.ListComments(ctx, video.ID, filter, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
+
+func TestCommentRepo_SetCommentHearted(t *testing.T) {
+	repo, env, cleanup := setupCommentRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user, author := users[0], users[1]
+	video := createFavoriteTestVideo(t, repo.data, author.ID)
+
+	comment, err := repo.CreateComment(ctx, &biz.Comment{VideoID: video.ID, UserID: user.ID, Content: "nice"}, author.ID)
+	require.NoError(t, err)
+	assert.False(t, comment.Hearted)
+
+	require.NoError(t, repo.SetCommentHearted(ctx, comment.ID, true))
+	comments, _, err := repo.ListComments(ctx, video.ID, nil, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.True(t, comments[0].Hearted)
+
+	require.NoError(t, repo.SetCommentHearted(ctx, comment.ID, false))
+	comment, err = repo.GetComment(ctx, comment.ID)
+	require.NoError(t, err)
+	assert.False(t, comment.Hearted)
+
+	// 已删除的评论不能再点红心
+	require.NoError(t, repo.DeleteComment(ctx, comment))
+	assert.ErrorIs(t, repo.SetCommentHearted(ctx, comment.ID, true), biz.ErrCommentNotFound)
+}
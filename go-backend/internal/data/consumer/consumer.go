@@ -0,0 +1,48 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+
+	"go-backend/internal/biz"
+	"go-backend/pkg/messaging"
+
+	"github.com/google/wire"
+)
+
+// ProviderSet is consumer providers.
+var ProviderSet = wire.NewSet(
+	NewVideoProcessConsumer,
+	NewStatsUpdateConsumer,
+)
+
+// idempotent 按消息ID去重，name 区分处理器，同一消息在同一处理器上只成功执行一次。
+// 出站事件的消息ID取自事件ID，重复投递时保持不变
+func idempotent(uc *biz.IdempotencyUsecase, name string, handler messaging.MessageHandler) messaging.MessageHandler {
+	return func(ctx context.Context, message *messaging.BaseMessage) error {
+		return uc.Run(ctx, name, message.ID, func(ctx context.Context) error {
+			return handler(ctx, message)
+		})
+	}
+}
+
+// fanout 依次执行同一条消息的多个处理器，遇到错误时返回，消息重投后已成功的处理器由各自的去重跳过
+func fanout(handlers ...messaging.MessageHandler) messaging.MessageHandler {
+	return func(ctx context.Context, message *messaging.BaseMessage) error {
+		for _, handler := range handlers {
+			if err := handler(ctx, message); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// decodeMessage 把消息体解码为具体的事件结构
+func decodeMessage(message *messaging.BaseMessage, v interface{}) error {
+	data, err := json.Marshal(message.Data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -14,48 +14,50 @@ import (
 
 // StatsUpdateConsumer 统计更新消费者
 type StatsUpdateConsumer struct {
-	kafkaManager *messaging.KafkaManager
 	videoUsecase *biz.VideoUsecase
+	userStats    *biz.UserStatsUsecase
+	trending     *biz.TrendingUsecase
+	idempotency  *biz.IdempotencyUsecase
 	config       *conf.Business_KafkaTopics
 	log          *log.Helper
 }
 
 // NewStatsUpdateConsumer 创建统计更新消费者
 func NewStatsUpdateConsumer(
-	kafkaManager *messaging.KafkaManager,
 	videoUsecase *biz.VideoUsecase,
+	userStats *biz.UserStatsUsecase,
+	trending *biz.TrendingUsecase,
+	idempotency *biz.IdempotencyUsecase,
 	businessConfig *conf.Business,
 	logger log.Logger,
 ) *StatsUpdateConsumer {
 	return &StatsUpdateConsumer{
-		kafkaManager: kafkaManager,
 		videoUsecase: videoUsecase,
+		userStats:    userStats,
+		trending:     trending,
+		idempotency:  idempotency,
 		config:       businessConfig.KafkaTopics,
 		log:          log.NewHelper(logger),
 	}
 }
 
-// Start 启动消费者
-func (c *StatsUpdateConsumer) Start(ctx context.Context) error {
-	consumer := c.kafkaManager.GetConsumer()
-
-	// 订阅视频统计事件
-	if err := consumer.Subscribe(c.config.VideoStats, c.handleVideoStatsEvent); err != nil {
+// Register 在共享的消费者上订阅统计事件，消费者的启停由 server.Workers 负责。
+// 每个主题上的视频计数、用户日统计和热门榜分别去重，一方失败重投时已成功的一方不会重复累加
+func (c *StatsUpdateConsumer) Register(consumer messaging.Consumer) error {
+	// 订阅视频统计事件，重复投递的增量不会重复累加
+	if err := consumer.Subscribe(c.config.VideoStats, fanout(
+		idempotent(c.idempotency, "video_stats", c.handleVideoStatsEvent),
+		idempotent(c.idempotency, "user_stats_plays", c.handleUserStatsPlays),
+		idempotent(c.idempotency, "trending", c.handleTrending),
+	)); err != nil {
 		return err
 	}
 
 	// 订阅用户行为事件
-	if err := consumer.Subscribe(c.config.UserAction, c.handleUserActionEvent); err != nil {
-		return err
-	}
-
-	return consumer.Start(ctx)
-}
-
-// Stop 停止消费者
-func (c *StatsUpdateConsumer) Stop() error {
-	consumer := c.kafkaManager.GetConsumer()
-	return consumer.Stop()
+	return consumer.Subscribe(c.config.UserAction, fanout(
+		idempotent(c.idempotency, "user_action", c.handleUserActionEvent),
+		idempotent(c.idempotency, "user_stats_action", c.handleUserStatsAction),
+	))
 }
 
 // handleVideoStatsEvent 处理视频统计事件
@@ -96,6 +98,56 @@ func (c *StatsUpdateConsumer) handleUserActionEvent(ctx context.Context, message
 	return c.handleUserAction(ctx, &event)
 }
 
+// handleUserStatsPlays 把发件箱投递的播放数增量计入作者的日统计
+func (c *StatsUpdateConsumer) handleUserStatsPlays(ctx context.Context, message *messaging.BaseMessage) error {
+	var event messaging.VideoStatsEvent
+	if err := decodeMessage(message, &event); err != nil {
+		c.log.WithContext(ctx).Errorf("decode video stats event failed: %v", err)
+		return err
+	}
+
+	// 同一次播放还会以 play 类型直接发布一次，只按发件箱写入的 play_count 计数
+	if event.StatsType != "play_count" {
+		return nil
+	}
+
+	return c.userStats.RecordPlays(ctx, event.VideoID, time.Unix(message.Timestamp, 0), event.Count)
+}
+
+// handleTrending 把发件箱投递的播放、点赞和评论增量累加到热门榜
+func (c *StatsUpdateConsumer) handleTrending(ctx context.Context, message *messaging.BaseMessage) error {
+	var event messaging.VideoStatsEvent
+	if err := decodeMessage(message, &event); err != nil {
+		c.log.WithContext(ctx).Errorf("decode video stats event failed: %v", err)
+		return err
+	}
+
+	return c.trending.RecordStats(ctx, event.VideoID, event.StatsType, event.Count, time.Unix(message.Timestamp, 0))
+}
+
+// handleUserStatsAction 把关注和点赞计入被关注者、视频作者的日统计
+func (c *StatsUpdateConsumer) handleUserStatsAction(ctx context.Context, message *messaging.BaseMessage) error {
+	var event messaging.UserActionEvent
+	if err := decodeMessage(message, &event); err != nil {
+		c.log.WithContext(ctx).Errorf("decode user action event failed: %v", err)
+		return err
+	}
+
+	at := time.Unix(event.Timestamp, 0)
+	switch {
+	case event.TargetType == "user" && event.ActionType == "follow":
+		return c.userStats.RecordFollow(ctx, event.TargetID, at, 1)
+	case event.TargetType == "user" && event.ActionType == "unfollow":
+		return c.userStats.RecordFollow(ctx, event.TargetID, at, -1)
+	case event.TargetType == "video" && event.ActionType == "like":
+		return c.userStats.RecordLike(ctx, event.UserID, event.TargetID, at, 1)
+	case event.TargetType == "video" && event.ActionType == "unlike":
+		return c.userStats.RecordLike(ctx, event.UserID, event.TargetID, at, -1)
+	default:
+		return nil
+	}
+}
+
 // updateVideoStats 更新视频统计
 func (c *StatsUpdateConsumer) updateVideoStats(ctx context.Context, event *messaging.VideoStatsEvent) error {
 	// 根据统计类型映射到具体字段
@@ -112,6 +164,9 @@ func (c *StatsUpdateConsumer) updateVideoStats(ctx context.Context, event *messa
 		statsType = "comment"
 	case "share":
 		statsType = "share"
+	case "play_count", "favorite_count", "comment_count", "share_count":
+		// 发件箱投递的是已写入数据库的计数变化，只供下游统计使用
+		return nil
 	default:
 		c.log.WithContext(ctx).Warnf("unknown stats type: %s", event.StatsType)
 		return nil
@@ -139,19 +194,8 @@ func (c *StatsUpdateConsumer) handleUserAction(ctx context.Context, event *messa
 	var delta int64
 	var statsType string
 
+	// 点赞和评论计数由FavoriteRepo、CommentRepo在事务内维护，这里不再重复累加
 	switch event.ActionType {
-	case "like":
-		statsType = "favorite"
-		delta = 1
-	case "unlike":
-		statsType = "favorite"
-		delta = -1
-	case "comment":
-		statsType = "comment"
-		delta = 1
-	case "uncomment":
-		statsType = "comment"
-		delta = -1
 	case "play":
 		statsType = "play"
 		delta = 1
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
+	"go-backend/internal/biz"
 	"go-backend/internal/conf"
 	"go-backend/internal/domain"
 	"go-backend/pkg/media"
@@ -23,7 +26,14 @@ type VideoProcessConsumer struct {
 	storage      storage.VideoStorage
 	processor    media.VideoProcessorInterface
 	thumbnail    *media.ThumbnailGenerator
+	preview      *media.PreviewOptions  // 动态封面参数，未启用时为nil
+	loudness     *media.LoudnessOptions // 响度归一化参数，未启用时为nil
+	processingUc *biz.ProcessingUsecase
+	videoUc      *biz.VideoUsecase
+	deadLetterUc *biz.DeadLetterUsecase
+	idempotency  *biz.IdempotencyUsecase
 	config       *conf.Business_KafkaTopics
+	retry        messaging.RetryPolicy
 	log          *log.Helper
 }
 
@@ -31,6 +41,10 @@ type VideoProcessConsumer struct {
 func NewVideoProcessConsumer(
 	kafkaManager *messaging.KafkaManager,
 	storage storage.VideoStorage,
+	processingUc *biz.ProcessingUsecase,
+	videoUc *biz.VideoUsecase,
+	deadLetterUc *biz.DeadLetterUsecase,
+	idempotency *biz.IdempotencyUsecase,
 	businessConfig *conf.Business,
 	logger log.Logger,
 ) *VideoProcessConsumer {
@@ -45,32 +59,81 @@ func NewVideoProcessConsumer(
 		storage:      storage,
 		processor:    processor,
 		thumbnail:    thumbnail,
+		preview:      newPreviewOptions(businessConfig.GetVideo().GetDynamicCover()),
+		loudness:     newLoudnessOptions(businessConfig.GetVideo().GetAudioNormalization()),
+		processingUc: processingUc,
+		videoUc:      videoUc,
+		deadLetterUc: deadLetterUc,
+		idempotency:  idempotency,
 		config:       businessConfig.KafkaTopics,
+		retry:        newRetryPolicy(businessConfig.GetConsumerRetry()),
 		log:          log.NewHelper(logger),
 	}
 }
 
-// Start 启动消费者
-func (c *VideoProcessConsumer) Start(ctx context.Context) error {
-	consumer := c.kafkaManager.GetConsumer()
+// newRetryPolicy 从配置创建重试策略，未配置的字段使用默认值
+func newRetryPolicy(cfg *conf.Business_ConsumerRetry) messaging.RetryPolicy {
+	policy := messaging.DefaultRetryPolicy()
+	if cfg.GetMaxAttempts() > 0 {
+		policy.MaxAttempts = int(cfg.GetMaxAttempts())
+	}
+	if d := cfg.GetInitialBackoff().AsDuration(); d > 0 {
+		policy.InitialBackoff = d
+	}
+	if d := cfg.GetMaxBackoff().AsDuration(); d > 0 {
+		policy.MaxBackoff = d
+	}
+	return policy
+}
 
-	// 订阅视频上传事件
-	if err := consumer.Subscribe(c.config.VideoUpload, c.handleVideoUploadEvent); err != nil {
-		return err
+// newPreviewOptions 从配置创建动态封面参数，未启用时返回nil
+func newPreviewOptions(cfg *conf.Business_Video_DynamicCover) *media.PreviewOptions {
+	if !cfg.GetEnabled() {
+		return nil
+	}
+	return &media.PreviewOptions{
+		StartSeconds: cfg.GetStartOffset().AsDuration().Seconds(),
+		Seconds:      cfg.GetDuration().AsDuration().Seconds(),
+		Width:        int(cfg.GetWidth()),
+		FPS:          int(cfg.GetFps()),
+		Format:       media.ParsePreviewFormat(cfg.GetFormat()),
+		Quality:      int(cfg.GetQuality()),
+	}
+}
+
+// newLoudnessOptions 从配置创建响度归一化参数，未启用时返回nil
+func newLoudnessOptions(cfg *conf.Business_Video_AudioNormalization) *media.LoudnessOptions {
+	if !cfg.GetEnabled() {
+		return nil
 	}
+	return &media.LoudnessOptions{
+		TargetLoudness: cfg.GetTargetLoudness(),
+		TruePeak:       cfg.GetTruePeak(),
+		LoudnessRange:  cfg.GetLoudnessRange(),
+		AudioBitrate:   cfg.GetAudioBitrate(),
+	}
+}
 
-	// 订阅视频处理事件
-	if err := consumer.Subscribe(c.config.VideoProcess, c.handleVideoProcessEvent); err != nil {
+// Register 在共享的消费者上订阅视频事件并设置重试策略，消费者的启停由 server.Workers 负责
+func (c *VideoProcessConsumer) Register(consumer messaging.Consumer) error {
+	consumer.SetRetryPolicy(c.retry, c.deadLetter)
+
+	// 订阅视频上传事件，重复投递的事件不会重复转码和上传封面
+	if err := consumer.Subscribe(c.config.VideoUpload, idempotent(c.idempotency, "video_upload", c.handleVideoUploadEvent)); err != nil {
 		return err
 	}
 
-	return consumer.Start(ctx)
+	// 订阅视频处理事件
+	return consumer.Subscribe(c.config.VideoProcess, idempotent(c.idempotency, "video_process", c.handleVideoProcessEvent))
 }
 
-// Stop 停止消费者
-func (c *VideoProcessConsumer) Stop() error {
-	consumer := c.kafkaManager.GetConsumer()
-	return consumer.Stop()
+// deadLetter 重试耗尽的消息先投递到死信主题，再记录到数据库供管理后台重放，
+// 任一步失败都不提交位点，消息会在再均衡后重新投递
+func (c *VideoProcessConsumer) deadLetter(ctx context.Context, event *messaging.DeadLetterEvent) error {
+	if err := c.kafkaManager.SendDeadLetter(ctx, c.config.DeadLetter, event); err != nil {
+		return err
+	}
+	return c.deadLetterUc.Record(ctx, event)
 }
 
 // handleVideoUploadEvent 处理视频上传事件
@@ -89,18 +152,19 @@ func (c *VideoProcessConsumer) handleVideoUploadEvent(ctx context.Context, messa
 		return err
 	}
 
-	// 异步处理视频
-	go func() {
-		c.processVideo(context.Background(), &event)
-	}()
-
-	return nil
+	// 同步处理视频，失败时由消费者按策略重试，重试耗尽后进入死信
+	return c.processVideo(ctx, &event)
 }
 
 // handleVideoProcessEvent 处理视频处理事件
 func (c *VideoProcessConsumer) handleVideoProcessEvent(ctx context.Context, message *messaging.BaseMessage) error {
 	c.log.WithContext(ctx).Infof("received video process event: %s", message.ID)
 
+	// 审核事件与处理事件共用主题，由下游审核消费者处理
+	if message.Type == messaging.VideoAuditMessage {
+		return nil
+	}
+
 	var event domain.VideoProcessedEvent
 	data, err := json.Marshal(message.Data)
 	if err != nil {
@@ -117,36 +181,87 @@ func (c *VideoProcessConsumer) handleVideoProcessEvent(ctx context.Context, mess
 }
 
 // processVideo 处理视频
-func (c *VideoProcessConsumer) processVideo(ctx context.Context, event *domain.VideoUploadedEvent) {
+func (c *VideoProcessConsumer) processVideo(ctx context.Context, event *domain.VideoUploadedEvent) error {
 	c.log.WithContext(ctx).Infof("start processing video: %d", event.VideoID)
 
 	// 生成缩略图
-	if err := c.generateThumbnail(ctx, event); err != nil {
+	if err := c.runJob(ctx, event, domain.ProcessTypeThumbnail, c.generateThumbnail); err != nil {
 		c.log.WithContext(ctx).Errorf("generate thumbnail failed: %v", err)
 		c.publishProcessFailedEvent(ctx, event.VideoID, domain.ProcessTypeThumbnail, err.Error())
-		return
+		return err
+	}
+
+	// 生成动态封面，只用于预览，失败时不重试，客户端退回静态封面
+	if c.preview != nil {
+		if err := c.runJob(ctx, event, domain.ProcessTypeDynamicCover, c.generateDynamicCover); err != nil {
+			c.log.WithContext(ctx).Warnf("generate dynamic cover failed: video=%d err=%v", event.VideoID, err)
+		}
+	}
+
+	// 音轨响度归一化，失败时各清晰度沿用原音轨
+	source := c.extractObjectName(event.PlayURL)
+	if c.loudness != nil {
+		if normalized := c.normalizeAudio(ctx, event); normalized != "" {
+			source = normalized
+			defer c.removeIntermediate(ctx, normalized)
+		}
 	}
 
 	// 视频转码
-	if err := c.transcodeVideo(ctx, event); err != nil {
+	transcode := func(ctx context.Context, event *domain.VideoUploadedEvent) (int64, error) {
+		return c.transcodeVideo(ctx, event, source)
+	}
+	if err := c.runJob(ctx, event, domain.ProcessTypeTranscode, transcode); err != nil {
 		c.log.WithContext(ctx).Errorf("transcode video failed: %v", err)
 		c.publishProcessFailedEvent(ctx, event.VideoID, domain.ProcessTypeTranscode, err.Error())
-		return
+		return err
 	}
 
 	// 发布处理成功事件
 	c.publishProcessSuccessEvent(ctx, event.VideoID)
+	return nil
 }
 
-// generateThumbnail 生成缩略图
-func (c *VideoProcessConsumer) generateThumbnail(ctx context.Context, event *domain.VideoUploadedEvent) error {
+// runJob 执行一个处理任务并记录耗时、CPU时间和输出大小
+func (c *VideoProcessConsumer) runJob(
+	ctx context.Context,
+	event *domain.VideoUploadedEvent,
+	jobType string,
+	fn func(context.Context, *domain.VideoUploadedEvent) (int64, error),
+) error {
+	usage := &media.Usage{}
+	start := time.Now()
+
+	outputBytes, err := fn(media.WithUsage(ctx, usage), event)
+
+	job := &domain.ProcessingJob{
+		VideoID:     event.VideoID,
+		AuthorID:    event.AuthorID,
+		JobType:     jobType,
+		Status:      domain.ProcessStatusSuccess,
+		DurationMs:  time.Since(start).Milliseconds(),
+		CPUSeconds:  usage.CPUTime().Seconds(),
+		InputBytes:  event.Size,
+		OutputBytes: outputBytes,
+	}
+	if err != nil {
+		job.Status = domain.ProcessStatusFailed
+		job.ErrorMessage = err.Error()
+	}
+	c.processingUc.RecordJob(ctx, job)
+
+	return err
+}
+
+// generateThumbnail 生成缩略图，返回缩略图大小
+func (c *VideoProcessConsumer) generateThumbnail(ctx context.Context, event *domain.VideoUploadedEvent) (int64, error) {
 	c.log.WithContext(ctx).Infof("generating thumbnail for video: %d", event.VideoID)
 
 	// 1. 从存储下载视频文件
 	objectName := c.extractObjectName(event.PlayURL)
 	videoReader, err := c.storage.Download(ctx, objectName)
 	if err != nil {
-		return fmt.Errorf("download video failed: %w", err)
+		return 0, fmt.Errorf("download video failed: %w", err)
 	}
 	defer videoReader.Close()
 
@@ -157,65 +272,240 @@ func (c *VideoProcessConsumer) generateThumbnail(ctx context.Context, event *dom
 		// 如果从视频生成失败，使用默认缩略图
 		thumbnailReader, err = c.thumbnail.GenerateDefault(ctx)
 		if err != nil {
-			return fmt.Errorf("generate default thumbnail failed: %w", err)
+			return 0, fmt.Errorf("generate default thumbnail failed: %w", err)
 		}
 	}
 
 	// 3. 读取缩略图数据
 	thumbnailData, err := io.ReadAll(thumbnailReader)
 	if err != nil {
-		return fmt.Errorf("read thumbnail data failed: %w", err)
+		return 0, fmt.Errorf("read thumbnail data failed: %w", err)
 	}
 
 	// 4. 上传缩略图到存储
 	coverFilename := fmt.Sprintf("cover_%d.jpg", event.VideoID)
 	coverURL, err := c.storage.UploadCover(ctx, coverFilename, bytes.NewReader(thumbnailData), int64(len(thumbnailData)))
 	if err != nil {
-		return fmt.Errorf("upload thumbnail failed: %w", err)
+		return 0, fmt.Errorf("upload thumbnail failed: %w", err)
 	}
 
 	// 5. 更新视频封面URL（这里可以发送事件或直接调用repo）
 	c.log.WithContext(ctx).Infof("thumbnail generated successfully: video_id=%d, cover_url=%s", event.VideoID, coverURL)
 
-	return nil
+	return int64(len(thumbnailData)), nil
+}
+
+// generateDynamicCover 截取视频开头几秒生成动态封面并保存地址，返回动态封面大小
+func (c *VideoProcessConsumer) generateDynamicCover(ctx context.Context, event *domain.VideoUploadedEvent) (int64, error) {
+	videoReader, err := c.storage.Download(ctx, c.extractObjectName(event.PlayURL))
+	if err != nil {
+		return 0, fmt.Errorf("download video failed: %w", err)
+	}
+	defer videoReader.Close()
+
+	var buf bytes.Buffer
+	if err := c.processor.GenerateAnimatedPreview(ctx, videoReader, &buf, c.preview); err != nil {
+		return 0, err
+	}
+
+	filename := fmt.Sprintf("dynamic_cover_%d%s", event.VideoID, c.preview.Format.Ext())
+	url, err := c.storage.UploadCover(ctx, filename, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		return 0, fmt.Errorf("upload dynamic cover failed: %w", err)
+	}
+	if err := c.videoUc.UpdateVideoDynamicCover(ctx, event.VideoID, url); err != nil {
+		return 0, fmt.Errorf("save dynamic cover failed: %w", err)
+	}
+
+	c.log.WithContext(ctx).Infof("dynamic cover generated: video_id=%d, url=%s, size=%d", event.VideoID, url, buf.Len())
+	return int64(buf.Len()), nil
+}
+
+// normalizeAudio 执行响度归一化任务，返回归一化后的中间文件对象键。没有音轨、静音或处理失败时返回空
+func (c *VideoProcessConsumer) normalizeAudio(ctx context.Context, event *domain.VideoUploadedEvent) string {
+	var objectName string
+	err := c.runJob(ctx, event, domain.ProcessTypeAudioNormalize, func(ctx context.Context, event *domain.VideoUploadedEvent) (int64, error) {
+		var (
+			size int64
+			err  error
+		)
+		objectName, size, err = c.normalizeAudioTrack(ctx, event)
+		return size, err
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("normalize audio failed, transcoding with original audio: video=%d err=%v", event.VideoID, err)
+		return ""
+	}
+	return objectName
 }
 
-// transcodeVideo 视频转码
-func (c *VideoProcessConsumer) transcodeVideo(ctx context.Context, event *domain.VideoUploadedEvent) error {
+// normalizeAudioTrack 下载原视频归一化音轨响度，重新封装后作为转码输入上传，返回对象键和大小
+func (c *VideoProcessConsumer) normalizeAudioTrack(ctx context.Context, event *domain.VideoUploadedEvent) (string, int64, error) {
+	videoReader, err := c.storage.Download(ctx, c.extractObjectName(event.PlayURL))
+	if err != nil {
+		return "", 0, fmt.Errorf("download video failed: %w", err)
+	}
+	defer videoReader.Close()
+
+	var buf bytes.Buffer
+	stats, err := c.processor.NormalizeAudio(ctx, videoReader, &buf, c.loudness)
+	if errors.Is(err, media.ErrNoAudioStream) || errors.Is(err, media.ErrSilentAudio) {
+		c.log.WithContext(ctx).Infof("skip audio normalization: video=%d reason=%v", event.VideoID, err)
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	size := int64(buf.Len())
+	objectName, err := c.storage.UploadRendition(ctx, fmt.Sprintf("normalized_%d.mkv", event.VideoID), bytes.NewReader(buf.Bytes()), size)
+	if err != nil {
+		return "", 0, fmt.Errorf("upload normalized video failed: %w", err)
+	}
+
+	c.log.WithContext(ctx).Infof("audio normalized: video_id=%d, input_i=%.2f LUFS, input_tp=%.2f dBTP, input_lra=%.2f LU",
+		event.VideoID, stats.InputI, stats.InputTP, stats.InputLRA)
+	return objectName, size, nil
+}
+
+// removeIntermediate 删除只供本次转码使用的中间文件，删除失败时由孤儿对象清理任务兜底
+func (c *VideoProcessConsumer) removeIntermediate(ctx context.Context, objectName string) {
+	if err := c.storage.Delete(ctx, objectName); err != nil {
+		c.log.WithContext(ctx).Warnf("delete intermediate object failed: object=%s err=%v", objectName, err)
+	}
+}
+
+// transcodeVideo 将视频转码为各清晰度并保存播放地址，同时生成HLS自适应码率播放列表，返回输出总大小。
+// 元信息从原视频探测，各清晰度从 source 转码，开启响度归一化时 source 为归一化后的中间文件。
+// 不输出高于原视频分辨率的清晰度，最低清晰度总是输出
+func (c *VideoProcessConsumer) transcodeVideo(ctx context.Context, event *domain.VideoUploadedEvent, source string) (int64, error) {
 	c.log.WithContext(ctx).Infof("transcoding video: %d", event.VideoID)
 
-	// 1. 从存储下载原始视频
-	objectName := c.extractObjectName(event.PlayURL)
+	sourceHeight := c.probeSource(ctx, event.VideoID, c.extractObjectName(event.PlayURL))
+
+	playURLs := make(map[string]string, len(domain.VideoRenditions))
+	variants := make([]media.HLSVariant, 0, len(domain.VideoRenditions))
+	var outputBytes int64
+	for i, rendition := range domain.VideoRenditions {
+		if i > 0 && sourceHeight > 0 && rendition.Height > sourceHeight {
+			break
+		}
+
+		url, size, err := c.transcodeRendition(ctx, event.VideoID, source, rendition)
+		if err != nil {
+			return outputBytes, fmt.Errorf("transcode %s failed: %w", rendition.Quality, err)
+		}
+		playURLs[rendition.Quality] = url
+		outputBytes += size
+
+		variants = append(variants, media.HLSVariant{
+			URI:       rendition.Quality + "/" + media.HLSPlaylistName,
+			Bandwidth: rendition.Bandwidth,
+			Width:     rendition.Width,
+			Height:    rendition.Height,
+		})
+	}
+
+	if err := c.videoUc.UpdateVideoPlayURLs(ctx, event.VideoID, playURLs); err != nil {
+		return outputBytes, fmt.Errorf("save play urls failed: %w", err)
+	}
+
+	// 各清晰度的切片已上传，最后写主播放列表
+	master := media.BuildMasterPlaylist(variants)
+	info, err := c.storage.Upload(ctx, hlsObjectName(event.VideoID, media.HLSMasterPlaylistName), bytes.NewReader(master), int64(len(master)),
+		&storage.UploadOptions{ContentType: media.HLSPlaylistContentType})
+	if err != nil {
+		return outputBytes, fmt.Errorf("upload hls master playlist failed: %w", err)
+	}
+	outputBytes += int64(len(master))
+
+	if err := c.videoUc.UpdateVideoHLSURL(ctx, event.VideoID, info.URL); err != nil {
+		return outputBytes, fmt.Errorf("save hls url failed: %w", err)
+	}
+
+	c.log.WithContext(ctx).Infof("video transcoded successfully: video_id=%d, renditions=%d", event.VideoID, len(playURLs))
+
+	return outputBytes, nil
+}
+
+// transcodeRendition 转码单个清晰度，上传MP4文件并切成HLS分片，返回MP4播放地址和输出总大小
+func (c *VideoProcessConsumer) transcodeRendition(ctx context.Context, videoID int64, objectName string, rendition domain.Rendition) (string, int64, error) {
+	// 每个清晰度都重新下载原始视频，转码器会读完输入流
 	videoReader, err := c.storage.Download(ctx, objectName)
 	if err != nil {
-		return fmt.Errorf("download video failed: %w", err)
+		return "", 0, fmt.Errorf("download video failed: %w", err)
 	}
 	defer videoReader.Close()
 
-	// 2. 使用处理器进行转码
 	var transcodedBuffer bytes.Buffer
 	opts := &media.ProcessorOptions{
-		Width:   1280,
-		Height:  720,
+		Width:   rendition.Width,
+		Height:  rendition.Height,
 		Format:  "mp4",
 		Quality: 80,
 	}
+	if err := c.processor.TranscodeVideo(ctx, videoReader, &transcodedBuffer, opts); err != nil {
+		return "", 0, err
+	}
+
+	transcoded := transcodedBuffer.Bytes()
+	size := int64(len(transcoded))
+	filename := fmt.Sprintf("transcoded_%d_%s.mp4", videoID, rendition.Quality)
+	url, err := c.storage.UploadRendition(ctx, filename, bytes.NewReader(transcoded), size)
+	if err != nil {
+		return "", 0, fmt.Errorf("upload transcoded video failed: %w", err)
+	}
+
+	hlsBytes, err := c.packageHLS(ctx, videoID, rendition.Quality, transcoded)
+	if err != nil {
+		return "", 0, err
+	}
+	return url, size + hlsBytes, nil
+}
 
-	err = c.processor.TranscodeVideo(ctx, videoReader, &transcodedBuffer, opts)
+// packageHLS 将转码后的视频切片并上传到 hls/<视频ID>/<清晰度>/ 下，返回上传的总大小
+func (c *VideoProcessConsumer) packageHLS(ctx context.Context, videoID int64, quality string, transcoded []byte) (int64, error) {
+	var uploaded int64
+	err := c.processor.PackageHLS(ctx, bytes.NewReader(transcoded), nil, func(name string, data []byte) error {
+		objectName := hlsObjectName(videoID, quality+"/"+name)
+		if _, err := c.storage.Upload(ctx, objectName, bytes.NewReader(data), int64(len(data)),
+			&storage.UploadOptions{ContentType: media.HLSContentType(name)}); err != nil {
+			return fmt.Errorf("upload hls file %s failed: %w", objectName, err)
+		}
+		uploaded += int64(len(data))
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("transcode video failed: %w", err)
+		return uploaded, fmt.Errorf("package hls failed: %w", err)
 	}
+	return uploaded, nil
+}
+
+// hlsObjectName 视频HLS文件的对象键，同一视频的播放列表和分片放在同一前缀下，便于相对引用
+func hlsObjectName(videoID int64, name string) string {
+	return fmt.Sprintf("hls/%d/%s", videoID, name)
+}
 
-	// 3. 上传转码后的视频
-	transcodedFilename := fmt.Sprintf("transcoded_%d.mp4", event.VideoID)
-	transcodedURL, err := c.storage.UploadVideo(ctx, transcodedFilename, &transcodedBuffer, int64(transcodedBuffer.Len()))
+// probeSource 探测原视频并保存元信息，返回原视频高度，探测失败时返回0，按所有清晰度转码
+func (c *VideoProcessConsumer) probeSource(ctx context.Context, videoID int64, objectName string) int {
+	videoReader, err := c.storage.Download(ctx, objectName)
 	if err != nil {
-		return fmt.Errorf("upload transcoded video failed: %w", err)
+		c.log.WithContext(ctx).Warnf("download video for metadata failed: %v", err)
+		return 0
 	}
+	defer videoReader.Close()
 
-	c.log.WithContext(ctx).Infof("video transcoded successfully: video_id=%d, transcoded_url=%s", event.VideoID, transcodedURL)
+	metadata, err := c.validateVideoMetadata(ctx, videoReader)
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("probe video metadata failed, transcoding all renditions: %v", err)
+		return 0
+	}
 
-	return nil
+	// 元信息只用于展示，保存失败不影响转码
+	if err := c.videoUc.UpdateVideoMetadata(ctx, videoID, metadata); err != nil {
+		c.log.WithContext(ctx).Warnf("save video metadata failed: video=%d err=%v", videoID, err)
+	}
+	return metadata.Height
 }
 
 // handleProcessResult 处理处理结果
@@ -0,0 +1,171 @@
+package data
+
+import (
+	"context"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type counterReconcileRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	log         *log.Helper
+}
+
+// NewCounterReconcileRepo .
+func NewCounterReconcileRepo(data *Data, invalidator domain.CacheInvalidationPublisher, logger log.Logger) biz.CounterReconcileRepo {
+	return &counterReconcileRepo{
+		data:        data,
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// ReconcileUserCounters 按关注关系表和点赞表重算用户计数，修复后删除 Redis 关注计数器由读取时回源
+func (r *counterReconcileRepo) ReconcileUserCounters(ctx context.Context, afterID int64, limit int, dryRun bool) (*biz.CounterReconcileBatch, error) {
+	var users []User
+	if err := r.data.db.WithContext(ctx).
+		Select("id", "follow_count", "follower_count", "favorite_count").
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	batch := &biz.CounterReconcileBatch{Scanned: len(users)}
+	if len(users) == 0 {
+		return batch, nil
+	}
+	batch.LastID = users[len(users)-1].ID
+
+	userIDs := make([]int64, len(users))
+	for i, u := range users {
+		userIDs[i] = u.ID
+	}
+	follows, err := r.countBy(ctx, &UserFollow{}, "user_id", userIDs)
+	if err != nil {
+		return nil, err
+	}
+	followers, err := r.countBy(ctx, &UserFollow{}, "follow_user_id", userIDs)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := r.countBy(ctx, &UserFavorite{}, "user_id", userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifted []int64
+	for _, u := range users {
+		updates := make(map[string]interface{})
+		batch.Corrections = appendDrift(batch.Corrections, updates, u.ID, "follow_count", int64(u.FollowCount), follows[u.ID])
+		batch.Corrections = appendDrift(batch.Corrections, updates, u.ID, "follower_count", int64(u.FollowerCount), followers[u.ID])
+		batch.Corrections = appendDrift(batch.Corrections, updates, u.ID, "favorite_count", int64(u.FavoriteCount), favorites[u.ID])
+		if len(updates) == 0 || dryRun {
+			continue
+		}
+		if err := r.data.db.WithContext(ctx).Model(&User{}).Where("id = ?", u.ID).Updates(updates).Error; err != nil {
+			return nil, err
+		}
+		drifted = append(drifted, u.ID)
+	}
+
+	if len(drifted) > 0 {
+		keys := make([]string, len(drifted))
+		for i, userID := range drifted {
+			keys[i] = userCountsKey(userID)
+		}
+		if err := r.data.rdb.Del(ctx, keys...).Err(); err != nil {
+			r.log.WithContext(ctx).Warnf("delete user counts cache failed: %v", err)
+		}
+		invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeUser, drifted...))
+	}
+	return batch, nil
+}
+
+// ReconcileVideoCounters 按点赞表重算视频点赞数，修复后删除点赞数缓存
+func (r *counterReconcileRepo) ReconcileVideoCounters(ctx context.Context, afterID int64, limit int, dryRun bool) (*biz.CounterReconcileBatch, error) {
+	var videos []VideoModel
+	if err := r.data.db.WithContext(ctx).
+		Select("id", "favorite_count").
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, err
+	}
+
+	batch := &biz.CounterReconcileBatch{Scanned: len(videos)}
+	if len(videos) == 0 {
+		return batch, nil
+	}
+	batch.LastID = videos[len(videos)-1].ID
+
+	videoIDs := make([]int64, len(videos))
+	for i, v := range videos {
+		videoIDs[i] = v.ID
+	}
+	favorites, err := r.countBy(ctx, &UserFavorite{}, "video_id", videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifted []int64
+	for _, v := range videos {
+		updates := make(map[string]interface{})
+		batch.Corrections = appendDrift(batch.Corrections, updates, v.ID, "favorite_count", v.FavoriteCount, favorites[v.ID])
+		if len(updates) == 0 || dryRun {
+			continue
+		}
+		if err := r.data.db.WithContext(ctx).Model(&VideoModel{}).Where("id = ?", v.ID).Updates(updates).Error; err != nil {
+			return nil, err
+		}
+		drifted = append(drifted, v.ID)
+	}
+
+	if len(drifted) > 0 {
+		keys := make([]string, len(drifted))
+		for i, videoID := range drifted {
+			keys[i] = favoriteCountKey(videoID)
+		}
+		if err := r.data.rdb.Del(ctx, keys...).Err(); err != nil {
+			r.log.WithContext(ctx).Warnf("delete favorite count cache failed: %v", err)
+		}
+		invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, drifted...))
+	}
+	return batch, nil
+}
+
+// countBy 按 column 分组统计 model 表中的行数，column 只取代码中的固定列名
+func (r *counterReconcileRepo) countBy(ctx context.Context, model interface{}, column string, ids []int64) (map[int64]int64, error) {
+	var rows []struct {
+		ID    int64
+		Total int64
+	}
+	if err := r.data.db.WithContext(ctx).Model(model).
+		Select(column+" AS id, COUNT(*) AS total").
+		Where(column+" IN ?", ids).
+		Group(column).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		result[row.ID] = row.Total
+	}
+	return result, nil
+}
+
+// appendDrift 计数不一致时记录偏差并加入待更新字段
+func appendDrift(corrections []biz.CounterCorrection, updates map[string]interface{}, id int64, column string, stored, actual int64) []biz.CounterCorrection {
+	if stored == actual {
+		return corrections
+	}
+	updates[column] = actual
+	return append(corrections, biz.CounterCorrection{ID: id, Column: column, Stored: stored, Actual: actual})
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounterReconcileRepo(t *testing.T) {
+	favorite, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := &counterReconcileRepo{
+		data:        favorite.data,
+		invalidator: favorite.invalidator,
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user, author := users[0], users[1]
+	video := createFavoriteTestVideo(t, repo.data, author.ID)
+	require.NoError(t, favorite.Like(ctx, user.ID, video.ID, author.ID))
+
+	// 人为制造偏差
+	require.NoError(t, repo.data.db.Model(&User{}).Where("id = ?", user.ID).
+		Updates(map[string]interface{}{"follow_count": 7, "favorite_count": 0}).Error)
+	require.NoError(t, repo.data.db.Model(&VideoModel{}).Where("id = ?", video.ID).
+		Update("favorite_count", 5).Error)
+
+	t.Run("DryRunReportsOnly", func(t *testing.T) {
+		batch, err := repo.ReconcileUserCounters(ctx, 0, 100, true)
+		require.NoError(t, err)
+		assert.Equal(t, 2, batch.Scanned)
+		assert.Len(t, batch.Corrections, 2)
+
+		var stored User
+		require.NoError(t, repo.data.db.First(&stored, user.ID).Error)
+		assert.Equal(t, 7, stored.FollowCount)
+	})
+
+	t.Run("RepairUsers", func(t *testing.T) {
+		batch, err := repo.ReconcileUserCounters(ctx, 0, 100, false)
+		require.NoError(t, err)
+		assert.Len(t, batch.Corrections, 2)
+
+		var stored User
+		require.NoError(t, repo.data.db.First(&stored, user.ID).Error)
+		assert.Equal(t, 0, stored.FollowCount)
+		assert.Equal(t, 1, stored.FavoriteCount)
+
+		batch, err = repo.ReconcileUserCounters(ctx, 0, 100, false)
+		require.NoError(t, err)
+		assert.Empty(t, batch.Corrections)
+	})
+
+	t.Run("RepairVideos", func(t *testing.T) {
+		batch, err := repo.ReconcileVideoCounters(ctx, 0, 100, false)
+		require.NoError(t, err)
+		require.Len(t, batch.Corrections, 1)
+		assert.Equal(t, int64(5), batch.Corrections[0].Stored)
+		assert.Equal(t, int64(1), batch.Corrections[0].Actual)
+
+		count, err := favorite.GetFavoriteCount(ctx, video.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		batch, err := repo.ReconcileUserCounters(ctx, 0, 1, true)
+		require.NoError(t, err)
+		assert.Equal(t, 1, batch.Scanned)
+
+		next, err := repo.ReconcileUserCounters(ctx, batch.LastID, 1, true)
+		require.NoError(t, err)
+		assert.Equal(t, 1, next.Scanned)
+		assert.Greater(t, next.LastID, batch.LastID)
+	})
+}
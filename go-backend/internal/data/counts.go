@@ -0,0 +1,236 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// userCountsTTL 计数器过期时间，过期后从关注关系表回源
+	userCountsTTL = time.Hour
+	// userCountsDirtyKey 等待校正的用户集合
+	userCountsDirtyKey = "counts:user:dirty"
+
+	followCountField   = "follow"
+	followerCountField = "follower"
+)
+
+// adjustFollowCountsScript 关注关系变化时调整双方计数：已缓存的计数原子增减且不小于0，
+// 未缓存的等读取时回源；双方都标记为待校正
+var adjustFollowCountsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 and redis.call('HINCRBY', KEYS[1], 'follow', ARGV[1]) < 0 then
+	redis.call('HSET', KEYS[1], 'follow', 0)
+end
+if redis.call('EXISTS', KEYS[2]) == 1 and redis.call('HINCRBY', KEYS[2], 'follower', ARGV[1]) < 0 then
+	redis.call('HSET', KEYS[2], 'follower', 0)
+end
+redis.call('SADD', KEYS[3], ARGV[2], ARGV[3])
+return 1
+`)
+
+type countsRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	log         *log.Helper
+}
+
+// NewCountsRepo 创建关注计数仓储
+func NewCountsRepo(data *Data, invalidator domain.CacheInvalidationPublisher, logger log.Logger) biz.CountsRepo {
+	return &countsRepo{
+		data:        data,
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// GetUserCounts 批量读取关注计数，缺失的从关注关系表回源并写入 Redis
+func (r *countsRepo) GetUserCounts(ctx context.Context, userIDs []int64) (map[int64]biz.UserCounts, error) {
+	pipe := r.data.rdb.Pipeline()
+	cmds := make(map[int64]*redis.SliceCmd, len(userIDs))
+	for _, userID := range userIDs {
+		if _, ok := cmds[userID]; !ok {
+			cmds[userID] = pipe.HMGet(ctx, userCountsKey(userID), followCountField, followerCountField)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	result := make(map[int64]biz.UserCounts, len(cmds))
+	var missing []int64
+	for userID, cmd := range cmds {
+		counts, ok := parseUserCounts(cmd.Val())
+		if !ok {
+			missing = append(missing, userID)
+			continue
+		}
+		result[userID] = counts
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	loaded, err := r.countFollows(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	r.storeCounts(ctx, loaded)
+	for userID, counts := range loaded {
+		result[userID] = counts
+	}
+	return result, nil
+}
+
+// PopDirtyUsers 取出等待校正的用户
+func (r *countsRepo) PopDirtyUsers(ctx context.Context, limit int) ([]int64, error) {
+	members, err := r.data.rdb.SPopN(ctx, userCountsDirtyKey, int64(limit)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		userID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+// ReconcileUserCounts 按关注关系表重算计数，校正用户表中的冗余计数并覆盖 Redis 计数器
+func (r *countsRepo) ReconcileUserCounts(ctx context.Context, userIDs []int64) ([]int64, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	actual, err := r.countFollows(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	if err := r.data.db.WithContext(ctx).
+		Select("id", "follow_count", "follower_count").
+		Where("id IN ?", userIDs).
+		Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	var drifted []int64
+	for _, u := range users {
+		counts := actual[u.ID]
+		if u.FollowCount == counts.FollowCount && u.FollowerCount == counts.FollowerCount {
+			continue
+		}
+		if err := r.data.db.WithContext(ctx).Model(&User{}).Where("id = ?", u.ID).Updates(map[string]interface{}{
+			"follow_count":   counts.FollowCount,
+			"follower_count": counts.FollowerCount,
+		}).Error; err != nil {
+			return drifted, err
+		}
+		drifted = append(drifted, u.ID)
+	}
+
+	r.storeCounts(ctx, actual)
+	if len(drifted) > 0 {
+		invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeUser, drifted...))
+	}
+	return drifted, nil
+}
+
+// countFollows 从关注关系表统计关注数和粉丝数
+func (r *countsRepo) countFollows(ctx context.Context, userIDs []int64) (map[int64]biz.UserCounts, error) {
+	type row struct {
+		UserID int64
+		Total  int
+	}
+
+	var follows, followers []row
+	if err := r.data.db.WithContext(ctx).Model(&UserFollow{}).
+		Select("user_id, COUNT(*) AS total").
+		Where("user_id IN ?", userIDs).
+		Group("user_id").
+		Scan(&follows).Error; err != nil {
+		return nil, err
+	}
+	if err := r.data.db.WithContext(ctx).Model(&UserFollow{}).
+		Select("follow_user_id AS user_id, COUNT(*) AS total").
+		Where("follow_user_id IN ?", userIDs).
+		Group("follow_user_id").
+		Scan(&followers).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]biz.UserCounts, len(userIDs))
+	for _, userID := range userIDs {
+		result[userID] = biz.UserCounts{}
+	}
+	for _, f := range follows {
+		c := result[f.UserID]
+		c.FollowCount = f.Total
+		result[f.UserID] = c
+	}
+	for _, f := range followers {
+		c := result[f.UserID]
+		c.FollowerCount = f.Total
+		result[f.UserID] = c
+	}
+	return result, nil
+}
+
+// storeCounts 写入 Redis 计数器，失败只记录日志，下次读取时重新回源
+func (r *countsRepo) storeCounts(ctx context.Context, counts map[int64]biz.UserCounts) {
+	pipe := r.data.rdb.Pipeline()
+	for userID, c := range counts {
+		key := userCountsKey(userID)
+		pipe.HSet(ctx, key, followCountField, c.FollowCount, followerCountField, c.FollowerCount)
+		pipe.Expire(ctx, key, userCountsTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.WithContext(ctx).Warnf("store user counts failed: %v", err)
+	}
+}
+
+// adjustFollowCounts 关注关系提交后调整 Redis 计数器，delta 为1表示关注，-1表示取关
+func adjustFollowCounts(ctx context.Context, rdb *redis.Client, logger *log.Helper, userID, followUserID int64, delta int) {
+	keys := []string{userCountsKey(userID), userCountsKey(followUserID), userCountsDirtyKey}
+	if err := adjustFollowCountsScript.Run(ctx, rdb, keys, delta, userID, followUserID).Err(); err != nil && err != redis.Nil {
+		// 计数器可能已与关系表不一致，删除后由下次读取回源
+		logger.WithContext(ctx).Warnf("adjust follow counts failed: user=%d follow=%d err=%v", userID, followUserID, err)
+		rdb.Del(ctx, keys[0], keys[1])
+	}
+}
+
+func parseUserCounts(values []interface{}) (biz.UserCounts, bool) {
+	if len(values) != 2 {
+		return biz.UserCounts{}, false
+	}
+
+	parsed := make([]int, 2)
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return biz.UserCounts{}, false
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return biz.UserCounts{}, false
+		}
+		parsed[i] = n
+	}
+	return biz.UserCounts{FollowCount: parsed[0], FollowerCount: parsed[1]}, true
+}
+
+func userCountsKey(userID int64) string {
+	return fmt.Sprintf("counts:user:%d", userID)
+}
@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountsRepo(t *testing.T) {
+	relation, env, cleanup := setupRelationRepo(t)
+	defer cleanup()
+
+	repo := &countsRepo{
+		data:        relation.data,
+		invalidator: relation.invalidator,
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(3)
+	require.NoError(t, err)
+	alice, bob, carol := users[0].ID, users[1].ID, users[2].ID
+
+	require.NoError(t, relation.Follow(ctx, alice, bob))
+	require.NoError(t, relation.Follow(ctx, carol, bob))
+
+	t.Run("LoadFromRelations", func(t *testing.T) {
+		counts, err := repo.GetUserCounts(ctx, []int64{alice, bob})
+		require.NoError(t, err)
+		assert.Equal(t, 1, counts[alice].FollowCount)
+		assert.Equal(t, 2, counts[bob].FollowerCount)
+
+		exists, err := env.Redis.Client.Exists(ctx, userCountsKey(bob)).Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), exists)
+	})
+
+	t.Run("AdjustOnFollow", func(t *testing.T) {
+		require.NoError(t, relation.Unfollow(ctx, carol, bob))
+		require.NoError(t, relation.Follow(ctx, bob, alice))
+
+		counts, err := repo.GetUserCounts(ctx, []int64{alice, bob})
+		require.NoError(t, err)
+		assert.Equal(t, 1, counts[alice].FollowerCount)
+		assert.Equal(t, 1, counts[bob].FollowCount)
+		assert.Equal(t, 1, counts[bob].FollowerCount)
+	})
+
+	t.Run("ReconcileDrift", func(t *testing.T) {
+		require.NoError(t, env.Redis.Client.HSet(ctx, userCountsKey(bob), followerCountField, 42).Err())
+		require.NoError(t, repo.data.db.Model(&User{}).Where("id = ?", bob).Update("follower_count", 42).Error)
+
+		dirty, err := repo.PopDirtyUsers(ctx, 10)
+		require.NoError(t, err)
+		assert.Contains(t, dirty, bob)
+
+		drifted, err := repo.ReconcileUserCounts(ctx, dirty)
+		require.NoError(t, err)
+		assert.Contains(t, drifted, bob)
+
+		var u User
+		require.NoError(t, repo.data.db.First(&u, bob).Error)
+		assert.Equal(t, 1, u.FollowerCount)
+
+		counts, err := repo.GetUserCounts(ctx, []int64{bob})
+		require.NoError(t, err)
+		assert.Equal(t, 1, counts[bob].FollowerCount)
+	})
+}
@@ -1,10 +1,14 @@
 package data
 
 import (
+	"context"
+	"fmt"
 	"go-backend/internal/biz"
 	"go-backend/internal/conf"
 	"go-backend/internal/data/cache"
+	"go-backend/internal/domain"
 	pkgcache "go-backend/pkg/cache"
+	"go-backend/pkg/eventbus"
 	"go-backend/pkg/storage"
 	"time"
 
@@ -19,17 +23,82 @@ import (
 // ProviderSet is data providers.
 var ProviderSet = wire.NewSet(
 	NewData,
+	NewLocker,
 	NewUserRepo,
 	NewRelationRepo,
 	NewRoleRepo,
 	NewPermissionRepo,
 	NewSessionRepo,
+	NewSessionManager,
 	NewVideoRepo,
-	NewMinIOStorage,
+	NewMessageRepo,
+	NewFavoriteRepo,
+	NewCommentRepo,
+	NewMutedKeywordRepo,
+	NewModerationRepo,
+	NewRetentionRepo,
+	NewRegistrationRepo,
+	NewPermissionAuditRepo,
+	NewOwnershipRepo,
+	NewPasswordResetNotifier,
+	NewProcessingJobRepo,
+	NewReferralRepo,
+	NewContentDraftRepo,
+	NewWatchHistoryRepo,
+	NewPlayDedupRepo,
+	NewUserStatsRepo,
+	NewTrendingRepo,
+	NewSensitiveWordRepo,
+	NewSigningKeyRepo,
+	NewLoginHistoryRepo,
+	NewOAuthAccountRepo,
+	NewOutboxRepo,
+	NewProcessedEventRepo,
+	NewAccountDeletionRepo,
+	NewDataExportRepo,
+	NewStorageDeletionRepo,
+	NewOrphanCleanupRepo,
+	NewDeadLetterRepo,
+	NewDeadLetterPublisher,
+	NewDraftReminderNotifier,
+	NewTakedownRepo,
+	NewTakedownNotifier,
+	NewUserBanRepo,
+	NewCategoryRepo,
+	NewUploadChecksumRepo,
+	NewCallbackNonceStore,
+	NewOpsRepo,
+	NewQuotaRepo,
+	NewCounterReconcileRepo,
+	NewVideoStatsBufferRepo,
+	NewCaptionRepo,
+	NewIntegrityRepo,
+	NewIntegrityNotifier,
+	NewPromotionRepo,
+	NewPlaylistRepo,
+	NewShareLinkRepo,
+	NewPresenceRepo,
+	NewDependencyChecker,
+	NewEmailSender,
+	NewSecurityEventNotifier,
+	NewLoginCodeSenders,
+	NewVideoStorage,
+	NewCDN,
 	NewUserCache,
 	NewAuthCache,
 	NewVideoCache,
 	NewMultiLevelCache,
+	NewEventBus,
+	NewCacheInvalidationConsumer,
+	NewCacheInvalidationPublisher,
+	NewProfileProjection,
+	NewProfileReadModelRepo,
+	NewCountsRepo,
+	wire.Bind(new(biz.AuthRepo), new(*SessionRepo)),
+	wire.Bind(new(biz.EmailVerificationRepo), new(*SessionRepo)),
+	wire.Bind(new(biz.LoginCodeRepo), new(*SessionRepo)),
+	wire.Bind(new(biz.RoleRepo), new(*RoleRepo)),
+	wire.Bind(new(biz.PermissionRepo), new(*PermissionRepo)),
 )
 
 // Data .
@@ -86,6 +155,25 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	return d, cleanup, nil
 }
 
+// NewEventBus create in-process event bus, pending async events are drained on cleanup
+func NewEventBus(bc *conf.Business, logger log.Logger) (domain.EventBus, func()) {
+	cfg := &eventbus.Config{}
+	if c := bc.GetEventBus(); c != nil {
+		cfg.Workers = int(c.Workers)
+		cfg.QueueSize = int(c.QueueSize)
+	}
+	bus := eventbus.New(cfg, logger)
+
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := bus.Close(ctx); err != nil {
+			log.NewHelper(logger).Warnf("close event bus: %v", err)
+		}
+	}
+	return bus, cleanup
+}
+
 // NewMultiLevelCache create multilevel cache
 func NewMultiLevelCache(data *Data) *pkgcache.MultiLevelCache {
 	config := &pkgcache.CacheConfig{
@@ -97,6 +185,11 @@ func NewMultiLevelCache(data *Data) *pkgcache.MultiLevelCache {
 	return pkgcache.NewMultiLevelCache(data.rdb, config)
 }
 
+// NewLocker create distributed lock
+func NewLocker(data *Data) biz.Locker {
+	return pkgcache.NewDistributedLock(data.rdb, pkgcache.DefaultLockConfig())
+}
+
 // NewUserCache create user cache
 func NewUserCache(multiCache *pkgcache.MultiLevelCache, logger log.Logger) *cache.UserCache {
 	return cache.NewUserCache(multiCache, logger)
@@ -107,19 +200,105 @@ func NewAuthCache(multiCache *pkgcache.MultiLevelCache, logger log.Logger) *cach
 	return cache.NewAuthCache(multiCache, logger)
 }
 
-// NewMinIOStorage create MinIO storage
-func NewMinIOStorage(c *conf.Data, logger log.Logger) (storage.VideoStorage, error) {
-	config := &storage.MinIOConfig{
-		Endpoint:   c.Minio.Endpoint,
-		AccessKey:  c.Minio.AccessKey,
-		SecretKey:  c.Minio.SecretKey,
-		BucketName: c.Minio.BucketName,
-		Region:     c.Minio.Region,
-		UseSSL:     c.Minio.UseSsl,
-		BaseURL:    c.Minio.BaseUrl,
+// NewVideoStorage create storage of the configured driver, routed by object class
+func NewVideoStorage(c *conf.Data, logger log.Logger) (storage.VideoStorage, error) {
+	router, err := NewStorageRouter(c)
+	if err != nil {
+		return nil, err
+	}
+	log.NewHelper(logger).Infof("storage driver: %s", storageDriver(c))
+	return router, nil
+}
+
+// NewStorageRouter 按 storage_driver 为每个对象类别创建存储桶，未配置的类别使用 bucket_name
+func NewStorageRouter(c *conf.Data) (*storage.Router, error) {
+	driver := storageDriver(c)
+	base, buckets, err := storageConfig(c, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	fallback, err := storage.Open(driver, base)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make(map[storage.ObjectClass]storage.VideoStorage, len(buckets))
+	for name, bucket := range buckets {
+		class, ok := storage.ParseObjectClass(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown storage object class: %s", name)
+		}
+		// 与旧存储桶相同时不单独路由，迁移时跳过
+		if bucket.Name == "" || bucket.Name == base.BucketName {
+			continue
+		}
+
+		config := *base
+		config.BucketName = bucket.Name
+		config.BaseURL = bucket.BaseUrl
+		config.StorageClass = bucket.StorageClass
+		target, err := storage.Open(driver, &config)
+		if err != nil {
+			return nil, fmt.Errorf("create %s bucket failed: %w", class, err)
+		}
+		routes[class] = target
+	}
+
+	return storage.NewRouter(fallback, routes), nil
+}
+
+func storageDriver(c *conf.Data) storage.Provider {
+	if c.StorageDriver == "" {
+		return storage.ProviderMinIO
+	}
+	return storage.Provider(c.StorageDriver)
+}
+
+// storageConfig 读取所选驱动的配置，本地磁盘不区分存储桶
+func storageConfig(c *conf.Data, driver storage.Provider) (*storage.DriverConfig, map[string]*conf.Data_MinIO_Bucket, error) {
+	switch driver {
+	case storage.ProviderMinIO:
+		m := c.GetMinio()
+		return &storage.DriverConfig{
+			Endpoint:   m.GetEndpoint(),
+			AccessKey:  m.GetAccessKey(),
+			SecretKey:  m.GetSecretKey(),
+			BucketName: m.GetBucketName(),
+			Region:     m.GetRegion(),
+			UseSSL:     m.GetUseSsl(),
+			BaseURL:    m.GetBaseUrl(),
+		}, m.GetBuckets(), nil
+	case storage.ProviderS3:
+		s3 := c.GetS3()
+		return &storage.DriverConfig{
+			Endpoint:   s3.GetEndpoint(),
+			AccessKey:  s3.GetAccessKey(),
+			SecretKey:  s3.GetSecretKey(),
+			BucketName: s3.GetBucketName(),
+			Region:     s3.GetRegion(),
+			BaseURL:    s3.GetBaseUrl(),
+		}, s3.GetBuckets(), nil
+	case storage.ProviderLocal:
+		local := c.GetLocal()
+		return &storage.DriverConfig{
+			RootDir: local.GetRootDir(),
+			BaseURL: local.GetBaseUrl(),
+		}, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported storage driver %q (available: %v)", driver, storage.Drivers())
 	}
+}
 
-	return storage.NewMinIOStorage(config)
+// NewCDN create CDN url rewriter
+func NewCDN(c *conf.Data) *storage.CDN {
+	cdn := c.GetCdn()
+	return storage.NewCDN(&storage.CDNConfig{
+		Domain:  cdn.GetDomain(),
+		Origins: cdn.GetOrigins(),
+		SignKey: cdn.GetSignKey(),
+		SignTTL: cdn.GetSignTtl().AsDuration(),
+	})
 }
 
 // NewVideoCache create video cache
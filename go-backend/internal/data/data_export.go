@@ -0,0 +1,148 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const dataExportKeyPrefix = "data_export:"
+
+type dataExportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewDataExportRepo .
+func NewDataExportRepo(data *Data, logger log.Logger) biz.DataExportRepo {
+	return &dataExportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetUserDataExport 读取正常或冷静期中账号的数据，已删除的视频和评论不导出
+func (r *dataExportRepo) GetUserDataExport(ctx context.Context, userID int64) (*biz.UserDataExport, error) {
+	db := r.data.db.WithContext(ctx)
+
+	var u User
+	if err := db.Where("id = ? AND status IN ?", userID,
+		[]domain.UserStatus{domain.UserStatusActive, domain.UserStatusPendingDeletion}).
+		First(&u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	export := &biz.UserDataExport{
+		Profile: &biz.ExportProfile{
+			ID:              u.ID,
+			Username:        u.Username,
+			Nickname:        u.Nickname,
+			Avatar:          u.Avatar,
+			BackgroundImage: u.BackgroundImage,
+			Signature:       u.Signature,
+			Timezone:        u.Timezone,
+			IsPrivate:       u.IsPrivate,
+			LastLoginAt:     u.LastLoginAt,
+			CreatedAt:       u.CreatedAt,
+		},
+	}
+	if u.Email != nil {
+		export.Profile.Email = *u.Email
+	}
+	if u.Phone != nil {
+		export.Profile.Phone = *u.Phone
+	}
+
+	var videos []*VideoModel
+	if err := db.Where("author_id = ? AND status <> ?", userID, domain.VideoStatusDeleted).
+		Order("id ASC").
+		Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	for _, v := range videos {
+		export.Videos = append(export.Videos, &biz.ExportVideo{
+			ID:            v.ID,
+			Title:         v.Title,
+			Duration:      v.Duration,
+			PlayURL:       v.PlayURL,
+			CoverURL:      v.CoverURL,
+			Status:        v.Status,
+			FavoriteCount: v.FavoriteCount,
+			CommentCount:  v.CommentCount,
+			PlayCount:     v.PlayCount,
+			CreatedAt:     v.CreatedAt,
+		})
+	}
+
+	var err error
+	if export.Following, err = r.relations(db, "f.follow_user_id", "f.user_id = ?", userID); err != nil {
+		return nil, err
+	}
+	if export.Followers, err = r.relations(db, "f.user_id", "f.follow_user_id = ?", userID); err != nil {
+		return nil, err
+	}
+
+	var comments []*Comment
+	if err := db.Where("user_id = ? AND status <> ?", userID, biz.CommentStatusDeleted).
+		Order("id ASC").
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	for _, c := range comments {
+		export.Comments = append(export.Comments, &biz.ExportComment{
+			ID:        c.ID,
+			VideoID:   c.VideoID,
+			ParentID:  c.ParentID,
+			Content:   c.Content,
+			CreatedAt: c.CreatedAt,
+		})
+	}
+
+	return export, nil
+}
+
+// relations 关注或粉丝列表，附带对方的用户名
+func (r *dataExportRepo) relations(db *gorm.DB, otherColumn, where string, userID int64) ([]*biz.ExportRelation, error) {
+	var rows []struct {
+		UserID    int64
+		Username  string
+		CreatedAt time.Time
+	}
+	if err := db.Table("user_follows AS f").
+		Select(fmt.Sprintf("%s AS user_id, u.username, f.created_at", otherColumn)).
+		Joins(fmt.Sprintf("JOIN users u ON u.id = %s", otherColumn)).
+		Where(where, userID).
+		Order("f.id ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	relations := make([]*biz.ExportRelation, 0, len(rows))
+	for _, row := range rows {
+		relations = append(relations, &biz.ExportRelation{
+			UserID:    row.UserID,
+			Username:  row.Username,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return relations, nil
+}
+
+// AcquireDataExport 通过 SETNX 占用导出间隔，多实例部署时同一用户同时只有一个导出
+func (r *dataExportRepo) AcquireDataExport(ctx context.Context, userID int64, interval time.Duration) (bool, error) {
+	return r.data.rdb.SetNX(ctx, dataExportKeyPrefix+strconv.FormatInt(userID, 10), 1, interval).Result()
+}
+
+func (r *dataExportRepo) ReleaseDataExport(ctx context.Context, userID int64) error {
+	return r.data.rdb.Del(ctx, dataExportKeyPrefix+strconv.FormatInt(userID, 10)).Err()
+}
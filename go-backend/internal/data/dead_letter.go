@@ -0,0 +1,174 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/pkg/listquery"
+	"go-backend/pkg/messaging"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DeadLetterModel 死信消息模型
+type DeadLetterModel struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Topic      string     `gorm:"size:128;not null;uniqueIndex:uk_topic_partition_offset,priority:1" json:"topic"`
+	Partition  int32      `gorm:"not null;uniqueIndex:uk_topic_partition_offset,priority:2" json:"partition"`
+	Offset     int64      `gorm:"not null;uniqueIndex:uk_topic_partition_offset,priority:3" json:"offset"`
+	MessageKey string     `gorm:"size:255;not null;default:''" json:"message_key"`
+	MessageID  string     `gorm:"size:64;not null;default:''" json:"message_id"`
+	Payload    []byte     `gorm:"type:mediumblob;not null" json:"payload"`
+	Error      string     `gorm:"size:1000;not null" json:"error"`
+	Attempts   int32      `gorm:"not null;default:0" json:"attempts"`
+	Status     int32      `gorm:"not null;default:0;index:idx_status_created,priority:1" json:"status"`
+	ReplayedBy *int64     `json:"replayed_by"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;index:idx_status_created,priority:2" json:"created_at"`
+	ReplayedAt *time.Time `gorm:"index:idx_replayed_at" json:"replayed_at"`
+}
+
+func (DeadLetterModel) TableName() string {
+	return "dead_letter_messages"
+}
+
+type deadLetterRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewDeadLetterRepo .
+func NewDeadLetterRepo(data *Data, logger log.Logger) biz.DeadLetterRepo {
+	return &deadLetterRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *deadLetterRepo) CreateDeadLetter(ctx context.Context, letter *biz.DeadLetter) error {
+	model := &DeadLetterModel{
+		Topic:      letter.Topic,
+		Partition:  letter.Partition,
+		Offset:     letter.Offset,
+		MessageKey: letter.Key,
+		MessageID:  letter.MessageID,
+		Payload:    letter.Payload,
+		Error:      letter.Error,
+		Attempts:   letter.Attempts,
+		Status:     letter.Status,
+	}
+	if model.Payload == nil {
+		model.Payload = []byte{}
+	}
+
+	// 死信出口先于提交位点执行，重启后同一条消息可能再次进入死信
+	if err := r.data.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model).Error; err != nil {
+		return err
+	}
+
+	letter.ID = model.ID
+	return nil
+}
+
+func (r *deadLetterRepo) GetDeadLetter(ctx context.Context, id int64) (*biz.DeadLetter, error) {
+	var model DeadLetterModel
+	if err := r.data.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrDeadLetterNotFound
+		}
+		return nil, err
+	}
+	return r.toBiz(&model), nil
+}
+
+func (r *deadLetterRepo) ListDeadLetters(ctx context.Context, filter *biz.DeadLetterFilter, q *listquery.Compiled) ([]*biz.DeadLetter, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&DeadLetterModel{})
+	if filter != nil {
+		if filter.Topic != "" {
+			query = query.Where("topic = ?", filter.Topic)
+		}
+		if filter.Status != nil {
+			query = query.Where("status = ?", *filter.Status)
+		}
+	}
+	query = applyListFilters(query, q)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 列表不返回消息体，重放时再按ID读取
+	var models []DeadLetterModel
+	if err := applyListPage(query.Omit("payload"), q).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	letters := make([]*biz.DeadLetter, 0, len(models))
+	for i := range models {
+		letters = append(letters, r.toBiz(&models[i]))
+	}
+	return letters, total, nil
+}
+
+func (r *deadLetterRepo) MarkReplayed(ctx context.Context, id, adminID int64) error {
+	res := r.data.db.WithContext(ctx).Model(&DeadLetterModel{}).
+		Where("id = ? AND status = ?", id, biz.DeadLetterStatusPending).
+		Updates(map[string]interface{}{
+			"status":      biz.DeadLetterStatusReplayed,
+			"replayed_by": adminID,
+			"replayed_at": time.Now(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		if _, err := r.GetDeadLetter(ctx, id); err != nil {
+			return err
+		}
+		return biz.ErrDeadLetterReplayed
+	}
+	return nil
+}
+
+func (r *deadLetterRepo) toBiz(model *DeadLetterModel) *biz.DeadLetter {
+	letter := &biz.DeadLetter{
+		ID:         model.ID,
+		Topic:      model.Topic,
+		Partition:  model.Partition,
+		Offset:     model.Offset,
+		Key:        model.MessageKey,
+		MessageID:  model.MessageID,
+		Payload:    model.Payload,
+		Error:      model.Error,
+		Attempts:   model.Attempts,
+		Status:     model.Status,
+		CreatedAt:  model.CreatedAt,
+		ReplayedAt: model.ReplayedAt,
+	}
+	if model.ReplayedBy != nil {
+		letter.ReplayedBy = *model.ReplayedBy
+	}
+	return letter
+}
+
+type deadLetterPublisher struct {
+	kafkaManager *messaging.KafkaManager
+}
+
+// NewDeadLetterPublisher 创建死信重放发布者，Kafka不可用时重放返回错误
+func NewDeadLetterPublisher(kafkaManager *messaging.KafkaManager) biz.DeadLetterPublisher {
+	return &deadLetterPublisher{kafkaManager: kafkaManager}
+}
+
+func (p *deadLetterPublisher) Republish(ctx context.Context, topic, key string, payload []byte) error {
+	if p.kafkaManager == nil {
+		return fmt.Errorf("kafka unavailable, cannot replay message to %s", topic)
+	}
+	return p.kafkaManager.Republish(ctx, topic, key, payload)
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := &deadLetterRepo{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	letter := &biz.DeadLetter{
+		Topic:     "video-upload-topic",
+		Partition: 1,
+		Offset:    100,
+		Key:       "video_1",
+		MessageID: "m1",
+		Payload:   []byte(`{"id":"m1"}`),
+		Error:     "transcode failed",
+		Attempts:  3,
+	}
+
+	t.Run("CreateDeadLetter", func(t *testing.T) {
+		require.NoError(t, repo.CreateDeadLetter(ctx, letter))
+		assert.NotZero(t, letter.ID)
+
+		// 同一位点重复进入死信时忽略
+		duplicate := *letter
+		duplicate.ID = 0
+		require.NoError(t, repo.CreateDeadLetter(ctx, &duplicate))
+
+		other := &biz.DeadLetter{Topic: "video-process-topic", Offset: 7, Error: "bad payload"}
+		require.NoError(t, repo.CreateDeadLetter(ctx, other))
+
+		var count int64
+		require.NoError(t, env.DB.DB.Model(&DeadLetterModel{}).Count(&count).Error)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("GetDeadLetter", func(t *testing.T) {
+		got, err := repo.GetDeadLetter(ctx, letter.ID)
+		require.NoError(t, err)
+		assert.Equal(t, letter.Payload, got.Payload)
+		assert.Equal(t, "video_1", got.Key)
+		assert.Equal(t, int32(3), got.Attempts)
+
+		_, err = repo.GetDeadLetter(ctx, 99999)
+		assert.ErrorIs(t, err, biz.ErrDeadLetterNotFound)
+	})
+
+	t.Run("ListDeadLetters", func(t *testing.T) {
+		letters, total, err := repo.ListDeadLetters(ctx, &biz.DeadLetterFilter{Topic: "video-upload-topic"}, mustCompileListQuery(t, biz.DeadLetterListSchema, "", "", 20))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, letters, 1)
+		assert.Equal(t, letter.ID, letters[0].ID)
+
+		_, total, err = repo.ListDeadLetters(ctx, &biz.DeadLetterFilter{}, mustCompileListQuery(t, biz.DeadLetterListSchema, "", "", 20))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("MarkReplayed", func(t *testing.T) {
+		require.NoError(t, repo.MarkReplayed(ctx, letter.ID, 9))
+
+		got, err := repo.GetDeadLetter(ctx, letter.ID)
+		require.NoError(t, err)
+		assert.Equal(t, biz.DeadLetterStatusReplayed, got.Status)
+		assert.Equal(t, int64(9), got.ReplayedBy)
+		assert.NotNil(t, got.ReplayedAt)
+
+		assert.ErrorIs(t, repo.MarkReplayed(ctx, letter.ID, 9), biz.ErrDeadLetterReplayed)
+		assert.ErrorIs(t, repo.MarkReplayed(ctx, 99999, 9), biz.ErrDeadLetterNotFound)
+
+		pending := biz.DeadLetterStatusPending
+		_, total, err := repo.ListDeadLetters(ctx, &biz.DeadLetterFilter{Status: &pending}, mustCompileListQuery(t, biz.DeadLetterListSchema, "", "", 20))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+}
@@ -0,0 +1,51 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type dependencyChecker struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewDependencyChecker .
+func NewDependencyChecker(data *Data, logger log.Logger) biz.DependencyChecker {
+	return &dependencyChecker{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (c *dependencyChecker) CheckDependencies(ctx context.Context) []*biz.DependencyHealth {
+	return []*biz.DependencyHealth{
+		c.probe(ctx, "mysql", func(ctx context.Context) error {
+			sqlDB, err := c.data.db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.PingContext(ctx)
+		}),
+		c.probe(ctx, "redis", func(ctx context.Context) error {
+			return c.data.rdb.Ping(ctx).Err()
+		}),
+	}
+}
+
+func (c *dependencyChecker) probe(ctx context.Context, name string, ping func(context.Context) error) *biz.DependencyHealth {
+	start := time.Now()
+	err := ping(ctx)
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("dependency probe failed: dependency=%s err=%v", name, err)
+	}
+	return &biz.DependencyHealth{
+		Name:    name,
+		Latency: time.Since(start),
+		Err:     err,
+	}
+}
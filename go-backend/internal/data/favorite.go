@@ -0,0 +1,241 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+const (
+	favoriteCacheExpire      = 10 * time.Minute
+	favoriteCountCacheExpire = time.Hour
+)
+
+// incrIfExistsScript 仅在计数已缓存时自增，避免缓存缺失时写入错误的计数
+var incrIfExistsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('INCRBY', KEYS[1], ARGV[1])
+end
+return false
+`)
+
+// UserFavorite 点赞关系模型
+type UserFavorite struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int64     `gorm:"not null;uniqueIndex:uk_user_video,priority:1" json:"user_id"`
+	VideoID   int64     `gorm:"not null;uniqueIndex:uk_user_video,priority:2;index:idx_video_id" json:"video_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (UserFavorite) TableName() string {
+	return "user_favorites"
+}
+
+type favoriteRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	producer    domain.InteractionEventPublisher
+	log         *log.Helper
+}
+
+// NewFavoriteRepo .
+func NewFavoriteRepo(data *Data, invalidator domain.CacheInvalidationPublisher, producer domain.InteractionEventPublisher, logger log.Logger) biz.FavoriteRepo {
+	return &favoriteRepo{
+		data:        data,
+		invalidator: invalidator,
+		producer:    producer,
+		log:         log.NewHelper(logger),
+	}
+}
+
+func (r *favoriteRepo) Like(ctx context.Context, userID, videoID, authorID int64) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 插入点赞记录，由唯一索引拒绝重复点赞，并发请求只有一个成功
+		if err := tx.Create(&UserFavorite{UserID: userID, VideoID: videoID}).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return biz.ErrAlreadyLike
+			}
+			return err
+		}
+
+		return r.updateCounters(tx, userID, videoID, authorID, 1)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.afterChange(ctx, userID, videoID, authorID, true, 1)
+
+	event := domain.NewEventFactory().CreateVideoLikedEvent(userID, videoID, authorID)
+	if err := r.producer.PublishVideoLikedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish video liked event failed: %v", err)
+	}
+
+	return nil
+}
+
+func (r *favoriteRepo) Unlike(ctx context.Context, userID, videoID, authorID int64) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 删除点赞记录
+		result := tx.Where("user_id = ? AND video_id = ?", userID, videoID).Delete(&UserFavorite{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrNotLike
+		}
+
+		return r.updateCounters(tx, userID, videoID, authorID, -1)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.afterChange(ctx, userID, videoID, authorID, false, -1)
+
+	event := domain.NewEventFactory().CreateVideoUnlikedEvent(userID, videoID, authorID)
+	if err := r.producer.PublishVideoUnlikedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish video unliked event failed: %v", err)
+	}
+
+	return nil
+}
+
+func (r *favoriteRepo) IsFavorite(ctx context.Context, userID, videoID int64) (bool, error) {
+	// 先从缓存检查
+	key := r.favoriteKey(userID, videoID)
+	if val, err := r.data.rdb.Get(ctx, key).Result(); err == nil {
+		return val == "1", nil
+	}
+
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&UserFavorite{}).
+		Where("user_id = ? AND video_id = ?", userID, videoID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	isFavorite := count > 0
+	r.setFavoriteCache(ctx, userID, videoID, isFavorite)
+
+	return isFavorite, nil
+}
+
+func (r *favoriteRepo) BatchIsFavorite(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(videoIDs))
+
+	var favorites []UserFavorite
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ? AND video_id IN ?", userID, videoIDs).
+		Find(&favorites).Error; err != nil {
+		return nil, err
+	}
+
+	for _, f := range favorites {
+		result[f.VideoID] = true
+	}
+
+	return result, nil
+}
+
+func (r *favoriteRepo) GetFavoriteVideoIDs(ctx context.Context, userID int64, page, size int32) ([]int64, int64, error) {
+	offset := (page - 1) * size
+
+	var total int64
+	if err := r.data.db.WithContext(ctx).Model(&UserFavorite{}).
+		Where("user_id = ?", userID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var videoIDs []int64
+	if err := r.data.db.WithContext(ctx).Model(&UserFavorite{}).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Offset(int(offset)).Limit(int(size)).
+		Pluck("video_id", &videoIDs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return videoIDs, total, nil
+}
+
+func (r *favoriteRepo) GetFavoriteCount(ctx context.Context, videoID int64) (int64, error) {
+	key := favoriteCountKey(videoID)
+	if val, err := r.data.rdb.Get(ctx, key).Result(); err == nil {
+		if count, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return count, nil
+		}
+	}
+
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Select("favorite_count").
+		Where("id = ?", videoID).
+		Scan(&count).Error; err != nil {
+		return 0, err
+	}
+
+	// 计数未缓存时才写入，避免覆盖并发点赞的自增结果
+	r.data.rdb.SetNX(ctx, key, count, favoriteCountCacheExpire)
+
+	return count, nil
+}
+
+// updateCounters 在事务内更新视频点赞数、用户喜欢数和作者获赞数，并写入视频统计更新事件
+func (r *favoriteRepo) updateCounters(tx *gorm.DB, userID, videoID, authorID int64, delta int) error {
+	if err := tx.Model(&VideoModel{}).Where("id = ?", videoID).
+		Update("favorite_count", gorm.Expr("GREATEST(favorite_count + ?, 0)", delta)).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Model(&User{}).Where("id = ?", userID).
+		Update("favorite_count", gorm.Expr("GREATEST(favorite_count + ?, 0)", delta)).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Model(&User{}).Where("id = ?", authorID).
+		Update("total_favorited", gorm.Expr("GREATEST(total_favorited + ?, 0)", delta)).Error; err != nil {
+		return err
+	}
+
+	return enqueueVideoStatsUpdated(tx, videoID, "favorite_count", int64(delta))
+}
+
+// afterChange 点赞状态变更后同步缓存
+func (r *favoriteRepo) afterChange(ctx context.Context, userID, videoID, authorID int64, isFavorite bool, delta int64) {
+	r.setFavoriteCache(ctx, userID, videoID, isFavorite)
+
+	if err := incrIfExistsScript.Run(ctx, r.data.rdb, []string{favoriteCountKey(videoID)}, delta).Err(); err != nil && err != redis.Nil {
+		r.log.WithContext(ctx).Warnf("incr favorite count cache failed: %v", err)
+	}
+
+	// 喜欢数和获赞数也已变化，点赞用户和作者的用户缓存一并失效
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeVideo, videoID),
+		cacheInvalidation(domain.CacheTypeUser, userID, authorID),
+	)
+}
+
+func (r *favoriteRepo) setFavoriteCache(ctx context.Context, userID, videoID int64, isFavorite bool) {
+	val := "0"
+	if isFavorite {
+		val = "1"
+	}
+	r.data.rdb.Set(ctx, r.favoriteKey(userID, videoID), val, favoriteCacheExpire)
+}
+
+func (r *favoriteRepo) favoriteKey(userID, videoID int64) string {
+	return fmt.Sprintf("favorite:%d:%d", userID, videoID)
+}
+
+func favoriteCountKey(videoID int64) string {
+	return fmt.Sprintf("video:favorite_count:%d", videoID)
+}
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/producer"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupFavoriteRepo(t *testing.T) (*favoriteRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+
+	repo := &favoriteRepo{
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		producer:    &producer.NoOpInteractionEventProducer{},
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+func createFavoriteTestVideo(t *testing.T, data *Data, authorID int64) *VideoModel {
+	video := &VideoModel{
+		AuthorID: authorID,
+		Title:    "favorite test video",
+		PlayURL:  "http://example.com/video.mp4",
+	}
+	require.NoError(t, data.db.Create(video).Error)
+	return video
+}
+
+func TestFavoriteRepo_LikeAndUnlike(t *testing.T) {
+	repo, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user, author := users[0], users[1]
+	video := createFavoriteTestVideo(t, repo.data, author.ID)
+
+	// 点赞
+	err = repo.Like(ctx, user.ID, video.ID, author.ID)
+	require.NoError(t, err)
+
+	isFavorite, err := repo.IsFavorite(ctx, user.ID, video.ID)
+	require.NoError(t, err)
+	assert.True(t, isFavorite)
+
+	count, err := repo.GetFavoriteCount(ctx, video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	var authorModel User
+	require.NoError(t, repo.data.db.First(&authorModel, author.ID).Error)
+	assert.Equal(t, int64(1), authorModel.TotalFavorited)
+
+	// 重复点赞
+	err = repo.Like(ctx, user.ID, video.ID, author.ID)
+	assert.Equal(t, biz.ErrAlreadyLike, err)
+
+	// 取消点赞
+	err = repo.Unlike(ctx, user.ID, video.ID, author.ID)
+	require.NoError(t, err)
+
+	isFavorite, err = repo.IsFavorite(ctx, user.ID, video.ID)
+	require.NoError(t, err)
+	assert.False(t, isFavorite)
+
+	count, err = repo.GetFavoriteCount(ctx, video.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// 重复取消点赞
+	err = repo.Unlike(ctx, user.ID, video.ID, author.ID)
+	assert.Equal(t, biz.ErrNotLike, err)
+}
+
+func TestFavoriteRepo_ConcurrentLike(t *testing.T) {
+	repo, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user, author := users[0], users[1]
+	video := createFavoriteTestVideo(t, repo.data, author.ID)
+
+	const workers = 5
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = repo.Like(ctx, user.ID, video.ID, author.ID)
+		}(i)
+	}
+	wg.Wait()
+
+	// 只有一个请求成功，其余返回已点赞，计数只增加一次
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.Equal(t, biz.ErrAlreadyLike, err)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	var videoModel VideoModel
+	require.NoError(t, repo.data.db.First(&videoModel, video.ID).Error)
+	assert.Equal(t, int64(1), videoModel.FavoriteCount)
+}
+
+func TestFavoriteRepo_GetFavoriteVideoIDs(t *testing.T) {
+	repo, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user, author := users[0], users[1]
+
+	video1 := createFavoriteTestVideo(t, repo.data, author.ID)
+	video2 := createFavoriteTestVideo(t, repo.data, author.ID)
+	require.NoError(t, repo.Like(ctx, user.ID, video1.ID, author.ID))
+	require.NoError(t, repo.Like(ctx, user.ID, video2.ID, author.ID))
+
+	videoIDs, total, err := repo.GetFavoriteVideoIDs(ctx, user.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, []int64{video2.ID, video1.ID}, videoIDs)
+
+	result, err := repo.BatchIsFavorite(ctx, user.ID, []int64{video1.ID, video2.ID, video2.ID + 1})
+	require.NoError(t, err)
+	assert.True(t, result[video1.ID])
+	assert.True(t, result[video2.ID])
+	assert.False(t, result[video2.ID+1])
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+// 关注申请状态
+const (
+	followRequestPending  int8 = 1
+	followRequestApproved int8 = 2
+	followRequestRejected int8 = 3
+)
+
+// FollowRequest 关注申请模型，每对用户只保留一条，重新申请时复用
+type FollowRequest struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement"`
+	RequesterID int64     `gorm:"not null;uniqueIndex:uk_requester_target,priority:1"`
+	TargetID    int64     `gorm:"not null;uniqueIndex:uk_requester_target,priority:2;index:idx_target_status_created,priority:1"`
+	Status      int8      `gorm:"not null;default:1;index:idx_target_status_created,priority:2"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;index:idx_target_status_created,priority:3"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (FollowRequest) TableName() string {
+	return "follow_requests"
+}
+
+// CreateFollowRequest 先把已处理的旧申请置回待处理，没有旧申请时新建，唯一键冲突说明已有待处理申请
+func (r *relationRepo) CreateFollowRequest(ctx context.Context, requesterID, targetID int64) error {
+	result := r.data.db.WithContext(ctx).Model(&FollowRequest{}).
+		Where("requester_id = ? AND target_id = ? AND status <> ?", requesterID, targetID, followRequestPending).
+		Updates(map[string]interface{}{"status": followRequestPending, "created_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	err := r.data.db.WithContext(ctx).Create(&FollowRequest{
+		RequesterID: requesterID,
+		TargetID:    targetID,
+		Status:      followRequestPending,
+	}).Error
+	if isDuplicateKeyError(err) {
+		return biz.ErrFollowRequestPending
+	}
+	return err
+}
+
+func (r *relationRepo) ListFollowRequests(ctx context.Context, targetID int64, page, size int32) ([]*biz.FollowRequest, int64, error) {
+	pending := func() *gorm.DB {
+		return r.data.db.WithContext(ctx).Model(&FollowRequest{}).
+			Where("target_id = ? AND status = ?", targetID, followRequestPending)
+	}
+
+	var total int64
+	if err := pending().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var requests []FollowRequest
+	if err := pending().Order("created_at DESC, id DESC").
+		Offset(int((page - 1) * size)).Limit(int(size)).
+		Find(&requests).Error; err != nil {
+		return nil, 0, err
+	}
+	if len(requests) == 0 {
+		return []*biz.FollowRequest{}, total, nil
+	}
+
+	requesterIDs := make([]int64, len(requests))
+	for i, req := range requests {
+		requesterIDs[i] = req.RequesterID
+	}
+	var users []User
+	if err := r.data.db.WithContext(ctx).
+		Where("id IN ? AND status = 1", requesterIDs).
+		Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	requesters := make(map[int64]*biz.User, len(users))
+	for i := range users {
+		user := userModelToBiz(&users[i])
+		user.PasswordHash, user.Salt = "", ""
+		requesters[user.ID] = user
+	}
+
+	// 申请人已停用的申请不展示，仍计入总数
+	result := make([]*biz.FollowRequest, 0, len(requests))
+	for _, req := range requests {
+		requester, ok := requesters[req.RequesterID]
+		if !ok {
+			continue
+		}
+		result = append(result, &biz.FollowRequest{
+			ID:          req.ID,
+			RequesterID: req.RequesterID,
+			TargetID:    req.TargetID,
+			Requester:   requester,
+			CreatedAt:   req.CreatedAt,
+		})
+	}
+
+	return result, total, nil
+}
+
+// ApproveFollowRequest 在同一事务中处理申请并写入关注关系，申请期间已通过其他途径关注时只更新申请状态
+func (r *relationRepo) ApproveFollowRequest(ctx context.Context, targetID, requesterID int64) error {
+	followed := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := resolveFollowRequest(tx, targetID, requesterID, followRequestApproved); err != nil {
+			return err
+		}
+
+		if err := tx.Create(&UserFollow{UserID: requesterID, FollowUserID: targetID}).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return nil
+			}
+			return err
+		}
+		followed = true
+
+		return updateFollowCounters(tx, requesterID, targetID, 1)
+	})
+	if err != nil || !followed {
+		return err
+	}
+
+	adjustFollowCounts(ctx, r.data.rdb, r.log, requesterID, targetID, 1)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeRelation, requesterID, targetID))
+
+	event := domain.NewEventFactory().CreateUserFollowedEvent(requesterID, targetID)
+	if err := r.producer.PublishUserFollowedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish user followed event failed: %v", err)
+	}
+
+	return nil
+}
+
+func (r *relationRepo) RejectFollowRequest(ctx context.Context, targetID, requesterID int64) error {
+	return resolveFollowRequest(r.data.db.WithContext(ctx), targetID, requesterID, followRequestRejected)
+}
+
+// resolveFollowRequest 以更新的行数判断申请是否待处理，并发处理同一申请时只有一个请求生效
+func resolveFollowRequest(db *gorm.DB, targetID, requesterID int64, status int8) error {
+	result := db.Model(&FollowRequest{}).
+		Where("requester_id = ? AND target_id = ? AND status = ?", requesterID, targetID, followRequestPending).
+		Update("status", status)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrFollowRequestNotExist
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// integritySampleStatuses 参与完整性抽查的视频状态
+var integritySampleStatuses = []int32{domain.VideoStatusPublished, domain.VideoStatusPrivate}
+
+// VideoObjectIntegrity 视频原始文件完整性基线与校验结果数据模型
+type VideoObjectIntegrity struct {
+	VideoID    int64     `gorm:"primaryKey;autoIncrement:false" json:"video_id"`
+	ObjectName string    `gorm:"size:500;not null" json:"object_name"`
+	Size       int64     `gorm:"not null;default:0" json:"size"`
+	ETag       string    `gorm:"column:etag;size:128;not null;default:''" json:"etag"`
+	Checksum   string    `gorm:"size:80;not null;default:''" json:"checksum"`
+	Status     int32     `gorm:"not null;default:1;index:idx_status_checked,priority:1" json:"status"`
+	Detail     string    `gorm:"size:500;not null;default:''" json:"detail"`
+	CheckedAt  time.Time `gorm:"not null;index:idx_status_checked,priority:2" json:"checked_at"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (VideoObjectIntegrity) TableName() string {
+	return "video_object_integrity"
+}
+
+type integrityRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	log         *log.Helper
+}
+
+// NewIntegrityRepo .
+func NewIntegrityRepo(data *Data, invalidator domain.CacheInvalidationPublisher, logger log.Logger) biz.IntegrityRepo {
+	return &integrityRepo{
+		data:        data,
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// SampleVideos 在ID范围内随机取起点，按ID顺序取一段；起点之后不足时从头补齐
+func (r *integrityRepo) SampleVideos(ctx context.Context, limit int) ([]*domain.Video, error) {
+	var maxID int64
+	if err := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Select("COALESCE(MAX(id), 0)").Scan(&maxID).Error; err != nil {
+		return nil, err
+	}
+	if maxID == 0 {
+		return []*domain.Video{}, nil
+	}
+	start := rand.Int63n(maxID) + 1
+
+	var models []VideoModel
+	if err := r.data.db.WithContext(ctx).
+		Where("id >= ? AND status IN ?", start, integritySampleStatuses).
+		Order("id").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) < limit {
+		var wrapped []VideoModel
+		if err := r.data.db.WithContext(ctx).
+			Where("id < ? AND status IN ?", start, integritySampleStatuses).
+			Order("id").Limit(limit - len(models)).Find(&wrapped).Error; err != nil {
+			return nil, err
+		}
+		models = append(models, wrapped...)
+	}
+
+	videos := make([]*domain.Video, len(models))
+	for i := range models {
+		videos[i] = videoModelToDomain(&models[i])
+	}
+	return videos, nil
+}
+
+func (r *integrityRepo) GetIntegrity(ctx context.Context, videoID int64) (*biz.VideoIntegrity, error) {
+	var model VideoObjectIntegrity
+	if err := r.data.db.WithContext(ctx).Where("video_id = ?", videoID).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &biz.VideoIntegrity{
+		VideoID:    model.VideoID,
+		ObjectName: model.ObjectName,
+		Size:       model.Size,
+		ETag:       model.ETag,
+		Checksum:   model.Checksum,
+		Status:     model.Status,
+		Detail:     model.Detail,
+		CheckedAt:  model.CheckedAt,
+	}, nil
+}
+
+func (r *integrityRepo) SaveIntegrity(ctx context.Context, record *biz.VideoIntegrity) error {
+	model := &VideoObjectIntegrity{
+		VideoID:    record.VideoID,
+		ObjectName: record.ObjectName,
+		Size:       record.Size,
+		ETag:       record.ETag,
+		Checksum:   record.Checksum,
+		Status:     record.Status,
+		Detail:     record.Detail,
+		CheckedAt:  record.CheckedAt,
+	}
+	return r.data.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "video_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"object_name", "size", "etag", "checksum", "status", "detail", "checked_at", "updated_at"}),
+		}).
+		Create(model).Error
+}
+
+// MarkVideoUnavailable 以抽查时的状态为条件更新，避免覆盖抽查期间作者删除或管理员下架的结果
+func (r *integrityRepo) MarkVideoUnavailable(ctx context.Context, videoID int64, fromStatus int32) (bool, error) {
+	var video VideoModel
+	if err := r.data.db.WithContext(ctx).Select("id", "author_id").First(&video, videoID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	result := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Where("id = ? AND status = ?", videoID, fromStatus).
+		Update("status", domain.VideoStatusUnavailable)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeVideo, videoID),
+		cacheInvalidation(domain.CacheTypeUserVideos, video.AuthorID),
+		cacheInvalidation(domain.CacheTypeFeed),
+	)
+	return true, nil
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrityRepo(t *testing.T) {
+	favorite, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+	repo := &integrityRepo{
+		data:        favorite.data,
+		invalidator: newTestCacheInvalidationPublisher(favorite.data, multiCache),
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	author := users[0].ID
+	published := createFavoriteTestVideo(t, repo.data, author)
+	deleted := createFavoriteTestVideo(t, repo.data, author)
+	require.NoError(t, repo.data.db.Model(&VideoModel{}).Where("id = ?", deleted.ID).
+		Update("status", domain.VideoStatusDeleted).Error)
+
+	t.Run("SampleVideos", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			videos, err := repo.SampleVideos(ctx, 10)
+			require.NoError(t, err)
+			require.Len(t, videos, 1)
+			assert.Equal(t, published.ID, videos[0].ID)
+		}
+	})
+
+	t.Run("SaveIntegrity", func(t *testing.T) {
+		record, err := repo.GetIntegrity(ctx, published.ID)
+		require.NoError(t, err)
+		assert.Nil(t, record)
+
+		checkedAt := time.Now().Truncate(time.Second)
+		require.NoError(t, repo.SaveIntegrity(ctx, &biz.VideoIntegrity{
+			VideoID: published.ID, ObjectName: "videos/a.mp4", Size: 8, ETag: "etag-1",
+			Status: biz.IntegrityStatusOK, CheckedAt: checkedAt,
+		}))
+		require.NoError(t, repo.SaveIntegrity(ctx, &biz.VideoIntegrity{
+			VideoID: published.ID, ObjectName: "videos/a.mp4", Size: 8, ETag: "etag-1",
+			Status: biz.IntegrityStatusDerivedMissing, Detail: "missing covers/a.jpg", CheckedAt: checkedAt.Add(time.Minute),
+		}))
+
+		record, err = repo.GetIntegrity(ctx, published.ID)
+		require.NoError(t, err)
+		require.NotNil(t, record)
+		assert.Equal(t, "etag-1", record.ETag)
+		assert.Equal(t, int32(biz.IntegrityStatusDerivedMissing), record.Status)
+		assert.Equal(t, "missing covers/a.jpg", record.Detail)
+		assert.True(t, record.CheckedAt.Equal(checkedAt.Add(time.Minute)))
+	})
+
+	t.Run("MarkVideoUnavailable", func(t *testing.T) {
+		updated, err := repo.MarkVideoUnavailable(ctx, published.ID, domain.VideoStatusPrivate)
+		require.NoError(t, err)
+		assert.False(t, updated)
+
+		updated, err = repo.MarkVideoUnavailable(ctx, published.ID, domain.VideoStatusPublished)
+		require.NoError(t, err)
+		assert.True(t, updated)
+
+		var model VideoModel
+		require.NoError(t, repo.data.db.First(&model, published.ID).Error)
+		assert.Equal(t, int32(domain.VideoStatusUnavailable), model.Status)
+
+		videos, err := repo.SampleVideos(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, videos)
+	})
+}
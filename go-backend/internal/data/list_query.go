@@ -0,0 +1,57 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+
+	"go-backend/pkg/listquery"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// likeEscaper 转义 LIKE 通配符，contains 按字面值匹配
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// applyListFilters 追加已校验的过滤条件，列名经 clause.Column 引用，值全部参数化
+func applyListFilters(query *gorm.DB, q *listquery.Compiled) *gorm.DB {
+	if q == nil {
+		return query
+	}
+	for _, cond := range q.Conditions {
+		query = query.Where(listConditionExpr(cond))
+	}
+	return query
+}
+
+// applyListPage 追加排序和分页
+func applyListPage(query *gorm.DB, q *listquery.Compiled) *gorm.DB {
+	for _, order := range q.Orders {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: order.Column}, Desc: order.Desc})
+	}
+	return query.Offset(q.Offset()).Limit(int(q.Size))
+}
+
+func listConditionExpr(cond listquery.Condition) clause.Expression {
+	column := clause.Column{Name: cond.Column}
+	value := cond.Values[0]
+
+	switch cond.Op {
+	case listquery.OpNe:
+		return clause.Neq{Column: column, Value: value}
+	case listquery.OpGt:
+		return clause.Gt{Column: column, Value: value}
+	case listquery.OpGte:
+		return clause.Gte{Column: column, Value: value}
+	case listquery.OpLt:
+		return clause.Lt{Column: column, Value: value}
+	case listquery.OpLte:
+		return clause.Lte{Column: column, Value: value}
+	case listquery.OpIn:
+		return clause.IN{Column: column, Values: cond.Values}
+	case listquery.OpContains:
+		return clause.Like{Column: column, Value: "%" + likeEscaper.Replace(fmt.Sprint(value)) + "%"}
+	default:
+		return clause.Eq{Column: column, Value: value}
+	}
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/listquery"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustCompileListQuery(t *testing.T, schema *listquery.Schema, filter, sort string, size int32) *listquery.Compiled {
+	t.Helper()
+	q, err := listquery.Parse(filter, sort, 1, size)
+	require.NoError(t, err)
+	compiled, err := schema.Compile(q)
+	require.NoError(t, err)
+	return compiled
+}
+
+func TestListQuery_Denials(t *testing.T) {
+	repo, cleanup := setupPermissionAuditRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	for _, d := range []*domain.PermissionDenial{
+		{UserID: 1, Resource: "/video", Action: "DELETE", Route: "POST /douyin/video/delete"},
+		{UserID: 2, Resource: "/admin", Action: "GET", Route: "GET /douyin/admin/100%_done"},
+		{UserID: 3, Resource: "/admin", Action: "GET", Route: "GET /douyin/admin/1000_done"},
+	} {
+		require.NoError(t, repo.CreateDenial(ctx, d))
+	}
+
+	t.Run("ContainsIsLiteral", func(t *testing.T) {
+		denials, total, err := repo.ListDenials(ctx, &biz.DenialFilter{},
+			mustCompileListQuery(t, biz.DenialListSchema, "route:contains:100%_", "", 10))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, denials, 1)
+		assert.Equal(t, int64(2), denials[0].UserID)
+	})
+
+	t.Run("InAndSort", func(t *testing.T) {
+		denials, total, err := repo.ListDenials(ctx, &biz.DenialFilter{},
+			mustCompileListQuery(t, biz.DenialListSchema, "user_id:in:1,3;action:ne:POST", "id", 10))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, denials, 2)
+		assert.Equal(t, int64(1), denials[0].UserID)
+		assert.Equal(t, int64(3), denials[1].UserID)
+	})
+
+	t.Run("Paging", func(t *testing.T) {
+		denials, total, err := repo.ListDenials(ctx, &biz.DenialFilter{},
+			mustCompileListQuery(t, biz.DenialListSchema, "", "", 2))
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, denials, 2)
+		assert.Equal(t, int64(3), denials[0].UserID)
+	})
+}
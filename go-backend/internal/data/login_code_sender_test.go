@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginCodeSenders_SMSGateway(t *testing.T) {
+	var got map[string]string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	bc := &conf.Business{CodeLogin: &conf.Business_CodeLogin{Sms: &conf.Business_CodeLogin_SMS{Endpoint: server.URL, ApiKey: "key"}}}
+	senders := NewLoginCodeSenders(bc, NewEmailSender(log.DefaultLogger), log.DefaultLogger)
+	require.Contains(t, senders, biz.LoginCodeChannelEmail)
+
+	sms := senders[biz.LoginCodeChannelSMS]
+	require.NoError(t, sms.SendLoginCode(context.Background(), "13800138000", "123456"))
+	assert.Equal(t, map[string]string{"phone": "13800138000", "code": "123456"}, got)
+
+	status = http.StatusServiceUnavailable
+	assert.Error(t, sms.SendLoginCode(context.Background(), "13800138000", "123456"))
+}
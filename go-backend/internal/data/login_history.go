@@ -0,0 +1,151 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LoginHistoryModel 登录记录模型
+type LoginHistoryModel struct {
+	ID                int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID            int64     `gorm:"not null;index:idx_user_created,priority:1" json:"user_id"`
+	DeviceID          string    `gorm:"size:128;not null;default:''" json:"device_id"`
+	Fingerprint       string    `gorm:"size:64;not null" json:"fingerprint"`
+	IP                string    `gorm:"column:ip;size:45;not null;default:''" json:"ip"`
+	Network           string    `gorm:"size:64;not null;default:''" json:"network"`
+	UserAgent         string    `gorm:"size:255;not null;default:''" json:"user_agent"`
+	Status            int8      `gorm:"not null" json:"status"`
+	Reason            string    `gorm:"size:64;not null;default:''" json:"reason"`
+	ChallengeCode     string    `gorm:"size:64;not null;default:''" json:"-"`
+	ChallengeAttempts int       `gorm:"not null;default:0" json:"challenge_attempts"`
+	CreatedAt         time.Time `gorm:"not null;index:idx_user_created,priority:2;index:idx_created_at" json:"created_at"`
+}
+
+func (LoginHistoryModel) TableName() string {
+	return "login_history"
+}
+
+type loginHistoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLoginHistoryRepo .
+func NewLoginHistoryRepo(data *Data, logger log.Logger) biz.LoginHistoryRepo {
+	return &loginHistoryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *loginHistoryRepo) CreateLoginRecord(ctx context.Context, record *biz.LoginRecord) error {
+	model := &LoginHistoryModel{
+		UserID:        record.UserID,
+		DeviceID:      record.DeviceID,
+		Fingerprint:   record.Fingerprint,
+		IP:            record.IP,
+		Network:       record.Network,
+		UserAgent:     record.UserAgent,
+		Status:        record.Status,
+		Reason:        record.Reason,
+		ChallengeCode: record.ChallengeCode,
+		CreatedAt:     record.CreatedAt,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	record.ID = model.ID
+	return nil
+}
+
+// GetLoginBaseline 一次查询统计窗口内的成功登录及设备、网络段的命中次数
+func (r *loginHistoryRepo) GetLoginBaseline(ctx context.Context, userID int64, fingerprint, network string, since time.Time) (*biz.LoginBaseline, error) {
+	var row struct {
+		Logins  int64
+		Devices int64
+		Nets    int64
+	}
+	err := r.data.db.WithContext(ctx).Model(&LoginHistoryModel{}).
+		Select("COUNT(*) AS logins, "+
+			"COALESCE(SUM(fingerprint = ?), 0) AS devices, "+
+			"COALESCE(SUM(network <> '' AND network = ?), 0) AS nets", fingerprint, network).
+		Where("user_id = ? AND created_at >= ? AND status IN ?", userID, since, []int8{biz.LoginStatusAllowed, biz.LoginStatusVerified}).
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.LoginBaseline{
+		Logins:      row.Logins,
+		DeviceSeen:  row.Devices > 0,
+		NetworkSeen: row.Nets > 0,
+	}, nil
+}
+
+func (r *loginHistoryRepo) GetPendingChallenge(ctx context.Context, userID int64, fingerprint string, since time.Time) (*biz.LoginRecord, error) {
+	var models []LoginHistoryModel
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ? AND fingerprint = ? AND status = ? AND created_at >= ?", userID, fingerprint, biz.LoginStatusChallenged, since).
+		Order("created_at DESC, id DESC").
+		Limit(1).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return loginHistoryModelToBiz(&models[0]), nil
+}
+
+func (r *loginHistoryRepo) UpdateChallenge(ctx context.Context, id int64, status int8, attempts int) error {
+	return r.data.db.WithContext(ctx).Model(&LoginHistoryModel{}).
+		Where("id = ? AND status = ?", id, biz.LoginStatusChallenged).
+		Updates(map[string]interface{}{
+			"status":             status,
+			"challenge_attempts": attempts,
+		}).Error
+}
+
+func (r *loginHistoryRepo) ListLoginRecords(ctx context.Context, userID int64, page, size int32) ([]*biz.LoginRecord, int64, error) {
+	db := r.data.db.WithContext(ctx).Model(&LoginHistoryModel{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []LoginHistoryModel
+	if err := db.Order("created_at DESC, id DESC").
+		Offset(int((page - 1) * size)).
+		Limit(int(size)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	records := make([]*biz.LoginRecord, len(models))
+	for i := range models {
+		records[i] = loginHistoryModelToBiz(&models[i])
+	}
+	return records, total, nil
+}
+
+func loginHistoryModelToBiz(m *LoginHistoryModel) *biz.LoginRecord {
+	return &biz.LoginRecord{
+		ID:                m.ID,
+		UserID:            m.UserID,
+		DeviceID:          m.DeviceID,
+		Fingerprint:       m.Fingerprint,
+		IP:                m.IP,
+		Network:           m.Network,
+		UserAgent:         m.UserAgent,
+		Status:            m.Status,
+		Reason:            m.Reason,
+		ChallengeCode:     m.ChallengeCode,
+		ChallengeAttempts: m.ChallengeAttempts,
+		CreatedAt:         m.CreatedAt,
+	}
+}
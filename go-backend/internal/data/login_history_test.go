@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginHistoryRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewLoginHistoryRepo(&Data{db: env.DB.DB}, log.DefaultLogger)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+
+	require.NoError(t, repo.CreateLoginRecord(ctx, &biz.LoginRecord{UserID: 1, Fingerprint: "old", Network: "198.51.100.0/24", Status: biz.LoginStatusAllowed, CreatedAt: now.Add(-100 * 24 * time.Hour)}))
+	require.NoError(t, repo.CreateLoginRecord(ctx, &biz.LoginRecord{UserID: 1, Fingerprint: "phone", Network: "203.0.113.0/24", Status: biz.LoginStatusAllowed, CreatedAt: now.Add(-time.Hour)}))
+	challenged := &biz.LoginRecord{UserID: 1, Fingerprint: "laptop", Network: "192.0.2.0/24", Status: biz.LoginStatusChallenged, Reason: "new_device,new_network", ChallengeCode: "hash", CreatedAt: now}
+	require.NoError(t, repo.CreateLoginRecord(ctx, challenged))
+
+	since := now.Add(-90 * 24 * time.Hour)
+	baseline, err := repo.GetLoginBaseline(ctx, 1, "laptop", "192.0.2.0/24", since)
+	require.NoError(t, err)
+	// 窗口外和等待验证的登录不计入
+	assert.Equal(t, &biz.LoginBaseline{Logins: 1}, baseline)
+
+	baseline, err = repo.GetLoginBaseline(ctx, 1, "laptop", "203.0.113.0/24", since)
+	require.NoError(t, err)
+	assert.True(t, baseline.NetworkSeen)
+	assert.False(t, baseline.DeviceSeen)
+
+	pending, err := repo.GetPendingChallenge(ctx, 1, "laptop", now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, pending)
+	assert.Equal(t, challenged.ID, pending.ID)
+	assert.Equal(t, "hash", pending.ChallengeCode)
+
+	// 通过验证后计入成功登录
+	require.NoError(t, repo.UpdateChallenge(ctx, pending.ID, biz.LoginStatusVerified, 1))
+	pending, err = repo.GetPendingChallenge(ctx, 1, "laptop", now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Nil(t, pending)
+	baseline, err = repo.GetLoginBaseline(ctx, 1, "laptop", "", since)
+	require.NoError(t, err)
+	assert.Equal(t, &biz.LoginBaseline{Logins: 2, DeviceSeen: true}, baseline)
+
+	records, total, err := repo.ListLoginRecords(ctx, 1, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, records, 2)
+	assert.Equal(t, "laptop", records[0].Fingerprint)
+	assert.Equal(t, biz.LoginStatusVerified, records[0].Status)
+	assert.Equal(t, 1, records[0].ChallengeAttempts)
+	assert.Equal(t, "phone", records[1].Fingerprint)
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Message 私信消息模型
+type Message struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	FromUserID  int64     `gorm:"not null;index:idx_from_to_created,priority:1" json:"from_user_id"`
+	ToUserID    int64     `gorm:"not null;index:idx_from_to_created,priority:2;index:idx_to_created,priority:1" json:"to_user_id"`
+	Content     string    `gorm:"size:500;not null" json:"content"`
+	MessageType int32     `gorm:"default:1" json:"message_type"`
+	Status      int32     `gorm:"default:1" json:"status"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;index:idx_from_to_created,priority:3;index:idx_to_created,priority:2" json:"created_at"`
+}
+
+func (Message) TableName() string {
+	return "messages"
+}
+
+type messageRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMessageRepo .
+func NewMessageRepo(data *Data, logger log.Logger) biz.MessageRepo {
+	return &messageRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *messageRepo) CreateMessage(ctx context.Context, msg *biz.Message) (*biz.Message, error) {
+	m := &Message{
+		FromUserID:  msg.FromUserID,
+		ToUserID:    msg.ToUserID,
+		Content:     msg.Content,
+		MessageType: msg.MessageType,
+		Status:      1,
+	}
+
+	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toBizMessage(m), nil
+}
+
+func (r *messageRepo) GetMessages(ctx context.Context, userID, toUserID int64, since time.Time, limit int) ([]*biz.Message, error) {
+	query := r.data.db.WithContext(ctx).
+		Where("(from_user_id = ? AND to_user_id = ?) OR (from_user_id = ? AND to_user_id = ?)",
+			userID, toUserID, toUserID, userID)
+
+	if !since.IsZero() {
+		query = query.Where("created_at > ?", since)
+	}
+
+	var messages []Message
+	if err := query.Order("created_at ASC, id ASC").Limit(limit).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Message, 0, len(messages))
+	for i := range messages {
+		result = append(result, r.toBizMessage(&messages[i]))
+	}
+
+	return result, nil
+}
+
+func (r *messageRepo) GetLatestMessages(ctx context.Context, userID int64, friendIDs []int64) (map[int64]*biz.Message, error) {
+	// 按会话分组取每组最大ID，即最新一条消息
+	latestIDs := r.data.db.WithContext(ctx).Model(&Message{}).
+		Select("MAX(id)").
+		Where("(from_user_id = ? AND to_user_id IN ?) OR (to_user_id = ? AND from_user_id IN ?)",
+			userID, friendIDs, userID, friendIDs).
+		Group("LEAST(from_user_id, to_user_id), GREATEST(from_user_id, to_user_id)")
+
+	var messages []Message
+	if err := r.data.db.WithContext(ctx).
+		Where("id IN (?)", latestIDs).
+		Find(&messages).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]*biz.Message, len(messages))
+	for i := range messages {
+		m := &messages[i]
+		friendID := m.ToUserID
+		if m.FromUserID != userID {
+			friendID = m.FromUserID
+		}
+		result[friendID] = r.toBizMessage(m)
+	}
+
+	return result, nil
+}
+
+func (r *messageRepo) toBizMessage(m *Message) *biz.Message {
+	return &biz.Message{
+		ID:          m.ID,
+		FromUserID:  m.FromUserID,
+		ToUserID:    m.ToUserID,
+		Content:     m.Content,
+		MessageType: m.MessageType,
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMessageRepo(t *testing.T) (*messageRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	repo := &messageRepo{
+		data: data,
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+func TestMessageRepo_CreateAndGetMessages(t *testing.T) {
+	repo, env, cleanup := setupMessageRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(3)
+	require.NoError(t, err)
+	user1, user2, user3 := users[0], users[1], users[2]
+
+	// 创建双向消息
+	msg1, err := repo.CreateMessage(ctx, &biz.Message{FromUserID: user1.ID, ToUserID: user2.ID, Content: "hello", MessageType: biz.MessageTypeText})
+	require.NoError(t, err)
+	assert.NotZero(t, msg1.ID)
+
+	_, err = repo.CreateMessage(ctx, &biz.Message{FromUserID: user2.ID, ToUserID: user1.ID, Content: "hi", MessageType: biz.MessageTypeText})
+	require.NoError(t, err)
+
+	// 其他会话的消息不应出现
+	_, err = repo.CreateMessage(ctx, &biz.Message{FromUserID: user3.ID, ToUserID: user1.ID, Content: "other", MessageType: biz.MessageTypeText})
+	require.NoError(t, err)
+
+	messages, err := repo.GetMessages(ctx, user1.ID, user2.ID, time.Time{}, 100)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "hello", messages[0].Content)
+	assert.Equal(t, "hi", messages[1].Content)
+
+	// 晚于最新消息时间后不应有新消息
+	messages, err = repo.GetMessages(ctx, user1.ID, user2.ID, time.Now().Add(time.Minute), 100)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestMessageRepo_GetLatestMessages(t *testing.T) {
+	repo, env, cleanup := setupMessageRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(3)
+	require.NoError(t, err)
+	user1, user2, user3 := users[0], users[1], users[2]
+
+	_, err = repo.CreateMessage(ctx, &biz.Message{FromUserID: user1.ID, ToUserID: user2.ID, Content: "first", MessageType: biz.MessageTypeText})
+	require.NoError(t, err)
+	_, err = repo.CreateMessage(ctx, &biz.Message{FromUserID: user2.ID, ToUserID: user1.ID, Content: "latest", MessageType: biz.MessageTypeText})
+	require.NoError(t, err)
+	_, err = repo.CreateMessage(ctx, &biz.Message{FromUserID: user1.ID, ToUserID: user3.ID, Content: "to user3", MessageType: biz.MessageTypeText})
+	require.NoError(t, err)
+
+	latest, err := repo.GetLatestMessages(ctx, user1.ID, []int64{user2.ID, user3.ID})
+	require.NoError(t, err)
+	require.Len(t, latest, 2)
+	assert.Equal(t, "latest", latest[user2.ID].Content)
+	assert.Equal(t, user2.ID, latest[user2.ID].FromUserID)
+	assert.Equal(t, "to user3", latest[user3.ID].Content)
+}
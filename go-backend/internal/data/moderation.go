@@ -0,0 +1,147 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// VideoAuditModel 视频审核记录模型
+type VideoAuditModel struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	VideoID    int64     `gorm:"not null;index:idx_video_id" json:"video_id"`
+	AuditorID  int64     `gorm:"not null;index:idx_auditor_created,priority:1" json:"auditor_id"`
+	FromStatus int32     `gorm:"not null" json:"from_status"`
+	ToStatus   int32     `gorm:"not null" json:"to_status"`
+	Reason     string    `gorm:"size:255" json:"reason"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;index:idx_auditor_created,priority:2,sort:desc" json:"created_at"`
+}
+
+func (VideoAuditModel) TableName() string {
+	return "video_audits"
+}
+
+type moderationRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	producer    domain.VideoEventPublisher
+	log         *log.Helper
+}
+
+// NewModerationRepo .
+func NewModerationRepo(data *Data, invalidator domain.CacheInvalidationPublisher, producer domain.VideoEventPublisher, logger log.Logger) biz.ModerationRepo {
+	return &moderationRepo{
+		data:        data,
+		invalidator: invalidator,
+		producer:    producer,
+		log:         log.NewHelper(logger),
+	}
+}
+
+func (r *moderationRepo) ListPendingVideos(ctx context.Context, page, size int32) ([]*domain.Video, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Where("status IN ?", biz.PendingVideoStatuses)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []VideoModel
+	if err := query.Session(&gorm.Session{}).
+		Order("created_at ASC, id ASC").
+		Offset(int((page - 1) * size)).Limit(int(size)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	videos := make([]*domain.Video, 0, len(models))
+	for i := range models {
+		videos = append(videos, videoModelToDomain(&models[i]))
+	}
+
+	return videos, total, nil
+}
+
+func (r *moderationRepo) AuditVideo(ctx context.Context, audit *biz.VideoAudit) (*domain.Video, error) {
+	var model VideoModel
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定视频行，避免多个审核员同时审核同一视频
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status != ?", audit.VideoID, domain.VideoStatusDeleted).
+			First(&model).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return utils.ErrVideoNotFound
+			}
+			return err
+		}
+
+		if !isPendingVideoStatus(model.Status) {
+			return biz.ErrVideoNotPending
+		}
+
+		audit.FromStatus = model.Status
+		if err := tx.Model(&VideoModel{}).Where("id = ?", model.ID).
+			Update("status", audit.ToStatus).Error; err != nil {
+			return err
+		}
+		model.Status = audit.ToStatus
+
+		record := &VideoAuditModel{
+			VideoID:    audit.VideoID,
+			AuditorID:  audit.AuditorID,
+			FromStatus: audit.FromStatus,
+			ToStatus:   audit.ToStatus,
+			Reason:     audit.Reason,
+		}
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+
+		audit.ID = record.ID
+		audit.CreatedAt = record.CreatedAt
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 审核通过后视频进入作者作品列表和推荐流
+	events := []*domain.CacheInvalidationEvent{
+		cacheInvalidation(domain.CacheTypeVideo, model.ID),
+		cacheInvalidation(domain.CacheTypeUserVideos, model.AuthorID),
+	}
+	if audit.ToStatus == domain.VideoStatusPublished {
+		events = append(events, cacheInvalidation(domain.CacheTypeFeed))
+	}
+	invalidateCache(ctx, r.invalidator, r.log, events...)
+
+	video := videoModelToDomain(&model)
+
+	auditStatus := domain.AuditStatusApproved
+	if audit.ToStatus == domain.VideoStatusRejected {
+		auditStatus = domain.AuditStatusRejected
+	}
+	event := domain.NewEventFactory().CreateVideoAuditEvent(video, auditStatus, audit.Reason, audit.AuditorID)
+	if err := r.producer.PublishVideoAuditedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish video audited event failed: %v", err)
+	}
+
+	return video, nil
+}
+
+func isPendingVideoStatus(status int32) bool {
+	for _, s := range biz.PendingVideoStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/producer"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/pkg/utils"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupModerationRepo(t *testing.T) (*moderationRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+
+	repo := &moderationRepo{
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		producer:    &producer.NoOpVideoEventProducer{},
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+// createPendingTestVideo 创建待审核视频，status字段有默认值，需要单独更新
+func createPendingTestVideo(t *testing.T, data *Data, authorID int64) *VideoModel {
+	video := createFavoriteTestVideo(t, data, authorID)
+	require.NoError(t, data.db.Model(video).Update("status", domain.VideoStatusPending).Error)
+	return video
+}
+
+func TestModerationRepo_ListPendingVideos(t *testing.T) {
+	repo, env, cleanup := setupModerationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	author := users[0]
+
+	first := createPendingTestVideo(t, repo.data, author.ID)
+	second := createPendingTestVideo(t, repo.data, author.ID)
+	createFavoriteTestVideo(t, repo.data, author.ID)
+
+	videos, total, err := repo.ListPendingVideos(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, videos, 2)
+	assert.Equal(t, first.ID, videos[0].ID)
+	assert.Equal(t, second.ID, videos[1].ID)
+}
+
+func TestModerationRepo_AuditVideo(t *testing.T) {
+	repo, env, cleanup := setupModerationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	author, moderator := users[0], users[1]
+
+	t.Run("Reject", func(t *testing.T) {
+		video := createPendingTestVideo(t, repo.data, author.ID)
+
+		audit := &biz.VideoAudit{
+			VideoID:   video.ID,
+			AuditorID: moderator.ID,
+			ToStatus:  domain.VideoStatusRejected,
+			Reason:    "spam",
+		}
+		result, err := repo.AuditVideo(ctx, audit)
+		require.NoError(t, err)
+		assert.Equal(t, int32(domain.VideoStatusRejected), result.Status)
+		assert.Equal(t, int32(domain.VideoStatusPending), audit.FromStatus)
+
+		var record VideoAuditModel
+		require.NoError(t, repo.data.db.Where("video_id = ?", video.ID).First(&record).Error)
+		assert.Equal(t, moderator.ID, record.AuditorID)
+		assert.Equal(t, "spam", record.Reason)
+
+		// 已审核的视频不能重复审核
+		_, err = repo.AuditVideo(ctx, &biz.VideoAudit{VideoID: video.ID, AuditorID: moderator.ID, ToStatus: domain.VideoStatusPublished})
+		assert.Equal(t, biz.ErrVideoNotPending, err)
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		video := createPendingTestVideo(t, repo.data, author.ID)
+
+		_, err := repo.AuditVideo(ctx, &biz.VideoAudit{VideoID: video.ID, AuditorID: moderator.ID, ToStatus: domain.VideoStatusPublished})
+		require.NoError(t, err)
+
+		var model VideoModel
+		require.NoError(t, repo.data.db.First(&model, video.ID).Error)
+		assert.Equal(t, int32(domain.VideoStatusPublished), model.Status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := repo.AuditVideo(ctx, &biz.VideoAudit{VideoID: 999999, AuditorID: moderator.ID, ToStatus: domain.VideoStatusPublished})
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+const mutedKeywordCacheExpire = 30 * time.Minute
+
+// UserMutedKeyword 用户屏蔽词模型
+type UserMutedKeyword struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int64     `gorm:"not null;uniqueIndex:uk_user_keyword,priority:1" json:"user_id"`
+	Keyword   string    `gorm:"size:50;not null;uniqueIndex:uk_user_keyword,priority:2" json:"keyword"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (UserMutedKeyword) TableName() string {
+	return "user_muted_keywords"
+}
+
+type mutedKeywordRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMutedKeywordRepo .
+func NewMutedKeywordRepo(data *Data, logger log.Logger) biz.MutedKeywordRepo {
+	return &mutedKeywordRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *mutedKeywordRepo) AddMutedKeyword(ctx context.Context, userID int64, keyword string) error {
+	if err := r.data.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&UserMutedKeyword{UserID: userID, Keyword: keyword}).Error; err != nil {
+		return err
+	}
+
+	r.data.rdb.Del(ctx, r.cacheKey(userID))
+	return nil
+}
+
+func (r *mutedKeywordRepo) RemoveMutedKeyword(ctx context.Context, userID int64, keyword string) error {
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ? AND keyword = ?", userID, keyword).
+		Delete(&UserMutedKeyword{}).Error; err != nil {
+		return err
+	}
+
+	r.data.rdb.Del(ctx, r.cacheKey(userID))
+	return nil
+}
+
+func (r *mutedKeywordRepo) GetMutedKeywords(ctx context.Context, userID int64) ([]string, error) {
+	// 评论列表每次都要读取屏蔽词，优先走缓存
+	key := r.cacheKey(userID)
+	if val, err := r.data.rdb.Get(ctx, key).Bytes(); err == nil {
+		var keywords []string
+		if err := json.Unmarshal(val, &keywords); err == nil {
+			return keywords, nil
+		}
+	}
+
+	var keywords []string
+	if err := r.data.db.WithContext(ctx).Model(&UserMutedKeyword{}).
+		Where("user_id = ?", userID).
+		Order("id ASC").
+		Pluck("keyword", &keywords).Error; err != nil {
+		return nil, err
+	}
+	if keywords == nil {
+		keywords = []string{}
+	}
+
+	if val, err := json.Marshal(keywords); err == nil {
+		r.data.rdb.Set(ctx, key, val, mutedKeywordCacheExpire)
+	}
+
+	return keywords, nil
+}
+
+func (r *mutedKeywordRepo) cacheKey(userID int64) string {
+	return fmt.Sprintf("muted_keywords:%d", userID)
+}
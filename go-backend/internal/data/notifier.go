@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// logPasswordResetNotifier 将重置Token写入日志，用于开发和测试环境，
+// 接入邮件或短信通道后替换该provider即可
+type logPasswordResetNotifier struct {
+	log *log.Helper
+}
+
+// NewPasswordResetNotifier .
+func NewPasswordResetNotifier(logger log.Logger) biz.PasswordResetNotifier {
+	return &logPasswordResetNotifier{
+		log: log.NewHelper(logger),
+	}
+}
+
+func (n *logPasswordResetNotifier) SendPasswordResetToken(ctx context.Context, user *biz.User, token string) error {
+	n.log.WithContext(ctx).Infof("password reset token for user %d (%s): %s", user.ID, user.Username, token)
+	return nil
+}
+
+// logEmailSender 将邮箱验证码写入日志，用于开发和测试环境
+type logEmailSender struct {
+	log *log.Helper
+}
+
+// NewEmailSender .
+func NewEmailSender(logger log.Logger) biz.EmailSender {
+	return &logEmailSender{
+		log: log.NewHelper(logger),
+	}
+}
+
+func (s *logEmailSender) SendVerificationCode(ctx context.Context, email, code string) error {
+	s.log.WithContext(ctx).Infof("email verification code for %s: %s", email, code)
+	return nil
+}
+
+// logSecurityEventNotifier 将安全事件写入告警日志，由日志平台按级别告警
+type logSecurityEventNotifier struct {
+	log *log.Helper
+}
+
+// NewSecurityEventNotifier .
+func NewSecurityEventNotifier(logger log.Logger) biz.SecurityEventNotifier {
+	return &logSecurityEventNotifier{
+		log: log.NewHelper(logger),
+	}
+}
+
+func (n *logSecurityEventNotifier) NotifySecurityEvent(ctx context.Context, event *domain.SecurityEvent) error {
+	n.log.WithContext(ctx).Warnf("security event: type=%s user=%d detail=%s", event.Type, event.UserID, event.Detail)
+	return nil
+}
+
+// logDraftReminderNotifier 将草稿提醒写入日志，接入站内信或推送通道后替换该provider即可
+type logDraftReminderNotifier struct {
+	log *log.Helper
+}
+
+// NewDraftReminderNotifier .
+func NewDraftReminderNotifier(logger log.Logger) biz.DraftReminderNotifier {
+	return &logDraftReminderNotifier{
+		log: log.NewHelper(logger),
+	}
+}
+
+func (n *logDraftReminderNotifier) NotifyDraftReminder(ctx context.Context, draft *biz.ContentDraft) error {
+	n.log.WithContext(ctx).Infof("draft reminder for user %d: draft=%d title=%q scheduled_at=%s", draft.UserID, draft.ID, draft.Title, draft.ScheduledAt)
+	return nil
+}
+
+// logTakedownNotifier 将下架通知写入日志，接入站内信或推送通道后替换该provider即可
+type logTakedownNotifier struct {
+	log *log.Helper
+}
+
+// NewTakedownNotifier .
+func NewTakedownNotifier(logger log.Logger) biz.TakedownNotifier {
+	return &logTakedownNotifier{
+		log: log.NewHelper(logger),
+	}
+}
+
+func (n *logTakedownNotifier) NotifyTakedown(ctx context.Context, takedown *biz.VideoTakedown) error {
+	n.log.WithContext(ctx).Infof("takedown notice for user %d: video=%d category=%s reason=%q, appeal via POST /douyin/video/takedown/appeal takedown_id=%d",
+		takedown.AuthorID, takedown.VideoID, takedown.Category, takedown.Reason, takedown.ID)
+	return nil
+}
+
+func (n *logTakedownNotifier) NotifyAppealDecision(ctx context.Context, takedown *biz.VideoTakedown) error {
+	n.log.WithContext(ctx).Infof("takedown appeal decision for user %d: video=%d takedown=%d status=%d note=%q",
+		takedown.AuthorID, takedown.VideoID, takedown.ID, takedown.Status, takedown.DecisionNote)
+	return nil
+}
+
+// logIntegrityNotifier 将视频文件损坏通知写入日志，接入站内信或推送通道后替换该provider即可
+type logIntegrityNotifier struct {
+	log *log.Helper
+}
+
+// NewIntegrityNotifier .
+func NewIntegrityNotifier(logger log.Logger) biz.IntegrityNotifier {
+	return &logIntegrityNotifier{
+		log: log.NewHelper(logger),
+	}
+}
+
+func (n *logIntegrityNotifier) NotifyVideoUnavailable(ctx context.Context, video *domain.Video, record *biz.VideoIntegrity) error {
+	n.log.WithContext(ctx).Infof("video unavailable notice for user %d: video=%d title=%q status=%d detail=%q, please upload the video again",
+		video.AuthorID, video.ID, video.Title, record.Status, record.Detail)
+	return nil
+}
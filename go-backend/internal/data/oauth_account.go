@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/cache"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// OAuthAccountModel 第三方账号绑定模型
+type OAuthAccountModel struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int64     `gorm:"not null;index:idx_user_id" json:"user_id"`
+	Provider  string    `gorm:"size:32;not null;uniqueIndex:uk_provider_subject,priority:1" json:"provider"`
+	Subject   string    `gorm:"size:128;not null;uniqueIndex:uk_provider_subject,priority:2" json:"subject"`
+	Email     string    `gorm:"size:128;not null;default:''" json:"email"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (OAuthAccountModel) TableName() string {
+	return "user_oauth_accounts"
+}
+
+type oauthAccountRepo struct {
+	data        *Data
+	log         *log.Helper
+	userCache   *cache.UserCache
+	passwordMgr *auth.PasswordManager
+}
+
+// NewOAuthAccountRepo .
+func NewOAuthAccountRepo(data *Data, userCache *cache.UserCache, passwordMgr *auth.PasswordManager, logger log.Logger) biz.OAuthAccountRepo {
+	return &oauthAccountRepo{
+		data:        data,
+		log:         log.NewHelper(logger),
+		userCache:   userCache,
+		passwordMgr: passwordMgr,
+	}
+}
+
+func (r *oauthAccountRepo) GetOAuthAccount(ctx context.Context, provider, subject string) (*biz.OAuthAccount, error) {
+	var models []OAuthAccountModel
+	if err := r.data.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		Limit(1).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return oauthAccountModelToBiz(&models[0]), nil
+}
+
+func (r *oauthAccountRepo) LinkOAuthAccount(ctx context.Context, account *biz.OAuthAccount) error {
+	model := oauthAccountBizToModel(account)
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	account.ID = model.ID
+	account.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// CreateOAuthUser 创建用户并绑定第三方账号，任一唯一键冲突时整体回滚
+func (r *oauthAccountRepo) CreateOAuthUser(ctx context.Context, user *biz.User, account *biz.OAuthAccount) (*biz.User, error) {
+	hash, salt, err := r.passwordMgr.HashPassword(user.PasswordHash)
+	if err != nil {
+		return nil, fmt.Errorf("hash password failed: %w", err)
+	}
+
+	u := &User{
+		Username:     user.Username,
+		PasswordHash: hash,
+		Salt:         salt,
+		Nickname:     user.Nickname,
+		Avatar:       user.Avatar,
+		Status:       1,
+	}
+	model := oauthAccountBizToModel(account)
+
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(u).Error; err != nil {
+			return err
+		}
+		model.UserID = u.ID
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, biz.ErrUserExist
+		}
+		return nil, err
+	}
+
+	account.ID = model.ID
+	account.UserID = u.ID
+	account.CreatedAt = model.CreatedAt
+
+	result := userModelToBiz(u)
+	r.userCache.SetUser(ctx, result)
+	return result, nil
+}
+
+func oauthAccountBizToModel(a *biz.OAuthAccount) *OAuthAccountModel {
+	return &OAuthAccountModel{
+		UserID:   a.UserID,
+		Provider: a.Provider,
+		Subject:  a.Subject,
+		Email:    a.Email,
+	}
+}
+
+func oauthAccountModelToBiz(m *OAuthAccountModel) *biz.OAuthAccount {
+	return &biz.OAuthAccount{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Provider:  m.Provider,
+		Subject:   m.Subject,
+		Email:     m.Email,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/cache"
+	"go-backend/pkg/auth"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthAccountRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{EnableL2: true})
+	passwordMgr := auth.NewPasswordManager()
+	repo := NewOAuthAccountRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, cache.NewUserCache(multiCache, log.DefaultLogger), passwordMgr, log.DefaultLogger)
+	ctx := context.Background()
+
+	account, err := repo.GetOAuthAccount(ctx, "github", "42")
+	require.NoError(t, err)
+	assert.Nil(t, account)
+
+	created, err := repo.CreateOAuthUser(ctx,
+		&biz.User{Username: "github_octocat_123456", PasswordHash: "random-secret", Nickname: "octocat"},
+		&biz.OAuthAccount{Provider: "github", Subject: "42"})
+	require.NoError(t, err)
+	assert.NotZero(t, created.ID)
+	assert.Equal(t, "octocat", created.Nickname)
+
+	account, err = repo.GetOAuthAccount(ctx, "github", "42")
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	assert.Equal(t, created.ID, account.UserID)
+
+	var u User
+	require.NoError(t, env.DB.DB.First(&u, created.ID).Error)
+	ok, err := passwordMgr.VerifyPassword("random-secret", u.PasswordHash, u.Salt)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// 同一第三方账号重复创建时整体回滚，不留下孤立用户
+	_, err = repo.CreateOAuthUser(ctx,
+		&biz.User{Username: "github_octocat_654321", PasswordHash: "random-secret"},
+		&biz.OAuthAccount{Provider: "github", Subject: "42"})
+	assert.Equal(t, biz.ErrUserExist, err)
+	var count int64
+	require.NoError(t, env.DB.DB.Model(&User{}).Where("username = ?", "github_octocat_654321").Count(&count).Error)
+	assert.Zero(t, count)
+
+	// 同一用户可绑定多个提供方
+	require.NoError(t, repo.LinkOAuthAccount(ctx, &biz.OAuthAccount{UserID: created.ID, Provider: "google", Subject: "g-1", Email: "octo@example.com"}))
+	account, err = repo.GetOAuthAccount(ctx, "google", "g-1")
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	assert.Equal(t, created.ID, account.UserID)
+	assert.Equal(t, "octo@example.com", account.Email)
+}
@@ -0,0 +1,222 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// sessionPurgeBatchSize 删除全部会话时每批处理的用户数
+const sessionPurgeBatchSize = 500
+
+// cacheNamespacePatterns 缓存命名空间对应的键模式
+var cacheNamespacePatterns = map[string][]string{
+	biz.CacheNamespaceUser:       {"user:*"},
+	biz.CacheNamespaceVideo:      {"video:*", "user:videos:*", "hot:videos:*"},
+	biz.CacheNamespaceFeed:       {"feed:*"},
+	biz.CacheNamespaceRelation:   {"follow:*", "follower:*"},
+	biz.CacheNamespacePermission: {"user_permissions:*", "user_roles:*"},
+	biz.CacheNamespaceProfile:    {"profile:page:*"},
+}
+
+// OpsLogModel 运维操作审计记录模型
+type OpsLogModel struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	AdminID   int64     `gorm:"not null;index:idx_admin_created,priority:1" json:"admin_id"`
+	Action    string    `gorm:"size:32;not null;index:idx_action_created,priority:1" json:"action"`
+	Target    string    `gorm:"size:500;not null;default:''" json:"target"`
+	Affected  int64     `gorm:"not null;default:0" json:"affected"`
+	Status    int32     `gorm:"not null;default:1" json:"status"`
+	Error     string    `gorm:"size:1000;not null;default:''" json:"error"`
+	CreatedAt time.Time `gorm:"autoCreateTime;index:idx_action_created,priority:2;index:idx_admin_created,priority:2" json:"created_at"`
+}
+
+func (OpsLogModel) TableName() string {
+	return "admin_operation_logs"
+}
+
+type opsRepo struct {
+	data       *Data
+	cache      *pkgcache.MultiLevelCache
+	sessions   *sessionManager
+	projection *ProfileProjection
+	log        *log.Helper
+}
+
+// NewOpsRepo .
+func NewOpsRepo(data *Data, cache *pkgcache.MultiLevelCache, projection *ProfileProjection, clk clock.Clock, logger log.Logger) biz.OpsRepo {
+	return &opsRepo{
+		data:       data,
+		cache:      cache,
+		sessions:   &sessionManager{data: data, clock: clk, log: log.NewHelper(logger)},
+		projection: projection,
+		log:        log.NewHelper(logger),
+	}
+}
+
+func (r *opsRepo) FlushCacheNamespace(ctx context.Context, namespace string) error {
+	patterns, ok := cacheNamespacePatterns[namespace]
+	if !ok {
+		return biz.ErrInvalidCacheNamespace
+	}
+	for _, pattern := range patterns {
+		if err := r.cache.Invalidate(ctx, pattern); err != nil {
+			return fmt.Errorf("flush %s: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// PurgeSessions 删除数据库中的会话后清除 Redis 中的会话和令牌索引。
+// 删除全部会话时按用户ID分批进行，期间新登录的会话可能被保留
+func (r *opsRepo) PurgeSessions(ctx context.Context, userID int64) (int64, error) {
+	if userID > 0 {
+		return r.purgeUserSessions(ctx, []int64{userID})
+	}
+
+	var total, lastUserID int64
+	for {
+		var userIDs []int64
+		if err := r.data.db.WithContext(ctx).Model(&UserSession{}).
+			Where("user_id > ?", lastUserID).
+			Distinct().Order("user_id").Limit(sessionPurgeBatchSize).
+			Pluck("user_id", &userIDs).Error; err != nil {
+			return total, err
+		}
+		if len(userIDs) == 0 {
+			return total, nil
+		}
+
+		purged, err := r.purgeUserSessions(ctx, userIDs)
+		total += purged
+		if err != nil {
+			return total, err
+		}
+		lastUserID = userIDs[len(userIDs)-1]
+	}
+}
+
+func (r *opsRepo) purgeUserSessions(ctx context.Context, userIDs []int64) (int64, error) {
+	result := r.data.db.WithContext(ctx).Where("user_id IN ?", userIDs).Delete(&UserSession{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	for _, userID := range userIDs {
+		if err := r.sessions.evictSession(ctx, userID); err != nil {
+			return result.RowsAffected, err
+		}
+	}
+	return result.RowsAffected, nil
+}
+
+// RebuildProfiles 重建区间内有效用户的读模型，区间内已停用或不存在的用户删除读模型
+func (r *opsRepo) RebuildProfiles(ctx context.Context, fromUserID, toUserID int64) (int64, error) {
+	var userIDs []int64
+	if err := r.data.db.WithContext(ctx).Model(&User{}).
+		Where("id BETWEEN ? AND ? AND status = 1", fromUserID, toUserID).
+		Order("id").Pluck("id", &userIDs).Error; err != nil {
+		return 0, err
+	}
+
+	active := make(map[int64]bool, len(userIDs))
+	var rebuilt int64
+	var errs []error
+	for _, userID := range userIDs {
+		active[userID] = true
+		if _, err := r.projection.rebuild(ctx, userID); err != nil && !errors.Is(err, biz.ErrUserNotFound) {
+			errs = append(errs, fmt.Errorf("rebuild profile %d: %w", userID, err))
+			continue
+		}
+		rebuilt++
+	}
+
+	var stale []string
+	for id := fromUserID; id <= toUserID; id++ {
+		if !active[id] {
+			stale = append(stale, profilePageKey(id))
+		}
+	}
+	if len(stale) > 0 {
+		if err := r.data.rdb.Del(ctx, stale...).Err(); err != nil {
+			errs = append(errs, fmt.Errorf("delete stale profiles: %w", err))
+		}
+	}
+	return rebuilt, errors.Join(errs...)
+}
+
+// RequeueProcessing 为每个视频写入新的上传事件，事件ID不同，不会被消费者去重
+func (r *opsRepo) RequeueProcessing(ctx context.Context, videoIDs []int64) (int64, error) {
+	var models []VideoModel
+	if err := r.data.db.WithContext(ctx).Where("id IN ?", videoIDs).Find(&models).Error; err != nil {
+		return 0, err
+	}
+
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range models {
+			event := &domain.VideoUploadedEvent{
+				VideoID:    model.ID,
+				AuthorID:   model.AuthorID,
+				Title:      model.Title,
+				PlayURL:    model.PlayURL,
+				CoverURL:   model.CoverURL,
+				Size:       model.Size,
+				Format:     model.Format,
+				UploadedAt: model.CreatedAt,
+				EventID:    utils.GenerateEventID(),
+				EventTime:  time.Now(),
+			}
+			if err := enqueueOutbox(tx, event.EventID, biz.OutboxEventVideoUploaded, model.ID, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(models)), nil
+}
+
+func (r *opsRepo) CreateOpsLog(ctx context.Context, entry *biz.OpsLog) error {
+	model := &OpsLogModel{
+		AdminID:  entry.AdminID,
+		Action:   entry.Action,
+		Target:   truncateRunes(entry.Target, 500),
+		Affected: entry.Affected,
+		Status:   entry.Status,
+		Error:    truncateRunes(entry.Error, 1000),
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	entry.ID = model.ID
+	entry.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *opsRepo) CountOpsLogs(ctx context.Context, action string, since time.Time) (int64, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Model(&OpsLogModel{}).
+		Where("action = ? AND created_at >= ?", action, since).
+		Count(&count).Error
+	return count, err
+}
+
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,107 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/pkg/clock"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpsRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	data := &Data{db: env.DB.DB, rdb: env.Redis.Client}
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{EnableL1: true, EnableL2: true})
+	repo := NewOpsRepo(data, multiCache, NewProfileProjection(data, log.DefaultLogger), clock.New(), log.DefaultLogger).(*opsRepo)
+	ctx := context.Background()
+
+	t.Run("OpsLog", func(t *testing.T) {
+		entry := &biz.OpsLog{AdminID: 1, Action: biz.OpsActionFlushCache, Target: "feed", Status: biz.OpsStatusSucceeded}
+		require.NoError(t, repo.CreateOpsLog(ctx, entry))
+		assert.NotZero(t, entry.ID)
+		require.NoError(t, repo.CreateOpsLog(ctx, &biz.OpsLog{AdminID: 1, Action: biz.OpsActionReindex, Status: biz.OpsStatusFailed, Error: "boom"}))
+
+		count, err := repo.CountOpsLogs(ctx, biz.OpsActionFlushCache, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		count, err = repo.CountOpsLogs(ctx, biz.OpsActionFlushCache, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("FlushCacheNamespace", func(t *testing.T) {
+		require.NoError(t, env.Redis.Client.Set(ctx, "feed:abc", "1", time.Minute).Err())
+		require.NoError(t, env.Redis.Client.Set(ctx, "video:1", "1", time.Minute).Err())
+
+		require.NoError(t, repo.FlushCacheNamespace(ctx, biz.CacheNamespaceFeed))
+
+		assert.Zero(t, env.Redis.Client.Exists(ctx, "feed:abc").Val())
+		assert.Equal(t, int64(1), env.Redis.Client.Exists(ctx, "video:1").Val())
+	})
+
+	t.Run("PurgeSessions", func(t *testing.T) {
+		users, err := env.DataManager.CreateTestUsers(3)
+		require.NoError(t, err)
+		for _, u := range users {
+			_, err := repo.sessions.CreateSession(ctx, u.ID, "token-"+u.Username, "family", time.Hour)
+			require.NoError(t, err)
+		}
+
+		purged, err := repo.PurgeSessions(ctx, users[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+		assert.Zero(t, env.Redis.Client.Exists(ctx, sessionUserKey(users[0].ID)).Val())
+		assert.Equal(t, int64(1), env.Redis.Client.Exists(ctx, sessionUserKey(users[1].ID)).Val())
+
+		purged, err = repo.PurgeSessions(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+
+		var remaining int64
+		require.NoError(t, env.DB.DB.Model(&UserSession{}).Count(&remaining).Error)
+		assert.Zero(t, remaining)
+		assert.Zero(t, env.Redis.Client.Exists(ctx, sessionUserKey(users[1].ID), sessionUserKey(users[2].ID)).Val())
+	})
+
+	t.Run("RebuildProfiles", func(t *testing.T) {
+		users, err := env.DataManager.CreateTestUsers(1)
+		require.NoError(t, err)
+		userID := users[0].ID
+		staleKey := profilePageKey(userID + 1)
+		require.NoError(t, env.Redis.Client.Set(ctx, staleKey, "{}", time.Minute).Err())
+
+		rebuilt, err := repo.RebuildProfiles(ctx, userID, userID+1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), rebuilt)
+		assert.Equal(t, int64(1), env.Redis.Client.Exists(ctx, profilePageKey(userID)).Val())
+		assert.Zero(t, env.Redis.Client.Exists(ctx, staleKey).Val())
+	})
+
+	t.Run("RequeueProcessing", func(t *testing.T) {
+		fixture, err := env.DataManager.CreateUser(testutils.WithVideos(1, domain.VideoStatusPublished))
+		require.NoError(t, err)
+		videoID := fixture.Videos[0].ID
+
+		requeued, err := repo.RequeueProcessing(ctx, []int64{videoID, videoID + 1000000})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), requeued)
+
+		var events int64
+		require.NoError(t, env.DB.DB.Model(&OutboxModel{}).
+			Where("event_type = ? AND aggregate_id = ?", biz.OutboxEventVideoUploaded, videoID).
+			Count(&events).Error)
+		assert.Equal(t, int64(1), events)
+	})
+}
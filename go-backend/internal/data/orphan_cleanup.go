@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type orphanCleanupRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOrphanCleanupRepo .
+func NewOrphanCleanupRepo(data *Data, logger log.Logger) biz.OrphanCleanupRepo {
+	return &orphanCleanupRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListVideoObjectRefs 已删除的视频不再引用存储对象，其余状态（包括处理失败、下架和草稿）都保留引用
+func (r *orphanCleanupRepo) ListVideoObjectRefs(ctx context.Context, afterID int64, limit int) ([]*domain.Video, error) {
+	var models []VideoModel
+	if err := r.data.db.WithContext(ctx).
+		Select("id", "play_url", "cover_url", "hls_url", "dynamic_cover_url", "play_urls").
+		Where("id > ? AND status <> ?", afterID, domain.VideoStatusDeleted).
+		Order("id").Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	videos := make([]*domain.Video, 0, len(models))
+	for i := range models {
+		videos = append(videos, &domain.Video{
+			ID:              models[i].ID,
+			PlayURL:         models[i].PlayURL,
+			CoverURL:        models[i].CoverURL,
+			HLSURL:          models[i].HLSURL,
+			DynamicCoverURL: models[i].DynamicCoverURL,
+			PlayURLs:        models[i].PlayURLs,
+		})
+	}
+	return videos, nil
+}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrphanCleanupRepo_ListVideoObjectRefs(t *testing.T) {
+	favorite, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := NewOrphanCleanupRepo(favorite.data, log.DefaultLogger)
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	author := users[0].ID
+	first := createFavoriteTestVideo(t, favorite.data, author)
+	deleted := createFavoriteTestVideo(t, favorite.data, author)
+	last := createFavoriteTestVideo(t, favorite.data, author)
+	require.NoError(t, favorite.data.db.Model(&VideoModel{}).Where("id = ?", deleted.ID).
+		Update("status", domain.VideoStatusDeleted).Error)
+
+	page, err := repo.ListVideoObjectRefs(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, first.ID, page[0].ID)
+	assert.Equal(t, first.PlayURL, page[0].PlayURL)
+
+	page, err = repo.ListVideoObjectRefs(ctx, first.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, last.ID, page[0].ID)
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxModel 发件箱事件模型，已投递的记录由 event_outbox 保留策略清理
+type OutboxModel struct {
+	ID            int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	EventID       string     `gorm:"size:64;not null;uniqueIndex:uk_event_id" json:"event_id"`
+	EventType     string     `gorm:"size:64;not null" json:"event_type"`
+	AggregateID   int64      `gorm:"not null" json:"aggregate_id"`
+	Payload       []byte     `gorm:"type:json;not null" json:"payload"`
+	Status        int32      `gorm:"not null;index:idx_status_next,priority:1" json:"status"`
+	Attempts      int32      `gorm:"not null" json:"attempts"`
+	LastError     string     `gorm:"size:500" json:"last_error"`
+	NextAttemptAt time.Time  `gorm:"not null;index:idx_status_next,priority:2" json:"next_attempt_at"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	SentAt        *time.Time `gorm:"index:idx_sent_at" json:"sent_at"`
+}
+
+func (OutboxModel) TableName() string {
+	return "event_outbox"
+}
+
+// enqueueOutbox 在业务事务 tx 中写入发件箱事件，事务回滚时事件一并丢弃
+func enqueueOutbox(tx *gorm.DB, eventID, eventType string, aggregateID int64, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&OutboxModel{
+		EventID:       eventID,
+		EventType:     eventType,
+		AggregateID:   aggregateID,
+		Payload:       data,
+		Status:        biz.OutboxStatusPending,
+		NextAttemptAt: time.Now(),
+	}).Error
+}
+
+type outboxRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOutboxRepo .
+func NewOutboxRepo(data *Data, logger log.Logger) biz.OutboxRepo {
+	return &outboxRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ClaimDue 用 SKIP LOCKED 选出到期事件并把 next_attempt_at 顺延一个租约，
+// 其他中继实例跳过已锁定的行；中继在租约内崩溃时事件到期后会被重新领取
+func (r *outboxRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*biz.OutboxEvent, error) {
+	var models []OutboxModel
+	now := time.Now()
+
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", biz.OutboxStatusPending, now).
+			Order("next_attempt_at, id").
+			Limit(limit).
+			Find(&models).Error; err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(models))
+		for i, m := range models {
+			ids[i] = m.ID
+		}
+		return tx.Model(&OutboxModel{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("claim outbox events failed: %v", err)
+		return nil, err
+	}
+
+	events := make([]*biz.OutboxEvent, len(models))
+	for i, m := range models {
+		events[i] = &biz.OutboxEvent{
+			ID:            m.ID,
+			EventID:       m.EventID,
+			EventType:     m.EventType,
+			AggregateID:   m.AggregateID,
+			Payload:       m.Payload,
+			Status:        m.Status,
+			Attempts:      m.Attempts,
+			LastError:     m.LastError,
+			NextAttemptAt: m.NextAttemptAt,
+			CreatedAt:     m.CreatedAt,
+		}
+	}
+	return events, nil
+}
+
+func (r *outboxRepo) MarkSent(ctx context.Context, id int64) error {
+	return r.data.db.WithContext(ctx).Model(&OutboxModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":  biz.OutboxStatusSent,
+			"sent_at": time.Now(),
+		}).Error
+}
+
+func (r *outboxRepo) MarkFailed(ctx context.Context, event *biz.OutboxEvent) error {
+	return r.data.db.WithContext(ctx).Model(&OutboxModel{}).
+		Where("id = ?", event.ID).
+		Updates(map[string]interface{}{
+			"status":          event.Status,
+			"attempts":        event.Attempts,
+			"last_error":      event.LastError,
+			"next_attempt_at": event.NextAttemptAt,
+		}).Error
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestOutboxRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := &outboxRepo{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	t.Run("RolledBackTransactionLeavesNoEvent", func(t *testing.T) {
+		err := env.DB.DB.Transaction(func(tx *gorm.DB) error {
+			if err := enqueueOutbox(tx, "evt_rollback", biz.OutboxEventVideoUploaded, 1, &domain.VideoUploadedEvent{VideoID: 1}); err != nil {
+				return err
+			}
+			return errors.New("business failure")
+		})
+		require.Error(t, err)
+
+		var count int64
+		require.NoError(t, env.DB.DB.Model(&OutboxModel{}).Where("event_id = ?", "evt_rollback").Count(&count).Error)
+		assert.Zero(t, count)
+	})
+
+	t.Run("ClaimAndAck", func(t *testing.T) {
+		require.NoError(t, enqueueOutbox(env.DB.DB, "evt_1", biz.OutboxEventVideoUploaded, 1, &domain.VideoUploadedEvent{VideoID: 1}))
+		require.NoError(t, enqueueOutbox(env.DB.DB, "evt_2", biz.OutboxEventVideoStatsUpdated, 1, &domain.VideoStatsUpdatedEvent{VideoID: 1}))
+
+		events, err := repo.ClaimDue(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "evt_1", events[0].EventID)
+		var uploaded domain.VideoUploadedEvent
+		require.NoError(t, json.Unmarshal(events[0].Payload, &uploaded))
+		assert.Equal(t, int64(1), uploaded.VideoID)
+
+		// 租约期内不会被再次领取
+		again, err := repo.ClaimDue(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, again)
+
+		require.NoError(t, repo.MarkSent(ctx, events[0].ID))
+		events[1].Status = biz.OutboxStatusPending
+		events[1].Attempts = 1
+		events[1].LastError = "kafka unavailable"
+		events[1].NextAttemptAt = time.Now().Add(-time.Second)
+		require.NoError(t, repo.MarkFailed(ctx, events[1]))
+
+		retry, err := repo.ClaimDue(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, retry, 1)
+		assert.Equal(t, "evt_2", retry[0].EventID)
+		assert.Equal(t, int32(1), retry[0].Attempts)
+		assert.Equal(t, "kafka unavailable", retry[0].LastError)
+
+		var sent OutboxModel
+		require.NoError(t, env.DB.DB.Where("event_id = ?", "evt_1").First(&sent).Error)
+		assert.Equal(t, biz.OutboxStatusSent, sent.Status)
+		assert.NotNil(t, sent.SentAt)
+	})
+
+	t.Run("DuplicateEventID", func(t *testing.T) {
+		require.NoError(t, enqueueOutbox(env.DB.DB, "evt_dup", biz.OutboxEventVideoUploaded, 1, &domain.VideoUploadedEvent{}))
+		assert.Error(t, enqueueOutbox(env.DB.DB, "evt_dup", biz.OutboxEventVideoUploaded, 1, &domain.VideoUploadedEvent{}))
+	})
+}
@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type ownershipRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOwnershipRepo .
+func NewOwnershipRepo(data *Data, logger log.Logger) biz.OwnershipRepo {
+	return &ownershipRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ownershipRepo) GetVideoAuthorID(ctx context.Context, videoID int64) (int64, error) {
+	var authorIDs []int64
+	if err := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Where("id = ? AND status != ?", videoID, domain.VideoStatusDeleted).
+		Limit(1).Pluck("author_id", &authorIDs).Error; err != nil {
+		return 0, err
+	}
+	if len(authorIDs) == 0 {
+		return 0, utils.ErrVideoNotFound
+	}
+
+	return authorIDs[0], nil
+}
+
+func (r *ownershipRepo) GetCommentOwnerIDs(ctx context.Context, commentID int64) (int64, int64, error) {
+	var row struct {
+		UserID   int64
+		AuthorID int64
+	}
+	result := r.data.db.WithContext(ctx).Table("comments c").
+		Select("c.user_id, v.author_id").
+		Joins("JOIN videos v ON v.id = c.video_id").
+		Where("c.id = ? AND c.status = ?", commentID, biz.CommentStatusNormal).
+		Limit(1).Scan(&row)
+	if result.Error != nil {
+		return 0, 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, 0, biz.ErrCommentNotFound
+	}
+
+	return row.UserID, row.AuthorID, nil
+}
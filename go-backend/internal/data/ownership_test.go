@@ -0,0 +1,45 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnershipRepo(t *testing.T) {
+	commentRepo, env, cleanup := setupCommentRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := &ownershipRepo{data: commentRepo.data, log: log.NewHelper(log.DefaultLogger)}
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user, author := users[0], users[1]
+	video := createFavoriteTestVideo(t, commentRepo.data, author.ID)
+
+	comment, err := commentRepo.CreateComment(ctx, &biz.Comment{VideoID: video.ID, UserID: user.ID, Content: "nice"}, author.ID)
+	require.NoError(t, err)
+
+	authorID, err := repo.GetVideoAuthorID(ctx, video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, author.ID, authorID)
+
+	commenterID, videoAuthorID, err := repo.GetCommentOwnerIDs(ctx, comment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, commenterID)
+	assert.Equal(t, author.ID, videoAuthorID)
+
+	_, err = repo.GetVideoAuthorID(ctx, video.ID+1000)
+	assert.Equal(t, utils.ErrVideoNotFound, err)
+
+	require.NoError(t, commentRepo.DeleteComment(ctx, comment))
+	_, _, err = repo.GetCommentOwnerIDs(ctx, comment.ID)
+	assert.Equal(t, biz.ErrCommentNotFound, err)
+}
@@ -19,6 +19,7 @@ type Permission struct {
 	Resource    string    `gorm:"size:100;not null" json:"resource"`
 	Action      string    `gorm:"size:20;not null" json:"action"`
 	Description string    `gorm:"size:200" json:"description"`
+	Scope       string    `gorm:"size:20;not null;default:''" json:"scope"`
 	Status      int8      `gorm:"default:1" json:"status"`
 	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
@@ -158,6 +159,132 @@ func (r *PermissionRepo) HasPermission(ctx context.Context, userID int64, resour
 	return false, nil
 }
 
+func (r *PermissionRepo) ListPermissions(ctx context.Context) ([]*domain.Permission, error) {
+	var permissions []Permission
+	if err := r.data.db.WithContext(ctx).Order("id").Find(&permissions).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Permission, 0, len(permissions))
+	for i := range permissions {
+		result = append(result, r.convertToPermission(&permissions[i]))
+	}
+
+	return result, nil
+}
+
+func (r *PermissionRepo) ListRolePermissions(ctx context.Context) ([]*domain.RolePermission, error) {
+	var rolePerms []RolePermission
+	if err := r.data.db.WithContext(ctx).Order("id").Find(&rolePerms).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.RolePermission, 0, len(rolePerms))
+	for _, rp := range rolePerms {
+		result = append(result, &domain.RolePermission{
+			ID:           rp.ID,
+			RoleID:       rp.RoleID,
+			PermissionID: rp.PermissionID,
+			CreatedAt:    rp.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *PermissionRepo) FindPermission(ctx context.Context, permissionID int64) (*domain.Permission, error) {
+	var perm Permission
+	if err := r.data.db.WithContext(ctx).Where("id = ?", permissionID).First(&perm).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrPermissionNotFound
+		}
+		return nil, err
+	}
+
+	return r.convertToPermission(&perm), nil
+}
+
+func (r *PermissionRepo) CreatePermission(ctx context.Context, perm *domain.Permission) error {
+	model := &Permission{
+		Name:        perm.Name,
+		Resource:    perm.Resource,
+		Action:      perm.Action,
+		Description: perm.Description,
+		Scope:       perm.Scope,
+		Status:      perm.Status,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return biz.ErrPermissionExist
+		}
+		return err
+	}
+
+	*perm = *r.convertToPermission(model)
+	return nil
+}
+
+func (r *PermissionRepo) UpdatePermission(ctx context.Context, perm *domain.Permission) error {
+	result := r.data.db.WithContext(ctx).Model(&Permission{}).
+		Where("id = ?", perm.ID).
+		Updates(map[string]interface{}{
+			"name":        perm.Name,
+			"resource":    perm.Resource,
+			"action":      perm.Action,
+			"description": perm.Description,
+			"scope":       perm.Scope,
+			"status":      perm.Status,
+		})
+	if result.Error != nil {
+		if isDuplicateKeyError(result.Error) {
+			return biz.ErrPermissionExist
+		}
+		return result.Error
+	}
+
+	updated, err := r.FindPermission(ctx, perm.ID)
+	if err != nil {
+		return err
+	}
+	*perm = *updated
+	return nil
+}
+
+func (r *PermissionRepo) DeletePermission(ctx context.Context, permissionID int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("permission_id = ?", permissionID).Delete(&RolePermission{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", permissionID).Delete(&Permission{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrPermissionNotFound
+		}
+		return nil
+	})
+}
+
+func (r *PermissionRepo) AssignPermission(ctx context.Context, roleID, permissionID int64) error {
+	rolePerm := &RolePermission{
+		RoleID:       roleID,
+		PermissionID: permissionID,
+	}
+	err := r.data.db.WithContext(ctx).Create(rolePerm).Error
+	if err != nil && isDuplicateKeyError(err) {
+		return nil // 已绑定，不重复添加
+	}
+	return err
+}
+
+func (r *PermissionRepo) RevokePermission(ctx context.Context, roleID, permissionID int64) error {
+	return r.data.db.WithContext(ctx).
+		Where("role_id = ? AND permission_id = ?", roleID, permissionID).
+		Delete(&RolePermission{}).Error
+}
+
 func (r *PermissionRepo) convertToPermission(perm *Permission) *domain.Permission {
 	return &domain.Permission{
 		ID:          perm.ID,
@@ -165,6 +292,7 @@ func (r *PermissionRepo) convertToPermission(perm *Permission) *domain.Permissio
 		Resource:    perm.Resource,
 		Action:      perm.Action,
 		Description: perm.Description,
+		Scope:       perm.Scope,
 		Status:      perm.Status,
 		CreatedAt:   perm.CreatedAt,
 		UpdatedAt:   perm.UpdatedAt,
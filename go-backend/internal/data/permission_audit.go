@@ -0,0 +1,126 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/listquery"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// PermissionDenialModel 权限拒绝记录模型
+type PermissionDenialModel struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int64     `gorm:"not null;index:idx_user_created,priority:1" json:"user_id"`
+	Resource  string    `gorm:"size:100;not null" json:"resource"`
+	Action    string    `gorm:"size:50;not null" json:"action"`
+	Route     string    `gorm:"size:255;not null;default:''" json:"route"`
+	CreatedAt time.Time `gorm:"autoCreateTime;index:idx_user_created,priority:2;index:idx_created_at" json:"created_at"`
+}
+
+func (PermissionDenialModel) TableName() string {
+	return "permission_denials"
+}
+
+type permissionAuditRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPermissionAuditRepo .
+func NewPermissionAuditRepo(data *Data, logger log.Logger) biz.PermissionAuditRepo {
+	return &permissionAuditRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *permissionAuditRepo) CreateDenial(ctx context.Context, denial *domain.PermissionDenial) error {
+	model := &PermissionDenialModel{
+		UserID:   denial.UserID,
+		Resource: denial.Resource,
+		Action:   denial.Action,
+		Route:    denial.Route,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	denial.ID = model.ID
+	denial.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *permissionAuditRepo) ListDenials(ctx context.Context, filter *biz.DenialFilter, q *listquery.Compiled) ([]*domain.PermissionDenial, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&PermissionDenialModel{})
+	if filter.UserID > 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Resource != "" {
+		query = query.Where("resource = ?", filter.Resource)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at < ?", filter.EndTime)
+	}
+	query = applyListFilters(query, q)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []PermissionDenialModel
+	if err := applyListPage(query.Session(&gorm.Session{}), q).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	denials := make([]*domain.PermissionDenial, 0, len(models))
+	for _, m := range models {
+		denials = append(denials, &domain.PermissionDenial{
+			ID:        m.ID,
+			UserID:    m.UserID,
+			Resource:  m.Resource,
+			Action:    m.Action,
+			Route:     m.Route,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	return denials, total, nil
+}
+
+func (r *permissionAuditRepo) TrimDenials(ctx context.Context, keep int64, batchSize int) (int64, error) {
+	// 第keep+1新的记录及更早的记录都需要删除
+	var boundary []int64
+	if err := r.data.db.WithContext(ctx).Model(&PermissionDenialModel{}).
+		Order("id DESC").Offset(int(keep)).Limit(1).
+		Pluck("id", &boundary).Error; err != nil {
+		return 0, err
+	}
+	if len(boundary) == 0 {
+		return 0, nil
+	}
+
+	var deleted int64
+	for {
+		result := r.data.db.WithContext(ctx).Exec(
+			"DELETE FROM `permission_denials` WHERE id <= ? LIMIT ?", boundary[0], batchSize)
+		if result.Error != nil {
+			return deleted, result.Error
+		}
+		deleted += result.RowsAffected
+		if result.RowsAffected < int64(batchSize) {
+			return deleted, nil
+		}
+	}
+}
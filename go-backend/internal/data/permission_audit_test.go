@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPermissionAuditRepo(t *testing.T) (*permissionAuditRepo, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	repo := &permissionAuditRepo{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, cleanup
+}
+
+func TestPermissionAuditRepo_ListDenials(t *testing.T) {
+	repo, cleanup := setupPermissionAuditRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	require.NoError(t, repo.CreateDenial(ctx, &domain.PermissionDenial{UserID: 1, Resource: "/video", Action: "DELETE", Route: "POST /douyin/video/delete"}))
+	require.NoError(t, repo.CreateDenial(ctx, &domain.PermissionDenial{UserID: 1, Resource: "/*", Action: "*", Route: "GET /douyin/admin/permission/denials"}))
+	require.NoError(t, repo.CreateDenial(ctx, &domain.PermissionDenial{UserID: 2, Resource: "/video", Action: "DELETE"}))
+
+	denials, total, err := repo.ListDenials(ctx, &biz.DenialFilter{UserID: 1}, mustCompileListQuery(t, biz.DenialListSchema, "", "", 10))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, denials, 2)
+	assert.Equal(t, "/*", denials[0].Resource)
+
+	denials, total, err = repo.ListDenials(ctx, &biz.DenialFilter{Resource: "/video", Action: "DELETE"}, mustCompileListQuery(t, biz.DenialListSchema, "", "", 10))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, denials, 2)
+}
+
+func TestPermissionAuditRepo_TrimDenials(t *testing.T) {
+	repo, cleanup := setupPermissionAuditRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	var newest int64
+	for i := 0; i < 5; i++ {
+		denial := &domain.PermissionDenial{UserID: int64(i + 1), Resource: "/video", Action: "DELETE"}
+		require.NoError(t, repo.CreateDenial(ctx, denial))
+		newest = denial.ID
+	}
+
+	deleted, err := repo.TrimDenials(ctx, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	denials, total, err := repo.ListDenials(ctx, &biz.DenialFilter{}, mustCompileListQuery(t, biz.DenialListSchema, "", "", 10))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, newest, denials[0].ID)
+
+	// 未超出上限时不删除
+	deleted, err = repo.TrimDenials(ctx, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"go-backend/internal/biz"
 	"go-backend/internal/domain"
 	"go-backend/testutils"
 
@@ -299,3 +300,80 @@ func TestPermissionRepo_MultipleRolesAndPermissions(t *testing.T) {
 	assert.True(t, permNames[perm2.Name])
 	assert.True(t, permNames[perm3.Name])
 }
+
+func TestPermissionRepo_ListPermissionsAndRolePermissions(t *testing.T) {
+	repo, env, cleanup := setupPermissionRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	roles, err := env.DataManager.CreateTestRoles()
+	require.NoError(t, err)
+	permissions, err := env.DataManager.CreateTestPermissions()
+	require.NoError(t, err)
+
+	err = env.DB.DB.Create(&RolePermission{
+		RoleID:       roles[0].ID,
+		PermissionID: permissions[0].ID,
+	}).Error
+	require.NoError(t, err)
+
+	allPermissions, err := repo.ListPermissions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, allPermissions, len(permissions))
+
+	rolePerms, err := repo.ListRolePermissions(ctx)
+	require.NoError(t, err)
+	require.Len(t, rolePerms, 1)
+	assert.Equal(t, roles[0].ID, rolePerms[0].RoleID)
+	assert.Equal(t, permissions[0].ID, rolePerms[0].PermissionID)
+}
+
+func TestPermissionRepo_CRUD(t *testing.T) {
+	repo, env, cleanup := setupPermissionRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	roles, err := env.DataManager.CreateTestRoles()
+	require.NoError(t, err)
+
+	perm := &domain.Permission{Name: "video:update", Resource: "/video", Action: "PUT", Status: 1}
+	require.NoError(t, repo.CreatePermission(ctx, perm))
+	assert.NotZero(t, perm.ID)
+
+	// 名称重复
+	err = repo.CreatePermission(ctx, &domain.Permission{Name: "video:update", Resource: "/video", Action: "PUT", Status: 1})
+	assert.ErrorIs(t, err, biz.ErrPermissionExist)
+
+	perm.Scope = domain.PermissionScopeOwn
+	perm.Status = 2
+	require.NoError(t, repo.UpdatePermission(ctx, perm))
+	found, err := repo.FindPermission(ctx, perm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PermissionScopeOwn, found.Scope)
+	assert.Equal(t, int8(2), found.Status)
+
+	// 重复绑定不报错
+	require.NoError(t, repo.AssignPermission(ctx, roles[0].ID, perm.ID))
+	require.NoError(t, repo.AssignPermission(ctx, roles[0].ID, perm.ID))
+	require.NoError(t, repo.AssignPermission(ctx, roles[1].ID, perm.ID))
+	rolePerms, err := repo.ListRolePermissions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, rolePerms, 2)
+
+	require.NoError(t, repo.RevokePermission(ctx, roles[1].ID, perm.ID))
+	rolePerms, err = repo.ListRolePermissions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, rolePerms, 1)
+
+	// 删除权限时一并解除绑定
+	require.NoError(t, repo.DeletePermission(ctx, perm.ID))
+	rolePerms, err = repo.ListRolePermissions(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, rolePerms)
+
+	_, err = repo.FindPermission(ctx, perm.ID)
+	assert.ErrorIs(t, err, biz.ErrPermissionNotFound)
+	assert.ErrorIs(t, repo.DeletePermission(ctx, perm.ID), biz.ErrPermissionNotFound)
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayDedupRepo_MarkPlayed(t *testing.T) {
+	favorite, _, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := NewPlayDedupRepo(favorite.data, log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("User", func(t *testing.T) {
+		viewer := biz.PlayViewer{UserID: 7}
+		first, err := repo.MarkPlayed(ctx, 100, viewer, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, first)
+
+		again, err := repo.MarkPlayed(ctx, 100, viewer, time.Hour)
+		require.NoError(t, err)
+		assert.False(t, again)
+
+		// 不同用户、不同视频分别计数
+		other, err := repo.MarkPlayed(ctx, 100, biz.PlayViewer{UserID: 8}, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, other)
+		otherVideo, err := repo.MarkPlayed(ctx, 101, viewer, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, otherVideo)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		viewer := biz.PlayViewer{ClientIP: "192.168.1.10"}
+		first, err := repo.MarkPlayed(ctx, 100, viewer, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, first)
+
+		again, err := repo.MarkPlayed(ctx, 100, viewer, time.Hour)
+		require.NoError(t, err)
+		assert.False(t, again)
+	})
+
+	t.Run("KeyExpiresWithWindow", func(t *testing.T) {
+		_, err := repo.MarkPlayed(ctx, 102, biz.PlayViewer{UserID: 1}, time.Hour)
+		require.NoError(t, err)
+
+		slot := time.Now().UnixNano() / int64(time.Hour)
+		ttl := favorite.data.rdb.TTL(ctx, playDedupUserKey(102, slot)).Val()
+		assert.True(t, ttl > 0 && ttl <= time.Hour)
+	})
+}
@@ -0,0 +1,245 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PlaylistModel 视频合集模型
+type PlaylistModel struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID      int64     `gorm:"not null;index:idx_user_created,priority:1" json:"user_id"`
+	Name        string    `gorm:"size:50;not null" json:"name"`
+	Description string    `gorm:"size:200;not null;default:''" json:"description"`
+	VideoCount  int64     `gorm:"not null;default:0" json:"video_count"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;index:idx_user_created,priority:2" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (PlaylistModel) TableName() string {
+	return "playlists"
+}
+
+// PlaylistVideoModel 合集中的视频条目，按 position 升序排列
+type PlaylistVideoModel struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	PlaylistID int64     `gorm:"not null;uniqueIndex:uk_playlist_video,priority:1;index:idx_playlist_position,priority:1" json:"playlist_id"`
+	VideoID    int64     `gorm:"not null;uniqueIndex:uk_playlist_video,priority:2" json:"video_id"`
+	Position   int       `gorm:"not null;default:0;index:idx_playlist_position,priority:2" json:"position"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (PlaylistVideoModel) TableName() string {
+	return "playlist_videos"
+}
+
+type playlistRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPlaylistRepo .
+func NewPlaylistRepo(data *Data, logger log.Logger) biz.PlaylistRepo {
+	return &playlistRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *playlistRepo) CreatePlaylist(ctx context.Context, playlist *biz.Playlist) error {
+	model := &PlaylistModel{
+		UserID:      playlist.UserID,
+		Name:        playlist.Name,
+		Description: playlist.Description,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*playlist = *playlistModelToBiz(model)
+	return nil
+}
+
+func (r *playlistRepo) UpdatePlaylist(ctx context.Context, playlist *biz.Playlist) error {
+	result := r.data.db.WithContext(ctx).Model(&PlaylistModel{}).
+		Where("id = ? AND user_id = ?", playlist.ID, playlist.UserID).
+		Updates(map[string]interface{}{
+			"name":        playlist.Name,
+			"description": playlist.Description,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	updated, err := r.GetPlaylist(ctx, playlist.ID)
+	if err != nil {
+		return err
+	}
+	*playlist = *updated
+	return nil
+}
+
+func (r *playlistRepo) GetPlaylist(ctx context.Context, playlistID int64) (*biz.Playlist, error) {
+	var model PlaylistModel
+	if err := r.data.db.WithContext(ctx).Where("id = ?", playlistID).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrPlaylistNotFound
+		}
+		return nil, err
+	}
+	return playlistModelToBiz(&model), nil
+}
+
+func (r *playlistRepo) DeletePlaylist(ctx context.Context, userID, playlistID int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND user_id = ?", playlistID, userID).Delete(&PlaylistModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrPlaylistNotFound
+		}
+		return tx.Where("playlist_id = ?", playlistID).Delete(&PlaylistVideoModel{}).Error
+	})
+}
+
+func (r *playlistRepo) CountPlaylists(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Model(&PlaylistModel{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *playlistRepo) ListUserPlaylists(ctx context.Context, userID int64, page, size int32) ([]*biz.Playlist, int64, error) {
+	offset := (page - 1) * size
+
+	var total int64
+	if err := r.data.db.WithContext(ctx).Model(&PlaylistModel{}).
+		Where("user_id = ?", userID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []PlaylistModel
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Offset(int(offset)).Limit(int(size)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	playlists := make([]*biz.Playlist, 0, len(models))
+	for i := range models {
+		playlists = append(playlists, playlistModelToBiz(&models[i]))
+	}
+	return playlists, total, nil
+}
+
+func (r *playlistRepo) AddPlaylistVideo(ctx context.Context, playlistID, videoID int64, maxVideos int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定合集行，并发追加时视频数和位置不会冲突
+		var playlist PlaylistModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", playlistID).
+			First(&playlist).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrPlaylistNotFound
+			}
+			return err
+		}
+		if playlist.VideoCount >= maxVideos {
+			return biz.ErrPlaylistFull
+		}
+
+		var position int
+		if err := tx.Model(&PlaylistVideoModel{}).
+			Where("playlist_id = ?", playlistID).
+			Select("COALESCE(MAX(position) + 1, 0)").
+			Scan(&position).Error; err != nil {
+			return err
+		}
+
+		entry := &PlaylistVideoModel{PlaylistID: playlistID, VideoID: videoID, Position: position}
+		if err := tx.Create(entry).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return biz.ErrVideoAlreadyInPlaylist
+			}
+			return err
+		}
+		return tx.Model(&playlist).Update("video_count", gorm.Expr("video_count + 1")).Error
+	})
+}
+
+func (r *playlistRepo) RemovePlaylistVideo(ctx context.Context, playlistID, videoID int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("playlist_id = ? AND video_id = ?", playlistID, videoID).Delete(&PlaylistVideoModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrVideoNotInPlaylist
+		}
+		return tx.Model(&PlaylistModel{}).
+			Where("id = ? AND video_count > 0", playlistID).
+			Update("video_count", gorm.Expr("video_count - 1")).Error
+	})
+}
+
+func (r *playlistRepo) GetPlaylistVideoIDs(ctx context.Context, playlistID int64, page, size int32) ([]int64, int64, error) {
+	offset := (page - 1) * size
+
+	var total int64
+	if err := r.data.db.WithContext(ctx).Model(&PlaylistVideoModel{}).
+		Where("playlist_id = ?", playlistID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var videoIDs []int64
+	if err := r.data.db.WithContext(ctx).Model(&PlaylistVideoModel{}).
+		Where("playlist_id = ?", playlistID).
+		Order("position ASC, id ASC").
+		Offset(int(offset)).Limit(int(size)).
+		Pluck("video_id", &videoIDs).Error; err != nil {
+		return nil, 0, err
+	}
+	return videoIDs, total, nil
+}
+
+func (r *playlistRepo) ListAllPlaylistVideoIDs(ctx context.Context, playlistID int64) ([]int64, error) {
+	var videoIDs []int64
+	err := r.data.db.WithContext(ctx).Model(&PlaylistVideoModel{}).
+		Where("playlist_id = ?", playlistID).
+		Order("position ASC, id ASC").
+		Pluck("video_id", &videoIDs).Error
+	return videoIDs, err
+}
+
+func (r *playlistRepo) ReorderPlaylistVideos(ctx context.Context, playlistID int64, videoIDs []int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for position, videoID := range videoIDs {
+			if err := tx.Model(&PlaylistVideoModel{}).
+				Where("playlist_id = ? AND video_id = ?", playlistID, videoID).
+				Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func playlistModelToBiz(model *PlaylistModel) *biz.Playlist {
+	return &biz.Playlist{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Name:        model.Name,
+		Description: model.Description,
+		VideoCount:  model.VideoCount,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+}
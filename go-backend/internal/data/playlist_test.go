@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaylistRepo_CRUD(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewPlaylistRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+
+	playlist := &biz.Playlist{UserID: 1, Name: "travel", Description: "on the road"}
+	require.NoError(t, repo.CreatePlaylist(ctx, playlist))
+	assert.NotZero(t, playlist.ID)
+	require.NoError(t, repo.CreatePlaylist(ctx, &biz.Playlist{UserID: 1, Name: "music"}))
+
+	playlist.Name = "travel 2024"
+	require.NoError(t, repo.UpdatePlaylist(ctx, playlist))
+	assert.Equal(t, "travel 2024", playlist.Name)
+
+	count, err := repo.CountPlaylists(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	playlists, total, err := repo.ListUserPlaylists(ctx, 1, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, playlists, 1)
+
+	assert.ErrorIs(t, repo.DeletePlaylist(ctx, 2, playlist.ID), biz.ErrPlaylistNotFound)
+	require.NoError(t, repo.DeletePlaylist(ctx, 1, playlist.ID))
+	_, err = repo.GetPlaylist(ctx, playlist.ID)
+	assert.ErrorIs(t, err, biz.ErrPlaylistNotFound)
+}
+
+func TestPlaylistRepo_Videos(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewPlaylistRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+
+	playlist := &biz.Playlist{UserID: 1, Name: "travel"}
+	require.NoError(t, repo.CreatePlaylist(ctx, playlist))
+
+	for _, videoID := range []int64{10, 11, 12} {
+		require.NoError(t, repo.AddPlaylistVideo(ctx, playlist.ID, videoID, 3))
+	}
+	assert.ErrorIs(t, repo.AddPlaylistVideo(ctx, playlist.ID, 13, 3), biz.ErrPlaylistFull)
+	assert.ErrorIs(t, repo.AddPlaylistVideo(ctx, playlist.ID, 10, 4), biz.ErrVideoAlreadyInPlaylist)
+
+	require.NoError(t, repo.ReorderPlaylistVideos(ctx, playlist.ID, []int64{12, 10, 11}))
+	videoIDs, total, err := repo.GetPlaylistVideoIDs(ctx, playlist.ID, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []int64{12, 10}, videoIDs)
+
+	require.NoError(t, repo.RemovePlaylistVideo(ctx, playlist.ID, 10))
+	assert.ErrorIs(t, repo.RemovePlaylistVideo(ctx, playlist.ID, 10), biz.ErrVideoNotInPlaylist)
+
+	// 移除后追加到末尾
+	require.NoError(t, repo.AddPlaylistVideo(ctx, playlist.ID, 13, 3))
+	all, err := repo.ListAllPlaylistVideoIDs(ctx, playlist.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{12, 11, 13}, all)
+
+	found, err := repo.GetPlaylist(ctx, playlist.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), found.VideoCount)
+}
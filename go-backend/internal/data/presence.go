@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/data/cache"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// presenceRepo 在线状态仓储，复用认证缓存中带过期时间的在线标记
+type presenceRepo struct {
+	authCache *cache.AuthCache
+	log       *log.Helper
+}
+
+// NewPresenceRepo .
+func NewPresenceRepo(authCache *cache.AuthCache, logger log.Logger) biz.PresenceRepo {
+	return &presenceRepo{
+		authCache: authCache,
+		log:       log.NewHelper(logger),
+	}
+}
+
+func (r *presenceRepo) MarkOnline(ctx context.Context, userID int64) error {
+	return r.authCache.AddOnlineUser(ctx, userID)
+}
+
+func (r *presenceRepo) MarkOffline(ctx context.Context, userID int64) error {
+	return r.authCache.RemoveOnlineUser(ctx, userID)
+}
+
+func (r *presenceRepo) OnlineUsers(ctx context.Context, userIDs []int64) (map[int64]bool, error) {
+	online := make(map[int64]bool, len(userIDs))
+	for _, userID := range userIDs {
+		ok, err := r.authCache.IsUserOnline(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			online[userID] = true
+		}
+	}
+	return online, nil
+}
@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	processedEventKeyPrefix = "event:processed:"
+	eventLockKeyPrefix      = "event:lock:"
+)
+
+// ProcessedEvent 消费者已处理的事件
+type ProcessedEvent struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement"`
+	Consumer    string    `gorm:"size:64;not null;uniqueIndex:uk_consumer_event,priority:1"`
+	EventID     string    `gorm:"size:64;not null;uniqueIndex:uk_consumer_event,priority:2"`
+	ProcessedAt time.Time `gorm:"autoCreateTime;index:idx_processed_at"`
+}
+
+func (ProcessedEvent) TableName() string {
+	return "processed_events"
+}
+
+type processedEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewProcessedEventRepo .
+func NewProcessedEventRepo(data *Data, logger log.Logger) biz.ProcessedEventRepo {
+	return &processedEventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func processedEventKey(consumer, eventID string) string {
+	return processedEventKeyPrefix + consumer + ":" + eventID
+}
+
+func eventLockKey(consumer, eventID string) string {
+	return eventLockKeyPrefix + consumer + ":" + eventID
+}
+
+// IsProcessed 先查Redis中的标记，未命中时查数据库并回填标记
+func (r *processedEventRepo) IsProcessed(ctx context.Context, consumer, eventID string, cacheTTL time.Duration) (bool, error) {
+	key := processedEventKey(consumer, eventID)
+	if _, err := r.data.rdb.Get(ctx, key).Result(); err == nil {
+		return true, nil
+	} else if err != redis.Nil {
+		r.log.WithContext(ctx).Warnf("get processed event marker failed: %v", err)
+	}
+
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&ProcessedEvent{}).
+		Where("consumer = ? AND event_id = ?", consumer, eventID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, nil
+	}
+
+	r.data.rdb.Set(ctx, key, 1, cacheTTL)
+	return true, nil
+}
+
+func (r *processedEventRepo) TryLock(ctx context.Context, consumer, eventID string, ttl time.Duration) (bool, error) {
+	return r.data.rdb.SetNX(ctx, eventLockKey(consumer, eventID), 1, ttl).Result()
+}
+
+func (r *processedEventRepo) Unlock(ctx context.Context, consumer, eventID string) error {
+	return r.data.rdb.Del(ctx, eventLockKey(consumer, eventID)).Err()
+}
+
+// MarkProcessed 先写数据库再写Redis标记，标记写入失败只影响下次检查走数据库
+func (r *processedEventRepo) MarkProcessed(ctx context.Context, consumer, eventID string, cacheTTL time.Duration) error {
+	err := r.data.db.WithContext(ctx).Create(&ProcessedEvent{
+		Consumer: consumer,
+		EventID:  eventID,
+	}).Error
+	if err != nil && !isDuplicateKeyError(err) {
+		return err
+	}
+
+	if err := r.data.rdb.Set(ctx, processedEventKey(consumer, eventID), 1, cacheTTL).Err(); err != nil {
+		r.log.WithContext(ctx).Warnf("set processed event marker failed: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessedEventRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := &processedEventRepo{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	t.Run("Lock", func(t *testing.T) {
+		locked, err := repo.TryLock(ctx, "video_upload", "evt-lock", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, locked)
+
+		locked, err = repo.TryLock(ctx, "video_upload", "evt-lock", time.Minute)
+		require.NoError(t, err)
+		assert.False(t, locked)
+
+		// 不同处理器独立占用
+		locked, err = repo.TryLock(ctx, "video_stats", "evt-lock", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, locked)
+
+		require.NoError(t, repo.Unlock(ctx, "video_upload", "evt-lock"))
+		locked, err = repo.TryLock(ctx, "video_upload", "evt-lock", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, locked)
+	})
+
+	t.Run("MarkProcessed", func(t *testing.T) {
+		processed, err := repo.IsProcessed(ctx, "video_upload", "evt-1", time.Hour)
+		require.NoError(t, err)
+		assert.False(t, processed)
+
+		require.NoError(t, repo.MarkProcessed(ctx, "video_upload", "evt-1", time.Hour))
+		// 重复记录不报错
+		require.NoError(t, repo.MarkProcessed(ctx, "video_upload", "evt-1", time.Hour))
+
+		processed, err = repo.IsProcessed(ctx, "video_upload", "evt-1", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		processed, err = repo.IsProcessed(ctx, "video_stats", "evt-1", time.Hour)
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("MarkerExpired", func(t *testing.T) {
+		// Redis标记过期后以数据库记录为准，并回填标记
+		require.NoError(t, repo.MarkProcessed(ctx, "user_action", "evt-2", time.Hour))
+		require.NoError(t, env.Redis.Client.Del(ctx, processedEventKey("user_action", "evt-2")).Err())
+
+		processed, err := repo.IsProcessed(ctx, "user_action", "evt-2", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		exists, err := env.Redis.Client.Exists(ctx, processedEventKey("user_action", "evt-2")).Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), exists)
+	})
+}
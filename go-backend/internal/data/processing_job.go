@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ProcessingJobModel 视频处理任务记录模型
+type ProcessingJobModel struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	VideoID      int64     `gorm:"not null;index:idx_video_id" json:"video_id"`
+	AuthorID     int64     `gorm:"not null;index:idx_author_created,priority:1" json:"author_id"`
+	JobType      string    `gorm:"size:20;not null" json:"job_type"`
+	Status       string    `gorm:"size:20;not null" json:"status"`
+	DurationMs   int64     `gorm:"not null;default:0" json:"duration_ms"`
+	CPUSeconds   float64   `gorm:"column:cpu_seconds;not null;default:0" json:"cpu_seconds"`
+	InputBytes   int64     `gorm:"not null;default:0" json:"input_bytes"`
+	OutputBytes  int64     `gorm:"not null;default:0" json:"output_bytes"`
+	ErrorMessage string    `gorm:"size:500;not null;default:''" json:"error_message"`
+	CreatedAt    time.Time `gorm:"autoCreateTime;index:idx_author_created,priority:2;index:idx_created_at" json:"created_at"`
+}
+
+func (ProcessingJobModel) TableName() string {
+	return "processing_jobs"
+}
+
+type processingJobRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewProcessingJobRepo .
+func NewProcessingJobRepo(data *Data, logger log.Logger) biz.ProcessingJobRepo {
+	return &processingJobRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *processingJobRepo) CreateJob(ctx context.Context, job *domain.ProcessingJob) error {
+	errorMessage := job.ErrorMessage
+	if len(errorMessage) > 500 {
+		errorMessage = errorMessage[:500]
+	}
+
+	model := &ProcessingJobModel{
+		VideoID:      job.VideoID,
+		AuthorID:     job.AuthorID,
+		JobType:      job.JobType,
+		Status:       job.Status,
+		DurationMs:   job.DurationMs,
+		CPUSeconds:   job.CPUSeconds,
+		InputBytes:   job.InputBytes,
+		OutputBytes:  job.OutputBytes,
+		ErrorMessage: errorMessage,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	job.ID = model.ID
+	job.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *processingJobRepo) AggregateJobs(ctx context.Context, filter *biz.ProcessingReportFilter) ([]*domain.ProcessingStat, error) {
+	query := r.data.db.WithContext(ctx).Model(&ProcessingJobModel{}).
+		Select(`DATE_FORMAT(created_at, '%Y-%m-%d') AS day, author_id,
+			COUNT(*) AS jobs,
+			SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS failed_jobs,
+			SUM(duration_ms) AS duration_ms,
+			SUM(cpu_seconds) AS cpu_seconds,
+			SUM(input_bytes) AS input_bytes,
+			SUM(output_bytes) AS output_bytes`, domain.ProcessStatusFailed)
+	if filter.AuthorID > 0 {
+		query = query.Where("author_id = ?", filter.AuthorID)
+	}
+	if filter.JobType != "" {
+		query = query.Where("job_type = ?", filter.JobType)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at < ?", filter.EndTime)
+	}
+
+	var stats []*domain.ProcessingStat
+	if err := query.Group("day, author_id").
+		Order("day DESC, cpu_seconds DESC").
+		Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
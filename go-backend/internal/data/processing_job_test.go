@@ -0,0 +1,51 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessingJobRepo_AggregateJobs(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewProcessingJobRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+
+	jobs := []*domain.ProcessingJob{
+		{VideoID: 1, AuthorID: 7, JobType: domain.ProcessTypeThumbnail, Status: domain.ProcessStatusSuccess, DurationMs: 200, CPUSeconds: 0.5, InputBytes: 1000, OutputBytes: 20},
+		{VideoID: 1, AuthorID: 7, JobType: domain.ProcessTypeTranscode, Status: domain.ProcessStatusSuccess, DurationMs: 3000, CPUSeconds: 6, InputBytes: 1000, OutputBytes: 800},
+		{VideoID: 2, AuthorID: 7, JobType: domain.ProcessTypeTranscode, Status: domain.ProcessStatusFailed, DurationMs: 100, InputBytes: 500, ErrorMessage: "ffmpeg transcode failed"},
+		{VideoID: 3, AuthorID: 8, JobType: domain.ProcessTypeTranscode, Status: domain.ProcessStatusSuccess, DurationMs: 1000, CPUSeconds: 2, InputBytes: 400, OutputBytes: 300},
+	}
+	for _, job := range jobs {
+		require.NoError(t, repo.CreateJob(ctx, job))
+		assert.NotZero(t, job.ID)
+	}
+
+	stats, err := repo.AggregateJobs(ctx, &biz.ProcessingReportFilter{})
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	// 同一天内按CPU时间倒序
+	assert.Equal(t, int64(7), stats[0].AuthorID)
+	assert.Equal(t, int64(3), stats[0].Jobs)
+	assert.Equal(t, int64(1), stats[0].FailedJobs)
+	assert.Equal(t, int64(3300), stats[0].DurationMs)
+	assert.InDelta(t, 6.5, stats[0].CPUSeconds, 1e-9)
+	assert.Equal(t, int64(820), stats[0].OutputBytes)
+	assert.NotEmpty(t, stats[0].Day)
+
+	stats, err = repo.AggregateJobs(ctx, &biz.ProcessingReportFilter{AuthorID: 7, JobType: domain.ProcessTypeTranscode})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, int64(2), stats[0].Jobs)
+}
@@ -28,3 +28,41 @@ func (p *NoOpVideoEventProducer) PublishVideoStatsUpdatedEvent(ctx context.Conte
 func (p *NoOpVideoEventProducer) PublishVideoDeletedEvent(ctx context.Context, event *domain.VideoDeletedEvent) error {
 	return nil
 }
+
+// PublishVideoAuditedEvent 发布视频审核事件（空实现）
+func (p *NoOpVideoEventProducer) PublishVideoAuditedEvent(ctx context.Context, event *domain.VideoAuditEvent) error {
+	return nil
+}
+
+// NoOpInteractionEventProducer 空实现的互动事件生产者
+type NoOpInteractionEventProducer struct{}
+
+// PublishVideoLikedEvent 发布视频点赞事件（空实现）
+func (p *NoOpInteractionEventProducer) PublishVideoLikedEvent(ctx context.Context, event *domain.VideoLikedEvent) error {
+	return nil
+}
+
+// PublishVideoUnlikedEvent 发布视频取消点赞事件（空实现）
+func (p *NoOpInteractionEventProducer) PublishVideoUnlikedEvent(ctx context.Context, event *domain.VideoUnlikedEvent) error {
+	return nil
+}
+
+// PublishCommentCreatedEvent 发布评论创建事件（空实现）
+func (p *NoOpInteractionEventProducer) PublishCommentCreatedEvent(ctx context.Context, event *domain.CommentCreatedEvent) error {
+	return nil
+}
+
+// PublishCommentDeletedEvent 发布评论删除事件（空实现）
+func (p *NoOpInteractionEventProducer) PublishCommentDeletedEvent(ctx context.Context, event *domain.CommentDeletedEvent) error {
+	return nil
+}
+
+// PublishUserFollowedEvent 发布用户关注事件（空实现）
+func (p *NoOpInteractionEventProducer) PublishUserFollowedEvent(ctx context.Context, event *domain.UserFollowedEvent) error {
+	return nil
+}
+
+// PublishUserUnfollowedEvent 发布用户取消关注事件（空实现）
+func (p *NoOpInteractionEventProducer) PublishUserUnfollowedEvent(ctx context.Context, event *domain.UserUnfollowedEvent) error {
+	return nil
+}
@@ -0,0 +1,150 @@
+package producer
+
+import (
+	"context"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/domain"
+	"go-backend/pkg/messaging"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// InteractionEventProducer 互动事件生产者
+type InteractionEventProducer struct {
+	kafkaManager *messaging.KafkaManager
+	config       *conf.Business_KafkaTopics
+	log          *log.Helper
+}
+
+// NewInteractionEventProducer 创建互动事件生产者
+func NewInteractionEventProducer(
+	kafkaManager *messaging.KafkaManager,
+	businessConfig *conf.Business,
+	logger log.Logger,
+) domain.InteractionEventPublisher {
+	// Kafka不可用时降级为空实现，避免互动操作因消息队列故障失败
+	if kafkaManager == nil {
+		return &NoOpInteractionEventProducer{}
+	}
+
+	return &InteractionEventProducer{
+		kafkaManager: kafkaManager,
+		config:       businessConfig.KafkaTopics,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// PublishVideoLikedEvent 发布视频点赞事件
+func (p *InteractionEventProducer) PublishVideoLikedEvent(ctx context.Context, event *domain.VideoLikedEvent) error {
+	kafkaEvent := &messaging.UserActionEvent{
+		UserID:     event.UserID,
+		ActionType: "like",
+		TargetID:   event.VideoID,
+		TargetType: "video",
+		Timestamp:  event.LikedAt.Unix(),
+	}
+
+	if err := p.kafkaManager.SendUserActionEvent(ctx, p.config.UserAction, kafkaEvent); err != nil {
+		p.log.WithContext(ctx).Errorf("send video liked event failed: %v", err)
+		return err
+	}
+
+	p.log.WithContext(ctx).Infof("published video liked event: video_id=%d, user_id=%d", event.VideoID, event.UserID)
+	return nil
+}
+
+// PublishVideoUnlikedEvent 发布视频取消点赞事件
+func (p *InteractionEventProducer) PublishVideoUnlikedEvent(ctx context.Context, event *domain.VideoUnlikedEvent) error {
+	kafkaEvent := &messaging.UserActionEvent{
+		UserID:     event.UserID,
+		ActionType: "unlike",
+		TargetID:   event.VideoID,
+		TargetType: "video",
+		Timestamp:  event.UnlikedAt.Unix(),
+	}
+
+	if err := p.kafkaManager.SendUserActionEvent(ctx, p.config.UserAction, kafkaEvent); err != nil {
+		p.log.WithContext(ctx).Errorf("send video unliked event failed: %v", err)
+		return err
+	}
+
+	p.log.WithContext(ctx).Infof("published video unliked event: video_id=%d, user_id=%d", event.VideoID, event.UserID)
+	return nil
+}
+
+// PublishCommentCreatedEvent 发布评论创建事件
+func (p *InteractionEventProducer) PublishCommentCreatedEvent(ctx context.Context, event *domain.CommentCreatedEvent) error {
+	kafkaEvent := &messaging.UserActionEvent{
+		UserID:     event.UserID,
+		ActionType: "comment",
+		TargetID:   event.VideoID,
+		TargetType: "video",
+		Timestamp:  event.CreatedAt.Unix(),
+	}
+
+	if err := p.kafkaManager.SendUserActionEvent(ctx, p.config.UserAction, kafkaEvent); err != nil {
+		p.log.WithContext(ctx).Errorf("send comment created event failed: %v", err)
+		return err
+	}
+
+	p.log.WithContext(ctx).Infof("published comment created event: comment_id=%d, video_id=%d", event.CommentID, event.VideoID)
+	return nil
+}
+
+// PublishCommentDeletedEvent 发布评论删除事件
+func (p *InteractionEventProducer) PublishCommentDeletedEvent(ctx context.Context, event *domain.CommentDeletedEvent) error {
+	kafkaEvent := &messaging.UserActionEvent{
+		UserID:     event.UserID,
+		ActionType: "uncomment",
+		TargetID:   event.VideoID,
+		TargetType: "video",
+		Timestamp:  event.DeletedAt.Unix(),
+	}
+
+	if err := p.kafkaManager.SendUserActionEvent(ctx, p.config.UserAction, kafkaEvent); err != nil {
+		p.log.WithContext(ctx).Errorf("send comment deleted event failed: %v", err)
+		return err
+	}
+
+	p.log.WithContext(ctx).Infof("published comment deleted event: comment_id=%d, video_id=%d", event.CommentID, event.VideoID)
+	return nil
+}
+
+// PublishUserFollowedEvent 发布用户关注事件
+func (p *InteractionEventProducer) PublishUserFollowedEvent(ctx context.Context, event *domain.UserFollowedEvent) error {
+	kafkaEvent := &messaging.UserActionEvent{
+		UserID:     event.UserID,
+		ActionType: "follow",
+		TargetID:   event.FollowUserID,
+		TargetType: "user",
+		Timestamp:  event.FollowedAt.Unix(),
+	}
+
+	if err := p.kafkaManager.SendUserActionEvent(ctx, p.config.UserAction, kafkaEvent); err != nil {
+		p.log.WithContext(ctx).Errorf("send user followed event failed: %v", err)
+		return err
+	}
+
+	p.log.WithContext(ctx).Infof("published user followed event: user_id=%d, follow_user_id=%d", event.UserID, event.FollowUserID)
+	return nil
+}
+
+// PublishUserUnfollowedEvent 发布用户取消关注事件
+func (p *InteractionEventProducer) PublishUserUnfollowedEvent(ctx context.Context, event *domain.UserUnfollowedEvent) error {
+	kafkaEvent := &messaging.UserActionEvent{
+		UserID:     event.UserID,
+		ActionType: "unfollow",
+		TargetID:   event.UnfollowUserID,
+		TargetType: "user",
+		Timestamp:  event.UnfollowedAt.Unix(),
+	}
+
+	if err := p.kafkaManager.SendUserActionEvent(ctx, p.config.UserAction, kafkaEvent); err != nil {
+		p.log.WithContext(ctx).Errorf("send user unfollowed event failed: %v", err)
+		return err
+	}
+
+	p.log.WithContext(ctx).Infof("published user unfollowed event: user_id=%d, unfollow_user_id=%d", event.UserID, event.UnfollowUserID)
+	return nil
+}
@@ -7,4 +7,5 @@ import (
 // ProviderSet is data providers.
 var ProviderSet = wire.NewSet(
 	NewVideoEventProducer,
+	NewInteractionEventProducer,
 )
@@ -24,6 +24,11 @@ func NewVideoEventProducer(
 	businessConfig *conf.Business,
 	logger log.Logger,
 ) domain.VideoEventPublisher {
+	// Kafka不可用时降级为空实现
+	if kafkaManager == nil {
+		return &NoOpVideoEventProducer{}
+	}
+
 	return &VideoEventProducer{
 		kafkaManager: kafkaManager,
 		config:       businessConfig.KafkaTopics,
@@ -41,6 +46,8 @@ func (p *VideoEventProducer) PublishVideoUploadedEvent(ctx context.Context, even
 		UploadTime: event.UploadedAt.Unix(),
 	}
 
+	// 事件ID作为消息ID，发件箱重复投递时消费者可据此去重
+	ctx = messaging.WithIdempotencyKey(ctx, event.EventID)
 	if err := p.kafkaManager.SendVideoUploadEvent(ctx, p.config.VideoUpload, kafkaEvent); err != nil {
 		p.log.WithContext(ctx).Errorf("send video upload event failed: %v", err)
 		return err
@@ -79,6 +86,8 @@ func (p *VideoEventProducer) PublishVideoStatsUpdatedEvent(ctx context.Context,
 		UserID:    event.UserID,
 	}
 
+	// 事件ID作为消息ID，发件箱重复投递时消费者可据此去重
+	ctx = messaging.WithIdempotencyKey(ctx, event.EventID)
 	if err := p.kafkaManager.SendVideoStatsEvent(ctx, p.config.VideoStats, kafkaEvent); err != nil {
 		p.log.WithContext(ctx).Errorf("send video stats event failed: %v", err)
 		return err
@@ -110,6 +119,27 @@ func (p *VideoEventProducer) PublishVideoDeletedEvent(ctx context.Context, event
 	return nil
 }
 
+// PublishVideoAuditedEvent 发布视频审核事件，审核属于视频处理流程，复用视频处理主题
+func (p *VideoEventProducer) PublishVideoAuditedEvent(ctx context.Context, event *domain.VideoAuditEvent) error {
+	kafkaEvent := &messaging.VideoAuditEvent{
+		VideoID:   event.VideoID,
+		AuthorID:  event.AuthorID,
+		AuditorID: event.AuditorID,
+		Status:    event.AuditStatus,
+		Reason:    event.AuditReason,
+		AuditTime: event.AuditedAt.Unix(),
+	}
+
+	if err := p.kafkaManager.SendVideoAuditEvent(ctx, p.config.VideoProcess, kafkaEvent); err != nil {
+		p.log.WithContext(ctx).Errorf("send video audit event failed: %v", err)
+		return err
+	}
+
+	p.log.WithContext(ctx).Infof("published video audited event: video_id=%d, status=%s, auditor_id=%d",
+		event.VideoID, event.AuditStatus, event.AuditorID)
+	return nil
+}
+
 // PublishUserActionEvent 发布用户行为事件
 func (p *VideoEventProducer) PublishUserActionEvent(ctx context.Context, userID int64, actionType string, targetID int64, targetType string) error {
 	kafkaEvent := &messaging.UserActionEvent{
@@ -0,0 +1,185 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+const (
+	// profilePageTTL 读模型过期时间，长期无人访问的主页不再占用内存
+	profilePageTTL = 24 * time.Hour
+	// profilePinnedLimit 置顶位展示的作品数
+	profilePinnedLimit = 3
+	// profileRecentLimit 最近作品展示数
+	profileRecentLimit = 12
+)
+
+// ProfileProjection 个人主页读模型投影。主页首次访问时从数据库物化到 Redis，
+// 此后订阅用户、视频和关注关系的变更事件重建，读请求不再访问数据库
+type ProfileProjection struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewProfileProjection 创建个人主页读模型投影
+func NewProfileProjection(data *Data, logger log.Logger) *ProfileProjection {
+	return &ProfileProjection{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// NewProfileReadModelRepo 创建个人主页读模型仓储
+func NewProfileReadModelRepo(projection *ProfileProjection) biz.ProfileReadModelRepo {
+	return projection
+}
+
+// GetEventType 获取处理的事件类型
+func (p *ProfileProjection) GetEventType() string {
+	return domain.EventTypeCacheInvalidation
+}
+
+// Handle 处理变更事件，只重建已物化的主页
+func (p *ProfileProjection) Handle(ctx context.Context, event domain.DomainEvent) error {
+	change, ok := event.(*domain.CacheInvalidationEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type: %s", event.GetEventType())
+	}
+
+	var userIDs []int64
+	switch change.CacheType {
+	case domain.CacheTypeUser, domain.CacheTypeUserVideos, domain.CacheTypeRelation:
+		userIDs = change.EntityIDs
+	case domain.CacheTypeVideo:
+		// 视频计数、封面或状态变化影响作者主页上的作品
+		if len(change.EntityIDs) == 0 {
+			return nil
+		}
+		if err := p.data.db.WithContext(ctx).Model(&VideoModel{}).
+			Where("id IN ?", change.EntityIDs).
+			Distinct().Pluck("author_id", &userIDs).Error; err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	var errs []error
+	for _, userID := range userIDs {
+		if err := p.refresh(ctx, userID); err != nil {
+			errs = append(errs, fmt.Errorf("project profile %d: %w", userID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetProfilePage 读取主页读模型，未物化时从数据库重建
+func (p *ProfileProjection) GetProfilePage(ctx context.Context, userID int64) (*biz.ProfilePage, error) {
+	data, err := p.data.rdb.Get(ctx, profilePageKey(userID)).Bytes()
+	if err == nil {
+		var page biz.ProfilePage
+		if err := json.Unmarshal(data, &page); err == nil {
+			return &page, nil
+		}
+		p.log.WithContext(ctx).Warnf("decode profile page failed, rebuilding: user=%d", userID)
+	} else if err != redis.Nil {
+		p.log.WithContext(ctx).Warnf("get profile page failed: user=%d err=%v", userID, err)
+	}
+
+	return p.rebuild(ctx, userID)
+}
+
+// refresh 已物化的主页重新投影，未物化的跳过，等首次访问时再构建
+func (p *ProfileProjection) refresh(ctx context.Context, userID int64) error {
+	exists, err := p.data.rdb.Exists(ctx, profilePageKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+	if exists == 0 {
+		return nil
+	}
+
+	_, err = p.rebuild(ctx, userID)
+	if errors.Is(err, biz.ErrUserNotFound) {
+		return nil
+	}
+	return err
+}
+
+// rebuild 从数据库构建主页并写入读模型，用户不存在或已停用时删除读模型
+func (p *ProfileProjection) rebuild(ctx context.Context, userID int64) (*biz.ProfilePage, error) {
+	key := profilePageKey(userID)
+
+	var u User
+	if err := p.data.db.WithContext(ctx).Where("id = ? AND status = 1", userID).First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			p.data.rdb.Del(ctx, key)
+			return nil, biz.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	pinned, err := p.queryVideos(ctx, userID, "favorite_count DESC, created_at DESC", profilePinnedLimit)
+	if err != nil {
+		return nil, err
+	}
+	recent, err := p.queryVideos(ctx, userID, "created_at DESC", profileRecentLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 读模型只保存可公开展示的字段
+	user := userModelToBiz(&u)
+	user.PasswordHash = ""
+	user.Salt = ""
+	user.Email = ""
+	user.LastLoginAt = nil
+
+	page := &biz.ProfilePage{
+		User:         user,
+		PinnedVideos: pinned,
+		RecentVideos: recent,
+		ProjectedAt:  time.Now(),
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.data.rdb.Set(ctx, key, data, profilePageTTL).Err(); err != nil {
+		p.log.WithContext(ctx).Warnf("save profile page failed: user=%d err=%v", userID, err)
+	}
+
+	return page, nil
+}
+
+func (p *ProfileProjection) queryVideos(ctx context.Context, authorID int64, order string, limit int) ([]*domain.Video, error) {
+	var models []VideoModel
+	if err := p.data.db.WithContext(ctx).
+		Where("author_id = ? AND status = ? AND visibility = ?", authorID, domain.VideoStatusPublished, domain.VideoVisibilityPublic).
+		Order(order).
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	videos := make([]*domain.Video, len(models))
+	for i := range models {
+		videos[i] = videoModelToDomain(&models[i])
+	}
+	return videos, nil
+}
+
+func profilePageKey(userID int64) string {
+	return fmt.Sprintf("profile:page:%d", userID)
+}
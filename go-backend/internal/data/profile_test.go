@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileProjection(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	data := &Data{db: env.DB.DB, rdb: env.Redis.Client}
+	projection := NewProfileProjection(data, log.DefaultLogger)
+	ctx := context.Background()
+
+	fixture, err := env.DataManager.CreateUser(
+		testutils.WithVideos(2, domain.VideoStatusPublished),
+		testutils.WithVideos(1, domain.VideoStatusPending),
+	)
+	require.NoError(t, err)
+	author := fixture.User
+	other, err := env.DataManager.CreateUser()
+	require.NoError(t, err)
+
+	popular, latest := fixture.Videos[0], fixture.Videos[1]
+	require.NoError(t, data.db.Model(&VideoModel{}).Where("id = ?", popular.ID).Update("favorite_count", 10).Error)
+	require.NoError(t, data.db.Model(&VideoModel{}).Where("id = ?", latest.ID).Update("favorite_count", 0).Error)
+
+	t.Run("MaterializeOnRead", func(t *testing.T) {
+		page, err := projection.GetProfilePage(ctx, author.ID)
+		require.NoError(t, err)
+		assert.Equal(t, author.ID, page.User.ID)
+		assert.Empty(t, page.User.PasswordHash)
+		require.Len(t, page.PinnedVideos, 2)
+		assert.Equal(t, popular.ID, page.PinnedVideos[0].ID)
+		require.Len(t, page.RecentVideos, 2)
+
+		exists, err := env.Redis.Client.Exists(ctx, profilePageKey(author.ID)).Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), exists)
+	})
+
+	t.Run("ProjectChanges", func(t *testing.T) {
+		require.NoError(t, data.db.Model(&User{}).Where("id = ?", author.ID).Update("nickname", "renamed").Error)
+		require.NoError(t, projection.Handle(ctx, cacheInvalidation(domain.CacheTypeUser, author.ID)))
+
+		page, err := projection.GetProfilePage(ctx, author.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "renamed", page.User.Nickname)
+
+		// 视频计数变化重建作者主页，置顶位随获赞数调整
+		require.NoError(t, data.db.Model(&VideoModel{}).Where("id = ?", latest.ID).Update("favorite_count", 20).Error)
+		require.NoError(t, projection.Handle(ctx, cacheInvalidation(domain.CacheTypeVideo, latest.ID)))
+
+		page, err = projection.GetProfilePage(ctx, author.ID)
+		require.NoError(t, err)
+		assert.Equal(t, latest.ID, page.PinnedVideos[0].ID)
+	})
+
+	t.Run("SkipUnmaterialized", func(t *testing.T) {
+		require.NoError(t, projection.Handle(ctx, cacheInvalidation(domain.CacheTypeRelation, other.User.ID, author.ID)))
+
+		exists, err := env.Redis.Client.Exists(ctx, profilePageKey(other.User.ID)).Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		_, err := projection.GetProfilePage(ctx, 999999)
+		assert.ErrorIs(t, err, biz.ErrUserNotFound)
+	})
+}
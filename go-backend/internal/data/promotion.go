@@ -0,0 +1,256 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// incrPromotionFrequencyScript 累加曝光次数，只在计数新建时设置过期时间，频控窗口从首次曝光开始
+var incrPromotionFrequencyScript = redis.NewScript(`
+if redis.call('INCR', KEYS[1]) == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// PromotionCampaignModel 推广计划数据模型
+type PromotionCampaignModel struct {
+	ID                 int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name               string    `gorm:"size:100;not null" json:"name"`
+	VideoID            int64     `gorm:"not null" json:"video_id"`
+	CategoryIDs        []int64   `gorm:"column:category_ids;serializer:json;type:json" json:"category_ids"`
+	Audience           string    `gorm:"size:16;not null;default:'all'" json:"audience"`
+	Priority           int32     `gorm:"not null;default:0" json:"priority"`
+	FrequencyCap       int32     `gorm:"not null;default:0" json:"frequency_cap"`
+	FrequencyWindowSec int64     `gorm:"not null;default:0" json:"frequency_window_sec"`
+	StartAt            time.Time `gorm:"not null" json:"start_at"`
+	EndAt              time.Time `gorm:"not null;index:idx_status_end,priority:2" json:"end_at"`
+	Status             int32     `gorm:"not null;default:1;index:idx_status_end,priority:1" json:"status"`
+	CreatedBy          int64     `gorm:"not null" json:"created_by"`
+	CreatedAt          time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (PromotionCampaignModel) TableName() string {
+	return "promotion_campaigns"
+}
+
+// PromotionEventModel 推广曝光点击记录数据模型
+type PromotionEventModel struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	CampaignID int64     `gorm:"not null;index:idx_campaign_type_created,priority:1" json:"campaign_id"`
+	VideoID    int64     `gorm:"not null" json:"video_id"`
+	UserID     int64     `gorm:"not null;default:0" json:"user_id"`
+	EventType  int32     `gorm:"not null;index:idx_campaign_type_created,priority:2" json:"event_type"`
+	CreatedAt  time.Time `gorm:"index:idx_campaign_type_created,priority:3" json:"created_at"`
+}
+
+func (PromotionEventModel) TableName() string {
+	return "promotion_events"
+}
+
+type promotionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPromotionRepo .
+func NewPromotionRepo(data *Data, logger log.Logger) biz.PromotionRepo {
+	return &promotionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *promotionRepo) ListCampaigns(ctx context.Context) ([]*biz.PromotionCampaign, error) {
+	var models []PromotionCampaignModel
+	if err := r.data.db.WithContext(ctx).Order("id DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return r.toBizList(models), nil
+}
+
+func (r *promotionRepo) ListRunningCampaigns(ctx context.Context, now time.Time) ([]*biz.PromotionCampaign, error) {
+	var models []PromotionCampaignModel
+	if err := r.data.db.WithContext(ctx).
+		Where("status = ? AND start_at <= ? AND end_at > ?", biz.PromotionStatusActive, now, now).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return r.toBizList(models), nil
+}
+
+func (r *promotionRepo) GetCampaign(ctx context.Context, id int64) (*biz.PromotionCampaign, error) {
+	var model PromotionCampaignModel
+	if err := r.data.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrPromotionNotFound
+		}
+		return nil, err
+	}
+	return r.toBiz(&model), nil
+}
+
+func (r *promotionRepo) CreateCampaign(ctx context.Context, campaign *biz.PromotionCampaign) error {
+	model := r.toModel(campaign)
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	*campaign = *r.toBiz(model)
+	return nil
+}
+
+func (r *promotionRepo) UpdateCampaign(ctx context.Context, campaign *biz.PromotionCampaign) error {
+	model := r.toModel(campaign)
+	result := r.data.db.WithContext(ctx).Model(&PromotionCampaignModel{ID: campaign.ID}).
+		Select("name", "category_ids", "audience", "priority", "frequency_cap", "frequency_window_sec", "start_at", "end_at", "status").
+		Updates(model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 字段未变化时 RowsAffected 也为0，需区分记录是否存在
+		if _, err := r.GetCampaign(ctx, campaign.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *promotionRepo) GetFrequencies(ctx context.Context, userID int64, campaignIDs []int64) (map[int64]int64, error) {
+	keys := make([]string, len(campaignIDs))
+	for i, id := range campaignIDs {
+		keys[i] = promotionFrequencyKey(id, userID)
+	}
+
+	values, err := r.data.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	frequencies := make(map[int64]int64, len(campaignIDs))
+	for i, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		count, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			continue
+		}
+		frequencies[campaignIDs[i]] = count
+	}
+	return frequencies, nil
+}
+
+func (r *promotionRepo) IncrFrequencies(ctx context.Context, userID int64, campaigns []*biz.PromotionCampaign) error {
+	_, err := r.data.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, campaign := range campaigns {
+			incrPromotionFrequencyScript.Run(ctx, pipe, []string{promotionFrequencyKey(campaign.ID, userID)},
+				campaign.FrequencyWindow.Milliseconds())
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *promotionRepo) CreateEvents(ctx context.Context, events []*biz.PromotionEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	models := make([]*PromotionEventModel, len(events))
+	for i, event := range events {
+		models[i] = &PromotionEventModel{
+			CampaignID: event.CampaignID,
+			VideoID:    event.VideoID,
+			UserID:     event.UserID,
+			EventType:  event.Type,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+	return r.data.db.WithContext(ctx).Create(&models).Error
+}
+
+func (r *promotionRepo) GetReport(ctx context.Context, campaignID int64, since, until time.Time) (*biz.PromotionReport, error) {
+	var row struct {
+		Impressions int64
+		Clicks      int64
+		UniqueUsers int64
+	}
+	err := r.data.db.WithContext(ctx).Model(&PromotionEventModel{}).
+		Select(`SUM(event_type = ?) AS impressions, SUM(event_type = ?) AS clicks,
+			COUNT(DISTINCT CASE WHEN event_type = ? AND user_id <> 0 THEN user_id END) AS unique_users`,
+			biz.PromotionEventImpression, biz.PromotionEventClick, biz.PromotionEventImpression).
+		Where("campaign_id = ? AND created_at >= ? AND created_at < ?", campaignID, since, until).
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+	return &biz.PromotionReport{
+		Impressions: row.Impressions,
+		Clicks:      row.Clicks,
+		UniqueUsers: row.UniqueUsers,
+	}, nil
+}
+
+func (r *promotionRepo) toModel(campaign *biz.PromotionCampaign) *PromotionCampaignModel {
+	categoryIDs := campaign.CategoryIDs
+	if categoryIDs == nil {
+		categoryIDs = []int64{}
+	}
+	return &PromotionCampaignModel{
+		ID:                 campaign.ID,
+		Name:               campaign.Name,
+		VideoID:            campaign.VideoID,
+		CategoryIDs:        categoryIDs,
+		Audience:           campaign.Audience,
+		Priority:           campaign.Priority,
+		FrequencyCap:       campaign.FrequencyCap,
+		FrequencyWindowSec: int64(campaign.FrequencyWindow / time.Second),
+		StartAt:            campaign.StartAt,
+		EndAt:              campaign.EndAt,
+		Status:             campaign.Status,
+		CreatedBy:          campaign.CreatedBy,
+	}
+}
+
+func (r *promotionRepo) toBiz(model *PromotionCampaignModel) *biz.PromotionCampaign {
+	return &biz.PromotionCampaign{
+		ID:              model.ID,
+		Name:            model.Name,
+		VideoID:         model.VideoID,
+		CategoryIDs:     model.CategoryIDs,
+		Audience:        model.Audience,
+		Priority:        model.Priority,
+		FrequencyCap:    model.FrequencyCap,
+		FrequencyWindow: time.Duration(model.FrequencyWindowSec) * time.Second,
+		StartAt:         model.StartAt,
+		EndAt:           model.EndAt,
+		Status:          model.Status,
+		CreatedBy:       model.CreatedBy,
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+}
+
+func (r *promotionRepo) toBizList(models []PromotionCampaignModel) []*biz.PromotionCampaign {
+	campaigns := make([]*biz.PromotionCampaign, len(models))
+	for i := range models {
+		campaigns[i] = r.toBiz(&models[i])
+	}
+	return campaigns
+}
+
+// promotionFrequencyKey 登录用户在推广当前频控窗口内的曝光次数
+func promotionFrequencyKey(campaignID, userID int64) string {
+	return fmt.Sprintf("promotion:freq:%d:%d", campaignID, userID)
+}
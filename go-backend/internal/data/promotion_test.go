@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotionRepo(t *testing.T) {
+	favorite, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := &promotionRepo{
+		data: favorite.data,
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	admin, viewer := users[0].ID, users[1].ID
+	video := createFavoriteTestVideo(t, repo.data, admin)
+	now := time.Now().Truncate(time.Second)
+
+	running := &biz.PromotionCampaign{
+		Name: "running", VideoID: video.ID, CategoryIDs: []int64{3}, Audience: biz.PromotionAudienceAll,
+		FrequencyCap: 2, FrequencyWindow: time.Hour,
+		StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour), Status: biz.PromotionStatusActive, CreatedBy: admin,
+	}
+	require.NoError(t, repo.CreateCampaign(ctx, running))
+	require.NotZero(t, running.ID)
+	ended := &biz.PromotionCampaign{
+		Name: "ended", VideoID: video.ID, Audience: biz.PromotionAudienceAll,
+		StartAt: now.Add(-2 * time.Hour), EndAt: now.Add(-time.Hour), Status: biz.PromotionStatusActive, CreatedBy: admin,
+	}
+	require.NoError(t, repo.CreateCampaign(ctx, ended))
+
+	t.Run("ListRunning", func(t *testing.T) {
+		campaigns, err := repo.ListRunningCampaigns(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, campaigns, 1)
+		assert.Equal(t, running.ID, campaigns[0].ID)
+		assert.Equal(t, []int64{3}, campaigns[0].CategoryIDs)
+		assert.Equal(t, time.Hour, campaigns[0].FrequencyWindow)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		updated := *running
+		updated.Status = biz.PromotionStatusPaused
+		require.NoError(t, repo.UpdateCampaign(ctx, &updated))
+
+		campaigns, err := repo.ListRunningCampaigns(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, campaigns)
+
+		missing := updated
+		missing.ID = running.ID + 100
+		assert.ErrorIs(t, repo.UpdateCampaign(ctx, &missing), biz.ErrPromotionNotFound)
+	})
+
+	t.Run("Frequencies", func(t *testing.T) {
+		defer repo.data.rdb.Del(ctx, promotionFrequencyKey(running.ID, viewer))
+
+		require.NoError(t, repo.IncrFrequencies(ctx, viewer, []*biz.PromotionCampaign{running}))
+		require.NoError(t, repo.IncrFrequencies(ctx, viewer, []*biz.PromotionCampaign{running}))
+
+		frequencies, err := repo.GetFrequencies(ctx, viewer, []int64{running.ID, ended.ID})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int64{running.ID: 2}, frequencies)
+
+		ttl, err := repo.data.rdb.TTL(ctx, promotionFrequencyKey(running.ID, viewer)).Result()
+		require.NoError(t, err)
+		assert.True(t, ttl > 0 && ttl <= time.Hour)
+	})
+
+	t.Run("Report", func(t *testing.T) {
+		require.NoError(t, repo.CreateEvents(ctx, []*biz.PromotionEvent{
+			{CampaignID: running.ID, VideoID: video.ID, UserID: viewer, Type: biz.PromotionEventImpression, CreatedAt: now},
+			{CampaignID: running.ID, VideoID: video.ID, UserID: viewer, Type: biz.PromotionEventImpression, CreatedAt: now},
+			{CampaignID: running.ID, VideoID: video.ID, UserID: 0, Type: biz.PromotionEventImpression, CreatedAt: now},
+			{CampaignID: running.ID, VideoID: video.ID, UserID: viewer, Type: biz.PromotionEventClick, CreatedAt: now},
+			{CampaignID: running.ID, VideoID: video.ID, UserID: viewer, Type: biz.PromotionEventClick, CreatedAt: now.Add(-2 * time.Hour)},
+		}))
+
+		report, err := repo.GetReport(ctx, running.ID, now.Add(-time.Hour), now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, &biz.PromotionReport{Impressions: 3, Clicks: 1, UniqueUsers: 1}, report)
+	})
+}
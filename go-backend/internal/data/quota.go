@@ -0,0 +1,41 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type quotaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewQuotaRepo .
+func NewQuotaRepo(data *Data, logger log.Logger) biz.QuotaRepo {
+	return &quotaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *quotaRepo) CountUploadsSince(ctx context.Context, userID int64, since time.Time) (int64, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Where("author_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *quotaRepo) SumStorageBytes(ctx context.Context, userID int64) (int64, error) {
+	var total int64
+	err := r.data.db.WithContext(ctx).Model(&VideoModel{}).
+		Select("COALESCE(SUM(size), 0)").
+		Where("author_id = ? AND status <> ?", userID, domain.VideoStatusDeleted).
+		Scan(&total).Error
+	return total, err
+}
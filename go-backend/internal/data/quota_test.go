@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/domain"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	data := &Data{db: env.DB.DB, rdb: env.Redis.Client}
+	repo := NewQuotaRepo(data, log.DefaultLogger)
+	ctx := context.Background()
+
+	fixture, err := env.DataManager.CreateUser(
+		testutils.WithVideos(2, domain.VideoStatusPublished),
+		testutils.WithVideos(1, domain.VideoStatusDeleted),
+	)
+	require.NoError(t, err)
+	userID := fixture.User.ID
+	for _, video := range fixture.Videos {
+		require.NoError(t, env.DB.DB.Model(&VideoModel{}).Where("id = ?", video.ID).Update("size", 100).Error)
+	}
+	// 昨天上传的视频不计入当日上传数，但占用存储
+	require.NoError(t, env.DB.DB.Model(&VideoModel{}).Where("id = ?", fixture.Videos[0].ID).
+		Update("created_at", time.Now().Add(-48*time.Hour)).Error)
+
+	uploads, err := repo.CountUploadsSince(ctx, userID, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), uploads)
+
+	storage, err := repo.SumStorageBytes(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), storage)
+
+	storage, err = repo.SumStorageBytes(ctx, userID+1000000)
+	require.NoError(t, err)
+	assert.Zero(t, storage)
+}
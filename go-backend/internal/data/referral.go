@@ -0,0 +1,216 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// ReferralCodeModel 推荐码模型
+type ReferralCodeModel struct {
+	UserID    int64     `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	Code      string    `gorm:"size:16;not null;uniqueIndex:uk_code" json:"code"`
+	IP        string    `gorm:"size:64;not null;default:''" json:"ip"`
+	DeviceID  string    `gorm:"size:128;not null;default:''" json:"device_id"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (ReferralCodeModel) TableName() string {
+	return "referral_codes"
+}
+
+// ReferralModel 推荐注册记录模型
+type ReferralModel struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	ReferrerID  int64      `gorm:"not null;index:idx_referrer_status,priority:1" json:"referrer_id"`
+	RefereeID   int64      `gorm:"not null;uniqueIndex:uk_referee_id" json:"referee_id"`
+	Code        string     `gorm:"size:16;not null" json:"code"`
+	IP          string     `gorm:"size:64;not null;default:''" json:"ip"`
+	DeviceID    string     `gorm:"size:128;not null;default:'';index:idx_device_created,priority:1" json:"device_id"`
+	Status      int32      `gorm:"not null;default:0;index:idx_referrer_status,priority:2;index:idx_status_activated,priority:1" json:"status"`
+	FlagReasons string     `gorm:"size:255;not null;default:''" json:"flag_reasons"`
+	ActivatedAt *time.Time `gorm:"index:idx_status_activated,priority:2" json:"activated_at"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;index:idx_device_created,priority:2" json:"created_at"`
+}
+
+func (ReferralModel) TableName() string {
+	return "referrals"
+}
+
+type referralRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewReferralRepo .
+func NewReferralRepo(data *Data, logger log.Logger) biz.ReferralRepo {
+	return &referralRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *referralRepo) GetReferralCodeByUser(ctx context.Context, userID int64) (*biz.ReferralCode, error) {
+	var model ReferralCodeModel
+	if err := r.data.db.WithContext(ctx).Where("user_id = ?", userID).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return referralCodeModelToBiz(&model), nil
+}
+
+func (r *referralRepo) GetReferralCode(ctx context.Context, code string) (*biz.ReferralCode, error) {
+	var model ReferralCodeModel
+	if err := r.data.db.WithContext(ctx).Where("code = ?", code).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return referralCodeModelToBiz(&model), nil
+}
+
+func (r *referralRepo) CreateReferralCode(ctx context.Context, code *biz.ReferralCode) error {
+	model := &ReferralCodeModel{
+		UserID:   code.UserID,
+		Code:     code.Code,
+		IP:       code.IP,
+		DeviceID: code.DeviceID,
+	}
+	err := r.data.db.WithContext(ctx).Create(model).Error
+	if err == nil {
+		code.CreatedAt = model.CreatedAt
+		return nil
+	}
+	if !isDuplicateKeyError(err) {
+		return err
+	}
+
+	// 并发请求可能已经为该用户生成了推荐码，此时沿用已有的推荐码
+	existing, getErr := r.GetReferralCodeByUser(ctx, code.UserID)
+	if getErr != nil {
+		return getErr
+	}
+	if existing == nil {
+		return biz.ErrReferralCodeConflict
+	}
+	*code = *existing
+	return nil
+}
+
+func (r *referralRepo) CreateReferral(ctx context.Context, referral *biz.Referral) error {
+	model := &ReferralModel{
+		ReferrerID:  referral.ReferrerID,
+		RefereeID:   referral.RefereeID,
+		Code:        referral.Code,
+		IP:          referral.IP,
+		DeviceID:    referral.DeviceID,
+		Status:      referral.Status,
+		FlagReasons: strings.Join(referral.FlagReasons, ","),
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	referral.ID = model.ID
+	referral.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *referralRepo) CountRecentReferrals(ctx context.Context, referrerID int64, deviceID, ip string, since time.Time) (*biz.ReferralClusterStats, error) {
+	stats := &biz.ReferralClusterStats{}
+	db := r.data.db.WithContext(ctx)
+
+	if deviceID != "" {
+		if err := db.Model(&ReferralModel{}).
+			Where("device_id = ? AND created_at >= ?", deviceID, since).
+			Count(&stats.SameDevice).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if ip != "" {
+		if err := db.Model(&ReferralModel{}).
+			Where("referrer_id = ? AND ip = ? AND created_at >= ?", referrerID, ip, since).
+			Count(&stats.SameReferrerIP).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return stats, nil
+}
+
+func (r *referralRepo) ActivateReferral(ctx context.Context, refereeID int64) (bool, error) {
+	result := r.data.db.WithContext(ctx).Model(&ReferralModel{}).
+		Where("referee_id = ? AND status = ?", refereeID, biz.ReferralStatusPending).
+		Updates(map[string]interface{}{
+			"status":       biz.ReferralStatusActivated,
+			"activated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *referralRepo) GetReferralStats(ctx context.Context, referrerID int64) (*biz.ReferralStats, error) {
+	var rows []struct {
+		Status int32
+		Count  int64
+	}
+	if err := r.data.db.WithContext(ctx).Model(&ReferralModel{}).
+		Select("status, COUNT(*) AS count").
+		Where("referrer_id = ? AND status IN ?", referrerID, []int32{biz.ReferralStatusPending, biz.ReferralStatusActivated}).
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	stats := &biz.ReferralStats{}
+	for _, row := range rows {
+		stats.Invited += row.Count
+		if row.Status == biz.ReferralStatusActivated {
+			stats.Activated = row.Count
+		}
+	}
+	return stats, nil
+}
+
+func (r *referralRepo) ListTopReferrers(ctx context.Context, since time.Time, limit int) ([]*biz.ReferralRank, error) {
+	var rows []struct {
+		ReferrerID int64
+		Activated  int64
+	}
+	if err := r.data.db.WithContext(ctx).Model(&ReferralModel{}).
+		Select("referrer_id, COUNT(*) AS activated").
+		Where("status = ? AND activated_at >= ?", biz.ReferralStatusActivated, since).
+		Group("referrer_id").
+		Order("activated DESC, referrer_id ASC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	ranks := make([]*biz.ReferralRank, 0, len(rows))
+	for _, row := range rows {
+		ranks = append(ranks, &biz.ReferralRank{UserID: row.ReferrerID, Activated: row.Activated})
+	}
+	return ranks, nil
+}
+
+func referralCodeModelToBiz(model *ReferralCodeModel) *biz.ReferralCode {
+	return &biz.ReferralCode{
+		UserID:    model.UserID,
+		Code:      model.Code,
+		IP:        model.IP,
+		DeviceID:  model.DeviceID,
+		CreatedAt: model.CreatedAt,
+	}
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferralRepo_Codes(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewReferralRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+
+	code := &biz.ReferralCode{UserID: 1, Code: "ABCD2345", DeviceID: "dev"}
+	require.NoError(t, repo.CreateReferralCode(ctx, code))
+
+	found, err := repo.GetReferralCode(ctx, "ABCD2345")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, int64(1), found.UserID)
+	assert.Equal(t, "dev", found.DeviceID)
+
+	// 同一用户再次生成时沿用已有推荐码
+	again := &biz.ReferralCode{UserID: 1, Code: "WXYZ6789"}
+	require.NoError(t, repo.CreateReferralCode(ctx, again))
+	assert.Equal(t, "ABCD2345", again.Code)
+
+	// 推荐码与其他用户重复
+	err = repo.CreateReferralCode(ctx, &biz.ReferralCode{UserID: 2, Code: "ABCD2345"})
+	assert.ErrorIs(t, err, biz.ErrReferralCodeConflict)
+
+	missing, err := repo.GetReferralCodeByUser(ctx, 2)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestReferralRepo_Attribution(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewReferralRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+	since := time.Now().Add(-time.Hour)
+
+	referrals := []*biz.Referral{
+		{ReferrerID: 1, RefereeID: 10, Code: "ABCD2345", IP: "1.1.1.1", DeviceID: "dev-a", Status: biz.ReferralStatusPending},
+		{ReferrerID: 1, RefereeID: 11, Code: "ABCD2345", IP: "1.1.1.1", DeviceID: "dev-b", Status: biz.ReferralStatusPending},
+		{ReferrerID: 1, RefereeID: 12, Code: "ABCD2345", IP: "1.1.1.1", DeviceID: "dev-a", Status: biz.ReferralStatusFlagged, FlagReasons: []string{biz.ReferralFlagDeviceCluster}},
+		{ReferrerID: 2, RefereeID: 13, Code: "WXYZ6789", IP: "3.3.3.3", DeviceID: "dev-c", Status: biz.ReferralStatusPending},
+	}
+	for _, referral := range referrals {
+		require.NoError(t, repo.CreateReferral(ctx, referral))
+		assert.NotZero(t, referral.ID)
+	}
+
+	cluster, err := repo.CountRecentReferrals(ctx, 1, "dev-a", "1.1.1.1", since)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), cluster.SameDevice)
+	assert.Equal(t, int64(3), cluster.SameReferrerIP)
+
+	// 被标记的推荐不能激活
+	activated, err := repo.ActivateReferral(ctx, 12)
+	require.NoError(t, err)
+	assert.False(t, activated)
+
+	for _, refereeID := range []int64{10, 11, 13} {
+		activated, err = repo.ActivateReferral(ctx, refereeID)
+		require.NoError(t, err)
+		assert.True(t, activated)
+	}
+	activated, err = repo.ActivateReferral(ctx, 10)
+	require.NoError(t, err)
+	assert.False(t, activated)
+
+	stats, err := repo.GetReferralStats(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Invited)
+	assert.Equal(t, int64(2), stats.Activated)
+
+	ranks, err := repo.ListTopReferrers(ctx, since, 10)
+	require.NoError(t, err)
+	require.Len(t, ranks, 2)
+	assert.Equal(t, int64(1), ranks[0].UserID)
+	assert.Equal(t, int64(2), ranks[0].Activated)
+	assert.Equal(t, int64(2), ranks[1].UserID)
+}
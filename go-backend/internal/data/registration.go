@@ -0,0 +1,197 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	registrationIPKeyPrefix = "register:ip:"
+	// registrationStatsTTL 统计按UTC自然日分键，保留到次日结束以覆盖时区差异
+	registrationStatsTTL = 48 * time.Hour
+)
+
+// FlaggedRegistrationModel 可疑注册记录模型
+type FlaggedRegistrationModel struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID     int64      `gorm:"not null;index:idx_user_id" json:"user_id"`
+	Username   string     `gorm:"size:32;not null" json:"username"`
+	IP         string     `gorm:"size:64;not null;default:''" json:"ip"`
+	Reasons    string     `gorm:"size:255;not null" json:"reasons"`
+	Contact    string     `gorm:"size:254;not null;default:''" json:"contact"`
+	Status     int32      `gorm:"not null;default:0;index:idx_status_created,priority:1" json:"status"`
+	ReviewerID int64      `gorm:"not null;default:0" json:"reviewer_id"`
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;index:idx_status_created,priority:2" json:"created_at"`
+}
+
+func (FlaggedRegistrationModel) TableName() string {
+	return "flagged_registrations"
+}
+
+type registrationRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	log         *log.Helper
+}
+
+// NewRegistrationRepo .
+func NewRegistrationRepo(data *Data, invalidator domain.CacheInvalidationPublisher, logger log.Logger) biz.RegistrationRepo {
+	return &registrationRepo{
+		data:        data,
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
+	}
+}
+
+func (r *registrationRepo) GetRegistrationStats(ctx context.Context, ip string) (*biz.RegistrationStats, error) {
+	values, err := r.data.rdb.HMGet(ctx, registrationIPKey(ip), "count", "last").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &biz.RegistrationStats{}
+	if count, ok := values[0].(string); ok {
+		stats.Count, _ = strconv.ParseInt(count, 10, 64)
+	}
+	if last, ok := values[1].(string); ok {
+		stats.LastUsername = last
+	}
+
+	return stats, nil
+}
+
+func (r *registrationRepo) RecordRegistration(ctx context.Context, ip, username string) error {
+	key := registrationIPKey(ip)
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, "count", 1)
+		pipe.HSet(ctx, key, "last", username)
+		pipe.Expire(ctx, key, registrationStatsTTL)
+		return nil
+	})
+	return err
+}
+
+func (r *registrationRepo) CreateFlaggedRegistration(ctx context.Context, registration *biz.FlaggedRegistration) error {
+	model := &FlaggedRegistrationModel{
+		UserID:   registration.UserID,
+		Username: registration.Username,
+		IP:       registration.IP,
+		Reasons:  strings.Join(registration.Reasons, ","),
+		Contact:  registration.Contact,
+		Status:   registration.Status,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	registration.ID = model.ID
+	registration.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *registrationRepo) ListFlaggedRegistrations(ctx context.Context, page, size int32) ([]*biz.FlaggedRegistration, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&FlaggedRegistrationModel{}).
+		Where("status = ?", biz.RegistrationStatusPending)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []FlaggedRegistrationModel
+	if err := query.Session(&gorm.Session{}).
+		Order("created_at ASC, id ASC").
+		Offset(int((page - 1) * size)).Limit(int(size)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	registrations := make([]*biz.FlaggedRegistration, 0, len(models))
+	for i := range models {
+		registrations = append(registrations, flaggedRegistrationModelToBiz(&models[i]))
+	}
+
+	return registrations, total, nil
+}
+
+func (r *registrationRepo) ReviewFlaggedRegistration(ctx context.Context, registration *biz.FlaggedRegistration) error {
+	var model FlaggedRegistrationModel
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定记录，避免多个管理员同时审核
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&model, registration.ID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrRegistrationNotPending
+			}
+			return err
+		}
+
+		if model.Status != biz.RegistrationStatusPending {
+			return biz.ErrRegistrationNotPending
+		}
+
+		now := time.Now()
+		if err := tx.Model(&model).Updates(map[string]interface{}{
+			"status":      registration.Status,
+			"reviewer_id": registration.ReviewerID,
+			"reviewed_at": now,
+		}).Error; err != nil {
+			return err
+		}
+
+		if registration.Status == biz.RegistrationStatusRejected {
+			if err := tx.Model(&User{}).Where("id = ?", model.UserID).
+				Update("status", domain.UserStatusInactive).Error; err != nil {
+				return err
+			}
+		}
+
+		registration.UserID = model.UserID
+		registration.ReviewedAt = &now
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if registration.Status == biz.RegistrationStatusRejected {
+		invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeUser, registration.UserID))
+	}
+
+	return nil
+}
+
+func registrationIPKey(ip string) string {
+	return registrationIPKeyPrefix + ip + ":" + time.Now().UTC().Format("20060102")
+}
+
+func flaggedRegistrationModelToBiz(model *FlaggedRegistrationModel) *biz.FlaggedRegistration {
+	var reasons []string
+	if model.Reasons != "" {
+		reasons = strings.Split(model.Reasons, ",")
+	}
+
+	return &biz.FlaggedRegistration{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		Username:   model.Username,
+		IP:         model.IP,
+		Reasons:    reasons,
+		Contact:    model.Contact,
+		Status:     model.Status,
+		ReviewerID: model.ReviewerID,
+		ReviewedAt: model.ReviewedAt,
+		CreatedAt:  model.CreatedAt,
+	}
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRegistrationRepo(t *testing.T) (*registrationRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+
+	repo := &registrationRepo{
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+func TestRegistrationRepo_Stats(t *testing.T) {
+	repo, _, cleanup := setupRegistrationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	const ip = "198.51.100.20"
+
+	stats, err := repo.GetRegistrationStats(ctx, ip)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Count)
+	assert.Empty(t, stats.LastUsername)
+
+	require.NoError(t, repo.RecordRegistration(ctx, ip, "bot_001"))
+	require.NoError(t, repo.RecordRegistration(ctx, ip, "bot_002"))
+
+	stats, err = repo.GetRegistrationStats(ctx, ip)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Count)
+	assert.Equal(t, "bot_002", stats.LastUsername)
+}
+
+func TestRegistrationRepo_ReviewFlaggedRegistration(t *testing.T) {
+	repo, env, cleanup := setupRegistrationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+
+	for _, user := range users {
+		require.NoError(t, repo.CreateFlaggedRegistration(ctx, &biz.FlaggedRegistration{
+			UserID:   user.ID,
+			Username: user.Username,
+			IP:       "198.51.100.20",
+			Reasons:  []string{biz.FlagReasonSequentialUsername, biz.FlagReasonHighVolumeIP},
+			Status:   biz.RegistrationStatusPending,
+		}))
+	}
+
+	pending, total, err := repo.ListFlaggedRegistrations(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, pending, 2)
+	assert.Equal(t, users[0].ID, pending[0].UserID)
+	assert.Equal(t, []string{biz.FlagReasonSequentialUsername, biz.FlagReasonHighVolumeIP}, pending[0].Reasons)
+
+	// 拒绝后账号被禁用
+	rejected := &biz.FlaggedRegistration{ID: pending[0].ID, Status: biz.RegistrationStatusRejected, ReviewerID: 99}
+	require.NoError(t, repo.ReviewFlaggedRegistration(ctx, rejected))
+	assert.Equal(t, users[0].ID, rejected.UserID)
+
+	var user User
+	require.NoError(t, repo.data.db.First(&user, users[0].ID).Error)
+	assert.Equal(t, int8(domain.UserStatusInactive), user.Status)
+
+	// 已审核的记录不能再次审核
+	err = repo.ReviewFlaggedRegistration(ctx, &biz.FlaggedRegistration{ID: pending[0].ID, Status: biz.RegistrationStatusApproved, ReviewerID: 99})
+	assert.Equal(t, biz.ErrRegistrationNotPending, err)
+
+	pending, total, err = repo.ListFlaggedRegistrations(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, users[1].ID, pending[0].UserID)
+}
@@ -3,19 +3,35 @@ package data
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"go-backend/internal/biz"
+	"go-backend/internal/domain"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 )
 
+// followCacheTTL 关注状态缓存的过期时间，从用户的缓存哈希创建时算起
+const followCacheTTL = 10 * time.Minute
+
+// setFollowCacheScript 写入关注状态，只在哈希新建时设置过期时间，
+// 避免持续写入让早先缓存的字段一直不过期
+var setFollowCacheScript = redis.NewScript(`
+redis.call('HSET', KEYS[1], unpack(ARGV, 2))
+if redis.call('TTL', KEYS[1]) == -1 then
+	redis.call('EXPIRE', KEYS[1], ARGV[1])
+end
+return 1
+`)
+
 // UserFollow 关注关系模型
 type UserFollow struct {
 	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
-	UserID       int64     `gorm:"not null;index:uk_user_follow,priority:1" json:"user_id"`
-	FollowUserID int64     `gorm:"not null;index:uk_user_follow,priority:2" json:"follow_user_id"`
+	UserID       int64     `gorm:"not null;uniqueIndex:uk_user_follow,priority:1" json:"user_id"`
+	FollowUserID int64     `gorm:"not null;uniqueIndex:uk_user_follow,priority:2" json:"follow_user_id"`
 	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
 }
 
@@ -24,107 +40,73 @@ func (UserFollow) TableName() string {
 }
 
 type relationRepo struct {
-	data *Data
-	log  *log.Helper
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	producer    domain.InteractionEventPublisher
+	log         *log.Helper
 }
 
 // NewRelationRepo .
-func NewRelationRepo(data *Data, logger log.Logger) biz.RelationRepo {
+func NewRelationRepo(data *Data, invalidator domain.CacheInvalidationPublisher, producer domain.InteractionEventPublisher, logger log.Logger) biz.RelationRepo {
 	return &relationRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:        data,
+		invalidator: invalidator,
+		producer:    producer,
+		log:         log.NewHelper(logger),
 	}
 }
 
+// Follow 依赖 uk_user_follow 唯一键保证幂等，并发重复关注时只有一个请求写入并更新计数
 func (r *relationRepo) Follow(ctx context.Context, userID, followUserID int64) error {
-	// 检查是否已关注
-	var count int64
-	r.data.db.WithContext(ctx).Model(&UserFollow{}).
-		Where("user_id = ? AND follow_user_id = ?", userID, followUserID).
-		Count(&count)
-
-	if count > 0 {
-		return biz.ErrAlreadyFollow
-	}
-
-	// 创建关注关系
-	follow := &UserFollow{
-		UserID:       userID,
-		FollowUserID: followUserID,
-	}
-
 	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 插入关注记录
-		if err := tx.Create(follow).Error; err != nil {
+		if err := tx.Create(&UserFollow{UserID: userID, FollowUserID: followUserID}).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return biz.ErrAlreadyFollow
+			}
 			return err
 		}
 
-		// 更新关注数
-		if err := tx.Model(&User{}).Where("id = ?", userID).
-			Update("follow_count", gorm.Expr("follow_count + 1")).Error; err != nil {
-			return err
-		}
-
-		// 更新粉丝数
-		if err := tx.Model(&User{}).Where("id = ?", followUserID).
-			Update("follower_count", gorm.Expr("follower_count + 1")).Error; err != nil {
-			return err
-		}
-
-		return nil
+		return updateFollowCounters(tx, userID, followUserID, 1)
 	})
-
 	if err != nil {
 		return err
 	}
 
-	// 清除缓存
-	r.clearRelationCache(ctx, userID, followUserID)
+	adjustFollowCounts(ctx, r.data.rdb, r.log, userID, followUserID, 1)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeRelation, userID, followUserID))
+
+	event := domain.NewEventFactory().CreateUserFollowedEvent(userID, followUserID)
+	if err := r.producer.PublishUserFollowedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish user followed event failed: %v", err)
+	}
 
 	return nil
 }
 
+// Unfollow 以删除的行数判断是否关注，并发重复取关时只有一个请求更新计数
 func (r *relationRepo) Unfollow(ctx context.Context, userID, followUserID int64) error {
-	// 检查是否已关注
-	var follow UserFollow
-	err := r.data.db.WithContext(ctx).
-		Where("user_id = ? AND follow_user_id = ?", userID, followUserID).
-		First(&follow).Error
-
-	if err == gorm.ErrRecordNotFound {
-		return biz.ErrNotFollow
-	}
-	if err != nil {
-		return err
-	}
-
-	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 删除关注记录
-		if err := tx.Delete(&follow).Error; err != nil {
-			return err
-		}
-
-		// 更新关注数
-		if err := tx.Model(&User{}).Where("id = ?", userID).
-			Update("follow_count", gorm.Expr("follow_count - 1")).Error; err != nil {
-			return err
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND follow_user_id = ?", userID, followUserID).Delete(&UserFollow{})
+		if result.Error != nil {
+			return result.Error
 		}
-
-		// 更新粉丝数
-		if err := tx.Model(&User{}).Where("id = ?", followUserID).
-			Update("follower_count", gorm.Expr("follower_count - 1")).Error; err != nil {
-			return err
+		if result.RowsAffected == 0 {
+			return biz.ErrNotFollow
 		}
 
-		return nil
+		return updateFollowCounters(tx, userID, followUserID, -1)
 	})
-
 	if err != nil {
 		return err
 	}
 
-	// 清除缓存
-	r.clearRelationCache(ctx, userID, followUserID)
+	adjustFollowCounts(ctx, r.data.rdb, r.log, userID, followUserID, -1)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeRelation, userID, followUserID))
+
+	event := domain.NewEventFactory().CreateUserUnfollowedEvent(userID, followUserID)
+	if err := r.producer.PublishUserUnfollowedEvent(ctx, event); err != nil {
+		r.log.WithContext(ctx).Warnf("publish user unfollowed event failed: %v", err)
+	}
 
 	return nil
 }
@@ -147,11 +129,55 @@ func (r *relationRepo) IsFollowing(ctx context.Context, userID, followUserID int
 
 	isFollowing := count > 0
 	// 设置缓存
-	r.setFollowCache(ctx, userID, followUserID, isFollowing)
+	r.setFollowCache(ctx, userID, map[int64]bool{followUserID: isFollowing})
 
 	return isFollowing, nil
 }
 
+// BatchIsFollowing 先从用户的关注缓存哈希批量读取，未命中的一次查库并回填缓存
+func (r *relationRepo) BatchIsFollowing(ctx context.Context, userID int64, followUserIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(followUserIDs))
+
+	fields := make([]string, len(followUserIDs))
+	for i, id := range followUserIDs {
+		fields[i] = followCacheField(id)
+	}
+	cached, err := r.data.rdb.HMGet(ctx, followCacheKey(userID), fields...).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("batch get follow cache failed: %v", err)
+		cached = make([]interface{}, len(fields))
+	}
+
+	var missed []int64
+	for i, id := range followUserIDs {
+		if val, ok := cached[i].(string); ok {
+			result[id] = val == "1"
+			continue
+		}
+		missed = append(missed, id)
+	}
+	if len(missed) == 0 {
+		return result, nil
+	}
+
+	var followed []int64
+	if err := r.data.db.WithContext(ctx).Model(&UserFollow{}).
+		Where("user_id = ? AND follow_user_id IN ?", userID, missed).
+		Pluck("follow_user_id", &followed).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range followed {
+		result[id] = true
+	}
+	states := make(map[int64]bool, len(missed))
+	for _, id := range missed {
+		states[id] = result[id]
+	}
+	r.setFollowCache(ctx, userID, states)
+
+	return result, nil
+}
+
 func (r *relationRepo) GetFollowList(ctx context.Context, userID int64, page, size int32) ([]*biz.User, int64, error) {
 	offset := (page - 1) * size
 
@@ -199,6 +225,7 @@ func (r *relationRepo) GetFollowList(ctx context.Context, userID int64, page, si
 			Avatar:          u.Avatar,
 			BackgroundImage: u.BackgroundImage,
 			Signature:       u.Signature,
+			IsPrivate:       u.IsPrivate,
 			FollowCount:     u.FollowCount,
 			FollowerCount:   u.FollowerCount,
 			TotalFavorited:  u.TotalFavorited,
@@ -270,6 +297,7 @@ func (r *relationRepo) GetFollowerList(ctx context.Context, userID int64, page,
 			Avatar:          u.Avatar,
 			BackgroundImage: u.BackgroundImage,
 			Signature:       u.Signature,
+			IsPrivate:       u.IsPrivate,
 			FollowCount:     u.FollowCount,
 			FollowerCount:   u.FollowerCount,
 			TotalFavorited:  u.TotalFavorited,
@@ -319,6 +347,7 @@ func (r *relationRepo) GetFriendList(ctx context.Context, userID int64) ([]*biz.
 			Avatar:          u.Avatar,
 			BackgroundImage: u.BackgroundImage,
 			Signature:       u.Signature,
+			IsPrivate:       u.IsPrivate,
 			FollowCount:     u.FollowCount,
 			FollowerCount:   u.FollowerCount,
 			TotalFavorited:  u.TotalFavorited,
@@ -333,26 +362,48 @@ func (r *relationRepo) GetFriendList(ctx context.Context, userID int64) ([]*biz.
 }
 
 // 缓存相关方法
+// updateFollowCounters 在事务内更新关注数和粉丝数，计数与关系表不一致时不会减为负数
+func updateFollowCounters(tx *gorm.DB, userID, followUserID int64, delta int) error {
+	if err := tx.Model(&User{}).Where("id = ?", userID).
+		Update("follow_count", gorm.Expr("GREATEST(follow_count + ?, 0)", delta)).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&User{}).Where("id = ?", followUserID).
+		Update("follower_count", gorm.Expr("GREATEST(follower_count + ?, 0)", delta)).Error
+}
+
 func (r *relationRepo) getFollowCache(ctx context.Context, userID, followUserID int64) string {
-	key := fmt.Sprintf("follow:%d:%d", userID, followUserID)
-	val, _ := r.data.rdb.Get(ctx, key).Result()
+	val, _ := r.data.rdb.HGet(ctx, followCacheKey(userID), followCacheField(followUserID)).Result()
 	return val
 }
 
-func (r *relationRepo) setFollowCache(ctx context.Context, userID, followUserID int64, isFollowing bool) {
-	key := fmt.Sprintf("follow:%d:%d", userID, followUserID)
-	val := "0"
-	if isFollowing {
-		val = "1"
+// setFollowCache 批量写入用户对其他用户的关注状态，失败只记录日志，下次读取时回源
+func (r *relationRepo) setFollowCache(ctx context.Context, userID int64, states map[int64]bool) {
+	if len(states) == 0 {
+		return
 	}
-	r.data.rdb.Set(ctx, key, val, 10*time.Minute)
-}
 
-func (r *relationRepo) clearRelationCache(ctx context.Context, userID, followUserID int64) {
-	keys := []string{
-		fmt.Sprintf("follow:%d:%d", userID, followUserID),
-		fmt.Sprintf("follow:%d:*", userID),
-		fmt.Sprintf("follow:*:%d", followUserID),
+	args := make([]interface{}, 0, 1+2*len(states))
+	args = append(args, int(followCacheTTL.Seconds()))
+	for followUserID, isFollowing := range states {
+		val := "0"
+		if isFollowing {
+			val = "1"
+		}
+		args = append(args, followCacheField(followUserID), val)
+	}
+	if err := setFollowCacheScript.Run(ctx, r.data.rdb, []string{followCacheKey(userID)}, args...).Err(); err != nil && err != redis.Nil {
+		r.log.WithContext(ctx).Warnf("set follow cache failed: user=%d err=%v", userID, err)
 	}
-	r.data.rdb.Del(ctx, keys...)
+}
+
+// followCacheKey 用户的关注状态缓存哈希，字段为被关注用户ID，值为 "1" 已关注或 "0" 未关注。
+// 一个用户的全部关注状态在同一个键下，可以单条 HDEL 或整体 DEL 失效
+func followCacheKey(userID int64) string {
+	return fmt.Sprintf("follow:%d", userID)
+}
+
+func followCacheField(followUserID int64) string {
+	return strconv.FormatInt(followUserID, 10)
 }
@@ -2,9 +2,14 @@ package data
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"go-backend/internal/biz"
+	"go-backend/internal/data/producer"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
 	"go-backend/testutils"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -21,9 +26,16 @@ func setupRelationRepo(t *testing.T) (*relationRepo, *testutils.TestEnv, func())
 		rdb: env.Redis.Client,
 	}
 
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+
 	repo := &relationRepo{
-		data: data,
-		log:  log.NewHelper(log.DefaultLogger),
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		producer:    &producer.NoOpInteractionEventProducer{},
+		log:         log.NewHelper(log.DefaultLogger),
 	}
 
 	return repo, env, cleanup
@@ -93,6 +105,69 @@ func TestRelationRepo_Unfollow(t *testing.T) {
 	assert.Equal(t, biz.ErrNotFollow, err)
 }
 
+func TestRelationRepo_ConcurrentFollow(t *testing.T) {
+	repo, env, cleanup := setupRelationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user1, user2 := users[0], users[1]
+
+	const workers = 8
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = repo.Follow(ctx, user1.ID, user2.ID)
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(t, err, biz.ErrAlreadyFollow)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	var dbUser1, dbUser2 User
+	require.NoError(t, env.DB.DB.First(&dbUser1, user1.ID).Error)
+	require.NoError(t, env.DB.DB.First(&dbUser2, user2.ID).Error)
+	assert.Equal(t, 1, dbUser1.FollowCount)
+	assert.Equal(t, 1, dbUser2.FollowerCount)
+}
+
+func TestRelationRepo_UnfollowCountersNotNegative(t *testing.T) {
+	repo, env, cleanup := setupRelationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(2)
+	require.NoError(t, err)
+	user1, user2 := users[0], users[1]
+
+	// 关系存在但计数已经为0，取关后计数保持为0
+	require.NoError(t, env.DataManager.CreateFollowRelation(user1.ID, user2.ID))
+	require.NoError(t, env.DB.DB.Model(&User{}).Where("id IN ?", []int64{user1.ID, user2.ID}).
+		Updates(map[string]interface{}{"follow_count": 0, "follower_count": 0}).Error)
+
+	require.NoError(t, repo.Unfollow(ctx, user1.ID, user2.ID))
+
+	var dbUser1, dbUser2 User
+	require.NoError(t, env.DB.DB.First(&dbUser1, user1.ID).Error)
+	require.NoError(t, env.DB.DB.First(&dbUser2, user2.ID).Error)
+	assert.Zero(t, dbUser1.FollowCount)
+	assert.Zero(t, dbUser2.FollowerCount)
+}
+
 func TestRelationRepo_IsFollowing(t *testing.T) {
 	repo, env, cleanup := setupRelationRepo(t)
 	defer cleanup()
@@ -119,6 +194,30 @@ func TestRelationRepo_IsFollowing(t *testing.T) {
 	assert.True(t, isFollowing)
 }
 
+func TestRelationRepo_BatchIsFollowing(t *testing.T) {
+	repo, env, cleanup := setupRelationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(3)
+	require.NoError(t, err)
+	user1, user2, user3 := users[0], users[1], users[2]
+
+	require.NoError(t, repo.Follow(ctx, user1.ID, user2.ID))
+
+	// 第一次查库并回填缓存，第二次命中缓存
+	for i := 0; i < 2; i++ {
+		result, err := repo.BatchIsFollowing(ctx, user1.ID, []int64{user2.ID, user3.ID})
+		require.NoError(t, err)
+		assert.True(t, result[user2.ID])
+		assert.False(t, result[user3.ID])
+	}
+	assert.Equal(t, "1", env.Redis.Client.HGet(ctx, followCacheKey(user1.ID), followCacheField(user2.ID)).Val())
+	assert.Equal(t, "0", env.Redis.Client.HGet(ctx, followCacheKey(user1.ID), followCacheField(user3.ID)).Val())
+	assert.Greater(t, env.Redis.Client.TTL(ctx, followCacheKey(user1.ID)).Val(), time.Duration(0))
+}
+
 func TestRelationRepo_GetFollowList(t *testing.T) {
 	repo, env, cleanup := setupRelationRepo(t)
 	defer cleanup()
@@ -160,19 +259,13 @@ func TestRelationRepo_GetFollowerList(t *testing.T) {
 
 	ctx := context.Background()
 
-	// 创建测试用户
-	users, err := env.DataManager.CreateTestUsers(4)
+	// 创建有3个粉丝的用户
+	fixture, err := env.DataManager.CreateUser(testutils.WithFollowers(3))
 	require.NoError(t, err)
-	user1 := users[0]
+	user1, followers := fixture.User, fixture.Followers
 
-	// 建立关注关系：user2, user3, user4 关注 user1
-	for i := 1; i < 4; i++ {
-		err = env.DataManager.CreateFollowRelation(users[i].ID, user1.ID)
-		require.NoError(t, err)
-	}
-
-	// 建立互相关注：user1 关注 user2
-	err = env.DataManager.CreateFollowRelation(user1.ID, users[1].ID)
+	// 建立互相关注：user1 关注第一个粉丝
+	err = env.DataManager.CreateFollowRelation(user1.ID, followers[0].ID)
 	require.NoError(t, err)
 
 	// 获取粉丝列表
@@ -184,8 +277,8 @@ func TestRelationRepo_GetFollowerList(t *testing.T) {
 	// 验证互相关注的用户标记为已关注
 	user2Found := false
 	for _, user := range followerList {
-		if user.ID == users[1].ID {
-			assert.True(t, user.IsFollow) // user2应该被标记为已关注
+		if user.ID == followers[0].ID {
+			assert.True(t, user.IsFollow) // 互相关注的粉丝应该被标记为已关注
 			user2Found = true
 		}
 	}
@@ -247,16 +340,68 @@ func TestRelationRepo_CacheOperations(t *testing.T) {
 	user1, user2 := users[0], users[1]
 
 	// 设置关注缓存
-	repo.setFollowCache(ctx, user1.ID, user2.ID, true)
+	repo.setFollowCache(ctx, user1.ID, map[int64]bool{user2.ID: true})
 
 	// 验证缓存
 	cached := repo.getFollowCache(ctx, user1.ID, user2.ID)
 	assert.Equal(t, "1", cached)
 
-	// 清除缓存
-	repo.clearRelationCache(ctx, user1.ID, user2.ID)
+	// 通过缓存失效事件清除缓存
+	require.NoError(t, repo.invalidator.PublishCacheInvalidation(ctx, cacheInvalidation(domain.CacheTypeRelation, user1.ID, user2.ID)))
 
 	// 验证缓存已清除
 	cached = repo.getFollowCache(ctx, user1.ID, user2.ID)
 	assert.Empty(t, cached)
 }
+
+func TestRelationRepo_FollowRequests(t *testing.T) {
+	repo, env, cleanup := setupRelationRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(3)
+	require.NoError(t, err)
+	target, alice, bob := users[0], users[1], users[2]
+
+	require.NoError(t, repo.CreateFollowRequest(ctx, alice.ID, target.ID))
+	require.NoError(t, repo.CreateFollowRequest(ctx, bob.ID, target.ID))
+	assert.Equal(t, biz.ErrFollowRequestPending, repo.CreateFollowRequest(ctx, alice.ID, target.ID))
+
+	requests, total, err := repo.ListFollowRequests(ctx, target.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, requests, 2)
+	assert.Equal(t, bob.ID, requests[0].RequesterID)
+	assert.Equal(t, bob.Username, requests[0].Requester.Username)
+	assert.Empty(t, requests[0].Requester.PasswordHash)
+
+	t.Run("Approve", func(t *testing.T) {
+		require.NoError(t, repo.ApproveFollowRequest(ctx, target.ID, alice.ID))
+		assert.Equal(t, biz.ErrFollowRequestNotExist, repo.ApproveFollowRequest(ctx, target.ID, alice.ID))
+
+		isFollowing, err := repo.IsFollowing(ctx, alice.ID, target.ID)
+		require.NoError(t, err)
+		assert.True(t, isFollowing)
+
+		var dbTarget User
+		require.NoError(t, env.DB.DB.Where("id = ?", target.ID).First(&dbTarget).Error)
+		assert.Equal(t, 1, dbTarget.FollowerCount)
+	})
+
+	t.Run("RejectAndRequestAgain", func(t *testing.T) {
+		require.NoError(t, repo.RejectFollowRequest(ctx, target.ID, bob.ID))
+		assert.Equal(t, biz.ErrFollowRequestNotExist, repo.RejectFollowRequest(ctx, target.ID, bob.ID))
+
+		_, total, err := repo.ListFollowRequests(ctx, target.ID, 1, 10)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+
+		// 被拒绝后可以再次申请
+		require.NoError(t, repo.CreateFollowRequest(ctx, bob.ID, target.ID))
+		requests, _, err := repo.ListFollowRequests(ctx, target.ID, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Equal(t, bob.ID, requests[0].RequesterID)
+	})
+}
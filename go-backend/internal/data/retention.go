@@ -0,0 +1,53 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type retentionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRetentionRepo .
+func NewRetentionRepo(data *Data, logger log.Logger) biz.RetentionRepo {
+	return &retentionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *retentionRepo) CountExpired(ctx context.Context, policy *biz.RetentionPolicy, cutoff time.Time) (int64, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Raw(
+		fmt.Sprintf("SELECT COUNT(*) FROM `%s` WHERE %s", policy.Table, r.whereClause(policy)),
+		cutoff,
+	).Scan(&count).Error
+
+	return count, err
+}
+
+func (r *retentionRepo) DeleteExpiredBatch(ctx context.Context, policy *biz.RetentionPolicy, cutoff time.Time, batchSize int) (int64, error) {
+	// MySQL支持DELETE ... LIMIT，按批删除避免长事务和大范围锁
+	result := r.data.db.WithContext(ctx).Exec(
+		fmt.Sprintf("DELETE FROM `%s` WHERE %s LIMIT ?", policy.Table, r.whereClause(policy)),
+		cutoff, batchSize,
+	)
+
+	return result.RowsAffected, result.Error
+}
+
+// whereClause 构造过期条件，表名和字段名已在策略加载时校验
+func (r *retentionRepo) whereClause(policy *biz.RetentionPolicy) string {
+	clause := fmt.Sprintf("`%s` < ?", policy.TimeColumn)
+	if policy.Condition != "" {
+		clause += " AND (" + policy.Condition + ")"
+	}
+	return clause
+}
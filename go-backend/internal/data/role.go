@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"go-backend/internal/biz"
 	"go-backend/internal/domain"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Role 角色模型
@@ -37,6 +39,17 @@ func (UserRole) TableName() string {
 	return "user_roles"
 }
 
+// UserPermissionVersion 用户权限版本模型，角色绑定变更时在同一事务中递增
+type UserPermissionVersion struct {
+	UserID    int64     `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	Version   int64     `gorm:"not null;default:0" json:"version"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (UserPermissionVersion) TableName() string {
+	return "user_permission_versions"
+}
+
 // RoleRepo 角色仓储实现
 type RoleRepo struct {
 	data *Data
@@ -105,28 +118,92 @@ func (r *RoleRepo) GetUserRoles(ctx context.Context, userID int64) ([]*domain.Ro
 }
 
 func (r *RoleRepo) AssignRole(ctx context.Context, userID, roleID int64) error {
-	// 检查是否已存在
-	var count int64
-	r.data.db.WithContext(ctx).Model(&UserRole{}).
-		Where("user_id = ? AND role_id = ?", userID, roleID).
-		Count(&count)
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 检查是否已存在
+		var count int64
+		tx.Model(&UserRole{}).
+			Where("user_id = ? AND role_id = ?", userID, roleID).
+			Count(&count)
 
-	if count > 0 {
-		return nil // 已存在，不重复添加
+		if count > 0 {
+			return nil // 已存在，不重复添加
+		}
+
+		userRole := &UserRole{
+			UserID: userID,
+			RoleID: roleID,
+		}
+		if err := tx.Create(userRole).Error; err != nil {
+			return err
+		}
+		return bumpPermissionVersions(tx, []int64{userID})
+	})
+}
+
+func (r *RoleRepo) RemoveRole(ctx context.Context, userID, roleID int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND role_id = ?", userID, roleID).Delete(&UserRole{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return bumpPermissionVersions(tx, []int64{userID})
+	})
+}
+
+func (r *RoleRepo) GetUserRoleBindings(ctx context.Context, userID int64) ([]int64, int64, error) {
+	var (
+		roleIDs []int64
+		version UserPermissionVersion
+	)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&UserRole{}).Where("user_id = ?", userID).Order("id").Pluck("role_id", &roleIDs).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Limit(1).Find(&version).Error
+	})
+	if err != nil {
+		return nil, 0, err
 	}
+	return roleIDs, version.Version, nil
+}
 
-	userRole := &UserRole{
-		UserID: userID,
-		RoleID: roleID,
+func (r *RoleRepo) ListPermissionVersions(ctx context.Context) (map[int64]int64, error) {
+	var models []UserPermissionVersion
+	if err := r.data.db.WithContext(ctx).Find(&models).Error; err != nil {
+		return nil, err
 	}
 
-	return r.data.db.WithContext(ctx).Create(userRole).Error
+	versions := make(map[int64]int64, len(models))
+	for _, m := range models {
+		versions[m.UserID] = m.Version
+	}
+	return versions, nil
 }
 
-func (r *RoleRepo) RemoveRole(ctx context.Context, userID, roleID int64) error {
-	return r.data.db.WithContext(ctx).
-		Where("user_id = ? AND role_id = ?", userID, roleID).
-		Delete(&UserRole{}).Error
+// bumpPermissionVersions 递增用户的权限版本，使已签发令牌中的角色失效
+func bumpPermissionVersions(tx *gorm.DB, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]UserPermissionVersion, len(userIDs))
+	for i, userID := range userIDs {
+		rows[i] = UserPermissionVersion{UserID: userID, Version: 1}
+	}
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"version": gorm.Expr("version + 1"),
+		}),
+	}).Create(&rows).Error
+}
+
+// bumpRoleUsers 递增持有该角色的所有用户的权限版本
+func bumpRoleUsers(tx *gorm.DB, roleID int64) error {
+	var userIDs []int64
+	if err := tx.Model(&UserRole{}).Where("role_id = ?", roleID).Pluck("user_id", &userIDs).Error; err != nil {
+		return err
+	}
+	return bumpPermissionVersions(tx, userIDs)
 }
 
 func (r *RoleRepo) HasRole(ctx context.Context, userID, roleID int64) (bool, error) {
@@ -138,6 +215,121 @@ func (r *RoleRepo) HasRole(ctx context.Context, userID, roleID int64) (bool, err
 	return count > 0, err
 }
 
+func (r *RoleRepo) ListRoles(ctx context.Context) ([]*domain.Role, error) {
+	var roles []Role
+	if err := r.data.db.WithContext(ctx).Order("id").Find(&roles).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Role, 0, len(roles))
+	for i := range roles {
+		result = append(result, r.convertToRole(&roles[i]))
+	}
+
+	return result, nil
+}
+
+func (r *RoleRepo) ListUserRoles(ctx context.Context) ([]*domain.UserRole, error) {
+	var userRoles []UserRole
+	if err := r.data.db.WithContext(ctx).Order("id").Find(&userRoles).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.UserRole, 0, len(userRoles))
+	for _, ur := range userRoles {
+		result = append(result, &domain.UserRole{
+			ID:        ur.ID,
+			UserID:    ur.UserID,
+			RoleID:    ur.RoleID,
+			CreatedAt: ur.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *RoleRepo) FindRole(ctx context.Context, roleID int64) (*domain.Role, error) {
+	var role Role
+	if err := r.data.db.WithContext(ctx).Where("id = ?", roleID).First(&role).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrRoleNotFound
+		}
+		return nil, err
+	}
+
+	return r.convertToRole(&role), nil
+}
+
+func (r *RoleRepo) CreateRole(ctx context.Context, role *domain.Role) error {
+	model := &Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Status:      role.Status,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return biz.ErrRoleExist
+		}
+		return err
+	}
+
+	*role = *r.convertToRole(model)
+	return nil
+}
+
+// UpdateRole 令牌中按名称记录角色，改名或禁用都需要递增持有者的权限版本
+func (r *RoleRepo) UpdateRole(ctx context.Context, role *domain.Role) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Role{}).
+			Where("id = ?", role.ID).
+			Updates(map[string]interface{}{
+				"name":        role.Name,
+				"description": role.Description,
+				"status":      role.Status,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		return bumpRoleUsers(tx, role.ID)
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return biz.ErrRoleExist
+		}
+		return err
+	}
+
+	updated, err := r.FindRole(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+	*role = *updated
+	return nil
+}
+
+func (r *RoleRepo) DeleteRole(ctx context.Context, roleID int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpRoleUsers(tx, roleID); err != nil {
+			return err
+		}
+		if err := tx.Where("role_id = ?", roleID).Delete(&UserRole{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("role_id = ?", roleID).Delete(&RolePermission{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", roleID).Delete(&Role{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrRoleNotFound
+		}
+		return nil
+	})
+}
+
 func (r *RoleRepo) convertToRole(role *Role) *domain.Role {
 	return &domain.Role{
 		ID:          role.ID,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"go-backend/internal/biz"
 	"go-backend/internal/domain"
 	"go-backend/testutils"
 
@@ -253,3 +254,116 @@ func TestRoleRepo_EmptyUserRoles(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, userRoles)
 }
+
+func TestRoleRepo_ListRolesAndUserRoles(t *testing.T) {
+	repo, env, cleanup := setupRoleRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	roles, err := env.DataManager.CreateTestRoles()
+	require.NoError(t, err)
+
+	err = env.DataManager.AssignRoleToUser(users[0].ID, roles[0].ID)
+	require.NoError(t, err)
+
+	allRoles, err := repo.ListRoles(ctx)
+	require.NoError(t, err)
+	assert.Len(t, allRoles, len(roles))
+
+	userRoles, err := repo.ListUserRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, userRoles, 1)
+	assert.Equal(t, users[0].ID, userRoles[0].UserID)
+	assert.Equal(t, roles[0].ID, userRoles[0].RoleID)
+}
+
+func TestRoleRepo_CRUD(t *testing.T) {
+	repo, env, cleanup := setupRoleRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+
+	role := &domain.Role{Name: "editor", Description: "edits videos", Status: 1}
+	require.NoError(t, repo.CreateRole(ctx, role))
+	assert.NotZero(t, role.ID)
+
+	// 名称重复
+	err = repo.CreateRole(ctx, &domain.Role{Name: "editor", Status: 1})
+	assert.ErrorIs(t, err, biz.ErrRoleExist)
+
+	// 禁用后GetRole查不到，FindRole仍可查到
+	role.Status = 2
+	require.NoError(t, repo.UpdateRole(ctx, role))
+	_, err = repo.GetRole(ctx, role.ID)
+	assert.Error(t, err)
+	found, err := repo.FindRole(ctx, role.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int8(2), found.Status)
+
+	// 删除角色时一并解除用户绑定
+	require.NoError(t, repo.AssignRole(ctx, users[0].ID, role.ID))
+	require.NoError(t, repo.DeleteRole(ctx, role.ID))
+	hasRole, err := repo.HasRole(ctx, users[0].ID, role.ID)
+	require.NoError(t, err)
+	assert.False(t, hasRole)
+
+	_, err = repo.FindRole(ctx, role.ID)
+	assert.ErrorIs(t, err, biz.ErrRoleNotFound)
+	assert.ErrorIs(t, repo.DeleteRole(ctx, role.ID), biz.ErrRoleNotFound)
+}
+
+func TestRoleRepo_PermissionVersions(t *testing.T) {
+	repo, env, cleanup := setupRoleRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	role := &domain.Role{Name: "editor", Status: 1}
+	require.NoError(t, repo.CreateRole(ctx, role))
+
+	// 没有绑定变更过的用户版本为0
+	roleIDs, version, err := repo.GetUserRoleBindings(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, roleIDs)
+	assert.Equal(t, int64(0), version)
+
+	require.NoError(t, repo.AssignRole(ctx, user.ID, role.ID))
+	roleIDs, version, err = repo.GetUserRoleBindings(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{role.ID}, roleIDs)
+	assert.Equal(t, int64(1), version)
+
+	// 重复分配不改变版本
+	require.NoError(t, repo.AssignRole(ctx, user.ID, role.ID))
+	_, version, err = repo.GetUserRoleBindings(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), version)
+
+	// 角色被禁用时持有该角色的用户版本递增
+	role.Status = 2
+	require.NoError(t, repo.UpdateRole(ctx, role))
+	versions, err := repo.ListPermissionVersions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), versions[user.ID])
+
+	require.NoError(t, repo.RemoveRole(ctx, user.ID, role.ID))
+	_, version, err = repo.GetUserRoleBindings(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), version)
+
+	// 移除不存在的绑定不改变版本
+	require.NoError(t, repo.RemoveRole(ctx, user.ID, role.ID))
+	_, version, err = repo.GetUserRoleBindings(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), version)
+}
@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 敏感词级别，与 sensitive_words.level 一致
+const (
+	sensitiveWordLevelReview int32 = 1
+	sensitiveWordLevelBlock  int32 = 2
+)
+
+// SensitiveWordModel 敏感词模型
+type SensitiveWordModel struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Word      string    `gorm:"size:64;not null;uniqueIndex:uk_word" json:"word"`
+	Level     int32     `gorm:"not null" json:"level"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (SensitiveWordModel) TableName() string {
+	return "sensitive_words"
+}
+
+type sensitiveWordRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSensitiveWordRepo .
+func NewSensitiveWordRepo(data *Data, logger log.Logger) biz.SensitiveWordRepo {
+	return &sensitiveWordRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListSensitiveWords 获取全部敏感词，未知级别的记录跳过
+func (r *sensitiveWordRepo) ListSensitiveWords(ctx context.Context) ([]*biz.SensitiveWord, error) {
+	var models []SensitiveWordModel
+	if err := r.data.db.WithContext(ctx).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	words := make([]*biz.SensitiveWord, 0, len(models))
+	for _, m := range models {
+		var verdict security.ModerationVerdict
+		switch m.Level {
+		case sensitiveWordLevelReview:
+			verdict = security.ModerationReview
+		case sensitiveWordLevelBlock:
+			verdict = security.ModerationBlock
+		default:
+			r.log.WithContext(ctx).Warnf("unknown sensitive word level: id=%d level=%d", m.ID, m.Level)
+			continue
+		}
+		words = append(words, &biz.SensitiveWord{Word: m.Word, Verdict: verdict})
+	}
+	return words, nil
+}
@@ -0,0 +1,34 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitiveWordRepo_ListSensitiveWords(t *testing.T) {
+	favorite, _, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := NewSensitiveWordRepo(favorite.data, log.DefaultLogger)
+	ctx := context.Background()
+
+	require.NoError(t, favorite.data.db.Create([]*SensitiveWordModel{
+		{Word: "赌博", Level: sensitiveWordLevelBlock},
+		{Word: "加微信", Level: sensitiveWordLevelReview},
+		{Word: "未知", Level: 9},
+	}).Error)
+
+	words, err := repo.ListSensitiveWords(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*biz.SensitiveWord{
+		{Word: "赌博", Verdict: security.ModerationBlock},
+		{Word: "加微信", Verdict: security.ModerationReview},
+	}, words)
+}
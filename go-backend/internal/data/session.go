@@ -2,14 +2,13 @@ package data
 
 import (
 	"context"
-	"fmt"
 	"time"
 
+	"go-backend/internal/biz"
 	"go-backend/internal/data/cache"
-	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"gorm.io/gorm"
 )
 
 // UserSession 用户会话模型
@@ -17,6 +16,7 @@ type UserSession struct {
 	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
 	UserID       int64     `gorm:"not null;index" json:"user_id"`
 	RefreshToken string    `gorm:"uniqueIndex;size:255;not null" json:"refresh_token"`
+	FamilyID     string    `gorm:"size:64;not null;default:''" json:"family_id"`
 	ExpiresAt    time.Time `gorm:"not null;index" json:"expires_at"`
 	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
 }
@@ -37,108 +37,24 @@ func (TokenBlacklist) TableName() string {
 	return "token_blacklist"
 }
 
-// SessionRepo 会话仓储实现 - 实现 biz.AuthRepo 接口
+// SessionRepo Token黑名单仓储实现 - 实现 biz.AuthRepo 接口，会话本身由 SessionManager 管理
 type SessionRepo struct {
 	data      *Data
 	authCache *cache.AuthCache
+	clock     clock.Clock
 	log       *log.Helper
 }
 
 // NewSessionRepo 创建会话仓储
-func NewSessionRepo(data *Data, authCache *cache.AuthCache, logger log.Logger) *SessionRepo {
+func NewSessionRepo(data *Data, authCache *cache.AuthCache, clk clock.Clock, logger log.Logger) *SessionRepo {
 	return &SessionRepo{
 		data:      data,
 		authCache: authCache,
+		clock:     clk,
 		log:       log.NewHelper(logger),
 	}
 }
 
-// 实现 biz.AuthRepo 接口的所有方法
-func (r *SessionRepo) CreateSession(ctx context.Context, session *domain.UserSession) error {
-	s := &UserSession{
-		UserID:       session.UserID,
-		RefreshToken: session.RefreshToken,
-		ExpiresAt:    session.ExpiresAt,
-	}
-
-	if err := r.data.db.WithContext(ctx).Create(s).Error; err != nil {
-		return err
-	}
-
-	session.ID = s.ID
-	session.CreatedAt = s.CreatedAt
-
-	// 设置缓存
-	r.authCache.SetUserSession(ctx, session)
-
-	return nil
-}
-
-func (r *SessionRepo) GetSession(ctx context.Context, userID int64) (*domain.UserSession, error) {
-	// 先从缓存获取
-	if session, err := r.authCache.GetUserSession(ctx, userID); err == nil {
-		if !session.IsExpired() {
-			return session, nil
-		}
-		// 过期了，删除缓存
-		r.authCache.DeleteUserSession(ctx, userID)
-	}
-
-	var s UserSession
-	if err := r.data.db.WithContext(ctx).
-		Where("user_id = ? AND expires_at > ?", userID, time.Now()).
-		First(&s).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("session not found")
-		}
-		return nil, err
-	}
-
-	session := r.convertToSession(&s)
-	r.authCache.SetUserSession(ctx, session)
-
-	return session, nil
-}
-
-func (r *SessionRepo) GetSessionByToken(ctx context.Context, refreshToken string) (*domain.UserSession, error) {
-	var s UserSession
-	if err := r.data.db.WithContext(ctx).
-		Where("refresh_token = ? AND expires_at > ?", refreshToken, time.Now()).
-		First(&s).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("session not found")
-		}
-		return nil, err
-	}
-
-	return r.convertToSession(&s), nil
-}
-
-func (r *SessionRepo) UpdateSession(ctx context.Context, userID int64, newRefreshToken string, expiry time.Duration) error {
-	expiresAt := time.Now().Add(expiry)
-
-	if err := r.data.db.WithContext(ctx).Model(&UserSession{}).
-		Where("user_id = ?", userID).
-		Updates(map[string]interface{}{
-			"refresh_token": newRefreshToken,
-			"expires_at":    expiresAt,
-		}).Error; err != nil {
-		return err
-	}
-
-	r.authCache.DeleteUserSession(ctx, userID)
-	return nil
-}
-
-func (r *SessionRepo) DeleteSession(ctx context.Context, userID int64) error {
-	if err := r.data.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&UserSession{}).Error; err != nil {
-		return err
-	}
-
-	r.authCache.DeleteUserSession(ctx, userID)
-	return nil
-}
-
 func (r *SessionRepo) AddTokenToBlacklist(ctx context.Context, tokenID string, expiresAt time.Time) error {
 	token := &TokenBlacklist{
 		TokenID:   tokenID,
@@ -149,7 +65,7 @@ func (r *SessionRepo) AddTokenToBlacklist(ctx context.Context, tokenID string, e
 		return err
 	}
 
-	expiry := time.Until(expiresAt)
+	expiry := r.clock.Until(expiresAt)
 	r.log.Infof("Cache expiry duration for token %s: %v", tokenID, expiry)
 
 	// 只有当Token还未过期时才添加到缓存
@@ -167,7 +83,7 @@ func (r *SessionRepo) IsTokenBlacklisted(ctx context.Context, tokenID string) (b
 	r.log.Infof("Token not found in cache blacklist: %s", tokenID)
 
 	var count int64
-	currentTime := time.Now()
+	currentTime := r.clock.Now()
 	r.log.Infof("Current time: %v", currentTime)
 
 	// 关键修改：只查询未过期的黑名单记录
@@ -194,12 +110,54 @@ func (r *SessionRepo) IsTokenBlacklisted(ctx context.Context, tokenID string) (b
 	return isBlacklisted, nil
 }
 
-func (r *SessionRepo) convertToSession(s *UserSession) *domain.UserSession {
-	return &domain.UserSession{
-		ID:           s.ID,
-		UserID:       s.UserID,
-		RefreshToken: s.RefreshToken,
-		ExpiresAt:    s.ExpiresAt,
-		CreatedAt:    s.CreatedAt,
-	}
+func (r *SessionRepo) GetLoginAttempts(ctx context.Context, username string) (int, error) {
+	return r.authCache.GetLoginAttempts(ctx, username)
+}
+
+func (r *SessionRepo) IncrLoginAttempts(ctx context.Context, username string, window time.Duration) (int, error) {
+	return r.authCache.IncrLoginAttempts(ctx, username, window)
+}
+
+func (r *SessionRepo) ClearLoginAttempts(ctx context.Context, username string) error {
+	return r.authCache.ClearLoginAttempts(ctx, username)
+}
+
+func (r *SessionRepo) SetPasswordResetToken(ctx context.Context, username, token string) error {
+	return r.authCache.SetPasswordResetToken(ctx, username, token)
+}
+
+func (r *SessionRepo) ConsumePasswordResetToken(ctx context.Context, username, token string) (bool, error) {
+	return r.authCache.ConsumePasswordResetToken(ctx, username, token)
+}
+
+func (r *SessionRepo) SetEmailVerification(ctx context.Context, userID int64, verification *biz.EmailVerification, ttl time.Duration) error {
+	return r.authCache.SetEmailVerification(ctx, userID, verification, ttl)
+}
+
+func (r *SessionRepo) GetEmailVerification(ctx context.Context, userID int64) (*biz.EmailVerification, error) {
+	return r.authCache.GetEmailVerification(ctx, userID)
+}
+
+func (r *SessionRepo) IncrEmailVerificationAttempts(ctx context.Context, userID int64, ttl time.Duration) (int, error) {
+	return r.authCache.IncrEmailVerificationAttempts(ctx, userID, ttl)
+}
+
+func (r *SessionRepo) DeleteEmailVerification(ctx context.Context, userID int64) error {
+	return r.authCache.DeleteEmailVerification(ctx, userID)
+}
+
+func (r *SessionRepo) SetLoginCode(ctx context.Context, channel, target string, code *biz.LoginCode, ttl time.Duration) error {
+	return r.authCache.SetLoginCode(ctx, channel, target, code, ttl)
+}
+
+func (r *SessionRepo) GetLoginCode(ctx context.Context, channel, target string) (*biz.LoginCode, error) {
+	return r.authCache.GetLoginCode(ctx, channel, target)
+}
+
+func (r *SessionRepo) IncrLoginCodeAttempts(ctx context.Context, channel, target string, ttl time.Duration) (int, error) {
+	return r.authCache.IncrLoginCodeAttempts(ctx, channel, target, ttl)
+}
+
+func (r *SessionRepo) DeleteLoginCode(ctx context.Context, channel, target string) error {
+	return r.authCache.DeleteLoginCode(ctx, channel, target)
 }
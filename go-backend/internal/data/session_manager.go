@@ -0,0 +1,287 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-backend/internal/domain"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+const (
+	sessionUserKeyPrefix  = "session:user:"
+	sessionTokenKeyPrefix = "session:token:"
+)
+
+// sessionManager 分布式会话管理器：Redis 保存热数据供所有实例共享，
+// user_sessions 表持久化会话，Redis 丢失数据时从数据库回填。
+// 不经过多级缓存，避免本地缓存导致登出后其他实例仍认为会话有效
+type sessionManager struct {
+	data  *Data
+	clock clock.Clock
+	log   *log.Helper
+}
+
+// NewSessionManager 创建基于 Redis + MySQL 的会话管理器
+func NewSessionManager(data *Data, clk clock.Clock, logger log.Logger) auth.SessionManager {
+	return &sessionManager{
+		data:  data,
+		clock: clk,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// CreateSession 创建会话，同一用户的旧会话会被替换
+func (m *sessionManager) CreateSession(ctx context.Context, userID int64, refreshToken, familyID string, expiry time.Duration) (*domain.UserSession, error) {
+	model := &UserSession{
+		UserID:       userID,
+		RefreshToken: refreshToken,
+		FamilyID:     familyID,
+		ExpiresAt:    m.clock.Now().Add(expiry),
+	}
+
+	err := m.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&UserSession{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	session := convertToSession(model)
+	if err := m.cacheSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// GetSession 获取用户当前会话
+func (m *sessionManager) GetSession(ctx context.Context, userID int64) (*domain.UserSession, error) {
+	session, err := m.getCachedSession(ctx, userID)
+	if err != nil && err != redis.Nil {
+		m.log.WithContext(ctx).Warnf("get session from redis failed: %v", err)
+	}
+	if session == nil {
+		var model UserSession
+		if err := m.data.db.WithContext(ctx).
+			Where("user_id = ?", userID).
+			Order("id DESC").
+			First(&model).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, auth.ErrSessionNotFound
+			}
+			return nil, err
+		}
+		session = convertToSession(&model)
+		m.backfill(ctx, session)
+	}
+
+	if session.ExpiredAt(m.clock.Now()) {
+		return nil, auth.ErrSessionExpired
+	}
+
+	return session, nil
+}
+
+// GetSessionByToken 根据刷新令牌获取会话
+func (m *sessionManager) GetSessionByToken(ctx context.Context, refreshToken string) (*domain.UserSession, error) {
+	userID, err := m.data.rdb.Get(ctx, sessionTokenKey(refreshToken)).Int64()
+	if err == nil {
+		session, err := m.GetSession(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		// 令牌索引可能落后于已轮换的会话
+		if session.RefreshToken != refreshToken {
+			return nil, auth.ErrSessionNotFound
+		}
+		return session, nil
+	}
+	if err != redis.Nil {
+		m.log.WithContext(ctx).Warnf("get session token index from redis failed: %v", err)
+	}
+
+	var model UserSession
+	if err := m.data.db.WithContext(ctx).
+		Where("refresh_token = ?", refreshToken).
+		First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, auth.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	session := convertToSession(&model)
+	if session.ExpiredAt(m.clock.Now()) {
+		return nil, auth.ErrSessionExpired
+	}
+	m.backfill(ctx, session)
+
+	return session, nil
+}
+
+// UpdateSession 轮换会话的刷新令牌并延长有效期
+func (m *sessionManager) UpdateSession(ctx context.Context, userID int64, newRefreshToken string, expiry time.Duration) error {
+	var model UserSession
+	err := m.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Order("id DESC").First(&model).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return auth.ErrSessionNotFound
+			}
+			return err
+		}
+
+		model.RefreshToken = newRefreshToken
+		model.ExpiresAt = m.clock.Now().Add(expiry)
+		return tx.Model(&UserSession{}).Where("id = ?", model.ID).
+			Updates(map[string]interface{}{
+				"refresh_token": model.RefreshToken,
+				"expires_at":    model.ExpiresAt,
+			}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.cacheSession(ctx, convertToSession(&model))
+}
+
+// DeleteSession 删除用户会话
+func (m *sessionManager) DeleteSession(ctx context.Context, userID int64) error {
+	if err := m.data.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&UserSession{}).Error; err != nil {
+		return err
+	}
+
+	return m.evictSession(ctx, userID)
+}
+
+// ValidateSession 检查刷新令牌是否为用户当前有效会话的令牌
+func (m *sessionManager) ValidateSession(ctx context.Context, userID int64, refreshToken string) (bool, error) {
+	session, err := m.GetSession(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return session.RefreshToken == refreshToken, nil
+}
+
+// CleanupExpiredSessions 清理数据库中的过期会话，Redis 中的数据依赖 TTL 自动过期
+func (m *sessionManager) CleanupExpiredSessions(ctx context.Context) error {
+	result := m.data.db.WithContext(ctx).Where("expires_at <= ?", m.clock.Now()).Delete(&UserSession{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		m.log.WithContext(ctx).Infof("cleaned up %d expired sessions", result.RowsAffected)
+	}
+	return nil
+}
+
+// Close 连接由 Data 统一释放
+func (m *sessionManager) Close() error {
+	return nil
+}
+
+// cacheSession 写入会话及令牌索引，并移除被替换会话的令牌索引
+func (m *sessionManager) cacheSession(ctx context.Context, session *domain.UserSession) error {
+	previous, err := m.getCachedSession(ctx, session.UserID)
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("get cached session failed: %w", err)
+	}
+
+	ttl := m.clock.Until(session.ExpiresAt)
+	value, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("marshal session failed: %w", err)
+	}
+
+	_, err = m.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if previous != nil && previous.RefreshToken != session.RefreshToken {
+			pipe.Del(ctx, sessionTokenKey(previous.RefreshToken))
+		}
+		if ttl <= 0 {
+			pipe.Del(ctx, sessionUserKey(session.UserID))
+			return nil
+		}
+		pipe.Set(ctx, sessionUserKey(session.UserID), value, ttl)
+		pipe.Set(ctx, sessionTokenKey(session.RefreshToken), session.UserID, ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("cache session failed: %w", err)
+	}
+
+	return nil
+}
+
+// backfill 将数据库中读到的会话回填到 Redis，失败不影响读取
+func (m *sessionManager) backfill(ctx context.Context, session *domain.UserSession) {
+	if err := m.cacheSession(ctx, session); err != nil {
+		m.log.WithContext(ctx).Warnf("backfill session %d failed: %v", session.UserID, err)
+	}
+}
+
+func (m *sessionManager) evictSession(ctx context.Context, userID int64) error {
+	previous, err := m.getCachedSession(ctx, userID)
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("get cached session failed: %w", err)
+	}
+
+	keys := []string{sessionUserKey(userID)}
+	if previous != nil {
+		keys = append(keys, sessionTokenKey(previous.RefreshToken))
+	}
+
+	if err := m.data.rdb.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("evict session failed: %w", err)
+	}
+	return nil
+}
+
+func (m *sessionManager) getCachedSession(ctx context.Context, userID int64) (*domain.UserSession, error) {
+	value, err := m.data.rdb.Get(ctx, sessionUserKey(userID)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var session domain.UserSession
+	if err := json.Unmarshal(value, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func sessionUserKey(userID int64) string {
+	return sessionUserKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// sessionTokenKey 刷新令牌较长，使用摘要作为键
+func sessionTokenKey(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return sessionTokenKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+func convertToSession(s *UserSession) *domain.UserSession {
+	return &domain.UserSession{
+		ID:           s.ID,
+		UserID:       s.UserID,
+		RefreshToken: s.RefreshToken,
+		FamilyID:     s.FamilyID,
+		ExpiresAt:    s.ExpiresAt,
+		CreatedAt:    s.CreatedAt,
+	}
+}
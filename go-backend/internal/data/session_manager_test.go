@@ -0,0 +1,225 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSessionManager(t *testing.T) (auth.SessionManager, *testutils.TestEnv, func()) {
+	return setupSessionManagerWithClock(t, clock.New())
+}
+
+func setupSessionManagerWithClock(t *testing.T, clk clock.Clock) (auth.SessionManager, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	return NewSessionManager(data, clk, log.DefaultLogger), env, cleanup
+}
+
+func TestSessionManager_CreateSession(t *testing.T) {
+	manager, env, cleanup := setupSessionManager(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	session, err := manager.CreateSession(ctx, user.ID, "test-refresh-token", "family", time.Hour)
+	require.NoError(t, err)
+	assert.NotZero(t, session.ID)
+	assert.True(t, session.ExpiresAt.After(time.Now()))
+
+	// 数据库持久化
+	var dbSession UserSession
+	err = env.DB.DB.Where("user_id = ?", user.ID).First(&dbSession).Error
+	require.NoError(t, err)
+	assert.Equal(t, "test-refresh-token", dbSession.RefreshToken)
+	assert.Equal(t, "family", dbSession.FamilyID)
+
+	// 再次创建替换旧会话
+	_, err = manager.CreateSession(ctx, user.ID, "second-refresh-token", "family", time.Hour)
+	require.NoError(t, err)
+
+	var count int64
+	env.DB.DB.Model(&UserSession{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(t, int64(1), count)
+
+	_, err = manager.GetSessionByToken(ctx, "test-refresh-token")
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+}
+
+func TestSessionManager_GetSession(t *testing.T) {
+	manager, env, cleanup := setupSessionManager(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	_, err = manager.CreateSession(ctx, user.ID, "test-refresh-token", "family", time.Hour)
+	require.NoError(t, err)
+
+	retrieved, err := manager.GetSession(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "test-refresh-token", retrieved.RefreshToken)
+
+	retrieved, err = manager.GetSessionByToken(ctx, "test-refresh-token")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, retrieved.UserID)
+
+	_, err = manager.GetSession(ctx, 99999)
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+
+	_, err = manager.GetSessionByToken(ctx, "nonexistent-token")
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+}
+
+func TestSessionManager_FallbackToDatabase(t *testing.T) {
+	manager, env, cleanup := setupSessionManager(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	_, err = manager.CreateSession(ctx, user.ID, "durable-token", "family", time.Hour)
+	require.NoError(t, err)
+
+	// 模拟Redis数据丢失
+	require.NoError(t, env.Redis.Client.FlushDB(ctx).Err())
+
+	retrieved, err := manager.GetSessionByToken(ctx, "durable-token")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, retrieved.UserID)
+
+	// 已回填到Redis
+	exists, err := env.Redis.Client.Exists(ctx, sessionUserKey(user.ID), sessionTokenKey("durable-token")).Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), exists)
+}
+
+func TestSessionManager_UpdateSession(t *testing.T) {
+	manager, env, cleanup := setupSessionManager(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	session, err := manager.CreateSession(ctx, user.ID, "old-refresh-token", "family", time.Hour)
+	require.NoError(t, err)
+
+	err = manager.UpdateSession(ctx, user.ID, "new-refresh-token", 2*time.Hour)
+	require.NoError(t, err)
+
+	updated, err := manager.GetSession(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-refresh-token", updated.RefreshToken)
+	assert.True(t, updated.ExpiresAt.After(session.ExpiresAt))
+
+	// 旧令牌失效
+	_, err = manager.GetSessionByToken(ctx, "old-refresh-token")
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+
+	err = manager.UpdateSession(ctx, 99999, "token", time.Hour)
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+}
+
+func TestSessionManager_DeleteSession(t *testing.T) {
+	manager, env, cleanup := setupSessionManager(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	_, err = manager.CreateSession(ctx, user.ID, "test-refresh-token", "family", time.Hour)
+	require.NoError(t, err)
+
+	err = manager.DeleteSession(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, err = manager.GetSession(ctx, user.ID)
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+
+	_, err = manager.GetSessionByToken(ctx, "test-refresh-token")
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+}
+
+func TestSessionManager_ValidateSession(t *testing.T) {
+	manager, env, cleanup := setupSessionManager(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	_, err = manager.CreateSession(ctx, user.ID, "valid-token", "family", time.Hour)
+	require.NoError(t, err)
+
+	valid, err := manager.ValidateSession(ctx, user.ID, "valid-token")
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = manager.ValidateSession(ctx, user.ID, "wrong-token")
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestSessionManager_ExpiredSession(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	manager, env, cleanup := setupSessionManagerWithClock(t, clk)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	user := users[0]
+
+	_, err = manager.CreateSession(ctx, user.ID, "expired-token", "family", time.Hour)
+	require.NoError(t, err)
+
+	_, err = manager.GetSession(ctx, user.ID)
+	require.NoError(t, err)
+
+	clk.Advance(2 * time.Hour)
+
+	_, err = manager.GetSession(ctx, user.ID)
+	assert.Equal(t, auth.ErrSessionExpired, err)
+
+	_, err = manager.GetSessionByToken(ctx, "expired-token")
+	assert.Equal(t, auth.ErrSessionExpired, err)
+
+	// 清理后会话不再存在
+	require.NoError(t, manager.CleanupExpiredSessions(ctx))
+
+	_, err = manager.GetSession(ctx, user.ID)
+	assert.Equal(t, auth.ErrSessionNotFound, err)
+}
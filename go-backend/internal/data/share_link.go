@@ -0,0 +1,125 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// VideoShareLinkModel 视频分享短链模型
+type VideoShareLinkModel struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Token      string    `gorm:"type:varchar(16) CHARACTER SET ascii COLLATE ascii_bin;not null;uniqueIndex:uk_token" json:"token"`
+	VideoID    int64     `gorm:"not null;uniqueIndex:uk_video_sharer,priority:1" json:"video_id"`
+	SharerID   int64     `gorm:"not null;uniqueIndex:uk_video_sharer,priority:2;index:idx_sharer" json:"sharer_id"`
+	ShareCount int64     `gorm:"not null;default:0" json:"share_count"`
+	OpenCount  int64     `gorm:"not null;default:0" json:"open_count"`
+	PlayCount  int64     `gorm:"not null;default:0" json:"play_count"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (VideoShareLinkModel) TableName() string {
+	return "video_share_links"
+}
+
+type shareLinkRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewShareLinkRepo .
+func NewShareLinkRepo(data *Data, logger log.Logger) biz.ShareLinkRepo {
+	return &shareLinkRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *shareLinkRepo) GetShareLink(ctx context.Context, videoID, sharerID int64) (*biz.ShareLink, error) {
+	var model VideoShareLinkModel
+	err := r.data.db.WithContext(ctx).
+		Where("video_id = ? AND sharer_id = ?", videoID, sharerID).
+		First(&model).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return shareLinkModelToBiz(&model), nil
+}
+
+func (r *shareLinkRepo) GetShareLinkByToken(ctx context.Context, token string) (*biz.ShareLink, error) {
+	var model VideoShareLinkModel
+	if err := r.data.db.WithContext(ctx).Where("token = ?", token).First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrShareLinkNotFound
+		}
+		return nil, err
+	}
+	return shareLinkModelToBiz(&model), nil
+}
+
+func (r *shareLinkRepo) CreateShareLink(ctx context.Context, link *biz.ShareLink) error {
+	model := &VideoShareLinkModel{
+		Token:    link.Token,
+		VideoID:  link.VideoID,
+		SharerID: link.SharerID,
+	}
+	err := r.data.db.WithContext(ctx).Create(model).Error
+	if err == nil {
+		*link = *shareLinkModelToBiz(model)
+		return nil
+	}
+	if !isDuplicateKeyError(err) {
+		return err
+	}
+
+	// 并发请求可能已经为该用户生成了链接，此时沿用已有的链接
+	existing, getErr := r.GetShareLink(ctx, link.VideoID, link.SharerID)
+	if getErr != nil {
+		return getErr
+	}
+	if existing == nil {
+		return biz.ErrShareTokenConflict
+	}
+	*link = *existing
+	return nil
+}
+
+func (r *shareLinkRepo) RecordShare(ctx context.Context, linkID int64) error {
+	return r.incr(ctx, linkID, "share_count")
+}
+
+func (r *shareLinkRepo) RecordOpen(ctx context.Context, linkID int64) error {
+	return r.incr(ctx, linkID, "open_count")
+}
+
+func (r *shareLinkRepo) RecordPlay(ctx context.Context, linkID int64) error {
+	return r.incr(ctx, linkID, "play_count")
+}
+
+func (r *shareLinkRepo) incr(ctx context.Context, linkID int64, field string) error {
+	return r.data.db.WithContext(ctx).Model(&VideoShareLinkModel{}).
+		Where("id = ?", linkID).
+		Update(field, gorm.Expr(field+" + 1")).Error
+}
+
+func shareLinkModelToBiz(model *VideoShareLinkModel) *biz.ShareLink {
+	return &biz.ShareLink{
+		ID:         model.ID,
+		Token:      model.Token,
+		VideoID:    model.VideoID,
+		SharerID:   model.SharerID,
+		ShareCount: model.ShareCount,
+		OpenCount:  model.OpenCount,
+		PlayCount:  model.PlayCount,
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareLinkRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewShareLinkRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+
+	missing, err := repo.GetShareLink(ctx, 10, 1)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	link := &biz.ShareLink{Token: "aB3dE5gH7j", VideoID: 10, SharerID: 1}
+	require.NoError(t, repo.CreateShareLink(ctx, link))
+	assert.NotZero(t, link.ID)
+
+	// 同一用户再次分享同一视频时沿用已有链接
+	again := &biz.ShareLink{Token: "Zz9yY8xX7w", VideoID: 10, SharerID: 1}
+	require.NoError(t, repo.CreateShareLink(ctx, again))
+	assert.Equal(t, link.ID, again.ID)
+	assert.Equal(t, "aB3dE5gH7j", again.Token)
+
+	assert.ErrorIs(t, repo.CreateShareLink(ctx, &biz.ShareLink{Token: "aB3dE5gH7j", VideoID: 11, SharerID: 1}), biz.ErrShareTokenConflict)
+
+	require.NoError(t, repo.RecordShare(ctx, link.ID))
+	require.NoError(t, repo.RecordShare(ctx, link.ID))
+	require.NoError(t, repo.RecordOpen(ctx, link.ID))
+	require.NoError(t, repo.RecordPlay(ctx, link.ID))
+
+	found, err := repo.GetShareLinkByToken(ctx, "aB3dE5gH7j")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), found.ShareCount)
+	assert.Equal(t, int64(1), found.OpenCount)
+	assert.Equal(t, int64(1), found.PlayCount)
+
+	_, err = repo.GetShareLinkByToken(ctx, "0000000000")
+	assert.ErrorIs(t, err, biz.ErrShareLinkNotFound)
+}
@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// SigningKeyModel 令牌签名密钥模型
+type SigningKeyModel struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Kid        string     `gorm:"column:kid;size:64;not null;uniqueIndex:uk_kid" json:"kid"`
+	Algorithm  string     `gorm:"size:16;not null;default:HS256" json:"algorithm"`
+	Secret     string     `gorm:"type:text;not null" json:"-"`
+	ActivateAt time.Time  `gorm:"not null" json:"activate_at"`
+	RetireAt   *time.Time `gorm:"index:idx_retire_at" json:"retire_at"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (SigningKeyModel) TableName() string {
+	return "jwt_signing_keys"
+}
+
+type signingKeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSigningKeyRepo .
+func NewSigningKeyRepo(data *Data, logger log.Logger) biz.SigningKeyRepo {
+	return &signingKeyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *signingKeyRepo) ListSigningKeys(ctx context.Context) ([]*biz.SigningKey, error) {
+	var models []SigningKeyModel
+	if err := r.data.db.WithContext(ctx).Order("activate_at, id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]*biz.SigningKey, len(models))
+	for i := range models {
+		keys[i] = r.toBiz(&models[i])
+	}
+	return keys, nil
+}
+
+func (r *signingKeyRepo) CreateSigningKey(ctx context.Context, key *biz.SigningKey) error {
+	model := r.toModel(key)
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	key.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// RotateSigningKey 新密钥的 kid 已存在时整个事务回滚，现有密钥的退役时间不变
+func (r *signingKeyRepo) RotateSigningKey(ctx context.Context, key *biz.SigningKey, retireAt time.Time) error {
+	model := r.toModel(key)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&SigningKeyModel{}).
+			Where("retire_at IS NULL").
+			Update("retire_at", retireAt).Error; err != nil {
+			return err
+		}
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		return err
+	}
+	key.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *signingKeyRepo) toModel(key *biz.SigningKey) *SigningKeyModel {
+	return &SigningKeyModel{
+		Kid:        key.KeyID,
+		Algorithm:  key.Algorithm,
+		Secret:     key.Secret,
+		ActivateAt: key.ActivateAt,
+		RetireAt:   key.RetireAt,
+	}
+}
+
+func (r *signingKeyRepo) toBiz(m *SigningKeyModel) *biz.SigningKey {
+	return &biz.SigningKey{
+		KeyID:      m.Kid,
+		Algorithm:  m.Algorithm,
+		Secret:     m.Secret,
+		ActivateAt: m.ActivateAt,
+		RetireAt:   m.RetireAt,
+		CreatedAt:  m.CreatedAt,
+	}
+}
@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningKeyRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewSigningKeyRepo(&Data{db: env.DB.DB}, log.DefaultLogger)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+
+	oldRetireAt := now.Add(time.Hour)
+	require.NoError(t, repo.CreateSigningKey(ctx, &biz.SigningKey{KeyID: "old", Secret: "s0", ActivateAt: now.Add(-48 * time.Hour), RetireAt: &oldRetireAt}))
+	require.NoError(t, repo.CreateSigningKey(ctx, &biz.SigningKey{KeyID: "k1", Secret: "s1", ActivateAt: now.Add(-24 * time.Hour)}))
+
+	retireAt := now.Add(7 * 24 * time.Hour)
+	require.NoError(t, repo.RotateSigningKey(ctx, &biz.SigningKey{KeyID: "k2", Secret: "s2", ActivateAt: now.Add(2 * time.Minute)}, retireAt))
+
+	keys, err := repo.ListSigningKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	assert.Equal(t, []string{"old", "k1", "k2"}, []string{keys[0].KeyID, keys[1].KeyID, keys[2].KeyID})
+	// 已安排退役的密钥不受轮换影响
+	assert.WithinDuration(t, oldRetireAt, *keys[0].RetireAt, time.Millisecond)
+	assert.WithinDuration(t, retireAt, *keys[1].RetireAt, time.Millisecond)
+	assert.Nil(t, keys[2].RetireAt)
+	assert.Equal(t, "s2", keys[2].Secret)
+
+	// kid 重复时整个轮换回滚
+	err = repo.RotateSigningKey(ctx, &biz.SigningKey{KeyID: "k2", Secret: "dup", ActivateAt: now}, now)
+	assert.Error(t, err)
+	keys, err = repo.ListSigningKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	assert.Nil(t, keys[2].RetireAt)
+}
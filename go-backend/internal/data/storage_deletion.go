@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// StorageDeletionModel 待删除存储对象模型
+type StorageDeletionModel struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ObjectName  string    `gorm:"size:500;not null" json:"object_name"`
+	Reason      string    `gorm:"size:32;not null;default:''" json:"reason"`
+	DeleteAfter time.Time `gorm:"not null;index:idx_delete_after" json:"delete_after"`
+	Attempts    int       `gorm:"not null;default:0" json:"attempts"`
+	LastError   string    `gorm:"size:500;not null;default:''" json:"last_error"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (StorageDeletionModel) TableName() string {
+	return "storage_deletions"
+}
+
+type storageDeletionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewStorageDeletionRepo .
+func NewStorageDeletionRepo(data *Data, logger log.Logger) biz.StorageDeletionRepo {
+	return &storageDeletionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *storageDeletionRepo) ScheduleStorageDeletions(ctx context.Context, deletions []*biz.StorageDeletion) error {
+	if len(deletions) == 0 {
+		return nil
+	}
+	return r.data.db.WithContext(ctx).Create(storageDeletionModelsFromBiz(deletions)).Error
+}
+
+func (r *storageDeletionRepo) ListDueStorageDeletions(ctx context.Context, now time.Time, limit int) ([]*biz.StorageDeletion, error) {
+	var models []*StorageDeletionModel
+	if err := r.data.db.WithContext(ctx).
+		Where("delete_after <= ?", now).
+		Order("delete_after ASC, id ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	deletions := make([]*biz.StorageDeletion, 0, len(models))
+	for _, m := range models {
+		deletions = append(deletions, &biz.StorageDeletion{
+			ID:          m.ID,
+			ObjectName:  m.ObjectName,
+			Reason:      m.Reason,
+			DeleteAfter: m.DeleteAfter,
+			Attempts:    m.Attempts,
+			LastError:   m.LastError,
+		})
+	}
+	return deletions, nil
+}
+
+func (r *storageDeletionRepo) DeleteStorageDeletion(ctx context.Context, id int64) error {
+	return r.data.db.WithContext(ctx).Delete(&StorageDeletionModel{}, id).Error
+}
+
+func (r *storageDeletionRepo) RetryStorageDeletion(ctx context.Context, id int64, retryAt time.Time, lastError string) error {
+	return r.data.db.WithContext(ctx).Model(&StorageDeletionModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":     gorm.Expr("attempts + 1"),
+			"delete_after": retryAt,
+			"last_error":   lastError,
+		}).Error
+}
+
+func storageDeletionModelsFromBiz(deletions []*biz.StorageDeletion) []*StorageDeletionModel {
+	models := make([]*StorageDeletionModel, 0, len(deletions))
+	for _, d := range deletions {
+		models = append(models, &StorageDeletionModel{
+			ObjectName:  d.ObjectName,
+			Reason:      d.Reason,
+			DeleteAfter: d.DeleteAfter,
+		})
+	}
+	return models
+}
@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageDeletionRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := NewStorageDeletionRepo(&Data{db: env.DB.DB, rdb: env.Redis.Client}, log.DefaultLogger)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	require.NoError(t, repo.ScheduleStorageDeletions(ctx, []*biz.StorageDeletion{
+		{ObjectName: "exports/1/a.zip", Reason: biz.StorageDeletionReasonDataExport, DeleteAfter: now.Add(time.Hour)},
+		{ObjectName: "hls/1/", Reason: biz.StorageDeletionReasonAccountPurge, DeleteAfter: now.Add(-time.Minute)},
+		{ObjectName: "videos/1.mp4", Reason: biz.StorageDeletionReasonAccountPurge, DeleteAfter: now},
+	}))
+
+	due, err := repo.ListDueStorageDeletions(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, "hls/1/", due[0].ObjectName)
+	assert.Equal(t, "videos/1.mp4", due[1].ObjectName)
+
+	require.NoError(t, repo.RetryStorageDeletion(ctx, due[0].ID, now.Add(10*time.Minute), "timeout"))
+	require.NoError(t, repo.DeleteStorageDeletion(ctx, due[1].ID))
+
+	due, err = repo.ListDueStorageDeletions(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	due, err = repo.ListDueStorageDeletions(ctx, now.Add(10*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, 1, due[0].Attempts)
+	assert.Equal(t, "timeout", due[0].LastError)
+}
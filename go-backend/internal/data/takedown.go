@@ -0,0 +1,328 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/listquery"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// VideoTakedownModel 视频下架记录模型
+type VideoTakedownModel struct {
+	ID           int64             `gorm:"primaryKey;autoIncrement" json:"id"`
+	VideoID      int64             `gorm:"not null;index:idx_video_id" json:"video_id"`
+	AuthorID     int64             `gorm:"not null;index:idx_author_created,priority:1;index:idx_author_legal_hold,priority:1" json:"author_id"`
+	AdminID      int64             `gorm:"not null" json:"admin_id"`
+	Category     string            `gorm:"size:32;not null" json:"category"`
+	Reason       string            `gorm:"size:500;not null" json:"reason"`
+	FromStatus   int32             `gorm:"not null" json:"from_status"`
+	HeldObjects  map[string]string `gorm:"serializer:json;type:json" json:"held_objects"`
+	Status       int32             `gorm:"not null;default:1;index:idx_status_created,priority:1" json:"status"`
+	LegalHold    bool              `gorm:"not null;default:true;index:idx_author_legal_hold,priority:2" json:"legal_hold"`
+	AppealReason string            `gorm:"size:500;not null;default:''" json:"appeal_reason"`
+	AppealedAt   *time.Time        `json:"appealed_at"`
+	DecidedBy    *int64            `json:"decided_by"`
+	DecidedAt    *time.Time        `json:"decided_at"`
+	DecisionNote string            `gorm:"size:500;not null;default:''" json:"decision_note"`
+	CreatedAt    time.Time         `gorm:"autoCreateTime;index:idx_author_created,priority:2;index:idx_status_created,priority:2" json:"created_at"`
+	UpdatedAt    time.Time         `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (VideoTakedownModel) TableName() string {
+	return "video_takedowns"
+}
+
+// TakedownEventModel 下架审计记录模型
+type TakedownEventModel struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	TakedownID int64     `gorm:"not null;index:idx_takedown_created,priority:1" json:"takedown_id"`
+	ActorID    int64     `gorm:"not null" json:"actor_id"`
+	Action     string    `gorm:"size:20;not null" json:"action"`
+	Note       string    `gorm:"size:500;not null;default:''" json:"note"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;index:idx_takedown_created,priority:2" json:"created_at"`
+}
+
+func (TakedownEventModel) TableName() string {
+	return "takedown_events"
+}
+
+type takedownRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	log         *log.Helper
+}
+
+// NewTakedownRepo .
+func NewTakedownRepo(data *Data, invalidator domain.CacheInvalidationPublisher, logger log.Logger) biz.TakedownRepo {
+	return &takedownRepo{
+		data:        data,
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
+	}
+}
+
+func (r *takedownRepo) CreateTakedown(ctx context.Context, takedown *biz.VideoTakedown) (*domain.Video, error) {
+	var video VideoModel
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定视频行，避免与审核、删除或另一次下架并发修改状态
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status NOT IN ?", takedown.VideoID, []int32{domain.VideoStatusDeleted, domain.VideoStatusTakenDown}).
+			First(&video).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return utils.ErrVideoNotFound
+			}
+			return err
+		}
+
+		if err := tx.Model(&VideoModel{}).Where("id = ?", video.ID).
+			Update("status", domain.VideoStatusTakenDown).Error; err != nil {
+			return err
+		}
+
+		model := &VideoTakedownModel{
+			VideoID:    video.ID,
+			AuthorID:   video.AuthorID,
+			AdminID:    takedown.AdminID,
+			Category:   takedown.Category,
+			Reason:     takedown.Reason,
+			FromStatus: video.Status,
+			Status:     biz.TakedownStatusTakenDown,
+			LegalHold:  true,
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		if err := createTakedownEvent(tx, model.ID, takedown.AdminID, biz.TakedownActionTakedown, takedown.Reason); err != nil {
+			return err
+		}
+
+		*takedown = *r.toBiz(model)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeVideo, video.ID),
+		cacheInvalidation(domain.CacheTypeUserVideos, video.AuthorID),
+		cacheInvalidation(domain.CacheTypeFeed),
+	)
+
+	return videoModelToDomain(&video), nil
+}
+
+func (r *takedownRepo) SetHeldObjects(ctx context.Context, id int64, objects map[string]string) error {
+	return r.data.db.WithContext(ctx).Model(&VideoTakedownModel{ID: id}).
+		Update("held_objects", objects).Error
+}
+
+func (r *takedownRepo) GetTakedown(ctx context.Context, id int64) (*biz.VideoTakedown, error) {
+	var model VideoTakedownModel
+	if err := r.data.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrTakedownNotFound
+		}
+		return nil, err
+	}
+	return r.toBiz(&model), nil
+}
+
+func (r *takedownRepo) ListTakedowns(ctx context.Context, filter *biz.TakedownFilter, q *listquery.Compiled) ([]*biz.VideoTakedown, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&VideoTakedownModel{})
+	if filter != nil {
+		if filter.AuthorID != 0 {
+			query = query.Where("author_id = ?", filter.AuthorID)
+		}
+		if filter.Status != nil {
+			query = query.Where("status = ?", *filter.Status)
+		}
+	}
+	query = applyListFilters(query, q)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []VideoTakedownModel
+	if err := applyListPage(query, q).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	takedowns := make([]*biz.VideoTakedown, 0, len(models))
+	for i := range models {
+		takedowns = append(takedowns, r.toBiz(&models[i]))
+	}
+	return takedowns, total, nil
+}
+
+func (r *takedownRepo) AppealTakedown(ctx context.Context, id, authorID int64, reason string) (*biz.VideoTakedown, error) {
+	var model VideoTakedownModel
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := r.lockTakedown(tx, id, &model); err != nil {
+			return err
+		}
+		// 不暴露其他作者的下架记录
+		if model.AuthorID != authorID {
+			return biz.ErrTakedownNotFound
+		}
+		if model.Status != biz.TakedownStatusTakenDown {
+			return biz.ErrTakedownNotAppealable
+		}
+
+		now := time.Now()
+		if err := tx.Model(&model).Updates(map[string]interface{}{
+			"status":        biz.TakedownStatusAppealed,
+			"appeal_reason": reason,
+			"appealed_at":   now,
+		}).Error; err != nil {
+			return err
+		}
+		model.Status = biz.TakedownStatusAppealed
+		model.AppealReason = reason
+		model.AppealedAt = &now
+
+		return createTakedownEvent(tx, id, authorID, biz.TakedownActionAppeal, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.toBiz(&model), nil
+}
+
+func (r *takedownRepo) DecideAppeal(ctx context.Context, id, adminID int64, reinstate bool, note string) (*biz.VideoTakedown, error) {
+	var model VideoTakedownModel
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := r.lockTakedown(tx, id, &model); err != nil {
+			return err
+		}
+		if model.Status != biz.TakedownStatusAppealed {
+			return biz.ErrTakedownNotAppealable
+		}
+
+		status, action := biz.TakedownStatusUpheld, biz.TakedownActionUphold
+		if reinstate {
+			status, action = biz.TakedownStatusReinstated, biz.TakedownActionReinstate
+		}
+
+		// 维持下架时保全继续有效，只有恢复视频才解除
+		now := time.Now()
+		updates := map[string]interface{}{
+			"status":        status,
+			"decided_by":    adminID,
+			"decided_at":    now,
+			"decision_note": note,
+		}
+		if reinstate {
+			updates["legal_hold"] = false
+		}
+		if err := tx.Model(&model).Updates(updates).Error; err != nil {
+			return err
+		}
+		model.Status = status
+		model.DecidedBy = &adminID
+		model.DecidedAt = &now
+		model.DecisionNote = note
+
+		if reinstate {
+			model.LegalHold = false
+			// 下架期间作者可能已删除视频，此时不恢复
+			if err := tx.Model(&VideoModel{}).
+				Where("id = ? AND status = ?", model.VideoID, domain.VideoStatusTakenDown).
+				Update("status", model.FromStatus).Error; err != nil {
+				return err
+			}
+		}
+
+		return createTakedownEvent(tx, id, adminID, action, note)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reinstate {
+		invalidateCache(ctx, r.invalidator, r.log,
+			cacheInvalidation(domain.CacheTypeVideo, model.VideoID),
+			cacheInvalidation(domain.CacheTypeUserVideos, model.AuthorID),
+			cacheInvalidation(domain.CacheTypeFeed),
+		)
+	}
+
+	return r.toBiz(&model), nil
+}
+
+func (r *takedownRepo) ListTakedownEvents(ctx context.Context, takedownID int64) ([]*biz.TakedownEvent, error) {
+	var models []TakedownEventModel
+	if err := r.data.db.WithContext(ctx).
+		Where("takedown_id = ?", takedownID).
+		Order("created_at ASC, id ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]*biz.TakedownEvent, 0, len(models))
+	for _, m := range models {
+		events = append(events, &biz.TakedownEvent{
+			ID:         m.ID,
+			TakedownID: m.TakedownID,
+			ActorID:    m.ActorID,
+			Action:     m.Action,
+			Note:       m.Note,
+			CreatedAt:  m.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+// lockTakedown 锁定下架记录，避免申诉与裁决并发
+func (r *takedownRepo) lockTakedown(tx *gorm.DB, id int64, model *VideoTakedownModel) error {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(model, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return biz.ErrTakedownNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func createTakedownEvent(tx *gorm.DB, takedownID, actorID int64, action, note string) error {
+	return tx.Create(&TakedownEventModel{
+		TakedownID: takedownID,
+		ActorID:    actorID,
+		Action:     action,
+		Note:       note,
+	}).Error
+}
+
+func (r *takedownRepo) toBiz(model *VideoTakedownModel) *biz.VideoTakedown {
+	takedown := &biz.VideoTakedown{
+		ID:           model.ID,
+		VideoID:      model.VideoID,
+		AuthorID:     model.AuthorID,
+		AdminID:      model.AdminID,
+		Category:     model.Category,
+		Reason:       model.Reason,
+		FromStatus:   model.FromStatus,
+		HeldObjects:  model.HeldObjects,
+		Status:       model.Status,
+		LegalHold:    model.LegalHold,
+		AppealReason: model.AppealReason,
+		AppealedAt:   model.AppealedAt,
+		DecidedAt:    model.DecidedAt,
+		DecisionNote: model.DecisionNote,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+	if model.DecidedBy != nil {
+		takedown.DecidedBy = *model.DecidedBy
+	}
+	return takedown
+}
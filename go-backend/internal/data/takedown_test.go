@@ -0,0 +1,169 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/pkg/utils"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTakedownRepo(t *testing.T) (*takedownRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+
+	repo := &takedownRepo{
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+func TestTakedownRepo(t *testing.T) {
+	repo, env, cleanup := setupTakedownRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(3)
+	require.NoError(t, err)
+	author, admin, other := users[0], users[1], users[2]
+
+	videoStatus := func(t *testing.T, videoID int64) int32 {
+		var model VideoModel
+		require.NoError(t, repo.data.db.First(&model, videoID).Error)
+		return model.Status
+	}
+
+	t.Run("CreateTakedown", func(t *testing.T) {
+		video := createFavoriteTestVideo(t, repo.data, author.ID)
+
+		takedown := &biz.VideoTakedown{VideoID: video.ID, AdminID: admin.ID, Category: "copyright", Reason: "reported by rights holder"}
+		result, err := repo.CreateTakedown(ctx, takedown)
+		require.NoError(t, err)
+		assert.Equal(t, video.ID, result.ID)
+		assert.NotZero(t, takedown.ID)
+		assert.Equal(t, author.ID, takedown.AuthorID)
+		assert.Equal(t, int32(domain.VideoStatusPublished), takedown.FromStatus)
+		assert.True(t, takedown.LegalHold)
+		assert.Equal(t, int32(domain.VideoStatusTakenDown), videoStatus(t, video.ID))
+
+		// 已下架的视频不能重复下架
+		_, err = repo.CreateTakedown(ctx, &biz.VideoTakedown{VideoID: video.ID, AdminID: admin.ID, Category: "spam", Reason: "again"})
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+
+		held := map[string]string{"videos/a.mp4": "legal-hold/videos/a.mp4"}
+		require.NoError(t, repo.SetHeldObjects(ctx, takedown.ID, held))
+		got, err := repo.GetTakedown(ctx, takedown.ID)
+		require.NoError(t, err)
+		assert.Equal(t, held, got.HeldObjects)
+	})
+
+	t.Run("AppealAndReinstate", func(t *testing.T) {
+		video := createFavoriteTestVideo(t, repo.data, author.ID)
+		takedown := &biz.VideoTakedown{VideoID: video.ID, AdminID: admin.ID, Category: "violence", Reason: "graphic content"}
+		_, err := repo.CreateTakedown(ctx, takedown)
+		require.NoError(t, err)
+
+		// 还没申诉时不能裁决
+		_, err = repo.DecideAppeal(ctx, takedown.ID, admin.ID, true, "")
+		assert.Equal(t, biz.ErrTakedownNotAppealable, err)
+
+		// 其他用户看不到这条下架记录
+		_, err = repo.AppealTakedown(ctx, takedown.ID, other.ID, "not mine")
+		assert.Equal(t, biz.ErrTakedownNotFound, err)
+
+		appealed, err := repo.AppealTakedown(ctx, takedown.ID, author.ID, "it is a movie clip")
+		require.NoError(t, err)
+		assert.Equal(t, biz.TakedownStatusAppealed, appealed.Status)
+		assert.NotNil(t, appealed.AppealedAt)
+
+		// 每条下架记录只能申诉一次
+		_, err = repo.AppealTakedown(ctx, takedown.ID, author.ID, "again")
+		assert.Equal(t, biz.ErrTakedownNotAppealable, err)
+
+		decided, err := repo.DecideAppeal(ctx, takedown.ID, admin.ID, true, "fictional content")
+		require.NoError(t, err)
+		assert.Equal(t, biz.TakedownStatusReinstated, decided.Status)
+		assert.False(t, decided.LegalHold)
+		assert.Equal(t, admin.ID, decided.DecidedBy)
+		assert.Equal(t, int32(domain.VideoStatusPublished), videoStatus(t, video.ID))
+
+		events, err := repo.ListTakedownEvents(ctx, takedown.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, biz.TakedownActionTakedown, events[0].Action)
+		assert.Equal(t, biz.TakedownActionAppeal, events[1].Action)
+		assert.Equal(t, author.ID, events[1].ActorID)
+		assert.Equal(t, biz.TakedownActionReinstate, events[2].Action)
+		assert.Equal(t, "fictional content", events[2].Note)
+	})
+
+	t.Run("Uphold", func(t *testing.T) {
+		video := createFavoriteTestVideo(t, repo.data, author.ID)
+		takedown := &biz.VideoTakedown{VideoID: video.ID, AdminID: admin.ID, Category: "illegal", Reason: "illegal goods"}
+		_, err := repo.CreateTakedown(ctx, takedown)
+		require.NoError(t, err)
+		_, err = repo.AppealTakedown(ctx, takedown.ID, author.ID, "please review")
+		require.NoError(t, err)
+
+		decided, err := repo.DecideAppeal(ctx, takedown.ID, admin.ID, false, "")
+		require.NoError(t, err)
+		assert.Equal(t, biz.TakedownStatusUpheld, decided.Status)
+		assert.True(t, decided.LegalHold)
+		assert.Equal(t, int32(domain.VideoStatusTakenDown), videoStatus(t, video.ID))
+	})
+
+	t.Run("ListTakedowns", func(t *testing.T) {
+		all, total, err := repo.ListTakedowns(ctx, &biz.TakedownFilter{AuthorID: author.ID}, mustCompileListQuery(t, biz.TakedownListSchema, "", "", 10))
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, all, 3)
+		assert.Equal(t, "illegal", all[0].Category)
+
+		status := biz.TakedownStatusReinstated
+		reinstated, total, err := repo.ListTakedowns(ctx, &biz.TakedownFilter{Status: &status}, mustCompileListQuery(t, biz.TakedownListSchema, "", "", 10))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, "violence", reinstated[0].Category)
+
+		_, total, err = repo.ListTakedowns(ctx, &biz.TakedownFilter{AuthorID: other.ID}, mustCompileListQuery(t, biz.TakedownListSchema, "", "", 10))
+		require.NoError(t, err)
+		assert.Zero(t, total)
+
+		held, total, err := repo.ListTakedowns(ctx, &biz.TakedownFilter{AuthorID: author.ID},
+			mustCompileListQuery(t, biz.TakedownListSchema, "legal_hold:eq:true;category:ne:spam", "created_at", 10))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, held, 2)
+		assert.Equal(t, "copyright", held[0].Category)
+		assert.Equal(t, "illegal", held[1].Category)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := repo.GetTakedown(ctx, 999999)
+		assert.Equal(t, biz.ErrTakedownNotFound, err)
+
+		_, err = repo.CreateTakedown(ctx, &biz.VideoTakedown{VideoID: 999999, AdminID: admin.ID, Category: "spam", Reason: "spam"})
+		assert.Equal(t, utils.ErrVideoNotFound, err)
+	})
+}
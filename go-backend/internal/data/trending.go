@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// trendingKey 合并后的热门榜
+const trendingKey = "trending:videos"
+
+type trendingRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTrendingRepo .
+func NewTrendingRepo(data *Data, logger log.Logger) biz.TrendingRepo {
+	return &trendingRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Incr 热度累加在按时间桶编号命名的有序集合中
+func (r *trendingRepo) Incr(ctx context.Context, bucket int64, videoID int64, score float64, ttl time.Duration) error {
+	key := trendingBucketKey(bucket)
+	_, err := r.data.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZIncrBy(ctx, key, score, strconv.FormatInt(videoID, 10))
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+// Rebuild 用 ZUNIONSTORE 按权重直接覆盖热门榜，再裁剪到 size 个。两步在同一事务中执行，
+// 读取方不会看到未裁剪的榜单；所有桶都不存在时热门榜被清空
+func (r *trendingRepo) Rebuild(ctx context.Context, buckets []int64, weights []float64, size int) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(buckets))
+	for i, bucket := range buckets {
+		keys[i] = trendingBucketKey(bucket)
+	}
+
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZUnionStore(ctx, trendingKey, &redis.ZStore{
+			Keys:    keys,
+			Weights: weights,
+		})
+		// 按分数升序排名，删除排在前 size 名之外的低分视频
+		pipe.ZRemRangeByRank(ctx, trendingKey, 0, int64(-size-1))
+		return nil
+	})
+	return err
+}
+
+func (r *trendingRepo) Top(ctx context.Context, limit int) ([]int64, error) {
+	members, err := r.data.rdb.ZRevRange(ctx, trendingKey, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			r.log.WithContext(ctx).Warnf("invalid trending member %q", member)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func trendingBucketKey(bucket int64) string {
+	return fmt.Sprintf("trending:bucket:%d", bucket)
+}
@@ -0,0 +1,39 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendingRepo(t *testing.T) {
+	favorite, _, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := NewTrendingRepo(favorite.data, log.DefaultLogger)
+	ctx := context.Background()
+	favorite.data.rdb.Del(ctx, trendingKey, trendingBucketKey(1), trendingBucketKey(2))
+
+	// 视频1的热度集中在较早的桶，衰减后低于视频2
+	require.NoError(t, repo.Incr(ctx, 1, 1, 10, time.Hour))
+	require.NoError(t, repo.Incr(ctx, 2, 2, 6, time.Hour))
+	require.NoError(t, repo.Incr(ctx, 2, 3, 1, time.Hour))
+	require.NoError(t, repo.Incr(ctx, 2, 3, 1, time.Hour))
+
+	ttl := favorite.data.rdb.TTL(ctx, trendingBucketKey(2)).Val()
+	assert.True(t, ttl > 0 && ttl <= time.Hour)
+
+	require.NoError(t, repo.Rebuild(ctx, []int64{2, 1}, []float64{1, 0.5}, 2))
+
+	ids, err := repo.Top(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 1}, ids)
+
+	top, err := repo.Top(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, top)
+}
@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	uploadChecksumKeyPrefix = "upload:checksum:"
+	// uploadChecksumTTL 校验记录的保留时间，每次上传分片时续期，覆盖未完成上传的存活期
+	uploadChecksumTTL = 24 * time.Hour
+)
+
+type uploadChecksumRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUploadChecksumRepo .
+func NewUploadChecksumRepo(data *Data, logger log.Logger) biz.UploadChecksumRepo {
+	return &uploadChecksumRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SavePartChecksum 以分片号为字段写入 Redis 哈希
+func (r *uploadChecksumRepo) SavePartChecksum(ctx context.Context, uploadID string, part *biz.PartChecksum) error {
+	value, err := json.Marshal(part)
+	if err != nil {
+		return err
+	}
+
+	key := uploadChecksumKey(uploadID)
+	_, err = r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, strconv.Itoa(part.PartNumber), value)
+		pipe.Expire(ctx, key, uploadChecksumTTL)
+		return nil
+	})
+	return err
+}
+
+func (r *uploadChecksumRepo) ListPartChecksums(ctx context.Context, uploadID string) ([]*biz.PartChecksum, error) {
+	values, err := r.data.rdb.HGetAll(ctx, uploadChecksumKey(uploadID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*biz.PartChecksum, 0, len(values))
+	for field, value := range values {
+		var part biz.PartChecksum
+		if err := json.Unmarshal([]byte(value), &part); err != nil {
+			r.log.WithContext(ctx).Warnf("skip invalid part checksum: upload=%s part=%s err=%v", uploadID, field, err)
+			continue
+		}
+		parts = append(parts, &part)
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].PartNumber < parts[j].PartNumber
+	})
+	return parts, nil
+}
+
+func (r *uploadChecksumRepo) DeleteUploadChecksums(ctx context.Context, uploadID string) error {
+	return r.data.rdb.Del(ctx, uploadChecksumKey(uploadID)).Err()
+}
+
+func uploadChecksumKey(uploadID string) string {
+	return uploadChecksumKeyPrefix + uploadID
+}
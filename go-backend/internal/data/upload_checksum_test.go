@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/internal/biz"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadChecksumRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := &uploadChecksumRepo{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	require.NoError(t, repo.SavePartChecksum(ctx, "upload-1", &biz.PartChecksum{PartNumber: 10, Status: biz.PartChecksumStatusVerified}))
+	require.NoError(t, repo.SavePartChecksum(ctx, "upload-1", &biz.PartChecksum{PartNumber: 2, Status: biz.PartChecksumStatusMismatch}))
+	// 重传覆盖之前的记录
+	require.NoError(t, repo.SavePartChecksum(ctx, "upload-1", &biz.PartChecksum{PartNumber: 2, Status: biz.PartChecksumStatusVerified, Actual: "ab"}))
+
+	parts, err := repo.ListPartChecksums(ctx, "upload-1")
+	require.NoError(t, err)
+	require.Len(t, parts, 2)
+	assert.Equal(t, 2, parts[0].PartNumber)
+	assert.Equal(t, "ab", parts[0].Actual)
+	assert.Equal(t, 10, parts[1].PartNumber)
+
+	ttl, err := env.Redis.Client.TTL(ctx, uploadChecksumKey("upload-1")).Result()
+	require.NoError(t, err)
+	assert.Greater(t, ttl.Seconds(), float64(0))
+
+	require.NoError(t, repo.DeleteUploadChecksums(ctx, "upload-1"))
+	parts, err = repo.ListPartChecksums(ctx, "upload-1")
+	require.NoError(t, err)
+	assert.Empty(t, parts)
+}
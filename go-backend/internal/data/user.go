@@ -2,14 +2,17 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"go-backend/internal/biz"
 	"go-backend/internal/data/cache"
+	"go-backend/internal/domain"
 	"go-backend/pkg/auth"
 
 	"github.com/go-kratos/kratos/v2/log"
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
 )
 
@@ -23,6 +26,10 @@ type User struct {
 	Avatar          string     `gorm:"size:255" json:"avatar"`
 	BackgroundImage string     `gorm:"size:255" json:"background_image"`
 	Signature       string     `gorm:"size:200" json:"signature"`
+	Email           *string    `gorm:"size:128;uniqueIndex" json:"email"`
+	Phone           *string    `gorm:"size:20;uniqueIndex" json:"phone"`
+	Timezone        string     `gorm:"size:64;default:UTC" json:"timezone"`
+	IsPrivate       bool       `gorm:"default:false" json:"is_private"`
 	FollowCount     int        `gorm:"default:0" json:"follow_count"`
 	FollowerCount   int        `gorm:"default:0" json:"follower_count"`
 	TotalFavorited  int64      `gorm:"default:0" json:"total_favorited"`
@@ -30,8 +37,15 @@ type User struct {
 	FavoriteCount   int        `gorm:"default:0" json:"favorite_count"`
 	Status          int8       `gorm:"default:1" json:"status"`
 	LastLoginAt     *time.Time `gorm:"column:last_login_at" json:"last_login_at"`
-	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+	// DeletionRequestedAt/DeletionScheduledAt 注销冷静期的申请时间和清除时间，仅 status=3 时有值
+	DeletionRequestedAt *time.Time `gorm:"column:deletion_requested_at" json:"-"`
+	DeletionScheduledAt *time.Time `gorm:"column:deletion_scheduled_at;index" json:"-"`
+	// BannedAt/BannedBy/BanReason 封禁时间、执行封禁的管理员和原因，仅 status=4 时有值
+	BannedAt  *time.Time `gorm:"column:banned_at" json:"-"`
+	BannedBy  *int64     `gorm:"column:banned_by" json:"-"`
+	BanReason *string    `gorm:"column:ban_reason;size:500" json:"-"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
 }
 
 func (User) TableName() string {
@@ -42,15 +56,17 @@ type userRepo struct {
 	data        *Data
 	log         *log.Helper
 	userCache   *cache.UserCache
+	invalidator domain.CacheInvalidationPublisher
 	passwordMgr *auth.PasswordManager
 }
 
 // NewUserRepo .
-func NewUserRepo(data *Data, userCache *cache.UserCache, passwordMgr *auth.PasswordManager, logger log.Logger) biz.UserRepo {
+func NewUserRepo(data *Data, userCache *cache.UserCache, invalidator domain.CacheInvalidationPublisher, passwordMgr *auth.PasswordManager, logger log.Logger) biz.UserRepo {
 	return &userRepo{
 		data:        data,
 		log:         log.NewHelper(logger),
 		userCache:   userCache,
+		invalidator: invalidator,
 		passwordMgr: passwordMgr,
 	}
 }
@@ -70,6 +86,7 @@ func (r *userRepo) CreateUser(ctx context.Context, user *biz.User) (*biz.User, e
 		Avatar:          user.Avatar,
 		BackgroundImage: user.BackgroundImage,
 		Signature:       user.Signature,
+		Timezone:        user.Timezone,
 		Status:          1,
 	}
 
@@ -165,9 +182,14 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) error {
 		"avatar":           user.Avatar,
 		"background_image": user.BackgroundImage,
 		"signature":        user.Signature,
+		"is_private":       user.IsPrivate,
 		"updated_at":       time.Now(),
 	}
 
+	if user.Timezone != "" {
+		updates["timezone"] = user.Timezone
+	}
+
 	if user.LastLoginAt != nil {
 		updates["last_login_at"] = user.LastLoginAt
 	}
@@ -176,8 +198,7 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) error {
 		return err
 	}
 
-	// 删除缓存
-	r.userCache.DeleteUser(ctx, user.ID)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeUser, user.ID))
 
 	return nil
 }
@@ -207,8 +228,75 @@ func (r *userRepo) UpdateUserStats(ctx context.Context, userID int64, stats *biz
 		return err
 	}
 
-	// 删除缓存
-	r.userCache.DeleteUser(ctx, userID)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeUser, userID))
+
+	return nil
+}
+
+func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User, error) {
+	var u User
+	if err := r.data.db.WithContext(ctx).Where("email = ? AND status = 1", email).First(&u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return r.convertToUser(&u), nil
+}
+
+func (r *userRepo) GetUserByPhone(ctx context.Context, phone string) (*biz.User, error) {
+	var u User
+	if err := r.data.db.WithContext(ctx).Where("phone = ? AND status = 1", phone).First(&u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return r.convertToUser(&u), nil
+}
+
+func (r *userRepo) UpdateEmail(ctx context.Context, userID int64, email string) error {
+	result := r.data.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"email":      email,
+		"updated_at": time.Now(),
+	})
+	if result.Error != nil {
+		// 唯一索引兜底并发绑定同一邮箱
+		if isDuplicateKeyError(result.Error) {
+			return biz.ErrEmailTaken
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrUserNotFound
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeUser, userID))
+
+	return nil
+}
+
+func (r *userRepo) UpdatePassword(ctx context.Context, userID int64, password string) error {
+	hash, salt, err := r.passwordMgr.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("hash password failed: %w", err)
+	}
+
+	result := r.data.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"password_hash": hash,
+		"salt":          salt,
+		"updated_at":    time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrUserNotFound
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeUser, userID))
 
 	return nil
 }
@@ -236,6 +324,11 @@ func (r *userRepo) VerifyPassword(ctx context.Context, username, password string
 
 // convertToUser 转换为业务模型
 func (r *userRepo) convertToUser(u *User) *biz.User {
+	return userModelToBiz(u)
+}
+
+// userModelToBiz 用户模型转业务模型，供其他仓储复用
+func userModelToBiz(u *User) *biz.User {
 	return &biz.User{
 		ID:              u.ID,
 		Username:        u.Username,
@@ -245,13 +338,31 @@ func (r *userRepo) convertToUser(u *User) *biz.User {
 		Avatar:          u.Avatar,
 		BackgroundImage: u.BackgroundImage,
 		Signature:       u.Signature,
+		Email:           stringValue(u.Email),
+		Phone:           stringValue(u.Phone),
+		Timezone:        u.Timezone,
+		IsPrivate:       u.IsPrivate,
 		FollowCount:     u.FollowCount,
 		FollowerCount:   u.FollowerCount,
 		TotalFavorited:  u.TotalFavorited,
 		WorkCount:       u.WorkCount,
 		FavoriteCount:   u.FavoriteCount,
+		Status:          u.Status,
 		LastLoginAt:     u.LastLoginAt,
 		CreatedAt:       u.CreatedAt,
 		UpdatedAt:       u.UpdatedAt,
 	}
 }
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// isDuplicateKeyError 判断是否为MySQL唯一键冲突
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysqldriver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
@@ -0,0 +1,146 @@
+package data
+
+import (
+	"context"
+	"strconv"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// userBannedKeyPrefix 封禁标记，不设过期时间，解封时删除。
+// 标记丢失时已封禁的账号仍无法登录，会话也已删除，影响限于未过期的访问令牌
+const userBannedKeyPrefix = "user_banned:"
+
+type userBanRepo struct {
+	data        *Data
+	sessions    *sessionManager
+	invalidator domain.CacheInvalidationPublisher
+	log         *log.Helper
+}
+
+// NewUserBanRepo .
+func NewUserBanRepo(data *Data, invalidator domain.CacheInvalidationPublisher, clk clock.Clock, logger log.Logger) biz.UserBanRepo {
+	return &userBanRepo{
+		data:        data,
+		sessions:    &sessionManager{data: data, clock: clk, log: log.NewHelper(logger)},
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// BanUser 先写入封禁标记再更新数据库，事务提交前认证中间件即开始拒绝该用户，
+// 事务失败时撤回标记
+func (r *userBanRepo) BanUser(ctx context.Context, ban *biz.UserBan) error {
+	if err := r.data.rdb.Set(ctx, userBannedKey(ban.UserID), 1, 0).Err(); err != nil {
+		return err
+	}
+
+	var videoIDs []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&User{}).
+			Where("id = ? AND status = ?", ban.UserID, domain.UserStatusActive).
+			Updates(map[string]interface{}{
+				"status":     domain.UserStatusBanned,
+				"banned_at":  ban.BannedAt,
+				"banned_by":  ban.AdminID,
+				"ban_reason": ban.Reason,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return r.statusError(tx, ban.UserID)
+		}
+
+		if err := tx.Where("user_id = ?", ban.UserID).Delete(&UserSession{}).Error; err != nil {
+			return err
+		}
+		return swapUserContentStatus(tx, ban.UserID,
+			domain.VideoStatusPublished, domain.VideoStatusHidden,
+			biz.CommentStatusNormal, biz.CommentStatusHidden, &videoIDs)
+	})
+	if err != nil {
+		// 已封禁的账号保留标记，重复封禁可以修复丢失的标记
+		if err != biz.ErrUserAlreadyBanned {
+			if derr := r.data.rdb.Del(ctx, userBannedKey(ban.UserID)).Err(); derr != nil {
+				r.log.WithContext(ctx).Errorf("rollback ban marker failed: user=%d err=%v", ban.UserID, derr)
+			}
+		}
+		return err
+	}
+
+	// 刷新令牌同样会被封禁标记拦截，清除缓存的会话失败不影响封禁生效
+	if err := r.sessions.evictSession(ctx, ban.UserID); err != nil {
+		r.log.WithContext(ctx).Warnf("evict banned user session failed: user=%d err=%v", ban.UserID, err)
+	}
+	invalidateUserContent(ctx, r.invalidator, r.log, ban.UserID, videoIDs)
+	return nil
+}
+
+// UnbanUser 恢复账号和被隐藏的内容后删除封禁标记。账号不是封禁状态时同样删除标记，
+// 便于修复数据库已解封而标记删除失败的情况
+func (r *userBanRepo) UnbanUser(ctx context.Context, userID int64) error {
+	var videoIDs []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&User{}).
+			Where("id = ? AND status = ?", userID, domain.UserStatusBanned).
+			Updates(map[string]interface{}{
+				"status":     domain.UserStatusActive,
+				"banned_at":  nil,
+				"banned_by":  nil,
+				"ban_reason": nil,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrUserNotBanned
+		}
+
+		return swapUserContentStatus(tx, userID,
+			domain.VideoStatusHidden, domain.VideoStatusPublished,
+			biz.CommentStatusHidden, biz.CommentStatusNormal, &videoIDs)
+	})
+	if err != nil && err != biz.ErrUserNotBanned {
+		return err
+	}
+
+	if derr := r.data.rdb.Del(ctx, userBannedKey(userID)).Err(); derr != nil {
+		return derr
+	}
+	if err != nil {
+		return err
+	}
+
+	invalidateUserContent(ctx, r.invalidator, r.log, userID, videoIDs)
+	return nil
+}
+
+func (r *userBanRepo) IsUserBanned(ctx context.Context, userID int64) (bool, error) {
+	n, err := r.data.rdb.Exists(ctx, userBannedKey(userID)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// statusError 区分封禁失败的原因：账号已封禁，或不存在、不是正常状态
+func (r *userBanRepo) statusError(tx *gorm.DB, userID int64) error {
+	var statuses []int8
+	if err := tx.Model(&User{}).Where("id = ?", userID).Pluck("status", &statuses).Error; err != nil {
+		return err
+	}
+	if len(statuses) > 0 && statuses[0] == int8(domain.UserStatusBanned) {
+		return biz.ErrUserAlreadyBanned
+	}
+	return biz.ErrUserNotFound
+}
+
+func userBannedKey(userID int64) string {
+	return userBannedKeyPrefix + strconv.FormatInt(userID, 10)
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/pkg/clock"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserBanRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	data := &Data{db: env.DB.DB, rdb: env.Redis.Client}
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{EnableL2: true})
+	repo := NewUserBanRepo(data, newTestCacheInvalidationPublisher(data, multiCache), clock.New(), log.DefaultLogger)
+	ctx := context.Background()
+
+	fixture, err := env.DataManager.CreateUser(
+		testutils.WithVideos(2, domain.VideoStatusPublished),
+		testutils.WithVideos(1, domain.VideoStatusPrivate),
+	)
+	require.NoError(t, err)
+	user := fixture.User
+	require.NoError(t, env.DB.DB.Create(&UserSession{
+		UserID: user.ID, RefreshToken: "refresh", FamilyID: "family", ExpiresAt: time.Now().Add(time.Hour),
+	}).Error)
+
+	videoStatuses := func() map[int32]int {
+		var statuses []int32
+		require.NoError(t, env.DB.DB.Model(&VideoModel{}).Where("author_id = ?", user.ID).Pluck("status", &statuses).Error)
+		counts := make(map[int32]int)
+		for _, s := range statuses {
+			counts[s]++
+		}
+		return counts
+	}
+
+	ban := &biz.UserBan{UserID: user.ID, AdminID: 1, Reason: "spam", BannedAt: time.Now()}
+	require.NoError(t, repo.BanUser(ctx, ban))
+
+	banned, err := repo.IsUserBanned(ctx, user.ID)
+	require.NoError(t, err)
+	assert.True(t, banned)
+
+	var u User
+	require.NoError(t, env.DB.DB.First(&u, user.ID).Error)
+	assert.Equal(t, int8(domain.UserStatusBanned), u.Status)
+	require.NotNil(t, u.BanReason)
+	assert.Equal(t, "spam", *u.BanReason)
+	assert.Equal(t, map[int32]int{domain.VideoStatusHidden: 2, domain.VideoStatusPrivate: 1}, videoStatuses())
+
+	var sessions int64
+	require.NoError(t, env.DB.DB.Model(&UserSession{}).Where("user_id = ?", user.ID).Count(&sessions).Error)
+	assert.Zero(t, sessions)
+
+	assert.Equal(t, biz.ErrUserAlreadyBanned, repo.BanUser(ctx, ban))
+
+	// 不存在的用户封禁失败时撤回标记
+	assert.Equal(t, biz.ErrUserNotFound, repo.BanUser(ctx, &biz.UserBan{UserID: user.ID + 1000, AdminID: 1, Reason: "spam", BannedAt: time.Now()}))
+	banned, err = repo.IsUserBanned(ctx, user.ID+1000)
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	require.NoError(t, repo.UnbanUser(ctx, user.ID))
+	banned, err = repo.IsUserBanned(ctx, user.ID)
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	require.NoError(t, env.DB.DB.First(&u, user.ID).Error)
+	assert.Equal(t, int8(domain.UserStatusActive), u.Status)
+	assert.Nil(t, u.BanReason)
+	assert.Equal(t, map[int32]int{domain.VideoStatusPublished: 2, domain.VideoStatusPrivate: 1}, videoStatuses())
+
+	assert.Equal(t, biz.ErrUserNotBanned, repo.UnbanUser(ctx, user.ID))
+}
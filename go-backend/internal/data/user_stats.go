@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userStatsMetrics 允许累加的统计列，列名拼入SQL前必须在此列表中
+var userStatsMetrics = map[string]bool{
+	biz.UserStatNewFollowers:  true,
+	biz.UserStatLostFollowers: true,
+	biz.UserStatVideoPlays:    true,
+	biz.UserStatLikesReceived: true,
+}
+
+// UserStatsDailyModel 用户日统计模型
+type UserStatsDailyModel struct {
+	UserID        int64     `gorm:"primaryKey"`
+	StatDate      time.Time `gorm:"primaryKey;type:date"`
+	NewFollowers  int64     `gorm:"not null;default:0"`
+	LostFollowers int64     `gorm:"not null;default:0"`
+	VideoPlays    int64     `gorm:"not null;default:0"`
+	LikesReceived int64     `gorm:"not null;default:0"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+func (UserStatsDailyModel) TableName() string {
+	return "user_stats_daily"
+}
+
+type userStatsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUserStatsRepo .
+func NewUserStatsRepo(data *Data, logger log.Logger) biz.UserStatsRepo {
+	return &userStatsRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// IncrDaily 以 (user_id, stat_date) 主键插入，冲突时在原值上累加
+func (r *userStatsRepo) IncrDaily(ctx context.Context, userID int64, date time.Time, metric string, delta int64) error {
+	if !userStatsMetrics[metric] {
+		return fmt.Errorf("unknown user stats metric %q", metric)
+	}
+
+	row := map[string]interface{}{
+		"user_id":   userID,
+		"stat_date": date.Format("2006-01-02"),
+		metric:      delta,
+	}
+	return r.data.db.WithContext(ctx).Model(&UserStatsDailyModel{}).
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				metric: gorm.Expr(metric+" + ?", delta),
+			}),
+		}).
+		Create(row).Error
+}
+
+func (r *userStatsRepo) ListDaily(ctx context.Context, userID int64, start, end time.Time) ([]*biz.UserDailyStats, error) {
+	var models []UserStatsDailyModel
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ? AND stat_date BETWEEN ? AND ?", userID, start.Format("2006-01-02"), end.Format("2006-01-02")).
+		Order("stat_date").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.UserDailyStats, len(models))
+	for i, m := range models {
+		// DATE 列按连接的时区解析，取其年月日还原为UTC日期
+		y, mo, d := m.StatDate.Date()
+		result[i] = &biz.UserDailyStats{
+			UserID:        m.UserID,
+			Date:          time.Date(y, mo, d, 0, 0, 0, 0, time.UTC),
+			NewFollowers:  m.NewFollowers,
+			LostFollowers: m.LostFollowers,
+			VideoPlays:    m.VideoPlays,
+			LikesReceived: m.LikesReceived,
+		}
+	}
+	return result, nil
+}
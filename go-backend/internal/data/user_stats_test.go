@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserStatsRepo(t *testing.T) {
+	favorite, _, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := NewUserStatsRepo(favorite.data, log.DefaultLogger)
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("IncrAndList", func(t *testing.T) {
+		require.NoError(t, repo.IncrDaily(ctx, 1, day(1), biz.UserStatNewFollowers, 1))
+		require.NoError(t, repo.IncrDaily(ctx, 1, day(1), biz.UserStatNewFollowers, 2))
+		require.NoError(t, repo.IncrDaily(ctx, 1, day(1), biz.UserStatVideoPlays, 10))
+		require.NoError(t, repo.IncrDaily(ctx, 1, day(3), biz.UserStatLikesReceived, 4))
+		require.NoError(t, repo.IncrDaily(ctx, 2, day(1), biz.UserStatNewFollowers, 7))
+
+		rows, err := repo.ListDaily(ctx, 1, day(1), day(3))
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, day(1), rows[0].Date)
+		assert.Equal(t, int64(3), rows[0].NewFollowers)
+		assert.Equal(t, int64(10), rows[0].VideoPlays)
+		assert.Equal(t, day(3), rows[1].Date)
+		assert.Equal(t, int64(4), rows[1].LikesReceived)
+
+		rows, err = repo.ListDaily(ctx, 1, day(2), day(2))
+		require.NoError(t, err)
+		assert.Empty(t, rows)
+	})
+
+	t.Run("RejectUnknownMetric", func(t *testing.T) {
+		assert.Error(t, repo.IncrDaily(ctx, 1, day(1), "user_id", 1))
+	})
+}
@@ -37,6 +37,7 @@ func setupUserRepo(t *testing.T) (*userRepo, *testutils.TestEnv, func()) {
 		data:        data,
 		log:         log.NewHelper(log.DefaultLogger),
 		userCache:   userCache,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
 		passwordMgr: passwordMgr,
 	}
 
@@ -169,6 +170,7 @@ func TestUserRepo_UpdateUser(t *testing.T) {
 	// 更新用户信息
 	user.Nickname = "Updated Nickname"
 	user.Signature = "Updated signature"
+	user.Timezone = "Asia/Shanghai"
 
 	err = repo.UpdateUser(ctx, user)
 	require.NoError(t, err)
@@ -178,6 +180,7 @@ func TestUserRepo_UpdateUser(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Updated Nickname", updated.Nickname)
 	assert.Equal(t, "Updated signature", updated.Signature)
+	assert.Equal(t, "Asia/Shanghai", updated.Timezone)
 }
 
 func TestUserRepo_UpdateUserStats(t *testing.T) {
@@ -242,3 +245,57 @@ func TestUserRepo_VerifyPassword(t *testing.T) {
 	_, err = repo.VerifyPassword(ctx, "nonexistent", "password123!")
 	assert.Equal(t, biz.ErrUserNotFound, err)
 }
+
+func TestUserRepo_UpdatePassword(t *testing.T) {
+	repo, _, cleanup := setupUserRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	created, err := repo.CreateUser(ctx, &biz.User{
+		Username:     "testuser",
+		PasswordHash: "password123!",
+		Nickname:     "Test User",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.UpdatePassword(ctx, created.ID, "newpassword456!"))
+
+	_, err = repo.VerifyPassword(ctx, created.Username, "password123!")
+	assert.Equal(t, biz.ErrPasswordError, err)
+
+	verified, err := repo.VerifyPassword(ctx, created.Username, "newpassword456!")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, verified.ID)
+
+	assert.Equal(t, biz.ErrUserNotFound, repo.UpdatePassword(ctx, created.ID+1000, "newpassword456!"))
+}
+
+func TestUserRepo_UpdateEmail(t *testing.T) {
+	repo, _, cleanup := setupUserRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	alice, err := repo.CreateUser(ctx, &biz.User{Username: "alice", PasswordHash: "password123!"})
+	require.NoError(t, err)
+	bob, err := repo.CreateUser(ctx, &biz.User{Username: "bob", PasswordHash: "password123!"})
+	require.NoError(t, err)
+
+	_, err = repo.GetUserByEmail(ctx, "alice@example.com")
+	assert.Equal(t, biz.ErrUserNotFound, err)
+
+	require.NoError(t, repo.UpdateEmail(ctx, alice.ID, "alice@example.com"))
+
+	found, err := repo.GetUserByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, found.ID)
+	assert.Equal(t, "alice@example.com", found.Email)
+
+	// 邮箱被其他账号占用
+	assert.Equal(t, biz.ErrEmailTaken, repo.UpdateEmail(ctx, bob.ID, "alice@example.com"))
+
+	unbound, err := repo.GetUser(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Empty(t, unbound.Email)
+}
@@ -17,17 +17,32 @@ import (
 
 // VideoModel 视频数据模型
 type VideoModel struct {
-	ID            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
-	AuthorID      int64     `gorm:"not null;index:idx_author_created" json:"author_id"`
-	Title         string    `gorm:"size:255;not null" json:"title"`
-	PlayURL       string    `gorm:"size:500;not null" json:"play_url"`
-	CoverURL      string    `gorm:"size:500" json:"cover_url"`
-	FavoriteCount int64     `gorm:"default:0" json:"favorite_count"`
-	CommentCount  int64     `gorm:"default:0" json:"comment_count"`
-	PlayCount     int64     `gorm:"default:0" json:"play_count"`
-	Status        int32     `gorm:"default:1" json:"status"`
-	CreatedAt     time.Time `gorm:"autoCreateTime;index:idx_created_at,sort:desc;index:idx_author_created,sort:desc" json:"created_at"`
-	UpdatedAt     time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID              int64             `gorm:"primaryKey;autoIncrement" json:"id"`
+	AuthorID        int64             `gorm:"not null;index:idx_author_created" json:"author_id"`
+	Title           string            `gorm:"size:255;not null" json:"title"`
+	PlayURL         string            `gorm:"size:500;not null" json:"play_url"`
+	CoverURL        string            `gorm:"size:500" json:"cover_url"`
+	PlayURLs        map[string]string `gorm:"serializer:json;type:json" json:"play_urls"`
+	HLSURL          string            `gorm:"column:hls_url;size:500;not null;default:''" json:"hls_url"`
+	DynamicCoverURL string            `gorm:"column:dynamic_cover_url;size:500;not null;default:''" json:"dynamic_cover_url"`
+	CategoryID      int64             `gorm:"not null;default:0;index:idx_category_status_created,priority:1" json:"category_id"`
+	Duration        float64           `gorm:"type:decimal(10,3);not null;default:0" json:"duration"`
+	Width           int32             `gorm:"not null;default:0" json:"width"`
+	Height          int32             `gorm:"not null;default:0" json:"height"`
+	Bitrate         int64             `gorm:"not null;default:0" json:"bitrate"`
+	Size            int64             `gorm:"not null;default:0" json:"size"`
+	Format          string            `gorm:"size:16;not null;default:''" json:"format"`
+	AudioCodec      string            `gorm:"size:32;not null;default:''" json:"audio_codec"`
+	AudioBitrate    int64             `gorm:"not null;default:0" json:"audio_bitrate"`
+	FavoriteCount   int64             `gorm:"default:0" json:"favorite_count"`
+	CommentCount    int64             `gorm:"default:0" json:"comment_count"`
+	PlayCount       int64             `gorm:"default:0" json:"play_count"`
+	ShareCount      int64             `gorm:"not null;default:0" json:"share_count"`
+	Status          int32             `gorm:"default:1;index:idx_category_status_created,priority:2;index:idx_status_publish_at,priority:1" json:"status"`
+	Visibility      int32             `gorm:"not null;default:1" json:"visibility"`
+	PublishAt       *time.Time        `gorm:"index:idx_status_publish_at,priority:2" json:"publish_at"`
+	CreatedAt       time.Time         `gorm:"autoCreateTime;index:idx_created_at,sort:desc;index:idx_author_created,sort:desc;index:idx_category_status_created,priority:3,sort:desc" json:"created_at"`
+	UpdatedAt       time.Time         `gorm:"autoUpdateTime" json:"updated_at"`
 }
 
 func (VideoModel) TableName() string {
@@ -36,21 +51,23 @@ func (VideoModel) TableName() string {
 
 // videoRepo 视频仓储实现
 type videoRepo struct {
-	data       *Data
-	storage    storage.VideoStorage
-	log        *log.Helper
-	videoCache biz.VideoCacheRepo
-	producer   domain.VideoEventPublisher
+	data        *Data
+	storage     storage.VideoStorage
+	cdn         *storage.CDN
+	log         *log.Helper
+	videoCache  biz.VideoCacheRepo
+	invalidator domain.CacheInvalidationPublisher
 }
 
 // NewVideoRepo 创建视频仓储
-func NewVideoRepo(data *Data, storage storage.VideoStorage, videoCache biz.VideoCacheRepo, producer domain.VideoEventPublisher, logger log.Logger) biz.VideoRepo {
+func NewVideoRepo(data *Data, storage storage.VideoStorage, cdn *storage.CDN, videoCache biz.VideoCacheRepo, invalidator domain.CacheInvalidationPublisher, logger log.Logger) biz.VideoRepo {
 	return &videoRepo{
-		data:       data,
-		storage:    storage,
-		videoCache: videoCache,
-		producer:   producer,
-		log:        log.NewHelper(logger),
+		data:        data,
+		storage:     storage,
+		cdn:         cdn,
+		videoCache:  videoCache,
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
 	}
 }
 
@@ -62,10 +79,20 @@ func (r *videoRepo) CreateVideo(ctx context.Context, video *domain.Video) error
 		Title:         video.Title,
 		PlayURL:       video.PlayURL,
 		CoverURL:      video.CoverURL,
+		CategoryID:    video.CategoryID,
+		Duration:      video.Duration,
+		Width:         video.Width,
+		Height:        video.Height,
+		Bitrate:       video.Bitrate,
+		Size:          video.Size,
+		Format:        video.Format,
 		FavoriteCount: video.FavoriteCount,
 		CommentCount:  video.CommentCount,
 		PlayCount:     video.PlayCount,
+		ShareCount:    video.ShareCount,
 		Status:        video.Status,
+		Visibility:    video.Visibility,
+		PublishAt:     video.PublishAt,
 	}
 
 	err := r.data.db.Transaction(func(tx *gorm.DB) error {
@@ -77,25 +104,11 @@ func (r *videoRepo) CreateVideo(ctx context.Context, video *domain.Video) error
 		video.CreatedAt = model.CreatedAt
 		video.UpdatedAt = model.UpdatedAt
 
-		// 发布视频上传事件
-		event := &domain.VideoUploadedEvent{
-			VideoID:    video.ID,
-			AuthorID:   video.AuthorID,
-			Title:      video.Title,
-			PlayURL:    video.PlayURL,
-			CoverURL:   video.CoverURL,
-			Size:       0, // TODO: 从视频元数据获取
-			Format:     "mp4",
-			UploadedAt: video.CreatedAt,
-			EventID:    utils.GenerateEventID(),
-			EventTime:  time.Now(),
+		// 草稿在定时发布时才写入上传事件，隔离的视频不处理
+		if video.Status == domain.VideoStatusDraft || video.Status == domain.VideoStatusQuarantined {
+			return nil
 		}
-
-		if err := r.producer.PublishVideoUploadedEvent(ctx, event); err != nil {
-			r.log.WithContext(ctx).Warnf("publish video uploaded event failed: %v", err)
-		}
-
-		return nil
+		return enqueueVideoUploadedEvent(tx.WithContext(ctx), video)
 	})
 
 	if err != nil {
@@ -103,13 +116,34 @@ func (r *videoRepo) CreateVideo(ctx context.Context, video *domain.Video) error
 		return err
 	}
 
-	// 清除相关缓存
-	r.videoCache.DeleteUserVideos(ctx, video.AuthorID)
-	r.videoCache.DeleteFeedCache(ctx)
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeUserVideos, video.AuthorID),
+		cacheInvalidation(domain.CacheTypeFeed),
+	)
 
 	return nil
 }
 
+// enqueueVideoUploadedEvent 视频上传事件写入发件箱，随事务提交后由中继投递
+func enqueueVideoUploadedEvent(tx *gorm.DB, video *domain.Video) error {
+	event := &domain.VideoUploadedEvent{
+		VideoID:    video.ID,
+		AuthorID:   video.AuthorID,
+		Title:      video.Title,
+		PlayURL:    video.PlayURL,
+		CoverURL:   video.CoverURL,
+		Size:       video.Size,
+		Format:     video.Format,
+		UploadedAt: video.CreatedAt,
+		EventID:    utils.GenerateEventID(),
+		EventTime:  time.Now(),
+	}
+	return enqueueOutbox(tx, event.EventID, biz.OutboxEventVideoUploaded, video.ID, event)
+}
+
+// invisibleVideoStatuses 按ID查询时不可见的视频状态
+var invisibleVideoStatuses = []int32{domain.VideoStatusDeleted, domain.VideoStatusHidden, domain.VideoStatusTakenDown, domain.VideoStatusUnavailable}
+
 // GetVideo 获取视频信息
 func (r *videoRepo) GetVideo(ctx context.Context, videoID int64) (*domain.Video, error) {
 	// 先从缓存获取
@@ -118,7 +152,7 @@ func (r *videoRepo) GetVideo(ctx context.Context, videoID int64) (*domain.Video,
 	}
 
 	var model VideoModel
-	if err := r.data.db.WithContext(ctx).Where("id = ? AND status != ?", videoID, domain.VideoStatusDeleted).First(&model).Error; err != nil {
+	if err := r.data.db.WithContext(ctx).Where("id = ? AND status NOT IN ?", videoID, invisibleVideoStatuses).First(&model).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, utils.ErrVideoNotFound
 		}
@@ -141,7 +175,7 @@ func (r *videoRepo) GetVideos(ctx context.Context, videoIDs []int64) ([]*domain.
 	}
 
 	var models []VideoModel
-	if err := r.data.db.WithContext(ctx).Where("id IN ? AND status != ?", videoIDs, domain.VideoStatusDeleted).Find(&models).Error; err != nil {
+	if err := r.data.db.WithContext(ctx).Where("id IN ? AND status NOT IN ?", videoIDs, invisibleVideoStatuses).Find(&models).Error; err != nil {
 		r.log.WithContext(ctx).Errorf("get videos failed: %v", err)
 		return nil, err
 	}
@@ -154,7 +188,7 @@ func (r *videoRepo) GetVideos(ctx context.Context, videoIDs []int64) ([]*domain.
 	return videos, nil
 }
 
-// GetUserVideos 获取用户视频列表
+// GetUserVideos 获取用户已发布的视频列表，包含全部可见范围，由调用方按访问者过滤
 func (r *videoRepo) GetUserVideos(ctx context.Context, userID int64, limit int) ([]*domain.Video, error) {
 	// 先从缓存获取
 	if videos, ok := r.videoCache.GetUserVideos(ctx, userID); ok {
@@ -185,16 +219,25 @@ func (r *videoRepo) GetUserVideos(ctx context.Context, userID int64, limit int)
 	return videos, nil
 }
 
-// GetFeedVideos 获取视频流
-func (r *videoRepo) GetFeedVideos(ctx context.Context, latestTime time.Time, limit int) ([]*domain.Video, error) {
+// GetFeedVideos 获取游标之后的公开视频流，按 (created_at, id) 倒序，cursor 为 nil 时从最新开始；
+// categoryID 为 0 时不按分类筛选
+func (r *videoRepo) GetFeedVideos(ctx context.Context, cursor *domain.FeedCursor, categoryID int64, limit int) ([]*domain.Video, error) {
 	var models []VideoModel
-	query := r.data.db.WithContext(ctx).Where("status = ?", domain.VideoStatusPublished)
+	query := r.data.db.WithContext(ctx).Where("status = ? AND visibility = ?", domain.VideoStatusPublished, domain.VideoVisibilityPublic)
 
-	if !latestTime.IsZero() {
-		query = query.Where("created_at < ?", latestTime)
+	if categoryID > 0 {
+		query = query.Where("category_id = ?", categoryID)
 	}
 
-	if err := query.Order("created_at DESC").Limit(limit).Find(&models).Error; err != nil {
+	if cursor != nil {
+		if cursor.VideoID > 0 {
+			query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.VideoID)
+		} else {
+			query = query.Where("created_at < ?", cursor.CreatedAt)
+		}
+	}
+
+	if err := query.Order("created_at DESC").Order("id DESC").Limit(limit).Find(&models).Error; err != nil {
 		r.log.WithContext(ctx).Errorf("get feed videos failed: %v", err)
 		return nil, err
 	}
@@ -225,6 +268,8 @@ func (r *videoRepo) UpdateVideoStats(ctx context.Context, videoID int64, field s
 			oldValue = video.CommentCount
 		case "play_count":
 			oldValue = video.PlayCount
+		case "share_count":
+			oldValue = video.ShareCount
 		}
 
 		// 更新统计
@@ -234,7 +279,7 @@ func (r *videoRepo) UpdateVideoStats(ctx context.Context, videoID int64, field s
 			return err
 		}
 
-		// 发布统计更新事件
+		// 统计更新事件写入发件箱
 		event := &domain.VideoStatsUpdatedEvent{
 			VideoID:   videoID,
 			StatsType: field,
@@ -246,11 +291,7 @@ func (r *videoRepo) UpdateVideoStats(ctx context.Context, videoID int64, field s
 			EventTime: time.Now(),
 		}
 
-		if err := r.producer.PublishVideoStatsUpdatedEvent(ctx, event); err != nil {
-			r.log.WithContext(ctx).Warnf("publish video stats updated event failed: %v", err)
-		}
-
-		return nil
+		return enqueueOutbox(tx.WithContext(ctx), event.EventID, biz.OutboxEventVideoStatsUpdated, videoID, event)
 	})
 
 	if err != nil {
@@ -258,12 +299,33 @@ func (r *videoRepo) UpdateVideoStats(ctx context.Context, videoID int64, field s
 		return err
 	}
 
-	// 清除缓存
-	r.videoCache.DeleteVideo(ctx, videoID)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, videoID))
 
 	return nil
 }
 
+// enqueueVideoStatsUpdated 在已更新计数的事务 tx 中读取新值，写入视频统计更新事件。
+// 点赞、评论等在各自事务内维护计数的场景用它补发事件，供热门榜等下游消费
+func enqueueVideoStatsUpdated(tx *gorm.DB, videoID int64, field string, delta int64) error {
+	var newValue int64
+	if err := tx.Model(&VideoModel{}).Where("id = ?", videoID).Pluck(field, &newValue).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	event := &domain.VideoStatsUpdatedEvent{
+		VideoID:   videoID,
+		StatsType: field,
+		OldValue:  newValue - delta,
+		NewValue:  newValue,
+		Delta:     delta,
+		UpdatedAt: now,
+		EventID:   utils.GenerateEventID(),
+		EventTime: now,
+	}
+	return enqueueOutbox(tx, event.EventID, biz.OutboxEventVideoStatsUpdated, videoID, event)
+}
+
 // UpdateVideo 更新视频信息
 func (r *videoRepo) UpdateVideo(ctx context.Context, video *domain.Video) error {
 	model := &VideoModel{
@@ -272,10 +334,13 @@ func (r *videoRepo) UpdateVideo(ctx context.Context, video *domain.Video) error
 		Title:         video.Title,
 		PlayURL:       video.PlayURL,
 		CoverURL:      video.CoverURL,
+		CategoryID:    video.CategoryID,
 		FavoriteCount: video.FavoriteCount,
 		CommentCount:  video.CommentCount,
 		PlayCount:     video.PlayCount,
+		ShareCount:    video.ShareCount,
 		Status:        video.Status,
+		Visibility:    video.Visibility,
 	}
 
 	if err := r.data.db.WithContext(ctx).Model(model).Where("id = ?", video.ID).Updates(model).Error; err != nil {
@@ -283,9 +348,10 @@ func (r *videoRepo) UpdateVideo(ctx context.Context, video *domain.Video) error
 		return err
 	}
 
-	// 清除缓存
-	r.videoCache.DeleteVideo(ctx, video.ID)
-	r.videoCache.DeleteUserVideos(ctx, video.AuthorID)
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeVideo, video.ID),
+		cacheInvalidation(domain.CacheTypeUserVideos, video.AuthorID),
+	)
 
 	return nil
 }
@@ -300,8 +366,29 @@ func (r *videoRepo) UpdateVideoCover(ctx context.Context, videoID int64, coverUR
 		return err
 	}
 
-	// 清除缓存
-	r.videoCache.DeleteVideo(ctx, videoID)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, videoID))
+	return nil
+}
+
+// UpdateVideoInfo 更新视频标题、封面和状态
+func (r *videoRepo) UpdateVideoInfo(ctx context.Context, video *domain.Video) error {
+	if err := r.data.db.WithContext(ctx).
+		Model(&VideoModel{}).
+		Where("id = ?", video.ID).
+		Updates(map[string]interface{}{
+			"title":     video.Title,
+			"cover_url": video.CoverURL,
+			"status":    video.Status,
+		}).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("update video info failed: %v", err)
+		return err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeVideo, video.ID),
+		cacheInvalidation(domain.CacheTypeUserVideos, video.AuthorID),
+		cacheInvalidation(domain.CacheTypeFeed),
+	)
 	return nil
 }
 
@@ -315,8 +402,185 @@ func (r *videoRepo) UpdateVideoPlayURL(ctx context.Context, videoID int64, playU
 		return err
 	}
 
-	// 清除缓存
-	r.videoCache.DeleteVideo(ctx, videoID)
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, videoID))
+	return nil
+}
+
+// UpdateVideoPlayURLs 更新视频各清晰度的播放地址
+func (r *videoRepo) UpdateVideoPlayURLs(ctx context.Context, videoID int64, playURLs map[string]string) error {
+	if err := r.data.db.WithContext(ctx).
+		Model(&VideoModel{}).
+		Where("id = ?", videoID).
+		Update("play_urls", playURLs).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("update video play urls failed: %v", err)
+		return err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, videoID))
+	return nil
+}
+
+// UpdateVideoDynamicCover 更新视频的动态封面地址
+func (r *videoRepo) UpdateVideoDynamicCover(ctx context.Context, videoID int64, dynamicCoverURL string) error {
+	if err := r.data.db.WithContext(ctx).
+		Model(&VideoModel{}).
+		Where("id = ?", videoID).
+		Update("dynamic_cover_url", dynamicCoverURL).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("update video dynamic cover failed: %v", err)
+		return err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, videoID))
+	return nil
+}
+
+// UpdateVideoHLSURL 更新视频的HLS主播放列表地址
+func (r *videoRepo) UpdateVideoHLSURL(ctx context.Context, videoID int64, hlsURL string) error {
+	if err := r.data.db.WithContext(ctx).
+		Model(&VideoModel{}).
+		Where("id = ?", videoID).
+		Update("hls_url", hlsURL).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("update video hls url failed: %v", err)
+		return err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, videoID))
+	return nil
+}
+
+// UpdateVideoVisibility 更新视频可见范围，同时失效作品列表和视频流
+func (r *videoRepo) UpdateVideoVisibility(ctx context.Context, video *domain.Video, visibility int32) error {
+	if err := r.data.db.WithContext(ctx).
+		Model(&VideoModel{}).
+		Where("id = ?", video.ID).
+		Update("visibility", visibility).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("update video visibility failed: %v", err)
+		return err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeVideo, video.ID),
+		cacheInvalidation(domain.CacheTypeUserVideos, video.AuthorID),
+		cacheInvalidation(domain.CacheTypeFeed),
+	)
+	return nil
+}
+
+// ListDueDraftVideos 按计划发布时间升序返回已到期的草稿
+func (r *videoRepo) ListDueDraftVideos(ctx context.Context, now time.Time, limit int) ([]*domain.Video, error) {
+	var models []VideoModel
+	if err := r.data.db.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", domain.VideoStatusDraft, now).
+		Order("publish_at ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("list due draft videos failed: %v", err)
+		return nil, err
+	}
+
+	videos := make([]*domain.Video, len(models))
+	for i := range models {
+		videos[i] = r.modelToDomain(&models[i])
+	}
+	return videos, nil
+}
+
+// RescheduleDraftVideo 修改草稿的计划发布时间
+func (r *videoRepo) RescheduleDraftVideo(ctx context.Context, video *domain.Video, publishAt time.Time) error {
+	result := r.data.db.WithContext(ctx).
+		Model(&VideoModel{}).
+		Where("id = ? AND status = ?", video.ID, domain.VideoStatusDraft).
+		Update("publish_at", publishAt)
+	if result.Error != nil {
+		r.log.WithContext(ctx).Errorf("reschedule draft video failed: %v", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrVideoNotDraft
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, video.ID))
+	return nil
+}
+
+// PublishDraftVideo 发布草稿，按状态条件更新，并发发布时只有一次成功。
+// created_at 改为实际发布时间，使视频按发布时间进入视频流
+func (r *videoRepo) PublishDraftVideo(ctx context.Context, video *domain.Video, status int32, publishedAt time.Time) (bool, error) {
+	var published bool
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&VideoModel{}).
+			Where("id = ? AND status = ?", video.ID, domain.VideoStatusDraft).
+			Updates(map[string]interface{}{
+				"status":     status,
+				"publish_at": nil,
+				"created_at": publishedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		published = true
+
+		event := *video
+		event.CreatedAt = publishedAt
+		return enqueueVideoUploadedEvent(tx, &event)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("publish draft video failed: %v", err)
+		return false, err
+	}
+	if !published {
+		return false, nil
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log,
+		cacheInvalidation(domain.CacheTypeVideo, video.ID),
+		cacheInvalidation(domain.CacheTypeUserVideos, video.AuthorID),
+		cacheInvalidation(domain.CacheTypeFeed),
+	)
+	return true, nil
+}
+
+// UpdateVideoMetadata 更新视频元信息，探测不到的字段保持原值
+func (r *videoRepo) UpdateVideoMetadata(ctx context.Context, videoID int64, metadata *domain.VideoMetadata) error {
+	updates := make(map[string]interface{}, 8)
+	if metadata.Duration > 0 {
+		updates["duration"] = metadata.Duration
+	}
+	if metadata.Width > 0 && metadata.Height > 0 {
+		updates["width"] = metadata.Width
+		updates["height"] = metadata.Height
+	}
+	if metadata.Bitrate > 0 {
+		updates["bitrate"] = metadata.Bitrate
+	}
+	if metadata.Size > 0 {
+		updates["size"] = metadata.Size
+	}
+	if metadata.Format != "" {
+		updates["format"] = metadata.Format
+	}
+	if metadata.AudioCodec != "" {
+		updates["audio_codec"] = metadata.AudioCodec
+	}
+	if metadata.AudioBitrate > 0 {
+		updates["audio_bitrate"] = metadata.AudioBitrate
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	if err := r.data.db.WithContext(ctx).
+		Model(&VideoModel{}).
+		Where("id = ?", videoID).
+		Updates(updates).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("update video metadata failed: %v", err)
+		return err
+	}
+
+	invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, videoID))
 	return nil
 }
 
@@ -364,26 +628,49 @@ func (r *videoRepo) DeleteFile(ctx context.Context, url string) error {
 	return r.storage.Delete(ctx, objectName)
 }
 
-// GetPreviewURL 生成预览URL
+// GetPreviewURL 生成预览URL，开启CDN签名时返回一小时有效的CDN签名地址，否则返回源站预签名地址
 func (r *videoRepo) GetPreviewURL(ctx context.Context, url string) (string, error) {
+	if r.cdn.Signing() {
+		return r.cdn.Sign(r.cdn.Rewrite(url), time.Now().Add(time.Hour)), nil
+	}
 	objectName := r.extractObjectName(url)
 	return r.storage.GetPresignedURL(ctx, objectName, time.Hour)
 }
 
 // modelToDomain 模型转领域对象
 func (r *videoRepo) modelToDomain(model *VideoModel) *domain.Video {
+	return videoModelToDomain(model)
+}
+
+// videoModelToDomain 视频模型转领域对象，供其他仓储复用
+func videoModelToDomain(model *VideoModel) *domain.Video {
 	return &domain.Video{
-		ID:            model.ID,
-		AuthorID:      model.AuthorID,
-		Title:         model.Title,
-		PlayURL:       model.PlayURL,
-		CoverURL:      model.CoverURL,
-		FavoriteCount: model.FavoriteCount,
-		CommentCount:  model.CommentCount,
-		PlayCount:     model.PlayCount,
-		Status:        model.Status,
-		CreatedAt:     model.CreatedAt,
-		UpdatedAt:     model.UpdatedAt,
+		ID:              model.ID,
+		AuthorID:        model.AuthorID,
+		Title:           model.Title,
+		PlayURL:         model.PlayURL,
+		PlayURLs:        model.PlayURLs,
+		HLSURL:          model.HLSURL,
+		DynamicCoverURL: model.DynamicCoverURL,
+		CoverURL:        model.CoverURL,
+		CategoryID:      model.CategoryID,
+		Duration:        model.Duration,
+		Width:           model.Width,
+		Height:          model.Height,
+		Bitrate:         model.Bitrate,
+		Size:            model.Size,
+		Format:          model.Format,
+		AudioCodec:      model.AudioCodec,
+		AudioBitrate:    model.AudioBitrate,
+		FavoriteCount:   model.FavoriteCount,
+		CommentCount:    model.CommentCount,
+		PlayCount:       model.PlayCount,
+		ShareCount:      model.ShareCount,
+		Status:          model.Status,
+		Visibility:      model.Visibility,
+		PublishAt:       model.PublishAt,
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
 	}
 }
 
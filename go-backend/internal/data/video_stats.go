@@ -0,0 +1,267 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	videoStatsBufferKey  = "video:stats:buffer"
+	videoStatsJournalKey = "video:stats:journal"
+)
+
+// sealVideoStatsScript 把缓冲哈希中的增量逐条追加到日志流后删除哈希，
+// 两步在同一脚本内执行，期间到达的增量要么已在日志中，要么留在下一轮的缓冲里
+var sealVideoStatsScript = redis.NewScript(`
+local entries = redis.call('HGETALL', KEYS[1])
+local sealed = 0
+for i = 1, #entries, 2 do
+	if entries[i + 1] ~= '0' then
+		local sep = string.find(entries[i], ':', 1, true)
+		redis.call('XADD', KEYS[2], '*',
+			'video_id', string.sub(entries[i], 1, sep - 1),
+			'field', string.sub(entries[i], sep + 1),
+			'delta', entries[i + 1])
+		sealed = sealed + 1
+	end
+end
+redis.call('DEL', KEYS[1])
+return sealed
+`)
+
+// videoStatsFields 允许写缓冲的计数列，日志中的列名拼入SQL前必须在此列表中
+var videoStatsFields = map[string]bool{
+	"favorite_count": true,
+	"comment_count":  true,
+	"play_count":     true,
+	"share_count":    true,
+}
+
+// VideoStatsCheckpoint 日志流已写入数据库的最后一条条目
+type VideoStatsCheckpoint struct {
+	Stream      string    `gorm:"primaryKey;size:64"`
+	LastEntryID string    `gorm:"size:32;not null"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (VideoStatsCheckpoint) TableName() string {
+	return "video_stats_checkpoints"
+}
+
+type videoStatsBufferRepo struct {
+	data        *Data
+	invalidator domain.CacheInvalidationPublisher
+	log         *log.Helper
+}
+
+// NewVideoStatsBufferRepo .
+func NewVideoStatsBufferRepo(data *Data, invalidator domain.CacheInvalidationPublisher, logger log.Logger) biz.VideoStatsBufferRepo {
+	return &videoStatsBufferRepo{
+		data:        data,
+		invalidator: invalidator,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// videoStatsDelta 一条日志中的增量
+type videoStatsDelta struct {
+	videoID int64
+	field   string
+	delta   int64
+}
+
+// Incr 增量按 {视频ID}:{列名} 累加在缓冲哈希中
+func (r *videoStatsBufferRepo) Incr(ctx context.Context, videoID int64, field string, delta int64) error {
+	return r.data.rdb.HIncrBy(ctx, videoStatsBufferKey, strconv.FormatInt(videoID, 10)+":"+field, delta).Err()
+}
+
+// Seal 把缓冲转入日志流
+func (r *videoStatsBufferRepo) Seal(ctx context.Context) (int, error) {
+	return sealVideoStatsScript.Run(ctx, r.data.rdb, []string{videoStatsBufferKey, videoStatsJournalKey}).Int()
+}
+
+// Apply 读取日志流中最早的一批条目，跳过检查点之前已应用的部分，合并同一视频同一列的增量后
+// 与检查点在同一事务内写入，提交后删除整批日志
+func (r *videoStatsBufferRepo) Apply(ctx context.Context, limit int) (*biz.VideoStatsFlushBatch, error) {
+	messages, err := r.data.rdb.XRangeN(ctx, videoStatsJournalKey, "-", "+", int64(limit)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &biz.VideoStatsFlushBatch{Entries: len(messages)}
+	if len(messages) == 0 {
+		return batch, nil
+	}
+
+	var touched []int64
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var checkpoint VideoStatsCheckpoint
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("stream = ?", videoStatsJournalKey).
+			First(&checkpoint).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+
+		deltas := make(map[int64]map[string]int64)
+		for _, msg := range messages {
+			if checkpoint.LastEntryID != "" && !streamIDAfter(msg.ID, checkpoint.LastEntryID) {
+				continue
+			}
+			d, ok := r.parseEntry(ctx, msg)
+			if !ok {
+				continue
+			}
+			if deltas[d.videoID] == nil {
+				deltas[d.videoID] = make(map[string]int64)
+			}
+			deltas[d.videoID][d.field] += d.delta
+			batch.Applied++
+		}
+
+		if len(deltas) > 0 {
+			ids, err := r.applyDeltas(tx, deltas)
+			if err != nil {
+				return err
+			}
+			touched = ids
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "stream"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_entry_id", "updated_at"}),
+		}).Create(&VideoStatsCheckpoint{
+			Stream:      videoStatsJournalKey,
+			LastEntryID: messages[len(messages)-1].ID,
+		}).Error
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("apply video stats journal failed: %v", err)
+		return nil, err
+	}
+
+	// 删除失败时日志留在流中，下次按检查点跳过
+	ids := make([]string, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.ID
+	}
+	if err := r.data.rdb.XDel(ctx, videoStatsJournalKey, ids...).Err(); err != nil {
+		r.log.WithContext(ctx).Warnf("delete video stats journal entries failed: %v", err)
+	}
+
+	if len(touched) > 0 {
+		invalidateCache(ctx, r.invalidator, r.log, cacheInvalidation(domain.CacheTypeVideo, touched...))
+	}
+	return batch, nil
+}
+
+// applyDeltas 锁定涉及的视频行后逐个累加，每列写一条统计更新事件到发件箱，返回实际更新的视频ID
+func (r *videoStatsBufferRepo) applyDeltas(tx *gorm.DB, deltas map[int64]map[string]int64) ([]int64, error) {
+	videoIDs := make([]int64, 0, len(deltas))
+	for videoID := range deltas {
+		videoIDs = append(videoIDs, videoID)
+	}
+	// 按ID顺序加锁，避免与其他事务死锁
+	sort.Slice(videoIDs, func(i, j int) bool { return videoIDs[i] < videoIDs[j] })
+
+	var videos []VideoModel
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "favorite_count", "comment_count", "play_count", "share_count").
+		Where("id IN ?", videoIDs).
+		Order("id").
+		Find(&videos).Error; err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	touched := make([]int64, 0, len(videos))
+	for _, video := range videos {
+		old := map[string]int64{
+			"favorite_count": video.FavoriteCount,
+			"comment_count":  video.CommentCount,
+			"play_count":     video.PlayCount,
+			"share_count":    video.ShareCount,
+		}
+
+		fields := make([]string, 0, len(deltas[video.ID]))
+		updates := make(map[string]interface{})
+		for field, delta := range deltas[video.ID] {
+			if delta == 0 {
+				continue
+			}
+			fields = append(fields, field)
+			updates[field] = gorm.Expr(field+" + ?", delta)
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		sort.Strings(fields)
+
+		if err := tx.Model(&VideoModel{}).Where("id = ?", video.ID).UpdateColumns(updates).Error; err != nil {
+			return nil, err
+		}
+
+		for _, field := range fields {
+			delta := deltas[video.ID][field]
+			event := &domain.VideoStatsUpdatedEvent{
+				VideoID:   video.ID,
+				StatsType: field,
+				OldValue:  old[field],
+				NewValue:  old[field] + delta,
+				Delta:     delta,
+				UpdatedAt: now,
+				EventID:   utils.GenerateEventID(),
+				EventTime: now,
+			}
+			if err := enqueueOutbox(tx, event.EventID, biz.OutboxEventVideoStatsUpdated, video.ID, event); err != nil {
+				return nil, err
+			}
+		}
+		touched = append(touched, video.ID)
+	}
+
+	return touched, nil
+}
+
+// parseEntry 解析日志条目，格式不正确的条目记录日志后丢弃
+func (r *videoStatsBufferRepo) parseEntry(ctx context.Context, msg redis.XMessage) (videoStatsDelta, bool) {
+	field, _ := msg.Values["field"].(string)
+	rawID, _ := msg.Values["video_id"].(string)
+	rawDelta, _ := msg.Values["delta"].(string)
+	videoID, idErr := strconv.ParseInt(rawID, 10, 64)
+	delta, deltaErr := strconv.ParseInt(rawDelta, 10, 64)
+	if idErr != nil || deltaErr != nil || !videoStatsFields[field] {
+		r.log.WithContext(ctx).Warnf("drop malformed video stats journal entry %s: %v", msg.ID, msg.Values)
+		return videoStatsDelta{}, false
+	}
+	return videoStatsDelta{videoID: videoID, field: field, delta: delta}, true
+}
+
+// streamIDAfter 比较两个 Redis Stream 条目ID（毫秒时间戳-序号），a 在 b 之后时返回 true
+func streamIDAfter(a, b string) bool {
+	aMs, aSeq := splitStreamID(a)
+	bMs, bSeq := splitStreamID(b)
+	if aMs != bMs {
+		return aMs > bMs
+	}
+	return aSeq > bSeq
+}
+
+func splitStreamID(id string) (uint64, uint64) {
+	ms, seq, _ := strings.Cut(id, "-")
+	msVal, _ := strconv.ParseUint(ms, 10, 64)
+	seqVal, _ := strconv.ParseUint(seq, 10, 64)
+	return msVal, seqVal
+}
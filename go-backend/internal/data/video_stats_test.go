@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVideoStatsBufferRepo(t *testing.T) {
+	favorite, env, cleanup := setupFavoriteRepo(t)
+	defer cleanup()
+
+	repo := &videoStatsBufferRepo{
+		data:        favorite.data,
+		invalidator: favorite.invalidator,
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+	video := createFavoriteTestVideo(t, repo.data, users[0].ID)
+
+	loadVideo := func() VideoModel {
+		var stored VideoModel
+		require.NoError(t, repo.data.db.First(&stored, video.ID).Error)
+		return stored
+	}
+
+	t.Run("SealAndApply", func(t *testing.T) {
+		require.NoError(t, repo.Incr(ctx, video.ID, "play_count", 1))
+		require.NoError(t, repo.Incr(ctx, video.ID, "play_count", 2))
+		require.NoError(t, repo.Incr(ctx, video.ID, "favorite_count", 1))
+		// 相互抵消的增量不写入日志
+		require.NoError(t, repo.Incr(ctx, video.ID, "comment_count", 1))
+		require.NoError(t, repo.Incr(ctx, video.ID, "comment_count", -1))
+
+		sealed, err := repo.Seal(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, sealed)
+		assert.Zero(t, repo.data.rdb.Exists(ctx, videoStatsBufferKey).Val())
+
+		batch, err := repo.Apply(ctx, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 2, batch.Entries)
+		assert.Equal(t, 2, batch.Applied)
+
+		stored := loadVideo()
+		assert.Equal(t, int64(3), stored.PlayCount)
+		assert.Equal(t, int64(1), stored.FavoriteCount)
+		assert.Zero(t, repo.data.rdb.XLen(ctx, videoStatsJournalKey).Val())
+
+		var events int64
+		require.NoError(t, repo.data.db.Model(&OutboxModel{}).Where("aggregate_id = ?", video.ID).Count(&events).Error)
+		assert.Equal(t, int64(2), events)
+	})
+
+	t.Run("ReplaySkipsAppliedEntries", func(t *testing.T) {
+		require.NoError(t, repo.Incr(ctx, video.ID, "play_count", 5))
+		_, err := repo.Seal(ctx)
+		require.NoError(t, err)
+
+		// 模拟写库后删除日志前崩溃：日志仍在流中，检查点已推进
+		messages, err := repo.data.rdb.XRange(ctx, videoStatsJournalKey, "-", "+").Result()
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		_, err = repo.Apply(ctx, 100)
+		require.NoError(t, err)
+		require.NoError(t, repo.data.rdb.XAdd(ctx, &redis.XAddArgs{
+			Stream: videoStatsJournalKey,
+			ID:     messages[0].ID,
+			Values: messages[0].Values,
+		}).Err())
+
+		batch, err := repo.Apply(ctx, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 1, batch.Entries)
+		assert.Zero(t, batch.Applied)
+		assert.Equal(t, int64(8), loadVideo().PlayCount)
+		assert.Zero(t, repo.data.rdb.XLen(ctx, videoStatsJournalKey).Val())
+	})
+
+	t.Run("DropUnknownField", func(t *testing.T) {
+		require.NoError(t, repo.Incr(ctx, video.ID, "author_id", 1))
+		_, err := repo.Seal(ctx)
+		require.NoError(t, err)
+
+		batch, err := repo.Apply(ctx, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 1, batch.Entries)
+		assert.Zero(t, batch.Applied)
+		assert.Equal(t, video.AuthorID, loadVideo().AuthorID)
+	})
+}
+
+func TestStreamIDAfter(t *testing.T) {
+	assert.True(t, streamIDAfter("1700000000001-0", "1700000000000-5"))
+	assert.True(t, streamIDAfter("1700000000000-10", "1700000000000-9"))
+	assert.False(t, streamIDAfter("1700000000000-9", "1700000000000-9"))
+	assert.False(t, streamIDAfter("999-0", "1000-0"))
+}
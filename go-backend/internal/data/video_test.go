@@ -0,0 +1,291 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	pkgcache "go-backend/pkg/cache"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupVideoRepo(t *testing.T) (*videoRepo, *testutils.TestEnv, func()) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+
+	data := &Data{
+		db:  env.DB.DB,
+		rdb: env.Redis.Client,
+	}
+
+	multiCache := pkgcache.NewMultiLevelCache(env.Redis.Client, &pkgcache.CacheConfig{
+		EnableL1: true,
+		EnableL2: true,
+	})
+
+	repo := &videoRepo{
+		data:        data,
+		invalidator: newTestCacheInvalidationPublisher(data, multiCache),
+		log:         log.NewHelper(log.DefaultLogger),
+	}
+
+	return repo, env, cleanup
+}
+
+func TestVideoRepo_Metadata(t *testing.T) {
+	repo, env, cleanup := setupVideoRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+
+	video := &domain.Video{
+		ID:       910001,
+		AuthorID: users[0].ID,
+		Title:    "metadata test video",
+		PlayURL:  "http://example.com/videos/a.mov",
+		Duration: 12.48,
+		Width:    1280,
+		Height:   720,
+		Size:     1048576,
+		Format:   "mov",
+		Status:   domain.VideoStatusPublished,
+	}
+	require.NoError(t, repo.CreateVideo(ctx, video))
+
+	t.Run("StoredOnCreate", func(t *testing.T) {
+		var model VideoModel
+		require.NoError(t, repo.data.db.First(&model, video.ID).Error)
+		got := videoModelToDomain(&model)
+		assert.InDelta(t, 12.48, got.Duration, 1e-6)
+		assert.Equal(t, int32(1280), got.Width)
+		assert.Equal(t, int32(720), got.Height)
+		assert.Equal(t, int64(1048576), got.Size)
+		assert.Equal(t, "mov", got.Format)
+	})
+
+	t.Run("UploadedEventCarriesSize", func(t *testing.T) {
+		var outbox OutboxModel
+		require.NoError(t, repo.data.db.
+			Where("event_type = ? AND aggregate_id = ?", biz.OutboxEventVideoUploaded, video.ID).
+			First(&outbox).Error)
+
+		var event domain.VideoUploadedEvent
+		require.NoError(t, json.Unmarshal(outbox.Payload, &event))
+		assert.Equal(t, int64(1048576), event.Size)
+		assert.Equal(t, "mov", event.Format)
+	})
+
+	t.Run("UpdateKeepsUnprobedFields", func(t *testing.T) {
+		require.NoError(t, repo.UpdateVideoMetadata(ctx, video.ID, &domain.VideoMetadata{
+			Duration:     12.5,
+			Bitrate:      672164,
+			AudioCodec:   "aac",
+			AudioBitrate: 128000,
+		}))
+
+		var model VideoModel
+		require.NoError(t, repo.data.db.First(&model, video.ID).Error)
+		assert.InDelta(t, 12.5, model.Duration, 1e-6)
+		assert.Equal(t, int64(672164), model.Bitrate)
+		assert.Equal(t, "aac", model.AudioCodec)
+		assert.Equal(t, int64(128000), model.AudioBitrate)
+		assert.Equal(t, int32(720), model.Height)
+		assert.Equal(t, "mov", model.Format)
+	})
+}
+
+func TestVideoRepo_GetFeedVideos_SameSecond(t *testing.T) {
+	repo, env, cleanup := setupVideoRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+
+	// 5个视频落在同一秒，另有一个更早的视频
+	createdAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i := int64(1); i <= 6; i++ {
+		at := createdAt
+		if i == 1 {
+			at = createdAt.Add(-time.Minute)
+		}
+		require.NoError(t, repo.data.db.Create(&VideoModel{
+			ID:        920000 + i,
+			AuthorID:  users[0].ID,
+			Title:     "feed cursor video",
+			PlayURL:   "http://example.com/video.mp4",
+			Status:    domain.VideoStatusPublished,
+			CreatedAt: at,
+		}).Error)
+	}
+
+	var seen []int64
+	var cursor *domain.FeedCursor
+	for page := 0; page < 5; page++ {
+		videos, err := repo.GetFeedVideos(ctx, cursor, 0, 2)
+		require.NoError(t, err)
+		if len(videos) == 0 {
+			break
+		}
+		for _, v := range videos {
+			seen = append(seen, v.ID)
+		}
+		last := videos[len(videos)-1]
+		cursor = &domain.FeedCursor{CreatedAt: last.CreatedAt, VideoID: last.ID}
+	}
+	assert.Equal(t, []int64{920006, 920005, 920004, 920003, 920002, 920001}, seen)
+
+	// 旧版只按时间定位时跳过同一秒的视频
+	videos, err := repo.GetFeedVideos(ctx, &domain.FeedCursor{CreatedAt: createdAt}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, videos, 1)
+	assert.Equal(t, int64(920001), videos[0].ID)
+}
+
+func TestVideoRepo_Visibility(t *testing.T) {
+	repo, env, cleanup := setupVideoRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+
+	video := &domain.Video{
+		ID:         930001,
+		AuthorID:   users[0].ID,
+		Title:      "visibility test video",
+		PlayURL:    "http://example.com/video.mp4",
+		Status:     domain.VideoStatusPublished,
+		Visibility: domain.VideoVisibilityPublic,
+	}
+	require.NoError(t, repo.CreateVideo(ctx, video))
+
+	feedIDs := func() []int64 {
+		videos, err := repo.GetFeedVideos(ctx, nil, 0, 10)
+		require.NoError(t, err)
+		ids := make([]int64, 0, len(videos))
+		for _, v := range videos {
+			ids = append(ids, v.ID)
+		}
+		return ids
+	}
+	assert.Contains(t, feedIDs(), video.ID)
+
+	require.NoError(t, repo.UpdateVideoVisibility(ctx, video, domain.VideoVisibilityFriends))
+	got, err := repo.GetVideo(ctx, video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(domain.VideoVisibilityFriends), got.Visibility)
+
+	// 非公开视频不进入推荐流，作者的作品列表仍然返回
+	assert.NotContains(t, feedIDs(), video.ID)
+	videos, err := repo.GetUserVideos(ctx, users[0].ID, 10)
+	require.NoError(t, err)
+	require.Len(t, videos, 1)
+	assert.Equal(t, int32(domain.VideoVisibilityFriends), videos[0].Visibility)
+}
+
+func TestVideoRepo_UpdateVideoInfo(t *testing.T) {
+	repo, env, cleanup := setupVideoRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+
+	video := &domain.Video{
+		ID:       930101,
+		AuthorID: users[0].ID,
+		Title:    "old title #tag",
+		PlayURL:  "http://example.com/video.mp4",
+		CoverURL: "covers/old.jpg",
+		Status:   domain.VideoStatusPublished,
+	}
+	require.NoError(t, repo.CreateVideo(ctx, video))
+
+	// 先读取一次使视频进入缓存，修改后应读到新数据
+	_, err = repo.GetVideo(ctx, video.ID)
+	require.NoError(t, err)
+
+	updated := *video
+	updated.Title = "new title #other"
+	updated.CoverURL = "covers/new.jpg"
+	updated.Status = domain.VideoStatusAuditing
+	require.NoError(t, repo.UpdateVideoInfo(ctx, &updated))
+
+	got, err := repo.GetVideo(ctx, video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new title #other", got.Title)
+	assert.Equal(t, "covers/new.jpg", got.CoverURL)
+	assert.Equal(t, int32(domain.VideoStatusAuditing), got.Status)
+}
+
+func TestVideoRepo_ScheduledPublish(t *testing.T) {
+	repo, env, cleanup := setupVideoRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	users, err := env.DataManager.CreateTestUsers(1)
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Second)
+	due, later := now.Add(-time.Minute), now.Add(time.Hour)
+	for i, publishAt := range []time.Time{due, later} {
+		publishAt := publishAt
+		require.NoError(t, repo.CreateVideo(ctx, &domain.Video{
+			ID:        940001 + int64(i),
+			AuthorID:  users[0].ID,
+			Title:     "scheduled video",
+			PlayURL:   "http://example.com/video.mp4",
+			Status:    domain.VideoStatusDraft,
+			PublishAt: &publishAt,
+		}))
+	}
+
+	uploadedEvents := func(videoID int64) int64 {
+		var count int64
+		require.NoError(t, repo.data.db.Model(&OutboxModel{}).
+			Where("event_type = ? AND aggregate_id = ?", biz.OutboxEventVideoUploaded, videoID).
+			Count(&count).Error)
+		return count
+	}
+	// 草稿创建时不写入上传事件
+	assert.Zero(t, uploadedEvents(940001))
+
+	videos, err := repo.ListDueDraftVideos(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, videos, 1)
+	assert.Equal(t, int64(940001), videos[0].ID)
+
+	require.NoError(t, repo.RescheduleDraftVideo(ctx, videos[0], later))
+
+	published, err := repo.PublishDraftVideo(ctx, videos[0], domain.VideoStatusPublished, now)
+	require.NoError(t, err)
+	assert.True(t, published)
+	assert.Equal(t, int64(1), uploadedEvents(940001))
+
+	var model VideoModel
+	require.NoError(t, repo.data.db.First(&model, 940001).Error)
+	assert.Equal(t, int32(domain.VideoStatusPublished), model.Status)
+	assert.Nil(t, model.PublishAt)
+	assert.Equal(t, now.Unix(), model.CreatedAt.Unix())
+
+	// 已发布的视频不再是草稿
+	published, err = repo.PublishDraftVideo(ctx, videos[0], domain.VideoStatusPublished, now)
+	require.NoError(t, err)
+	assert.False(t, published)
+	assert.Equal(t, biz.ErrVideoNotDraft, repo.RescheduleDraftVideo(ctx, videos[0], later))
+}
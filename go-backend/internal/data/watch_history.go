@@ -0,0 +1,180 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WatchHistoryModel 观看记录模型
+type WatchHistoryModel struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int64     `gorm:"not null;index:idx_user_watched,priority:1" json:"user_id"`
+	VideoID   int64     `gorm:"not null;index:idx_video_watched,priority:1" json:"video_id"`
+	Source    int32     `gorm:"not null;default:0" json:"source"`
+	WatchedAt time.Time `gorm:"not null;index:idx_user_watched,priority:2;index:idx_video_watched,priority:2;index:idx_watched_at" json:"watched_at"`
+}
+
+func (WatchHistoryModel) TableName() string {
+	return "watch_history"
+}
+
+type watchHistoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewWatchHistoryRepo .
+func NewWatchHistoryRepo(data *Data, logger log.Logger) biz.WatchHistoryRepo {
+	return &watchHistoryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// RecordView 用 Redis 标记去重窗口，Redis 不可用时查询窗口内是否已有记录
+func (r *watchHistoryRepo) RecordView(ctx context.Context, entry *biz.WatchHistoryEntry, dedupWindow time.Duration) (bool, error) {
+	key := watchDedupKey(entry.UserID, entry.VideoID)
+	if dedupWindow > 0 {
+		first, err := r.data.rdb.SetNX(ctx, key, 1, dedupWindow).Result()
+		if err != nil {
+			r.log.WithContext(ctx).Warnf("set watch dedup key failed, checking database: %v", err)
+			var count int64
+			if err := r.data.db.WithContext(ctx).Model(&WatchHistoryModel{}).
+				Where("user_id = ? AND video_id = ? AND watched_at > ?", entry.UserID, entry.VideoID, entry.WatchedAt.Add(-dedupWindow)).
+				Count(&count).Error; err != nil {
+				return false, err
+			}
+			first = count == 0
+		}
+		if !first {
+			return false, nil
+		}
+	}
+
+	model := &WatchHistoryModel{
+		UserID:    entry.UserID,
+		VideoID:   entry.VideoID,
+		Source:    entry.Source,
+		WatchedAt: entry.WatchedAt,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		// 写入失败时释放窗口，允许客户端重试
+		r.data.rdb.Del(ctx, key)
+		return false, err
+	}
+
+	entry.ID = model.ID
+	return true, nil
+}
+
+func (r *watchHistoryRepo) ListWatchHistory(ctx context.Context, userID int64, page, size int32) ([]*biz.WatchHistoryEntry, int64, error) {
+	db := r.data.db.WithContext(ctx).Model(&WatchHistoryModel{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []WatchHistoryModel
+	if err := db.Order("watched_at DESC, id DESC").
+		Offset(int((page - 1) * size)).
+		Limit(int(size)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	entries := make([]*biz.WatchHistoryEntry, len(models))
+	for i, m := range models {
+		entries[i] = &biz.WatchHistoryEntry{
+			ID:        m.ID,
+			UserID:    m.UserID,
+			VideoID:   m.VideoID,
+			Source:    m.Source,
+			WatchedAt: m.WatchedAt,
+		}
+	}
+	return entries, total, nil
+}
+
+// AggregateVideoAudience 关注关系按查询时的状态判断，取关的观众计入非粉丝
+func (r *watchHistoryRepo) AggregateVideoAudience(ctx context.Context, videoID, authorID int64, start, end time.Time) (*biz.VideoAudience, error) {
+	db := r.data.db.WithContext(ctx)
+	audience := &biz.VideoAudience{
+		VideoID:     videoID,
+		StartTime:   start,
+		EndTime:     end,
+		SourceViews: make(map[int32]int64),
+	}
+
+	var viewRows []struct {
+		Source   int32
+		Follower bool
+		Views    int64
+	}
+	if err := db.Raw(`SELECT w.source, f.id IS NOT NULL AS follower, COUNT(*) AS views
+		FROM watch_history w
+		LEFT JOIN user_follows f ON f.user_id = w.user_id AND f.follow_user_id = ?
+		WHERE w.video_id = ? AND w.user_id <> ? AND w.watched_at >= ? AND w.watched_at < ?
+		GROUP BY w.source, follower`,
+		authorID, videoID, authorID, start, end).Scan(&viewRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range viewRows {
+		addAudienceSplit(&audience.Views, row.Follower, row.Views)
+		audience.SourceViews[row.Source] += row.Views
+	}
+
+	var likeRows []struct {
+		Follower bool
+		Likes    int64
+	}
+	if err := db.Raw(`SELECT f.id IS NOT NULL AS follower, COUNT(*) AS likes
+		FROM user_favorites l
+		LEFT JOIN user_follows f ON f.user_id = l.user_id AND f.follow_user_id = ?
+		WHERE l.video_id = ? AND l.user_id <> ? AND l.created_at >= ? AND l.created_at < ?
+		GROUP BY follower`,
+		authorID, videoID, authorID, start, end).Scan(&likeRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range likeRows {
+		addAudienceSplit(&audience.Likes, row.Follower, row.Likes)
+	}
+
+	// 统计范围开始前看过该作者任一视频的观众记为回访观众
+	var viewers struct {
+		Total     int64
+		Returning int64
+	}
+	if err := db.Raw(`SELECT COUNT(*) AS total, COALESCE(SUM(EXISTS(
+			SELECT 1 FROM watch_history h JOIN videos v ON v.id = h.video_id
+			WHERE h.user_id = viewers.user_id AND v.author_id = ? AND h.watched_at < ?
+		)), 0) AS returning
+		FROM (
+			SELECT DISTINCT user_id FROM watch_history
+			WHERE video_id = ? AND user_id <> ? AND watched_at >= ? AND watched_at < ?
+		) viewers`,
+		authorID, start, videoID, authorID, start, end).Scan(&viewers).Error; err != nil {
+		return nil, err
+	}
+	audience.ReturningViewers = viewers.Returning
+	audience.NewViewers = viewers.Total - viewers.Returning
+
+	return audience, nil
+}
+
+func addAudienceSplit(split *biz.AudienceSplit, follower bool, count int64) {
+	if follower {
+		split.Follower += count
+	} else {
+		split.NonFollower += count
+	}
+}
+
+func watchDedupKey(userID, videoID int64) string {
+	return fmt.Sprintf("watch:dedup:%d:%d", userID, videoID)
+}
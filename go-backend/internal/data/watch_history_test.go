@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/testutils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchHistoryRepo(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := &watchHistoryRepo{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("DedupWithinWindow", func(t *testing.T) {
+		recorded, err := repo.RecordView(ctx, &biz.WatchHistoryEntry{UserID: 1, VideoID: 10, WatchedAt: now}, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, recorded)
+
+		recorded, err = repo.RecordView(ctx, &biz.WatchHistoryEntry{UserID: 1, VideoID: 10, WatchedAt: now}, time.Minute)
+		require.NoError(t, err)
+		assert.False(t, recorded)
+
+		// 窗口过期后再次记录
+		require.NoError(t, env.Redis.Client.Del(ctx, watchDedupKey(1, 10)).Err())
+		recorded, err = repo.RecordView(ctx, &biz.WatchHistoryEntry{UserID: 1, VideoID: 10, WatchedAt: now.Add(2 * time.Minute)}, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, recorded)
+	})
+
+	t.Run("ListNewestFirst", func(t *testing.T) {
+		_, err := repo.RecordView(ctx, &biz.WatchHistoryEntry{UserID: 1, VideoID: 11, WatchedAt: now.Add(time.Minute)}, 0)
+		require.NoError(t, err)
+		_, err = repo.RecordView(ctx, &biz.WatchHistoryEntry{UserID: 2, VideoID: 10, WatchedAt: now}, 0)
+		require.NoError(t, err)
+
+		entries, total, err := repo.ListWatchHistory(ctx, 1, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, entries, 2)
+		assert.Equal(t, int64(10), entries[0].VideoID)
+		assert.Equal(t, int64(11), entries[1].VideoID)
+
+		entries, _, err = repo.ListWatchHistory(ctx, 1, 2, 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, int64(10), entries[0].VideoID)
+	})
+}
+
+func TestWatchHistoryRepo_AggregateVideoAudience(t *testing.T) {
+	env, cleanup, err := testutils.SetupTestWithCleanup()
+	require.NoError(t, err)
+	defer cleanup()
+
+	repo := &watchHistoryRepo{
+		data: &Data{db: env.DB.DB, rdb: env.Redis.Client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+	ctx := context.Background()
+	now := time.Now()
+
+	fixture, err := env.DataManager.CreateUser(
+		testutils.WithFollowers(1),
+		testutils.WithVideos(2, domain.VideoStatusPublished),
+	)
+	require.NoError(t, err)
+	author := fixture.User.ID
+	follower := fixture.Followers[0].ID
+	video := fixture.Videos[0].ID
+	stranger, err := env.DataManager.CreateUser()
+	require.NoError(t, err)
+	viewer := stranger.User.ID
+
+	record := func(userID, videoID int64, source int32, at time.Time) {
+		_, err := repo.RecordView(ctx, &biz.WatchHistoryEntry{UserID: userID, VideoID: videoID, Source: source, WatchedAt: at}, 0)
+		require.NoError(t, err)
+	}
+	// 统计范围前看过作者的另一个视频，记为回访观众
+	record(viewer, fixture.Videos[1].ID, biz.WatchSourceFeed, now.Add(-2*time.Hour))
+	record(follower, video, biz.WatchSourceFeed, now)
+	record(viewer, video, biz.WatchSourceShare, now)
+	record(viewer, video, biz.WatchSourceProfile, now.Add(time.Minute))
+	record(author, video, biz.WatchSourceFeed, now)
+
+	require.NoError(t, env.DB.DB.Create(&UserFavorite{UserID: follower, VideoID: video}).Error)
+	require.NoError(t, env.DB.DB.Create(&UserFavorite{UserID: author, VideoID: video}).Error)
+
+	audience, err := repo.AggregateVideoAudience(ctx, video, author, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+
+	assert.Equal(t, biz.AudienceSplit{Follower: 1, NonFollower: 2}, audience.Views)
+	assert.Equal(t, biz.AudienceSplit{Follower: 1}, audience.Likes)
+	assert.Equal(t, int64(1), audience.NewViewers)
+	assert.Equal(t, int64(1), audience.ReturningViewers)
+	assert.Equal(t, map[int32]int64{
+		biz.WatchSourceFeed:    1,
+		biz.WatchSourceProfile: 1,
+		biz.WatchSourceShare:   1,
+	}, audience.SourceViews)
+}
@@ -281,10 +281,25 @@ func (e *SystemErrorEvent) GetSeverity() string {
 type CacheInvalidationEvent struct {
 	BaseEvent
 	CacheKey      string    `json:"cache_key"`
-	CacheType     string    `json:"cache_type"` // user, video, feed
+	CacheType     string    `json:"cache_type"` // user, video, user_videos, feed, relation
+	EntityIDs     []int64   `json:"entity_ids"` // 关注关系为 [user_id, follow_user_id]
 	InvalidatedAt time.Time `json:"invalidated_at"`
 }
 
+// 缓存类型常量
+const (
+	CacheTypeUser       = "user"
+	CacheTypeVideo      = "video"
+	CacheTypeUserVideos = "user_videos"
+	CacheTypeFeed       = "feed"
+	CacheTypeRelation   = "relation"
+)
+
+// CacheInvalidationPublisher 缓存失效事件发布器接口
+type CacheInvalidationPublisher interface {
+	PublishCacheInvalidation(ctx context.Context, events ...*CacheInvalidationEvent) error
+}
+
 // EventFactory 事件工厂
 type EventFactory struct{}
 
@@ -322,6 +337,20 @@ func (f *EventFactory) CreateVideoStatsUpdatedEvent(videoID int64, statsType str
 	}
 }
 
+// CreateVideoAuditEvent 创建视频审核事件
+func (f *EventFactory) CreateVideoAuditEvent(video *Video, auditStatus, reason string, auditorID int64) *VideoAuditEvent {
+	return &VideoAuditEvent{
+		VideoID:     video.ID,
+		AuthorID:    video.AuthorID,
+		AuditStatus: auditStatus,
+		AuditReason: reason,
+		AuditorID:   auditorID,
+		AuditedAt:   time.Now(),
+		EventID:     generateEventID(),
+		EventTime:   time.Now(),
+	}
+}
+
 // CreateVideoLikedEvent 创建视频点赞事件
 func (f *EventFactory) CreateVideoLikedEvent(userID, videoID, authorID int64) *VideoLikedEvent {
 	return &VideoLikedEvent{
@@ -339,6 +368,55 @@ func (f *EventFactory) CreateVideoLikedEvent(userID, videoID, authorID int64) *V
 	}
 }
 
+// CreateVideoUnlikedEvent 创建视频取消点赞事件
+func (f *EventFactory) CreateVideoUnlikedEvent(userID, videoID, authorID int64) *VideoUnlikedEvent {
+	return &VideoUnlikedEvent{
+		BaseEvent: BaseEvent{
+			EventID:     generateEventID(),
+			EventType:   EventTypeVideoUnliked,
+			AggregateID: fmt.Sprintf("video:%d", videoID),
+			EventTime:   time.Now(),
+			Version:     1,
+		},
+		UserID:    userID,
+		VideoID:   videoID,
+		AuthorID:  authorID,
+		UnlikedAt: time.Now(),
+	}
+}
+
+// CreateUserFollowedEvent 创建用户关注事件
+func (f *EventFactory) CreateUserFollowedEvent(userID, followUserID int64) *UserFollowedEvent {
+	return &UserFollowedEvent{
+		BaseEvent: BaseEvent{
+			EventID:     generateEventID(),
+			EventType:   EventTypeUserFollowed,
+			AggregateID: fmt.Sprintf("user:%d", followUserID),
+			EventTime:   time.Now(),
+			Version:     1,
+		},
+		UserID:       userID,
+		FollowUserID: followUserID,
+		FollowedAt:   time.Now(),
+	}
+}
+
+// CreateUserUnfollowedEvent 创建用户取消关注事件
+func (f *EventFactory) CreateUserUnfollowedEvent(userID, unfollowUserID int64) *UserUnfollowedEvent {
+	return &UserUnfollowedEvent{
+		BaseEvent: BaseEvent{
+			EventID:     generateEventID(),
+			EventType:   EventTypeUserUnfollowed,
+			AggregateID: fmt.Sprintf("user:%d", unfollowUserID),
+			EventTime:   time.Now(),
+			Version:     1,
+		},
+		UserID:         userID,
+		UnfollowUserID: unfollowUserID,
+		UnfollowedAt:   time.Now(),
+	}
+}
+
 // CreateCommentCreatedEvent 创建评论创建事件
 func (f *EventFactory) CreateCommentCreatedEvent(commentID, videoID, userID, authorID int64, content string, parentCommentID int64) *CommentCreatedEvent {
 	return &CommentCreatedEvent{
@@ -359,6 +437,39 @@ func (f *EventFactory) CreateCommentCreatedEvent(commentID, videoID, userID, aut
 	}
 }
 
+// CreateCommentDeletedEvent 创建评论删除事件
+func (f *EventFactory) CreateCommentDeletedEvent(commentID, videoID, userID int64) *CommentDeletedEvent {
+	return &CommentDeletedEvent{
+		BaseEvent: BaseEvent{
+			EventID:     generateEventID(),
+			EventType:   "comment.deleted",
+			AggregateID: fmt.Sprintf("comment:%d", commentID),
+			EventTime:   time.Now(),
+			Version:     1,
+		},
+		CommentID: commentID,
+		VideoID:   videoID,
+		UserID:    userID,
+		DeletedAt: time.Now(),
+	}
+}
+
+// CreateCacheInvalidationEvent 创建缓存失效事件
+func (f *EventFactory) CreateCacheInvalidationEvent(cacheType string, entityIDs ...int64) *CacheInvalidationEvent {
+	return &CacheInvalidationEvent{
+		BaseEvent: BaseEvent{
+			EventID:     generateEventID(),
+			EventType:   EventTypeCacheInvalidation,
+			AggregateID: fmt.Sprintf("cache:%s", cacheType),
+			EventTime:   time.Now(),
+			Version:     1,
+		},
+		CacheType:     cacheType,
+		EntityIDs:     entityIDs,
+		InvalidatedAt: time.Now(),
+	}
+}
+
 // EventBus 事件总线接口
 type EventBus interface {
 	Subscribe(eventType string, handler EventHandler) error
@@ -367,6 +478,16 @@ type EventBus interface {
 	PublishAsync(ctx context.Context, event DomainEvent) error
 }
 
+// InteractionEventPublisher 互动事件发布器接口
+type InteractionEventPublisher interface {
+	PublishVideoLikedEvent(ctx context.Context, event *VideoLikedEvent) error
+	PublishVideoUnlikedEvent(ctx context.Context, event *VideoUnlikedEvent) error
+	PublishCommentCreatedEvent(ctx context.Context, event *CommentCreatedEvent) error
+	PublishCommentDeletedEvent(ctx context.Context, event *CommentDeletedEvent) error
+	PublishUserFollowedEvent(ctx context.Context, event *UserFollowedEvent) error
+	PublishUserUnfollowedEvent(ctx context.Context, event *UserUnfollowedEvent) error
+}
+
 // generateEventID 生成事件ID
 func generateEventID() string {
 	return fmt.Sprintf("evt_%d_%s", time.Now().UnixNano(), randomString(8))
@@ -9,6 +9,7 @@ type Permission struct {
 	Resource    string    `json:"resource"`
 	Action      string    `json:"action"`
 	Description string    `json:"description"`
+	Scope       string    `json:"scope"` // 生效范围，空表示不限资源
 	Status      int8      `json:"status"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
@@ -22,6 +23,16 @@ type RolePermission struct {
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+// PermissionDenial 被拒绝的权限检查记录
+type PermissionDenial struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Resource  string    `json:"resource"`
+	Action    string    `json:"action"`
+	Route     string    `json:"route"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 // PermissionStatus 权限状态枚举
 type PermissionStatus int8
 
@@ -30,6 +41,12 @@ const (
 	PermissionStatusInactive PermissionStatus = 2 // 禁用
 )
 
+// 权限生效范围
+const (
+	PermissionScopeAll = ""    // 对所有资源生效
+	PermissionScopeOwn = "own" // 仅对用户拥有的资源生效，需要按资源ID解析归属
+)
+
 // ActionType 操作类型常量
 const (
 	ActionGet    = "GET"
@@ -44,8 +61,22 @@ func (p *Permission) IsActive() bool {
 	return p.Status == int8(PermissionStatusActive)
 }
 
-// Match 检查权限是否匹配资源和操作
+// IsScoped 检查权限是否带有范围条件
+func (p *Permission) IsScoped() bool {
+	return p.Scope != PermissionScopeAll
+}
+
+// Match 检查权限是否无条件匹配资源和操作，带范围条件的权限不参与匹配
 func (p *Permission) Match(resource, action string) bool {
+	return !p.IsScoped() && p.matchResourceAction(resource, action)
+}
+
+// MatchScoped 检查带范围条件的权限是否匹配资源和操作，条件本身由调用方评估
+func (p *Permission) MatchScoped(resource, action string) bool {
+	return p.IsScoped() && p.matchResourceAction(resource, action)
+}
+
+func (p *Permission) matchResourceAction(resource, action string) bool {
 	if !p.IsActive() {
 		return false
 	}
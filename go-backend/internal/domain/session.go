@@ -7,6 +7,7 @@ type UserSession struct {
 	ID           int64     `json:"id"`
 	UserID       int64     `json:"user_id"`
 	RefreshToken string    `json:"refresh_token"`
+	FamilyID     string    `json:"family_id"` // Refresh Token轮换族ID，轮换时保持不变
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
 }
@@ -19,6 +20,20 @@ type TokenBlacklist struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// SecurityEvent 安全事件，用于告警和审计
+type SecurityEvent struct {
+	Type       string    `json:"type"`
+	UserID     int64     `json:"user_id"`
+	Detail     string    `json:"detail"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// 安全事件类型常量
+const (
+	SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+	SecurityEventLoginAnomaly      = "login_anomaly"
+)
+
 // TokenPair Token对
 type TokenPair struct {
 	AccessToken  string    `json:"access_token"`
@@ -28,12 +43,22 @@ type TokenPair struct {
 
 // IsExpired 检查会话是否过期
 func (s *UserSession) IsExpired() bool {
-	return time.Now().After(s.ExpiresAt)
+	return s.ExpiredAt(time.Now())
+}
+
+// ExpiredAt 检查会话在 now 时是否已过期
+func (s *UserSession) ExpiredAt(now time.Time) bool {
+	return now.After(s.ExpiresAt)
 }
 
 // IsExpired 检查黑名单项是否过期
 func (t *TokenBlacklist) IsExpired() bool {
-	return time.Now().After(t.ExpiresAt)
+	return t.ExpiredAt(time.Now())
+}
+
+// ExpiredAt 检查黑名单项在 now 时是否已过期
+func (t *TokenBlacklist) ExpiredAt(now time.Time) bool {
+	return now.After(t.ExpiresAt)
 }
 
 // Refresh 刷新会话
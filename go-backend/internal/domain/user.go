@@ -38,6 +38,12 @@ type UserStatus int8
 const (
 	UserStatusActive   UserStatus = 1 // 正常
 	UserStatusInactive UserStatus = 2 // 禁用
+	// UserStatusPendingDeletion 注销冷静期，期满后账号及关联数据被清除
+	UserStatusPendingDeletion UserStatus = 3
+	// UserStatusBanned 被管理员封禁，令牌校验失败，内容对其他用户隐藏，解封后恢复
+	UserStatusBanned UserStatus = 4
+	// UserStatusDeleted 冷静期满已注销，个人信息已匿名化，行保留以维持引用
+	UserStatusDeleted UserStatus = 5
 )
 
 // IsActive 检查用户是否激活
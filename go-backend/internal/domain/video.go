@@ -2,23 +2,115 @@ package domain
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // Video 视频领域模型
 type Video struct {
-	ID            int64     `json:"id"`
-	AuthorID      int64     `json:"author_id"`
-	Title         string    `json:"title"`
-	PlayURL       string    `json:"play_url"`
-	CoverURL      string    `json:"cover_url"`
-	FavoriteCount int64     `json:"favorite_count"`
-	CommentCount  int64     `json:"comment_count"`
-	PlayCount     int64     `json:"play_count"`
-	Status        int32     `json:"status"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID       int64  `json:"id"`
+	AuthorID int64  `json:"author_id"`
+	Title    string `json:"title"`
+	PlayURL  string `json:"play_url"`
+	CoverURL string `json:"cover_url"`
+	// PlayURLs 各清晰度转码后的播放地址，键为清晰度名称，转码完成前为空
+	PlayURLs map[string]string `json:"play_urls,omitempty"`
+	// HLSURL 自适应码率主播放列表地址，HLS切片完成前为空
+	HLSURL string `json:"hls_url,omitempty"`
+	// DynamicCoverURL 动态封面地址（视频开头几秒的 GIF 或动图 WebP），生成前为空
+	DynamicCoverURL string `json:"dynamic_cover_url,omitempty"`
+	// CategoryID 视频分类，0表示未分类
+	CategoryID int64 `json:"category_id"`
+	// 原始视频的元信息，探测失败时为零值，Size 和 Format 总是有值
+	Duration      float64 `json:"duration"` // 时长（秒）
+	Width         int32   `json:"width"`
+	Height        int32   `json:"height"`
+	Bitrate       int64   `json:"bitrate"`                 // 码率（bps）
+	Size          int64   `json:"size"`                    // 文件大小（字节）
+	Format        string  `json:"format"`                  // 容器格式，取自文件扩展名，如 mp4
+	AudioCodec    string  `json:"audio_codec,omitempty"`   // 音频编码，如 aac，没有音轨时为空
+	AudioBitrate  int64   `json:"audio_bitrate,omitempty"` // 音频码率（bps）
+	FavoriteCount int64   `json:"favorite_count"`
+	CommentCount  int64   `json:"comment_count"`
+	PlayCount     int64   `json:"play_count"`
+	ShareCount    int64   `json:"share_count"`
+	Status        int32   `json:"status"`
+	Visibility    int32   `json:"visibility,omitempty"` // 可见范围，与 Status 独立，0 按公开处理
+	// PublishAt 草稿的计划发布时间，发布后为空
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// CreatedAt 发布时间，定时发布的视频为实际发布的时间
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsPublic 是否对所有人可见。缓存中早于可见范围字段的数据没有该字段，按公开处理
+func (v *Video) IsPublic() bool {
+	return v.Visibility == VideoVisibilityPublic || v.Visibility == 0
+}
+
+// PlayURLFor 按请求的清晰度选择播放地址：优先精确匹配，否则取不高于请求的最高清晰度，
+// 都高于请求时取最低清晰度。未指定清晰度或尚未转码时返回原始播放地址
+func (v *Video) PlayURLFor(quality string) string {
+	if quality == "" || len(v.PlayURLs) == 0 {
+		return v.PlayURL
+	}
+	if url, ok := v.PlayURLs[quality]; ok {
+		return url
+	}
+
+	requested, known := renditionHeight(quality)
+	if !known {
+		return v.PlayURL
+	}
+
+	var best, lowest *Rendition
+	for i := range VideoRenditions {
+		r := &VideoRenditions[i]
+		if _, ok := v.PlayURLs[r.Quality]; !ok {
+			continue
+		}
+		if lowest == nil {
+			lowest = r
+		}
+		if r.Height <= requested {
+			best = r
+		}
+	}
+	if best == nil {
+		best = lowest
+	}
+	if best == nil {
+		return v.PlayURL
+	}
+	return v.PlayURLs[best.Quality]
+}
+
+// Rendition 转码输出的清晰度规格
+type Rendition struct {
+	Quality   string
+	Width     int
+	Height    int
+	Bandwidth int64 // 码率估计（bps），写入HLS主播放列表供客户端选择码流
+}
+
+// VideoRenditions 转码输出的清晰度，按分辨率升序
+var VideoRenditions = []Rendition{
+	{Quality: "480p", Width: 854, Height: 480, Bandwidth: 1_400_000},
+	{Quality: "720p", Width: 1280, Height: 720, Bandwidth: 2_800_000},
+	{Quality: "1080p", Width: 1920, Height: 1080, Bandwidth: 5_000_000},
+}
+
+func renditionHeight(quality string) (int, bool) {
+	for _, r := range VideoRenditions {
+		if r.Quality == quality {
+			return r.Height, true
+		}
+	}
+	return 0, false
 }
 
 // VideoFile 视频文件信息
@@ -29,13 +121,50 @@ type VideoFile struct {
 	ContentType string `json:"content_type"`
 }
 
+// ErrInvalidFeedCursor 视频流游标无法解析
+var ErrInvalidFeedCursor = errors.New("invalid feed cursor")
+
+// FeedCursor 视频流翻页位置，按 (CreatedAt, VideoID) 倒序定位，同一秒发布的视频不会被跳过。
+// VideoID 为 0 时只按时间定位，对应旧版 latest_time 参数
+type FeedCursor struct {
+	CreatedAt time.Time
+	VideoID   int64
+}
+
+// Encode 编码为不透明的游标字符串，客户端原样回传
+func (c *FeedCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.Unix(), 10) + ":" + strconv.FormatInt(c.VideoID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseFeedCursor 解析 Encode 生成的游标
+func ParseFeedCursor(s string) (*FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidFeedCursor
+	}
+	secStr, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidFeedCursor
+	}
+	sec, err := strconv.ParseInt(secStr, 10, 64)
+	if err != nil || sec <= 0 {
+		return nil, ErrInvalidFeedCursor
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id < 0 {
+		return nil, ErrInvalidFeedCursor
+	}
+	return &FeedCursor{CreatedAt: time.Unix(sec, 0), VideoID: id}, nil
+}
+
 // VideoRepository 视频数据仓储接口
 type VideoRepository interface {
 	CreateVideo(ctx context.Context, video *Video) error
 	GetVideo(ctx context.Context, videoID int64) (*Video, error)
 	GetVideos(ctx context.Context, videoIDs []int64) ([]*Video, error)
 	GetUserVideos(ctx context.Context, userID int64, limit int) ([]*Video, error)
-	GetFeedVideos(ctx context.Context, latestTime time.Time, limit int) ([]*Video, error)
+	GetFeedVideos(ctx context.Context, cursor *FeedCursor, categoryID int64, limit int) ([]*Video, error)
 	UpdateVideoStats(ctx context.Context, videoID int64, field string, delta int64) error
 	UpdateVideo(ctx context.Context, video *Video) error
 }
@@ -57,13 +186,16 @@ type VideoProcessor interface {
 
 // VideoMetadata 视频元信息
 type VideoMetadata struct {
-	Duration  int64  `json:"duration"`  // 时长(秒)
-	Width     int32  `json:"width"`     // 宽度
-	Height    int32  `json:"height"`    // 高度
-	Bitrate   int64  `json:"bitrate"`   // 比特率
-	Format    string `json:"format"`    // 格式
-	Size      int64  `json:"size"`      // 文件大小
-	Framerate string `json:"framerate"` // 帧率
+	Duration  float64 `json:"duration"`  // 时长(秒)
+	Width     int32   `json:"width"`     // 宽度
+	Height    int32   `json:"height"`    // 高度
+	Bitrate   int64   `json:"bitrate"`   // 比特率
+	Format    string  `json:"format"`    // 格式
+	Size      int64   `json:"size"`      // 文件大小
+	Framerate string  `json:"framerate"` // 帧率
+	// 音频编码和码率，没有音轨时为空
+	AudioCodec   string `json:"audio_codec"`
+	AudioBitrate int64  `json:"audio_bitrate"`
 }
 
 // VideoEventPublisher 视频事件发布器接口
@@ -72,6 +204,7 @@ type VideoEventPublisher interface {
 	PublishVideoProcessedEvent(ctx context.Context, event *VideoProcessedEvent) error
 	PublishVideoStatsUpdatedEvent(ctx context.Context, event *VideoStatsUpdatedEvent) error
 	PublishVideoDeletedEvent(ctx context.Context, event *VideoDeletedEvent) error
+	PublishVideoAuditedEvent(ctx context.Context, event *VideoAuditEvent) error
 }
 
 // VideoUploadedEvent 视频上传事件
@@ -139,23 +272,69 @@ type VideoAuditEvent struct {
 
 // 视频状态常量
 const (
-	VideoStatusPending   = 0 // 处理中
-	VideoStatusPublished = 1 // 已发布
-	VideoStatusPrivate   = 2 // 私密
-	VideoStatusDeleted   = 3 // 已删除
-	VideoStatusFailed    = 4 // 处理失败
-	VideoStatusAuditing  = 5 // 审核中
-	VideoStatusRejected  = 6 // 审核拒绝
+	VideoStatusPending     = 0  // 处理中
+	VideoStatusPublished   = 1  // 已发布
+	VideoStatusPrivate     = 2  // 私密
+	VideoStatusDeleted     = 3  // 已删除
+	VideoStatusFailed      = 4  // 处理失败
+	VideoStatusAuditing    = 5  // 审核中
+	VideoStatusRejected    = 6  // 审核拒绝
+	VideoStatusHidden      = 7  // 作者账号注销冷静期内或被封禁时隐藏，撤销注销或解封后恢复为已发布
+	VideoStatusTakenDown   = 8  // 被管理员下架，申诉成功后恢复为下架前的状态
+	VideoStatusUnavailable = 9  // 原始文件丢失或损坏，无法播放也无法重新转码
+	VideoStatusDraft       = 10 // 草稿，仅作者可见，到达计划发布时间后由定时发布任务发布
+	VideoStatusQuarantined = 11 // 上传扫描发现病毒或未能完成扫描，文件已隔离，不会处理和发布，仅作者可见
+)
+
+// 视频可见范围常量
+const (
+	VideoVisibilityPublic  = 1 // 公开
+	VideoVisibilityFriends = 2 // 仅互相关注的好友和作者可见
+	VideoVisibilityPrivate = 3 // 仅作者可见
 )
 
+// ValidVideoVisibility 是否为有效的可见范围
+func ValidVideoVisibility(visibility int32) bool {
+	return visibility >= VideoVisibilityPublic && visibility <= VideoVisibilityPrivate
+}
+
 // 视频处理类型常量
 const (
-	ProcessTypeTranscode = "transcode"
-	ProcessTypeThumbnail = "thumbnail"
-	ProcessTypeAudit     = "audit"
-	ProcessTypeWatermark = "watermark"
+	ProcessTypeTranscode      = "transcode"
+	ProcessTypeThumbnail      = "thumbnail"
+	ProcessTypeDynamicCover   = "dynamic_cover"
+	ProcessTypeAudioNormalize = "audio_normalize"
+	ProcessTypeAudit          = "audit"
+	ProcessTypeWatermark      = "watermark"
 )
 
+// ProcessingJob 一次视频处理任务的耗时和资源消耗
+type ProcessingJob struct {
+	ID           int64     `json:"id"`
+	VideoID      int64     `json:"video_id"`
+	AuthorID     int64     `json:"author_id"`
+	JobType      string    `json:"job_type"` // transcode, thumbnail
+	Status       string    `json:"status"`   // success, failed
+	DurationMs   int64     `json:"duration_ms"`
+	CPUSeconds   float64   `json:"cpu_seconds"` // 外部处理进程的用户态和内核态CPU时间
+	InputBytes   int64     `json:"input_bytes"`
+	OutputBytes  int64     `json:"output_bytes"`
+	ErrorMessage string    `json:"error_message"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ProcessingStat 按天和创作者聚合的处理统计
+type ProcessingStat struct {
+	Day         string  `json:"day"` // YYYY-MM-DD
+	AuthorID    int64   `json:"author_id"`
+	Jobs        int64   `json:"jobs"`
+	FailedJobs  int64   `json:"failed_jobs"`
+	DurationMs  int64   `json:"duration_ms"`
+	CPUSeconds  float64 `json:"cpu_seconds"`
+	InputBytes  int64   `json:"input_bytes"`
+	OutputBytes int64   `json:"output_bytes"`
+}
+
 // 视频处理状态常量
 const (
 	ProcessStatusPending    = "pending"
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideo_PlayURLFor(t *testing.T) {
+	video := &Video{
+		PlayURL: "original.mp4",
+		PlayURLs: map[string]string{
+			"480p": "480.mp4",
+			"720p": "720.mp4",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		quality string
+		want    string
+	}{
+		{"Default", "", "original.mp4"},
+		{"Exact", "480p", "480.mp4"},
+		{"HighestNotAbove", "1080p", "720.mp4"},
+		{"UnknownQuality", "4k", "original.mp4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, video.PlayURLFor(tt.quality))
+		})
+	}
+
+	t.Run("LowestWhenAllAbove", func(t *testing.T) {
+		v := &Video{PlayURL: "original.mp4", PlayURLs: map[string]string{"720p": "720.mp4", "1080p": "1080.mp4"}}
+		assert.Equal(t, "720.mp4", v.PlayURLFor("480p"))
+	})
+
+	t.Run("NotTranscoded", func(t *testing.T) {
+		v := &Video{PlayURL: "original.mp4"}
+		assert.Equal(t, "original.mp4", v.PlayURLFor("720p"))
+	})
+}
+
+func TestFeedCursor(t *testing.T) {
+	cursor := &FeedCursor{CreatedAt: time.Unix(1700000000, 0), VideoID: 42}
+
+	parsed, err := ParseFeedCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.CreatedAt.Unix(), parsed.CreatedAt.Unix())
+	assert.Equal(t, int64(42), parsed.VideoID)
+
+	for _, s := range []string{"", "not base64!", "MTcwMDAwMDAwMA", "YWJjOjQy", "MDo0Mg"} {
+		_, err := ParseFeedCursor(s)
+		assert.ErrorIs(t, err, ErrInvalidFeedCursor, s)
+	}
+}
@@ -2,26 +2,40 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	nethttp "net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"go-backend/api/common/v1"
 	"go-backend/pkg/auth"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/utils"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
+// BanChecker 查询账号是否被封禁，每个认证请求调用一次
+type BanChecker interface {
+	IsUserBanned(ctx context.Context, userID int64) (bool, error)
+}
+
 type AuthMiddleware struct {
 	jwtManager *auth.JWTManager
+	banChecker BanChecker
 	log        *log.Helper
 }
 
-func NewAuthMiddleware(jwtManager *auth.JWTManager, logger log.Logger) *AuthMiddleware {
+func NewAuthMiddleware(jwtManager *auth.JWTManager, banChecker BanChecker, logger log.Logger) *AuthMiddleware {
 	return &AuthMiddleware{
 		jwtManager: jwtManager,
+		banChecker: banChecker,
 		log:        log.NewHelper(logger),
 	}
 }
@@ -35,7 +49,7 @@ func (a *AuthMiddleware) JWTAuth() middleware.Middleware {
 				return nil, errors.New("transport not found")
 			}
 
-			token := a.extractToken(tr)
+			token := ExtractToken(tr)
 			if token == "" {
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "token required")
 			}
@@ -45,16 +59,40 @@ func (a *AuthMiddleware) JWTAuth() middleware.Middleware {
 				a.log.WithContext(ctx).Warnf("invalid token: %v", err)
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "invalid token")
 			}
+			if a.isBanned(ctx, claims.UserID) {
+				return nil, NewAuthError(v1.ErrorCode_USER_BANNED, "account banned")
+			}
 
-			ctx = WithUserID(ctx, claims.UserID)
-			ctx = WithUsername(ctx, claims.Username)
-			ctx = WithTokenID(ctx, claims.TokenID)
+			ctx = reqctx.WithClaims(ctx, claims)
 
 			return handler(ctx, req)
 		}
 	}
 }
 
+// jwksMaxAge 下游缓存 JWKS 的时长，应小于签名密钥的 activation_delay，保证新密钥生效前下游已刷新
+const jwksMaxAge = time.Minute
+
+// JWKSHandler 发布非对称签名密钥的公钥，供其他服务和网关独立验证 Access Token
+func (a *AuthMiddleware) JWKSHandler() nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodGet && r.Method != nethttp.MethodHead {
+			w.WriteHeader(nethttp.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := json.Marshal(a.jwtManager.Keyring().JWKS(time.Now()))
+		if err != nil {
+			a.log.Errorf("marshal jwks failed: %v", err)
+			w.WriteHeader(nethttp.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(jwksMaxAge.Seconds())))
+		_, _ = w.Write(body)
+	})
+}
+
 // OptionalJWTAuth 可选JWT认证中间件
 func (a *AuthMiddleware) OptionalJWTAuth() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
@@ -64,13 +102,12 @@ func (a *AuthMiddleware) OptionalJWTAuth() middleware.Middleware {
 				return handler(ctx, req)
 			}
 
-			token := a.extractToken(tr)
+			token := ExtractToken(tr)
 			if token != "" {
+				// 被封禁的用户按匿名用户处理
 				claims, err := a.jwtManager.VerifyToken(token)
-				if err == nil {
-					ctx = WithUserID(ctx, claims.UserID)
-					ctx = WithUsername(ctx, claims.Username)
-					ctx = WithTokenID(ctx, claims.TokenID)
+				if err == nil && !a.isBanned(ctx, claims.UserID) {
+					ctx = reqctx.WithClaims(ctx, claims)
 				}
 			}
 
@@ -98,19 +135,32 @@ func (a *AuthMiddleware) RefreshTokenAuth() middleware.Middleware {
 				a.log.WithContext(ctx).Warnf("invalid refresh token: %v", err)
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "invalid refresh token")
 			}
+			if a.isBanned(ctx, claims.UserID) {
+				return nil, NewAuthError(v1.ErrorCode_USER_BANNED, "account banned")
+			}
 
-			ctx = WithUserID(ctx, claims.UserID)
-			ctx = WithUsername(ctx, claims.Username)
-			ctx = WithRefreshToken(ctx, refreshToken)
-			ctx = WithTokenID(ctx, claims.TokenID)
+			ctx = reqctx.WithUserID(ctx, claims.UserID)
+			ctx = reqctx.WithUsername(ctx, claims.Username)
+			ctx = reqctx.WithRefreshToken(ctx, refreshToken)
+			ctx = reqctx.WithTokenID(ctx, claims.TokenID)
 
 			return handler(ctx, req)
 		}
 	}
 }
 
-// extractToken 从请求中提取Token
-func (a *AuthMiddleware) extractToken(tr transport.Transporter) string {
+// isBanned 查询失败时放行，避免 Redis 故障导致所有认证请求失败
+func (a *AuthMiddleware) isBanned(ctx context.Context, userID int64) bool {
+	banned, err := a.banChecker.IsUserBanned(ctx, userID)
+	if err != nil {
+		a.log.WithContext(ctx).Warnf("check user ban failed: user=%d err=%v", userID, err)
+		return false
+	}
+	return banned
+}
+
+// ExtractToken 从请求头或查询参数中提取Access Token
+func ExtractToken(tr transport.Transporter) string {
 	if header := tr.RequestHeader(); header != nil {
 		if auth := header.Get("Authorization"); auth != "" {
 			if strings.HasPrefix(auth, "Bearer ") {
@@ -151,19 +201,20 @@ func (a *AuthMiddleware) extractRefreshToken(tr transport.Transporter) string {
 	return ""
 }
 
-// GetUserIDFromToken 从Token解析用户ID
-func GetUserIDFromToken(ctx context.Context, token string) (int64, bool) {
-	// 这是一个简化实现，实际项目中应该注入JWTManager
-	if token == "" {
-		return 0, false
-	}
-
-	// 临时实现：从上下文获取
-	userID, ok := GetUserIDFromContext(ctx)
-	return userID, ok
-}
-
-// NewAuthError 创建认证错误
+// NewAuthError 创建中间件拒绝请求的错误，按错误码映射HTTP状态码
 func NewAuthError(code v1.ErrorCode, message string) error {
-	return errors.New(message)
+	switch code {
+	case v1.ErrorCode_TOKEN_INVALID, v1.ErrorCode_TOKEN_EXPIRED, v1.ErrorCode_SIGNATURE_INVALID:
+		return utils.NewUnauthorizedError(code, message)
+	case v1.ErrorCode_PERMISSION_DENIED, v1.ErrorCode_USER_BANNED:
+		return utils.NewForbiddenError(code, message)
+	case v1.ErrorCode_PARAM_ERROR:
+		return utils.NewBadRequestError(code, message)
+	case v1.ErrorCode_RATE_LIMIT:
+		return kerrors.New(nethttp.StatusTooManyRequests, code.String(), message)
+	case v1.ErrorCode_REQUEST_REPLAYED:
+		return kerrors.Conflict(code.String(), message)
+	default:
+		return utils.NewInternalError(code, message)
+	}
 }
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"context"
+
+	"go-backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// DegradationMiddleware 统计请求负载，作为降级策略的评估信号
+type DegradationMiddleware struct {
+	degradationUc *biz.DegradationUsecase
+}
+
+// NewDegradationMiddleware 创建请求负载统计中间件
+func NewDegradationMiddleware(degradationUc *biz.DegradationUsecase) *DegradationMiddleware {
+	return &DegradationMiddleware{
+		degradationUc: degradationUc,
+	}
+}
+
+// Track 记录请求的耗时和结果，需放在恢复中间件之外，使panic转换的错误也被计入
+func (m *DegradationMiddleware) Track() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			done := m.degradationUc.TrackRequest()
+			reply, err := handler(ctx, req)
+			done(err)
+			return reply, err
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"go-backend/pkg/reqctx"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// MetadataMiddleware 请求元数据中间件
+type MetadataMiddleware struct {
+	log *log.Helper
+}
+
+// NewMetadataMiddleware 创建请求元数据中间件
+func NewMetadataMiddleware(logger log.Logger) *MetadataMiddleware {
+	return &MetadataMiddleware{
+		log: log.NewHelper(logger),
+	}
+}
+
+// Propagate 从请求头提取链路ID、租户、设备ID、客户端IP和User-Agent写入上下文，缺少链路ID时自动生成并回写到响应头
+func (m *MetadataMiddleware) Propagate() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			header := tr.RequestHeader()
+			traceID := header.Get(reqctx.HeaderTraceID)
+			if traceID == "" {
+				traceID = reqctx.NewTraceID()
+			}
+			ctx = reqctx.WithTraceID(ctx, traceID)
+
+			if tenant := header.Get(reqctx.HeaderTenant); tenant != "" {
+				ctx = reqctx.WithTenant(ctx, tenant)
+			}
+			if deviceID := header.Get(reqctx.HeaderDeviceID); deviceID != "" {
+				ctx = reqctx.WithDeviceID(ctx, deviceID)
+			}
+			if ip := clientIP(tr); ip != "" {
+				ctx = reqctx.WithClientIP(ctx, ip)
+			}
+			if userAgent := header.Get("User-Agent"); userAgent != "" {
+				ctx = reqctx.WithUserAgent(ctx, userAgent)
+			}
+
+			if replyHeader := tr.ReplyHeader(); replyHeader != nil {
+				replyHeader.Set(reqctx.HeaderTraceID, traceID)
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// clientIP 获取HTTP请求的客户端IP，优先使用代理头中最靠近客户端的地址
+func clientIP(tr transport.Transporter) string {
+	ht, ok := tr.(http.Transporter)
+	if !ok {
+		return ""
+	}
+
+	req := ht.Request()
+	if xff := req.Header.Get("X-Forwarded-For"); xff != "" {
+		first, _, _ := strings.Cut(xff, ",")
+		return strings.TrimSpace(first)
+	}
+	if xri := req.Header.Get("X-Real-IP"); xri != "" {
+		return strings.TrimSpace(xri)
+	}
+
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go-backend/api/common/v1"
+	"go-backend/pkg/metrics"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// baseReply 携带业务状态码的响应，业务错误以 Base.StatusCode 返回而不是error
+type baseReply interface {
+	GetBase() *v1.BaseResponse
+}
+
+// MetricsMiddleware 按RPC统计请求数、耗时和错误
+type MetricsMiddleware struct {
+	provider *metrics.Provider
+	requests metric.Int64Counter
+	seconds  metric.Float64Histogram
+}
+
+// NewMetricsMiddleware 创建请求指标中间件
+func NewMetricsMiddleware(provider *metrics.Provider) *MetricsMiddleware {
+	meter := otel.Meter("go-backend/server")
+	requests, _ := meter.Int64Counter("server_requests_total",
+		metric.WithDescription("Requests handled by the server, by operation and result code"))
+	seconds, _ := meter.Float64Histogram("server_request_duration_seconds",
+		metric.WithDescription("Request handling duration"), metric.WithUnit("s"))
+
+	return &MetricsMiddleware{
+		provider: provider,
+		requests: requests,
+		seconds:  seconds,
+	}
+}
+
+// Server 记录每个请求的结果和耗时，需放在恢复中间件之外，使panic转换的错误也被计入
+func (m *MetricsMiddleware) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			kind, operation := "", ""
+			if info, ok := transport.FromServerContext(ctx); ok {
+				kind, operation = info.Kind().String(), info.Operation()
+			}
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			code, reason := requestResult(reply, err)
+
+			attrs := metric.WithAttributes(
+				attribute.String("kind", kind),
+				attribute.String("operation", operation),
+			)
+			m.seconds.Record(ctx, time.Since(start).Seconds(), attrs)
+			m.requests.Add(ctx, 1, attrs, metric.WithAttributes(
+				attribute.String("code", code),
+				attribute.String("reason", reason),
+				attribute.Bool("error", code != "0"),
+			))
+			return reply, err
+		}
+	}
+}
+
+// Handler 返回 /metrics 端点的处理器
+func (m *MetricsMiddleware) Handler() http.Handler {
+	return m.provider.Handler()
+}
+
+// requestResult 返回请求的结果码：error取kratos错误码和原因，否则取响应中的业务状态码
+func requestResult(reply interface{}, err error) (string, string) {
+	if err != nil {
+		e := kerrors.FromError(err)
+		return strconv.Itoa(int(e.Code)), e.Reason
+	}
+	if r, ok := reply.(baseReply); ok && r.GetBase() != nil {
+		return strconv.Itoa(int(r.GetBase().GetStatusCode())), ""
+	}
+	return "0", ""
+}
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"go-backend/internal/biz"
+
 	"github.com/google/wire"
 )
 
@@ -11,4 +13,10 @@ var ProviderSet = wire.NewSet(
 	NewRateLimitMiddleware,
 	NewSecurityMiddleware,
 	NewVideoMiddleware,
+	NewMetadataMiddleware,
+	NewCallbackMiddleware,
+	NewDegradationMiddleware,
+	NewMetricsMiddleware,
+	wire.Bind(new(biz.RateLimitInspector), new(*RateLimitMiddleware)),
+	wire.Bind(new(BanChecker), new(*biz.UserBanUsecase)),
 )
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"go-backend/api/common/v1"
+	"go-backend/internal/biz"
+	"go-backend/pkg/reqctx"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
@@ -17,6 +19,7 @@ import (
 
 // RateLimitMiddleware 限流中间件
 type RateLimitMiddleware struct {
+	global   *rate.Limiter
 	limiters map[string]*rate.Limiter
 	mutex    sync.RWMutex
 	log      *log.Helper
@@ -32,13 +35,22 @@ func NewRateLimitMiddleware(logger log.Logger) *RateLimitMiddleware {
 
 // Limit 全局限流
 func (m *RateLimitMiddleware) Limit() middleware.Middleware {
-	return m.LimitWithConfig(100, 10) // 每秒100次，突发10次
+	m.mutex.Lock()
+	if m.global == nil {
+		m.global = rate.NewLimiter(100, 10) // 每秒100次，突发10次
+	}
+	limiter := m.global
+	m.mutex.Unlock()
+
+	return m.limit(limiter)
 }
 
 // LimitWithConfig 自定义限流配置
 func (m *RateLimitMiddleware) LimitWithConfig(rps, burst int) middleware.Middleware {
-	limiter := rate.NewLimiter(rate.Limit(rps), burst)
+	return m.limit(rate.NewLimiter(rate.Limit(rps), burst))
+}
 
+func (m *RateLimitMiddleware) limit(limiter *rate.Limiter) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			if !limiter.Allow() {
@@ -74,7 +86,7 @@ func (m *RateLimitMiddleware) LimitByIP(rps, burst int) middleware.Middleware {
 func (m *RateLimitMiddleware) LimitByUser(rps, burst int) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			userID, ok := GetUserIDFromContext(ctx)
+			userID, ok := reqctx.UserID(ctx)
 			if !ok {
 				// 未认证用户使用IP限流
 				return m.LimitByIP(rps/2, burst/2)(handler)(ctx, req)
@@ -112,6 +124,36 @@ func (m *RateLimitMiddleware) LimitComment() middleware.Middleware {
 	return m.LimitByUser(20, 10) // 每秒20次，突发10次
 }
 
+// UserBuckets 返回影响该用户请求的限流桶：全局桶和已创建的用户桶
+func (m *RateLimitMiddleware) UserBuckets(userID int64) []biz.RateLimitBucket {
+	m.mutex.RLock()
+	global := m.global
+	user := m.limiters[fmt.Sprintf("user:%d", userID)]
+	m.mutex.RUnlock()
+
+	var buckets []biz.RateLimitBucket
+	if global != nil {
+		buckets = append(buckets, rateLimitBucket("global", global))
+	}
+	if user != nil {
+		buckets = append(buckets, rateLimitBucket("user", user))
+	}
+	return buckets
+}
+
+func rateLimitBucket(name string, limiter *rate.Limiter) biz.RateLimitBucket {
+	remaining := int(limiter.Tokens())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return biz.RateLimitBucket{
+		Name:      name,
+		Rate:      float64(limiter.Limit()),
+		Burst:     limiter.Burst(),
+		Remaining: remaining,
+	}
+}
+
 // getLimiter 获取或创建限流器
 func (m *RateLimitMiddleware) getLimiter(key string, rps, burst int) *rate.Limiter {
 	m.mutex.RLock()
@@ -5,7 +5,9 @@ import (
 	"strings"
 
 	"go-backend/api/common/v1"
+	"go-backend/internal/domain"
 	"go-backend/pkg/auth"
+	"go-backend/pkg/reqctx"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
@@ -13,16 +15,21 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
+// adminServicePrefix 管理后台服务的 operation 前缀
+const adminServicePrefix = "/admin.v1.AdminService/"
+
 // RBACMiddleware RBAC权限中间件
 type RBACMiddleware struct {
 	permissionChecker auth.PermissionChecker
+	denialRecorder    auth.DenialRecorder
 	log               *log.Helper
 }
 
 // NewRBACMiddleware 创建RBAC中间件
-func NewRBACMiddleware(permissionChecker auth.PermissionChecker, logger log.Logger) *RBACMiddleware {
+func NewRBACMiddleware(permissionChecker auth.PermissionChecker, denialRecorder auth.DenialRecorder, logger log.Logger) *RBACMiddleware {
 	return &RBACMiddleware{
 		permissionChecker: permissionChecker,
+		denialRecorder:    denialRecorder,
 		log:               log.NewHelper(logger),
 	}
 }
@@ -32,7 +39,7 @@ func (m *RBACMiddleware) ResourceAction() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			// 获取用户ID
-			userID, ok := GetUserIDFromContext(ctx)
+			userID, ok := reqctx.UserID(ctx)
 			if !ok {
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "token required")
 			}
@@ -44,6 +51,11 @@ func (m *RBACMiddleware) ResourceAction() middleware.Middleware {
 				return handler(ctx, req)
 			}
 
+			// 令牌中的角色仍然有效时直接放行
+			if m.tokenAllows(ctx, resource, action) {
+				return handler(ctx, req)
+			}
+
 			// 检查权限
 			hasPermission, err := m.permissionChecker.CheckPermission(ctx, userID, resource, action)
 			if err != nil {
@@ -53,6 +65,7 @@ func (m *RBACMiddleware) ResourceAction() middleware.Middleware {
 
 			if !hasPermission {
 				m.log.WithContext(ctx).Warnf("permission denied: user=%d, resource=%s, action=%s", userID, resource, action)
+				m.recordDenial(ctx, userID, resource, action)
 				return nil, NewAuthError(v1.ErrorCode_PERMISSION_DENIED, "permission denied")
 			}
 
@@ -65,11 +78,15 @@ func (m *RBACMiddleware) ResourceAction() middleware.Middleware {
 func (m *RBACMiddleware) AdminOnly() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			userID, ok := GetUserIDFromContext(ctx)
+			userID, ok := reqctx.UserID(ctx)
 			if !ok {
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "token required")
 			}
 
+			if m.tokenHasRole(ctx, auth.RoleNameAdmin) {
+				return handler(ctx, req)
+			}
+
 			isAdmin, err := m.permissionChecker.IsAdmin(ctx, userID)
 			if err != nil {
 				m.log.WithContext(ctx).Errorf("check admin failed: %v", err)
@@ -78,6 +95,7 @@ func (m *RBACMiddleware) AdminOnly() middleware.Middleware {
 
 			if !isAdmin {
 				m.log.WithContext(ctx).Warnf("admin permission denied: user=%d", userID)
+				m.recordDenial(ctx, userID, "/*", domain.ActionAll)
 				return nil, NewAuthError(v1.ErrorCode_PERMISSION_DENIED, "admin permission required")
 			}
 
@@ -90,11 +108,15 @@ func (m *RBACMiddleware) AdminOnly() middleware.Middleware {
 func (m *RBACMiddleware) ModeratorOrAdmin() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			userID, ok := GetUserIDFromContext(ctx)
+			userID, ok := reqctx.UserID(ctx)
 			if !ok {
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "token required")
 			}
 
+			if m.tokenHasRole(ctx, auth.RoleNameAdmin, auth.RoleNameModerator) {
+				return handler(ctx, req)
+			}
+
 			canModerate, err := m.permissionChecker.CanModerateContent(ctx, userID)
 			if err != nil {
 				m.log.WithContext(ctx).Errorf("check moderator failed: %v", err)
@@ -103,6 +125,7 @@ func (m *RBACMiddleware) ModeratorOrAdmin() middleware.Middleware {
 
 			if !canModerate {
 				m.log.WithContext(ctx).Warnf("moderator permission denied: user=%d", userID)
+				m.recordDenial(ctx, userID, "/moderation", domain.ActionAll)
 				return nil, NewAuthError(v1.ErrorCode_PERMISSION_DENIED, "moderator permission required")
 			}
 
@@ -111,6 +134,36 @@ func (m *RBACMiddleware) ModeratorOrAdmin() middleware.Middleware {
 	}
 }
 
+// tokenAllows 按令牌中的角色判断权限，令牌过期或不足时返回 false，由调用方做完整检查
+func (m *RBACMiddleware) tokenAllows(ctx context.Context, resource, action string) bool {
+	claims, ok := reqctx.Claims(ctx)
+	return ok && m.permissionChecker.CheckTokenPermission(claims, resource, action)
+}
+
+// tokenHasRole 令牌的权限版本有效且包含任一指定角色
+func (m *RBACMiddleware) tokenHasRole(ctx context.Context, roleNames ...string) bool {
+	claims, ok := reqctx.Claims(ctx)
+	return ok && m.permissionChecker.TokenHasRole(claims, roleNames...)
+}
+
+// recordDenial 记录权限拒绝，路由取HTTP方法和路径，gRPC请求取方法名
+func (m *RBACMiddleware) recordDenial(ctx context.Context, userID int64, resource, action string) {
+	var route string
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		route = tr.Operation()
+		if ht, ok := tr.(http.Transporter); ok {
+			route = ht.Request().Method + " " + ht.Request().URL.Path
+		}
+	}
+
+	m.denialRecorder.RecordDenial(ctx, &domain.PermissionDenial{
+		UserID:   userID,
+		Resource: resource,
+		Action:   action,
+		Route:    route,
+	})
+}
+
 // extractResourceAction 从请求中提取资源和操作
 func (m *RBACMiddleware) extractResourceAction(ctx context.Context) (string, string) {
 	tr, ok := transport.FromServerContext(ctx)
@@ -118,6 +171,11 @@ func (m *RBACMiddleware) extractResourceAction(ctx context.Context) (string, str
 		return "", ""
 	}
 
+	// 管理后台的所有方法都需要管理员权限，按 operation 判断以同时覆盖HTTP和gRPC
+	if strings.HasPrefix(tr.Operation(), adminServicePrefix) {
+		return "/*", "*"
+	}
+
 	// 获取操作路径
 	var path, method string
 	if ht, ok := tr.(http.Transporter); ok {
@@ -181,17 +239,22 @@ func (m *RBACMiddleware) extractResourceAction(ctx context.Context) (string, str
 func (m *RBACMiddleware) CheckVideoPermission(action string) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			userID, ok := GetUserIDFromContext(ctx)
+			userID, ok := reqctx.UserID(ctx)
 			if !ok {
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "token required")
 			}
 
+			if m.tokenAllows(ctx, "/video", action) {
+				return handler(ctx, req)
+			}
+
 			hasPermission, err := m.permissionChecker.CheckPermission(ctx, userID, "/video", action)
 			if err != nil {
 				return nil, err
 			}
 
 			if !hasPermission {
+				m.recordDenial(ctx, userID, "/video", action)
 				return nil, NewAuthError(v1.ErrorCode_PERMISSION_DENIED, "video permission denied")
 			}
 
@@ -204,17 +267,22 @@ func (m *RBACMiddleware) CheckVideoPermission(action string) middleware.Middlewa
 func (m *RBACMiddleware) CheckCommentPermission(action string) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			userID, ok := GetUserIDFromContext(ctx)
+			userID, ok := reqctx.UserID(ctx)
 			if !ok {
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "token required")
 			}
 
+			if m.tokenAllows(ctx, "/comment", action) {
+				return handler(ctx, req)
+			}
+
 			hasPermission, err := m.permissionChecker.CheckPermission(ctx, userID, "/comment", action)
 			if err != nil {
 				return nil, err
 			}
 
 			if !hasPermission {
+				m.recordDenial(ctx, userID, "/comment", action)
 				return nil, NewAuthError(v1.ErrorCode_PERMISSION_DENIED, "comment permission denied")
 			}
 
@@ -227,7 +295,7 @@ func (m *RBACMiddleware) CheckCommentPermission(action string) middleware.Middle
 func (m *RBACMiddleware) SelfOrAdmin(targetUserIDFunc func(interface{}) int64) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			currentUserID, ok := GetUserIDFromContext(ctx)
+			currentUserID, ok := reqctx.UserID(ctx)
 			if !ok {
 				return nil, NewAuthError(v1.ErrorCode_TOKEN_INVALID, "token required")
 			}
@@ -240,12 +308,16 @@ func (m *RBACMiddleware) SelfOrAdmin(targetUserIDFunc func(interface{}) int64) m
 			}
 
 			// 检查是否为管理员
+			if m.tokenHasRole(ctx, auth.RoleNameAdmin) {
+				return handler(ctx, req)
+			}
 			isAdmin, err := m.permissionChecker.IsAdmin(ctx, currentUserID)
 			if err != nil {
 				return nil, err
 			}
 
 			if !isAdmin {
+				m.recordDenial(ctx, currentUserID, "/user", domain.ActionAll)
 				return nil, NewAuthError(v1.ErrorCode_PERMISSION_DENIED, "permission denied")
 			}
 
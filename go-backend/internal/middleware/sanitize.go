@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	adminv1 "go-backend/api/admin/v1"
+	calendarv1 "go-backend/api/calendar/v1"
+	commentv1 "go-backend/api/comment/v1"
+	messagev1 "go-backend/api/message/v1"
+	moderationv1 "go-backend/api/moderation/v1"
+	userv1 "go-backend/api/user/v1"
+	videov1 "go-backend/api/video/v1"
+	"go-backend/pkg/security"
+)
+
+var (
+	// singleLineText 标题、昵称等单行展示的文本
+	singleLineText = []security.FieldSanitizer{security.StripControl, security.SingleLine, security.TrimSpace}
+	// multiLineText 评论、私信、备注等允许换行的文本
+	multiLineText = []security.FieldSanitizer{security.StripControl, security.TrimSpace}
+)
+
+// newRequestSanitizers 构建请求字段的清洗规则。只登记用户可写、会被展示给其他人的文本和链接字段，
+// 文本只做规范化，不按关键词拒绝，SQL 注入由参数化查询防范，XSS 由展示端转义防范
+func newRequestSanitizers() *security.SanitizerRegistry {
+	r := security.NewSanitizerRegistry()
+
+	// 评论
+	r.Register(&commentv1.CommentActionRequest{}, "comment_text", multiLineText...)
+	r.Register(&commentv1.MutedKeywordActionRequest{}, "keyword", singleLineText...)
+
+	// 私信
+	r.Register(&messagev1.SendMessageRequest{}, "content", multiLineText...)
+
+	// 视频
+	r.Register(&videov1.PublishVideoRequest{}, "title", singleLineText...)
+	r.Register(&videov1.UploadVideoFileRequest{}, "title", singleLineText...)
+	r.Register(&videov1.InitiateMultipartUploadRequest{}, "title", singleLineText...)
+	r.Register(&videov1.CompleteMultipartUploadRequest{}, "title", singleLineText...)
+	r.Register(&videov1.AppealTakedownRequest{}, "reason", multiLineText...)
+	r.Register(&videov1.SearchWithinCreatorRequest{}, "query", singleLineText...)
+	// 字幕的空行和缩进有含义，只去掉控制字符
+	r.Register(&videov1.SetVideoCaptionsRequest{}, "content", security.StripControl)
+
+	// 个人资料
+	r.Register(&userv1.UpdateProfileRequest{}, "nickname", singleLineText...)
+	r.Register(&userv1.UpdateProfileRequest{}, "signature", multiLineText...)
+	r.Register(&userv1.UpdateProfileRequest{}, "avatar", security.SafeURL)
+	r.Register(&userv1.UpdateProfileRequest{}, "background_image", security.SafeURL)
+
+	// 发布日历
+	r.Register(&calendarv1.CreateDraftRequest{}, "title", singleLineText...)
+	r.Register(&calendarv1.CreateDraftRequest{}, "note", multiLineText...)
+	r.Register(&calendarv1.UpdateDraftRequest{}, "title", singleLineText...)
+	r.Register(&calendarv1.UpdateDraftRequest{}, "note", multiLineText...)
+
+	// 审核和下架意见会展示给创作者
+	r.Register(&moderationv1.ReviewVideoRequest{}, "reason", multiLineText...)
+	r.Register(&adminv1.TakedownVideoRequest{}, "reason", multiLineText...)
+	r.Register(&adminv1.DecideTakedownAppealRequest{}, "note", multiLineText...)
+
+	return r
+}
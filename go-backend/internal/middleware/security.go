@@ -2,29 +2,32 @@ package middleware
 
 import (
 	"context"
-	"regexp"
 	"strings"
 
 	"go-backend/api/common/v1"
+	"go-backend/pkg/reqctx"
 	"go-backend/pkg/security"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 	transportHttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/proto"
 )
 
 // SecurityMiddleware 安全中间件
 type SecurityMiddleware struct {
-	validator *security.Validator
-	log       *log.Helper
+	validator  *security.Validator
+	sanitizers *security.SanitizerRegistry
+	log        *log.Helper
 }
 
 // NewSecurityMiddleware 创建安全中间件
 func NewSecurityMiddleware(validator *security.Validator, logger log.Logger) *SecurityMiddleware {
 	return &SecurityMiddleware{
-		validator: validator,
-		log:       log.NewHelper(logger),
+		validator:  validator,
+		sanitizers: newRequestSanitizers(),
+		log:        log.NewHelper(logger),
 	}
 }
 
@@ -37,18 +40,6 @@ func (m *SecurityMiddleware) GlobalSecurityHandler() middleware.Middleware {
 				m.log.WithContext(ctx).Errorf("set security headers failed: %v", err)
 			}
 
-			// SQL注入检测
-			if err := m.checkSQLInjection(ctx); err != nil {
-				m.log.WithContext(ctx).Warnf("SQL injection detected: %v", err)
-				return nil, NewAuthError(v1.ErrorCode_PARAM_ERROR, "invalid request")
-			}
-
-			// XSS检测
-			if err := m.checkXSS(ctx); err != nil {
-				m.log.WithContext(ctx).Warnf("XSS attack detected: %v", err)
-				return nil, NewAuthError(v1.ErrorCode_PARAM_ERROR, "invalid request")
-			}
-
 			return handler(ctx, req)
 		}
 	}
@@ -105,7 +96,7 @@ func (m *SecurityMiddleware) ContentSecurityPolicy() middleware.Middleware {
 	}
 }
 
-// InputValidation 输入验证
+// InputValidation 输入验证，按字段清洗规则处理解码后的请求消息，不读取原始请求体，HTTP 和 gRPC 共用
 func (m *SecurityMiddleware) InputValidation() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
@@ -114,9 +105,12 @@ func (m *SecurityMiddleware) InputValidation() middleware.Middleware {
 				return nil, err
 			}
 
-			// 检查文件类型
-			if err := m.checkFileType(ctx); err != nil {
-				return nil, err
+			// 清洗请求字段
+			if msg, ok := req.(proto.Message); ok {
+				if err := m.sanitizers.Sanitize(msg); err != nil {
+					m.log.WithContext(ctx).Warnf("request sanitation failed: %v", err)
+					return nil, NewAuthError(v1.ErrorCode_PARAM_ERROR, err.Error())
+				}
 			}
 
 			return handler(ctx, req)
@@ -175,109 +169,6 @@ func (m *SecurityMiddleware) setCSPHeaders(ctx context.Context) error {
 	return nil
 }
 
-// checkSQLInjection SQL注入检测
-func (m *SecurityMiddleware) checkSQLInjection(ctx context.Context) error {
-	tr, ok := transport.FromServerContext(ctx)
-	if !ok {
-		return nil
-	}
-
-	if ht, ok := tr.(transportHttp.Transporter); ok {
-		req := ht.Request()
-
-		// SQL注入关键词
-		sqlPatterns := []string{
-			`(?i)\bunion\b.*\bselect\b`,
-			`(?i)\bselect\b.*\bfrom\b`,
-			`(?i)\binsert\b.*\binto\b`,
-			`(?i)\bupdate\b.*\bset\b`,
-			`(?i)\bdelete\b.*\bfrom\b`,
-			`(?i)\bdrop\b.*\btable\b`,
-			`(?i);\s*(drop|alter|create|truncate)`,
-			`(?i)\bor\b.*=.*\bor\b`,
-			`(?i)'\s*(or|and)\s*'?1'?\s*=\s*'?1`,
-			`--\s*$`,
-			`/\*.*\*/`,
-		}
-
-		// 检查查询参数
-		for _, values := range req.URL.Query() {
-			for _, value := range values {
-				for _, pattern := range sqlPatterns {
-					if matched, _ := regexp.MatchString(pattern, value); matched {
-						return NewAuthError(v1.ErrorCode_PARAM_ERROR, "potential SQL injection")
-					}
-				}
-			}
-		}
-
-		// 检查请求体（如果是POST请求）
-		if req.Method == "POST" {
-			req.ParseForm()
-			for _, values := range req.PostForm {
-				for _, value := range values {
-					for _, pattern := range sqlPatterns {
-						if matched, _ := regexp.MatchString(pattern, value); matched {
-							return NewAuthError(v1.ErrorCode_PARAM_ERROR, "potential SQL injection")
-						}
-					}
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
-// checkXSS XSS检测
-func (m *SecurityMiddleware) checkXSS(ctx context.Context) error {
-	tr, ok := transport.FromServerContext(ctx)
-	if !ok {
-		return nil
-	}
-
-	if ht, ok := tr.(transportHttp.Transporter); ok {
-		req := ht.Request()
-
-		// XSS模式
-		xssPatterns := []string{
-			`(?i)<script.*?>.*?</script>`,
-			`(?i)<iframe.*?>.*?</iframe>`,
-			`(?i)javascript:`,
-			`(?i)on\w+\s*=`,
-			`(?i)<.*?\bstyle\s*=.*?\bexpression\s*\(`,
-			`(?i)<.*?\bsrc\s*=.*?\bjavascript:`,
-		}
-
-		// 检查所有参数
-		allParams := make([]string, 0)
-
-		// URL参数
-		for _, values := range req.URL.Query() {
-			allParams = append(allParams, values...)
-		}
-
-		// POST参数
-		if req.Method == "POST" {
-			req.ParseForm()
-			for _, values := range req.PostForm {
-				allParams = append(allParams, values...)
-			}
-		}
-
-		// 检查XSS
-		for _, param := range allParams {
-			for _, pattern := range xssPatterns {
-				if matched, _ := regexp.MatchString(pattern, param); matched {
-					return NewAuthError(v1.ErrorCode_PARAM_ERROR, "potential XSS attack")
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
 // checkRequestSize 检查请求大小
 func (m *SecurityMiddleware) checkRequestSize(ctx context.Context) error {
 	tr, ok := transport.FromServerContext(ctx)
@@ -297,60 +188,9 @@ func (m *SecurityMiddleware) checkRequestSize(ctx context.Context) error {
 	return nil
 }
 
-// checkFileType 检查文件类型
-func (m *SecurityMiddleware) checkFileType(ctx context.Context) error {
-	tr, ok := transport.FromServerContext(ctx)
-	if !ok {
-		return nil
-	}
-
-	if ht, ok := tr.(transportHttp.Transporter); ok {
-		req := ht.Request()
-
-		contentType := req.Header.Get("Content-Type")
-		if strings.HasPrefix(contentType, "multipart/form-data") {
-			// 文件上传请求，检查文件类型
-			if err := req.ParseMultipartForm(32 << 20); err != nil { // 32MB
-				return NewAuthError(v1.ErrorCode_PARAM_ERROR, "invalid multipart form")
-			}
-
-			if req.MultipartForm != nil {
-				for _, fileHeaders := range req.MultipartForm.File {
-					for _, fileHeader := range fileHeaders {
-						// 检查文件扩展名
-						if !m.isAllowedFileType(fileHeader.Filename) {
-							return NewAuthError(v1.ErrorCode_PARAM_ERROR, "file type not allowed")
-						}
-					}
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
-// isAllowedFileType 检查是否为允许的文件类型
-func (m *SecurityMiddleware) isAllowedFileType(filename string) bool {
-	allowedExtensions := []string{
-		".jpg", ".jpeg", ".png", ".gif", ".webp", // 图片
-		".mp4", ".avi", ".mov", ".wmv", ".flv", // 视频
-		".mp3", ".wav", ".aac", ".ogg", // 音频
-		".txt", ".pdf", ".doc", ".docx", // 文档
-	}
-
-	filename = strings.ToLower(filename)
-	for _, ext := range allowedExtensions {
-		if strings.HasSuffix(filename, ext) {
-			return true
-		}
-	}
-	return false
-}
-
 // LogSecurityEvent 记录安全事件
 func (m *SecurityMiddleware) LogSecurityEvent(ctx context.Context, eventType, message string) {
-	userID, _ := GetUserIDFromContext(ctx)
+	userID, _ := reqctx.UserID(ctx)
 	ip := ""
 
 	if tr, ok := transport.FromServerContext(ctx); ok {
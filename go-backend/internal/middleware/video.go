@@ -271,14 +271,17 @@ func (v *VideoMiddleware) ExtractVideoFile() middleware.Middleware {
 	}
 }
 
+// videoFileHeaderKey 视频文件头上下文key
+type videoFileHeaderKey struct{}
+
 // WithVideoFileHeader 设置视频文件头到上下文
 func WithVideoFileHeader(ctx context.Context, fileHeader *multipart.FileHeader) context.Context {
-	return context.WithValue(ctx, "video_file_header", fileHeader)
+	return context.WithValue(ctx, videoFileHeaderKey{}, fileHeader)
 }
 
 // GetVideoFileHeaderFromContext 从上下文获取视频文件头
 func GetVideoFileHeaderFromContext(ctx context.Context) (*multipart.FileHeader, bool) {
-	fileHeader, ok := ctx.Value("video_file_header").(*multipart.FileHeader)
+	fileHeader, ok := ctx.Value(videoFileHeaderKey{}).(*multipart.FileHeader)
 	return fileHeader, ok
 }
 
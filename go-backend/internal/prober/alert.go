@@ -0,0 +1,85 @@
+package prober
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	AlertFiring   = "firing"
+	AlertResolved = "resolved"
+)
+
+// Alert 告警通知内容
+type Alert struct {
+	Status              string    `json:"status"`
+	Target              string    `json:"target"`
+	Step                string    `json:"step"`
+	ConsecutiveFailures int       `json:"consecutive_failures,omitempty"`
+	Error               string    `json:"error,omitempty"`
+	Time                time.Time `json:"time"`
+}
+
+// Alerter 以JSON POST到 webhook 发送告警，未配置 webhook 时只记录日志
+type Alerter struct {
+	webhook string
+	http    *http.Client
+	log     *log.Helper
+}
+
+// NewAlerter 创建告警发送器
+func NewAlerter(webhook string, client *http.Client, logger log.Logger) *Alerter {
+	return &Alerter{
+		webhook: webhook,
+		http:    client,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// Send 发送告警，发送失败只记录日志，不影响拨测
+func (a *Alerter) Send(ctx context.Context, alert *Alert) {
+	if alert.Time.IsZero() {
+		alert.Time = time.Now()
+	}
+
+	if alert.Status == AlertFiring {
+		a.log.WithContext(ctx).Errorf("probe alert firing: target=%s step=%s failures=%d error=%s",
+			alert.Target, alert.Step, alert.ConsecutiveFailures, alert.Error)
+	} else {
+		a.log.WithContext(ctx).Infof("probe alert resolved: target=%s step=%s", alert.Target, alert.Step)
+	}
+
+	if a.webhook == "" {
+		return
+	}
+
+	body, err := json.Marshal(alert)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("marshal probe alert failed: %v", err)
+		return
+	}
+	// 拨测超时后仍要把告警发出去
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.webhook, bytes.NewReader(body))
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("build probe alert request failed: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.http.Do(req)
+	if err != nil {
+		a.log.WithContext(ctx).Errorf("send probe alert failed: %v", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		a.log.WithContext(ctx).Errorf("send probe alert failed: webhook returned %d", resp.StatusCode)
+	}
+}
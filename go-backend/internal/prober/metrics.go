@@ -0,0 +1,124 @@
+package prober
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Metrics 拨测指标，以 Prometheus 文本格式暴露
+type Metrics struct {
+	mu       sync.Mutex
+	steps    map[string]*stepMetrics
+	runs     map[bool]int64
+	lastRun  time.Time
+	lastPass time.Time
+}
+
+type stepMetrics struct {
+	success  bool
+	duration time.Duration
+	total    map[bool]int64
+}
+
+// NewMetrics 创建拨测指标
+func NewMetrics() *Metrics {
+	return &Metrics{
+		steps: make(map[string]*stepMetrics),
+		runs:  make(map[bool]int64),
+	}
+}
+
+// Observe 记录一个步骤的执行结果，跳过的步骤不记录
+func (m *Metrics) Observe(result StepResult) {
+	if result.Skipped {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.steps[result.Name]
+	if !ok {
+		s = &stepMetrics{total: make(map[bool]int64)}
+		m.steps[result.Name] = s
+	}
+	s.success = result.Err == nil
+	s.duration = result.Duration
+	s.total[s.success]++
+}
+
+// ObserveRun 记录一轮拨测是否全部成功
+func (m *Metrics) ObserveRun(success bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.runs[success]++
+	m.lastRun = time.Now()
+	if success {
+		m.lastPass = m.lastRun
+	}
+}
+
+// ServeHTTP 输出 Prometheus 文本格式的指标
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	names := make([]string, 0, len(m.steps))
+	for name := range m.steps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "# HELP prober_step_success Whether the last run of the step succeeded.")
+	fmt.Fprintln(w, "# TYPE prober_step_success gauge")
+	for _, name := range names {
+		fmt.Fprintf(w, "prober_step_success{step=%q} %d\n", name, boolValue(m.steps[name].success))
+	}
+
+	fmt.Fprintln(w, "# HELP prober_step_duration_seconds Latency of the last run of the step.")
+	fmt.Fprintln(w, "# TYPE prober_step_duration_seconds gauge")
+	for _, name := range names {
+		fmt.Fprintf(w, "prober_step_duration_seconds{step=%q} %g\n", name, m.steps[name].duration.Seconds())
+	}
+
+	fmt.Fprintln(w, "# HELP prober_step_runs_total Step runs by result.")
+	fmt.Fprintln(w, "# TYPE prober_step_runs_total counter")
+	for _, name := range names {
+		s := m.steps[name]
+		fmt.Fprintf(w, "prober_step_runs_total{step=%q,result=\"success\"} %d\n", name, s.total[true])
+		fmt.Fprintf(w, "prober_step_runs_total{step=%q,result=\"failure\"} %d\n", name, s.total[false])
+	}
+
+	fmt.Fprintln(w, "# HELP prober_runs_total Probe rounds by result.")
+	fmt.Fprintln(w, "# TYPE prober_runs_total counter")
+	fmt.Fprintf(w, "prober_runs_total{result=\"success\"} %d\n", m.runs[true])
+	fmt.Fprintf(w, "prober_runs_total{result=\"failure\"} %d\n", m.runs[false])
+
+	fmt.Fprintln(w, "# HELP prober_last_run_timestamp_seconds Unix time of the last probe round.")
+	fmt.Fprintln(w, "# TYPE prober_last_run_timestamp_seconds gauge")
+	fmt.Fprintf(w, "prober_last_run_timestamp_seconds %d\n", unixOrZero(m.lastRun))
+
+	fmt.Fprintln(w, "# HELP prober_last_success_timestamp_seconds Unix time of the last fully successful probe round.")
+	fmt.Fprintln(w, "# TYPE prober_last_success_timestamp_seconds gauge")
+	fmt.Fprintf(w, "prober_last_success_timestamp_seconds %d\n", unixOrZero(m.lastPass))
+}
+
+func boolValue(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,247 @@
+// Package prober 生产环境拨测：定期用金丝雀账号走一遍核心链路（登录、拉取视频流、申请上传），
+// 记录每一步的成功率和耗时供 Prometheus 抓取，连续失败时发送告警
+package prober
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	userv1 "go-backend/api/user/v1"
+	videov1 "go-backend/api/video/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	userAgent       = "tiktok-prober/1.0"
+	maxResponseSize = 1 << 20
+
+	// canaryUploadSize 申请上传时声明的文件大小，只初始化不上传分片
+	canaryUploadSize = 1 << 20
+)
+
+const (
+	StepLogin  = "login"
+	StepFeed   = "feed"
+	StepUpload = "upload"
+)
+
+// Config 拨测配置
+type Config struct {
+	// Target 被测服务的HTTP地址，如 https://api.example.com
+	Target string
+	// Username、Password 金丝雀账号，需提前注册并具备上传权限
+	Username string
+	Password string
+
+	Interval time.Duration
+	// Timeout 单轮拨测的超时时间
+	Timeout time.Duration
+	// FailureThreshold 同一步骤连续失败多少轮后告警
+	FailureThreshold int
+	// AlertWebhook 告警通知地址，为空时只记录日志
+	AlertWebhook string
+}
+
+// Step 拨测步骤，按顺序执行，前一步失败时后续步骤跳过
+type Step struct {
+	Name string
+	Run  func(ctx context.Context, c *client) error
+}
+
+// StepResult 单个步骤的执行结果
+type StepResult struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+	Skipped  bool
+}
+
+// Prober 拨测执行器
+type Prober struct {
+	cfg     Config
+	steps   []Step
+	http    *http.Client
+	metrics *Metrics
+	alerter *Alerter
+
+	mu       sync.Mutex
+	failures map[string]int
+
+	log *log.Helper
+}
+
+// New 创建拨测执行器
+func New(cfg Config, logger log.Logger) (*Prober, error) {
+	if cfg.Target == "" {
+		return nil, errors.New("prober: target is required")
+	}
+	if cfg.Username == "" || cfg.Password == "" {
+		return nil, errors.New("prober: canary username and password are required")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Minute
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 3
+	}
+	cfg.Target = strings.TrimRight(cfg.Target, "/")
+
+	p := &Prober{
+		cfg:      cfg,
+		http:     &http.Client{Timeout: cfg.Timeout},
+		metrics:  NewMetrics(),
+		failures: make(map[string]int),
+		log:      log.NewHelper(logger),
+	}
+	p.alerter = NewAlerter(cfg.AlertWebhook, p.http, logger)
+	p.steps = []Step{
+		{Name: StepLogin, Run: p.login},
+		{Name: StepFeed, Run: fetchFeed},
+		{Name: StepUpload, Run: requestUpload},
+	}
+	return p, nil
+}
+
+// Metrics 拨测指标
+func (p *Prober) Metrics() *Metrics {
+	return p.metrics
+}
+
+// Run 按间隔执行拨测直到 ctx 取消，启动时立即执行一轮
+func (p *Prober) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce 执行一轮拨测并更新指标和告警状态
+func (p *Prober) RunOnce(ctx context.Context) []StepResult {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	c := &client{baseURL: p.cfg.Target, http: p.http}
+	results := make([]StepResult, 0, len(p.steps))
+	var failed bool
+	for _, step := range p.steps {
+		if failed {
+			results = append(results, StepResult{Name: step.Name, Skipped: true})
+			continue
+		}
+
+		start := time.Now()
+		err := step.Run(ctx, c)
+		result := StepResult{Name: step.Name, Duration: time.Since(start), Err: err}
+		results = append(results, result)
+		failed = err != nil
+
+		p.metrics.Observe(result)
+		p.track(ctx, result)
+	}
+
+	p.metrics.ObserveRun(!failed)
+	return results
+}
+
+// track 维护连续失败次数，达到阈值时告警一次，恢复后发送恢复通知
+func (p *Prober) track(ctx context.Context, result StepResult) {
+	p.mu.Lock()
+	prev := p.failures[result.Name]
+	if result.Err == nil {
+		delete(p.failures, result.Name)
+	} else {
+		p.failures[result.Name] = prev + 1
+	}
+	p.mu.Unlock()
+
+	if result.Err != nil {
+		p.log.WithContext(ctx).Warnf("probe step %s failed (%d in a row): %v", result.Name, prev+1, result.Err)
+		if prev+1 == p.cfg.FailureThreshold {
+			p.alerter.Send(ctx, &Alert{
+				Status:              AlertFiring,
+				Target:              p.cfg.Target,
+				Step:                result.Name,
+				ConsecutiveFailures: prev + 1,
+				Error:               result.Err.Error(),
+			})
+		}
+		return
+	}
+
+	if prev >= p.cfg.FailureThreshold {
+		p.alerter.Send(ctx, &Alert{
+			Status: AlertResolved,
+			Target: p.cfg.Target,
+			Step:   result.Name,
+		})
+	}
+}
+
+// login 用金丝雀账号登录，后续步骤携带返回的Token
+func (p *Prober) login(ctx context.Context, c *client) error {
+	var resp userv1.LoginResponse
+	if err := c.post(ctx, "/douyin/user/login", &userv1.LoginRequest{
+		Username: p.cfg.Username,
+		Password: p.cfg.Password,
+	}, &resp); err != nil {
+		return err
+	}
+	if err := checkBase(resp.Base); err != nil {
+		return err
+	}
+	if resp.GetData().GetToken() == "" {
+		return errors.New("login returned empty token")
+	}
+	c.token = resp.Data.Token
+	return nil
+}
+
+// fetchFeed 拉取视频流
+func fetchFeed(ctx context.Context, c *client) error {
+	var resp videov1.GetFeedResponse
+	if err := c.get(ctx, "/douyin/feed", nil, &resp); err != nil {
+		return err
+	}
+	return checkBase(resp.Base)
+}
+
+// requestUpload 申请分片上传后立即取消，验证上传链路和对象存储可用，不产生视频
+func requestUpload(ctx context.Context, c *client) error {
+	var resp videov1.InitiateMultipartUploadResponse
+	if err := c.post(ctx, "/douyin/upload/multipart/initiate", &videov1.InitiateMultipartUploadRequest{
+		Filename:    "prober-canary.mp4",
+		FileSize:    canaryUploadSize,
+		ContentType: "video/mp4",
+		Title:       "prober canary",
+	}, &resp); err != nil {
+		return err
+	}
+	if err := checkBase(resp.Base); err != nil {
+		return err
+	}
+	uploadID := resp.GetData().GetUploadId()
+	if uploadID == "" {
+		return errors.New("initiate upload returned empty upload_id")
+	}
+
+	if err := c.post(ctx, "/douyin/upload/multipart/abort", &videov1.AbortMultipartUploadRequest{UploadId: uploadID}, nil); err != nil {
+		return fmt.Errorf("abort upload %s: %w", uploadID, err)
+	}
+	return nil
+}
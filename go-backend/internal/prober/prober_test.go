@@ -0,0 +1,149 @@
+package prober
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPI 模拟被测服务，feedStatus 非200时视频流接口失败
+type fakeAPI struct {
+	mu         sync.Mutex
+	feedStatus int
+	aborted    []string
+	alerts     []Alert
+}
+
+func (f *fakeAPI) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/douyin/user/login", func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Username, Password string }
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Password != "secret" {
+			w.Write([]byte(`{"base":{"status_code":1003,"status_msg":"wrong password"}}`))
+			return
+		}
+		w.Write([]byte(`{"base":{"status_code":0},"data":{"user_id":"1","token":"tok"}}`))
+	})
+	mux.HandleFunc("/douyin/feed", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		status := f.feedStatus
+		f.mu.Unlock()
+		if status != 0 && status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{"base":{"status_code":0},"data":{"video_list":[]}}`))
+	})
+	mux.HandleFunc("/douyin/upload/multipart/initiate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer tok", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"base":{"status_code":0},"data":{"upload_id":"up-1","chunk_size":"5242880","total_parts":1}}`))
+	})
+	mux.HandleFunc("/douyin/upload/multipart/abort", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			UploadID string `json:"uploadId"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		f.mu.Lock()
+		f.aborted = append(f.aborted, req.UploadID)
+		f.mu.Unlock()
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/alert", func(w http.ResponseWriter, r *http.Request) {
+		var alert Alert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		f.mu.Lock()
+		f.alerts = append(f.alerts, alert)
+		f.mu.Unlock()
+	})
+	return mux
+}
+
+func setupProber(t *testing.T, password string) (*fakeAPI, *Prober) {
+	api := &fakeAPI{}
+	srv := httptest.NewServer(api.handler(t))
+	t.Cleanup(srv.Close)
+
+	p, err := New(Config{
+		Target:           srv.URL + "/",
+		Username:         "canary",
+		Password:         password,
+		FailureThreshold: 2,
+		AlertWebhook:     srv.URL + "/alert",
+	}, log.DefaultLogger)
+	require.NoError(t, err)
+	return api, p
+}
+
+func TestProber_RunOnce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("AllStepsPass", func(t *testing.T) {
+		api, p := setupProber(t, "secret")
+
+		results := p.RunOnce(ctx)
+		require.Len(t, results, 3)
+		for _, r := range results {
+			assert.NoError(t, r.Err, r.Name)
+			assert.False(t, r.Skipped, r.Name)
+		}
+		assert.Equal(t, []string{"up-1"}, api.aborted)
+
+		rec := httptest.NewRecorder()
+		p.Metrics().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		body := rec.Body.String()
+		assert.Contains(t, body, `prober_step_success{step="upload"} 1`)
+		assert.Contains(t, body, `prober_runs_total{result="success"} 1`)
+	})
+
+	t.Run("LoginFailureSkipsRest", func(t *testing.T) {
+		_, p := setupProber(t, "wrong")
+
+		results := p.RunOnce(ctx)
+		require.Len(t, results, 3)
+		assert.ErrorContains(t, results[0].Err, "status_code=1003")
+		assert.True(t, results[1].Skipped)
+		assert.True(t, results[2].Skipped)
+
+		rec := httptest.NewRecorder()
+		p.Metrics().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		assert.Contains(t, rec.Body.String(), `prober_step_success{step="login"} 0`)
+		assert.NotContains(t, rec.Body.String(), `step="feed"`)
+	})
+}
+
+func TestProber_Alerting(t *testing.T) {
+	ctx := context.Background()
+	api, p := setupProber(t, "secret")
+
+	api.feedStatus = http.StatusServiceUnavailable
+	p.RunOnce(ctx)
+	assert.Empty(t, api.alerts, "below threshold")
+
+	p.RunOnce(ctx)
+	p.RunOnce(ctx)
+	require.Len(t, api.alerts, 1, "alert once when threshold is reached")
+	assert.Equal(t, AlertFiring, api.alerts[0].Status)
+	assert.Equal(t, StepFeed, api.alerts[0].Step)
+	assert.Equal(t, 2, api.alerts[0].ConsecutiveFailures)
+	assert.True(t, strings.Contains(api.alerts[0].Error, "http 503"))
+
+	api.feedStatus = http.StatusOK
+	p.RunOnce(ctx)
+	require.Len(t, api.alerts, 2)
+	assert.Equal(t, AlertResolved, api.alerts[1].Status)
+	assert.Equal(t, StepFeed, api.alerts[1].Step)
+}
+
+func TestNew_RequiresCredentials(t *testing.T) {
+	_, err := New(Config{Target: "http://localhost"}, log.DefaultLogger)
+	assert.Error(t, err)
+}
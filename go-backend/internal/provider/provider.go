@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/internal/conf"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/clock"
+	"go-backend/pkg/media"
+	"go-backend/pkg/messaging"
+	"go-backend/pkg/metrics"
+	"go-backend/pkg/security"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// TestJWTSecret 测试环境使用的固定JWT密钥，测试可用它自行签发Token
+const TestJWTSecret = "test-secret"
+
+// PkgSet pkg层组件的生产环境providers
+var PkgSet = wire.NewSet(
+	NewKeyring,
+	NewJWTManager,
+	NewPasswordManager,
+	NewRBACManager,
+	NewPermissionChecker,
+	NewValidator,
+	NewOAuthProviders,
+	NewKafkaManager,
+	NewVideoProcessor,
+	NewClock,
+	NewMetricsProvider,
+	NewWorkerManager,
+)
+
+// TestPkgSet pkg层组件的测试providers：固定JWT密钥，不连接Kafka（生产者降级为空实现）
+var TestPkgSet = wire.NewSet(
+	NewTestKeyring,
+	NewTestJWTManager,
+	NewPasswordManager,
+	NewRBACManager,
+	NewPermissionChecker,
+	NewValidator,
+	NewOAuthProviders,
+	NewNoopKafkaManager,
+	NewVideoProcessor,
+	NewClock,
+	NewMetricsProvider,
+	NewWorkerManager,
+)
+
+// NewKeyring 按配置创建签名密钥环。未配置 keys 时使用 secret，kid 为 default；
+// 配置了多个密钥时，current_key_id 以外的密钥视为正在退役，只用于验证
+func NewKeyring(bc *conf.Bootstrap) (*auth.Keyring, error) {
+	retireAfter := auth.RefreshTokenExpiry
+	if expiry := bc.Jwt.ExpireTime.AsDuration(); expiry > retireAfter {
+		retireAfter = expiry
+	}
+
+	if len(bc.Jwt.Keys) == 0 {
+		return auth.NewKeyring(retireAfter, &auth.SigningKey{ID: auth.LegacyKeyID, Secret: bc.Jwt.Secret})
+	}
+
+	now := time.Now()
+	keys := make([]*auth.SigningKey, 0, len(bc.Jwt.Keys))
+	for _, k := range bc.Jwt.Keys {
+		key := &auth.SigningKey{ID: k.Id, Algorithm: k.Algorithm, Secret: k.Secret}
+		if k.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(k.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("read private key of signing key %s: %w", k.Id, err)
+			}
+			key.Secret = string(pem)
+		}
+		if k.Id != bc.Jwt.CurrentKeyId {
+			key.RetireAt = now.Add(retireAfter)
+		}
+		keys = append(keys, key)
+	}
+	return auth.NewKeyring(retireAfter, keys...)
+}
+
+// NewJWTManager 从数据库加载签名密钥后再创建JWT管理器，
+// 避免新启动的实例使用已被轮换掉的配置密钥签发令牌。Access Token 携带内存RBAC中的角色和权限版本
+func NewJWTManager(bc *conf.Bootstrap, keyring *auth.Keyring, signingKeyUc *biz.SigningKeyUsecase, rbacManager auth.RBACManager) (*auth.JWTManager, error) {
+	if err := signingKeyUc.Refresh(context.Background()); err != nil {
+		return nil, err
+	}
+	jwtManager := auth.NewJWTManagerWithKeyring(keyring, bc.Jwt.ExpireTime.AsDuration())
+	jwtManager.SetRoleClaimsProvider(rbacManager)
+	return jwtManager, nil
+}
+
+// NewTestKeyring 创建只含固定密钥的密钥环
+func NewTestKeyring() (*auth.Keyring, error) {
+	return auth.NewKeyring(auth.RefreshTokenExpiry, &auth.SigningKey{ID: auth.LegacyKeyID, Secret: TestJWTSecret})
+}
+
+// NewTestJWTManager 创建使用固定密钥的JWT管理器，不从数据库加载密钥
+func NewTestJWTManager(keyring *auth.Keyring, rbacManager auth.RBACManager) *auth.JWTManager {
+	jwtManager := auth.NewJWTManagerWithKeyring(keyring, time.Hour)
+	jwtManager.SetRoleClaimsProvider(rbacManager)
+	return jwtManager
+}
+
+// NewClock 创建系统时钟
+func NewClock() clock.Clock {
+	return clock.New()
+}
+
+// NewWorkerManager 创建后台任务管理器，由 server.Workers 在应用停止时等待任务结束
+func NewWorkerManager(logger log.Logger) *worker.Manager {
+	return worker.NewManager(logger)
+}
+
+// NewPasswordManager 创建密码管理器
+func NewPasswordManager() *auth.PasswordManager {
+	return auth.NewPasswordManager()
+}
+
+// NewRBACManager 创建内存RBAC管理器
+func NewRBACManager() auth.RBACManager {
+	return auth.NewMemoryRBACManager()
+}
+
+// NewPermissionChecker 在内存RBAC从数据库完成加载后再创建权限检查器，
+// 避免服务启动初期因内存状态为空而误拒请求
+func NewPermissionChecker(rbacManager auth.RBACManager, rbacSyncUc *biz.RBACSyncUsecase) (auth.PermissionChecker, error) {
+	if err := rbacSyncUc.Hydrate(context.Background()); err != nil {
+		return nil, err
+	}
+	return auth.NewSimplePermissionChecker(rbacManager), nil
+}
+
+// NewValidator 创建参数校验器
+func NewValidator() *security.Validator {
+	return security.NewValidator()
+}
+
+// NewOAuthProviders 按配置创建第三方登录提供方，google 和 github 类型未配置的地址和字段取内置值
+func NewOAuthProviders(bc *conf.Business) (*auth.OAuthProviders, error) {
+	var providers []auth.OAuthProvider
+	for _, p := range bc.GetOauth().GetProviders() {
+		if p.Type == auth.OAuthTypeWeChat {
+			providers = append(providers, auth.NewWeChatProvider(auth.WeChatConfig{
+				Name:        p.Name,
+				AppID:       p.ClientId,
+				AppSecret:   p.ClientSecret,
+				TokenURL:    p.TokenUrl,
+				UserInfoURL: p.UserinfoUrl,
+			}, nil))
+			continue
+		}
+
+		config, ok := auth.OAuth2Preset(p.Type)
+		if !ok {
+			return nil, fmt.Errorf("oauth provider %s: unsupported type %q", p.Name, p.Type)
+		}
+		config.Name = p.Name
+		config.ClientID = p.ClientId
+		config.ClientSecret = p.ClientSecret
+		config.RedirectURI = p.RedirectUri
+		overrides := []struct {
+			value string
+			field *string
+		}{
+			{p.TokenUrl, &config.TokenURL},
+			{p.UserinfoUrl, &config.UserInfoURL},
+			{p.SubjectField, &config.SubjectField},
+			{p.EmailField, &config.EmailField},
+			{p.EmailVerifiedField, &config.EmailVerifiedField},
+			{p.NameField, &config.NameField},
+			{p.AvatarField, &config.AvatarField},
+		}
+		for _, o := range overrides {
+			if o.value != "" {
+				*o.field = o.value
+			}
+		}
+		if config.TokenURL == "" || config.UserInfoURL == "" {
+			return nil, fmt.Errorf("oauth provider %s: token_url and userinfo_url are required", p.Name)
+		}
+		providers = append(providers, auth.NewOAuth2Provider(config, nil))
+	}
+	return auth.NewOAuthProviders(providers...), nil
+}
+
+// NewKafkaManager 创建Kafka管理器，连接失败时返回nil，由生产者降级处理。
+// 开启 provision_on_start 时先创建缺失的主题，配置漂移只记录不修正
+func NewKafkaManager(dc *conf.Data, bc *conf.Business, logger log.Logger) *messaging.KafkaManager {
+	kafkaManager, err := messaging.NewKafkaManager(dc.Kafka, logger)
+	if err != nil {
+		return nil
+	}
+	if bc.KafkaTopics.GetProvisionOnStart() {
+		provisionKafkaTopics(dc.Kafka.Brokers, bc.KafkaTopics, logger)
+	}
+	return kafkaManager
+}
+
+// provisionKafkaTopics 主题管理失败不影响启动，生产者发送到缺失的主题时会报错并降级
+func provisionKafkaTopics(brokers []string, topics *conf.Business_KafkaTopics, logger log.Logger) {
+	helper := log.NewHelper(logger)
+
+	manager, err := messaging.NewKafkaTopicManager(brokers, messaging.TopicSpecsFromConfig(topics), logger)
+	if err != nil {
+		helper.Warnf("provision kafka topics failed: %v", err)
+		return
+	}
+	defer manager.Close()
+
+	report, err := manager.Sync(false)
+	if err != nil {
+		helper.Warnf("provision kafka topics failed: %v", err)
+	}
+	if report == nil {
+		return
+	}
+	for _, drift := range report.Drifts {
+		helper.Warnf("kafka topic drift: %s", drift)
+	}
+}
+
+// NewMetricsProvider 创建Prometheus指标导出，退出时停止采集
+func NewMetricsProvider(logger log.Logger) (*metrics.Provider, func(), error) {
+	provider, err := metrics.NewProvider()
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		if err := provider.Shutdown(context.Background()); err != nil {
+			log.NewHelper(logger).Warnf("shutdown metrics provider failed: %v", err)
+		}
+	}
+	return provider, cleanup, nil
+}
+
+// NewNoopKafkaManager 测试环境不连接Kafka
+func NewNoopKafkaManager() *messaging.KafkaManager {
+	return nil
+}
+
+// NewVideoProcessor 按业务配置创建视频处理器
+func NewVideoProcessor(bc *conf.Business) *media.VideoProcessor {
+	processor := media.NewVideoProcessor(
+		bc.Video.MaxFileSize,
+		bc.Video.SupportedFormats,
+		int(bc.Video.CoverWidth),
+		int(bc.Video.CoverHeight),
+		int(bc.Video.CoverQuality),
+	)
+	processor.SetLimits(biz.NewVideoLimits(bc.Video))
+	return processor
+}
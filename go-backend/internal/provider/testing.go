@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"go-backend/internal/biz"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/security"
+)
+
+// Usecases 测试注入器构建的业务用例及其共享依赖，
+// service层测试用它构造被测服务，不再手工搭建依赖图
+type Usecases struct {
+	User       *biz.UserUsecase
+	Relation   *biz.RelationUsecase
+	Auth       *biz.AuthUsecase
+	Permission *biz.PermissionUsecase
+	Message    *biz.MessageUsecase
+	Register   *biz.RegistrationUsecase
+	Reset      *biz.PasswordResetUsecase
+	Email      *biz.EmailUsecase
+	Referral   *biz.ReferralUsecase
+	Counts     *biz.CountsUsecase
+	Deletion   *biz.AccountDeletionUsecase
+	Presence   *biz.PresenceUsecase
+
+	JWTManager  *auth.JWTManager
+	RBACManager auth.RBACManager
+	Validator   *security.Validator
+}
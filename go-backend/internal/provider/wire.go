@@ -0,0 +1,25 @@
+//go:build wireinject
+// +build wireinject
+
+package provider
+
+import (
+	"go-backend/internal/biz"
+	"go-backend/internal/conf"
+	"go-backend/internal/data"
+	"go-backend/internal/data/producer"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// NewTestUsecases 使用测试providers构建业务用例
+func NewTestUsecases(*conf.Data, *conf.Business, log.Logger) (*Usecases, func(), error) {
+	panic(wire.Build(
+		data.ProviderSet,
+		producer.ProviderSet,
+		biz.ProviderSet,
+		TestPkgSet,
+		wire.Struct(new(Usecases), "*"),
+	))
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/encoding/form"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// multipartMaxMemory multipart 表单保存在内存中的上限，超出部分写入临时文件
+const multipartMaxMemory = 32 << 20
+
+// multipartRequestDecoder 在默认解码器基础上支持 multipart/form-data：
+// 文本字段按表单规则写入请求消息，文件读入同名的 bytes 字段，
+// 请求消息有 filename 字段且未传值时填入上传的文件名
+func multipartRequestDecoder(r *nethttp.Request, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok || !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		return http.DefaultRequestDecoder(r, v)
+	}
+
+	if err := r.ParseMultipartForm(multipartMaxMemory); err != nil {
+		return errors.BadRequest("CODEC", fmt.Sprintf("parse multipart form: %s", err.Error()))
+	}
+	if err := form.DecodeValues(msg, r.MultipartForm.Value); err != nil {
+		return errors.BadRequest("CODEC", fmt.Sprintf("body unmarshal %s", err.Error()))
+	}
+
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for name, headers := range r.MultipartForm.File {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.BytesKind || fd.IsList() || len(headers) == 0 {
+			continue
+		}
+
+		file, err := headers[0].Open()
+		if err != nil {
+			return errors.BadRequest("CODEC", fmt.Sprintf("open file %s: %s", name, err.Error()))
+		}
+		data, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return errors.BadRequest("CODEC", fmt.Sprintf("read file %s: %s", name, err.Error()))
+		}
+		m.Set(fd, protoreflect.ValueOfBytes(data))
+
+		if fn := fields.ByName("filename"); fn != nil && fn.Kind() == protoreflect.StringKind && !m.Has(fn) {
+			m.Set(fn, protoreflect.ValueOfString(headers[0].Filename))
+		}
+	}
+	return nil
+}
@@ -3,6 +3,14 @@ package server
 import (
 	"context"
 
+	adminv1 "go-backend/api/admin/v1"
+	calendarv1 "go-backend/api/calendar/v1"
+	commentv1 "go-backend/api/comment/v1"
+	favoritev1 "go-backend/api/favorite/v1"
+	messagev1 "go-backend/api/message/v1"
+	moderationv1 "go-backend/api/moderation/v1"
+	playlistv1 "go-backend/api/playlist/v1"
+	referralv1 "go-backend/api/referral/v1"
 	userv1 "go-backend/api/user/v1"
 	videov1 "go-backend/api/video/v1"
 	"go-backend/internal/conf"
@@ -10,8 +18,8 @@ import (
 	"go-backend/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	kmiddleware "github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
-	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
@@ -23,46 +31,24 @@ func NewGRPCServer(
 	c *conf.Server,
 	userService *service.UserService,
 	videoService *service.VideoService,
+	messageService *service.MessageService,
+	favoriteService *service.FavoriteService,
+	commentService *service.CommentService,
+	moderationService *service.ModerationService,
+	adminService *service.AdminService,
+	referralService *service.ReferralService,
+	calendarService *service.CalendarService,
+	playlistService *service.PlaylistService,
 	authMiddleware *middleware.AuthMiddleware,
+	rbacMiddleware *middleware.RBACMiddleware,
+	securityMiddleware *middleware.SecurityMiddleware,
 	videoMiddleware *middleware.VideoMiddleware,
+	metadataMiddleware *middleware.MetadataMiddleware,
+	degradationMiddleware *middleware.DegradationMiddleware,
+	metricsMiddleware *middleware.MetricsMiddleware,
 	logger log.Logger,
 ) *grpc.Server {
-	// 需要认证的gRPC方法选择器
-	authRequired := selector.Server(
-		authMiddleware.JWTAuth(),
-	).Match(func(ctx context.Context, operation string) bool {
-		// gRPC内部调用接口不需要JWT认证
-		internalMethods := []string{
-			"/user.v1.UserService/GetUserInfo",
-			"/user.v1.UserService/GetUsersInfo",
-			"/user.v1.UserService/VerifyToken",
-			"/user.v1.UserService/UpdateUserStats",
-			"/video.v1.VideoService/GetVideoInfo",
-			"/video.v1.VideoService/GetVideosInfo",
-			"/video.v1.VideoService/UpdateVideoStats",
-		}
-
-		for _, method := range internalMethods {
-			if operation == method {
-				return false
-			}
-		}
-
-		// 公开接口不需要认证
-		publicMethods := []string{
-			"/user.v1.UserService/Register",
-			"/user.v1.UserService/Login",
-			"/video.v1.VideoService/GetFeed",
-		}
-
-		for _, method := range publicMethods {
-			if operation == method {
-				return false
-			}
-		}
-
-		return true
-	}).Build()
+	authRequired, permissionRequired := newGRPCAuthSelectors(authMiddleware, rbacMiddleware)
 
 	videoFileUploadValidator := videoMiddleware.FileUploadValidator()
 	videoFileSizelimitor := videoMiddleware.FileSizeLimit()
@@ -71,11 +57,15 @@ func NewGRPCServer(
 
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
+			degradationMiddleware.Track(),
+			metricsMiddleware.Server(),
 			recovery.Recovery(),
+			metadataMiddleware.Propagate(),
 			logging.Server(logger),
-			metrics.Server(),
+			securityMiddleware.InputValidation(),
 			validate.Validator(),
 			authRequired,             // 认证中间件
+			permissionRequired,       // 权限中间件
 			videoFileUploadValidator, // 视频文件上传验证中间件
 			videoFileSizelimitor,     // 视频文件大小限制中间件
 			videoTitleValidator,      // 视频标题验证中间件
@@ -101,5 +91,93 @@ func NewGRPCServer(
 	// 注册视频服务gRPC
 	videov1.RegisterVideoServiceServer(srv, videoService)
 
+	// 注册私信服务gRPC
+	messagev1.RegisterMessageServiceServer(srv, messageService)
+
+	// 注册点赞服务gRPC
+	favoritev1.RegisterFavoriteServiceServer(srv, favoriteService)
+
+	// 注册评论服务gRPC
+	commentv1.RegisterCommentServiceServer(srv, commentService)
+
+	// 注册审核服务gRPC
+	moderationv1.RegisterModerationServiceServer(srv, moderationService)
+
+	// 注册管理后台gRPC
+	adminv1.RegisterAdminServiceServer(srv, adminService)
+
+	// 注册邀请推荐服务gRPC
+	referralv1.RegisterReferralServiceServer(srv, referralService)
+
+	// 注册内容日历服务gRPC
+	calendarv1.RegisterCalendarServiceServer(srv, calendarService)
+
+	// 注册视频合集服务gRPC
+	playlistv1.RegisterPlaylistServiceServer(srv, playlistService)
+
 	return srv
 }
+
+// newGRPCAuthSelectors 按方法全名为gRPC接口选择认证和权限中间件
+func newGRPCAuthSelectors(authMiddleware *middleware.AuthMiddleware, rbacMiddleware *middleware.RBACMiddleware) (authRequired, permissionRequired kmiddleware.Middleware) {
+	// 需要认证的gRPC方法选择器
+	authRequired = selector.Server(
+		authMiddleware.JWTAuth(),
+	).Match(func(ctx context.Context, operation string) bool {
+		// gRPC内部调用接口不需要JWT认证
+		internalMethods := []string{
+			"/user.v1.UserService/GetUserInfo",
+			"/user.v1.UserService/GetUsersInfo",
+			"/user.v1.UserService/VerifyToken",
+			"/user.v1.UserService/UpdateUserStats",
+			"/video.v1.VideoService/GetVideoInfo",
+			"/video.v1.VideoService/GetVideosInfo",
+			"/video.v1.VideoService/UpdateVideoStats",
+		}
+
+		for _, method := range internalMethods {
+			if operation == method {
+				return false
+			}
+		}
+
+		// 公开接口不需要认证
+		publicMethods := []string{
+			"/user.v1.UserService/Register",
+			"/user.v1.UserService/Login",
+			"/user.v1.UserService/LoginWithOAuth",
+			"/user.v1.UserService/SendVerificationCode",
+			"/user.v1.UserService/LoginWithCode",
+			"/user.v1.UserService/RestoreAccount",
+			"/user.v1.UserService/RequestPasswordReset",
+			"/user.v1.UserService/ResetPassword",
+			"/user.v1.UserService/GetUserShareCard",
+			"/user.v1.UserService/GetProfilePage",
+			"/video.v1.VideoService/GetFeed",
+			"/video.v1.VideoService/GetVideoShareCard",
+			"/video.v1.VideoService/ReportPlay",
+			"/video.v1.VideoService/ResolveShareLink",
+			"/video.v1.VideoService/GetTrending",
+			"/comment.v1.CommentService/GetCommentList",
+			"/comment.v1.CommentService/GetCommentReplies",
+			"/referral.v1.ReferralService/GetReferralLeaderboard",
+			"/playlist.v1.PlaylistService/GetPlaylist",
+			"/playlist.v1.PlaylistService/ListUserPlaylists",
+		}
+
+		for _, method := range publicMethods {
+			if operation == method {
+				return false
+			}
+		}
+
+		return true
+	}).Build()
+
+	// 需要权限检查的方法选择器
+	permissionRequired = selector.Server(
+		rbacMiddleware.ResourceAction(),
+	).Path(adminPermissionOperations...).Build()
+
+	return authRequired, permissionRequired
+}
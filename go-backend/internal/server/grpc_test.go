@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	adminv1 "go-backend/api/admin/v1"
+	commonv1 "go-backend/api/common/v1"
+	"go-backend/internal/middleware"
+	"go-backend/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGRPCAdminPermission(t *testing.T) {
+	jwt := auth.NewJWTManager("test-secret", time.Hour)
+	admins := map[int64]bool{1: true}
+	authMiddleware := middleware.NewAuthMiddleware(jwt, stubBanChecker{}, log.DefaultLogger)
+	rbacMiddleware := middleware.NewRBACMiddleware(&stubPermissionChecker{admins: admins}, discardDenials{}, log.DefaultLogger)
+
+	authRequired, permissionRequired := newGRPCAuthSelectors(authMiddleware, rbacMiddleware)
+	srv := grpc.NewServer(grpc.Address("127.0.0.1:0"), grpc.Middleware(authRequired, permissionRequired))
+	adminv1.RegisterAdminServiceServer(srv, &stubAdminService{})
+
+	endpoint, err := srv.Endpoint()
+	require.NoError(t, err)
+	go func() { _ = srv.Start(context.Background()) }()
+	t.Cleanup(func() { _ = srv.Stop(context.Background()) })
+
+	conn, err := grpc.DialInsecure(context.Background(), grpc.WithEndpoint(endpoint.Host))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := adminv1.NewAdminServiceClient(conn)
+
+	listPromotions := func(userID int64) (*adminv1.ListPromotionsResponse, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if userID != 0 {
+			token, err := jwt.GenerateToken(userID, "user")
+			require.NoError(t, err)
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		}
+		return client.ListPromotions(ctx, &adminv1.ListPromotionsRequest{})
+	}
+
+	_, err = listPromotions(0)
+	assert.Equal(t, commonv1.ErrorCode_TOKEN_INVALID.String(), errors.Reason(err))
+
+	_, err = listPromotions(2)
+	assert.Equal(t, commonv1.ErrorCode_PERMISSION_DENIED.String(), errors.Reason(err))
+
+	resp, err := listPromotions(1)
+	require.NoError(t, err)
+	assert.Equal(t, "1", resp.GetBase().GetStatusMsg())
+}
@@ -1,6 +1,14 @@
 package server
 
 import (
+	adminv1 "go-backend/api/admin/v1"
+	calendarv1 "go-backend/api/calendar/v1"
+	commentv1 "go-backend/api/comment/v1"
+	favoritev1 "go-backend/api/favorite/v1"
+	messagev1 "go-backend/api/message/v1"
+	moderationv1 "go-backend/api/moderation/v1"
+	playlistv1 "go-backend/api/playlist/v1"
+	referralv1 "go-backend/api/referral/v1"
 	userv1 "go-backend/api/user/v1"
 	videov1 "go-backend/api/video/v1"
 	"go-backend/internal/conf"
@@ -8,8 +16,8 @@ import (
 	"go-backend/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	kmiddleware "github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
-	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
@@ -21,47 +29,34 @@ func NewHTTPServer(
 	c *conf.Server,
 	userService *service.UserService,
 	videoService *service.VideoService,
+	messageService *service.MessageService,
+	favoriteService *service.FavoriteService,
+	commentService *service.CommentService,
+	moderationService *service.ModerationService,
+	adminService *service.AdminService,
+	referralService *service.ReferralService,
+	calendarService *service.CalendarService,
+	playlistService *service.PlaylistService,
 	authMiddleware *middleware.AuthMiddleware,
 	rbacMiddleware *middleware.RBACMiddleware,
 	rateLimitMiddleware *middleware.RateLimitMiddleware,
 	securityMiddleware *middleware.SecurityMiddleware,
 	videoMiddleware *middleware.VideoMiddleware,
+	metadataMiddleware *middleware.MetadataMiddleware,
+	callbackMiddleware *middleware.CallbackMiddleware,
+	degradationMiddleware *middleware.DegradationMiddleware,
+	metricsMiddleware *middleware.MetricsMiddleware,
 	logger log.Logger,
 ) *http.Server {
-	// 需要认证的路由中间件
-	authRequired := selector.Server(
-		authMiddleware.JWTAuth(),
-	).Path(
-		"/douyin/user",
-		"/douyin/relation/action",
-		"/douyin/relation/follow/list",
-		"/douyin/relation/follower/list",
-		"/douyin/relation/friend/list",
-		"/douyin/publish/action",
-		"/douyin/publish/list",
-	).Build()
-
-	// 可选认证的路由中间件
-	optionalAuth := selector.Server(
-		authMiddleware.OptionalJWTAuth(),
-	).Path(
-		"/douyin/feed",
-	).Build()
-
-	// 需要权限检查的路由中间件
-	permissionRequired := selector.Server(
-		rbacMiddleware.ResourceAction(),
-	).Path(
-		"/douyin/video/delete",   // 需要特定权限
-		"/douyin/comment/delete", // 需要特定权限
-		"/douyin/admin",          // 需要管理员权限
-	).Build()
+	// 认证和权限中间件
+	authRequired, optionalAuth, permissionRequired := newHTTPAuthSelectors(authMiddleware, rbacMiddleware)
 
 	// 限流中间件
 	rateLimiter := rateLimitMiddleware.Limit()
 
 	// 安全中间件
 	security := securityMiddleware.GlobalSecurityHandler()
+	inputValidation := securityMiddleware.InputValidation()
 
 	// 视频中间件
 	videoFileUploadValidator := videoMiddleware.FileUploadValidator()
@@ -71,20 +66,25 @@ func NewHTTPServer(
 
 	var opts = []http.ServerOption{
 		http.Middleware(
-			recovery.Recovery(),      // 恢复中间件
-			logging.Server(logger),   // 日志中间件
-			metrics.Server(),         // 指标中间件
-			validate.Validator(),     // 验证器中间件
-			security,                 // 全局安全中间件
-			rateLimiter,              // 限流中间件
-			authRequired,             // 认证中间件
-			optionalAuth,             // 可选认证中间件
-			permissionRequired,       // 权限中间件
-			videoFileUploadValidator, // 视频文件上传验证中间件
-			videoFileSizelimitor,     // 视频文件大小限制中间件
-			videoTitleValidator,      // 视频标题验证中间件
-			videoFormatValidator,     // 视频文件类型验证中间件
+			degradationMiddleware.Track(),  // 请求负载统计中间件
+			metricsMiddleware.Server(),     // 指标中间件
+			recovery.Recovery(),            // 恢复中间件
+			metadataMiddleware.Propagate(), // 请求元数据中间件
+			logging.Server(logger),         // 日志中间件
+			inputValidation,                // 请求字段清洗中间件
+			validate.Validator(),           // 验证器中间件
+			security,                       // 全局安全中间件
+			rateLimiter,                    // 限流中间件
+			authRequired,                   // 认证中间件
+			optionalAuth,                   // 可选认证中间件
+			permissionRequired,             // 权限中间件
+			videoFileUploadValidator,       // 视频文件上传验证中间件
+			videoFileSizelimitor,           // 视频文件大小限制中间件
+			videoTitleValidator,            // 视频标题验证中间件
+			videoFormatValidator,           // 视频文件类型验证中间件
 		),
+		http.RequestDecoder(multipartRequestDecoder), // 支持 multipart 文件上传
+		http.Filter(callbackMiddleware.Filter()),     // 回调签名校验，需读取原始请求体
 	}
 
 	if c.Http.Network != "" {
@@ -99,11 +99,61 @@ func NewHTTPServer(
 
 	srv := http.NewServer(opts...)
 
+	// Prometheus指标端点
+	srv.Handle("/metrics", metricsMiddleware.Handler())
+
+	// 签名公钥端点，供下游服务验证令牌
+	srv.Handle("/.well-known/jwks.json", authMiddleware.JWKSHandler())
+
 	// 注册用户服务HTTP路由
 	userv1.RegisterUserServiceHTTPServer(srv, userService)
 
 	// 注册视频服务HTTP路由
 	videov1.RegisterVideoServiceHTTPServer(srv, videoService)
 
+	// 注册私信服务HTTP路由
+	messagev1.RegisterMessageServiceHTTPServer(srv, messageService)
+
+	// 注册点赞服务HTTP路由
+	favoritev1.RegisterFavoriteServiceHTTPServer(srv, favoriteService)
+
+	// 注册评论服务HTTP路由
+	commentv1.RegisterCommentServiceHTTPServer(srv, commentService)
+
+	// 注册审核服务HTTP路由
+	moderationv1.RegisterModerationServiceHTTPServer(srv, moderationService)
+
+	// 注册管理后台HTTP路由
+	adminv1.RegisterAdminServiceHTTPServer(srv, adminService)
+
+	// 注册邀请推荐服务HTTP路由
+	referralv1.RegisterReferralServiceHTTPServer(srv, referralService)
+
+	// 注册内容日历服务HTTP路由
+	calendarv1.RegisterCalendarServiceHTTPServer(srv, calendarService)
+
+	// 注册视频合集服务HTTP路由
+	playlistv1.RegisterPlaylistServiceHTTPServer(srv, playlistService)
+
 	return srv
 }
+
+// newHTTPAuthSelectors 按 operation 名称为HTTP接口选择认证、可选认证和权限中间件
+func newHTTPAuthSelectors(authMiddleware *middleware.AuthMiddleware, rbacMiddleware *middleware.RBACMiddleware) (authRequired, optionalAuth, permissionRequired kmiddleware.Middleware) {
+	// 需要认证的路由中间件
+	authRequired = selector.Server(
+		authMiddleware.JWTAuth(),
+	).Path(httpAuthOperations...).Build()
+
+	// 可选认证的路由中间件
+	optionalAuth = selector.Server(
+		authMiddleware.OptionalJWTAuth(),
+	).Path(httpOptionalAuthOperations...).Build()
+
+	// 需要权限检查的路由中间件
+	permissionRequired = selector.Server(
+		rbacMiddleware.ResourceAction(),
+	).Path(adminPermissionOperations...).Build()
+
+	return authRequired, optionalAuth, permissionRequired
+}
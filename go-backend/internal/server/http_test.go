@@ -0,0 +1,255 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	adminv1 "go-backend/api/admin/v1"
+	commonv1 "go-backend/api/common/v1"
+	favoritev1 "go-backend/api/favorite/v1"
+	userv1 "go-backend/api/user/v1"
+	"go-backend/internal/domain"
+	"go-backend/internal/middleware"
+	"go-backend/pkg/auth"
+	"go-backend/pkg/reqctx"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// currentUser 把中间件识别出的用户ID写入响应，0表示匿名
+func currentUser(ctx context.Context) *commonv1.BaseResponse {
+	userID, _ := reqctx.UserID(ctx)
+	return &commonv1.BaseResponse{StatusMsg: strconv.FormatInt(userID, 10)}
+}
+
+type stubUserService struct {
+	userv1.UserServiceHTTPServer
+}
+
+func (s *stubUserService) Register(ctx context.Context, req *userv1.RegisterRequest) (*userv1.RegisterResponse, error) {
+	return &userv1.RegisterResponse{Base: currentUser(ctx)}, nil
+}
+
+func (s *stubUserService) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.GetUserResponse, error) {
+	return &userv1.GetUserResponse{Base: currentUser(ctx)}, nil
+}
+
+type stubFavoriteService struct {
+	favoritev1.FavoriteServiceHTTPServer
+}
+
+func (s *stubFavoriteService) FavoriteAction(ctx context.Context, req *favoritev1.FavoriteActionRequest) (*favoritev1.FavoriteActionResponse, error) {
+	return &favoritev1.FavoriteActionResponse{Base: currentUser(ctx)}, nil
+}
+
+func (s *stubFavoriteService) GetFavoriteList(ctx context.Context, req *favoritev1.GetFavoriteListRequest) (*favoritev1.GetFavoriteListResponse, error) {
+	return &favoritev1.GetFavoriteListResponse{Base: currentUser(ctx)}, nil
+}
+
+type stubAdminService struct {
+	adminv1.UnimplementedAdminServiceServer
+}
+
+func (s *stubAdminService) ListPromotions(ctx context.Context, req *adminv1.ListPromotionsRequest) (*adminv1.ListPromotionsResponse, error) {
+	return &adminv1.ListPromotionsResponse{Base: currentUser(ctx)}, nil
+}
+
+func (s *stubAdminService) BanUser(ctx context.Context, req *adminv1.BanUserRequest) (*adminv1.BanUserResponse, error) {
+	return &adminv1.BanUserResponse{Base: currentUser(ctx)}, nil
+}
+
+type stubBanChecker map[int64]bool
+
+func (c stubBanChecker) IsUserBanned(ctx context.Context, userID int64) (bool, error) {
+	return c[userID], nil
+}
+
+// stubPermissionChecker 只有 admins 中的用户是管理员
+type stubPermissionChecker struct {
+	admins map[int64]bool
+}
+
+func (c *stubPermissionChecker) CheckPermission(ctx context.Context, userID int64, resource, action string) (bool, error) {
+	return c.admins[userID], nil
+}
+
+func (c *stubPermissionChecker) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	return c.admins[userID], nil
+}
+
+func (c *stubPermissionChecker) IsModerator(ctx context.Context, userID int64) (bool, error) {
+	return false, nil
+}
+
+func (c *stubPermissionChecker) CanModerateContent(ctx context.Context, userID int64) (bool, error) {
+	return c.admins[userID], nil
+}
+
+func (c *stubPermissionChecker) CheckTokenPermission(claims *auth.Claims, resource, action string) bool {
+	return false
+}
+
+func (c *stubPermissionChecker) TokenHasRole(claims *auth.Claims, roleNames ...string) bool {
+	return false
+}
+
+type discardDenials struct{}
+
+func (discardDenials) RecordDenial(ctx context.Context, denial *domain.PermissionDenial) {}
+
+// selectorTestEnv 只挂载认证和权限中间件的HTTP服务，路由使用生成代码注册，operation 与线上一致
+type selectorTestEnv struct {
+	url    string
+	jwt    *auth.JWTManager
+	banned stubBanChecker
+	admins map[int64]bool
+}
+
+func newSelectorTestEnv(t *testing.T) *selectorTestEnv {
+	env := &selectorTestEnv{
+		jwt:    auth.NewJWTManager("test-secret", time.Hour),
+		banned: stubBanChecker{},
+		admins: map[int64]bool{},
+	}
+	authMiddleware := middleware.NewAuthMiddleware(env.jwt, env.banned, log.DefaultLogger)
+	rbacMiddleware := middleware.NewRBACMiddleware(&stubPermissionChecker{admins: env.admins}, discardDenials{}, log.DefaultLogger)
+
+	authRequired, optionalAuth, permissionRequired := newHTTPAuthSelectors(authMiddleware, rbacMiddleware)
+	srv := http.NewServer(http.Middleware(authRequired, optionalAuth, permissionRequired))
+	userv1.RegisterUserServiceHTTPServer(srv, &stubUserService{})
+	favoritev1.RegisterFavoriteServiceHTTPServer(srv, &stubFavoriteService{})
+	adminv1.RegisterAdminServiceHTTPServer(srv, &stubAdminService{})
+
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	env.url = ts.URL
+	return env
+}
+
+func (e *selectorTestEnv) token(t *testing.T, userID int64) string {
+	token, err := e.jwt.GenerateToken(userID, "user"+strconv.FormatInt(userID, 10))
+	require.NoError(t, err)
+	return token
+}
+
+// do 发送请求，返回HTTP状态码和响应体中的 reason（错误）或 statusMsg（成功）
+func (e *selectorTestEnv) do(t *testing.T, method, path, token string) (int, string) {
+	var body io.Reader
+	if method == nethttp.MethodPost {
+		body = strings.NewReader("{}")
+	}
+	req, err := nethttp.NewRequest(method, e.url+path, body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := nethttp.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var decoded struct {
+		Reason string `json:"reason"`
+		Base   struct {
+			StatusMsg string `json:"statusMsg"`
+		} `json:"base"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+	if decoded.Reason != "" {
+		return resp.StatusCode, decoded.Reason
+	}
+	return resp.StatusCode, decoded.Base.StatusMsg
+}
+
+func TestHTTPAuthSelectors(t *testing.T) {
+	env := newSelectorTestEnv(t)
+
+	t.Run("AuthRequired", func(t *testing.T) {
+		status, reason := env.do(t, nethttp.MethodPost, "/douyin/favorite/action", "")
+		assert.Equal(t, nethttp.StatusUnauthorized, status)
+		assert.Equal(t, commonv1.ErrorCode_TOKEN_INVALID.String(), reason)
+
+		status, reason = env.do(t, nethttp.MethodPost, "/douyin/favorite/action", "not-a-token")
+		assert.Equal(t, nethttp.StatusUnauthorized, status)
+		assert.Equal(t, commonv1.ErrorCode_TOKEN_INVALID.String(), reason)
+
+		status, user := env.do(t, nethttp.MethodPost, "/douyin/favorite/action", env.token(t, 7))
+		assert.Equal(t, nethttp.StatusOK, status)
+		assert.Equal(t, "7", user)
+	})
+
+	t.Run("OptionalAuth", func(t *testing.T) {
+		status, user := env.do(t, nethttp.MethodGet, "/douyin/favorite/list", "")
+		assert.Equal(t, nethttp.StatusOK, status)
+		assert.Equal(t, "0", user)
+
+		status, user = env.do(t, nethttp.MethodGet, "/douyin/favorite/list", env.token(t, 7))
+		assert.Equal(t, nethttp.StatusOK, status)
+		assert.Equal(t, "7", user)
+	})
+
+	t.Run("Public", func(t *testing.T) {
+		status, user := env.do(t, nethttp.MethodPost, "/douyin/user/register", "")
+		assert.Equal(t, nethttp.StatusOK, status)
+		assert.Equal(t, "0", user)
+	})
+}
+
+func TestHTTPAdminPermission(t *testing.T) {
+	env := newSelectorTestEnv(t)
+	env.admins[1] = true
+
+	status, reason := env.do(t, nethttp.MethodGet, "/douyin/admin/promotion/list", "")
+	assert.Equal(t, nethttp.StatusUnauthorized, status)
+	assert.Equal(t, commonv1.ErrorCode_TOKEN_INVALID.String(), reason)
+
+	status, reason = env.do(t, nethttp.MethodGet, "/douyin/admin/promotion/list", env.token(t, 2))
+	assert.Equal(t, nethttp.StatusForbidden, status)
+	assert.Equal(t, commonv1.ErrorCode_PERMISSION_DENIED.String(), reason)
+
+	status, user := env.do(t, nethttp.MethodGet, "/douyin/admin/promotion/list", env.token(t, 1))
+	assert.Equal(t, nethttp.StatusOK, status)
+	assert.Equal(t, "1", user)
+}
+
+func TestHTTPBanUserRequiresAdmin(t *testing.T) {
+	env := newSelectorTestEnv(t)
+	env.admins[1] = true
+
+	status, reason := env.do(t, nethttp.MethodPost, "/douyin/admin/user/ban", env.token(t, 2))
+	assert.Equal(t, nethttp.StatusForbidden, status)
+	assert.Equal(t, commonv1.ErrorCode_PERMISSION_DENIED.String(), reason)
+
+	status, user := env.do(t, nethttp.MethodPost, "/douyin/admin/user/ban", env.token(t, 1))
+	assert.Equal(t, nethttp.StatusOK, status)
+	assert.Equal(t, "1", user)
+}
+
+func TestHTTPBannedUserRejected(t *testing.T) {
+	env := newSelectorTestEnv(t)
+	token := env.token(t, 3)
+
+	status, _ := env.do(t, nethttp.MethodPost, "/douyin/favorite/action", token)
+	assert.Equal(t, nethttp.StatusOK, status)
+
+	env.banned[3] = true
+	status, reason := env.do(t, nethttp.MethodPost, "/douyin/favorite/action", token)
+	assert.Equal(t, nethttp.StatusForbidden, status)
+	assert.Equal(t, commonv1.ErrorCode_USER_BANNED.String(), reason)
+
+	// 可选认证接口把封禁用户按匿名用户处理
+	status, user := env.do(t, nethttp.MethodGet, "/douyin/favorite/list", token)
+	assert.Equal(t, nethttp.StatusOK, status)
+	assert.Equal(t, "0", user)
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	adminv1 "go-backend/api/admin/v1"
+	calendarv1 "go-backend/api/calendar/v1"
+	commentv1 "go-backend/api/comment/v1"
+	favoritev1 "go-backend/api/favorite/v1"
+	messagev1 "go-backend/api/message/v1"
+	moderationv1 "go-backend/api/moderation/v1"
+	playlistv1 "go-backend/api/playlist/v1"
+	referralv1 "go-backend/api/referral/v1"
+	userv1 "go-backend/api/user/v1"
+	videov1 "go-backend/api/video/v1"
+)
+
+// 中间件选择器按 operation 名称匹配（如 /user.v1.UserService/GetUser），HTTP 请求的 operation
+// 由生成的 _http.pb.go 在路由处理函数中设置，与 gRPC 的方法全名一致，不是请求的 URL 路径
+
+// httpAuthOperations 需要认证的HTTP接口
+var httpAuthOperations = []string{
+	userv1.OperationUserServiceGetUser,
+	userv1.OperationUserServiceLogout,
+	userv1.OperationUserServiceDeleteAccount,
+	userv1.OperationUserServiceExportMyData,
+	userv1.OperationUserServiceUpdateTimezone,
+	userv1.OperationUserServiceUpdatePrivacy,
+	userv1.OperationUserServiceGetMyQuota,
+	userv1.OperationUserServiceGetCreatorAnalytics,
+	userv1.OperationUserServiceGetLoginHistory,
+	userv1.OperationUserServicePing,
+	userv1.OperationUserServiceUpdateProfile,
+	userv1.OperationUserServiceChangePassword,
+	userv1.OperationUserServiceUploadAvatar,
+	userv1.OperationUserServiceUploadBackgroundImage,
+	userv1.OperationUserServiceBindEmail,
+	userv1.OperationUserServiceVerifyEmail,
+	userv1.OperationUserServiceRelationAction,
+	userv1.OperationUserServiceGetFollowList,
+	userv1.OperationUserServiceGetFollowerList,
+	userv1.OperationUserServiceGetFriendList,
+	userv1.OperationUserServiceGetOnlineFriends,
+	userv1.OperationUserServiceListFollowRequests,
+	userv1.OperationUserServiceApproveFollowRequest,
+	userv1.OperationUserServiceRejectFollowRequest,
+	videov1.OperationVideoServicePublishVideo,
+	videov1.OperationVideoServiceGetPublishList,
+	videov1.OperationVideoServiceUploadVideoFile,
+	videov1.OperationVideoServiceInitiateMultipartUpload,
+	videov1.OperationVideoServiceUploadPart,
+	videov1.OperationVideoServiceListUploadedParts,
+	videov1.OperationVideoServiceVerifyUpload,
+	videov1.OperationVideoServiceCompleteMultipartUpload,
+	videov1.OperationVideoServiceAbortMultipartUpload,
+	videov1.OperationVideoServiceGetUploadProgress,
+	videov1.OperationVideoServiceRecordView,
+	videov1.OperationVideoServiceGetWatchHistory,
+	videov1.OperationVideoServiceGetVideoAudience,
+	videov1.OperationVideoServiceAppealTakedown,
+	videov1.OperationVideoServiceListMyTakedowns,
+	videov1.OperationVideoServiceSetVideoCaptions,
+	videov1.OperationVideoServiceSchedulePublish,
+	videov1.OperationVideoServiceSetVideoVisibility,
+	videov1.OperationVideoServiceUpdateVideoInfo,
+	videov1.OperationVideoServiceShareVideo,
+	messagev1.OperationMessageServiceSendMessage,
+	messagev1.OperationMessageServiceGetMessageHistory,
+	favoritev1.OperationFavoriteServiceFavoriteAction,
+	commentv1.OperationCommentServiceCommentAction,
+	commentv1.OperationCommentServiceCommentHeartAction,
+	commentv1.OperationCommentServiceMutedKeywordAction,
+	commentv1.OperationCommentServiceListMutedKeywords,
+	moderationv1.OperationModerationServiceListPendingVideos,
+	moderationv1.OperationModerationServiceReviewVideo,
+	moderationv1.OperationModerationServiceListPendingComments,
+	moderationv1.OperationModerationServiceReviewComment,
+	moderationv1.OperationModerationServiceListFlaggedRegistrations,
+	moderationv1.OperationModerationServiceReviewRegistration,
+	adminv1.OperationAdminServiceListPermissionDenials,
+	adminv1.OperationAdminServiceGetProcessingReport,
+	adminv1.OperationAdminServiceListRoles,
+	adminv1.OperationAdminServiceCreateRole,
+	adminv1.OperationAdminServiceUpdateRole,
+	adminv1.OperationAdminServiceDeleteRole,
+	adminv1.OperationAdminServiceListPermissions,
+	adminv1.OperationAdminServiceCreatePermission,
+	adminv1.OperationAdminServiceUpdatePermission,
+	adminv1.OperationAdminServiceDeletePermission,
+	adminv1.OperationAdminServiceRolePermissionAction,
+	adminv1.OperationAdminServiceListDeadLetters,
+	adminv1.OperationAdminServiceReplayDeadLetter,
+	adminv1.OperationAdminServiceTakedownVideo,
+	adminv1.OperationAdminServiceListTakedowns,
+	adminv1.OperationAdminServiceDecideTakedownAppeal,
+	adminv1.OperationAdminServiceGetTakedownEvents,
+	adminv1.OperationAdminServiceBanUser,
+	adminv1.OperationAdminServiceUnbanUser,
+	adminv1.OperationAdminServiceListCategories,
+	adminv1.OperationAdminServiceCreateCategory,
+	adminv1.OperationAdminServiceUpdateCategory,
+	adminv1.OperationAdminServiceDeleteCategory,
+	adminv1.OperationAdminServiceFlushCache,
+	adminv1.OperationAdminServicePurgeSessions,
+	adminv1.OperationAdminServiceReindex,
+	adminv1.OperationAdminServiceRequeueProcessing,
+	adminv1.OperationAdminServiceRotateSigningKey,
+	adminv1.OperationAdminServiceListSigningKeys,
+	adminv1.OperationAdminServiceGetDegradationStatus,
+	adminv1.OperationAdminServiceListPromotions,
+	adminv1.OperationAdminServiceCreatePromotion,
+	adminv1.OperationAdminServiceUpdatePromotion,
+	adminv1.OperationAdminServiceGetPromotionReport,
+	referralv1.OperationReferralServiceGetMyReferral,
+	calendarv1.OperationCalendarServiceListCalendar,
+	calendarv1.OperationCalendarServiceCreateDraft,
+	calendarv1.OperationCalendarServiceUpdateDraft,
+	calendarv1.OperationCalendarServiceDeleteDraft,
+	playlistv1.OperationPlaylistServiceCreatePlaylist,
+	playlistv1.OperationPlaylistServiceUpdatePlaylist,
+	playlistv1.OperationPlaylistServiceDeletePlaylist,
+	playlistv1.OperationPlaylistServicePlaylistVideoAction,
+	playlistv1.OperationPlaylistServiceReorderPlaylist,
+}
+
+// httpOptionalAuthOperations 可选认证的HTTP接口，携带有效令牌时识别当前用户
+var httpOptionalAuthOperations = []string{
+	videov1.OperationVideoServiceGetFeed,
+	userv1.OperationUserServiceGetProfilePage,
+	favoritev1.OperationFavoriteServiceGetFavoriteList,
+	commentv1.OperationCommentServiceGetCommentList,
+	commentv1.OperationCommentServiceGetCommentReplies,
+	videov1.OperationVideoServiceSearchWithinCreator,
+	videov1.OperationVideoServiceReportPlay,
+	videov1.OperationVideoServiceResolveShareLink,
+	videov1.OperationVideoServiceGetTrending,
+	videov1.OperationVideoServiceRecordPromotionClick,
+	playlistv1.OperationPlaylistServiceGetPlaylist,
+	playlistv1.OperationPlaylistServiceListUserPlaylists,
+}
+
+// adminPermissionOperations 需要管理员权限的接口，HTTP 和 gRPC 共用，必须同时在认证中间件之后执行
+var adminPermissionOperations = []string{
+	adminv1.OperationAdminServiceListPermissionDenials,
+	adminv1.OperationAdminServiceGetProcessingReport,
+	adminv1.OperationAdminServiceListRoles,
+	adminv1.OperationAdminServiceCreateRole,
+	adminv1.OperationAdminServiceUpdateRole,
+	adminv1.OperationAdminServiceDeleteRole,
+	adminv1.OperationAdminServiceListPermissions,
+	adminv1.OperationAdminServiceCreatePermission,
+	adminv1.OperationAdminServiceUpdatePermission,
+	adminv1.OperationAdminServiceDeletePermission,
+	adminv1.OperationAdminServiceRolePermissionAction,
+	adminv1.OperationAdminServiceListDeadLetters,
+	adminv1.OperationAdminServiceReplayDeadLetter,
+	adminv1.OperationAdminServiceTakedownVideo,
+	adminv1.OperationAdminServiceListTakedowns,
+	adminv1.OperationAdminServiceDecideTakedownAppeal,
+	adminv1.OperationAdminServiceGetTakedownEvents,
+	adminv1.OperationAdminServiceListCategories,
+	adminv1.OperationAdminServiceCreateCategory,
+	adminv1.OperationAdminServiceUpdateCategory,
+	adminv1.OperationAdminServiceDeleteCategory,
+	adminv1.OperationAdminServiceFlushCache,
+	adminv1.OperationAdminServicePurgeSessions,
+	adminv1.OperationAdminServiceReindex,
+	adminv1.OperationAdminServiceRequeueProcessing,
+	adminv1.OperationAdminServiceRotateSigningKey,
+	adminv1.OperationAdminServiceListSigningKeys,
+	adminv1.OperationAdminServiceGetDegradationStatus,
+	adminv1.OperationAdminServiceListPromotions,
+	adminv1.OperationAdminServiceCreatePromotion,
+	adminv1.OperationAdminServiceUpdatePromotion,
+	adminv1.OperationAdminServiceGetPromotionReport,
+	adminv1.OperationAdminServiceBanUser,
+	adminv1.OperationAdminServiceUnbanUser,
+}
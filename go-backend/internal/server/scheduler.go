@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go-backend/internal/biz"
+	"go-backend/pkg/clock"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Job 定时任务
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(context.Context) error
+}
+
+// Scheduler 定时任务调度器，实现kratos transport.Server接口随应用启停
+type Scheduler struct {
+	jobs   []*Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	clock  clock.Clock
+	log    *log.Helper
+}
+
+// NewScheduler 创建调度器并注册后台任务
+func NewScheduler(
+	retentionUc *biz.RetentionUsecase,
+	rbacSyncUc *biz.RBACSyncUsecase,
+	auditUc *biz.PermissionAuditUsecase,
+	calendarUc *biz.CalendarUsecase,
+	countsUc *biz.CountsUsecase,
+	reconcileUc *biz.CounterReconcileUsecase,
+	integrityUc *biz.IntegrityUsecase,
+	outboxUc *biz.OutboxRelayUsecase,
+	statsFlushUc *biz.VideoStatsFlushUsecase,
+	trendingUc *biz.TrendingUsecase,
+	moderationUc *biz.ContentModerationUsecase,
+	signingKeyUc *biz.SigningKeyUsecase,
+	degradationUc *biz.DegradationUsecase,
+	accountPurgeUc *biz.AccountPurgeUsecase,
+	storageDeletionUc *biz.StorageDeletionUsecase,
+	orphanCleanupUc *biz.OrphanCleanupUsecase,
+	videoUc *biz.VideoUsecase,
+	clk clock.Clock,
+	logger log.Logger,
+) *Scheduler {
+	s := &Scheduler{
+		clock: clk,
+		log:   log.NewHelper(logger),
+	}
+
+	if retentionUc.Enabled() {
+		s.Register(&Job{
+			Name:     "data_retention",
+			Interval: retentionUc.Interval(),
+			Run:      retentionUc.Run,
+		})
+	}
+
+	s.Register(&Job{
+		Name:     "rbac_refresh",
+		Interval: rbacSyncUc.RefreshInterval(),
+		Run:      rbacSyncUc.Hydrate,
+	})
+	s.Register(&Job{
+		Name:     "rbac_consistency_check",
+		Interval: rbacSyncUc.ConsistencyCheckInterval(),
+		Run:      rbacSyncUc.RunConsistencyCheck,
+	})
+
+	if auditUc.Enabled() {
+		s.Register(&Job{
+			Name:     "permission_denial_trim",
+			Interval: auditUc.TrimInterval(),
+			Run:      auditUc.Trim,
+		})
+	}
+
+	// 通知被降级期间提醒保持待发送，恢复后补发
+	s.Register(&Job{
+		Name:     "draft_reminder",
+		Interval: calendarUc.ReminderInterval(),
+		Run:      degradationUc.Guard(biz.FeatureNotifications, calendarUc.SendReminders),
+	})
+	s.Register(&Job{
+		Name:     "follow_counts_reconcile",
+		Interval: countsUc.ReconcileInterval(),
+		Run:      countsUc.Reconcile,
+	})
+	if reconcileUc.Enabled() {
+		s.Register(&Job{
+			Name:     "counter_reconcile",
+			Interval: reconcileUc.Interval(),
+			Run:      reconcileUc.Run,
+		})
+	}
+	if integrityUc.Enabled() {
+		s.Register(&Job{
+			Name:     "storage_integrity_check",
+			Interval: integrityUc.Interval(),
+			Run:      integrityUc.Run,
+		})
+	}
+	s.Register(&Job{
+		Name:     "outbox_relay",
+		Interval: outboxUc.PollInterval(),
+		Run:      outboxUc.Relay,
+	})
+	if statsFlushUc.Enabled() {
+		s.Register(&Job{
+			Name:     "video_stats_flush",
+			Interval: statsFlushUc.Interval(),
+			Run:      statsFlushUc.Flush,
+		})
+	}
+	s.Register(&Job{
+		Name:     "trending_refresh",
+		Interval: trendingUc.RefreshInterval(),
+		Run:      trendingUc.Refresh,
+	})
+	s.Register(&Job{
+		Name:     "sensitive_words_reload",
+		Interval: moderationUc.ReloadInterval(),
+		Run:      moderationUc.Reload,
+	})
+	s.Register(&Job{
+		Name:     "signing_key_refresh",
+		Interval: signingKeyUc.RefreshInterval(),
+		Run:      signingKeyUc.Refresh,
+	})
+	// 降级状态属于本实例，每个实例都独立评估
+	if degradationUc.Enabled() {
+		s.Register(&Job{
+			Name:     "degradation_evaluate",
+			Interval: degradationUc.Interval(),
+			Run:      degradationUc.Evaluate,
+		})
+	}
+	s.Register(&Job{
+		Name:     "account_purge",
+		Interval: accountPurgeUc.Interval(),
+		Run:      accountPurgeUc.Run,
+	})
+	s.Register(&Job{
+		Name:     "storage_deletion",
+		Interval: storageDeletionUc.Interval(),
+		Run:      storageDeletionUc.Run,
+	})
+	if orphanCleanupUc.Enabled() {
+		s.Register(&Job{
+			Name:     "orphan_cleanup",
+			Interval: orphanCleanupUc.Interval(),
+			Run:      orphanCleanupUc.Run,
+		})
+	}
+	s.Register(&Job{
+		Name:     "scheduled_publish",
+		Interval: videoUc.ScheduledPublishInterval(),
+		Run:      videoUc.PublishDueDrafts,
+	})
+
+	return s
+}
+
+// Register 注册定时任务
+func (s *Scheduler) Register(job *Job) {
+	if job.Interval <= 0 {
+		s.log.Warnf("skip job %s: invalid interval %s", job.Name, job.Interval)
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Start 启动所有定时任务
+func (s *Scheduler) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+
+	s.log.Infof("scheduler started with %d jobs", len(s.jobs))
+	return nil
+}
+
+// Stop 停止所有定时任务并等待正在执行的任务结束
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.log.Info("scheduler stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop 按间隔执行任务
+func (s *Scheduler) loop(ctx context.Context, job *Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runJob(ctx, job)
+		}
+	}
+}
+
+// runJob 执行单次任务，捕获panic避免影响其他任务
+func (s *Scheduler) runJob(ctx context.Context, job *Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.log.Errorf("job %s panic: %v", job.Name, r)
+		}
+	}()
+
+	start := s.clock.Now()
+	if err := job.Run(ctx); err != nil {
+		s.log.Errorf("job %s failed: %v", job.Name, err)
+		return
+	}
+	s.log.Debugf("job %s finished in %s", job.Name, s.clock.Since(start))
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go-backend/internal/conf"
+	"go-backend/internal/data/consumer"
+	"go-backend/pkg/messaging"
+	"go-backend/pkg/worker"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultDrainTimeout 未配置时停止等待的时间，与kratos默认的停止超时一致
+const defaultDrainTimeout = 10 * time.Second
+
+// consumerRegistrar 在共享的Kafka消费者上订阅主题的业务消费者
+type consumerRegistrar interface {
+	Register(consumer messaging.Consumer) error
+}
+
+// Workers 管理Kafka消费者和请求派生的后台任务，实现kratos transport.Server接口随应用启停。
+// 停止时先停止消费，正在处理的消息处理完后返回，再等待后台任务结束，整体不超过 drain_timeout
+type Workers struct {
+	kafkaManager *messaging.KafkaManager
+	registrars   []consumerRegistrar
+	manager      *worker.Manager
+	drainTimeout time.Duration
+	consumer     messaging.Consumer
+	log          *log.Helper
+}
+
+// NewWorkers 创建后台工作管理
+func NewWorkers(
+	kafkaManager *messaging.KafkaManager,
+	videoConsumer *consumer.VideoProcessConsumer,
+	statsConsumer *consumer.StatsUpdateConsumer,
+	manager *worker.Manager,
+	bc *conf.Business,
+	logger log.Logger,
+) *Workers {
+	drainTimeout := bc.GetShutdown().GetDrainTimeout().AsDuration()
+	if drainTimeout <= 0 {
+		drainTimeout = defaultDrainTimeout
+	}
+
+	return &Workers{
+		kafkaManager: kafkaManager,
+		registrars:   []consumerRegistrar{videoConsumer, statsConsumer},
+		manager:      manager,
+		drainTimeout: drainTimeout,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// DrainTimeout 停止时等待消费者和后台任务结束的时间
+func (w *Workers) DrainTimeout() time.Duration {
+	return w.drainTimeout
+}
+
+// Start 订阅主题并启动Kafka消费者，Kafka不可用时只管理后台任务
+func (w *Workers) Start(ctx context.Context) error {
+	if w.kafkaManager == nil {
+		w.log.Warn("kafka unavailable, consumers disabled")
+		return nil
+	}
+
+	consumer := w.kafkaManager.GetConsumer()
+	for _, registrar := range w.registrars {
+		if err := registrar.Register(consumer); err != nil {
+			return err
+		}
+	}
+	// 消费者的生命周期由 Stop 控制，不随启动上下文取消
+	if err := consumer.Start(context.Background()); err != nil {
+		return err
+	}
+	w.consumer = consumer
+
+	w.log.Infof("workers started with %d consumers", len(w.registrars))
+	return nil
+}
+
+// Stop 停止消费并等待后台任务结束，超时后仍在运行的后台任务被取消
+func (w *Workers) Stop(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, w.drainTimeout)
+	defer cancel()
+
+	var errs []error
+	if w.consumer != nil {
+		if err := w.stopConsumer(ctx); err != nil {
+			w.log.Errorf("stop kafka consumer failed: %v", err)
+			errs = append(errs, err)
+		}
+	}
+
+	if err := w.manager.Shutdown(ctx); err != nil {
+		w.log.Errorf("drain background jobs failed: %v", err)
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		w.log.Info("workers stopped")
+	}
+	return errors.Join(errs...)
+}
+
+// stopConsumer 消费者停止时等待正在处理的消息，处理耗时过长时不再等待
+func (w *Workers) stopConsumer(ctx context.Context) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- w.consumer.Stop()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
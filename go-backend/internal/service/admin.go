@@ -0,0 +1,1244 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/admin/v1"
+	commonv1 "go-backend/api/common/v1"
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AdminService 管理后台服务
+type AdminService struct {
+	v1.UnimplementedAdminServiceServer
+
+	auditUc       *biz.PermissionAuditUsecase
+	processingUc  *biz.ProcessingUsecase
+	rbacAdminUc   *biz.RBACAdminUsecase
+	deadLetterUc  *biz.DeadLetterUsecase
+	takedownUc    *biz.TakedownUsecase
+	banUc         *biz.UserBanUsecase
+	categoryUc    *biz.CategoryUsecase
+	opsUc         *biz.OpsUsecase
+	promotionUc   *biz.PromotionUsecase
+	degradationUc *biz.DegradationUsecase
+	log           *log.Helper
+}
+
+// NewAdminService 创建管理后台服务
+func NewAdminService(auditUc *biz.PermissionAuditUsecase, processingUc *biz.ProcessingUsecase, rbacAdminUc *biz.RBACAdminUsecase, deadLetterUc *biz.DeadLetterUsecase, takedownUc *biz.TakedownUsecase, banUc *biz.UserBanUsecase, categoryUc *biz.CategoryUsecase, opsUc *biz.OpsUsecase, promotionUc *biz.PromotionUsecase, degradationUc *biz.DegradationUsecase, logger log.Logger) *AdminService {
+	return &AdminService{
+		auditUc:       auditUc,
+		processingUc:  processingUc,
+		rbacAdminUc:   rbacAdminUc,
+		deadLetterUc:  deadLetterUc,
+		takedownUc:    takedownUc,
+		banUc:         banUc,
+		categoryUc:    categoryUc,
+		opsUc:         opsUc,
+		promotionUc:   promotionUc,
+		degradationUc: degradationUc,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// ListPermissionDenials 查询权限拒绝记录
+func (s *AdminService) ListPermissionDenials(ctx context.Context, req *v1.ListPermissionDenialsRequest) (*v1.ListPermissionDenialsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListPermissionDenialsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	filter := &biz.DenialFilter{
+		UserID:   req.UserId,
+		Resource: req.Resource,
+		Action:   req.Action,
+	}
+	if req.StartTime > 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+
+	query, err := biz.ParseListQuery(req.Filter, req.Sort, req.Page, req.Size)
+	if err != nil {
+		return &v1.ListPermissionDenialsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	denials, total, err := s.auditUc.ListDenials(ctx, userID, filter, query)
+	if err != nil {
+		return &v1.ListPermissionDenialsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	denialList := make([]*v1.PermissionDenial, 0, len(denials))
+	for _, denial := range denials {
+		denialList = append(denialList, &v1.PermissionDenial{
+			Id:        denial.ID,
+			UserId:    denial.UserID,
+			Resource:  denial.Resource,
+			Action:    denial.Action,
+			Route:     denial.Route,
+			CreatedAt: denial.CreatedAt.Unix(),
+		})
+	}
+
+	return &v1.ListPermissionDenialsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ListPermissionDenialsData{
+			DenialList: denialList,
+			Total:      total,
+		},
+	}, nil
+}
+
+// GetProcessingReport 查询视频处理报表
+func (s *AdminService) GetProcessingReport(ctx context.Context, req *v1.GetProcessingReportRequest) (*v1.GetProcessingReportResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetProcessingReportResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	filter := &biz.ProcessingReportFilter{
+		AuthorID: req.AuthorId,
+		JobType:  req.JobType,
+	}
+	if req.StartTime > 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+
+	report, err := s.processingUc.GetReport(ctx, userID, filter)
+	if err != nil {
+		return &v1.GetProcessingReportResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	statList := make([]*v1.ProcessingStat, 0, len(report.Stats))
+	for _, stat := range report.Stats {
+		statList = append(statList, convertProcessingStat(stat))
+	}
+
+	return &v1.GetProcessingReportResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetProcessingReportData{
+			StatList: statList,
+			Total:    convertProcessingStat(report.Total),
+		},
+	}, nil
+}
+
+// ListRoles 查询角色列表
+func (s *AdminService) ListRoles(ctx context.Context, req *v1.ListRolesRequest) (*v1.ListRolesResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListRolesResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	roles, err := s.rbacAdminUc.ListRoles(ctx, userID)
+	if err != nil {
+		return &v1.ListRolesResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	roleList := make([]*v1.Role, 0, len(roles))
+	for _, role := range roles {
+		roleList = append(roleList, convertRole(role.Role, role.PermissionIDs))
+	}
+
+	return &v1.ListRolesResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		RoleList: roleList,
+	}, nil
+}
+
+// CreateRole 创建角色
+func (s *AdminService) CreateRole(ctx context.Context, req *v1.CreateRoleRequest) (*v1.CreateRoleResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CreateRoleResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	role, err := s.rbacAdminUc.CreateRole(ctx, userID, req.Name, req.Description)
+	if err != nil {
+		return &v1.CreateRoleResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.CreateRoleResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Role: convertRole(role, nil),
+	}, nil
+}
+
+// UpdateRole 修改角色
+func (s *AdminService) UpdateRole(ctx context.Context, req *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdateRoleResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	role, err := s.rbacAdminUc.UpdateRole(ctx, userID, &domain.Role{
+		ID:          req.RoleId,
+		Name:        req.Name,
+		Description: req.Description,
+		Status:      int8(req.Status),
+	})
+	if err != nil {
+		return &v1.UpdateRoleResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.UpdateRoleResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Role: convertRole(role, nil),
+	}, nil
+}
+
+// DeleteRole 删除角色
+func (s *AdminService) DeleteRole(ctx context.Context, req *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.DeleteRoleResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.rbacAdminUc.DeleteRole(ctx, userID, req.RoleId); err != nil {
+		return &v1.DeleteRoleResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.DeleteRoleResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ListPermissions 查询权限列表
+func (s *AdminService) ListPermissions(ctx context.Context, req *v1.ListPermissionsRequest) (*v1.ListPermissionsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListPermissionsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	permissions, err := s.rbacAdminUc.ListPermissions(ctx, userID)
+	if err != nil {
+		return &v1.ListPermissionsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	permissionList := make([]*v1.Permission, 0, len(permissions))
+	for _, perm := range permissions {
+		permissionList = append(permissionList, convertPermission(perm))
+	}
+
+	return &v1.ListPermissionsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		PermissionList: permissionList,
+	}, nil
+}
+
+// CreatePermission 创建权限
+func (s *AdminService) CreatePermission(ctx context.Context, req *v1.CreatePermissionRequest) (*v1.CreatePermissionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CreatePermissionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	perm, err := s.rbacAdminUc.CreatePermission(ctx, userID, &domain.Permission{
+		Name:        req.Name,
+		Resource:    req.Resource,
+		Action:      req.Action,
+		Scope:       req.Scope,
+		Description: req.Description,
+	})
+	if err != nil {
+		return &v1.CreatePermissionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.CreatePermissionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Permission: convertPermission(perm),
+	}, nil
+}
+
+// UpdatePermission 修改权限
+func (s *AdminService) UpdatePermission(ctx context.Context, req *v1.UpdatePermissionRequest) (*v1.UpdatePermissionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdatePermissionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	perm, err := s.rbacAdminUc.UpdatePermission(ctx, userID, &domain.Permission{
+		ID:          req.PermissionId,
+		Name:        req.Name,
+		Resource:    req.Resource,
+		Action:      req.Action,
+		Scope:       req.Scope,
+		Description: req.Description,
+		Status:      int8(req.Status),
+	})
+	if err != nil {
+		return &v1.UpdatePermissionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.UpdatePermissionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Permission: convertPermission(perm),
+	}, nil
+}
+
+// DeletePermission 删除权限
+func (s *AdminService) DeletePermission(ctx context.Context, req *v1.DeletePermissionRequest) (*v1.DeletePermissionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.DeletePermissionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.rbacAdminUc.DeletePermission(ctx, userID, req.PermissionId); err != nil {
+		return &v1.DeletePermissionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.DeletePermissionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// RolePermissionAction 为角色绑定或解绑权限
+func (s *AdminService) RolePermissionAction(ctx context.Context, req *v1.RolePermissionActionRequest) (*v1.RolePermissionActionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.RolePermissionActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.rbacAdminUc.RolePermissionAction(ctx, userID, req.RoleId, req.PermissionId, req.ActionType); err != nil {
+		return &v1.RolePermissionActionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.RolePermissionActionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ListDeadLetters 查询死信消息
+func (s *AdminService) ListDeadLetters(ctx context.Context, req *v1.ListDeadLettersRequest) (*v1.ListDeadLettersResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListDeadLettersResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	filter := &biz.DeadLetterFilter{Topic: req.Topic}
+	switch req.Status {
+	case 1:
+		status := biz.DeadLetterStatusPending
+		filter.Status = &status
+	case 2:
+		status := biz.DeadLetterStatusReplayed
+		filter.Status = &status
+	}
+
+	query, err := biz.ParseListQuery(req.Filter, req.Sort, req.Page, req.Size)
+	if err != nil {
+		return &v1.ListDeadLettersResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	letters, total, err := s.deadLetterUc.ListDeadLetters(ctx, userID, filter, query)
+	if err != nil {
+		return &v1.ListDeadLettersResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	letterList := make([]*v1.DeadLetter, 0, len(letters))
+	for _, letter := range letters {
+		letterList = append(letterList, convertDeadLetter(letter))
+	}
+
+	return &v1.ListDeadLettersResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ListDeadLettersData{
+			DeadLetterList: letterList,
+			Total:          total,
+		},
+	}, nil
+}
+
+// ReplayDeadLetter 重放死信消息
+func (s *AdminService) ReplayDeadLetter(ctx context.Context, req *v1.ReplayDeadLetterRequest) (*v1.ReplayDeadLetterResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ReplayDeadLetterResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.deadLetterUc.Replay(ctx, userID, req.Id); err != nil {
+		return &v1.ReplayDeadLetterResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ReplayDeadLetterResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// TakedownVideo 下架视频
+func (s *AdminService) TakedownVideo(ctx context.Context, req *v1.TakedownVideoRequest) (*v1.TakedownVideoResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.TakedownVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	takedown, err := s.takedownUc.TakedownVideo(ctx, userID, req.VideoId, req.Category, req.Reason)
+	if err != nil {
+		return &v1.TakedownVideoResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.TakedownVideoResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Takedown: convertTakedown(takedown),
+	}, nil
+}
+
+// ListTakedowns 查询下架记录
+func (s *AdminService) ListTakedowns(ctx context.Context, req *v1.ListTakedownsRequest) (*v1.ListTakedownsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListTakedownsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	var status *int32
+	if req.Status > 0 {
+		status = &req.Status
+	}
+
+	query, err := biz.ParseListQuery(req.Filter, req.Sort, req.Page, req.Size)
+	if err != nil {
+		return &v1.ListTakedownsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	takedowns, total, err := s.takedownUc.ListTakedowns(ctx, userID, status, query)
+	if err != nil {
+		return &v1.ListTakedownsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ListTakedownsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ListTakedownsData{
+			TakedownList: convertTakedowns(takedowns),
+			Total:        total,
+		},
+	}, nil
+}
+
+// DecideTakedownAppeal 裁决下架申诉
+func (s *AdminService) DecideTakedownAppeal(ctx context.Context, req *v1.DecideTakedownAppealRequest) (*v1.DecideTakedownAppealResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.DecideTakedownAppealResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	takedown, err := s.takedownUc.DecideAppeal(ctx, userID, req.TakedownId, req.Decision, req.Note)
+	if err != nil {
+		return &v1.DecideTakedownAppealResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.DecideTakedownAppealResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Takedown: convertTakedown(takedown),
+	}, nil
+}
+
+// GetTakedownEvents 查询下架审计记录
+func (s *AdminService) GetTakedownEvents(ctx context.Context, req *v1.GetTakedownEventsRequest) (*v1.GetTakedownEventsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetTakedownEventsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	takedown, events, err := s.takedownUc.GetTakedownEvents(ctx, userID, req.TakedownId)
+	if err != nil {
+		return &v1.GetTakedownEventsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	eventList := make([]*v1.TakedownEvent, 0, len(events))
+	for _, event := range events {
+		eventList = append(eventList, &v1.TakedownEvent{
+			Id:        event.ID,
+			ActorId:   event.ActorID,
+			Action:    event.Action,
+			Note:      event.Note,
+			CreatedAt: event.CreatedAt.Unix(),
+		})
+	}
+
+	return &v1.GetTakedownEventsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetTakedownEventsData{
+			Takedown:  convertTakedown(takedown),
+			EventList: eventList,
+		},
+	}, nil
+}
+
+// BanUser 封禁用户
+func (s *AdminService) BanUser(ctx context.Context, req *v1.BanUserRequest) (*v1.BanUserResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.BanUserResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	ban, err := s.banUc.BanUser(ctx, userID, req.UserId, req.Reason)
+	if err != nil {
+		return &v1.BanUserResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.BanUserResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		BannedAt: ban.BannedAt.Unix(),
+	}, nil
+}
+
+// UnbanUser 解除封禁
+func (s *AdminService) UnbanUser(ctx context.Context, req *v1.UnbanUserRequest) (*v1.UnbanUserResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UnbanUserResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.banUc.UnbanUser(ctx, userID, req.UserId); err != nil {
+		return &v1.UnbanUserResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.UnbanUserResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ListCategories 查询所有视频分类
+func (s *AdminService) ListCategories(ctx context.Context, req *v1.ListCategoriesRequest) (*v1.ListCategoriesResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListCategoriesResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	categories, err := s.categoryUc.ListCategories(ctx, userID)
+	if err != nil {
+		return &v1.ListCategoriesResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ListCategoriesResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		CategoryList: convertCategories(categories, req.Locale),
+	}, nil
+}
+
+// CreateCategory 创建视频分类
+func (s *AdminService) CreateCategory(ctx context.Context, req *v1.CreateCategoryRequest) (*v1.CreateCategoryResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CreateCategoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	category, err := s.categoryUc.CreateCategory(ctx, userID, req.Slug, req.Names, req.SortOrder)
+	if err != nil {
+		return &v1.CreateCategoryResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.CreateCategoryResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Category: convertCategory(category, biz.DefaultCategoryLocale),
+	}, nil
+}
+
+// UpdateCategory 修改视频分类
+func (s *AdminService) UpdateCategory(ctx context.Context, req *v1.UpdateCategoryRequest) (*v1.UpdateCategoryResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdateCategoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	category, err := s.categoryUc.UpdateCategory(ctx, userID, &biz.Category{
+		ID:        req.CategoryId,
+		Names:     req.Names,
+		SortOrder: req.SortOrder,
+		Status:    req.Status,
+	})
+	if err != nil {
+		return &v1.UpdateCategoryResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.UpdateCategoryResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Category: convertCategory(category, biz.DefaultCategoryLocale),
+	}, nil
+}
+
+// DeleteCategory 删除视频分类
+func (s *AdminService) DeleteCategory(ctx context.Context, req *v1.DeleteCategoryRequest) (*v1.DeleteCategoryResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.DeleteCategoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.categoryUc.DeleteCategory(ctx, userID, req.CategoryId); err != nil {
+		return &v1.DeleteCategoryResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.DeleteCategoryResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// FlushCache 清除缓存命名空间
+func (s *AdminService) FlushCache(ctx context.Context, req *v1.FlushCacheRequest) (*v1.FlushCacheResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.FlushCacheResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.opsUc.FlushCache(ctx, userID, req.Namespace); err != nil {
+		return &v1.FlushCacheResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.FlushCacheResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// PurgeSessions 删除用户会话
+func (s *AdminService) PurgeSessions(ctx context.Context, req *v1.PurgeSessionsRequest) (*v1.PurgeSessionsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.PurgeSessionsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	purged, err := s.opsUc.PurgeSessions(ctx, userID, req.UserId)
+	if err != nil {
+		return &v1.PurgeSessionsResponse{Base: s.errorResponse(ctx, err), Purged: purged}, nil
+	}
+
+	return &v1.PurgeSessionsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Purged: purged,
+	}, nil
+}
+
+// Reindex 重建个人主页读模型
+func (s *AdminService) Reindex(ctx context.Context, req *v1.ReindexRequest) (*v1.ReindexResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ReindexResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	rebuilt, err := s.opsUc.Reindex(ctx, userID, req.FromUserId, req.ToUserId)
+	if err != nil {
+		return &v1.ReindexResponse{Base: s.errorResponse(ctx, err), Rebuilt: rebuilt}, nil
+	}
+
+	return &v1.ReindexResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Rebuilt: rebuilt,
+	}, nil
+}
+
+// RequeueProcessing 重新处理视频
+func (s *AdminService) RequeueProcessing(ctx context.Context, req *v1.RequeueProcessingRequest) (*v1.RequeueProcessingResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.RequeueProcessingResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	requeued, err := s.opsUc.RequeueProcessing(ctx, userID, req.VideoIds)
+	if err != nil {
+		return &v1.RequeueProcessingResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.RequeueProcessingResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Requeued: requeued,
+	}, nil
+}
+
+// RotateSigningKey 轮换令牌签名密钥
+func (s *AdminService) RotateSigningKey(ctx context.Context, req *v1.RotateSigningKeyRequest) (*v1.RotateSigningKeyResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.RotateSigningKeyResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	key, err := s.opsUc.RotateSigningKey(ctx, userID)
+	if err != nil {
+		return &v1.RotateSigningKeyResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.RotateSigningKeyResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Key: convertSigningKey(key),
+	}, nil
+}
+
+// ListSigningKeys 查询令牌签名密钥
+func (s *AdminService) ListSigningKeys(ctx context.Context, req *v1.ListSigningKeysRequest) (*v1.ListSigningKeysResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListSigningKeysResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	keys, err := s.opsUc.ListSigningKeys(ctx, userID)
+	if err != nil {
+		return &v1.ListSigningKeysResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	pbKeys := make([]*v1.SigningKey, len(keys))
+	for i, key := range keys {
+		pbKeys[i] = convertSigningKey(key)
+	}
+
+	return &v1.ListSigningKeysResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Keys: pbKeys,
+	}, nil
+}
+
+// GetDegradationStatus 查询本实例的功能降级状态
+func (s *AdminService) GetDegradationStatus(ctx context.Context, req *v1.GetDegradationStatusRequest) (*v1.GetDegradationStatusResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetDegradationStatusResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	status, err := s.degradationUc.GetStatus(ctx, userID)
+	if err != nil {
+		return &v1.GetDegradationStatusResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	disabled := make(map[string]bool, len(status.Disabled))
+	for _, feature := range status.Disabled {
+		disabled[feature] = true
+	}
+	features := make([]*v1.DegradedFeature, 0, len(status.Order))
+	for _, feature := range status.Order {
+		features = append(features, &v1.DegradedFeature{Name: feature, Disabled: disabled[feature]})
+	}
+	dependencies := make([]*v1.DependencyHealth, 0, len(status.Dependencies))
+	for _, dep := range status.Dependencies {
+		item := &v1.DependencyHealth{
+			Name:      dep.Name,
+			Healthy:   dep.Err == nil,
+			LatencyMs: dep.Latency.Milliseconds(),
+		}
+		if dep.Err != nil {
+			item.Error = dep.Err.Error()
+		}
+		dependencies = append(dependencies, item)
+	}
+
+	return &v1.GetDegradationStatusResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Enabled:      status.Enabled,
+		Features:     features,
+		Reasons:      status.Reasons,
+		Dependencies: dependencies,
+		Inflight:     status.Load.Inflight,
+		Requests:     status.Load.Requests,
+		Errors:       status.Load.Errors,
+		AvgLatencyMs: status.Load.AvgLatency.Milliseconds(),
+		ChangedAt:    unixSeconds(status.ChangedAt),
+		EvaluatedAt:  unixSeconds(status.EvaluatedAt),
+	}, nil
+}
+
+// ListPromotions 查询所有推广计划
+func (s *AdminService) ListPromotions(ctx context.Context, req *v1.ListPromotionsRequest) (*v1.ListPromotionsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListPromotionsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	campaigns, err := s.promotionUc.ListCampaigns(ctx, userID)
+	if err != nil {
+		return &v1.ListPromotionsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	promotionList := make([]*v1.PromotionCampaign, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		promotionList = append(promotionList, convertPromotion(campaign))
+	}
+	return &v1.ListPromotionsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		PromotionList: promotionList,
+	}, nil
+}
+
+// CreatePromotion 创建推广计划
+func (s *AdminService) CreatePromotion(ctx context.Context, req *v1.CreatePromotionRequest) (*v1.CreatePromotionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CreatePromotionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	campaign, err := s.promotionUc.CreateCampaign(ctx, userID, &biz.PromotionCampaign{
+		Name:            req.Name,
+		VideoID:         req.VideoId,
+		CategoryIDs:     req.CategoryIds,
+		Audience:        req.Audience,
+		Priority:        req.Priority,
+		FrequencyCap:    req.FrequencyCap,
+		FrequencyWindow: time.Duration(req.FrequencyWindow) * time.Second,
+		StartAt:         unixTime(req.StartAt),
+		EndAt:           unixTime(req.EndAt),
+	})
+	if err != nil {
+		return &v1.CreatePromotionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.CreatePromotionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Promotion: convertPromotion(campaign),
+	}, nil
+}
+
+// UpdatePromotion 修改推广计划
+func (s *AdminService) UpdatePromotion(ctx context.Context, req *v1.UpdatePromotionRequest) (*v1.UpdatePromotionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdatePromotionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	campaign, err := s.promotionUc.UpdateCampaign(ctx, userID, &biz.PromotionCampaign{
+		ID:              req.PromotionId,
+		Name:            req.Name,
+		CategoryIDs:     req.CategoryIds,
+		Audience:        req.Audience,
+		Priority:        req.Priority,
+		FrequencyCap:    req.FrequencyCap,
+		FrequencyWindow: time.Duration(req.FrequencyWindow) * time.Second,
+		StartAt:         unixTime(req.StartAt),
+		EndAt:           unixTime(req.EndAt),
+		Status:          req.Status,
+	})
+	if err != nil {
+		return &v1.UpdatePromotionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.UpdatePromotionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Promotion: convertPromotion(campaign),
+	}, nil
+}
+
+// GetPromotionReport 查询推广计费报表
+func (s *AdminService) GetPromotionReport(ctx context.Context, req *v1.GetPromotionReportRequest) (*v1.GetPromotionReportResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetPromotionReportResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	report, err := s.promotionUc.GetReport(ctx, userID, req.PromotionId, unixTime(req.Since), unixTime(req.Until))
+	if err != nil {
+		return &v1.GetPromotionReportResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.GetPromotionReportResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Impressions: report.Impressions,
+		Clicks:      report.Clicks,
+		UniqueUsers: report.UniqueUsers,
+	}, nil
+}
+
+// convertRole 转换角色
+func convertRole(role *domain.Role, permissionIDs []int64) *v1.Role {
+	return &v1.Role{
+		Id:            role.ID,
+		Name:          role.Name,
+		Description:   role.Description,
+		Status:        int32(role.Status),
+		PermissionIds: permissionIDs,
+		CreatedAt:     role.CreatedAt.Unix(),
+		UpdatedAt:     role.UpdatedAt.Unix(),
+	}
+}
+
+// convertPermission 转换权限
+func convertPermission(perm *domain.Permission) *v1.Permission {
+	return &v1.Permission{
+		Id:          perm.ID,
+		Name:        perm.Name,
+		Resource:    perm.Resource,
+		Action:      perm.Action,
+		Scope:       perm.Scope,
+		Description: perm.Description,
+		Status:      int32(perm.Status),
+		CreatedAt:   perm.CreatedAt.Unix(),
+		UpdatedAt:   perm.UpdatedAt.Unix(),
+	}
+}
+
+// convertProcessingStat 转换处理统计
+func convertProcessingStat(stat *domain.ProcessingStat) *v1.ProcessingStat {
+	return &v1.ProcessingStat{
+		Day:         stat.Day,
+		AuthorId:    stat.AuthorID,
+		Jobs:        stat.Jobs,
+		FailedJobs:  stat.FailedJobs,
+		DurationMs:  stat.DurationMs,
+		CpuSeconds:  stat.CPUSeconds,
+		InputBytes:  stat.InputBytes,
+		OutputBytes: stat.OutputBytes,
+	}
+}
+
+// convertDeadLetter 转换死信，状态转换为接口取值：1待处理 2已重放
+func convertDeadLetter(letter *biz.DeadLetter) *v1.DeadLetter {
+	dl := &v1.DeadLetter{
+		Id:         letter.ID,
+		Topic:      letter.Topic,
+		Partition:  letter.Partition,
+		Offset:     letter.Offset,
+		MessageId:  letter.MessageID,
+		Error:      letter.Error,
+		Attempts:   letter.Attempts,
+		Status:     letter.Status + 1,
+		ReplayedBy: letter.ReplayedBy,
+		CreatedAt:  letter.CreatedAt.Unix(),
+	}
+	if letter.ReplayedAt != nil {
+		dl.ReplayedAt = letter.ReplayedAt.Unix()
+	}
+	return dl
+}
+
+// convertPromotion 转换推广计划
+func convertPromotion(campaign *biz.PromotionCampaign) *v1.PromotionCampaign {
+	return &v1.PromotionCampaign{
+		Id:              campaign.ID,
+		Name:            campaign.Name,
+		VideoId:         campaign.VideoID,
+		CategoryIds:     campaign.CategoryIDs,
+		Audience:        campaign.Audience,
+		Priority:        campaign.Priority,
+		FrequencyCap:    campaign.FrequencyCap,
+		FrequencyWindow: int64(campaign.FrequencyWindow / time.Second),
+		StartAt:         campaign.StartAt.Unix(),
+		EndAt:           campaign.EndAt.Unix(),
+		Status:          campaign.Status,
+		CreatedBy:       campaign.CreatedBy,
+		CreatedAt:       campaign.CreatedAt.Unix(),
+	}
+}
+
+// convertSigningKey 不包含密钥内容
+func convertSigningKey(key *biz.SigningKey) *v1.SigningKey {
+	pbKey := &v1.SigningKey{
+		KeyId:      key.KeyID,
+		ActivateAt: key.ActivateAt.Unix(),
+		CreatedAt:  unixSeconds(key.CreatedAt),
+		Algorithm:  key.Algorithm,
+	}
+	if key.RetireAt != nil {
+		pbKey.RetireAt = key.RetireAt.Unix()
+	}
+	return pbKey
+}
+
+// unixTime 秒级时间戳转为时间，0表示未设置
+func unixTime(sec int64) time.Time {
+	if sec <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// unixSeconds 转换为秒级时间戳，零值时间返回0
+func unixSeconds(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// errorResponse 将业务错误转换为响应，未知错误只记录日志不暴露细节
+func (s *AdminService) errorResponse(ctx context.Context, err error) *commonv1.BaseResponse {
+	code := utils.GetErrorCode(err)
+	if code == commonv1.ErrorCode_SERVER_ERROR {
+		s.log.WithContext(ctx).Errorf("admin operation failed: %v", err)
+		return &commonv1.BaseResponse{
+			StatusCode: int32(code),
+			StatusMsg:  "operation failed",
+		}
+	}
+
+	return &commonv1.BaseResponse{
+		StatusCode: int32(code),
+		StatusMsg:  err.Error(),
+	}
+}
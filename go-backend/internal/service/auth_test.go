@@ -5,19 +5,15 @@ import (
 	"testing"
 	"time"
 
-	"go-backend/internal/biz"
-	"go-backend/internal/conf"
 	"go-backend/internal/data"
-	"go-backend/internal/data/cache"
+	"go-backend/internal/provider"
 	"go-backend/pkg/auth"
-	pkgcache "go-backend/pkg/cache"
 	"go-backend/testutils"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestAuthService_RefreshToken(t *testing.T) {
@@ -527,25 +523,7 @@ func setupAuthServiceForTest(t *testing.T) (*AuthService, *testutils.TestEnv, fu
 	env, cleanup, err := testutils.SetupTestWithCleanup()
 	require.NoError(t, err)
 
-	// 创建配置
-	config := &conf.Data{
-		Database: &conf.Data_Database{
-			Driver:          "mysql",
-			Source:          "tiktok:tiktok123@tcp(localhost:3307)/tiktok?charset=utf8mb4&parseTime=True&loc=Local",
-			MaxIdleConns:    10,
-			MaxOpenConns:    100,
-			ConnMaxLifetime: durationpb.New(time.Hour),
-		},
-		Redis: &conf.Data_Redis{
-			Addr:         "localhost:6381",
-			Password:     "tiktok123",
-			Db:           1,
-			DialTimeout:  durationpb.New(5 * time.Second),
-			ReadTimeout:  durationpb.New(3 * time.Second),
-			WriteTimeout: durationpb.New(3 * time.Second),
-			PoolSize:     100,
-		},
-	}
+	config := testutils.NewDataConfig()
 
 	// 创建Redis客户端
 	rdb := redis.NewClient(&redis.Options{
@@ -562,30 +540,10 @@ func setupAuthServiceForTest(t *testing.T) (*AuthService, *testutils.TestEnv, fu
 	env.DB.TruncateTable("user_sessions")
 	env.DB.TruncateTable("token_blacklist")
 
-	// 创建Data实例
-	d, dataCleanup, err := data.NewData(config, log.DefaultLogger)
+	uc, ucCleanup, err := provider.NewTestUsecases(config, testutils.NewBusinessConfig(), log.DefaultLogger)
 	require.NoError(t, err)
 
-	// 创建缓存
-	multiCache := pkgcache.NewMultiLevelCache(rdb, &pkgcache.CacheConfig{
-		EnableL1: true,
-		EnableL2: true,
-	})
-	userCache := cache.NewUserCache(multiCache, log.DefaultLogger)
-	authCache := cache.NewAuthCache(multiCache, log.DefaultLogger)
-
-	// 创建仓储
-	passwordMgr := auth.NewPasswordManager()
-	userRepo := data.NewUserRepo(d, userCache, passwordMgr, log.DefaultLogger)
-	sessionRepo := data.NewSessionRepo(d, authCache, log.DefaultLogger)
-
-	// 创建用例
-	jwtManager := auth.NewJWTManager("test-secret", time.Hour)
-	sessionMgr := auth.NewMemorySessionManager()
-	authUc := biz.NewAuthUsecase(sessionRepo, userRepo, jwtManager, sessionMgr, log.DefaultLogger)
-
-	// 创建服务
-	service := NewAuthService(authUc, jwtManager, log.DefaultLogger)
+	service := NewAuthService(uc.Auth, uc.JWTManager, log.DefaultLogger)
 
 	cleanupFunc := func() {
 		// 清理Redis数据
@@ -596,7 +554,7 @@ func setupAuthServiceForTest(t *testing.T) (*AuthService, *testutils.TestEnv, fu
 		env.DB.TruncateTable("user_sessions")
 		env.DB.TruncateTable("token_blacklist")
 
-		dataCleanup()
+		ucCleanup()
 		cleanup()
 	}
 
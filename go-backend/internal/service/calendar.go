@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "go-backend/api/calendar/v1"
+	commonv1 "go-backend/api/common/v1"
+	"go-backend/internal/biz"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CalendarService 创作者内容日历服务
+type CalendarService struct {
+	v1.UnimplementedCalendarServiceServer
+
+	calendarUc *biz.CalendarUsecase
+	log        *log.Helper
+}
+
+// NewCalendarService 创建内容日历服务
+func NewCalendarService(calendarUc *biz.CalendarUsecase, logger log.Logger) *CalendarService {
+	return &CalendarService{
+		calendarUc: calendarUc,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// CreateDraft 创建草稿
+func (s *CalendarService) CreateDraft(ctx context.Context, req *v1.CreateDraftRequest) (*v1.CreateDraftResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CreateDraftResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	draft, conflicts, err := s.calendarUc.CreateDraft(ctx, userID, newDraftInput(req.Title, req.Note, req.ScheduledAt, req.RemindBefore))
+	if err != nil {
+		return &v1.CreateDraftResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.CreateDraftResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Draft:     convertDraft(draft),
+		Conflicts: convertDrafts(conflicts),
+	}, nil
+}
+
+// UpdateDraft 修改草稿
+func (s *CalendarService) UpdateDraft(ctx context.Context, req *v1.UpdateDraftRequest) (*v1.UpdateDraftResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdateDraftResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	draft, conflicts, err := s.calendarUc.UpdateDraft(ctx, userID, req.DraftId, newDraftInput(req.Title, req.Note, req.ScheduledAt, req.RemindBefore))
+	if err != nil {
+		return &v1.UpdateDraftResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.UpdateDraftResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Draft:     convertDraft(draft),
+		Conflicts: convertDrafts(conflicts),
+	}, nil
+}
+
+// DeleteDraft 删除草稿
+func (s *CalendarService) DeleteDraft(ctx context.Context, req *v1.DeleteDraftRequest) (*v1.DeleteDraftResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.DeleteDraftResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.calendarUc.DeleteDraft(ctx, userID, req.DraftId); err != nil {
+		return &v1.DeleteDraftResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.DeleteDraftResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ListCalendar 查询日历
+func (s *CalendarService) ListCalendar(ctx context.Context, req *v1.ListCalendarRequest) (*v1.ListCalendarResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListCalendarResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	scheduled, unscheduled, err := s.calendarUc.ListCalendar(ctx, userID, start, end, req.IncludeUnscheduled)
+	if err != nil {
+		return &v1.ListCalendarResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ListCalendarResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Scheduled:   convertDrafts(scheduled),
+		Unscheduled: convertDrafts(unscheduled),
+	}, nil
+}
+
+// newDraftInput 构造草稿参数，时间戳为0表示未排期
+func newDraftInput(title, note string, scheduledAt, remindBefore int64) *biz.DraftInput {
+	input := &biz.DraftInput{
+		Title:        title,
+		Note:         note,
+		RemindBefore: time.Duration(remindBefore) * time.Second,
+	}
+	if scheduledAt > 0 {
+		t := time.Unix(scheduledAt, 0)
+		input.ScheduledAt = &t
+	}
+	return input
+}
+
+// convertDraft 转换草稿
+func convertDraft(draft *biz.ContentDraft) *v1.Draft {
+	result := &v1.Draft{
+		Id:           draft.ID,
+		Title:        draft.Title,
+		Note:         draft.Note,
+		RemindBefore: int64(draft.RemindBefore() / time.Second),
+		Reminded:     draft.RemindedAt != nil,
+		CreatedAt:    draft.CreatedAt.Unix(),
+		UpdatedAt:    draft.UpdatedAt.Unix(),
+	}
+	if draft.ScheduledAt != nil {
+		result.ScheduledAt = draft.ScheduledAt.Unix()
+	}
+	if draft.RemindAt != nil {
+		result.RemindAt = draft.RemindAt.Unix()
+	}
+	return result
+}
+
+func convertDrafts(drafts []*biz.ContentDraft) []*v1.Draft {
+	result := make([]*v1.Draft, 0, len(drafts))
+	for _, draft := range drafts {
+		result = append(result, convertDraft(draft))
+	}
+	return result
+}
+
+// errorResponse 将业务错误转换为响应，服务端错误不向客户端暴露细节
+func (s *CalendarService) errorResponse(ctx context.Context, err error) *commonv1.BaseResponse {
+	code := utils.GetErrorCode(err)
+	if code == commonv1.ErrorCode_SERVER_ERROR {
+		s.log.WithContext(ctx).Errorf("calendar operation failed: %v", err)
+		return &commonv1.BaseResponse{
+			StatusCode: int32(code),
+			StatusMsg:  "operation failed",
+		}
+	}
+
+	return &commonv1.BaseResponse{
+		StatusCode: int32(code),
+		StatusMsg:  err.Error(),
+	}
+}
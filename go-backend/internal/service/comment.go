@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+
+	v1 "go-backend/api/comment/v1"
+	commonv1 "go-backend/api/common/v1"
+	"go-backend/internal/biz"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/security"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 评论发布日期格式
+const commentDateLayout = "01-02"
+
+// CommentService 评论服务
+type CommentService struct {
+	v1.UnimplementedCommentServiceServer
+
+	commentUc *biz.CommentUsecase
+	mutedUc   *biz.MutedKeywordUsecase
+	userUc    *biz.UserUsecase
+	countsUc  *biz.CountsUsecase
+	validator *security.Validator
+	log       *log.Helper
+}
+
+// NewCommentService 创建评论服务
+func NewCommentService(
+	commentUc *biz.CommentUsecase,
+	mutedUc *biz.MutedKeywordUsecase,
+	userUc *biz.UserUsecase,
+	countsUc *biz.CountsUsecase,
+	validator *security.Validator,
+	logger log.Logger,
+) *CommentService {
+	return &CommentService{
+		commentUc: commentUc,
+		mutedUc:   mutedUc,
+		userUc:    userUc,
+		countsUc:  countsUc,
+		validator: validator,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// CommentAction 评论操作
+func (s *CommentService) CommentAction(ctx context.Context, req *v1.CommentActionRequest) (*v1.CommentActionResponse, error) {
+	// 获取当前用户ID
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CommentActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	// 验证参数
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.CommentActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	switch req.ActionType {
+	case 1:
+		// 发布评论
+		comment, err := s.commentUc.CreateComment(ctx, userID, req.VideoId, req.ParentCommentId, req.CommentText)
+		if err != nil {
+			return &v1.CommentActionResponse{Base: s.errorResponse(ctx, err)}, nil
+		}
+
+		user, err := s.userUc.GetUser(ctx, userID)
+		if err != nil {
+			s.log.WithContext(ctx).Warnf("get comment user failed: %v", err)
+			user = &biz.User{ID: userID}
+		}
+		s.countsUc.Apply(ctx, user)
+
+		return &v1.CommentActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: 0,
+				StatusMsg:  "success",
+			},
+			Comment: convertToCommonComment(comment, user),
+		}, nil
+
+	case 2:
+		// 删除评论
+		if req.CommentId <= 0 {
+			return &v1.CommentActionResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+					StatusMsg:  "invalid comment id",
+				},
+			}, nil
+		}
+
+		if err := s.commentUc.DeleteComment(ctx, userID, req.VideoId, req.CommentId); err != nil {
+			return &v1.CommentActionResponse{Base: s.errorResponse(ctx, err)}, nil
+		}
+
+		return &v1.CommentActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: 0,
+				StatusMsg:  "success",
+			},
+		}, nil
+
+	default:
+		return &v1.CommentActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid action type",
+			},
+		}, nil
+	}
+}
+
+// GetCommentList 获取视频评论列表
+func (s *CommentService) GetCommentList(ctx context.Context, req *v1.GetCommentListRequest) (*v1.GetCommentListResponse, error) {
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.GetCommentListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	// 未登录时viewerID为0
+	viewerID, _ := reqctx.UserID(ctx)
+	comments, total, err := s.commentUc.GetCommentList(ctx, viewerID, req.VideoId, req.Page, req.Size)
+	if err != nil {
+		return &v1.GetCommentListResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	commentList, err := s.buildCommentList(ctx, comments)
+	if err != nil {
+		return &v1.GetCommentListResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.GetCommentListResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetCommentListData{
+			CommentList: commentList,
+			Total:       total,
+		},
+	}, nil
+}
+
+// GetCommentReplies 获取评论回复列表
+func (s *CommentService) GetCommentReplies(ctx context.Context, req *v1.GetCommentRepliesRequest) (*v1.GetCommentRepliesResponse, error) {
+	if req.CommentId <= 0 {
+		return &v1.GetCommentRepliesResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid comment id",
+			},
+		}, nil
+	}
+
+	viewerID, _ := reqctx.UserID(ctx)
+	replies, total, err := s.commentUc.GetCommentReplies(ctx, viewerID, req.CommentId, req.Page, req.Size)
+	if err != nil {
+		return &v1.GetCommentRepliesResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	commentList, err := s.buildCommentList(ctx, replies)
+	if err != nil {
+		return &v1.GetCommentRepliesResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.GetCommentRepliesResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetCommentListData{
+			CommentList: commentList,
+			Total:       total,
+		},
+	}, nil
+}
+
+// CommentHeartAction 视频作者为评论点或取消小红心
+func (s *CommentService) CommentHeartAction(ctx context.Context, req *v1.CommentHeartActionRequest) (*v1.CommentHeartActionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CommentHeartActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if req.CommentId <= 0 {
+		return &v1.CommentHeartActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid comment id",
+			},
+		}, nil
+	}
+
+	var hearted bool
+	switch req.ActionType {
+	case 1:
+		hearted = true
+	case 2:
+		hearted = false
+	default:
+		return &v1.CommentHeartActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid action type",
+			},
+		}, nil
+	}
+
+	if err := s.commentUc.HeartComment(ctx, userID, req.CommentId, hearted); err != nil {
+		return &v1.CommentHeartActionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.CommentHeartActionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// MutedKeywordAction 屏蔽词操作
+func (s *CommentService) MutedKeywordAction(ctx context.Context, req *v1.MutedKeywordActionRequest) (*v1.MutedKeywordActionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.MutedKeywordActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	var err error
+	switch req.ActionType {
+	case 1:
+		err = s.mutedUc.AddMutedKeyword(ctx, userID, req.Keyword)
+	case 2:
+		err = s.mutedUc.RemoveMutedKeyword(ctx, userID, req.Keyword)
+	default:
+		return &v1.MutedKeywordActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid action type",
+			},
+		}, nil
+	}
+	if err != nil {
+		return &v1.MutedKeywordActionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.MutedKeywordActionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ListMutedKeywords 获取屏蔽词列表
+func (s *CommentService) ListMutedKeywords(ctx context.Context, req *v1.ListMutedKeywordsRequest) (*v1.ListMutedKeywordsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListMutedKeywordsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	keywords, err := s.mutedUc.ListMutedKeywords(ctx, userID)
+	if err != nil {
+		return &v1.ListMutedKeywordsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ListMutedKeywordsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Keywords: keywords,
+	}, nil
+}
+
+// buildCommentList 批量填充评论用户信息
+func (s *CommentService) buildCommentList(ctx context.Context, comments []*biz.Comment) ([]*commonv1.Comment, error) {
+	userIDs := make([]int64, 0, len(comments))
+	for _, comment := range comments {
+		userIDs = append(userIDs, comment.UserID)
+	}
+
+	users, err := s.userUc.GetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	s.countsUc.Apply(ctx, users...)
+	userMap := make(map[int64]*biz.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	result := make([]*commonv1.Comment, 0, len(comments))
+	for _, comment := range comments {
+		user, ok := userMap[comment.UserID]
+		if !ok {
+			continue
+		}
+		result = append(result, convertToCommonComment(comment, user))
+	}
+
+	return result, nil
+}
+
+// errorResponse 将业务错误转换为响应，未知错误只记录日志不暴露细节
+func (s *CommentService) errorResponse(ctx context.Context, err error) *commonv1.BaseResponse {
+	code := utils.GetErrorCode(err)
+	if code == commonv1.ErrorCode_SERVER_ERROR {
+		s.log.WithContext(ctx).Errorf("comment operation failed: %v", err)
+		return &commonv1.BaseResponse{
+			StatusCode: int32(code),
+			StatusMsg:  "operation failed",
+		}
+	}
+
+	return &commonv1.BaseResponse{
+		StatusCode: int32(code),
+		StatusMsg:  err.Error(),
+	}
+}
+
+// convertToCommonComment 转换为通用评论结构
+func convertToCommonComment(comment *biz.Comment, user *biz.User) *commonv1.Comment {
+	return &commonv1.Comment{
+		Id:            comment.ID,
+		User:          convertToCommonUser(user, false),
+		Content:       comment.Content,
+		CreateDate:    comment.CreatedAt.Format(commentDateLayout),
+		LikeCount:     comment.LikeCount,
+		ReplyCount:    comment.ReplyCount,
+		Entities:      convertTextEntities(comment.Content),
+		ParentId:      comment.ParentID,
+		VideoId:       comment.VideoID,
+		Hearted:       comment.Hearted,
+		Collapsed:     comment.Collapsed,
+		PendingReview: comment.Status == biz.CommentStatusReview,
+	}
+}
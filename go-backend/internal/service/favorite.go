@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+
+	commonv1 "go-backend/api/common/v1"
+	v1 "go-backend/api/favorite/v1"
+	"go-backend/internal/biz"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/security"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// FavoriteService 点赞服务
+type FavoriteService struct {
+	v1.UnimplementedFavoriteServiceServer
+
+	favoriteUc *biz.FavoriteUsecase
+	userUc     *biz.UserUsecase
+	relationUc *biz.RelationUsecase
+	countsUc   *biz.CountsUsecase
+	validator  *security.Validator
+	cdn        *storage.CDN
+	log        *log.Helper
+}
+
+// NewFavoriteService 创建点赞服务
+func NewFavoriteService(
+	favoriteUc *biz.FavoriteUsecase,
+	userUc *biz.UserUsecase,
+	relationUc *biz.RelationUsecase,
+	countsUc *biz.CountsUsecase,
+	validator *security.Validator,
+	cdn *storage.CDN,
+	logger log.Logger,
+) *FavoriteService {
+	return &FavoriteService{
+		favoriteUc: favoriteUc,
+		userUc:     userUc,
+		relationUc: relationUc,
+		countsUc:   countsUc,
+		validator:  validator,
+		cdn:        cdn,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// FavoriteAction 点赞操作
+func (s *FavoriteService) FavoriteAction(ctx context.Context, req *v1.FavoriteActionRequest) (*v1.FavoriteActionResponse, error) {
+	// 获取当前用户ID
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.FavoriteActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	// 验证参数
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.FavoriteActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if req.ActionType != 1 && req.ActionType != 2 {
+		return &v1.FavoriteActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid action type",
+			},
+		}, nil
+	}
+
+	var err error
+	if req.ActionType == 1 {
+		// 点赞
+		err = s.favoriteUc.Like(ctx, userID, req.VideoId)
+	} else {
+		// 取消点赞
+		err = s.favoriteUc.Unlike(ctx, userID, req.VideoId)
+	}
+
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("favorite action failed: %v", err)
+			return &v1.FavoriteActionResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(code),
+					StatusMsg:  "operation failed",
+				},
+			}, nil
+		}
+		return &v1.FavoriteActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	return &v1.FavoriteActionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// GetFavoriteList 获取喜欢列表
+func (s *FavoriteService) GetFavoriteList(ctx context.Context, req *v1.GetFavoriteListRequest) (*v1.GetFavoriteListResponse, error) {
+	// 验证用户ID
+	if err := s.validator.ValidateUserID(req.UserId); err != nil {
+		return &v1.GetFavoriteListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	// 获取当前用户ID，未登录时为0
+	currentUserID, _ := reqctx.UserID(ctx)
+
+	// 私密账号的喜欢列表仅本人和粉丝可见
+	owner, err := s.userUc.GetUser(ctx, req.UserId)
+	if err == nil {
+		err = s.relationUc.CheckVisible(ctx, currentUserID, owner)
+	}
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("check favorite list visibility failed: %v", err)
+			msg = "get favorite list failed"
+		}
+		return &v1.GetFavoriteListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	// 喜欢列表混合多个作者的视频，过滤访问者无权查看的视频
+	videos, total, err := s.favoriteUc.GetFavoriteList(ctx, req.UserId, req.Page, req.Size)
+	if err == nil {
+		videos, err = s.relationUc.FilterVisibleVideos(ctx, currentUserID, videos)
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get favorite list failed: %v", err)
+		return &v1.GetFavoriteListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get favorite list failed",
+			},
+		}, nil
+	}
+
+	// 批量获取作者信息和当前用户的点赞状态
+	videoIDs := make([]int64, 0, len(videos))
+	authorIDs := make([]int64, 0, len(videos))
+	for _, video := range videos {
+		videoIDs = append(videoIDs, video.ID)
+		authorIDs = append(authorIDs, video.AuthorID)
+	}
+
+	authors, err := s.userUc.GetUsers(ctx, authorIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get video authors failed: %v", err)
+		return &v1.GetFavoriteListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get favorite list failed",
+			},
+		}, nil
+	}
+	s.countsUc.Apply(ctx, authors...)
+	authorMap := make(map[int64]*biz.User, len(authors))
+	for _, author := range authors {
+		authorMap[author.ID] = author
+	}
+
+	favoriteMap, err := s.favoriteUc.BatchIsFavorite(ctx, currentUserID, videoIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("batch check favorite status failed: %v", err)
+		favoriteMap = map[int64]bool{}
+	}
+
+	// 转换为响应格式
+	videoList := make([]*commonv1.Video, 0, len(videos))
+	for _, video := range videos {
+		author, ok := authorMap[video.AuthorID]
+		if !ok {
+			continue
+		}
+		videoList = append(videoList, deliverVideoURLs(s.cdn, convertToCommonVideo(video, author, favoriteMap[video.ID], false)))
+	}
+
+	return &v1.GetFavoriteListResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetFavoriteListData{
+			VideoList: videoList,
+			Total:     total,
+		},
+	}, nil
+}
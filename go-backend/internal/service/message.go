@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+
+	commonv1 "go-backend/api/common/v1"
+	v1 "go-backend/api/message/v1"
+	"go-backend/internal/biz"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/security"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MessageService 私信服务
+type MessageService struct {
+	v1.UnimplementedMessageServiceServer
+
+	messageUc *biz.MessageUsecase
+	validator *security.Validator
+	log       *log.Helper
+}
+
+// NewMessageService 创建私信服务
+func NewMessageService(
+	messageUc *biz.MessageUsecase,
+	validator *security.Validator,
+	logger log.Logger,
+) *MessageService {
+	return &MessageService{
+		messageUc: messageUc,
+		validator: validator,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// SendMessage 发送消息
+func (s *MessageService) SendMessage(ctx context.Context, req *v1.SendMessageRequest) (*v1.SendMessageResponse, error) {
+	// 获取当前用户ID
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.SendMessageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	// 验证参数
+	if err := s.validator.ValidateUserID(req.ToUserId); err != nil {
+		return &v1.SendMessageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if req.ActionType != 1 {
+		return &v1.SendMessageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid action type",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateMessage(req.Content); err != nil {
+		return &v1.SendMessageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if _, err := s.messageUc.SendMessage(ctx, userID, req.ToUserId, req.Content); err != nil {
+		if err == biz.ErrNotFriend {
+			return &v1.SendMessageResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_NOT_FRIEND),
+					StatusMsg:  err.Error(),
+				},
+			}, nil
+		}
+		if err == biz.ErrMessageToSelf {
+			return &v1.SendMessageResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+					StatusMsg:  err.Error(),
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("send message failed: %v", err)
+		return &v1.SendMessageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "send message failed",
+			},
+		}, nil
+	}
+
+	return &v1.SendMessageResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// GetMessageHistory 获取聊天记录
+func (s *MessageService) GetMessageHistory(ctx context.Context, req *v1.GetMessageHistoryRequest) (*v1.GetMessageHistoryResponse, error) {
+	// 获取当前用户ID
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetMessageHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	// 验证参数
+	if err := s.validator.ValidateUserID(req.ToUserId); err != nil {
+		return &v1.GetMessageHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	messages, err := s.messageUc.GetMessageHistory(ctx, userID, req.ToUserId, req.PreMsgTime)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get message history failed: %v", err)
+		return &v1.GetMessageHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get message history failed",
+			},
+		}, nil
+	}
+
+	// 转换为响应格式
+	messageList := make([]*commonv1.Message, 0, len(messages))
+	for _, msg := range messages {
+		messageList = append(messageList, s.convertToCommonMessage(msg))
+	}
+
+	return &v1.GetMessageHistoryResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetMessageHistoryData{
+			MessageList: messageList,
+		},
+	}, nil
+}
+
+// convertToCommonMessage 转换为通用消息信息
+func (s *MessageService) convertToCommonMessage(msg *biz.Message) *commonv1.Message {
+	return &commonv1.Message{
+		Id:         msg.ID,
+		ToUserId:   msg.ToUserID,
+		FromUserId: msg.FromUserID,
+		Content:    msg.Content,
+		CreateTime: msg.CreatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+
+	commonv1 "go-backend/api/common/v1"
+	v1 "go-backend/api/moderation/v1"
+	"go-backend/internal/biz"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/security"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ModerationService 内容审核服务
+type ModerationService struct {
+	v1.UnimplementedModerationServiceServer
+
+	moderationUc *biz.ModerationUsecase
+	registerUc   *biz.RegistrationUsecase
+	commentUc    *biz.CommentUsecase
+	userUc       *biz.UserUsecase
+	countsUc     *biz.CountsUsecase
+	validator    *security.Validator
+	log          *log.Helper
+}
+
+// NewModerationService 创建内容审核服务
+func NewModerationService(
+	moderationUc *biz.ModerationUsecase,
+	registerUc *biz.RegistrationUsecase,
+	commentUc *biz.CommentUsecase,
+	userUc *biz.UserUsecase,
+	countsUc *biz.CountsUsecase,
+	validator *security.Validator,
+	logger log.Logger,
+) *ModerationService {
+	return &ModerationService{
+		moderationUc: moderationUc,
+		registerUc:   registerUc,
+		commentUc:    commentUc,
+		userUc:       userUc,
+		countsUc:     countsUc,
+		validator:    validator,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// ListPendingVideos 获取待审核视频列表
+func (s *ModerationService) ListPendingVideos(ctx context.Context, req *v1.ListPendingVideosRequest) (*v1.ListPendingVideosResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListPendingVideosResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	videos, total, err := s.moderationUc.ListPendingVideos(ctx, userID, req.Page, req.Size)
+	if err != nil {
+		return &v1.ListPendingVideosResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	// 批量获取作者信息
+	authorIDs := make([]int64, 0, len(videos))
+	for _, video := range videos {
+		authorIDs = append(authorIDs, video.AuthorID)
+	}
+
+	authors, err := s.userUc.GetUsers(ctx, authorIDs)
+	if err != nil {
+		return &v1.ListPendingVideosResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+	s.countsUc.Apply(ctx, authors...)
+	authorMap := make(map[int64]*biz.User, len(authors))
+	for _, author := range authors {
+		authorMap[author.ID] = author
+	}
+
+	videoList := make([]*commonv1.Video, 0, len(videos))
+	for _, video := range videos {
+		author, ok := authorMap[video.AuthorID]
+		if !ok {
+			author = &biz.User{ID: video.AuthorID}
+		}
+		videoList = append(videoList, convertToCommonVideo(video, author, false, false))
+	}
+
+	return &v1.ListPendingVideosResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ListPendingVideosData{
+			VideoList: videoList,
+			Total:     total,
+		},
+	}, nil
+}
+
+// ReviewVideo 审核视频
+func (s *ModerationService) ReviewVideo(ctx context.Context, req *v1.ReviewVideoRequest) (*v1.ReviewVideoResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ReviewVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.ReviewVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if _, err := s.moderationUc.ReviewVideo(ctx, userID, req.VideoId, req.ActionType, req.Reason); err != nil {
+		return &v1.ReviewVideoResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ReviewVideoResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ListPendingComments 获取待复核评论列表
+func (s *ModerationService) ListPendingComments(ctx context.Context, req *v1.ListPendingCommentsRequest) (*v1.ListPendingCommentsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListPendingCommentsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	comments, total, err := s.commentUc.ListPendingComments(ctx, userID, req.Page, req.Size)
+	if err != nil {
+		return &v1.ListPendingCommentsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	// 批量获取评论者信息
+	userIDs := make([]int64, 0, len(comments))
+	for _, comment := range comments {
+		userIDs = append(userIDs, comment.UserID)
+	}
+
+	users, err := s.userUc.GetUsers(ctx, userIDs)
+	if err != nil {
+		return &v1.ListPendingCommentsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+	userMap := make(map[int64]*biz.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	commentList := make([]*commonv1.Comment, 0, len(comments))
+	for _, comment := range comments {
+		user, ok := userMap[comment.UserID]
+		if !ok {
+			user = &biz.User{ID: comment.UserID}
+		}
+		commentList = append(commentList, convertToCommonComment(comment, user))
+	}
+
+	return &v1.ListPendingCommentsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ListPendingCommentsData{
+			CommentList: commentList,
+			Total:       total,
+		},
+	}, nil
+}
+
+// ReviewComment 复核评论
+func (s *ModerationService) ReviewComment(ctx context.Context, req *v1.ReviewCommentRequest) (*v1.ReviewCommentResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ReviewCommentResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if req.CommentId <= 0 {
+		return &v1.ReviewCommentResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid comment id",
+			},
+		}, nil
+	}
+
+	if err := s.commentUc.ReviewComment(ctx, userID, req.CommentId, req.ActionType); err != nil {
+		return &v1.ReviewCommentResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ReviewCommentResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ListFlaggedRegistrations 获取可疑注册列表
+func (s *ModerationService) ListFlaggedRegistrations(ctx context.Context, req *v1.ListFlaggedRegistrationsRequest) (*v1.ListFlaggedRegistrationsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListFlaggedRegistrationsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	registrations, total, err := s.registerUc.ListFlaggedRegistrations(ctx, userID, req.Page, req.Size)
+	if err != nil {
+		return &v1.ListFlaggedRegistrationsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	registrationList := make([]*v1.FlaggedRegistration, 0, len(registrations))
+	for _, registration := range registrations {
+		registrationList = append(registrationList, &v1.FlaggedRegistration{
+			Id:         registration.ID,
+			UserId:     registration.UserID,
+			Username:   registration.Username,
+			Ip:         registration.IP,
+			Reasons:    registration.Reasons,
+			Contact:    registration.Contact,
+			Status:     registration.Status,
+			ReviewerId: registration.ReviewerID,
+			CreatedAt:  registration.CreatedAt.Unix(),
+		})
+	}
+
+	return &v1.ListFlaggedRegistrationsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ListFlaggedRegistrationsData{
+			RegistrationList: registrationList,
+			Total:            total,
+		},
+	}, nil
+}
+
+// ReviewRegistration 审核可疑注册
+func (s *ModerationService) ReviewRegistration(ctx context.Context, req *v1.ReviewRegistrationRequest) (*v1.ReviewRegistrationResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ReviewRegistrationResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if req.RegistrationId <= 0 {
+		return &v1.ReviewRegistrationResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid registration id",
+			},
+		}, nil
+	}
+
+	if err := s.registerUc.ReviewRegistration(ctx, userID, req.RegistrationId, req.ActionType); err != nil {
+		return &v1.ReviewRegistrationResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ReviewRegistrationResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// errorResponse 将业务错误转换为响应，未知错误只记录日志不暴露细节
+func (s *ModerationService) errorResponse(ctx context.Context, err error) *commonv1.BaseResponse {
+	code := utils.GetErrorCode(err)
+	if code == commonv1.ErrorCode_SERVER_ERROR {
+		s.log.WithContext(ctx).Errorf("moderation operation failed: %v", err)
+		return &commonv1.BaseResponse{
+			StatusCode: int32(code),
+			StatusMsg:  "operation failed",
+		}
+	}
+
+	return &commonv1.BaseResponse{
+		StatusCode: int32(code),
+		StatusMsg:  err.Error(),
+	}
+}
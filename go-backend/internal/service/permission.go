@@ -156,14 +156,15 @@ func (s *PermissionService) CanModerateContent(ctx context.Context, userID int64
 }
 
 // CanDeleteComment 检查是否可以删除评论
-func (s *PermissionService) CanDeleteComment(ctx context.Context, userID int64, commentUserID int64) (bool, error) {
-	// 用户可以删除自己的评论
-	if userID == commentUserID {
-		return true, nil
+func (s *PermissionService) CanDeleteComment(ctx context.Context, userID int64, commentID int64) (bool, error) {
+	// 管理员和审核员可以删除任何评论
+	canModerate, err := s.CanModerateContent(ctx, userID)
+	if err != nil || canModerate {
+		return canModerate, err
 	}
 
-	// 管理员和审核员可以删除任何评论
-	return s.CanModerateContent(ctx, userID)
+	// 评论者和视频作者由归属条件授权
+	return s.permissionUc.CheckResourcePermission(ctx, userID, biz.ResourceComment, domain.ActionDelete, commentID)
 }
 
 // CanManageUser 检查是否可以管理用户
@@ -5,16 +5,12 @@ import (
 	"testing"
 	"time"
 
-	"go-backend/internal/biz"
-	"go-backend/internal/conf"
-	"go-backend/internal/data"
-	"go-backend/pkg/auth"
+	"go-backend/internal/provider"
 	"go-backend/testutils"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestPermissionService_CheckPermission(t *testing.T) {
@@ -713,14 +709,34 @@ func TestPermissionService_AdvancedPermissionChecks(t *testing.T) {
 
 	t.Run("CanDeleteComment_SelfComment", func(t *testing.T) {
 		// 创建独立的服务和环境
-		service, _, cleanup := setupPermissionServiceForTest(t)
+		service, env, cleanup := setupPermissionServiceForTest(t)
 		defer cleanup()
 
 		ctx := context.Background()
 
-		// 用户可以删除自己的评论
-		canDelete, err := service.CanDeleteComment(ctx, 1, 1) // 同一个用户ID
+		users, err := env.DataManager.CreateTestUsers(2)
+		require.NoError(t, err)
+		commenter, other := users[0], users[1]
+
+		roles, err := env.DataManager.CreateTestRoles()
+		require.NoError(t, err)
+		userRole := roles[0]
+
+		// user角色通过 comment:delete:own 删除自己的评论
+		require.NoError(t, env.DB.ExecSQL("INSERT INTO permissions (name, resource, action, scope) VALUES ('comment:delete:own', '/comment', 'DELETE', 'own')"))
+		require.NoError(t, env.DB.ExecSQL("INSERT INTO role_permissions (role_id, permission_id) SELECT ?, id FROM permissions WHERE name = 'comment:delete:own'", userRole.ID))
+		require.NoError(t, env.DataManager.AssignRoleToUser(commenter.ID, userRole.ID))
+		require.NoError(t, env.DataManager.AssignRoleToUser(other.ID, userRole.ID))
+
+		require.NoError(t, env.DB.ExecSQL("INSERT INTO videos (id, author_id, title, play_url) VALUES (1, ?, 'video', 'http://example.com/v.mp4')", other.ID))
+		require.NoError(t, env.DB.ExecSQL("INSERT INTO comments (id, video_id, user_id, content) VALUES (1, 1, ?, 'comment')", commenter.ID))
 
+		canDelete, err := service.CanDeleteComment(ctx, commenter.ID, 1)
+		require.NoError(t, err)
+		assert.True(t, canDelete)
+
+		// 视频作者也可以删除自己视频下的评论
+		canDelete, err = service.CanDeleteComment(ctx, other.ID, 1)
 		require.NoError(t, err)
 		assert.True(t, canDelete)
 	})
@@ -755,7 +771,7 @@ func TestPermissionService_AdvancedPermissionChecks(t *testing.T) {
 		require.NoError(t, err)
 
 		// 管理员可以删除任何评论
-		canDelete, err := service.CanDeleteComment(ctx, testUser.ID, 999) // 不同的用户ID
+		canDelete, err := service.CanDeleteComment(ctx, testUser.ID, 999) // 不存在的评论
 
 		require.NoError(t, err)
 		assert.True(t, canDelete)
@@ -802,42 +818,13 @@ func setupPermissionServiceForTest(t *testing.T) (*PermissionService, *testutils
 	env, cleanup, err := testutils.SetupTestWithCleanup()
 	require.NoError(t, err)
 
-	// 创建配置
-	config := &conf.Data{
-		Database: &conf.Data_Database{
-			Driver:          "mysql",
-			Source:          "tiktok:tiktok123@tcp(localhost:3307)/tiktok?charset=utf8mb4&parseTime=True&loc=Local",
-			MaxIdleConns:    10,
-			MaxOpenConns:    100,
-			ConnMaxLifetime: durationpb.New(time.Hour),
-		},
-		Redis: &conf.Data_Redis{
-			Addr:         "localhost:6381",
-			Password:     "tiktok123",
-			Db:           1,
-			DialTimeout:  durationpb.New(5 * time.Second),
-			ReadTimeout:  durationpb.New(3 * time.Second),
-			WriteTimeout: durationpb.New(3 * time.Second),
-			PoolSize:     100,
-		},
-	}
-
-	d, dataCleanup, err := data.NewData(config, log.DefaultLogger)
+	uc, ucCleanup, err := provider.NewTestUsecases(testutils.NewDataConfig(), testutils.NewBusinessConfig(), log.DefaultLogger)
 	require.NoError(t, err)
 
-	// 创建仓储
-	roleRepo := data.NewRoleRepo(d, log.DefaultLogger)
-	permissionRepo := data.NewPermissionRepo(d, roleRepo, log.DefaultLogger)
-
-	// 创建用例
-	rbacManager := auth.NewMemoryRBACManager()
-	permissionUc := biz.NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
-
-	// 创建服务
-	service := NewPermissionService(permissionUc, log.DefaultLogger)
+	service := NewPermissionService(uc.Permission, log.DefaultLogger)
 
 	cleanupFunc := func() {
-		dataCleanup()
+		ucCleanup()
 		cleanup()
 	}
 
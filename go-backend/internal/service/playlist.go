@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+
+	commonv1 "go-backend/api/common/v1"
+	v1 "go-backend/api/playlist/v1"
+	"go-backend/internal/biz"
+	"go-backend/internal/domain"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/security"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	playlistActionAdd    = 1
+	playlistActionRemove = 2
+)
+
+// PlaylistService 视频合集服务
+type PlaylistService struct {
+	v1.UnimplementedPlaylistServiceServer
+
+	playlistUc *biz.PlaylistUsecase
+	userUc     *biz.UserUsecase
+	countsUc   *biz.CountsUsecase
+	favoriteUc *biz.FavoriteUsecase
+	validator  *security.Validator
+	cdn        *storage.CDN
+	log        *log.Helper
+}
+
+// NewPlaylistService 创建视频合集服务
+func NewPlaylistService(
+	playlistUc *biz.PlaylistUsecase,
+	userUc *biz.UserUsecase,
+	countsUc *biz.CountsUsecase,
+	favoriteUc *biz.FavoriteUsecase,
+	validator *security.Validator,
+	cdn *storage.CDN,
+	logger log.Logger,
+) *PlaylistService {
+	return &PlaylistService{
+		playlistUc: playlistUc,
+		userUc:     userUc,
+		countsUc:   countsUc,
+		favoriteUc: favoriteUc,
+		validator:  validator,
+		cdn:        cdn,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// CreatePlaylist 创建合集
+func (s *PlaylistService) CreatePlaylist(ctx context.Context, req *v1.CreatePlaylistRequest) (*v1.CreatePlaylistResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.CreatePlaylistResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	playlist, err := s.playlistUc.CreatePlaylist(ctx, userID, req.Name, req.Description)
+	if err != nil {
+		return &v1.CreatePlaylistResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.CreatePlaylistResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Playlist: convertPlaylist(playlist),
+	}, nil
+}
+
+// UpdatePlaylist 修改合集名称和简介
+func (s *PlaylistService) UpdatePlaylist(ctx context.Context, req *v1.UpdatePlaylistRequest) (*v1.UpdatePlaylistResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdatePlaylistResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	playlist, err := s.playlistUc.UpdatePlaylist(ctx, userID, req.PlaylistId, req.Name, req.Description)
+	if err != nil {
+		return &v1.UpdatePlaylistResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.UpdatePlaylistResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Playlist: convertPlaylist(playlist),
+	}, nil
+}
+
+// DeletePlaylist 删除合集
+func (s *PlaylistService) DeletePlaylist(ctx context.Context, req *v1.DeletePlaylistRequest) (*v1.DeletePlaylistResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.DeletePlaylistResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.playlistUc.DeletePlaylist(ctx, userID, req.PlaylistId); err != nil {
+		return &v1.DeletePlaylistResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.DeletePlaylistResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// PlaylistVideoAction 向合集添加或移除视频
+func (s *PlaylistService) PlaylistVideoAction(ctx context.Context, req *v1.PlaylistVideoActionRequest) (*v1.PlaylistVideoActionResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.PlaylistVideoActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.PlaylistVideoActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	var err error
+	switch req.ActionType {
+	case playlistActionAdd:
+		err = s.playlistUc.AddVideo(ctx, userID, req.PlaylistId, req.VideoId)
+	case playlistActionRemove:
+		err = s.playlistUc.RemoveVideo(ctx, userID, req.PlaylistId, req.VideoId)
+	default:
+		return &v1.PlaylistVideoActionResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid action type",
+			},
+		}, nil
+	}
+	if err != nil {
+		return &v1.PlaylistVideoActionResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.PlaylistVideoActionResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ReorderPlaylist 调整合集中视频的顺序
+func (s *PlaylistService) ReorderPlaylist(ctx context.Context, req *v1.ReorderPlaylistRequest) (*v1.ReorderPlaylistResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ReorderPlaylistResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.playlistUc.ReorderVideos(ctx, userID, req.PlaylistId, req.VideoIds); err != nil {
+		return &v1.ReorderPlaylistResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.ReorderPlaylistResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// GetPlaylist 获取合集及一页视频
+func (s *PlaylistService) GetPlaylist(ctx context.Context, req *v1.GetPlaylistRequest) (*v1.GetPlaylistResponse, error) {
+	currentUserID, _ := reqctx.UserID(ctx)
+
+	playlist, videos, total, err := s.playlistUc.GetPlaylist(ctx, currentUserID, req.PlaylistId, req.Page, req.Size)
+	if err != nil {
+		return &v1.GetPlaylistResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	videoList, err := s.buildVideoList(ctx, videos, currentUserID)
+	if err != nil {
+		return &v1.GetPlaylistResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.GetPlaylistResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Playlist:  convertPlaylist(playlist),
+		VideoList: videoList,
+		Total:     total,
+	}, nil
+}
+
+// ListUserPlaylists 获取用户创建的合集列表
+func (s *PlaylistService) ListUserPlaylists(ctx context.Context, req *v1.ListUserPlaylistsRequest) (*v1.ListUserPlaylistsResponse, error) {
+	if err := s.validator.ValidateUserID(req.UserId); err != nil {
+		return &v1.ListUserPlaylistsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	playlists, total, err := s.playlistUc.ListUserPlaylists(ctx, req.UserId, req.Page, req.Size)
+	if err != nil {
+		return &v1.ListUserPlaylistsResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	playlistList := make([]*v1.Playlist, 0, len(playlists))
+	for _, playlist := range playlists {
+		playlistList = append(playlistList, convertPlaylist(playlist))
+	}
+
+	return &v1.ListUserPlaylistsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		PlaylistList: playlistList,
+		Total:        total,
+	}, nil
+}
+
+// buildVideoList 批量获取作者信息和当前用户的点赞状态，作者不存在的视频被跳过
+func (s *PlaylistService) buildVideoList(ctx context.Context, videos []*domain.Video, currentUserID int64) ([]*commonv1.Video, error) {
+	videoIDs := make([]int64, 0, len(videos))
+	authorIDs := make([]int64, 0, len(videos))
+	for _, video := range videos {
+		videoIDs = append(videoIDs, video.ID)
+		authorIDs = append(authorIDs, video.AuthorID)
+	}
+
+	authors, err := s.userUc.GetUsers(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+	s.countsUc.Apply(ctx, authors...)
+	authorMap := make(map[int64]*biz.User, len(authors))
+	for _, author := range authors {
+		authorMap[author.ID] = author
+	}
+
+	favoriteMap, err := s.favoriteUc.BatchIsFavorite(ctx, currentUserID, videoIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("batch check favorite status failed: %v", err)
+		favoriteMap = map[int64]bool{}
+	}
+
+	videoList := make([]*commonv1.Video, 0, len(videos))
+	for _, video := range videos {
+		author, ok := authorMap[video.AuthorID]
+		if !ok {
+			continue
+		}
+		videoList = append(videoList, deliverVideoURLs(s.cdn, convertToCommonVideo(video, author, favoriteMap[video.ID], false)))
+	}
+	return videoList, nil
+}
+
+// convertPlaylist 转换合集
+func convertPlaylist(playlist *biz.Playlist) *v1.Playlist {
+	return &v1.Playlist{
+		Id:          playlist.ID,
+		UserId:      playlist.UserID,
+		Name:        playlist.Name,
+		Description: playlist.Description,
+		VideoCount:  playlist.VideoCount,
+		CreatedAt:   playlist.CreatedAt.Unix(),
+		UpdatedAt:   playlist.UpdatedAt.Unix(),
+	}
+}
+
+// errorResponse 将业务错误转换为响应，服务端错误不向客户端暴露细节
+func (s *PlaylistService) errorResponse(ctx context.Context, err error) *commonv1.BaseResponse {
+	code := utils.GetErrorCode(err)
+	if code == commonv1.ErrorCode_SERVER_ERROR {
+		s.log.WithContext(ctx).Errorf("playlist operation failed: %v", err)
+		return &commonv1.BaseResponse{
+			StatusCode: int32(code),
+			StatusMsg:  "operation failed",
+		}
+	}
+
+	return &commonv1.BaseResponse{
+		StatusCode: int32(code),
+		StatusMsg:  err.Error(),
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	commonv1 "go-backend/api/common/v1"
+	v1 "go-backend/api/referral/v1"
+	"go-backend/internal/biz"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReferralService 邀请推荐服务
+type ReferralService struct {
+	v1.UnimplementedReferralServiceServer
+
+	referralUc *biz.ReferralUsecase
+	countsUc   *biz.CountsUsecase
+	log        *log.Helper
+}
+
+// NewReferralService 创建邀请推荐服务
+func NewReferralService(referralUc *biz.ReferralUsecase, countsUc *biz.CountsUsecase, logger log.Logger) *ReferralService {
+	return &ReferralService{
+		referralUc: referralUc,
+		countsUc:   countsUc,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// GetMyReferral 获取自己的推荐码和推荐统计
+func (s *ReferralService) GetMyReferral(ctx context.Context, req *v1.GetMyReferralRequest) (*v1.GetMyReferralResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetMyReferralResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	// 记录领取推荐码时的IP和设备，用于识别自我推荐
+	clientIP, _ := reqctx.ClientIP(ctx)
+	deviceID, _ := reqctx.DeviceID(ctx)
+
+	code, stats, err := s.referralUc.GetMyReferral(ctx, userID, clientIP, deviceID)
+	if err != nil {
+		return &v1.GetMyReferralResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	return &v1.GetMyReferralResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ReferralInfo{
+			Code:           code.Code,
+			InvitedCount:   stats.Invited,
+			ActivatedCount: stats.Activated,
+		},
+	}, nil
+}
+
+// GetReferralLeaderboard 获取推荐排行榜
+func (s *ReferralService) GetReferralLeaderboard(ctx context.Context, req *v1.GetReferralLeaderboardRequest) (*v1.GetReferralLeaderboardResponse, error) {
+	ranks, err := s.referralUc.GetLeaderboard(ctx, req.Days, req.Limit)
+	if err != nil {
+		return &v1.GetReferralLeaderboardResponse{Base: s.errorResponse(ctx, err)}, nil
+	}
+
+	users := make([]*biz.User, 0, len(ranks))
+	for _, rank := range ranks {
+		users = append(users, rank.User)
+	}
+	s.countsUc.Apply(ctx, users...)
+
+	rankList := make([]*v1.ReferralRank, 0, len(ranks))
+	for _, rank := range ranks {
+		rankList = append(rankList, &v1.ReferralRank{
+			User:           convertToCommonUser(rank.User, false),
+			ActivatedCount: rank.Activated,
+		})
+	}
+
+	return &v1.GetReferralLeaderboardResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Ranks: rankList,
+	}, nil
+}
+
+// errorResponse 将业务错误转换为响应，服务端错误不向客户端暴露细节
+func (s *ReferralService) errorResponse(ctx context.Context, err error) *commonv1.BaseResponse {
+	code := utils.GetErrorCode(err)
+	if code == commonv1.ErrorCode_SERVER_ERROR {
+		s.log.WithContext(ctx).Errorf("referral operation failed: %v", err)
+		return &commonv1.BaseResponse{
+			StatusCode: int32(code),
+			StatusMsg:  "operation failed",
+		}
+	}
+
+	return &commonv1.BaseResponse{
+		StatusCode: int32(code),
+		StatusMsg:  err.Error(),
+	}
+}
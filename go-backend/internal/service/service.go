@@ -8,4 +8,12 @@ var ProviderSet = wire.NewSet(
 	NewAuthService,
 	NewPermissionService,
 	NewVideoService,
+	NewMessageService,
+	NewFavoriteService,
+	NewCommentService,
+	NewModerationService,
+	NewAdminService,
+	NewReferralService,
+	NewCalendarService,
+	NewPlaylistService,
 )
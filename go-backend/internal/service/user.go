@@ -1,16 +1,25 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"strings"
+	"time"
 
 	commonv1 "go-backend/api/common/v1"
 	v1 "go-backend/api/user/v1"
 	"go-backend/internal/biz"
+	"go-backend/internal/domain"
 	"go-backend/internal/middleware"
 	"go-backend/pkg/auth"
+	"go-backend/pkg/reqctx"
 	"go-backend/pkg/security"
+	"go-backend/pkg/storage"
+	"go-backend/pkg/utils"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -19,31 +28,85 @@ type UserService struct {
 	v1.UnimplementedUserServiceServer
 
 	userUc       *biz.UserUsecase
+	countsUc     *biz.CountsUsecase
 	relationUc   *biz.RelationUsecase
 	authUc       *biz.AuthUsecase
 	permissionUc *biz.PermissionUsecase
+	messageUc    *biz.MessageUsecase
+	registerUc   *biz.RegistrationUsecase
+	resetUc      *biz.PasswordResetUsecase
+	emailUc      *biz.EmailUsecase
+	shareUc      *biz.ShareUsecase
+	referralUc   *biz.ReferralUsecase
+	imageUc      *biz.ProfileImageUsecase
+	profileUc    *biz.ProfileUsecase
+	deletionUc   *biz.AccountDeletionUsecase
+	exportUc     *biz.DataExportUsecase
+	quotaUc      *biz.QuotaUsecase
+	statsUc      *biz.UserStatsUsecase
+	loginUc      *biz.LoginAnomalyUsecase
+	oauthUc      *biz.OAuthUsecase
+	codeLoginUc  *biz.CodeLoginUsecase
+	presenceUc   *biz.PresenceUsecase
 	jwtManager   *auth.JWTManager
 	validator    *security.Validator
+	cdn          *storage.CDN
 	log          *log.Helper
 }
 
 // NewUserService new a user service.
 func NewUserService(
 	userUc *biz.UserUsecase,
+	countsUc *biz.CountsUsecase,
 	relationUc *biz.RelationUsecase,
 	authUc *biz.AuthUsecase,
 	permissionUc *biz.PermissionUsecase,
+	messageUc *biz.MessageUsecase,
+	registerUc *biz.RegistrationUsecase,
+	resetUc *biz.PasswordResetUsecase,
+	emailUc *biz.EmailUsecase,
+	shareUc *biz.ShareUsecase,
+	referralUc *biz.ReferralUsecase,
+	imageUc *biz.ProfileImageUsecase,
+	profileUc *biz.ProfileUsecase,
+	deletionUc *biz.AccountDeletionUsecase,
+	exportUc *biz.DataExportUsecase,
+	quotaUc *biz.QuotaUsecase,
+	statsUc *biz.UserStatsUsecase,
+	loginUc *biz.LoginAnomalyUsecase,
+	oauthUc *biz.OAuthUsecase,
+	codeLoginUc *biz.CodeLoginUsecase,
+	presenceUc *biz.PresenceUsecase,
 	jwtManager *auth.JWTManager,
 	validator *security.Validator,
+	cdn *storage.CDN,
 	logger log.Logger,
 ) *UserService {
 	return &UserService{
 		userUc:       userUc,
+		countsUc:     countsUc,
 		relationUc:   relationUc,
 		authUc:       authUc,
 		permissionUc: permissionUc,
+		messageUc:    messageUc,
+		registerUc:   registerUc,
+		resetUc:      resetUc,
+		emailUc:      emailUc,
+		shareUc:      shareUc,
+		referralUc:   referralUc,
+		imageUc:      imageUc,
+		profileUc:    profileUc,
+		deletionUc:   deletionUc,
+		exportUc:     exportUc,
+		quotaUc:      quotaUc,
+		statsUc:      statsUc,
+		loginUc:      loginUc,
+		oauthUc:      oauthUc,
+		codeLoginUc:  codeLoginUc,
+		presenceUc:   presenceUc,
 		jwtManager:   jwtManager,
 		validator:    validator,
+		cdn:          cdn,
 		log:          log.NewHelper(logger),
 	}
 }
@@ -69,163 +132,1292 @@ func (s *UserService) Register(ctx context.Context, req *v1.RegisterRequest) (*v
 		}, nil
 	}
 
+	// 注册防滥用检查
+	clientIP, _ := reqctx.ClientIP(ctx)
+	check, err := s.registerUc.Check(ctx, clientIP, req.Username, req.Email, req.Phone)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("check registration failed: %v", err)
+			return &v1.RegisterResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+					StatusMsg:  "register failed",
+				},
+			}, nil
+		}
+		return &v1.RegisterResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	// 校验推荐码，填写了无效推荐码时拒绝注册，便于用户更正
+	referralCode, err := s.referralUc.ResolveCode(ctx, req.ReferralCode)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("resolve referral code failed: %v", err)
+			msg = "register failed"
+		}
+		return &v1.RegisterResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
 	// 注册用户
 	user, err := s.userUc.Register(ctx, req.Username, req.Password)
 	if err != nil {
-		if err == biz.ErrUserExist {
-			return &v1.RegisterResponse{
-				Base: &commonv1.BaseResponse{
-					StatusCode: int32(commonv1.ErrorCode_USER_EXIST),
-					StatusMsg:  "user already exists",
-				},
-			}, nil
+		if err == biz.ErrUserExist {
+			return &v1.RegisterResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_EXIST),
+					StatusMsg:  "user already exists",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("register user failed: %v", err)
+		return &v1.RegisterResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "register failed",
+			},
+		}, nil
+	}
+
+	s.registerUc.Record(ctx, check, user)
+	if referralCode != nil {
+		deviceID, _ := reqctx.DeviceID(ctx)
+		s.referralUc.Redeem(ctx, referralCode, user.ID, clientIP, deviceID)
+	}
+
+	// 生成Token对
+	tokenPair, err := s.jwtManager.GenerateTokenPair(user.ID, user.Username)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("generate token failed: %v", err)
+		return &v1.RegisterResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "generate token failed",
+			},
+		}, nil
+	}
+
+	// 为新用户分配默认角色
+	if err := s.permissionUc.InitUserDefaultRole(ctx, user.ID); err != nil {
+		s.log.WithContext(ctx).Errorf("init user default role failed: %v", err)
+	}
+
+	return &v1.RegisterResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.RegisterData{
+			UserId: user.ID,
+			Token:  tokenPair.AccessToken,
+		},
+	}, nil
+}
+
+// Login 用户登录
+func (s *UserService) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginResponse, error) {
+	// 参数验证
+	if req.Username == "" || req.Password == "" {
+		return &v1.LoginResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "username and password required",
+			},
+		}, nil
+	}
+
+	// 使用认证服务登录
+	tokenPair, user, err := s.authUc.LoginWithChallenge(ctx, req.Username, req.Password, req.VerificationCode)
+	if err != nil {
+		if err == biz.ErrUserNotFound {
+			// 冷静期中的账号密码正确时提示恢复，否则与账号不存在一致，避免暴露注销状态
+			if deletion, derr := s.deletionUc.PendingDeletion(ctx, req.Username, req.Password); derr == nil {
+				return &v1.LoginResponse{
+					Base: &commonv1.BaseResponse{
+						StatusCode: int32(commonv1.ErrorCode_ACCOUNT_PENDING_DELETION),
+						StatusMsg:  "account is pending deletion, restore it to continue",
+					},
+					DeletionScheduledAt: deletion.PurgeAt.Unix(),
+				}, nil
+			} else if derr == biz.ErrAccountLocked {
+				return &v1.LoginResponse{
+					Base: &commonv1.BaseResponse{
+						StatusCode: int32(commonv1.ErrorCode_ACCOUNT_LOCKED),
+						StatusMsg:  "account temporarily locked, try again later",
+					},
+				}, nil
+			}
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
+					StatusMsg:  "user not found",
+				},
+			}, nil
+		}
+		if err == biz.ErrPasswordError {
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PASSWORD_ERROR),
+					StatusMsg:  "invalid password",
+				},
+			}, nil
+		}
+		if err == biz.ErrAccountLocked {
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_ACCOUNT_LOCKED),
+					StatusMsg:  "account temporarily locked, try again later",
+				},
+			}, nil
+		}
+		if err == biz.ErrLoginChallengeRequired {
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_LOGIN_CHALLENGE_REQUIRED),
+					StatusMsg:  "unrecognized device, enter the verification code sent to your email",
+				},
+			}, nil
+		}
+		if err == biz.ErrVerificationCodeInvalid {
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_VERIFICATION_CODE_INVALID),
+					StatusMsg:  "invalid or expired verification code",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("login failed: %v", err)
+		return &v1.LoginResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "login failed",
+			},
+		}, nil
+	}
+
+	return &v1.LoginResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.LoginData{
+			UserId: user.ID,
+			Token:  tokenPair.AccessToken,
+		},
+	}, nil
+}
+
+// LoginWithOAuth 第三方登录，首次登录时关联已验证邮箱对应的用户或创建新用户
+func (s *UserService) LoginWithOAuth(ctx context.Context, req *v1.LoginWithOAuthRequest) (*v1.LoginWithOAuthResponse, error) {
+	tokenPair, user, created, err := s.oauthUc.LoginWithOAuth(ctx, req.Provider, req.Code, req.RedirectUri, req.VerificationCode)
+	if err != nil {
+		if err == biz.ErrOAuthProviderNotFound {
+			return &v1.LoginWithOAuthResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+					StatusMsg:  "oauth provider not supported",
+				},
+			}, nil
+		}
+		if err == biz.ErrOAuthCodeRequired {
+			return &v1.LoginWithOAuthResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+					StatusMsg:  "oauth code required",
+				},
+			}, nil
+		}
+		if err == biz.ErrOAuthFailed {
+			return &v1.LoginWithOAuthResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_OAUTH_LOGIN_FAILED),
+					StatusMsg:  "third-party login failed, authorize again",
+				},
+			}, nil
+		}
+		if err == biz.ErrUserNotFound {
+			// 绑定的用户已注销或被禁用
+			return &v1.LoginWithOAuthResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
+					StatusMsg:  "user not found",
+				},
+			}, nil
+		}
+		if err == biz.ErrLoginChallengeRequired {
+			return &v1.LoginWithOAuthResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_LOGIN_CHALLENGE_REQUIRED),
+					StatusMsg:  "unrecognized device, enter the verification code sent to your email",
+				},
+			}, nil
+		}
+		if err == biz.ErrVerificationCodeInvalid {
+			return &v1.LoginWithOAuthResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_VERIFICATION_CODE_INVALID),
+					StatusMsg:  "invalid or expired verification code",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("oauth login failed: %v", err)
+		return &v1.LoginWithOAuthResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "login failed",
+			},
+		}, nil
+	}
+
+	return &v1.LoginWithOAuthResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.LoginData{
+			UserId: user.ID,
+			Token:  tokenPair.AccessToken,
+		},
+		Created: created,
+	}, nil
+}
+
+// SendVerificationCode 发送登录验证码
+func (s *UserService) SendVerificationCode(ctx context.Context, req *v1.SendVerificationCodeRequest) (*v1.SendVerificationCodeResponse, error) {
+	if err := s.validateLoginTarget(req.Channel, req.Target); err != nil {
+		return &v1.SendVerificationCodeResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if err := s.codeLoginUc.SendLoginCode(ctx, req.Channel, req.Target); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("send login code failed: %v", err)
+			msg = "send verification code failed"
+		}
+		return &v1.SendVerificationCodeResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.SendVerificationCodeResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// LoginWithCode 验证码登录
+func (s *UserService) LoginWithCode(ctx context.Context, req *v1.LoginWithCodeRequest) (*v1.LoginWithCodeResponse, error) {
+	if req.Code == "" {
+		return &v1.LoginWithCodeResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "verification code required",
+			},
+		}, nil
+	}
+	if err := s.validateLoginTarget(req.Channel, req.Target); err != nil {
+		return &v1.LoginWithCodeResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	tokenPair, user, err := s.codeLoginUc.LoginWithCode(ctx, req.Channel, req.Target, req.Code, req.VerificationCode)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("code login failed: %v", err)
+			msg = "login failed"
+		}
+		return &v1.LoginWithCodeResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.LoginWithCodeResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.LoginData{
+			UserId: user.ID,
+			Token:  tokenPair.AccessToken,
+		},
+	}, nil
+}
+
+// validateLoginTarget 按渠道校验邮箱或手机号格式
+func (s *UserService) validateLoginTarget(channel, target string) error {
+	target = biz.NormalizeLoginTarget(channel, target)
+	if channel == biz.LoginCodeChannelSMS {
+		return s.validator.ValidatePhone(target)
+	}
+	return s.validator.ValidateEmail(target)
+}
+
+// Logout 用户登出，撤销当前Token并删除会话
+func (s *UserService) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.LogoutResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	accessToken := req.Token
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if token := middleware.ExtractToken(tr); token != "" {
+			accessToken = token
+		}
+	}
+
+	if err := s.authUc.Logout(ctx, userID, accessToken, req.RefreshToken); err != nil {
+		s.log.WithContext(ctx).Errorf("logout failed: user=%d err=%v", userID, err)
+		return &v1.LogoutResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "logout failed",
+			},
+		}, nil
+	}
+
+	if err := s.presenceUc.Offline(ctx, userID); err != nil {
+		s.log.WithContext(ctx).Warnf("clear online status failed: user=%d err=%v", userID, err)
+	}
+
+	return &v1.LogoutResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// DeleteAccount 注销账号，进入冷静期并登出
+func (s *UserService) DeleteAccount(ctx context.Context, req *v1.DeleteAccountRequest) (*v1.DeleteAccountResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.DeleteAccountResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+	if req.Password == "" {
+		return &v1.DeleteAccountResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "password required",
+			},
+		}, nil
+	}
+
+	accessToken := req.Token
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if token := middleware.ExtractToken(tr); token != "" {
+			accessToken = token
+		}
+	}
+
+	deletion, err := s.deletionUc.RequestDeletion(ctx, userID, req.Password, accessToken)
+	if err != nil {
+		if err == biz.ErrUserNotFound {
+			return &v1.DeleteAccountResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
+					StatusMsg:  "user not found",
+				},
+			}, nil
+		}
+		if err == biz.ErrPasswordError {
+			return &v1.DeleteAccountResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PASSWORD_ERROR),
+					StatusMsg:  "invalid password",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("delete account failed: user=%d err=%v", userID, err)
+		return &v1.DeleteAccountResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "delete account failed",
+			},
+		}, nil
+	}
+
+	return &v1.DeleteAccountResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		PurgeAt: deletion.PurgeAt.Unix(),
+	}, nil
+}
+
+// ExportMyData 导出当前用户的个人数据
+func (s *UserService) ExportMyData(ctx context.Context, req *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ExportMyDataResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	export, err := s.exportUc.ExportMyData(ctx, userID)
+	if err != nil {
+		if err == biz.ErrUserNotFound {
+			return &v1.ExportMyDataResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
+					StatusMsg:  "user not found",
+				},
+			}, nil
+		}
+		if err == biz.ErrDataExportTooFrequent {
+			return &v1.ExportMyDataResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_RATE_LIMIT),
+					StatusMsg:  "data export requested too frequently, retry later",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("export user data failed: user=%d err=%v", userID, err)
+		return &v1.ExportMyDataResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "export user data failed",
+			},
+		}, nil
+	}
+
+	return &v1.ExportMyDataResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		DownloadUrl: export.DownloadURL,
+		ExpiresAt:   export.ExpiresAt.Unix(),
+	}, nil
+}
+
+// RestoreAccount 撤销冷静期中的注销并登录
+func (s *UserService) RestoreAccount(ctx context.Context, req *v1.RestoreAccountRequest) (*v1.LoginResponse, error) {
+	if req.Username == "" || req.Password == "" {
+		return &v1.LoginResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "username and password required",
+			},
+		}, nil
+	}
+
+	tokenPair, user, err := s.deletionUc.Restore(ctx, req.Username, req.Password)
+	if err != nil {
+		if err == biz.ErrUserNotFound {
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
+					StatusMsg:  "no account pending deletion",
+				},
+			}, nil
+		}
+		if err == biz.ErrPasswordError {
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PASSWORD_ERROR),
+					StatusMsg:  "invalid password",
+				},
+			}, nil
+		}
+		if err == biz.ErrAccountLocked {
+			return &v1.LoginResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_ACCOUNT_LOCKED),
+					StatusMsg:  "account temporarily locked, try again later",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("restore account failed: %v", err)
+		return &v1.LoginResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "restore account failed",
+			},
+		}, nil
+	}
+
+	return &v1.LoginResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.LoginData{
+			UserId: user.ID,
+			Token:  tokenPair.AccessToken,
+		},
+	}, nil
+}
+
+// GetUser 获取用户信息
+func (s *UserService) GetUser(ctx context.Context, req *v1.GetUserRequest) (*v1.GetUserResponse, error) {
+	// 验证用户ID
+	if err := s.validator.ValidateUserID(req.UserId); err != nil {
+		return &v1.GetUserResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	// 获取当前用户ID
+	currentUserID, _ := reqctx.UserID(ctx)
+
+	// 获取用户信息
+	user, err := s.userUc.GetUser(ctx, req.UserId)
+	if err != nil {
+		if err == biz.ErrUserNotFound {
+			return &v1.GetUserResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
+					StatusMsg:  "user not found",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("get user failed: %v", err)
+		return &v1.GetUserResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get user failed",
+			},
+		}, nil
+	}
+	// 检查关注关系
+	isFollow := false
+	if currentUserID > 0 && currentUserID != req.UserId {
+		isFollow, _ = s.relationUc.IsFollowing(ctx, currentUserID, req.UserId)
+	}
+
+	// 私密账号对非粉丝隐藏计数
+	if biz.CanViewContent(user, currentUserID, isFollow) {
+		s.countsUc.Apply(ctx, user)
+	} else {
+		user = biz.RestrictedUser(user)
+	}
+
+	return &v1.GetUserResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetUserData{
+			User: convertToCommonUser(user, isFollow),
+		},
+	}, nil
+}
+
+// GetProfilePage 获取个人主页
+func (s *UserService) GetProfilePage(ctx context.Context, req *v1.GetProfilePageRequest) (*v1.GetProfilePageResponse, error) {
+	if err := s.validator.ValidateUserID(req.UserId); err != nil {
+		return &v1.GetProfilePageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	currentUserID, _ := reqctx.UserID(ctx)
+
+	view, err := s.profileUc.GetProfilePage(ctx, currentUserID, req.UserId)
+	if err != nil {
+		if err == biz.ErrUserNotFound {
+			return &v1.GetProfilePageResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
+					StatusMsg:  "user not found",
+				},
+			}, nil
+		}
+		s.log.WithContext(ctx).Errorf("get profile page failed: %v", err)
+		return &v1.GetProfilePageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get profile page failed",
+			},
+		}, nil
+	}
+	// 读模型中的计数可能滞后于计数器，私密账号对非粉丝隐藏的计数不补齐
+	if !view.Restricted {
+		s.countsUc.Apply(ctx, view.User)
+	}
+
+	convertVideos := func(videos []*domain.Video) []*commonv1.Video {
+		result := make([]*commonv1.Video, len(videos))
+		for i, video := range videos {
+			result[i] = deliverVideoURLs(s.cdn, convertToCommonVideo(video, view.User, view.Favorited[video.ID], view.IsFollow))
+		}
+		return result
+	}
+
+	return &v1.GetProfilePageResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		User:         convertToCommonUser(view.User, view.IsFollow),
+		PinnedVideos: convertVideos(view.PinnedVideos),
+		RecentVideos: convertVideos(view.RecentVideos),
+	}, nil
+}
+
+// UpdateTimezone 更新时区偏好
+func (s *UserService) UpdateTimezone(ctx context.Context, req *v1.UpdateTimezoneRequest) (*v1.UpdateTimezoneResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdateTimezoneResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	timezone, err := s.userUc.UpdateTimezone(ctx, userID, req.Timezone)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("update timezone failed: %v", err)
+			msg = "update timezone failed"
+		}
+		return &v1.UpdateTimezoneResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.UpdateTimezoneResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Timezone: timezone,
+	}, nil
+}
+
+// UpdatePrivacy 设置私密账号
+func (s *UserService) UpdatePrivacy(ctx context.Context, req *v1.UpdatePrivacyRequest) (*v1.UpdatePrivacyResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdatePrivacyResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.userUc.UpdatePrivacy(ctx, userID, req.IsPrivate); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("update privacy failed: %v", err)
+			msg = "update privacy failed"
+		}
+		return &v1.UpdatePrivacyResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.UpdatePrivacyResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		IsPrivate: req.IsPrivate,
+	}, nil
+}
+
+// UpdateProfile 更新个人资料
+func (s *UserService) UpdateProfile(ctx context.Context, req *v1.UpdateProfileRequest) (*v1.UpdateProfileResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UpdateProfileResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	nickname := strings.TrimSpace(req.Nickname)
+	if err := s.validateProfile(nickname, req.Avatar, req.BackgroundImage, req.Signature); err != nil {
+		return &v1.UpdateProfileResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	user, err := s.userUc.UpdateProfile(ctx, userID, nickname, req.Avatar, req.BackgroundImage, req.Signature)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("update profile failed: %v", err)
+			msg = "update profile failed"
+		}
+		return &v1.UpdateProfileResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+	s.countsUc.Apply(ctx, user)
+
+	return &v1.UpdateProfileResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		User: convertToCommonUser(user, false),
+	}, nil
+}
+
+// ChangePassword 修改密码
+func (s *UserService) ChangePassword(ctx context.Context, req *v1.ChangePasswordRequest) (*v1.ChangePasswordResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ChangePasswordResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if req.OldPassword == "" {
+		return &v1.ChangePasswordResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "old password required",
+			},
+		}, nil
+	}
+	if err := s.validator.ValidatePassword(req.NewPassword); err != nil {
+		return &v1.ChangePasswordResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if err := s.userUc.ChangePassword(ctx, userID, req.OldPassword, req.NewPassword); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("change password failed: %v", err)
+			msg = "change password failed"
+		}
+		return &v1.ChangePasswordResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	// 旧密码可能已泄露，撤销所有已签发的Token
+	if err := s.authUc.RevokeAllUserTokens(ctx, userID); err != nil {
+		s.log.WithContext(ctx).Warnf("revoke sessions after password change failed: user=%d err=%v", userID, err)
+	}
+
+	return &v1.ChangePasswordResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// validateProfile 校验资料字段，空字段表示不修改，跳过校验
+func (s *UserService) validateProfile(nickname, avatar, backgroundImage, signature string) error {
+	if nickname != "" {
+		if err := s.validator.ValidateNickname(nickname); err != nil {
+			return err
+		}
+	}
+	if avatar != "" {
+		if err := s.validator.ValidateImageURL(avatar); err != nil {
+			return err
+		}
+	}
+	if backgroundImage != "" {
+		if err := s.validator.ValidateImageURL(backgroundImage); err != nil {
+			return err
+		}
+	}
+	return s.validator.ValidateSignature(signature)
+}
+
+// UploadAvatar 上传头像
+func (s *UserService) UploadAvatar(ctx context.Context, req *v1.UploadProfileImageRequest) (*v1.UploadProfileImageResponse, error) {
+	return s.uploadProfileImage(ctx, req, s.imageUc.UploadAvatar)
+}
+
+// UploadBackgroundImage 上传主页背景图
+func (s *UserService) UploadBackgroundImage(ctx context.Context, req *v1.UploadProfileImageRequest) (*v1.UploadProfileImageResponse, error) {
+	return s.uploadProfileImage(ctx, req, s.imageUc.UploadBackgroundImage)
+}
+
+func (s *UserService) uploadProfileImage(
+	ctx context.Context,
+	req *v1.UploadProfileImageRequest,
+	upload func(ctx context.Context, userID int64, reader io.Reader) (*biz.User, error),
+) (*v1.UploadProfileImageResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.UploadProfileImageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if len(req.Data) == 0 {
+		return &v1.UploadProfileImageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "image data is required",
+			},
+		}, nil
+	}
+
+	user, err := upload(ctx, userID, bytes.NewReader(req.Data))
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("upload profile image failed: user=%d file=%s err=%v", userID, req.Filename, err)
+			msg = "upload image failed"
+		}
+		return &v1.UploadProfileImageResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+	s.countsUc.Apply(ctx, user)
+
+	return &v1.UploadProfileImageResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		User: convertToCommonUser(user, false),
+	}, nil
+}
+
+// RequestPasswordReset 申请重置密码
+func (s *UserService) RequestPasswordReset(ctx context.Context, req *v1.RequestPasswordResetRequest) (*v1.RequestPasswordResetResponse, error) {
+	if req.Username == "" {
+		return &v1.RequestPasswordResetResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "username required",
+			},
+		}, nil
+	}
+
+	if err := s.resetUc.RequestPasswordReset(ctx, req.Username); err != nil {
+		s.log.WithContext(ctx).Errorf("request password reset failed: %v", err)
+		return &v1.RequestPasswordResetResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "request password reset failed",
+			},
+		}, nil
+	}
+
+	return &v1.RequestPasswordResetResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// ResetPassword 使用重置Token设置新密码
+func (s *UserService) ResetPassword(ctx context.Context, req *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error) {
+	if req.Username == "" || req.Token == "" {
+		return &v1.ResetPasswordResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "username and token required",
+			},
+		}, nil
+	}
+	if err := s.validator.ValidatePassword(req.NewPassword); err != nil {
+		return &v1.ResetPasswordResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if err := s.resetUc.ResetPassword(ctx, req.Username, req.Token, req.NewPassword); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("reset password failed: %v", err)
+			msg = "reset password failed"
+		}
+		return &v1.ResetPasswordResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.ResetPasswordResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// BindEmail 绑定邮箱，向邮箱发送验证码
+func (s *UserService) BindEmail(ctx context.Context, req *v1.BindEmailRequest) (*v1.BindEmailResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.BindEmailResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateEmail(strings.TrimSpace(req.Email)); err != nil {
+		return &v1.BindEmailResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if err := s.emailUc.BindEmail(ctx, userID, req.Email); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("bind email failed: %v", err)
+			msg = "bind email failed"
+		}
+		return &v1.BindEmailResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.BindEmailResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// VerifyEmail 校验邮箱验证码，通过后完成绑定
+func (s *UserService) VerifyEmail(ctx context.Context, req *v1.VerifyEmailRequest) (*v1.VerifyEmailResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.VerifyEmailResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if req.Code == "" {
+		return &v1.VerifyEmailResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "code required",
+			},
+		}, nil
+	}
+
+	email, err := s.emailUc.VerifyEmail(ctx, userID, req.Code)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("verify email failed: %v", err)
+			msg = "verify email failed"
 		}
-		s.log.WithContext(ctx).Errorf("register user failed: %v", err)
-		return &v1.RegisterResponse{
+		return &v1.VerifyEmailResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
-				StatusMsg:  "register failed",
+				StatusCode: int32(code),
+				StatusMsg:  msg,
 			},
 		}, nil
 	}
 
-	// 生成Token对
-	tokenPair, err := s.jwtManager.GenerateTokenPair(user.ID, user.Username)
-	if err != nil {
-		s.log.WithContext(ctx).Errorf("generate token failed: %v", err)
-		return &v1.RegisterResponse{
+	return &v1.VerifyEmailResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Email: email,
+	}, nil
+}
+
+// GetUserShareCard 获取用户主页分享卡片
+func (s *UserService) GetUserShareCard(ctx context.Context, req *v1.GetUserShareCardRequest) (*v1.GetUserShareCardResponse, error) {
+	if err := s.validator.ValidateUserID(req.UserId); err != nil {
+		return &v1.GetUserShareCardResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
-				StatusMsg:  "generate token failed",
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
 			},
 		}, nil
 	}
 
-	// 为新用户分配默认角色
-	if err := s.permissionUc.InitUserDefaultRole(ctx, user.ID); err != nil {
-		s.log.WithContext(ctx).Errorf("init user default role failed: %v", err)
+	cardURL, err := s.shareUc.GetUserCard(ctx, req.UserId)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("get user share card failed: %v", err)
+			msg = "get share card failed"
+		}
+		return &v1.GetUserShareCardResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
 	}
 
-	return &v1.RegisterResponse{
+	return &v1.GetUserShareCardResponse{
 		Base: &commonv1.BaseResponse{
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		Data: &v1.RegisterData{
-			UserId: user.ID,
-			Token:  tokenPair.AccessToken,
-		},
+		CardUrl: cardURL,
 	}, nil
 }
 
-// Login 用户登录
-func (s *UserService) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginResponse, error) {
-	// 参数验证
-	if req.Username == "" || req.Password == "" {
-		return &v1.LoginResponse{
+// GetMyQuota 获取当前用户的限流和上传配额用量
+func (s *UserService) GetMyQuota(ctx context.Context, req *v1.GetMyQuotaRequest) (*v1.GetMyQuotaResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetMyQuotaResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
-				StatusMsg:  "username and password required",
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
 			},
 		}, nil
 	}
 
-	// 使用认证服务登录
-	tokenPair, user, err := s.authUc.LoginWithToken(ctx, req.Username, req.Password)
+	quota, err := s.quotaUc.GetQuota(ctx, userID)
 	if err != nil {
-		if err == biz.ErrUserNotFound {
-			return &v1.LoginResponse{
-				Base: &commonv1.BaseResponse{
-					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
-					StatusMsg:  "user not found",
-				},
-			}, nil
-		}
-		if err == biz.ErrPasswordError {
-			return &v1.LoginResponse{
-				Base: &commonv1.BaseResponse{
-					StatusCode: int32(commonv1.ErrorCode_PASSWORD_ERROR),
-					StatusMsg:  "invalid password",
-				},
-			}, nil
-		}
-		s.log.WithContext(ctx).Errorf("login failed: %v", err)
-		return &v1.LoginResponse{
+		s.log.WithContext(ctx).Errorf("get quota failed: %v", err)
+		return &v1.GetMyQuotaResponse{
 			Base: &commonv1.BaseResponse{
 				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
-				StatusMsg:  "login failed",
+				StatusMsg:  "get quota failed",
 			},
 		}, nil
 	}
 
-	return &v1.LoginResponse{
+	buckets := make([]*v1.RateLimitBucket, len(quota.RateLimits))
+	for i, b := range quota.RateLimits {
+		buckets[i] = &v1.RateLimitBucket{
+			Name:      b.Name,
+			Rate:      b.Rate,
+			Burst:     int32(b.Burst),
+			Remaining: int32(b.Remaining),
+		}
+	}
+
+	return &v1.GetMyQuotaResponse{
 		Base: &commonv1.BaseResponse{
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		Data: &v1.LoginData{
-			UserId: user.ID,
-			Token:  tokenPair.AccessToken,
+		Data: &v1.QuotaData{
+			RateLimits:     buckets,
+			UploadsUsed:    quota.UploadsUsed,
+			UploadsLimit:   quota.UploadsLimit,
+			UploadsResetAt: quota.UploadsResetAt.Unix(),
+			StorageUsed:    quota.StorageUsed,
+			StorageLimit:   quota.StorageLimit,
 		},
 	}, nil
 }
 
-// GetUser 获取用户信息
-func (s *UserService) GetUser(ctx context.Context, req *v1.GetUserRequest) (*v1.GetUserResponse, error) {
-	// 验证用户ID
-	if err := s.validator.ValidateUserID(req.UserId); err != nil {
-		return &v1.GetUserResponse{
+// GetCreatorAnalytics 获取当前用户的创作者数据
+func (s *UserService) GetCreatorAnalytics(ctx context.Context, req *v1.GetCreatorAnalyticsRequest) (*v1.GetCreatorAnalyticsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetCreatorAnalyticsResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
-				StatusMsg:  err.Error(),
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
 			},
 		}, nil
 	}
 
-	// 获取当前用户ID
-	currentUserID, _ := middleware.GetUserIDFromContext(ctx)
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
 
-	// 获取用户信息
-	user, err := s.userUc.GetUser(ctx, req.UserId)
+	series, err := s.statsUc.GetCreatorAnalytics(ctx, userID, start, end)
 	if err != nil {
-		if err == biz.ErrUserNotFound {
-			return &v1.GetUserResponse{
-				Base: &commonv1.BaseResponse{
-					StatusCode: int32(commonv1.ErrorCode_USER_NOT_EXIST),
-					StatusMsg:  "user not found",
-				},
-			}, nil
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("get creator analytics failed: user=%d err=%v", userID, err)
+			msg = "get creator analytics failed"
 		}
-		s.log.WithContext(ctx).Errorf("get user failed: %v", err)
-		return &v1.GetUserResponse{
+		return &v1.GetCreatorAnalyticsResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
-				StatusMsg:  "get user failed",
+				StatusCode: int32(code),
+				StatusMsg:  msg,
 			},
 		}, nil
 	}
 
-	// 检查关注关系
-	isFollow := false
-	if currentUserID > 0 && currentUserID != req.UserId {
-		isFollow, _ = s.relationUc.IsFollowing(ctx, currentUserID, req.UserId)
+	days := make([]*v1.CreatorDailyStats, len(series))
+	for i, day := range series {
+		days[i] = &v1.CreatorDailyStats{
+			Date:          day.Date.Format("2006-01-02"),
+			NewFollowers:  day.NewFollowers,
+			LostFollowers: day.LostFollowers,
+			VideoPlays:    day.VideoPlays,
+			LikesReceived: day.LikesReceived,
+		}
 	}
 
-	return &v1.GetUserResponse{
+	return &v1.GetCreatorAnalyticsResponse{
 		Base: &commonv1.BaseResponse{
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		Data: &v1.GetUserData{
-			User: s.convertToCommonUser(user, isFollow),
+		Days: days,
+	}, nil
+}
+
+// GetLoginHistory 获取当前用户的登录记录
+func (s *UserService) GetLoginHistory(ctx context.Context, req *v1.GetLoginHistoryRequest) (*v1.GetLoginHistoryResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetLoginHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	records, total, err := s.loginUc.ListLoginHistory(ctx, userID, req.Page, req.Size)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get login history failed: user=%d err=%v", userID, err)
+		return &v1.GetLoginHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get login history failed",
+			},
+		}, nil
+	}
+
+	items := make([]*v1.LoginRecord, len(records))
+	for i, record := range records {
+		items[i] = &v1.LoginRecord{
+			Id:        record.ID,
+			DeviceId:  record.DeviceID,
+			Ip:        record.IP,
+			UserAgent: record.UserAgent,
+			Status:    int32(record.Status),
+			Reason:    record.Reason,
+			CreatedAt: record.CreatedAt.Unix(),
+		}
+	}
+
+	return &v1.GetLoginHistoryResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
 		},
+		Records: items,
+		Total:   total,
 	}, nil
 }
 
 // RelationAction 关注操作
 func (s *UserService) RelationAction(ctx context.Context, req *v1.RelationActionRequest) (*v1.RelationActionResponse, error) {
 	// 获取当前用户ID
-	userID, ok := middleware.GetUserIDFromContext(ctx)
+	userID, ok := reqctx.UserID(ctx)
 	if !ok {
 		return &v1.RelationActionResponse{
 			Base: &commonv1.BaseResponse{
@@ -254,10 +1446,13 @@ func (s *UserService) RelationAction(ctx context.Context, req *v1.RelationAction
 		}, nil
 	}
 
-	var err error
+	var (
+		requested bool
+		err       error
+	)
 	if req.ActionType == 1 {
-		// 关注
-		err = s.relationUc.Follow(ctx, userID, req.ToUserId)
+		// 关注，对方为私密账号时发送关注申请
+		requested, err = s.relationUc.Follow(ctx, userID, req.ToUserId)
 	} else {
 		// 取消关注
 		err = s.relationUc.Unfollow(ctx, userID, req.ToUserId)
@@ -272,6 +1467,14 @@ func (s *UserService) RelationAction(ctx context.Context, req *v1.RelationAction
 				},
 			}, nil
 		}
+		if err == biz.ErrFollowRequestPending || err == biz.ErrUserNotFound {
+			return &v1.RelationActionResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(utils.GetErrorCode(err)),
+					StatusMsg:  err.Error(),
+				},
+			}, nil
+		}
 		s.log.WithContext(ctx).Errorf("relation action failed: %v", err)
 		return &v1.RelationActionResponse{
 			Base: &commonv1.BaseResponse{
@@ -286,6 +1489,108 @@ func (s *UserService) RelationAction(ctx context.Context, req *v1.RelationAction
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
+		Requested: requested,
+	}, nil
+}
+
+// ListFollowRequests 获取收到的待处理关注申请
+func (s *UserService) ListFollowRequests(ctx context.Context, req *v1.ListFollowRequestsRequest) (*v1.ListFollowRequestsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListFollowRequestsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	page, size := biz.NormalizePage(req.Page, req.Size)
+	requests, total, err := s.relationUc.ListFollowRequests(ctx, userID, page, size)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("list follow requests failed: %v", err)
+		return &v1.ListFollowRequestsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "list follow requests failed",
+			},
+		}, nil
+	}
+
+	requestList := make([]*v1.FollowRequest, 0, len(requests))
+	for _, r := range requests {
+		requestList = append(requestList, &v1.FollowRequest{
+			Id:        r.ID,
+			User:      convertToCommonUser(r.Requester, false),
+			CreatedAt: r.CreatedAt.Unix(),
+		})
+	}
+
+	return &v1.ListFollowRequestsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		RequestList: requestList,
+		Total:       total,
+		HasMore:     int64(page)*int64(size) < total,
+	}, nil
+}
+
+// ApproveFollowRequest 通过关注申请
+func (s *UserService) ApproveFollowRequest(ctx context.Context, req *v1.HandleFollowRequestRequest) (*v1.HandleFollowRequestResponse, error) {
+	return s.handleFollowRequest(ctx, req, s.relationUc.ApproveFollowRequest)
+}
+
+// RejectFollowRequest 拒绝关注申请
+func (s *UserService) RejectFollowRequest(ctx context.Context, req *v1.HandleFollowRequestRequest) (*v1.HandleFollowRequestResponse, error) {
+	return s.handleFollowRequest(ctx, req, s.relationUc.RejectFollowRequest)
+}
+
+func (s *UserService) handleFollowRequest(
+	ctx context.Context,
+	req *v1.HandleFollowRequestRequest,
+	handle func(ctx context.Context, userID, requesterID int64) error,
+) (*v1.HandleFollowRequestResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.HandleFollowRequestResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateUserID(req.FromUserId); err != nil {
+		return &v1.HandleFollowRequestResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if err := handle(ctx, userID, req.FromUserId); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("handle follow request failed: %v", err)
+			msg = "handle follow request failed"
+		}
+		return &v1.HandleFollowRequestResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.HandleFollowRequestResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
 	}, nil
 }
 
@@ -302,7 +1607,8 @@ func (s *UserService) GetFollowList(ctx context.Context, req *v1.GetFollowListRe
 	}
 
 	// 获取关注列表
-	users, _, err := s.relationUc.GetFollowList(ctx, req.UserId, 1, 50)
+	page, size := biz.NormalizePage(req.Page, req.Size)
+	users, total, err := s.relationUc.GetFollowList(ctx, req.UserId, page, size)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("get follow list failed: %v", err)
 		return &v1.GetFollowListResponse{
@@ -313,10 +1619,12 @@ func (s *UserService) GetFollowList(ctx context.Context, req *v1.GetFollowListRe
 		}, nil
 	}
 
+	s.countsUc.Apply(ctx, users...)
+
 	// 转换为响应格式
 	userList := make([]*commonv1.User, 0, len(users))
 	for _, user := range users {
-		userList = append(userList, s.convertToCommonUser(user, user.IsFollow))
+		userList = append(userList, convertToCommonUser(user, user.IsFollow))
 	}
 
 	return &v1.GetFollowListResponse{
@@ -326,6 +1634,8 @@ func (s *UserService) GetFollowList(ctx context.Context, req *v1.GetFollowListRe
 		},
 		Data: &v1.GetFollowListData{
 			UserList: userList,
+			Total:    total,
+			HasMore:  int64(page)*int64(size) < total,
 		},
 	}, nil
 }
@@ -343,7 +1653,8 @@ func (s *UserService) GetFollowerList(ctx context.Context, req *v1.GetFollowerLi
 	}
 
 	// 获取粉丝列表
-	users, _, err := s.relationUc.GetFollowerList(ctx, req.UserId, 1, 50)
+	page, size := biz.NormalizePage(req.Page, req.Size)
+	users, total, err := s.relationUc.GetFollowerList(ctx, req.UserId, page, size)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("get follower list failed: %v", err)
 		return &v1.GetFollowerListResponse{
@@ -354,10 +1665,12 @@ func (s *UserService) GetFollowerList(ctx context.Context, req *v1.GetFollowerLi
 		}, nil
 	}
 
+	s.countsUc.Apply(ctx, users...)
+
 	// 转换为响应格式
 	userList := make([]*commonv1.User, 0, len(users))
 	for _, user := range users {
-		userList = append(userList, s.convertToCommonUser(user, user.IsFollow))
+		userList = append(userList, convertToCommonUser(user, user.IsFollow))
 	}
 
 	return &v1.GetFollowerListResponse{
@@ -367,6 +1680,8 @@ func (s *UserService) GetFollowerList(ctx context.Context, req *v1.GetFollowerLi
 		},
 		Data: &v1.GetFollowerListData{
 			UserList: userList,
+			Total:    total,
+			HasMore:  int64(page)*int64(size) < total,
 		},
 	}, nil
 }
@@ -395,6 +1710,29 @@ func (s *UserService) GetFriendList(ctx context.Context, req *v1.GetFriendListRe
 		}, nil
 	}
 
+	s.countsUc.Apply(ctx, users...)
+
+	friendIDs := make([]int64, 0, len(users))
+	for _, user := range users {
+		friendIDs = append(friendIDs, user.ID)
+	}
+
+	// 最新消息和在线状态只在查看自己的好友列表时返回
+	currentUserID, ok := reqctx.UserID(ctx)
+	isSelf := ok && currentUserID == req.UserId
+	latestMessages := map[int64]*biz.Message{}
+	online := map[int64]bool{}
+	if isSelf {
+		if latestMessages, err = s.messageUc.GetLatestMessages(ctx, req.UserId, friendIDs); err != nil {
+			s.log.WithContext(ctx).Warnf("get latest messages failed: %v", err)
+			latestMessages = map[int64]*biz.Message{}
+		}
+		if online, err = s.presenceUc.OnlineStatus(ctx, friendIDs); err != nil {
+			s.log.WithContext(ctx).Warnf("get online status failed: %v", err)
+			online = map[int64]bool{}
+		}
+	}
+
 	// 转换为响应格式
 	userList := make([]*v1.FriendUser, 0, len(users))
 	for _, user := range users {
@@ -410,8 +1748,18 @@ func (s *UserService) GetFriendList(ctx context.Context, req *v1.GetFriendListRe
 			TotalFavorited:  user.TotalFavorited,
 			WorkCount:       int64(user.WorkCount),
 			FavoriteCount:   int64(user.FavoriteCount),
-			Message:         "暂无消息",
-			MsgType:         1,
+			IsOnline:        online[user.ID],
+		}
+		if isSelf {
+			friendUser.Message = "暂无消息"
+			friendUser.MsgType = biz.MsgTypeSent
+			if msg, ok := latestMessages[user.ID]; ok {
+				friendUser.Message = msg.Content
+				friendUser.MsgType = biz.MsgTypeReceived
+				if msg.FromUserID == req.UserId {
+					friendUser.MsgType = biz.MsgTypeSent
+				}
+			}
 		}
 		userList = append(userList, friendUser)
 	}
@@ -427,15 +1775,85 @@ func (s *UserService) GetFriendList(ctx context.Context, req *v1.GetFriendListRe
 	}, nil
 }
 
+// GetOnlineFriends 获取当前在线的好友
+func (s *UserService) GetOnlineFriends(ctx context.Context, req *v1.GetOnlineFriendsRequest) (*v1.GetOnlineFriendsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetOnlineFriendsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	users, err := s.presenceUc.GetOnlineFriends(ctx, userID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get online friends failed: user=%d err=%v", userID, err)
+		return &v1.GetOnlineFriendsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get online friends failed",
+			},
+		}, nil
+	}
+
+	s.countsUc.Apply(ctx, users...)
+
+	userList := make([]*commonv1.User, 0, len(users))
+	for _, user := range users {
+		userList = append(userList, convertToCommonUser(user, user.IsFollow))
+	}
+
+	return &v1.GetOnlineFriendsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		UserList: userList,
+	}, nil
+}
+
+// Ping 在线心跳
+func (s *UserService) Ping(ctx context.Context, req *v1.PingRequest) (*v1.PingResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.PingResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.presenceUc.Heartbeat(ctx, userID); err != nil {
+		s.log.WithContext(ctx).Errorf("refresh online status failed: user=%d err=%v", userID, err)
+		return &v1.PingResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "ping failed",
+			},
+		}, nil
+	}
+
+	return &v1.PingResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
 // GetUserInfo 获取用户信息
 func (s *UserService) GetUserInfo(ctx context.Context, req *v1.GetUserInfoRequest) (*v1.GetUserInfoResponse, error) {
 	user, err := s.userUc.GetUser(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
+	s.countsUc.Apply(ctx, user)
 
 	return &v1.GetUserInfoResponse{
-		User: s.convertToCommonUser(user, false),
+		User: convertToCommonUser(user, false),
 	}, nil
 }
 
@@ -445,10 +1863,11 @@ func (s *UserService) GetUsersInfo(ctx context.Context, req *v1.GetUsersInfoRequ
 	if err != nil {
 		return nil, err
 	}
+	s.countsUc.Apply(ctx, users...)
 
 	userList := make([]*commonv1.User, 0, len(users))
 	for _, user := range users {
-		userList = append(userList, s.convertToCommonUser(user, false))
+		userList = append(userList, convertToCommonUser(user, false))
 	}
 
 	return &v1.GetUsersInfoResponse{
@@ -497,8 +1916,8 @@ func (s *UserService) UpdateUserStats(ctx context.Context, req *v1.UpdateUserSta
 	return &emptypb.Empty{}, nil
 }
 
-// convertToCommonUser 转换为通用用户信息
-func (s *UserService) convertToCommonUser(user *biz.User, isFollow bool) *commonv1.User {
+// convertToCommonUser 转换为通用用户信息，关注计数应先经 CountsUsecase.Apply 填充
+func convertToCommonUser(user *biz.User, isFollow bool) *commonv1.User {
 	return &commonv1.User{
 		Id:              user.ID,
 		Name:            user.Nickname,
@@ -511,5 +1930,6 @@ func (s *UserService) convertToCommonUser(user *biz.User, isFollow bool) *common
 		TotalFavorited:  user.TotalFavorited,
 		WorkCount:       int64(user.WorkCount),
 		FavoriteCount:   int64(user.FavoriteCount),
+		IsPrivate:       user.IsPrivate,
 	}
 }
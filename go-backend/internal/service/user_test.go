@@ -2,24 +2,21 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
+	commonv1 "go-backend/api/common/v1"
 	v1 "go-backend/api/user/v1"
-	"go-backend/internal/biz"
-	"go-backend/internal/conf"
-	"go-backend/internal/data"
-	"go-backend/internal/data/cache"
+	"go-backend/internal/provider"
 	"go-backend/pkg/auth"
-	pkgcache "go-backend/pkg/cache"
-	"go-backend/pkg/security"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/storage"
 	"go-backend/testutils"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestUserService_Register(t *testing.T) {
@@ -268,6 +265,126 @@ func TestUserService_GetUser(t *testing.T) {
 	})
 }
 
+func TestUserService_UpdateProfile(t *testing.T) {
+	t.Run("UpdateProfile_Success", func(t *testing.T) {
+		service, env, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		users, err := env.DataManager.CreateTestUsers(1)
+		require.NoError(t, err)
+		testUser := users[0]
+		ctx := reqctx.WithUserID(context.Background(), testUser.ID)
+
+		// 先读取一次，确保资料已进入缓存
+		_, err = service.GetUser(ctx, &v1.GetUserRequest{UserId: testUser.ID})
+		require.NoError(t, err)
+
+		resp, err := service.UpdateProfile(ctx, &v1.UpdateProfileRequest{
+			Nickname: "  新昵称  ",
+			Avatar:   "https://cdn.example.com/avatar/new.jpg",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Base.StatusCode)
+		assert.Equal(t, "新昵称", resp.User.Name)
+		assert.Equal(t, "https://cdn.example.com/avatar/new.jpg", resp.User.Avatar)
+		assert.Equal(t, testUser.Signature, resp.User.Signature)
+
+		// 缓存已失效，重新读取到新资料
+		getResp, err := service.GetUser(ctx, &v1.GetUserRequest{UserId: testUser.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "新昵称", getResp.Data.User.Name)
+	})
+
+	t.Run("UpdateProfile_InvalidFields", func(t *testing.T) {
+		service, env, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		users, err := env.DataManager.CreateTestUsers(1)
+		require.NoError(t, err)
+		ctx := reqctx.WithUserID(context.Background(), users[0].ID)
+
+		resp, err := service.UpdateProfile(ctx, &v1.UpdateProfileRequest{Avatar: "javascript:alert(1)"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(commonv1.ErrorCode_PARAM_ERROR), resp.Base.StatusCode)
+
+		resp, err = service.UpdateProfile(ctx, &v1.UpdateProfileRequest{Nickname: strings.Repeat("长", 33)})
+		require.NoError(t, err)
+		assert.Equal(t, int32(commonv1.ErrorCode_PARAM_ERROR), resp.Base.StatusCode)
+
+		resp, err = service.UpdateProfile(ctx, &v1.UpdateProfileRequest{Signature: strings.Repeat("签", 201)})
+		require.NoError(t, err)
+		assert.Equal(t, int32(commonv1.ErrorCode_PARAM_ERROR), resp.Base.StatusCode)
+	})
+
+	t.Run("UpdateProfile_Unauthenticated", func(t *testing.T) {
+		service, _, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		resp, err := service.UpdateProfile(context.Background(), &v1.UpdateProfileRequest{Nickname: "nick"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(commonv1.ErrorCode_TOKEN_INVALID), resp.Base.StatusCode)
+	})
+}
+
+func TestUserService_ChangePassword(t *testing.T) {
+	t.Run("ChangePassword_Success", func(t *testing.T) {
+		service, env, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		users, err := env.DataManager.CreateTestUsers(1)
+		require.NoError(t, err)
+		testUser := users[0]
+		ctx := reqctx.WithUserID(context.Background(), testUser.ID)
+
+		resp, err := service.ChangePassword(ctx, &v1.ChangePasswordRequest{
+			OldPassword: "password1",
+			NewPassword: "NewPassword123!",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Base.StatusCode)
+
+		loginResp, err := service.Login(context.Background(), &v1.LoginRequest{
+			Username: testUser.Username,
+			Password: "NewPassword123!",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), loginResp.Base.StatusCode)
+	})
+
+	t.Run("ChangePassword_WrongOldPassword", func(t *testing.T) {
+		service, env, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		users, err := env.DataManager.CreateTestUsers(1)
+		require.NoError(t, err)
+		ctx := reqctx.WithUserID(context.Background(), users[0].ID)
+
+		resp, err := service.ChangePassword(ctx, &v1.ChangePasswordRequest{
+			OldPassword: "wrong-password",
+			NewPassword: "NewPassword123!",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(commonv1.ErrorCode_PASSWORD_ERROR), resp.Base.StatusCode)
+	})
+
+	t.Run("ChangePassword_WeakNewPassword", func(t *testing.T) {
+		service, env, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		users, err := env.DataManager.CreateTestUsers(1)
+		require.NoError(t, err)
+		ctx := reqctx.WithUserID(context.Background(), users[0].ID)
+
+		resp, err := service.ChangePassword(ctx, &v1.ChangePasswordRequest{
+			OldPassword: "password1",
+			NewPassword: "weak",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(commonv1.ErrorCode_PARAM_ERROR), resp.Base.StatusCode)
+	})
+}
+
 func TestUserService_RelationAction(t *testing.T) {
 	t.Run("RelationAction_Follow", func(t *testing.T) {
 		service, env, cleanup := setupUserServiceForTest(t)
@@ -286,7 +403,7 @@ func TestUserService_RelationAction(t *testing.T) {
 		require.NoError(t, err)
 
 		// 在上下文中设置用户信息
-		ctx = context.WithValue(ctx, "user_id", user1.ID)
+		ctx = reqctx.WithUserID(ctx, user1.ID)
 
 		req := &v1.RelationActionRequest{
 			Token:      token,
@@ -323,7 +440,7 @@ func TestUserService_RelationAction(t *testing.T) {
 		require.NoError(t, err)
 
 		// 在上下文中设置用户信息
-		ctx = context.WithValue(ctx, "user_id", user1.ID)
+		ctx = reqctx.WithUserID(ctx, user1.ID)
 
 		req := &v1.RelationActionRequest{
 			Token:      token,
@@ -355,7 +472,7 @@ func TestUserService_RelationAction(t *testing.T) {
 		require.NoError(t, err)
 
 		// 在上下文中设置用户信息
-		ctx = context.WithValue(ctx, "user_id", user1.ID)
+		ctx = reqctx.WithUserID(ctx, user1.ID)
 
 		req := &v1.RelationActionRequest{
 			Token:      token,
@@ -388,7 +505,7 @@ func TestUserService_RelationAction(t *testing.T) {
 		require.NoError(t, err)
 
 		// 在上下文中设置用户信息
-		ctx = context.WithValue(ctx, "user_id", user1.ID)
+		ctx = reqctx.WithUserID(ctx, user1.ID)
 
 		req := &v1.RelationActionRequest{
 			Token:      token,
@@ -434,12 +551,43 @@ func TestUserService_GetFollowList(t *testing.T) {
 		assert.NotNil(t, resp.Data)
 		assert.Len(t, resp.Data.UserList, 2)
 
+		assert.Equal(t, int64(2), resp.Data.Total)
+		assert.False(t, resp.Data.HasMore)
+
 		// 验证关注状态
 		for _, user := range resp.Data.UserList {
 			assert.True(t, user.IsFollow)
 		}
 	})
 
+	t.Run("GetFollowList_Pagination", func(t *testing.T) {
+		service, env, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		ctx := context.Background()
+
+		users, err := env.DataManager.CreateTestUsers(4)
+		require.NoError(t, err)
+		user1 := users[0]
+		for i := 1; i < 4; i++ {
+			err = env.DataManager.CreateFollowRelation(user1.ID, users[i].ID)
+			require.NoError(t, err)
+		}
+
+		resp, err := service.GetFollowList(ctx, &v1.GetFollowListRequest{UserId: user1.ID, Page: 1, Size: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Base.StatusCode)
+		assert.Len(t, resp.Data.UserList, 2)
+		assert.Equal(t, int64(3), resp.Data.Total)
+		assert.True(t, resp.Data.HasMore)
+
+		resp, err = service.GetFollowList(ctx, &v1.GetFollowListRequest{UserId: user1.ID, Page: 2, Size: 2})
+		require.NoError(t, err)
+		assert.Len(t, resp.Data.UserList, 1)
+		assert.Equal(t, int64(3), resp.Data.Total)
+		assert.False(t, resp.Data.HasMore)
+	})
+
 	t.Run("GetFollowList_InvalidUserID", func(t *testing.T) {
 		service, _, cleanup := setupUserServiceForTest(t)
 		defer cleanup()
@@ -495,12 +643,11 @@ func TestUserService_GetFriendList(t *testing.T) {
 		service, env, cleanup := setupUserServiceForTest(t)
 		defer cleanup()
 
-		ctx := context.Background()
-
 		// 创建测试用户
 		users, err := env.DataManager.CreateTestUsers(3)
 		require.NoError(t, err)
 		user1 := users[0]
+		ctx := reqctx.WithUserID(context.Background(), user1.ID)
 
 		// 建立互相关注关系
 		for i := 1; i < 3; i++ {
@@ -529,6 +676,42 @@ func TestUserService_GetFriendList(t *testing.T) {
 			assert.Equal(t, int64(1), user.MsgType)
 		}
 	})
+
+	t.Run("GetFriendList_OtherUserNoMessages", func(t *testing.T) {
+		service, env, cleanup := setupUserServiceForTest(t)
+		defer cleanup()
+
+		users, err := env.DataManager.CreateTestUsers(3)
+		require.NoError(t, err)
+		userA, userB, friend := users[0], users[1], users[2]
+
+		err = env.DataManager.CreateFollowRelation(userB.ID, friend.ID)
+		require.NoError(t, err)
+		err = env.DataManager.CreateFollowRelation(friend.ID, userB.ID)
+		require.NoError(t, err)
+		_, err = service.messageUc.SendMessage(context.Background(), friend.ID, userB.ID, "private message")
+		require.NoError(t, err)
+
+		// A 查看 B 的好友列表，看不到 B 与好友的消息
+		ctx := reqctx.WithUserID(context.Background(), userA.ID)
+		resp, err := service.GetFriendList(ctx, &v1.GetFriendListRequest{UserId: userB.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Base.StatusCode)
+		require.Len(t, resp.Data.UserList, 1)
+		assert.Equal(t, friend.ID, resp.Data.UserList[0].Id)
+		assert.Empty(t, resp.Data.UserList[0].Message)
+		assert.Zero(t, resp.Data.UserList[0].MsgType)
+
+		// B 本人可以看到
+		ctx = reqctx.WithUserID(context.Background(), userB.ID)
+		resp, err = service.GetFriendList(ctx, &v1.GetFriendListRequest{UserId: userB.ID})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Data.UserList, 1)
+		assert.Equal(t, "private message", resp.Data.UserList[0].Message)
+		assert.Equal(t, int64(0), resp.Data.UserList[0].MsgType)
+	})
 }
 
 func TestUserService_GetUserInfo(t *testing.T) {
@@ -663,66 +846,13 @@ func setupUserServiceForTest(t *testing.T) (*UserService, *testutils.TestEnv, fu
 	env, cleanup, err := testutils.SetupTestWithCleanup()
 	require.NoError(t, err)
 
-	// 创建配置
-	config := &conf.Data{
-		Database: &conf.Data_Database{
-			Driver:          "mysql",
-			Source:          "tiktok:tiktok123@tcp(localhost:3307)/tiktok?charset=utf8mb4&parseTime=True&loc=Local",
-			MaxIdleConns:    10,
-			MaxOpenConns:    100,
-			ConnMaxLifetime: durationpb.New(time.Hour),
-		},
-		Redis: &conf.Data_Redis{
-			Addr:         "localhost:6381",
-			Password:     "tiktok123",
-			Db:           1,
-			DialTimeout:  durationpb.New(5 * time.Second),
-			ReadTimeout:  durationpb.New(3 * time.Second),
-			WriteTimeout: durationpb.New(3 * time.Second),
-			PoolSize:     100,
-		},
-	}
-
-	rdb := redis.NewClient(&redis.Options{
-		Addr:     config.Redis.Addr,
-		Password: config.Redis.Password,
-		DB:       int(config.Redis.Db),
-	})
-
-	d, dataCleanup, err := data.NewData(config, log.DefaultLogger)
+	uc, ucCleanup, err := provider.NewTestUsecases(testutils.NewDataConfig(), testutils.NewBusinessConfig(), log.DefaultLogger)
 	require.NoError(t, err)
 
-	// 创建缓存
-	multiCache := pkgcache.NewMultiLevelCache(rdb, &pkgcache.CacheConfig{
-		EnableL1: true,
-		EnableL2: true,
-	})
-	userCache := cache.NewUserCache(multiCache, log.DefaultLogger)
-	authCache := cache.NewAuthCache(multiCache, log.DefaultLogger)
-
-	// 创建仓储
-	passwordMgr := auth.NewPasswordManager()
-	userRepo := data.NewUserRepo(d, userCache, passwordMgr, log.DefaultLogger)
-	relationRepo := data.NewRelationRepo(d, log.DefaultLogger)
-	roleRepo := data.NewRoleRepo(d, log.DefaultLogger)
-	permissionRepo := data.NewPermissionRepo(d, roleRepo, log.DefaultLogger)
-	sessionRepo := data.NewSessionRepo(d, authCache, log.DefaultLogger)
-
-	// 创建用例
-	userUc := biz.NewUserUsecase(userRepo, log.DefaultLogger)
-	relationUc := biz.NewRelationUsecase(relationRepo, log.DefaultLogger)
-	jwtManager := auth.NewJWTManager("test-secret", time.Hour)
-	sessionMgr := auth.NewMemorySessionManager()
-	authUc := biz.NewAuthUsecase(sessionRepo, userRepo, jwtManager, sessionMgr, log.DefaultLogger)
-	rbacManager := auth.NewMemoryRBACManager()
-	permissionUc := biz.NewPermissionUsecase(roleRepo, permissionRepo, rbacManager, log.DefaultLogger)
-
-	// 创建服务
-	validator := security.NewValidator()
-	service := NewUserService(userUc, relationUc, authUc, permissionUc, jwtManager, validator, log.DefaultLogger)
+	service := NewUserService(uc.User, uc.Counts, uc.Relation, uc.Auth, uc.Permission, uc.Message, uc.Register, uc.Reset, uc.Email, nil, uc.Referral, nil, nil, uc.Deletion, nil, nil, nil, nil, nil, nil, uc.Presence, uc.JWTManager, uc.Validator, storage.NewCDN(&storage.CDNConfig{}), log.DefaultLogger)
 
 	cleanupFunc := func() {
-		dataCleanup()
+		ucCleanup()
 		cleanup()
 	}
 
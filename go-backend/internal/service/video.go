@@ -5,6 +5,8 @@ import (
 	"context"
 	"io"
 	"mime/multipart"
+	"sort"
+	"time"
 
 	commonv1 "go-backend/api/common/v1"
 	v1 "go-backend/api/video/v1"
@@ -12,39 +14,96 @@ import (
 	"go-backend/internal/domain"
 	"go-backend/internal/middleware"
 	"go-backend/pkg/media"
+	"go-backend/pkg/reqctx"
+	"go-backend/pkg/richtext"
 	"go-backend/pkg/security"
 	"go-backend/pkg/storage"
 	"go-backend/pkg/utils"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// feedTypeRanked 按互动得分排序的视频流
+const feedTypeRanked = 1
+
 // VideoService 视频服务
 type VideoService struct {
 	v1.UnimplementedVideoServiceServer
 
-	videoUc   *biz.VideoUsecase
-	userUc    *biz.UserUsecase
-	validator *security.Validator
-	processor *media.VideoProcessor
-	log       *log.Helper
+	videoUc     *biz.VideoUsecase
+	userUc      *biz.UserUsecase
+	countsUc    *biz.CountsUsecase
+	favoriteUc  *biz.FavoriteUsecase
+	relationUc  *biz.RelationUsecase
+	shareUc     *biz.ShareUsecase
+	referralUc  *biz.ReferralUsecase
+	historyUc   *biz.WatchHistoryUsecase
+	playUc      *biz.PlayCountUsecase
+	trendingUc  *biz.TrendingUsecase
+	takedownUc  *biz.TakedownUsecase
+	categoryUc  *biz.CategoryUsecase
+	quotaUc     *biz.QuotaUsecase
+	captionUc   *biz.CaptionUsecase
+	promotionUc *biz.PromotionUsecase
+	editUc      *biz.VideoEditUsecase
+	shareLinkUc *biz.ShareLinkUsecase
+	scanUc      *biz.UploadScanUsecase
+	validator   *security.Validator
+	processor   *media.VideoProcessor
+	cdn         *storage.CDN
+	log         *log.Helper
 }
 
 // NewVideoService 创建视频服务
 func NewVideoService(
 	videoUc *biz.VideoUsecase,
 	userUc *biz.UserUsecase,
+	countsUc *biz.CountsUsecase,
+	favoriteUc *biz.FavoriteUsecase,
+	relationUc *biz.RelationUsecase,
+	shareUc *biz.ShareUsecase,
+	referralUc *biz.ReferralUsecase,
+	historyUc *biz.WatchHistoryUsecase,
+	playUc *biz.PlayCountUsecase,
+	trendingUc *biz.TrendingUsecase,
+	takedownUc *biz.TakedownUsecase,
+	categoryUc *biz.CategoryUsecase,
+	quotaUc *biz.QuotaUsecase,
+	captionUc *biz.CaptionUsecase,
+	promotionUc *biz.PromotionUsecase,
+	editUc *biz.VideoEditUsecase,
+	shareLinkUc *biz.ShareLinkUsecase,
+	scanUc *biz.UploadScanUsecase,
 	validator *security.Validator,
 	processor *media.VideoProcessor,
+	cdn *storage.CDN,
 	logger log.Logger,
 ) *VideoService {
 	return &VideoService{
-		videoUc:   videoUc,
-		userUc:    userUc,
-		validator: validator,
-		processor: processor,
-		log:       log.NewHelper(logger),
+		videoUc:     videoUc,
+		userUc:      userUc,
+		countsUc:    countsUc,
+		favoriteUc:  favoriteUc,
+		relationUc:  relationUc,
+		shareUc:     shareUc,
+		referralUc:  referralUc,
+		historyUc:   historyUc,
+		playUc:      playUc,
+		trendingUc:  trendingUc,
+		takedownUc:  takedownUc,
+		categoryUc:  categoryUc,
+		quotaUc:     quotaUc,
+		captionUc:   captionUc,
+		promotionUc: promotionUc,
+		editUc:      editUc,
+		shareLinkUc: shareLinkUc,
+		scanUc:      scanUc,
+		validator:   validator,
+		processor:   processor,
+		cdn:         cdn,
+		log:         log.NewHelper(logger),
 	}
 }
 
@@ -55,12 +114,35 @@ func (s *VideoService) GetFeed(ctx context.Context, req *v1.GetFeedRequest) (*v1
 	// 获取当前用户ID（可选）
 	var currentUserID int64
 	if req.Token != "" {
-		userID, _ := middleware.GetUserIDFromToken(ctx, req.Token)
+		userID, _ := reqctx.UserID(ctx)
 		currentUserID = userID
 	}
 
 	// 获取视频流
-	videos, nextTime, err := s.videoUc.GetFeed(ctx, req.LatestTime, 30)
+	var (
+		videos     []*domain.Video
+		nextCursor *domain.FeedCursor
+		nextTime   int64
+		nextOffset int
+		err        error
+	)
+	if req.FeedType == feedTypeRanked {
+		videos, nextOffset, err = s.videoUc.GetRankedFeed(ctx, req.CategoryId, int(req.Offset), 30)
+	} else {
+		cursor, parseErr := feedCursor(req)
+		if parseErr != nil {
+			return &v1.GetFeedResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+					StatusMsg:  "invalid cursor",
+				},
+			}, nil
+		}
+		videos, nextCursor, err = s.videoUc.GetFeed(ctx, cursor, req.CategoryId, 30)
+		if len(videos) > 0 {
+			nextTime = videos[len(videos)-1].CreatedAt.Unix()
+		}
+	}
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("get feed failed: %v", err)
 		return &v1.GetFeedResponse{
@@ -71,15 +153,35 @@ func (s *VideoService) GetFeed(ctx context.Context, req *v1.GetFeedRequest) (*v1
 		}, nil
 	}
 
+	// 过滤私密账号对访问者不可见的作品，翻页位置仍按过滤前计算
+	videos, err = s.relationUc.FilterVisibleVideos(ctx, currentUserID, videos)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("filter feed videos failed: %v", err)
+		return &v1.GetFeedResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get feed failed",
+			},
+		}, nil
+	}
+
+	// 翻页位置已按普通视频计算，再插入推广视频
+	videos, promoted := s.promotionUc.Inject(ctx, currentUserID, req.CategoryId, videos)
+
 	// 转换为响应格式
-	videoList := make([]*commonv1.Video, 0, len(videos))
-	for _, video := range videos {
-		videoItem, err := s.buildVideoResponse(ctx, video, currentUserID)
-		if err != nil {
-			s.log.WithContext(ctx).Warnf("build video response failed: %v", err)
-			continue
-		}
-		videoList = append(videoList, videoItem)
+	videoList, err := s.buildVideoResponses(ctx, videos, currentUserID, req.Quality)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("build video responses failed: %v", err)
+		return &v1.GetFeedResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get feed failed",
+			},
+		}, nil
+	}
+
+	for _, item := range videoList {
+		item.PromotionId = promoted[item.Id]
 	}
 
 	return &v1.GetFeedResponse{
@@ -88,18 +190,39 @@ func (s *VideoService) GetFeed(ctx context.Context, req *v1.GetFeedRequest) (*v1
 			StatusMsg:  "success",
 		},
 		Data: &v1.GetFeedData{
-			NextTime:  nextTime,
-			VideoList: videoList,
+			NextTime:   nextTime,
+			VideoList:  videoList,
+			NextOffset: int32(nextOffset),
+			NextCursor: encodeFeedCursor(nextCursor),
+			HasMore:    nextCursor != nil || nextOffset > 0,
 		},
 	}, nil
 }
 
+// feedCursor 读取翻页位置，cursor 优先，旧版客户端只传 latest_time 时按时间定位
+func feedCursor(req *v1.GetFeedRequest) (*domain.FeedCursor, error) {
+	if req.Cursor != "" {
+		return domain.ParseFeedCursor(req.Cursor)
+	}
+	if req.LatestTime > 0 {
+		return &domain.FeedCursor{CreatedAt: time.Unix(req.LatestTime, 0)}, nil
+	}
+	return nil, nil
+}
+
+func encodeFeedCursor(cursor *domain.FeedCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	return cursor.Encode()
+}
+
 // PublishVideo 发布视频
 func (s *VideoService) PublishVideo(ctx context.Context, req *v1.PublishVideoRequest) (*v1.PublishVideoResponse, error) {
 	s.log.WithContext(ctx).Info("publish video request")
 
 	// 验证Token
-	userID, ok := middleware.GetUserIDFromToken(ctx, req.Token)
+	userID, ok := reqctx.UserID(ctx)
 	if !ok {
 		return &v1.PublishVideoResponse{
 			Base: &commonv1.BaseResponse{
@@ -119,6 +242,16 @@ func (s *VideoService) PublishVideo(ctx context.Context, req *v1.PublishVideoReq
 		}, nil
 	}
 
+	// 验证分类
+	if err := s.categoryUc.ValidateSelectable(ctx, req.CategoryId); err != nil {
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  "invalid category",
+			},
+		}, nil
+	}
+
 	var videoData []byte
 	var filename string
 
@@ -162,8 +295,29 @@ func (s *VideoService) PublishVideo(ctx context.Context, req *v1.PublishVideoReq
 		}, nil
 	}
 
+	// 检查上传配额
+	if err := s.quotaUc.CheckUpload(ctx, userID, int64(len(videoData))); err != nil {
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  quotaErrorMsg(err, "publish video failed"),
+			},
+		}, nil
+	}
+
+	// 写入存储前扫描病毒
+	quarantined, err := s.scanUc.Scan(ctx, userID, filename, videoData)
+	if err != nil {
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  "uploaded file failed virus scan",
+			},
+		}, nil
+	}
+
 	// 发布视频
-	video, err := s.videoUc.PublishVideo(ctx, userID, req.Title, videoData, filename)
+	video, err := s.videoUc.PublishVideo(ctx, userID, req.Title, req.CategoryId, req.Visibility, req.PublishAt, videoData, filename, quarantined)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("publish video failed: %v", err)
 		return &v1.PublishVideoResponse{
@@ -173,6 +327,7 @@ func (s *VideoService) PublishVideo(ctx context.Context, req *v1.PublishVideoReq
 			},
 		}, nil
 	}
+	s.referralUc.Activate(ctx, userID)
 
 	return &v1.PublishVideoResponse{
 		Base: &commonv1.BaseResponse{
@@ -191,7 +346,7 @@ func (s *VideoService) UploadVideoFile(ctx context.Context, req *v1.UploadVideoF
 	s.log.WithContext(ctx).Info("upload video file request")
 
 	// 验证Token
-	userID, ok := middleware.GetUserIDFromToken(ctx, req.Token)
+	userID, ok := reqctx.UserID(ctx)
 	if !ok {
 		return &v1.PublishVideoResponse{
 			Base: &commonv1.BaseResponse{
@@ -211,59 +366,875 @@ func (s *VideoService) UploadVideoFile(ctx context.Context, req *v1.UploadVideoF
 		}, nil
 	}
 
-	// 验证文件元数据
-	if req.Metadata == nil {
-		return &v1.PublishVideoResponse{
+	// 验证分类
+	if err := s.categoryUc.ValidateSelectable(ctx, req.CategoryId); err != nil {
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  "invalid category",
+			},
+		}, nil
+	}
+
+	// 验证文件元数据
+	if req.Metadata == nil {
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "file metadata is required",
+			},
+		}, nil
+	}
+
+	// 从中间件获取文件头
+	fileHeader, ok := middleware.GetVideoFileHeaderFromContext(ctx)
+	if !ok {
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "video file not found",
+			},
+		}, nil
+	}
+
+	// 处理文件上传
+	video, err := s.handleVideoUpload(ctx, userID, req.Title, req.CategoryId, req.Visibility, req.PublishAt, fileHeader)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("handle video upload failed: %v", err)
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  quotaErrorMsg(err, "upload failed"),
+			},
+		}, nil
+	}
+	s.referralUc.Activate(ctx, userID)
+
+	return &v1.PublishVideoResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.PublishVideoData{
+			VideoId: video.ID,
+			Status:  v1.UploadStatus_UPLOAD_STATUS_COMPLETED,
+		},
+	}, nil
+}
+
+// GetPublishList 获取发布列表
+func (s *VideoService) GetPublishList(ctx context.Context, req *v1.GetPublishListRequest) (*v1.GetPublishListResponse, error) {
+	s.log.WithContext(ctx).Info("get publish list request")
+
+	// 验证Token
+	currentUserID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetPublishListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	// 验证用户ID
+	if err := s.validator.ValidateUserID(req.UserId); err != nil {
+		return &v1.GetPublishListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid user id",
+			},
+		}, nil
+	}
+
+	// 私密账号的作品仅本人和粉丝可见
+	author, err := s.userUc.GetUser(ctx, req.UserId)
+	if err == nil {
+		err = s.relationUc.CheckVisible(ctx, currentUserID, author)
+	}
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("check publish list visibility failed: %v", err)
+			msg = "get publish list failed"
+		}
+		return &v1.GetPublishListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	// 获取用户发布列表，按可见范围过滤访问者无权查看的视频
+	videos, err := s.videoUc.GetPublishList(ctx, req.UserId)
+	if err == nil {
+		videos, err = s.relationUc.FilterVideosByVisibility(ctx, currentUserID, videos)
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get publish list failed: %v", err)
+		return &v1.GetPublishListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get publish list failed",
+			},
+		}, nil
+	}
+
+	// 转换为响应格式
+	videoList, err := s.buildVideoResponses(ctx, videos, currentUserID, req.Quality)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("build video responses failed: %v", err)
+		return &v1.GetPublishListResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get publish list failed",
+			},
+		}, nil
+	}
+
+	return &v1.GetPublishListResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.GetPublishListData{
+			VideoList: videoList,
+		},
+	}, nil
+}
+
+// GetUploadConfig 获取上传配置
+func (s *VideoService) GetUploadConfig(ctx context.Context, req *v1.GetUploadConfigRequest) (*v1.GetUploadConfigResponse, error) {
+	s.log.WithContext(ctx).Info("get upload config request")
+
+	config, err := s.videoUc.GetUploadConfig(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get upload config failed: %v", err)
+		return &v1.GetUploadConfigResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get upload config failed",
+			},
+		}, nil
+	}
+
+	return &v1.GetUploadConfigResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.UploadConfig{
+			MaxFileSize:          config.MaxFileSize,
+			SupportedFormats:     config.SupportedFormats,
+			ChunkSize:            config.ChunkSize,
+			EnableResume:         config.EnableResume,
+			MaxConcurrentUploads: 3,
+		},
+	}, nil
+}
+
+// GetUploadProgress 获取上传进度
+func (s *VideoService) GetUploadProgress(ctx context.Context, req *v1.GetUploadProgressRequest) (*v1.GetUploadProgressResponse, error) {
+	s.log.WithContext(ctx).Info("get upload progress request")
+
+	// 验证Token
+	_, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetUploadProgressResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	progress, err := s.videoUc.GetUploadProgress(ctx, req.UploadId)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get upload progress failed: %v", err)
+		return &v1.GetUploadProgressResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get upload progress failed",
+			},
+		}, nil
+	}
+
+	return &v1.GetUploadProgressResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.UploadProgress{
+			UploadId:      progress.UploadID,
+			Progress:      progress.Progress,
+			Status:        v1.UploadStatus(v1.UploadStatus_value[progress.Status]),
+			TotalSize:     progress.TotalSize,
+			UploadedSize:  progress.UploadedSize,
+			ErrorMessage:  progress.ErrorMessage,
+			EstimatedTime: progress.EstimatedTime,
+		},
+	}, nil
+}
+
+// GetVideoShareCard 获取视频分享卡片
+func (s *VideoService) GetVideoShareCard(ctx context.Context, req *v1.GetVideoShareCardRequest) (*v1.GetVideoShareCardResponse, error) {
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.GetVideoShareCardResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	cardURL, err := s.shareUc.GetVideoCard(ctx, req.VideoId)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("get video share card failed: %v", err)
+			msg = "get share card failed"
+		}
+		return &v1.GetVideoShareCardResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.GetVideoShareCardResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		CardUrl: cardURL,
+	}, nil
+}
+
+// ShareVideo 分享视频
+func (s *VideoService) ShareVideo(ctx context.Context, req *v1.ShareVideoRequest) (*v1.ShareVideoResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ShareVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.ShareVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	link, err := s.shareLinkUc.ShareVideo(ctx, userID, req.VideoId)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("share video failed: user=%d video=%d err=%v", userID, req.VideoId, err)
+			msg = "share video failed"
+		}
+		return &v1.ShareVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.ShareVideoResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		ShareToken: link.Token,
+		ShareUrl:   s.shareLinkUc.ShareURL(link.Token),
+	}, nil
+}
+
+// ResolveShareLink 解析分享短链
+func (s *VideoService) ResolveShareLink(ctx context.Context, req *v1.ResolveShareLinkRequest) (*v1.ResolveShareLinkResponse, error) {
+	currentUserID, _ := reqctx.UserID(ctx)
+
+	link, video, err := s.shareLinkUc.ResolveShareLink(ctx, currentUserID, req.ShareToken)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("resolve share link failed: %v", err)
+			msg = "resolve share link failed"
+		}
+		return &v1.ResolveShareLinkResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	videoItem, err := s.buildVideoResponse(ctx, video, currentUserID)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("build shared video response failed: video=%d err=%v", video.ID, err)
+			msg = "resolve share link failed"
+		}
+		return &v1.ResolveShareLinkResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.ResolveShareLinkResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Video:    videoItem,
+		SharerId: link.SharerID,
+	}, nil
+}
+
+// RecordView 记录观看
+func (s *VideoService) RecordView(ctx context.Context, req *v1.RecordViewRequest) (*v1.RecordViewResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.RecordViewResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.RecordViewResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	recorded, err := s.historyUc.RecordView(ctx, userID, req.VideoId, req.Source)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("record view failed: user=%d video=%d err=%v", userID, req.VideoId, err)
+			msg = "record view failed"
+		}
+		return &v1.RecordViewResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.RecordViewResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Recorded: recorded,
+	}, nil
+}
+
+// ReportPlay 上报播放，登录用户按用户去重，未登录时按客户端IP去重。
+// 带分享token的播放计入后归因到分享者，归因失败不影响上报结果
+func (s *VideoService) ReportPlay(ctx context.Context, req *v1.ReportPlayRequest) (*v1.ReportPlayResponse, error) {
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.ReportPlayResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+	if req.WatchedMs < 0 {
+		return &v1.ReportPlayResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid watched duration",
+			},
+		}, nil
+	}
+
+	viewer := biz.PlayViewer{}
+	if userID, ok := reqctx.UserID(ctx); ok {
+		viewer.UserID = userID
+	} else if ip, ok := reqctx.ClientIP(ctx); ok {
+		viewer.ClientIP = ip
+	}
+
+	counted, err := s.playUc.ReportPlay(ctx, viewer, req.VideoId, time.Duration(req.WatchedMs)*time.Millisecond)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("report play failed: video=%d err=%v", req.VideoId, err)
+			msg = "report play failed"
+		}
+		return &v1.ReportPlayResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	if counted && req.ShareToken != "" {
+		if err := s.shareLinkUc.AttributePlay(ctx, viewer.UserID, req.ShareToken, req.VideoId); err != nil {
+			s.log.WithContext(ctx).Warnf("attribute play to share link failed: video=%d err=%v", req.VideoId, err)
+		}
+	}
+
+	return &v1.ReportPlayResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Counted: counted,
+	}, nil
+}
+
+// GetTrending 获取热门视频，榜单由定时任务合并，访问者不可见的私密作品不返回
+func (s *VideoService) GetTrending(ctx context.Context, req *v1.GetTrendingRequest) (*v1.GetTrendingResponse, error) {
+	// 获取当前用户ID（可选）
+	var currentUserID int64
+	if req.Token != "" {
+		userID, _ := reqctx.UserID(ctx)
+		currentUserID = userID
+	}
+
+	videos, err := s.trendingUc.GetTrending(ctx, int(req.Limit))
+	if err == nil {
+		videos, err = s.relationUc.FilterVisibleVideos(ctx, currentUserID, videos)
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get trending failed: %v", err)
+		return &v1.GetTrendingResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get trending failed",
+			},
+		}, nil
+	}
+
+	videoList, err := s.buildVideoResponses(ctx, videos, currentUserID, "")
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("build video responses failed: %v", err)
+		return &v1.GetTrendingResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get trending failed",
+			},
+		}, nil
+	}
+
+	return &v1.GetTrendingResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		VideoList: videoList,
+	}, nil
+}
+
+// GetWatchHistory 获取观看记录，已删除或下架的视频不返回
+func (s *VideoService) GetWatchHistory(ctx context.Context, req *v1.GetWatchHistoryRequest) (*v1.GetWatchHistoryResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetWatchHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	entries, total, err := s.historyUc.GetWatchHistory(ctx, userID, req.Page, req.Size)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get watch history failed: %v", err)
+		return &v1.GetWatchHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get watch history failed",
+			},
+		}, nil
+	}
+
+	videoIDs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		videoIDs = append(videoIDs, entry.VideoID)
+	}
+	videos, err := s.videoUc.GetVideos(ctx, videoIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get watched videos failed: %v", err)
+		return &v1.GetWatchHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get watch history failed",
+			},
+		}, nil
+	}
+	videoMap := make(map[int64]*domain.Video, len(videos))
+	authorIDs := make([]int64, 0, len(videos))
+	for _, video := range videos {
+		if video.Status != domain.VideoStatusPublished {
+			continue
+		}
+		videoMap[video.ID] = video
+		authorIDs = append(authorIDs, video.AuthorID)
+	}
+
+	authors, err := s.userUc.GetUsers(ctx, authorIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("get video authors failed: %v", err)
+		return &v1.GetWatchHistoryResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "get watch history failed",
+			},
+		}, nil
+	}
+	s.countsUc.Apply(ctx, authors...)
+	authorMap := make(map[int64]*biz.User, len(authors))
+	for _, author := range authors {
+		authorMap[author.ID] = author
+	}
+
+	favoriteMap, err := s.favoriteUc.BatchIsFavorite(ctx, userID, videoIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("batch check favorite status failed: %v", err)
+		favoriteMap = map[int64]bool{}
+	}
+
+	items := make([]*v1.WatchHistoryItem, 0, len(entries))
+	for _, entry := range entries {
+		video, ok := videoMap[entry.VideoID]
+		if !ok {
+			continue
+		}
+		author, ok := authorMap[video.AuthorID]
+		if !ok {
+			continue
+		}
+		item := convertToCommonVideo(video, author, favoriteMap[video.ID], false)
+		item.PlayUrl = video.PlayURLFor(req.Quality)
+		items = append(items, &v1.WatchHistoryItem{
+			Video:     deliverVideoURLs(s.cdn, item),
+			WatchedAt: entry.WatchedAt.Unix(),
+		})
+	}
+
+	return &v1.GetWatchHistoryResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Items: items,
+		Total: total,
+	}, nil
+}
+
+// GetVideoAudience 获取视频受众分析
+func (s *VideoService) GetVideoAudience(ctx context.Context, req *v1.GetVideoAudienceRequest) (*v1.GetVideoAudienceResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.GetVideoAudienceResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.GetVideoAudienceResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	audience, err := s.historyUc.GetVideoAudience(ctx, userID, req.VideoId, start, end)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("get video audience failed: user=%d video=%d err=%v", userID, req.VideoId, err)
+			msg = "get video audience failed"
+		}
+		return &v1.GetVideoAudienceResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	sources := make([]int32, 0, len(audience.SourceViews))
+	for source := range audience.SourceViews {
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+	sourceViews := make([]*v1.SourceViews, 0, len(sources))
+	for _, source := range sources {
+		sourceViews = append(sourceViews, &v1.SourceViews{Source: source, Views: audience.SourceViews[source]})
+	}
+
+	return &v1.GetVideoAudienceResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.VideoAudience{
+			VideoId:          audience.VideoID,
+			StartTime:        audience.StartTime.Unix(),
+			EndTime:          audience.EndTime.Unix(),
+			Views:            &v1.AudienceSplit{Follower: audience.Views.Follower, NonFollower: audience.Views.NonFollower},
+			Likes:            &v1.AudienceSplit{Follower: audience.Likes.Follower, NonFollower: audience.Likes.NonFollower},
+			NewViewers:       audience.NewViewers,
+			ReturningViewers: audience.ReturningViewers,
+			SourceViews:      sourceViews,
+		},
+	}, nil
+}
+
+// AppealTakedown 申诉视频下架
+func (s *VideoService) AppealTakedown(ctx context.Context, req *v1.AppealTakedownRequest) (*v1.AppealTakedownResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.AppealTakedownResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	takedown, err := s.takedownUc.AppealTakedown(ctx, userID, req.TakedownId, req.Reason)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("appeal takedown failed: user=%d takedown=%d err=%v", userID, req.TakedownId, err)
+			msg = "appeal takedown failed"
+		}
+		return &v1.AppealTakedownResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.AppealTakedownResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Takedown: convertTakedown(takedown),
+	}, nil
+}
+
+// ListMyTakedowns 查询当前用户被下架的视频
+func (s *VideoService) ListMyTakedowns(ctx context.Context, req *v1.ListMyTakedownsRequest) (*v1.ListMyTakedownsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.ListMyTakedownsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	takedowns, total, err := s.takedownUc.ListMyTakedowns(ctx, userID, req.Page, req.Size)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("list takedowns failed: user=%d err=%v", userID, err)
+		return &v1.ListMyTakedownsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+				StatusMsg:  "list takedowns failed",
+			},
+		}, nil
+	}
+
+	return &v1.ListMyTakedownsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: &v1.ListMyTakedownsData{
+			TakedownList: convertTakedowns(takedowns),
+			Total:        total,
+		},
+	}, nil
+}
+
+// SetVideoCaptions 创作者上传视频字幕
+func (s *VideoService) SetVideoCaptions(ctx context.Context, req *v1.SetVideoCaptionsRequest) (*v1.SetVideoCaptionsResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.SetVideoCaptionsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.SetVideoCaptionsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	count, err := s.captionUc.SetCaptions(ctx, userID, req.VideoId, req.Language, req.Content)
+	if err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("set video captions failed: user=%d video=%d err=%v", userID, req.VideoId, err)
+			msg = "set video captions failed"
+		}
+		return &v1.SetVideoCaptionsResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.SetVideoCaptionsResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		CueCount: int32(count),
+	}, nil
+}
+
+// SetVideoVisibility 作者修改视频的可见范围
+func (s *VideoService) SetVideoVisibility(ctx context.Context, req *v1.SetVideoVisibilityRequest) (*v1.SetVideoVisibilityResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.SetVideoVisibilityResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.SetVideoVisibilityResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
+
+	if _, err := s.videoUc.SetVideoVisibility(ctx, userID, req.VideoId, req.Visibility); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("set video visibility failed: user=%d video=%d err=%v", userID, req.VideoId, err)
+			msg = "set video visibility failed"
+		}
+		return &v1.SetVideoVisibilityResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	return &v1.SetVideoVisibilityResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+	}, nil
+}
+
+// SchedulePublish 作者修改草稿的计划发布时间或立即发布
+func (s *VideoService) SchedulePublish(ctx context.Context, req *v1.SchedulePublishRequest) (*v1.SchedulePublishResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
+		return &v1.SchedulePublishResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
-				StatusMsg:  "file metadata is required",
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
 			},
 		}, nil
 	}
 
-	// 从中间件获取文件头
-	fileHeader, ok := middleware.GetVideoFileHeaderFromContext(ctx)
-	if !ok {
-		return &v1.PublishVideoResponse{
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.SchedulePublishResponse{
 			Base: &commonv1.BaseResponse{
 				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
-				StatusMsg:  "video file not found",
+				StatusMsg:  err.Error(),
 			},
 		}, nil
 	}
 
-	// 处理文件上传
-	video, err := s.handleVideoUpload(ctx, userID, req.Title, fileHeader)
-	if err != nil {
-		s.log.WithContext(ctx).Errorf("handle video upload failed: %v", err)
-		return &v1.PublishVideoResponse{
+	if _, err := s.videoUc.SchedulePublish(ctx, userID, req.VideoId, req.PublishAt); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("schedule publish failed: user=%d video=%d err=%v", userID, req.VideoId, err)
+			msg = "schedule publish failed"
+		}
+		return &v1.SchedulePublishResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(utils.GetErrorCode(err)),
-				StatusMsg:  "upload failed",
+				StatusCode: int32(code),
+				StatusMsg:  msg,
 			},
 		}, nil
 	}
 
-	return &v1.PublishVideoResponse{
+	return &v1.SchedulePublishResponse{
 		Base: &commonv1.BaseResponse{
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		Data: &v1.PublishVideoData{
-			VideoId: video.ID,
-			Status:  v1.UploadStatus_UPLOAD_STATUS_COMPLETED,
-		},
 	}, nil
 }
 
-// GetPublishList 获取发布列表
-func (s *VideoService) GetPublishList(ctx context.Context, req *v1.GetPublishListRequest) (*v1.GetPublishListResponse, error) {
-	s.log.WithContext(ctx).Info("get publish list request")
-
-	// 验证Token
-	currentUserID, ok := middleware.GetUserIDFromToken(ctx, req.Token)
+// UpdateVideoInfo 作者或审核员修改视频的标题、话题和封面
+func (s *VideoService) UpdateVideoInfo(ctx context.Context, req *v1.UpdateVideoInfoRequest) (*v1.UpdateVideoInfoResponse, error) {
+	userID, ok := reqctx.UserID(ctx)
 	if !ok {
-		return &v1.GetPublishListResponse{
+		return &v1.UpdateVideoInfoResponse{
 			Base: &commonv1.BaseResponse{
 				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
 				StatusMsg:  "invalid token",
@@ -271,120 +1242,205 @@ func (s *VideoService) GetPublishList(ctx context.Context, req *v1.GetPublishLis
 		}, nil
 	}
 
-	// 验证用户ID
-	if err := s.validator.ValidateUserID(req.UserId); err != nil {
-		return &v1.GetPublishListResponse{
+	if err := s.validator.ValidateVideoID(req.VideoId); err != nil {
+		return &v1.UpdateVideoInfoResponse{
 			Base: &commonv1.BaseResponse{
 				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
-				StatusMsg:  "invalid user id",
+				StatusMsg:  err.Error(),
 			},
 		}, nil
 	}
 
-	// 获取用户发布列表
-	videos, err := s.videoUc.GetPublishList(ctx, req.UserId)
+	input := &biz.VideoInfoInput{}
+	if req.Title != "" {
+		input.Title = &req.Title
+	}
+	if req.UpdateHashtags {
+		input.Hashtags = append([]string{}, req.Hashtags...)
+	}
+	if len(req.CoverData) > 0 {
+		input.Cover = bytes.NewReader(req.CoverData)
+	}
+
+	video, err := s.editUc.UpdateVideoInfo(ctx, userID, req.VideoId, input)
 	if err != nil {
-		s.log.WithContext(ctx).Errorf("get publish list failed: %v", err)
-		return &v1.GetPublishListResponse{
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("update video info failed: user=%d video=%d err=%v", userID, req.VideoId, err)
+			msg = "update video info failed"
+		}
+		return &v1.UpdateVideoInfoResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
-				StatusMsg:  "get publish list failed",
+				StatusCode: int32(code),
+				StatusMsg:  msg,
 			},
 		}, nil
 	}
 
-	// 转换为响应格式
-	videoList := make([]*commonv1.Video, 0, len(videos))
-	for _, video := range videos {
-		videoItem, err := s.buildVideoResponse(ctx, video, currentUserID)
-		if err != nil {
-			s.log.WithContext(ctx).Warnf("build video response failed: %v", err)
-			continue
-		}
-		videoList = append(videoList, videoItem)
+	videoItem, err := s.buildVideoResponse(ctx, video, userID)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("build updated video response failed: video=%d err=%v", video.ID, err)
 	}
 
-	return &v1.GetPublishListResponse{
+	return &v1.UpdateVideoInfoResponse{
 		Base: &commonv1.BaseResponse{
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		Data: &v1.GetPublishListData{
-			VideoList: videoList,
-		},
+		Video: videoItem,
 	}, nil
 }
 
-// GetUploadConfig 获取上传配置
-func (s *VideoService) GetUploadConfig(ctx context.Context, req *v1.GetUploadConfigRequest) (*v1.GetUploadConfigResponse, error) {
-	s.log.WithContext(ctx).Info("get upload config request")
+// SearchWithinCreator 按字幕内容检索创作者的视频
+func (s *VideoService) SearchWithinCreator(ctx context.Context, req *v1.SearchWithinCreatorRequest) (*v1.SearchWithinCreatorResponse, error) {
+	if err := s.validator.ValidateUserID(req.CreatorId); err != nil {
+		return &v1.SearchWithinCreatorResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  err.Error(),
+			},
+		}, nil
+	}
 
-	config, err := s.videoUc.GetUploadConfig(ctx)
+	results, err := s.captionUc.SearchWithinCreator(ctx, req.CreatorId, req.Query, req.Limit)
 	if err != nil {
-		s.log.WithContext(ctx).Errorf("get upload config failed: %v", err)
-		return &v1.GetUploadConfigResponse{
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("search captions failed: creator=%d err=%v", req.CreatorId, err)
+			msg = "search failed"
+		}
+		return &v1.SearchWithinCreatorResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(code),
+				StatusMsg:  msg,
+			},
+		}, nil
+	}
+
+	currentUserID, _ := reqctx.UserID(ctx)
+	videos := make([]*domain.Video, len(results))
+	for i, result := range results {
+		videos[i] = result.Video
+	}
+	videoList, err := s.buildVideoResponses(ctx, videos, currentUserID, "")
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("build caption search videos failed: creator=%d err=%v", req.CreatorId, err)
+		return &v1.SearchWithinCreatorResponse{
 			Base: &commonv1.BaseResponse{
 				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
-				StatusMsg:  "get upload config failed",
+				StatusMsg:  "search failed",
 			},
 		}, nil
 	}
 
-	return &v1.GetUploadConfigResponse{
+	videoMap := make(map[int64]*commonv1.Video, len(videoList))
+	for _, video := range videoList {
+		videoMap[video.Id] = video
+	}
+	resultList := make([]*v1.CaptionSearchResult, 0, len(results))
+	for _, result := range results {
+		video, ok := videoMap[result.Video.ID]
+		if !ok {
+			continue
+		}
+		hits := make([]*v1.CaptionHit, len(result.Hits))
+		for i, hit := range result.Hits {
+			hits[i] = &v1.CaptionHit{
+				StartMs:  hit.Start.Milliseconds(),
+				EndMs:    hit.End.Milliseconds(),
+				Text:     hit.Text,
+				DeepLink: hit.DeepLink,
+			}
+		}
+		resultList = append(resultList, &v1.CaptionSearchResult{Video: video, Hits: hits})
+	}
+
+	return &v1.SearchWithinCreatorResponse{
 		Base: &commonv1.BaseResponse{
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		Data: &v1.UploadConfig{
-			MaxFileSize:          config.MaxFileSize,
-			SupportedFormats:     config.SupportedFormats,
-			ChunkSize:            config.ChunkSize,
-			EnableResume:         config.EnableResume,
-			MaxConcurrentUploads: 3,
-		},
+		ResultList: resultList,
 	}, nil
 }
 
-// GetUploadProgress 获取上传进度
-func (s *VideoService) GetUploadProgress(ctx context.Context, req *v1.GetUploadProgressRequest) (*v1.GetUploadProgressResponse, error) {
-	s.log.WithContext(ctx).Info("get upload progress request")
-
-	// 验证Token
-	_, ok := middleware.GetUserIDFromToken(ctx, req.Token)
-	if !ok {
-		return &v1.GetUploadProgressResponse{
+// RecordPromotionClick 上报推广点击，未登录用户也可以上报
+func (s *VideoService) RecordPromotionClick(ctx context.Context, req *v1.RecordPromotionClickRequest) (*v1.RecordPromotionClickResponse, error) {
+	if req.PromotionId <= 0 {
+		return &v1.RecordPromotionClickResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
-				StatusMsg:  "invalid token",
+				StatusCode: int32(commonv1.ErrorCode_PARAM_ERROR),
+				StatusMsg:  "invalid promotion id",
 			},
 		}, nil
 	}
 
-	progress, err := s.videoUc.GetUploadProgress(ctx, req.UploadId)
-	if err != nil {
-		s.log.WithContext(ctx).Errorf("get upload progress failed: %v", err)
-		return &v1.GetUploadProgressResponse{
+	userID, _ := reqctx.UserID(ctx)
+	if err := s.promotionUc.RecordClick(ctx, userID, req.PromotionId); err != nil {
+		code := utils.GetErrorCode(err)
+		msg := err.Error()
+		if code == commonv1.ErrorCode_SERVER_ERROR {
+			s.log.WithContext(ctx).Errorf("record promotion click failed: promotion=%d err=%v", req.PromotionId, err)
+			msg = "record click failed"
+		}
+		return &v1.RecordPromotionClickResponse{
 			Base: &commonv1.BaseResponse{
-				StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
-				StatusMsg:  "get upload progress failed",
+				StatusCode: int32(code),
+				StatusMsg:  msg,
 			},
 		}, nil
 	}
 
-	return &v1.GetUploadProgressResponse{
+	return &v1.RecordPromotionClickResponse{
 		Base: &commonv1.BaseResponse{
 			StatusCode: 0,
 			StatusMsg:  "success",
 		},
-		Data: &v1.UploadProgress{
-			UploadId:      progress.UploadID,
-			Progress:      progress.Progress,
-			Status:        v1.UploadStatus(v1.UploadStatus_value[progress.Status]),
-			TotalSize:     progress.TotalSize,
-			UploadedSize:  progress.UploadedSize,
-			ErrorMessage:  progress.ErrorMessage,
-			EstimatedTime: progress.EstimatedTime,
+	}, nil
+}
+
+// ListVideoCategories 查询可选的视频分类
+func (s *VideoService) ListVideoCategories(ctx context.Context, req *v1.ListVideoCategoriesRequest) (*v1.ListVideoCategoriesResponse, error) {
+	var categoryList []*commonv1.VideoCategory
+	if req.WithFacets {
+		facets, err := s.categoryUc.ListCategoryFacets(ctx)
+		if err != nil {
+			s.log.WithContext(ctx).Errorf("list category facets failed: %v", err)
+			return &v1.ListVideoCategoriesResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+					StatusMsg:  "list categories failed",
+				},
+			}, nil
+		}
+		categoryList = make([]*commonv1.VideoCategory, 0, len(facets))
+		for _, facet := range facets {
+			category := convertCategory(facet.Category, req.Locale)
+			category.VideoCount = facet.VideoCount
+			categoryList = append(categoryList, category)
+		}
+	} else {
+		categories, err := s.categoryUc.ListActiveCategories(ctx)
+		if err != nil {
+			s.log.WithContext(ctx).Errorf("list categories failed: %v", err)
+			return &v1.ListVideoCategoriesResponse{
+				Base: &commonv1.BaseResponse{
+					StatusCode: int32(commonv1.ErrorCode_SERVER_ERROR),
+					StatusMsg:  "list categories failed",
+				},
+			}, nil
+		}
+		categoryList = convertCategories(categories, req.Locale)
+	}
+
+	return &v1.ListVideoCategoriesResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
 		},
+		CategoryList: categoryList,
 	}, nil
 }
 
@@ -393,7 +1449,8 @@ func (s *VideoService) InitiateMultipartUpload(ctx context.Context, req *v1.Init
 	s.log.WithContext(ctx).Info("initiate multipart upload request")
 
 	// 验证Token
-	if _, ok := middleware.GetUserIDFromToken(ctx, req.Token); !ok {
+	userID, ok := reqctx.UserID(ctx)
+	if !ok {
 		return &v1.InitiateMultipartUploadResponse{
 			Base: &commonv1.BaseResponse{
 				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
@@ -402,6 +1459,16 @@ func (s *VideoService) InitiateMultipartUpload(ctx context.Context, req *v1.Init
 		}, nil
 	}
 
+	// 检查上传配额
+	if err := s.quotaUc.CheckUpload(ctx, userID, req.FileSize); err != nil {
+		return &v1.InitiateMultipartUploadResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  quotaErrorMsg(err, "initiate upload failed"),
+			},
+		}, nil
+	}
+
 	// 初始化分片上传
 	uploadInfo, err := s.videoUc.InitiateMultipartUpload(ctx, req.Filename, req.FileSize, req.ContentType, req.Title)
 	if err != nil {
@@ -435,7 +1502,7 @@ func (s *VideoService) UploadPart(ctx context.Context, req *v1.UploadPartRequest
 	s.log.WithContext(ctx).Info("upload part request")
 
 	// 验证Token
-	_, ok := middleware.GetUserIDFromToken(ctx, req.Token)
+	_, ok := reqctx.UserID(ctx)
 	if !ok {
 		return &v1.UploadPartResponse{
 			Base: &commonv1.BaseResponse{
@@ -447,7 +1514,7 @@ func (s *VideoService) UploadPart(ctx context.Context, req *v1.UploadPartRequest
 
 	// 上传分片
 	reader := bytes.NewReader(req.Data)
-	partInfo, err := s.videoUc.UploadPart(ctx, req.UploadId, int(req.PartNumber), reader, req.Size)
+	partInfo, err := s.videoUc.UploadPart(ctx, req.UploadId, int(req.PartNumber), reader, req.Size, req.Checksum)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("upload part failed: %v", err)
 		return &v1.UploadPartResponse{
@@ -476,7 +1543,7 @@ func (s *VideoService) CompleteMultipartUpload(ctx context.Context, req *v1.Comp
 	s.log.WithContext(ctx).Info("complete multipart upload request")
 
 	// 验证Token
-	userID, ok := middleware.GetUserIDFromToken(ctx, req.Token)
+	userID, ok := reqctx.UserID(ctx)
 	if !ok {
 		return &v1.PublishVideoResponse{
 			Base: &commonv1.BaseResponse{
@@ -486,6 +1553,16 @@ func (s *VideoService) CompleteMultipartUpload(ctx context.Context, req *v1.Comp
 		}, nil
 	}
 
+	// 验证分类
+	if err := s.categoryUc.ValidateSelectable(ctx, req.CategoryId); err != nil {
+		return &v1.PublishVideoResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  "invalid category",
+			},
+		}, nil
+	}
+
 	// 转换分片信息
 	parts := make([]storage.PartInfo, len(req.Parts))
 	for i, part := range req.Parts {
@@ -497,7 +1574,7 @@ func (s *VideoService) CompleteMultipartUpload(ctx context.Context, req *v1.Comp
 	}
 
 	// 完成上传
-	video, err := s.videoUc.CompleteMultipartUpload(ctx, req.UploadId, parts, req.Title, userID)
+	video, err := s.videoUc.CompleteMultipartUpload(ctx, req.UploadId, parts, req.Title, req.CategoryId, userID, req.Visibility, req.PublishAt, req.Checksum)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("complete multipart upload failed: %v", err)
 		return &v1.PublishVideoResponse{
@@ -507,6 +1584,7 @@ func (s *VideoService) CompleteMultipartUpload(ctx context.Context, req *v1.Comp
 			},
 		}, nil
 	}
+	s.referralUc.Activate(ctx, userID)
 
 	return &v1.PublishVideoResponse{
 		Base: &commonv1.BaseResponse{
@@ -525,7 +1603,7 @@ func (s *VideoService) AbortMultipartUpload(ctx context.Context, req *v1.AbortMu
 	s.log.WithContext(ctx).Info("abort multipart upload request")
 
 	// 验证Token
-	_, ok := middleware.GetUserIDFromToken(ctx, req.Token)
+	_, ok := reqctx.UserID(ctx)
 	if !ok {
 		return nil, utils.ErrTokenInvalid
 	}
@@ -544,7 +1622,7 @@ func (s *VideoService) ListUploadedParts(ctx context.Context, req *v1.ListUpload
 	s.log.WithContext(ctx).Info("list uploaded parts request")
 
 	// 验证Token
-	_, ok := middleware.GetUserIDFromToken(ctx, req.Token)
+	_, ok := reqctx.UserID(ctx)
 	if !ok {
 		return &v1.ListUploadedPartsResponse{
 			Base: &commonv1.BaseResponse{
@@ -591,14 +1669,71 @@ func (s *VideoService) ListUploadedParts(ctx context.Context, req *v1.ListUpload
 	}, nil
 }
 
-// GetVideoInfo gRPC内部调用 - 获取视频信息
+// VerifyUpload 查询分片上传的校验状态
+func (s *VideoService) VerifyUpload(ctx context.Context, req *v1.VerifyUploadRequest) (*v1.VerifyUploadResponse, error) {
+	// 验证Token
+	if _, ok := reqctx.UserID(ctx); !ok {
+		return &v1.VerifyUploadResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(commonv1.ErrorCode_TOKEN_INVALID),
+				StatusMsg:  "invalid token",
+			},
+		}, nil
+	}
+
+	parts, err := s.videoUc.VerifyUpload(ctx, req.UploadId)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("verify upload failed: %v", err)
+		return &v1.VerifyUploadResponse{
+			Base: &commonv1.BaseResponse{
+				StatusCode: int32(utils.GetErrorCode(err)),
+				StatusMsg:  "verify upload failed",
+			},
+		}, nil
+	}
+
+	data := &v1.VerifyUploadData{
+		Parts:       make([]*v1.PartChecksum, 0, len(parts)),
+		AllVerified: len(parts) > 0,
+	}
+	for _, part := range parts {
+		data.Parts = append(data.Parts, &v1.PartChecksum{
+			PartNumber: int32(part.PartNumber),
+			Size:       part.Size,
+			Algorithm:  part.Algorithm,
+			Expected:   part.Expected,
+			Actual:     part.Actual,
+			Status:     part.Status,
+			UploadedAt: part.UploadedAt.Unix(),
+		})
+		if part.Status == biz.PartChecksumStatusMismatch {
+			data.MismatchedParts++
+		}
+		if part.Status != biz.PartChecksumStatusVerified {
+			data.AllVerified = false
+		}
+	}
+
+	return &v1.VerifyUploadResponse{
+		Base: &commonv1.BaseResponse{
+			StatusCode: 0,
+			StatusMsg:  "success",
+		},
+		Data: data,
+	}, nil
+}
+
+// GetVideoInfo gRPC内部调用 - 获取视频信息，访问者按可见范围无权查看时按视频不存在返回
 func (s *VideoService) GetVideoInfo(ctx context.Context, req *v1.GetVideoInfoRequest) (*v1.GetVideoInfoResponse, error) {
 	video, err := s.videoUc.GetVideo(ctx, req.VideoId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.relationUc.CheckVideoVisible(ctx, req.ViewerId, video); err != nil {
+		return nil, err
+	}
 
-	videoItem, err := s.buildVideoResponse(ctx, video, 0)
+	videoItem, err := s.buildVideoResponse(ctx, video, req.ViewerId)
 	if err != nil {
 		return nil, err
 	}
@@ -608,21 +1743,20 @@ func (s *VideoService) GetVideoInfo(ctx context.Context, req *v1.GetVideoInfoReq
 	}, nil
 }
 
-// GetVideosInfo gRPC内部调用 - 批量获取视频信息
+// GetVideosInfo gRPC内部调用 - 批量获取视频信息，访问者按可见范围无权查看的视频不返回
 func (s *VideoService) GetVideosInfo(ctx context.Context, req *v1.GetVideosInfoRequest) (*v1.GetVideosInfoResponse, error) {
 	videos, err := s.videoUc.GetVideos(ctx, req.VideoIds)
 	if err != nil {
 		return nil, err
 	}
+	videos, err = s.relationUc.FilterVideosByVisibility(ctx, req.ViewerId, videos)
+	if err != nil {
+		return nil, err
+	}
 
-	videoList := make([]*commonv1.Video, 0, len(videos))
-	for _, video := range videos {
-		videoItem, err := s.buildVideoResponse(ctx, video, 0)
-		if err != nil {
-			s.log.WithContext(ctx).Warnf("build video response failed: %v", err)
-			continue
-		}
-		videoList = append(videoList, videoItem)
+	videoList, err := s.buildVideoResponses(ctx, videos, req.ViewerId, "")
+	if err != nil {
+		return nil, err
 	}
 
 	return &v1.GetVideosInfoResponse{
@@ -655,7 +1789,7 @@ func (s *VideoService) UpdateVideoStats(ctx context.Context, req *v1.UpdateVideo
 }
 
 // handleVideoUpload 处理视频上传
-func (s *VideoService) handleVideoUpload(ctx context.Context, userID int64, title string, fileHeader *multipart.FileHeader) (*domain.Video, error) {
+func (s *VideoService) handleVideoUpload(ctx context.Context, userID int64, title string, categoryID int64, visibility int32, publishAt int64, fileHeader *multipart.FileHeader) (*domain.Video, error) {
 	s.log.WithContext(ctx).Infof("handling video upload: user_id=%d, filename=%s, size=%d",
 		userID, fileHeader.Filename, fileHeader.Size)
 
@@ -669,6 +1803,11 @@ func (s *VideoService) handleVideoUpload(ctx context.Context, userID int64, titl
 		return nil, err
 	}
 
+	// 检查上传配额
+	if err := s.quotaUc.CheckUpload(ctx, userID, fileHeader.Size); err != nil {
+		return nil, err
+	}
+
 	// 打开文件
 	file, err := fileHeader.Open()
 	if err != nil {
@@ -694,11 +1833,17 @@ func (s *VideoService) handleVideoUpload(ctx context.Context, userID int64, titl
 		return nil, err
 	}
 
+	// 写入存储前扫描病毒
+	quarantined, err := s.scanUc.Scan(ctx, userID, fileHeader.Filename, data)
+	if err != nil {
+		return nil, err
+	}
+
 	// 生成唯一文件名
 	filename := utils.GenerateVideoFilename(fileHeader.Filename)
 
 	// 发布视频
-	video, err := s.videoUc.PublishVideo(ctx, userID, title, data, filename)
+	video, err := s.videoUc.PublishVideo(ctx, userID, title, categoryID, visibility, publishAt, data, filename, quarantined)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("publish video failed: %v", err)
 		return nil, err
@@ -734,47 +1879,204 @@ func (s *VideoService) validateVideoContent(ctx context.Context, file multipart.
 	return nil
 }
 
-// buildVideoResponse 构建视频响应
+// buildVideoResponse 构建单个视频响应，作者不存在时返回 ErrUserNotFound
 func (s *VideoService) buildVideoResponse(ctx context.Context, video *domain.Video, currentUserID int64) (*commonv1.Video, error) {
-	// 获取作者信息
-	author, err := s.userUc.GetUser(ctx, video.AuthorID)
+	items, err := s.buildVideoResponses(ctx, []*domain.Video{video}, currentUserID, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, biz.ErrUserNotFound
+	}
+	return items[0], nil
+}
+
+// buildVideoResponses 批量构建视频响应，作者信息、点赞和关注状态各查询一次。
+// 作者不存在的视频被跳过，点赞和关注状态查询失败时按未点赞、未关注返回
+func (s *VideoService) buildVideoResponses(ctx context.Context, videos []*domain.Video, currentUserID int64, quality string) ([]*commonv1.Video, error) {
+	if len(videos) == 0 {
+		return []*commonv1.Video{}, nil
+	}
+
+	videoIDs := make([]int64, 0, len(videos))
+	authorIDs := make([]int64, 0, len(videos))
+	followIDs := make([]int64, 0, len(videos))
+	seen := make(map[int64]bool, len(videos))
+	for _, video := range videos {
+		videoIDs = append(videoIDs, video.ID)
+		if seen[video.AuthorID] {
+			continue
+		}
+		seen[video.AuthorID] = true
+		authorIDs = append(authorIDs, video.AuthorID)
+		if video.AuthorID != currentUserID {
+			followIDs = append(followIDs, video.AuthorID)
+		}
+	}
+
+	authors, err := s.userUc.GetUsers(ctx, authorIDs)
 	if err != nil {
 		return nil, err
 	}
+	s.countsUc.Apply(ctx, authors...)
+	authorMap := make(map[int64]*biz.User, len(authors))
+	for _, author := range authors {
+		authorMap[author.ID] = author
+	}
 
-	// 检查是否已点赞（简化实现）
-	isFavorite := false
-	if currentUserID > 0 {
-		// TODO: 实现点赞状态检查
+	favoriteMap, err := s.favoriteUc.BatchIsFavorite(ctx, currentUserID, videoIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("batch check favorite status failed: %v", err)
+		favoriteMap = map[int64]bool{}
+	}
+	followMap, err := s.relationUc.BatchIsFollowing(ctx, currentUserID, followIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("batch check follow status failed: %v", err)
+		followMap = map[int64]bool{}
 	}
 
-	// 检查是否已关注（简化实现）
-	isFollow := false
-	if currentUserID > 0 && currentUserID != video.AuthorID {
-		// TODO: 实现关注状态检查
+	items := make([]*commonv1.Video, 0, len(videos))
+	for _, video := range videos {
+		author, ok := authorMap[video.AuthorID]
+		if !ok {
+			s.log.WithContext(ctx).Warnf("video author not found: video=%d author=%d", video.ID, video.AuthorID)
+			continue
+		}
+		item := convertToCommonVideo(video, author, favoriteMap[video.ID], followMap[video.AuthorID])
+		item.PlayUrl = video.PlayURLFor(quality)
+		items = append(items, deliverVideoURLs(s.cdn, item))
 	}
+	return items, nil
+}
 
+// convertToCommonVideo 转换为通用视频信息
+func convertToCommonVideo(video *domain.Video, author *biz.User, isFavorite, isFollow bool) *commonv1.Video {
 	return &commonv1.Video{
-		Id: video.ID,
-		Author: &commonv1.User{
-			Id:              author.ID,
-			Name:            author.Nickname,
-			FollowCount:     int64(author.FollowCount),
-			FollowerCount:   int64(author.FollowerCount),
-			IsFollow:        isFollow,
-			Avatar:          author.Avatar,
-			BackgroundImage: author.BackgroundImage,
-			Signature:       author.Signature,
-			TotalFavorited:  author.TotalFavorited,
-			WorkCount:       int64(author.WorkCount),
-			FavoriteCount:   int64(author.FavoriteCount),
-		},
-		PlayUrl:       video.PlayURL,
-		CoverUrl:      video.CoverURL,
-		FavoriteCount: video.FavoriteCount,
-		CommentCount:  video.CommentCount,
-		IsFavorite:    isFavorite,
-		Title:         video.Title,
-		CreatedAt:     video.CreatedAt.Unix(),
-	}, nil
+		Id:              video.ID,
+		Author:          convertToCommonUser(author, isFollow),
+		PlayUrl:         video.PlayURL,
+		PlayUrls:        video.PlayURLs,
+		HlsUrl:          video.HLSURL,
+		CategoryId:      video.CategoryID,
+		Duration:        video.Duration,
+		CoverUrl:        video.CoverURL,
+		DynamicCoverUrl: video.DynamicCoverURL,
+		FavoriteCount:   video.FavoriteCount,
+		CommentCount:    video.CommentCount,
+		ShareCount:      video.ShareCount,
+		IsFavorite:      isFavorite,
+		Title:           video.Title,
+		CreatedAt:       video.CreatedAt.Unix(),
+		TitleEntities:   convertTextEntities(video.Title),
+		Visibility:      videoVisibility(video),
+		PublishAt:       videoPublishAt(video),
+	}
+}
+
+// deliverVideoURLs 将播放地址和封面地址（含动态封面）改写为CDN地址，开启签名时附加过期签名
+func deliverVideoURLs(cdn *storage.CDN, video *commonv1.Video) *commonv1.Video {
+	video.PlayUrl = cdn.URL(video.PlayUrl)
+	video.HlsUrl = cdn.URL(video.HlsUrl)
+	video.CoverUrl = cdn.URL(video.CoverUrl)
+	video.DynamicCoverUrl = cdn.URL(video.DynamicCoverUrl)
+	if len(video.PlayUrls) > 0 {
+		// PlayUrls 与缓存中的领域对象共用同一个map，改写前先复制
+		playUrls := make(map[string]string, len(video.PlayUrls))
+		for quality, url := range video.PlayUrls {
+			playUrls[quality] = cdn.URL(url)
+		}
+		video.PlayUrls = playUrls
+	}
+	return video
+}
+
+// videoPublishAt 草稿的计划发布时间
+func videoPublishAt(video *domain.Video) int64 {
+	if video.PublishAt == nil {
+		return 0
+	}
+	return video.PublishAt.Unix()
+}
+
+// videoVisibility 可见范围，未设置的旧数据按公开返回
+func videoVisibility(video *domain.Video) int32 {
+	if video.IsPublic() {
+		return domain.VideoVisibilityPublic
+	}
+	return video.Visibility
+}
+
+// convertTakedown 转换下架记录，管理后台和创作者接口共用
+func convertTakedown(takedown *biz.VideoTakedown) *commonv1.VideoTakedown {
+	t := &commonv1.VideoTakedown{
+		Id:           takedown.ID,
+		VideoId:      takedown.VideoID,
+		AuthorId:     takedown.AuthorID,
+		Category:     takedown.Category,
+		Reason:       takedown.Reason,
+		Status:       takedown.Status,
+		LegalHold:    takedown.LegalHold,
+		AppealReason: takedown.AppealReason,
+		DecisionNote: takedown.DecisionNote,
+		CreatedAt:    takedown.CreatedAt.Unix(),
+	}
+	if takedown.AppealedAt != nil {
+		t.AppealedAt = takedown.AppealedAt.Unix()
+	}
+	if takedown.DecidedAt != nil {
+		t.DecidedAt = takedown.DecidedAt.Unix()
+	}
+	return t
+}
+
+func convertTakedowns(takedowns []*biz.VideoTakedown) []*commonv1.VideoTakedown {
+	result := make([]*commonv1.VideoTakedown, 0, len(takedowns))
+	for _, takedown := range takedowns {
+		result = append(result, convertTakedown(takedown))
+	}
+	return result
+}
+
+// convertCategory 转换视频分类，名称按请求语言选择
+func convertCategory(category *biz.Category, locale string) *commonv1.VideoCategory {
+	return &commonv1.VideoCategory{
+		Id:        category.ID,
+		Slug:      category.Slug,
+		Name:      category.Name(locale),
+		Names:     category.Names,
+		SortOrder: category.SortOrder,
+		Status:    category.Status,
+	}
+}
+
+// convertCategories 批量转换视频分类
+func convertCategories(categories []*biz.Category, locale string) []*commonv1.VideoCategory {
+	result := make([]*commonv1.VideoCategory, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, convertCategory(category, locale))
+	}
+	return result
+}
+
+// convertTextEntities 解析纯文本中的提及、话题和链接实体
+func convertTextEntities(plain string) []*commonv1.TextEntity {
+	text := richtext.Extract(plain)
+	entities := make([]*commonv1.TextEntity, 0, len(text.Entities))
+	for _, e := range text.Entities {
+		entities = append(entities, &commonv1.TextEntity{
+			Type:   string(e.Type),
+			Offset: int32(e.Offset),
+			Length: int32(e.Length),
+			Value:  e.Value,
+		})
+	}
+	return entities
+}
+
+// quotaErrorMsg 配额超限时返回具体原因，便于客户端提示，其他错误返回默认信息
+func quotaErrorMsg(err error, fallback string) string {
+	if errors.Is(err, biz.ErrUploadQuotaExceeded) || errors.Is(err, biz.ErrStorageQuotaExceeded) {
+		return errors.FromError(err).Message
+	}
+	return fallback
 }
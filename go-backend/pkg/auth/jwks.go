@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"go-backend/pkg/security"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// 签名算法。HS256 的 Secret 为共享密钥，RS256 和 EdDSA 的 Secret 为 PKCS#8 PEM 格式的私钥，公钥通过 JWKS 发布
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const (
+	hmacSecretLength = 48
+	rsaKeyBits       = 2048
+)
+
+// JWK JSON Web Key，只包含公钥参数
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA 公钥
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519 公钥
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// GenerateSigningKey 生成指定算法的新密钥，algorithm 为空时使用 HS256
+func GenerateSigningKey(id, algorithm string) (*SigningKey, error) {
+	key := &SigningKey{ID: id, Algorithm: algorithm}
+	switch key.algorithm() {
+	case AlgorithmHS256:
+		secret, err := security.GenerateRandomString(hmacSecretLength)
+		if err != nil {
+			return nil, err
+		}
+		key.Secret = secret
+		return key, nil
+	case AlgorithmRS256:
+		private, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		return key, key.setPrivateKey(private)
+	case AlgorithmEdDSA:
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return key, key.setPrivateKey(private)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+}
+
+// setPrivateKey 将私钥编码为 PEM 写入 Secret
+func (k *SigningKey) setPrivateKey(private crypto.Signer) error {
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return err
+	}
+	k.Secret = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	k.private = private
+	return nil
+}
+
+func (k *SigningKey) algorithm() string {
+	if k.Algorithm == "" {
+		return AlgorithmHS256
+	}
+	return k.Algorithm
+}
+
+// asymmetric 是否为公私钥算法
+func (k *SigningKey) asymmetric() bool {
+	return k.algorithm() != AlgorithmHS256
+}
+
+// parse 解析非对称密钥的私钥并校验与算法匹配
+func (k *SigningKey) parse() error {
+	switch k.algorithm() {
+	case AlgorithmHS256:
+		return nil
+	case AlgorithmRS256, AlgorithmEdDSA:
+	default:
+		return fmt.Errorf("signing key %s: unsupported algorithm %q", k.ID, k.Algorithm)
+	}
+
+	block, _ := pem.Decode([]byte(k.Secret))
+	if block == nil {
+		return fmt.Errorf("signing key %s: invalid PEM private key", k.ID)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("signing key %s: %w", k.ID, err)
+	}
+
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.algorithm() == AlgorithmRS256 {
+			k.private = private
+			return nil
+		}
+	case ed25519.PrivateKey:
+		if k.algorithm() == AlgorithmEdDSA {
+			k.private = private
+			return nil
+		}
+	}
+	return fmt.Errorf("signing key %s: private key type %T does not match algorithm %s", k.ID, parsed, k.algorithm())
+}
+
+// method 签发 Access Token 使用的签名算法
+func (k *SigningKey) method() jwt.SigningMethod {
+	switch k.algorithm() {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA
+	default:
+		return jwt.SigningMethodHS256
+	}
+}
+
+// signKey 签发 Access Token 使用的密钥
+func (k *SigningKey) signKey() interface{} {
+	if k.asymmetric() {
+		return k.private
+	}
+	return []byte(k.Secret)
+}
+
+// verifyKey 验证 Access Token 使用的密钥
+func (k *SigningKey) verifyKey() interface{} {
+	if k.asymmetric() {
+		return k.private.Public()
+	}
+	return []byte(k.Secret)
+}
+
+// jwk 公钥的 JWK 表示，对称密钥不发布
+func (k *SigningKey) jwk() (JWK, bool) {
+	jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.algorithm()}
+	switch public := k.private.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	default:
+		return JWK{}, false
+	}
+	return jwk, true
+}
+
+// JWKS 返回 now 时可用于验证的非对称密钥的公钥，包括尚未生效的密钥，
+// 下游在新密钥开始签发前即可缓存到它
+func (k *Keyring) JWKS(now time.Time) *JWKS {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	set := &JWKS{Keys: []JWK{}}
+	for _, key := range k.keys {
+		if !key.asymmetric() || key.retired(now) {
+			continue
+		}
+		if jwk, ok := key.jwk(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+	return set
+}
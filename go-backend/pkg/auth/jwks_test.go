@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// publicKeyFromJWK 按 JWK 还原公钥，模拟下游服务的验证方式
+func publicKeyFromJWK(t *testing.T, jwk JWK) interface{} {
+	t.Helper()
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		require.NoError(t, err)
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		require.NoError(t, err)
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		require.NoError(t, err)
+		return ed25519.PublicKey(x)
+	}
+	t.Fatalf("unexpected kty %s", jwk.Kty)
+	return nil
+}
+
+func TestJWTManager_AsymmetricKeys(t *testing.T) {
+	for _, alg := range []string{AlgorithmRS256, AlgorithmEdDSA} {
+		t.Run(alg, func(t *testing.T) {
+			key, err := GenerateSigningKey("k1", alg)
+			require.NoError(t, err)
+			keyring, err := NewKeyring(time.Hour, key)
+			require.NoError(t, err)
+			jwtManager := NewJWTManagerWithKeyring(keyring, time.Hour)
+
+			pair, err := jwtManager.GenerateTokenPair(1, "alice")
+			require.NoError(t, err)
+			claims, err := jwtManager.VerifyToken(pair.AccessToken)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), claims.UserID)
+			_, err = jwtManager.VerifyRefreshToken(pair.RefreshToken)
+			require.NoError(t, err)
+
+			// 下游只凭 JWKS 中的公钥即可验证 Access Token
+			set := keyring.JWKS(time.Now())
+			require.Len(t, set.Keys, 1)
+			assert.Equal(t, "k1", set.Keys[0].Kid)
+			assert.Equal(t, alg, set.Keys[0].Alg)
+			public := publicKeyFromJWK(t, set.Keys[0])
+			_, err = jwt.ParseWithClaims(pair.AccessToken, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+				return public, nil
+			})
+			assert.NoError(t, err)
+
+			// Refresh Token 不能用公钥验证
+			_, err = jwt.ParseWithClaims(pair.RefreshToken, &RefreshClaims{}, func(token *jwt.Token) (interface{}, error) {
+				return public, nil
+			})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWTManager_RejectsAlgorithmMismatch(t *testing.T) {
+	key, err := GenerateSigningKey("k1", AlgorithmRS256)
+	require.NoError(t, err)
+	keyring, err := NewKeyring(time.Hour, key)
+	require.NoError(t, err)
+	jwtManager := NewJWTManagerWithKeyring(keyring, time.Hour)
+
+	// 用私钥 PEM 当作 HMAC 密钥伪造同 kid 的令牌
+	claims := &Claims{
+		UserID: 1,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = "k1"
+	forged, err := token.SignedString([]byte(key.Secret))
+	require.NoError(t, err)
+
+	_, err = jwtManager.VerifyToken(forged)
+	assert.Error(t, err)
+}
+
+func TestKeyring_JWKS(t *testing.T) {
+	now := time.Now()
+	rsaKey, err := GenerateSigningKey("rsa", AlgorithmRS256)
+	require.NoError(t, err)
+	edKey, err := GenerateSigningKey("ed", AlgorithmEdDSA)
+	require.NoError(t, err)
+	edKey.ActivateAt = now.Add(time.Minute)
+	retired, err := GenerateSigningKey("retired", AlgorithmEdDSA)
+	require.NoError(t, err)
+	retired.RetireAt = now.Add(-time.Minute)
+
+	keyring, err := NewKeyring(time.Hour, rsaKey, edKey, retired, &SigningKey{ID: "hmac", Secret: "s"})
+	require.NoError(t, err)
+
+	// 尚未生效的密钥也要发布，已退役的和对称密钥不发布
+	set := keyring.JWKS(now)
+	require.Len(t, set.Keys, 2)
+	assert.Equal(t, "ed", set.Keys[0].Kid)
+	assert.Equal(t, "OKP", set.Keys[0].Kty)
+	assert.Equal(t, "Ed25519", set.Keys[0].Crv)
+	assert.Equal(t, "rsa", set.Keys[1].Kid)
+	assert.Equal(t, "RSA", set.Keys[1].Kty)
+	assert.Equal(t, "AQAB", set.Keys[1].E)
+}
+
+func TestKeyring_LoadSkipsInvalidKeys(t *testing.T) {
+	keyring, err := NewKeyring(time.Hour, &SigningKey{ID: "k1", Secret: "s"})
+	require.NoError(t, err)
+
+	edKey, err := GenerateSigningKey("ed", AlgorithmEdDSA)
+	require.NoError(t, err)
+	err = keyring.Load([]*SigningKey{
+		{ID: "k1", Secret: "s"},
+		{ID: "bad-pem", Algorithm: AlgorithmRS256, Secret: "not a pem"},
+		{ID: "mismatch", Algorithm: AlgorithmRS256, Secret: edKey.Secret},
+		{ID: "unknown", Algorithm: "HS512", Secret: "s"},
+	})
+	assert.Error(t, err)
+
+	_, ok := keyring.Lookup("k1", time.Now())
+	assert.True(t, ok)
+	for _, kid := range []string{"bad-pem", "mismatch", "unknown"} {
+		_, ok := keyring.Lookup(kid, time.Now())
+		assert.False(t, ok, kid)
+	}
+}
@@ -14,14 +14,25 @@ type Claims struct {
 	UserID   int64  `json:"user_id"`
 	Username string `json:"username"`
 	TokenID  string `json:"token_id"`
+	// Roles 签发时的有效角色名，PermVersion 为签发时用户角色绑定的权限版本。
+	// 版本与服务端一致时可直接按 Roles 鉴权，否则回退到完整的权限检查
+	Roles       []string `json:"roles,omitempty"`
+	PermVersion int64    `json:"perm_version,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// RoleClaimsProvider 签发 Access Token 时提供用户的角色名和权限版本，ok 为 false 时不写入
+type RoleClaimsProvider interface {
+	RoleClaims(userID int64) (roles []string, version int64, ok bool)
+}
+
 // RefreshClaims Refresh Token Claims
 type RefreshClaims struct {
 	UserID   int64  `json:"user_id"`
 	Username string `json:"username"`
 	TokenID  string `json:"token_id"`
+	// FamilyID 轮换族ID，同一次登录轮换出的Refresh Token属于同一族
+	FamilyID string `json:"family_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -31,33 +42,56 @@ type TokenPair struct {
 	RefreshToken  string    `json:"refresh_token"`
 	AccessExpiry  time.Time `json:"access_expiry"`
 	RefreshExpiry time.Time `json:"refresh_expiry"`
+	FamilyID      string    `json:"-"`
 }
 
+// RefreshTokenExpiry Refresh Token有效期
+const RefreshTokenExpiry = 7 * 24 * time.Hour
+
 // JWTManager JWT管理器
 type JWTManager struct {
-	accessSecret   string
-	refreshSecret  string
+	keyring        *Keyring
 	accessExpiry   time.Duration
 	refreshExpiry  time.Duration
 	tokenBlacklist TokenBlacklist
+	roleClaims     RoleClaimsProvider
 }
 
-// NewJWTManager 创建JWT管理器
+// NewJWTManager 创建使用单个密钥的JWT管理器，签发的令牌 kid 为 LegacyKeyID
 func NewJWTManager(accessSecret string, accessExpiry time.Duration) *JWTManager {
+	// HS256 密钥无需解析，直接构造密钥环
+	keyring := &Keyring{
+		keys:        map[string]*SigningKey{LegacyKeyID: {ID: LegacyKeyID, Secret: accessSecret}},
+		retireAfter: RefreshTokenExpiry,
+	}
+	return NewJWTManagerWithKeyring(keyring, accessExpiry)
+}
+
+// NewJWTManagerWithKeyring 创建使用密钥环的JWT管理器，密钥轮换只需更新密钥环
+func NewJWTManagerWithKeyring(keyring *Keyring, accessExpiry time.Duration) *JWTManager {
 	return &JWTManager{
-		accessSecret:   accessSecret,
-		refreshSecret:  accessSecret + "_refresh", // 简化处理，实际应该用不同密钥
+		keyring:        keyring,
 		accessExpiry:   accessExpiry,
-		refreshExpiry:  7 * 24 * time.Hour, // 7天
+		refreshExpiry:  RefreshTokenExpiry,
 		tokenBlacklist: NewMemoryTokenBlacklist(),
 	}
 }
 
+// Keyring 返回签名密钥环
+func (j *JWTManager) Keyring() *Keyring {
+	return j.keyring
+}
+
 // SetTokenBlacklist 设置Token黑名单
 func (j *JWTManager) SetTokenBlacklist(blacklist TokenBlacklist) {
 	j.tokenBlacklist = blacklist
 }
 
+// SetRoleClaimsProvider 设置角色声明来源，未设置时 Access Token 不携带角色
+func (j *JWTManager) SetRoleClaimsProvider(provider RoleClaimsProvider) {
+	j.roleClaims = provider
+}
+
 // GenerateToken 生成单个Access Token (兼容现有代码)
 func (j *JWTManager) GenerateToken(userID int64, username string) (string, error) {
 	tokenID, err := security.GenerateTokenID()
@@ -75,13 +109,23 @@ func (j *JWTManager) GenerateToken(userID int64, username string) (string, error
 			Issuer:    "tiktok-service",
 		},
 	}
+	j.setRoleClaims(claims)
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.accessSecret))
+	return j.sign(claims, false)
 }
 
-// GenerateTokenPair 生成Token对
+// GenerateTokenPair 生成Token对，Refresh Token属于新的轮换族
 func (j *JWTManager) GenerateTokenPair(userID int64, username string) (*TokenPair, error) {
+	familyID, err := security.GenerateTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	return j.GenerateTokenPairInFamily(userID, username, familyID)
+}
+
+// GenerateTokenPairInFamily 在指定轮换族内生成Token对，用于轮换Refresh Token
+func (j *JWTManager) GenerateTokenPairInFamily(userID int64, username, familyID string) (*TokenPair, error) {
 	accessTokenID, err := security.GenerateTokenID()
 	if err != nil {
 		return nil, err
@@ -107,9 +151,9 @@ func (j *JWTManager) GenerateTokenPair(userID int64, username string) (*TokenPai
 			Issuer:    "tiktok-service",
 		},
 	}
+	j.setRoleClaims(accessClaims)
 
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessTokenString, err := accessToken.SignedString([]byte(j.accessSecret))
+	accessTokenString, err := j.sign(accessClaims, false)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +163,7 @@ func (j *JWTManager) GenerateTokenPair(userID int64, username string) (*TokenPai
 		UserID:   userID,
 		Username: username,
 		TokenID:  refreshTokenID,
+		FamilyID: familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(refreshExpiry),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -126,8 +171,7 @@ func (j *JWTManager) GenerateTokenPair(userID int64, username string) (*TokenPai
 		},
 	}
 
-	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
-	refreshTokenString, err := refreshToken.SignedString([]byte(j.refreshSecret))
+	refreshTokenString, err := j.sign(refreshClaims, true)
 	if err != nil {
 		return nil, err
 	}
@@ -137,17 +181,13 @@ func (j *JWTManager) GenerateTokenPair(userID int64, username string) (*TokenPai
 		RefreshToken:  refreshTokenString,
 		AccessExpiry:  accessExpiry,
 		RefreshExpiry: refreshExpiry,
+		FamilyID:      familyID,
 	}, nil
 }
 
 // VerifyToken 验证Access Token (兼容现有代码)
 func (j *JWTManager) VerifyToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return []byte(j.accessSecret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, j.keyFunc(false))
 
 	if err != nil {
 		return nil, err
@@ -166,12 +206,7 @@ func (j *JWTManager) VerifyToken(tokenString string) (*Claims, error) {
 
 // VerifyRefreshToken 验证Refresh Token
 func (j *JWTManager) VerifyRefreshToken(tokenString string) (*RefreshClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &RefreshClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return []byte(j.refreshSecret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &RefreshClaims{}, j.keyFunc(true))
 
 	if err != nil {
 		return nil, err
@@ -208,8 +243,8 @@ func (j *JWTManager) RefreshTokenPair(refreshTokenString string) (*TokenPair, er
 	// 将旧的refresh token加入黑名单
 	j.tokenBlacklist.Add(refreshClaims.TokenID, time.Until(time.Unix(refreshClaims.ExpiresAt.Unix(), 0)))
 
-	// 生成新的Token对
-	return j.GenerateTokenPair(refreshClaims.UserID, refreshClaims.Username)
+	// 在同一轮换族内生成新的Token对
+	return j.GenerateTokenPairInFamily(refreshClaims.UserID, refreshClaims.Username, refreshClaims.FamilyID)
 }
 
 // RevokeToken 撤销Token
@@ -248,3 +283,66 @@ func (j *JWTManager) GetTokenID(tokenString string) (string, error) {
 	}
 	return claims.TokenID, nil
 }
+
+// setRoleClaims 写入角色和权限版本，没有有效角色时不写入，避免空角色的令牌跳过完整检查
+func (j *JWTManager) setRoleClaims(claims *Claims) {
+	if j.roleClaims == nil {
+		return
+	}
+	roles, version, ok := j.roleClaims.RoleClaims(claims.UserID)
+	if !ok || len(roles) == 0 {
+		return
+	}
+	claims.Roles = roles
+	claims.PermVersion = version
+}
+
+// sign 使用当前密钥签名，kid 写入令牌头。Access Token 按密钥的算法签名；
+// Refresh Token 只由本服务验证，始终使用 HS256
+func (j *JWTManager) sign(claims jwt.Claims, refresh bool) (string, error) {
+	key, err := j.keyring.Current(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	if refresh {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = key.ID
+		return token.SignedString(refreshSecret(key))
+	}
+
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.signKey())
+}
+
+// keyFunc 按令牌头的 kid 选择验证密钥，没有 kid 的令牌使用 LegacyKeyID。
+// 令牌的算法必须与密钥一致，防止用公钥当作 HMAC 密钥伪造令牌
+func (j *JWTManager) keyFunc(refresh bool) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			kid = LegacyKeyID
+		}
+		key, ok := j.keyring.Lookup(kid, time.Now())
+		if !ok {
+			return nil, errors.New("unknown signing key")
+		}
+
+		if refresh {
+			if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+				return nil, errors.New("invalid signing method")
+			}
+			return refreshSecret(key), nil
+		}
+		if token.Method.Alg() != key.method().Alg() {
+			return nil, errors.New("invalid signing method")
+		}
+		return key.verifyKey(), nil
+	}
+}
+
+// refreshSecret Refresh Token使用由签名密钥派生的独立密钥，Access Token不能当作Refresh Token使用
+func refreshSecret(key *SigningKey) []byte {
+	return []byte(key.Secret + "_refresh")
+}
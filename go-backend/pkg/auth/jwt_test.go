@@ -71,6 +71,13 @@ func TestJWTManager(t *testing.T) {
 		assert.NotEmpty(t, newTokenPair.RefreshToken)
 		assert.NotEqual(t, tokenPair.AccessToken, newTokenPair.AccessToken)
 		assert.NotEqual(t, tokenPair.RefreshToken, newTokenPair.RefreshToken)
+
+		// 轮换保持轮换族不变
+		assert.NotEmpty(t, tokenPair.FamilyID)
+		assert.Equal(t, tokenPair.FamilyID, newTokenPair.FamilyID)
+		claims, err := jwtManager.VerifyRefreshToken(newTokenPair.RefreshToken)
+		require.NoError(t, err)
+		assert.Equal(t, tokenPair.FamilyID, claims.FamilyID)
 	})
 
 	t.Run("RevokeToken", func(t *testing.T) {
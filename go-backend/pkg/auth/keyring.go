@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LegacyKeyID 未携带 kid 的令牌使用的密钥ID，对应只配置了单个密钥时签发的令牌
+const LegacyKeyID = "default"
+
+var ErrNoSigningKey = errors.New("no active signing key")
+
+// SigningKey 令牌签名密钥。ActivateAt 之前只用于验证，其他实例在此期间加载新密钥；
+// RetireAt 之后不再接受，零值表示未退役
+type SigningKey struct {
+	ID string
+	// Algorithm 为空时使用 HS256
+	Algorithm  string
+	Secret     string
+	ActivateAt time.Time
+	RetireAt   time.Time
+
+	// private 由 Secret 解析出的私钥，仅非对称算法使用
+	private crypto.Signer
+}
+
+// retired 密钥在 now 时是否已退役
+func (k *SigningKey) retired(now time.Time) bool {
+	return !k.RetireAt.IsZero() && !now.Before(k.RetireAt)
+}
+
+// Keyring 令牌签名密钥环。签发时使用已生效的密钥中最新的一个，验证时按令牌头的 kid 查找未退役的密钥
+type Keyring struct {
+	mu   sync.RWMutex
+	keys map[string]*SigningKey
+	// retireAfter 密钥被替换后继续用于验证的时长，不短于令牌的最长有效期
+	retireAfter time.Duration
+}
+
+// NewKeyring 创建密钥环，存在无法解析的密钥时返回错误
+func NewKeyring(retireAfter time.Duration, keys ...*SigningKey) (*Keyring, error) {
+	k := &Keyring{retireAfter: retireAfter}
+	if err := k.Load(keys); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// RetireAfter 密钥被替换后继续用于验证的时长
+func (k *Keyring) RetireAfter() time.Duration {
+	return k.retireAfter
+}
+
+// Load 用新的密钥集合整体替换当前密钥。无法解析的密钥被跳过并在返回的错误中列出，
+// 其余密钥仍然生效
+func (k *Keyring) Load(keys []*SigningKey) error {
+	m := make(map[string]*SigningKey, len(keys))
+	var errs []error
+	for _, key := range keys {
+		copied := *key
+		if err := copied.parse(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		m[key.ID] = &copied
+	}
+
+	k.mu.Lock()
+	k.keys = m
+	k.mu.Unlock()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("load signing keys: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Keys 返回全部密钥的副本，按生效时间排序
+func (k *Keyring) Keys() []*SigningKey {
+	k.mu.RLock()
+	keys := make([]*SigningKey, 0, len(k.keys))
+	for _, key := range k.keys {
+		copied := *key
+		keys = append(keys, &copied)
+	}
+	k.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ActivateAt.Equal(keys[j].ActivateAt) {
+			return keys[i].ID < keys[j].ID
+		}
+		return keys[i].ActivateAt.Before(keys[j].ActivateAt)
+	})
+	return keys
+}
+
+// Current 返回 now 时用于签发的密钥：优先选择未安排退役的密钥，其次选择生效时间最新的。
+// 轮换后新密钥生效前，即将退役的旧密钥继续签发
+func (k *Keyring) Current(now time.Time) (*SigningKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	var current *SigningKey
+	for _, key := range k.keys {
+		if key.ActivateAt.After(now) || key.retired(now) {
+			continue
+		}
+		if current == nil || preferForSigning(key, current) {
+			current = key
+		}
+	}
+	if current == nil {
+		return nil, ErrNoSigningKey
+	}
+	return current, nil
+}
+
+func preferForSigning(a, b *SigningKey) bool {
+	if a.RetireAt.IsZero() != b.RetireAt.IsZero() {
+		return a.RetireAt.IsZero()
+	}
+	if !a.ActivateAt.Equal(b.ActivateAt) {
+		return a.ActivateAt.After(b.ActivateAt)
+	}
+	return a.ID > b.ID
+}
+
+// Lookup 按 kid 查找 now 时可用于验证的密钥，尚未生效的密钥同样可用
+func (k *Keyring) Lookup(kid string, now time.Time) (*SigningKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok := k.keys[kid]
+	if !ok || key.retired(now) {
+		return nil, false
+	}
+	return key, true
+}
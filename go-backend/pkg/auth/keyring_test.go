@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring_Current(t *testing.T) {
+	now := time.Now()
+	keyring, err := NewKeyring(time.Hour,
+		&SigningKey{ID: "old", Secret: "s1", ActivateAt: now.Add(-2 * time.Hour), RetireAt: now.Add(time.Hour)},
+		&SigningKey{ID: "cur", Secret: "s2", ActivateAt: now.Add(-time.Hour)},
+		&SigningKey{ID: "next", Secret: "s3", ActivateAt: now.Add(time.Minute)},
+		&SigningKey{ID: "gone", Secret: "s4", ActivateAt: now.Add(-3 * time.Hour), RetireAt: now.Add(-time.Minute)},
+	)
+	require.NoError(t, err)
+
+	current, err := keyring.Current(now)
+	require.NoError(t, err)
+	assert.Equal(t, "cur", current.ID)
+
+	// 新密钥生效后接替签发
+	current, err = keyring.Current(now.Add(2 * time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "next", current.ID)
+
+	// 尚未生效的密钥可以验证，已退役的不行
+	_, ok := keyring.Lookup("next", now)
+	assert.True(t, ok)
+	_, ok = keyring.Lookup("old", now)
+	assert.True(t, ok)
+	_, ok = keyring.Lookup("gone", now)
+	assert.False(t, ok)
+	_, ok = keyring.Lookup("missing", now)
+	assert.False(t, ok)
+
+	// 新密钥生效前，即将退役的旧密钥继续签发
+	rotating, err := NewKeyring(time.Hour,
+		&SigningKey{ID: "old", Secret: "s1", RetireAt: now.Add(time.Hour)},
+		&SigningKey{ID: "new", Secret: "s2", ActivateAt: now.Add(time.Minute)},
+	)
+	require.NoError(t, err)
+	current, err = rotating.Current(now)
+	require.NoError(t, err)
+	assert.Equal(t, "old", current.ID)
+
+	empty, err := NewKeyring(time.Hour)
+	require.NoError(t, err)
+	_, err = empty.Current(now)
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+}
+
+func TestJWTManager_KeyRotation(t *testing.T) {
+	now := time.Now()
+	keyring, err := NewKeyring(time.Hour, &SigningKey{ID: "k1", Secret: "secret-1", ActivateAt: now.Add(-time.Hour)})
+	require.NoError(t, err)
+	jwtManager := NewJWTManagerWithKeyring(keyring, time.Hour)
+
+	oldPair, err := jwtManager.GenerateTokenPair(1, "alice")
+	require.NoError(t, err)
+
+	// 轮换：旧密钥进入退役期，新密钥立即生效
+	require.NoError(t, keyring.Load([]*SigningKey{
+		{ID: "k1", Secret: "secret-1", ActivateAt: now.Add(-time.Hour), RetireAt: now.Add(time.Hour)},
+		{ID: "k2", Secret: "secret-2", ActivateAt: now.Add(-time.Second)},
+	}))
+
+	newToken, err := jwtManager.GenerateToken(1, "alice")
+	require.NoError(t, err)
+	parsed, _, err := new(jwt.Parser).ParseUnverified(newToken, &Claims{})
+	require.NoError(t, err)
+	assert.Equal(t, "k2", parsed.Header["kid"])
+
+	_, err = jwtManager.VerifyToken(oldPair.AccessToken)
+	assert.NoError(t, err)
+	_, err = jwtManager.VerifyRefreshToken(oldPair.RefreshToken)
+	assert.NoError(t, err)
+
+	// 旧密钥退役后，旧令牌失效
+	require.NoError(t, keyring.Load([]*SigningKey{{ID: "k2", Secret: "secret-2", ActivateAt: now.Add(-time.Second)}}))
+	_, err = jwtManager.VerifyToken(oldPair.AccessToken)
+	assert.Error(t, err)
+	_, err = jwtManager.VerifyToken(newToken)
+	assert.NoError(t, err)
+}
+
+func TestJWTManager_LegacyTokenWithoutKid(t *testing.T) {
+	jwtManager := NewJWTManager("legacy-secret", time.Hour)
+
+	claims := &Claims{
+		UserID:   1,
+		Username: "alice",
+		TokenID:  "legacy",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("legacy-secret"))
+	require.NoError(t, err)
+
+	verified, err := jwtManager.VerifyToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), verified.UserID)
+}
+
+func TestJWTManager_AccessTokenNotAcceptedAsRefresh(t *testing.T) {
+	jwtManager := NewJWTManager("secret", time.Hour)
+
+	pair, err := jwtManager.GenerateTokenPair(1, "alice")
+	require.NoError(t, err)
+
+	_, err = jwtManager.VerifyRefreshToken(pair.AccessToken)
+	assert.Error(t, err)
+}
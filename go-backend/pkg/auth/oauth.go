@@ -0,0 +1,338 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrOAuthExchange 授权码无效、已使用或第三方接口返回错误
+var ErrOAuthExchange = errors.New("oauth code exchange failed")
+
+// 内置的第三方登录类型
+const (
+	OAuthTypeOAuth2 = "oauth2"
+	OAuthTypeGoogle = "google"
+	OAuthTypeGitHub = "github"
+	OAuthTypeWeChat = "wechat"
+)
+
+const (
+	oauthHTTPTimeout = 10 * time.Second
+	// oauthMaxResponseSize 第三方接口响应的最大读取字节数
+	oauthMaxResponseSize = 1 << 20
+)
+
+// OAuthIdentity 第三方账号身份
+type OAuthIdentity struct {
+	Provider      string
+	Subject       string // 第三方账号在该提供方内的唯一ID
+	Email         string
+	EmailVerified bool // 提供方确认过邮箱归属，才可据此关联已有账号
+	Name          string
+	AvatarURL     string
+}
+
+// OAuthProvider 第三方登录提供方，用客户端拿到的授权码换取账号身份
+type OAuthProvider interface {
+	Name() string
+	// Exchange 用授权码换取身份，redirectURI 必须与获取授权码时一致，为空时使用配置的地址
+	Exchange(ctx context.Context, code, redirectURI string) (*OAuthIdentity, error)
+}
+
+// OAuthProviders 按名称查找已配置的第三方登录提供方
+type OAuthProviders struct {
+	providers map[string]OAuthProvider
+}
+
+// NewOAuthProviders 创建第三方登录提供方集合，名称重复时后者覆盖前者
+func NewOAuthProviders(providers ...OAuthProvider) *OAuthProviders {
+	p := &OAuthProviders{providers: make(map[string]OAuthProvider, len(providers))}
+	for _, provider := range providers {
+		p.providers[provider.Name()] = provider
+	}
+	return p
+}
+
+// Get 获取提供方
+func (p *OAuthProviders) Get(name string) (OAuthProvider, bool) {
+	provider, ok := p.providers[name]
+	return provider, ok
+}
+
+// OAuth2Config 标准 OAuth2 授权码流程的配置。用户信息字段为 JSON 顶层字段名
+type OAuth2Config struct {
+	Name               string
+	ClientID           string
+	ClientSecret       string
+	TokenURL           string
+	UserInfoURL        string
+	RedirectURI        string
+	SubjectField       string
+	EmailField         string
+	EmailVerifiedField string // 为空时不信任邮箱
+	NameField          string
+	AvatarField        string
+}
+
+// OAuth2Preset 返回内置类型的默认地址和字段映射，未知类型返回 false
+func OAuth2Preset(kind string) (OAuth2Config, bool) {
+	switch kind {
+	case OAuthTypeGoogle:
+		return OAuth2Config{
+			TokenURL:           "https://oauth2.googleapis.com/token",
+			UserInfoURL:        "https://openidconnect.googleapis.com/v1/userinfo",
+			SubjectField:       "sub",
+			EmailField:         "email",
+			EmailVerifiedField: "email_verified",
+			NameField:          "name",
+			AvatarField:        "picture",
+		}, true
+	case OAuthTypeGitHub:
+		// GitHub 用户接口返回的是公开邮箱，不保证已验证
+		return OAuth2Config{
+			TokenURL:     "https://github.com/login/oauth/access_token",
+			UserInfoURL:  "https://api.github.com/user",
+			SubjectField: "id",
+			EmailField:   "email",
+			NameField:    "login",
+			AvatarField:  "avatar_url",
+		}, true
+	case OAuthTypeOAuth2, "":
+		return OAuth2Config{SubjectField: "sub", EmailField: "email", NameField: "name"}, true
+	default:
+		return OAuth2Config{}, false
+	}
+}
+
+// OAuth2Provider 标准 OAuth2 授权码流程：换取 Access Token 后请求用户信息接口
+type OAuth2Provider struct {
+	config OAuth2Config
+	client *http.Client
+}
+
+// NewOAuth2Provider 创建 OAuth2 提供方，client 为 nil 时使用带超时的默认客户端
+func NewOAuth2Provider(config OAuth2Config, client *http.Client) *OAuth2Provider {
+	if client == nil {
+		client = &http.Client{Timeout: oauthHTTPTimeout}
+	}
+	return &OAuth2Provider{config: config, client: client}
+}
+
+func (p *OAuth2Provider) Name() string {
+	return p.config.Name
+}
+
+func (p *OAuth2Provider) Exchange(ctx context.Context, code, redirectURI string) (*OAuthIdentity, error) {
+	redirectURI, err := resolveRedirectURI(p.config.RedirectURI, redirectURI)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		Error       string `json:"error"`
+	}
+	if err := doOAuthRequest(p.client, req, &token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("%w: %s", ErrOAuthExchange, token.Error)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, p.config.UserInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	var info map[string]interface{}
+	if err := doOAuthRequest(p.client, req, &info); err != nil {
+		return nil, err
+	}
+
+	identity := &OAuthIdentity{
+		Provider:  p.config.Name,
+		Subject:   oauthField(info, p.config.SubjectField),
+		Email:     oauthField(info, p.config.EmailField),
+		Name:      oauthField(info, p.config.NameField),
+		AvatarURL: oauthField(info, p.config.AvatarField),
+	}
+	if p.config.EmailVerifiedField != "" {
+		identity.EmailVerified = oauthField(info, p.config.EmailVerifiedField) == "true"
+	}
+	if identity.Subject == "" {
+		return nil, fmt.Errorf("%w: missing %s in user info", ErrOAuthExchange, p.config.SubjectField)
+	}
+	return identity, nil
+}
+
+// WeChatConfig 微信开放平台网页/移动应用登录配置
+type WeChatConfig struct {
+	Name        string
+	AppID       string
+	AppSecret   string
+	TokenURL    string // 默认 https://api.weixin.qq.com/sns/oauth2/access_token
+	UserInfoURL string // 默认 https://api.weixin.qq.com/sns/userinfo
+}
+
+// WeChatProvider 微信登录。换取令牌用 appid/secret 查询参数而不是标准表单，
+// 同一开放平台下的应用共用 unionid，优先作为账号ID，未绑定开放平台时退回 openid
+type WeChatProvider struct {
+	config WeChatConfig
+	client *http.Client
+}
+
+// NewWeChatProvider 创建微信登录提供方，client 为 nil 时使用带超时的默认客户端
+func NewWeChatProvider(config WeChatConfig, client *http.Client) *WeChatProvider {
+	if config.TokenURL == "" {
+		config.TokenURL = "https://api.weixin.qq.com/sns/oauth2/access_token"
+	}
+	if config.UserInfoURL == "" {
+		config.UserInfoURL = "https://api.weixin.qq.com/sns/userinfo"
+	}
+	if client == nil {
+		client = &http.Client{Timeout: oauthHTTPTimeout}
+	}
+	return &WeChatProvider{config: config, client: client}
+}
+
+func (p *WeChatProvider) Name() string {
+	return p.config.Name
+}
+
+// Exchange 微信授权码与应用绑定，不校验 redirectURI
+func (p *WeChatProvider) Exchange(ctx context.Context, code, redirectURI string) (*OAuthIdentity, error) {
+	query := url.Values{
+		"appid":      {p.config.AppID},
+		"secret":     {p.config.AppSecret},
+		"code":       {code},
+		"grant_type": {"authorization_code"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.TokenURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		OpenID      string `json:"openid"`
+		UnionID     string `json:"unionid"`
+		ErrCode     int    `json:"errcode"`
+		ErrMsg      string `json:"errmsg"`
+	}
+	if err := doOAuthRequest(p.client, req, &token); err != nil {
+		return nil, err
+	}
+	if token.ErrCode != 0 || token.AccessToken == "" || token.OpenID == "" {
+		return nil, fmt.Errorf("%w: errcode=%d %s", ErrOAuthExchange, token.ErrCode, token.ErrMsg)
+	}
+
+	query = url.Values{"access_token": {token.AccessToken}, "openid": {token.OpenID}}
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, p.config.UserInfoURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		Nickname   string `json:"nickname"`
+		HeadImgURL string `json:"headimgurl"`
+		UnionID    string `json:"unionid"`
+		ErrCode    int    `json:"errcode"`
+	}
+	if err := doOAuthRequest(p.client, req, &info); err != nil {
+		return nil, err
+	}
+	if info.ErrCode != 0 {
+		return nil, fmt.Errorf("%w: userinfo errcode=%d", ErrOAuthExchange, info.ErrCode)
+	}
+
+	subject := token.UnionID
+	if subject == "" {
+		subject = info.UnionID
+	}
+	if subject == "" {
+		subject = token.OpenID
+	}
+	return &OAuthIdentity{
+		Provider:  p.config.Name,
+		Subject:   subject,
+		Name:      info.Nickname,
+		AvatarURL: info.HeadImgURL,
+	}, nil
+}
+
+// resolveRedirectURI 客户端传入的回调地址必须与配置一致，防止授权码被发往其他地址后在此兑换
+func resolveRedirectURI(configured, requested string) (string, error) {
+	if requested == "" {
+		return configured, nil
+	}
+	if configured != "" && requested != configured {
+		return "", fmt.Errorf("%w: redirect_uri mismatch", ErrOAuthExchange)
+	}
+	return requested, nil
+}
+
+// doOAuthRequest 发送请求并解析 JSON 响应，非 2xx 状态视为兑换失败
+func doOAuthRequest(client *http.Client, req *http.Request, out interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oauthMaxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: %s returned %d", ErrOAuthExchange, req.URL.Host, resp.StatusCode)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("%w: decode response from %s: %v", ErrOAuthExchange, req.URL.Host, err)
+	}
+	return nil
+}
+
+// oauthField 读取用户信息的顶层字段，数字和布尔值转为字符串
+func oauthField(info map[string]interface{}, field string) string {
+	if field == "" {
+		return ""
+	}
+	switch v := info[field].(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	default:
+		return ""
+	}
+}
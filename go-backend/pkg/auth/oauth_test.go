@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOAuthServer 模拟提供方的令牌接口和用户信息接口
+func newOAuthServer(t *testing.T, token, userinfo http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", token)
+	mux.HandleFunc("/userinfo", userinfo)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestOAuth2Provider_Exchange(t *testing.T) {
+	server := newOAuthServer(t,
+		func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			if r.Method != http.MethodPost || r.PostForm.Get("code") != "good" || r.PostForm.Get("client_secret") != "secret" {
+				w.WriteHeader(http.StatusBadRequest)
+				writeJSON(w, map[string]string{"error": "invalid_grant"})
+				return
+			}
+			assert.Equal(t, "https://app.example.com/callback", r.PostForm.Get("redirect_uri"))
+			writeJSON(w, map[string]string{"access_token": "at-1"})
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer at-1", r.Header.Get("Authorization"))
+			writeJSON(w, map[string]interface{}{
+				"id":         12345678901,
+				"login":      "octocat",
+				"email":      "octo@example.com",
+				"avatar_url": "https://cdn.example.com/a.png",
+			})
+		},
+	)
+
+	config, ok := OAuth2Preset(OAuthTypeGitHub)
+	require.True(t, ok)
+	config.Name = "github"
+	config.ClientID = "client"
+	config.ClientSecret = "secret"
+	config.RedirectURI = "https://app.example.com/callback"
+	config.TokenURL = server.URL + "/token"
+	config.UserInfoURL = server.URL + "/userinfo"
+	provider := NewOAuth2Provider(config, server.Client())
+
+	identity, err := provider.Exchange(context.Background(), "good", "")
+	require.NoError(t, err)
+	assert.Equal(t, &OAuthIdentity{
+		Provider:  "github",
+		Subject:   "12345678901",
+		Email:     "octo@example.com",
+		Name:      "octocat",
+		AvatarURL: "https://cdn.example.com/a.png",
+	}, identity)
+
+	_, err = provider.Exchange(context.Background(), "bad", "")
+	assert.ErrorIs(t, err, ErrOAuthExchange)
+
+	// 回调地址必须与配置一致
+	_, err = provider.Exchange(context.Background(), "good", "https://evil.example.com/callback")
+	assert.ErrorIs(t, err, ErrOAuthExchange)
+}
+
+func TestOAuth2Provider_EmailVerified(t *testing.T) {
+	server := newOAuthServer(t,
+		func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, map[string]string{"access_token": "at-1"})
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, map[string]interface{}{"sub": "g-1", "email": "a@example.com", "email_verified": true})
+		},
+	)
+
+	config, ok := OAuth2Preset(OAuthTypeGoogle)
+	require.True(t, ok)
+	config.Name = "google"
+	config.TokenURL = server.URL + "/token"
+	config.UserInfoURL = server.URL + "/userinfo"
+
+	identity, err := NewOAuth2Provider(config, server.Client()).Exchange(context.Background(), "code", "")
+	require.NoError(t, err)
+	assert.Equal(t, "g-1", identity.Subject)
+	assert.True(t, identity.EmailVerified)
+
+	_, ok = OAuth2Preset("unknown")
+	assert.False(t, ok)
+}
+
+func TestWeChatProvider_Exchange(t *testing.T) {
+	server := newOAuthServer(t,
+		func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("code") != "good" || q.Get("appid") != "wx-app" {
+				writeJSON(w, map[string]interface{}{"errcode": 40029, "errmsg": "invalid code"})
+				return
+			}
+			writeJSON(w, map[string]string{"access_token": "at-1", "openid": "open-1"})
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "open-1", r.URL.Query().Get("openid"))
+			writeJSON(w, map[string]string{"nickname": "微信用户", "headimgurl": "https://wx.example.com/h.png", "unionid": "union-1"})
+		},
+	)
+
+	provider := NewWeChatProvider(WeChatConfig{
+		Name:        "wechat",
+		AppID:       "wx-app",
+		AppSecret:   "secret",
+		TokenURL:    server.URL + "/token",
+		UserInfoURL: server.URL + "/userinfo",
+	}, server.Client())
+
+	identity, err := provider.Exchange(context.Background(), "good", "")
+	require.NoError(t, err)
+	// 令牌接口未返回 unionid 时取用户信息中的 unionid
+	assert.Equal(t, "union-1", identity.Subject)
+	assert.Equal(t, "微信用户", identity.Name)
+	assert.False(t, identity.EmailVerified)
+
+	_, err = provider.Exchange(context.Background(), "bad", "")
+	assert.ErrorIs(t, err, ErrOAuthExchange)
+}
+
+func TestOAuthProviders_Get(t *testing.T) {
+	providers := NewOAuthProviders(NewWeChatProvider(WeChatConfig{Name: "wechat"}, nil))
+
+	p, ok := providers.Get("wechat")
+	require.True(t, ok)
+	assert.Equal(t, "wechat", p.Name())
+
+	_, ok = providers.Get("google")
+	assert.False(t, ok)
+}
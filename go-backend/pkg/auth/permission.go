@@ -1,6 +1,10 @@
 package auth
 
-import "context"
+import (
+	"context"
+
+	"go-backend/internal/domain"
+)
 
 // PermissionChecker 权限检查器接口
 type PermissionChecker interface {
@@ -8,6 +12,16 @@ type PermissionChecker interface {
 	IsAdmin(ctx context.Context, userID int64) (bool, error)
 	IsModerator(ctx context.Context, userID int64) (bool, error)
 	CanModerateContent(ctx context.Context, userID int64) (bool, error)
+	// CheckTokenPermission 按令牌中的角色检查权限，令牌的权限版本过期或角色不足时返回 false，
+	// 调用方应回退到 CheckPermission
+	CheckTokenPermission(claims *Claims, resource, action string) bool
+	// TokenHasRole 令牌的权限版本有效且包含任一指定角色
+	TokenHasRole(claims *Claims, roleNames ...string) bool
+}
+
+// DenialRecorder 权限拒绝记录器，实现方负责采样和持久化，不应阻塞请求
+type DenialRecorder interface {
+	RecordDenial(ctx context.Context, denial *domain.PermissionDenial)
 }
 
 // SimplePermissionChecker 简单权限检查器（基于内存RBAC）
@@ -31,14 +45,7 @@ func (c *SimplePermissionChecker) IsAdmin(ctx context.Context, userID int64) (bo
 }
 
 func (c *SimplePermissionChecker) IsModerator(ctx context.Context, userID int64) (bool, error) {
-	// 检查是否有审核员角色（角色ID为3）
-	roles, _ := c.rbacManager.GetUserRoles(userID)
-	for _, role := range roles {
-		if role.ID == 3 { // moderator role
-			return true, nil
-		}
-	}
-	return false, nil
+	return c.rbacManager.IsModerator(userID), nil
 }
 
 func (c *SimplePermissionChecker) CanModerateContent(ctx context.Context, userID int64) (bool, error) {
@@ -49,3 +56,34 @@ func (c *SimplePermissionChecker) CanModerateContent(ctx context.Context, userID
 	}
 	return c.IsModerator(ctx, userID)
 }
+
+func (c *SimplePermissionChecker) CheckTokenPermission(claims *Claims, resource, action string) bool {
+	if !c.tokenCurrent(claims) {
+		return false
+	}
+	return c.rbacManager.RolesHavePermission(claims.Roles, resource, action)
+}
+
+func (c *SimplePermissionChecker) TokenHasRole(claims *Claims, roleNames ...string) bool {
+	if !c.tokenCurrent(claims) {
+		return false
+	}
+	for _, role := range claims.Roles {
+		for _, name := range roleNames {
+			if role == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tokenCurrent 令牌携带角色且权限版本与内存一致。角色绑定、角色名称或状态变更都会递增版本，
+// 版本一致时令牌中的角色与内存中的绑定相同
+func (c *SimplePermissionChecker) tokenCurrent(claims *Claims) bool {
+	if claims == nil || len(claims.Roles) == 0 {
+		return false
+	}
+	version, ok := c.rbacManager.PermissionVersion(claims.UserID)
+	return ok && version == claims.PermVersion
+}
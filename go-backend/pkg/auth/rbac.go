@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"go-backend/internal/domain"
@@ -22,8 +23,26 @@ type RBACManager interface {
 	IsModerator(userID int64) bool
 	// 缓存管理
 	ClearUserCache(userID int64)
+	// 持久化同步
+	Load(snapshot *RBACSnapshot)
+	Snapshot() *RBACSnapshot
+	// SetUserRoles 用数据库中的绑定整体替换用户的角色，并记录对应的权限版本
+	SetUserRoles(userID int64, roleIDs []int64, version int64)
+	// 令牌中的角色声明
+	RoleClaimsProvider
+	// PermissionVersion 用户角色绑定的权限版本，内存中的绑定未与数据库版本对应时返回 false
+	PermissionVersion(userID int64) (int64, bool)
+	// RolesHavePermission 按角色名检查权限，忽略不存在或已禁用的角色
+	RolesHavePermission(roleNames []string, resource, action string) bool
 }
 
+// 内置角色名称，角色ID以数据库为准
+const (
+	RoleNameUser      = "user"
+	RoleNameAdmin     = "admin"
+	RoleNameModerator = "moderator"
+)
+
 // MemoryRBACManager 内存RBAC管理器
 type MemoryRBACManager struct {
 	// 用户角色映射
@@ -36,7 +55,9 @@ type MemoryRBACManager struct {
 	permissions map[int64]*domain.Permission
 	// 用户权限缓存
 	userPermissionCache map[int64][]*domain.Permission
-	mutex               sync.RWMutex
+	// 用户角色绑定的权限版本，只记录与数据库一致的用户，本地增量修改绑定后移除
+	userVersions map[int64]int64
+	mutex        sync.RWMutex
 }
 
 // NewMemoryRBACManager 创建内存RBAC管理器
@@ -47,6 +68,7 @@ func NewMemoryRBACManager() *MemoryRBACManager {
 		roles:               make(map[int64]*domain.Role),
 		permissions:         make(map[int64]*domain.Permission),
 		userPermissionCache: make(map[int64][]*domain.Permission),
+		userVersions:        make(map[int64]int64),
 	}
 
 	// 初始化基础角色和权限
@@ -67,6 +89,8 @@ func (r *MemoryRBACManager) initDefaultRolesAndPermissions() {
 		{ID: 6, Name: "comment:create", Resource: "/comment", Action: "POST", Status: 1},
 		{ID: 7, Name: "comment:delete", Resource: "/comment", Action: "DELETE", Status: 1},
 		{ID: 8, Name: "admin:all", Resource: "/*", Action: "*", Status: 1},
+		{ID: 9, Name: "video:delete:own", Resource: "/video", Action: "DELETE", Scope: domain.PermissionScopeOwn, Status: 1},
+		{ID: 10, Name: "comment:delete:own", Resource: "/comment", Action: "DELETE", Scope: domain.PermissionScopeOwn, Status: 1},
 	}
 
 	for _, perm := range permissions {
@@ -86,7 +110,7 @@ func (r *MemoryRBACManager) initDefaultRolesAndPermissions() {
 
 	// 分配权限给角色
 	// 普通用户权限
-	r.rolePermissions[1] = []int64{1, 2, 3, 4, 6} // user:read, user:update, video:create, video:read, comment:create
+	r.rolePermissions[1] = []int64{1, 2, 3, 4, 6, 9, 10} // user:read, user:update, video:create, video:read, comment:create, video:delete:own, comment:delete:own
 	// 管理员权限
 	r.rolePermissions[2] = []int64{8} // admin:all
 	// 内容审核员权限
@@ -134,6 +158,7 @@ func (r *MemoryRBACManager) AssignRole(userID, roleID int64) error {
 
 	// 清除缓存
 	delete(r.userPermissionCache, userID)
+	delete(r.userVersions, userID)
 
 	return nil
 }
@@ -156,6 +181,7 @@ func (r *MemoryRBACManager) RemoveRole(userID, roleID int64) error {
 
 	// 清除缓存
 	delete(r.userPermissionCache, userID)
+	delete(r.userVersions, userID)
 
 	return nil
 }
@@ -227,6 +253,135 @@ func (r *MemoryRBACManager) ClearUserCache(userID int64) {
 	delete(r.userPermissionCache, userID)
 }
 
+// Load 用快照整体替换内存中的角色、权限及绑定关系
+func (r *MemoryRBACManager) Load(snapshot *RBACSnapshot) {
+	roles := make(map[int64]*domain.Role, len(snapshot.Roles))
+	for _, role := range snapshot.Roles {
+		roles[role.ID] = role
+	}
+
+	permissions := make(map[int64]*domain.Permission, len(snapshot.Permissions))
+	for _, perm := range snapshot.Permissions {
+		permissions[perm.ID] = perm
+	}
+
+	rolePermissions := make(map[int64][]int64, len(snapshot.RolePermissions))
+	for roleID, permIDs := range snapshot.RolePermissions {
+		rolePermissions[roleID] = append([]int64(nil), permIDs...)
+	}
+
+	// 有绑定但从未变更过的用户版本为0
+	userRoles := make(map[int64][]int64, len(snapshot.UserRoles))
+	userVersions := make(map[int64]int64, len(snapshot.UserRoles))
+	for userID, roleIDs := range snapshot.UserRoles {
+		userRoles[userID] = append([]int64(nil), roleIDs...)
+		userVersions[userID] = 0
+	}
+	for userID, version := range snapshot.UserVersions {
+		userVersions[userID] = version
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.roles = roles
+	r.permissions = permissions
+	r.rolePermissions = rolePermissions
+	r.userRoles = userRoles
+	r.userPermissionCache = make(map[int64][]*domain.Permission)
+	r.userVersions = userVersions
+}
+
+// SetUserRoles 用数据库中的绑定整体替换用户的角色，并记录对应的权限版本
+func (r *MemoryRBACManager) SetUserRoles(userID int64, roleIDs []int64, version int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.userRoles[userID] = append([]int64(nil), roleIDs...)
+	r.userVersions[userID] = version
+	delete(r.userPermissionCache, userID)
+}
+
+// RoleClaims 用户有效角色的名称和权限版本，版本未知时返回 false
+func (r *MemoryRBACManager) RoleClaims(userID int64) ([]string, int64, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	version, ok := r.userVersions[userID]
+	if !ok {
+		return nil, 0, false
+	}
+
+	var names []string
+	for _, roleID := range r.userRoles[userID] {
+		if role, exists := r.roles[roleID]; exists && role.IsActive() {
+			names = append(names, role.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, version, true
+}
+
+// PermissionVersion 用户角色绑定的权限版本
+func (r *MemoryRBACManager) PermissionVersion(userID int64) (int64, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	version, ok := r.userVersions[userID]
+	return version, ok
+}
+
+// RolesHavePermission 按角色名检查权限，角色的权限绑定以内存中的当前状态为准
+func (r *MemoryRBACManager) RolesHavePermission(roleNames []string, resource, action string) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, name := range roleNames {
+		for roleID, role := range r.roles {
+			if role.Name != name || !role.IsActive() {
+				continue
+			}
+			for _, permID := range r.rolePermissions[roleID] {
+				if perm, exists := r.permissions[permID]; exists && perm.IsActive() && perm.Match(resource, action) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Snapshot 导出当前内存状态，用于与数据库比对
+func (r *MemoryRBACManager) Snapshot() *RBACSnapshot {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	snapshot := NewRBACSnapshot()
+	for _, role := range r.roles {
+		snapshot.Roles = append(snapshot.Roles, role)
+	}
+	for _, perm := range r.permissions {
+		snapshot.Permissions = append(snapshot.Permissions, perm)
+	}
+	for roleID, permIDs := range r.rolePermissions {
+		if len(permIDs) > 0 {
+			snapshot.RolePermissions[roleID] = append([]int64(nil), permIDs...)
+		}
+	}
+	for userID, roleIDs := range r.userRoles {
+		if len(roleIDs) > 0 {
+			snapshot.UserRoles[userID] = append([]int64(nil), roleIDs...)
+		}
+	}
+	for userID, version := range r.userVersions {
+		if version > 0 {
+			snapshot.UserVersions[userID] = version
+		}
+	}
+
+	return snapshot
+}
+
 // SetDefaultUserRole 为新用户设置默认角色
 func (r *MemoryRBACManager) SetDefaultUserRole(userID int64) error {
 	return r.AssignRole(userID, 1) // 默认分配普通用户角色
@@ -237,13 +392,7 @@ func (r *MemoryRBACManager) IsAdmin(userID int64) bool {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	userRoleIDs := r.userRoles[userID]
-	for _, roleID := range userRoleIDs {
-		if roleID == 2 { // 管理员角色ID为2
-			return true
-		}
-	}
-	return false
+	return r.hasRoleNamed(userID, RoleNameAdmin)
 }
 
 // AddPermission 添加权限 (管理用)
@@ -296,9 +445,13 @@ func (r *MemoryRBACManager) IsModerator(userID int64) bool {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	userRoleIDs := r.userRoles[userID]
-	for _, roleID := range userRoleIDs {
-		if roleID == 3 { // 审核员角色ID为3
+	return r.hasRoleNamed(userID, RoleNameModerator)
+}
+
+// hasRoleNamed 按名称判断用户是否拥有有效角色，调用方需持有读锁
+func (r *MemoryRBACManager) hasRoleNamed(userID int64, name string) bool {
+	for _, roleID := range r.userRoles[userID] {
+		if role, exists := r.roles[roleID]; exists && role.IsActive() && role.Name == name {
 			return true
 		}
 	}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"sort"
+
+	"go-backend/internal/domain"
+)
+
+// RBACSnapshot RBAC 全量状态快照，用于在内存管理器与持久化存储之间同步
+type RBACSnapshot struct {
+	Roles           []*domain.Role
+	Permissions     []*domain.Permission
+	RolePermissions map[int64][]int64 // 角色ID -> 权限ID
+	UserRoles       map[int64][]int64 // 用户ID -> 角色ID
+	UserVersions    map[int64]int64   // 用户ID -> 权限版本，只包含绑定变更过的用户
+}
+
+// NewRBACSnapshot 创建空快照
+func NewRBACSnapshot() *RBACSnapshot {
+	return &RBACSnapshot{
+		RolePermissions: make(map[int64][]int64),
+		UserRoles:       make(map[int64][]int64),
+		UserVersions:    make(map[int64]int64),
+	}
+}
+
+// RBACDivergence 两份快照之间的差异，记录不一致的对象ID
+type RBACDivergence struct {
+	Roles           []int64 // 定义缺失或不一致的角色
+	Permissions     []int64 // 定义缺失或不一致的权限
+	RolePermissions []int64 // 权限绑定不一致的角色
+	UserRoles       []int64 // 角色绑定不一致的用户
+}
+
+// IsEmpty 是否没有差异
+func (d *RBACDivergence) IsEmpty() bool {
+	return len(d.Roles) == 0 && len(d.Permissions) == 0 &&
+		len(d.RolePermissions) == 0 && len(d.UserRoles) == 0
+}
+
+// Total 差异对象总数
+func (d *RBACDivergence) Total() int {
+	return len(d.Roles) + len(d.Permissions) + len(d.RolePermissions) + len(d.UserRoles)
+}
+
+// DiffRBACSnapshots 比较两份快照。角色和权限比较名称、资源、操作与状态，绑定关系比较ID集合，忽略顺序
+func DiffRBACSnapshots(expected, actual *RBACSnapshot) *RBACDivergence {
+	expectedRoles := make(map[int64]*domain.Role, len(expected.Roles))
+	for _, role := range expected.Roles {
+		expectedRoles[role.ID] = role
+	}
+	actualRoles := make(map[int64]*domain.Role, len(actual.Roles))
+	for _, role := range actual.Roles {
+		actualRoles[role.ID] = role
+	}
+
+	expectedPerms := make(map[int64]*domain.Permission, len(expected.Permissions))
+	for _, perm := range expected.Permissions {
+		expectedPerms[perm.ID] = perm
+	}
+	actualPerms := make(map[int64]*domain.Permission, len(actual.Permissions))
+	for _, perm := range actual.Permissions {
+		actualPerms[perm.ID] = perm
+	}
+
+	return &RBACDivergence{
+		Roles: diffKeys(expectedRoles, actualRoles, func(a, b *domain.Role) bool {
+			return a.Name == b.Name && a.Status == b.Status
+		}),
+		Permissions: diffKeys(expectedPerms, actualPerms, func(a, b *domain.Permission) bool {
+			return a.Name == b.Name && a.Resource == b.Resource && a.Action == b.Action && a.Scope == b.Scope && a.Status == b.Status
+		}),
+		RolePermissions: diffKeys(expected.RolePermissions, actual.RolePermissions, sameIDSet),
+		UserRoles:       diffKeys(expected.UserRoles, actual.UserRoles, sameIDSet),
+	}
+}
+
+// diffKeys 返回只在一侧存在或两侧不相等的键，按升序排列
+func diffKeys[V any](expected, actual map[int64]V, equal func(a, b V) bool) []int64 {
+	var keys []int64
+	for id, e := range expected {
+		if a, ok := actual[id]; !ok || !equal(e, a) {
+			keys = append(keys, id)
+		}
+	}
+	for id := range actual {
+		if _, ok := expected[id]; !ok {
+			keys = append(keys, id)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func sameIDSet(a, b []int64) bool {
+	set := make(map[int64]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+
+	other := make(map[int64]struct{}, len(b))
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+		other[id] = struct{}{}
+	}
+
+	return len(set) == len(other)
+}
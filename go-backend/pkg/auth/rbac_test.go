@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"testing"
+	"time"
 
 	"go-backend/internal/domain"
 
@@ -261,3 +262,135 @@ func TestPermissionMatching(t *testing.T) {
 		assert.True(t, adminPerm.Match("/anything", "POST"))
 	})
 }
+
+func TestMemoryRBACManager_LoadSnapshot(t *testing.T) {
+	manager := NewMemoryRBACManager()
+	ctx := context.Background()
+
+	// 数据库中的角色ID与内置默认值不同
+	snapshot := NewRBACSnapshot()
+	snapshot.Roles = []*domain.Role{
+		{ID: 10, Name: RoleNameUser, Status: 1},
+		{ID: 20, Name: RoleNameModerator, Status: 1},
+	}
+	snapshot.Permissions = []*domain.Permission{
+		{ID: 100, Name: "video:read", Resource: "/video", Action: "GET", Status: 1},
+		{ID: 101, Name: "comment:delete", Resource: "/comment", Action: "DELETE", Status: 1},
+	}
+	snapshot.RolePermissions[10] = []int64{100}
+	snapshot.RolePermissions[20] = []int64{100, 101}
+	snapshot.UserRoles[1] = []int64{10}
+	snapshot.UserRoles[2] = []int64{20}
+
+	// 加载前的缓存应被清除
+	assert.False(t, manager.HasPermission(ctx, 2, "/comment", "DELETE"))
+
+	manager.Load(snapshot)
+
+	assert.True(t, manager.HasPermission(ctx, 1, "/video", "GET"))
+	assert.False(t, manager.HasPermission(ctx, 1, "/comment", "DELETE"))
+	assert.True(t, manager.HasPermission(ctx, 2, "/comment", "DELETE"))
+	assert.True(t, manager.IsModerator(2))
+	assert.False(t, manager.IsModerator(1))
+	assert.False(t, manager.IsAdmin(2))
+
+	// 内置默认角色被替换
+	assert.Error(t, manager.AssignRole(3, 2))
+
+	diff := DiffRBACSnapshots(snapshot, manager.Snapshot())
+	assert.True(t, diff.IsEmpty())
+
+	// 修改输入快照不影响已加载状态
+	snapshot.UserRoles[1] = append(snapshot.UserRoles[1], 20)
+	assert.False(t, manager.IsModerator(1))
+}
+
+func TestDiffRBACSnapshots(t *testing.T) {
+	expected := NewRBACSnapshot()
+	expected.Roles = []*domain.Role{{ID: 1, Name: "user", Status: 1}, {ID: 2, Name: "admin", Status: 1}}
+	expected.Permissions = []*domain.Permission{{ID: 1, Name: "video:read", Resource: "/video", Action: "GET", Status: 1}}
+	expected.RolePermissions[1] = []int64{1}
+	expected.UserRoles[7] = []int64{1, 2}
+	expected.UserRoles[8] = []int64{1}
+
+	actual := NewRBACSnapshot()
+	actual.Roles = []*domain.Role{{ID: 1, Name: "user", Status: 1}, {ID: 2, Name: "admin", Status: 2}}
+	actual.Permissions = []*domain.Permission{{ID: 1, Name: "video:read", Resource: "/video", Action: "GET", Status: 1}}
+	actual.RolePermissions[1] = []int64{1}
+	actual.UserRoles[7] = []int64{2, 1}
+	actual.UserRoles[9] = []int64{1}
+
+	diff := DiffRBACSnapshots(expected, actual)
+
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, []int64{2}, diff.Roles)
+	assert.Empty(t, diff.Permissions)
+	assert.Empty(t, diff.RolePermissions)
+	assert.Equal(t, []int64{8, 9}, diff.UserRoles)
+	assert.Equal(t, 3, diff.Total())
+}
+
+func TestTokenRoleClaims(t *testing.T) {
+	manager := NewMemoryRBACManager()
+	checker := NewSimplePermissionChecker(manager)
+
+	snapshot := NewRBACSnapshot()
+	snapshot.Roles = []*domain.Role{
+		{ID: 10, Name: RoleNameUser, Status: 1},
+		{ID: 20, Name: RoleNameModerator, Status: 1},
+	}
+	snapshot.Permissions = []*domain.Permission{
+		{ID: 100, Name: "video:read", Resource: "/video", Action: "GET", Status: 1},
+		{ID: 101, Name: "comment:delete", Resource: "/comment", Action: "DELETE", Status: 1},
+	}
+	snapshot.RolePermissions[10] = []int64{100}
+	snapshot.RolePermissions[20] = []int64{100, 101}
+	snapshot.UserRoles[1] = []int64{20, 10}
+	snapshot.UserVersions[1] = 3
+	manager.Load(snapshot)
+
+	jwtManager := NewJWTManager("test-secret-key", time.Hour)
+	jwtManager.SetRoleClaimsProvider(manager)
+	token, err := jwtManager.GenerateToken(1, "alice")
+	require.NoError(t, err)
+	claims, err := jwtManager.VerifyToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{RoleNameModerator, RoleNameUser}, claims.Roles)
+	assert.Equal(t, int64(3), claims.PermVersion)
+
+	t.Run("CurrentVersion", func(t *testing.T) {
+		assert.True(t, checker.CheckTokenPermission(claims, "/comment", "DELETE"))
+		assert.False(t, checker.CheckTokenPermission(claims, "/admin", "POST"))
+		assert.True(t, checker.TokenHasRole(claims, RoleNameAdmin, RoleNameModerator))
+		assert.False(t, checker.TokenHasRole(claims, RoleNameAdmin))
+	})
+
+	t.Run("StaleVersion", func(t *testing.T) {
+		manager.SetUserRoles(1, []int64{10}, 4)
+
+		// 令牌中的角色已过期，必须回退到完整检查
+		assert.False(t, checker.CheckTokenPermission(claims, "/comment", "DELETE"))
+		assert.False(t, checker.TokenHasRole(claims, RoleNameModerator))
+
+		roles, version, ok := manager.RoleClaims(1)
+		require.True(t, ok)
+		assert.Equal(t, []string{RoleNameUser}, roles)
+		assert.Equal(t, int64(4), version)
+	})
+
+	t.Run("UnknownVersion", func(t *testing.T) {
+		// 未经数据库确认的增量修改使版本失效，不再签发角色声明
+		require.NoError(t, manager.AssignRole(1, 20))
+		_, ok := manager.PermissionVersion(1)
+		assert.False(t, ok)
+		_, _, ok = manager.RoleClaims(1)
+		assert.False(t, ok)
+
+		token, err := jwtManager.GenerateToken(1, "alice")
+		require.NoError(t, err)
+		fresh, err := jwtManager.VerifyToken(token)
+		require.NoError(t, err)
+		assert.Empty(t, fresh.Roles)
+		assert.False(t, checker.CheckTokenPermission(fresh, "/video", "GET"))
+	})
+}
@@ -6,22 +6,26 @@ import (
 	"time"
 
 	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
 )
 
-// SessionManager 会话管理器接口
+// SessionManager 会话管理器接口。会话的过期判断与刷新令牌校验统一由实现负责，
+// 调用方只需处理 ErrSessionNotFound / ErrSessionExpired
 type SessionManager interface {
-	CreateSession(userID int64, refreshToken string, expiry time.Duration) (*domain.UserSession, error)
-	GetSession(userID int64) (*domain.UserSession, error)
-	UpdateSession(userID int64, newRefreshToken string, expiry time.Duration) error
-	DeleteSession(userID int64) error
-	IsSessionValid(userID int64, refreshToken string) bool
-	CleanupExpiredSessions() error
+	CreateSession(ctx context.Context, userID int64, refreshToken, familyID string, expiry time.Duration) (*domain.UserSession, error)
+	GetSession(ctx context.Context, userID int64) (*domain.UserSession, error)
+	GetSessionByToken(ctx context.Context, refreshToken string) (*domain.UserSession, error)
+	UpdateSession(ctx context.Context, userID int64, newRefreshToken string, expiry time.Duration) error
+	DeleteSession(ctx context.Context, userID int64) error
+	ValidateSession(ctx context.Context, userID int64, refreshToken string) (bool, error)
+	CleanupExpiredSessions(ctx context.Context) error
 	Close() error
 }
 
-// MemorySessionManager 内存会话管理器
+// MemorySessionManager 内存会话管理器，仅在单进程内有效，用于测试和本地开发
 type MemorySessionManager struct {
 	sessions map[int64]*domain.UserSession
+	clock    clock.Clock
 	mutex    sync.RWMutex
 	ctx      context.Context
 	cancel   context.CancelFunc
@@ -29,9 +33,15 @@ type MemorySessionManager struct {
 
 // NewMemorySessionManager 创建内存会话管理器
 func NewMemorySessionManager() *MemorySessionManager {
+	return NewMemorySessionManagerWithClock(clock.New())
+}
+
+// NewMemorySessionManagerWithClock 创建使用指定时钟判断过期的内存会话管理器
+func NewMemorySessionManagerWithClock(clk clock.Clock) *MemorySessionManager {
 	ctx, cancel := context.WithCancel(context.Background())
 	manager := &MemorySessionManager{
 		sessions: make(map[int64]*domain.UserSession),
+		clock:    clk,
 		ctx:      ctx,
 		cancel:   cancel,
 	}
@@ -42,26 +52,29 @@ func NewMemorySessionManager() *MemorySessionManager {
 	return manager
 }
 
-// CreateSession 创建会话
-func (s *MemorySessionManager) CreateSession(userID int64, refreshToken string, expiry time.Duration) (*domain.UserSession, error) {
+// CreateSession 创建会话，同一用户只保留一个会话
+func (s *MemorySessionManager) CreateSession(ctx context.Context, userID int64, refreshToken, familyID string, expiry time.Duration) (*domain.UserSession, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	now := s.clock.Now()
 	session := &domain.UserSession{
 		UserID:       userID,
 		RefreshToken: refreshToken,
-		ExpiresAt:    time.Now().Add(expiry),
-		CreatedAt:    time.Now(),
+		FamilyID:     familyID,
+		ExpiresAt:    now.Add(expiry),
+		CreatedAt:    now,
 	}
 
 	s.sessions[userID] = session
-	return session, nil
+	copied := *session
+	return &copied, nil
 }
 
 // GetSession 获取会话
-func (s *MemorySessionManager) GetSession(userID int64) (*domain.UserSession, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+func (s *MemorySessionManager) GetSession(ctx context.Context, userID int64) (*domain.UserSession, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
 	session, exists := s.sessions[userID]
 	if !exists {
@@ -69,20 +82,36 @@ func (s *MemorySessionManager) GetSession(userID int64) (*domain.UserSession, er
 	}
 
 	// 检查会话是否过期
-	if session.IsExpired() {
-		s.mutex.RUnlock()
-		s.mutex.Lock()
+	if session.ExpiredAt(s.clock.Now()) {
 		delete(s.sessions, userID)
-		s.mutex.Unlock()
-		s.mutex.RLock()
 		return nil, ErrSessionExpired
 	}
 
-	return session, nil
+	copied := *session
+	return &copied, nil
+}
+
+// GetSessionByToken 根据刷新令牌获取会话
+func (s *MemorySessionManager) GetSessionByToken(ctx context.Context, refreshToken string) (*domain.UserSession, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, session := range s.sessions {
+		if session.RefreshToken != refreshToken {
+			continue
+		}
+		if session.ExpiredAt(s.clock.Now()) {
+			return nil, ErrSessionExpired
+		}
+		copied := *session
+		return &copied, nil
+	}
+
+	return nil, ErrSessionNotFound
 }
 
 // UpdateSession 更新会话
-func (s *MemorySessionManager) UpdateSession(userID int64, newRefreshToken string, expiry time.Duration) error {
+func (s *MemorySessionManager) UpdateSession(ctx context.Context, userID int64, newRefreshToken string, expiry time.Duration) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -91,12 +120,13 @@ func (s *MemorySessionManager) UpdateSession(userID int64, newRefreshToken strin
 		return ErrSessionNotFound
 	}
 
-	session.Refresh(newRefreshToken, expiry)
+	session.RefreshToken = newRefreshToken
+	session.ExpiresAt = s.clock.Now().Add(expiry)
 	return nil
 }
 
 // DeleteSession 删除会话
-func (s *MemorySessionManager) DeleteSession(userID int64) error {
+func (s *MemorySessionManager) DeleteSession(ctx context.Context, userID int64) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -104,22 +134,22 @@ func (s *MemorySessionManager) DeleteSession(userID int64) error {
 	return nil
 }
 
-// IsSessionValid 检查会话是否有效
-func (s *MemorySessionManager) IsSessionValid(userID int64, refreshToken string) bool {
-	session, err := s.GetSession(userID)
+// ValidateSession 检查刷新令牌是否为用户当前有效会话的令牌
+func (s *MemorySessionManager) ValidateSession(ctx context.Context, userID int64, refreshToken string) (bool, error) {
+	session, err := s.GetSession(ctx, userID)
 	if err != nil {
-		return false
+		return false, err
 	}
 
-	return session.RefreshToken == refreshToken && !session.IsExpired()
+	return session.RefreshToken == refreshToken, nil
 }
 
 // CleanupExpiredSessions 清理过期会话
-func (s *MemorySessionManager) CleanupExpiredSessions() error {
+func (s *MemorySessionManager) CleanupExpiredSessions(ctx context.Context) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	now := time.Now()
+	now := s.clock.Now()
 	for userID, session := range s.sessions {
 		if now.After(session.ExpiresAt) {
 			delete(s.sessions, userID)
@@ -139,7 +169,7 @@ func (s *MemorySessionManager) cleanup() {
 		case <-s.ctx.Done():
 			return // 收到停止信号，退出清理循环
 		case <-ticker.C:
-			s.CleanupExpiredSessions()
+			s.CleanupExpiredSessions(s.ctx)
 		}
 	}
 }
@@ -1,10 +1,12 @@
 package auth
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"go-backend/internal/domain"
+	"go-backend/pkg/clock"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,12 +16,14 @@ func TestMemorySessionManager(t *testing.T) {
 	manager := NewMemorySessionManager()
 	defer manager.Close()
 
+	ctx := context.Background()
+
 	userID := int64(12345)
 	refreshToken := "test-refresh-token"
 	expiry := time.Hour
 
 	t.Run("CreateSession", func(t *testing.T) {
-		session, err := manager.CreateSession(userID, refreshToken, expiry)
+		session, err := manager.CreateSession(ctx, userID, refreshToken, "family", expiry)
 		require.NoError(t, err)
 		assert.Equal(t, userID, session.UserID)
 		assert.Equal(t, refreshToken, session.RefreshToken)
@@ -27,10 +31,10 @@ func TestMemorySessionManager(t *testing.T) {
 	})
 
 	t.Run("GetSession_Success", func(t *testing.T) {
-		_, err := manager.CreateSession(userID, refreshToken, expiry)
+		_, err := manager.CreateSession(ctx, userID, refreshToken, "family", expiry)
 		require.NoError(t, err)
 
-		session, err := manager.GetSession(userID)
+		session, err := manager.GetSession(ctx, userID)
 		require.NoError(t, err)
 		assert.Equal(t, userID, session.UserID)
 		assert.Equal(t, refreshToken, session.RefreshToken)
@@ -39,61 +43,75 @@ func TestMemorySessionManager(t *testing.T) {
 	t.Run("GetSession_NotFound", func(t *testing.T) {
 		nonExistentUserID := int64(99999)
 
-		_, err := manager.GetSession(nonExistentUserID)
+		_, err := manager.GetSession(ctx, nonExistentUserID)
 		assert.Error(t, err)
 		assert.Equal(t, ErrSessionNotFound, err)
 	})
 
 	t.Run("UpdateSession", func(t *testing.T) {
-		_, err := manager.CreateSession(userID, refreshToken, expiry)
+		_, err := manager.CreateSession(ctx, userID, refreshToken, "family", expiry)
 		require.NoError(t, err)
 
 		newRefreshToken := "new-refresh-token"
-		err = manager.UpdateSession(userID, newRefreshToken, expiry)
+		err = manager.UpdateSession(ctx, userID, newRefreshToken, expiry)
 		require.NoError(t, err)
 
-		session, err := manager.GetSession(userID)
+		session, err := manager.GetSession(ctx, userID)
 		require.NoError(t, err)
 		assert.Equal(t, newRefreshToken, session.RefreshToken)
 	})
 
 	t.Run("DeleteSession", func(t *testing.T) {
-		_, err := manager.CreateSession(userID, refreshToken, expiry)
+		_, err := manager.CreateSession(ctx, userID, refreshToken, "family", expiry)
 		require.NoError(t, err)
 
-		err = manager.DeleteSession(userID)
+		err = manager.DeleteSession(ctx, userID)
 		require.NoError(t, err)
 
-		_, err = manager.GetSession(userID)
+		_, err = manager.GetSession(ctx, userID)
 		assert.Error(t, err)
 		assert.Equal(t, ErrSessionNotFound, err)
 	})
 
-	t.Run("IsSessionValid_Success", func(t *testing.T) {
-		_, err := manager.CreateSession(userID, refreshToken, expiry)
+	t.Run("GetSessionByToken", func(t *testing.T) {
+		_, err := manager.CreateSession(ctx, userID, refreshToken, "family", expiry)
+		require.NoError(t, err)
+
+		session, err := manager.GetSessionByToken(ctx, refreshToken)
+		require.NoError(t, err)
+		assert.Equal(t, userID, session.UserID)
+
+		_, err = manager.GetSessionByToken(ctx, "unknown-token")
+		assert.Equal(t, ErrSessionNotFound, err)
+	})
+
+	t.Run("ValidateSession_Success", func(t *testing.T) {
+		_, err := manager.CreateSession(ctx, userID, refreshToken, "family", expiry)
 		require.NoError(t, err)
 
-		isValid := manager.IsSessionValid(userID, refreshToken)
+		isValid, err := manager.ValidateSession(ctx, userID, refreshToken)
+		require.NoError(t, err)
 		assert.True(t, isValid)
 	})
 
-	t.Run("IsSessionValid_WrongToken", func(t *testing.T) {
-		_, err := manager.CreateSession(userID, refreshToken, expiry)
+	t.Run("ValidateSession_WrongToken", func(t *testing.T) {
+		_, err := manager.CreateSession(ctx, userID, refreshToken, "family", expiry)
 		require.NoError(t, err)
 
-		isValid := manager.IsSessionValid(userID, "wrong-token")
+		isValid, err := manager.ValidateSession(ctx, userID, "wrong-token")
+		require.NoError(t, err)
 		assert.False(t, isValid)
 	})
 
 	t.Run("Session_Expiry", func(t *testing.T) {
 		shortExpiry := 100 * time.Millisecond
-		_, err := manager.CreateSession(userID, refreshToken, shortExpiry)
+		_, err := manager.CreateSession(ctx, userID, refreshToken, "family", shortExpiry)
 		require.NoError(t, err)
 
 		// 等待过期
 		time.Sleep(200 * time.Millisecond)
 
-		_, err = manager.GetSession(userID)
+		_, err = manager.GetSession(ctx, userID)
 		assert.Error(t, err)
 		assert.Equal(t, ErrSessionExpired, err)
 	})
@@ -102,10 +120,10 @@ func TestMemorySessionManager(t *testing.T) {
 		user1 := int64(1001)
 		user2 := int64(1002)
 
-		_, err := manager.CreateSession(user1, "token1", expiry)
+		_, err := manager.CreateSession(ctx, user1, "token1", "family", expiry)
 		require.NoError(t, err)
 
-		_, err = manager.CreateSession(user2, "token2", expiry)
+		_, err = manager.CreateSession(ctx, user2, "token2", "family", expiry)
 		require.NoError(t, err)
 
 		sessions := manager.GetAllSessions()
@@ -115,6 +133,46 @@ func TestMemorySessionManager(t *testing.T) {
 	})
 }
 
+func TestMemorySessionManager_Expiry(t *testing.T) {
+	clk := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	manager := NewMemorySessionManagerWithClock(clk)
+	defer manager.Close()
+
+	ctx := context.Background()
+	userID := int64(12345)
+
+	_, err := manager.CreateSession(ctx, userID, "token", "family", time.Hour)
+	require.NoError(t, err)
+
+	clk.Advance(59 * time.Minute)
+	_, err = manager.GetSessionByToken(ctx, "token")
+	require.NoError(t, err)
+
+	// 刷新后从当前时间重新计算有效期
+	require.NoError(t, manager.UpdateSession(ctx, userID, "token2", time.Hour))
+	clk.Advance(59 * time.Minute)
+	_, err = manager.GetSession(ctx, userID)
+	require.NoError(t, err)
+
+	clk.Advance(2 * time.Minute)
+	_, err = manager.GetSessionByToken(ctx, "token2")
+	assert.Equal(t, ErrSessionExpired, err)
+	_, err = manager.GetSession(ctx, userID)
+	assert.Equal(t, ErrSessionExpired, err)
+}
+
+func TestMemoryTokenBlacklist_Expiry(t *testing.T) {
+	clk := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	blacklist := NewMemoryTokenBlacklistWithClock(clk)
+
+	require.NoError(t, blacklist.Add("token", time.Hour))
+	assert.True(t, blacklist.IsBlacklisted("token"))
+
+	clk.Advance(time.Hour + time.Second)
+	assert.False(t, blacklist.IsBlacklisted("token"))
+	assert.Zero(t, blacklist.Size())
+}
+
 func TestSessionDomainMethods(t *testing.T) {
 	t.Run("Session_IsExpired", func(t *testing.T) {
 		session := &domain.UserSession{
@@ -125,6 +183,7 @@ func TestSessionDomainMethods(t *testing.T) {
 		}
 
 		assert.True(t, session.IsExpired())
+		assert.False(t, session.ExpiredAt(session.CreatedAt))
 	})
 
 	t.Run("Session_Refresh", func(t *testing.T) {
@@ -5,6 +5,8 @@ import (
 	"sync"
 	"time"
 
+	"go-backend/pkg/clock"
+
 	"github.com/go-redis/redis/v8"
 )
 
@@ -19,13 +21,20 @@ type TokenBlacklist interface {
 // MemoryTokenBlacklist 内存Token黑名单实现
 type MemoryTokenBlacklist struct {
 	tokens map[string]time.Time
+	clock  clock.Clock
 	mutex  sync.RWMutex
 }
 
 // NewMemoryTokenBlacklist 创建内存Token黑名单
 func NewMemoryTokenBlacklist() *MemoryTokenBlacklist {
+	return NewMemoryTokenBlacklistWithClock(clock.New())
+}
+
+// NewMemoryTokenBlacklistWithClock 创建使用指定时钟判断过期的内存Token黑名单
+func NewMemoryTokenBlacklistWithClock(clk clock.Clock) *MemoryTokenBlacklist {
 	blacklist := &MemoryTokenBlacklist{
 		tokens: make(map[string]time.Time),
+		clock:  clk,
 	}
 
 	// 启动清理goroutine
@@ -39,7 +48,7 @@ func (b *MemoryTokenBlacklist) Add(tokenID string, expiry time.Duration) error {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
-	expiryTime := time.Now().Add(expiry)
+	expiryTime := b.clock.Now().Add(expiry)
 	b.tokens[tokenID] = expiryTime
 
 	return nil
@@ -56,7 +65,7 @@ func (b *MemoryTokenBlacklist) IsBlacklisted(tokenID string) bool {
 	}
 
 	// 检查是否过期
-	if time.Now().After(expiryTime) {
+	if b.clock.Now().After(expiryTime) {
 		// 过期了，删除并返回false
 		b.mutex.RUnlock()
 		b.mutex.Lock()
@@ -102,7 +111,7 @@ func (b *MemoryTokenBlacklist) cleanupExpired() {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
-	now := time.Now()
+	now := b.clock.Now()
 	for tokenID, expiryTime := range b.tokens {
 		if now.After(expiryTime) {
 			delete(b.tokens, tokenID)
@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	lockKeyPrefix  = "lock:"
+	fenceKeyPrefix = "lock:fence:"
+)
+
+var (
+	// ErrLockNotAcquired 等待超时仍未拿到锁
+	ErrLockNotAcquired = errors.New("lock not acquired")
+	// ErrLockLost 持有期间续期失败，锁可能已被其他实例获取
+	ErrLockLost = errors.New("lock lost")
+)
+
+// acquireScript 占用成功后递增该键的栅栏令牌，两步在同一脚本中执行，令牌随获取顺序严格递增
+var acquireScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return redis.call("INCR", KEYS[2])
+end
+return 0
+`)
+
+// renewScript 只有仍持有锁时才续期
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript 只释放自己持有的锁，过期后被他人获取的锁不受影响
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// LockConfig 分布式锁配置
+type LockConfig struct {
+	TTL           time.Duration // 锁的过期时间，持有期间每 TTL/3 自动续期
+	WaitTimeout   time.Duration // 获取锁的最长等待时间，0 表示只尝试一次
+	RetryInterval time.Duration // 等待期间的重试间隔
+}
+
+// DefaultLockConfig 默认分布式锁配置
+func DefaultLockConfig() *LockConfig {
+	return &LockConfig{
+		TTL:           10 * time.Second,
+		WaitTimeout:   3 * time.Second,
+		RetryInterval: 50 * time.Millisecond,
+	}
+}
+
+// DistributedLock 基于Redis SET NX PX 的跨实例互斥锁。每次获取返回递增的栅栏令牌，
+// 下游存储可以拒绝令牌更小的写入，防止持有者停顿期间锁过期导致的并发写
+type DistributedLock struct {
+	client *redis.Client
+	config *LockConfig
+}
+
+// NewDistributedLock 创建分布式锁，config 为 nil 或字段为零时使用默认值
+func NewDistributedLock(client *redis.Client, config *LockConfig) *DistributedLock {
+	cfg := DefaultLockConfig()
+	if config != nil {
+		if config.TTL > 0 {
+			cfg.TTL = config.TTL
+		}
+		cfg.WaitTimeout = config.WaitTimeout
+		if config.RetryInterval > 0 {
+			cfg.RetryInterval = config.RetryInterval
+		}
+	}
+	return &DistributedLock{client: client, config: cfg}
+}
+
+// Lock 已获取的锁，持有期间在后台自动续期
+type Lock struct {
+	key   string
+	owner string
+	token int64
+
+	client *redis.Client
+	ttl    time.Duration
+	lost   chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Acquire 获取锁，被占用时在 WaitTimeout 内重试，仍未拿到返回 ErrLockNotAcquired
+func (d *DistributedLock) Acquire(ctx context.Context, key string) (*Lock, error) {
+	owner, err := newLockOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	var deadline time.Time
+	if d.config.WaitTimeout > 0 {
+		deadline = time.Now().Add(d.config.WaitTimeout)
+	}
+
+	for {
+		token, err := acquireScript.Run(ctx, d.client,
+			[]string{lockKeyPrefix + key, fenceKeyPrefix + key},
+			owner, d.config.TTL.Milliseconds()).Int64()
+		if err != nil {
+			return nil, err
+		}
+		if token > 0 {
+			return d.newLock(key, owner, token), nil
+		}
+
+		if deadline.IsZero() || time.Now().Add(d.config.RetryInterval).After(deadline) {
+			return nil, ErrLockNotAcquired
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(d.config.RetryInterval):
+		}
+	}
+}
+
+// WithLock 持有锁执行 fn，fn 的上下文在锁丢失时被取消。fn 返回后释放锁
+func (d *DistributedLock) WithLock(ctx context.Context, key string, fn func(ctx context.Context, token int64) error) error {
+	lock, err := d.Acquire(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	go func() {
+		select {
+		case <-lock.Lost():
+			cancel(ErrLockLost)
+		case <-lockCtx.Done():
+		}
+	}()
+
+	if err := fn(lockCtx, lock.Token()); err != nil {
+		return err
+	}
+	// fn 忽略了取消继续执行完毕时，结果可能与新的持有者冲突
+	if context.Cause(lockCtx) == ErrLockLost {
+		return ErrLockLost
+	}
+	return nil
+}
+
+func (d *DistributedLock) newLock(key, owner string, token int64) *Lock {
+	l := &Lock{
+		key:    lockKeyPrefix + key,
+		owner:  owner,
+		token:  token,
+		client: d.client,
+		ttl:    d.config.TTL,
+		lost:   make(chan struct{}),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go l.renew()
+	return l
+}
+
+// Token 本次获取的栅栏令牌
+func (l *Lock) Token() int64 {
+	return l.token
+}
+
+// Lost 续期失败时关闭
+func (l *Lock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Release 停止续期并释放锁，重复调用只释放一次
+func (l *Lock) Release(ctx context.Context) error {
+	var err error
+	l.once.Do(func() {
+		close(l.stop)
+		<-l.done
+		err = releaseScript.Run(ctx, l.client, []string{l.key}, l.owner).Err()
+	})
+	return err
+}
+
+// renew 每 TTL/3 续期一次，续期被拒绝或连续失败到锁过期时视为丢失
+func (l *Lock) renew() {
+	defer close(l.done)
+
+	interval := l.ttl / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	expiresAt := time.Now().Add(l.ttl)
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		renewed, err := renewScript.Run(ctx, l.client, []string{l.key}, l.owner, l.ttl.Milliseconds()).Int64()
+		cancel()
+
+		switch {
+		case err == nil && renewed == 1:
+			expiresAt = time.Now().Add(l.ttl)
+		case err == nil || time.Now().After(expiresAt):
+			close(l.lost)
+			return
+		}
+	}
+}
+
+func newLockOwner() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
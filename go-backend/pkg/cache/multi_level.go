@@ -3,16 +3,29 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// 缓存查询结果的指标属性
+var (
+	l1Hit  = metric.WithAttributes(attribute.String("level", "l1"), attribute.String("result", "hit"))
+	l1Miss = metric.WithAttributes(attribute.String("level", "l1"), attribute.String("result", "miss"))
+	l2Hit  = metric.WithAttributes(attribute.String("level", "l2"), attribute.String("result", "hit"))
+	l2Miss = metric.WithAttributes(attribute.String("level", "l2"), attribute.String("result", "miss"))
 )
 
 // MultiLevelCache 多级缓存
 type MultiLevelCache struct {
-	local  *LocalCache
-	redis  *RedisCache
-	config *CacheConfig
+	local   *LocalCache
+	redis   *RedisCache
+	config  *CacheConfig
+	lookups metric.Int64Counter
 }
 
 // CacheConfig 缓存配置
@@ -34,9 +47,14 @@ func NewMultiLevelCache(redisClient *redis.Client, config *CacheConfig) *MultiLe
 		}
 	}
 
+	meter := otel.Meter("go-backend/cache")
+	lookups, _ := meter.Int64Counter("cache_lookups_total",
+		metric.WithDescription("Multi-level cache lookups, by level and hit or miss"))
+
 	cache := &MultiLevelCache{
-		redis:  NewRedisCache(redisClient),
-		config: config,
+		redis:   NewRedisCache(redisClient),
+		config:  config,
+		lookups: lookups,
 	}
 
 	if config.EnableL1 {
@@ -51,8 +69,10 @@ func (c *MultiLevelCache) Get(ctx context.Context, key string) (interface{}, boo
 	// 先从本地缓存获取
 	if c.config.EnableL1 && c.local != nil {
 		if value, exists := c.local.Get(key); exists {
+			c.lookups.Add(ctx, 1, l1Hit)
 			return value, true
 		}
+		c.lookups.Add(ctx, 1, l1Miss)
 	}
 
 	// 再从Redis获取
@@ -65,9 +85,11 @@ func (c *MultiLevelCache) Get(ctx context.Context, key string) (interface{}, boo
 				if c.config.EnableL1 && c.local != nil {
 					c.local.Set(key, result, c.config.LocalTTL)
 				}
+				c.lookups.Add(ctx, 1, l2Hit)
 				return result, true
 			}
 		}
+		c.lookups.Add(ctx, 1, l2Miss)
 	}
 
 	return nil, false
@@ -111,15 +133,47 @@ func (c *MultiLevelCache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+// Incr 原子自增计数器并将过期时间重置为 expiration。计数器只保存在Redis中，
+// 多个实例共享同一计数，不写入本地缓存
+func (c *MultiLevelCache) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	if c.config.EnableL1 && c.local != nil {
+		c.local.Delete(key)
+	}
+	return c.redis.IncrExpire(ctx, key, expiration)
+}
+
+// GetCounter 从Redis读取 Incr 维护的计数器，不存在时返回0
+func (c *MultiLevelCache) GetCounter(ctx context.Context, key string) (int64, error) {
+	val, err := c.redis.Get(ctx, key)
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// CompareAndDelete 键在Redis中的值等于 value 时删除该键，返回是否删除。
+// 比较只以Redis为准，多个实例同时删除同一个值时只有一个成功
+func (c *MultiLevelCache) CompareAndDelete(ctx context.Context, key, value string) (bool, error) {
+	if c.config.EnableL1 && c.local != nil {
+		c.local.Delete(key)
+	}
+	return c.redis.CompareAndDelete(ctx, key, value)
+}
+
 // GetString 获取字符串
 func (c *MultiLevelCache) GetString(ctx context.Context, key string) (string, error) {
 	// 先从本地缓存获取
 	if c.config.EnableL1 && c.local != nil {
 		if value, exists := c.local.Get(key); exists {
 			if str, ok := value.(string); ok {
+				c.lookups.Add(ctx, 1, l1Hit)
 				return str, nil
 			}
 		}
+		c.lookups.Add(ctx, 1, l1Miss)
 	}
 
 	// 从Redis获取
@@ -130,8 +184,10 @@ func (c *MultiLevelCache) GetString(ctx context.Context, key string) (string, er
 			if c.config.EnableL1 && c.local != nil {
 				c.local.Set(key, val, c.config.LocalTTL)
 			}
+			c.lookups.Add(ctx, 1, l2Hit)
 			return val, nil
 		}
+		c.lookups.Add(ctx, 1, l2Miss)
 		return "", err
 	}
 
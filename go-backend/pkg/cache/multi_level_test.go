@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -348,3 +350,87 @@ func TestMultiLevelCache_TTLRespect(t *testing.T) {
 		t.Error("value should have expired")
 	}
 }
+
+func TestMultiLevelCache_IncrCounter(t *testing.T) {
+	cache, cleanup := setupMultiLevelCache()
+	defer cleanup()
+
+	ctx := context.Background()
+
+	if err := cache.redis.client.Ping(ctx).Err(); err != nil {
+		t.Skipf("Redis not available: %v", err)
+	}
+
+	count, err := cache.GetCounter(ctx, "counter_key")
+	if err != nil || count != 0 {
+		t.Fatalf("missing counter should be 0, got %d, %v", count, err)
+	}
+
+	// 并发自增不丢失计数
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.Incr(ctx, "counter_key", time.Minute); err != nil {
+				t.Errorf("Incr failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	count, err = cache.GetCounter(ctx, "counter_key")
+	if err != nil || count != 20 {
+		t.Fatalf("counter should be 20, got %d, %v", count, err)
+	}
+
+	ttl, err := cache.redis.client.TTL(ctx, "counter_key").Result()
+	if err != nil || ttl <= 0 || ttl > time.Minute {
+		t.Errorf("counter should expire within a minute, got %v, %v", ttl, err)
+	}
+}
+
+func TestMultiLevelCache_CompareAndDelete(t *testing.T) {
+	cache, cleanup := setupMultiLevelCache()
+	defer cleanup()
+
+	ctx := context.Background()
+
+	if err := cache.redis.client.Ping(ctx).Err(); err != nil {
+		t.Skipf("Redis not available: %v", err)
+	}
+
+	if err := cache.SetString(ctx, "cad_key", "token", time.Minute); err != nil {
+		t.Fatalf("SetString failed: %v", err)
+	}
+
+	deleted, err := cache.CompareAndDelete(ctx, "cad_key", "wrong")
+	if err != nil || deleted {
+		t.Fatalf("mismatched value should not be deleted, got %v, %v", deleted, err)
+	}
+
+	// 并发删除同一个值只有一个成功
+	var succeeded int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deleted, err := cache.CompareAndDelete(ctx, "cad_key", "token")
+			if err != nil {
+				t.Errorf("CompareAndDelete failed: %v", err)
+			}
+			if deleted {
+				atomic.AddInt32(&succeeded, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Errorf("exactly one delete should succeed, got %d", succeeded)
+	}
+	if _, err := cache.GetString(ctx, "cad_key"); err != redis.Nil {
+		t.Errorf("key should be deleted, got %v", err)
+	}
+}
@@ -8,6 +8,14 @@ import (
 	"github.com/go-redis/redis/v8"
 )
 
+// compareAndDeleteScript 值等于期望值时才删除，比较和删除在同一脚本中执行
+var compareAndDeleteScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 // RedisCache Redis缓存实现
 type RedisCache struct {
 	client *redis.Client
@@ -83,6 +91,29 @@ func (c *RedisCache) Incr(ctx context.Context, key string) (int64, error) {
 	return c.client.Incr(ctx, key).Result()
 }
 
+// IncrExpire 自增并重置过期时间，两条命令在同一事务中执行，不会留下没有过期时间的计数
+func (c *RedisCache) IncrExpire(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, expiration)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// CompareAndDelete 键的值等于 value 时删除该键，返回是否删除。并发调用时只有一个返回 true
+func (c *RedisCache) CompareAndDelete(ctx context.Context, key, value string) (bool, error) {
+	deleted, err := compareAndDeleteScript.Run(ctx, c.client, []string{key}, value).Int()
+	if err != nil {
+		return false, err
+	}
+	return deleted == 1, nil
+}
+
 // Decr 自减
 func (c *RedisCache) Decr(ctx context.Context, key string) (int64, error) {
 	return c.client.Decr(ctx, key).Result()
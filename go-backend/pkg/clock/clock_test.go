@@ -0,0 +1,33 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealClock(t *testing.T) {
+	c := New()
+	before := time.Now()
+	now := c.Now()
+
+	assert.False(t, now.Before(before))
+	assert.True(t, c.Since(before) >= 0)
+	assert.True(t, c.Until(now.Add(time.Hour)) > 0)
+}
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+
+	assert.Equal(t, start, c.Now())
+
+	c.Advance(90 * time.Minute)
+	assert.Equal(t, start.Add(90*time.Minute), c.Now())
+	assert.Equal(t, 90*time.Minute, c.Since(start))
+	assert.Equal(t, -90*time.Minute, c.Until(start))
+
+	c.Set(start)
+	assert.Equal(t, start, c.Now())
+}
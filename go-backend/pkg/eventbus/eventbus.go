@@ -0,0 +1,312 @@
+// Package eventbus 进程内事件总线，实现 domain.EventBus
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultWorkers   = 4
+	defaultQueueSize = 1024
+)
+
+var (
+	ErrNilHandler        = errors.New("eventbus: nil handler")
+	ErrAlreadySubscribed = errors.New("eventbus: handler already subscribed")
+	ErrNotSubscribed     = errors.New("eventbus: handler not subscribed")
+	ErrQueueFull         = errors.New("eventbus: async queue full")
+	ErrClosed            = errors.New("eventbus: closed")
+)
+
+// Config 事件总线配置
+type Config struct {
+	// Workers 异步投递的工作协程数
+	Workers int
+	// QueueSize 异步队列长度，队列满时 PublishAsync 返回 ErrQueueFull
+	QueueSize int
+}
+
+// HandlerStats 单个处理器的累计统计
+type HandlerStats struct {
+	EventType string
+	Handler   string
+	Handled   int64
+	Failed    int64
+	Panics    int64
+	Duration  time.Duration
+}
+
+type asyncEvent struct {
+	ctx   context.Context
+	event domain.DomainEvent
+}
+
+// EventBus 进程内事件总线。Publish 在调用方协程按订阅顺序依次执行处理器；
+// PublishAsync 把事件放入有界队列由工作协程池执行。处理器 panic 会被恢复并计为失败。
+// 处理器按 == 比较识别，需要使用指针等可比较类型
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]domain.EventHandler
+	closed   bool
+
+	queue chan asyncEvent
+	wg    sync.WaitGroup
+
+	statsMu sync.Mutex
+	stats   map[statsKey]*HandlerStats
+
+	handledCounter metric.Int64Counter
+	handleDuration metric.Float64Histogram
+	droppedCounter metric.Int64Counter
+
+	log *log.Helper
+}
+
+type statsKey struct {
+	eventType string
+	handler   string
+}
+
+// New 创建事件总线并启动异步工作协程
+func New(cfg *Config, logger log.Logger) *EventBus {
+	workers, queueSize := defaultWorkers, defaultQueueSize
+	if cfg != nil {
+		if cfg.Workers > 0 {
+			workers = cfg.Workers
+		}
+		if cfg.QueueSize > 0 {
+			queueSize = cfg.QueueSize
+		}
+	}
+
+	b := &EventBus{
+		handlers: make(map[string][]domain.EventHandler),
+		queue:    make(chan asyncEvent, queueSize),
+		stats:    make(map[statsKey]*HandlerStats),
+		log:      log.NewHelper(logger),
+	}
+
+	meter := otel.Meter("go-backend/eventbus")
+	b.handledCounter, _ = meter.Int64Counter("eventbus_handled_total",
+		metric.WithDescription("Events handled by handler and result"))
+	b.handleDuration, _ = meter.Float64Histogram("eventbus_handle_duration_seconds",
+		metric.WithDescription("Handler execution duration"), metric.WithUnit("s"))
+	b.droppedCounter, _ = meter.Int64Counter("eventbus_dropped_total",
+		metric.WithDescription("Async events rejected because the queue was full or the bus was closed"))
+
+	for i := 0; i < workers; i++ {
+		b.wg.Add(1)
+		go b.worker()
+	}
+	return b
+}
+
+// Subscribe 订阅事件类型，同一处理器不能重复订阅同一类型
+func (b *EventBus) Subscribe(eventType string, handler domain.EventHandler) error {
+	if handler == nil {
+		return ErrNilHandler
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, h := range b.handlers[eventType] {
+		if h == handler {
+			return ErrAlreadySubscribed
+		}
+	}
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+	return nil
+}
+
+// Unsubscribe 取消订阅，正在执行的投递不受影响
+func (b *EventBus) Unsubscribe(eventType string, handler domain.EventHandler) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	handlers := b.handlers[eventType]
+	for i, h := range handlers {
+		if h == handler {
+			// 复制而不是原地修改，避免影响正在遍历旧切片的投递
+			next := make([]domain.EventHandler, 0, len(handlers)-1)
+			next = append(next, handlers[:i]...)
+			next = append(next, handlers[i+1:]...)
+			if len(next) == 0 {
+				delete(b.handlers, eventType)
+			} else {
+				b.handlers[eventType] = next
+			}
+			return nil
+		}
+	}
+	return ErrNotSubscribed
+}
+
+// Publish 同步投递，单个处理器失败不影响其余处理器，返回所有处理器错误的合并
+func (b *EventBus) Publish(ctx context.Context, event domain.DomainEvent) error {
+	b.mu.RLock()
+	closed := b.closed
+	b.mu.RUnlock()
+	if closed {
+		return ErrClosed
+	}
+	return b.dispatch(ctx, event)
+}
+
+// PublishAsync 异步投递，不等待处理器执行。ctx 只保留其中的值，不随调用方取消
+func (b *EventBus) PublishAsync(ctx context.Context, event domain.DomainEvent) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		b.drop(ctx, event)
+		return ErrClosed
+	}
+	select {
+	case b.queue <- asyncEvent{ctx: context.WithoutCancel(ctx), event: event}:
+		return nil
+	default:
+		b.drop(ctx, event)
+		return ErrQueueFull
+	}
+}
+
+// Close 停止接收新事件，等待队列中的事件处理完成或 ctx 到期
+func (b *EventBus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.queue)
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("eventbus: %d events left in queue: %w", len(b.queue), ctx.Err())
+	}
+}
+
+// Stats 各处理器的累计统计，按事件类型和处理器名排序
+func (b *EventBus) Stats() []HandlerStats {
+	b.statsMu.Lock()
+	defer b.statsMu.Unlock()
+
+	stats := make([]HandlerStats, 0, len(b.stats))
+	for _, s := range b.stats {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].EventType != stats[j].EventType {
+			return stats[i].EventType < stats[j].EventType
+		}
+		return stats[i].Handler < stats[j].Handler
+	})
+	return stats
+}
+
+func (b *EventBus) worker() {
+	defer b.wg.Done()
+	for e := range b.queue {
+		if err := b.dispatch(e.ctx, e.event); err != nil {
+			b.log.WithContext(e.ctx).Warnf("async event %s (%s) handling failed: %v",
+				e.event.GetEventID(), e.event.GetEventType(), err)
+		}
+	}
+}
+
+func (b *EventBus) dispatch(ctx context.Context, event domain.DomainEvent) error {
+	b.mu.RLock()
+	handlers := b.handlers[event.GetEventType()]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := b.invoke(ctx, handler, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// invoke 执行单个处理器，恢复 panic 并记录指标
+func (b *EventBus) invoke(ctx context.Context, handler domain.EventHandler, event domain.DomainEvent) (err error) {
+	name := fmt.Sprintf("%T", handler)
+	start := time.Now()
+	panicked := false
+
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			err = fmt.Errorf("handler %s panicked: %v", name, r)
+			b.log.WithContext(ctx).Errorf("event handler %s panicked on %s: %v", name, event.GetEventType(), r)
+		}
+		b.record(ctx, event.GetEventType(), name, time.Since(start), err, panicked)
+	}()
+
+	if err := handler.Handle(ctx, event); err != nil {
+		return fmt.Errorf("handler %s: %w", name, err)
+	}
+	return nil
+}
+
+func (b *EventBus) record(ctx context.Context, eventType, handler string, d time.Duration, err error, panicked bool) {
+	result := "success"
+	if panicked {
+		result = "panic"
+	} else if err != nil {
+		result = "failure"
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("event_type", eventType),
+		attribute.String("handler", handler),
+		attribute.String("result", result),
+	)
+	b.handledCounter.Add(ctx, 1, attrs)
+	b.handleDuration.Record(ctx, d.Seconds(), attrs)
+
+	b.statsMu.Lock()
+	defer b.statsMu.Unlock()
+	key := statsKey{eventType: eventType, handler: handler}
+	s, ok := b.stats[key]
+	if !ok {
+		s = &HandlerStats{EventType: eventType, Handler: handler}
+		b.stats[key] = s
+	}
+	s.Handled++
+	s.Duration += d
+	if err != nil {
+		s.Failed++
+	}
+	if panicked {
+		s.Panics++
+	}
+}
+
+func (b *EventBus) drop(ctx context.Context, event domain.DomainEvent) {
+	b.droppedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("event_type", event.GetEventType())))
+	b.log.WithContext(ctx).Warnf("drop async event %s (%s)", event.GetEventID(), event.GetEventType())
+}
+
+var _ domain.EventBus = (*EventBus)(nil)
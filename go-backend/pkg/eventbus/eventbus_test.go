@@ -0,0 +1,138 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go-backend/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingHandler struct {
+	name string
+
+	mu     sync.Mutex
+	events []string
+	err    error
+	panic  bool
+	block  chan struct{}
+	order  *[]string
+}
+
+func (h *recordingHandler) Handle(_ context.Context, event domain.DomainEvent) error {
+	if h.block != nil {
+		<-h.block
+	}
+	if h.panic {
+		panic("boom")
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, event.GetEventID())
+	if h.order != nil {
+		*h.order = append(*h.order, h.name)
+	}
+	return h.err
+}
+
+func (h *recordingHandler) GetEventType() string {
+	return "test"
+}
+
+func (h *recordingHandler) received() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.events...)
+}
+
+func newEvent(id string) domain.DomainEvent {
+	return &domain.BaseEvent{EventID: id, EventType: "test", EventTime: time.Now(), Version: 1}
+}
+
+func TestEventBus_Subscribe(t *testing.T) {
+	bus := New(nil, log.DefaultLogger)
+	defer bus.Close(context.Background())
+	h := &recordingHandler{}
+
+	require.NoError(t, bus.Subscribe("test", h))
+	assert.ErrorIs(t, bus.Subscribe("test", h), ErrAlreadySubscribed)
+	assert.ErrorIs(t, bus.Subscribe("test", nil), ErrNilHandler)
+
+	require.NoError(t, bus.Publish(context.Background(), newEvent("e1")))
+	require.NoError(t, bus.Unsubscribe("test", h))
+	assert.ErrorIs(t, bus.Unsubscribe("test", h), ErrNotSubscribed)
+	require.NoError(t, bus.Publish(context.Background(), newEvent("e2")))
+
+	assert.Equal(t, []string{"e1"}, h.received())
+}
+
+func TestEventBus_Publish(t *testing.T) {
+	ctx := context.Background()
+	bus := New(nil, log.DefaultLogger)
+	defer bus.Close(ctx)
+
+	var order []string
+	first := &recordingHandler{name: "first", order: &order}
+	failing := &recordingHandler{name: "failing", order: &order, err: errors.New("handler failed")}
+	panicking := &recordingHandler{name: "panicking", panic: true}
+	last := &recordingHandler{name: "last", order: &order}
+	for _, h := range []*recordingHandler{first, failing, panicking, last} {
+		require.NoError(t, bus.Subscribe("test", h))
+	}
+
+	err := bus.Publish(ctx, newEvent("e1"))
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "handler failed")
+	assert.ErrorContains(t, err, "panicked")
+	// 失败和 panic 不影响后续处理器，按订阅顺序执行
+	assert.Equal(t, []string{"first", "failing", "last"}, order)
+
+	stats := bus.Stats()
+	require.Len(t, stats, 1, "handlers share the same type name")
+	assert.Equal(t, int64(4), stats[0].Handled)
+	assert.Equal(t, int64(2), stats[0].Failed)
+	assert.Equal(t, int64(1), stats[0].Panics)
+}
+
+func TestEventBus_PublishAsync(t *testing.T) {
+	t.Run("DeliverAndDrainOnClose", func(t *testing.T) {
+		bus := New(&Config{Workers: 2, QueueSize: 10}, log.DefaultLogger)
+		h := &recordingHandler{}
+		require.NoError(t, bus.Subscribe("test", h))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		for _, id := range []string{"e1", "e2", "e3"} {
+			require.NoError(t, bus.PublishAsync(ctx, newEvent(id)))
+		}
+		// 调用方取消不影响已入队的事件
+		cancel()
+
+		require.NoError(t, bus.Close(context.Background()))
+		assert.ElementsMatch(t, []string{"e1", "e2", "e3"}, h.received())
+		assert.ErrorIs(t, bus.PublishAsync(context.Background(), newEvent("e4")), ErrClosed)
+		assert.ErrorIs(t, bus.Publish(context.Background(), newEvent("e5")), ErrClosed)
+	})
+
+	t.Run("QueueFull", func(t *testing.T) {
+		bus := New(&Config{Workers: 1, QueueSize: 1}, log.DefaultLogger)
+		h := &recordingHandler{block: make(chan struct{})}
+		require.NoError(t, bus.Subscribe("test", h))
+
+		ctx := context.Background()
+		require.NoError(t, bus.PublishAsync(ctx, newEvent("e1")))
+		// 等待工作协程取走 e1 并阻塞在处理器中
+		require.Eventually(t, func() bool { return len(bus.queue) == 0 }, time.Second, time.Millisecond)
+		require.NoError(t, bus.PublishAsync(ctx, newEvent("e2")))
+		assert.ErrorIs(t, bus.PublishAsync(ctx, newEvent("e3")), ErrQueueFull)
+
+		close(h.block)
+		require.NoError(t, bus.Close(ctx))
+		assert.Equal(t, []string{"e1", "e2"}, h.received())
+	})
+}
@@ -0,0 +1,110 @@
+// Package listquery 管理后台列表接口的过滤、排序和分页表达式。
+//
+// 过滤表达式由分号分隔的条件组成，每个条件为 字段:操作符:值，in 的多个值用逗号分隔：
+//
+//	status:eq:1;created_at:gte:1700000000;category:in:spam,other
+//
+// 排序表达式为逗号分隔的字段，前缀 - 表示降序：
+//
+//	-created_at,id
+//
+// 表达式先由 Parse 解析为 Query，再由各列表的 Schema 按字段白名单校验并转换为
+// 带类型的 Compiled，数据层只使用 Compiled 中的列名和值构造查询
+package listquery
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Op 过滤操作符
+type Op string
+
+const (
+	OpEq       Op = "eq"
+	OpNe       Op = "ne"
+	OpGt       Op = "gt"
+	OpGte      Op = "gte"
+	OpLt       Op = "lt"
+	OpLte      Op = "lte"
+	OpIn       Op = "in"
+	OpContains Op = "contains" // 字符串包含，不区分通配符
+)
+
+// 表达式的规模上限，避免构造过大的查询
+const (
+	MaxFilters  = 10
+	MaxSorts    = 3
+	MaxInValues = 50
+	MaxValueLen = 200
+)
+
+// Filter 单个过滤条件，Values 为未转换类型的原始值
+type Filter struct {
+	Field  string
+	Op     Op
+	Values []string
+}
+
+// Sort 排序字段
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// Query 解析后的列表请求，字段和值尚未校验
+type Query struct {
+	Filters []Filter
+	Sorts   []Sort
+	Page    int32
+	Size    int32
+}
+
+// Parse 解析过滤和排序表达式，表达式为空时只包含分页参数
+func Parse(filter, sort string, page, size int32) (*Query, error) {
+	q := &Query{Page: page, Size: size}
+
+	for _, clause := range splitNonEmpty(filter, ";") {
+		parts := strings.SplitN(clause, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("filter %q must be field:op:value", clause)
+		}
+
+		f := Filter{
+			Field: strings.TrimSpace(parts[0]),
+			Op:    Op(strings.ToLower(strings.TrimSpace(parts[1]))),
+		}
+		if f.Op == OpIn {
+			f.Values = splitNonEmpty(parts[2], ",")
+		} else {
+			f.Values = []string{strings.TrimSpace(parts[2])}
+		}
+		q.Filters = append(q.Filters, f)
+	}
+	if len(q.Filters) > MaxFilters {
+		return nil, fmt.Errorf("at most %d filters are allowed", MaxFilters)
+	}
+
+	for _, field := range splitNonEmpty(sort, ",") {
+		s := Sort{Field: field}
+		if strings.HasPrefix(field, "-") {
+			s = Sort{Field: strings.TrimSpace(field[1:]), Desc: true}
+		}
+		q.Sorts = append(q.Sorts, s)
+	}
+	if len(q.Sorts) > MaxSorts {
+		return nil, fmt.Errorf("at most %d sort fields are allowed", MaxSorts)
+	}
+
+	return q, nil
+}
+
+func splitNonEmpty(s, sep string) []string {
+	var result []string
+	for _, part := range strings.Split(s, sep) {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package listquery
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = NewSchema("id", []Sort{{Field: "created_at", Desc: true}},
+	Field{Name: "user_id", Column: "author_id", Type: Int},
+	Field{Name: "title", Type: String},
+	Field{Name: "hidden", Type: Bool},
+	Field{Name: "likes", Type: Int, Sortable: true},
+	Field{Name: "created_at", Type: Time},
+)
+
+func TestParse(t *testing.T) {
+	q, err := Parse(" user_id:eq:7 ; title:IN:a, b ,;note:eq:a:b ", "-likes, title", 2, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Filter{
+		{Field: "user_id", Op: OpEq, Values: []string{"7"}},
+		{Field: "title", Op: OpIn, Values: []string{"a", "b"}},
+		{Field: "note", Op: OpEq, Values: []string{"a:b"}},
+	}, q.Filters)
+	assert.Equal(t, []Sort{{Field: "likes", Desc: true}, {Field: "title"}}, q.Sorts)
+	assert.Equal(t, int32(2), q.Page)
+	assert.Equal(t, int32(10), q.Size)
+
+	q, err = Parse("", "", 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, q.Filters)
+	assert.Empty(t, q.Sorts)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("user_id=7", "", 1, 10)
+	assert.ErrorContains(t, err, "field:op:value")
+
+	_, err = Parse(strings.Repeat("likes:gt:1;", MaxFilters+1), "", 1, 10)
+	assert.ErrorContains(t, err, "filters")
+
+	_, err = Parse("", "a,b,c,d", 1, 10)
+	assert.ErrorContains(t, err, "sort")
+}
+
+func TestCompile(t *testing.T) {
+	q, err := Parse("user_id:in:1,2;hidden:eq:true;created_at:gte:1700000000;title:contains:go", "-likes", 3, 10)
+	require.NoError(t, err)
+
+	c, err := testSchema.Compile(q)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Condition{
+		{Column: "author_id", Op: OpIn, Values: []interface{}{int64(1), int64(2)}},
+		{Column: "hidden", Op: OpEq, Values: []interface{}{true}},
+		{Column: "created_at", Op: OpGte, Values: []interface{}{time.Unix(1700000000, 0)}},
+		{Column: "title", Op: OpContains, Values: []interface{}{"go"}},
+	}, c.Conditions)
+	assert.Equal(t, []Order{{Column: "likes", Desc: true}, {Column: "id", Desc: true}}, c.Orders)
+	assert.Equal(t, 20, c.Offset())
+}
+
+func TestCompileDefaults(t *testing.T) {
+	c, err := testSchema.Compile(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []Order{{Column: "created_at", Desc: true}, {Column: "id", Desc: true}}, c.Orders)
+	assert.Equal(t, int32(1), c.Page)
+	assert.Equal(t, int32(DefaultPageSize), c.Size)
+
+	c, err = testSchema.Compile(&Query{Sorts: []Sort{{Field: "created_at"}}, Size: MaxPageSize + 1})
+	require.NoError(t, err)
+	assert.Equal(t, []Order{{Column: "created_at"}, {Column: "id"}}, c.Orders)
+	assert.Equal(t, int32(DefaultPageSize), c.Size)
+}
+
+func TestCompileRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		sort   string
+		want   string
+	}{
+		{"UnknownField", "password:eq:x", "", "unknown filter field"},
+		{"ColumnNameNotAccepted", "author_id:eq:1", "", "unknown filter field"},
+		{"OperatorNotAllowed", "created_at:eq:1700000000", "", "not allowed"},
+		{"BoolOperator", "hidden:ne:true", "", "not allowed"},
+		{"UnknownOperator", "likes:like:1", "", "not allowed"},
+		{"BadInt", "likes:gt:1 OR 1=1", "", "invalid value"},
+		{"BadBool", "hidden:eq:yes", "", "invalid value"},
+		{"EmptyString", "title:eq:", "", "invalid value"},
+		{"LongString", "title:eq:" + strings.Repeat("a", MaxValueLen+1), "", "invalid value"},
+		{"EmptyIn", "title:in:,", "", "requires a value"},
+		{"TooManyIn", "user_id:in:" + strings.Repeat("1,", MaxInValues+1), "", "at most"},
+		{"UnsortableField", "", "title", "cannot sort"},
+		{"UnknownSort", "", "-id; DROP TABLE users", "cannot sort"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.filter, tt.sort, 1, 10)
+			require.NoError(t, err)
+
+			_, err = testSchema.Compile(q)
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package listquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FieldType 字段值类型
+type FieldType int
+
+const (
+	String FieldType = iota
+	Int
+	Bool
+	Time // 值为Unix时间戳（秒）
+)
+
+// 各类型默认允许的操作符
+var defaultOps = map[FieldType][]Op{
+	String: {OpEq, OpNe, OpIn, OpContains},
+	Int:    {OpEq, OpNe, OpGt, OpGte, OpLt, OpLte, OpIn},
+	Bool:   {OpEq},
+	Time:   {OpGt, OpGte, OpLt, OpLte},
+}
+
+// 分页默认值
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 50
+)
+
+// Field 可过滤或排序的字段
+type Field struct {
+	Name     string    // 表达式中的字段名
+	Column   string    // 数据库列名，为空时与字段名相同
+	Type     FieldType // 值类型
+	Ops      []Op      // 允许的操作符，为空时使用类型默认值
+	Sortable bool      // 是否允许排序
+}
+
+// Schema 列表接口的字段白名单和默认排序
+type Schema struct {
+	fields      map[string]Field
+	defaultSort []Sort
+	tieBreaker  string
+}
+
+// NewSchema 创建字段白名单。tieBreaker 为唯一列，追加在排序末尾保证翻页稳定；
+// defaultSort 为请求未指定排序时使用的排序，字段必须在白名单中
+func NewSchema(tieBreaker string, defaultSort []Sort, fields ...Field) *Schema {
+	s := &Schema{
+		fields:      make(map[string]Field, len(fields)),
+		defaultSort: defaultSort,
+		tieBreaker:  tieBreaker,
+	}
+	for _, f := range fields {
+		if f.Column == "" {
+			f.Column = f.Name
+		}
+		if len(f.Ops) == 0 {
+			f.Ops = defaultOps[f.Type]
+		}
+		s.fields[f.Name] = f
+	}
+	for _, sort := range defaultSort {
+		if _, ok := s.fields[sort.Field]; !ok {
+			panic(fmt.Sprintf("listquery: default sort field %q is not in schema", sort.Field))
+		}
+	}
+	return s
+}
+
+// Condition 已校验的过滤条件，Values 已转换为字段类型
+type Condition struct {
+	Column string
+	Op     Op
+	Values []interface{}
+}
+
+// Order 已校验的排序列
+type Order struct {
+	Column string
+	Desc   bool
+}
+
+// Compiled 已按白名单校验的列表请求
+type Compiled struct {
+	Conditions []Condition
+	Orders     []Order
+	Page       int32
+	Size       int32
+}
+
+// Offset 当前页的偏移量
+func (c *Compiled) Offset() int {
+	return int((c.Page - 1) * c.Size)
+}
+
+// Compile 校验字段、操作符和值并转换类型，页码和每页数量超出范围时使用默认值。
+// q 为 nil 时返回默认排序的第一页
+func (s *Schema) Compile(q *Query) (*Compiled, error) {
+	if q == nil {
+		q = &Query{}
+	}
+
+	c := &Compiled{Page: q.Page, Size: q.Size}
+	if c.Page <= 0 {
+		c.Page = 1
+	}
+	if c.Size <= 0 || c.Size > MaxPageSize {
+		c.Size = DefaultPageSize
+	}
+
+	for _, f := range q.Filters {
+		field, ok := s.fields[f.Field]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter field %q", f.Field)
+		}
+		if !containsOp(field.Ops, f.Op) {
+			return nil, fmt.Errorf("operator %q is not allowed on %q", f.Op, f.Field)
+		}
+		if len(f.Values) == 0 || (f.Op != OpIn && len(f.Values) != 1) {
+			return nil, fmt.Errorf("filter %q requires a value", f.Field)
+		}
+		if len(f.Values) > MaxInValues {
+			return nil, fmt.Errorf("filter %q allows at most %d values", f.Field, MaxInValues)
+		}
+
+		values := make([]interface{}, 0, len(f.Values))
+		for _, raw := range f.Values {
+			v, err := convertValue(field.Type, raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %q: %w", f.Field, err)
+			}
+			values = append(values, v)
+		}
+		c.Conditions = append(c.Conditions, Condition{Column: field.Column, Op: f.Op, Values: values})
+	}
+
+	sorts := q.Sorts
+	if len(sorts) == 0 {
+		sorts = s.defaultSort
+	}
+	seen := make(map[string]bool, len(sorts)+1)
+	for _, sort := range sorts {
+		field, ok := s.fields[sort.Field]
+		if !ok || (!field.Sortable && !s.isDefaultSort(sort.Field)) {
+			return nil, fmt.Errorf("cannot sort by %q", sort.Field)
+		}
+		if seen[field.Column] {
+			continue
+		}
+		seen[field.Column] = true
+		c.Orders = append(c.Orders, Order{Column: field.Column, Desc: sort.Desc})
+	}
+
+	// 追加唯一列，方向与最后一个排序列一致
+	if s.tieBreaker != "" && !seen[s.tieBreaker] {
+		desc := true
+		if len(c.Orders) > 0 {
+			desc = c.Orders[len(c.Orders)-1].Desc
+		}
+		c.Orders = append(c.Orders, Order{Column: s.tieBreaker, Desc: desc})
+	}
+
+	return c, nil
+}
+
+func (s *Schema) isDefaultSort(name string) bool {
+	for _, sort := range s.defaultSort {
+		if sort.Field == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsOp(ops []Op, op Op) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+func convertValue(t FieldType, raw string) (interface{}, error) {
+	switch t {
+	case Int:
+		return strconv.ParseInt(raw, 10, 64)
+	case Bool:
+		return strconv.ParseBool(raw)
+	case Time:
+		sec, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(sec, 0), nil
+	default:
+		if raw == "" || utf8.RuneCountInString(raw) > MaxValueLen {
+			return nil, fmt.Errorf("must be 1-%d characters", MaxValueLen)
+		}
+		return strings.TrimSpace(raw), nil
+	}
+}
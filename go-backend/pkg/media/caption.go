@@ -0,0 +1,119 @@
+package media
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CaptionCue 一个字幕段
+type CaptionCue struct {
+	Start time.Duration
+	End   time.Duration
+	Text  string
+}
+
+// captionTagRegex 字幕段中的样式和说话人标签，如 <b>、<v Alice>、<00:00:01.000>
+var captionTagRegex = regexp.MustCompile(`<[^>]*>`)
+
+// ParseCaptions 解析 WebVTT 或 SRT 字幕。两种格式都以空行分隔字幕段，段内 "-->" 所在行为时间轴，
+// 其后各行为文本；WebVTT 的文件头、NOTE、STYLE 等不含时间轴的段被忽略
+func ParseCaptions(content string) ([]CaptionCue, error) {
+	content = strings.TrimPrefix(content, "\uFEFF")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var cues []CaptionCue
+	for _, block := range strings.Split(content, "\n\n") {
+		lines := strings.Split(strings.Trim(block, "\n"), "\n")
+		timing := -1
+		for i, line := range lines {
+			if strings.Contains(line, "-->") {
+				timing = i
+				break
+			}
+		}
+		if timing < 0 {
+			continue
+		}
+
+		start, end, err := parseCueTiming(lines[timing])
+		if err != nil {
+			return nil, err
+		}
+
+		text := make([]string, 0, len(lines)-timing-1)
+		for _, line := range lines[timing+1:] {
+			line = strings.TrimSpace(captionTagRegex.ReplaceAllString(line, ""))
+			if line != "" {
+				text = append(text, line)
+			}
+		}
+		if len(text) == 0 {
+			continue
+		}
+
+		cues = append(cues, CaptionCue{Start: start, End: end, Text: strings.Join(text, " ")})
+	}
+
+	if len(cues) == 0 {
+		return nil, fmt.Errorf("no caption cues found")
+	}
+	return cues, nil
+}
+
+// parseCueTiming 解析时间轴行，WebVTT 结束时间后可能跟随位置等设置
+func parseCueTiming(line string) (time.Duration, time.Duration, error) {
+	parts := strings.SplitN(line, "-->", 2)
+	endFields := strings.Fields(parts[1])
+	if len(endFields) == 0 {
+		return 0, 0, fmt.Errorf("invalid cue timing: %q", line)
+	}
+
+	start, err := parseCueTimestamp(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseCueTimestamp(endFields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	if end <= start {
+		return 0, 0, fmt.Errorf("cue ends before it starts: %q", line)
+	}
+	return start, end, nil
+}
+
+// parseCueTimestamp 解析 [HH:]MM:SS.mmm 时间戳，SRT 使用逗号作为毫秒分隔符
+func parseCueTimestamp(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid cue timestamp: %q", s)
+
+	clock, millis, ok := strings.Cut(strings.Replace(s, ",", ".", 1), ".")
+	if !ok || len(millis) != 3 {
+		return 0, invalid
+	}
+	fields := strings.Split(clock, ":")
+	if len(fields) == 2 {
+		fields = append([]string{"0"}, fields...)
+	}
+	if len(fields) != 3 {
+		return 0, invalid
+	}
+
+	values := make([]int, 4)
+	for i, field := range append(fields, millis) {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return 0, invalid
+		}
+		values[i] = n
+	}
+	hours, minutes, seconds, ms := values[0], values[1], values[2], values[3]
+	if minutes >= 60 || seconds >= 60 {
+		return 0, invalid
+	}
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second + time.Duration(ms)*time.Millisecond, nil
+}
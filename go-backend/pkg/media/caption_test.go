@@ -0,0 +1,48 @@
+package media
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCaptions(t *testing.T) {
+	t.Run("WebVTT", func(t *testing.T) {
+		content := "WEBVTT\n\nNOTE 这段会被忽略\n\n" +
+			"intro\n00:01.500 --> 00:04.000 align:start\n<v Alice>大家好</v>\n欢迎回来\n\n" +
+			"01:00:00.000 --> 01:00:02.250\n<b>再见</b>\n"
+
+		cues, err := ParseCaptions(content)
+		require.NoError(t, err)
+		assert.Equal(t, []CaptionCue{
+			{Start: 1500 * time.Millisecond, End: 4 * time.Second, Text: "大家好 欢迎回来"},
+			{Start: time.Hour, End: time.Hour + 2250*time.Millisecond, Text: "再见"},
+		}, cues)
+	})
+
+	t.Run("SRT", func(t *testing.T) {
+		content := "1\r\n00:00:01,000 --> 00:00:02,500\r\nhello world\r\n\r\n2\r\n00:00:03,000 --> 00:00:04,000\r\nbye\r\n"
+
+		cues, err := ParseCaptions(content)
+		require.NoError(t, err)
+		require.Len(t, cues, 2)
+		assert.Equal(t, time.Second, cues[0].Start)
+		assert.Equal(t, "hello world", cues[0].Text)
+		assert.Equal(t, 4*time.Second, cues[1].End)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, content := range []string{
+			"",
+			"WEBVTT\n",
+			"00:00:05.000 --> 00:00:01.000\ntext",
+			"00:00:01 --> 00:00:02\ntext",
+			"00:61.000 --> 00:62.000\ntext",
+		} {
+			_, err := ParseCaptions(content)
+			assert.Error(t, err, content)
+		}
+	})
+}
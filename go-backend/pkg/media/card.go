@@ -0,0 +1,219 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Card 分享卡片内容
+type Card struct {
+	Title     string      // 主标题，如昵称或视频标题
+	Subtitle  string      // 副标题，如 @用户名
+	Image     image.Image // 头像或封面，为空时绘制首字母占位图
+	Stats     []CardStat  // 统计数据，最多展示4项
+	QRContent string      // 二维码内容，通常是分享落地页链接
+	Footer    string
+}
+
+// CardStat 卡片上的一项统计数据
+type CardStat struct {
+	Label string
+	Value string
+}
+
+const (
+	cardWidth     = 600
+	cardHeight    = 800
+	cardImageSize = 200
+	cardQRSize    = 200
+	cardMaxStats  = 4
+	cardPadding   = 40
+	// maxCardImageBytes 头像或封面原图的大小上限
+	maxCardImageBytes = 10 << 20
+)
+
+var (
+	cardBackgroundTop    = color.NRGBA{R: 22, G: 24, B: 35, A: 255}
+	cardBackgroundBottom = color.NRGBA{R: 58, G: 28, B: 72, A: 255}
+	cardTextColor        = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	cardMutedColor       = color.NRGBA{R: 170, G: 170, B: 190, A: 255}
+	cardAccentColor      = color.NRGBA{R: 254, G: 44, B: 85, A: 255}
+)
+
+// CardRenderer 分享卡片渲染器。内置点阵字体只包含 ASCII 字符，
+// 调用方应先用 CanRenderText 判断文本能否完整显示
+type CardRenderer struct {
+	quality int
+}
+
+// NewCardRenderer 创建分享卡片渲染器
+func NewCardRenderer(quality int) *CardRenderer {
+	if quality <= 0 || quality > 100 {
+		quality = 90
+	}
+	return &CardRenderer{quality: quality}
+}
+
+// CanRenderText 检查文本是否只包含内置字体能显示的字符
+func CanRenderText(text string) bool {
+	for _, r := range text {
+		if r < 0x20 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// DecodeCardImage 解码头像或封面原图，超过大小上限时返回错误
+func DecodeCardImage(reader io.Reader) (image.Image, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxCardImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxCardImageBytes {
+		return nil, fmt.Errorf("card image exceeds %d bytes", maxCardImageBytes)
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, fmt.Errorf("decode card image failed: %w", err)
+	}
+	return img, nil
+}
+
+// Render 渲染卡片并编码为 JPEG
+func (r *CardRenderer) Render(card *Card, w io.Writer) error {
+	img := imaging.New(cardWidth, cardHeight, cardBackgroundTop)
+	for y := 0; y < cardHeight; y++ {
+		c := blendColor(cardBackgroundTop, cardBackgroundBottom, float64(y)/float64(cardHeight-1))
+		for x := 0; x < cardWidth; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	imageTop := cardPadding
+	var picture image.Image
+	if card.Image != nil {
+		picture = imaging.Fill(card.Image, cardImageSize, cardImageSize, imaging.Center, imaging.Lanczos)
+	} else {
+		picture = placeholderImage(card.Subtitle+card.Title, cardImageSize)
+	}
+	draw.Draw(img, image.Rect((cardWidth-cardImageSize)/2, imageTop, (cardWidth+cardImageSize)/2, imageTop+cardImageSize),
+		picture, image.Point{}, draw.Src)
+
+	y := imageTop + cardImageSize + 30
+	drawCenteredText(img, card.Title, y, 3, cardTextColor)
+	y += 13*3 + 10
+	if card.Subtitle != "" {
+		drawCenteredText(img, card.Subtitle, y, 2, cardMutedColor)
+		y += 13*2 + 10
+	}
+
+	stats := card.Stats
+	if len(stats) > cardMaxStats {
+		stats = stats[:cardMaxStats]
+	}
+	if len(stats) > 0 {
+		y += 20
+		column := (cardWidth - cardPadding*2) / len(stats)
+		for i, stat := range stats {
+			center := cardPadding + column*i + column/2
+			drawText(img, stat.Value, center, y, 3, cardAccentColor, column)
+			drawText(img, stat.Label, center, y+13*3+6, 2, cardMutedColor, column)
+		}
+	}
+
+	if card.QRContent != "" {
+		qr, err := EncodeQR(card.QRContent)
+		if err != nil {
+			return fmt.Errorf("encode share qr failed: %w", err)
+		}
+		// 静区占8个模块，按卡片上预留的尺寸取最大的整数模块大小
+		moduleSize := cardQRSize / (qr.Size + 8)
+		code := qr.Image(moduleSize)
+		size := code.Bounds().Dx()
+		top := cardHeight - cardPadding - 13*2 - 10 - size
+		draw.Draw(img, image.Rect((cardWidth-size)/2, top, (cardWidth+size)/2, top+size), code, image.Point{}, draw.Src)
+	}
+
+	if card.Footer != "" {
+		drawCenteredText(img, card.Footer, cardHeight-cardPadding-13*2, 2, cardMutedColor)
+	}
+
+	var buf bytes.Buffer
+	if err := imaging.Encode(&buf, img, imaging.JPEG, imaging.JPEGQuality(r.quality)); err != nil {
+		return fmt.Errorf("encode share card failed: %w", err)
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// drawCenteredText 在卡片水平居中绘制文本
+func drawCenteredText(dst draw.Image, text string, top, scale int, c color.Color) {
+	drawText(dst, text, cardWidth/2, top, scale, c, cardWidth-cardPadding*2)
+}
+
+// drawText 以 center 为中心绘制放大的点阵文本，超出 maxWidth 时截断
+func drawText(dst draw.Image, text string, center, top, scale int, c color.Color, maxWidth int) {
+	face := basicfont.Face7x13
+	maxChars := maxWidth / (face.Advance * scale)
+	runes := []rune(text)
+	if len(runes) > maxChars && maxChars > 3 {
+		runes = append(runes[:maxChars-3], []rune("...")...)
+	}
+	text = string(runes)
+	if text == "" {
+		return
+	}
+
+	glyphs := image.NewNRGBA(image.Rect(0, 0, len(runes)*face.Advance, face.Height))
+	drawer := &font.Drawer{
+		Dst:  glyphs,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(0, face.Ascent),
+	}
+	drawer.DrawString(text)
+
+	scaled := imaging.Resize(glyphs, glyphs.Bounds().Dx()*scale, glyphs.Bounds().Dy()*scale, imaging.NearestNeighbor)
+	left := center - scaled.Bounds().Dx()/2
+	draw.Draw(dst, scaled.Bounds().Add(image.Pt(left, top)), scaled, image.Point{}, draw.Over)
+}
+
+// placeholderImage 没有头像或封面时，用名称首字母和由名称决定的底色生成占位图
+func placeholderImage(name string, size int) image.Image {
+	var hash uint32 = 2166136261
+	for i := 0; i < len(name); i++ {
+		hash = (hash ^ uint32(name[i])) * 16777619
+	}
+	background := color.NRGBA{R: uint8(80 + hash%120), G: uint8(80 + (hash>>8)%120), B: uint8(80 + (hash>>16)%120), A: 255}
+	img := imaging.New(size, size, background)
+
+	initial := "?"
+	for _, r := range strings.TrimLeft(name, "@ ") {
+		if CanRenderText(string(r)) {
+			initial = strings.ToUpper(string(r))
+		}
+		break
+	}
+	drawText(img, initial, size/2, (size-13*8)/2, 8, cardTextColor, size)
+
+	return img
+}
+
+func blendColor(from, to color.NRGBA, t float64) color.NRGBA {
+	mix := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*t)
+	}
+	return color.NRGBA{R: mix(from.R, to.R), G: mix(from.G, to.G), B: mix(from.B, to.B), A: 255}
+}
@@ -3,10 +3,12 @@ package media
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/disintegration/imaging"
@@ -49,15 +51,14 @@ func (f *FFmpegProcessor) GenerateThumbnail(ctx context.Context, input io.Reader
 
 	// 使用ffmpeg-go提取帧
 	buf := bytes.NewBuffer(nil)
-	err = ffmpeg.Input(inputFile).
+	err = f.run(ctx, ffmpeg.Input(inputFile).
 		Filter("select", ffmpeg.Args{fmt.Sprintf("gte(n,%d)", opts.SeekTime*30)}). // 假设30fps
 		Output("pipe:", ffmpeg.KwArgs{
 			"vframes": 1,
 			"format":  "image2",
 			"vcodec":  "mjpeg",
 		}).
-		WithOutput(buf).
-		Run()
+		WithOutput(buf))
 
 	if err != nil {
 		return fmt.Errorf("ffmpeg extract frame failed: %w", err)
@@ -69,8 +70,11 @@ func (f *FFmpegProcessor) GenerateThumbnail(ctx context.Context, input io.Reader
 		return fmt.Errorf("decode image failed: %w", err)
 	}
 
-	// 调整尺寸
-	thumbnail := imaging.Resize(img, opts.Width, opts.Height, imaging.Lanczos)
+	// 调整尺寸，未指定尺寸时输出原始画面
+	thumbnail := img
+	if opts.Width > 0 || opts.Height > 0 {
+		thumbnail = imaging.Resize(img, opts.Width, opts.Height, imaging.Lanczos)
+	}
 
 	// 编码输出
 	return imaging.Encode(output, thumbnail, imaging.JPEG, imaging.JPEGQuality(opts.Quality))
@@ -96,13 +100,13 @@ func (f *FFmpegProcessor) TranscodeVideo(ctx context.Context, input io.Reader, o
 	}
 
 	// 执行转码
-	err = stream.Output(outputFile, ffmpeg.KwArgs{
+	err = f.run(ctx, stream.Output(outputFile, ffmpeg.KwArgs{
 		"c:v":    "libx264",
 		"preset": "medium",
 		"crf":    "23",
 		"c:a":    "aac",
 		"b:a":    "128k",
-	}).OverWriteOutput().Run()
+	}).OverWriteOutput())
 
 	if err != nil {
 		return fmt.Errorf("ffmpeg transcode failed: %w", err)
@@ -118,6 +122,187 @@ func (f *FFmpegProcessor) TranscodeVideo(ctx context.Context, input io.Reader, o
 	return err
 }
 
+// GenerateAnimatedPreview 截取视频开头的片段生成动态封面（GIF 或动图 WebP），不含音频
+func (f *FFmpegProcessor) GenerateAnimatedPreview(ctx context.Context, input io.Reader, output io.Writer, opts *PreviewOptions) error {
+	o := opts.withDefaults()
+
+	inputFile, err := f.createTempFile(input, "input")
+	if err != nil {
+		return fmt.Errorf("create temp input file failed: %w", err)
+	}
+	defer os.Remove(inputFile)
+
+	outputFile := filepath.Join(f.tempDir, fmt.Sprintf("preview_%d%s", time.Now().UnixNano(), o.Format.Ext()))
+	defer os.Remove(outputFile)
+
+	args := ffmpeg.KwArgs{
+		"vf":   previewFilter(o),
+		"an":   "",
+		"loop": 0,
+		"f":    string(o.Format),
+	}
+	if o.Format == PreviewFormatWebP {
+		args["c:v"] = "libwebp"
+		args["quality"] = o.Quality
+		args["lossless"] = 0
+	}
+
+	err = f.run(ctx, ffmpeg.Input(inputFile, ffmpeg.KwArgs{
+		"ss": fmt.Sprintf("%.3f", o.StartSeconds),
+		"t":  fmt.Sprintf("%.3f", o.Seconds),
+	}).Output(outputFile, args).OverWriteOutput())
+	if err != nil {
+		return fmt.Errorf("ffmpeg generate animated preview failed: %w", err)
+	}
+
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		return fmt.Errorf("read output file failed: %w", err)
+	}
+
+	_, err = output.Write(data)
+	return err
+}
+
+// NormalizeAudio 按 EBU R128 归一化音轨响度：提取音轨，第一遍测量响度，第二遍按测量值线性归一化
+// 并编码为 AAC，再与原视频流重新封装为 Matroska，视频流直接复制不重新编码。
+// 没有音轨时返回 ErrNoAudioStream，静音时返回 ErrSilentAudio，调用方保留原音轨即可
+func (f *FFmpegProcessor) NormalizeAudio(ctx context.Context, input io.Reader, output io.Writer, opts *LoudnessOptions) (*LoudnessStats, error) {
+	o := opts.withDefaults()
+
+	inputFile, err := f.createTempFile(input, "input")
+	if err != nil {
+		return nil, fmt.Errorf("create temp input file failed: %w", err)
+	}
+	defer os.Remove(inputFile)
+
+	probeData, err := ffmpeg.Probe(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg probe failed: %w", err)
+	}
+	if !hasAudioStream(probeData) {
+		return nil, ErrNoAudioStream
+	}
+
+	workDir, err := os.MkdirTemp(f.tempDir, "loudnorm_*")
+	if err != nil {
+		return nil, fmt.Errorf("create loudnorm work dir failed: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	// 1. 提取第一条音轨为 PCM，两遍处理都读取同一份解码结果
+	audioFile := filepath.Join(workDir, "audio.wav")
+	err = f.run(ctx, ffmpeg.Input(inputFile).Output(audioFile, ffmpeg.KwArgs{
+		"map": "0:a:0",
+		"vn":  "",
+		"c:a": "pcm_s16le",
+	}).OverWriteOutput())
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg extract audio failed: %w", err)
+	}
+
+	// 2. 测量响度，结果输出在 stderr
+	var stderr bytes.Buffer
+	err = f.run(ctx, ffmpeg.Input(audioFile).Output("-", ffmpeg.KwArgs{
+		"af": loudnormFilter(o, nil),
+		"f":  "null",
+	}).WithErrorOutput(&stderr))
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg measure loudness failed: %w", err)
+	}
+	stats, err := parseLoudnormStats(stderr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 按测量值归一化，loudnorm 内部上采样到192kHz，输出时还原为48kHz
+	normalizedFile := filepath.Join(workDir, "audio.m4a")
+	err = f.run(ctx, ffmpeg.Input(audioFile).Output(normalizedFile, ffmpeg.KwArgs{
+		"af":  loudnormFilter(o, stats),
+		"ar":  48000,
+		"c:a": "aac",
+		"b:a": o.AudioBitrate,
+	}).OverWriteOutput())
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg normalize loudness failed: %w", err)
+	}
+
+	// 4. 重新封装，Matroska 可以容纳任意编码的原视频流
+	outputFile := filepath.Join(workDir, "output.mkv")
+	err = f.run(ctx, ffmpeg.Output([]*ffmpeg.Stream{
+		ffmpeg.Input(inputFile).Video(),
+		ffmpeg.Input(normalizedFile).Audio(),
+	}, outputFile, ffmpeg.KwArgs{"c": "copy"}).OverWriteOutput())
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg remux audio failed: %w", err)
+	}
+
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		return nil, fmt.Errorf("read output file failed: %w", err)
+	}
+	if _, err := output.Write(data); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// PackageHLS 将已转码的视频切成 HLS 播放列表和 TS 分片。输入需为 H.264/AAC 编码，
+// 切片时直接复制码流不重新编码。播放列表最后交给 fn，分片引用使用相对文件名
+func (f *FFmpegProcessor) PackageHLS(ctx context.Context, input io.Reader, opts *HLSOptions, fn HLSFileFunc) error {
+	segmentSeconds := defaultHLSSegmentSeconds
+	if opts != nil && opts.SegmentSeconds > 0 {
+		segmentSeconds = opts.SegmentSeconds
+	}
+
+	inputFile, err := f.createTempFile(input, "input")
+	if err != nil {
+		return fmt.Errorf("create temp input file failed: %w", err)
+	}
+	defer os.Remove(inputFile)
+
+	outputDir, err := os.MkdirTemp(f.tempDir, "hls_*")
+	if err != nil {
+		return fmt.Errorf("create hls output dir failed: %w", err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	err = f.run(ctx, ffmpeg.Input(inputFile).Output(filepath.Join(outputDir, HLSPlaylistName), ffmpeg.KwArgs{
+		"c":                    "copy",
+		"f":                    "hls",
+		"hls_time":             segmentSeconds,
+		"hls_playlist_type":    "vod",
+		"hls_segment_filename": filepath.Join(outputDir, hlsSegmentPattern),
+	}).OverWriteOutput())
+	if err != nil {
+		return fmt.Errorf("ffmpeg hls packaging failed: %w", err)
+	}
+
+	entries, err := os.ReadDir(outputDir)
+	if err != nil {
+		return fmt.Errorf("read hls output dir failed: %w", err)
+	}
+
+	// 先交出分片，播放列表可见时引用的分片都已就绪
+	for _, entry := range entries {
+		if entry.Name() == HLSPlaylistName {
+			continue
+		}
+		if err := f.emitFile(outputDir, entry.Name(), fn); err != nil {
+			return err
+		}
+	}
+	return f.emitFile(outputDir, HLSPlaylistName, fn)
+}
+
+func (f *FFmpegProcessor) emitFile(dir, name string, fn HLSFileFunc) error {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return fmt.Errorf("read hls file %s failed: %w", name, err)
+	}
+	return fn(name, data)
+}
+
 // GetVideoInfo 获取视频元信息
 func (f *FFmpegProcessor) GetVideoInfo(ctx context.Context, input io.Reader) (*VideoMetadata, error) {
 	inputFile, err := f.createTempFile(input, "probe")
@@ -132,11 +317,17 @@ func (f *FFmpegProcessor) GetVideoInfo(ctx context.Context, input io.Reader) (*V
 		return nil, fmt.Errorf("ffmpeg probe failed: %w", err)
 	}
 
-	// 解析probe数据转换为VideoMetadata
-	// 这里需要根据实际的probe返回格式进行解析
 	return f.parseProbeData(probeData)
 }
 
+// run 执行ffmpeg命令，并将进程CPU时间记入上下文中的用量
+func (f *FFmpegProcessor) run(ctx context.Context, stream *ffmpeg.Stream) error {
+	cmd := stream.Compile()
+	err := cmd.Run()
+	recordProcess(ctx, cmd.ProcessState)
+	return err
+}
+
 // createTempFile 创建临时文件
 func (f *FFmpegProcessor) createTempFile(reader io.Reader, prefix string) (string, error) {
 	tempFile, err := os.CreateTemp(f.tempDir, prefix+"_*.tmp")
@@ -154,16 +345,78 @@ func (f *FFmpegProcessor) createTempFile(reader io.Reader, prefix string) (strin
 	return tempFile.Name(), nil
 }
 
-// parseProbeData 解析ffmpeg probe数据
+// probeOutput ffprobe -show_format -show_streams 的JSON输出中用到的字段
+type probeOutput struct {
+	Streams []struct {
+		CodecType          string `json:"codec_type"`
+		CodecName          string `json:"codec_name"`
+		BitRate            string `json:"bit_rate"`
+		Width              int    `json:"width"`
+		Height             int    `json:"height"`
+		RFrameRate         string `json:"r_frame_rate"`
+		AvgFrameRate       string `json:"avg_frame_rate"`
+		DisplayAspectRatio string `json:"display_aspect_ratio"`
+	} `json:"streams"`
+	Format struct {
+		FormatName string `json:"format_name"`
+		Duration   string `json:"duration"`
+		Size       string `json:"size"`
+		BitRate    string `json:"bit_rate"`
+	} `json:"format"`
+}
+
+// parseProbeData 解析ffprobe输出，取第一路视频流的分辨率和帧率
 func (f *FFmpegProcessor) parseProbeData(probeData string) (*VideoMetadata, error) {
-	// 使用现有的VideoProcessor解析，避免重复实现
-	// vp := NewVideoProcessor(100*1024*1024, []string{"video/mp4", "video/avi"}, 480, 270, 80)
-
-	// TODO: 这里需要将probe数据转换为VideoMetadata
-	// 暂时返回基本结构，具体实现需要解析JSON
-	return &VideoMetadata{
-		Format: "mp4",
-		Width:  1920,
-		Height: 1080,
-	}, nil
+	var out probeOutput
+	if err := json.Unmarshal([]byte(probeData), &out); err != nil {
+		return nil, fmt.Errorf("parse probe output failed: %w", err)
+	}
+
+	metadata := &VideoMetadata{
+		Format:  out.Format.FormatName,
+		Bitrate: out.Format.BitRate,
+	}
+	// ffprobe 对无法确定的数值输出 N/A，解析失败时保持零值
+	metadata.Duration, _ = strconv.ParseFloat(out.Format.Duration, 64)
+	metadata.Size, _ = strconv.ParseInt(out.Format.Size, 10, 64)
+
+	// 音轨信息取第一路音频流，没有音轨时为空
+	for _, stream := range out.Streams {
+		if stream.CodecType == "audio" {
+			metadata.AudioCodec = stream.CodecName
+			metadata.AudioBitrate = stream.BitRate
+			break
+		}
+	}
+
+	for _, stream := range out.Streams {
+		if stream.CodecType != "video" {
+			continue
+		}
+		metadata.Width = stream.Width
+		metadata.Height = stream.Height
+		metadata.CodecName = stream.CodecName
+		metadata.AspectRatio = stream.DisplayAspectRatio
+		metadata.Framerate = stream.RFrameRate
+		if metadata.Framerate == "" {
+			metadata.Framerate = stream.AvgFrameRate
+		}
+		return metadata, nil
+	}
+
+	return nil, fmt.Errorf("no video stream found")
+}
+
+// hasAudioStream ffprobe 输出中是否有音频流，解析失败时按没有音轨处理
+func hasAudioStream(probeData string) bool {
+	var out probeOutput
+	if err := json.Unmarshal([]byte(probeData), &out); err != nil {
+		return false
+	}
+	for _, stream := range out.Streams {
+		if stream.CodecType == "audio" {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,54 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFFmpegProcessor_ParseProbeData(t *testing.T) {
+	f := NewFFmpegProcessor(t.TempDir())
+
+	t.Run("VideoStream", func(t *testing.T) {
+		probe := `{
+			"streams": [
+				{"codec_type": "audio", "codec_name": "aac", "bit_rate": "128000"},
+				{"codec_type": "video", "codec_name": "h264", "width": 1280, "height": 720,
+				 "r_frame_rate": "30/1", "avg_frame_rate": "30/1", "display_aspect_ratio": "16:9"}
+			],
+			"format": {"format_name": "mov,mp4,m4a,3gp,3g2,mj2", "duration": "12.480000", "size": "1048576", "bit_rate": "672164"}
+		}`
+
+		metadata, err := f.parseProbeData(probe)
+		require.NoError(t, err)
+		assert.InDelta(t, 12.48, metadata.Duration, 1e-9)
+		assert.Equal(t, 1280, metadata.Width)
+		assert.Equal(t, 720, metadata.Height)
+		assert.Equal(t, int64(1048576), metadata.Size)
+		assert.Equal(t, "672164", metadata.Bitrate)
+		assert.Equal(t, "h264", metadata.CodecName)
+		assert.Equal(t, "30/1", metadata.Framerate)
+		assert.Equal(t, "aac", metadata.AudioCodec)
+		assert.Equal(t, "128000", metadata.AudioBitrate)
+	})
+
+	t.Run("UnknownDuration", func(t *testing.T) {
+		probe := `{"streams": [{"codec_type": "video", "width": 640, "height": 360}], "format": {"duration": "N/A"}}`
+
+		metadata, err := f.parseProbeData(probe)
+		require.NoError(t, err)
+		assert.Zero(t, metadata.Duration)
+		assert.Equal(t, 360, metadata.Height)
+	})
+
+	t.Run("NoVideoStream", func(t *testing.T) {
+		_, err := f.parseProbeData(`{"streams": [{"codec_type": "audio"}], "format": {}}`)
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := f.parseProbeData("not json")
+		assert.Error(t, err)
+	})
+}
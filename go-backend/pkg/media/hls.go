@@ -0,0 +1,64 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+)
+
+// HLS 播放列表和分片的内容类型
+const (
+	HLSPlaylistContentType = "application/vnd.apple.mpegurl"
+	HLSSegmentContentType  = "video/mp2t"
+)
+
+// HLS 输出文件名，分片名按序号递增
+const (
+	HLSPlaylistName       = "index.m3u8"
+	HLSMasterPlaylistName = "master.m3u8"
+	hlsSegmentPattern     = "seg_%04d.ts"
+)
+
+// 默认分片时长（秒）
+const defaultHLSSegmentSeconds = 6
+
+// HLSOptions HLS切片选项
+type HLSOptions struct {
+	SegmentSeconds int // 分片时长，<=0 时使用默认值
+}
+
+// HLSFileFunc 处理切片产生的文件，name 为相对播放列表所在目录的文件名
+type HLSFileFunc func(name string, data []byte) error
+
+// HLSVariant 主播放列表中的一路码流
+type HLSVariant struct {
+	URI       string // 码流播放列表地址，相对主播放列表
+	Bandwidth int64  // 峰值码率（bps）
+	Width     int
+	Height    int
+}
+
+// BuildMasterPlaylist 生成自适应码率主播放列表，客户端按网络状况在各路码流间切换
+func BuildMasterPlaylist(variants []HLSVariant) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("#EXTM3U\n")
+	buf.WriteString("#EXT-X-VERSION:3\n")
+	for _, v := range variants {
+		fmt.Fprintf(&buf, "#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d\n", v.Bandwidth, v.Width, v.Height)
+		buf.WriteString(v.URI)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+// HLSContentType 按文件扩展名返回 HLS 文件的内容类型
+func HLSContentType(name string) string {
+	switch path.Ext(name) {
+	case ".m3u8":
+		return HLSPlaylistContentType
+	case ".ts":
+		return HLSSegmentContentType
+	default:
+		return "application/octet-stream"
+	}
+}
@@ -0,0 +1,28 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMasterPlaylist(t *testing.T) {
+	playlist := BuildMasterPlaylist([]HLSVariant{
+		{URI: "480p/index.m3u8", Bandwidth: 1400000, Width: 854, Height: 480},
+		{URI: "720p/index.m3u8", Bandwidth: 2800000, Width: 1280, Height: 720},
+	})
+
+	expected := "#EXTM3U\n" +
+		"#EXT-X-VERSION:3\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=1400000,RESOLUTION=854x480\n" +
+		"480p/index.m3u8\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=2800000,RESOLUTION=1280x720\n" +
+		"720p/index.m3u8\n"
+	assert.Equal(t, expected, string(playlist))
+}
+
+func TestHLSContentType(t *testing.T) {
+	assert.Equal(t, HLSPlaylistContentType, HLSContentType("index.m3u8"))
+	assert.Equal(t, HLSSegmentContentType, HLSContentType("seg_0001.ts"))
+	assert.Equal(t, "application/octet-stream", HLSContentType("readme"))
+}
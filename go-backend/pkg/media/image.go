@@ -0,0 +1,273 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	ffmpeg "github.com/u2takey/ffmpeg-go"
+	_ "golang.org/x/image/webp" // 注册 WebP 解码器
+)
+
+const (
+	// defaultMaxImageBytes 上传图片的默认大小上限
+	defaultMaxImageBytes = 5 << 20
+	// maxImagePixels 解码前检查的像素上限，防止小文件解码出超大图片
+	maxImagePixels = 40_000_000
+)
+
+var (
+	// ErrImageTooLarge 图片文件超过大小上限
+	ErrImageTooLarge = errors.New("image exceeds size limit")
+	// ErrUnsupportedImage 不支持的图片格式或图片已损坏
+	ErrUnsupportedImage = errors.New("unsupported image format")
+)
+
+// imageSignature 图片文件头
+type imageSignature struct {
+	contentType string
+	offset      int
+	magic       string
+}
+
+// imageSignatures 允许上传的图片类型，按文件头识别，不信任扩展名和客户端声明的类型。
+// WebP 为 RIFF 容器，需同时匹配偏移8处的格式标识
+var imageSignatures = []imageSignature{
+	{contentType: "image/jpeg", magic: "\xff\xd8\xff"},
+	{contentType: "image/png", magic: "\x89PNG\r\n\x1a\n"},
+	{contentType: "image/gif", magic: "GIF87a"},
+	{contentType: "image/gif", magic: "GIF89a"},
+	{contentType: "image/webp", offset: 8, magic: "WEBP"},
+}
+
+// DetectImageType 按文件头识别图片类型，不是支持的图片类型时返回false
+func DetectImageType(data []byte) (string, bool) {
+	for _, sig := range imageSignatures {
+		if sig.contentType == "image/webp" && !bytes.HasPrefix(data, []byte("RIFF")) {
+			continue
+		}
+		end := sig.offset + len(sig.magic)
+		if len(data) >= end && string(data[sig.offset:end]) == sig.magic {
+			return sig.contentType, true
+		}
+	}
+	return "", false
+}
+
+// ImageFormat 图片输出格式
+type ImageFormat string
+
+const (
+	ImageFormatJPEG ImageFormat = "jpeg"
+	ImageFormatWebP ImageFormat = "webp"
+)
+
+// ParseImageFormat 解析配置中的图片格式，无法识别时使用 JPEG
+func ParseImageFormat(format string) ImageFormat {
+	if strings.EqualFold(format, string(ImageFormatWebP)) {
+		return ImageFormatWebP
+	}
+	return ImageFormatJPEG
+}
+
+// ImageOptions 图片处理参数
+type ImageOptions struct {
+	Width       int         // 目标宽度上限
+	Height      int         // 目标高度上限
+	Crop        bool        // 为 true 时居中裁剪为目标宽高比，否则等比缩放到范围内
+	AspectRatio float64     // 宽高比，大于0时先居中裁剪为该比例再缩放，如 9:16 为 0.5625
+	Format      ImageFormat // 输出格式，为空时使用 JPEG
+	Quality     int         // 编码质量，为0时使用处理器的默认质量
+}
+
+// ProcessedImage 处理后的图片
+type ProcessedImage struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+	Width       int
+	Height      int
+}
+
+// Size 图片字节数
+func (i *ProcessedImage) Size() int64 {
+	return int64(len(i.Data))
+}
+
+// webpEncoder WebP 编码函数
+type webpEncoder func(img image.Image, quality int) ([]byte, error)
+
+// ImageProcessor 图片处理器，用于头像、封面替换和截帧缩略图：按文件头校验类型和大小，
+// 按 EXIF 方向摆正后裁剪缩放，再重新编码为 JPEG 或 WebP。重新编码会丢弃原图中的
+// EXIF（含拍摄位置）等元数据，也保证存储的内容与声明的类型一致
+type ImageProcessor struct {
+	maxBytes int64
+	quality  int
+	webp     webpEncoder
+}
+
+// NewImageProcessor 创建图片处理器
+func NewImageProcessor(maxBytes int64, quality int) *ImageProcessor {
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxImageBytes
+	}
+	if quality <= 0 || quality > 100 {
+		quality = 85
+	}
+	return &ImageProcessor{
+		maxBytes: maxBytes,
+		quality:  quality,
+		webp:     encodeWebP,
+	}
+}
+
+// MaxBytes 图片大小上限
+func (p *ImageProcessor) MaxBytes() int64 {
+	return p.maxBytes
+}
+
+// Process 读取并处理图片
+func (p *ImageProcessor) Process(reader io.Reader, opts ImageOptions) (*ProcessedImage, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, p.maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > p.maxBytes {
+		return nil, ErrImageTooLarge
+	}
+
+	img, err := p.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return p.Encode(resizeImage(img, opts), opts)
+}
+
+// Decode 校验文件头和像素数后解码图片，并按 EXIF 方向摆正
+func (p *ImageProcessor) Decode(data []byte) (image.Image, error) {
+	if _, ok := DetectImageType(data); !ok {
+		return nil, ErrUnsupportedImage
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+	return img, nil
+}
+
+// Encode 按参数中的格式和质量编码图片。WebP 编码依赖 ffmpeg 的 libwebp，
+// 编码失败时退回 JPEG，返回结果中的类型和扩展名为实际编码格式
+func (p *ImageProcessor) Encode(img image.Image, opts ImageOptions) (*ProcessedImage, error) {
+	quality := p.quality
+	if opts.Quality > 0 && opts.Quality <= 100 {
+		quality = opts.Quality
+	}
+
+	if opts.Format == ImageFormatWebP && p.webp != nil {
+		if data, err := p.webp(img, quality); err == nil {
+			return &ProcessedImage{
+				Data:        data,
+				ContentType: "image/webp",
+				Ext:         ".webp",
+				Width:       img.Bounds().Dx(),
+				Height:      img.Bounds().Dy(),
+			}, nil
+		}
+	}
+
+	// JPEG 不支持透明通道，透明区域铺白底
+	canvas := imaging.New(img.Bounds().Dx(), img.Bounds().Dy(), color.White)
+	canvas = imaging.Overlay(canvas, img, image.Pt(0, 0), 1.0)
+
+	var buf bytes.Buffer
+	if err := imaging.Encode(&buf, canvas, imaging.JPEG, imaging.JPEGQuality(quality)); err != nil {
+		return nil, fmt.Errorf("encode image failed: %w", err)
+	}
+
+	return &ProcessedImage{
+		Data:        buf.Bytes(),
+		ContentType: "image/jpeg",
+		Ext:         ".jpg",
+		Width:       canvas.Bounds().Dx(),
+		Height:      canvas.Bounds().Dy(),
+	}, nil
+}
+
+// resizeImage 按参数裁剪和缩放图片，不放大小图
+func resizeImage(img image.Image, opts ImageOptions) image.Image {
+	if opts.AspectRatio > 0 {
+		img = cropToAspectRatio(img, opts.AspectRatio)
+	}
+	if opts.Width <= 0 || opts.Height <= 0 {
+		return img
+	}
+	srcW, srcH := img.Bounds().Dx(), img.Bounds().Dy()
+
+	if !opts.Crop {
+		return imaging.Fit(img, opts.Width, opts.Height, imaging.Lanczos)
+	}
+
+	// 原图不足目标尺寸时按比例缩小目标，只裁剪不放大
+	width, height := opts.Width, opts.Height
+	scale := min(float64(srcW)/float64(width), float64(srcH)/float64(height))
+	if scale < 1 {
+		width = max(1, int(float64(width)*scale))
+		height = max(1, int(float64(height)*scale))
+	}
+	return imaging.Fill(img, width, height, imaging.Center, imaging.Lanczos)
+}
+
+// cropToAspectRatio 居中裁剪为指定宽高比，保留尽可能多的画面
+func cropToAspectRatio(img image.Image, ratio float64) image.Image {
+	srcW, srcH := img.Bounds().Dx(), img.Bounds().Dy()
+	width, height := srcW, int(float64(srcW)/ratio+0.5)
+	if height > srcH {
+		width, height = int(float64(srcH)*ratio+0.5), srcH
+	}
+	width, height = max(1, width), max(1, height)
+	if width == srcW && height == srcH {
+		return img
+	}
+	return imaging.CropCenter(img, width, height)
+}
+
+// encodeWebP 通过 ffmpeg 的 libwebp 编码器将图片编码为 WebP，图片以 PNG 经管道传入
+func encodeWebP(img image.Image, quality int) ([]byte, error) {
+	var input bytes.Buffer
+	if err := imaging.Encode(&input, img, imaging.PNG); err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	err := ffmpeg.Input("pipe:", ffmpeg.KwArgs{"f": "png_pipe"}).
+		Output("pipe:", ffmpeg.KwArgs{
+			"c:v":     "libwebp",
+			"quality": quality,
+			"f":       "webp",
+		}).
+		WithInput(&input).
+		WithOutput(&output).
+		Silent(true).
+		Run()
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg encode webp failed: %w", err)
+	}
+	if _, ok := DetectImageType(output.Bytes()); !ok {
+		return nil, errors.New("ffmpeg produced invalid webp")
+	}
+	return output.Bytes(), nil
+}
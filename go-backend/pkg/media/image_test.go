@@ -0,0 +1,196 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/disintegration/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestPNG(t *testing.T, width, height int, c color.Color) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, imaging.New(width, height, c)))
+	return buf.Bytes()
+}
+
+func TestImageProcessor_Process(t *testing.T) {
+	p := NewImageProcessor(1<<20, 80)
+
+	t.Run("crop to square", func(t *testing.T) {
+		data := encodeTestPNG(t, 800, 600, color.NRGBA{R: 255, A: 255})
+
+		out, err := p.Process(bytes.NewReader(data), ImageOptions{Width: 256, Height: 256, Crop: true})
+		require.NoError(t, err)
+		assert.Equal(t, "image/jpeg", out.ContentType)
+		assert.Equal(t, ".jpg", out.Ext)
+		assert.Equal(t, 256, out.Width)
+		assert.Equal(t, 256, out.Height)
+
+		img, format, err := image.Decode(bytes.NewReader(out.Data))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, image.Rect(0, 0, 256, 256), img.Bounds())
+	})
+
+	t.Run("fit keeps aspect ratio", func(t *testing.T) {
+		data := encodeTestPNG(t, 1000, 500, color.NRGBA{G: 255, A: 255})
+
+		out, err := p.Process(bytes.NewReader(data), ImageOptions{Width: 400, Height: 400})
+		require.NoError(t, err)
+		assert.Equal(t, 400, out.Width)
+		assert.Equal(t, 200, out.Height)
+	})
+
+	t.Run("small image not upscaled", func(t *testing.T) {
+		data := encodeTestPNG(t, 100, 50, color.NRGBA{B: 255, A: 255})
+
+		out, err := p.Process(bytes.NewReader(data), ImageOptions{Width: 256, Height: 256, Crop: true})
+		require.NoError(t, err)
+		assert.Equal(t, 50, out.Width)
+		assert.Equal(t, 50, out.Height)
+	})
+
+	t.Run("transparent flattened to white", func(t *testing.T) {
+		data := encodeTestPNG(t, 20, 20, color.NRGBA{})
+
+		out, err := p.Process(bytes.NewReader(data), ImageOptions{})
+		require.NoError(t, err)
+		img, _, err := image.Decode(bytes.NewReader(out.Data))
+		require.NoError(t, err)
+		r, g, b, _ := img.At(10, 10).RGBA()
+		assert.Greater(t, r>>8, uint32(240))
+		assert.Greater(t, g>>8, uint32(240))
+		assert.Greater(t, b>>8, uint32(240))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		small := NewImageProcessor(64, 80)
+		data := encodeTestPNG(t, 200, 200, color.NRGBA{R: 1, A: 255})
+
+		_, err := small.Process(bytes.NewReader(data), ImageOptions{})
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := p.Process(bytes.NewReader([]byte("<svg xmlns='http://www.w3.org/2000/svg'/>")), ImageOptions{})
+		assert.ErrorIs(t, err, ErrUnsupportedImage)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		data := encodeTestPNG(t, 20, 20, color.NRGBA{A: 255})
+
+		_, err := p.Process(bytes.NewReader(data[:len(data)/2]), ImageOptions{})
+		assert.ErrorIs(t, err, ErrUnsupportedImage)
+	})
+}
+
+func TestImageProcessor_Options(t *testing.T) {
+	p := NewImageProcessor(1<<20, 80)
+	data := encodeTestPNG(t, 1000, 1000, color.NRGBA{R: 200, G: 100, B: 50, A: 255})
+
+	t.Run("aspect ratio", func(t *testing.T) {
+		out, err := p.Process(bytes.NewReader(data), ImageOptions{AspectRatio: 9.0 / 16.0})
+		require.NoError(t, err)
+		assert.Equal(t, 563, out.Width)
+		assert.Equal(t, 1000, out.Height)
+
+		out, err = p.Process(bytes.NewReader(data), ImageOptions{AspectRatio: 9.0 / 16.0, Width: 360, Height: 640, Crop: true})
+		require.NoError(t, err)
+		assert.Equal(t, 360, out.Width)
+		assert.Equal(t, 640, out.Height)
+	})
+
+	t.Run("quality", func(t *testing.T) {
+		noisy := imaging.New(300, 300, color.White)
+		for i := 0; i < 300*300; i += 7 {
+			noisy.Pix[i*4] = uint8(i)
+		}
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, noisy))
+
+		low, err := p.Process(bytes.NewReader(buf.Bytes()), ImageOptions{Quality: 10})
+		require.NoError(t, err)
+		high, err := p.Process(bytes.NewReader(buf.Bytes()), ImageOptions{Quality: 100})
+		require.NoError(t, err)
+		assert.Less(t, low.Size(), high.Size())
+	})
+
+	t.Run("webp", func(t *testing.T) {
+		webp := NewImageProcessor(1<<20, 80)
+		var quality int
+		webp.webp = func(img image.Image, q int) ([]byte, error) {
+			quality = q
+			return []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), nil
+		}
+
+		out, err := webp.Process(bytes.NewReader(data), ImageOptions{Width: 100, Height: 100, Format: ImageFormatWebP, Quality: 70})
+		require.NoError(t, err)
+		assert.Equal(t, "image/webp", out.ContentType)
+		assert.Equal(t, ".webp", out.Ext)
+		assert.Equal(t, 70, quality)
+		assert.Equal(t, 100, out.Width)
+	})
+
+	t.Run("webp falls back to jpeg", func(t *testing.T) {
+		webp := NewImageProcessor(1<<20, 80)
+		webp.webp = func(image.Image, int) ([]byte, error) {
+			return nil, errors.New("libwebp not available")
+		}
+
+		out, err := webp.Process(bytes.NewReader(data), ImageOptions{Format: ImageFormatWebP})
+		require.NoError(t, err)
+		assert.Equal(t, "image/jpeg", out.ContentType)
+		assert.Equal(t, ".jpg", out.Ext)
+	})
+
+	t.Run("exif stripped", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, imaging.New(50, 50, color.Black), nil))
+		// 在 SOI 之后插入带GPS信息的 APP1 段
+		exif := append([]byte("Exif\x00\x00"), []byte("GPSLatitude=31.2304")...)
+		segment := append([]byte{0xff, 0xe1, byte((len(exif) + 2) >> 8), byte(len(exif) + 2)}, exif...)
+		withExif := append(append(append([]byte{}, buf.Bytes()[:2]...), segment...), buf.Bytes()[2:]...)
+
+		out, err := p.Process(bytes.NewReader(withExif), ImageOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, string(out.Data), "Exif")
+		assert.NotContains(t, string(out.Data), "GPSLatitude")
+	})
+}
+
+func TestDetectImageType(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"jpeg", "\xff\xd8\xff\xe0", "image/jpeg"},
+		{"png", "\x89PNG\r\n\x1a\n\x00", "image/png"},
+		{"gif", "GIF89a\x01\x00", "image/gif"},
+		{"webp", "RIFF\x24\x00\x00\x00WEBPVP8 ", "image/webp"},
+		{"wav", "RIFF\x24\x00\x00\x00WAVEfmt ", ""},
+		{"svg", "<svg xmlns='http://www.w3.org/2000/svg'/>", ""},
+		{"short", "\xff\xd8", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DetectImageType([]byte(tt.data))
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want != "", ok)
+		})
+	}
+}
+
+func TestParseImageFormat(t *testing.T) {
+	assert.Equal(t, ImageFormatWebP, ParseImageFormat("WebP"))
+	assert.Equal(t, ImageFormatJPEG, ParseImageFormat("jpeg"))
+	assert.Equal(t, ImageFormatJPEG, ParseImageFormat(""))
+}
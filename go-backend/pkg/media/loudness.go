@@ -0,0 +1,122 @@
+package media
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EBU R128 响度归一化默认参数
+const (
+	defaultTargetLoudness = -23.0 // 综合响度（LUFS）
+	defaultTruePeak       = -1.0  // 真峰值上限（dBTP）
+	defaultLoudnessRange  = 11.0  // 响度范围（LU）
+	defaultAudioBitrate   = "128k"
+)
+
+var (
+	// ErrNoAudioStream 视频没有音轨
+	ErrNoAudioStream = errors.New("no audio stream found")
+	// ErrSilentAudio 音轨为静音，无法测量响度
+	ErrSilentAudio = errors.New("audio is silent")
+)
+
+// LoudnessOptions 响度归一化选项，零值字段使用 EBU R128 推荐值
+type LoudnessOptions struct {
+	TargetLoudness float64 // 目标综合响度（LUFS），如 -23，短视频平台常用 -14 ~ -16
+	TruePeak       float64 // 真峰值上限（dBTP）
+	LoudnessRange  float64 // 目标响度范围（LU）
+	AudioBitrate   string  // 输出 AAC 码率，如 128k
+}
+
+// withDefaults 补全默认值
+func (o *LoudnessOptions) withDefaults() LoudnessOptions {
+	opts := LoudnessOptions{}
+	if o != nil {
+		opts = *o
+	}
+	if opts.TargetLoudness >= 0 {
+		opts.TargetLoudness = defaultTargetLoudness
+	}
+	if opts.TruePeak >= 0 {
+		opts.TruePeak = defaultTruePeak
+	}
+	if opts.LoudnessRange <= 0 {
+		opts.LoudnessRange = defaultLoudnessRange
+	}
+	if opts.AudioBitrate == "" {
+		opts.AudioBitrate = defaultAudioBitrate
+	}
+	return opts
+}
+
+// LoudnessStats 第一遍测量得到的原始音轨响度
+type LoudnessStats struct {
+	InputI       float64 // 综合响度（LUFS）
+	InputTP      float64 // 真峰值（dBTP）
+	InputLRA     float64 // 响度范围（LU）
+	InputThresh  float64 // 门限（LUFS）
+	TargetOffset float64 // 目标偏移（LU）
+}
+
+// loudnormFilter 生成 loudnorm 滤镜参数。stats 为空时为测量遍，输出 JSON 格式的测量结果；
+// 否则为归一化遍，传入测量值做线性归一化，避免动态压缩改变音色
+func loudnormFilter(opts LoudnessOptions, stats *LoudnessStats) string {
+	filter := fmt.Sprintf("loudnorm=I=%s:TP=%s:LRA=%s",
+		formatLoudness(opts.TargetLoudness), formatLoudness(opts.TruePeak), formatLoudness(opts.LoudnessRange))
+	if stats == nil {
+		return filter + ":print_format=json"
+	}
+	return filter + fmt.Sprintf(":measured_I=%s:measured_TP=%s:measured_LRA=%s:measured_thresh=%s:offset=%s:linear=true",
+		formatLoudness(stats.InputI), formatLoudness(stats.InputTP), formatLoudness(stats.InputLRA),
+		formatLoudness(stats.InputThresh), formatLoudness(stats.TargetOffset))
+}
+
+func formatLoudness(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// parseLoudnormStats 从 ffmpeg 的错误输出中解析 loudnorm 测量结果，结果为最后一个 JSON 对象，
+// 数值以字符串输出，静音时综合响度为 -inf
+func parseLoudnormStats(stderr string) (*LoudnessStats, error) {
+	start := strings.LastIndex(stderr, "{")
+	end := strings.LastIndex(stderr, "}")
+	if start < 0 || end < start {
+		return nil, errors.New("loudnorm stats not found")
+	}
+
+	var raw struct {
+		InputI       string `json:"input_i"`
+		InputTP      string `json:"input_tp"`
+		InputLRA     string `json:"input_lra"`
+		InputThresh  string `json:"input_thresh"`
+		TargetOffset string `json:"target_offset"`
+	}
+	if err := json.Unmarshal([]byte(stderr[start:end+1]), &raw); err != nil {
+		return nil, fmt.Errorf("parse loudnorm stats failed: %w", err)
+	}
+
+	values := []string{raw.InputI, raw.InputTP, raw.InputLRA, raw.InputThresh, raw.TargetOffset}
+	parsed := make([]float64, len(values))
+	for i, value := range values {
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse loudnorm value %q failed: %w", value, err)
+		}
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, ErrSilentAudio
+		}
+		parsed[i] = v
+	}
+
+	return &LoudnessStats{
+		InputI:       parsed[0],
+		InputTP:      parsed[1],
+		InputLRA:     parsed[2],
+		InputThresh:  parsed[3],
+		TargetOffset: parsed[4],
+	}, nil
+}
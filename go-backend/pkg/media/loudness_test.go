@@ -0,0 +1,62 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLoudnormStats(t *testing.T) {
+	t.Run("Stats", func(t *testing.T) {
+		stderr := `size=N/A time=00:00:12.48 bitrate=N/A speed= 312x
+[Parsed_loudnorm_0 @ 0x55d0c8e3c2c0]
+{
+	"input_i" : "-27.61",
+	"input_tp" : "-4.47",
+	"input_lra" : "18.06",
+	"input_thresh" : "-39.20",
+	"output_i" : "-16.58",
+	"output_tp" : "-1.50",
+	"output_lra" : "14.78",
+	"output_thresh" : "-27.71",
+	"normalization_type" : "dynamic",
+	"target_offset" : "0.58"
+}`
+		stats, err := parseLoudnormStats(stderr)
+		require.NoError(t, err)
+		assert.Equal(t, &LoudnessStats{InputI: -27.61, InputTP: -4.47, InputLRA: 18.06, InputThresh: -39.20, TargetOffset: 0.58}, stats)
+	})
+
+	t.Run("Silent", func(t *testing.T) {
+		stderr := `{"input_i" : "-inf", "input_tp" : "-inf", "input_lra" : "0.00", "input_thresh" : "-70.00", "target_offset" : "inf"}`
+		_, err := parseLoudnormStats(stderr)
+		assert.ErrorIs(t, err, ErrSilentAudio)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := parseLoudnormStats("Stream map '0:a:0' matches no streams.")
+		assert.Error(t, err)
+	})
+}
+
+func TestLoudnormFilter(t *testing.T) {
+	opts := (*LoudnessOptions)(nil).withDefaults()
+	assert.Equal(t, LoudnessOptions{TargetLoudness: -23, TruePeak: -1, LoudnessRange: 11, AudioBitrate: "128k"}, opts)
+
+	assert.Equal(t, "loudnorm=I=-23.00:TP=-1.00:LRA=11.00:print_format=json", loudnormFilter(opts, nil))
+
+	stats := &LoudnessStats{InputI: -27.61, InputTP: -4.47, InputLRA: 18.06, InputThresh: -39.2, TargetOffset: 0.58}
+	assert.Equal(t,
+		"loudnorm=I=-23.00:TP=-1.00:LRA=11.00:measured_I=-27.61:measured_TP=-4.47:measured_LRA=18.06:measured_thresh=-39.20:offset=0.58:linear=true",
+		loudnormFilter(opts, stats))
+
+	custom := (&LoudnessOptions{TargetLoudness: -14, TruePeak: -2, LoudnessRange: 7, AudioBitrate: "192k"}).withDefaults()
+	assert.Equal(t, "loudnorm=I=-14.00:TP=-2.00:LRA=7.00:print_format=json", loudnormFilter(custom, nil))
+}
+
+func TestHasAudioStream(t *testing.T) {
+	assert.True(t, hasAudioStream(`{"streams": [{"codec_type": "video"}, {"codec_type": "audio"}]}`))
+	assert.False(t, hasAudioStream(`{"streams": [{"codec_type": "video"}]}`))
+	assert.False(t, hasAudioStream("not json"))
+}
@@ -0,0 +1,89 @@
+package media
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 动态封面默认参数：截取开头3秒，宽320像素，每秒10帧
+const (
+	defaultPreviewSeconds = 3
+	maxPreviewSeconds     = 5
+	defaultPreviewWidth   = 320
+	defaultPreviewFPS     = 10
+	defaultPreviewQuality = 60
+)
+
+// PreviewFormat 动态封面格式
+type PreviewFormat string
+
+const (
+	PreviewFormatGIF  PreviewFormat = "gif"
+	PreviewFormatWebP PreviewFormat = "webp"
+)
+
+// ParsePreviewFormat 解析配置中的动态封面格式，无法识别时使用 GIF
+func ParsePreviewFormat(format string) PreviewFormat {
+	if strings.EqualFold(format, string(PreviewFormatWebP)) {
+		return PreviewFormatWebP
+	}
+	return PreviewFormatGIF
+}
+
+// Ext 文件扩展名
+func (f PreviewFormat) Ext() string {
+	return "." + string(f)
+}
+
+// ContentType 内容类型
+func (f PreviewFormat) ContentType() string {
+	return "image/" + string(f)
+}
+
+// PreviewOptions 动态封面选项，零值字段使用默认值
+type PreviewOptions struct {
+	StartSeconds float64 // 起始时间点（秒）
+	Seconds      float64 // 时长（秒），不超过5秒
+	Width        int     // 输出宽度，高度按比例缩放
+	FPS          int     // 帧率
+	Format       PreviewFormat
+	Quality      int // 仅对 WebP 有效
+}
+
+// withDefaults 补全默认值
+func (o *PreviewOptions) withDefaults() PreviewOptions {
+	opts := PreviewOptions{}
+	if o != nil {
+		opts = *o
+	}
+	if opts.StartSeconds < 0 {
+		opts.StartSeconds = 0
+	}
+	if opts.Seconds <= 0 {
+		opts.Seconds = defaultPreviewSeconds
+	}
+	opts.Seconds = min(opts.Seconds, maxPreviewSeconds)
+	if opts.Width <= 0 {
+		opts.Width = defaultPreviewWidth
+	}
+	if opts.FPS <= 0 {
+		opts.FPS = defaultPreviewFPS
+	}
+	if opts.Format != PreviewFormatWebP {
+		opts.Format = PreviewFormatGIF
+	}
+	if opts.Quality <= 0 || opts.Quality > 100 {
+		opts.Quality = defaultPreviewQuality
+	}
+	return opts
+}
+
+// previewFilter 动态封面的滤镜：降帧率并等比缩放，高度取偶数。
+// GIF 只有256色，先按片段生成调色板再映射，避免默认调色板的色带和噪点
+func previewFilter(opts PreviewOptions) string {
+	filter := fmt.Sprintf("fps=%d,scale=%d:-2:flags=lanczos", opts.FPS, opts.Width)
+	if opts.Format == PreviewFormatGIF {
+		filter += ",split[a][b];[a]palettegen=stats_mode=diff[p];[b][p]paletteuse=dither=bayer"
+	}
+	return filter
+}
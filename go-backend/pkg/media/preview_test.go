@@ -0,0 +1,35 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewOptions_WithDefaults(t *testing.T) {
+	opts := (*PreviewOptions)(nil).withDefaults()
+	assert.Equal(t, PreviewOptions{Seconds: 3, Width: 320, FPS: 10, Format: PreviewFormatGIF, Quality: 60}, opts)
+
+	opts = (&PreviewOptions{StartSeconds: -1, Seconds: 10, Width: 240, Format: PreviewFormatWebP, Quality: 80}).withDefaults()
+	assert.Zero(t, opts.StartSeconds)
+	assert.Equal(t, float64(5), opts.Seconds)
+	assert.Equal(t, 240, opts.Width)
+	assert.Equal(t, PreviewFormatWebP, opts.Format)
+	assert.Equal(t, 80, opts.Quality)
+}
+
+func TestPreviewFilter(t *testing.T) {
+	gif := previewFilter(PreviewOptions{FPS: 10, Width: 320, Format: PreviewFormatGIF})
+	assert.Equal(t, "fps=10,scale=320:-2:flags=lanczos,split[a][b];[a]palettegen=stats_mode=diff[p];[b][p]paletteuse=dither=bayer", gif)
+
+	webp := previewFilter(PreviewOptions{FPS: 12, Width: 240, Format: PreviewFormatWebP})
+	assert.Equal(t, "fps=12,scale=240:-2:flags=lanczos", webp)
+}
+
+func TestParsePreviewFormat(t *testing.T) {
+	assert.Equal(t, PreviewFormatWebP, ParsePreviewFormat("WEBP"))
+	assert.Equal(t, PreviewFormatGIF, ParsePreviewFormat("gif"))
+	assert.Equal(t, PreviewFormatGIF, ParsePreviewFormat(""))
+	assert.Equal(t, ".webp", PreviewFormatWebP.Ext())
+	assert.Equal(t, "image/gif", PreviewFormatGIF.ContentType())
+}
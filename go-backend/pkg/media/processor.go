@@ -24,6 +24,15 @@ type VideoProcessorInterface interface {
 
 	// 获取视频元信息
 	GetVideoInfo(ctx context.Context, input io.Reader) (*VideoMetadata, error)
+
+	// HLS切片
+	PackageHLS(ctx context.Context, input io.Reader, opts *HLSOptions, fn HLSFileFunc) error
+
+	// 生成动态封面
+	GenerateAnimatedPreview(ctx context.Context, input io.Reader, output io.Writer, opts *PreviewOptions) error
+
+	// 音轨响度归一化
+	NormalizeAudio(ctx context.Context, input io.Reader, output io.Writer, opts *LoudnessOptions) (*LoudnessStats, error)
 }
 
 // TranscodeOptions 转码选项
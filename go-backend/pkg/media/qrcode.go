@@ -0,0 +1,447 @@
+package media
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// QRCode 二维码模块矩阵，true 为深色模块
+type QRCode struct {
+	Size    int
+	Version int
+	modules [][]bool
+}
+
+// qrVersion 纠错等级 M 下各版本的码字结构，所有分块大小相同
+type qrVersion struct {
+	totalCodewords int
+	ecPerBlock     int
+	blocks         int
+	alignment      int // 右下角校正图形的中心坐标，版本1没有校正图形
+}
+
+// 只支持版本1-6（字节模式最多106字节），足够容纳分享链接，也不需要写版本信息
+var qrVersions = []qrVersion{
+	{totalCodewords: 26, ecPerBlock: 10, blocks: 1},
+	{totalCodewords: 44, ecPerBlock: 16, blocks: 1, alignment: 18},
+	{totalCodewords: 70, ecPerBlock: 26, blocks: 1, alignment: 22},
+	{totalCodewords: 100, ecPerBlock: 18, blocks: 2, alignment: 26},
+	{totalCodewords: 134, ecPerBlock: 24, blocks: 2, alignment: 30},
+	{totalCodewords: 172, ecPerBlock: 16, blocks: 4, alignment: 34},
+}
+
+func (v qrVersion) dataCodewords() int {
+	return v.totalCodewords - v.ecPerBlock*v.blocks
+}
+
+// EncodeQR 以字节模式、M级纠错编码内容，自动选择最小版本和惩罚分最低的掩码
+func EncodeQR(content string) (*QRCode, error) {
+	data := []byte(content)
+
+	version := 0
+	for i, v := range qrVersions {
+		// 模式指示符4位 + 长度8位
+		if len(data)+2 <= v.dataCodewords() {
+			version = i + 1
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("qr content too long: %d bytes", len(data))
+	}
+
+	spec := qrVersions[version-1]
+	codewords := interleaveQRBlocks(encodeQRData(data, spec.dataCodewords()), spec)
+
+	var best *QRCode
+	bestPenalty := 0
+	for mask := 0; mask < 8; mask++ {
+		qr := newQRCode(version)
+		function := qr.drawFunctionPatterns(spec)
+		qr.drawCodewords(codewords, function)
+		qr.applyMask(mask, function)
+		qr.drawFormatBits(mask)
+
+		penalty := qr.penalty()
+		if best == nil || penalty < bestPenalty {
+			best, bestPenalty = qr, penalty
+		}
+	}
+
+	return best, nil
+}
+
+// Dark 获取模块颜色，x 为列，y 为行
+func (q *QRCode) Dark(x, y int) bool {
+	return q.modules[y][x]
+}
+
+// Image 按模块大小渲染二维码，四周保留4个模块的静区
+func (q *QRCode) Image(moduleSize int) *image.NRGBA {
+	if moduleSize <= 0 {
+		moduleSize = 1
+	}
+
+	const quietZone = 4
+	size := (q.Size + quietZone*2) * moduleSize
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	dark := color.NRGBA{A: 0xff}
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			if !q.modules[y][x] {
+				continue
+			}
+			x0 := (x + quietZone) * moduleSize
+			y0 := (y + quietZone) * moduleSize
+			for dy := 0; dy < moduleSize; dy++ {
+				for dx := 0; dx < moduleSize; dx++ {
+					img.SetNRGBA(x0+dx, y0+dy, dark)
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+func newQRCode(version int) *QRCode {
+	size := version*4 + 17
+	modules := make([][]bool, size)
+	for i := range modules {
+		modules[i] = make([]bool, size)
+	}
+	return &QRCode{Size: size, Version: version, modules: modules}
+}
+
+// encodeQRData 生成数据码字：模式、长度、内容、终止符和填充字节
+func encodeQRData(data []byte, capacity int) []byte {
+	bits := make([]bool, 0, capacity*8)
+	appendBits := func(value, length int) {
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, (value>>i)&1 == 1)
+		}
+	}
+
+	appendBits(0x4, 4)
+	appendBits(len(data), 8)
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+
+	terminator := capacity*8 - len(bits)
+	if terminator > 4 {
+		terminator = 4
+	}
+	appendBits(0, terminator)
+	appendBits(0, (8-len(bits)%8)%8)
+
+	result := make([]byte, 0, capacity)
+	for i := 0; i < len(bits); i += 8 {
+		var b byte
+		for j := 0; j < 8; j++ {
+			if bits[i+j] {
+				b |= 1 << (7 - j)
+			}
+		}
+		result = append(result, b)
+	}
+	for pad := byte(0xec); len(result) < capacity; pad ^= 0xec ^ 0x11 {
+		result = append(result, pad)
+	}
+
+	return result
+}
+
+// interleaveQRBlocks 分块计算纠错码字，再按列交错数据码字和纠错码字
+func interleaveQRBlocks(data []byte, spec qrVersion) []byte {
+	blockLen := len(data) / spec.blocks
+	generator := reedSolomonGenerator(spec.ecPerBlock)
+
+	dataBlocks := make([][]byte, spec.blocks)
+	ecBlocks := make([][]byte, spec.blocks)
+	for i := range dataBlocks {
+		dataBlocks[i] = data[i*blockLen : (i+1)*blockLen]
+		ecBlocks[i] = reedSolomonRemainder(dataBlocks[i], generator)
+	}
+
+	result := make([]byte, 0, spec.totalCodewords)
+	for i := 0; i < blockLen; i++ {
+		for _, block := range dataBlocks {
+			result = append(result, block[i])
+		}
+	}
+	for i := 0; i < spec.ecPerBlock; i++ {
+		for _, block := range ecBlocks {
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+// drawFunctionPatterns 绘制定位、分隔、定时、校正图形和固定深色模块，返回功能区标记
+func (q *QRCode) drawFunctionPatterns(spec qrVersion) [][]bool {
+	function := make([][]bool, q.Size)
+	for i := range function {
+		function[i] = make([]bool, q.Size)
+	}
+	set := func(x, y int, dark bool) {
+		q.modules[y][x] = dark
+		function[y][x] = true
+	}
+
+	for i := 0; i < q.Size; i++ {
+		set(6, i, i%2 == 0)
+		set(i, 6, i%2 == 0)
+	}
+
+	for _, center := range [][2]int{{3, 3}, {q.Size - 4, 3}, {3, q.Size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := center[0]+dx, center[1]+dy
+				if x < 0 || x >= q.Size || y < 0 || y >= q.Size {
+					continue
+				}
+				dist := maxInt(absInt(dx), absInt(dy))
+				set(x, y, dist != 2 && dist != 4)
+			}
+		}
+	}
+
+	if spec.alignment > 0 {
+		for dy := -2; dy <= 2; dy++ {
+			for dx := -2; dx <= 2; dx++ {
+				set(spec.alignment+dx, spec.alignment+dy, maxInt(absInt(dx), absInt(dy)) != 1)
+			}
+		}
+	}
+
+	// 预留格式信息区域
+	for i := 0; i < 9; i++ {
+		function[8][i] = true
+		function[i][8] = true
+	}
+	for i := 0; i < 8; i++ {
+		function[8][q.Size-1-i] = true
+		function[q.Size-1-i][8] = true
+	}
+	set(8, q.Size-8, true)
+
+	return function
+}
+
+// drawCodewords 按之字形从右下角开始填充码字，跳过功能区
+func (q *QRCode) drawCodewords(codewords []byte, function [][]bool) {
+	i := 0
+	for right := q.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < q.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = q.Size - 1 - vert
+				}
+				if function[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				q.modules[y][x] = (codewords[i>>3]>>(7-i&7))&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+func (q *QRCode) applyMask(mask int, function [][]bool) {
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			if !function[y][x] && qrMaskBit(mask, x, y) {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+func qrMaskBit(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// drawFormatBits 写入两份格式信息（纠错等级 M 的指示位为 00）
+func (q *QRCode) drawFormatBits(mask int) {
+	data := mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	bit := func(i int) bool {
+		return (bits>>i)&1 == 1
+	}
+
+	for i := 0; i <= 5; i++ {
+		q.modules[i][8] = bit(i)
+	}
+	q.modules[7][8] = bit(6)
+	q.modules[8][8] = bit(7)
+	q.modules[8][7] = bit(8)
+	for i := 9; i < 15; i++ {
+		q.modules[8][14-i] = bit(i)
+	}
+
+	for i := 0; i < 8; i++ {
+		q.modules[8][q.Size-1-i] = bit(i)
+	}
+	for i := 8; i < 15; i++ {
+		q.modules[q.Size-15+i][8] = bit(i)
+	}
+}
+
+// penalty 按标准的四条规则计算掩码惩罚分
+func (q *QRCode) penalty() int {
+	penalty := 0
+	dark := 0
+
+	line := func(get func(i int) bool) {
+		run := 1
+		for i := 1; i <= q.Size; i++ {
+			if i < q.Size && get(i) == get(i-1) {
+				run++
+				continue
+			}
+			if run >= 5 {
+				penalty += run - 2
+			}
+			run = 1
+		}
+
+		// 类似定位图形的 1:1:3:1:1 序列，一侧带4个浅色模块
+		pattern := []bool{true, false, true, true, true, false, true}
+		for i := 0; i+len(pattern) <= q.Size; i++ {
+			match := true
+			for j, p := range pattern {
+				if get(i+j) != p {
+					match = false
+					break
+				}
+			}
+			if match && (lightRun(get, i-4, i, q.Size) || lightRun(get, i+len(pattern), i+len(pattern)+4, q.Size)) {
+				penalty += 40
+			}
+		}
+	}
+
+	for y := 0; y < q.Size; y++ {
+		line(func(i int) bool { return q.modules[y][i] })
+	}
+	for x := 0; x < q.Size; x++ {
+		line(func(i int) bool { return q.modules[i][x] })
+	}
+
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			c := q.modules[y][x]
+			if c {
+				dark++
+			}
+			if x+1 < q.Size && y+1 < q.Size &&
+				c == q.modules[y][x+1] && c == q.modules[y+1][x] && c == q.modules[y+1][x+1] {
+				penalty += 3
+			}
+		}
+	}
+
+	total := q.Size * q.Size
+	deviation := absInt(dark*20-total*10) / total
+	penalty += deviation * 10
+
+	return penalty
+}
+
+// lightRun 判断 [from, to) 是否全为浅色，越界部分视为静区
+func lightRun(get func(i int) bool, from, to, size int) bool {
+	for i := from; i < to; i++ {
+		if i >= 0 && i < size && get(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// reedSolomonGenerator 生成指定次数的生成多项式系数（不含最高次项）
+func reedSolomonGenerator(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+func reedSolomonRemainder(data, generator []byte) []byte {
+	result := make([]byte, len(generator))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coef := range generator {
+			result[i] ^= gfMultiply(coef, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply GF(2^8) 乘法，本原多项式 0x11d
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11d)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,140 @@
+package media
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// 标准示例 "HELLO WORLD" 1-M 的数据码字和纠错码字
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	assert.Equal(t, expected, reedSolomonRemainder(data, reedSolomonGenerator(10)))
+}
+
+func TestEncodeQR(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		for _, content := range []string{
+			"https://example.com/u/1",
+			"https://tiktok.example.com/share/video/7251234567890123456",
+			strings.Repeat("x", 106),
+		} {
+			qr, err := EncodeQR(content)
+			require.NoError(t, err)
+			assert.Equal(t, qr.Version*4+17, qr.Size)
+			assert.Equal(t, content, decodeQRForTest(t, qr))
+		}
+	})
+
+	t.Run("picks smallest version", func(t *testing.T) {
+		qr, err := EncodeQR("hello")
+		require.NoError(t, err)
+		assert.Equal(t, 1, qr.Version)
+	})
+
+	t.Run("content too long", func(t *testing.T) {
+		_, err := EncodeQR(strings.Repeat("x", 107))
+		assert.Error(t, err)
+	})
+
+	t.Run("image has quiet zone", func(t *testing.T) {
+		qr, err := EncodeQR("hello")
+		require.NoError(t, err)
+
+		img := qr.Image(2)
+		assert.Equal(t, (qr.Size+8)*2, img.Bounds().Dx())
+		assert.Equal(t, uint8(0xff), img.NRGBAAt(0, 0).R)
+		assert.Equal(t, uint8(0), img.NRGBAAt(8, 8).R)
+	})
+}
+
+// decodeQRForTest 按编码的逆过程读取二维码：校验格式信息、去掩码、反交错并校验纠错码字
+func decodeQRForTest(t *testing.T, qr *QRCode) string {
+	t.Helper()
+
+	format := 0
+	for i := 0; i <= 5; i++ {
+		format |= boolBit(qr.Dark(8, i)) << i
+	}
+	format |= boolBit(qr.Dark(8, 7)) << 6
+	format |= boolBit(qr.Dark(8, 8)) << 7
+	format |= boolBit(qr.Dark(7, 8)) << 8
+	for i := 9; i < 15; i++ {
+		format |= boolBit(qr.Dark(14-i, 8)) << i
+	}
+	format ^= 0x5412
+	require.Equal(t, 0, format>>13, "error correction level should be M")
+	mask := format >> 10
+
+	rem := mask
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	require.Equal(t, rem, format&0x3ff, "format BCH code mismatch")
+
+	spec := qrVersions[qr.Version-1]
+	function := newQRCode(qr.Version).drawFunctionPatterns(spec)
+
+	var codewords []byte
+	var current byte
+	bits := 0
+	for right := qr.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < qr.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = qr.Size - 1 - vert
+				}
+				if function[y][x] {
+					continue
+				}
+				current = current<<1 | byte(boolBit(qr.Dark(x, y) != qrMaskBit(mask, x, y)))
+				bits++
+				if bits%8 == 0 {
+					codewords = append(codewords, current)
+					current = 0
+				}
+			}
+		}
+	}
+	require.GreaterOrEqual(t, len(codewords), spec.totalCodewords)
+
+	blockLen := spec.dataCodewords() / spec.blocks
+	generator := reedSolomonGenerator(spec.ecPerBlock)
+	var data []byte
+	for b := 0; b < spec.blocks; b++ {
+		block := make([]byte, 0, blockLen)
+		for i := 0; i < blockLen; i++ {
+			block = append(block, codewords[i*spec.blocks+b])
+		}
+		ec := make([]byte, 0, spec.ecPerBlock)
+		for i := 0; i < spec.ecPerBlock; i++ {
+			ec = append(ec, codewords[spec.dataCodewords()+i*spec.blocks+b])
+		}
+		require.Equal(t, reedSolomonRemainder(block, generator), ec)
+		data = append(data, block...)
+	}
+
+	require.Equal(t, byte(0x4), data[0]>>4, "byte mode expected")
+	length := int(data[0]&0x0f)<<4 | int(data[1]>>4)
+	var content bytes.Buffer
+	for i := 0; i < length; i++ {
+		content.WriteByte(data[1+i]<<4 | data[2+i]>>4)
+	}
+	return content.String()
+}
+
+func boolBit(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -10,12 +10,17 @@ import (
 	"github.com/disintegration/imaging"
 )
 
-// ThumbnailGenerator 缩略图生成器
+// maxFrameBytes 截取的原始画面大小上限
+const maxFrameBytes = 20 << 20
+
+// ThumbnailGenerator 缩略图生成器。截取的原始画面经图片处理器居中裁剪为目标宽高比并重新编码，
+// 不会拉伸变形，也不保留编码器写入的元数据
 type ThumbnailGenerator struct {
 	width     int
 	height    int
 	quality   int
 	processor VideoProcessorInterface
+	images    *ImageProcessor
 }
 
 // NewThumbnailGenerator 创建缩略图生成器
@@ -35,31 +40,52 @@ func NewThumbnailGenerator(width, height, quality int, processor VideoProcessorI
 		height:    height,
 		quality:   quality,
 		processor: processor,
+		images:    NewImageProcessor(maxFrameBytes, quality),
 	}
 }
 
-// GenerateFromVideo 从视频生成缩略图
+// GenerateFromVideo 从视频生成缩略图，截帧失败时返回默认缩略图
 func (t *ThumbnailGenerator) GenerateFromVideo(ctx context.Context, videoReader io.Reader, seekTime int64) (io.Reader, error) {
-	if t.processor == nil {
+	reader, err := t.ExtractFrame(ctx, videoReader, seekTime)
+	if err != nil {
+		// 如果生成失败，返回默认缩略图
 		return t.GenerateDefault(ctx)
 	}
+	return reader, nil
+}
 
+// ExtractFrame 截取视频指定秒数处的画面作为缩略图，失败时返回错误，由调用方决定如何降级
+func (t *ThumbnailGenerator) ExtractFrame(ctx context.Context, videoReader io.Reader, seekTime int64) (io.Reader, error) {
+	if t.processor == nil {
+		return nil, fmt.Errorf("video processor not configured")
+	}
+
+	// 截取原始尺寸的画面，裁剪缩放由图片处理器完成
 	var buf bytes.Buffer
 	opts := &ProcessorOptions{
-		Width:    t.width,
-		Height:   t.height,
 		SeekTime: seekTime,
 		Format:   "jpg",
-		Quality:  t.quality,
+		Quality:  100,
 	}
 
-	err := t.processor.GenerateThumbnail(ctx, videoReader, &buf, opts)
-	if err != nil {
-		// 如果生成失败，返回默认缩略图
-		return t.GenerateDefault(ctx)
+	if err := t.processor.GenerateThumbnail(ctx, videoReader, &buf, opts); err != nil {
+		return nil, err
 	}
 
-	return &buf, nil
+	return t.postProcess(&buf)
+}
+
+// postProcess 将画面裁剪缩放到缩略图尺寸并重新编码
+func (t *ThumbnailGenerator) postProcess(reader io.Reader) (io.Reader, error) {
+	img, err := t.images.Process(reader, ImageOptions{
+		Width:  t.width,
+		Height: t.height,
+		Crop:   true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("process thumbnail failed: %w", err)
+	}
+	return bytes.NewReader(img.Data), nil
 }
 
 // GenerateDefault 生成默认缩略图
@@ -87,21 +113,7 @@ func (t *ThumbnailGenerator) GenerateDefault(ctx context.Context) (io.Reader, er
 
 // GenerateFromImage 从图片生成缩略图
 func (t *ThumbnailGenerator) GenerateFromImage(ctx context.Context, imageReader io.Reader) (io.Reader, error) {
-	img, err := imaging.Decode(imageReader)
-	if err != nil {
-		return nil, fmt.Errorf("decode image failed: %w", err)
-	}
-
-	// 使用imaging库的高质量缩放
-	thumbnail := imaging.Resize(img, t.width, t.height, imaging.Lanczos)
-
-	var buf bytes.Buffer
-	err = imaging.Encode(&buf, thumbnail, imaging.JPEG, imaging.JPEGQuality(t.quality))
-	if err != nil {
-		return nil, fmt.Errorf("encode thumbnail failed: %w", err)
-	}
-
-	return &buf, nil
+	return t.postProcess(imageReader)
 }
 
 // GetSize 获取缩略图尺寸
@@ -113,5 +125,6 @@ func (t *ThumbnailGenerator) GetSize() (int, int) {
 func (t *ThumbnailGenerator) SetQuality(quality int) {
 	if quality > 0 && quality <= 100 {
 		t.quality = quality
+		t.images = NewImageProcessor(maxFrameBytes, quality)
 	}
 }
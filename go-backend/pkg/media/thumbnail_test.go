@@ -0,0 +1,72 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProcessor 只实现截帧，写入固定内容或返回错误
+type fakeProcessor struct {
+	VideoProcessorInterface
+	frame    string
+	err      error
+	seekTime int64
+}
+
+func (p *fakeProcessor) GenerateThumbnail(_ context.Context, _ io.Reader, output io.Writer, opts *ProcessorOptions) error {
+	p.seekTime = opts.SeekTime
+	if p.err != nil {
+		return p.err
+	}
+	_, err := io.WriteString(output, p.frame)
+	return err
+}
+
+func TestThumbnailGenerator_ExtractFrame(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		processor := &fakeProcessor{frame: string(encodeTestPNG(t, 800, 800, color.NRGBA{R: 255, A: 255}))}
+		generator := NewThumbnailGenerator(480, 270, 80, processor)
+
+		reader, err := generator.ExtractFrame(ctx, strings.NewReader("video"), 1)
+		require.NoError(t, err)
+		// 正方形画面居中裁剪为16:9，不拉伸
+		img, format, err := image.Decode(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, image.Rect(0, 0, 480, 270), img.Bounds())
+		assert.Equal(t, int64(1), processor.seekTime)
+	})
+
+	t.Run("InvalidFrame", func(t *testing.T) {
+		_, err := NewThumbnailGenerator(480, 270, 80, &fakeProcessor{frame: "jpeg"}).ExtractFrame(ctx, strings.NewReader("video"), 1)
+		assert.ErrorIs(t, err, ErrUnsupportedImage)
+	})
+
+	t.Run("ProcessorFailed", func(t *testing.T) {
+		generator := NewThumbnailGenerator(480, 270, 80, &fakeProcessor{err: errors.New("ffmpeg not found")})
+
+		_, err := generator.ExtractFrame(ctx, strings.NewReader("video"), 1)
+		assert.EqualError(t, err, "ffmpeg not found")
+
+		// GenerateFromVideo 降级为默认缩略图
+		reader, err := generator.GenerateFromVideo(ctx, strings.NewReader("video"), 1)
+		require.NoError(t, err)
+		data, _ := io.ReadAll(reader)
+		assert.NotEmpty(t, data)
+	})
+
+	t.Run("NoProcessor", func(t *testing.T) {
+		_, err := NewThumbnailGenerator(480, 270, 80, nil).ExtractFrame(ctx, strings.NewReader("video"), 1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,40 @@
+package media
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+)
+
+type usageKey struct{}
+
+// Usage 累计一次处理过程中外部进程消耗的CPU时间
+type Usage struct {
+	mu      sync.Mutex
+	cpuTime time.Duration
+}
+
+// WithUsage 在上下文中挂载用量记录，处理器执行ffmpeg后会累加进程CPU时间
+func WithUsage(ctx context.Context, usage *Usage) context.Context {
+	return context.WithValue(ctx, usageKey{}, usage)
+}
+
+// CPUTime 已累计的用户态和内核态CPU时间
+func (u *Usage) CPUTime() time.Duration {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.cpuTime
+}
+
+// recordProcess 将已退出进程的CPU时间累加到上下文中的用量记录
+func recordProcess(ctx context.Context, state *os.ProcessState) {
+	usage, ok := ctx.Value(usageKey{}).(*Usage)
+	if !ok || state == nil {
+		return
+	}
+
+	usage.mu.Lock()
+	usage.cpuTime += state.UserTime() + state.SystemTime()
+	usage.mu.Unlock()
+}
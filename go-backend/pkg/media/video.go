@@ -3,6 +3,7 @@ package media
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"image"
 	"image/color"
@@ -29,6 +30,33 @@ type VideoMetadata struct {
 	Framerate   string  `json:"framerate"`
 	CodecName   string  `json:"codec_name"`
 	AspectRatio string  `json:"aspect_ratio"`
+	// 第一路音频流的编码和码率，没有音轨时为空
+	AudioCodec   string `json:"audio_codec"`
+	AudioBitrate string `json:"audio_bitrate"`
+}
+
+var (
+	// ErrVideoTooLong 视频时长超过上限
+	ErrVideoTooLong = errors.New("video duration exceeds limit")
+	// ErrResolutionTooLow 视频分辨率低于下限
+	ErrResolutionTooLow = errors.New("video resolution below limit")
+	// ErrResolutionTooHigh 视频分辨率超过上限
+	ErrResolutionTooHigh = errors.New("video resolution exceeds limit")
+	// ErrMetadataUnavailable 配置了限制但未能探测到视频时长或分辨率
+	ErrMetadataUnavailable = errors.New("video metadata unavailable")
+)
+
+// VideoLimits 视频时长和分辨率限制，零值字段表示不限制。
+// 分辨率按短边计算，1080 即 1080p，横屏 1920x1080 和竖屏 1080x1920 使用同一限制
+type VideoLimits struct {
+	MaxDuration   time.Duration
+	MinResolution int
+	MaxResolution int
+}
+
+// Enabled 是否配置了任一限制
+func (l VideoLimits) Enabled() bool {
+	return l.MaxDuration > 0 || l.MinResolution > 0 || l.MaxResolution > 0
 }
 
 // VideoProcessor 视频处理器
@@ -38,6 +66,7 @@ type VideoProcessor struct {
 	thumbnailWidth   int
 	thumbnailHeight  int
 	thumbnailQuality int
+	limits           VideoLimits
 	log              *log.Helper
 }
 
@@ -75,6 +104,53 @@ func (vp *VideoProcessor) ValidateFormat(filename string, size int64) error {
 	return fmt.Errorf("unsupported video format: %s", ext)
 }
 
+// SetLimits 设置时长和分辨率限制
+func (vp *VideoProcessor) SetLimits(limits VideoLimits) {
+	vp.limits = limits
+}
+
+// GetLimits 获取时长和分辨率限制
+func (vp *VideoProcessor) GetLimits() VideoLimits {
+	return vp.limits
+}
+
+// ValidateMetadata 按探测到的元数据校验时长和分辨率，依次检查时长、分辨率下限和上限，
+// 返回的错误包装 ErrVideoTooLong、ErrResolutionTooLow 或 ErrResolutionTooHigh。
+// 配置了限制但未探测到被限制的字段（metadata 为 nil、时长或宽高为0）时返回 ErrMetadataUnavailable，
+// 不能当作符合限制放行
+func (vp *VideoProcessor) ValidateMetadata(metadata *VideoMetadata) error {
+	if !vp.limits.Enabled() {
+		return nil
+	}
+	if metadata == nil {
+		return ErrMetadataUnavailable
+	}
+
+	if vp.limits.MaxDuration > 0 {
+		if metadata.Duration <= 0 {
+			return fmt.Errorf("%w: duration unknown", ErrMetadataUnavailable)
+		}
+		if metadata.Duration > vp.limits.MaxDuration.Seconds() {
+			return fmt.Errorf("%w: duration %.1fs, max %.0fs", ErrVideoTooLong, metadata.Duration, vp.limits.MaxDuration.Seconds())
+		}
+	}
+
+	if vp.limits.MinResolution <= 0 && vp.limits.MaxResolution <= 0 {
+		return nil
+	}
+	if metadata.Width <= 0 || metadata.Height <= 0 {
+		return fmt.Errorf("%w: resolution unknown", ErrMetadataUnavailable)
+	}
+	resolution := min(metadata.Width, metadata.Height)
+	if vp.limits.MinResolution > 0 && resolution < vp.limits.MinResolution {
+		return fmt.Errorf("%w: %dx%d, min %dp", ErrResolutionTooLow, metadata.Width, metadata.Height, vp.limits.MinResolution)
+	}
+	if vp.limits.MaxResolution > 0 && resolution > vp.limits.MaxResolution {
+		return fmt.Errorf("%w: %dx%d, max %dp", ErrResolutionTooHigh, metadata.Width, metadata.Height, vp.limits.MaxResolution)
+	}
+	return nil
+}
+
 // ValidateVideoFile 验证视频文件内容
 func (vp *VideoProcessor) ValidateVideoFile(ctx context.Context, reader io.Reader) error {
 	header := make([]byte, 512)
@@ -140,6 +216,10 @@ func (vp *VideoProcessor) GetMetadata(ctx context.Context, reader io.Reader) (*V
 	} else if videoStream.AvgFrameRate != "" {
 		metadata.Framerate = videoStream.AvgFrameRate
 	}
+	if audioStream := data.FirstAudioStream(); audioStream != nil {
+		metadata.AudioCodec = audioStream.CodecName
+		metadata.AudioBitrate = audioStream.BitRate
+	}
 
 	return metadata, nil
 }
@@ -190,6 +270,10 @@ func (vp *VideoProcessor) GetMetadataFromFile(ctx context.Context, filename stri
 	} else if videoStream.AvgFrameRate != "" {
 		metadata.Framerate = videoStream.AvgFrameRate
 	}
+	if audioStream := data.FirstAudioStream(); audioStream != nil {
+		metadata.AudioCodec = audioStream.CodecName
+		metadata.AudioBitrate = audioStream.BitRate
+	}
 
 	return metadata, nil
 }
@@ -0,0 +1,55 @@
+package media
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideoProcessor_ValidateMetadata(t *testing.T) {
+	vp := NewVideoProcessor(100<<20, []string{"video/mp4"}, 320, 240, 80)
+	vp.SetLimits(VideoLimits{MaxDuration: time.Minute, MinResolution: 360, MaxResolution: 1080})
+
+	tests := []struct {
+		name     string
+		metadata *VideoMetadata
+		want     error
+	}{
+		{"Landscape", &VideoMetadata{Duration: 59.5, Width: 1920, Height: 1080}, nil},
+		{"Portrait", &VideoMetadata{Duration: 30, Width: 720, Height: 1280}, nil},
+		{"TooLong", &VideoMetadata{Duration: 60.5, Width: 1280, Height: 720}, ErrVideoTooLong},
+		{"TooLow", &VideoMetadata{Duration: 10, Width: 640, Height: 320}, ErrResolutionTooLow},
+		{"PortraitTooLow", &VideoMetadata{Duration: 10, Width: 320, Height: 640}, ErrResolutionTooLow},
+		{"TooHigh", &VideoMetadata{Duration: 10, Width: 2160, Height: 3840}, ErrResolutionTooHigh},
+		{"Unprobed", &VideoMetadata{}, ErrMetadataUnavailable},
+		{"ResolutionUnknown", &VideoMetadata{Duration: 10}, ErrMetadataUnavailable},
+		{"Nil", nil, ErrMetadataUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vp.ValidateMetadata(tt.metadata)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestVideoProcessor_ValidateMetadataUnlimited(t *testing.T) {
+	vp := NewVideoProcessor(100<<20, []string{"video/mp4"}, 320, 240, 80)
+	assert.NoError(t, vp.ValidateMetadata(&VideoMetadata{Duration: 7200, Width: 7680, Height: 4320}))
+	assert.NoError(t, vp.ValidateMetadata(&VideoMetadata{Duration: 1, Width: 160, Height: 120}))
+	assert.NoError(t, vp.ValidateMetadata(nil))
+}
+
+func TestVideoProcessor_ValidateMetadataDurationOnly(t *testing.T) {
+	vp := NewVideoProcessor(100<<20, []string{"video/mp4"}, 320, 240, 80)
+	vp.SetLimits(VideoLimits{MaxDuration: time.Minute})
+
+	// 只限制时长时不要求探测到分辨率
+	assert.NoError(t, vp.ValidateMetadata(&VideoMetadata{Duration: 30}))
+	assert.ErrorIs(t, vp.ValidateMetadata(&VideoMetadata{Width: 1280, Height: 720}), ErrMetadataUnavailable)
+}
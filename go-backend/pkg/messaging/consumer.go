@@ -3,9 +3,12 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
+	"go-backend/pkg/reqctx"
+
 	"github.com/IBM/sarama"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -16,6 +19,8 @@ type MessageHandler func(ctx context.Context, message *BaseMessage) error
 // Consumer Kafka消费者接口
 type Consumer interface {
 	Subscribe(topic string, handler MessageHandler) error
+	// SetRetryPolicy 设置失败重试策略和死信出口，未设置死信出口时重试耗尽的消息不提交
+	SetRetryPolicy(policy RetryPolicy, sink DeadLetterSink)
 	Start(ctx context.Context) error
 	Stop() error
 }
@@ -24,6 +29,8 @@ type Consumer interface {
 type KafkaConsumer struct {
 	consumerGroup sarama.ConsumerGroup
 	handlers      map[string]MessageHandler
+	retry         RetryPolicy
+	deadLetter    DeadLetterSink
 	log           *log.Helper
 	wg            sync.WaitGroup
 	cancel        context.CancelFunc
@@ -61,6 +68,7 @@ func NewKafkaConsumer(config *ConsumerConfig, logger log.Logger) (*KafkaConsumer
 	return &KafkaConsumer{
 		consumerGroup: consumerGroup,
 		handlers:      make(map[string]MessageHandler),
+		retry:         DefaultRetryPolicy(),
 		log:           log.NewHelper(logger),
 	}, nil
 }
@@ -71,6 +79,12 @@ func (c *KafkaConsumer) Subscribe(topic string, handler MessageHandler) error {
 	return nil
 }
 
+// SetRetryPolicy 设置重试策略和死信出口，需在 Start 前调用
+func (c *KafkaConsumer) SetRetryPolicy(policy RetryPolicy, sink DeadLetterSink) {
+	c.retry = policy
+	c.deadLetter = sink
+}
+
 // Start 启动消费者
 func (c *KafkaConsumer) Start(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -140,20 +154,29 @@ func (c *KafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim
 				continue
 			}
 
-			// 解析消息
+			// 恢复生产者传递的请求元数据
+			ctx := headersToContext(context.Background(), message.Headers)
+
+			// 无法解析的消息重试没有意义，直接转入死信
 			baseMessage := &BaseMessage{}
 			if err := baseMessage.FromJSON(message.Value); err != nil {
 				c.log.Errorf("failed to parse message: %v", err)
-				session.MarkMessage(message, "")
+				if c.sendDeadLetter(ctx, message, "", fmt.Errorf("parse message: %w", err), 0) {
+					session.MarkMessage(message, "")
+				}
 				continue
 			}
 
-			// 处理消息
-			ctx := context.Background()
-			if err := handler(ctx, baseMessage); err != nil {
-				c.log.Errorf("failed to handle message: %v", err)
-				// 根据业务需求决定是否重试或跳过
-				continue
+			attempts, err := handleWithRetry(ctx, session.Context().Done(), c.retry, handler, baseMessage)
+			if err != nil {
+				if session.Context().Err() != nil {
+					// 分区被回收，不提交，由新的消费者重新处理
+					return nil
+				}
+				c.log.Errorf("failed to handle message %s after %d attempts: %v", baseMessage.ID, attempts, err)
+				if !c.sendDeadLetter(ctx, message, baseMessage.ID, err, attempts) {
+					continue
+				}
 			}
 
 			session.MarkMessage(message, "")
@@ -163,3 +186,59 @@ func (c *KafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim
 		}
 	}
 }
+
+// sendDeadLetter 将消息转入死信，成功时返回 true，消息可以提交
+func (c *KafkaConsumer) sendDeadLetter(ctx context.Context, message *sarama.ConsumerMessage, messageID string, cause error, attempts int) bool {
+	if c.deadLetter == nil {
+		return false
+	}
+
+	headers := make(map[string]string, len(message.Headers))
+	for _, header := range message.Headers {
+		if header != nil {
+			headers[string(header.Key)] = string(header.Value)
+		}
+	}
+	event := &DeadLetterEvent{
+		Topic:     message.Topic,
+		Partition: message.Partition,
+		Offset:    message.Offset,
+		Key:       string(message.Key),
+		Headers:   headers,
+		Payload:   message.Value,
+		MessageID: messageID,
+		Error:     truncateError(cause.Error()),
+		Attempts:  attempts,
+		FailedAt:  time.Now().Unix(),
+	}
+	if err := c.deadLetter(ctx, event); err != nil {
+		c.log.Errorf("failed to dead-letter message %s/%d/%d: %v", message.Topic, message.Partition, message.Offset, err)
+		return false
+	}
+	return true
+}
+
+// truncateError 截断过长的错误信息
+func truncateError(msg string) string {
+	const maxLength = 1000
+	if len(msg) <= maxLength {
+		return msg
+	}
+	return strings.ToValidUTF8(msg[:maxLength], "")
+}
+
+// headersToContext 从Kafka消息头恢复请求上下文元数据
+func headersToContext(ctx context.Context, headers []*sarama.RecordHeader) context.Context {
+	if len(headers) == 0 {
+		return ctx
+	}
+
+	values := make(map[string]string, len(headers))
+	for _, header := range headers {
+		if header == nil {
+			continue
+		}
+		values[string(header.Key)] = string(header.Value)
+	}
+	return reqctx.FromHeaders(ctx, values)
+}
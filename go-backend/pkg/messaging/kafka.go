@@ -89,6 +89,28 @@ func (km *KafkaManager) SendUserActionEvent(ctx context.Context, topic string, e
 	return km.producer.SendMessage(ctx, topic, message)
 }
 
+// SendVideoAuditEvent 发送视频审核事件
+func (km *KafkaManager) SendVideoAuditEvent(ctx context.Context, topic string, event *VideoAuditEvent) error {
+	message := NewBaseMessage(VideoAuditMessage, event)
+	return km.producer.SendMessage(ctx, topic, message)
+}
+
+// SendDeadLetter 发送死信，以原始主题和分区作为key，同一分区的死信保持顺序
+func (km *KafkaManager) SendDeadLetter(ctx context.Context, topic string, event *DeadLetterEvent) error {
+	message := NewBaseMessage(DeadLetterMessage, event)
+	key := fmt.Sprintf("%s-%d", event.Topic, event.Partition)
+	return km.producer.SendMessageWithKey(ctx, topic, key, message)
+}
+
+// Republish 将原始消息体重新发送到指定主题，消息ID保持不变，消费者可据此去重
+func (km *KafkaManager) Republish(ctx context.Context, topic, key string, payload []byte) error {
+	message := &BaseMessage{}
+	if err := message.FromJSON(payload); err != nil {
+		return fmt.Errorf("payload is not a valid message: %w", err)
+	}
+	return km.producer.SendMessageWithKey(ctx, topic, key, message)
+}
+
 // Close 关闭Kafka管理器
 func (km *KafkaManager) Close() error {
 	var err error
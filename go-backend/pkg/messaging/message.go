@@ -1,6 +1,7 @@
 package messaging
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 )
@@ -13,6 +14,8 @@ const (
 	VideoProcessMessage MessageType = "video_process"
 	VideoStatsMessage   MessageType = "video_stats"
 	UserActionMessage   MessageType = "user_action"
+	VideoAuditMessage   MessageType = "video_audit"
+	DeadLetterMessage   MessageType = "dead_letter"
 )
 
 // BaseMessage 基础消息结构
@@ -23,6 +26,20 @@ type BaseMessage struct {
 	Data      interface{} `json:"data"`
 }
 
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey 指定发送消息时使用的消息ID。同一事件重复投递时消息ID保持不变，
+// 消费者可以据此去重
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+// IdempotencyKeyFromContext 读取 WithIdempotencyKey 设置的消息ID
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyCtx{}).(string)
+	return key
+}
+
 // NewBaseMessage 创建基础消息
 func NewBaseMessage(msgType MessageType, data interface{}) *BaseMessage {
 	return &BaseMessage{
@@ -43,6 +60,20 @@ func (m *BaseMessage) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// DeadLetterEvent 重试耗尽后转入死信主题的消息及失败信息
+type DeadLetterEvent struct {
+	Topic     string            `json:"topic"` // 原始主题
+	Partition int32             `json:"partition"`
+	Offset    int64             `json:"offset"`
+	Key       string            `json:"key,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Payload   []byte            `json:"payload"` // 原始消息体，按字节保留以兼容无法解析的消息
+	MessageID string            `json:"message_id,omitempty"`
+	Error     string            `json:"error"`    // 最后一次处理错误
+	Attempts  int               `json:"attempts"` // 已处理次数
+	FailedAt  int64             `json:"failed_at"`
+}
+
 // VideoUploadEvent 视频上传事件
 type VideoUploadEvent struct {
 	VideoID    int64  `json:"video_id"`
@@ -69,6 +100,16 @@ type VideoStatsEvent struct {
 	UserID    int64  `json:"user_id,omitempty"`
 }
 
+// VideoAuditEvent 视频审核事件
+type VideoAuditEvent struct {
+	VideoID   int64  `json:"video_id"`
+	AuthorID  int64  `json:"author_id"`
+	AuditorID int64  `json:"auditor_id"`
+	Status    string `json:"status"` // approved, rejected
+	Reason    string `json:"reason,omitempty"`
+	AuditTime int64  `json:"audit_time"`
+}
+
 // UserActionEvent 用户行为事件
 type UserActionEvent struct {
 	UserID     int64  `json:"user_id"`
@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"time"
 
+	"go-backend/pkg/reqctx"
+
 	"github.com/IBM/sarama"
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Producer Kafka生产者接口
@@ -20,6 +25,7 @@ type Producer interface {
 type KafkaProducer struct {
 	producer sarama.SyncProducer
 	log      *log.Helper
+	failures metric.Int64Counter
 }
 
 // ProducerConfig 生产者配置
@@ -59,9 +65,14 @@ func NewKafkaProducer(config *ProducerConfig, logger log.Logger) (*KafkaProducer
 		return nil, fmt.Errorf("failed to create kafka producer: %w", err)
 	}
 
+	meter := otel.Meter("go-backend/messaging")
+	failures, _ := meter.Int64Counter("kafka_publish_failures_total",
+		metric.WithDescription("Messages that failed to be published to Kafka, by topic"))
+
 	return &KafkaProducer{
 		producer: producer,
 		log:      log.NewHelper(logger),
+		failures: failures,
 	}, nil
 }
 
@@ -72,14 +83,19 @@ func (p *KafkaProducer) SendMessage(ctx context.Context, topic string, message *
 
 // SendMessageWithKey 带key发送消息
 func (p *KafkaProducer) SendMessageWithKey(ctx context.Context, topic, key string, message *BaseMessage) error {
+	if id := IdempotencyKeyFromContext(ctx); id != "" {
+		message.ID = id
+	}
+
 	data, err := message.ToJSON()
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
 	msg := &sarama.ProducerMessage{
-		Topic: topic,
-		Value: sarama.StringEncoder(data),
+		Topic:   topic,
+		Value:   sarama.StringEncoder(data),
+		Headers: contextToHeaders(ctx),
 	}
 
 	if key != "" {
@@ -88,6 +104,7 @@ func (p *KafkaProducer) SendMessageWithKey(ctx context.Context, topic, key strin
 
 	partition, offset, err := p.producer.SendMessage(msg)
 	if err != nil {
+		p.failures.Add(ctx, 1, metric.WithAttributes(attribute.String("topic", topic)))
 		p.log.WithContext(ctx).Errorf("failed to send message to kafka: %v", err)
 		return fmt.Errorf("failed to send message: %w", err)
 	}
@@ -103,3 +120,20 @@ func (p *KafkaProducer) Close() error {
 	}
 	return nil
 }
+
+// contextToHeaders 将请求上下文元数据写入Kafka消息头
+func contextToHeaders(ctx context.Context) []sarama.RecordHeader {
+	values := reqctx.ToHeaders(ctx)
+	if len(values) == 0 {
+		return nil
+	}
+
+	headers := make([]sarama.RecordHeader, 0, len(values))
+	for key, value := range values {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(value),
+		})
+	}
+	return headers
+}
@@ -0,0 +1,70 @@
+package messaging
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// RetryPolicy 消费失败的重试策略，按指数退避重试，MaxAttempts 包含首次处理
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy 默认重试策略：共处理3次，间隔从500ms开始翻倍
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    defaultRetryMaxAttempts,
+		InitialBackoff: defaultRetryInitialBackoff,
+		MaxBackoff:     defaultRetryMaxBackoff,
+	}
+}
+
+// Backoff 第 attempt 次失败后到下一次处理的等待时间，attempt 从1开始
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// DeadLetterSink 接收重试耗尽的消息，返回错误时消息不提交，由消费者组重新投递
+type DeadLetterSink func(ctx context.Context, event *DeadLetterEvent) error
+
+// handleWithRetry 按策略处理消息，返回处理次数和最后一次错误。done 关闭时（如分区再均衡）停止等待并提前返回
+func handleWithRetry(ctx context.Context, done <-chan struct{}, policy RetryPolicy, handler MessageHandler, message *BaseMessage) (int, error) {
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = handler(ctx, message); err == nil {
+			return attempt, nil
+		}
+		if attempt == maxAttempts {
+			return attempt, err
+		}
+
+		timer := time.NewTimer(policy.Backoff(attempt))
+		select {
+		case <-done:
+			timer.Stop()
+			return attempt, err
+		case <-timer.C:
+		}
+	}
+	return maxAttempts, err
+}
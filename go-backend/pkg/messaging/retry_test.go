@@ -0,0 +1,63 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 350 * time.Millisecond}
+
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 350*time.Millisecond, policy.Backoff(3))
+	assert.Equal(t, 350*time.Millisecond, policy.Backoff(10))
+}
+
+func TestHandleWithRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	message := &BaseMessage{ID: "m1"}
+
+	t.Run("SucceedsAfterRetry", func(t *testing.T) {
+		calls := 0
+		handler := func(context.Context, *BaseMessage) error {
+			calls++
+			if calls < 2 {
+				return errors.New("temporary")
+			}
+			return nil
+		}
+
+		attempts, err := handleWithRetry(context.Background(), nil, policy, handler, message)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		calls := 0
+		handler := func(context.Context, *BaseMessage) error {
+			calls++
+			return errors.New("permanent")
+		}
+
+		attempts, err := handleWithRetry(context.Background(), nil, policy, handler, message)
+		assert.EqualError(t, err, "permanent")
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("StopsWhenDone", func(t *testing.T) {
+		done := make(chan struct{})
+		close(done)
+		slow := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+		handler := func(context.Context, *BaseMessage) error { return errors.New("temporary") }
+
+		attempts, err := handleWithRetry(context.Background(), done, slow, handler, message)
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+}
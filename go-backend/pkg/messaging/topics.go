@@ -0,0 +1,285 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go-backend/internal/conf"
+
+	"github.com/IBM/sarama"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 主题配置项名称
+const (
+	topicConfigRetention     = "retention.ms"
+	topicConfigCleanupPolicy = "cleanup.policy"
+
+	settingPartitions        = "partitions"
+	settingReplicationFactor = "replication_factor"
+)
+
+// TopicSpec 主题的声明配置
+type TopicSpec struct {
+	Name              string
+	Partitions        int32
+	ReplicationFactor int16
+	Retention         time.Duration // 0 表示不管理，沿用broker默认值
+	Compact           bool
+}
+
+// configEntries 声明的主题级配置，未管理的配置项不出现
+func (s TopicSpec) configEntries() map[string]string {
+	entries := map[string]string{}
+	if s.Retention > 0 {
+		entries[topicConfigRetention] = strconv.FormatInt(s.Retention.Milliseconds(), 10)
+	}
+	if s.Compact {
+		entries[topicConfigCleanupPolicy] = "compact"
+	}
+	return entries
+}
+
+// TopicDrift 已存在的主题与声明配置不一致的一项
+type TopicDrift struct {
+	Topic    string
+	Setting  string
+	Declared string
+	Actual   string
+	// Fixable 能否在线修正：分区数只能增加，减少分区和修改副本数需要人工处理
+	Fixable bool
+}
+
+func (d TopicDrift) String() string {
+	return fmt.Sprintf("%s %s: declared=%s actual=%s", d.Topic, d.Setting, d.Declared, d.Actual)
+}
+
+// TopicReport 一次检查或同步的结果
+type TopicReport struct {
+	Missing []string     // 不存在的主题，同步时会被创建
+	Created []string     // 本次创建的主题
+	Drifts  []TopicDrift // 配置漂移
+	Fixed   []TopicDrift // 本次修正的漂移
+}
+
+// InSync 主题全部存在且没有未修正的漂移
+func (r *TopicReport) InSync() bool {
+	return len(r.Missing) == len(r.Created) && len(r.Drifts) == len(r.Fixed)
+}
+
+// TopicManager 按声明配置创建和校验Kafka主题
+type TopicManager struct {
+	admin sarama.ClusterAdmin
+	specs []TopicSpec
+	log   *log.Helper
+}
+
+// NewTopicManager 创建主题管理器
+func NewTopicManager(admin sarama.ClusterAdmin, specs []TopicSpec, logger log.Logger) *TopicManager {
+	return &TopicManager{
+		admin: admin,
+		specs: specs,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// NewKafkaTopicManager 连接broker并创建主题管理器，使用完毕后调用 Close
+func NewKafkaTopicManager(brokers []string, specs []TopicSpec, logger log.Logger) (*TopicManager, error) {
+	config := sarama.NewConfig()
+	// 增量修改主题配置需要 2.3 以上的协议版本
+	config.Version = sarama.V2_8_0_0
+	admin, err := sarama.NewClusterAdmin(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka cluster admin: %w", err)
+	}
+	return NewTopicManager(admin, specs, logger), nil
+}
+
+// Check 对比声明配置和集群中的主题，不做任何修改
+func (m *TopicManager) Check() (*TopicReport, error) {
+	topics, err := m.admin.ListTopics()
+	if err != nil {
+		return nil, fmt.Errorf("list topics failed: %w", err)
+	}
+
+	report := &TopicReport{}
+	for _, spec := range m.specs {
+		detail, ok := topics[spec.Name]
+		if !ok {
+			report.Missing = append(report.Missing, spec.Name)
+			continue
+		}
+		report.Drifts = append(report.Drifts, topicDrifts(spec, detail)...)
+	}
+	return report, nil
+}
+
+// Sync 创建缺失的主题，fixDrift 为 true 时同时修正可在线修正的漂移。
+// 单个主题失败不影响其他主题，返回的报告包含已完成的部分
+func (m *TopicManager) Sync(fixDrift bool) (*TopicReport, error) {
+	report, err := m.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	specs := make(map[string]TopicSpec, len(m.specs))
+	for _, spec := range m.specs {
+		specs[spec.Name] = spec
+	}
+
+	var firstErr error
+	for _, name := range report.Missing {
+		if err := m.create(specs[name]); err != nil {
+			// 其他实例同时启动时可能已经创建
+			if !isTopicExists(err) {
+				m.log.Errorf("create topic %s failed: %v", name, err)
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+		}
+		m.log.Infof("created topic %s", name)
+		report.Created = append(report.Created, name)
+	}
+
+	if !fixDrift {
+		return report, firstErr
+	}
+	for _, drift := range report.Drifts {
+		if !drift.Fixable {
+			continue
+		}
+		if err := m.fix(specs[drift.Topic], drift); err != nil {
+			m.log.Errorf("fix topic drift failed: %s: %v", drift, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		m.log.Infof("fixed topic drift: %s", drift)
+		report.Fixed = append(report.Fixed, drift)
+	}
+
+	return report, firstErr
+}
+
+// Close 关闭与broker的连接
+func (m *TopicManager) Close() error {
+	return m.admin.Close()
+}
+
+func (m *TopicManager) create(spec TopicSpec) error {
+	detail := &sarama.TopicDetail{
+		NumPartitions:     spec.Partitions,
+		ReplicationFactor: spec.ReplicationFactor,
+	}
+	if entries := spec.configEntries(); len(entries) > 0 {
+		detail.ConfigEntries = make(map[string]*string, len(entries))
+		for name, value := range entries {
+			detail.ConfigEntries[name] = &value
+		}
+	}
+	return m.admin.CreateTopic(spec.Name, detail, false)
+}
+
+func (m *TopicManager) fix(spec TopicSpec, drift TopicDrift) error {
+	if drift.Setting == settingPartitions {
+		return m.admin.CreatePartitions(spec.Name, spec.Partitions, nil, false)
+	}
+	// 增量修改只覆盖漂移的配置项，不影响主题上的其他配置
+	value := drift.Declared
+	return m.admin.IncrementalAlterConfig(sarama.TopicResource, spec.Name, map[string]sarama.IncrementalAlterConfigsEntry{
+		drift.Setting: {Operation: sarama.IncrementalAlterConfigsOperationSet, Value: &value},
+	}, false)
+}
+
+// topicDrifts ListTopics 只返回非默认的配置项，缺失的配置项按broker默认值处理
+func topicDrifts(spec TopicSpec, detail sarama.TopicDetail) []TopicDrift {
+	var drifts []TopicDrift
+	if spec.Partitions > 0 && detail.NumPartitions != spec.Partitions {
+		drifts = append(drifts, TopicDrift{
+			Topic:    spec.Name,
+			Setting:  settingPartitions,
+			Declared: strconv.Itoa(int(spec.Partitions)),
+			Actual:   strconv.Itoa(int(detail.NumPartitions)),
+			Fixable:  detail.NumPartitions < spec.Partitions,
+		})
+	}
+	if spec.ReplicationFactor > 0 && detail.ReplicationFactor != spec.ReplicationFactor {
+		drifts = append(drifts, TopicDrift{
+			Topic:    spec.Name,
+			Setting:  settingReplicationFactor,
+			Declared: strconv.Itoa(int(spec.ReplicationFactor)),
+			Actual:   strconv.Itoa(int(detail.ReplicationFactor)),
+		})
+	}
+
+	declared := spec.configEntries()
+	names := make([]string, 0, len(declared))
+	for name := range declared {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		actual := "default"
+		if value, ok := detail.ConfigEntries[name]; ok && value != nil {
+			actual = *value
+		}
+		if actual != declared[name] {
+			drifts = append(drifts, TopicDrift{
+				Topic:    spec.Name,
+				Setting:  name,
+				Declared: declared[name],
+				Actual:   actual,
+				Fixable:  true,
+			})
+		}
+	}
+	return drifts
+}
+
+func isTopicExists(err error) bool {
+	return errors.Is(err, sarama.ErrTopicAlreadyExists)
+}
+
+// TopicSpecsFromConfig 按业务配置中引用的主题生成声明配置，overrides 中未设置的字段取 defaults
+func TopicSpecsFromConfig(topics *conf.Business_KafkaTopics) []TopicSpec {
+	names := []string{topics.VideoUpload, topics.VideoProcess, topics.VideoStats, topics.UserAction, topics.DeadLetter}
+
+	seen := make(map[string]bool, len(names))
+	specs := make([]TopicSpec, 0, len(names))
+	for _, name := range names {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		spec := TopicSpec{Name: name, Partitions: 1, ReplicationFactor: 1}
+		applyTopicSpec(&spec, topics.Defaults)
+		applyTopicSpec(&spec, topics.Overrides[name])
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func applyTopicSpec(spec *TopicSpec, c *conf.Business_KafkaTopics_Spec) {
+	if c == nil {
+		return
+	}
+	if c.Partitions > 0 {
+		spec.Partitions = c.Partitions
+	}
+	if c.ReplicationFactor > 0 {
+		spec.ReplicationFactor = int16(c.ReplicationFactor)
+	}
+	if c.Retention != nil {
+		spec.Retention = c.Retention.AsDuration()
+	}
+	if c.Compact {
+		spec.Compact = true
+	}
+}
@@ -0,0 +1,147 @@
+package messaging
+
+import (
+	"testing"
+	"time"
+
+	"go-backend/internal/conf"
+
+	"github.com/IBM/sarama"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeClusterAdmin 内存中的主题集合，只实现主题管理用到的方法
+type fakeClusterAdmin struct {
+	sarama.ClusterAdmin
+	topics    map[string]sarama.TopicDetail
+	altered   map[string]string
+	createErr error
+}
+
+func (a *fakeClusterAdmin) ListTopics() (map[string]sarama.TopicDetail, error) {
+	return a.topics, nil
+}
+
+func (a *fakeClusterAdmin) CreateTopic(topic string, detail *sarama.TopicDetail, validateOnly bool) error {
+	if a.createErr != nil {
+		return a.createErr
+	}
+	a.topics[topic] = *detail
+	return nil
+}
+
+func (a *fakeClusterAdmin) CreatePartitions(topic string, count int32, assignment [][]int32, validateOnly bool) error {
+	detail := a.topics[topic]
+	detail.NumPartitions = count
+	a.topics[topic] = detail
+	return nil
+}
+
+func (a *fakeClusterAdmin) IncrementalAlterConfig(resourceType sarama.ConfigResourceType, name string, entries map[string]sarama.IncrementalAlterConfigsEntry, validateOnly bool) error {
+	for key, entry := range entries {
+		a.altered[name+"/"+key] = *entry.Value
+	}
+	return nil
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestTopicManager(t *testing.T) {
+	specs := []TopicSpec{
+		{Name: "uploads", Partitions: 3, ReplicationFactor: 1, Retention: 24 * time.Hour},
+		{Name: "stats", Partitions: 2, ReplicationFactor: 3},
+		{Name: "state", Partitions: 1, ReplicationFactor: 1, Compact: true},
+	}
+	newAdmin := func() *fakeClusterAdmin {
+		return &fakeClusterAdmin{
+			topics: map[string]sarama.TopicDetail{
+				"stats": {NumPartitions: 4, ReplicationFactor: 1},
+				"state": {NumPartitions: 1, ReplicationFactor: 1, ConfigEntries: map[string]*string{
+					"cleanup.policy": stringPtr("delete"),
+				}},
+			},
+			altered: map[string]string{},
+		}
+	}
+
+	t.Run("Check", func(t *testing.T) {
+		admin := newAdmin()
+		report, err := NewTopicManager(admin, specs, log.DefaultLogger).Check()
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"uploads"}, report.Missing)
+		assert.Equal(t, []TopicDrift{
+			{Topic: "stats", Setting: "partitions", Declared: "2", Actual: "4"},
+			{Topic: "stats", Setting: "replication_factor", Declared: "3", Actual: "1"},
+			{Topic: "state", Setting: "cleanup.policy", Declared: "compact", Actual: "delete", Fixable: true},
+		}, report.Drifts)
+		assert.False(t, report.InSync())
+		assert.Len(t, admin.topics, 2, "check must not create topics")
+	})
+
+	t.Run("SyncCreatesMissing", func(t *testing.T) {
+		admin := newAdmin()
+		report, err := NewTopicManager(admin, specs, log.DefaultLogger).Sync(false)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"uploads"}, report.Created)
+		assert.Empty(t, report.Fixed)
+		created := admin.topics["uploads"]
+		assert.Equal(t, int32(3), created.NumPartitions)
+		assert.Equal(t, "86400000", *created.ConfigEntries["retention.ms"])
+		assert.Empty(t, admin.altered)
+	})
+
+	t.Run("SyncFixesDrift", func(t *testing.T) {
+		admin := newAdmin()
+		admin.topics["uploads"] = sarama.TopicDetail{NumPartitions: 1, ReplicationFactor: 1}
+
+		report, err := NewTopicManager(admin, specs, log.DefaultLogger).Sync(true)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(3), admin.topics["uploads"].NumPartitions)
+		assert.Equal(t, "86400000", admin.altered["uploads/retention.ms"])
+		assert.Equal(t, "compact", admin.altered["state/cleanup.policy"])
+		assert.Len(t, report.Fixed, 3)
+		// 分区减少和副本数变更需要人工处理
+		assert.False(t, report.InSync())
+	})
+
+	t.Run("CreatedConcurrently", func(t *testing.T) {
+		// 其他实例在检查之后创建了主题
+		admin := newAdmin()
+		admin.createErr = &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+
+		report, err := NewTopicManager(admin, specs[:1], log.DefaultLogger).Sync(false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"uploads"}, report.Created)
+		assert.True(t, report.InSync())
+	})
+}
+
+func TestTopicSpecsFromConfig(t *testing.T) {
+	specs := TopicSpecsFromConfig(&conf.Business_KafkaTopics{
+		VideoUpload:  "video-upload",
+		VideoProcess: "video-process",
+		VideoStats:   "video-upload",
+		DeadLetter:   "dead-letter",
+		Defaults: &conf.Business_KafkaTopics_Spec{
+			Partitions: 3,
+			Retention:  durationpb.New(7 * 24 * time.Hour),
+		},
+		Overrides: map[string]*conf.Business_KafkaTopics_Spec{
+			"dead-letter": {Partitions: 1, ReplicationFactor: 2, Compact: true},
+		},
+	})
+
+	assert.Equal(t, []TopicSpec{
+		{Name: "video-upload", Partitions: 3, ReplicationFactor: 1, Retention: 7 * 24 * time.Hour},
+		{Name: "video-process", Partitions: 3, ReplicationFactor: 1, Retention: 7 * 24 * time.Hour},
+		{Name: "dead-letter", Partitions: 1, ReplicationFactor: 2, Retention: 7 * 24 * time.Hour, Compact: true},
+	}, specs)
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// DurationBuckets 以秒为单位的耗时直方图分桶，覆盖缓存读取到视频上传的耗时范围
+var DurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Provider 以Prometheus格式导出指标。创建后注册为全局 MeterProvider，
+// 各层通过 otel.Meter 创建的指标都从同一个注册表导出
+type Provider struct {
+	registry *prometheus.Registry
+	provider *sdkmetric.MeterProvider
+}
+
+// NewProvider 创建指标导出并注册为全局 MeterProvider
+func NewProvider() (*Provider, error) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	exporter, err := otelprom.New(otelprom.WithRegisterer(registry))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prometheus exporter: %w", err)
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(exporter),
+		// SDK默认分桶按毫秒设计，单位为秒的直方图统一使用秒级分桶
+		sdkmetric.WithView(sdkmetric.NewView(
+			sdkmetric.Instrument{Kind: sdkmetric.InstrumentKindHistogram, Unit: "s"},
+			sdkmetric.Stream{Aggregation: sdkmetric.AggregationExplicitBucketHistogram{Boundaries: DurationBuckets}},
+		)),
+	)
+	otel.SetMeterProvider(provider)
+
+	return &Provider{
+		registry: registry,
+		provider: provider,
+	}, nil
+}
+
+// Handler 返回 /metrics 端点的处理器
+func (p *Provider) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{})
+}
+
+// Shutdown 停止指标采集
+func (p *Provider) Shutdown(ctx context.Context) error {
+	return p.provider.Shutdown(ctx)
+}
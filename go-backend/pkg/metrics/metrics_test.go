@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+
+	// 组件在Provider创建前通过全局Meter创建的指标也应被导出
+	meter := otel.Meter("go-backend/test")
+	counter, err := meter.Int64Counter("test_events_total")
+	require.NoError(t, err)
+	histogram, err := meter.Float64Histogram("test_duration_seconds", metric.WithUnit("s"))
+	require.NoError(t, err)
+
+	provider, err := NewProvider()
+	require.NoError(t, err)
+	defer provider.Shutdown(ctx)
+
+	counter.Add(ctx, 2, metric.WithAttributes(attribute.String("result", "hit")))
+	histogram.Record(ctx, 0.02)
+
+	recorder := httptest.NewRecorder()
+	provider.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	output := string(body)
+
+	assert.Contains(t, output, `test_events_total{otel_scope_name="go-backend/test",otel_scope_version="",result="hit"} 2`)
+	// 秒级直方图使用秒级分桶
+	assert.Contains(t, output, `test_duration_seconds_bucket{otel_scope_name="go-backend/test",otel_scope_version="",le="0.025"} 1`)
+	assert.Contains(t, output, "go_goroutines")
+}
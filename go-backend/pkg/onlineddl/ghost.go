@@ -0,0 +1,236 @@
+package onlineddl
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// ErrAborted 迁移被中止，gh-ost 已放弃切换，原表保持不变
+var ErrAborted = errors.New("online migration aborted")
+
+// abortGracePeriod 写入中止标记后等待 gh-ost 自行清理退出的时间，超时后强制结束进程
+const abortGracePeriod = 30 * time.Second
+
+// GhostConfig gh-ost 执行参数
+type GhostConfig struct {
+	Binary   string // gh-ost 可执行文件，默认从 PATH 查找
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Database string
+
+	ChunkSize    int    // 每批复制的行数，默认1000
+	MaxLoad      string // 超过后暂停复制，默认 Threads_running=25
+	CriticalLoad string // 超过后中止迁移，默认 Threads_running=100
+	MaxLagMillis int    // 从库延迟超过后暂停复制，默认1500
+	// AllowOnMaster 直接连接主库读取 binlog，没有从库时需要开启
+	AllowOnMaster bool
+	// Execute 为 false 时只做演练，建立影子表并校验变更后退出，不复制数据
+	Execute bool
+	// WorkDir 存放中止标记和连接配置的目录，默认系统临时目录
+	WorkDir string
+	// Output 接收 gh-ost 的原始输出，为空时丢弃
+	Output io.Writer
+}
+
+// GhostConfigFromDSN 从应用使用的 MySQL DSN 读取连接参数
+func GhostConfigFromDSN(dsn string) (*GhostConfig, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	host, portStr, err := net.SplitHostPort(cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database address %q: %w", cfg.Addr, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database port %q: %w", portStr, err)
+	}
+
+	return &GhostConfig{
+		Host:     host,
+		Port:     port,
+		User:     cfg.User,
+		Password: cfg.Passwd,
+		Database: cfg.DBName,
+	}, nil
+}
+
+// Ghost 通过 gh-ost 执行单条变更
+type Ghost struct {
+	config GhostConfig
+}
+
+// NewGhost 创建 gh-ost 执行器，未设置的参数使用默认值
+func NewGhost(config GhostConfig) *Ghost {
+	if config.Binary == "" {
+		config.Binary = "gh-ost"
+	}
+	if config.ChunkSize <= 0 {
+		config.ChunkSize = 1000
+	}
+	if config.MaxLoad == "" {
+		config.MaxLoad = "Threads_running=25"
+	}
+	if config.CriticalLoad == "" {
+		config.CriticalLoad = "Threads_running=100"
+	}
+	if config.MaxLagMillis <= 0 {
+		config.MaxLagMillis = 1500
+	}
+	if config.WorkDir == "" {
+		config.WorkDir = os.TempDir()
+	}
+	return &Ghost{config: config}
+}
+
+// Args 构造执行参数。连接密码写在 confFile 中，不出现在进程参数里；
+// 上次中止遗留的影子表在开始前删除，旧表保留为 _del 表供回滚
+func (g *Ghost) Args(stmt Statement, confFile, panicFlag string) []string {
+	c := g.config
+	args := []string{
+		"--host=" + c.Host,
+		"--port=" + strconv.Itoa(c.Port),
+		"--conf=" + confFile,
+		"--database=" + c.Database,
+		"--table=" + stmt.Table,
+		"--alter=" + stmt.Alter,
+		"--chunk-size=" + strconv.Itoa(c.ChunkSize),
+		"--max-load=" + c.MaxLoad,
+		"--critical-load=" + c.CriticalLoad,
+		"--max-lag-millis=" + strconv.Itoa(c.MaxLagMillis),
+		"--panic-flag-file=" + panicFlag,
+		"--initially-drop-ghost-table",
+		"--initially-drop-socket-file",
+		"--exact-rowcount",
+		"--default-retries=120",
+		"--verbose",
+	}
+	if c.AllowOnMaster {
+		args = append(args, "--allow-on-master")
+	}
+	if c.Execute {
+		args = append(args, "--execute")
+	}
+	return args
+}
+
+// Run 执行变更并回调进度。ctx 取消时写入中止标记，gh-ost 放弃切换并退出，返回 ErrAborted
+func (g *Ghost) Run(ctx context.Context, stmt Statement, progress func(Progress)) error {
+	base := filepath.Join(g.config.WorkDir, fmt.Sprintf("gh-ost.%s.%s", g.config.Database, stmt.Table))
+	confFile, panicFlag := base+".cnf", base+".panic.flag"
+
+	// 清理上次中止留下的标记，否则 gh-ost 启动后会立即中止
+	if err := os.Remove(panicFlag); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	conf := fmt.Sprintf("[client]\nuser=%s\npassword=%s\n", g.config.User, g.config.Password)
+	if err := os.WriteFile(confFile, []byte(conf), 0o600); err != nil {
+		return err
+	}
+	defer os.Remove(confFile)
+
+	cmd := exec.Command(g.config.Binary, g.Args(stmt, confFile, panicFlag)...)
+	output, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start gh-ost: %w", err)
+	}
+
+	done := make(chan struct{})
+	aborted := make(chan struct{})
+	go func() {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+		}
+		close(aborted)
+		if err := os.WriteFile(panicFlag, nil, 0o600); err != nil {
+			cmd.Process.Kill()
+			return
+		}
+		select {
+		case <-done:
+		case <-time.After(abortGracePeriod):
+			cmd.Process.Kill()
+		}
+	}()
+
+	scanner := bufio.NewScanner(output)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if g.config.Output != nil {
+			fmt.Fprintln(g.config.Output, line)
+		}
+		if p, ok := ParseProgress(line); ok && progress != nil {
+			p.Table = stmt.Table
+			progress(p)
+		}
+	}
+
+	err = cmd.Wait()
+	close(done)
+	os.Remove(panicFlag)
+
+	select {
+	case <-aborted:
+		return fmt.Errorf("%w: %v", ErrAborted, ctx.Err())
+	default:
+	}
+	if err != nil {
+		return fmt.Errorf("gh-ost failed on %s: %w", stmt.Table, err)
+	}
+	return nil
+}
+
+// Progress gh-ost 的复制进度
+type Progress struct {
+	Table   string
+	Copied  int64
+	Total   int64
+	Percent float64
+	State   string // migrating、throttled、postponing cut-over 等
+	ETA     string // 预计剩余时间，无法估计时为 N/A
+}
+
+// 示例：Copy: 1000/2915 34.3%; Applied: 0; Backlog: 0/1000; Time: 5s(total), 4s(copy); streamer: mysql-bin.000003:10749;
+// Lag: 0.01s, HeartbeatLag: 0.01s, State: migrating; ETA: 8s
+var progressPattern = regexp.MustCompile(`Copy: (\d+)/(\d+) ([\d.]+)%;.*State: ([^;]+); ETA: (\S+)`)
+
+// ParseProgress 解析 gh-ost 的状态行，不是状态行时返回 false
+func ParseProgress(line string) (Progress, bool) {
+	match := progressPattern.FindStringSubmatch(line)
+	if match == nil {
+		return Progress{}, false
+	}
+
+	copied, _ := strconv.ParseInt(match[1], 10, 64)
+	total, _ := strconv.ParseInt(match[2], 10, 64)
+	percent, _ := strconv.ParseFloat(match[3], 64)
+	return Progress{
+		Copied:  copied,
+		Total:   total,
+		Percent: percent,
+		State:   match[4],
+		ETA:     match[5],
+	}, true
+}
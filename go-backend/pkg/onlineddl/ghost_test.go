@@ -0,0 +1,115 @@
+package onlineddl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGhostConfigFromDSN(t *testing.T) {
+	config, err := GhostConfigFromDSN("tiktok:tiktok123@tcp(mysql-master:3306)/tiktok?charset=utf8mb4&parseTime=True")
+	require.NoError(t, err)
+
+	assert.Equal(t, "mysql-master", config.Host)
+	assert.Equal(t, 3306, config.Port)
+	assert.Equal(t, "tiktok", config.User)
+	assert.Equal(t, "tiktok123", config.Password)
+	assert.Equal(t, "tiktok", config.Database)
+}
+
+func TestGhostArgs(t *testing.T) {
+	ghost := NewGhost(GhostConfig{Host: "db", Port: 3306, User: "u", Password: "secret", Database: "tiktok"})
+	args := ghost.Args(Statement{Table: "videos", Alter: "ADD COLUMN a int"}, "/tmp/x.cnf", "/tmp/x.flag")
+
+	assert.Contains(t, args, "--table=videos")
+	assert.Contains(t, args, "--alter=ADD COLUMN a int")
+	assert.Contains(t, args, "--chunk-size=1000")
+	assert.Contains(t, args, "--panic-flag-file=/tmp/x.flag")
+	assert.NotContains(t, args, "--execute")
+	assert.NotContains(t, strings.Join(args, " "), "secret")
+
+	ghost = NewGhost(GhostConfig{Execute: true, AllowOnMaster: true})
+	args = ghost.Args(Statement{Table: "videos"}, "", "")
+	assert.Contains(t, args, "--execute")
+	assert.Contains(t, args, "--allow-on-master")
+}
+
+func TestParseProgress(t *testing.T) {
+	p, ok := ParseProgress("Copy: 1000/2915 34.3%; Applied: 0; Backlog: 0/1000; Time: 5s(total), 4s(copy); " +
+		"streamer: mysql-bin.000003:10749; Lag: 0.01s, HeartbeatLag: 0.01s, State: migrating; ETA: 8s")
+	require.True(t, ok)
+	assert.Equal(t, Progress{Copied: 1000, Total: 2915, Percent: 34.3, State: "migrating", ETA: "8s"}, p)
+
+	p, ok = ParseProgress("Copy: 0/0 100.0%; Applied: 0; Backlog: 0/1000; Time: 1s(total), 0s(copy); " +
+		"streamer: mysql-bin.000003:1; Lag: 0.01s, HeartbeatLag: 0.01s, State: postponing cut-over; ETA: due")
+	require.True(t, ok)
+	assert.Equal(t, "postponing cut-over", p.State)
+
+	_, ok = ParseProgress("# Migrating `tiktok`.`videos`; Ghost table is `tiktok`.`_videos_gho`")
+	assert.False(t, ok)
+}
+
+// fakeGhost 写入模拟 gh-ost 的脚本：输出一行进度后等待中止标记或直接退出
+func fakeGhost(t *testing.T, script string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake gh-ost requires a POSIX shell")
+	}
+	path := filepath.Join(t.TempDir(), "gh-ost")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755))
+	return path
+}
+
+func TestGhostRun(t *testing.T) {
+	t.Run("Progress", func(t *testing.T) {
+		binary := fakeGhost(t, `echo "Copy: 5/10 50.0%; Applied: 0; Backlog: 0/1000; Time: 1s(total), 1s(copy); streamer: b:1; Lag: 0.01s, HeartbeatLag: 0.01s, State: migrating; ETA: 1s"
+echo "# Done"
+`)
+		ghost := NewGhost(GhostConfig{Binary: binary, Database: "tiktok", WorkDir: t.TempDir()})
+
+		var got []Progress
+		err := ghost.Run(context.Background(), Statement{Table: "videos", Alter: "ADD COLUMN a int"}, func(p Progress) {
+			got = append(got, p)
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "videos", got[0].Table)
+		assert.Equal(t, int64(5), got[0].Copied)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		ghost := NewGhost(GhostConfig{Binary: fakeGhost(t, "exit 1\n"), WorkDir: t.TempDir()})
+
+		err := ghost.Run(context.Background(), Statement{Table: "videos"}, nil)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrAborted)
+	})
+
+	t.Run("AbortWritesPanicFlag", func(t *testing.T) {
+		// 模拟 gh-ost 检测到中止标记后退出
+		binary := fakeGhost(t, `for arg in "$@"; do
+  case "$arg" in --panic-flag-file=*) flag="${arg#--panic-flag-file=}";; esac
+done
+echo started
+while [ ! -f "$flag" ]; do sleep 0.05; done
+exit 1
+`)
+		workDir := t.TempDir()
+		ghost := NewGhost(GhostConfig{Binary: binary, Database: "tiktok", WorkDir: workDir})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		err := ghost.Run(ctx, Statement{Table: "videos"}, nil)
+		assert.ErrorIs(t, err, ErrAborted)
+
+		leftovers, _ := filepath.Glob(filepath.Join(workDir, "gh-ost.*"))
+		assert.Empty(t, leftovers)
+	})
+}
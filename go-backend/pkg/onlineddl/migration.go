@@ -0,0 +1,121 @@
+// Package onlineddl 对大表执行在线结构变更。
+//
+// 普通迁移文件中的 ALTER TABLE 会锁表或长时间占用复制，videos、user_follows 等大表的变更
+// 放在 migrations/online 目录，由 cmd/online-migrate 逐条交给 gh-ost 执行：gh-ost 先在影子表上
+// 按批复制数据并通过 binlog 追平增量，最后原子切换表名，中途中止不会影响原表。
+//
+// 在线迁移文件沿用 sql-migrate 的格式，Up 部分只允许 ALTER TABLE 语句：
+//
+//	-- +migrate Up
+//	ALTER TABLE `videos` ADD COLUMN `language` varchar(16) NOT NULL DEFAULT '';
+//
+//	-- +migrate Down
+//	ALTER TABLE `videos` DROP COLUMN `language`;
+package onlineddl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Statement 单条表结构变更，Alter 为 ALTER TABLE 表名之后的部分
+type Statement struct {
+	Table string
+	Alter string
+}
+
+// Migration 在线迁移文件
+type Migration struct {
+	ID   string // 文件名去掉扩展名，如 030_add_video_language
+	Up   []Statement
+	Down []Statement
+}
+
+// StatementID 语句的唯一标识，用于记录执行历史，同一文件中的语句逐条记录
+func (m *Migration) StatementID(index int) string {
+	return fmt.Sprintf("%s#%d", m.ID, index+1)
+}
+
+var alterPattern = regexp.MustCompile("(?is)^ALTER\\s+TABLE\\s+`?([A-Za-z0-9_]+)`?\\s+(.+)$")
+
+// ParseMigration 解析在线迁移文件，语句不是 ALTER TABLE 时返回错误。
+// 语句按分号拆分，注释和默认值中不能包含分号
+func ParseMigration(id, content string) (*Migration, error) {
+	m := &Migration{ID: id}
+
+	var section *[]Statement
+	var buf strings.Builder
+	flush := func() error {
+		for _, raw := range strings.Split(buf.String(), ";") {
+			sql := strings.Join(strings.Fields(raw), " ")
+			if sql == "" {
+				continue
+			}
+			if section == nil {
+				return fmt.Errorf("%s: statement outside of +migrate section", id)
+			}
+			match := alterPattern.FindStringSubmatch(sql)
+			if match == nil {
+				return fmt.Errorf("%s: only ALTER TABLE is allowed in online migrations: %q", id, sql)
+			}
+			*section = append(*section, Statement{Table: match[1], Alter: match[2]})
+		}
+		buf.Reset()
+		return nil
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "-- +migrate Up"):
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			section = &m.Up
+		case strings.HasPrefix(trimmed, "-- +migrate Down"):
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			section = &m.Down
+		case trimmed == "" || strings.HasPrefix(trimmed, "--"):
+		default:
+			buf.WriteString(line)
+			buf.WriteString("\n")
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if len(m.Up) == 0 {
+		return nil, fmt.Errorf("%s: no ALTER TABLE statement in +migrate Up", id)
+	}
+	return m, nil
+}
+
+// LoadMigrations 读取目录下的全部 .sql 文件，按文件名排序
+func LoadMigrations(dir string) ([]*Migration, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	migrations := make([]*Migration, 0, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		m, err := ParseMigration(strings.TrimSuffix(filepath.Base(file), ".sql"), string(content))
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, nil
+}
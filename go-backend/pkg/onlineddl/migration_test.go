@@ -0,0 +1,63 @@
+package onlineddl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMigration(t *testing.T) {
+	m, err := ParseMigration("030_add_video_language", `-- +migrate Up
+-- 视频语言，用于推荐过滤
+ALTER TABLE `+"`videos`"+`
+  ADD COLUMN `+"`language`"+` varchar(16) NOT NULL DEFAULT '',
+  ADD INDEX `+"`idx_language`"+` (`+"`language`"+`);
+alter table user_follows add index idx_created (created_at);
+
+-- +migrate Down
+ALTER TABLE `+"`videos`"+` DROP INDEX `+"`idx_language`"+`, DROP COLUMN `+"`language`"+`;
+`)
+	require.NoError(t, err)
+
+	require.Len(t, m.Up, 2)
+	assert.Equal(t, "videos", m.Up[0].Table)
+	assert.Equal(t, "ADD COLUMN `language` varchar(16) NOT NULL DEFAULT '', ADD INDEX `idx_language` (`language`)", m.Up[0].Alter)
+	assert.Equal(t, Statement{Table: "user_follows", Alter: "add index idx_created (created_at)"}, m.Up[1])
+	require.Len(t, m.Down, 1)
+	assert.Equal(t, "030_add_video_language#2", m.StatementID(1))
+}
+
+func TestParseMigrationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"NotAlter", "-- +migrate Up\nCREATE TABLE t (id int);"},
+		{"OutsideSection", "ALTER TABLE videos ADD COLUMN a int;"},
+		{"EmptyUp", "-- +migrate Up\n-- +migrate Down\nALTER TABLE videos DROP COLUMN a;"},
+		{"UpdateRows", "-- +migrate Up\nUPDATE videos SET status = 1;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseMigration("030_test", tt.content)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "031_b.sql"), []byte("-- +migrate Up\nALTER TABLE videos ADD COLUMN b int;"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "030_a.sql"), []byte("-- +migrate Up\nALTER TABLE videos ADD COLUMN a int;"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a migration"), 0o644))
+
+	migrations, err := LoadMigrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, "030_a", migrations[0].ID)
+	assert.Equal(t, "031_b", migrations[1].ID)
+}
@@ -0,0 +1,116 @@
+package onlineddl
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// historyTable 记录已执行的在线变更，与 sql-migrate 的记录表分开，两者互不影响
+const historyTable = "online_schema_migrations"
+
+// Executor 执行单条变更
+type Executor interface {
+	Run(ctx context.Context, stmt Statement, progress func(Progress)) error
+}
+
+// History 在线变更的执行记录，按语句记录，部分失败后重新执行时跳过已完成的语句
+type History struct {
+	db *sql.DB
+}
+
+// NewHistory 创建执行记录，记录表不存在时创建
+func NewHistory(ctx context.Context, db *sql.DB) (*History, error) {
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS `"+historyTable+"` ("+
+		"`id` varchar(255) NOT NULL, "+
+		"`applied_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP, "+
+		"PRIMARY KEY (`id`)"+
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4")
+	if err != nil {
+		return nil, err
+	}
+	return &History{db: db}, nil
+}
+
+// Applied 返回已执行的语句标识
+func (h *History) Applied(ctx context.Context) (map[string]bool, error) {
+	rows, err := h.db.QueryContext(ctx, "SELECT `id` FROM `"+historyTable+"`")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		applied[id] = true
+	}
+	return applied, rows.Err()
+}
+
+// Record 记录语句已执行
+func (h *History) Record(ctx context.Context, id string) error {
+	_, err := h.db.ExecContext(ctx, "INSERT INTO `"+historyTable+"` (`id`) VALUES (?)", id)
+	return err
+}
+
+// Runner 按文件名顺序执行未完成的在线变更
+type Runner struct {
+	executor Executor
+	history  *History
+	// dryRun 为 true 时执行器只做演练，不写入执行记录
+	dryRun bool
+}
+
+// NewRunner 创建执行器，dryRun 需与执行器的演练模式一致
+func NewRunner(executor Executor, history *History, dryRun bool) *Runner {
+	return &Runner{executor: executor, history: history, dryRun: dryRun}
+}
+
+// Pending 返回尚未执行的语句
+func (r *Runner) Pending(ctx context.Context, migrations []*Migration) ([]PendingStatement, error) {
+	applied, err := r.history.Applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []PendingStatement
+	for _, m := range migrations {
+		for i, stmt := range m.Up {
+			if id := m.StatementID(i); !applied[id] {
+				pending = append(pending, PendingStatement{ID: id, Statement: stmt})
+			}
+		}
+	}
+	return pending, nil
+}
+
+// PendingStatement 待执行的语句
+type PendingStatement struct {
+	ID string
+	Statement
+}
+
+// Run 依次执行待执行的语句，遇到失败或中止立即停止，已完成的语句不会回滚
+func (r *Runner) Run(ctx context.Context, pending []PendingStatement, progress func(string, Progress)) error {
+	for _, p := range pending {
+		err := r.executor.Run(ctx, p.Statement, func(pr Progress) {
+			if progress != nil {
+				progress(p.ID, pr)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", p.ID, err)
+		}
+		if r.dryRun {
+			continue
+		}
+		if err := r.history.Record(ctx, p.ID); err != nil {
+			return fmt.Errorf("%s applied but not recorded, record it manually: %w", p.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package reqctx
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+
+	"go-backend/pkg/auth"
+)
+
+// contextKey 上下文key类型，避免与其他包的字符串key冲突
+type contextKey int
+
+const (
+	userIDKey contextKey = iota
+	usernameKey
+	tokenIDKey
+	refreshTokenKey
+	claimsKey
+	traceIDKey
+	tenantKey
+	deviceIDKey
+	clientIPKey
+	userAgentKey
+)
+
+// 请求元数据在HTTP/gRPC/Kafka头中的名称
+const (
+	HeaderTraceID  = "X-Trace-ID"
+	HeaderTenant   = "X-Tenant-ID"
+	HeaderDeviceID = "X-Device-ID"
+	HeaderUserID   = "X-User-ID"
+)
+
+// WithUserID 设置用户ID到上下文
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserID 从上下文获取用户ID
+func UserID(ctx context.Context) (int64, bool) {
+	userID, ok := ctx.Value(userIDKey).(int64)
+	return userID, ok
+}
+
+// WithUsername 设置用户名到上下文
+func WithUsername(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, usernameKey, username)
+}
+
+// Username 从上下文获取用户名
+func Username(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(usernameKey).(string)
+	return username, ok
+}
+
+// WithTokenID 设置TokenID到上下文
+func WithTokenID(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, tokenIDKey, tokenID)
+}
+
+// TokenID 从上下文获取TokenID
+func TokenID(ctx context.Context) (string, bool) {
+	tokenID, ok := ctx.Value(tokenIDKey).(string)
+	return tokenID, ok
+}
+
+// WithRefreshToken 设置刷新Token到上下文
+func WithRefreshToken(ctx context.Context, refreshToken string) context.Context {
+	return context.WithValue(ctx, refreshTokenKey, refreshToken)
+}
+
+// RefreshToken 从上下文获取刷新Token
+func RefreshToken(ctx context.Context) (string, bool) {
+	refreshToken, ok := ctx.Value(refreshTokenKey).(string)
+	return refreshToken, ok
+}
+
+// WithClaims 设置JWT Claims到上下文，同时写入用户ID、用户名和TokenID
+func WithClaims(ctx context.Context, claims *auth.Claims) context.Context {
+	if claims == nil {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, claimsKey, claims)
+	ctx = WithUserID(ctx, claims.UserID)
+	ctx = WithUsername(ctx, claims.Username)
+	return WithTokenID(ctx, claims.TokenID)
+}
+
+// Claims 从上下文获取JWT Claims
+func Claims(ctx context.Context) (*auth.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*auth.Claims)
+	return claims, ok
+}
+
+// WithTraceID 设置链路ID到上下文
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey, traceID)
+}
+
+// TraceID 从上下文获取链路ID
+func TraceID(ctx context.Context) (string, bool) {
+	traceID, ok := ctx.Value(traceIDKey).(string)
+	return traceID, ok
+}
+
+// WithTenant 设置租户到上下文
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey, tenant)
+}
+
+// Tenant 从上下文获取租户
+func Tenant(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey).(string)
+	return tenant, ok
+}
+
+// WithDeviceID 设置设备ID到上下文
+func WithDeviceID(ctx context.Context, deviceID string) context.Context {
+	return context.WithValue(ctx, deviceIDKey, deviceID)
+}
+
+// DeviceID 从上下文获取设备ID
+func DeviceID(ctx context.Context) (string, bool) {
+	deviceID, ok := ctx.Value(deviceIDKey).(string)
+	return deviceID, ok
+}
+
+// WithClientIP 设置客户端IP到上下文
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+// ClientIP 从上下文获取客户端IP
+func ClientIP(ctx context.Context) (string, bool) {
+	ip, ok := ctx.Value(clientIPKey).(string)
+	return ip, ok
+}
+
+// WithUserAgent 设置客户端User-Agent到上下文
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey, userAgent)
+}
+
+// UserAgent 从上下文获取客户端User-Agent
+func UserAgent(ctx context.Context) (string, bool) {
+	userAgent, ok := ctx.Value(userAgentKey).(string)
+	return userAgent, ok
+}
+
+// NewTraceID 生成新的链路ID
+func NewTraceID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// ToHeaders 将上下文中需要跨服务传递的元数据导出为头部键值对
+func ToHeaders(ctx context.Context) map[string]string {
+	headers := make(map[string]string)
+	if traceID, ok := TraceID(ctx); ok && traceID != "" {
+		headers[HeaderTraceID] = traceID
+	}
+	if tenant, ok := Tenant(ctx); ok && tenant != "" {
+		headers[HeaderTenant] = tenant
+	}
+	if deviceID, ok := DeviceID(ctx); ok && deviceID != "" {
+		headers[HeaderDeviceID] = deviceID
+	}
+	if userID, ok := UserID(ctx); ok {
+		headers[HeaderUserID] = strconv.FormatInt(userID, 10)
+	}
+	return headers
+}
+
+// FromHeaders 从头部键值对恢复元数据到上下文，未知或非法的值会被忽略
+func FromHeaders(ctx context.Context, headers map[string]string) context.Context {
+	if traceID := headers[HeaderTraceID]; traceID != "" {
+		ctx = WithTraceID(ctx, traceID)
+	}
+	if tenant := headers[HeaderTenant]; tenant != "" {
+		ctx = WithTenant(ctx, tenant)
+	}
+	if deviceID := headers[HeaderDeviceID]; deviceID != "" {
+		ctx = WithDeviceID(ctx, deviceID)
+	}
+	if raw := headers[HeaderUserID]; raw != "" {
+		if userID, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			ctx = WithUserID(ctx, userID)
+		}
+	}
+	return ctx
+}
@@ -0,0 +1,75 @@
+package reqctx
+
+import (
+	"context"
+	"testing"
+
+	"go-backend/pkg/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaims(t *testing.T) {
+	ctx := WithClaims(context.Background(), &auth.Claims{UserID: 42, Username: "alice", TokenID: "tid"})
+
+	claims, ok := Claims(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(42), claims.UserID)
+
+	userID, ok := UserID(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), userID)
+
+	username, _ := Username(ctx)
+	assert.Equal(t, "alice", username)
+
+	tokenID, _ := TokenID(ctx)
+	assert.Equal(t, "tid", tokenID)
+}
+
+func TestStringKeyDoesNotCollide(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", int64(1))
+
+	_, ok := UserID(ctx)
+	assert.False(t, ok)
+}
+
+func TestHeadersRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	ctx = WithTraceID(ctx, "trace-1")
+	ctx = WithTenant(ctx, "tenant-a")
+	ctx = WithDeviceID(ctx, "device-x")
+	ctx = WithUserID(ctx, 7)
+
+	headers := ToHeaders(ctx)
+	assert.Equal(t, map[string]string{
+		HeaderTraceID:  "trace-1",
+		HeaderTenant:   "tenant-a",
+		HeaderDeviceID: "device-x",
+		HeaderUserID:   "7",
+	}, headers)
+
+	restored := FromHeaders(context.Background(), headers)
+	traceID, _ := TraceID(restored)
+	tenant, _ := Tenant(restored)
+	deviceID, _ := DeviceID(restored)
+	userID, _ := UserID(restored)
+	assert.Equal(t, "trace-1", traceID)
+	assert.Equal(t, "tenant-a", tenant)
+	assert.Equal(t, "device-x", deviceID)
+	assert.Equal(t, int64(7), userID)
+}
+
+func TestFromHeadersIgnoresInvalidUserID(t *testing.T) {
+	ctx := FromHeaders(context.Background(), map[string]string{HeaderUserID: "abc"})
+
+	_, ok := UserID(ctx)
+	assert.False(t, ok)
+}
+
+func TestNewTraceID(t *testing.T) {
+	a, b := NewTraceID(), NewTraceID()
+	assert.Len(t, a, 32)
+	assert.NotEqual(t, a, b)
+}
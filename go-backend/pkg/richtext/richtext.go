@@ -0,0 +1,269 @@
+package richtext
+
+import (
+	"errors"
+	"html"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// EntityType 富文本实体类型
+type EntityType string
+
+const (
+	EntityMention EntityType = "mention"
+	EntityHashtag EntityType = "hashtag"
+	EntityLink    EntityType = "link"
+)
+
+// Entity 富文本实体，Offset和Length以Unicode字符（rune）计
+type Entity struct {
+	Type   EntityType
+	Offset int
+	Length int
+	// Value 提及的用户名、话题名（不含前缀）或规范化后的链接
+	Value string
+}
+
+// Text 纯文本及其实体
+type Text struct {
+	Plain    string
+	Entities []Entity
+}
+
+// Limits 实体数量限制
+type Limits struct {
+	MaxMentions int
+	MaxHashtags int
+	MaxLinks    int
+}
+
+// DefaultLimits 默认实体数量限制
+var DefaultLimits = Limits{
+	MaxMentions: 10,
+	MaxHashtags: 10,
+	MaxLinks:    3,
+}
+
+var (
+	ErrTooManyMentions = errors.New("too many mentions")
+	ErrTooManyHashtags = errors.New("too many hashtags")
+	ErrTooManyLinks    = errors.New("too many links")
+	ErrInvalidHashtag  = errors.New("invalid hashtag")
+)
+
+var (
+	// 实体前必须是文本开头或非单词字符，避免把邮箱等识别为提及
+	mentionRegex     = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])@([a-zA-Z0-9_]{3,32})\b`)
+	hashtagRegex     = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_#&])#([\p{L}\p{N}_]{1,50})`)
+	hashtagNameRegex = regexp.MustCompile(`^[\p{L}\p{N}_]{1,50}$`)
+	linkRegex        = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"']+`)
+
+	htmlTagRegex   = regexp.MustCompile(`</?[a-zA-Z!][^>]*>`)
+	blankLineRegex = regexp.MustCompile(`\n{3,}`)
+)
+
+// Sanitize 清理用户输入：去除非法UTF-8、控制字符和HTML标签，结果按纯文本存储
+func Sanitize(input string) string {
+	input = strings.ToValidUTF8(input, "")
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+
+	input = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+		return r
+	}, input)
+
+	input = htmlTagRegex.ReplaceAllString(input, "")
+	input = blankLineRegex.ReplaceAllString(input, "\n\n")
+
+	return strings.TrimSpace(input)
+}
+
+// Parse 清理输入并解析实体，实体数量超过限制时返回错误
+func Parse(input string, limits Limits) (*Text, error) {
+	text := Extract(Sanitize(input))
+
+	var mentions, hashtags, links int
+	for _, e := range text.Entities {
+		switch e.Type {
+		case EntityMention:
+			mentions++
+		case EntityHashtag:
+			hashtags++
+		case EntityLink:
+			links++
+		}
+	}
+
+	if limits.MaxMentions > 0 && mentions > limits.MaxMentions {
+		return nil, ErrTooManyMentions
+	}
+	if limits.MaxHashtags > 0 && hashtags > limits.MaxHashtags {
+		return nil, ErrTooManyHashtags
+	}
+	if limits.MaxLinks > 0 && links > limits.MaxLinks {
+		return nil, ErrTooManyLinks
+	}
+
+	return text, nil
+}
+
+// Extract 从已存储的纯文本中解析实体，不做数量校验，用于读取路径
+func Extract(plain string) *Text {
+	type span struct {
+		start, end int
+		entity     Entity
+	}
+	var spans []span
+
+	// 先识别链接，链接内的#和@不再作为话题或提及
+	for _, loc := range linkRegex.FindAllStringIndex(plain, -1) {
+		raw := strings.TrimRight(plain[loc[0]:loc[1]], ".,;:!?)]}")
+		normalized, ok := normalizeLink(raw)
+		if !ok {
+			continue
+		}
+		spans = append(spans, span{
+			start:  loc[0],
+			end:    loc[0] + len(raw),
+			entity: Entity{Type: EntityLink, Value: normalized},
+		})
+	}
+
+	overlaps := func(start, end int) bool {
+		for _, s := range spans {
+			if start < s.end && end > s.start {
+				return true
+			}
+		}
+		return false
+	}
+
+	collect := func(re *regexp.Regexp, typ EntityType) {
+		for _, loc := range re.FindAllStringSubmatchIndex(plain, -1) {
+			// loc[2]:loc[3]为实体名，前一个字节为@或#前缀
+			start, end := loc[2]-1, loc[3]
+			if overlaps(start, end) {
+				continue
+			}
+			spans = append(spans, span{
+				start:  start,
+				end:    end,
+				entity: Entity{Type: typ, Value: plain[loc[2]:loc[3]]},
+			})
+		}
+	}
+	collect(mentionRegex, EntityMention)
+	collect(hashtagRegex, EntityHashtag)
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+
+	entities := make([]Entity, 0, len(spans))
+	for _, s := range spans {
+		e := s.entity
+		e.Offset = utf8.RuneCountInString(plain[:s.start])
+		e.Length = utf8.RuneCountInString(plain[s.start:s.end])
+		entities = append(entities, e)
+	}
+
+	return &Text{Plain: plain, Entities: entities}
+}
+
+// ReplaceHashtags 删除纯文本中已有的话题，再把 tags 按顺序以 #话题 追加到末尾，重复的话题只保留一个。
+// 话题名不含 # 前缀，只能由字母、数字和下划线组成
+func ReplaceHashtags(plain string, tags []string) (string, error) {
+	seen := make(map[string]bool, len(tags))
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+		if !hashtagNameRegex.MatchString(tag) {
+			return "", ErrInvalidHashtag
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			names = append(names, tag)
+		}
+	}
+
+	runes := []rune(plain)
+	var b strings.Builder
+	pos := 0
+	for _, e := range Extract(plain).Entities {
+		if e.Type != EntityHashtag {
+			continue
+		}
+		b.WriteString(string(runes[pos:e.Offset]))
+		pos = e.Offset + e.Length
+	}
+	b.WriteString(string(runes[pos:]))
+
+	// 删除话题后留下的多余空白
+	parts := strings.FieldsFunc(b.String(), func(r rune) bool { return r == ' ' || r == '\t' })
+	for _, name := range names {
+		parts = append(parts, "#"+name)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// RenderHTML 渲染为安全的HTML：普通文本全部转义，仅实体输出为受控的链接
+func RenderHTML(text *Text) string {
+	if text == nil {
+		return ""
+	}
+
+	runes := []rune(text.Plain)
+	var b strings.Builder
+	pos := 0
+
+	for _, e := range text.Entities {
+		if e.Offset < pos || e.Offset+e.Length > len(runes) {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:e.Offset])))
+		display := html.EscapeString(string(runes[e.Offset : e.Offset+e.Length]))
+
+		switch e.Type {
+		case EntityMention:
+			b.WriteString(`<a class="mention" href="/user/` + url.PathEscape(e.Value) + `">` + display + `</a>`)
+		case EntityHashtag:
+			b.WriteString(`<a class="hashtag" href="/tag/` + url.PathEscape(e.Value) + `">` + display + `</a>`)
+		case EntityLink:
+			b.WriteString(`<a href="` + html.EscapeString(e.Value) + `" rel="nofollow noopener noreferrer" target="_blank">` + display + `</a>`)
+		default:
+			b.WriteString(display)
+		}
+		pos = e.Offset + e.Length
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:])))
+
+	return b.String()
+}
+
+// normalizeLink 校验链接，只允许带主机名的http/https链接
+func normalizeLink(raw string) (string, bool) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", false
+	}
+	if u.Host == "" || u.User != nil {
+		return "", false
+	}
+	u.Scheme = scheme
+	u.Host = strings.ToLower(u.Host)
+	return u.String(), true
+}
@@ -13,7 +13,7 @@ import (
 const (
 	saltLength = 16
 	keyLength  = 32
-	time       = 1
+	argonTime  = 1
 	memory     = 64 * 1024
 	threads    = 4
 )
@@ -25,7 +25,7 @@ func HashPassword(password string) (string, string, error) {
 		return "", "", err
 	}
 
-	hash := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLength)
+	hash := argon2.IDKey([]byte(password), salt, argonTime, memory, threads, keyLength)
 
 	saltStr := base64.RawStdEncoding.EncodeToString(salt)
 	hashStr := base64.RawStdEncoding.EncodeToString(hash)
@@ -45,7 +45,7 @@ func VerifyPassword(password, hash, salt string) (bool, error) {
 		return false, err
 	}
 
-	comparisonHash := argon2.IDKey([]byte(password), saltBytes, time, memory, threads, keyLength)
+	comparisonHash := argon2.IDKey([]byte(password), saltBytes, argonTime, memory, threads, keyLength)
 
 	return subtle.ConstantTimeCompare(hashBytes, comparisonHash) == 1, nil
 }
@@ -0,0 +1,203 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// ModerationVerdict 内容审核结论，数值越大越严格
+type ModerationVerdict int
+
+const (
+	ModerationPass   ModerationVerdict = iota // 通过
+	ModerationReview                          // 疑似违规，转人工复核
+	ModerationBlock                           // 违规，直接拒绝
+)
+
+// ModerationResult 审核结果
+type ModerationResult struct {
+	Verdict ModerationVerdict
+	// Hits 命中的词或外部审核给出的标签，用于日志和人工复核
+	Hits []string
+}
+
+// ContentModerator 内容审核器，本地词表和外部审核服务都实现该接口
+type ContentModerator interface {
+	Moderate(ctx context.Context, text string) (*ModerationResult, error)
+}
+
+type trieNode struct {
+	children map[rune]*trieNode
+	// verdict 以该节点结尾的词的审核结论，ModerationPass 表示不是词尾
+	verdict ModerationVerdict
+	word    string
+}
+
+// SensitiveWordFilter 基于前缀树的敏感词过滤器。匹配前统一转为小写半角并去掉空白和标点，
+// 用 "敏 感 词"、"敏.感.词" 等方式插入分隔符无法绕过
+type SensitiveWordFilter struct {
+	mu   sync.RWMutex
+	root *trieNode
+}
+
+// NewSensitiveWordFilter 创建敏感词过滤器，words 为词到审核结论的映射
+func NewSensitiveWordFilter(words map[string]ModerationVerdict) *SensitiveWordFilter {
+	f := &SensitiveWordFilter{}
+	f.Load(words)
+	return f
+}
+
+// Load 用新词表整体替换当前词表，加载期间的匹配使用旧词表
+func (f *SensitiveWordFilter) Load(words map[string]ModerationVerdict) {
+	root := &trieNode{}
+	for word, verdict := range words {
+		if verdict <= ModerationPass {
+			continue
+		}
+		key := normalizeModerationText(word)
+		if len(key) == 0 {
+			continue
+		}
+
+		node := root
+		for _, r := range key {
+			if node.children == nil {
+				node.children = make(map[rune]*trieNode)
+			}
+			child, ok := node.children[r]
+			if !ok {
+				child = &trieNode{}
+				node.children[r] = child
+			}
+			node = child
+		}
+		// 同一个词在多个来源中出现时取更严格的结论
+		if verdict > node.verdict {
+			node.verdict = verdict
+			node.word = word
+		}
+	}
+
+	f.mu.Lock()
+	f.root = root
+	f.mu.Unlock()
+}
+
+// Moderate 查找文本中的所有敏感词，结论取命中词中最严格的一个
+func (f *SensitiveWordFilter) Moderate(ctx context.Context, text string) (*ModerationResult, error) {
+	f.mu.RLock()
+	root := f.root
+	f.mu.RUnlock()
+
+	result := &ModerationResult{Verdict: ModerationPass}
+	if root == nil || len(root.children) == 0 {
+		return result, nil
+	}
+
+	seen := make(map[string]bool)
+	runes := normalizeModerationText(text)
+	for start := range runes {
+		node := root
+		for _, r := range runes[start:] {
+			node = node.children[r]
+			if node == nil {
+				break
+			}
+			if node.verdict == ModerationPass || seen[node.word] {
+				continue
+			}
+			seen[node.word] = true
+			result.Hits = append(result.Hits, node.word)
+			if node.verdict > result.Verdict {
+				result.Verdict = node.verdict
+			}
+		}
+	}
+	sort.Strings(result.Hits)
+	return result, nil
+}
+
+// normalizeModerationText 转为小写半角，只保留字母和数字
+func normalizeModerationText(text string) []rune {
+	runes := make([]rune, 0, len(text))
+	for _, r := range text {
+		// 全角字符转半角
+		if r >= 0xFF01 && r <= 0xFF5E {
+			r -= 0xFEE0
+		}
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			continue
+		}
+		runes = append(runes, unicode.ToLower(r))
+	}
+	return runes
+}
+
+// RemoteModerator 调用外部审核服务。请求以JSON POST {"text": "..."}，
+// 响应 {"verdict": "pass|review|block", "labels": [...]}，未知的结论按 review 处理
+type RemoteModerator struct {
+	endpoint string
+	http     *http.Client
+}
+
+// NewRemoteModerator 创建外部审核器，超时由 client 或调用方的 ctx 控制
+func NewRemoteModerator(endpoint string, client *http.Client) *RemoteModerator {
+	return &RemoteModerator{
+		endpoint: endpoint,
+		http:     client,
+	}
+}
+
+type remoteModerationRequest struct {
+	Text string `json:"text"`
+}
+
+type remoteModerationResponse struct {
+	Verdict string   `json:"verdict"`
+	Labels  []string `json:"labels"`
+}
+
+// Moderate 调用外部审核服务，非200响应视为调用失败
+func (m *RemoteModerator) Moderate(ctx context.Context, text string) (*ModerationResult, error) {
+	body, err := json.Marshal(&remoteModerationRequest{Text: text})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("moderation service returned status %d", resp.StatusCode)
+	}
+
+	var decoded remoteModerationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	result := &ModerationResult{Hits: decoded.Labels}
+	switch strings.ToLower(decoded.Verdict) {
+	case "pass":
+		result.Verdict = ModerationPass
+	case "block":
+		result.Verdict = ModerationBlock
+	default:
+		result.Verdict = ModerationReview
+	}
+	return result, nil
+}
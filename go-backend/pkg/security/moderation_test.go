@@ -0,0 +1,94 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitiveWordFilter_Moderate(t *testing.T) {
+	ctx := context.Background()
+	filter := NewSensitiveWordFilter(map[string]ModerationVerdict{
+		"赌博":   ModerationBlock,
+		"代开发票": ModerationBlock,
+		"加微信":  ModerationReview,
+		"Spam": ModerationReview,
+	})
+
+	tests := []struct {
+		name    string
+		text    string
+		verdict ModerationVerdict
+		hits    []string
+	}{
+		{name: "Clean", text: "今天天气不错", verdict: ModerationPass},
+		{name: "Review", text: "想要资源加微信", verdict: ModerationReview, hits: []string{"加微信"}},
+		{name: "StrictestWins", text: "加微信，带你赌博", verdict: ModerationBlock, hits: []string{"加微信", "赌博"}},
+		{name: "SeparatorsIgnored", text: "代 开.发*票", verdict: ModerationBlock, hits: []string{"代开发票"}},
+		{name: "CaseAndWidthInsensitive", text: "ＳＰＡＭ here", verdict: ModerationReview, hits: []string{"Spam"}},
+		{name: "RepeatedHitOnce", text: "赌博赌博", verdict: ModerationBlock, hits: []string{"赌博"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := filter.Moderate(ctx, tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.verdict, result.Verdict)
+			assert.Equal(t, tt.hits, result.Hits)
+		})
+	}
+}
+
+func TestSensitiveWordFilter_Load(t *testing.T) {
+	ctx := context.Background()
+	filter := NewSensitiveWordFilter(map[string]ModerationVerdict{"旧词": ModerationBlock})
+
+	filter.Load(map[string]ModerationVerdict{"新词": ModerationReview})
+
+	result, err := filter.Moderate(ctx, "旧词和新词")
+	require.NoError(t, err)
+	assert.Equal(t, ModerationReview, result.Verdict)
+	assert.Equal(t, []string{"新词"}, result.Hits)
+}
+
+func TestRemoteModerator_Moderate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Verdict", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req remoteModerationRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			verdict := "pass"
+			if req.Text == "bad" {
+				verdict = "block"
+			}
+			json.NewEncoder(w).Encode(&remoteModerationResponse{Verdict: verdict, Labels: []string{"label"}})
+		}))
+		defer server.Close()
+
+		moderator := NewRemoteModerator(server.URL, server.Client())
+		result, err := moderator.Moderate(ctx, "bad")
+		require.NoError(t, err)
+		assert.Equal(t, ModerationBlock, result.Verdict)
+		assert.Equal(t, []string{"label"}, result.Hits)
+
+		result, err = moderator.Moderate(ctx, "good")
+		require.NoError(t, err)
+		assert.Equal(t, ModerationPass, result.Verdict)
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		_, err := NewRemoteModerator(server.URL, server.Client()).Moderate(ctx, "text")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,173 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldSanitizer 字段清洗函数，返回清洗后的值，值不可接受时返回错误
+type FieldSanitizer func(value string) (string, error)
+
+// SanitizeError 字段清洗失败
+type SanitizeError struct {
+	Field string
+	Err   error
+}
+
+func (e *SanitizeError) Error() string {
+	return fmt.Sprintf("invalid field %s: %v", e.Field, e.Err)
+}
+
+func (e *SanitizeError) Unwrap() error {
+	return e.Err
+}
+
+// SanitizerRegistry 按 proto 消息字段登记的清洗规则。清洗作用于解码后的请求消息，
+// 每个字段只套用为它登记的规则，未登记的字段原样交给业务层
+type SanitizerRegistry struct {
+	rules map[protoreflect.FullName]map[protoreflect.Name][]FieldSanitizer
+}
+
+// NewSanitizerRegistry 创建空的清洗规则表
+func NewSanitizerRegistry() *SanitizerRegistry {
+	return &SanitizerRegistry{
+		rules: make(map[protoreflect.FullName]map[protoreflect.Name][]FieldSanitizer),
+	}
+}
+
+// Register 为消息的字符串字段登记清洗规则，按登记顺序执行。字段不存在或不是字符串时 panic，
+// 规则表在启动时构建，拼错字段名应当立即暴露
+func (r *SanitizerRegistry) Register(msg proto.Message, field string, sanitizers ...FieldSanitizer) *SanitizerRegistry {
+	desc := msg.ProtoReflect().Descriptor()
+	fd := desc.Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsMap() {
+		panic(fmt.Sprintf("security: %s has no string field %q", desc.FullName(), field))
+	}
+
+	fields, ok := r.rules[desc.FullName()]
+	if !ok {
+		fields = make(map[protoreflect.Name][]FieldSanitizer)
+		r.rules[desc.FullName()] = fields
+	}
+	fields[fd.Name()] = append(fields[fd.Name()], sanitizers...)
+	return r
+}
+
+// Sanitize 就地清洗消息及其嵌套消息中登记过的字段，返回第一个 *SanitizeError
+func (r *SanitizerRegistry) Sanitize(msg proto.Message) error {
+	if msg == nil {
+		return nil
+	}
+	return r.sanitize(msg.ProtoReflect())
+}
+
+func (r *SanitizerRegistry) sanitize(m protoreflect.Message) error {
+	if !m.IsValid() {
+		return nil
+	}
+	rules := r.rules[m.Descriptor().FullName()]
+
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Kind() == protoreflect.StringKind && !fd.IsMap():
+			sanitizers := rules[fd.Name()]
+			if len(sanitizers) == 0 {
+				return true
+			}
+			if fd.IsList() {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					var s string
+					if s, err = applySanitizers(fd, list.Get(i).String(), sanitizers); err != nil {
+						return false
+					}
+					list.Set(i, protoreflect.ValueOfString(s))
+				}
+				return true
+			}
+			var s string
+			if s, err = applySanitizers(fd, v.String(), sanitizers); err != nil {
+				return false
+			}
+			m.Set(fd, protoreflect.ValueOfString(s))
+		case fd.Message() != nil && !fd.IsMap():
+			if fd.IsList() {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					if err = r.sanitize(list.Get(i).Message()); err != nil {
+						return false
+					}
+				}
+				return true
+			}
+			err = r.sanitize(v.Message())
+		}
+		return err == nil
+	})
+	return err
+}
+
+func applySanitizers(fd protoreflect.FieldDescriptor, value string, sanitizers []FieldSanitizer) (string, error) {
+	for _, sanitize := range sanitizers {
+		var err error
+		if value, err = sanitize(value); err != nil {
+			return "", &SanitizeError{Field: string(fd.Name()), Err: err}
+		}
+	}
+	return value, nil
+}
+
+// TrimSpace 去掉首尾空白
+func TrimSpace(value string) (string, error) {
+	return strings.TrimSpace(value), nil
+}
+
+// StripControl 去掉换行和制表符以外的控制字符，以及可以让文字倒序显示、伪装文件名或链接的双向文本控制符
+func StripControl(value string) (string, error) {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if unicode.IsControl(r) || isBidiControl(r) {
+			return -1
+		}
+		return r
+	}, value), nil
+}
+
+// SingleLine 把换行替换为空格，用于标题、昵称等单行展示的字段
+func SingleLine(value string) (string, error) {
+	value = strings.ReplaceAll(value, "\r\n", " ")
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value), nil
+}
+
+// SafeURL 只接受空值或 http(s) 绝对地址，拒绝 javascript:、data: 等会被浏览器执行的地址
+func SafeURL(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return value, nil
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return "", errors.New("invalid url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.New("url must use http or https")
+	}
+	return value, nil
+}
+
+// isBidiControl 判断是否为双向文本控制符（U+200E/F、U+202A-202E、U+2066-2069）
+func isBidiControl(r rune) bool {
+	return r == '\u200e' || r == '\u200f' ||
+		(r >= '\u202a' && r <= '\u202e') ||
+		(r >= '\u2066' && r <= '\u2069')
+}
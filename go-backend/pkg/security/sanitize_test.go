@@ -0,0 +1,73 @@
+package security
+
+import (
+	"testing"
+
+	commonv1 "go-backend/api/common/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizerRegistry_Sanitize(t *testing.T) {
+	registry := NewSanitizerRegistry().
+		Register(&commonv1.Comment{}, "content", StripControl, TrimSpace).
+		Register(&commonv1.User{}, "name", StripControl, SingleLine, TrimSpace).
+		Register(&commonv1.User{}, "avatar", SafeURL)
+
+	t.Run("LegitimateContentKept", func(t *testing.T) {
+		comment := &commonv1.Comment{Content: "  select * from 评论 where 1=1; -- <b>ok</b>\n第二行 "}
+		require.NoError(t, registry.Sanitize(comment))
+		assert.Equal(t, "select * from 评论 where 1=1; -- <b>ok</b>\n第二行", comment.Content)
+	})
+
+	t.Run("ControlCharactersStripped", func(t *testing.T) {
+		comment := &commonv1.Comment{Content: "a\x00b\u202ec\td"}
+		require.NoError(t, registry.Sanitize(comment))
+		assert.Equal(t, "abc\td", comment.Content)
+	})
+
+	t.Run("NestedMessages", func(t *testing.T) {
+		comment := &commonv1.Comment{
+			Content: "hi",
+			User:    &commonv1.User{Name: " 第一行\r\n第二行 ", Avatar: "https://cdn.example.com/a.png"},
+		}
+		require.NoError(t, registry.Sanitize(comment))
+		assert.Equal(t, "第一行 第二行", comment.User.Name)
+		assert.Equal(t, "https://cdn.example.com/a.png", comment.User.Avatar)
+	})
+
+	t.Run("UnsafeURLRejected", func(t *testing.T) {
+		comment := &commonv1.Comment{User: &commonv1.User{Avatar: "javascript:alert(1)"}}
+		err := registry.Sanitize(comment)
+		var sanitizeErr *SanitizeError
+		require.ErrorAs(t, err, &sanitizeErr)
+		assert.Equal(t, "avatar", sanitizeErr.Field)
+	})
+
+	t.Run("UnregisteredFieldsUntouched", func(t *testing.T) {
+		comment := &commonv1.Comment{CreateDate: " 10-15\x00 "}
+		require.NoError(t, registry.Sanitize(comment))
+		assert.Equal(t, " 10-15\x00 ", comment.CreateDate)
+	})
+}
+
+func TestSanitizerRegistry_RegisterUnknownField(t *testing.T) {
+	assert.Panics(t, func() {
+		NewSanitizerRegistry().Register(&commonv1.Comment{}, "missing", TrimSpace)
+	})
+	assert.Panics(t, func() {
+		NewSanitizerRegistry().Register(&commonv1.Comment{}, "like_count", TrimSpace)
+	})
+}
+
+func TestSafeURL(t *testing.T) {
+	for _, value := range []string{"", "http://example.com/a.png", "https://example.com"} {
+		_, err := SafeURL(value)
+		assert.NoError(t, err, value)
+	}
+	for _, value := range []string{"javascript:alert(1)", "data:text/html,<script>", "//example.com", "not a url"} {
+		_, err := SafeURL(value)
+		assert.Error(t, err, value)
+	}
+}
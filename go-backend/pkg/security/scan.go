@@ -0,0 +1,106 @@
+package security
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+// clamAVChunkSize INSTREAM 每个数据块的大小，需小于 clamd 的 StreamMaxLength
+const clamAVChunkSize = 64 * 1024
+
+// ScanResult 文件扫描结果
+type ScanResult struct {
+	Infected bool
+	// Signature 命中的病毒特征名，用于日志和人工复核
+	Signature string
+}
+
+// ScanHook 上传文件扫描钩子，ClamAV 等杀毒引擎实现该接口。返回错误表示未能完成扫描，
+// 不代表文件有问题，由调用方决定如何处理
+type ScanHook interface {
+	Scan(ctx context.Context, reader io.Reader) (*ScanResult, error)
+}
+
+// ClamAVScanner 通过 TCP 连接 clamd，用 INSTREAM 命令流式扫描，文件不需要落盘到 clamd 所在机器
+type ClamAVScanner struct {
+	address string
+	dialer  net.Dialer
+}
+
+// NewClamAVScanner 创建 ClamAV 扫描器，address 为 clamd 的 TCP 地址，如 127.0.0.1:3310。
+// 超时由调用方的 ctx 控制
+func NewClamAVScanner(address string) *ClamAVScanner {
+	return &ClamAVScanner{address: address}
+}
+
+// Scan 发送 zINSTREAM 命令，数据按 <4字节大端长度><数据> 分块发送，以长度为0的块结束。
+// clamd 返回 "stream: OK"、"stream: <特征名> FOUND" 或 "<原因> ERROR"
+func (s *ClamAVScanner) Scan(ctx context.Context, reader io.Reader) (*ScanResult, error) {
+	conn, err := s.dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return nil, fmt.Errorf("connect clamd failed: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	writer := bufio.NewWriterSize(conn, clamAVChunkSize+4)
+	if _, err := writer.WriteString("zINSTREAM\x00"); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, clamAVChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if err := binary.Write(writer, binary.BigEndian, uint32(n)); err != nil {
+				return nil, err
+			}
+			if _, err := writer.Write(buf[:n]); err != nil {
+				return nil, err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := binary.Write(writer, binary.BigEndian, uint32(0)); err != nil {
+		return nil, err
+	}
+	if err := writer.Flush(); err != nil {
+		return nil, fmt.Errorf("send stream to clamd failed: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read clamd reply failed: %w", err)
+	}
+	return parseClamAVReply(reply)
+}
+
+// parseClamAVReply 解析 clamd 的单行回复
+func parseClamAVReply(reply string) (*ScanResult, error) {
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+	switch {
+	case strings.HasSuffix(reply, "FOUND"):
+		signature := strings.TrimSpace(strings.TrimSuffix(reply, "FOUND"))
+		signature = strings.TrimSpace(strings.TrimPrefix(signature, "stream:"))
+		return &ScanResult{Infected: true, Signature: signature}, nil
+	case strings.HasSuffix(reply, "OK"):
+		return &ScanResult{}, nil
+	case reply == "":
+		return nil, fmt.Errorf("clamd closed connection without reply")
+	default:
+		return nil, fmt.Errorf("clamd scan failed: %s", reply)
+	}
+}
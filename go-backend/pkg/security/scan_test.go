@@ -0,0 +1,119 @@
+package security
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClamd 按 INSTREAM 协议接收数据，调用 reply 生成回复
+func fakeClamd(t *testing.T, reply func(data []byte) string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				command, err := reader.ReadString(0)
+				if err != nil || command != "zINSTREAM\x00" {
+					return
+				}
+				var data []byte
+				for {
+					var size uint32
+					if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+						return
+					}
+					if size == 0 {
+						break
+					}
+					chunk := make([]byte, size)
+					if _, err := io.ReadFull(reader, chunk); err != nil {
+						return
+					}
+					data = append(data, chunk...)
+				}
+				conn.Write([]byte(reply(data) + "\x00"))
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func TestClamAVScanner_Scan(t *testing.T) {
+	address := fakeClamd(t, func(data []byte) string {
+		switch {
+		case strings.Contains(string(data), "EICAR"):
+			return "stream: Eicar-Test-Signature FOUND"
+		case len(data) == 0:
+			return "INSTREAM size limit exceeded. ERROR"
+		default:
+			return "stream: OK"
+		}
+	})
+	scanner := NewClamAVScanner(address)
+	ctx := context.Background()
+
+	t.Run("Clean", func(t *testing.T) {
+		// 超过一个数据块，验证分块发送
+		result, err := scanner.Scan(ctx, strings.NewReader(strings.Repeat("a", clamAVChunkSize*2+1)))
+		require.NoError(t, err)
+		assert.False(t, result.Infected)
+	})
+
+	t.Run("Infected", func(t *testing.T) {
+		result, err := scanner.Scan(ctx, strings.NewReader("X5O!P%@AP EICAR test file"))
+		require.NoError(t, err)
+		assert.True(t, result.Infected)
+		assert.Equal(t, "Eicar-Test-Signature", result.Signature)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := scanner.Scan(ctx, strings.NewReader(""))
+		assert.ErrorContains(t, err, "size limit exceeded")
+	})
+}
+
+func TestClamAVScanner_Timeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		// 接受连接但不回复
+		conn, err := listener.Accept()
+		if err == nil {
+			defer conn.Close()
+			io.Copy(io.Discard, conn)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = NewClamAVScanner(listener.Addr().String()).Scan(ctx, strings.NewReader("data"))
+	assert.Error(t, err)
+}
+
+func TestClamAVScanner_Unreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	_, err = NewClamAVScanner(address).Scan(context.Background(), strings.NewReader("data"))
+	assert.ErrorContains(t, err, "connect clamd failed")
+}
@@ -0,0 +1,104 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryNonceStore struct {
+	used map[string]time.Duration
+	err  error
+}
+
+func (s *memoryNonceStore) Use(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	if _, ok := s.used[key]; ok {
+		return false, nil
+	}
+	s.used[key] = ttl
+	return true, nil
+}
+
+func newTestGuard(now time.Time) (*ReplayGuard, *memoryNonceStore) {
+	store := &memoryNonceStore{used: make(map[string]time.Duration)}
+	guard := NewReplayGuard(store, 5*time.Minute)
+	guard.now = func() time.Time { return now }
+	return guard, store
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"event":"transcoded"}`)
+	sig := SignPayload("secret", "POST", "/callback/media", "1700000000", "nonce-123", body)
+
+	assert.True(t, VerifySignature("secret", "POST", "/callback/media", "1700000000", "nonce-123", body, sig))
+	assert.True(t, VerifySignature("secret", "post", "/callback/media", "1700000000", "nonce-123", body, "sha256="+sig))
+	assert.False(t, VerifySignature("other", "POST", "/callback/media", "1700000000", "nonce-123", body, sig))
+	assert.False(t, VerifySignature("secret", "POST", "/callback/media", "1700000001", "nonce-123", body, sig))
+	assert.False(t, VerifySignature("secret", "POST", "/callback/media", "1700000000", "nonce-123", []byte(`{}`), sig))
+	// 方法和路径参与签名，同一签名不能用于其他接口
+	assert.False(t, VerifySignature("secret", "PUT", "/callback/media", "1700000000", "nonce-123", body, sig))
+	assert.False(t, VerifySignature("secret", "POST", "/callback/payment", "1700000000", "nonce-123", body, sig))
+}
+
+func TestReplayGuard_Verify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	body := []byte(`{"event":"transcoded"}`)
+	ctx := context.Background()
+
+	t.Run("accepts once then rejects replay", func(t *testing.T) {
+		guard, store := newTestGuard(now)
+		sig := SignPayload("secret", "POST", "/callback/media", ts, "nonce-123", body)
+
+		require.NoError(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", ts, "nonce-123", sig, body))
+		assert.Equal(t, 10*time.Minute, store.used["media:nonce-123"])
+		assert.ErrorIs(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", ts, "nonce-123", sig, body), ErrReplayedRequest)
+
+		// 不同来源的 nonce 互不影响
+		require.NoError(t, guard.Verify(ctx, "cdn", "secret", "POST", "/callback/media", ts, "nonce-123", sig, body))
+	})
+
+	t.Run("clock skew", func(t *testing.T) {
+		guard, _ := newTestGuard(now)
+		for _, offset := range []time.Duration{-6 * time.Minute, 6 * time.Minute} {
+			old := strconv.FormatInt(now.Add(offset).Unix(), 10)
+			sig := SignPayload("secret", "POST", "/callback/media", old, "nonce-123", body)
+			assert.ErrorIs(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", old, "nonce-123", sig, body), ErrTimestampSkew)
+		}
+
+		within := strconv.FormatInt(now.Add(-4*time.Minute).Unix(), 10)
+		sig := SignPayload("secret", "POST", "/callback/media", within, "nonce-456", body)
+		assert.NoError(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", within, "nonce-456", sig, body))
+
+		assert.ErrorIs(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", "not-a-number", "nonce-789", sig, body), ErrTimestampSkew)
+	})
+
+	t.Run("invalid signature does not consume nonce", func(t *testing.T) {
+		guard, store := newTestGuard(now)
+		assert.ErrorIs(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", ts, "nonce-123", "deadbeef", body), ErrInvalidSignature)
+		assert.Empty(t, store.used)
+	})
+
+	t.Run("missing fields and bad nonce", func(t *testing.T) {
+		guard, _ := newTestGuard(now)
+		sig := SignPayload("secret", "POST", "/callback/media", ts, "nonce-123", body)
+		assert.ErrorIs(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", ts, "nonce-123", "", body), ErrMissingSignature)
+		assert.ErrorIs(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", "", "nonce-123", sig, body), ErrMissingSignature)
+		assert.ErrorIs(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", ts, "short", sig, body), ErrInvalidNonce)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		guard, store := newTestGuard(now)
+		store.err = errors.New("redis down")
+		sig := SignPayload("secret", "POST", "/callback/media", ts, "nonce-123", body)
+		assert.EqualError(t, guard.Verify(ctx, "media", "secret", "POST", "/callback/media", ts, "nonce-123", sig, body), "redis down")
+	})
+}
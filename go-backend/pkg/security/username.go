@@ -0,0 +1,83 @@
+package security
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// sequentialUsernameGap 同前缀用户名数字后缀相差不超过该值视为连号
+	sequentialUsernameGap = 100
+	// randomUsernameMinLength 参与随机性判断的最短用户名长度
+	randomUsernameMinLength = 8
+	// randomUsernameMinTransitions 字母与数字交替次数达到该值视为随机生成
+	randomUsernameMinTransitions = 4
+	// randomUsernameMaxVowelRatio 字母中元音占比低于该值视为随机生成
+	randomUsernameMaxVowelRatio = 0.15
+)
+
+// SplitNumericSuffix 拆分用户名的非数字前缀与数字后缀，没有数字后缀时ok为false
+func SplitNumericSuffix(username string) (prefix string, number int64, ok bool) {
+	trimmed := strings.TrimRightFunc(username, unicode.IsDigit)
+	if trimmed == username || trimmed == "" {
+		return username, 0, false
+	}
+
+	number, err := strconv.ParseInt(username[len(trimmed):], 10, 64)
+	if err != nil {
+		return username, 0, false
+	}
+	return trimmed, number, true
+}
+
+// IsSequentialUsername 判断username是否与previous为同前缀的连号，如bot_001之后注册bot_002
+func IsSequentialUsername(previous, username string) bool {
+	prevPrefix, prevNumber, ok := SplitNumericSuffix(previous)
+	if !ok {
+		return false
+	}
+	prefix, number, ok := SplitNumericSuffix(username)
+	if !ok || !strings.EqualFold(prefix, prevPrefix) {
+		return false
+	}
+
+	gap := number - prevNumber
+	if gap < 0 {
+		gap = -gap
+	}
+	return gap > 0 && gap <= sequentialUsernameGap
+}
+
+// LooksRandomUsername 判断用户名是否像脚本随机生成：字母数字频繁交替，或字母几乎不含元音
+func LooksRandomUsername(username string) bool {
+	if len(username) < randomUsernameMinLength {
+		return false
+	}
+
+	var letters, vowels, transitions int
+	var prevDigit, hasPrev bool
+	for _, char := range strings.ToLower(username) {
+		isDigit := unicode.IsDigit(char)
+		if !isDigit && !unicode.IsLetter(char) {
+			hasPrev = false
+			continue
+		}
+		if hasPrev && isDigit != prevDigit {
+			transitions++
+		}
+		prevDigit, hasPrev = isDigit, true
+
+		if !isDigit {
+			letters++
+			if strings.ContainsRune("aeiouy", char) {
+				vowels++
+			}
+		}
+	}
+
+	if transitions >= randomUsernameMinTransitions {
+		return true
+	}
+	return letters >= randomUsernameMinLength && float64(vowels)/float64(letters) < randomUsernameMaxVowelRatio
+}
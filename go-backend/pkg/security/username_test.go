@@ -0,0 +1,64 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitNumericSuffix(t *testing.T) {
+	prefix, number, ok := SplitNumericSuffix("bot_007")
+	assert.True(t, ok)
+	assert.Equal(t, "bot_", prefix)
+	assert.Equal(t, int64(7), number)
+
+	_, _, ok = SplitNumericSuffix("alice")
+	assert.False(t, ok)
+
+	// 纯数字用户名没有前缀，不参与连号判断
+	_, _, ok = SplitNumericSuffix("123456")
+	assert.False(t, ok)
+}
+
+func TestIsSequentialUsername(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		username string
+		want     bool
+	}{
+		{"next_number", "bot_001", "bot_002", true},
+		{"case_insensitive_prefix", "Bot1", "bot5", true},
+		{"gap_too_large", "user1", "user2024", false},
+		{"same_number", "user1", "user1", false},
+		{"different_prefix", "alice1", "bob2", false},
+		{"no_suffix", "alice", "alice2", false},
+		{"empty_previous", "", "bot_002", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsSequentialUsername(tt.previous, tt.username))
+		})
+	}
+}
+
+func TestLooksRandomUsername(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		want     bool
+	}{
+		{"normal", "alice_wonder", false},
+		{"short", "x7k9q2", false},
+		{"name_with_year", "zhangsan1998", false},
+		{"alternating", "a8f3k2m9x", true},
+		{"no_vowels", "qwrtzxcvbnm", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LooksRandomUsername(tt.username))
+		})
+	}
+}
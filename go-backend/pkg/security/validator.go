@@ -2,15 +2,17 @@ package security
 
 import (
 	"errors"
-	"log"
+	"net/url"
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 var (
 	usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]{3,32}$`)
 	emailRegex    = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	phoneRegex    = regexp.MustCompile(`^1[3-9][0-9]{9}$`)
 
 	// 常见弱密码模式 - 只检查完全匹配或作为独立词汇的弱密码
 	weakPatterns = []string{
@@ -125,20 +127,22 @@ func ValidatePassword(password string) error {
 
 // ValidateEmail 验证邮箱格式
 func ValidateEmail(email string) error {
-	log.Printf("DEBUG: Validating email: %s", email)
-	log.Printf("DEBUG: Email length: %d", len(email))
-
 	if len(email) > 254 {
-		log.Printf("DEBUG: Email too long, length: %d", len(email))
 		return errors.New("email too long")
 	}
 
 	if !emailRegex.MatchString(email) {
-		log.Printf("DEBUG: Email format invalid")
 		return errors.New("invalid email format")
 	}
 
-	log.Printf("DEBUG: Email validation passed")
+	return nil
+}
+
+// ValidatePhone 验证手机号格式，仅支持中国大陆11位手机号
+func ValidatePhone(phone string) error {
+	if !phoneRegex.MatchString(phone) {
+		return errors.New("invalid phone format")
+	}
 	return nil
 }
 
@@ -180,6 +184,59 @@ func ValidateComment(content string) error {
 	return nil
 }
 
+// ValidateMessage 验证私信内容，按字符数计算长度
+func ValidateMessage(content string) error {
+	content = strings.TrimSpace(content)
+	if len(content) == 0 {
+		return errors.New("message cannot be empty")
+	}
+	if utf8.RuneCountInString(content) > 500 {
+		return errors.New("message too long, max 500 characters")
+	}
+	return nil
+}
+
+// ValidateNickname 验证昵称，按字符数计算长度
+func ValidateNickname(nickname string) error {
+	nickname = strings.TrimSpace(nickname)
+	if len(nickname) == 0 {
+		return errors.New("nickname cannot be empty")
+	}
+	if utf8.RuneCountInString(nickname) > 32 {
+		return errors.New("nickname too long, max 32 characters")
+	}
+	for _, char := range nickname {
+		if unicode.IsControl(char) {
+			return errors.New("nickname contains invalid characters")
+		}
+	}
+	return nil
+}
+
+// ValidateSignature 验证个性签名，允许为空
+func ValidateSignature(signature string) error {
+	if utf8.RuneCountInString(signature) > 200 {
+		return errors.New("signature too long, max 200 characters")
+	}
+	return nil
+}
+
+// ValidateImageURL 验证头像、背景图等图片地址，只接受http(s)绝对地址
+func ValidateImageURL(rawURL string) error {
+	if len(rawURL) > 255 {
+		return errors.New("image url too long, max 255 characters")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return errors.New("invalid image url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("image url must use http or https")
+	}
+	return nil
+}
+
 // hasRepeatingChars 检查是否有重复字符
 func hasRepeatingChars(password string, maxRepeat int) bool {
 	if len(password) < maxRepeat {
@@ -267,6 +324,11 @@ func (v *Validator) ValidateEmail(email string) error {
 	return ValidateEmail(email)
 }
 
+// ValidatePhone 验证手机号
+func (v *Validator) ValidatePhone(phone string) error {
+	return ValidatePhone(phone)
+}
+
 // ValidateVideoTitle 验证视频标题
 func (v *Validator) ValidateVideoTitle(title string) error {
 	return ValidateVideoTitle(title)
@@ -276,3 +338,23 @@ func (v *Validator) ValidateVideoTitle(title string) error {
 func (v *Validator) ValidateComment(content string) error {
 	return ValidateComment(content)
 }
+
+// ValidateMessage 验证私信内容，按字符数计算长度
+func (v *Validator) ValidateMessage(content string) error {
+	return ValidateMessage(content)
+}
+
+// ValidateNickname 验证昵称
+func (v *Validator) ValidateNickname(nickname string) error {
+	return ValidateNickname(nickname)
+}
+
+// ValidateSignature 验证个性签名
+func (v *Validator) ValidateSignature(signature string) error {
+	return ValidateSignature(signature)
+}
+
+// ValidateImageURL 验证图片地址
+func (v *Validator) ValidateImageURL(rawURL string) error {
+	return ValidateImageURL(rawURL)
+}
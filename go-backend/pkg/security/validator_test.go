@@ -1,6 +1,7 @@
 package security
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -103,6 +104,13 @@ func TestValidateEmail(t *testing.T) {
 	}
 }
 
+func TestValidatePhone(t *testing.T) {
+	assert.NoError(t, ValidatePhone("13812345678"))
+	assert.Error(t, ValidatePhone("12812345678"))
+	assert.Error(t, ValidatePhone("1381234567"))
+	assert.Error(t, ValidatePhone("+8613812345678"))
+}
+
 func TestSanitizeInput(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -180,6 +188,93 @@ func TestValidateComment(t *testing.T) {
 	}
 }
 
+func TestValidateMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		wantErr bool
+	}{
+		{"valid_message", "hello, are you free tonight?", false},
+		{"empty_string", "", true},
+		{"only_spaces", "   ", true},
+		{"too_long", strings.Repeat("a", 501), true},
+		{"exactly_500_chars", strings.Repeat("a", 500), false},
+		{"exactly_500_chinese_chars", strings.Repeat("消", 500), false},
+		{"too_long_chinese", strings.Repeat("消", 501), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMessage(tt.message)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateNickname(t *testing.T) {
+	tests := []struct {
+		name     string
+		nickname string
+		wantErr  bool
+	}{
+		{"valid_ascii", "alice", false},
+		{"valid_chinese", "小明同学", false},
+		{"empty_string", "", true},
+		{"only_spaces", "   ", true},
+		{"too_long", strings.Repeat("长", 33), true},
+		{"exactly_32_chars", strings.Repeat("长", 32), false},
+		{"control_char", "ali\x00ce", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNickname(tt.nickname)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSignature(t *testing.T) {
+	assert.NoError(t, ValidateSignature(""))
+	assert.NoError(t, ValidateSignature(strings.Repeat("签", 200)))
+	assert.Error(t, ValidateSignature(strings.Repeat("签", 201)))
+}
+
+func TestValidateImageURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{"valid_https", "https://cdn.example.com/avatar/1.jpg", false},
+		{"valid_http", "http://example.com/a.png", false},
+		{"empty_string", "", true},
+		{"relative_path", "/avatar/1.jpg", true},
+		{"javascript_scheme", "javascript:alert(1)", true},
+		{"ftp_scheme", "ftp://example.com/a.png", true},
+		{"too_long", "https://example.com/" + strings.Repeat("a", 240), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateImageURL(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidator_ValidateUserID(t *testing.T) {
 	v := NewValidator()
 
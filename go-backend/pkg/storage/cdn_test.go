@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCDN_Rewrite(t *testing.T) {
+	cdn := NewCDN(&CDNConfig{
+		Domain:  "https://cdn.example.com/",
+		Origins: []string{"http://localhost:9000/"},
+	})
+
+	assert.Equal(t, "https://cdn.example.com/tiktok-videos/covers/1.jpg", cdn.Rewrite("http://localhost:9000/tiktok-videos/covers/1.jpg"))
+	assert.Equal(t, "http://localhost:90001/x.jpg", cdn.Rewrite("http://localhost:90001/x.jpg"))
+	assert.Equal(t, "https://avatars.example.org/1.png", cdn.Rewrite("https://avatars.example.org/1.png"))
+
+	// 未开启签名时只改写域名
+	assert.Equal(t, "https://cdn.example.com/tiktok-videos/videos/1.mp4", cdn.URL("http://localhost:9000/tiktok-videos/videos/1.mp4"))
+	assert.Equal(t, "", cdn.URL(""))
+}
+
+func TestCDN_Disabled(t *testing.T) {
+	cdn := NewCDN(&CDNConfig{})
+	assert.False(t, cdn.Signing())
+	assert.Equal(t, "http://localhost:9000/a/b.mp4", cdn.URL("http://localhost:9000/a/b.mp4"))
+}
+
+func TestCDN_SignVerify(t *testing.T) {
+	cdn := NewCDN(&CDNConfig{
+		Domain:  "https://cdn.example.com",
+		Origins: []string{"http://localhost:9000"},
+		SignKey: "secret",
+		SignTTL: 10 * time.Minute,
+	})
+	now := time.Now()
+
+	signed := cdn.Sign("https://cdn.example.com/tiktok-videos/videos/1.mp4", now.Add(time.Minute))
+	assert.True(t, cdn.Verify(signed, now))
+	assert.False(t, cdn.Verify(signed, now.Add(2*time.Minute)))
+	assert.False(t, cdn.Verify("https://cdn.example.com/tiktok-videos/videos/2.mp4?"+signed[len("https://cdn.example.com/tiktok-videos/videos/1.mp4?"):], now))
+	assert.False(t, NewCDN(&CDNConfig{SignKey: "other"}).Verify(signed, now))
+
+	delivered := cdn.URL("http://localhost:9000/tiktok-videos/videos/1.mp4")
+	assert.Contains(t, delivered, "https://cdn.example.com/tiktok-videos/videos/1.mp4?")
+	assert.True(t, cdn.Verify(delivered, now.Add(10*time.Minute)))
+	assert.False(t, cdn.Verify(delivered, now.Add(20*time.Minute)))
+}
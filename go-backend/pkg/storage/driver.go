@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DriverConfig 打开一个存储桶所需的配置，各驱动只读取自己用到的字段
+type DriverConfig struct {
+	Endpoint     string
+	AccessKey    string
+	SecretKey    string
+	BucketName   string
+	Region       string
+	UseSSL       bool
+	BaseURL      string
+	StorageClass string
+	// RootDir 本地磁盘驱动的存储根目录
+	RootDir string
+}
+
+// Driver 按配置打开一个存储桶
+type Driver func(config *DriverConfig) (VideoStorage, error)
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[Provider]Driver)
+)
+
+// Register 注册存储驱动，各驱动在 init 中注册自己，重复注册同名驱动会 panic
+func Register(provider Provider, driver Driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+
+	if driver == nil {
+		panic("storage: Register driver is nil")
+	}
+	if _, dup := drivers[provider]; dup {
+		panic("storage: Register called twice for driver " + string(provider))
+	}
+	drivers[provider] = driver
+}
+
+// Open 使用指定驱动打开存储桶，驱动名为空时使用 MinIO
+func Open(provider Provider, config *DriverConfig) (VideoStorage, error) {
+	if provider == "" {
+		provider = ProviderMinIO
+	}
+
+	driversMu.RLock()
+	driver, ok := drivers[provider]
+	driversMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown storage driver %q (available: %v)", provider, Drivers())
+	}
+	return driver(config)
+}
+
+// Drivers 已注册的驱动名，按字母顺序排列
+func Drivers() []Provider {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+
+	list := make([]Provider, 0, len(drivers))
+	for provider := range drivers {
+		list = append(list, provider)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
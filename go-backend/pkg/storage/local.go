@@ -0,0 +1,535 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-backend/pkg/utils"
+)
+
+// localUploadDir 分片上传的临时目录，位于根目录下，ListObjects 不会遍历到
+const localUploadDir = ".uploads"
+
+// LocalConfig 本地磁盘存储配置
+type LocalConfig struct {
+	RootDir string
+	// BaseURL 对外提供 RootDir 下文件的静态文件服务地址
+	BaseURL string
+}
+
+// LocalStorage 本地磁盘存储实现，对象键即相对于根目录的路径，用于没有对象存储的开发环境。
+// 对象元数据不落盘，内容类型按扩展名推断
+type LocalStorage struct {
+	rootDir string
+	baseURL string
+}
+
+func init() {
+	Register(ProviderLocal, func(config *DriverConfig) (VideoStorage, error) {
+		return NewLocalStorage(&LocalConfig{
+			RootDir: config.RootDir,
+			BaseURL: config.BaseURL,
+		})
+	})
+}
+
+// NewLocalStorage 创建本地磁盘存储，根目录不存在时自动创建
+func NewLocalStorage(config *LocalConfig) (*LocalStorage, error) {
+	if config.RootDir == "" {
+		return nil, fmt.Errorf("local storage root dir is required")
+	}
+
+	rootDir, err := filepath.Abs(config.RootDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve root dir: %w", err)
+	}
+	if err := os.MkdirAll(rootDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create root dir: %w", err)
+	}
+
+	return &LocalStorage{
+		rootDir: rootDir,
+		baseURL: strings.TrimRight(config.BaseURL, "/"),
+	}, nil
+}
+
+// path 将对象键转换为磁盘路径，拒绝跳出根目录的键
+func (s *LocalStorage) path(objectName string) (string, error) {
+	name := filepath.FromSlash(objectName)
+	if objectName == "" || filepath.IsAbs(name) || !filepath.IsLocal(name) {
+		return "", fmt.Errorf("invalid object name: %s", objectName)
+	}
+	return filepath.Join(s.rootDir, name), nil
+}
+
+// writeFile 先写入同目录的临时文件再重命名，读取方不会看到写了一半的对象
+func (s *LocalStorage) writeFile(path string, reader io.Reader) (int64, string, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return 0, "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := md5.New()
+	written, err := io.Copy(io.MultiWriter(tmp, hash), reader)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, "", err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, "", err
+	}
+
+	return written, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Upload 上传文件
+func (s *LocalStorage) Upload(ctx context.Context, objectName string, reader io.Reader, size int64, opts *UploadOptions) (*FileInfo, error) {
+	path, err := s.path(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	written, etag, err := s.writeFile(path, reader)
+	observeUpload(ctx, string(ProviderLocal), start, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload object: %w", err)
+	}
+
+	fileInfo := &FileInfo{
+		Name:        objectName,
+		Size:        written,
+		ContentType: contentTypeByName(objectName),
+		ETag:        etag,
+		URL:         s.buildObjectURL(objectName),
+		UploadedAt:  time.Now(),
+	}
+
+	if opts != nil && opts.ContentType != "" {
+		fileInfo.ContentType = opts.ContentType
+	}
+
+	return fileInfo, nil
+}
+
+// Download 下载文件
+func (s *LocalStorage) Download(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	path, err := s.path(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	return file, nil
+}
+
+// Delete 删除文件，对象不存在时与S3一致视为成功
+func (s *LocalStorage) Delete(ctx context.Context, objectName string) error {
+	path, err := s.path(objectName)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove object: %w", err)
+	}
+	return nil
+}
+
+// GetPresignedURL 获取预签名URL，本地存储不做签名，直接返回访问地址
+func (s *LocalStorage) GetPresignedURL(ctx context.Context, objectName string, expires time.Duration) (string, error) {
+	if _, err := s.path(objectName); err != nil {
+		return "", err
+	}
+	return s.buildObjectURL(objectName), nil
+}
+
+// Exists 检查文件是否存在
+func (s *LocalStorage) Exists(ctx context.Context, objectName string) (bool, error) {
+	path, err := s.path(objectName)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return true, nil
+}
+
+// GetFileInfo 获取文件信息
+func (s *LocalStorage) GetFileInfo(ctx context.Context, objectName string) (*FileInfo, error) {
+	path, err := s.path(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return s.fileInfo(objectName, stat), nil
+}
+
+func (s *LocalStorage) fileInfo(objectName string, stat fs.FileInfo) *FileInfo {
+	return &FileInfo{
+		Name:        objectName,
+		Size:        stat.Size(),
+		ContentType: contentTypeByName(objectName),
+		URL:         s.buildObjectURL(objectName),
+		UploadedAt:  stat.ModTime(),
+	}
+}
+
+// UploadVideo 上传视频文件
+func (s *LocalStorage) UploadVideo(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	videoID := utils.MustGenerateID()
+	ext := filepath.Ext(filename)
+	objectName := fmt.Sprintf("%s%d%s", originalPrefix, videoID, ext)
+
+	if _, err := s.Upload(ctx, objectName, reader, size, &UploadOptions{ContentType: s.getVideoContentType(ext)}); err != nil {
+		return "", err
+	}
+	return objectName, nil
+}
+
+// UploadCover 上传封面文件
+func (s *LocalStorage) UploadCover(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	coverID := utils.MustGenerateID()
+	ext, contentType := coverExt(filename)
+	objectName := fmt.Sprintf("%s%d%s", coverPrefix, coverID, ext)
+
+	if _, err := s.Upload(ctx, objectName, reader, size, &UploadOptions{ContentType: contentType}); err != nil {
+		return "", err
+	}
+	return objectName, nil
+}
+
+// UploadRendition 上传转码后的视频文件
+func (s *LocalStorage) UploadRendition(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	renditionID := utils.MustGenerateID()
+	ext := filepath.Ext(filename)
+	objectName := fmt.Sprintf("%s%d%s", renditionPrefix, renditionID, ext)
+
+	if _, err := s.Upload(ctx, objectName, reader, size, &UploadOptions{ContentType: s.getVideoContentType(ext)}); err != nil {
+		return "", err
+	}
+	return objectName, nil
+}
+
+// ListObjects 按前缀遍历根目录下的对象，跳过分片上传临时目录和未完成的写入
+func (s *LocalStorage) ListObjects(ctx context.Context, prefix string, fn func(*FileInfo) error) error {
+	err := filepath.WalkDir(s.rootDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == localUploadDir && filepath.Dir(path) == s.rootDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasPrefix(entry.Name(), ".tmp-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.rootDir, path)
+		if err != nil {
+			return err
+		}
+		objectName := filepath.ToSlash(rel)
+		if !strings.HasPrefix(objectName, prefix) {
+			return nil
+		}
+
+		stat, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return fn(s.fileInfo(objectName, stat))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list objects: %w", err)
+	}
+	return nil
+}
+
+// GenerateVideoURL 生成视频访问URL
+func (s *LocalStorage) GenerateVideoURL(ctx context.Context, objectName string) (string, error) {
+	return s.buildObjectURL(objectName), nil
+}
+
+// GenerateCoverURL 生成封面访问URL
+func (s *LocalStorage) GenerateCoverURL(ctx context.Context, objectName string) (string, error) {
+	return s.buildObjectURL(objectName), nil
+}
+
+// uploadDir 分片上传的临时目录，目录中的 key 文件记录目标对象键，分片按序号命名
+func (s *LocalStorage) uploadDir(uploadID string) (string, error) {
+	if _, err := strconv.ParseInt(uploadID, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid upload ID format")
+	}
+	return filepath.Join(s.rootDir, localUploadDir, uploadID), nil
+}
+
+// InitiateMultipartUpload 初始化分片上传
+func (s *LocalStorage) InitiateMultipartUpload(ctx context.Context, key string, opts *MultipartUploadOptions) (*MultipartUploadInfo, error) {
+	if _, err := s.path(key); err != nil {
+		return nil, err
+	}
+
+	uploadID := strconv.FormatInt(utils.MustGenerateID(), 10)
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to initiate multipart upload: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "key"), []byte(key), 0o644); err != nil {
+		return nil, fmt.Errorf("failed to initiate multipart upload: %w", err)
+	}
+
+	info := &MultipartUploadInfo{
+		UploadID: uploadID,
+		Key:      key,
+	}
+	if opts != nil {
+		info.ChunkSize = opts.ChunkSize
+	}
+	return info, nil
+}
+
+// uploadKey 读取分片上传的目标对象键
+func (s *LocalStorage) uploadKey(uploadID string) (string, string, error) {
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return "", "", err
+	}
+	key, err := os.ReadFile(filepath.Join(dir, "key"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", "", fmt.Errorf("upload %s not found", uploadID)
+		}
+		return "", "", err
+	}
+	return dir, string(key), nil
+}
+
+// UploadPart 上传分片，重传同一序号的分片会覆盖之前的内容
+func (s *LocalStorage) UploadPart(ctx context.Context, uploadID string, partNumber int, reader io.Reader, size int64) (*PartInfo, error) {
+	if partNumber < 1 {
+		return nil, fmt.Errorf("invalid part number: %d", partNumber)
+	}
+	dir, _, err := s.uploadKey(uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	written, etag, err := s.writeFile(filepath.Join(dir, strconv.Itoa(partNumber)), reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload part: %w", err)
+	}
+
+	return &PartInfo{
+		PartNumber: partNumber,
+		ETag:       etag,
+		Size:       written,
+	}, nil
+}
+
+// CompleteMultipartUpload 按分片序号拼接成完整对象并清理临时目录
+func (s *LocalStorage) CompleteMultipartUpload(ctx context.Context, uploadID string, parts []PartInfo) (*FileInfo, error) {
+	dir, key, err := s.uploadKey(uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := append([]PartInfo(nil), parts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].PartNumber < sorted[j].PartNumber })
+
+	readers := make([]io.Reader, 0, len(sorted))
+	for _, part := range sorted {
+		file, err := os.Open(filepath.Join(dir, strconv.Itoa(part.PartNumber)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open part %d: %w", part.PartNumber, err)
+		}
+		defer file.Close()
+		readers = append(readers, file)
+	}
+
+	info, err := s.Upload(ctx, key, io.MultiReader(readers...), s.calculateTotalSize(sorted), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("failed to clean up upload: %w", err)
+	}
+	return info, nil
+}
+
+// AbortMultipartUpload 取消分片上传
+func (s *LocalStorage) AbortMultipartUpload(ctx context.Context, uploadID string) error {
+	dir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+// ListParts 列出已上传的分片
+func (s *LocalStorage) ListParts(ctx context.Context, uploadID string) ([]PartInfo, error) {
+	dir, _, err := s.uploadKey(uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]PartInfo, 0, len(entries))
+	for _, entry := range entries {
+		partNumber, err := strconv.Atoi(entry.Name())
+		if err != nil || entry.IsDir() {
+			continue
+		}
+		stat, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, PartInfo{PartNumber: partNumber, Size: stat.Size()})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	return parts, nil
+}
+
+// ResumeUpload 恢复上传，以剩余内容直接写入完整对象
+func (s *LocalStorage) ResumeUpload(ctx context.Context, uploadID string, reader io.Reader, size int64) (*FileInfo, error) {
+	dir, key, err := s.uploadKey(uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.Upload(ctx, key, reader, size, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("failed to clean up upload: %w", err)
+	}
+	return info, nil
+}
+
+// GetUploadProgress 获取已上传的字节数
+func (s *LocalStorage) GetUploadProgress(ctx context.Context, uploadID string) (int64, error) {
+	parts, err := s.ListParts(ctx, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	return s.calculateTotalSize(parts), nil
+}
+
+// AbortStaleUploads 清理过期的分片上传临时目录，发起时间以 key 文件的修改时间为准
+func (s *LocalStorage) AbortStaleUploads(ctx context.Context, before time.Time, dryRun bool) (int, error) {
+	entries, err := os.ReadDir(filepath.Join(s.rootDir, localUploadDir))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var aborted int
+	for _, entry := range entries {
+		dir, err := s.uploadDir(entry.Name())
+		if err != nil {
+			continue
+		}
+		stat, err := os.Stat(filepath.Join(dir, "key"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return aborted, err
+		}
+		if err == nil && !stat.ModTime().Before(before) {
+			continue
+		}
+		if !dryRun {
+			if err := os.RemoveAll(dir); err != nil {
+				return aborted, err
+			}
+		}
+		aborted++
+	}
+	return aborted, nil
+}
+
+// buildObjectURL 构建对象URL
+func (s *LocalStorage) buildObjectURL(objectName string) string {
+	return fmt.Sprintf("%s/%s", s.baseURL, objectName)
+}
+
+// ObjectName 从访问URL解析对象键
+func (s *LocalStorage) ObjectName(url string) (string, bool) {
+	objectName := strings.TrimPrefix(url, s.buildObjectURL(""))
+	if objectName == url || objectName == "" {
+		return "", false
+	}
+	return objectName, true
+}
+
+// getVideoContentType 获取视频内容类型
+func (s *LocalStorage) getVideoContentType(ext string) string {
+	switch strings.ToLower(ext) {
+	case ".mp4":
+		return "video/mp4"
+	case ".avi":
+		return "video/avi"
+	case ".mov":
+		return "video/quicktime"
+	default:
+		return "video/mp4"
+	}
+}
+
+// calculateTotalSize 计算总大小
+func (s *LocalStorage) calculateTotalSize(parts []PartInfo) int64 {
+	var total int64
+	for _, part := range parts {
+		total += part.Size
+	}
+	return total
+}
+
+// contentTypeByName 按扩展名推断内容类型
+func contentTypeByName(objectName string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(objectName)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-backend/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocalStorage(t *testing.T) *LocalStorage {
+	s, err := NewLocalStorage(&LocalConfig{RootDir: t.TempDir(), BaseURL: "http://localhost:8080/files/"})
+	require.NoError(t, err)
+	return s
+}
+
+func TestLocalStorage_UploadDownload(t *testing.T) {
+	ctx := context.Background()
+	s := newTestLocalStorage(t)
+
+	info, err := s.Upload(ctx, "covers/1.jpg", strings.NewReader("cover"), 5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), info.Size)
+	assert.Equal(t, "image/jpeg", info.ContentType)
+	assert.Equal(t, "http://localhost:8080/files/covers/1.jpg", info.URL)
+
+	exists, err := s.Exists(ctx, "covers/1.jpg")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	reader, err := s.Download(ctx, "covers/1.jpg")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "cover", string(content))
+
+	objectName, ok := s.ObjectName(info.URL)
+	assert.True(t, ok)
+	assert.Equal(t, "covers/1.jpg", objectName)
+
+	require.NoError(t, s.Delete(ctx, "covers/1.jpg"))
+	require.NoError(t, s.Delete(ctx, "covers/1.jpg"))
+	exists, err = s.Exists(ctx, "covers/1.jpg")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestLocalStorage_UploadCover(t *testing.T) {
+	ctx := context.Background()
+	s := newTestLocalStorage(t)
+	require.NoError(t, utils.InitSnowflake(1, 1))
+
+	objectName, err := s.UploadCover(ctx, "cover_1.webp", strings.NewReader("webp"), 4)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(objectName, "covers/") && strings.HasSuffix(objectName, ".webp"))
+	info, err := s.GetFileInfo(ctx, objectName)
+	require.NoError(t, err)
+	assert.Equal(t, "image/webp", info.ContentType)
+
+	objectName, err = s.UploadCover(ctx, "dynamic_cover_1.GIF", strings.NewReader("gif"), 3)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(objectName, ".gif"))
+
+	objectName, err = s.UploadCover(ctx, "cover_1", strings.NewReader("jpeg"), 4)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(objectName, ".jpg"))
+}
+
+func TestLocalStorage_RejectsEscapingKeys(t *testing.T) {
+	ctx := context.Background()
+	s := newTestLocalStorage(t)
+
+	for _, name := range []string{"", "../etc/passwd", "/etc/passwd", "covers/../../x"} {
+		_, err := s.Upload(ctx, name, strings.NewReader("x"), 1, nil)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLocalStorage_Multipart(t *testing.T) {
+	require.NoError(t, utils.InitSnowflake(1, 1))
+	ctx := context.Background()
+	s := newTestLocalStorage(t)
+
+	upload, err := s.InitiateMultipartUpload(ctx, "videos/1.mp4", &MultipartUploadOptions{ChunkSize: 3})
+	require.NoError(t, err)
+
+	_, err = s.UploadPart(ctx, upload.UploadID, 2, strings.NewReader("def"), 3)
+	require.NoError(t, err)
+	_, err = s.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("abc"), 3)
+	require.NoError(t, err)
+
+	parts, err := s.ListParts(ctx, upload.UploadID)
+	require.NoError(t, err)
+	require.Len(t, parts, 2)
+	assert.Equal(t, 1, parts[0].PartNumber)
+
+	progress, err := s.GetUploadProgress(ctx, upload.UploadID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), progress)
+
+	info, err := s.CompleteMultipartUpload(ctx, upload.UploadID, parts)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), info.Size)
+
+	reader, err := s.Download(ctx, "videos/1.mp4")
+	require.NoError(t, err)
+	content, _ := io.ReadAll(reader)
+	reader.Close()
+	assert.Equal(t, "abcdef", string(content))
+
+	// 分片上传的临时文件不出现在对象列表中
+	var names []string
+	require.NoError(t, s.ListObjects(ctx, "", func(info *FileInfo) error {
+		names = append(names, info.Name)
+		return nil
+	}))
+	assert.Equal(t, []string{"videos/1.mp4"}, names)
+
+	_, err = s.ListParts(ctx, upload.UploadID)
+	assert.Error(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	assert.Contains(t, Drivers(), ProviderLocal)
+	assert.Contains(t, Drivers(), ProviderS3)
+
+	s, err := Open(ProviderLocal, &DriverConfig{RootDir: t.TempDir(), BaseURL: "http://localhost/files"})
+	require.NoError(t, err)
+	assert.IsType(t, &LocalStorage{}, s)
+
+	_, err = Open("ftp", &DriverConfig{})
+	assert.ErrorContains(t, err, "unknown storage driver")
+}
+
+func TestLocalStorage_AbortStaleUploads(t *testing.T) {
+	require.NoError(t, utils.InitSnowflake(1, 1))
+	ctx := context.Background()
+	s := newTestLocalStorage(t)
+
+	upload, err := s.InitiateMultipartUpload(ctx, "videos/1.mp4", nil)
+	require.NoError(t, err)
+
+	aborted, err := s.AbortStaleUploads(ctx, time.Now().Add(-time.Hour), false)
+	require.NoError(t, err)
+	assert.Zero(t, aborted)
+
+	aborted, err = s.AbortStaleUploads(ctx, time.Now().Add(time.Hour), true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, aborted)
+	_, err = s.ListParts(ctx, upload.UploadID)
+	require.NoError(t, err)
+
+	aborted, err = s.AbortStaleUploads(ctx, time.Now().Add(time.Hour), false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, aborted)
+	_, err = s.ListParts(ctx, upload.UploadID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var uploadDuration, _ = otel.Meter("go-backend/storage").Float64Histogram("storage_upload_duration_seconds",
+	metric.WithDescription("Object upload duration, by storage backend and result"), metric.WithUnit("s"))
+
+// observeUpload 记录一次上传的耗时，各存储实现在 Upload 中调用
+func observeUpload(ctx context.Context, backend string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	uploadDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("backend", backend),
+		attribute.String("result", result),
+	))
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+// ObjectLister 可按前缀遍历对象的存储
+type ObjectLister interface {
+	ListObjects(ctx context.Context, prefix string, fn func(*FileInfo) error) error
+}
+
+// MigrateOptions 迁移选项
+type MigrateOptions struct {
+	Prefix string // 只迁移该前缀下的对象，为空时迁移全部
+	DryRun bool   // 只统计需要迁移的对象，不复制
+	// DeleteSource 复制后删除旧存储桶中的对象。已保存的URL仍指向旧桶，
+	// 确认没有引用后再开启
+	DeleteSource bool
+}
+
+// MigrateStats 迁移统计
+type MigrateStats struct {
+	Scanned       int64
+	Copied        int64
+	CopiedBytes   int64
+	Skipped       int64
+	Failed        int64
+	FailedObjects []string
+}
+
+// Migrate 将旧布局中的对象复制到所属类别的存储桶。目标桶中已存在且大小一致的对象跳过，
+// 单个对象失败不会中断迁移，可以重复执行直到没有失败
+func (r *Router) Migrate(ctx context.Context, opts *MigrateOptions) (*MigrateStats, error) {
+	if opts == nil {
+		opts = &MigrateOptions{}
+	}
+
+	lister, ok := r.fallback.(ObjectLister)
+	if !ok {
+		return nil, fmt.Errorf("fallback storage does not support listing objects")
+	}
+
+	stats := &MigrateStats{}
+	err := lister.ListObjects(ctx, opts.Prefix, func(object *FileInfo) error {
+		stats.Scanned++
+
+		class, ok := ClassifyObject(object.Name)
+		target := r.Bucket(class)
+		if !ok || target == r.fallback {
+			stats.Skipped++
+			return nil
+		}
+
+		copied, err := r.migrateObject(ctx, target, object, opts)
+		if err != nil {
+			stats.Failed++
+			stats.FailedObjects = append(stats.FailedObjects, fmt.Sprintf("%s: %v", object.Name, err))
+			return nil
+		}
+		if copied {
+			stats.Copied++
+			stats.CopiedBytes += object.Size
+		} else {
+			stats.Skipped++
+		}
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+// migrateObject 迁移单个对象，返回是否发生了复制
+func (r *Router) migrateObject(ctx context.Context, target VideoStorage, object *FileInfo, opts *MigrateOptions) (bool, error) {
+	exists, err := target.Exists(ctx, object.Name)
+	if err != nil {
+		return false, err
+	}
+
+	copied := false
+	if exists {
+		info, err := target.GetFileInfo(ctx, object.Name)
+		if err != nil {
+			return false, err
+		}
+		exists = info.Size == object.Size
+	}
+	if !exists {
+		if opts.DryRun {
+			return true, nil
+		}
+		info, err := r.fallback.GetFileInfo(ctx, object.Name)
+		if err != nil {
+			return false, err
+		}
+		if err := copyObject(ctx, r.fallback, target, object.Name, object.Name, info); err != nil {
+			return false, err
+		}
+		copied = true
+	}
+
+	if opts.DeleteSource && !opts.DryRun {
+		if err := r.fallback.Delete(ctx, object.Name); err != nil {
+			return copied, fmt.Errorf("delete source failed: %w", err)
+		}
+	}
+
+	return copied, nil
+}
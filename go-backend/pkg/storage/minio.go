@@ -23,13 +23,32 @@ type MinIOConfig struct {
 	Region     string
 	UseSSL     bool
 	BaseURL    string
+	// StorageClass 写入对象的默认存储类型
+	StorageClass string
 }
 
 // MinIOStorage MinIO存储实现
 type MinIOStorage struct {
-	client     *minio.Client
-	bucketName string
-	baseURL    string
+	client       *minio.Client
+	bucketName   string
+	baseURL      string
+	storageClass string
+	backend      string // 上传耗时指标中的存储后端名
+}
+
+func init() {
+	Register(ProviderMinIO, func(config *DriverConfig) (VideoStorage, error) {
+		return NewMinIOStorage(&MinIOConfig{
+			Endpoint:     config.Endpoint,
+			AccessKey:    config.AccessKey,
+			SecretKey:    config.SecretKey,
+			BucketName:   config.BucketName,
+			Region:       config.Region,
+			UseSSL:       config.UseSSL,
+			BaseURL:      config.BaseURL,
+			StorageClass: config.StorageClass,
+		})
+	})
 }
 
 // NewMinIOStorage 创建MinIO存储客户端
@@ -44,9 +63,11 @@ func NewMinIOStorage(config *MinIOConfig) (*MinIOStorage, error) {
 	}
 
 	storage := &MinIOStorage{
-		client:     client,
-		bucketName: config.BucketName,
-		baseURL:    config.BaseURL,
+		client:       client,
+		bucketName:   config.BucketName,
+		baseURL:      config.BaseURL,
+		storageClass: config.StorageClass,
+		backend:      string(ProviderMinIO),
 	}
 
 	if err := storage.ensureBucket(context.Background()); err != nil {
@@ -74,7 +95,7 @@ func (s *MinIOStorage) ensureBucket(ctx context.Context) error {
 
 // Upload 上传文件
 func (s *MinIOStorage) Upload(ctx context.Context, objectName string, reader io.Reader, size int64, opts *UploadOptions) (*FileInfo, error) {
-	putOpts := minio.PutObjectOptions{}
+	putOpts := minio.PutObjectOptions{StorageClass: s.storageClass}
 
 	if opts != nil {
 		if opts.ContentType != "" {
@@ -83,9 +104,14 @@ func (s *MinIOStorage) Upload(ctx context.Context, objectName string, reader io.
 		if opts.Metadata != nil {
 			putOpts.UserMetadata = opts.Metadata
 		}
+		if opts.StorageClass != "" {
+			putOpts.StorageClass = opts.StorageClass
+		}
 	}
 
+	start := time.Now()
 	info, err := s.client.PutObject(ctx, s.bucketName, objectName, reader, size, putOpts)
+	observeUpload(ctx, s.backend, start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload object: %w", err)
 	}
@@ -187,10 +213,11 @@ func (s *MinIOStorage) UploadVideo(ctx context.Context, filename string, reader
 // UploadCover 上传封面文件
 func (s *MinIOStorage) UploadCover(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
 	coverID := utils.MustGenerateID()
-	objectName := fmt.Sprintf("covers/%d.jpg", coverID)
+	ext, contentType := coverExt(filename)
+	objectName := fmt.Sprintf("covers/%d%s", coverID, ext)
 
 	opts := &UploadOptions{
-		ContentType: "image/jpeg",
+		ContentType: contentType,
 		Metadata: map[string]string{
 			"original-filename": filename,
 			"cover-id":          fmt.Sprintf("%d", coverID),
@@ -205,6 +232,69 @@ func (s *MinIOStorage) UploadCover(ctx context.Context, filename string, reader
 	return objectName, nil
 }
 
+// UploadRendition 上传转码后的视频文件
+func (s *MinIOStorage) UploadRendition(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	renditionID := utils.MustGenerateID()
+	ext := filepath.Ext(filename)
+	objectName := fmt.Sprintf("%s%d%s", renditionPrefix, renditionID, ext)
+
+	opts := &UploadOptions{
+		ContentType: s.getVideoContentType(ext),
+		Metadata: map[string]string{
+			"original-filename": filename,
+			"rendition-id":      fmt.Sprintf("%d", renditionID),
+		},
+	}
+
+	_, err := s.Upload(ctx, objectName, reader, size, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return objectName, nil
+}
+
+// ListObjects 按前缀遍历存储桶中的对象
+func (s *MinIOStorage) ListObjects(ctx context.Context, prefix string, fn func(*FileInfo) error) error {
+	for object := range s.client.ListObjects(ctx, s.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return fmt.Errorf("failed to list objects: %w", object.Err)
+		}
+		err := fn(&FileInfo{
+			Name:        object.Key,
+			Size:        object.Size,
+			ContentType: object.ContentType,
+			ETag:        object.ETag,
+			URL:         s.buildObjectURL(object.Key),
+			UploadedAt:  object.LastModified,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AbortStaleUploads 取消过期的未完成分片上传
+func (s *MinIOStorage) AbortStaleUploads(ctx context.Context, before time.Time, dryRun bool) (int, error) {
+	var aborted int
+	for upload := range s.client.ListIncompleteUploads(ctx, s.bucketName, "", true) {
+		if upload.Err != nil {
+			return aborted, fmt.Errorf("failed to list incomplete uploads: %w", upload.Err)
+		}
+		if !upload.Initiated.Before(before) {
+			continue
+		}
+		if !dryRun {
+			if err := s.client.RemoveIncompleteUpload(ctx, s.bucketName, upload.Key); err != nil {
+				return aborted, fmt.Errorf("failed to remove incomplete upload: %w", err)
+			}
+		}
+		aborted++
+	}
+	return aborted, nil
+}
+
 // GenerateVideoURL 生成视频访问URL
 func (s *MinIOStorage) GenerateVideoURL(ctx context.Context, objectName string) (string, error) {
 	return s.buildObjectURL(objectName), nil
@@ -301,6 +391,15 @@ func (s *MinIOStorage) buildObjectURL(objectName string) string {
 	return fmt.Sprintf("http://%s/%s/%s", s.client.EndpointURL().Host, s.bucketName, objectName)
 }
 
+// ObjectName 从访问URL解析对象键
+func (s *MinIOStorage) ObjectName(url string) (string, bool) {
+	objectName := strings.TrimPrefix(url, s.buildObjectURL(""))
+	if objectName == url || objectName == "" {
+		return "", false
+	}
+	return objectName, true
+}
+
 // getVideoContentType 获取视频内容类型
 func (s *MinIOStorage) getVideoContentType(ext string) string {
 	switch strings.ToLower(ext) {
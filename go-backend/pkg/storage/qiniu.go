@@ -78,7 +78,9 @@ func (q *QiniuStorage) Upload(ctx context.Context, objectName string, reader io.
 		}
 	}
 
+	start := time.Now()
 	err := q.uploadManager.UploadReader(ctx, reader, objectOptions, nil)
+	observeUpload(ctx, "qiniu", start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload to qiniu: %w", err)
 	}
@@ -147,10 +149,11 @@ func (q *QiniuStorage) UploadVideo(ctx context.Context, filename string, reader
 // UploadCover 上传封面文件
 func (q *QiniuStorage) UploadCover(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
 	coverID := utils.MustGenerateID()
-	objectName := fmt.Sprintf("covers/%d.jpg", coverID)
+	ext, contentType := coverExt(filename)
+	objectName := fmt.Sprintf("covers/%d%s", coverID, ext)
 
 	opts := &UploadOptions{
-		ContentType: "image/jpeg",
+		ContentType: contentType,
 		Metadata: map[string]string{
 			"original-filename": filename,
 			"cover-id":          fmt.Sprintf("%d", coverID),
@@ -165,6 +168,28 @@ func (q *QiniuStorage) UploadCover(ctx context.Context, filename string, reader
 	return objectName, nil
 }
 
+// UploadRendition 上传转码后的视频文件
+func (q *QiniuStorage) UploadRendition(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	renditionID := utils.MustGenerateID()
+	ext := filepath.Ext(filename)
+	objectName := fmt.Sprintf("%s%d%s", renditionPrefix, renditionID, ext)
+
+	opts := &UploadOptions{
+		ContentType: q.getVideoContentType(ext),
+		Metadata: map[string]string{
+			"original-filename": filename,
+			"rendition-id":      fmt.Sprintf("%d", renditionID),
+		},
+	}
+
+	_, err := q.Upload(ctx, objectName, reader, size, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return objectName, nil
+}
+
 // GenerateVideoURL 生成视频访问URL
 func (q *QiniuStorage) GenerateVideoURL(ctx context.Context, objectName string) (string, error) {
 	return q.buildURL(objectName), nil
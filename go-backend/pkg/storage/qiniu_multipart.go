@@ -102,7 +102,9 @@ func (q *QiniuMultipartStorage) ResumeUpload(ctx context.Context, uploadID strin
 		ObjectName: &uploadID,
 	}
 
+	start := time.Now()
 	err := uploadManager.UploadReader(ctx, reader, objectOptions, nil)
+	observeUpload(ctx, "qiniu", start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resume upload: %w", err)
 	}
@@ -0,0 +1,424 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ObjectClass 对象类别，不同类别可以放在不同存储桶中，按访问温度使用不同的存储类型
+type ObjectClass string
+
+const (
+	ClassOriginal   ObjectClass = "original"   // 上传的原始视频，转码后很少读取
+	ClassTranscoded ObjectClass = "transcoded" // 转码后的视频和HLS切片，播放热点
+	ClassCover      ObjectClass = "cover"      // 封面、分享卡片和用户头像等图片，播放热点
+	ClassQuarantine ObjectClass = "quarantine" // 隔离内容，仅审核可见
+	ClassLegalHold  ObjectClass = "legal-hold" // 被下架内容的法律保全副本，申诉结案前不得清理
+)
+
+// 各类别对象的键前缀，对象在不同存储桶间迁移时键保持不变
+const (
+	originalPrefix   = "videos/"
+	renditionPrefix  = "renditions/"
+	hlsPrefix        = "hls/"
+	coverPrefix      = "covers/"
+	cardPrefix       = "cards/"
+	profilePrefix    = "profiles/"
+	quarantinePrefix = "quarantine/"
+	legalHoldPrefix  = "legal-hold/"
+)
+
+// ObjectClasses 所有对象类别
+var ObjectClasses = []ObjectClass{ClassOriginal, ClassTranscoded, ClassCover, ClassQuarantine, ClassLegalHold}
+
+// ParseObjectClass 解析配置中的对象类别
+func ParseObjectClass(name string) (ObjectClass, bool) {
+	for _, class := range ObjectClasses {
+		if string(class) == name {
+			return class, true
+		}
+	}
+	return "", false
+}
+
+// ClassifyObject 根据对象键判断类别，无法识别的对象返回false
+func ClassifyObject(objectName string) (ObjectClass, bool) {
+	switch {
+	case strings.HasPrefix(objectName, originalPrefix):
+		return ClassOriginal, true
+	case strings.HasPrefix(objectName, renditionPrefix), strings.HasPrefix(objectName, hlsPrefix):
+		return ClassTranscoded, true
+	case strings.HasPrefix(objectName, coverPrefix), strings.HasPrefix(objectName, cardPrefix),
+		strings.HasPrefix(objectName, profilePrefix):
+		return ClassCover, true
+	case strings.HasPrefix(objectName, quarantinePrefix):
+		return ClassQuarantine, true
+	case strings.HasPrefix(objectName, legalHoldPrefix):
+		return ClassLegalHold, true
+	default:
+		return "", false
+	}
+}
+
+// Router 按对象类别将请求路由到对应存储桶。fallback 是迁移前的单桶布局，
+// 未配置的类别写入 fallback；读取时目标桶中不存在的对象回退到 fallback，
+// 因此可以先切换写入路由，再用 Migrate 逐步搬迁存量对象
+type Router struct {
+	routes   map[ObjectClass]VideoStorage
+	fallback VideoStorage
+}
+
+// NewRouter 创建存储路由
+func NewRouter(fallback VideoStorage, routes map[ObjectClass]VideoStorage) *Router {
+	r := &Router{
+		routes:   make(map[ObjectClass]VideoStorage, len(routes)),
+		fallback: fallback,
+	}
+	for class, target := range routes {
+		if target != nil {
+			r.routes[class] = target
+		}
+	}
+	return r
+}
+
+// Bucket 获取类别对应的存储
+func (r *Router) Bucket(class ObjectClass) VideoStorage {
+	if target, ok := r.routes[class]; ok {
+		return target
+	}
+	return r.fallback
+}
+
+// route 按对象键选择存储
+func (r *Router) route(objectName string) VideoStorage {
+	class, ok := ClassifyObject(objectName)
+	if !ok {
+		return r.fallback
+	}
+	return r.Bucket(class)
+}
+
+// locate 查找对象实际所在的存储，目标桶中不存在时回退到旧布局
+func (r *Router) locate(ctx context.Context, objectName string) (VideoStorage, error) {
+	target := r.route(objectName)
+	if target == r.fallback {
+		return target, nil
+	}
+
+	exists, err := target.Exists(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return r.fallback, nil
+	}
+	return target, nil
+}
+
+// Upload 上传文件
+func (r *Router) Upload(ctx context.Context, objectName string, reader io.Reader, size int64, opts *UploadOptions) (*FileInfo, error) {
+	return r.route(objectName).Upload(ctx, objectName, reader, size, opts)
+}
+
+// Download 下载文件
+func (r *Router) Download(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	target, err := r.locate(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return target.Download(ctx, objectName)
+}
+
+// Delete 删除文件，迁移期间对象可能同时存在于新旧存储桶，两处都删除
+func (r *Router) Delete(ctx context.Context, objectName string) error {
+	target := r.route(objectName)
+	if err := target.Delete(ctx, objectName); err != nil {
+		return err
+	}
+	if target != r.fallback {
+		return r.fallback.Delete(ctx, objectName)
+	}
+	return nil
+}
+
+// ListObjects 遍历前缀下的对象，迁移期间新旧存储桶都遍历，同名对象只回调一次
+func (r *Router) ListObjects(ctx context.Context, prefix string, fn func(*FileInfo) error) error {
+	targets := []VideoStorage{r.route(prefix)}
+	if targets[0] != r.fallback {
+		targets = append(targets, r.fallback)
+	}
+
+	seen := make(map[string]bool)
+	for _, target := range targets {
+		lister, ok := target.(ObjectLister)
+		if !ok {
+			return fmt.Errorf("storage does not support listing objects")
+		}
+		err := lister.ListObjects(ctx, prefix, func(object *FileInfo) error {
+			if seen[object.Name] {
+				return nil
+			}
+			seen[object.Name] = true
+			return fn(object)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPresignedURL 获取预签名URL
+func (r *Router) GetPresignedURL(ctx context.Context, objectName string, expires time.Duration) (string, error) {
+	target, err := r.locate(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	return target.GetPresignedURL(ctx, objectName, expires)
+}
+
+// Exists 检查文件是否存在
+func (r *Router) Exists(ctx context.Context, objectName string) (bool, error) {
+	target, err := r.locate(ctx, objectName)
+	if err != nil {
+		return false, err
+	}
+	return target.Exists(ctx, objectName)
+}
+
+// GetFileInfo 获取文件信息
+func (r *Router) GetFileInfo(ctx context.Context, objectName string) (*FileInfo, error) {
+	target, err := r.locate(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return target.GetFileInfo(ctx, objectName)
+}
+
+// UploadVideo 上传原始视频
+func (r *Router) UploadVideo(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	return r.Bucket(ClassOriginal).UploadVideo(ctx, filename, reader, size)
+}
+
+// UploadCover 上传封面
+func (r *Router) UploadCover(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	return r.Bucket(ClassCover).UploadCover(ctx, filename, reader, size)
+}
+
+// UploadRendition 上传转码后的视频
+func (r *Router) UploadRendition(ctx context.Context, filename string, reader io.Reader, size int64) (string, error) {
+	return r.Bucket(ClassTranscoded).UploadRendition(ctx, filename, reader, size)
+}
+
+// GenerateVideoURL 生成视频访问URL
+func (r *Router) GenerateVideoURL(ctx context.Context, objectName string) (string, error) {
+	return r.route(objectName).GenerateVideoURL(ctx, objectName)
+}
+
+// GenerateCoverURL 生成封面访问URL
+func (r *Router) GenerateCoverURL(ctx context.Context, objectName string) (string, error) {
+	return r.route(objectName).GenerateCoverURL(ctx, objectName)
+}
+
+// ObjectName 从访问URL解析对象键，依次尝试各类别存储桶和旧布局
+func (r *Router) ObjectName(url string) (string, bool) {
+	for _, class := range ObjectClasses {
+		if resolver, ok := r.Bucket(class).(ObjectResolver); ok {
+			if objectName, ok := resolver.ObjectName(url); ok {
+				return objectName, true
+			}
+		}
+	}
+	if resolver, ok := r.fallback.(ObjectResolver); ok {
+		return resolver.ObjectName(url)
+	}
+	return "", false
+}
+
+// Quarantine 将对象移入隔离存储桶，返回隔离后的对象键
+func (r *Router) Quarantine(ctx context.Context, objectName string) (string, error) {
+	if class, ok := ClassifyObject(objectName); ok && class == ClassQuarantine {
+		return objectName, nil
+	}
+
+	source, err := r.locate(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	info, err := source.GetFileInfo(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+
+	quarantined := quarantinePrefix + objectName
+	if err := copyObject(ctx, source, r.Bucket(ClassQuarantine), objectName, quarantined, info); err != nil {
+		return "", err
+	}
+	if err := r.Delete(ctx, objectName); err != nil {
+		return "", fmt.Errorf("delete quarantined source failed: %w", err)
+	}
+
+	return quarantined, nil
+}
+
+// Hold 将对象移入法律保全存储桶，返回保全后的对象键
+func (r *Router) Hold(ctx context.Context, objectName string) (string, error) {
+	if class, ok := ClassifyObject(objectName); ok && class == ClassLegalHold {
+		return objectName, nil
+	}
+
+	source, err := r.locate(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	info, err := source.GetFileInfo(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+
+	held := legalHoldPrefix + objectName
+	if err := copyObject(ctx, source, r.Bucket(ClassLegalHold), objectName, held, info); err != nil {
+		return "", err
+	}
+	if err := r.Delete(ctx, objectName); err != nil {
+		return "", fmt.Errorf("delete held source failed: %w", err)
+	}
+
+	return held, nil
+}
+
+// Release 将保全对象移回原位置，返回原对象键
+func (r *Router) Release(ctx context.Context, heldName string) (string, error) {
+	objectName, ok := strings.CutPrefix(heldName, legalHoldPrefix)
+	if !ok {
+		return "", fmt.Errorf("object %s is not under legal hold", heldName)
+	}
+
+	source, err := r.locate(ctx, heldName)
+	if err != nil {
+		return "", err
+	}
+	info, err := source.GetFileInfo(ctx, heldName)
+	if err != nil {
+		return "", err
+	}
+
+	if err := copyObject(ctx, source, r.route(objectName), heldName, objectName, info); err != nil {
+		return "", err
+	}
+	if err := r.Delete(ctx, heldName); err != nil {
+		return "", fmt.Errorf("delete released hold failed: %w", err)
+	}
+
+	return objectName, nil
+}
+
+// copyObject 在两个存储之间复制对象
+func copyObject(ctx context.Context, source, target Storage, sourceName, targetName string, info *FileInfo) error {
+	reader, err := source.Download(ctx, sourceName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	_, err = target.Upload(ctx, targetName, reader, info.Size, &UploadOptions{ContentType: info.ContentType})
+	return err
+}
+
+// multipart 分片上传只用于上传原始视频，所有分片操作都交给原始视频所在的存储，保证上传ID有效
+func (r *Router) multipart() (ResumableUpload, error) {
+	target, ok := r.Bucket(ClassOriginal).(ResumableUpload)
+	if !ok {
+		return nil, fmt.Errorf("storage does not support multipart upload")
+	}
+	return target, nil
+}
+
+// InitiateMultipartUpload 初始化分片上传
+func (r *Router) InitiateMultipartUpload(ctx context.Context, key string, opts *MultipartUploadOptions) (*MultipartUploadInfo, error) {
+	target, err := r.multipart()
+	if err != nil {
+		return nil, err
+	}
+	return target.InitiateMultipartUpload(ctx, key, opts)
+}
+
+// UploadPart 上传分片
+func (r *Router) UploadPart(ctx context.Context, uploadID string, partNumber int, reader io.Reader, size int64) (*PartInfo, error) {
+	target, err := r.multipart()
+	if err != nil {
+		return nil, err
+	}
+	return target.UploadPart(ctx, uploadID, partNumber, reader, size)
+}
+
+// CompleteMultipartUpload 完成分片上传
+func (r *Router) CompleteMultipartUpload(ctx context.Context, uploadID string, parts []PartInfo) (*FileInfo, error) {
+	target, err := r.multipart()
+	if err != nil {
+		return nil, err
+	}
+	return target.CompleteMultipartUpload(ctx, uploadID, parts)
+}
+
+// AbortMultipartUpload 取消分片上传
+func (r *Router) AbortMultipartUpload(ctx context.Context, uploadID string) error {
+	target, err := r.multipart()
+	if err != nil {
+		return err
+	}
+	return target.AbortMultipartUpload(ctx, uploadID)
+}
+
+// ListParts 列出已上传的分片
+func (r *Router) ListParts(ctx context.Context, uploadID string) ([]PartInfo, error) {
+	target, err := r.multipart()
+	if err != nil {
+		return nil, err
+	}
+	return target.ListParts(ctx, uploadID)
+}
+
+// ResumeUpload 恢复上传
+func (r *Router) ResumeUpload(ctx context.Context, uploadID string, reader io.Reader, size int64) (*FileInfo, error) {
+	target, err := r.multipart()
+	if err != nil {
+		return nil, err
+	}
+	return target.ResumeUpload(ctx, uploadID, reader, size)
+}
+
+// GetUploadProgress 获取上传进度
+func (r *Router) GetUploadProgress(ctx context.Context, uploadID string) (int64, error) {
+	target, err := r.multipart()
+	if err != nil {
+		return 0, err
+	}
+	return target.GetUploadProgress(ctx, uploadID)
+}
+
+// AbortStaleUploads 取消各存储桶中过期的未完成分片上传
+func (r *Router) AbortStaleUploads(ctx context.Context, before time.Time, dryRun bool) (int, error) {
+	targets := []VideoStorage{r.fallback}
+	for _, target := range r.routes {
+		targets = append(targets, target)
+	}
+
+	var aborted int
+	for _, target := range targets {
+		aborter, ok := target.(StaleUploadAborter)
+		if !ok {
+			continue
+		}
+		n, err := aborter.AbortStaleUploads(ctx, before, dryRun)
+		aborted += n
+		if err != nil {
+			return aborted, err
+		}
+	}
+	return aborted, nil
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config AWS S3配置
+type S3Config struct {
+	Region     string
+	BucketName string
+	// AccessKey 为空时依次从环境变量、~/.aws/credentials 和实例角色获取凭证
+	AccessKey string
+	SecretKey string
+	// Endpoint 为空时使用 s3.<region>.amazonaws.com
+	Endpoint string
+	// BaseURL 为空时使用存储桶的虚拟主机域名
+	BaseURL      string
+	StorageClass string
+}
+
+// S3Storage AWS S3存储实现。S3 与 MinIO 协议兼容，读写逻辑复用 MinIOStorage，
+// 区别在于凭证获取方式和存储桶必须预先创建：生产环境的存储桶由基础设施统一管理，
+// 服务账号通常没有建桶权限
+type S3Storage struct {
+	*MinIOStorage
+}
+
+func init() {
+	Register(ProviderS3, func(config *DriverConfig) (VideoStorage, error) {
+		return NewS3Storage(&S3Config{
+			Region:       config.Region,
+			BucketName:   config.BucketName,
+			AccessKey:    config.AccessKey,
+			SecretKey:    config.SecretKey,
+			Endpoint:     config.Endpoint,
+			BaseURL:      config.BaseURL,
+			StorageClass: config.StorageClass,
+		})
+	})
+}
+
+// NewS3Storage 创建S3存储客户端
+func NewS3Storage(config *S3Config) (*S3Storage, error) {
+	if config.BucketName == "" {
+		return nil, fmt.Errorf("s3 bucket name is required")
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = "s3.amazonaws.com"
+		if config.Region != "" {
+			endpoint = fmt.Sprintf("s3.%s.amazonaws.com", config.Region)
+		}
+	}
+
+	creds := credentials.NewStaticV4(config.AccessKey, config.SecretKey, "")
+	if config.AccessKey == "" {
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.FileAWSCredentials{},
+			&credentials.IAM{},
+		})
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: true,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("https://%s.%s", config.BucketName, endpoint)
+	}
+
+	storage := &S3Storage{MinIOStorage: &MinIOStorage{
+		client:       client,
+		bucketName:   config.BucketName,
+		baseURL:      baseURL,
+		storageClass: config.StorageClass,
+		backend:      string(ProviderS3),
+	}}
+
+	exists, err := client.BucketExists(context.Background(), config.BucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("s3 bucket %s does not exist", config.BucketName)
+	}
+
+	return storage, nil
+}
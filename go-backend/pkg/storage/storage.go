@@ -3,6 +3,8 @@ package storage
 import (
 	"context"
 	"io"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -18,9 +20,10 @@ type FileInfo struct {
 
 // UploadOptions 上传选项
 type UploadOptions struct {
-	ContentType string
-	Metadata    map[string]string
-	Expires     time.Duration
+	ContentType  string
+	Metadata     map[string]string
+	Expires      time.Duration
+	StorageClass string // 存储类型，为空时使用存储桶的默认配置
 }
 
 // Storage 存储接口
@@ -54,6 +57,9 @@ type VideoStorage interface {
 	// UploadCover 上传封面文件
 	UploadCover(ctx context.Context, filename string, reader io.Reader, size int64) (string, error)
 
+	// UploadRendition 上传转码后的视频文件
+	UploadRendition(ctx context.Context, filename string, reader io.Reader, size int64) (string, error)
+
 	// GenerateVideoURL 生成视频访问URL
 	GenerateVideoURL(ctx context.Context, objectName string) (string, error)
 
@@ -61,6 +67,32 @@ type VideoStorage interface {
 	GenerateCoverURL(ctx context.Context, objectName string) (string, error)
 }
 
+// ObjectResolver 可以把访问URL解析回对象键的存储
+type ObjectResolver interface {
+	// ObjectName 解析本存储生成的访问URL，不属于本存储的URL返回false
+	ObjectName(url string) (string, bool)
+}
+
+// LegalHoldStorage 支持法律保全的存储，保全对象移出公开访问路径且不会被清理任务删除
+type LegalHoldStorage interface {
+	// Hold 将对象移入保全区，返回保全后的对象键
+	Hold(ctx context.Context, objectName string) (string, error)
+	// Release 将保全对象移回原位置，返回原对象键
+	Release(ctx context.Context, heldName string) (string, error)
+}
+
+// QuarantineStorage 支持隔离的存储，隔离对象移出公开访问路径，仅审核可见
+type QuarantineStorage interface {
+	// Quarantine 将对象移入隔离区，返回隔离后的对象键
+	Quarantine(ctx context.Context, objectName string) (string, error)
+}
+
+// StaleUploadAborter 可以清理长期未完成的分片上传的存储
+type StaleUploadAborter interface {
+	// AbortStaleUploads 取消 before 之前发起且仍未完成的分片上传，dryRun 时只统计不取消，返回涉及的上传数
+	AbortStaleUploads(ctx context.Context, before time.Time, dryRun bool) (int, error)
+}
+
 // MultipartStorage 分片上传存储接口
 type MultipartStorage interface {
 	Storage
@@ -79,4 +111,16 @@ type Provider string
 const (
 	ProviderMinIO Provider = "minio"
 	ProviderQiniu Provider = "qiniu"
+	ProviderS3    Provider = "s3"
+	ProviderLocal Provider = "local"
 )
+
+// coverExt 封面对象的扩展名和类型，按上传文件名区分 WebP 和 GIF（动态封面），其余按 JPEG 保存
+func coverExt(filename string) (string, string) {
+	switch ext := strings.ToLower(filepath.Ext(filename)); ext {
+	case ".webp", ".gif":
+		return ext, "image/" + ext[1:]
+	default:
+		return ".jpg", "image/jpeg"
+	}
+}
@@ -0,0 +1,134 @@
+package timeutil
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimezone 未设置时区的用户使用UTC
+const DefaultTimezone = "UTC"
+
+var (
+	ErrInvalidTimezone = errors.New("invalid timezone")
+	ErrInvalidWindow   = errors.New("invalid time window")
+)
+
+// Bucket 统计聚合粒度
+type Bucket string
+
+const (
+	BucketHour  Bucket = "hour"
+	BucketDay   Bucket = "day"
+	BucketWeek  Bucket = "week"
+	BucketMonth Bucket = "month"
+)
+
+// locationCache 缓存已加载的时区，避免重复读取时区数据库
+var locationCache sync.Map
+
+// LoadLocation 加载IANA时区，空字符串返回UTC。拒绝"Local"，服务端本地时区对用户没有意义
+func LoadLocation(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return time.UTC, nil
+	}
+	if name == "Local" || len(name) > 64 {
+		return nil, ErrInvalidTimezone
+	}
+
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimezone
+	}
+
+	locationCache.Store(name, loc)
+	return loc, nil
+}
+
+// LocationOrUTC 加载时区，失败时回退到UTC
+func LocationOrUTC(name string) *time.Location {
+	loc, err := LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// ParseInLocation 按用户时区解析不带时区信息的本地时间，如定时发布时间"2006-01-02 15:04"。
+// 若输入自带时区偏移则以输入为准
+func ParseInLocation(layout, value string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(layout, strings.TrimSpace(value), loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+// NextDailyWindow 返回now之后(含当前)用户本地时间每天[startHour, endHour)窗口的起止时间，
+// 用于摘要推送等只应在用户白天进行的任务。endHour小于等于startHour时表示跨越午夜
+func NextDailyWindow(now time.Time, loc *time.Location, startHour, endHour int) (time.Time, time.Time, error) {
+	if startHour < 0 || startHour > 23 || endHour < 0 || endHour > 24 || startHour == endHour {
+		return time.Time{}, time.Time{}, ErrInvalidWindow
+	}
+
+	local := now.In(loc)
+	// 从前一天开始检查，覆盖跨午夜窗口在今天凌晨仍然有效的情况
+	for offset := -1; offset <= 1; offset++ {
+		day := local.AddDate(0, 0, offset)
+		start := time.Date(day.Year(), day.Month(), day.Day(), startHour, 0, 0, 0, loc)
+		end := time.Date(day.Year(), day.Month(), day.Day(), endHour, 0, 0, 0, loc)
+		if endHour <= startHour {
+			end = end.AddDate(0, 0, 1)
+		}
+
+		if now.Before(end) {
+			if now.After(start) {
+				start = now
+			}
+			return start.UTC(), end.UTC(), nil
+		}
+	}
+
+	// 不可达：明天的窗口一定在now之后
+	return time.Time{}, time.Time{}, ErrInvalidWindow
+}
+
+// TruncateToBucket 按用户本地日历截断时间，返回桶起点(UTC)。周以周一为起点
+func TruncateToBucket(t time.Time, loc *time.Location, bucket Bucket) time.Time {
+	local := t.In(loc)
+
+	var start time.Time
+	switch bucket {
+	case BucketHour:
+		start = time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, loc)
+	case BucketWeek:
+		weekday := (int(local.Weekday()) + 6) % 7
+		start = time.Date(local.Year(), local.Month(), local.Day()-weekday, 0, 0, 0, 0, loc)
+	case BucketMonth:
+		start = time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		start = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	}
+
+	return start.UTC()
+}
+
+// BucketKey 返回桶在用户本地时间下的标识，如"2026-10-15"、"2026-10-15T08"
+func BucketKey(t time.Time, loc *time.Location, bucket Bucket) string {
+	start := TruncateToBucket(t, loc, bucket).In(loc)
+
+	switch bucket {
+	case BucketHour:
+		return start.Format("2006-01-02T15")
+	case BucketMonth:
+		return start.Format("2006-01")
+	default:
+		return start.Format("2006-01-02")
+	}
+}
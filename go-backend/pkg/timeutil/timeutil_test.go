@@ -0,0 +1,119 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	loc, err := LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation("")
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+
+	loc, err = LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Shanghai", loc.String())
+
+	_, err = LoadLocation("Local")
+	assert.Equal(t, ErrInvalidTimezone, err)
+
+	_, err = LoadLocation("Mars/Olympus")
+	assert.Equal(t, ErrInvalidTimezone, err)
+
+	assert.Equal(t, time.UTC, LocationOrUTC("Mars/Olympus"))
+}
+
+func TestParseInLocation(t *testing.T) {
+	loc := mustLoad(t, "Asia/Shanghai")
+
+	got, err := ParseInLocation("2006-01-02 15:04", "2026-10-15 20:00", loc)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC), got)
+
+	// 自带偏移时以输入为准
+	got, err = ParseInLocation(time.RFC3339, "2026-10-15T20:00:00Z", loc)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC), got)
+}
+
+func TestNextDailyWindow(t *testing.T) {
+	loc := mustLoad(t, "Asia/Shanghai")
+
+	t.Run("BeforeWindow", func(t *testing.T) {
+		// 本地 06:00
+		now := time.Date(2026, 10, 14, 22, 0, 0, 0, time.UTC)
+		start, end, err := NextDailyWindow(now, loc, 9, 21)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 15, 1, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2026, 10, 15, 13, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("InsideWindow", func(t *testing.T) {
+		// 本地 10:00
+		now := time.Date(2026, 10, 15, 2, 0, 0, 0, time.UTC)
+		start, end, err := NextDailyWindow(now, loc, 9, 21)
+		require.NoError(t, err)
+		assert.Equal(t, now, start)
+		assert.Equal(t, time.Date(2026, 10, 15, 13, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("AfterWindow", func(t *testing.T) {
+		// 本地 22:00
+		now := time.Date(2026, 10, 15, 14, 0, 0, 0, time.UTC)
+		start, _, err := NextDailyWindow(now, loc, 9, 21)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 16, 1, 0, 0, 0, time.UTC), start)
+	})
+
+	t.Run("CrossMidnight", func(t *testing.T) {
+		// 本地 02:00，处于前一天22点开始的窗口内
+		now := time.Date(2026, 10, 14, 18, 0, 0, 0, time.UTC)
+		start, end, err := NextDailyWindow(now, loc, 22, 6)
+		require.NoError(t, err)
+		assert.Equal(t, now, start)
+		assert.Equal(t, time.Date(2026, 10, 14, 22, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, err := NextDailyWindow(time.Now(), loc, 9, 9)
+		assert.Equal(t, ErrInvalidWindow, err)
+	})
+}
+
+func TestTruncateToBucket(t *testing.T) {
+	loc := mustLoad(t, "America/New_York")
+	// 本地 2026-10-14 (周三) 21:30 EDT
+	ts := time.Date(2026, 10, 15, 1, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 10, 15, 1, 0, 0, 0, time.UTC), TruncateToBucket(ts, loc, BucketHour))
+	assert.Equal(t, time.Date(2026, 10, 14, 4, 0, 0, 0, time.UTC), TruncateToBucket(ts, loc, BucketDay))
+	assert.Equal(t, time.Date(2026, 10, 12, 4, 0, 0, 0, time.UTC), TruncateToBucket(ts, loc, BucketWeek))
+	assert.Equal(t, time.Date(2026, 10, 1, 4, 0, 0, 0, time.UTC), TruncateToBucket(ts, loc, BucketMonth))
+
+	// 同一时刻在UTC下属于另一天
+	assert.Equal(t, "2026-10-14", BucketKey(ts, loc, BucketDay))
+	assert.Equal(t, "2026-10-15", BucketKey(ts, time.UTC, BucketDay))
+	assert.Equal(t, "2026-10-14T21", BucketKey(ts, loc, BucketHour))
+	assert.Equal(t, "2026-10", BucketKey(ts, loc, BucketMonth))
+}
+
+func TestTruncateToBucket_DST(t *testing.T) {
+	loc := mustLoad(t, "America/New_York")
+	// 2026-11-01 夏令时结束，当天有25小时
+	ts := time.Date(2026, 11, 1, 20, 0, 0, 0, time.UTC)
+
+	start := TruncateToBucket(ts, loc, BucketDay)
+	assert.Equal(t, time.Date(2026, 11, 1, 4, 0, 0, 0, time.UTC), start)
+
+	next := TruncateToBucket(ts.Add(24*time.Hour), loc, BucketDay)
+	assert.Equal(t, 25*time.Hour, next.Sub(start))
+}
@@ -85,6 +85,34 @@ func GetErrorCode(err error) v1.ErrorCode {
 			return v1.ErrorCode_USER_NOT_EXIST
 		case v1.ErrorCode_USER_EXIST.String():
 			return v1.ErrorCode_USER_EXIST
+		case v1.ErrorCode_REGISTER_LIMITED.String():
+			return v1.ErrorCode_REGISTER_LIMITED
+		case v1.ErrorCode_CONTACT_REQUIRED.String():
+			return v1.ErrorCode_CONTACT_REQUIRED
+		case v1.ErrorCode_REGISTRATION_NOT_PENDING.String():
+			return v1.ErrorCode_REGISTRATION_NOT_PENDING
+		case v1.ErrorCode_ACCOUNT_LOCKED.String():
+			return v1.ErrorCode_ACCOUNT_LOCKED
+		case v1.ErrorCode_RESET_TOKEN_INVALID.String():
+			return v1.ErrorCode_RESET_TOKEN_INVALID
+		case v1.ErrorCode_EMAIL_TAKEN.String():
+			return v1.ErrorCode_EMAIL_TAKEN
+		case v1.ErrorCode_VERIFICATION_CODE_INVALID.String():
+			return v1.ErrorCode_VERIFICATION_CODE_INVALID
+		case v1.ErrorCode_REFERRAL_CODE_INVALID.String():
+			return v1.ErrorCode_REFERRAL_CODE_INVALID
+		case v1.ErrorCode_IMAGE_FORMAT_ERR.String():
+			return v1.ErrorCode_IMAGE_FORMAT_ERR
+		case v1.ErrorCode_IMAGE_SIZE_ERR.String():
+			return v1.ErrorCode_IMAGE_SIZE_ERR
+		case v1.ErrorCode_LOGIN_CHALLENGE_REQUIRED.String():
+			return v1.ErrorCode_LOGIN_CHALLENGE_REQUIRED
+		case v1.ErrorCode_OAUTH_LOGIN_FAILED.String():
+			return v1.ErrorCode_OAUTH_LOGIN_FAILED
+		case v1.ErrorCode_USER_BANNED.String():
+			return v1.ErrorCode_USER_BANNED
+		case v1.ErrorCode_RATE_LIMIT.String():
+			return v1.ErrorCode_RATE_LIMIT
 		case v1.ErrorCode_VIDEO_NOT_EXIST.String():
 			return v1.ErrorCode_VIDEO_NOT_EXIST
 		case v1.ErrorCode_VIDEO_UPLOAD_FAIL.String():
@@ -93,6 +121,78 @@ func GetErrorCode(err error) v1.ErrorCode {
 			return v1.ErrorCode_VIDEO_FORMAT_ERR
 		case v1.ErrorCode_VIDEO_SIZE_ERR.String():
 			return v1.ErrorCode_VIDEO_SIZE_ERR
+		case v1.ErrorCode_VIDEO_NOT_PENDING.String():
+			return v1.ErrorCode_VIDEO_NOT_PENDING
+		case v1.ErrorCode_DRAFT_NOT_EXIST.String():
+			return v1.ErrorCode_DRAFT_NOT_EXIST
+		case v1.ErrorCode_TAKEDOWN_NOT_EXIST.String():
+			return v1.ErrorCode_TAKEDOWN_NOT_EXIST
+		case v1.ErrorCode_TAKEDOWN_NOT_APPEALABLE.String():
+			return v1.ErrorCode_TAKEDOWN_NOT_APPEALABLE
+		case v1.ErrorCode_CATEGORY_NOT_EXIST.String():
+			return v1.ErrorCode_CATEGORY_NOT_EXIST
+		case v1.ErrorCode_CATEGORY_EXIST.String():
+			return v1.ErrorCode_CATEGORY_EXIST
+		case v1.ErrorCode_CATEGORY_IN_USE.String():
+			return v1.ErrorCode_CATEGORY_IN_USE
+		case v1.ErrorCode_PART_CHECKSUM_MISMATCH.String():
+			return v1.ErrorCode_PART_CHECKSUM_MISMATCH
+		case v1.ErrorCode_UPLOAD_CHECKSUM_MISMATCH.String():
+			return v1.ErrorCode_UPLOAD_CHECKSUM_MISMATCH
+		case v1.ErrorCode_UPLOAD_QUOTA_EXCEEDED.String():
+			return v1.ErrorCode_UPLOAD_QUOTA_EXCEEDED
+		case v1.ErrorCode_STORAGE_QUOTA_EXCEEDED.String():
+			return v1.ErrorCode_STORAGE_QUOTA_EXCEEDED
+		case v1.ErrorCode_PROMOTION_NOT_EXIST.String():
+			return v1.ErrorCode_PROMOTION_NOT_EXIST
+		case v1.ErrorCode_PLAYLIST_NOT_EXIST.String():
+			return v1.ErrorCode_PLAYLIST_NOT_EXIST
+		case v1.ErrorCode_SHARE_LINK_NOT_EXIST.String():
+			return v1.ErrorCode_SHARE_LINK_NOT_EXIST
+		case v1.ErrorCode_VIDEO_DURATION_ERR.String():
+			return v1.ErrorCode_VIDEO_DURATION_ERR
+		case v1.ErrorCode_VIDEO_RESOLUTION_TOO_LOW.String():
+			return v1.ErrorCode_VIDEO_RESOLUTION_TOO_LOW
+		case v1.ErrorCode_VIDEO_RESOLUTION_TOO_HIGH.String():
+			return v1.ErrorCode_VIDEO_RESOLUTION_TOO_HIGH
+		case v1.ErrorCode_VIDEO_INFECTED.String():
+			return v1.ErrorCode_VIDEO_INFECTED
+		case v1.ErrorCode_ALREADY_LIKE.String():
+			return v1.ErrorCode_ALREADY_LIKE
+		case v1.ErrorCode_NOT_LIKE.String():
+			return v1.ErrorCode_NOT_LIKE
+		case v1.ErrorCode_COMMENT_NOT_EXIST.String():
+			return v1.ErrorCode_COMMENT_NOT_EXIST
+		case v1.ErrorCode_FOLLOW_REQUEST_PENDING.String():
+			return v1.ErrorCode_FOLLOW_REQUEST_PENDING
+		case v1.ErrorCode_FOLLOW_REQUEST_NOT_EXIST.String():
+			return v1.ErrorCode_FOLLOW_REQUEST_NOT_EXIST
+		case v1.ErrorCode_ACCOUNT_PRIVATE.String():
+			return v1.ErrorCode_ACCOUNT_PRIVATE
+		case v1.ErrorCode_PERMISSION_DENIED.String():
+			return v1.ErrorCode_PERMISSION_DENIED
+		case v1.ErrorCode_ROLE_NOT_FOUND.String():
+			return v1.ErrorCode_ROLE_NOT_FOUND
+		case v1.ErrorCode_PERMISSION_NOT_FOUND.String():
+			return v1.ErrorCode_PERMISSION_NOT_FOUND
+		case v1.ErrorCode_ROLE_EXIST.String():
+			return v1.ErrorCode_ROLE_EXIST
+		case v1.ErrorCode_PERMISSION_EXIST.String():
+			return v1.ErrorCode_PERMISSION_EXIST
+		case v1.ErrorCode_BUILTIN_ROLE.String():
+			return v1.ErrorCode_BUILTIN_ROLE
+		case v1.ErrorCode_DEAD_LETTER_NOT_FOUND.String():
+			return v1.ErrorCode_DEAD_LETTER_NOT_FOUND
+		case v1.ErrorCode_DEAD_LETTER_REPLAYED.String():
+			return v1.ErrorCode_DEAD_LETTER_REPLAYED
+		case v1.ErrorCode_SIGNATURE_INVALID.String():
+			return v1.ErrorCode_SIGNATURE_INVALID
+		case v1.ErrorCode_REQUEST_REPLAYED.String():
+			return v1.ErrorCode_REQUEST_REPLAYED
+		case v1.ErrorCode_RESOURCE_LOCKED.String():
+			return v1.ErrorCode_RESOURCE_LOCKED
+		case v1.ErrorCode_CONTENT_VIOLATION.String():
+			return v1.ErrorCode_CONTENT_VIOLATION
 		default:
 			return v1.ErrorCode_SERVER_ERROR
 		}
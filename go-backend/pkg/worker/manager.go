@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrStopped 管理器已停止，不再接收新任务
+var ErrStopped = errors.New("worker manager stopped")
+
+// Manager 跟踪请求结束后仍在运行的后台任务，应用停止时等待任务结束。
+// 任务的上下文不随请求取消，只在停止等待超时后被取消
+type Manager struct {
+	mu       sync.Mutex
+	inflight int
+	draining bool
+	stopped  bool
+	idle     chan struct{}
+	idleOnce sync.Once
+
+	// base 停止等待超时后取消，通知仍在运行的任务尽快退出
+	base   context.Context
+	cancel context.CancelFunc
+	log    *log.Helper
+}
+
+// NewManager 创建后台任务管理器
+func NewManager(logger log.Logger) *Manager {
+	base, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		idle:   make(chan struct{}),
+		base:   base,
+		cancel: cancel,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Go 在后台运行任务。任务上下文保留 ctx 中的请求元数据但不继承其取消。
+// 等待停止期间仍接收新任务，正在结束的请求派生的任务也会被等待；停止后返回 ErrStopped
+func (m *Manager) Go(ctx context.Context, name string, fn func(ctx context.Context)) error {
+	m.mu.Lock()
+	if m.stopped {
+		m.mu.Unlock()
+		m.log.WithContext(ctx).Warnf("drop background job %s: %v", name, ErrStopped)
+		return ErrStopped
+	}
+	m.inflight++
+	m.mu.Unlock()
+
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(m.base, cancel)
+
+	go func() {
+		defer m.done()
+		defer stop()
+		defer cancel()
+		defer func() {
+			if r := recover(); r != nil {
+				m.log.WithContext(jobCtx).Errorf("background job %s panic: %v", name, r)
+			}
+		}()
+
+		fn(jobCtx)
+	}()
+	return nil
+}
+
+// InFlight 正在运行的任务数
+func (m *Manager) InFlight() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.inflight
+}
+
+// Shutdown 等待所有任务结束。ctx 到期时取消仍在运行的任务并返回错误，之后不再接收新任务
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	if !m.draining {
+		m.draining = true
+		if m.inflight == 0 {
+			m.closeIdle()
+		}
+	}
+	m.mu.Unlock()
+
+	var err error
+	select {
+	case <-m.idle:
+	case <-ctx.Done():
+		err = fmt.Errorf("%d background jobs still running: %w", m.InFlight(), ctx.Err())
+	}
+
+	m.mu.Lock()
+	m.stopped = true
+	m.mu.Unlock()
+	m.cancel()
+	return err
+}
+
+func (m *Manager) done() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inflight--
+	if m.draining && m.inflight == 0 {
+		m.closeIdle()
+	}
+}
+
+// closeIdle 等待期间任务数可能多次归零，只通知一次
+func (m *Manager) closeIdle() {
+	m.idleOnce.Do(func() { close(m.idle) })
+}
@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey struct{}
+
+func TestManager_ShutdownWaitsForJobs(t *testing.T) {
+	m := NewManager(log.DefaultLogger)
+
+	reqCtx, cancelReq := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "req-1"))
+	release := make(chan struct{})
+	var finished atomic.Bool
+	var value atomic.Value
+	require.NoError(t, m.Go(reqCtx, "slow", func(ctx context.Context) {
+		<-release
+		// 请求结束不影响后台任务，元数据仍可读取
+		value.Store(ctx.Value(ctxKey{}))
+		assert.NoError(t, ctx.Err())
+		finished.Store(true)
+	}))
+	cancelReq()
+	assert.Equal(t, 1, m.InFlight())
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		release <- struct{}{}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, m.Shutdown(ctx))
+	assert.True(t, finished.Load())
+	assert.Equal(t, "req-1", value.Load())
+	assert.Equal(t, 0, m.InFlight())
+
+	assert.ErrorIs(t, m.Go(context.Background(), "late", func(ctx context.Context) {}), ErrStopped)
+}
+
+func TestManager_ShutdownTimeout(t *testing.T) {
+	m := NewManager(log.DefaultLogger)
+
+	cancelled := make(chan struct{})
+	require.NoError(t, m.Go(context.Background(), "stuck", func(ctx context.Context) {
+		<-ctx.Done()
+		close(cancelled)
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := m.Shutdown(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// 超时后仍在运行的任务被取消
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("job was not cancelled after shutdown timeout")
+	}
+}
+
+func TestManager_RecoversPanic(t *testing.T) {
+	m := NewManager(log.DefaultLogger)
+	require.NoError(t, m.Go(context.Background(), "panic", func(ctx context.Context) {
+		panic("boom")
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, m.Shutdown(ctx))
+}
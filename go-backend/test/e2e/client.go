@@ -0,0 +1,133 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// APIError 非2xx响应，Body 为 Kratos 错误结构 {code, reason, message}
+type APIError struct {
+	Status int
+	Reason string
+	Body   string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("http %d %s: %s", e.Status, e.Reason, e.Body)
+}
+
+// Client 模拟客户端的HTTP调用，登录后自动携带Token
+type Client struct {
+	baseURL string
+	http    *http.Client
+
+	// Token 当前登录用户的Access Token，为空时以匿名身份请求
+	Token  string
+	UserID int64
+}
+
+func newClient(baseURL string) *Client {
+	return &Client{
+		baseURL: baseURL,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Get 发送GET请求，out 为空时忽略响应体
+func (c *Client) Get(ctx context.Context, path string, query url.Values, out proto.Message) (*http.Response, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req, out)
+}
+
+// Post 以JSON发送POST请求
+func (c *Client) Post(ctx context.Context, path string, in, out proto.Message) (*http.Response, error) {
+	body, err := protojson.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, out)
+}
+
+// PostMultipart 以multipart表单发送POST请求，文件放在 fileField 字段
+func (c *Client) PostMultipart(ctx context.Context, path string, fields map[string]string, fileField, filename string, data []byte, out proto.Message) (*http.Response, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for k, v := range fields {
+		if err := w.WriteField(k, v); err != nil {
+			return nil, err
+		}
+	}
+	fw, err := w.CreateFormFile(fileField, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, &buf)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return c.do(req, out)
+}
+
+func (c *Client) do(req *http.Request, out proto.Message) (*http.Response, error) {
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{Status: resp.StatusCode, Body: string(body)}
+		var e struct {
+			Reason string `json:"reason"`
+		}
+		if json.Unmarshal(body, &e) == nil {
+			apiErr.Reason = e.Reason
+		}
+		return resp, apiErr
+	}
+
+	if out != nil {
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, out); err != nil {
+			return resp, fmt.Errorf("decode %s response: %w", req.URL.Path, err)
+		}
+	}
+	return resp, nil
+}
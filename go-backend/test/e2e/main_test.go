@@ -0,0 +1,24 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+var env *Env
+
+func TestMain(m *testing.M) {
+	var err error
+	env, err = Start()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "start e2e env failed (is `make testenv-setup` running?): %v\n", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	env.Stop()
+	os.Exit(code)
+}
@@ -0,0 +1,180 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	commentv1 "go-backend/api/comment/v1"
+	commonv1 "go-backend/api/common/v1"
+	favoritev1 "go-backend/api/favorite/v1"
+	userv1 "go-backend/api/user/v1"
+	videov1 "go-backend/api/video/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPassword = "E2e-Passw0rd!"
+
+// TestVideoLifecycle 注册 → 上传 → 处理 → 视频流 → 点赞 → 评论 → 登出
+func TestVideoLifecycle(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	ns := env.Namespace(t)
+
+	creator := register(ctx, t, ns+"_creator")
+	viewer := register(ctx, t, ns+"_viewer")
+
+	title := ns + " lifecycle"
+	var published videov1.PublishVideoResponse
+	_, err := creator.PostMultipart(ctx, "/douyin/publish/action",
+		map[string]string{"token": creator.Token, "title": title},
+		"data", "sample.mp4", sampleVideo(t), &published)
+	require.NoError(t, err)
+	requireSuccess(t, published.Base)
+	videoID := published.Data.VideoId
+
+	// 视频处理完成并发布后出现在视频流中
+	video := waitForFeedVideo(ctx, t, viewer, videoID)
+	assert.Equal(t, title, video.Title)
+	assert.Equal(t, creator.UserID, video.Author.Id)
+
+	var favorited favoritev1.FavoriteActionResponse
+	_, err = viewer.Post(ctx, "/douyin/favorite/action", &favoritev1.FavoriteActionRequest{VideoId: videoID, ActionType: 1}, &favorited)
+	require.NoError(t, err)
+	requireSuccess(t, favorited.Base)
+
+	var commented commentv1.CommentActionResponse
+	_, err = viewer.Post(ctx, "/douyin/comment/action", &commentv1.CommentActionRequest{
+		VideoId:     videoID,
+		ActionType:  1,
+		CommentText: "nice video from " + ns,
+	}, &commented)
+	require.NoError(t, err)
+	requireSuccess(t, commented.Base)
+	assert.Equal(t, viewer.UserID, commented.Comment.User.Id)
+
+	var comments commentv1.GetCommentListResponse
+	_, err = viewer.Get(ctx, "/douyin/comment/list", url.Values{"video_id": {strconv.FormatInt(videoID, 10)}}, &comments)
+	require.NoError(t, err)
+	requireSuccess(t, comments.Base)
+	require.Len(t, comments.Data.CommentList, 1)
+	assert.Equal(t, commented.Comment.Id, comments.Data.CommentList[0].Id)
+
+	var favorites favoritev1.GetFavoriteListResponse
+	_, err = viewer.Get(ctx, "/douyin/favorite/list", url.Values{"user_id": {strconv.FormatInt(viewer.UserID, 10)}}, &favorites)
+	require.NoError(t, err)
+	requireSuccess(t, favorites.Base)
+	require.Len(t, favorites.Data.VideoList, 1)
+	assert.True(t, favorites.Data.VideoList[0].IsFavorite)
+
+	// 内部gRPC接口看到的计数与HTTP操作一致
+	info, err := videov1.NewVideoServiceClient(env.GRPCConn).GetVideoInfo(ctx, &videov1.GetVideoInfoRequest{VideoId: videoID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), info.Video.FavoriteCount)
+	assert.Equal(t, int64(1), info.Video.CommentCount)
+
+	var loggedOut userv1.LogoutResponse
+	_, err = viewer.Post(ctx, "/douyin/user/logout", &userv1.LogoutRequest{}, &loggedOut)
+	require.NoError(t, err)
+	requireSuccess(t, loggedOut.Base)
+
+	// 登出后原Token不能再访问需要认证的接口
+	_, err = viewer.Post(ctx, "/douyin/favorite/action", &favoritev1.FavoriteActionRequest{VideoId: videoID, ActionType: 2}, nil)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "expected api error, got %v", err)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.Status)
+}
+
+// TestAnonymousAccess 未登录用户可以浏览视频流，不能执行写操作
+func TestAnonymousAccess(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	anonymous := env.Client()
+
+	var feed videov1.GetFeedResponse
+	_, err := anonymous.Get(ctx, "/douyin/feed", nil, &feed)
+	require.NoError(t, err)
+	requireSuccess(t, feed.Base)
+
+	_, err = anonymous.Post(ctx, "/douyin/comment/action", &commentv1.CommentActionRequest{VideoId: 1, ActionType: 1, CommentText: "hi"}, nil)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "expected api error, got %v", err)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.Status)
+}
+
+// register 注册用户并返回已登录的客户端
+func register(ctx context.Context, t *testing.T, username string) *Client {
+	t.Helper()
+
+	client := env.Client()
+	var resp userv1.RegisterResponse
+	_, err := client.Post(ctx, "/douyin/user/register", &userv1.RegisterRequest{Username: username, Password: testPassword}, &resp)
+	require.NoError(t, err)
+	requireSuccess(t, resp.Base)
+
+	client.Token = resp.Data.Token
+	client.UserID = resp.Data.UserId
+	return client
+}
+
+// waitForFeedVideo 轮询视频流直到出现指定视频
+func waitForFeedVideo(ctx context.Context, t *testing.T, client *Client, videoID int64) *commonv1.Video {
+	t.Helper()
+
+	for {
+		var feed videov1.GetFeedResponse
+		_, err := client.Get(ctx, "/douyin/feed", nil, &feed)
+		require.NoError(t, err)
+		requireSuccess(t, feed.Base)
+		for _, video := range feed.GetData().GetVideoList() {
+			if video.Id == videoID {
+				return video
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			t.Fatalf("video %d did not appear in feed: %v", videoID, ctx.Err())
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// sampleVideo 用 ffmpeg 生成一秒的测试视频，环境中没有 ffmpeg 时跳过用例
+func sampleVideo(t *testing.T) []byte {
+	t.Helper()
+
+	if _, err := exec.LookPath("ffmpeg"); err != nil {
+		t.Skip("ffmpeg not found, skipping upload scenario")
+	}
+
+	path := filepath.Join(t.TempDir(), "sample.mp4")
+	cmd := exec.Command("ffmpeg", "-loglevel", "error", "-f", "lavfi", "-i", "testsrc=duration=1:size=320x240:rate=10",
+		"-pix_fmt", "yuv420p", "-y", path)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return data
+}
+
+func requireSuccess(t *testing.T, base *commonv1.BaseResponse) {
+	t.Helper()
+	require.NotNil(t, base)
+	require.Equal(t, int32(0), base.StatusCode, base.StatusMsg)
+}
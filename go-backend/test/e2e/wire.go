@@ -0,0 +1,34 @@
+//go:build wireinject
+// +build wireinject
+
+package e2e
+
+import (
+	"go-backend/internal/biz"
+	"go-backend/internal/conf"
+	"go-backend/internal/data"
+	"go-backend/internal/data/consumer"
+	"go-backend/internal/data/producer"
+	"go-backend/internal/middleware"
+	"go-backend/internal/provider"
+	"go-backend/internal/server"
+	"go-backend/internal/service"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireServers 使用与 cmd/go-backend 相同的 providers 组装服务
+func wireServers(*conf.Server, *conf.Data, *conf.Business, *conf.Bootstrap, log.Logger) (*servers, func(), error) {
+	panic(wire.Build(
+		server.ProviderSet,
+		data.ProviderSet,
+		biz.ProviderSet,
+		service.ProviderSet,
+		middleware.ProviderSet,
+		producer.ProviderSet,
+		consumer.ProviderSet,
+		provider.PkgSet,
+		wire.Struct(new(servers), "*"),
+	))
+}
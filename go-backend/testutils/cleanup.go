@@ -41,7 +41,9 @@ func (te *TestEnv) Setup() error {
 		return err
 	}
 
-	// 不再初始化基础数据，让每个测试自己创建需要的数据
+	// 不再初始化基础数据，让每个测试自己创建需要的数据；
+	// 表清空后自增ID从头开始，重置种子使工厂生成的数据可复现
+	te.DataManager.Seed(defaultFixtureSeed)
 	return nil
 }
 
@@ -0,0 +1,38 @@
+package testutils
+
+import (
+	"time"
+
+	"go-backend/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// NewDataConfig 返回指向测试环境MySQL和Redis的数据层配置，
+// 用于通过注入器构建依赖的测试
+func NewDataConfig() *conf.Data {
+	return &conf.Data{
+		Database: &conf.Data_Database{
+			Driver:          "mysql",
+			Source:          "tiktok:tiktok123@tcp(localhost:3307)/tiktok?charset=utf8mb4&parseTime=True&loc=Local",
+			MaxIdleConns:    10,
+			MaxOpenConns:    100,
+			ConnMaxLifetime: durationpb.New(time.Hour),
+		},
+		Redis: &conf.Data_Redis{
+			Addr:         "localhost:6381",
+			Password:     "tiktok123",
+			Db:           1,
+			DialTimeout:  durationpb.New(5 * time.Second),
+			ReadTimeout:  durationpb.New(3 * time.Second),
+			WriteTimeout: durationpb.New(3 * time.Second),
+			PoolSize:     100,
+		},
+	}
+}
+
+// NewBusinessConfig 返回测试环境的业务配置，不启用注册次数限制等防滥用策略，
+// 需要这些策略的测试自行设置对应字段
+func NewBusinessConfig() *conf.Business {
+	return &conf.Business{}
+}
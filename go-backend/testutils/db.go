@@ -87,6 +87,38 @@ func (tdb *TestDB) TruncateAllTables() error {
 		"user_favorites",
 		"comments",
 		"messages",
+		"user_muted_keywords",
+		"video_audits",
+		"flagged_registrations",
+		"permission_denials",
+		"processing_jobs",
+		"referrals",
+		"referral_codes",
+		"content_drafts",
+		"watch_history",
+		"event_outbox",
+		"dead_letter_messages",
+		"video_takedowns",
+		"takedown_events",
+		"video_categories",
+		"admin_operation_logs",
+		"video_captions",
+		"video_object_integrity",
+		"promotion_campaigns",
+		"promotion_events",
+		"follow_requests",
+		"processed_events",
+		"video_stats_checkpoints",
+		"user_stats_daily",
+		"sensitive_words",
+		"jwt_signing_keys",
+		"user_permission_versions",
+		"login_history",
+		"user_oauth_accounts",
+		"storage_deletions",
+		"playlists",
+		"playlist_videos",
+		"video_share_links",
 		"videos",
 		"users",
 		"roles",
@@ -0,0 +1,263 @@
+package testutils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+	"gorm.io/gorm"
+)
+
+const (
+	// FixturePassword 工厂创建的用户统一使用的明文密码
+	FixturePassword = "password123"
+	// defaultFixtureSeed 默认随机种子，同一种子下生成的数据相同
+	defaultFixtureSeed = 20240101
+)
+
+// TestVideo 测试视频数据
+type TestVideo struct {
+	ID            int64
+	AuthorID      int64
+	Title         string
+	PlayURL       string
+	CoverURL      string
+	FavoriteCount int64
+	CommentCount  int64
+	PlayCount     int64
+	Status        int32
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (TestVideo) TableName() string {
+	return "videos"
+}
+
+// UserFixture 工厂创建的用户及其关联数据
+type UserFixture struct {
+	User      *TestUser
+	Followers []*TestUser
+	Videos    []*TestVideo
+	Roles     []*TestRole
+}
+
+// UserOption 用户工厂选项
+type UserOption func(*userSpec)
+
+type userSpec struct {
+	nickname  string
+	followers int
+	videos    []videoSpec
+	roles     []string
+}
+
+type videoSpec struct {
+	count  int
+	status int32
+}
+
+// WithNickname 指定昵称
+func WithNickname(nickname string) UserOption {
+	return func(s *userSpec) {
+		s.nickname = nickname
+	}
+}
+
+// WithFollowers 创建 n 个关注该用户的粉丝
+func WithFollowers(n int) UserOption {
+	return func(s *userSpec) {
+		s.followers += n
+	}
+}
+
+// WithVideos 创建 n 个指定状态的视频，可多次使用创建不同状态的视频
+func WithVideos(n int, status int32) UserOption {
+	return func(s *userSpec) {
+		s.videos = append(s.videos, videoSpec{count: n, status: status})
+	}
+}
+
+// WithRole 分配角色，角色不存在时创建
+func WithRole(name string) UserOption {
+	return func(s *userSpec) {
+		s.roles = append(s.roles, name)
+	}
+}
+
+// Seed 重置随机种子和序号，相同种子下后续生成的用户名、标题和计数相同
+func (tdm *TestDataManager) Seed(seed int64) {
+	tdm.rng = rand.New(rand.NewSource(seed))
+	tdm.seq = 0
+}
+
+// CreateUser 按选项创建用户及其粉丝、视频和角色，冗余计数与关联数据保持一致
+func (tdm *TestDataManager) CreateUser(opts ...UserOption) (*UserFixture, error) {
+	spec := &userSpec{}
+	for _, opt := range opts {
+		opt(spec)
+	}
+
+	fixture := &UserFixture{}
+	err := tdm.db.DB.Transaction(func(tx *gorm.DB) error {
+		user, err := tdm.insertUser(tx, spec.nickname)
+		if err != nil {
+			return err
+		}
+		fixture.User = user
+
+		for i := 0; i < spec.followers; i++ {
+			follower, err := tdm.insertUser(tx, "")
+			if err != nil {
+				return err
+			}
+			if err := insertFollow(tx, follower.ID, user.ID); err != nil {
+				return err
+			}
+			follower.FollowCount = 1
+			fixture.Followers = append(fixture.Followers, follower)
+		}
+		user.FollowerCount = spec.followers
+
+		videos, err := tdm.insertVideos(tx, user.ID, spec.videos)
+		if err != nil {
+			return err
+		}
+		fixture.Videos = videos
+		for _, video := range videos {
+			if video.Status == 1 {
+				user.WorkCount++
+			}
+		}
+
+		for _, name := range spec.roles {
+			role, err := findOrCreateRole(tx, name)
+			if err != nil {
+				return err
+			}
+			if err := tx.Table("user_roles").Create(map[string]interface{}{
+				"user_id":    user.ID,
+				"role_id":    role.ID,
+				"created_at": time.Now(),
+			}).Error; err != nil {
+				return fmt.Errorf("assign role %s: %w", name, err)
+			}
+			fixture.Roles = append(fixture.Roles, role)
+		}
+
+		return tx.Model(user).Updates(map[string]interface{}{
+			"follower_count": user.FollowerCount,
+			"work_count":     user.WorkCount,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fixture, nil
+}
+
+func (tdm *TestDataManager) insertUser(tx *gorm.DB, nickname string) (*TestUser, error) {
+	tdm.seq++
+	hash, salt := tdm.fixturePassword()
+	if nickname == "" {
+		nickname = fmt.Sprintf("Fixture User %d", tdm.seq)
+	}
+
+	now := time.Now()
+	user := &TestUser{
+		Username:        fmt.Sprintf("fixture%d", tdm.seq),
+		PasswordHash:    hash,
+		Salt:            salt,
+		Nickname:        nickname,
+		Avatar:          "https://example.com/avatar.jpg",
+		BackgroundImage: "https://example.com/bg.jpg",
+		Signature:       fmt.Sprintf("fixture user %d", tdm.seq),
+		Status:          1,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := tx.Create(user).Error; err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// insertVideos 按规格顺序创建视频，后创建的视频发布时间更晚
+func (tdm *TestDataManager) insertVideos(tx *gorm.DB, authorID int64, specs []videoSpec) ([]*TestVideo, error) {
+	total := 0
+	for _, spec := range specs {
+		total += spec.count
+	}
+
+	base := time.Now().Truncate(time.Second).Add(-time.Duration(total) * time.Second)
+	videos := make([]*TestVideo, 0, total)
+	for _, spec := range specs {
+		for i := 0; i < spec.count; i++ {
+			tdm.seq++
+			createdAt := base.Add(time.Duration(len(videos)) * time.Second)
+			video := &TestVideo{
+				AuthorID:      authorID,
+				Title:         fmt.Sprintf("fixture video %d", tdm.seq),
+				PlayURL:       fmt.Sprintf("https://example.com/videos/%d.mp4", tdm.seq),
+				CoverURL:      fmt.Sprintf("https://example.com/covers/%d.jpg", tdm.seq),
+				FavoriteCount: tdm.rng.Int63n(1000),
+				CommentCount:  tdm.rng.Int63n(100),
+				PlayCount:     tdm.rng.Int63n(10000),
+				Status:        spec.status,
+				CreatedAt:     createdAt,
+				UpdatedAt:     createdAt,
+			}
+			if err := tx.Create(video).Error; err != nil {
+				return nil, err
+			}
+			videos = append(videos, video)
+		}
+	}
+	return videos, nil
+}
+
+// fixturePassword 所有工厂用户共用一个密码哈希，避免每个用户都做一次 argon2 计算
+func (tdm *TestDataManager) fixturePassword() (string, string) {
+	if tdm.passwordHash == "" {
+		salt := []byte("fixture-salt-016")
+		hash := argon2.IDKey([]byte(FixturePassword), salt, 1, 64*1024, 4, 32)
+		tdm.passwordHash = base64.RawStdEncoding.EncodeToString(hash)
+		tdm.passwordSalt = base64.RawStdEncoding.EncodeToString(salt)
+	}
+	return tdm.passwordHash, tdm.passwordSalt
+}
+
+func insertFollow(tx *gorm.DB, userID, followUserID int64) error {
+	return tx.Table("user_follows").Create(map[string]interface{}{
+		"user_id":        userID,
+		"follow_user_id": followUserID,
+		"created_at":     time.Now(),
+	}).Error
+}
+
+func findOrCreateRole(tx *gorm.DB, name string) (*TestRole, error) {
+	var role TestRole
+	err := tx.Where("name = ?", name).First(&role).Error
+	if err == nil {
+		return &role, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	now := time.Now()
+	role = TestRole{
+		Name:        name,
+		Description: fmt.Sprintf("%s role", name),
+		Status:      1,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := tx.Create(&role).Error; err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
@@ -1,9 +1,12 @@
 package testutils
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	mathrand "math/rand"
+	"strconv"
 	"time"
 
 	"golang.org/x/crypto/argon2"
@@ -68,6 +71,12 @@ func (TestPermission) TableName() string {
 type TestDataManager struct {
 	db    *TestDB
 	redis *TestRedis
+
+	// 工厂数据的随机源和序号，见 Seed
+	rng          *mathrand.Rand
+	seq          int
+	passwordHash string
+	passwordSalt string
 }
 
 // NewTestDataManager 创建测试数据管理器
@@ -75,6 +84,7 @@ func NewTestDataManager(db *TestDB, redis *TestRedis) *TestDataManager {
 	return &TestDataManager{
 		db:    db,
 		redis: redis,
+		rng:   mathrand.New(mathrand.NewSource(defaultFixtureSeed)),
 	}
 }
 
@@ -267,13 +277,11 @@ func (tdm *TestDataManager) CreateFollowRelation(userID, followUserID int64) err
 
 	// 清除相关缓存，确保缓存一致性
 	if tdm.redis != nil {
-		keys := []string{
-			fmt.Sprintf("follow:%d:%d", userID, followUserID),
-			fmt.Sprintf("follow:%d:*", userID),
-			fmt.Sprintf("follow:*:%d", followUserID),
-		}
+		ctx := context.Background()
+		// 关注状态缓存为每个用户一个哈希，直接插入关系后删除对应字段和双方的计数缓存；
 		// 忽略缓存清理错误，因为这是测试环境
-		tdm.redis.Del(keys...)
+		tdm.redis.Client.HDel(ctx, fmt.Sprintf("follow:%d", userID), strconv.FormatInt(followUserID, 10))
+		tdm.redis.Del(fmt.Sprintf("counts:user:%d", userID), fmt.Sprintf("counts:user:%d", followUserID))
 	}
 
 	// 验证插入是否成功
@@ -0,0 +1,13 @@
+-- +migrate Up
+-- 用户屏蔽词表
+CREATE TABLE `user_muted_keywords` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Creator user ID',
+  `keyword` varchar(50) NOT NULL COMMENT 'Muted keyword, lower case',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_keyword` (`user_id`,`keyword`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `user_muted_keywords`;
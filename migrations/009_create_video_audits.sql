@@ -0,0 +1,17 @@
+-- +migrate Up
+-- 视频审核记录表
+CREATE TABLE `video_audits` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL COMMENT 'Video ID',
+  `auditor_id` bigint NOT NULL COMMENT 'Moderator user ID',
+  `from_status` tinyint NOT NULL COMMENT 'Video status before review',
+  `to_status` tinyint NOT NULL COMMENT 'Video status after review',
+  `reason` varchar(255) DEFAULT NULL COMMENT 'Review reason, required on rejection',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_video_id` (`video_id`),
+  KEY `idx_auditor_created` (`auditor_id`,`created_at` DESC)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `video_audits`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- 用户时区偏好，用于定时发布、推送窗口和统计分桶
+ALTER TABLE `users`
+  ADD COLUMN `timezone` varchar(64) NOT NULL DEFAULT 'UTC' COMMENT 'IANA timezone name' AFTER `signature`;
+
+-- +migrate Down
+ALTER TABLE `users` DROP COLUMN `timezone`;
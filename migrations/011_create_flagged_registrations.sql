@@ -0,0 +1,20 @@
+-- +migrate Up
+-- 可疑注册审核队列
+CREATE TABLE `flagged_registrations` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Registered user ID',
+  `username` varchar(32) NOT NULL COMMENT 'Username at registration',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT 'Client IP at registration',
+  `reasons` varchar(255) NOT NULL COMMENT 'Comma separated flag reasons',
+  `contact` varchar(254) NOT NULL DEFAULT '' COMMENT 'Email or phone provided at registration',
+  `status` tinyint NOT NULL DEFAULT 0 COMMENT '0 pending, 1 approved, 2 rejected',
+  `reviewer_id` bigint NOT NULL DEFAULT 0 COMMENT 'Reviewing admin user ID',
+  `reviewed_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`),
+  KEY `idx_status_created` (`status`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `flagged_registrations`;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- 权限拒绝审计记录，按采样写入并由定时任务裁剪到固定行数
+CREATE TABLE `permission_denials` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Denied user ID',
+  `resource` varchar(100) NOT NULL COMMENT 'Requested resource',
+  `action` varchar(50) NOT NULL COMMENT 'Requested action',
+  `route` varchar(255) NOT NULL DEFAULT '' COMMENT 'Request route or gRPC operation',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`,`created_at`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `permission_denials`;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- 权限生效范围，own 表示仅对用户拥有的资源生效
+ALTER TABLE `permissions`
+  ADD COLUMN `scope` varchar(20) NOT NULL DEFAULT '' COMMENT 'Permission scope: empty-any resource, own-owned resources only' AFTER `description`;
+
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `scope`) VALUES
+('video:delete:own', '/video', 'DELETE', 'Delete own video', 'own'),
+('comment:delete:own', '/comment', 'DELETE', 'Delete own comment or comments under own video', 'own');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT r.id, p.id FROM `roles` r, `permissions` p
+WHERE r.name = 'user' AND p.name IN ('video:delete:own', 'comment:delete:own');
+
+-- +migrate Down
+DELETE FROM `permissions` WHERE `name` IN ('video:delete:own', 'comment:delete:own');
+ALTER TABLE `permissions` DROP COLUMN `scope`;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- 用户绑定的邮箱，仅在验证通过后写入
+ALTER TABLE `users`
+  ADD COLUMN `email` varchar(128) NULL DEFAULT NULL COMMENT 'Verified email' AFTER `signature`,
+  ADD UNIQUE KEY `uk_email` (`email`);
+
+-- +migrate Down
+ALTER TABLE `users` DROP INDEX `uk_email`, DROP COLUMN `email`;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- 视频处理任务的耗时和资源记录，用于容量规划和分级限额
+CREATE TABLE `processing_jobs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL COMMENT 'Video ID',
+  `author_id` bigint NOT NULL COMMENT 'Video author ID',
+  `job_type` varchar(20) NOT NULL COMMENT 'Job type: transcode, thumbnail',
+  `status` varchar(20) NOT NULL COMMENT 'Job status: success, failed',
+  `duration_ms` bigint NOT NULL DEFAULT '0' COMMENT 'Wall clock time in milliseconds',
+  `cpu_seconds` double NOT NULL DEFAULT '0' COMMENT 'CPU time of external processes',
+  `input_bytes` bigint NOT NULL DEFAULT '0' COMMENT 'Input size',
+  `output_bytes` bigint NOT NULL DEFAULT '0' COMMENT 'Output size',
+  `error_message` varchar(500) NOT NULL DEFAULT '' COMMENT 'Failure reason',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_video_id` (`video_id`),
+  KEY `idx_author_created` (`author_id`,`created_at`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `processing_jobs`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- Refresh Token轮换族，用于检测已轮换Token的重放
+ALTER TABLE `user_sessions`
+  ADD COLUMN `family_id` varchar(64) NOT NULL DEFAULT '' COMMENT 'Refresh token rotation family' AFTER `refresh_token`;
+
+-- +migrate Down
+ALTER TABLE `user_sessions` DROP COLUMN `family_id`;
@@ -0,0 +1,34 @@
+-- +migrate Up
+-- 用户推荐码，记录生成时的IP和设备用于识别自我推荐
+CREATE TABLE `referral_codes` (
+  `user_id` bigint NOT NULL COMMENT 'Referrer user ID',
+  `code` varchar(16) NOT NULL COMMENT 'Referral code',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT 'IP when the code was created',
+  `device_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'Device when the code was created',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`user_id`),
+  UNIQUE KEY `uk_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 推荐注册归因
+CREATE TABLE `referrals` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `referrer_id` bigint NOT NULL COMMENT 'Referrer user ID',
+  `referee_id` bigint NOT NULL COMMENT 'Referred user ID',
+  `code` varchar(16) NOT NULL COMMENT 'Redeemed referral code',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT 'Registration IP',
+  `device_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'Registration device',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT 'Status: 0-pending, 1-activated, 2-flagged',
+  `flag_reasons` varchar(255) NOT NULL DEFAULT '' COMMENT 'Comma separated abuse flags',
+  `activated_at` timestamp NULL DEFAULT NULL COMMENT 'When the referred user published the first video',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_referee_id` (`referee_id`),
+  KEY `idx_referrer_status` (`referrer_id`,`status`),
+  KEY `idx_device_created` (`device_id`,`created_at`),
+  KEY `idx_status_activated` (`status`,`activated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `referrals`;
+DROP TABLE IF EXISTS `referral_codes`;
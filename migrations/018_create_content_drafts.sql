@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 创作者内容日历草稿，设置了计划发布时间的草稿出现在日历中并按提醒时间发送提醒
+CREATE TABLE `content_drafts` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Creator user ID',
+  `title` varchar(100) NOT NULL COMMENT 'Draft title',
+  `note` varchar(500) NOT NULL DEFAULT '' COMMENT 'Planning note',
+  `scheduled_at` timestamp NULL DEFAULT NULL COMMENT 'Planned publish time, NULL when unscheduled',
+  `remind_at` timestamp NULL DEFAULT NULL COMMENT 'Reminder time, NULL when no reminder',
+  `reminded_at` timestamp NULL DEFAULT NULL COMMENT 'When the reminder was sent',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_scheduled` (`user_id`,`scheduled_at`),
+  KEY `idx_remind` (`reminded_at`,`remind_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `content_drafts`;
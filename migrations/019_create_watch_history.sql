@@ -0,0 +1,14 @@
+-- +migrate Up
+-- 观看记录，同一用户在去重窗口内重复观看同一视频只记录一次，过期记录由 watch_history 保留策略清理
+CREATE TABLE `watch_history` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Viewer user ID',
+  `video_id` bigint NOT NULL COMMENT 'Watched video ID',
+  `watched_at` timestamp(3) NOT NULL COMMENT 'When the view was recorded',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_watched` (`user_id`,`watched_at`),
+  KEY `idx_watched_at` (`watched_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `watch_history`;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- 事件发件箱，与业务数据同一事务写入，由 outbox_relay 任务投递到Kafka；已投递记录由 event_outbox 保留策略清理
+CREATE TABLE `event_outbox` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `event_id` varchar(64) NOT NULL COMMENT 'Idempotency key, used as the Kafka message ID',
+  `event_type` varchar(64) NOT NULL COMMENT 'Event type, e.g. video.uploaded',
+  `aggregate_id` bigint NOT NULL COMMENT 'ID of the entity the event belongs to',
+  `payload` json NOT NULL COMMENT 'Serialized domain event',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '0: pending, 1: sent, 2: dead',
+  `attempts` int NOT NULL DEFAULT '0' COMMENT 'Failed delivery attempts',
+  `last_error` varchar(500) DEFAULT NULL COMMENT 'Last delivery error',
+  `next_attempt_at` timestamp(3) NOT NULL COMMENT 'Earliest time of the next delivery attempt',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `sent_at` timestamp(3) NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_event_id` (`event_id`),
+  KEY `idx_status_next` (`status`,`next_attempt_at`),
+  KEY `idx_sent_at` (`sent_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `event_outbox`;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- 账号注销冷静期：status=3 表示待注销，deletion_scheduled_at 到期后由 pending_account_deletion 保留策略清除
+ALTER TABLE `users`
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive, 3-pending deletion',
+  ADD COLUMN `deletion_requested_at` timestamp NULL DEFAULT NULL COMMENT 'Account deletion request time' AFTER `last_login_at`,
+  ADD COLUMN `deletion_scheduled_at` timestamp NULL DEFAULT NULL COMMENT 'Account purge time after the grace period' AFTER `deletion_requested_at`,
+  ADD KEY `idx_deletion_scheduled` (`deletion_scheduled_at`);
+
+-- +migrate Down
+ALTER TABLE `users`
+  DROP KEY `idx_deletion_scheduled`,
+  DROP COLUMN `deletion_scheduled_at`,
+  DROP COLUMN `deletion_requested_at`,
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive';
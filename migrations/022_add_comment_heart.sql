@@ -0,0 +1,7 @@
+-- +migrate Up
+-- 视频作者给评论点的小红心，非空表示已点
+ALTER TABLE `comments`
+  ADD COLUMN `hearted_at` timestamp NULL DEFAULT NULL COMMENT 'Time the video author hearted the comment' AFTER `status`;
+
+-- +migrate Down
+ALTER TABLE `comments` DROP COLUMN `hearted_at`;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- 消费重试耗尽的死信消息，供管理后台查询和重放；已重放记录由 replayed_dead_letters 保留策略清理
+CREATE TABLE `dead_letter_messages` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `topic` varchar(128) NOT NULL COMMENT 'Original topic',
+  `partition` int NOT NULL COMMENT 'Original partition',
+  `offset` bigint NOT NULL COMMENT 'Original offset',
+  `message_key` varchar(255) NOT NULL DEFAULT '' COMMENT 'Original message key',
+  `message_id` varchar(64) NOT NULL DEFAULT '' COMMENT 'Message ID, empty when the payload could not be parsed',
+  `payload` mediumblob NOT NULL COMMENT 'Original message value',
+  `error` varchar(1000) NOT NULL COMMENT 'Last handling error',
+  `attempts` int NOT NULL DEFAULT '0' COMMENT 'Handling attempts before dead-lettering',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '0: pending, 1: replayed',
+  `replayed_by` bigint DEFAULT NULL COMMENT 'Admin who replayed the message',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `replayed_at` timestamp(3) NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_topic_partition_offset` (`topic`,`partition`,`offset`),
+  KEY `idx_status_created` (`status`,`created_at`),
+  KEY `idx_replayed_at` (`replayed_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `dead_letter_messages`;
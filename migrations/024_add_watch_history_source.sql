@@ -0,0 +1,10 @@
+-- +migrate Up
+-- 观看记录增加流量来源，并按视频建立索引供创作者受众分析聚合
+ALTER TABLE `watch_history`
+  ADD COLUMN `source` tinyint NOT NULL DEFAULT '0' COMMENT 'Traffic source: 0-unknown, 1-feed, 2-profile, 3-share link' AFTER `video_id`,
+  ADD KEY `idx_video_watched` (`video_id`,`watched_at`);
+
+-- +migrate Down
+ALTER TABLE `watch_history`
+  DROP KEY `idx_video_watched`,
+  DROP COLUMN `source`;
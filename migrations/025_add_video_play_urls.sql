@@ -0,0 +1,7 @@
+-- +migrate Up
+-- 各清晰度转码后的播放地址，键为清晰度名称（480p/720p/1080p），play_url 仍为原始上传地址
+ALTER TABLE `videos`
+  ADD COLUMN `play_urls` json DEFAULT NULL COMMENT 'Transcoded play URLs keyed by quality' AFTER `cover_url`;
+
+-- +migrate Down
+ALTER TABLE `videos` DROP COLUMN `play_urls`;
@@ -0,0 +1,41 @@
+-- +migrate Up
+-- 管理员下架记录和审计记录；legal_hold 置位期间视频文件保存在 legal-hold/ 前缀下，作者账号不会被注销清理
+CREATE TABLE `video_takedowns` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL,
+  `author_id` bigint NOT NULL COMMENT 'Video author, no foreign key so the record outlives account deletion',
+  `admin_id` bigint NOT NULL COMMENT 'Admin who took the video down',
+  `category` varchar(32) NOT NULL COMMENT 'copyright, illegal, violence, sexual, harassment, spam, other',
+  `reason` varchar(500) NOT NULL,
+  `from_status` tinyint NOT NULL COMMENT 'Video status before takedown, restored on reinstatement',
+  `held_objects` json DEFAULT NULL COMMENT 'Original object key to legal-hold object key',
+  `status` tinyint NOT NULL DEFAULT '1' COMMENT '1: taken down, 2: appealed, 3: reinstated, 4: upheld',
+  `legal_hold` tinyint(1) NOT NULL DEFAULT '1' COMMENT 'Content and author account must not be purged while set',
+  `appeal_reason` varchar(500) NOT NULL DEFAULT '',
+  `appealed_at` timestamp(3) NULL DEFAULT NULL,
+  `decided_by` bigint DEFAULT NULL COMMENT 'Admin who decided the appeal',
+  `decided_at` timestamp(3) NULL DEFAULT NULL,
+  `decision_note` varchar(500) NOT NULL DEFAULT '',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_video_id` (`video_id`),
+  KEY `idx_author_created` (`author_id`,`created_at`),
+  KEY `idx_status_created` (`status`,`created_at`),
+  KEY `idx_author_legal_hold` (`author_id`,`legal_hold`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `takedown_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `takedown_id` bigint NOT NULL,
+  `actor_id` bigint NOT NULL COMMENT 'Admin or author who performed the action',
+  `action` varchar(20) NOT NULL COMMENT 'takedown, appeal, reinstate, uphold',
+  `note` varchar(500) NOT NULL DEFAULT '',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_takedown_created` (`takedown_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `takedown_events`;
+DROP TABLE IF EXISTS `video_takedowns`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- HLS自适应码率主播放列表地址，切片文件位于同一前缀 hls/<video_id>/ 下
+ALTER TABLE `videos`
+  ADD COLUMN `hls_url` varchar(500) NOT NULL DEFAULT '' COMMENT 'HLS master playlist URL' AFTER `play_urls`;
+
+-- +migrate Down
+ALTER TABLE `videos` DROP COLUMN `hls_url`;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- 管理员维护的视频分类，names 为各语言的分类名称；videos.category_id 为 0 表示未分类
+CREATE TABLE `video_categories` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `slug` varchar(50) NOT NULL COMMENT 'Stable identifier used by clients and analytics',
+  `names` json NOT NULL COMMENT 'Localized names keyed by language tag',
+  `sort_order` int NOT NULL DEFAULT '0' COMMENT 'Ascending display order',
+  `status` tinyint NOT NULL DEFAULT '1' COMMENT '1: active, 2: disabled',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_slug` (`slug`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE `videos`
+  ADD COLUMN `category_id` bigint NOT NULL DEFAULT '0' COMMENT 'Video category, 0 for uncategorized' AFTER `hls_url`,
+  ADD KEY `idx_category_status_created` (`category_id`,`status`,`created_at` DESC);
+
+-- +migrate Down
+ALTER TABLE `videos`
+  DROP KEY `idx_category_status_created`,
+  DROP COLUMN `category_id`;
+DROP TABLE IF EXISTS `video_categories`;
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- 上传时探测到的原始视频元信息，探测失败时为0，转码消费者会再次探测补齐
+ALTER TABLE `videos`
+  ADD COLUMN `duration` decimal(10,3) NOT NULL DEFAULT '0' COMMENT 'Duration in seconds' AFTER `category_id`,
+  ADD COLUMN `width` int NOT NULL DEFAULT '0' COMMENT 'Source width in pixels' AFTER `duration`,
+  ADD COLUMN `height` int NOT NULL DEFAULT '0' COMMENT 'Source height in pixels' AFTER `width`,
+  ADD COLUMN `bitrate` bigint NOT NULL DEFAULT '0' COMMENT 'Source bitrate in bps' AFTER `height`,
+  ADD COLUMN `size` bigint NOT NULL DEFAULT '0' COMMENT 'Source file size in bytes' AFTER `bitrate`,
+  ADD COLUMN `format` varchar(16) NOT NULL DEFAULT '' COMMENT 'Container format from file extension' AFTER `size`;
+
+-- +migrate Down
+ALTER TABLE `videos`
+  DROP COLUMN `format`,
+  DROP COLUMN `size`,
+  DROP COLUMN `bitrate`,
+  DROP COLUMN `height`,
+  DROP COLUMN `width`,
+  DROP COLUMN `duration`;
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- 管理员执行的运维操作（清缓存、清会话、重建索引、重新处理视频）的审计记录，同时作为操作限频的计数来源
+CREATE TABLE `admin_operation_logs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `admin_id` bigint NOT NULL,
+  `action` varchar(32) NOT NULL COMMENT 'flush_cache, purge_sessions, reindex, requeue_processing',
+  `target` varchar(500) NOT NULL DEFAULT '' COMMENT 'Namespace, user id, id range or video ids',
+  `affected` bigint NOT NULL DEFAULT '0' COMMENT 'Number of entries affected',
+  `status` tinyint NOT NULL DEFAULT '1' COMMENT '1: succeeded, 2: failed',
+  `error` varchar(1000) NOT NULL DEFAULT '',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_action_created` (`action`,`created_at`),
+  KEY `idx_admin_created` (`admin_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `admin_operation_logs`;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 视频字幕，每行一个字幕段；ngram 全文索引用于在创作者的视频中按口播内容检索
+CREATE TABLE `video_captions` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `video_id` bigint NOT NULL,
+  `author_id` bigint NOT NULL COMMENT 'Denormalized video author for per-creator search',
+  `language` varchar(16) NOT NULL DEFAULT '' COMMENT 'BCP 47 language tag, empty if unknown',
+  `start_ms` int NOT NULL COMMENT 'Cue start offset in milliseconds',
+  `end_ms` int NOT NULL COMMENT 'Cue end offset in milliseconds',
+  `text` varchar(1000) NOT NULL,
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_video_language_start` (`video_id`,`language`,`start_ms`),
+  KEY `idx_author` (`author_id`),
+  FULLTEXT KEY `ft_text` (`text`) WITH PARSER ngram
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `video_captions`;
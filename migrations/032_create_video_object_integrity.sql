@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 视频原始文件的完整性基线与最近一次校验结果，由存储完整性校验任务维护
+CREATE TABLE `video_object_integrity` (
+  `video_id` bigint NOT NULL,
+  `object_name` varchar(500) NOT NULL COMMENT 'Object key of the original upload',
+  `size` bigint NOT NULL DEFAULT 0,
+  `etag` varchar(128) NOT NULL DEFAULT '' COMMENT 'ETag recorded on first verification',
+  `checksum` varchar(80) NOT NULL DEFAULT '' COMMENT 'md5:<hex> of the content, empty if never downloaded',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '1 ok, 2 derived objects missing, 3 original missing, 4 original corrupted',
+  `detail` varchar(500) NOT NULL DEFAULT '',
+  `checked_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`video_id`),
+  KEY `idx_status_checked` (`status`,`checked_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `video_object_integrity`;
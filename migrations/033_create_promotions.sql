@@ -0,0 +1,35 @@
+-- +migrate Up
+-- 视频流推广：管理员配置的推广计划，以及用于计费的曝光和点击记录
+CREATE TABLE `promotion_campaigns` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `video_id` bigint NOT NULL COMMENT 'Promoted video',
+  `category_ids` json DEFAULT NULL COMMENT 'Category feeds to target, empty for the main feed only',
+  `audience` varchar(16) NOT NULL DEFAULT 'all' COMMENT 'all, users or guests',
+  `priority` int NOT NULL DEFAULT 0 COMMENT 'Higher priority fills earlier slots',
+  `frequency_cap` int NOT NULL DEFAULT 0 COMMENT 'Max impressions per user within the window, 0 for unlimited',
+  `frequency_window_sec` int NOT NULL DEFAULT 0,
+  `start_at` timestamp(3) NOT NULL,
+  `end_at` timestamp(3) NOT NULL,
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '1 active, 2 paused',
+  `created_by` bigint NOT NULL,
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_status_end` (`status`,`end_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE `promotion_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `campaign_id` bigint NOT NULL,
+  `video_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL DEFAULT 0 COMMENT '0 for guests',
+  `event_type` tinyint NOT NULL COMMENT '1 impression, 2 click',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_campaign_type_created` (`campaign_id`,`event_type`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `promotion_events`;
+DROP TABLE IF EXISTS `promotion_campaigns`;
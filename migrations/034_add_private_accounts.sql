@@ -0,0 +1,20 @@
+-- +migrate Up
+-- 私密账号：关注私密账号需要对方通过关注申请
+ALTER TABLE `users`
+  ADD COLUMN `is_private` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'Private account, follows require approval' AFTER `timezone`;
+
+CREATE TABLE `follow_requests` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `requester_id` bigint NOT NULL COMMENT 'User asking to follow',
+  `target_id` bigint NOT NULL COMMENT 'Private account being followed',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '1 pending, 2 approved, 3 rejected',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_requester_target` (`requester_id`,`target_id`),
+  KEY `idx_target_status_created` (`target_id`,`status`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `follow_requests`;
+ALTER TABLE `users` DROP COLUMN `is_private`;
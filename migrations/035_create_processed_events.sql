@@ -0,0 +1,14 @@
+-- +migrate Up
+-- 消费者已处理的事件，Kafka重复投递时按事件ID跳过，避免重复执行副作用
+CREATE TABLE `processed_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `consumer` varchar(64) NOT NULL COMMENT 'Handler that processed the event',
+  `event_id` varchar(64) NOT NULL,
+  `processed_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_consumer_event` (`consumer`,`event_id`),
+  KEY `idx_processed_at` (`processed_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `processed_events`;
@@ -0,0 +1,11 @@
+-- +migrate Up
+-- 视频计数写缓冲的日志流检查点，记录已写入视频表的最后一条日志，崩溃后重放时跳过已应用的条目
+CREATE TABLE `video_stats_checkpoints` (
+  `stream` varchar(64) NOT NULL COMMENT 'Redis stream key of the stats journal',
+  `last_entry_id` varchar(32) NOT NULL COMMENT 'Last stream entry applied to videos',
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`stream`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `video_stats_checkpoints`;
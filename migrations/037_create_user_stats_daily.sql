@@ -0,0 +1,15 @@
+-- +migrate Up
+-- 用户日统计，由统计消费者根据关注、点赞和播放事件按UTC自然日累加，供创作者数据查询
+CREATE TABLE `user_stats_daily` (
+  `user_id` bigint NOT NULL,
+  `stat_date` date NOT NULL COMMENT 'UTC date',
+  `new_followers` bigint NOT NULL DEFAULT 0,
+  `lost_followers` bigint NOT NULL DEFAULT 0,
+  `video_plays` bigint NOT NULL DEFAULT 0 COMMENT 'Plays of the user''s videos',
+  `likes_received` bigint NOT NULL DEFAULT 0 COMMENT 'Net likes on the user''s videos',
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`user_id`, `stat_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `user_stats_daily`;
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- 内容审核词表，与配置中的词表合并后加载到内存，命中违禁词的内容直接拒绝，命中疑似违规词的转人工复核
+CREATE TABLE `sensitive_words` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `word` varchar(64) NOT NULL,
+  `level` tinyint NOT NULL COMMENT '1 review, 2 block',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_word` (`word`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 待复核评论按提交时间排队
+ALTER TABLE `comments`
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'Comment status: 1-normal, 2-deleted, 3-hidden, 4-pending review',
+  ADD KEY `idx_status_created` (`status`,`created_at`);
+
+-- +migrate Down
+ALTER TABLE `comments`
+  DROP KEY `idx_status_created`,
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'Comment status: 1-normal, 2-deleted, 3-hidden';
+DROP TABLE IF EXISTS `sensitive_words`;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- 令牌签名密钥，各实例定时加载。新密钥在 activate_at 之后开始签发，被替换的密钥在 retire_at 之前仍可验证
+CREATE TABLE `jwt_signing_keys` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `kid` varchar(64) NOT NULL,
+  `secret` varchar(255) NOT NULL,
+  `activate_at` timestamp(3) NOT NULL,
+  `retire_at` timestamp(3) NULL DEFAULT NULL,
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_kid` (`kid`),
+  KEY `idx_retire_at` (`retire_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `jwt_signing_keys`;
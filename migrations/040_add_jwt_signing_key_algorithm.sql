@@ -0,0 +1,10 @@
+-- +migrate Up
+-- 签名密钥支持 RS256/EdDSA，secret 存放 PEM 私钥，长度超过原字段
+ALTER TABLE `jwt_signing_keys`
+  ADD COLUMN `algorithm` varchar(16) NOT NULL DEFAULT 'HS256' AFTER `kid`,
+  MODIFY COLUMN `secret` text NOT NULL;
+
+-- +migrate Down
+ALTER TABLE `jwt_signing_keys`
+  DROP COLUMN `algorithm`,
+  MODIFY COLUMN `secret` varchar(255) NOT NULL;
@@ -0,0 +1,11 @@
+-- +migrate Up
+-- 用户角色绑定的权限版本，随绑定变更在同一事务中递增，写入 Access Token 用于跳过大部分权限查询
+CREATE TABLE `user_permission_versions` (
+  `user_id` bigint NOT NULL,
+  `version` bigint NOT NULL DEFAULT '0',
+  `updated_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `user_permission_versions`;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- 登录记录，按设备指纹和网络段与历史成功登录比较识别异常登录，过期记录由 login_history 保留策略清理
+CREATE TABLE `login_history` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `device_id` varchar(128) NOT NULL DEFAULT '',
+  `fingerprint` varchar(64) NOT NULL COMMENT 'SHA-256 of device ID and user agent',
+  `ip` varchar(45) NOT NULL DEFAULT '',
+  `network` varchar(64) NOT NULL DEFAULT '' COMMENT 'IPv4 /24 or IPv6 /48 of the client IP',
+  `user_agent` varchar(255) NOT NULL DEFAULT '',
+  `status` tinyint NOT NULL COMMENT '1: allowed, 2: challenged, 3: verified',
+  `reason` varchar(64) NOT NULL DEFAULT '' COMMENT 'Anomaly reasons, empty for normal logins',
+  `challenge_code` varchar(64) NOT NULL DEFAULT '' COMMENT 'SHA-256 of the step-up verification code',
+  `challenge_attempts` int NOT NULL DEFAULT '0',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`,`created_at`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `login_history`;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- 第三方登录账号绑定，同一第三方账号只能绑定一个本地用户
+CREATE TABLE `user_oauth_accounts` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `provider` varchar(32) NOT NULL COMMENT 'Configured provider name',
+  `subject` varchar(128) NOT NULL COMMENT 'Account ID at the provider',
+  `email` varchar(128) NOT NULL DEFAULT '' COMMENT 'Email reported by the provider when linked',
+  `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_provider_subject` (`provider`,`subject`),
+  KEY `idx_user_id` (`user_id`),
+  CONSTRAINT `fk_user_oauth_accounts_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `user_oauth_accounts`;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- 用户绑定的手机号，用于短信验证码登录，仅支持中国大陆11位手机号
+ALTER TABLE `users`
+  ADD COLUMN `phone` varchar(20) NULL DEFAULT NULL COMMENT 'Mobile phone number' AFTER `email`,
+  ADD UNIQUE KEY `uk_phone` (`phone`);
+
+-- +migrate Down
+ALTER TABLE `users` DROP INDEX `uk_phone`, DROP COLUMN `phone`;
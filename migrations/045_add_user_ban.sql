@@ -0,0 +1,14 @@
+-- +migrate Up
+-- 账号封禁：status=4 表示已封禁，记录封禁的管理员、时间和原因，解封时清空
+ALTER TABLE `users`
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive, 3-pending deletion, 4-banned',
+  ADD COLUMN `banned_at` timestamp NULL DEFAULT NULL COMMENT 'Ban time' AFTER `deletion_scheduled_at`,
+  ADD COLUMN `banned_by` bigint NULL DEFAULT NULL COMMENT 'Admin who banned the user' AFTER `banned_at`,
+  ADD COLUMN `ban_reason` varchar(500) NULL DEFAULT NULL COMMENT 'Ban reason' AFTER `banned_by`;
+
+-- +migrate Down
+ALTER TABLE `users`
+  DROP COLUMN `ban_reason`,
+  DROP COLUMN `banned_by`,
+  DROP COLUMN `banned_at`,
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive, 3-pending deletion';
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- 待删除的存储对象，由后台任务在 delete_after 之后删除，失败时推迟重试。
+-- 以 / 结尾的 object_name 表示删除该前缀下的全部对象
+CREATE TABLE `storage_deletions` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `object_name` varchar(500) NOT NULL COMMENT 'Object key, or a prefix when ending with /',
+  `reason` varchar(32) NOT NULL DEFAULT '' COMMENT 'account_purge, data_export',
+  `delete_after` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'Earliest deletion time',
+  `attempts` int NOT NULL DEFAULT 0,
+  `last_error` varchar(500) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_delete_after` (`delete_after`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 冷静期满的注销账号改为匿名化保留，status=5 表示已注销
+ALTER TABLE `users`
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive, 3-pending deletion, 4-banned, 5-deleted';
+
+-- +migrate Down
+ALTER TABLE `users`
+  MODIFY COLUMN `status` tinyint DEFAULT '1' COMMENT 'User status: 1-active, 2-inactive, 3-pending deletion, 4-banned';
+
+DROP TABLE IF EXISTS `storage_deletions`;
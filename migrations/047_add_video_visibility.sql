@@ -0,0 +1,8 @@
+-- +migrate Up
+-- 视频可见范围，与 status 独立：1公开 2仅互相关注的好友 3仅作者本人。视频流只包含公开视频
+ALTER TABLE `videos`
+  ADD COLUMN `visibility` tinyint NOT NULL DEFAULT '1' COMMENT 'Visibility: 1-public, 2-friends, 3-private' AFTER `status`;
+
+-- +migrate Down
+ALTER TABLE `videos`
+  DROP COLUMN `visibility`;
@@ -0,0 +1,10 @@
+-- +migrate Up
+-- 定时发布：草稿视频（status=10）到达 publish_at 后由定时发布任务发布，发布时清空 publish_at
+ALTER TABLE `videos`
+  ADD COLUMN `publish_at` timestamp NULL DEFAULT NULL COMMENT 'Scheduled publish time of a draft' AFTER `visibility`,
+  ADD KEY `idx_status_publish_at` (`status`, `publish_at`);
+
+-- +migrate Down
+ALTER TABLE `videos`
+  DROP KEY `idx_status_publish_at`,
+  DROP COLUMN `publish_at`;
@@ -0,0 +1,29 @@
+-- +migrate Up
+-- 用户创建的视频合集，video_count 随条目增删同步维护
+CREATE TABLE `playlists` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT 'Owner user ID',
+  `name` varchar(50) NOT NULL COMMENT 'Playlist name',
+  `description` varchar(200) NOT NULL DEFAULT '' COMMENT 'Playlist description',
+  `video_count` bigint NOT NULL DEFAULT 0 COMMENT 'Number of entries',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_created` (`user_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 合集中的视频条目，按 position 升序排列
+CREATE TABLE `playlist_videos` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `playlist_id` bigint NOT NULL,
+  `video_id` bigint NOT NULL,
+  `position` int NOT NULL DEFAULT 0 COMMENT 'Sort order within the playlist',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_playlist_video` (`playlist_id`,`video_id`),
+  KEY `idx_playlist_position` (`playlist_id`,`position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `playlist_videos`;
+DROP TABLE IF EXISTS `playlists`;
@@ -0,0 +1,26 @@
+-- +migrate Up
+-- 分享计数：每次分享计入 share_count，并经发件箱投递统计事件
+ALTER TABLE `videos`
+  ADD COLUMN `share_count` bigint NOT NULL DEFAULT '0' COMMENT 'Share count' AFTER `play_count`;
+
+-- 分享短链，同一用户分享同一视频复用同一个 token，打开和带 token 的播放归因到分享者
+CREATE TABLE `video_share_links` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `token` varchar(16) CHARACTER SET ascii COLLATE ascii_bin NOT NULL COMMENT 'Short share token, case-sensitive',
+  `video_id` bigint NOT NULL,
+  `sharer_id` bigint NOT NULL COMMENT 'User who shared the video',
+  `share_count` bigint NOT NULL DEFAULT 0 COMMENT 'Times the sharer shared the video',
+  `open_count` bigint NOT NULL DEFAULT 0 COMMENT 'Times the link was opened by others',
+  `play_count` bigint NOT NULL DEFAULT 0 COMMENT 'Counted plays attributed to the link',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_token` (`token`),
+  UNIQUE KEY `uk_video_sharer` (`video_id`,`sharer_id`),
+  KEY `idx_sharer` (`sharer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `video_share_links`;
+ALTER TABLE `videos`
+  DROP COLUMN `share_count`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- 动态封面：视频处理时截取开头几秒生成的 GIF 或动图 WebP，与静态封面同在 covers/ 前缀下
+ALTER TABLE `videos`
+  ADD COLUMN `dynamic_cover_url` varchar(500) NOT NULL DEFAULT '' COMMENT 'Animated preview URL' AFTER `hls_url`;
+
+-- +migrate Down
+ALTER TABLE `videos` DROP COLUMN `dynamic_cover_url`;
@@ -0,0 +1,10 @@
+-- +migrate Up
+-- 原视频第一路音轨的编码和码率，由视频处理时的探测结果写入，没有音轨时为空
+ALTER TABLE `videos`
+  ADD COLUMN `audio_codec` varchar(32) NOT NULL DEFAULT '' COMMENT 'Source audio codec' AFTER `format`,
+  ADD COLUMN `audio_bitrate` bigint NOT NULL DEFAULT '0' COMMENT 'Source audio bitrate in bps' AFTER `audio_codec`;
+
+-- +migrate Down
+ALTER TABLE `videos`
+  DROP COLUMN `audio_bitrate`,
+  DROP COLUMN `audio_codec`;